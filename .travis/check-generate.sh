@@ -3,6 +3,7 @@ set -ex
 
 go generate gonum.org/v1/netlib/blas/netlib
 go generate gonum.org/v1/netlib/lapack/lapacke
+go generate gonum.org/v1/netlib/lapack/lapacke/expert
 go generate gonum.org/v1/netlib/lapack/netlib
 
 git checkout -- go.{mod,sum}
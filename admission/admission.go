@@ -0,0 +1,333 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package admission provides an admission controller that bounds the load
+// placed on the native BLAS and LAPACK libraries by a process.
+//
+// A Controller caps the number of calls that run concurrently, the number of
+// calls waiting to be admitted and the rate of floating point operations
+// performed by admitted calls. It is intended for programs, such as servers,
+// in which numerical work shares the processors with latency sensitive work
+// that must not be starved by the threads of the backend library.
+//
+// The process-wide controller installed with SetDefault is consulted by the
+// Level 3 routines of gonum.org/v1/netlib/blas/netlib and by the LAPACK
+// factorizations and drivers of gonum.org/v1/netlib/lapack/lapacke, which
+// are used by gonum.org/v1/netlib/lapack/netlib. Other work can be admitted
+// explicitly with Controller.Do, which must not enclose calls that are
+// admitted themselves:
+//
+//	c := admission.New(admission.Config{MaxConcurrent: 2, FLOPRate: 50e9})
+//	admission.SetDefault(c)
+//	err := c.Do(flops, func() {
+//		fft.Coefficients(dst, seq)
+//	})
+package admission // import "gonum.org/v1/netlib/admission"
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	// ErrQueueFull is returned when a call cannot be admitted because
+	// the number of waiting calls has reached Config.MaxQueue.
+	ErrQueueFull = errors.New("admission: queue full")
+
+	// ErrLimited is returned by a non-blocking Controller when a call
+	// cannot be admitted immediately.
+	ErrLimited = errors.New("admission: limit reached")
+)
+
+// Config holds the limits of a Controller. Zero values impose no limit.
+type Config struct {
+	// MaxConcurrent is the largest number of admitted calls that may
+	// run at the same time.
+	MaxConcurrent int
+
+	// MaxQueue is the largest number of calls that may wait to be
+	// admitted. Calls arriving when the queue is full fail with
+	// ErrQueueFull.
+	MaxQueue int
+
+	// FLOPRate is the sustained number of floating point operations per
+	// second that admitted calls may perform.
+	FLOPRate float64
+
+	// Burst is the number of floating point operations that may be
+	// admitted at once when the controller has been idle. If Burst is
+	// zero, the operations of one second at FLOPRate are allowed. A
+	// single call larger than Burst is admitted when the full burst is
+	// available and delays the calls that follow it.
+	Burst float64
+
+	// NonBlocking specifies that calls which cannot be admitted
+	// immediately fail with ErrLimited instead of waiting.
+	NonBlocking bool
+}
+
+// Stats holds the counters of a Controller.
+type Stats struct {
+	// Running and Waiting are the number of calls that are currently
+	// admitted and waiting to be admitted.
+	Running, Waiting int
+
+	// Admitted and Rejected are the number of calls that have been
+	// admitted and rejected with an error.
+	Admitted, Rejected uint64
+
+	// FLOPs is the total number of floating point operations reported by
+	// admitted calls.
+	FLOPs float64
+
+	// Wait is the total time admitted calls spent waiting.
+	Wait time.Duration
+}
+
+// Controller admits calls subject to the limits of its Config. A Controller
+// is safe for concurrent use. Waiting calls are admitted in arrival order.
+type Controller struct {
+	cfg Config
+
+	mu sync.Mutex
+	// tokens is the number of operations that could be admitted at the
+	// time last. It is negative after a call larger than the burst.
+	tokens float64
+	last   time.Time
+	// queue holds the waiting calls in arrival order.
+	queue []*waiter
+	// changed is closed and replaced when the state changes in a way
+	// that may allow a waiting call to be admitted.
+	changed chan struct{}
+	// tickets holds the tickets of ended calls for reuse by later calls.
+	tickets []*ticket
+
+	stats Stats
+}
+
+// ticket is the admission of a call. Its release function is created with
+// the ticket and the ticket is reused once the call has ended, so that
+// admitting a call does not allocate.
+type ticket struct {
+	c *Controller
+	// held is whether the call has been admitted and has not ended.
+	held    bool
+	release func()
+}
+
+type waiter struct {
+	flops float64
+}
+
+// New returns a Controller with the limits in cfg. New panics if any limit
+// is negative.
+func New(cfg Config) *Controller {
+	if cfg.MaxConcurrent < 0 || cfg.MaxQueue < 0 || cfg.FLOPRate < 0 || cfg.Burst < 0 {
+		panic("admission: negative limit")
+	}
+	if cfg.FLOPRate > 0 && cfg.Burst == 0 {
+		cfg.Burst = cfg.FLOPRate
+	}
+	return &Controller{
+		cfg:     cfg,
+		tokens:  cfg.Burst,
+		last:    time.Now(),
+		changed: make(chan struct{}),
+	}
+}
+
+// Config returns the limits of c.
+func (c *Controller) Config() Config {
+	return c.cfg
+}
+
+// Acquire admits a call that performs the given number of floating point
+// operations, waiting if necessary, and returns a function that must be
+// called when the call has returned. Calls of release after the first have
+// no effect, but release is reused for a later call once it has been
+// called, so it must not be retained. If the call cannot be admitted,
+// Acquire returns ErrQueueFull or ErrLimited and a nil release function.
+func (c *Controller) Acquire(flops float64) (release func(), err error) {
+	if flops < 0 || math.IsNaN(flops) {
+		flops = 0
+	}
+	c.mu.Lock()
+	now := time.Now()
+	c.refill(now)
+	if len(c.queue) == 0 && c.admissible(flops) {
+		t := c.admit(flops, 0)
+		c.mu.Unlock()
+		return t.release, nil
+	}
+	if c.cfg.NonBlocking {
+		c.stats.Rejected++
+		c.mu.Unlock()
+		return nil, ErrLimited
+	}
+	if c.cfg.MaxQueue > 0 && len(c.queue) >= c.cfg.MaxQueue {
+		c.stats.Rejected++
+		c.mu.Unlock()
+		return nil, ErrQueueFull
+	}
+	w := &waiter{flops: flops}
+	c.queue = append(c.queue, w)
+	c.stats.Waiting++
+	start := now
+
+	var timer *time.Timer
+	for {
+		var delay time.Duration
+		if c.queue[0] == w {
+			now = time.Now()
+			c.refill(now)
+			if c.admissible(flops) {
+				c.queue = c.queue[1:]
+				c.stats.Waiting--
+				t := c.admit(flops, now.Sub(start))
+				c.broadcast()
+				c.mu.Unlock()
+				if timer != nil {
+					timer.Stop()
+				}
+				return t.release, nil
+			}
+			delay = c.delay(flops)
+		}
+		changed := c.changed
+		c.mu.Unlock()
+
+		if delay > 0 {
+			if timer == nil {
+				timer = time.NewTimer(delay)
+			} else {
+				timer.Reset(delay)
+			}
+			select {
+			case <-changed:
+				if !timer.Stop() {
+					<-timer.C
+				}
+			case <-timer.C:
+			}
+		} else {
+			<-changed
+		}
+		c.mu.Lock()
+	}
+}
+
+// Do calls fn after admitting it as described for Acquire. If the call is
+// not admitted, fn is not called and the error is returned.
+func (c *Controller) Do(flops float64, fn func()) error {
+	release, err := c.Acquire(flops)
+	if err != nil {
+		return err
+	}
+	defer release()
+	fn()
+	return nil
+}
+
+// Stats returns the current counters of c.
+func (c *Controller) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// end ends the call admitted with t unless it has already ended.
+func (t *ticket) end() {
+	c := t.c
+	c.mu.Lock()
+	if t.held {
+		t.held = false
+		c.stats.Running--
+		c.tickets = append(c.tickets, t)
+		if len(c.queue) != 0 {
+			c.broadcast()
+		}
+	}
+	c.mu.Unlock()
+}
+
+// refill adds the operations accrued since the last refill to the budget.
+// It must be called with c.mu held.
+func (c *Controller) refill(now time.Time) {
+	if c.cfg.FLOPRate == 0 {
+		return
+	}
+	c.tokens = math.Min(c.cfg.Burst, c.tokens+now.Sub(c.last).Seconds()*c.cfg.FLOPRate)
+	c.last = now
+}
+
+// admissible returns whether a call performing flops operations may be
+// admitted now. It must be called with c.mu held.
+func (c *Controller) admissible(flops float64) bool {
+	if c.cfg.MaxConcurrent > 0 && c.stats.Running >= c.cfg.MaxConcurrent {
+		return false
+	}
+	return c.cfg.FLOPRate == 0 || c.tokens >= math.Min(flops, c.cfg.Burst)
+}
+
+// delay returns the time until the budget allows a call performing flops
+// operations, or zero if the call is waiting for a running call to finish.
+// It must be called with c.mu held.
+func (c *Controller) delay(flops float64) time.Duration {
+	if c.cfg.MaxConcurrent > 0 && c.stats.Running >= c.cfg.MaxConcurrent {
+		return 0
+	}
+	need := math.Min(flops, c.cfg.Burst) - c.tokens
+	return time.Duration(math.Ceil(need / c.cfg.FLOPRate * float64(time.Second)))
+}
+
+// admit records the admission of a call and returns its ticket. It must be
+// called with c.mu held.
+func (c *Controller) admit(flops float64, wait time.Duration) *ticket {
+	if c.cfg.FLOPRate > 0 {
+		c.tokens -= flops
+	}
+	c.stats.Running++
+	c.stats.Admitted++
+	c.stats.FLOPs += flops
+	c.stats.Wait += wait
+
+	var t *ticket
+	if n := len(c.tickets); n != 0 {
+		t = c.tickets[n-1]
+		c.tickets = c.tickets[:n-1]
+	} else {
+		t = &ticket{c: c}
+		t.release = t.end
+	}
+	t.held = true
+	return t
+}
+
+// broadcast wakes all waiting calls. It must be called with c.mu held.
+func (c *Controller) broadcast() {
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
+
+// def holds the process-wide controller.
+var def atomic.Value
+
+type holder struct {
+	c *Controller
+}
+
+// SetDefault installs c as the process-wide controller consulted by the
+// netlib packages. A nil c removes the controller.
+func SetDefault(c *Controller) {
+	def.Store(holder{c})
+}
+
+// Default returns the process-wide controller, or nil if none is installed.
+func Default() *Controller {
+	h, _ := def.Load().(holder)
+	return h.c
+}
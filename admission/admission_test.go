@@ -0,0 +1,187 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package admission
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMaxConcurrent(t *testing.T) {
+	const (
+		limit = 3
+		calls = 20
+	)
+	c := New(Config{MaxConcurrent: limit})
+
+	var (
+		mu              sync.Mutex
+		running, maxRun int
+		wg              sync.WaitGroup
+	)
+	wg.Add(calls)
+	for i := 0; i < calls; i++ {
+		go func() {
+			defer wg.Done()
+			err := c.Do(1, func() {
+				mu.Lock()
+				running++
+				if running > maxRun {
+					maxRun = running
+				}
+				mu.Unlock()
+				time.Sleep(time.Millisecond)
+				mu.Lock()
+				running--
+				mu.Unlock()
+			})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if maxRun > limit {
+		t.Errorf("concurrency limit exceeded: got %d running want at most %d", maxRun, limit)
+	}
+	s := c.Stats()
+	if s.Admitted != calls || s.Running != 0 || s.Waiting != 0 || s.Rejected != 0 {
+		t.Errorf("unexpected counters: %+v", s)
+	}
+	if s.FLOPs != calls {
+		t.Errorf("unexpected FLOP count: got %v want %v", s.FLOPs, calls)
+	}
+}
+
+func TestQueueFull(t *testing.T) {
+	c := New(Config{MaxConcurrent: 1, MaxQueue: 1})
+	release, err := c.Acquire(0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	admitted := make(chan struct{})
+	go func() {
+		r, err := c.Acquire(0)
+		if err != nil {
+			t.Errorf("unexpected error for queued call: %v", err)
+			close(admitted)
+			return
+		}
+		close(admitted)
+		r()
+	}()
+	for c.Stats().Waiting != 1 {
+		time.Sleep(time.Millisecond)
+	}
+
+	if _, err := c.Acquire(0); err != ErrQueueFull {
+		t.Errorf("unexpected error for call beyond queue: got %v want %v", err, ErrQueueFull)
+	}
+	release()
+	<-admitted
+	if s := c.Stats(); s.Rejected != 1 || s.Admitted != 2 {
+		t.Errorf("unexpected counters: %+v", s)
+	}
+}
+
+func TestNonBlocking(t *testing.T) {
+	c := New(Config{MaxConcurrent: 1, NonBlocking: true})
+	release, err := c.Acquire(0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	called := false
+	if err := c.Do(0, func() { called = true }); err != ErrLimited {
+		t.Errorf("unexpected error: got %v want %v", err, ErrLimited)
+	}
+	if called {
+		t.Error("rejected function was called")
+	}
+	release()
+	if err := c.Do(0, func() { called = true }); err != nil || !called {
+		t.Errorf("call not admitted after release: %v", err)
+	}
+}
+
+func TestRelease(t *testing.T) {
+	c := New(Config{MaxConcurrent: 2})
+	first, err := c.Acquire(0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := c.Acquire(0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	first()
+	first()
+	if s := c.Stats(); s.Running != 1 {
+		t.Errorf("unexpected number of running calls after repeated release: got %d want 1", s.Running)
+	}
+	second()
+	if s := c.Stats(); s.Running != 0 {
+		t.Errorf("unexpected number of running calls: got %d want 0", s.Running)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() {
+		release, err := c.Acquire(1)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		release()
+	})
+	if allocs != 0 {
+		t.Errorf("unexpected number of allocations: got %v want 0", allocs)
+	}
+}
+
+func TestFLOPRate(t *testing.T) {
+	const (
+		rate  = 1e6
+		burst = 1e4
+		calls = 5
+	)
+	c := New(Config{FLOPRate: rate, Burst: burst})
+	start := time.Now()
+	for i := 0; i < calls; i++ {
+		if err := c.Do(burst, func() {}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	// The first call uses the initial burst and each following call
+	// waits for the budget to refill.
+	want := time.Duration((calls - 1) * burst / rate * float64(time.Second))
+	if got := time.Since(start); got < want*9/10 {
+		t.Errorf("calls admitted too fast: took %v want at least %v", got, want)
+	}
+	if s := c.Stats(); s.Wait <= 0 {
+		t.Errorf("no waiting time recorded: %+v", s)
+	}
+
+	c = New(Config{FLOPRate: rate, Burst: burst, NonBlocking: true})
+	if err := c.Do(burst, func() {}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := c.Do(burst, func() {}); err != ErrLimited {
+		t.Errorf("unexpected error for call over budget: got %v want %v", err, ErrLimited)
+	}
+}
+
+func TestDefault(t *testing.T) {
+	if Default() != nil {
+		t.Fatal("unexpected default controller")
+	}
+	c := New(Config{})
+	SetDefault(c)
+	if Default() != c {
+		t.Error("default controller not installed")
+	}
+	SetDefault(nil)
+	if Default() != nil {
+		t.Error("default controller not removed")
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+
+	blasnetlib "gonum.org/v1/netlib/blas/netlib"
+)
+
+const badInc = "autodiff: recorded operand with non-unit increment"
+
+// Recorder is a blas.Float64 implementation that records the products
+// computed by Dgemm and Dgemv on a Tape, so that code written against
+// blas64 can be differentiated by installing the Recorder with
+//  blas64.Use(rec)
+// A call is recorded if one of its operands is a variable of the Recorder:
+// a matrix passed to Watch or the output of an earlier recorded call. An
+// operand is identified by the address of its first element, its
+// dimensions and its stride. The other routines are computed by the
+// embedded Implementation and are not recorded.
+//
+// The values of the variables are copied when they are recorded, so the
+// storage of a variable must not be modified other than by recorded calls
+// while the Recorder is in use.
+type Recorder struct {
+	blasnetlib.Implementation
+
+	tape *Tape
+	vars map[operand]*Var
+}
+
+// operand identifies the storage of a matrix passed to a recorded call.
+type operand struct {
+	data               *float64
+	rows, cols, stride int
+}
+
+// NewRecorder returns a Recorder that records on t.
+func NewRecorder(t *Tape) *Recorder {
+	return &Recorder{tape: t, vars: make(map[operand]*Var)}
+}
+
+// Watch records a copy of the matrix a as an independent variable and
+// associates it with the storage of a.
+func (r *Recorder) Watch(a blas64.General) *Var {
+	v := r.tape.NewVar(a)
+	if a.Rows > 0 && a.Cols > 0 {
+		r.vars[operand{&a.Data[0], a.Rows, a.Cols, a.Stride}] = v
+	}
+	return v
+}
+
+// Var returns the variable associated with the storage of a, or nil if
+// a is not a variable of r.
+func (r *Recorder) Var(a blas64.General) *Var {
+	if a.Rows == 0 || a.Cols == 0 {
+		return nil
+	}
+	return r.vars[operand{&a.Data[0], a.Rows, a.Cols, a.Stride}]
+}
+
+// Dgemm computes
+//  C = alpha * op(A) * op(B) + beta * C
+// as the Implementation does, and records the product if A, B or C, when
+// beta is not zero, is a variable of r. The result is then a variable of r.
+func (r *Recorder) Dgemm(tA, tB blas.Transpose, m, n, k int, alpha float64, a []float64, lda int, b []float64, ldb int, beta float64, c []float64, ldc int) {
+	ar, ac := m, k
+	if tA != blas.NoTrans {
+		ar, ac = k, m
+	}
+	br, bc := k, n
+	if tB != blas.NoTrans {
+		br, bc = n, k
+	}
+	av := r.lookup(a, ar, ac, lda)
+	bv := r.lookup(b, br, bc, ldb)
+	var cv *Var
+	if beta != 0 {
+		cv = r.lookup(c, m, n, ldc)
+	}
+	if av == nil && bv == nil && cv == nil {
+		r.Implementation.Dgemm(tA, tB, m, n, k, alpha, a, lda, b, ldb, beta, c, ldc)
+		r.forget(c, m, n, ldc)
+		return
+	}
+	if av == nil {
+		av = r.constant(a, ar, ac, lda)
+	}
+	if bv == nil {
+		bv = r.constant(b, br, bc, ldb)
+	}
+	p := r.tape.Gemm(tA, tB, alpha, av, bv)
+	r.Implementation.Dgeadd(m, n, 1, p.value.Data, p.value.Stride, beta, c, ldc)
+	r.result(p, beta, cv, c, m, n, ldc)
+}
+
+// Dgemv computes
+//  y = alpha * op(A) * x + beta * y
+// as the Implementation does, and records the product if A, x or y, when
+// beta is not zero, is a variable of r. The result is then a variable of r.
+// Vectors are column vectors with a stride of one, so Dgemv panics if a
+// recorded call has increments other than one.
+func (r *Recorder) Dgemv(tA blas.Transpose, m, n int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
+	lenX, lenY := n, m
+	if tA != blas.NoTrans {
+		lenX, lenY = m, n
+	}
+	av := r.lookup(a, m, n, lda)
+	var xv, yv *Var
+	if incX == 1 {
+		xv = r.lookup(x, lenX, 1, 1)
+	}
+	if beta != 0 && incY == 1 {
+		yv = r.lookup(y, lenY, 1, 1)
+	}
+	if av == nil && xv == nil && yv == nil {
+		r.Implementation.Dgemv(tA, m, n, alpha, a, lda, x, incX, beta, y, incY)
+		if incY == 1 {
+			r.forget(y, lenY, 1, 1)
+		}
+		return
+	}
+	if incX != 1 || incY != 1 {
+		panic(badInc)
+	}
+	if av == nil {
+		av = r.constant(a, m, n, lda)
+	}
+	if xv == nil {
+		xv = r.constant(x, lenX, 1, 1)
+	}
+	p := r.tape.Gemv(tA, alpha, av, xv)
+	r.Implementation.Dgeadd(lenY, 1, 1, p.value.Data, p.value.Stride, beta, y, 1)
+	r.result(p, beta, yv, y, lenY, 1, 1)
+}
+
+// result associates the variable holding the m×n matrix
+//  p + beta * old
+// stored in c with the storage of c, recording the sum if beta is not
+// zero. If old is nil, the previous value of c is a constant.
+func (r *Recorder) result(p *Var, beta float64, old *Var, c []float64, m, n, ldc int) {
+	if m == 0 || n == 0 {
+		return
+	}
+	if beta == 0 {
+		r.vars[operand{&c[0], m, n, ldc}] = p
+		return
+	}
+	var out *Var
+	out = r.tape.record(clone(blas64.General{Rows: m, Cols: n, Stride: ldc, Data: c}), func() {
+		gout := out.gradient()
+		gp := p.gradient()
+		for i := range gout.Data {
+			gp.Data[i] += gout.Data[i]
+		}
+		if old == nil {
+			return
+		}
+		// The gradient of the previous value of C is beta * C̄.
+		gold := old.gradient()
+		for i := range gout.Data {
+			gold.Data[i] += beta * gout.Data[i]
+		}
+	})
+	r.vars[operand{&c[0], m, n, ldc}] = out
+}
+
+// lookup returns the variable associated with the storage of the r×c
+// matrix starting at s[0] with the given stride, or nil.
+func (r *Recorder) lookup(s []float64, rows, cols, stride int) *Var {
+	if rows == 0 || cols == 0 || len(s) == 0 {
+		return nil
+	}
+	return r.vars[operand{&s[0], rows, cols, stride}]
+}
+
+// forget removes the association of the storage of a matrix that has been
+// overwritten by a call that was not recorded.
+func (r *Recorder) forget(s []float64, rows, cols, stride int) {
+	if rows == 0 || cols == 0 || len(s) == 0 {
+		return
+	}
+	delete(r.vars, operand{&s[0], rows, cols, stride})
+}
+
+// constant records a copy of the matrix stored in s as a variable whose
+// gradient is not used.
+func (r *Recorder) constant(s []float64, rows, cols, stride int) *Var {
+	if stride < max(1, cols) || rows > 0 && cols > 0 && len(s) < (rows-1)*stride+cols {
+		panic(badShape)
+	}
+	return r.tape.NewVar(blas64.General{Rows: rows, Cols: cols, Stride: stride, Data: s})
+}
@@ -0,0 +1,118 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+func TestRecorder(t *testing.T) {
+	const (
+		h   = 1e-6
+		tol = 1e-6
+	)
+	rnd := rand.New(rand.NewSource(1))
+	const m, n, k = 3, 4, 5
+	inputs := []blas64.General{
+		randomGeneral(rnd, m, k),
+		randomGeneral(rnd, n, k),
+		randomGeneral(rnd, n, 1),
+		randomGeneral(rnd, m, 1),
+	}
+
+	// f computes y = C * x + 0.5 * y with C = 1.5 * A * B^T through
+	// blas64, with the inputs A, B, x and y, and overwrites y.
+	f := func(in []blas64.General) blas64.Vector {
+		a, b := in[0], in[1]
+		x := blas64.Vector{N: n, Inc: 1, Data: in[2].Data}
+		y := blas64.Vector{N: m, Inc: 1, Data: in[3].Data}
+		c := general(m, n)
+		blas64.Gemm(blas.NoTrans, blas.Trans, 1.5, a, b, 0, c)
+		blas64.Gemv(blas.NoTrans, 1, c, x, 0.5, y)
+		return y
+	}
+
+	defer blas64.Use(blas64.Implementation())
+	var tape Tape
+	rec := NewRecorder(&tape)
+	blas64.Use(rec)
+	vars := make([]*Var, len(inputs))
+	watched := make([]blas64.General, len(inputs))
+	for i, in := range inputs {
+		watched[i] = clone(in)
+		vars[i] = rec.Watch(watched[i])
+	}
+	y := f(watched)
+	out := rec.Var(blas64.General{Rows: m, Cols: 1, Stride: 1, Data: y.Data})
+	if out == nil {
+		t.Fatal("result not recorded")
+	}
+	seed := randomGeneral(rnd, m, 1)
+	tape.Backward(out, seed)
+
+	blas64.Use(blasImpl)
+	loss := func() float64 {
+		var sum float64
+		in := make([]blas64.General, len(inputs))
+		for i := range in {
+			in[i] = clone(inputs[i])
+		}
+		for i, v := range f(in).Data {
+			sum += seed.Data[i] * v
+		}
+		return sum
+	}
+	for k, in := range inputs {
+		grad := vars[k].Grad()
+		for i := range in.Data {
+			orig := in.Data[i]
+			in.Data[i] = orig + h
+			fp := loss()
+			in.Data[i] = orig - h
+			fm := loss()
+			in.Data[i] = orig
+			want := (fp - fm) / (2 * h)
+			if math.Abs(grad.Data[i]-want) > tol*math.Max(1, math.Abs(want)) {
+				t.Errorf("input %d element %d: gradient mismatch: got %v want %v", k, i, grad.Data[i], want)
+			}
+		}
+	}
+}
+
+func TestRecorderUnrecorded(t *testing.T) {
+	var tape Tape
+	rec := NewRecorder(&tape)
+	a := blas64.General{Rows: 1, Cols: 1, Stride: 1, Data: []float64{2}}
+	b := blas64.General{Rows: 1, Cols: 1, Stride: 1, Data: []float64{3}}
+	c := blas64.General{Rows: 1, Cols: 1, Stride: 1, Data: []float64{0}}
+
+	rec.Dgemm(blas.NoTrans, blas.NoTrans, 1, 1, 1, 1, a.Data, 1, b.Data, 1, 0, c.Data, 1)
+	if c.Data[0] != 6 || rec.Var(c) != nil || tape.Len() != 0 {
+		t.Errorf("unexpected recording of constant product: c=%v, recorded %d", c.Data[0], tape.Len())
+	}
+
+	va := rec.Watch(a)
+	rec.Dgemm(blas.NoTrans, blas.NoTrans, 1, 1, 1, 1, a.Data, 1, b.Data, 1, 0, c.Data, 1)
+	vc := rec.Var(c)
+	if c.Data[0] != 6 || vc == nil {
+		t.Fatalf("product not recorded: c=%v", c.Data[0])
+	}
+	tape.Backward(vc, blas64.General{Rows: 1, Cols: 1, Stride: 1, Data: []float64{1}})
+	if got := va.Grad().Data[0]; got != 3 {
+		t.Errorf("unexpected gradient: got %v want 3", got)
+	}
+
+	// Overwriting C with a constant product ends its association.
+	rec.Dgemm(blas.NoTrans, blas.NoTrans, 1, 1, 1, 1, b.Data, 1, b.Data, 1, 0, c.Data, 1)
+	if rec.Var(c) != nil {
+		t.Error("unexpected variable for overwritten storage")
+	}
+}
@@ -0,0 +1,430 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package netlib provides reverse-mode automatic differentiation through
+// matrix operations computed by the cgo BLAS and LAPACK bindings in
+// gonum.org/v1/netlib.
+//
+// Operations are recorded on a Tape as they are evaluated. Calling Backward
+// with the gradient of a scalar loss with respect to an output propagates
+// vector-Jacobian products back through the recorded operations, after which
+// Grad returns the gradient of the loss with respect to each variable.
+//
+// Code written against gonum.org/v1/gonum/blas/blas64 is recorded by
+// installing a Recorder with blas64.Use, which records the products computed
+// by Dgemm and Dgemv on a Tape. The factorizations are recorded by the
+// methods of Tape.
+//
+// All matrices are stored in row-major order and vectors are represented as
+// single column matrices.
+package netlib // import "gonum.org/v1/netlib/autodiff/netlib"
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+
+	blasnetlib "gonum.org/v1/netlib/blas/netlib"
+	lapacknetlib "gonum.org/v1/netlib/lapack/netlib"
+)
+
+var (
+	blasImpl   blasnetlib.Implementation
+	lapackImpl lapacknetlib.Implementation
+)
+
+const (
+	badTape   = "autodiff: variable recorded on a different tape"
+	badShape  = "autodiff: dimension mismatch"
+	badTrans  = "autodiff: bad transpose"
+	badSquare = "autodiff: matrix is not square"
+	badSeed   = "autodiff: seed dimension mismatch"
+	notVector = "autodiff: x is not a column vector"
+)
+
+// Tape records operations on variables for reverse-mode differentiation.
+// The zero value is an empty Tape ready to use. A Tape is not safe for
+// concurrent use.
+type Tape struct {
+	vars []*Var
+}
+
+// Var is a matrix valued variable recorded on a Tape.
+type Var struct {
+	tape *Tape
+
+	// value is the value of the variable. It is always stored with a
+	// compact stride.
+	value blas64.General
+
+	// grad is the accumulated gradient of the loss with respect to
+	// the variable. It is nil until Backward has been called.
+	grad []float64
+
+	// back propagates grad to the inputs of the operation that
+	// produced the variable. It is nil for leaf variables.
+	back func()
+}
+
+// Value returns the value of v. The returned matrix must not be modified.
+func (v *Var) Value() blas64.General {
+	return v.value
+}
+
+// Grad returns the gradient of the loss passed to the last call to Backward
+// with respect to v. If v does not contribute to the loss, the gradient is
+// zero.
+func (v *Var) Grad() blas64.General {
+	g := blas64.General{
+		Rows:   v.value.Rows,
+		Cols:   v.value.Cols,
+		Stride: v.value.Stride,
+		Data:   v.grad,
+	}
+	if g.Data == nil {
+		g.Data = make([]float64, len(v.value.Data))
+	}
+	return g
+}
+
+// gradient returns the gradient of v as a matrix, allocating it if needed.
+func (v *Var) gradient() blas64.General {
+	if v.grad == nil {
+		v.grad = make([]float64, len(v.value.Data))
+	}
+	return blas64.General{
+		Rows:   v.value.Rows,
+		Cols:   v.value.Cols,
+		Stride: v.value.Stride,
+		Data:   v.grad,
+	}
+}
+
+// NewVar records a copy of the matrix a as an independent variable.
+func (t *Tape) NewVar(a blas64.General) *Var {
+	return t.record(clone(a), nil)
+}
+
+func (t *Tape) record(value blas64.General, back func()) *Var {
+	v := &Var{tape: t, value: value, back: back}
+	t.vars = append(t.vars, v)
+	return v
+}
+
+func (t *Tape) check(vars ...*Var) {
+	for _, v := range vars {
+		if v.tape != t {
+			panic(badTape)
+		}
+	}
+}
+
+// Len returns the number of variables recorded on t.
+func (t *Tape) Len() int {
+	return len(t.vars)
+}
+
+// Reset removes all recorded variables from t.
+func (t *Tape) Reset() {
+	t.vars = nil
+}
+
+// Backward computes the gradients of the scalar loss
+//  L = sum_ij seed_ij * out_ij
+// with respect to all variables recorded on t before out. Gradients from
+// previous calls to Backward are discarded. seed must have the dimensions
+// of out, otherwise Backward will panic.
+func (t *Tape) Backward(out *Var, seed blas64.General) {
+	t.check(out)
+	if seed.Rows != out.value.Rows || seed.Cols != out.value.Cols {
+		panic(badSeed)
+	}
+	for _, v := range t.vars {
+		v.grad = nil
+	}
+	g := out.gradient()
+	for i := 0; i < g.Rows; i++ {
+		copy(g.Data[i*g.Stride:i*g.Stride+g.Cols], seed.Data[i*seed.Stride:i*seed.Stride+g.Cols])
+	}
+	for i := len(t.vars) - 1; i >= 0; i-- {
+		v := t.vars[i]
+		if v.back != nil && v.grad != nil {
+			v.back()
+		}
+	}
+}
+
+// Gemm records the matrix product
+//  C = alpha * op(A) * op(B),
+// where op(X) is X or X^T depending on tA and tB.
+func (t *Tape) Gemm(tA, tB blas.Transpose, alpha float64, a, b *Var) *Var {
+	t.check(a, b)
+	tA = normTrans(tA)
+	tB = normTrans(tB)
+	m, k := dims(tA, a.value)
+	kb, n := dims(tB, b.value)
+	if k != kb {
+		panic(badShape)
+	}
+	c := general(m, n)
+	if m > 0 && n > 0 {
+		blasImpl.Dgemm(tA, tB, m, n, k, alpha, a.value.Data, a.value.Stride, b.value.Data, b.value.Stride, 0, c.Data, c.Stride)
+	}
+	var out *Var
+	out = t.record(c, func() {
+		if m == 0 || n == 0 || k == 0 {
+			return
+		}
+		gc := out.gradient()
+		ga := a.gradient()
+		gb := b.gradient()
+		av, bv := a.value, b.value
+		if tA == blas.NoTrans {
+			// Ā += alpha * C̄ * op(B)^T
+			blasImpl.Dgemm(blas.NoTrans, flip(tB), m, k, n, alpha, gc.Data, gc.Stride, bv.Data, bv.Stride, 1, ga.Data, ga.Stride)
+		} else {
+			// Ā += alpha * op(B) * C̄^T
+			blasImpl.Dgemm(tB, blas.Trans, k, m, n, alpha, bv.Data, bv.Stride, gc.Data, gc.Stride, 1, ga.Data, ga.Stride)
+		}
+		if tB == blas.NoTrans {
+			// B̄ += alpha * op(A)^T * C̄
+			blasImpl.Dgemm(flip(tA), blas.NoTrans, k, n, m, alpha, av.Data, av.Stride, gc.Data, gc.Stride, 1, gb.Data, gb.Stride)
+		} else {
+			// B̄ += alpha * C̄^T * op(A)
+			blasImpl.Dgemm(blas.Trans, tA, n, k, m, alpha, gc.Data, gc.Stride, av.Data, av.Stride, 1, gb.Data, gb.Stride)
+		}
+	})
+	return out
+}
+
+// Gemv records the matrix-vector product
+//  y = alpha * op(A) * x,
+// where op(A) is A or A^T depending on tA, and x is a column vector.
+func (t *Tape) Gemv(tA blas.Transpose, alpha float64, a, x *Var) *Var {
+	t.check(a, x)
+	tA = normTrans(tA)
+	if x.value.Cols != 1 {
+		panic(notVector)
+	}
+	m, n := a.value.Rows, a.value.Cols
+	lenX, lenY := n, m
+	if tA == blas.Trans {
+		lenX, lenY = m, n
+	}
+	if x.value.Rows != lenX {
+		panic(badShape)
+	}
+	y := general(lenY, 1)
+	if m > 0 && n > 0 {
+		blasImpl.Dgemv(tA, m, n, alpha, a.value.Data, a.value.Stride, x.value.Data, x.value.Stride, 0, y.Data, y.Stride)
+	}
+	var out *Var
+	out = t.record(y, func() {
+		if m == 0 || n == 0 {
+			return
+		}
+		gy := out.gradient()
+		ga := a.gradient()
+		gx := x.gradient()
+		av, xv := a.value, x.value
+		if tA == blas.NoTrans {
+			// Ā += alpha * ȳ * x^T
+			blasImpl.Dger(m, n, alpha, gy.Data, gy.Stride, xv.Data, xv.Stride, ga.Data, ga.Stride)
+		} else {
+			// Ā += alpha * x * ȳ^T
+			blasImpl.Dger(m, n, alpha, xv.Data, xv.Stride, gy.Data, gy.Stride, ga.Data, ga.Stride)
+		}
+		// x̄ += alpha * op(A)^T * ȳ
+		blasImpl.Dgemv(flip(tA), m, n, alpha, av.Data, av.Stride, gy.Data, gy.Stride, 1, gx.Data, gx.Stride)
+	})
+	return out
+}
+
+// Cholesky records the Cholesky factorization of the symmetric positive
+// definite matrix A,
+//  A = L * L^T,
+// and returns the lower triangular factor L. Only the lower triangle of A is
+// referenced, and the gradient with respect to A is symmetric. If A is not
+// positive definite, Cholesky returns false and nothing is recorded.
+func (t *Tape) Cholesky(a *Var) (l *Var, ok bool) {
+	t.check(a)
+	n := a.value.Rows
+	if a.value.Cols != n {
+		panic(badSquare)
+	}
+	lv := clone(a.value)
+	if !lapackImpl.Dpotrf(blas.Lower, n, lv.Data, lv.Stride) {
+		return nil, false
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			lv.Data[i*lv.Stride+j] = 0
+		}
+	}
+	l = t.record(lv, func() {
+		if n == 0 {
+			return
+		}
+		gl := l.gradient()
+		ga := a.gradient()
+
+		// Compute P = Φ(L^T * tril(L̄)), where Φ takes the lower
+		// triangle and halves the diagonal.
+		p := clone(gl)
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				p.Data[i*p.Stride+j] = 0
+			}
+		}
+		blasImpl.Dtrmm(blas.Left, blas.Lower, blas.Trans, blas.NonUnit, n, n, 1, lv.Data, lv.Stride, p.Data, p.Stride)
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				p.Data[i*p.Stride+j] = 0
+			}
+			p.Data[i*p.Stride+i] /= 2
+		}
+
+		// Compute S = L^-T * P * L^-1 and Ā += (S + S^T) / 2.
+		blasImpl.Dtrsm(blas.Left, blas.Lower, blas.Trans, blas.NonUnit, n, n, 1, lv.Data, lv.Stride, p.Data, p.Stride)
+		blasImpl.Dtrsm(blas.Right, blas.Lower, blas.NoTrans, blas.NonUnit, n, n, 1, lv.Data, lv.Stride, p.Data, p.Stride)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				ga.Data[i*ga.Stride+j] += (p.Data[i*p.Stride+j] + p.Data[j*p.Stride+i]) / 2
+			}
+		}
+	})
+	return l, true
+}
+
+// Solve records the solution of the system of linear equations
+//  A * X = B
+// computed from the LU factorization of the square matrix A. If A is
+// singular, Solve returns false and nothing is recorded.
+func (t *Tape) Solve(a, b *Var) (x *Var, ok bool) {
+	t.check(a, b)
+	n := a.value.Rows
+	if a.value.Cols != n {
+		panic(badSquare)
+	}
+	if b.value.Rows != n {
+		panic(badShape)
+	}
+	nrhs := b.value.Cols
+	lu := clone(a.value)
+	ipiv := make([]int, n)
+	if !lapackImpl.Dgetrf(n, n, lu.Data, lu.Stride, ipiv) {
+		return nil, false
+	}
+	xv := clone(b.value)
+	if n > 0 && nrhs > 0 {
+		lapackImpl.Dgetrs(blas.NoTrans, n, nrhs, lu.Data, lu.Stride, ipiv, xv.Data, xv.Stride)
+	}
+	x = t.record(xv, func() {
+		if n == 0 || nrhs == 0 {
+			return
+		}
+		gx := x.gradient()
+		ga := a.gradient()
+		gb := b.gradient()
+
+		// B̄ += A^-T * X̄
+		tmp := clone(gx)
+		lapackImpl.Dgetrs(blas.Trans, n, nrhs, lu.Data, lu.Stride, ipiv, tmp.Data, tmp.Stride)
+		for i := 0; i < n; i++ {
+			blasImpl.Daxpy(nrhs, 1, tmp.Data[i*tmp.Stride:], 1, gb.Data[i*gb.Stride:], 1)
+		}
+		// Ā -= B̄_x * X^T, where B̄_x = A^-T * X̄.
+		blasImpl.Dgemm(blas.NoTrans, blas.Trans, n, n, nrhs, -1, tmp.Data, tmp.Stride, xv.Data, xv.Stride, 1, ga.Data, ga.Stride)
+	})
+	return x, true
+}
+
+// SingularValues records the singular values of the m×n matrix A, returned
+// in decreasing order as a column vector of length min(m,n). The gradient is
+// only defined when the singular values are distinct. If the SVD does not
+// converge, SingularValues returns false and nothing is recorded.
+func (t *Tape) SingularValues(a *Var) (s *Var, ok bool) {
+	t.check(a)
+	m, n := a.value.Rows, a.value.Cols
+	k := min(m, n)
+	sv := general(k, 1)
+	u := general(m, k)
+	vt := general(k, n)
+	if k > 0 {
+		work := make([]float64, 1)
+		ac := clone(a.value)
+		lapackImpl.Dgesvd(lapack.SVDStore, lapack.SVDStore, m, n, ac.Data, ac.Stride, sv.Data, u.Data, u.Stride, vt.Data, vt.Stride, work, -1)
+		work = make([]float64, int(work[0]))
+		if !lapackImpl.Dgesvd(lapack.SVDStore, lapack.SVDStore, m, n, ac.Data, ac.Stride, sv.Data, u.Data, u.Stride, vt.Data, vt.Stride, work, len(work)) {
+			return nil, false
+		}
+	}
+	s = t.record(sv, func() {
+		gs := s.gradient()
+		ga := a.gradient()
+		// Ā += U * diag(s̄) * V^T
+		for i := 0; i < k; i++ {
+			blasImpl.Dger(m, n, gs.Data[i*gs.Stride], u.Data[i:], u.Stride, vt.Data[i*vt.Stride:], 1, ga.Data, ga.Stride)
+		}
+	})
+	return s, true
+}
+
+// dims returns the dimensions of op(a).
+func dims(t blas.Transpose, a blas64.General) (r, c int) {
+	if t == blas.NoTrans {
+		return a.Rows, a.Cols
+	}
+	return a.Cols, a.Rows
+}
+
+func normTrans(t blas.Transpose) blas.Transpose {
+	switch t {
+	case blas.NoTrans:
+		return blas.NoTrans
+	case blas.Trans, blas.ConjTrans:
+		return blas.Trans
+	}
+	panic(badTrans)
+}
+
+func flip(t blas.Transpose) blas.Transpose {
+	if t == blas.NoTrans {
+		return blas.Trans
+	}
+	return blas.NoTrans
+}
+
+// clone returns a copy of a with a compact stride.
+func clone(a blas64.General) blas64.General {
+	c := general(a.Rows, a.Cols)
+	for i := 0; i < a.Rows; i++ {
+		copy(c.Data[i*c.Stride:i*c.Stride+a.Cols], a.Data[i*a.Stride:i*a.Stride+a.Cols])
+	}
+	return c
+}
+
+// general returns a zeroed r×c matrix with a compact stride.
+func general(r, c int) blas64.General {
+	return blas64.General{
+		Rows:   r,
+		Cols:   c,
+		Stride: max(1, c),
+		Data:   make([]float64, r*c),
+	}
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
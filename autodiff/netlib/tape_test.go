@@ -0,0 +1,170 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+func randomGeneral(rnd *rand.Rand, r, c int) blas64.General {
+	a := general(r, c)
+	for i := range a.Data {
+		a.Data[i] = rnd.NormFloat64()
+	}
+	return a
+}
+
+// checkGradient compares the gradients computed by Backward for the loss
+// sum(seed .* f(inputs)) with central finite differences.
+func checkGradient(t *testing.T, name string, rnd *rand.Rand, inputs []blas64.General, f func(*Tape, []*Var) *Var) {
+	t.Helper()
+	const (
+		h   = 1e-6
+		tol = 1e-6
+	)
+	var tape Tape
+	vars := make([]*Var, len(inputs))
+	for i, in := range inputs {
+		vars[i] = tape.NewVar(in)
+	}
+	out := f(&tape, vars)
+	seed := randomGeneral(rnd, out.value.Rows, out.value.Cols)
+	tape.Backward(out, seed)
+
+	loss := func() float64 {
+		var tape Tape
+		vars := make([]*Var, len(inputs))
+		for i, in := range inputs {
+			vars[i] = tape.NewVar(in)
+		}
+		out := f(&tape, vars)
+		var sum float64
+		for i, v := range out.value.Data {
+			sum += seed.Data[i] * v
+		}
+		return sum
+	}
+	for k, in := range inputs {
+		grad := vars[k].Grad()
+		for i := range in.Data {
+			orig := in.Data[i]
+			in.Data[i] = orig + h
+			fp := loss()
+			in.Data[i] = orig - h
+			fm := loss()
+			in.Data[i] = orig
+			want := (fp - fm) / (2 * h)
+			if math.Abs(grad.Data[i]-want) > tol*math.Max(1, math.Abs(want)) {
+				t.Errorf("%s: input %d element %d: gradient mismatch: got %v want %v", name, k, i, grad.Data[i], want)
+			}
+		}
+	}
+}
+
+func TestGemm(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	const m, n, k = 3, 4, 5
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, tB := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			ar, ac := m, k
+			if tA == blas.Trans {
+				ar, ac = k, m
+			}
+			br, bc := k, n
+			if tB == blas.Trans {
+				br, bc = n, k
+			}
+			inputs := []blas64.General{randomGeneral(rnd, ar, ac), randomGeneral(rnd, br, bc)}
+			checkGradient(t, fmt.Sprintf("tA=%c,tB=%c", tA, tB), rnd, inputs, func(tape *Tape, v []*Var) *Var {
+				return tape.Gemm(tA, tB, 1.5, v[0], v[1])
+			})
+		}
+	}
+}
+
+func TestGemv(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	const m, n = 4, 3
+	for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		lenX := n
+		if tA == blas.Trans {
+			lenX = m
+		}
+		inputs := []blas64.General{randomGeneral(rnd, m, n), randomGeneral(rnd, lenX, 1)}
+		checkGradient(t, fmt.Sprintf("tA=%c", tA), rnd, inputs, func(tape *Tape, v []*Var) *Var {
+			return tape.Gemv(tA, -0.5, v[0], v[1])
+		})
+	}
+}
+
+func TestCholesky(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	const n = 4
+	// Differentiate L(X*X^T) so that the symmetric perturbations of the
+	// input are accounted for by Gemm.
+	inputs := []blas64.General{randomGeneral(rnd, n, n)}
+	checkGradient(t, "cholesky", rnd, inputs, func(tape *Tape, v []*Var) *Var {
+		a := tape.Gemm(blas.NoTrans, blas.Trans, 1, v[0], v[0])
+		l, ok := tape.Cholesky(a)
+		if !ok {
+			t.Fatal("matrix not positive definite")
+		}
+		return l
+	})
+}
+
+func TestSolve(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	const n, nrhs = 4, 2
+	a := randomGeneral(rnd, n, n)
+	for i := 0; i < n; i++ {
+		a.Data[i*n+i] += 5
+	}
+	inputs := []blas64.General{a, randomGeneral(rnd, n, nrhs)}
+	checkGradient(t, "solve", rnd, inputs, func(tape *Tape, v []*Var) *Var {
+		x, ok := tape.Solve(v[0], v[1])
+		if !ok {
+			t.Fatal("matrix singular")
+		}
+		return x
+	})
+}
+
+func TestSingularValues(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, dims := range [][2]int{{4, 3}, {3, 5}, {4, 4}} {
+		inputs := []blas64.General{randomGeneral(rnd, dims[0], dims[1])}
+		checkGradient(t, fmt.Sprintf("m=%d,n=%d", dims[0], dims[1]), rnd, inputs, func(tape *Tape, v []*Var) *Var {
+			s, ok := tape.SingularValues(v[0])
+			if !ok {
+				t.Fatal("SVD did not converge")
+			}
+			return s
+		})
+	}
+}
+
+func TestBackwardResets(t *testing.T) {
+	var tape Tape
+	a := tape.NewVar(blas64.General{Rows: 1, Cols: 1, Stride: 1, Data: []float64{2}})
+	b := tape.NewVar(blas64.General{Rows: 1, Cols: 1, Stride: 1, Data: []float64{3}})
+	c := tape.Gemm(blas.NoTrans, blas.NoTrans, 1, a, b)
+	seed := blas64.General{Rows: 1, Cols: 1, Stride: 1, Data: []float64{1}}
+	tape.Backward(c, seed)
+	tape.Backward(c, seed)
+	if got := a.Grad().Data[0]; got != 3 {
+		t.Errorf("unexpected gradient after repeated Backward: got %v want 3", got)
+	}
+	if got := c.Grad().Data[0]; got != 1 {
+		t.Errorf("unexpected output gradient: got %v want 1", got)
+	}
+}
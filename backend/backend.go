@@ -0,0 +1,75 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:generate go run generate_backends.go
+
+// Package backend records the backend library selected by importing one of
+// the backend packages
+//  gonum.org/v1/netlib/openblas
+//  gonum.org/v1/netlib/mkl
+//  gonum.org/v1/netlib/reference
+// for their side effects. Each backend package adds the linker flags of its
+// library to the build, so that the choice of backend is made by an import
+// rather than by setting CGO_LDFLAGS or regenerating the bindings:
+//  import (
+//  	"gonum.org/v1/netlib/blas/netlib"
+//  	_ "gonum.org/v1/netlib/openblas"
+//  )
+// Programs that set CGO_LDFLAGS themselves need not import a backend
+// package, in which case no backend is recorded.
+package backend // import "gonum.org/v1/netlib/backend"
+
+import "sync"
+
+// Info describes a backend library.
+type Info struct {
+	// Name is the name of the backend package.
+	Name string
+
+	// LDFlags are the linker flags added by the backend package.
+	LDFlags []string
+
+	// Static is whether the backend package was built with the static
+	// build tag to link the program from static archives.
+	Static bool
+}
+
+var (
+	mu       sync.Mutex
+	selected *Info
+)
+
+// Register records info as the selected backend. It is called by the
+// backend packages during initialization. Register panics if a different
+// backend has already been registered, since linking a program against two
+// BLAS implementations leaves the choice of routines to the linker.
+//
+// If info.Static is true, Register also panics if the running executable
+// loads shared libraries, since then a shared library has been linked in
+// place of one of the static archives of the backend.
+func Register(info Info) {
+	mu.Lock()
+	defer mu.Unlock()
+	if selected != nil && selected.Name != info.Name {
+		panic("netlib: multiple backends imported: " + selected.Name + " and " + info.Name)
+	}
+	if info.Static {
+		err := checkStatic()
+		if err != nil {
+			panic("netlib: " + info.Name + " built with the static tag: " + err.Error())
+		}
+	}
+	selected = &info
+}
+
+// Selected returns the backend registered by an imported backend package
+// and whether there is one.
+func Selected() (Info, bool) {
+	mu.Lock()
+	defer mu.Unlock()
+	if selected == nil {
+		return Info{}, false
+	}
+	return *selected, true
+}
@@ -0,0 +1,32 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package backend
+
+import "testing"
+
+func TestRegister(t *testing.T) {
+	if _, ok := Selected(); ok {
+		t.Fatal("unexpected backend before registration")
+	}
+	info := Info{Name: "openblas", LDFlags: []string{"-lopenblas"}}
+	Register(info)
+	Register(info)
+	got, ok := Selected()
+	if !ok || got.Name != info.Name {
+		t.Errorf("unexpected selected backend: %+v, %t", got, ok)
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected panic for second backend")
+			}
+		}()
+		Register(Info{Name: "mkl"})
+	}()
+	if got, _ := Selected(); got.Name != info.Name {
+		t.Errorf("selected backend changed to %q", got.Name)
+	}
+}
@@ -0,0 +1,195 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+// generate_backends creates the backend packages that add the linker flags
+// of a BLAS and LAPACKE implementation to a build.
+package main
+
+import (
+	"bytes"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// backends lists the generated packages, relative to the module root.
+var backends = []struct {
+	Name string
+	Lib  string
+	Doc  string
+}{
+	{
+		Name: "openblas",
+		Lib:  "OpenBLAS",
+		Doc: `OpenBLAS must be built with its CBLAS and LAPACKE interfaces, which
+// is the default.`,
+	},
+	{
+		Name: "mkl",
+		Lib:  "Intel MKL",
+		Doc: `The single dynamic library interface of MKL is used, with the LP64
+// integer model.`,
+	},
+	{
+		Name: "reference",
+		Lib:  "the Netlib reference implementations",
+		Doc: `The reference BLAS, CBLAS, LAPACK and LAPACKE libraries must all be
+// installed.`,
+	},
+}
+
+// ldflags holds the linker flags of each backend.
+var ldflags = map[string][]string{
+	"openblas":  {"-lopenblas"},
+	"mkl":       {"-lmkl_rt"},
+	"reference": {"-llapacke", "-llapack", "-lcblas", "-lblas", "-lgfortran", "-lm"},
+}
+
+// staticLDFlags holds the linker flags of the backends that can be linked
+// from static archives when building with the static build tag. The Fortran
+// runtime of the archives is linked explicitly, and libquadmath, which
+// libgfortran needs on the architectures that have it, is added for those
+// architectures by quadmathArch. The flags are intended for musl based
+// systems such as Alpine Linux, where the C library is fully static; with
+// glibc the static link works but functions such as dlopen are unavailable.
+// MKL is only supported as a shared library.
+var staticLDFlags = map[string][]string{
+	"openblas":  {"-static", "-lopenblas", "-lgfortran", "-lm", "-lpthread"},
+	"reference": {"-static", "-llapacke", "-llapack", "-lcblas", "-lblas", "-lgfortran", "-lm"},
+}
+
+// quadmathArch lists the GOARCH values for which GCC provides libquadmath.
+var quadmathArch = []string{"386", "amd64", "ppc64le"}
+
+func main() {
+	for _, b := range backends {
+		data := struct {
+			Name, Lib, Doc         string
+			LDFlags, StaticLDFlags []string
+			QuadmathArch           []string
+		}{
+			Name:          b.Name,
+			Lib:           b.Lib,
+			Doc:           b.Doc,
+			LDFlags:       ldflags[b.Name],
+			StaticLDFlags: staticLDFlags[b.Name],
+			QuadmathArch:  quadmathArch,
+		}
+		dir := filepath.Join("..", b.Name)
+		err := os.MkdirAll(dir, 0755)
+		if err != nil {
+			log.Fatal(err)
+		}
+		write(filepath.Join(dir, b.Name+".go"), pkg, data)
+		if data.StaticLDFlags != nil {
+			write(filepath.Join(dir, b.Name+"_static.go"), static, data)
+		}
+	}
+}
+
+// write executes tmpl with data and writes the formatted result to path.
+func write(path string, tmpl *template.Template, data interface{}) {
+	var buf bytes.Buffer
+	err := tmpl.Execute(&buf, data)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("%s: %v", path, err)
+	}
+	err = ioutil.WriteFile(path, src, 0664)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+var funcs = template.FuncMap{
+	"join":  func(s []string) string { return strings.Join(s, " ") },
+	"quote": func(s []string) string { return `"` + strings.Join(s, `", "`) + `"` },
+}
+
+var pkg = template.Must(template.New("pkg").Funcs(funcs).Parse(`// Code generated by "go generate gonum.org/v1/netlib/backend"; DO NOT EDIT.
+
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+{{if .StaticLDFlags}}
+//go:build !static
+// +build !static
+{{end}}
+// Package {{.Name}} links the netlib packages against {{.Lib}}.
+//
+// The package is imported for its side effects:
+//  import _ "gonum.org/v1/netlib/{{.Name}}"
+// It adds the linker flags
+//  {{join .LDFlags}}
+// to the build and registers itself with the backend package. A program
+// must import at most one backend package.
+//
+// {{.Doc}}{{if .StaticLDFlags}}
+//
+// Built with the static build tag, the package instead adds the linker
+// flags
+//  {{join .StaticLDFlags}}
+// so that the program is linked into a single self-contained executable
+// from the static archives of the libraries and of the C library, such as
+// musl on Alpine Linux:
+//  go build -tags static
+// The backend then checks during initialization that the executable does
+// not load any shared library, which happens when a shared library is
+// found in place of one of the archives, and panics if it does. The
+// static build tag cannot be combined with the dlopen build tag.{{else}}
+//
+// The package does not support the static build tag.{{end}}
+package {{.Name}} // import "gonum.org/v1/netlib/{{.Name}}"
+
+/*
+#cgo LDFLAGS: {{join .LDFlags}}
+*/
+import "C"
+
+import "gonum.org/v1/netlib/backend"
+
+func init() {
+	backend.Register(backend.Info{
+		Name:    "{{.Name}}",
+		LDFlags: []string{ {{quote .LDFlags}} },
+	})
+}
+`))
+
+var static = template.Must(template.New("static").Funcs(funcs).Parse(`// Code generated by "go generate gonum.org/v1/netlib/backend"; DO NOT EDIT.
+
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build static
+// +build static
+
+package {{.Name}}
+
+/*
+#cgo LDFLAGS: {{join .StaticLDFlags}}
+#cgo {{join .QuadmathArch}} LDFLAGS: -lquadmath
+*/
+import "C"
+
+import "gonum.org/v1/netlib/backend"
+
+func init() {
+	backend.Register(backend.Info{
+		Name:    "{{.Name}}",
+		LDFlags: []string{ {{quote .StaticLDFlags}} },
+		Static:  true,
+	})
+}
+`))
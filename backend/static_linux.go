@@ -0,0 +1,40 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package backend
+
+import (
+	"debug/elf"
+	"errors"
+	"os"
+	"strings"
+)
+
+// checkStatic returns an error if the running executable is dynamically
+// linked. It returns nil if the executable cannot be read.
+func checkStatic() error {
+	path, err := os.Executable()
+	if err != nil {
+		return nil
+	}
+	return checkExecutable(path)
+}
+
+// checkExecutable returns an error if the ELF executable at path loads
+// shared libraries.
+func checkExecutable(path string) error {
+	f, err := elf.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+	libs, err := f.ImportedLibraries()
+	if err != nil {
+		return nil
+	}
+	if len(libs) != 0 {
+		return errors.New("executable loads shared libraries " + strings.Join(libs, ", ") + "; link with static archives only")
+	}
+	return nil
+}
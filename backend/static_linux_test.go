@@ -0,0 +1,51 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package backend
+
+import (
+	"debug/elf"
+	"os"
+	"os/exec"
+	"testing"
+)
+
+func TestCheckExecutable(t *testing.T) {
+	// The test binary of this package does not use cgo, so unless the
+	// race detector or external linking is in use it is static.
+	exe, err := os.Executable()
+	if err != nil {
+		t.Skipf("no executable path: %v", err)
+	}
+	want := imports(t, exe) != 0
+	if got := checkExecutable(exe) != nil; got != want {
+		t.Errorf("unexpected result for test binary: got error %t, want %t", got, want)
+	}
+
+	sh, err := exec.LookPath("sh")
+	if err != nil {
+		t.Skip("no shell to check")
+	}
+	if imports(t, sh) == 0 {
+		t.Skip("shell is statically linked")
+	}
+	if checkExecutable(sh) == nil {
+		t.Errorf("no error for dynamically linked %s", sh)
+	}
+}
+
+// imports returns the number of shared libraries loaded by the ELF
+// executable at path.
+func imports(t *testing.T, path string) int {
+	f, err := elf.Open(path)
+	if err != nil {
+		t.Skipf("cannot read %s: %v", path, err)
+	}
+	defer f.Close()
+	libs, err := f.ImportedLibraries()
+	if err != nil {
+		t.Skipf("cannot read %s: %v", path, err)
+	}
+	return len(libs)
+}
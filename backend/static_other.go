@@ -0,0 +1,11 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !linux
+// +build !linux
+
+package backend
+
+// checkStatic returns nil, since static linking is only checked on Linux.
+func checkStatic() error { return nil }
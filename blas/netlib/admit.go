@@ -0,0 +1,37 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import "gonum.org/v1/netlib/admission"
+
+// admit admits a call performing flops floating point operations with the
+// process-wide admission controller and returns the function that ends the
+// call. If no controller is installed, admit returns immediately. If the
+// controller rejects the call, admit panics with the admission error.
+//
+// The Level 3 routines of Implementation call admit after their parameters
+// have been checked.
+func admit(flops float64) func() {
+	c := admission.Default()
+	if c == nil {
+		return nop
+	}
+	release, err := c.Acquire(flops)
+	if err != nil {
+		panic(err)
+	}
+	return release
+}
+
+func nop() {}
+
+// sideFlops returns the number of multiplications of a triangular or
+// symmetric m×m or n×n matrix on the left or right of an m×n matrix.
+func sideFlops(left bool, m, n int) float64 {
+	if left {
+		return float64(m) * float64(m) * float64(n)
+	}
+	return float64(m) * float64(n) * float64(n)
+}
@@ -0,0 +1,66 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import "strings"
+
+// Error is the panic value of the Implementation methods generated from the
+// C header when an argument check fails, and the error returned by the
+// methods of ErrImplementation. Callers that recover the panic can use
+// Routine and Param to identify the failing call.
+type Error struct {
+	// Routine is the name of the method, for example "Dgemm".
+	Routine string
+
+	// Param is the name of the method parameter that failed the
+	// check, for example "lda". Param is empty if the check is not
+	// specific to one parameter. For an invalid argument detected by
+	// the library itself, Routine is the library routine name, for
+	// example "DGEMM", and Param is the 1-based parameter position
+	// reported to its error handler.
+	Param string
+
+	// Message is the description of the failure, for example
+	// "blas: bad leading dimension of A".
+	Message string
+}
+
+func (e Error) Error() string {
+	if e.Param == "" {
+		return e.Message + " in " + e.Routine
+	}
+	return e.Message + " in " + e.Routine + " (" + e.Param + ")"
+}
+
+// ErrImplementation is the cgo-based C implementation of BLAS routines
+// with methods that return an error instead of panicking when the input
+// arguments are invalid. Its methods are generated from those of
+// Implementation, which remains the type to use with gonum.org/v1/gonum/mat
+// and other consumers of the blas interfaces.
+//
+// The returned error is an Error. Panics that are not caused by an
+// argument check, such as a nil method value, are not recovered.
+type ErrImplementation struct{}
+
+// catch recovers an argument check panic of routine and stores it in err.
+// The panics of the methods that are not generated from the C header hold
+// only the message, which is converted to an Error for routine. Other
+// panics are propagated.
+func catch(routine string, err *error) {
+	r := recover()
+	switch r := r.(type) {
+	case nil:
+		return
+	case Error:
+		*err = r
+		return
+	case string:
+		if strings.HasPrefix(r, "blas: ") {
+			*err = Error{Routine: routine, Message: r}
+			return
+		}
+	}
+	panic(r)
+}
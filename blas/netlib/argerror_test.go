@@ -0,0 +1,101 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func TestErrImplementation(t *testing.T) {
+	var e ErrImplementation
+
+	a := []float64{1, 2, 3, 4}
+	b := []float64{5, 6, 7, 8}
+	c := make([]float64, 4)
+	err := e.Dgemm(blas.NoTrans, blas.NoTrans, 2, 2, 2, 1, a, 2, b, 2, 0, c, 2)
+	if err != nil {
+		t.Fatalf("unexpected error for valid arguments: %v", err)
+	}
+	want := make([]float64, 4)
+	impl.Dgemm(blas.NoTrans, blas.NoTrans, 2, 2, 2, 1, a, 2, b, 2, 0, want, 2)
+	for i := range want {
+		if c[i] != want[i] {
+			t.Fatalf("unexpected result: got %v want %v", c, want)
+		}
+	}
+
+	dot, err := e.Ddot(4, a, 1, b, 1)
+	if err != nil || dot != 70 {
+		t.Errorf("unexpected dot product: got %v, %v want 70, <nil>", dot, err)
+	}
+
+	for _, test := range []struct {
+		routine string
+		param   string
+		call    func() error
+		want    string
+	}{
+		{
+			routine: "Dgemm",
+			param:   "lda",
+			call: func() error {
+				return e.Dgemm(blas.NoTrans, blas.NoTrans, 2, 2, 2, 1, a, 1, b, 2, 0, c, 2)
+			},
+			want: badLdA,
+		},
+		{
+			routine: "Dgemm",
+			param:   "tA",
+			call: func() error {
+				return e.Dgemm('X', blas.NoTrans, 2, 2, 2, 1, a, 2, b, 2, 0, c, 2)
+			},
+			want: badTranspose,
+		},
+		{
+			routine: "Ddot",
+			param:   "incX",
+			call: func() error {
+				_, err := e.Ddot(4, a, 0, b, 1)
+				return err
+			},
+			want: zeroIncX,
+		},
+		{
+			routine: "MaskedDdot",
+			call: func() error {
+				_, err := e.MaskedDdot([]bool{true}, a, b)
+				return err
+			},
+			want: badLenX,
+		},
+		{
+			routine: "Dgemv",
+			param:   "x",
+			call: func() error {
+				return e.Dgemv(blas.NoTrans, 2, 2, 1, a, 2, b[:1], 1, 0, c, 1)
+			},
+			want: shortX,
+		},
+	} {
+		err := test.call()
+		want := Error{Routine: test.routine, Param: test.param, Message: test.want}
+		if err != want {
+			t.Errorf("unexpected error: got %#v want %#v", err, want)
+		}
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r != "other" {
+				t.Errorf("unexpected recovered value: %v", r)
+			}
+		}()
+		var err error
+		defer catch("Dgemm", &err)
+		panic("other")
+	}()
+}
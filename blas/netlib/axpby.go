@@ -0,0 +1,180 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+/*
+#cgo dlopen CFLAGS: -DNETLIB_DLOPEN=1
+#include "cblas.h"
+#include "extension.h"
+
+// The ?axpby routines are extensions provided by OpenBLAS and Intel MKL.
+// As for ?gemmt they are declared weak where possible or looked up in the
+// library loaded with the dlopen build tag, and the update is composed of
+// ?scal and ?axpy in C if the library does not provide them.
+#if defined(NETLIB_DLOPEN)
+#define NETLIB_AXPBY 1
+#define NETLIB_WEAK
+#elif defined(__ELF__) && !defined(NETLIB_NO_AXPBY)
+#define NETLIB_AXPBY 1
+#define NETLIB_WEAK __attribute__((weak))
+#else
+#define NETLIB_AXPBY 0
+#define NETLIB_WEAK
+#endif
+
+#define NETLIB_DECLARE_AXPBY(name, S, P) \
+	NETLIB_WEAK void name(const blasint n, const S alpha, const P *x, const blasint incx, \
+		const S beta, P *y, const blasint incy);
+
+NETLIB_DECLARE_AXPBY(cblas_saxpby, float, float)
+NETLIB_DECLARE_AXPBY(cblas_daxpby, double, double)
+NETLIB_DECLARE_AXPBY(cblas_caxpby, void *, void)
+NETLIB_DECLARE_AXPBY(cblas_zaxpby, void *, void)
+
+#if NETLIB_AXPBY
+#define NETLIB_CALL_AXPBY(axpby) \
+	NETLIB_EXTENSION(fn, axpby) \
+	if (fn != NULL) { \
+		fn(n, alpha, x, incx, beta, y, incy); \
+		return; \
+	}
+#else
+#define NETLIB_CALL_AXPBY(axpby)
+#endif
+
+// NETLIB_DEFINE_AXPBY defines netlib_?axpby, which computes
+// y = alpha * x + beta * y by a single call into the library where
+// possible, and by ?scal followed by ?axpy otherwise.
+#define NETLIB_DEFINE_AXPBY(name, axpby, scal, axpy, S, P) \
+	static void name(const blasint n, const S alpha, const P *x, const blasint incx, \
+		const S beta, P *y, const blasint incy) \
+	{ \
+		NETLIB_CALL_AXPBY(axpby) \
+		scal(n, beta, y, incy < 0 ? -incy : incy); \
+		axpy(n, alpha, x, incx, y, incy); \
+	}
+
+NETLIB_DEFINE_AXPBY(netlib_saxpby, cblas_saxpby, cblas_sscal, cblas_saxpy, float, float)
+NETLIB_DEFINE_AXPBY(netlib_daxpby, cblas_daxpby, cblas_dscal, cblas_daxpy, double, double)
+NETLIB_DEFINE_AXPBY(netlib_caxpby, cblas_caxpby, cblas_cscal, cblas_caxpy, void *, void)
+NETLIB_DEFINE_AXPBY(netlib_zaxpby, cblas_zaxpby, cblas_zscal, cblas_zaxpy, void *, void)
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"gonum.org/v1/netlib/diag"
+)
+
+// The ?axpby routines compute
+//  y = alpha * x + beta * y
+// in a single pass over y, which is the common update of the iterative
+// solvers. They call the cblas_?axpby extension of OpenBLAS or Intel MKL,
+// as reported by CapAxpby. If the library does not provide it, y is scaled
+// by ?scal and updated by ?axpy on the C side, so that the result is the
+// same up to rounding but y is traversed twice.
+
+// Daxpby computes
+//  y = alpha * x + beta * y
+// where x and y are vectors of n elements with increments incX and incY.
+func (Implementation) Daxpby(n int, alpha float64, x []float64, incX int, beta float64, y []float64, incY int) {
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Daxpby", n, alpha, incX, beta, incY)()
+	}
+	checkAxpby("Daxpby", n, incX, incY, len(x), len(y))
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	C.netlib_daxpby(C.blasint(n), C.double(alpha), (*C.double)(&x[0]), C.blasint(incX), C.double(beta), (*C.double)(&y[0]), C.blasint(incY))
+}
+
+// Saxpby computes
+//  y = alpha * x + beta * y
+// where x and y are vectors of n elements with increments incX and incY.
+func (Implementation) Saxpby(n int, alpha float32, x []float32, incX int, beta float32, y []float32, incY int) {
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Saxpby", n, alpha, incX, beta, incY)()
+	}
+	checkAxpby("Saxpby", n, incX, incY, len(x), len(y))
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	C.netlib_saxpby(C.blasint(n), C.float(alpha), (*C.float)(&x[0]), C.blasint(incX), C.float(beta), (*C.float)(&y[0]), C.blasint(incY))
+}
+
+// Zaxpby computes
+//  y = alpha * x + beta * y
+// where x and y are complex vectors of n elements with increments incX and
+// incY.
+func (Implementation) Zaxpby(n int, alpha complex128, x []complex128, incX int, beta complex128, y []complex128, incY int) {
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zaxpby", n, alpha, incX, beta, incY)()
+	}
+	checkAxpby("Zaxpby", n, incX, incY, len(x), len(y))
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	C.netlib_zaxpby(C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(&x[0]), C.blasint(incX), unsafe.Pointer(&beta), unsafe.Pointer(&y[0]), C.blasint(incY))
+}
+
+// Caxpby computes
+//  y = alpha * x + beta * y
+// where x and y are complex vectors of n elements with increments incX and
+// incY.
+func (Implementation) Caxpby(n int, alpha complex64, x []complex64, incX int, beta complex64, y []complex64, incY int) {
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Caxpby", n, alpha, incX, beta, incY)()
+	}
+	checkAxpby("Caxpby", n, incX, incY, len(x), len(y))
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	C.netlib_caxpby(C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(&x[0]), C.blasint(incX), unsafe.Pointer(&beta), unsafe.Pointer(&y[0]), C.blasint(incY))
+}
+
+// checkAxpby panics as the ?axpby routine name does if its arguments are
+// invalid. The lengths of the slices are lx and ly.
+func checkAxpby(name string, n, incX, incY, lx, ly int) {
+	if n < 0 {
+		panic(Error{Routine: name, Param: "n", Message: nLT0})
+	}
+	if incX == 0 {
+		panic(Error{Routine: name, Param: "incX", Message: zeroIncX})
+	}
+	if incY == 0 {
+		panic(Error{Routine: name, Param: "incY", Message: zeroIncY})
+	}
+	if n == 0 {
+		return
+	}
+	if (incX > 0 && lx <= (n-1)*incX) || (incX < 0 && lx <= (1-n)*incX) {
+		panic(Error{Routine: name, Param: "x", Message: shortX})
+	}
+	if (incY > 0 && ly <= (n-1)*incY) || (incY < 0 && ly <= (1-n)*incY) {
+		panic(Error{Routine: name, Param: "y", Message: shortY})
+	}
+	if n > maxInt {
+		panic(Error{Routine: name, Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: name, Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: name, Param: "incY", Message: incYTooLarge})
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDaxpby(t *testing.T) {
+	const tol = 1e-14
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 4, 9} {
+		for _, inc := range [][2]int{{1, 1}, {2, 3}, {-1, 2}, {3, -2}, {-2, -1}} {
+			for _, ab := range [][2]float64{{1, 1}, {0.5, -2}, {0, 3}, {-1, 0}} {
+				incX, incY := inc[0], inc[1]
+				alpha, beta := ab[0], ab[1]
+				name := fmt.Sprintf("n=%d,incX=%d,incY=%d,alpha=%v,beta=%v", n, incX, incY, alpha, beta)
+				x := randomFloats(rnd, 1+max(0, n-1)*abs(incX))
+				y := randomFloats(rnd, 1+max(0, n-1)*abs(incY))
+				want := append([]float64(nil), y...)
+				for i := 0; i < n; i++ {
+					ix, iy := i*incX, i*incY
+					if incX < 0 {
+						ix = (i - n + 1) * incX
+					}
+					if incY < 0 {
+						iy = (i - n + 1) * incY
+					}
+					want[iy] = alpha*x[ix] + beta*want[iy]
+				}
+				impl.Daxpby(n, alpha, x, incX, beta, y, incY)
+				if !floats.EqualApprox(y, want, tol) {
+					t.Errorf("%s: unexpected result: got %v want %v", name, y, want)
+				}
+			}
+		}
+	}
+}
+
+func TestZaxpby(t *testing.T) {
+	const tol = 1e-14
+	rnd := rand.New(rand.NewSource(1))
+	const n, incX, incY = 5, 2, -3
+	x := make([]complex128, 1+(n-1)*incX)
+	y := make([]complex128, 1+(n-1)*-incY)
+	for _, s := range [][]complex128{x, y} {
+		for i := range s {
+			s[i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+		}
+	}
+	alpha, beta := complex(0.5, -1), complex(2, 0.25)
+	want := append([]complex128(nil), y...)
+	for i := 0; i < n; i++ {
+		iy := (i - n + 1) * incY
+		want[iy] = alpha*x[i*incX] + beta*want[iy]
+	}
+	impl.Zaxpby(n, alpha, x, incX, beta, y, incY)
+	for i := range y {
+		if cmplx.Abs(y[i]-want[i]) > tol {
+			t.Errorf("unexpected y[%d]: got %v want %v", i, y[i], want[i])
+		}
+	}
+}
+
+func TestAxpbyPanics(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		fn   func()
+		want Error
+	}{
+		{
+			name: "Daxpby",
+			fn:   func() { impl.Daxpby(3, 1, make([]float64, 3), 0, 1, make([]float64, 3), 1) },
+			want: Error{Routine: "Daxpby", Param: "incX", Message: zeroIncX},
+		},
+		{
+			name: "Saxpby",
+			fn:   func() { impl.Saxpby(3, 1, make([]float32, 3), 1, 1, make([]float32, 4), 2) },
+			want: Error{Routine: "Saxpby", Param: "y", Message: shortY},
+		},
+		{
+			name: "Caxpby",
+			fn:   func() { impl.Caxpby(-1, 1, nil, 1, 1, nil, 1) },
+			want: Error{Routine: "Caxpby", Param: "n", Message: nLT0},
+		},
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r != test.want {
+					t.Errorf("%s: unexpected panic: got %#v want %#v", test.name, r, test.want)
+				}
+			}()
+			test.fn()
+		}()
+	}
+}
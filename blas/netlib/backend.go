@@ -0,0 +1,199 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+	"sync"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// BackendInfo describes the CBLAS library the package calls into.
+type BackendInfo struct {
+	// Library is the path of the library loaded with the dlopen build
+	// tag, as returned by Library, and is empty otherwise.
+	Library string
+
+	// CoreName is the name of the kernels selected by OpenBLAS for the
+	// processor, or empty if the library does not report it.
+	CoreName string
+
+	// Threads is the number of threads used by the library, as returned
+	// by NumThreads.
+	Threads int
+
+	// Caps holds the capabilities of the library, as returned by
+	// Capabilities.
+	Caps Caps
+
+	// IEEE holds the results of the probe of the IEEE 754 semantics of
+	// the library.
+	IEEE IEEEReport
+}
+
+// IEEEReport holds the results of a probe of the IEEE 754 semantics of the
+// BLAS routines. Libraries built with fast-math compiler options may flush
+// subnormal numbers to zero, lose the sign of zero or fail to propagate
+// NaN, and some optimized libraries short-circuit multiplications by zero.
+type IEEEReport struct {
+	// SignedZero reports whether the sign of zero results is kept.
+	SignedZero bool
+
+	// NaN reports whether NaN operands and invalid operations such as
+	// 0*Inf produce NaN.
+	NaN bool
+
+	// Rounding reports whether elementary operations are rounded to
+	// nearest with ties to even.
+	Rounding bool
+
+	// Subnormal reports whether subnormal operands and results are kept
+	// instead of being flushed to zero.
+	Subnormal bool
+
+	// Failed holds a description of each check that failed.
+	Failed []string
+}
+
+// Strict returns whether all the checks of the probe passed.
+func (r IEEEReport) Strict() bool {
+	return len(r.Failed) == 0
+}
+
+var (
+	ieeeOnce   sync.Once
+	ieeeReport IEEEReport
+)
+
+// Backend returns the description of the library. The IEEE semantics are
+// probed by the first call to Backend and the result is reused by later
+// calls. Programs that require strict IEEE semantics can reject a library
+// for which Backend().IEEE.Strict() is false.
+func Backend() BackendInfo {
+	ieeeOnce.Do(func() {
+		ieeeReport = ProbeIEEE()
+	})
+	r := ieeeReport
+	r.Failed = append([]string(nil), r.Failed...)
+	return BackendInfo{
+		Library:  Library(),
+		CoreName: coreName(),
+		Threads:  NumThreads(),
+		Caps:     Capabilities(),
+		IEEE:     r,
+	}
+}
+
+// ieeeCheck is a check of the IEEE semantics of a routine.
+type ieeeCheck struct {
+	name string
+	ok   func(impl Implementation) bool
+}
+
+// ProbeIEEE calls a set of BLAS routines with operands whose results are
+// exactly determined by IEEE 754 arithmetic and reports the checks that
+// fail. The checks only use calls whose result does not depend on the order
+// of summation, so a conforming library passes them regardless of its
+// blocking and vectorization.
+func ProbeIEEE() IEEEReport {
+	var impl Implementation
+	r := IEEEReport{SignedZero: true, NaN: true, Rounding: true, Subnormal: true}
+	for _, c := range []struct {
+		flag   *bool
+		checks []ieeeCheck
+	}{
+		{&r.SignedZero, signedZeroChecks},
+		{&r.NaN, nanChecks},
+		{&r.Rounding, roundingChecks},
+		{&r.Subnormal, subnormalChecks},
+	} {
+		for _, check := range c.checks {
+			if !check.ok(impl) {
+				*c.flag = false
+				r.Failed = append(r.Failed, check.name)
+			}
+		}
+	}
+	return r
+}
+
+var signedZeroChecks = []ieeeCheck{
+	{"Dscal: -1 * +0 = -0", func(impl Implementation) bool {
+		x := []float64{0}
+		impl.Dscal(1, -1, x, 1)
+		return x[0] == 0 && math.Signbit(x[0])
+	}},
+	{"Daxpy: -0 + -0 = -0", func(impl Implementation) bool {
+		y := []float64{math.Copysign(0, -1)}
+		impl.Daxpy(1, 1, []float64{math.Copysign(0, -1)}, 1, y, 1)
+		return y[0] == 0 && math.Signbit(y[0])
+	}},
+	{"Dcopy: -0 is copied", func(impl Implementation) bool {
+		y := []float64{1}
+		impl.Dcopy(1, []float64{math.Copysign(0, -1)}, 1, y, 1)
+		return y[0] == 0 && math.Signbit(y[0])
+	}},
+}
+
+var nanChecks = []ieeeCheck{
+	{"Dscal: 0 * NaN = NaN", func(impl Implementation) bool {
+		x := []float64{math.NaN()}
+		impl.Dscal(1, 0, x, 1)
+		return math.IsNaN(x[0])
+	}},
+	{"Ddot: NaN operand", func(impl Implementation) bool {
+		return math.IsNaN(impl.Ddot(3, []float64{1, math.NaN(), 1}, 1, []float64{1, 1, 1}, 1))
+	}},
+	{"Ddot: 0 * Inf = NaN", func(impl Implementation) bool {
+		return math.IsNaN(impl.Ddot(2, []float64{0, 1}, 1, []float64{math.Inf(1), 1}, 1))
+	}},
+	{"Dnrm2: NaN operand", func(impl Implementation) bool {
+		return math.IsNaN(impl.Dnrm2(3, []float64{1, math.NaN(), 1}, 1))
+	}},
+	{"Dgemm: NaN operand", func(impl Implementation) bool {
+		a := []float64{
+			1, math.NaN(),
+			1, 1,
+		}
+		b := []float64{
+			1, 1,
+			1, 1,
+		}
+		c := make([]float64, 4)
+		impl.Dgemm(blas.NoTrans, blas.NoTrans, 2, 2, 2, 1, a, 2, b, 2, 0, c, 2)
+		return math.IsNaN(c[0]) && math.IsNaN(c[1]) && c[2] == 2 && c[3] == 2
+	}},
+}
+
+var roundingChecks = []ieeeCheck{
+	{"Daxpy: 1 + 2^-53 rounds to even", func(impl Implementation) bool {
+		y := []float64{1}
+		impl.Daxpy(1, 1, []float64{0x1p-53}, 1, y, 1)
+		return y[0] == 1
+	}},
+	{"Daxpy: 1 + 3*2^-53 rounds to even", func(impl Implementation) bool {
+		y := []float64{1}
+		impl.Daxpy(1, 1, []float64{0x3p-53}, 1, y, 1)
+		return y[0] == 1+0x1p-51
+	}},
+	{"Ddot: (1+2^-52)^2 is rounded to nearest", func(impl Implementation) bool {
+		x := []float64{1 + 0x1p-52}
+		return impl.Ddot(1, x, 1, x, 1) == 1+0x1p-51
+	}},
+}
+
+var subnormalChecks = []ieeeCheck{
+	{"Dscal: subnormal result", func(impl Implementation) bool {
+		x := []float64{0x1p-1020}
+		impl.Dscal(1, 0x1p-40, x, 1)
+		return x[0] == 0x1p-1060
+	}},
+	{"Daxpy: subnormal operand", func(impl Implementation) bool {
+		y := []float64{0}
+		impl.Daxpy(1, 0x1p60, []float64{math.SmallestNonzeroFloat64}, 1, y, 1)
+		return y[0] == 0x1p-1014
+	}},
+}
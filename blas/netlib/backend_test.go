@@ -0,0 +1,48 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"flag"
+	"testing"
+)
+
+var strictIEEE = flag.Bool("ieee.strict", false, "fail unless the linked library honors IEEE 754 semantics")
+
+// TestIEEE reports the checks of ProbeIEEE that the linked library fails.
+// Libraries are not required to pass them, so the failures are only logged
+// unless the test is run with
+//
+//	go test -run IEEE -ieee.strict
+func TestIEEE(t *testing.T) {
+	r := ProbeIEEE()
+	report := t.Logf
+	if *strictIEEE {
+		report = t.Errorf
+	}
+	for _, f := range r.Failed {
+		report("library does not honor IEEE semantics: %s", f)
+	}
+	if r.Strict() != (r.SignedZero && r.NaN && r.Rounding && r.Subnormal) {
+		t.Errorf("inconsistent report: %+v", r)
+	}
+}
+
+func TestBackend(t *testing.T) {
+	b := Backend()
+	r := ProbeIEEE()
+	if b.IEEE.Strict() != r.Strict() || len(b.IEEE.Failed) != len(r.Failed) {
+		t.Errorf("cached report %+v differs from probe %+v", b.IEEE, r)
+	}
+	if len(b.IEEE.Failed) > 0 {
+		b.IEEE.Failed[0] = ""
+		if Backend().IEEE.Failed[0] == "" {
+			t.Error("cached report shared with caller")
+		}
+	}
+	if b.Threads != NumThreads() {
+		t.Errorf("unexpected number of threads: got %d want %d", b.Threads, NumThreads())
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"unsafe"
 
 	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/netlib/diag"
 )
 
 // Type check assertions:
@@ -71,16 +72,16 @@ func (Implementation) Srotmg(d1 float32, d2 float32, b1 float32, b2 float32) (p
 }
 func (Implementation) Srotm(n int, x []float32, incX int, y []float32, incY int, p blas.SrotmParams) {
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Srotm", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Srotm", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Srotm", Param: "incY", Message: zeroIncY})
 	}
 	if p.Flag < blas.Identity || p.Flag > blas.Diagonal {
-		panic(badFlag)
+		panic(Error{Routine: "Srotm", Param: "p", Message: badFlag})
 	}
 
 	// Quick return if possible.
@@ -90,10 +91,10 @@ func (Implementation) Srotm(n int, x []float32, incX int, y []float32, incY int,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Srotm", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Srotm", Param: "y", Message: shortY})
 	}
 	var _x *float32
 	if len(x) > 0 {
@@ -107,7 +108,16 @@ func (Implementation) Srotm(n int, x []float32, incX int, y []float32, incY int,
 		flag: float32(p.Flag),
 		h:    p.H,
 	}
-	C.cblas_srotm(C.int(n), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY), (*C.float)(unsafe.Pointer(&pi)))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Srotm", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Srotm", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Srotm", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_srotm(C.blasint(n), (*C.float)(_x), C.blasint(incX), (*C.float)(_y), C.blasint(incY), (*C.float)(unsafe.Pointer(&pi)))
 }
 func (Implementation) Drotg(a float64, b float64) (c float64, s float64, r float64, z float64) {
 	C.cblas_drotg((*C.double)(&a), (*C.double)(&b), (*C.double)(&c), (*C.double)(&s))
@@ -120,16 +130,16 @@ func (Implementation) Drotmg(d1 float64, d2 float64, b1 float64, b2 float64) (p
 }
 func (Implementation) Drotm(n int, x []float64, incX int, y []float64, incY int, p blas.DrotmParams) {
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Drotm", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Drotm", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Drotm", Param: "incY", Message: zeroIncY})
 	}
 	if p.Flag < blas.Identity || p.Flag > blas.Diagonal {
-		panic(badFlag)
+		panic(Error{Routine: "Drotm", Param: "p", Message: badFlag})
 	}
 
 	// Quick return if possible.
@@ -139,10 +149,10 @@ func (Implementation) Drotm(n int, x []float64, incX int, y []float64, incY int,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Drotm", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Drotm", Param: "y", Message: shortY})
 	}
 	var _x *float64
 	if len(x) > 0 {
@@ -156,17 +166,26 @@ func (Implementation) Drotm(n int, x []float64, incX int, y []float64, incY int,
 		flag: float64(p.Flag),
 		h:    p.H,
 	}
-	C.cblas_drotm(C.int(n), (*C.double)(_x), C.int(incX), (*C.double)(_y), C.int(incY), (*C.double)(unsafe.Pointer(&pi)))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Drotm", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Drotm", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Drotm", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_drotm(C.blasint(n), (*C.double)(_x), C.blasint(incX), (*C.double)(_y), C.blasint(incY), (*C.double)(unsafe.Pointer(&pi)))
 }
 func (Implementation) Cdotu(n int, x []complex64, incX int, y []complex64, incY int) (dotu complex64) {
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Cdotu", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Cdotu", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Cdotu", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -176,10 +195,10 @@ func (Implementation) Cdotu(n int, x []complex64, incX int, y []complex64, incY
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Cdotu", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Cdotu", Param: "y", Message: shortY})
 	}
 	var _x *complex64
 	if len(x) > 0 {
@@ -189,18 +208,27 @@ func (Implementation) Cdotu(n int, x []complex64, incX int, y []complex64, incY
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_cdotu_sub(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(&dotu))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Cdotu", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Cdotu", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Cdotu", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_cdotu_sub(C.blasint(n), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(_y), C.blasint(incY), unsafe.Pointer(&dotu))
 	return dotu
 }
 func (Implementation) Cdotc(n int, x []complex64, incX int, y []complex64, incY int) (dotc complex64) {
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Cdotc", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Cdotc", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Cdotc", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -210,10 +238,10 @@ func (Implementation) Cdotc(n int, x []complex64, incX int, y []complex64, incY
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Cdotc", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Cdotc", Param: "y", Message: shortY})
 	}
 	var _x *complex64
 	if len(x) > 0 {
@@ -223,18 +251,27 @@ func (Implementation) Cdotc(n int, x []complex64, incX int, y []complex64, incY
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_cdotc_sub(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(&dotc))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Cdotc", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Cdotc", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Cdotc", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_cdotc_sub(C.blasint(n), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(_y), C.blasint(incY), unsafe.Pointer(&dotc))
 	return dotc
 }
 func (Implementation) Zdotu(n int, x []complex128, incX int, y []complex128, incY int) (dotu complex128) {
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Zdotu", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Zdotu", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Zdotu", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -244,10 +281,10 @@ func (Implementation) Zdotu(n int, x []complex128, incX int, y []complex128, inc
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Zdotu", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Zdotu", Param: "y", Message: shortY})
 	}
 	var _x *complex128
 	if len(x) > 0 {
@@ -257,18 +294,27 @@ func (Implementation) Zdotu(n int, x []complex128, incX int, y []complex128, inc
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_zdotu_sub(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(&dotu))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Zdotu", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Zdotu", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Zdotu", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_zdotu_sub(C.blasint(n), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(_y), C.blasint(incY), unsafe.Pointer(&dotu))
 	return dotu
 }
 func (Implementation) Zdotc(n int, x []complex128, incX int, y []complex128, incY int) (dotc complex128) {
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Zdotc", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Zdotc", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Zdotc", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -278,10 +324,10 @@ func (Implementation) Zdotc(n int, x []complex128, incX int, y []complex128, inc
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Zdotc", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Zdotc", Param: "y", Message: shortY})
 	}
 	var _x *complex128
 	if len(x) > 0 {
@@ -291,7 +337,16 @@ func (Implementation) Zdotc(n int, x []complex128, incX int, y []complex128, inc
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_zdotc_sub(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(&dotc))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Zdotc", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Zdotc", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Zdotc", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_zdotc_sub(C.blasint(n), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(_y), C.blasint(incY), unsafe.Pointer(&dotc))
 	return dotc
 }
 
@@ -300,16 +355,19 @@ func (Implementation) Zdotc(n int, x []complex128, incX int, y []complex128, inc
 // Sdsdot computes the dot product of the two vectors plus a constant
 //  alpha + \sum_i x[i]*y[i]
 func (Implementation) Sdsdot(n int, alpha float32, x []float32, incX int, y []float32, incY int) float32 {
-	// declared at cblas.h:24:8 float cblas_sdsdot ...
+	// declared at cblas.h:34:8 float cblas_sdsdot ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Sdsdot", n, alpha, incX, incY)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Sdsdot", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Sdsdot", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Sdsdot", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -319,10 +377,10 @@ func (Implementation) Sdsdot(n int, alpha float32, x []float32, incX int, y []fl
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Sdsdot", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Sdsdot", Param: "y", Message: shortY})
 	}
 	var _x *float32
 	if len(x) > 0 {
@@ -332,22 +390,34 @@ func (Implementation) Sdsdot(n int, alpha float32, x []float32, incX int, y []fl
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	return float32(C.cblas_sdsdot(C.int(n), C.float(alpha), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY)))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Sdsdot", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Sdsdot", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Sdsdot", Param: "incY", Message: incYTooLarge})
+	}
+	return float32(C.cblas_sdsdot(C.blasint(n), C.float(alpha), (*C.float)(_x), C.blasint(incX), (*C.float)(_y), C.blasint(incY)))
 }
 
 // Dsdot computes the dot product of the two vectors
 //  \sum_i x[i]*y[i]
 func (Implementation) Dsdot(n int, x []float32, incX int, y []float32, incY int) float64 {
-	// declared at cblas.h:26:8 double cblas_dsdot ...
+	// declared at cblas.h:36:8 double cblas_dsdot ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dsdot", n, incX, incY)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dsdot", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Dsdot", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Dsdot", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -357,10 +427,10 @@ func (Implementation) Dsdot(n int, x []float32, incX int, y []float32, incY int)
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Dsdot", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Dsdot", Param: "y", Message: shortY})
 	}
 	var _x *float32
 	if len(x) > 0 {
@@ -370,22 +440,34 @@ func (Implementation) Dsdot(n int, x []float32, incX int, y []float32, incY int)
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	return float64(C.cblas_dsdot(C.int(n), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY)))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dsdot", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Dsdot", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Dsdot", Param: "incY", Message: incYTooLarge})
+	}
+	return float64(C.cblas_dsdot(C.blasint(n), (*C.float)(_x), C.blasint(incX), (*C.float)(_y), C.blasint(incY)))
 }
 
 // Sdot computes the dot product of the two vectors
 //  \sum_i x[i]*y[i]
 func (Implementation) Sdot(n int, x []float32, incX int, y []float32, incY int) float32 {
-	// declared at cblas.h:28:8 float cblas_sdot ...
+	// declared at cblas.h:38:8 float cblas_sdot ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Sdot", n, incX, incY)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Sdot", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Sdot", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Sdot", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -395,10 +477,10 @@ func (Implementation) Sdot(n int, x []float32, incX int, y []float32, incY int)
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Sdot", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Sdot", Param: "y", Message: shortY})
 	}
 	var _x *float32
 	if len(x) > 0 {
@@ -408,22 +490,34 @@ func (Implementation) Sdot(n int, x []float32, incX int, y []float32, incY int)
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	return float32(C.cblas_sdot(C.int(n), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY)))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Sdot", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Sdot", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Sdot", Param: "incY", Message: incYTooLarge})
+	}
+	return float32(C.cblas_sdot(C.blasint(n), (*C.float)(_x), C.blasint(incX), (*C.float)(_y), C.blasint(incY)))
 }
 
 // Ddot computes the dot product of the two vectors
 //  \sum_i x[i]*y[i]
 func (Implementation) Ddot(n int, x []float64, incX int, y []float64, incY int) float64 {
-	// declared at cblas.h:30:8 double cblas_ddot ...
+	// declared at cblas.h:40:8 double cblas_ddot ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Ddot", n, incX, incY)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Ddot", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Ddot", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Ddot", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -433,10 +527,10 @@ func (Implementation) Ddot(n int, x []float64, incX int, y []float64, incY int)
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Ddot", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Ddot", Param: "y", Message: shortY})
 	}
 	var _x *float64
 	if len(x) > 0 {
@@ -446,20 +540,32 @@ func (Implementation) Ddot(n int, x []float64, incX int, y []float64, incY int)
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	return float64(C.cblas_ddot(C.int(n), (*C.double)(_x), C.int(incX), (*C.double)(_y), C.int(incY)))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Ddot", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Ddot", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Ddot", Param: "incY", Message: incYTooLarge})
+	}
+	return float64(C.cblas_ddot(C.blasint(n), (*C.double)(_x), C.blasint(incX), (*C.double)(_y), C.blasint(incY)))
 }
 
 // Snrm2 computes the Euclidean norm of a vector,
 //  sqrt(\sum_i x[i] * x[i]).
 // This function returns 0 if incX is negative.
 func (Implementation) Snrm2(n int, x []float32, incX int) float32 {
-	// declared at cblas.h:49:8 float cblas_snrm2 ...
+	// declared at cblas.h:59:8 float cblas_snrm2 ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Snrm2", n, incX)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Snrm2", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Snrm2", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -469,26 +575,35 @@ func (Implementation) Snrm2(n int, x []float32, incX int) float32 {
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(x) <= (n-1)*incX {
-		panic(shortX)
+		panic(Error{Routine: "Snrm2", Param: "x", Message: shortX})
 	}
 	var _x *float32
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	return float32(C.cblas_snrm2(C.int(n), (*C.float)(_x), C.int(incX)))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Snrm2", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Snrm2", Param: "incX", Message: incXTooLarge})
+	}
+	return float32(C.cblas_snrm2(C.blasint(n), (*C.float)(_x), C.blasint(incX)))
 }
 
 // Sasum computes the sum of the absolute values of the elements of x.
 //  \sum_i |x[i]|
 // Sasum returns 0 if incX is negative.
 func (Implementation) Sasum(n int, x []float32, incX int) float32 {
-	// declared at cblas.h:50:8 float cblas_sasum ...
+	// declared at cblas.h:60:8 float cblas_sasum ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Sasum", n, incX)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Sasum", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Sasum", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -498,26 +613,35 @@ func (Implementation) Sasum(n int, x []float32, incX int) float32 {
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(x) <= (n-1)*incX {
-		panic(shortX)
+		panic(Error{Routine: "Sasum", Param: "x", Message: shortX})
 	}
 	var _x *float32
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	return float32(C.cblas_sasum(C.int(n), (*C.float)(_x), C.int(incX)))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Sasum", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Sasum", Param: "incX", Message: incXTooLarge})
+	}
+	return float32(C.cblas_sasum(C.blasint(n), (*C.float)(_x), C.blasint(incX)))
 }
 
 // Dnrm2 computes the Euclidean norm of a vector,
 //  sqrt(\sum_i x[i] * x[i]).
 // This function returns 0 if incX is negative.
 func (Implementation) Dnrm2(n int, x []float64, incX int) float64 {
-	// declared at cblas.h:52:8 double cblas_dnrm2 ...
+	// declared at cblas.h:62:8 double cblas_dnrm2 ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dnrm2", n, incX)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dnrm2", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Dnrm2", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -527,26 +651,35 @@ func (Implementation) Dnrm2(n int, x []float64, incX int) float64 {
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(x) <= (n-1)*incX {
-		panic(shortX)
+		panic(Error{Routine: "Dnrm2", Param: "x", Message: shortX})
 	}
 	var _x *float64
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	return float64(C.cblas_dnrm2(C.int(n), (*C.double)(_x), C.int(incX)))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dnrm2", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Dnrm2", Param: "incX", Message: incXTooLarge})
+	}
+	return float64(C.cblas_dnrm2(C.blasint(n), (*C.double)(_x), C.blasint(incX)))
 }
 
 // Dasum computes the sum of the absolute values of the elements of x.
 //  \sum_i |x[i]|
 // Dasum returns 0 if incX is negative.
 func (Implementation) Dasum(n int, x []float64, incX int) float64 {
-	// declared at cblas.h:53:8 double cblas_dasum ...
+	// declared at cblas.h:63:8 double cblas_dasum ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dasum", n, incX)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dasum", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Dasum", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -556,13 +689,19 @@ func (Implementation) Dasum(n int, x []float64, incX int) float64 {
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(x) <= (n-1)*incX {
-		panic(shortX)
+		panic(Error{Routine: "Dasum", Param: "x", Message: shortX})
 	}
 	var _x *float64
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	return float64(C.cblas_dasum(C.int(n), (*C.double)(_x), C.int(incX)))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dasum", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Dasum", Param: "incX", Message: incXTooLarge})
+	}
+	return float64(C.cblas_dasum(C.blasint(n), (*C.double)(_x), C.blasint(incX)))
 }
 
 // Scnrm2 computes the Euclidean norm of the complex vector x,
@@ -571,13 +710,16 @@ func (Implementation) Dasum(n int, x []float64, incX int) float64 {
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Scnrm2(n int, x []complex64, incX int) float32 {
-	// declared at cblas.h:55:8 float cblas_scnrm2 ...
+	// declared at cblas.h:65:8 float cblas_scnrm2 ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Scnrm2", n, incX)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Scnrm2", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Scnrm2", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -587,13 +729,19 @@ func (Implementation) Scnrm2(n int, x []complex64, incX int) float32 {
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(x) <= (n-1)*incX {
-		panic(shortX)
+		panic(Error{Routine: "Scnrm2", Param: "x", Message: shortX})
 	}
 	var _x *complex64
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	return float32(C.cblas_scnrm2(C.int(n), unsafe.Pointer(_x), C.int(incX)))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Scnrm2", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Scnrm2", Param: "incX", Message: incXTooLarge})
+	}
+	return float32(C.cblas_scnrm2(C.blasint(n), unsafe.Pointer(_x), C.blasint(incX)))
 }
 
 // Scasum returns the sum of the absolute values of the elements of x
@@ -602,13 +750,16 @@ func (Implementation) Scnrm2(n int, x []complex64, incX int) float32 {
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Scasum(n int, x []complex64, incX int) float32 {
-	// declared at cblas.h:56:8 float cblas_scasum ...
+	// declared at cblas.h:66:8 float cblas_scasum ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Scasum", n, incX)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Scasum", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Scasum", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -618,26 +769,35 @@ func (Implementation) Scasum(n int, x []complex64, incX int) float32 {
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(x) <= (n-1)*incX {
-		panic(shortX)
+		panic(Error{Routine: "Scasum", Param: "x", Message: shortX})
 	}
 	var _x *complex64
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	return float32(C.cblas_scasum(C.int(n), unsafe.Pointer(_x), C.int(incX)))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Scasum", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Scasum", Param: "incX", Message: incXTooLarge})
+	}
+	return float32(C.cblas_scasum(C.blasint(n), unsafe.Pointer(_x), C.blasint(incX)))
 }
 
 // Dznrm2 computes the Euclidean norm of the complex vector x,
 //  ‖x‖_2 = sqrt(\sum_i x[i] * conj(x[i])).
 // This function returns 0 if incX is negative.
 func (Implementation) Dznrm2(n int, x []complex128, incX int) float64 {
-	// declared at cblas.h:58:8 double cblas_dznrm2 ...
+	// declared at cblas.h:68:8 double cblas_dznrm2 ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dznrm2", n, incX)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dznrm2", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Dznrm2", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -647,26 +807,35 @@ func (Implementation) Dznrm2(n int, x []complex128, incX int) float64 {
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(x) <= (n-1)*incX {
-		panic(shortX)
+		panic(Error{Routine: "Dznrm2", Param: "x", Message: shortX})
 	}
 	var _x *complex128
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	return float64(C.cblas_dznrm2(C.int(n), unsafe.Pointer(_x), C.int(incX)))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dznrm2", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Dznrm2", Param: "incX", Message: incXTooLarge})
+	}
+	return float64(C.cblas_dznrm2(C.blasint(n), unsafe.Pointer(_x), C.blasint(incX)))
 }
 
 // Dzasum returns the sum of the absolute values of the elements of x
 //  \sum_i |Re(x[i])| + |Im(x[i])|
 // Dzasum returns 0 if incX is negative.
 func (Implementation) Dzasum(n int, x []complex128, incX int) float64 {
-	// declared at cblas.h:59:8 double cblas_dzasum ...
+	// declared at cblas.h:69:8 double cblas_dzasum ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dzasum", n, incX)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dzasum", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Dzasum", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -676,26 +845,35 @@ func (Implementation) Dzasum(n int, x []complex128, incX int) float64 {
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(x) <= (n-1)*incX {
-		panic(shortX)
+		panic(Error{Routine: "Dzasum", Param: "x", Message: shortX})
 	}
 	var _x *complex128
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	return float64(C.cblas_dzasum(C.int(n), unsafe.Pointer(_x), C.int(incX)))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dzasum", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Dzasum", Param: "incX", Message: incXTooLarge})
+	}
+	return float64(C.cblas_dzasum(C.blasint(n), unsafe.Pointer(_x), C.blasint(incX)))
 }
 
 // Isamax returns the index of an element of x with the largest absolute value.
 // If there are multiple such indices the earliest is returned.
 // Isamax returns -1 if n == 0.
 func (Implementation) Isamax(n int, x []float32, incX int) int {
-	// declared at cblas.h:65:13 int cblas_isamax ...
+	// declared at cblas.h:75:13 int cblas_isamax ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Isamax", n, incX)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Isamax", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Isamax", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -705,26 +883,35 @@ func (Implementation) Isamax(n int, x []float32, incX int) int {
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(x) <= (n-1)*incX {
-		panic(shortX)
+		panic(Error{Routine: "Isamax", Param: "x", Message: shortX})
 	}
 	var _x *float32
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	return int(C.cblas_isamax(C.int(n), (*C.float)(_x), C.int(incX)))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Isamax", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Isamax", Param: "incX", Message: incXTooLarge})
+	}
+	return int(C.cblas_isamax(C.blasint(n), (*C.float)(_x), C.blasint(incX)))
 }
 
 // Idamax returns the index of an element of x with the largest absolute value.
 // If there are multiple such indices the earliest is returned.
 // Idamax returns -1 if n == 0.
 func (Implementation) Idamax(n int, x []float64, incX int) int {
-	// declared at cblas.h:66:13 int cblas_idamax ...
+	// declared at cblas.h:76:13 int cblas_idamax ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Idamax", n, incX)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Idamax", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Idamax", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -734,13 +921,19 @@ func (Implementation) Idamax(n int, x []float64, incX int) int {
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(x) <= (n-1)*incX {
-		panic(shortX)
+		panic(Error{Routine: "Idamax", Param: "x", Message: shortX})
 	}
 	var _x *float64
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	return int(C.cblas_idamax(C.int(n), (*C.double)(_x), C.int(incX)))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Idamax", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Idamax", Param: "incX", Message: incXTooLarge})
+	}
+	return int(C.cblas_idamax(C.blasint(n), (*C.double)(_x), C.blasint(incX)))
 }
 
 // Icamax returns the index of the first element of x having largest |Re(·)|+|Im(·)|.
@@ -748,13 +941,16 @@ func (Implementation) Idamax(n int, x []float64, incX int) int {
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Icamax(n int, x []complex64, incX int) int {
-	// declared at cblas.h:67:13 int cblas_icamax ...
+	// declared at cblas.h:77:13 int cblas_icamax ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Icamax", n, incX)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Icamax", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Icamax", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -764,25 +960,34 @@ func (Implementation) Icamax(n int, x []complex64, incX int) int {
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(x) <= (n-1)*incX {
-		panic(shortX)
+		panic(Error{Routine: "Icamax", Param: "x", Message: shortX})
 	}
 	var _x *complex64
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	return int(C.cblas_icamax(C.int(n), unsafe.Pointer(_x), C.int(incX)))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Icamax", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Icamax", Param: "incX", Message: incXTooLarge})
+	}
+	return int(C.cblas_icamax(C.blasint(n), unsafe.Pointer(_x), C.blasint(incX)))
 }
 
 // Izamax returns the index of the first element of x having largest |Re(·)|+|Im(·)|.
 // Izamax returns -1 if n is 0 or incX is negative.
 func (Implementation) Izamax(n int, x []complex128, incX int) int {
-	// declared at cblas.h:68:13 int cblas_izamax ...
+	// declared at cblas.h:78:13 int cblas_izamax ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Izamax", n, incX)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Izamax", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Izamax", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -792,28 +997,37 @@ func (Implementation) Izamax(n int, x []complex128, incX int) int {
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(x) <= (n-1)*incX {
-		panic(shortX)
+		panic(Error{Routine: "Izamax", Param: "x", Message: shortX})
 	}
 	var _x *complex128
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	return int(C.cblas_izamax(C.int(n), unsafe.Pointer(_x), C.int(incX)))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Izamax", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Izamax", Param: "incX", Message: incXTooLarge})
+	}
+	return int(C.cblas_izamax(C.blasint(n), unsafe.Pointer(_x), C.blasint(incX)))
 }
 
 // Sswap exchanges the elements of two vectors.
 //  x[i], y[i] = y[i], x[i] for all i
 func (Implementation) Sswap(n int, x []float32, incX int, y []float32, incY int) {
-	// declared at cblas.h:79:6 void cblas_sswap ...
+	// declared at cblas.h:89:6 void cblas_sswap ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Sswap", n, incX, incY)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Sswap", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Sswap", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Sswap", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -823,10 +1037,10 @@ func (Implementation) Sswap(n int, x []float32, incX int, y []float32, incY int)
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Sswap", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Sswap", Param: "y", Message: shortY})
 	}
 	var _x *float32
 	if len(x) > 0 {
@@ -836,22 +1050,34 @@ func (Implementation) Sswap(n int, x []float32, incX int, y []float32, incY int)
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_sswap(C.int(n), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Sswap", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Sswap", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Sswap", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_sswap(C.blasint(n), (*C.float)(_x), C.blasint(incX), (*C.float)(_y), C.blasint(incY))
 }
 
 // Scopy copies the elements of x into the elements of y.
 //  y[i] = x[i] for all i
 func (Implementation) Scopy(n int, x []float32, incX int, y []float32, incY int) {
-	// declared at cblas.h:81:6 void cblas_scopy ...
+	// declared at cblas.h:91:6 void cblas_scopy ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Scopy", n, incX, incY)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Scopy", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Scopy", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Scopy", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -861,10 +1087,10 @@ func (Implementation) Scopy(n int, x []float32, incX int, y []float32, incY int)
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Scopy", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Scopy", Param: "y", Message: shortY})
 	}
 	var _x *float32
 	if len(x) > 0 {
@@ -874,22 +1100,34 @@ func (Implementation) Scopy(n int, x []float32, incX int, y []float32, incY int)
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_scopy(C.int(n), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Scopy", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Scopy", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Scopy", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_scopy(C.blasint(n), (*C.float)(_x), C.blasint(incX), (*C.float)(_y), C.blasint(incY))
 }
 
 // Saxpy adds alpha times x to y
 //  y[i] += alpha * x[i] for all i
 func (Implementation) Saxpy(n int, alpha float32, x []float32, incX int, y []float32, incY int) {
-	// declared at cblas.h:83:6 void cblas_saxpy ...
+	// declared at cblas.h:93:6 void cblas_saxpy ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Saxpy", n, alpha, incX, incY)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Saxpy", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Saxpy", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Saxpy", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -899,10 +1137,10 @@ func (Implementation) Saxpy(n int, alpha float32, x []float32, incX int, y []flo
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Saxpy", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Saxpy", Param: "y", Message: shortY})
 	}
 	var _x *float32
 	if len(x) > 0 {
@@ -912,22 +1150,34 @@ func (Implementation) Saxpy(n int, alpha float32, x []float32, incX int, y []flo
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_saxpy(C.int(n), C.float(alpha), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Saxpy", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Saxpy", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Saxpy", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_saxpy(C.blasint(n), C.float(alpha), (*C.float)(_x), C.blasint(incX), (*C.float)(_y), C.blasint(incY))
 }
 
 // Dswap exchanges the elements of two vectors.
 //  x[i], y[i] = y[i], x[i] for all i
 func (Implementation) Dswap(n int, x []float64, incX int, y []float64, incY int) {
-	// declared at cblas.h:90:6 void cblas_dswap ...
+	// declared at cblas.h:100:6 void cblas_dswap ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dswap", n, incX, incY)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dswap", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Dswap", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Dswap", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -937,10 +1187,10 @@ func (Implementation) Dswap(n int, x []float64, incX int, y []float64, incY int)
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Dswap", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Dswap", Param: "y", Message: shortY})
 	}
 	var _x *float64
 	if len(x) > 0 {
@@ -950,22 +1200,34 @@ func (Implementation) Dswap(n int, x []float64, incX int, y []float64, incY int)
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_dswap(C.int(n), (*C.double)(_x), C.int(incX), (*C.double)(_y), C.int(incY))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dswap", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Dswap", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Dswap", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_dswap(C.blasint(n), (*C.double)(_x), C.blasint(incX), (*C.double)(_y), C.blasint(incY))
 }
 
 // Dcopy copies the elements of x into the elements of y.
 //  y[i] = x[i] for all i
 func (Implementation) Dcopy(n int, x []float64, incX int, y []float64, incY int) {
-	// declared at cblas.h:92:6 void cblas_dcopy ...
+	// declared at cblas.h:102:6 void cblas_dcopy ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dcopy", n, incX, incY)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dcopy", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Dcopy", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Dcopy", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -975,10 +1237,10 @@ func (Implementation) Dcopy(n int, x []float64, incX int, y []float64, incY int)
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Dcopy", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Dcopy", Param: "y", Message: shortY})
 	}
 	var _x *float64
 	if len(x) > 0 {
@@ -988,22 +1250,34 @@ func (Implementation) Dcopy(n int, x []float64, incX int, y []float64, incY int)
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_dcopy(C.int(n), (*C.double)(_x), C.int(incX), (*C.double)(_y), C.int(incY))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dcopy", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Dcopy", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Dcopy", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_dcopy(C.blasint(n), (*C.double)(_x), C.blasint(incX), (*C.double)(_y), C.blasint(incY))
 }
 
 // Daxpy adds alpha times x to y
 //  y[i] += alpha * x[i] for all i
 func (Implementation) Daxpy(n int, alpha float64, x []float64, incX int, y []float64, incY int) {
-	// declared at cblas.h:94:6 void cblas_daxpy ...
+	// declared at cblas.h:104:6 void cblas_daxpy ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Daxpy", n, alpha, incX, incY)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Daxpy", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Daxpy", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Daxpy", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -1013,10 +1287,10 @@ func (Implementation) Daxpy(n int, alpha float64, x []float64, incX int, y []flo
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Daxpy", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Daxpy", Param: "y", Message: shortY})
 	}
 	var _x *float64
 	if len(x) > 0 {
@@ -1026,23 +1300,35 @@ func (Implementation) Daxpy(n int, alpha float64, x []float64, incX int, y []flo
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_daxpy(C.int(n), C.double(alpha), (*C.double)(_x), C.int(incX), (*C.double)(_y), C.int(incY))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Daxpy", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Daxpy", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Daxpy", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_daxpy(C.blasint(n), C.double(alpha), (*C.double)(_x), C.blasint(incX), (*C.double)(_y), C.blasint(incY))
 }
 
 // Cswap exchanges the elements of two complex vectors x and y.
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Cswap(n int, x []complex64, incX int, y []complex64, incY int) {
-	// declared at cblas.h:101:6 void cblas_cswap ...
+	// declared at cblas.h:111:6 void cblas_cswap ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Cswap", n, incX, incY)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Cswap", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Cswap", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Cswap", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -1052,10 +1338,10 @@ func (Implementation) Cswap(n int, x []complex64, incX int, y []complex64, incY
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Cswap", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Cswap", Param: "y", Message: shortY})
 	}
 	var _x *complex64
 	if len(x) > 0 {
@@ -1065,23 +1351,35 @@ func (Implementation) Cswap(n int, x []complex64, incX int, y []complex64, incY
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_cswap(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Cswap", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Cswap", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Cswap", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_cswap(C.blasint(n), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(_y), C.blasint(incY))
 }
 
 // Ccopy copies the vector x to vector y.
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Ccopy(n int, x []complex64, incX int, y []complex64, incY int) {
-	// declared at cblas.h:103:6 void cblas_ccopy ...
+	// declared at cblas.h:113:6 void cblas_ccopy ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Ccopy", n, incX, incY)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Ccopy", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Ccopy", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Ccopy", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -1091,10 +1389,10 @@ func (Implementation) Ccopy(n int, x []complex64, incX int, y []complex64, incY
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Ccopy", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Ccopy", Param: "y", Message: shortY})
 	}
 	var _x *complex64
 	if len(x) > 0 {
@@ -1104,7 +1402,16 @@ func (Implementation) Ccopy(n int, x []complex64, incX int, y []complex64, incY
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_ccopy(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Ccopy", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Ccopy", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Ccopy", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_ccopy(C.blasint(n), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(_y), C.blasint(incY))
 }
 
 // Caxpy adds alpha times x to y:
@@ -1112,16 +1419,19 @@ func (Implementation) Ccopy(n int, x []complex64, incX int, y []complex64, incY
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Caxpy(n int, alpha complex64, x []complex64, incX int, y []complex64, incY int) {
-	// declared at cblas.h:105:6 void cblas_caxpy ...
+	// declared at cblas.h:115:6 void cblas_caxpy ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Caxpy", n, alpha, incX, incY)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Caxpy", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Caxpy", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Caxpy", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -1131,10 +1441,10 @@ func (Implementation) Caxpy(n int, alpha complex64, x []complex64, incX int, y [
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Caxpy", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Caxpy", Param: "y", Message: shortY})
 	}
 	var _x *complex64
 	if len(x) > 0 {
@@ -1144,21 +1454,33 @@ func (Implementation) Caxpy(n int, alpha complex64, x []complex64, incX int, y [
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_caxpy(C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Caxpy", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Caxpy", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Caxpy", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_caxpy(C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(_y), C.blasint(incY))
 }
 
 // Zswap exchanges the elements of two complex vectors x and y.
 func (Implementation) Zswap(n int, x []complex128, incX int, y []complex128, incY int) {
-	// declared at cblas.h:112:6 void cblas_zswap ...
+	// declared at cblas.h:122:6 void cblas_zswap ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zswap", n, incX, incY)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Zswap", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Zswap", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Zswap", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -1168,10 +1490,10 @@ func (Implementation) Zswap(n int, x []complex128, incX int, y []complex128, inc
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Zswap", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Zswap", Param: "y", Message: shortY})
 	}
 	var _x *complex128
 	if len(x) > 0 {
@@ -1181,21 +1503,33 @@ func (Implementation) Zswap(n int, x []complex128, incX int, y []complex128, inc
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_zswap(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Zswap", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Zswap", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Zswap", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_zswap(C.blasint(n), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(_y), C.blasint(incY))
 }
 
 // Zcopy copies the vector x to vector y.
 func (Implementation) Zcopy(n int, x []complex128, incX int, y []complex128, incY int) {
-	// declared at cblas.h:114:6 void cblas_zcopy ...
+	// declared at cblas.h:124:6 void cblas_zcopy ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zcopy", n, incX, incY)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Zcopy", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Zcopy", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Zcopy", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -1205,10 +1539,10 @@ func (Implementation) Zcopy(n int, x []complex128, incX int, y []complex128, inc
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Zcopy", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Zcopy", Param: "y", Message: shortY})
 	}
 	var _x *complex128
 	if len(x) > 0 {
@@ -1218,22 +1552,34 @@ func (Implementation) Zcopy(n int, x []complex128, incX int, y []complex128, inc
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_zcopy(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Zcopy", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Zcopy", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Zcopy", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_zcopy(C.blasint(n), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(_y), C.blasint(incY))
 }
 
 // Zaxpy adds alpha times x to y:
 //  y[i] += alpha * x[i] for all i
 func (Implementation) Zaxpy(n int, alpha complex128, x []complex128, incX int, y []complex128, incY int) {
-	// declared at cblas.h:116:6 void cblas_zaxpy ...
+	// declared at cblas.h:126:6 void cblas_zaxpy ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zaxpy", n, alpha, incX, incY)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Zaxpy", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Zaxpy", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Zaxpy", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -1243,10 +1589,10 @@ func (Implementation) Zaxpy(n int, alpha complex128, x []complex128, incX int, y
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Zaxpy", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Zaxpy", Param: "y", Message: shortY})
 	}
 	var _x *complex128
 	if len(x) > 0 {
@@ -1256,23 +1602,35 @@ func (Implementation) Zaxpy(n int, alpha complex128, x []complex128, incX int, y
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_zaxpy(C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Zaxpy", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Zaxpy", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Zaxpy", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_zaxpy(C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(_y), C.blasint(incY))
 }
 
 // Srot applies a plane transformation.
 //  x[i] = c * x[i] + s * y[i]
 //  y[i] = c * y[i] - s * x[i]
 func (Implementation) Srot(n int, x []float32, incX int, y []float32, incY int, c, s float32) {
-	// declared at cblas.h:129:6 void cblas_srot ...
+	// declared at cblas.h:139:6 void cblas_srot ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Srot", n, incX, incY, c, s)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Srot", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Srot", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Srot", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -1282,10 +1640,10 @@ func (Implementation) Srot(n int, x []float32, incX int, y []float32, incY int,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Srot", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Srot", Param: "y", Message: shortY})
 	}
 	var _x *float32
 	if len(x) > 0 {
@@ -1295,23 +1653,35 @@ func (Implementation) Srot(n int, x []float32, incX int, y []float32, incY int,
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_srot(C.int(n), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY), C.float(c), C.float(s))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Srot", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Srot", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Srot", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_srot(C.blasint(n), (*C.float)(_x), C.blasint(incX), (*C.float)(_y), C.blasint(incY), C.float(c), C.float(s))
 }
 
 // Drot applies a plane transformation.
 //  x[i] = c * x[i] + s * y[i]
 //  y[i] = c * y[i] - s * x[i]
 func (Implementation) Drot(n int, x []float64, incX int, y []float64, incY int, c, s float64) {
-	// declared at cblas.h:136:6 void cblas_drot ...
+	// declared at cblas.h:146:6 void cblas_drot ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Drot", n, incX, incY, c, s)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Drot", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Drot", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Drot", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -1321,10 +1691,10 @@ func (Implementation) Drot(n int, x []float64, incX int, y []float64, incY int,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Drot", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Drot", Param: "y", Message: shortY})
 	}
 	var _x *float64
 	if len(x) > 0 {
@@ -1334,20 +1704,32 @@ func (Implementation) Drot(n int, x []float64, incX int, y []float64, incY int,
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_drot(C.int(n), (*C.double)(_x), C.int(incX), (*C.double)(_y), C.int(incY), C.double(c), C.double(s))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Drot", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Drot", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Drot", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_drot(C.blasint(n), (*C.double)(_x), C.blasint(incX), (*C.double)(_y), C.blasint(incY), C.double(c), C.double(s))
 }
 
 // Sscal scales x by alpha.
 //  x[i] *= alpha
 // Sscal has no effect if incX < 0.
 func (Implementation) Sscal(n int, alpha float32, x []float32, incX int) {
-	// declared at cblas.h:145:6 void cblas_sscal ...
+	// declared at cblas.h:155:6 void cblas_sscal ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Sscal", n, alpha, incX)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Sscal", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Sscal", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -1357,26 +1739,35 @@ func (Implementation) Sscal(n int, alpha float32, x []float32, incX int) {
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(x) <= (n-1)*incX {
-		panic(shortX)
+		panic(Error{Routine: "Sscal", Param: "x", Message: shortX})
 	}
 	var _x *float32
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_sscal(C.int(n), C.float(alpha), (*C.float)(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Sscal", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Sscal", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_sscal(C.blasint(n), C.float(alpha), (*C.float)(_x), C.blasint(incX))
 }
 
 // Dscal scales x by alpha.
 //  x[i] *= alpha
 // Dscal has no effect if incX < 0.
 func (Implementation) Dscal(n int, alpha float64, x []float64, incX int) {
-	// declared at cblas.h:146:6 void cblas_dscal ...
+	// declared at cblas.h:156:6 void cblas_dscal ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dscal", n, alpha, incX)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dscal", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Dscal", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -1386,13 +1777,19 @@ func (Implementation) Dscal(n int, alpha float64, x []float64, incX int) {
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(x) <= (n-1)*incX {
-		panic(shortX)
+		panic(Error{Routine: "Dscal", Param: "x", Message: shortX})
 	}
 	var _x *float64
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_dscal(C.int(n), C.double(alpha), (*C.double)(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dscal", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Dscal", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_dscal(C.blasint(n), C.double(alpha), (*C.double)(_x), C.blasint(incX))
 }
 
 // Cscal scales the vector x by a complex scalar alpha.
@@ -1400,13 +1797,16 @@ func (Implementation) Dscal(n int, alpha float64, x []float64, incX int) {
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Cscal(n int, alpha complex64, x []complex64, incX int) {
-	// declared at cblas.h:147:6 void cblas_cscal ...
+	// declared at cblas.h:157:6 void cblas_cscal ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Cscal", n, alpha, incX)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Cscal", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Cscal", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -1416,25 +1816,34 @@ func (Implementation) Cscal(n int, alpha complex64, x []complex64, incX int) {
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(x) <= (n-1)*incX {
-		panic(shortX)
+		panic(Error{Routine: "Cscal", Param: "x", Message: shortX})
 	}
 	var _x *complex64
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_cscal(C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Cscal", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Cscal", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_cscal(C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.blasint(incX))
 }
 
 // Zscal scales the vector x by a complex scalar alpha.
 // Zscal has no effect if incX < 0.
 func (Implementation) Zscal(n int, alpha complex128, x []complex128, incX int) {
-	// declared at cblas.h:148:6 void cblas_zscal ...
+	// declared at cblas.h:158:6 void cblas_zscal ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zscal", n, alpha, incX)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Zscal", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Zscal", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -1444,13 +1853,19 @@ func (Implementation) Zscal(n int, alpha complex128, x []complex128, incX int) {
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(x) <= (n-1)*incX {
-		panic(shortX)
+		panic(Error{Routine: "Zscal", Param: "x", Message: shortX})
 	}
 	var _x *complex128
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_zscal(C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Zscal", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Zscal", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_zscal(C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.blasint(incX))
 }
 
 // Csscal scales the vector x by a real scalar alpha.
@@ -1458,13 +1873,16 @@ func (Implementation) Zscal(n int, alpha complex128, x []complex128, incX int) {
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Csscal(n int, alpha float32, x []complex64, incX int) {
-	// declared at cblas.h:149:6 void cblas_csscal ...
+	// declared at cblas.h:159:6 void cblas_csscal ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Csscal", n, alpha, incX)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Csscal", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Csscal", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -1474,25 +1892,34 @@ func (Implementation) Csscal(n int, alpha float32, x []complex64, incX int) {
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(x) <= (n-1)*incX {
-		panic(shortX)
+		panic(Error{Routine: "Csscal", Param: "x", Message: shortX})
 	}
 	var _x *complex64
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_csscal(C.int(n), C.float(alpha), unsafe.Pointer(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Csscal", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Csscal", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_csscal(C.blasint(n), C.float(alpha), unsafe.Pointer(_x), C.blasint(incX))
 }
 
 // Zdscal scales the vector x by a real scalar alpha.
 // Zdscal has no effect if incX < 0.
 func (Implementation) Zdscal(n int, alpha float64, x []complex128, incX int) {
-	// declared at cblas.h:150:6 void cblas_zdscal ...
+	// declared at cblas.h:160:6 void cblas_zdscal ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zdscal", n, alpha, incX)()
+	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Zdscal", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Zdscal", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -1502,13 +1929,19 @@ func (Implementation) Zdscal(n int, alpha float64, x []complex128, incX int) {
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(x) <= (n-1)*incX {
-		panic(shortX)
+		panic(Error{Routine: "Zdscal", Param: "x", Message: shortX})
 	}
 	var _x *complex128
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_zdscal(C.int(n), C.double(alpha), unsafe.Pointer(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Zdscal", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Zdscal", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_zdscal(C.blasint(n), C.double(alpha), unsafe.Pointer(_x), C.blasint(incX))
 }
 
 // Sgemv computes
@@ -1516,8 +1949,11 @@ func (Implementation) Zdscal(n int, alpha float64, x []complex128, incX int) {
 //  y = alpha * Aᵀ * x + beta * y  if tA = blas.Trans or blas.ConjTrans
 // where A is an m×n dense matrix, x and y are vectors, and alpha and beta are scalars.
 func (Implementation) Sgemv(tA blas.Transpose, m, n int, alpha float32, a []float32, lda int, x []float32, incX int, beta float32, y []float32, incY int) {
-	// declared at cblas.h:171:6 void cblas_sgemv ...
+	// declared at cblas.h:181:6 void cblas_sgemv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Sgemv", tA, m, n, alpha, lda, incX, beta, incY)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -1526,22 +1962,22 @@ func (Implementation) Sgemv(tA blas.Transpose, m, n int, alpha float32, a []floa
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Sgemv", Param: "tA", Message: badTranspose})
 	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Sgemv", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Sgemv", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Sgemv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Sgemv", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Sgemv", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -1551,7 +1987,7 @@ func (Implementation) Sgemv(tA blas.Transpose, m, n int, alpha float32, a []floa
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(m-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Sgemv", Param: "a", Message: shortA})
 	}
 	var lenX, lenY int
 	if tA == C.CblasNoTrans {
@@ -1560,10 +1996,10 @@ func (Implementation) Sgemv(tA blas.Transpose, m, n int, alpha float32, a []floa
 		lenX, lenY = m, n
 	}
 	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Sgemv", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Sgemv", Param: "y", Message: shortY})
 	}
 	var _a *float32
 	if len(a) > 0 {
@@ -1577,7 +2013,22 @@ func (Implementation) Sgemv(tA blas.Transpose, m, n int, alpha float32, a []floa
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_sgemv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_TRANSPOSE(tA), C.int(m), C.int(n), C.float(alpha), (*C.float)(_a), C.int(lda), (*C.float)(_x), C.int(incX), C.float(beta), (*C.float)(_y), C.int(incY))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Sgemv", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Sgemv", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Sgemv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Sgemv", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Sgemv", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_sgemv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_TRANSPOSE(tA), C.blasint(m), C.blasint(n), C.float(alpha), (*C.float)(_a), C.blasint(lda), (*C.float)(_x), C.blasint(incX), C.float(beta), (*C.float)(_y), C.blasint(incY))
 }
 
 // Sgbmv performs one of the matrix-vector operations
@@ -1586,8 +2037,11 @@ func (Implementation) Sgemv(tA blas.Transpose, m, n int, alpha float32, a []floa
 // where A is an m×n band matrix with kL sub-diagonals and kU super-diagonals,
 // x and y are vectors, and alpha and beta are scalars.
 func (Implementation) Sgbmv(tA blas.Transpose, m, n, kL, kU int, alpha float32, a []float32, lda int, x []float32, incX int, beta float32, y []float32, incY int) {
-	// declared at cblas.h:176:6 void cblas_sgbmv ...
+	// declared at cblas.h:186:6 void cblas_sgbmv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Sgbmv", tA, m, n, kL, kU, alpha, lda, incX, beta, incY)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -1596,28 +2050,28 @@ func (Implementation) Sgbmv(tA blas.Transpose, m, n, kL, kU int, alpha float32,
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Sgbmv", Param: "tA", Message: badTranspose})
 	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Sgbmv", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Sgbmv", Param: "n", Message: nLT0})
 	}
 	if kL < 0 {
-		panic(kLLT0)
+		panic(Error{Routine: "Sgbmv", Param: "kL", Message: kLLT0})
 	}
 	if kU < 0 {
-		panic(kULT0)
+		panic(Error{Routine: "Sgbmv", Param: "kU", Message: kULT0})
 	}
 	if lda < kL+kU+1 {
-		panic(badLdA)
+		panic(Error{Routine: "Sgbmv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Sgbmv", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Sgbmv", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -1627,7 +2081,7 @@ func (Implementation) Sgbmv(tA blas.Transpose, m, n, kL, kU int, alpha float32,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(min(m, n+kL)-1)+kL+kU+1 {
-		panic(shortA)
+		panic(Error{Routine: "Sgbmv", Param: "a", Message: shortA})
 	}
 	var lenX, lenY int
 	if tA == C.CblasNoTrans {
@@ -1636,10 +2090,10 @@ func (Implementation) Sgbmv(tA blas.Transpose, m, n, kL, kU int, alpha float32,
 		lenX, lenY = m, n
 	}
 	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Sgbmv", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Sgbmv", Param: "y", Message: shortY})
 	}
 	var _a *float32
 	if len(a) > 0 {
@@ -1653,7 +2107,28 @@ func (Implementation) Sgbmv(tA blas.Transpose, m, n, kL, kU int, alpha float32,
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_sgbmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_TRANSPOSE(tA), C.int(m), C.int(n), C.int(kL), C.int(kU), C.float(alpha), (*C.float)(_a), C.int(lda), (*C.float)(_x), C.int(incX), C.float(beta), (*C.float)(_y), C.int(incY))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Sgbmv", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Sgbmv", Param: "n", Message: nTooLarge})
+	}
+	if kL < minInt || kL > maxInt {
+		panic(Error{Routine: "Sgbmv", Param: "kL", Message: kLTooLarge})
+	}
+	if kU < minInt || kU > maxInt {
+		panic(Error{Routine: "Sgbmv", Param: "kU", Message: kUTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Sgbmv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Sgbmv", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Sgbmv", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_sgbmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_TRANSPOSE(tA), C.blasint(m), C.blasint(n), C.blasint(kL), C.blasint(kU), C.float(alpha), (*C.float)(_a), C.blasint(lda), (*C.float)(_x), C.blasint(incX), C.float(beta), (*C.float)(_y), C.blasint(incY))
 }
 
 // Strmv performs one of the matrix-vector operations
@@ -1661,8 +2136,11 @@ func (Implementation) Sgbmv(tA blas.Transpose, m, n, kL, kU int, alpha float32,
 //  x = Aᵀ * x  if tA == blas.Trans or blas.ConjTrans
 // where A is an n×n triangular matrix, and x is a vector.
 func (Implementation) Strmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float32, lda int, x []float32, incX int) {
-	// declared at cblas.h:181:6 void cblas_strmv ...
+	// declared at cblas.h:191:6 void cblas_strmv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Strmv", ul, tA, d, n, lda, incX)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -1671,7 +2149,7 @@ func (Implementation) Strmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Strmv", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -1679,7 +2157,7 @@ func (Implementation) Strmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Strmv", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -1687,16 +2165,16 @@ func (Implementation) Strmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Strmv", Param: "d", Message: badDiag})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Strmv", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Strmv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Strmv", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -1706,10 +2184,10 @@ func (Implementation) Strmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Strmv", Param: "a", Message: shortA})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Strmv", Param: "x", Message: shortX})
 	}
 	var _a *float32
 	if len(a) > 0 {
@@ -1719,7 +2197,16 @@ func (Implementation) Strmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_strmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(n), (*C.float)(_a), C.int(lda), (*C.float)(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Strmv", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Strmv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Strmv", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_strmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(n), (*C.float)(_a), C.blasint(lda), (*C.float)(_x), C.blasint(incX))
 }
 
 // Stbmv performs one of the matrix-vector operations
@@ -1727,8 +2214,11 @@ func (Implementation) Strmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 //  x = Aᵀ * x  if tA == blas.Trans or blas.ConjTrans
 // where A is an n×n triangular band matrix with k+1 diagonals, and x is a vector.
 func (Implementation) Stbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []float32, lda int, x []float32, incX int) {
-	// declared at cblas.h:185:6 void cblas_stbmv ...
+	// declared at cblas.h:195:6 void cblas_stbmv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Stbmv", ul, tA, d, n, k, lda, incX)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -1737,7 +2227,7 @@ func (Implementation) Stbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Stbmv", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -1745,7 +2235,7 @@ func (Implementation) Stbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Stbmv", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -1753,19 +2243,19 @@ func (Implementation) Stbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Stbmv", Param: "d", Message: badDiag})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Stbmv", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Stbmv", Param: "k", Message: kLT0})
 	}
 	if lda < k+1 {
-		panic(badLdA)
+		panic(Error{Routine: "Stbmv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Stbmv", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -1775,10 +2265,10 @@ func (Implementation) Stbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+k+1 {
-		panic(shortA)
+		panic(Error{Routine: "Stbmv", Param: "a", Message: shortA})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Stbmv", Param: "x", Message: shortX})
 	}
 	var _a *float32
 	if len(a) > 0 {
@@ -1788,7 +2278,19 @@ func (Implementation) Stbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_stbmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(n), C.int(k), (*C.float)(_a), C.int(lda), (*C.float)(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Stbmv", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Stbmv", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Stbmv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Stbmv", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_stbmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(n), C.blasint(k), (*C.float)(_a), C.blasint(lda), (*C.float)(_x), C.blasint(incX))
 }
 
 // Stpmv performs one of the matrix-vector operations
@@ -1796,8 +2298,11 @@ func (Implementation) Stbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 //  x = Aᵀ * x  if tA == blas.Trans or blas.ConjTrans
 // where A is an n×n triangular matrix in packed format, and x is a vector.
 func (Implementation) Stpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap, x []float32, incX int) {
-	// declared at cblas.h:189:6 void cblas_stpmv ...
+	// declared at cblas.h:199:6 void cblas_stpmv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Stpmv", ul, tA, d, n, incX)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -1806,7 +2311,7 @@ func (Implementation) Stpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Stpmv", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -1814,7 +2319,7 @@ func (Implementation) Stpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Stpmv", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -1822,13 +2327,13 @@ func (Implementation) Stpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Stpmv", Param: "d", Message: badDiag})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Stpmv", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Stpmv", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -1838,10 +2343,10 @@ func (Implementation) Stpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(ap) < n*(n+1)/2 {
-		panic(shortAP)
+		panic(Error{Routine: "Stpmv", Param: "ap", Message: shortAP})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Stpmv", Param: "x", Message: shortX})
 	}
 	var _ap *float32
 	if len(ap) > 0 {
@@ -1851,7 +2356,13 @@ func (Implementation) Stpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_stpmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(n), (*C.float)(_ap), (*C.float)(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Stpmv", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Stpmv", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_stpmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(n), (*C.float)(_ap), (*C.float)(_x), C.blasint(incX))
 }
 
 // Strsv solves one of the systems of equations
@@ -1865,8 +2376,11 @@ func (Implementation) Stpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 // No test for singularity or near-singularity is included in this
 // routine. Such tests must be performed before calling this routine.
 func (Implementation) Strsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float32, lda int, x []float32, incX int) {
-	// declared at cblas.h:192:6 void cblas_strsv ...
+	// declared at cblas.h:202:6 void cblas_strsv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Strsv", ul, tA, d, n, lda, incX)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -1875,7 +2389,7 @@ func (Implementation) Strsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Strsv", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -1883,7 +2397,7 @@ func (Implementation) Strsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Strsv", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -1891,16 +2405,16 @@ func (Implementation) Strsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Strsv", Param: "d", Message: badDiag})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Strsv", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Strsv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Strsv", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -1910,10 +2424,10 @@ func (Implementation) Strsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Strsv", Param: "a", Message: shortA})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Strsv", Param: "x", Message: shortX})
 	}
 	var _a *float32
 	if len(a) > 0 {
@@ -1923,7 +2437,16 @@ func (Implementation) Strsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_strsv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(n), (*C.float)(_a), C.int(lda), (*C.float)(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Strsv", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Strsv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Strsv", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_strsv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(n), (*C.float)(_a), C.blasint(lda), (*C.float)(_x), C.blasint(incX))
 }
 
 // Stbsv solves one of the systems of equations
@@ -1938,8 +2461,11 @@ func (Implementation) Strsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 // No test for singularity or near-singularity is included in this
 // routine. Such tests must be performed before calling this routine.
 func (Implementation) Stbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []float32, lda int, x []float32, incX int) {
-	// declared at cblas.h:196:6 void cblas_stbsv ...
+	// declared at cblas.h:206:6 void cblas_stbsv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Stbsv", ul, tA, d, n, k, lda, incX)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -1948,7 +2474,7 @@ func (Implementation) Stbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Stbsv", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -1956,7 +2482,7 @@ func (Implementation) Stbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Stbsv", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -1964,19 +2490,19 @@ func (Implementation) Stbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Stbsv", Param: "d", Message: badDiag})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Stbsv", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Stbsv", Param: "k", Message: kLT0})
 	}
 	if lda < k+1 {
-		panic(badLdA)
+		panic(Error{Routine: "Stbsv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Stbsv", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -1986,10 +2512,10 @@ func (Implementation) Stbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+k+1 {
-		panic(shortA)
+		panic(Error{Routine: "Stbsv", Param: "a", Message: shortA})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Stbsv", Param: "x", Message: shortX})
 	}
 	var _a *float32
 	if len(a) > 0 {
@@ -1999,7 +2525,19 @@ func (Implementation) Stbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_stbsv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(n), C.int(k), (*C.float)(_a), C.int(lda), (*C.float)(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Stbsv", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Stbsv", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Stbsv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Stbsv", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_stbsv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(n), C.blasint(k), (*C.float)(_a), C.blasint(lda), (*C.float)(_x), C.blasint(incX))
 }
 
 // Stpsv solves one of the systems of equations
@@ -2013,8 +2551,11 @@ func (Implementation) Stbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 // No test for singularity or near-singularity is included in this
 // routine. Such tests must be performed before calling this routine.
 func (Implementation) Stpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap, x []float32, incX int) {
-	// declared at cblas.h:200:6 void cblas_stpsv ...
+	// declared at cblas.h:210:6 void cblas_stpsv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Stpsv", ul, tA, d, n, incX)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -2023,7 +2564,7 @@ func (Implementation) Stpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Stpsv", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -2031,7 +2572,7 @@ func (Implementation) Stpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Stpsv", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -2039,13 +2580,13 @@ func (Implementation) Stpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Stpsv", Param: "d", Message: badDiag})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Stpsv", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Stpsv", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -2055,10 +2596,10 @@ func (Implementation) Stpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(ap) < n*(n+1)/2 {
-		panic(shortAP)
+		panic(Error{Routine: "Stpsv", Param: "ap", Message: shortAP})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Stpsv", Param: "x", Message: shortX})
 	}
 	var _ap *float32
 	if len(ap) > 0 {
@@ -2068,7 +2609,13 @@ func (Implementation) Stpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_stpsv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(n), (*C.float)(_ap), (*C.float)(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Stpsv", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Stpsv", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_stpsv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(n), (*C.float)(_ap), (*C.float)(_x), C.blasint(incX))
 }
 
 // Dgemv computes
@@ -2076,8 +2623,11 @@ func (Implementation) Stpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 //  y = alpha * Aᵀ * x + beta * y  if tA = blas.Trans or blas.ConjTrans
 // where A is an m×n dense matrix, x and y are vectors, and alpha and beta are scalars.
 func (Implementation) Dgemv(tA blas.Transpose, m, n int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
-	// declared at cblas.h:204:6 void cblas_dgemv ...
+	// declared at cblas.h:214:6 void cblas_dgemv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dgemv", tA, m, n, alpha, lda, incX, beta, incY)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -2086,22 +2636,22 @@ func (Implementation) Dgemv(tA blas.Transpose, m, n int, alpha float64, a []floa
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Dgemv", Param: "tA", Message: badTranspose})
 	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Dgemv", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dgemv", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Dgemv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Dgemv", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Dgemv", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -2111,7 +2661,7 @@ func (Implementation) Dgemv(tA blas.Transpose, m, n int, alpha float64, a []floa
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(m-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Dgemv", Param: "a", Message: shortA})
 	}
 	var lenX, lenY int
 	if tA == C.CblasNoTrans {
@@ -2120,10 +2670,10 @@ func (Implementation) Dgemv(tA blas.Transpose, m, n int, alpha float64, a []floa
 		lenX, lenY = m, n
 	}
 	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Dgemv", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Dgemv", Param: "y", Message: shortY})
 	}
 	var _a *float64
 	if len(a) > 0 {
@@ -2137,7 +2687,22 @@ func (Implementation) Dgemv(tA blas.Transpose, m, n int, alpha float64, a []floa
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_dgemv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_TRANSPOSE(tA), C.int(m), C.int(n), C.double(alpha), (*C.double)(_a), C.int(lda), (*C.double)(_x), C.int(incX), C.double(beta), (*C.double)(_y), C.int(incY))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Dgemv", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dgemv", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Dgemv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Dgemv", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Dgemv", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_dgemv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_TRANSPOSE(tA), C.blasint(m), C.blasint(n), C.double(alpha), (*C.double)(_a), C.blasint(lda), (*C.double)(_x), C.blasint(incX), C.double(beta), (*C.double)(_y), C.blasint(incY))
 }
 
 // Dgbmv performs one of the matrix-vector operations
@@ -2146,8 +2711,11 @@ func (Implementation) Dgemv(tA blas.Transpose, m, n int, alpha float64, a []floa
 // where A is an m×n band matrix with kL sub-diagonals and kU super-diagonals,
 // x and y are vectors, and alpha and beta are scalars.
 func (Implementation) Dgbmv(tA blas.Transpose, m, n, kL, kU int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
-	// declared at cblas.h:209:6 void cblas_dgbmv ...
+	// declared at cblas.h:219:6 void cblas_dgbmv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dgbmv", tA, m, n, kL, kU, alpha, lda, incX, beta, incY)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -2156,28 +2724,28 @@ func (Implementation) Dgbmv(tA blas.Transpose, m, n, kL, kU int, alpha float64,
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Dgbmv", Param: "tA", Message: badTranspose})
 	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Dgbmv", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dgbmv", Param: "n", Message: nLT0})
 	}
 	if kL < 0 {
-		panic(kLLT0)
+		panic(Error{Routine: "Dgbmv", Param: "kL", Message: kLLT0})
 	}
 	if kU < 0 {
-		panic(kULT0)
+		panic(Error{Routine: "Dgbmv", Param: "kU", Message: kULT0})
 	}
 	if lda < kL+kU+1 {
-		panic(badLdA)
+		panic(Error{Routine: "Dgbmv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Dgbmv", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Dgbmv", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -2187,7 +2755,7 @@ func (Implementation) Dgbmv(tA blas.Transpose, m, n, kL, kU int, alpha float64,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(min(m, n+kL)-1)+kL+kU+1 {
-		panic(shortA)
+		panic(Error{Routine: "Dgbmv", Param: "a", Message: shortA})
 	}
 	var lenX, lenY int
 	if tA == C.CblasNoTrans {
@@ -2196,10 +2764,10 @@ func (Implementation) Dgbmv(tA blas.Transpose, m, n, kL, kU int, alpha float64,
 		lenX, lenY = m, n
 	}
 	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Dgbmv", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Dgbmv", Param: "y", Message: shortY})
 	}
 	var _a *float64
 	if len(a) > 0 {
@@ -2213,7 +2781,28 @@ func (Implementation) Dgbmv(tA blas.Transpose, m, n, kL, kU int, alpha float64,
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_dgbmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_TRANSPOSE(tA), C.int(m), C.int(n), C.int(kL), C.int(kU), C.double(alpha), (*C.double)(_a), C.int(lda), (*C.double)(_x), C.int(incX), C.double(beta), (*C.double)(_y), C.int(incY))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Dgbmv", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dgbmv", Param: "n", Message: nTooLarge})
+	}
+	if kL < minInt || kL > maxInt {
+		panic(Error{Routine: "Dgbmv", Param: "kL", Message: kLTooLarge})
+	}
+	if kU < minInt || kU > maxInt {
+		panic(Error{Routine: "Dgbmv", Param: "kU", Message: kUTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Dgbmv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Dgbmv", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Dgbmv", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_dgbmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_TRANSPOSE(tA), C.blasint(m), C.blasint(n), C.blasint(kL), C.blasint(kU), C.double(alpha), (*C.double)(_a), C.blasint(lda), (*C.double)(_x), C.blasint(incX), C.double(beta), (*C.double)(_y), C.blasint(incY))
 }
 
 // Dtrmv performs one of the matrix-vector operations
@@ -2221,8 +2810,11 @@ func (Implementation) Dgbmv(tA blas.Transpose, m, n, kL, kU int, alpha float64,
 //  x = Aᵀ * x  if tA == blas.Trans or blas.ConjTrans
 // where A is an n×n triangular matrix, and x is a vector.
 func (Implementation) Dtrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float64, lda int, x []float64, incX int) {
-	// declared at cblas.h:214:6 void cblas_dtrmv ...
+	// declared at cblas.h:224:6 void cblas_dtrmv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dtrmv", ul, tA, d, n, lda, incX)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -2231,7 +2823,7 @@ func (Implementation) Dtrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Dtrmv", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -2239,7 +2831,7 @@ func (Implementation) Dtrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Dtrmv", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -2247,16 +2839,16 @@ func (Implementation) Dtrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Dtrmv", Param: "d", Message: badDiag})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dtrmv", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Dtrmv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Dtrmv", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -2266,10 +2858,10 @@ func (Implementation) Dtrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Dtrmv", Param: "a", Message: shortA})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Dtrmv", Param: "x", Message: shortX})
 	}
 	var _a *float64
 	if len(a) > 0 {
@@ -2279,7 +2871,16 @@ func (Implementation) Dtrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_dtrmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(n), (*C.double)(_a), C.int(lda), (*C.double)(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dtrmv", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Dtrmv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Dtrmv", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_dtrmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(n), (*C.double)(_a), C.blasint(lda), (*C.double)(_x), C.blasint(incX))
 }
 
 // Dtbmv performs one of the matrix-vector operations
@@ -2287,8 +2888,11 @@ func (Implementation) Dtrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 //  x = Aᵀ * x  if tA == blas.Trans or blas.ConjTrans
 // where A is an n×n triangular band matrix with k+1 diagonals, and x is a vector.
 func (Implementation) Dtbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []float64, lda int, x []float64, incX int) {
-	// declared at cblas.h:218:6 void cblas_dtbmv ...
+	// declared at cblas.h:228:6 void cblas_dtbmv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dtbmv", ul, tA, d, n, k, lda, incX)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -2297,7 +2901,7 @@ func (Implementation) Dtbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Dtbmv", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -2305,7 +2909,7 @@ func (Implementation) Dtbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Dtbmv", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -2313,19 +2917,19 @@ func (Implementation) Dtbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Dtbmv", Param: "d", Message: badDiag})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dtbmv", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Dtbmv", Param: "k", Message: kLT0})
 	}
 	if lda < k+1 {
-		panic(badLdA)
+		panic(Error{Routine: "Dtbmv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Dtbmv", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -2335,10 +2939,10 @@ func (Implementation) Dtbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+k+1 {
-		panic(shortA)
+		panic(Error{Routine: "Dtbmv", Param: "a", Message: shortA})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Dtbmv", Param: "x", Message: shortX})
 	}
 	var _a *float64
 	if len(a) > 0 {
@@ -2348,7 +2952,19 @@ func (Implementation) Dtbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_dtbmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(n), C.int(k), (*C.double)(_a), C.int(lda), (*C.double)(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dtbmv", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Dtbmv", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Dtbmv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Dtbmv", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_dtbmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(n), C.blasint(k), (*C.double)(_a), C.blasint(lda), (*C.double)(_x), C.blasint(incX))
 }
 
 // Dtpmv performs one of the matrix-vector operations
@@ -2356,8 +2972,11 @@ func (Implementation) Dtbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 //  x = Aᵀ * x  if tA == blas.Trans or blas.ConjTrans
 // where A is an n×n triangular matrix in packed format, and x is a vector.
 func (Implementation) Dtpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap, x []float64, incX int) {
-	// declared at cblas.h:222:6 void cblas_dtpmv ...
+	// declared at cblas.h:232:6 void cblas_dtpmv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dtpmv", ul, tA, d, n, incX)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -2366,7 +2985,7 @@ func (Implementation) Dtpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Dtpmv", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -2374,7 +2993,7 @@ func (Implementation) Dtpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Dtpmv", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -2382,13 +3001,13 @@ func (Implementation) Dtpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Dtpmv", Param: "d", Message: badDiag})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dtpmv", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Dtpmv", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -2398,10 +3017,10 @@ func (Implementation) Dtpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(ap) < n*(n+1)/2 {
-		panic(shortAP)
+		panic(Error{Routine: "Dtpmv", Param: "ap", Message: shortAP})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Dtpmv", Param: "x", Message: shortX})
 	}
 	var _ap *float64
 	if len(ap) > 0 {
@@ -2411,7 +3030,13 @@ func (Implementation) Dtpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_dtpmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(n), (*C.double)(_ap), (*C.double)(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dtpmv", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Dtpmv", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_dtpmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(n), (*C.double)(_ap), (*C.double)(_x), C.blasint(incX))
 }
 
 // Dtrsv solves one of the systems of equations
@@ -2425,8 +3050,11 @@ func (Implementation) Dtpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 // No test for singularity or near-singularity is included in this
 // routine. Such tests must be performed before calling this routine.
 func (Implementation) Dtrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []float64, lda int, x []float64, incX int) {
-	// declared at cblas.h:225:6 void cblas_dtrsv ...
+	// declared at cblas.h:235:6 void cblas_dtrsv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dtrsv", ul, tA, d, n, lda, incX)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -2435,7 +3063,7 @@ func (Implementation) Dtrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Dtrsv", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -2443,7 +3071,7 @@ func (Implementation) Dtrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Dtrsv", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -2451,16 +3079,16 @@ func (Implementation) Dtrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Dtrsv", Param: "d", Message: badDiag})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dtrsv", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Dtrsv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Dtrsv", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -2470,10 +3098,10 @@ func (Implementation) Dtrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Dtrsv", Param: "a", Message: shortA})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Dtrsv", Param: "x", Message: shortX})
 	}
 	var _a *float64
 	if len(a) > 0 {
@@ -2483,7 +3111,16 @@ func (Implementation) Dtrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_dtrsv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(n), (*C.double)(_a), C.int(lda), (*C.double)(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dtrsv", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Dtrsv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Dtrsv", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_dtrsv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(n), (*C.double)(_a), C.blasint(lda), (*C.double)(_x), C.blasint(incX))
 }
 
 // Dtbsv solves one of the systems of equations
@@ -2498,8 +3135,11 @@ func (Implementation) Dtrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 // No test for singularity or near-singularity is included in this
 // routine. Such tests must be performed before calling this routine.
 func (Implementation) Dtbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []float64, lda int, x []float64, incX int) {
-	// declared at cblas.h:229:6 void cblas_dtbsv ...
+	// declared at cblas.h:239:6 void cblas_dtbsv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dtbsv", ul, tA, d, n, k, lda, incX)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -2508,7 +3148,7 @@ func (Implementation) Dtbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Dtbsv", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -2516,7 +3156,7 @@ func (Implementation) Dtbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Dtbsv", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -2524,19 +3164,19 @@ func (Implementation) Dtbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Dtbsv", Param: "d", Message: badDiag})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dtbsv", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Dtbsv", Param: "k", Message: kLT0})
 	}
 	if lda < k+1 {
-		panic(badLdA)
+		panic(Error{Routine: "Dtbsv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Dtbsv", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -2546,10 +3186,10 @@ func (Implementation) Dtbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+k+1 {
-		panic(shortA)
+		panic(Error{Routine: "Dtbsv", Param: "a", Message: shortA})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Dtbsv", Param: "x", Message: shortX})
 	}
 	var _a *float64
 	if len(a) > 0 {
@@ -2559,7 +3199,19 @@ func (Implementation) Dtbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_dtbsv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(n), C.int(k), (*C.double)(_a), C.int(lda), (*C.double)(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dtbsv", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Dtbsv", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Dtbsv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Dtbsv", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_dtbsv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(n), C.blasint(k), (*C.double)(_a), C.blasint(lda), (*C.double)(_x), C.blasint(incX))
 }
 
 // Dtpsv solves one of the systems of equations
@@ -2573,8 +3225,11 @@ func (Implementation) Dtbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 // No test for singularity or near-singularity is included in this
 // routine. Such tests must be performed before calling this routine.
 func (Implementation) Dtpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap, x []float64, incX int) {
-	// declared at cblas.h:233:6 void cblas_dtpsv ...
+	// declared at cblas.h:243:6 void cblas_dtpsv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dtpsv", ul, tA, d, n, incX)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -2583,7 +3238,7 @@ func (Implementation) Dtpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Dtpsv", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -2591,7 +3246,7 @@ func (Implementation) Dtpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Dtpsv", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -2599,13 +3254,13 @@ func (Implementation) Dtpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Dtpsv", Param: "d", Message: badDiag})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dtpsv", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Dtpsv", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -2615,10 +3270,10 @@ func (Implementation) Dtpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(ap) < n*(n+1)/2 {
-		panic(shortAP)
+		panic(Error{Routine: "Dtpsv", Param: "ap", Message: shortAP})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Dtpsv", Param: "x", Message: shortX})
 	}
 	var _ap *float64
 	if len(ap) > 0 {
@@ -2628,7 +3283,13 @@ func (Implementation) Dtpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_dtpsv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(n), (*C.double)(_ap), (*C.double)(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dtpsv", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Dtpsv", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_dtpsv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(n), (*C.double)(_ap), (*C.double)(_x), C.blasint(incX))
 }
 
 // Cgemv performs one of the matrix-vector operations
@@ -2639,8 +3300,11 @@ func (Implementation) Dtpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Cgemv(tA blas.Transpose, m, n int, alpha complex64, a []complex64, lda int, x []complex64, incX int, beta complex64, y []complex64, incY int) {
-	// declared at cblas.h:237:6 void cblas_cgemv ...
+	// declared at cblas.h:247:6 void cblas_cgemv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Cgemv", tA, m, n, alpha, lda, incX, beta, incY)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -2649,22 +3313,22 @@ func (Implementation) Cgemv(tA blas.Transpose, m, n int, alpha complex64, a []co
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Cgemv", Param: "tA", Message: badTranspose})
 	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Cgemv", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Cgemv", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Cgemv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Cgemv", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Cgemv", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -2674,7 +3338,7 @@ func (Implementation) Cgemv(tA blas.Transpose, m, n int, alpha complex64, a []co
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(m-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Cgemv", Param: "a", Message: shortA})
 	}
 	var lenX, lenY int
 	if tA == C.CblasNoTrans {
@@ -2683,10 +3347,10 @@ func (Implementation) Cgemv(tA blas.Transpose, m, n int, alpha complex64, a []co
 		lenX, lenY = m, n
 	}
 	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Cgemv", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Cgemv", Param: "y", Message: shortY})
 	}
 	var _a *complex64
 	if len(a) > 0 {
@@ -2700,7 +3364,22 @@ func (Implementation) Cgemv(tA blas.Transpose, m, n int, alpha complex64, a []co
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_cgemv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_TRANSPOSE(tA), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.int(incY))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Cgemv", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Cgemv", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Cgemv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Cgemv", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Cgemv", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_cgemv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_TRANSPOSE(tA), C.blasint(m), C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.blasint(incY))
 }
 
 // Cgbmv performs one of the matrix-vector operations
@@ -2712,8 +3391,11 @@ func (Implementation) Cgemv(tA blas.Transpose, m, n int, alpha complex64, a []co
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Cgbmv(tA blas.Transpose, m, n, kL, kU int, alpha complex64, a []complex64, lda int, x []complex64, incX int, beta complex64, y []complex64, incY int) {
-	// declared at cblas.h:242:6 void cblas_cgbmv ...
+	// declared at cblas.h:252:6 void cblas_cgbmv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Cgbmv", tA, m, n, kL, kU, alpha, lda, incX, beta, incY)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -2722,28 +3404,28 @@ func (Implementation) Cgbmv(tA blas.Transpose, m, n, kL, kU int, alpha complex64
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Cgbmv", Param: "tA", Message: badTranspose})
 	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Cgbmv", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Cgbmv", Param: "n", Message: nLT0})
 	}
 	if kL < 0 {
-		panic(kLLT0)
+		panic(Error{Routine: "Cgbmv", Param: "kL", Message: kLLT0})
 	}
 	if kU < 0 {
-		panic(kULT0)
+		panic(Error{Routine: "Cgbmv", Param: "kU", Message: kULT0})
 	}
 	if lda < kL+kU+1 {
-		panic(badLdA)
+		panic(Error{Routine: "Cgbmv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Cgbmv", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Cgbmv", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -2753,7 +3435,7 @@ func (Implementation) Cgbmv(tA blas.Transpose, m, n, kL, kU int, alpha complex64
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(min(m, n+kL)-1)+kL+kU+1 {
-		panic(shortA)
+		panic(Error{Routine: "Cgbmv", Param: "a", Message: shortA})
 	}
 	var lenX, lenY int
 	if tA == C.CblasNoTrans {
@@ -2762,10 +3444,10 @@ func (Implementation) Cgbmv(tA blas.Transpose, m, n, kL, kU int, alpha complex64
 		lenX, lenY = m, n
 	}
 	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Cgbmv", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Cgbmv", Param: "y", Message: shortY})
 	}
 	var _a *complex64
 	if len(a) > 0 {
@@ -2779,7 +3461,28 @@ func (Implementation) Cgbmv(tA blas.Transpose, m, n, kL, kU int, alpha complex64
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_cgbmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_TRANSPOSE(tA), C.int(m), C.int(n), C.int(kL), C.int(kU), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.int(incY))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Cgbmv", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Cgbmv", Param: "n", Message: nTooLarge})
+	}
+	if kL < minInt || kL > maxInt {
+		panic(Error{Routine: "Cgbmv", Param: "kL", Message: kLTooLarge})
+	}
+	if kU < minInt || kU > maxInt {
+		panic(Error{Routine: "Cgbmv", Param: "kU", Message: kUTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Cgbmv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Cgbmv", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Cgbmv", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_cgbmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_TRANSPOSE(tA), C.blasint(m), C.blasint(n), C.blasint(kL), C.blasint(kU), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.blasint(incY))
 }
 
 // Ctrmv performs one of the matrix-vector operations
@@ -2790,8 +3493,11 @@ func (Implementation) Cgbmv(tA blas.Transpose, m, n, kL, kU int, alpha complex64
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Ctrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []complex64, lda int, x []complex64, incX int) {
-	// declared at cblas.h:247:6 void cblas_ctrmv ...
+	// declared at cblas.h:257:6 void cblas_ctrmv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Ctrmv", ul, tA, d, n, lda, incX)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -2800,7 +3506,7 @@ func (Implementation) Ctrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Ctrmv", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -2808,7 +3514,7 @@ func (Implementation) Ctrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Ctrmv", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -2816,16 +3522,16 @@ func (Implementation) Ctrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Ctrmv", Param: "d", Message: badDiag})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Ctrmv", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Ctrmv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Ctrmv", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -2835,10 +3541,10 @@ func (Implementation) Ctrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Ctrmv", Param: "a", Message: shortA})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Ctrmv", Param: "x", Message: shortX})
 	}
 	var _a *complex64
 	if len(a) > 0 {
@@ -2848,7 +3554,16 @@ func (Implementation) Ctrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_ctrmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(n), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Ctrmv", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Ctrmv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Ctrmv", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_ctrmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(n), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_x), C.blasint(incX))
 }
 
 // Ctbmv performs one of the matrix-vector operations
@@ -2860,8 +3575,11 @@ func (Implementation) Ctrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Ctbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []complex64, lda int, x []complex64, incX int) {
-	// declared at cblas.h:251:6 void cblas_ctbmv ...
+	// declared at cblas.h:261:6 void cblas_ctbmv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Ctbmv", ul, tA, d, n, k, lda, incX)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -2870,7 +3588,7 @@ func (Implementation) Ctbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Ctbmv", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -2878,7 +3596,7 @@ func (Implementation) Ctbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Ctbmv", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -2886,19 +3604,19 @@ func (Implementation) Ctbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Ctbmv", Param: "d", Message: badDiag})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Ctbmv", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Ctbmv", Param: "k", Message: kLT0})
 	}
 	if lda < k+1 {
-		panic(badLdA)
+		panic(Error{Routine: "Ctbmv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Ctbmv", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -2908,10 +3626,10 @@ func (Implementation) Ctbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+k+1 {
-		panic(shortA)
+		panic(Error{Routine: "Ctbmv", Param: "a", Message: shortA})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Ctbmv", Param: "x", Message: shortX})
 	}
 	var _a *complex64
 	if len(a) > 0 {
@@ -2921,7 +3639,19 @@ func (Implementation) Ctbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_ctbmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(n), C.int(k), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Ctbmv", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Ctbmv", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Ctbmv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Ctbmv", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_ctbmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(n), C.blasint(k), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_x), C.blasint(incX))
 }
 
 // Ctpmv performs one of the matrix-vector operations
@@ -2933,8 +3663,11 @@ func (Implementation) Ctbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Ctpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap, x []complex64, incX int) {
-	// declared at cblas.h:255:6 void cblas_ctpmv ...
+	// declared at cblas.h:265:6 void cblas_ctpmv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Ctpmv", ul, tA, d, n, incX)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -2943,7 +3676,7 @@ func (Implementation) Ctpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Ctpmv", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -2951,7 +3684,7 @@ func (Implementation) Ctpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Ctpmv", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -2959,13 +3692,13 @@ func (Implementation) Ctpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Ctpmv", Param: "d", Message: badDiag})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Ctpmv", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Ctpmv", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -2975,10 +3708,10 @@ func (Implementation) Ctpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(ap) < n*(n+1)/2 {
-		panic(shortAP)
+		panic(Error{Routine: "Ctpmv", Param: "ap", Message: shortAP})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Ctpmv", Param: "x", Message: shortX})
 	}
 	var _ap *complex64
 	if len(ap) > 0 {
@@ -2988,7 +3721,13 @@ func (Implementation) Ctpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_ctpmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(n), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Ctpmv", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Ctpmv", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_ctpmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(n), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.blasint(incX))
 }
 
 // Ctrsv solves one of the systems of equations
@@ -3005,8 +3744,11 @@ func (Implementation) Ctpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Ctrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []complex64, lda int, x []complex64, incX int) {
-	// declared at cblas.h:258:6 void cblas_ctrsv ...
+	// declared at cblas.h:268:6 void cblas_ctrsv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Ctrsv", ul, tA, d, n, lda, incX)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -3015,7 +3757,7 @@ func (Implementation) Ctrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Ctrsv", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -3023,7 +3765,7 @@ func (Implementation) Ctrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Ctrsv", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -3031,16 +3773,16 @@ func (Implementation) Ctrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Ctrsv", Param: "d", Message: badDiag})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Ctrsv", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Ctrsv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Ctrsv", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -3050,10 +3792,10 @@ func (Implementation) Ctrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Ctrsv", Param: "a", Message: shortA})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Ctrsv", Param: "x", Message: shortX})
 	}
 	var _a *complex64
 	if len(a) > 0 {
@@ -3063,7 +3805,16 @@ func (Implementation) Ctrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_ctrsv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(n), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Ctrsv", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Ctrsv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Ctrsv", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_ctrsv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(n), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_x), C.blasint(incX))
 }
 
 // Ctbsv solves one of the systems of equations
@@ -3081,8 +3832,11 @@ func (Implementation) Ctrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Ctbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []complex64, lda int, x []complex64, incX int) {
-	// declared at cblas.h:262:6 void cblas_ctbsv ...
+	// declared at cblas.h:272:6 void cblas_ctbsv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Ctbsv", ul, tA, d, n, k, lda, incX)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -3091,7 +3845,7 @@ func (Implementation) Ctbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Ctbsv", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -3099,7 +3853,7 @@ func (Implementation) Ctbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Ctbsv", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -3107,19 +3861,19 @@ func (Implementation) Ctbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Ctbsv", Param: "d", Message: badDiag})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Ctbsv", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Ctbsv", Param: "k", Message: kLT0})
 	}
 	if lda < k+1 {
-		panic(badLdA)
+		panic(Error{Routine: "Ctbsv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Ctbsv", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -3129,10 +3883,10 @@ func (Implementation) Ctbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+k+1 {
-		panic(shortA)
+		panic(Error{Routine: "Ctbsv", Param: "a", Message: shortA})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Ctbsv", Param: "x", Message: shortX})
 	}
 	var _a *complex64
 	if len(a) > 0 {
@@ -3142,7 +3896,19 @@ func (Implementation) Ctbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_ctbsv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(n), C.int(k), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Ctbsv", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Ctbsv", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Ctbsv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Ctbsv", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_ctbsv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(n), C.blasint(k), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_x), C.blasint(incX))
 }
 
 // Ctpsv solves one of the systems of equations
@@ -3160,8 +3926,11 @@ func (Implementation) Ctbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Ctpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap, x []complex64, incX int) {
-	// declared at cblas.h:266:6 void cblas_ctpsv ...
+	// declared at cblas.h:276:6 void cblas_ctpsv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Ctpsv", ul, tA, d, n, incX)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -3170,7 +3939,7 @@ func (Implementation) Ctpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Ctpsv", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -3178,7 +3947,7 @@ func (Implementation) Ctpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Ctpsv", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -3186,13 +3955,13 @@ func (Implementation) Ctpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Ctpsv", Param: "d", Message: badDiag})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Ctpsv", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Ctpsv", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -3202,10 +3971,10 @@ func (Implementation) Ctpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(ap) < n*(n+1)/2 {
-		panic(shortAP)
+		panic(Error{Routine: "Ctpsv", Param: "ap", Message: shortAP})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Ctpsv", Param: "x", Message: shortX})
 	}
 	var _ap *complex64
 	if len(ap) > 0 {
@@ -3215,7 +3984,13 @@ func (Implementation) Ctpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_ctpsv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(n), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Ctpsv", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Ctpsv", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_ctpsv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(n), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.blasint(incX))
 }
 
 // Zgemv performs one of the matrix-vector operations
@@ -3224,8 +3999,11 @@ func (Implementation) Ctpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 //  y = alpha * Aᴴ * x + beta * y  if trans = blas.ConjTrans
 // where alpha and beta are scalars, x and y are vectors, and A is an m×n dense matrix.
 func (Implementation) Zgemv(tA blas.Transpose, m, n int, alpha complex128, a []complex128, lda int, x []complex128, incX int, beta complex128, y []complex128, incY int) {
-	// declared at cblas.h:270:6 void cblas_zgemv ...
+	// declared at cblas.h:280:6 void cblas_zgemv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zgemv", tA, m, n, alpha, lda, incX, beta, incY)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -3234,22 +4012,22 @@ func (Implementation) Zgemv(tA blas.Transpose, m, n int, alpha complex128, a []c
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Zgemv", Param: "tA", Message: badTranspose})
 	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Zgemv", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Zgemv", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Zgemv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Zgemv", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Zgemv", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -3259,7 +4037,7 @@ func (Implementation) Zgemv(tA blas.Transpose, m, n int, alpha complex128, a []c
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(m-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Zgemv", Param: "a", Message: shortA})
 	}
 	var lenX, lenY int
 	if tA == C.CblasNoTrans {
@@ -3268,10 +4046,10 @@ func (Implementation) Zgemv(tA blas.Transpose, m, n int, alpha complex128, a []c
 		lenX, lenY = m, n
 	}
 	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Zgemv", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Zgemv", Param: "y", Message: shortY})
 	}
 	var _a *complex128
 	if len(a) > 0 {
@@ -3285,7 +4063,22 @@ func (Implementation) Zgemv(tA blas.Transpose, m, n int, alpha complex128, a []c
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_zgemv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_TRANSPOSE(tA), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.int(incY))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Zgemv", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Zgemv", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Zgemv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Zgemv", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Zgemv", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_zgemv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_TRANSPOSE(tA), C.blasint(m), C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.blasint(incY))
 }
 
 // Zgbmv performs one of the matrix-vector operations
@@ -3295,8 +4088,11 @@ func (Implementation) Zgemv(tA blas.Transpose, m, n int, alpha complex128, a []c
 // where alpha and beta are scalars, x and y are vectors, and A is an m×n band matrix
 // with kL sub-diagonals and kU super-diagonals.
 func (Implementation) Zgbmv(tA blas.Transpose, m, n, kL, kU int, alpha complex128, a []complex128, lda int, x []complex128, incX int, beta complex128, y []complex128, incY int) {
-	// declared at cblas.h:275:6 void cblas_zgbmv ...
+	// declared at cblas.h:285:6 void cblas_zgbmv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zgbmv", tA, m, n, kL, kU, alpha, lda, incX, beta, incY)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -3305,28 +4101,28 @@ func (Implementation) Zgbmv(tA blas.Transpose, m, n, kL, kU int, alpha complex12
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Zgbmv", Param: "tA", Message: badTranspose})
 	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Zgbmv", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Zgbmv", Param: "n", Message: nLT0})
 	}
 	if kL < 0 {
-		panic(kLLT0)
+		panic(Error{Routine: "Zgbmv", Param: "kL", Message: kLLT0})
 	}
 	if kU < 0 {
-		panic(kULT0)
+		panic(Error{Routine: "Zgbmv", Param: "kU", Message: kULT0})
 	}
 	if lda < kL+kU+1 {
-		panic(badLdA)
+		panic(Error{Routine: "Zgbmv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Zgbmv", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Zgbmv", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -3336,7 +4132,7 @@ func (Implementation) Zgbmv(tA blas.Transpose, m, n, kL, kU int, alpha complex12
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(min(m, n+kL)-1)+kL+kU+1 {
-		panic(shortA)
+		panic(Error{Routine: "Zgbmv", Param: "a", Message: shortA})
 	}
 	var lenX, lenY int
 	if tA == C.CblasNoTrans {
@@ -3345,10 +4141,10 @@ func (Implementation) Zgbmv(tA blas.Transpose, m, n, kL, kU int, alpha complex12
 		lenX, lenY = m, n
 	}
 	if (incX > 0 && len(x) <= (lenX-1)*incX) || (incX < 0 && len(x) <= (1-lenX)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Zgbmv", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (lenY-1)*incY) || (incY < 0 && len(y) <= (1-lenY)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Zgbmv", Param: "y", Message: shortY})
 	}
 	var _a *complex128
 	if len(a) > 0 {
@@ -3362,7 +4158,28 @@ func (Implementation) Zgbmv(tA blas.Transpose, m, n, kL, kU int, alpha complex12
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_zgbmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_TRANSPOSE(tA), C.int(m), C.int(n), C.int(kL), C.int(kU), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.int(incY))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Zgbmv", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Zgbmv", Param: "n", Message: nTooLarge})
+	}
+	if kL < minInt || kL > maxInt {
+		panic(Error{Routine: "Zgbmv", Param: "kL", Message: kLTooLarge})
+	}
+	if kU < minInt || kU > maxInt {
+		panic(Error{Routine: "Zgbmv", Param: "kU", Message: kUTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Zgbmv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Zgbmv", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Zgbmv", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_zgbmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_TRANSPOSE(tA), C.blasint(m), C.blasint(n), C.blasint(kL), C.blasint(kU), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.blasint(incY))
 }
 
 // Ztrmv performs one of the matrix-vector operations
@@ -3371,8 +4188,11 @@ func (Implementation) Zgbmv(tA blas.Transpose, m, n, kL, kU int, alpha complex12
 //  x = Aᴴ * x  if trans = blas.ConjTrans
 // where x is a vector, and A is an n×n triangular matrix.
 func (Implementation) Ztrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []complex128, lda int, x []complex128, incX int) {
-	// declared at cblas.h:280:6 void cblas_ztrmv ...
+	// declared at cblas.h:290:6 void cblas_ztrmv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Ztrmv", ul, tA, d, n, lda, incX)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -3381,7 +4201,7 @@ func (Implementation) Ztrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Ztrmv", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -3389,7 +4209,7 @@ func (Implementation) Ztrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Ztrmv", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -3397,16 +4217,16 @@ func (Implementation) Ztrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Ztrmv", Param: "d", Message: badDiag})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Ztrmv", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Ztrmv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Ztrmv", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -3416,10 +4236,10 @@ func (Implementation) Ztrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Ztrmv", Param: "a", Message: shortA})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Ztrmv", Param: "x", Message: shortX})
 	}
 	var _a *complex128
 	if len(a) > 0 {
@@ -3429,7 +4249,16 @@ func (Implementation) Ztrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_ztrmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(n), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Ztrmv", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Ztrmv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Ztrmv", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_ztrmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(n), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_x), C.blasint(incX))
 }
 
 // Ztbmv performs one of the matrix-vector operations
@@ -3439,8 +4268,11 @@ func (Implementation) Ztrmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 // where x is an n element vector and A is an n×n triangular band matrix, with
 // (k+1) diagonals.
 func (Implementation) Ztbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []complex128, lda int, x []complex128, incX int) {
-	// declared at cblas.h:284:6 void cblas_ztbmv ...
+	// declared at cblas.h:294:6 void cblas_ztbmv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Ztbmv", ul, tA, d, n, k, lda, incX)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -3449,7 +4281,7 @@ func (Implementation) Ztbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Ztbmv", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -3457,7 +4289,7 @@ func (Implementation) Ztbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Ztbmv", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -3465,19 +4297,19 @@ func (Implementation) Ztbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Ztbmv", Param: "d", Message: badDiag})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Ztbmv", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Ztbmv", Param: "k", Message: kLT0})
 	}
 	if lda < k+1 {
-		panic(badLdA)
+		panic(Error{Routine: "Ztbmv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Ztbmv", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -3487,10 +4319,10 @@ func (Implementation) Ztbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+k+1 {
-		panic(shortA)
+		panic(Error{Routine: "Ztbmv", Param: "a", Message: shortA})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Ztbmv", Param: "x", Message: shortX})
 	}
 	var _a *complex128
 	if len(a) > 0 {
@@ -3500,7 +4332,19 @@ func (Implementation) Ztbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_ztbmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(n), C.int(k), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Ztbmv", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Ztbmv", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Ztbmv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Ztbmv", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_ztbmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(n), C.blasint(k), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_x), C.blasint(incX))
 }
 
 // Ztpmv performs one of the matrix-vector operations
@@ -3510,8 +4354,11 @@ func (Implementation) Ztbmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 // where x is an n element vector and A is an n×n triangular matrix, supplied in
 // packed form.
 func (Implementation) Ztpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap, x []complex128, incX int) {
-	// declared at cblas.h:288:6 void cblas_ztpmv ...
+	// declared at cblas.h:298:6 void cblas_ztpmv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Ztpmv", ul, tA, d, n, incX)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -3520,7 +4367,7 @@ func (Implementation) Ztpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Ztpmv", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -3528,7 +4375,7 @@ func (Implementation) Ztpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Ztpmv", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -3536,13 +4383,13 @@ func (Implementation) Ztpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Ztpmv", Param: "d", Message: badDiag})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Ztpmv", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Ztpmv", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -3552,10 +4399,10 @@ func (Implementation) Ztpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(ap) < n*(n+1)/2 {
-		panic(shortAP)
+		panic(Error{Routine: "Ztpmv", Param: "ap", Message: shortAP})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Ztpmv", Param: "x", Message: shortX})
 	}
 	var _ap *complex128
 	if len(ap) > 0 {
@@ -3565,7 +4412,13 @@ func (Implementation) Ztpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_ztpmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(n), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Ztpmv", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Ztpmv", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_ztpmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(n), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.blasint(incX))
 }
 
 // Ztrsv solves one of the systems of equations
@@ -3580,8 +4433,11 @@ func (Implementation) Ztpmv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 // No test for singularity or near-singularity is included in this
 // routine. Such tests must be performed before calling this routine.
 func (Implementation) Ztrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, a []complex128, lda int, x []complex128, incX int) {
-	// declared at cblas.h:291:6 void cblas_ztrsv ...
+	// declared at cblas.h:301:6 void cblas_ztrsv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Ztrsv", ul, tA, d, n, lda, incX)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -3590,7 +4446,7 @@ func (Implementation) Ztrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Ztrsv", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -3598,7 +4454,7 @@ func (Implementation) Ztrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Ztrsv", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -3606,16 +4462,16 @@ func (Implementation) Ztrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Ztrsv", Param: "d", Message: badDiag})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Ztrsv", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Ztrsv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Ztrsv", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -3625,10 +4481,10 @@ func (Implementation) Ztrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Ztrsv", Param: "a", Message: shortA})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Ztrsv", Param: "x", Message: shortX})
 	}
 	var _a *complex128
 	if len(a) > 0 {
@@ -3638,7 +4494,16 @@ func (Implementation) Ztrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_ztrsv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(n), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Ztrsv", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Ztrsv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Ztrsv", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_ztrsv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(n), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_x), C.blasint(incX))
 }
 
 // Ztbsv solves one of the systems of equations
@@ -3654,8 +4519,11 @@ func (Implementation) Ztrsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 // No test for singularity or near-singularity is included in this
 // routine. Such tests must be performed before calling this routine.
 func (Implementation) Ztbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k int, a []complex128, lda int, x []complex128, incX int) {
-	// declared at cblas.h:295:6 void cblas_ztbsv ...
+	// declared at cblas.h:305:6 void cblas_ztbsv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Ztbsv", ul, tA, d, n, k, lda, incX)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -3664,7 +4532,7 @@ func (Implementation) Ztbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Ztbsv", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -3672,7 +4540,7 @@ func (Implementation) Ztbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Ztbsv", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -3680,19 +4548,19 @@ func (Implementation) Ztbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Ztbsv", Param: "d", Message: badDiag})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Ztbsv", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Ztbsv", Param: "k", Message: kLT0})
 	}
 	if lda < k+1 {
-		panic(badLdA)
+		panic(Error{Routine: "Ztbsv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Ztbsv", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -3702,10 +4570,10 @@ func (Implementation) Ztbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+k+1 {
-		panic(shortA)
+		panic(Error{Routine: "Ztbsv", Param: "a", Message: shortA})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Ztbsv", Param: "x", Message: shortX})
 	}
 	var _a *complex128
 	if len(a) > 0 {
@@ -3715,7 +4583,19 @@ func (Implementation) Ztbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_ztbsv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(n), C.int(k), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Ztbsv", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Ztbsv", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Ztbsv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Ztbsv", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_ztbsv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(n), C.blasint(k), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_x), C.blasint(incX))
 }
 
 // Ztpsv solves one of the systems of equations
@@ -3731,8 +4611,11 @@ func (Implementation) Ztbsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n, k i
 // No test for singularity or near-singularity is included in this
 // routine. Such tests must be performed before calling this routine.
 func (Implementation) Ztpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int, ap, x []complex128, incX int) {
-	// declared at cblas.h:299:6 void cblas_ztpsv ...
+	// declared at cblas.h:309:6 void cblas_ztpsv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Ztpsv", ul, tA, d, n, incX)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -3741,7 +4624,7 @@ func (Implementation) Ztpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Ztpsv", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -3749,7 +4632,7 @@ func (Implementation) Ztpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Ztpsv", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -3757,13 +4640,13 @@ func (Implementation) Ztpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Ztpsv", Param: "d", Message: badDiag})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Ztpsv", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Ztpsv", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -3773,10 +4656,10 @@ func (Implementation) Ztpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(ap) < n*(n+1)/2 {
-		panic(shortAP)
+		panic(Error{Routine: "Ztpsv", Param: "ap", Message: shortAP})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Ztpsv", Param: "x", Message: shortX})
 	}
 	var _ap *complex128
 	if len(ap) > 0 {
@@ -3786,7 +4669,13 @@ func (Implementation) Ztpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	C.cblas_ztpsv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(n), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.int(incX))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Ztpsv", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Ztpsv", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_ztpsv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(n), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.blasint(incX))
 }
 
 // Ssymv performs the matrix-vector operation
@@ -3794,27 +4683,30 @@ func (Implementation) Ztpsv(ul blas.Uplo, tA blas.Transpose, d blas.Diag, n int,
 // where A is an n×n symmetric matrix, x and y are vectors, and alpha and
 // beta are scalars.
 func (Implementation) Ssymv(ul blas.Uplo, n int, alpha float32, a []float32, lda int, x []float32, incX int, beta float32, y []float32, incY int) {
-	// declared at cblas.h:307:6 void cblas_ssymv ...
+	// declared at cblas.h:317:6 void cblas_ssymv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Ssymv", ul, n, alpha, lda, incX, beta, incY)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Ssymv", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Ssymv", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Ssymv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Ssymv", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Ssymv", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -3824,13 +4716,13 @@ func (Implementation) Ssymv(ul blas.Uplo, n int, alpha float32, a []float32, lda
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Ssymv", Param: "a", Message: shortA})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Ssymv", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Ssymv", Param: "y", Message: shortY})
 	}
 	var _a *float32
 	if len(a) > 0 {
@@ -3844,7 +4736,19 @@ func (Implementation) Ssymv(ul blas.Uplo, n int, alpha float32, a []float32, lda
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_ssymv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), C.float(alpha), (*C.float)(_a), C.int(lda), (*C.float)(_x), C.int(incX), C.float(beta), (*C.float)(_y), C.int(incY))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Ssymv", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Ssymv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Ssymv", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Ssymv", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_ssymv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), C.float(alpha), (*C.float)(_a), C.blasint(lda), (*C.float)(_x), C.blasint(incX), C.float(beta), (*C.float)(_y), C.blasint(incY))
 }
 
 // Ssbmv performs the matrix-vector operation
@@ -3852,30 +4756,33 @@ func (Implementation) Ssymv(ul blas.Uplo, n int, alpha float32, a []float32, lda
 // where A is an n×n symmetric band matrix with k super-diagonals, x and y are
 // vectors, and alpha and beta are scalars.
 func (Implementation) Ssbmv(ul blas.Uplo, n, k int, alpha float32, a []float32, lda int, x []float32, incX int, beta float32, y []float32, incY int) {
-	// declared at cblas.h:311:6 void cblas_ssbmv ...
+	// declared at cblas.h:321:6 void cblas_ssbmv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Ssbmv", ul, n, k, alpha, lda, incX, beta, incY)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Ssbmv", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Ssbmv", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Ssbmv", Param: "k", Message: kLT0})
 	}
 	if lda < k+1 {
-		panic(badLdA)
+		panic(Error{Routine: "Ssbmv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Ssbmv", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Ssbmv", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -3885,13 +4792,13 @@ func (Implementation) Ssbmv(ul blas.Uplo, n, k int, alpha float32, a []float32,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+k+1 {
-		panic(shortA)
+		panic(Error{Routine: "Ssbmv", Param: "a", Message: shortA})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Ssbmv", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Ssbmv", Param: "y", Message: shortY})
 	}
 	var _a *float32
 	if len(a) > 0 {
@@ -3905,7 +4812,22 @@ func (Implementation) Ssbmv(ul blas.Uplo, n, k int, alpha float32, a []float32,
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_ssbmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), C.int(k), C.float(alpha), (*C.float)(_a), C.int(lda), (*C.float)(_x), C.int(incX), C.float(beta), (*C.float)(_y), C.int(incY))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Ssbmv", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Ssbmv", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Ssbmv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Ssbmv", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Ssbmv", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_ssbmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), C.blasint(k), C.float(alpha), (*C.float)(_a), C.blasint(lda), (*C.float)(_x), C.blasint(incX), C.float(beta), (*C.float)(_y), C.blasint(incY))
 }
 
 // Sspmv performs the matrix-vector operation
@@ -3913,24 +4835,27 @@ func (Implementation) Ssbmv(ul blas.Uplo, n, k int, alpha float32, a []float32,
 // where A is an n×n symmetric matrix in packed format, x and y are vectors,
 // and alpha and beta are scalars.
 func (Implementation) Sspmv(ul blas.Uplo, n int, alpha float32, ap, x []float32, incX int, beta float32, y []float32, incY int) {
-	// declared at cblas.h:315:6 void cblas_sspmv ...
+	// declared at cblas.h:325:6 void cblas_sspmv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Sspmv", ul, n, alpha, incX, beta, incY)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Sspmv", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Sspmv", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Sspmv", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Sspmv", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -3940,13 +4865,13 @@ func (Implementation) Sspmv(ul blas.Uplo, n int, alpha float32, ap, x []float32,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(ap) < n*(n+1)/2 {
-		panic(shortAP)
+		panic(Error{Routine: "Sspmv", Param: "ap", Message: shortAP})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Sspmv", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Sspmv", Param: "y", Message: shortY})
 	}
 	var _ap *float32
 	if len(ap) > 0 {
@@ -3960,29 +4885,41 @@ func (Implementation) Sspmv(ul blas.Uplo, n int, alpha float32, ap, x []float32,
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_sspmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), C.float(alpha), (*C.float)(_ap), (*C.float)(_x), C.int(incX), C.float(beta), (*C.float)(_y), C.int(incY))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Sspmv", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Sspmv", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Sspmv", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_sspmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), C.float(alpha), (*C.float)(_ap), (*C.float)(_x), C.blasint(incX), C.float(beta), (*C.float)(_y), C.blasint(incY))
 }
 
 // Sger performs the rank-one operation
 //  A += alpha * x * yᵀ
 // where A is an m×n dense matrix, x and y are vectors, and alpha is a scalar.
 func (Implementation) Sger(m, n int, alpha float32, x []float32, incX int, y []float32, incY int, a []float32, lda int) {
-	// declared at cblas.h:319:6 void cblas_sger ...
+	// declared at cblas.h:329:6 void cblas_sger ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Sger", m, n, alpha, incX, incY, lda)()
+	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Sger", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Sger", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Sger", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Sger", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Sger", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -3992,13 +4929,13 @@ func (Implementation) Sger(m, n int, alpha float32, x []float32, incX int, y []f
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (m-1)*incX) || (incX < 0 && len(x) <= (1-m)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Sger", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Sger", Param: "y", Message: shortY})
 	}
 	if len(a) < lda*(m-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Sger", Param: "a", Message: shortA})
 	}
 	var _x *float32
 	if len(x) > 0 {
@@ -4012,31 +4949,49 @@ func (Implementation) Sger(m, n int, alpha float32, x []float32, incX int, y []f
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_sger(C.enum_CBLAS_ORDER(rowMajor), C.int(m), C.int(n), C.float(alpha), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY), (*C.float)(_a), C.int(lda))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Sger", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Sger", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Sger", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Sger", Param: "incY", Message: incYTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Sger", Param: "lda", Message: ldaTooLarge})
+	}
+	C.cblas_sger(C.enum_CBLAS_ORDER(rowMajor), C.blasint(m), C.blasint(n), C.float(alpha), (*C.float)(_x), C.blasint(incX), (*C.float)(_y), C.blasint(incY), (*C.float)(_a), C.blasint(lda))
 }
 
 // Ssyr performs the symmetric rank-one update
 //  A += alpha * x * xᵀ
 // where A is an n×n symmetric matrix, and x is a vector.
 func (Implementation) Ssyr(ul blas.Uplo, n int, alpha float32, x []float32, incX int, a []float32, lda int) {
-	// declared at cblas.h:322:6 void cblas_ssyr ...
+	// declared at cblas.h:332:6 void cblas_ssyr ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Ssyr", ul, n, alpha, incX, lda)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Ssyr", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Ssyr", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Ssyr", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Ssyr", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -4046,10 +5001,10 @@ func (Implementation) Ssyr(ul blas.Uplo, n int, alpha float32, x []float32, incX
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Ssyr", Param: "x", Message: shortX})
 	}
 	if len(a) < lda*(n-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Ssyr", Param: "a", Message: shortA})
 	}
 	var _x *float32
 	if len(x) > 0 {
@@ -4059,7 +5014,16 @@ func (Implementation) Ssyr(ul blas.Uplo, n int, alpha float32, x []float32, incX
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_ssyr(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), C.float(alpha), (*C.float)(_x), C.int(incX), (*C.float)(_a), C.int(lda))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Ssyr", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Ssyr", Param: "incX", Message: incXTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Ssyr", Param: "lda", Message: ldaTooLarge})
+	}
+	C.cblas_ssyr(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), C.float(alpha), (*C.float)(_x), C.blasint(incX), (*C.float)(_a), C.blasint(lda))
 }
 
 // Sspr performs the symmetric rank-one operation
@@ -4067,21 +5031,24 @@ func (Implementation) Ssyr(ul blas.Uplo, n int, alpha float32, x []float32, incX
 // where A is an n×n symmetric matrix in packed format, x is a vector, and
 // alpha is a scalar.
 func (Implementation) Sspr(ul blas.Uplo, n int, alpha float32, x []float32, incX int, ap []float32) {
-	// declared at cblas.h:325:6 void cblas_sspr ...
+	// declared at cblas.h:335:6 void cblas_sspr ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Sspr", ul, n, alpha, incX)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Sspr", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Sspr", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Sspr", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -4091,10 +5058,10 @@ func (Implementation) Sspr(ul blas.Uplo, n int, alpha float32, x []float32, incX
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Sspr", Param: "x", Message: shortX})
 	}
 	if len(ap) < n*(n+1)/2 {
-		panic(shortAP)
+		panic(Error{Routine: "Sspr", Param: "ap", Message: shortAP})
 	}
 	var _x *float32
 	if len(x) > 0 {
@@ -4104,34 +5071,43 @@ func (Implementation) Sspr(ul blas.Uplo, n int, alpha float32, x []float32, incX
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	C.cblas_sspr(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), C.float(alpha), (*C.float)(_x), C.int(incX), (*C.float)(_ap))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Sspr", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Sspr", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_sspr(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), C.float(alpha), (*C.float)(_x), C.blasint(incX), (*C.float)(_ap))
 }
 
 // Ssyr2 performs the symmetric rank-two update
 //  A += alpha * x * yᵀ + alpha * y * xᵀ
 // where A is an n×n symmetric matrix, x and y are vectors, and alpha is a scalar.
 func (Implementation) Ssyr2(ul blas.Uplo, n int, alpha float32, x []float32, incX int, y []float32, incY int, a []float32, lda int) {
-	// declared at cblas.h:328:6 void cblas_ssyr2 ...
+	// declared at cblas.h:338:6 void cblas_ssyr2 ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Ssyr2", ul, n, alpha, incX, incY, lda)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Ssyr2", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Ssyr2", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Ssyr2", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Ssyr2", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Ssyr2", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -4141,13 +5117,13 @@ func (Implementation) Ssyr2(ul blas.Uplo, n int, alpha float32, x []float32, inc
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Ssyr2", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Ssyr2", Param: "y", Message: shortY})
 	}
 	if len(a) < lda*(n-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Ssyr2", Param: "a", Message: shortA})
 	}
 	var _x *float32
 	if len(x) > 0 {
@@ -4161,7 +5137,19 @@ func (Implementation) Ssyr2(ul blas.Uplo, n int, alpha float32, x []float32, inc
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_ssyr2(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), C.float(alpha), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY), (*C.float)(_a), C.int(lda))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Ssyr2", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Ssyr2", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Ssyr2", Param: "incY", Message: incYTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Ssyr2", Param: "lda", Message: ldaTooLarge})
+	}
+	C.cblas_ssyr2(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), C.float(alpha), (*C.float)(_x), C.blasint(incX), (*C.float)(_y), C.blasint(incY), (*C.float)(_a), C.blasint(lda))
 }
 
 // Sspr2 performs the symmetric rank-2 update
@@ -4169,24 +5157,27 @@ func (Implementation) Ssyr2(ul blas.Uplo, n int, alpha float32, x []float32, inc
 // where A is an n×n symmetric matrix in packed format, x and y are vectors,
 // and alpha is a scalar.
 func (Implementation) Sspr2(ul blas.Uplo, n int, alpha float32, x []float32, incX int, y []float32, incY int, ap []float32) {
-	// declared at cblas.h:332:6 void cblas_sspr2 ...
+	// declared at cblas.h:342:6 void cblas_sspr2 ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Sspr2", ul, n, alpha, incX, incY)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Sspr2", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Sspr2", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Sspr2", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Sspr2", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -4196,13 +5187,13 @@ func (Implementation) Sspr2(ul blas.Uplo, n int, alpha float32, x []float32, inc
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Sspr2", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Sspr2", Param: "y", Message: shortY})
 	}
 	if len(ap) < n*(n+1)/2 {
-		panic(shortAP)
+		panic(Error{Routine: "Sspr2", Param: "ap", Message: shortAP})
 	}
 	var _x *float32
 	if len(x) > 0 {
@@ -4216,7 +5207,16 @@ func (Implementation) Sspr2(ul blas.Uplo, n int, alpha float32, x []float32, inc
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	C.cblas_sspr2(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), C.float(alpha), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY), (*C.float)(_ap))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Sspr2", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Sspr2", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Sspr2", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_sspr2(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), C.float(alpha), (*C.float)(_x), C.blasint(incX), (*C.float)(_y), C.blasint(incY), (*C.float)(_ap))
 }
 
 // Dsymv performs the matrix-vector operation
@@ -4224,27 +5224,30 @@ func (Implementation) Sspr2(ul blas.Uplo, n int, alpha float32, x []float32, inc
 // where A is an n×n symmetric matrix, x and y are vectors, and alpha and
 // beta are scalars.
 func (Implementation) Dsymv(ul blas.Uplo, n int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
-	// declared at cblas.h:336:6 void cblas_dsymv ...
+	// declared at cblas.h:346:6 void cblas_dsymv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dsymv", ul, n, alpha, lda, incX, beta, incY)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Dsymv", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dsymv", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Dsymv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Dsymv", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Dsymv", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -4254,13 +5257,13 @@ func (Implementation) Dsymv(ul blas.Uplo, n int, alpha float64, a []float64, lda
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Dsymv", Param: "a", Message: shortA})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Dsymv", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Dsymv", Param: "y", Message: shortY})
 	}
 	var _a *float64
 	if len(a) > 0 {
@@ -4274,7 +5277,19 @@ func (Implementation) Dsymv(ul blas.Uplo, n int, alpha float64, a []float64, lda
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_dsymv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), C.double(alpha), (*C.double)(_a), C.int(lda), (*C.double)(_x), C.int(incX), C.double(beta), (*C.double)(_y), C.int(incY))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dsymv", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Dsymv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Dsymv", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Dsymv", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_dsymv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), C.double(alpha), (*C.double)(_a), C.blasint(lda), (*C.double)(_x), C.blasint(incX), C.double(beta), (*C.double)(_y), C.blasint(incY))
 }
 
 // Dsbmv performs the matrix-vector operation
@@ -4282,30 +5297,33 @@ func (Implementation) Dsymv(ul blas.Uplo, n int, alpha float64, a []float64, lda
 // where A is an n×n symmetric band matrix with k super-diagonals, x and y are
 // vectors, and alpha and beta are scalars.
 func (Implementation) Dsbmv(ul blas.Uplo, n, k int, alpha float64, a []float64, lda int, x []float64, incX int, beta float64, y []float64, incY int) {
-	// declared at cblas.h:340:6 void cblas_dsbmv ...
+	// declared at cblas.h:350:6 void cblas_dsbmv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dsbmv", ul, n, k, alpha, lda, incX, beta, incY)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Dsbmv", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dsbmv", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Dsbmv", Param: "k", Message: kLT0})
 	}
 	if lda < k+1 {
-		panic(badLdA)
+		panic(Error{Routine: "Dsbmv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Dsbmv", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Dsbmv", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -4315,13 +5333,13 @@ func (Implementation) Dsbmv(ul blas.Uplo, n, k int, alpha float64, a []float64,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+k+1 {
-		panic(shortA)
+		panic(Error{Routine: "Dsbmv", Param: "a", Message: shortA})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Dsbmv", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Dsbmv", Param: "y", Message: shortY})
 	}
 	var _a *float64
 	if len(a) > 0 {
@@ -4335,7 +5353,22 @@ func (Implementation) Dsbmv(ul blas.Uplo, n, k int, alpha float64, a []float64,
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_dsbmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), C.int(k), C.double(alpha), (*C.double)(_a), C.int(lda), (*C.double)(_x), C.int(incX), C.double(beta), (*C.double)(_y), C.int(incY))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dsbmv", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Dsbmv", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Dsbmv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Dsbmv", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Dsbmv", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_dsbmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), C.blasint(k), C.double(alpha), (*C.double)(_a), C.blasint(lda), (*C.double)(_x), C.blasint(incX), C.double(beta), (*C.double)(_y), C.blasint(incY))
 }
 
 // Dspmv performs the matrix-vector operation
@@ -4343,24 +5376,27 @@ func (Implementation) Dsbmv(ul blas.Uplo, n, k int, alpha float64, a []float64,
 // where A is an n×n symmetric matrix in packed format, x and y are vectors,
 // and alpha and beta are scalars.
 func (Implementation) Dspmv(ul blas.Uplo, n int, alpha float64, ap, x []float64, incX int, beta float64, y []float64, incY int) {
-	// declared at cblas.h:344:6 void cblas_dspmv ...
+	// declared at cblas.h:354:6 void cblas_dspmv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dspmv", ul, n, alpha, incX, beta, incY)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Dspmv", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dspmv", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Dspmv", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Dspmv", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -4370,13 +5406,13 @@ func (Implementation) Dspmv(ul blas.Uplo, n int, alpha float64, ap, x []float64,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(ap) < n*(n+1)/2 {
-		panic(shortAP)
+		panic(Error{Routine: "Dspmv", Param: "ap", Message: shortAP})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Dspmv", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Dspmv", Param: "y", Message: shortY})
 	}
 	var _ap *float64
 	if len(ap) > 0 {
@@ -4390,29 +5426,41 @@ func (Implementation) Dspmv(ul blas.Uplo, n int, alpha float64, ap, x []float64,
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_dspmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), C.double(alpha), (*C.double)(_ap), (*C.double)(_x), C.int(incX), C.double(beta), (*C.double)(_y), C.int(incY))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dspmv", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Dspmv", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Dspmv", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_dspmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), C.double(alpha), (*C.double)(_ap), (*C.double)(_x), C.blasint(incX), C.double(beta), (*C.double)(_y), C.blasint(incY))
 }
 
 // Dger performs the rank-one operation
 //  A += alpha * x * yᵀ
 // where A is an m×n dense matrix, x and y are vectors, and alpha is a scalar.
 func (Implementation) Dger(m, n int, alpha float64, x []float64, incX int, y []float64, incY int, a []float64, lda int) {
-	// declared at cblas.h:348:6 void cblas_dger ...
+	// declared at cblas.h:358:6 void cblas_dger ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dger", m, n, alpha, incX, incY, lda)()
+	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Dger", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dger", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Dger", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Dger", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Dger", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -4422,13 +5470,13 @@ func (Implementation) Dger(m, n int, alpha float64, x []float64, incX int, y []f
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (m-1)*incX) || (incX < 0 && len(x) <= (1-m)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Dger", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Dger", Param: "y", Message: shortY})
 	}
 	if len(a) < lda*(m-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Dger", Param: "a", Message: shortA})
 	}
 	var _x *float64
 	if len(x) > 0 {
@@ -4442,31 +5490,49 @@ func (Implementation) Dger(m, n int, alpha float64, x []float64, incX int, y []f
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_dger(C.enum_CBLAS_ORDER(rowMajor), C.int(m), C.int(n), C.double(alpha), (*C.double)(_x), C.int(incX), (*C.double)(_y), C.int(incY), (*C.double)(_a), C.int(lda))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Dger", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dger", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Dger", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Dger", Param: "incY", Message: incYTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Dger", Param: "lda", Message: ldaTooLarge})
+	}
+	C.cblas_dger(C.enum_CBLAS_ORDER(rowMajor), C.blasint(m), C.blasint(n), C.double(alpha), (*C.double)(_x), C.blasint(incX), (*C.double)(_y), C.blasint(incY), (*C.double)(_a), C.blasint(lda))
 }
 
 // Dsyr performs the symmetric rank-one update
 //  A += alpha * x * xᵀ
 // where A is an n×n symmetric matrix, and x is a vector.
 func (Implementation) Dsyr(ul blas.Uplo, n int, alpha float64, x []float64, incX int, a []float64, lda int) {
-	// declared at cblas.h:351:6 void cblas_dsyr ...
+	// declared at cblas.h:361:6 void cblas_dsyr ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dsyr", ul, n, alpha, incX, lda)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Dsyr", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dsyr", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Dsyr", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Dsyr", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -4476,10 +5542,10 @@ func (Implementation) Dsyr(ul blas.Uplo, n int, alpha float64, x []float64, incX
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Dsyr", Param: "x", Message: shortX})
 	}
 	if len(a) < lda*(n-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Dsyr", Param: "a", Message: shortA})
 	}
 	var _x *float64
 	if len(x) > 0 {
@@ -4489,7 +5555,16 @@ func (Implementation) Dsyr(ul blas.Uplo, n int, alpha float64, x []float64, incX
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_dsyr(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), C.double(alpha), (*C.double)(_x), C.int(incX), (*C.double)(_a), C.int(lda))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dsyr", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Dsyr", Param: "incX", Message: incXTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Dsyr", Param: "lda", Message: ldaTooLarge})
+	}
+	C.cblas_dsyr(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), C.double(alpha), (*C.double)(_x), C.blasint(incX), (*C.double)(_a), C.blasint(lda))
 }
 
 // Dspr performs the symmetric rank-one operation
@@ -4497,21 +5572,24 @@ func (Implementation) Dsyr(ul blas.Uplo, n int, alpha float64, x []float64, incX
 // where A is an n×n symmetric matrix in packed format, x is a vector, and
 // alpha is a scalar.
 func (Implementation) Dspr(ul blas.Uplo, n int, alpha float64, x []float64, incX int, ap []float64) {
-	// declared at cblas.h:354:6 void cblas_dspr ...
+	// declared at cblas.h:364:6 void cblas_dspr ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dspr", ul, n, alpha, incX)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Dspr", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dspr", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Dspr", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -4521,10 +5599,10 @@ func (Implementation) Dspr(ul blas.Uplo, n int, alpha float64, x []float64, incX
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Dspr", Param: "x", Message: shortX})
 	}
 	if len(ap) < n*(n+1)/2 {
-		panic(shortAP)
+		panic(Error{Routine: "Dspr", Param: "ap", Message: shortAP})
 	}
 	var _x *float64
 	if len(x) > 0 {
@@ -4534,34 +5612,43 @@ func (Implementation) Dspr(ul blas.Uplo, n int, alpha float64, x []float64, incX
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	C.cblas_dspr(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), C.double(alpha), (*C.double)(_x), C.int(incX), (*C.double)(_ap))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dspr", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Dspr", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_dspr(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), C.double(alpha), (*C.double)(_x), C.blasint(incX), (*C.double)(_ap))
 }
 
 // Dsyr2 performs the symmetric rank-two update
 //  A += alpha * x * yᵀ + alpha * y * xᵀ
 // where A is an n×n symmetric matrix, x and y are vectors, and alpha is a scalar.
 func (Implementation) Dsyr2(ul blas.Uplo, n int, alpha float64, x []float64, incX int, y []float64, incY int, a []float64, lda int) {
-	// declared at cblas.h:357:6 void cblas_dsyr2 ...
+	// declared at cblas.h:367:6 void cblas_dsyr2 ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dsyr2", ul, n, alpha, incX, incY, lda)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Dsyr2", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dsyr2", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Dsyr2", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Dsyr2", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Dsyr2", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -4571,13 +5658,13 @@ func (Implementation) Dsyr2(ul blas.Uplo, n int, alpha float64, x []float64, inc
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Dsyr2", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Dsyr2", Param: "y", Message: shortY})
 	}
 	if len(a) < lda*(n-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Dsyr2", Param: "a", Message: shortA})
 	}
 	var _x *float64
 	if len(x) > 0 {
@@ -4591,7 +5678,19 @@ func (Implementation) Dsyr2(ul blas.Uplo, n int, alpha float64, x []float64, inc
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_dsyr2(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), C.double(alpha), (*C.double)(_x), C.int(incX), (*C.double)(_y), C.int(incY), (*C.double)(_a), C.int(lda))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dsyr2", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Dsyr2", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Dsyr2", Param: "incY", Message: incYTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Dsyr2", Param: "lda", Message: ldaTooLarge})
+	}
+	C.cblas_dsyr2(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), C.double(alpha), (*C.double)(_x), C.blasint(incX), (*C.double)(_y), C.blasint(incY), (*C.double)(_a), C.blasint(lda))
 }
 
 // Dspr2 performs the symmetric rank-2 update
@@ -4599,24 +5698,27 @@ func (Implementation) Dsyr2(ul blas.Uplo, n int, alpha float64, x []float64, inc
 // where A is an n×n symmetric matrix in packed format, x and y are vectors,
 // and alpha is a scalar.
 func (Implementation) Dspr2(ul blas.Uplo, n int, alpha float64, x []float64, incX int, y []float64, incY int, ap []float64) {
-	// declared at cblas.h:361:6 void cblas_dspr2 ...
+	// declared at cblas.h:371:6 void cblas_dspr2 ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dspr2", ul, n, alpha, incX, incY)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Dspr2", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dspr2", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Dspr2", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Dspr2", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -4626,13 +5728,13 @@ func (Implementation) Dspr2(ul blas.Uplo, n int, alpha float64, x []float64, inc
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Dspr2", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Dspr2", Param: "y", Message: shortY})
 	}
 	if len(ap) < n*(n+1)/2 {
-		panic(shortAP)
+		panic(Error{Routine: "Dspr2", Param: "ap", Message: shortAP})
 	}
 	var _x *float64
 	if len(x) > 0 {
@@ -4646,7 +5748,16 @@ func (Implementation) Dspr2(ul blas.Uplo, n int, alpha float64, x []float64, inc
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	C.cblas_dspr2(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), C.double(alpha), (*C.double)(_x), C.int(incX), (*C.double)(_y), C.int(incY), (*C.double)(_ap))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dspr2", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Dspr2", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Dspr2", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_dspr2(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), C.double(alpha), (*C.double)(_x), C.blasint(incX), (*C.double)(_y), C.blasint(incY), (*C.double)(_ap))
 }
 
 // Chemv performs the matrix-vector operation
@@ -4657,27 +5768,30 @@ func (Implementation) Dspr2(ul blas.Uplo, n int, alpha float64, x []float64, inc
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Chemv(ul blas.Uplo, n int, alpha complex64, a []complex64, lda int, x []complex64, incX int, beta complex64, y []complex64, incY int) {
-	// declared at cblas.h:369:6 void cblas_chemv ...
+	// declared at cblas.h:379:6 void cblas_chemv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Chemv", ul, n, alpha, lda, incX, beta, incY)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Chemv", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Chemv", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Chemv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Chemv", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Chemv", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -4687,13 +5801,13 @@ func (Implementation) Chemv(ul blas.Uplo, n int, alpha complex64, a []complex64,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Chemv", Param: "a", Message: shortA})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Chemv", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Chemv", Param: "y", Message: shortY})
 	}
 	var _a *complex64
 	if len(a) > 0 {
@@ -4703,11 +5817,23 @@ func (Implementation) Chemv(ul blas.Uplo, n int, alpha complex64, a []complex64,
 	if len(x) > 0 {
 		_x = &x[0]
 	}
-	var _y *complex64
-	if len(y) > 0 {
-		_y = &y[0]
+	var _y *complex64
+	if len(y) > 0 {
+		_y = &y[0]
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Chemv", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Chemv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Chemv", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Chemv", Param: "incY", Message: incYTooLarge})
 	}
-	C.cblas_chemv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.int(incY))
+	C.cblas_chemv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.blasint(incY))
 }
 
 // Chbmv performs the matrix-vector operation
@@ -4718,30 +5844,33 @@ func (Implementation) Chemv(ul blas.Uplo, n int, alpha complex64, a []complex64,
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Chbmv(ul blas.Uplo, n, k int, alpha complex64, a []complex64, lda int, x []complex64, incX int, beta complex64, y []complex64, incY int) {
-	// declared at cblas.h:373:6 void cblas_chbmv ...
+	// declared at cblas.h:383:6 void cblas_chbmv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Chbmv", ul, n, k, alpha, lda, incX, beta, incY)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Chbmv", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Chbmv", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Chbmv", Param: "k", Message: kLT0})
 	}
 	if lda < k+1 {
-		panic(badLdA)
+		panic(Error{Routine: "Chbmv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Chbmv", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Chbmv", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -4751,13 +5880,13 @@ func (Implementation) Chbmv(ul blas.Uplo, n, k int, alpha complex64, a []complex
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+k+1 {
-		panic(shortA)
+		panic(Error{Routine: "Chbmv", Param: "a", Message: shortA})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Chbmv", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Chbmv", Param: "y", Message: shortY})
 	}
 	var _a *complex64
 	if len(a) > 0 {
@@ -4771,7 +5900,22 @@ func (Implementation) Chbmv(ul blas.Uplo, n, k int, alpha complex64, a []complex
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_chbmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), C.int(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.int(incY))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Chbmv", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Chbmv", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Chbmv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Chbmv", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Chbmv", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_chbmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), C.blasint(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.blasint(incY))
 }
 
 // Chpmv performs the matrix-vector operation
@@ -4782,24 +5926,27 @@ func (Implementation) Chbmv(ul blas.Uplo, n, k int, alpha complex64, a []complex
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Chpmv(ul blas.Uplo, n int, alpha complex64, ap, x []complex64, incX int, beta complex64, y []complex64, incY int) {
-	// declared at cblas.h:377:6 void cblas_chpmv ...
+	// declared at cblas.h:387:6 void cblas_chpmv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Chpmv", ul, n, alpha, incX, beta, incY)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Chpmv", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Chpmv", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Chpmv", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Chpmv", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -4809,13 +5956,13 @@ func (Implementation) Chpmv(ul blas.Uplo, n int, alpha complex64, ap, x []comple
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(ap) < n*(n+1)/2 {
-		panic(shortAP)
+		panic(Error{Routine: "Chpmv", Param: "ap", Message: shortAP})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Chpmv", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Chpmv", Param: "y", Message: shortY})
 	}
 	var _ap *complex64
 	if len(ap) > 0 {
@@ -4829,7 +5976,16 @@ func (Implementation) Chpmv(ul blas.Uplo, n int, alpha complex64, ap, x []comple
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_chpmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.int(incY))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Chpmv", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Chpmv", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Chpmv", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_chpmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.blasint(incY))
 }
 
 // Cgeru performs the rank-one operation
@@ -4839,22 +5995,25 @@ func (Implementation) Chpmv(ul blas.Uplo, n int, alpha complex64, ap, x []comple
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Cgeru(m, n int, alpha complex64, x []complex64, incX int, y []complex64, incY int, a []complex64, lda int) {
-	// declared at cblas.h:381:6 void cblas_cgeru ...
+	// declared at cblas.h:391:6 void cblas_cgeru ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Cgeru", m, n, alpha, incX, incY, lda)()
+	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Cgeru", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Cgeru", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Cgeru", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Cgeru", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Cgeru", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -4864,13 +6023,13 @@ func (Implementation) Cgeru(m, n int, alpha complex64, x []complex64, incX int,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (m-1)*incX) || (incX < 0 && len(x) <= (1-m)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Cgeru", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Cgeru", Param: "y", Message: shortY})
 	}
 	if len(a) < lda*(m-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Cgeru", Param: "a", Message: shortA})
 	}
 	var _x *complex64
 	if len(x) > 0 {
@@ -4884,7 +6043,22 @@ func (Implementation) Cgeru(m, n int, alpha complex64, x []complex64, incX int,
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_cgeru(C.enum_CBLAS_ORDER(rowMajor), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(_a), C.int(lda))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Cgeru", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Cgeru", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Cgeru", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Cgeru", Param: "incY", Message: incYTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Cgeru", Param: "lda", Message: ldaTooLarge})
+	}
+	C.cblas_cgeru(C.enum_CBLAS_ORDER(rowMajor), C.blasint(m), C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(_y), C.blasint(incY), unsafe.Pointer(_a), C.blasint(lda))
 }
 
 // Cgerc performs the rank-one operation
@@ -4894,22 +6068,25 @@ func (Implementation) Cgeru(m, n int, alpha complex64, x []complex64, incX int,
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Cgerc(m, n int, alpha complex64, x []complex64, incX int, y []complex64, incY int, a []complex64, lda int) {
-	// declared at cblas.h:384:6 void cblas_cgerc ...
+	// declared at cblas.h:394:6 void cblas_cgerc ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Cgerc", m, n, alpha, incX, incY, lda)()
+	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Cgerc", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Cgerc", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Cgerc", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Cgerc", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Cgerc", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -4919,13 +6096,13 @@ func (Implementation) Cgerc(m, n int, alpha complex64, x []complex64, incX int,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (m-1)*incX) || (incX < 0 && len(x) <= (1-m)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Cgerc", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Cgerc", Param: "y", Message: shortY})
 	}
 	if len(a) < lda*(m-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Cgerc", Param: "a", Message: shortA})
 	}
 	var _x *complex64
 	if len(x) > 0 {
@@ -4939,7 +6116,22 @@ func (Implementation) Cgerc(m, n int, alpha complex64, x []complex64, incX int,
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_cgerc(C.enum_CBLAS_ORDER(rowMajor), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(_a), C.int(lda))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Cgerc", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Cgerc", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Cgerc", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Cgerc", Param: "incY", Message: incYTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Cgerc", Param: "lda", Message: ldaTooLarge})
+	}
+	C.cblas_cgerc(C.enum_CBLAS_ORDER(rowMajor), C.blasint(m), C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(_y), C.blasint(incY), unsafe.Pointer(_a), C.blasint(lda))
 }
 
 // Cher performs the Hermitian rank-one operation
@@ -4950,24 +6142,27 @@ func (Implementation) Cgerc(m, n int, alpha complex64, x []complex64, incX int,
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Cher(ul blas.Uplo, n int, alpha float32, x []complex64, incX int, a []complex64, lda int) {
-	// declared at cblas.h:387:6 void cblas_cher ...
+	// declared at cblas.h:397:6 void cblas_cher ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Cher", ul, n, alpha, incX, lda)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Cher", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Cher", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Cher", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Cher", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -4977,10 +6172,10 @@ func (Implementation) Cher(ul blas.Uplo, n int, alpha float32, x []complex64, in
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Cher", Param: "x", Message: shortX})
 	}
 	if len(a) < lda*(n-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Cher", Param: "a", Message: shortA})
 	}
 	var _x *complex64
 	if len(x) > 0 {
@@ -4990,7 +6185,16 @@ func (Implementation) Cher(ul blas.Uplo, n int, alpha float32, x []complex64, in
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_cher(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), C.float(alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_a), C.int(lda))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Cher", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Cher", Param: "incX", Message: incXTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Cher", Param: "lda", Message: ldaTooLarge})
+	}
+	C.cblas_cher(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), C.float(alpha), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(_a), C.blasint(lda))
 }
 
 // Chpr performs the Hermitian rank-1 operation
@@ -5001,21 +6205,24 @@ func (Implementation) Cher(ul blas.Uplo, n int, alpha float32, x []complex64, in
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Chpr(ul blas.Uplo, n int, alpha float32, x []complex64, incX int, ap []complex64) {
-	// declared at cblas.h:390:6 void cblas_chpr ...
+	// declared at cblas.h:400:6 void cblas_chpr ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Chpr", ul, n, alpha, incX)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Chpr", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Chpr", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Chpr", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -5025,10 +6232,10 @@ func (Implementation) Chpr(ul blas.Uplo, n int, alpha float32, x []complex64, in
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Chpr", Param: "x", Message: shortX})
 	}
 	if len(ap) < n*(n+1)/2 {
-		panic(shortAP)
+		panic(Error{Routine: "Chpr", Param: "ap", Message: shortAP})
 	}
 	var _x *complex64
 	if len(x) > 0 {
@@ -5038,7 +6245,13 @@ func (Implementation) Chpr(ul blas.Uplo, n int, alpha float32, x []complex64, in
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	C.cblas_chpr(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), C.float(alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_ap))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Chpr", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Chpr", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_chpr(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), C.float(alpha), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(_ap))
 }
 
 // Cher2 performs the Hermitian rank-two operation
@@ -5049,27 +6262,30 @@ func (Implementation) Chpr(ul blas.Uplo, n int, alpha float32, x []complex64, in
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Cher2(ul blas.Uplo, n int, alpha complex64, x []complex64, incX int, y []complex64, incY int, a []complex64, lda int) {
-	// declared at cblas.h:393:6 void cblas_cher2 ...
+	// declared at cblas.h:403:6 void cblas_cher2 ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Cher2", ul, n, alpha, incX, incY, lda)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Cher2", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Cher2", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Cher2", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Cher2", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Cher2", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -5079,13 +6295,13 @@ func (Implementation) Cher2(ul blas.Uplo, n int, alpha complex64, x []complex64,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Cher2", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Cher2", Param: "y", Message: shortY})
 	}
 	if len(a) < lda*(n-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Cher2", Param: "a", Message: shortA})
 	}
 	var _x *complex64
 	if len(x) > 0 {
@@ -5099,7 +6315,19 @@ func (Implementation) Cher2(ul blas.Uplo, n int, alpha complex64, x []complex64,
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_cher2(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(_a), C.int(lda))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Cher2", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Cher2", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Cher2", Param: "incY", Message: incYTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Cher2", Param: "lda", Message: ldaTooLarge})
+	}
+	C.cblas_cher2(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(_y), C.blasint(incY), unsafe.Pointer(_a), C.blasint(lda))
 }
 
 // Chpr2 performs the Hermitian rank-2 operation
@@ -5110,24 +6338,27 @@ func (Implementation) Cher2(ul blas.Uplo, n int, alpha complex64, x []complex64,
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Chpr2(ul blas.Uplo, n int, alpha complex64, x []complex64, incX int, y []complex64, incY int, ap []complex64) {
-	// declared at cblas.h:396:6 void cblas_chpr2 ...
+	// declared at cblas.h:406:6 void cblas_chpr2 ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Chpr2", ul, n, alpha, incX, incY)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Chpr2", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Chpr2", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Chpr2", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Chpr2", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -5137,13 +6368,13 @@ func (Implementation) Chpr2(ul blas.Uplo, n int, alpha complex64, x []complex64,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Chpr2", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Chpr2", Param: "y", Message: shortY})
 	}
 	if len(ap) < n*(n+1)/2 {
-		panic(shortAP)
+		panic(Error{Routine: "Chpr2", Param: "ap", Message: shortAP})
 	}
 	var _x *complex64
 	if len(x) > 0 {
@@ -5157,7 +6388,16 @@ func (Implementation) Chpr2(ul blas.Uplo, n int, alpha complex64, x []complex64,
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	C.cblas_chpr2(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(_ap))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Chpr2", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Chpr2", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Chpr2", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_chpr2(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(_y), C.blasint(incY), unsafe.Pointer(_ap))
 }
 
 // Zhemv performs the matrix-vector operation
@@ -5166,27 +6406,30 @@ func (Implementation) Chpr2(ul blas.Uplo, n int, alpha complex64, x []complex64,
 // Hermitian matrix. The imaginary parts of the diagonal elements of A are
 // ignored and assumed to be zero.
 func (Implementation) Zhemv(ul blas.Uplo, n int, alpha complex128, a []complex128, lda int, x []complex128, incX int, beta complex128, y []complex128, incY int) {
-	// declared at cblas.h:400:6 void cblas_zhemv ...
+	// declared at cblas.h:410:6 void cblas_zhemv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zhemv", ul, n, alpha, lda, incX, beta, incY)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Zhemv", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Zhemv", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Zhemv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Zhemv", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Zhemv", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -5196,13 +6439,13 @@ func (Implementation) Zhemv(ul blas.Uplo, n int, alpha complex128, a []complex12
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Zhemv", Param: "a", Message: shortA})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Zhemv", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Zhemv", Param: "y", Message: shortY})
 	}
 	var _a *complex128
 	if len(a) > 0 {
@@ -5216,7 +6459,19 @@ func (Implementation) Zhemv(ul blas.Uplo, n int, alpha complex128, a []complex12
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_zhemv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.int(incY))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Zhemv", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Zhemv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Zhemv", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Zhemv", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_zhemv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.blasint(incY))
 }
 
 // Zhbmv performs the matrix-vector operation
@@ -5225,30 +6480,33 @@ func (Implementation) Zhemv(ul blas.Uplo, n int, alpha complex128, a []complex12
 // Hermitian band matrix with k super-diagonals. The imaginary parts of
 // the diagonal elements of A are ignored and assumed to be zero.
 func (Implementation) Zhbmv(ul blas.Uplo, n, k int, alpha complex128, a []complex128, lda int, x []complex128, incX int, beta complex128, y []complex128, incY int) {
-	// declared at cblas.h:404:6 void cblas_zhbmv ...
+	// declared at cblas.h:414:6 void cblas_zhbmv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zhbmv", ul, n, k, alpha, lda, incX, beta, incY)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Zhbmv", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Zhbmv", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Zhbmv", Param: "k", Message: kLT0})
 	}
 	if lda < k+1 {
-		panic(badLdA)
+		panic(Error{Routine: "Zhbmv", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Zhbmv", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Zhbmv", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -5258,13 +6516,13 @@ func (Implementation) Zhbmv(ul blas.Uplo, n, k int, alpha complex128, a []comple
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(n-1)+k+1 {
-		panic(shortA)
+		panic(Error{Routine: "Zhbmv", Param: "a", Message: shortA})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Zhbmv", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Zhbmv", Param: "y", Message: shortY})
 	}
 	var _a *complex128
 	if len(a) > 0 {
@@ -5278,7 +6536,22 @@ func (Implementation) Zhbmv(ul blas.Uplo, n, k int, alpha complex128, a []comple
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_zhbmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), C.int(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.int(incY))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Zhbmv", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Zhbmv", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Zhbmv", Param: "lda", Message: ldaTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Zhbmv", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Zhbmv", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_zhbmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), C.blasint(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.blasint(incY))
 }
 
 // Zhpmv performs the matrix-vector operation
@@ -5287,24 +6560,27 @@ func (Implementation) Zhbmv(ul blas.Uplo, n, k int, alpha complex128, a []comple
 // Hermitian matrix in packed form. The imaginary parts of the diagonal
 // elements of A are ignored and assumed to be zero.
 func (Implementation) Zhpmv(ul blas.Uplo, n int, alpha complex128, ap, x []complex128, incX int, beta complex128, y []complex128, incY int) {
-	// declared at cblas.h:408:6 void cblas_zhpmv ...
+	// declared at cblas.h:418:6 void cblas_zhpmv ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zhpmv", ul, n, alpha, incX, beta, incY)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Zhpmv", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Zhpmv", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Zhpmv", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Zhpmv", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -5314,13 +6590,13 @@ func (Implementation) Zhpmv(ul blas.Uplo, n int, alpha complex128, ap, x []compl
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(ap) < n*(n+1)/2 {
-		panic(shortAP)
+		panic(Error{Routine: "Zhpmv", Param: "ap", Message: shortAP})
 	}
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Zhpmv", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Zhpmv", Param: "y", Message: shortY})
 	}
 	var _ap *complex128
 	if len(ap) > 0 {
@@ -5334,7 +6610,16 @@ func (Implementation) Zhpmv(ul blas.Uplo, n int, alpha complex128, ap, x []compl
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_zhpmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.int(incY))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Zhpmv", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Zhpmv", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Zhpmv", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_zhpmv(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(_ap), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(&beta), unsafe.Pointer(_y), C.blasint(incY))
 }
 
 // Zgeru performs the rank-one operation
@@ -5342,22 +6627,25 @@ func (Implementation) Zhpmv(ul blas.Uplo, n int, alpha complex128, ap, x []compl
 // where A is an m×n dense matrix, alpha is a scalar, x is an m element vector,
 // and y is an n element vector.
 func (Implementation) Zgeru(m, n int, alpha complex128, x []complex128, incX int, y []complex128, incY int, a []complex128, lda int) {
-	// declared at cblas.h:412:6 void cblas_zgeru ...
+	// declared at cblas.h:422:6 void cblas_zgeru ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zgeru", m, n, alpha, incX, incY, lda)()
+	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Zgeru", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Zgeru", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Zgeru", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Zgeru", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Zgeru", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -5367,13 +6655,13 @@ func (Implementation) Zgeru(m, n int, alpha complex128, x []complex128, incX int
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (m-1)*incX) || (incX < 0 && len(x) <= (1-m)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Zgeru", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Zgeru", Param: "y", Message: shortY})
 	}
 	if len(a) < lda*(m-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Zgeru", Param: "a", Message: shortA})
 	}
 	var _x *complex128
 	if len(x) > 0 {
@@ -5387,7 +6675,22 @@ func (Implementation) Zgeru(m, n int, alpha complex128, x []complex128, incX int
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_zgeru(C.enum_CBLAS_ORDER(rowMajor), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(_a), C.int(lda))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Zgeru", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Zgeru", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Zgeru", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Zgeru", Param: "incY", Message: incYTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Zgeru", Param: "lda", Message: ldaTooLarge})
+	}
+	C.cblas_zgeru(C.enum_CBLAS_ORDER(rowMajor), C.blasint(m), C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(_y), C.blasint(incY), unsafe.Pointer(_a), C.blasint(lda))
 }
 
 // Zgerc performs the rank-one operation
@@ -5395,22 +6698,25 @@ func (Implementation) Zgeru(m, n int, alpha complex128, x []complex128, incX int
 // where A is an m×n dense matrix, alpha is a scalar, x is an m element vector,
 // and y is an n element vector.
 func (Implementation) Zgerc(m, n int, alpha complex128, x []complex128, incX int, y []complex128, incY int, a []complex128, lda int) {
-	// declared at cblas.h:415:6 void cblas_zgerc ...
+	// declared at cblas.h:425:6 void cblas_zgerc ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zgerc", m, n, alpha, incX, incY, lda)()
+	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Zgerc", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Zgerc", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Zgerc", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Zgerc", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Zgerc", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -5420,13 +6726,13 @@ func (Implementation) Zgerc(m, n int, alpha complex128, x []complex128, incX int
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (m-1)*incX) || (incX < 0 && len(x) <= (1-m)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Zgerc", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Zgerc", Param: "y", Message: shortY})
 	}
 	if len(a) < lda*(m-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Zgerc", Param: "a", Message: shortA})
 	}
 	var _x *complex128
 	if len(x) > 0 {
@@ -5440,7 +6746,22 @@ func (Implementation) Zgerc(m, n int, alpha complex128, x []complex128, incX int
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_zgerc(C.enum_CBLAS_ORDER(rowMajor), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(_a), C.int(lda))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Zgerc", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Zgerc", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Zgerc", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Zgerc", Param: "incY", Message: incYTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Zgerc", Param: "lda", Message: ldaTooLarge})
+	}
+	C.cblas_zgerc(C.enum_CBLAS_ORDER(rowMajor), C.blasint(m), C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(_y), C.blasint(incY), unsafe.Pointer(_a), C.blasint(lda))
 }
 
 // Zher performs the Hermitian rank-one operation
@@ -5449,24 +6770,27 @@ func (Implementation) Zgerc(m, n int, alpha complex128, x []complex128, incX int
 // element vector. On entry, the imaginary parts of the diagonal elements of A
 // are ignored and assumed to be zero, on return they will be set to zero.
 func (Implementation) Zher(ul blas.Uplo, n int, alpha float64, x []complex128, incX int, a []complex128, lda int) {
-	// declared at cblas.h:418:6 void cblas_zher ...
+	// declared at cblas.h:428:6 void cblas_zher ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zher", ul, n, alpha, incX, lda)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Zher", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Zher", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Zher", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Zher", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -5476,10 +6800,10 @@ func (Implementation) Zher(ul blas.Uplo, n int, alpha float64, x []complex128, i
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Zher", Param: "x", Message: shortX})
 	}
 	if len(a) < lda*(n-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Zher", Param: "a", Message: shortA})
 	}
 	var _x *complex128
 	if len(x) > 0 {
@@ -5489,7 +6813,16 @@ func (Implementation) Zher(ul blas.Uplo, n int, alpha float64, x []complex128, i
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_zher(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), C.double(alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_a), C.int(lda))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Zher", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Zher", Param: "incX", Message: incXTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Zher", Param: "lda", Message: ldaTooLarge})
+	}
+	C.cblas_zher(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), C.double(alpha), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(_a), C.blasint(lda))
 }
 
 // Zhpr performs the Hermitian rank-1 operation
@@ -5498,21 +6831,24 @@ func (Implementation) Zher(ul blas.Uplo, n int, alpha float64, x []complex128, i
 // in packed form. On entry, the imaginary parts of the diagonal elements are
 // assumed to be zero, and on return they are set to zero.
 func (Implementation) Zhpr(ul blas.Uplo, n int, alpha float64, x []complex128, incX int, ap []complex128) {
-	// declared at cblas.h:421:6 void cblas_zhpr ...
+	// declared at cblas.h:431:6 void cblas_zhpr ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zhpr", ul, n, alpha, incX)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Zhpr", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Zhpr", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Zhpr", Param: "incX", Message: zeroIncX})
 	}
 
 	// Quick return if possible.
@@ -5522,10 +6858,10 @@ func (Implementation) Zhpr(ul blas.Uplo, n int, alpha float64, x []complex128, i
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Zhpr", Param: "x", Message: shortX})
 	}
 	if len(ap) < n*(n+1)/2 {
-		panic(shortAP)
+		panic(Error{Routine: "Zhpr", Param: "ap", Message: shortAP})
 	}
 	var _x *complex128
 	if len(x) > 0 {
@@ -5535,7 +6871,13 @@ func (Implementation) Zhpr(ul blas.Uplo, n int, alpha float64, x []complex128, i
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	C.cblas_zhpr(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), C.double(alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_ap))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Zhpr", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Zhpr", Param: "incX", Message: incXTooLarge})
+	}
+	C.cblas_zhpr(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), C.double(alpha), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(_ap))
 }
 
 // Zher2 performs the Hermitian rank-two operation
@@ -5544,27 +6886,30 @@ func (Implementation) Zhpr(ul blas.Uplo, n int, alpha float64, x []complex128, i
 // Hermitian matrix. On entry, the imaginary parts of the diagonal elements are
 // ignored and assumed to be zero. On return they will be set to zero.
 func (Implementation) Zher2(ul blas.Uplo, n int, alpha complex128, x []complex128, incX int, y []complex128, incY int, a []complex128, lda int) {
-	// declared at cblas.h:424:6 void cblas_zher2 ...
+	// declared at cblas.h:434:6 void cblas_zher2 ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zher2", ul, n, alpha, incX, incY, lda)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Zher2", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Zher2", Param: "n", Message: nLT0})
 	}
 	if lda < max(1, n) {
-		panic(badLdA)
+		panic(Error{Routine: "Zher2", Param: "lda", Message: badLdA})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Zher2", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Zher2", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -5574,13 +6919,13 @@ func (Implementation) Zher2(ul blas.Uplo, n int, alpha complex128, x []complex12
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Zher2", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Zher2", Param: "y", Message: shortY})
 	}
 	if len(a) < lda*(n-1)+n {
-		panic(shortA)
+		panic(Error{Routine: "Zher2", Param: "a", Message: shortA})
 	}
 	var _x *complex128
 	if len(x) > 0 {
@@ -5594,7 +6939,19 @@ func (Implementation) Zher2(ul blas.Uplo, n int, alpha complex128, x []complex12
 	if len(a) > 0 {
 		_a = &a[0]
 	}
-	C.cblas_zher2(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(_a), C.int(lda))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Zher2", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Zher2", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Zher2", Param: "incY", Message: incYTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Zher2", Param: "lda", Message: ldaTooLarge})
+	}
+	C.cblas_zher2(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(_y), C.blasint(incY), unsafe.Pointer(_a), C.blasint(lda))
 }
 
 // Zhpr2 performs the Hermitian rank-2 operation
@@ -5603,24 +6960,27 @@ func (Implementation) Zher2(ul blas.Uplo, n int, alpha complex128, x []complex12
 // n×n Hermitian matrix, supplied in packed form. On entry, the imaginary parts
 // of the diagonal elements are assumed to be zero, and on return they are set to zero.
 func (Implementation) Zhpr2(ul blas.Uplo, n int, alpha complex128, x []complex128, incX int, y []complex128, incY int, ap []complex128) {
-	// declared at cblas.h:427:6 void cblas_zhpr2 ...
+	// declared at cblas.h:437:6 void cblas_zhpr2 ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zhpr2", ul, n, alpha, incX, incY)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Zhpr2", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Zhpr2", Param: "n", Message: nLT0})
 	}
 	if incX == 0 {
-		panic(zeroIncX)
+		panic(Error{Routine: "Zhpr2", Param: "incX", Message: zeroIncX})
 	}
 	if incY == 0 {
-		panic(zeroIncY)
+		panic(Error{Routine: "Zhpr2", Param: "incY", Message: zeroIncY})
 	}
 
 	// Quick return if possible.
@@ -5630,13 +6990,13 @@ func (Implementation) Zhpr2(ul blas.Uplo, n int, alpha complex128, x []complex12
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if (incX > 0 && len(x) <= (n-1)*incX) || (incX < 0 && len(x) <= (1-n)*incX) {
-		panic(shortX)
+		panic(Error{Routine: "Zhpr2", Param: "x", Message: shortX})
 	}
 	if (incY > 0 && len(y) <= (n-1)*incY) || (incY < 0 && len(y) <= (1-n)*incY) {
-		panic(shortY)
+		panic(Error{Routine: "Zhpr2", Param: "y", Message: shortY})
 	}
 	if len(ap) < n*(n+1)/2 {
-		panic(shortAP)
+		panic(Error{Routine: "Zhpr2", Param: "ap", Message: shortAP})
 	}
 	var _x *complex128
 	if len(x) > 0 {
@@ -5650,7 +7010,16 @@ func (Implementation) Zhpr2(ul blas.Uplo, n int, alpha complex128, x []complex12
 	if len(ap) > 0 {
 		_ap = &ap[0]
 	}
-	C.cblas_zhpr2(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(_ap))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Zhpr2", Param: "n", Message: nTooLarge})
+	}
+	if incX < minInt || incX > maxInt {
+		panic(Error{Routine: "Zhpr2", Param: "incX", Message: incXTooLarge})
+	}
+	if incY < minInt || incY > maxInt {
+		panic(Error{Routine: "Zhpr2", Param: "incY", Message: incYTooLarge})
+	}
+	C.cblas_zhpr2(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(_y), C.blasint(incY), unsafe.Pointer(_ap))
 }
 
 // Sgemm performs one of the matrix-matrix operations
@@ -5662,8 +7031,11 @@ func (Implementation) Zhpr2(ul blas.Uplo, n int, alpha complex128, x []complex12
 // an m×n matrix, and alpha and beta are scalars. tA and tB specify whether A or
 // B are transposed.
 func (Implementation) Sgemm(tA, tB blas.Transpose, m, n, k int, alpha float32, a []float32, lda int, b []float32, ldb int, beta float32, c []float32, ldc int) {
-	// declared at cblas.h:440:6 void cblas_sgemm ...
+	// declared at cblas.h:450:6 void cblas_sgemm ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Sgemm", tA, tB, m, n, k, alpha, lda, ldb, beta, ldc)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -5672,7 +7044,7 @@ func (Implementation) Sgemm(tA, tB blas.Transpose, m, n, k int, alpha float32, a
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Sgemm", Param: "tA", Message: badTranspose})
 	}
 	switch tB {
 	case blas.NoTrans:
@@ -5682,16 +7054,16 @@ func (Implementation) Sgemm(tA, tB blas.Transpose, m, n, k int, alpha float32, a
 	case blas.ConjTrans:
 		tB = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Sgemm", Param: "tB", Message: badTranspose})
 	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Sgemm", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Sgemm", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Sgemm", Param: "k", Message: kLT0})
 	}
 	var rowA, colA, rowB, colB int
 	if tA == C.CblasNoTrans {
@@ -5705,13 +7077,13 @@ func (Implementation) Sgemm(tA, tB blas.Transpose, m, n, k int, alpha float32, a
 		rowB, colB = n, k
 	}
 	if lda < max(1, colA) {
-		panic(badLdA)
+		panic(Error{Routine: "Sgemm", Param: "lda", Message: badLdA})
 	}
 	if ldb < max(1, colB) {
-		panic(badLdB)
+		panic(Error{Routine: "Sgemm", Param: "ldb", Message: badLdB})
 	}
 	if ldc < max(1, n) {
-		panic(badLdC)
+		panic(Error{Routine: "Sgemm", Param: "ldc", Message: badLdC})
 	}
 
 	// Quick return if possible.
@@ -5721,13 +7093,13 @@ func (Implementation) Sgemm(tA, tB blas.Transpose, m, n, k int, alpha float32, a
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(rowA-1)+colA {
-		panic(shortA)
+		panic(Error{Routine: "Sgemm", Param: "a", Message: shortA})
 	}
 	if len(b) < ldb*(rowB-1)+colB {
-		panic(shortB)
+		panic(Error{Routine: "Sgemm", Param: "b", Message: shortB})
 	}
 	if len(c) < ldc*(m-1)+n {
-		panic(shortC)
+		panic(Error{Routine: "Sgemm", Param: "c", Message: shortC})
 	}
 	var _a *float32
 	if len(a) > 0 {
@@ -5741,7 +7113,26 @@ func (Implementation) Sgemm(tA, tB blas.Transpose, m, n, k int, alpha float32, a
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_sgemm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_TRANSPOSE(tB), C.int(m), C.int(n), C.int(k), C.float(alpha), (*C.float)(_a), C.int(lda), (*C.float)(_b), C.int(ldb), C.float(beta), (*C.float)(_c), C.int(ldc))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Sgemm", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Sgemm", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Sgemm", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Sgemm", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldb < minInt || ldb > maxInt {
+		panic(Error{Routine: "Sgemm", Param: "ldb", Message: ldbTooLarge})
+	}
+	if ldc < minInt || ldc > maxInt {
+		panic(Error{Routine: "Sgemm", Param: "ldc", Message: ldcTooLarge})
+	}
+	defer admit(2 * float64(m) * float64(n) * float64(k))()
+	C.cblas_sgemm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_TRANSPOSE(tB), C.blasint(m), C.blasint(n), C.blasint(k), C.float(alpha), (*C.float)(_a), C.blasint(lda), (*C.float)(_b), C.blasint(ldb), C.float(beta), (*C.float)(_c), C.blasint(ldc))
 }
 
 // Ssymm performs one of the matrix-matrix operations
@@ -5750,15 +7141,18 @@ func (Implementation) Sgemm(tA, tB blas.Transpose, m, n, k int, alpha float32, a
 // where A is an n×n or m×m symmetric matrix, B and C are m×n matrices, and alpha
 // is a scalar.
 func (Implementation) Ssymm(s blas.Side, ul blas.Uplo, m, n int, alpha float32, a []float32, lda int, b []float32, ldb int, beta float32, c []float32, ldc int) {
-	// declared at cblas.h:445:6 void cblas_ssymm ...
+	// declared at cblas.h:455:6 void cblas_ssymm ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Ssymm", s, ul, m, n, alpha, lda, ldb, beta, ldc)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Ssymm", Param: "ul", Message: badUplo})
 	}
 	switch s {
 	case blas.Left:
@@ -5766,13 +7160,13 @@ func (Implementation) Ssymm(s blas.Side, ul blas.Uplo, m, n int, alpha float32,
 	case blas.Right:
 		s = C.CblasRight
 	default:
-		panic(badSide)
+		panic(Error{Routine: "Ssymm", Param: "s", Message: badSide})
 	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Ssymm", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Ssymm", Param: "n", Message: nLT0})
 	}
 	var k int
 	if s == C.CblasLeft {
@@ -5781,13 +7175,13 @@ func (Implementation) Ssymm(s blas.Side, ul blas.Uplo, m, n int, alpha float32,
 		k = n
 	}
 	if lda < max(1, k) {
-		panic(badLdA)
+		panic(Error{Routine: "Ssymm", Param: "lda", Message: badLdA})
 	}
 	if ldb < max(1, n) {
-		panic(badLdB)
+		panic(Error{Routine: "Ssymm", Param: "ldb", Message: badLdB})
 	}
 	if ldc < max(1, n) {
-		panic(badLdC)
+		panic(Error{Routine: "Ssymm", Param: "ldc", Message: badLdC})
 	}
 
 	// Quick return if possible.
@@ -5797,13 +7191,13 @@ func (Implementation) Ssymm(s blas.Side, ul blas.Uplo, m, n int, alpha float32,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(k-1)+k {
-		panic(shortA)
+		panic(Error{Routine: "Ssymm", Param: "a", Message: shortA})
 	}
 	if len(b) < ldb*(m-1)+n {
-		panic(shortB)
+		panic(Error{Routine: "Ssymm", Param: "b", Message: shortB})
 	}
 	if len(c) < ldc*(m-1)+n {
-		panic(shortC)
+		panic(Error{Routine: "Ssymm", Param: "c", Message: shortC})
 	}
 	var _a *float32
 	if len(a) > 0 {
@@ -5817,7 +7211,23 @@ func (Implementation) Ssymm(s blas.Side, ul blas.Uplo, m, n int, alpha float32,
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_ssymm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.int(m), C.int(n), C.float(alpha), (*C.float)(_a), C.int(lda), (*C.float)(_b), C.int(ldb), C.float(beta), (*C.float)(_c), C.int(ldc))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Ssymm", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Ssymm", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Ssymm", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldb < minInt || ldb > maxInt {
+		panic(Error{Routine: "Ssymm", Param: "ldb", Message: ldbTooLarge})
+	}
+	if ldc < minInt || ldc > maxInt {
+		panic(Error{Routine: "Ssymm", Param: "ldc", Message: ldcTooLarge})
+	}
+	defer admit(2 * sideFlops(s == C.CblasLeft, m, n))()
+	C.cblas_ssymm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.blasint(m), C.blasint(n), C.float(alpha), (*C.float)(_a), C.blasint(lda), (*C.float)(_b), C.blasint(ldb), C.float(beta), (*C.float)(_c), C.blasint(ldc))
 }
 
 // Ssyrk performs one of the symmetric rank-k operations
@@ -5826,8 +7236,11 @@ func (Implementation) Ssymm(s blas.Side, ul blas.Uplo, m, n int, alpha float32,
 // where A is an n×k or k×n matrix, C is an n×n symmetric matrix, and alpha and
 // beta are scalars.
 func (Implementation) Ssyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha float32, a []float32, lda int, beta float32, c []float32, ldc int) {
-	// declared at cblas.h:450:6 void cblas_ssyrk ...
+	// declared at cblas.h:460:6 void cblas_ssyrk ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Ssyrk", ul, t, n, k, alpha, lda, beta, ldc)()
+	}
 	switch t {
 	case blas.NoTrans:
 		t = C.CblasNoTrans
@@ -5836,7 +7249,7 @@ func (Implementation) Ssyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha floa
 	case blas.ConjTrans:
 		t = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Ssyrk", Param: "t", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -5844,13 +7257,13 @@ func (Implementation) Ssyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha floa
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Ssyrk", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Ssyrk", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Ssyrk", Param: "k", Message: kLT0})
 	}
 	var row, col int
 	if t == C.CblasNoTrans {
@@ -5859,10 +7272,10 @@ func (Implementation) Ssyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha floa
 		row, col = k, n
 	}
 	if lda < max(1, col) {
-		panic(badLdA)
+		panic(Error{Routine: "Ssyrk", Param: "lda", Message: badLdA})
 	}
 	if ldc < max(1, n) {
-		panic(badLdC)
+		panic(Error{Routine: "Ssyrk", Param: "ldc", Message: badLdC})
 	}
 
 	// Quick return if possible.
@@ -5872,10 +7285,10 @@ func (Implementation) Ssyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha floa
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(row-1)+col {
-		panic(shortA)
+		panic(Error{Routine: "Ssyrk", Param: "a", Message: shortA})
 	}
 	if len(c) < ldc*(n-1)+n {
-		panic(shortC)
+		panic(Error{Routine: "Ssyrk", Param: "c", Message: shortC})
 	}
 	var _a *float32
 	if len(a) > 0 {
@@ -5885,7 +7298,20 @@ func (Implementation) Ssyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha floa
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_ssyrk(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(t), C.int(n), C.int(k), C.float(alpha), (*C.float)(_a), C.int(lda), C.float(beta), (*C.float)(_c), C.int(ldc))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Ssyrk", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Ssyrk", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Ssyrk", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldc < minInt || ldc > maxInt {
+		panic(Error{Routine: "Ssyrk", Param: "ldc", Message: ldcTooLarge})
+	}
+	defer admit(float64(n) * float64(n) * float64(k))()
+	C.cblas_ssyrk(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(t), C.blasint(n), C.blasint(k), C.float(alpha), (*C.float)(_a), C.blasint(lda), C.float(beta), (*C.float)(_c), C.blasint(ldc))
 }
 
 // Ssyr2k performs one of the symmetric rank 2k operations
@@ -5894,8 +7320,11 @@ func (Implementation) Ssyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha floa
 // where A and B are n×k or k×n matrices, C is an n×n symmetric matrix, and
 // alpha and beta are scalars.
 func (Implementation) Ssyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha float32, a []float32, lda int, b []float32, ldb int, beta float32, c []float32, ldc int) {
-	// declared at cblas.h:454:6 void cblas_ssyr2k ...
+	// declared at cblas.h:464:6 void cblas_ssyr2k ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Ssyr2k", ul, t, n, k, alpha, lda, ldb, beta, ldc)()
+	}
 	switch t {
 	case blas.NoTrans:
 		t = C.CblasNoTrans
@@ -5904,7 +7333,7 @@ func (Implementation) Ssyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha flo
 	case blas.ConjTrans:
 		t = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Ssyr2k", Param: "t", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -5912,13 +7341,13 @@ func (Implementation) Ssyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha flo
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Ssyr2k", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Ssyr2k", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Ssyr2k", Param: "k", Message: kLT0})
 	}
 	var row, col int
 	if t == C.CblasNoTrans {
@@ -5927,13 +7356,13 @@ func (Implementation) Ssyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha flo
 		row, col = k, n
 	}
 	if lda < max(1, col) {
-		panic(badLdA)
+		panic(Error{Routine: "Ssyr2k", Param: "lda", Message: badLdA})
 	}
 	if ldb < max(1, col) {
-		panic(badLdB)
+		panic(Error{Routine: "Ssyr2k", Param: "ldb", Message: badLdB})
 	}
 	if ldc < max(1, n) {
-		panic(badLdC)
+		panic(Error{Routine: "Ssyr2k", Param: "ldc", Message: badLdC})
 	}
 
 	// Quick return if possible.
@@ -5943,13 +7372,13 @@ func (Implementation) Ssyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha flo
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(row-1)+col {
-		panic(shortA)
+		panic(Error{Routine: "Ssyr2k", Param: "a", Message: shortA})
 	}
 	if len(b) < ldb*(row-1)+col {
-		panic(shortB)
+		panic(Error{Routine: "Ssyr2k", Param: "b", Message: shortB})
 	}
 	if len(c) < ldc*(n-1)+n {
-		panic(shortC)
+		panic(Error{Routine: "Ssyr2k", Param: "c", Message: shortC})
 	}
 	var _a *float32
 	if len(a) > 0 {
@@ -5963,7 +7392,23 @@ func (Implementation) Ssyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha flo
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_ssyr2k(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(t), C.int(n), C.int(k), C.float(alpha), (*C.float)(_a), C.int(lda), (*C.float)(_b), C.int(ldb), C.float(beta), (*C.float)(_c), C.int(ldc))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Ssyr2k", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Ssyr2k", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Ssyr2k", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldb < minInt || ldb > maxInt {
+		panic(Error{Routine: "Ssyr2k", Param: "ldb", Message: ldbTooLarge})
+	}
+	if ldc < minInt || ldc > maxInt {
+		panic(Error{Routine: "Ssyr2k", Param: "ldc", Message: ldcTooLarge})
+	}
+	defer admit(2 * float64(n) * float64(n) * float64(k))()
+	C.cblas_ssyr2k(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(t), C.blasint(n), C.blasint(k), C.float(alpha), (*C.float)(_a), C.blasint(lda), (*C.float)(_b), C.blasint(ldb), C.float(beta), (*C.float)(_c), C.blasint(ldc))
 }
 
 // Strmm performs one of the matrix-matrix operations
@@ -5973,8 +7418,11 @@ func (Implementation) Ssyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha flo
 //  B = alpha * B * Aᵀ  if tA == blas.Trans or blas.ConjTrans, and side == blas.Right
 // where A is an n×n or m×m triangular matrix, B is an m×n matrix, and alpha is a scalar.
 func (Implementation) Strmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float32, a []float32, lda int, b []float32, ldb int) {
-	// declared at cblas.h:459:6 void cblas_strmm ...
+	// declared at cblas.h:469:6 void cblas_strmm ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Strmm", s, ul, tA, d, m, n, alpha, lda, ldb)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -5983,7 +7431,7 @@ func (Implementation) Strmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Strmm", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -5991,7 +7439,7 @@ func (Implementation) Strmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Strmm", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -5999,7 +7447,7 @@ func (Implementation) Strmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Strmm", Param: "d", Message: badDiag})
 	}
 	switch s {
 	case blas.Left:
@@ -6007,13 +7455,13 @@ func (Implementation) Strmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.Right:
 		s = C.CblasRight
 	default:
-		panic(badSide)
+		panic(Error{Routine: "Strmm", Param: "s", Message: badSide})
 	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Strmm", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Strmm", Param: "n", Message: nLT0})
 	}
 	var k int
 	if s == C.CblasLeft {
@@ -6022,10 +7470,10 @@ func (Implementation) Strmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 		k = n
 	}
 	if lda < max(1, k) {
-		panic(badLdA)
+		panic(Error{Routine: "Strmm", Param: "lda", Message: badLdA})
 	}
 	if ldb < max(1, n) {
-		panic(badLdB)
+		panic(Error{Routine: "Strmm", Param: "ldb", Message: badLdB})
 	}
 
 	// Quick return if possible.
@@ -6035,10 +7483,10 @@ func (Implementation) Strmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(k-1)+k {
-		panic(shortA)
+		panic(Error{Routine: "Strmm", Param: "a", Message: shortA})
 	}
 	if len(b) < ldb*(m-1)+n {
-		panic(shortB)
+		panic(Error{Routine: "Strmm", Param: "b", Message: shortB})
 	}
 	var _a *float32
 	if len(a) > 0 {
@@ -6048,7 +7496,20 @@ func (Implementation) Strmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	C.cblas_strmm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(m), C.int(n), C.float(alpha), (*C.float)(_a), C.int(lda), (*C.float)(_b), C.int(ldb))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Strmm", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Strmm", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Strmm", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldb < minInt || ldb > maxInt {
+		panic(Error{Routine: "Strmm", Param: "ldb", Message: ldbTooLarge})
+	}
+	defer admit(sideFlops(s == C.CblasLeft, m, n))()
+	C.cblas_strmm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(m), C.blasint(n), C.float(alpha), (*C.float)(_a), C.blasint(lda), (*C.float)(_b), C.blasint(ldb))
 }
 
 // Strsm solves one of the matrix equations
@@ -6064,8 +7525,11 @@ func (Implementation) Strmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 //
 // No check is made that A is invertible.
 func (Implementation) Strsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float32, a []float32, lda int, b []float32, ldb int) {
-	// declared at cblas.h:464:6 void cblas_strsm ...
+	// declared at cblas.h:474:6 void cblas_strsm ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Strsm", s, ul, tA, d, m, n, alpha, lda, ldb)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -6074,7 +7538,7 @@ func (Implementation) Strsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Strsm", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -6082,7 +7546,7 @@ func (Implementation) Strsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Strsm", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -6090,7 +7554,7 @@ func (Implementation) Strsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Strsm", Param: "d", Message: badDiag})
 	}
 	switch s {
 	case blas.Left:
@@ -6098,13 +7562,13 @@ func (Implementation) Strsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.Right:
 		s = C.CblasRight
 	default:
-		panic(badSide)
+		panic(Error{Routine: "Strsm", Param: "s", Message: badSide})
 	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Strsm", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Strsm", Param: "n", Message: nLT0})
 	}
 	var k int
 	if s == C.CblasLeft {
@@ -6113,10 +7577,10 @@ func (Implementation) Strsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 		k = n
 	}
 	if lda < max(1, k) {
-		panic(badLdA)
+		panic(Error{Routine: "Strsm", Param: "lda", Message: badLdA})
 	}
 	if ldb < max(1, n) {
-		panic(badLdB)
+		panic(Error{Routine: "Strsm", Param: "ldb", Message: badLdB})
 	}
 
 	// Quick return if possible.
@@ -6126,10 +7590,10 @@ func (Implementation) Strsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(k-1)+k {
-		panic(shortA)
+		panic(Error{Routine: "Strsm", Param: "a", Message: shortA})
 	}
 	if len(b) < ldb*(m-1)+n {
-		panic(shortB)
+		panic(Error{Routine: "Strsm", Param: "b", Message: shortB})
 	}
 	var _a *float32
 	if len(a) > 0 {
@@ -6139,7 +7603,20 @@ func (Implementation) Strsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	C.cblas_strsm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(m), C.int(n), C.float(alpha), (*C.float)(_a), C.int(lda), (*C.float)(_b), C.int(ldb))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Strsm", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Strsm", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Strsm", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldb < minInt || ldb > maxInt {
+		panic(Error{Routine: "Strsm", Param: "ldb", Message: ldbTooLarge})
+	}
+	defer admit(sideFlops(s == C.CblasLeft, m, n))()
+	C.cblas_strsm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(m), C.blasint(n), C.float(alpha), (*C.float)(_a), C.blasint(lda), (*C.float)(_b), C.blasint(ldb))
 }
 
 // Dgemm performs one of the matrix-matrix operations
@@ -6151,8 +7628,11 @@ func (Implementation) Strsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 // an m×n matrix, and alpha and beta are scalars. tA and tB specify whether A or
 // B are transposed.
 func (Implementation) Dgemm(tA, tB blas.Transpose, m, n, k int, alpha float64, a []float64, lda int, b []float64, ldb int, beta float64, c []float64, ldc int) {
-	// declared at cblas.h:470:6 void cblas_dgemm ...
+	// declared at cblas.h:480:6 void cblas_dgemm ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dgemm", tA, tB, m, n, k, alpha, lda, ldb, beta, ldc)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -6161,7 +7641,7 @@ func (Implementation) Dgemm(tA, tB blas.Transpose, m, n, k int, alpha float64, a
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Dgemm", Param: "tA", Message: badTranspose})
 	}
 	switch tB {
 	case blas.NoTrans:
@@ -6171,16 +7651,16 @@ func (Implementation) Dgemm(tA, tB blas.Transpose, m, n, k int, alpha float64, a
 	case blas.ConjTrans:
 		tB = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Dgemm", Param: "tB", Message: badTranspose})
 	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Dgemm", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dgemm", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Dgemm", Param: "k", Message: kLT0})
 	}
 	var rowA, colA, rowB, colB int
 	if tA == C.CblasNoTrans {
@@ -6194,13 +7674,13 @@ func (Implementation) Dgemm(tA, tB blas.Transpose, m, n, k int, alpha float64, a
 		rowB, colB = n, k
 	}
 	if lda < max(1, colA) {
-		panic(badLdA)
+		panic(Error{Routine: "Dgemm", Param: "lda", Message: badLdA})
 	}
 	if ldb < max(1, colB) {
-		panic(badLdB)
+		panic(Error{Routine: "Dgemm", Param: "ldb", Message: badLdB})
 	}
 	if ldc < max(1, n) {
-		panic(badLdC)
+		panic(Error{Routine: "Dgemm", Param: "ldc", Message: badLdC})
 	}
 
 	// Quick return if possible.
@@ -6210,13 +7690,13 @@ func (Implementation) Dgemm(tA, tB blas.Transpose, m, n, k int, alpha float64, a
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(rowA-1)+colA {
-		panic(shortA)
+		panic(Error{Routine: "Dgemm", Param: "a", Message: shortA})
 	}
 	if len(b) < ldb*(rowB-1)+colB {
-		panic(shortB)
+		panic(Error{Routine: "Dgemm", Param: "b", Message: shortB})
 	}
 	if len(c) < ldc*(m-1)+n {
-		panic(shortC)
+		panic(Error{Routine: "Dgemm", Param: "c", Message: shortC})
 	}
 	var _a *float64
 	if len(a) > 0 {
@@ -6230,7 +7710,26 @@ func (Implementation) Dgemm(tA, tB blas.Transpose, m, n, k int, alpha float64, a
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_dgemm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_TRANSPOSE(tB), C.int(m), C.int(n), C.int(k), C.double(alpha), (*C.double)(_a), C.int(lda), (*C.double)(_b), C.int(ldb), C.double(beta), (*C.double)(_c), C.int(ldc))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Dgemm", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dgemm", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Dgemm", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Dgemm", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldb < minInt || ldb > maxInt {
+		panic(Error{Routine: "Dgemm", Param: "ldb", Message: ldbTooLarge})
+	}
+	if ldc < minInt || ldc > maxInt {
+		panic(Error{Routine: "Dgemm", Param: "ldc", Message: ldcTooLarge})
+	}
+	defer admit(2 * float64(m) * float64(n) * float64(k))()
+	C.cblas_dgemm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_TRANSPOSE(tB), C.blasint(m), C.blasint(n), C.blasint(k), C.double(alpha), (*C.double)(_a), C.blasint(lda), (*C.double)(_b), C.blasint(ldb), C.double(beta), (*C.double)(_c), C.blasint(ldc))
 }
 
 // Dsymm performs one of the matrix-matrix operations
@@ -6239,15 +7738,18 @@ func (Implementation) Dgemm(tA, tB blas.Transpose, m, n, k int, alpha float64, a
 // where A is an n×n or m×m symmetric matrix, B and C are m×n matrices, and alpha
 // is a scalar.
 func (Implementation) Dsymm(s blas.Side, ul blas.Uplo, m, n int, alpha float64, a []float64, lda int, b []float64, ldb int, beta float64, c []float64, ldc int) {
-	// declared at cblas.h:475:6 void cblas_dsymm ...
+	// declared at cblas.h:485:6 void cblas_dsymm ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dsymm", s, ul, m, n, alpha, lda, ldb, beta, ldc)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Dsymm", Param: "ul", Message: badUplo})
 	}
 	switch s {
 	case blas.Left:
@@ -6255,13 +7757,13 @@ func (Implementation) Dsymm(s blas.Side, ul blas.Uplo, m, n int, alpha float64,
 	case blas.Right:
 		s = C.CblasRight
 	default:
-		panic(badSide)
+		panic(Error{Routine: "Dsymm", Param: "s", Message: badSide})
 	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Dsymm", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dsymm", Param: "n", Message: nLT0})
 	}
 	var k int
 	if s == C.CblasLeft {
@@ -6270,13 +7772,13 @@ func (Implementation) Dsymm(s blas.Side, ul blas.Uplo, m, n int, alpha float64,
 		k = n
 	}
 	if lda < max(1, k) {
-		panic(badLdA)
+		panic(Error{Routine: "Dsymm", Param: "lda", Message: badLdA})
 	}
 	if ldb < max(1, n) {
-		panic(badLdB)
+		panic(Error{Routine: "Dsymm", Param: "ldb", Message: badLdB})
 	}
 	if ldc < max(1, n) {
-		panic(badLdC)
+		panic(Error{Routine: "Dsymm", Param: "ldc", Message: badLdC})
 	}
 
 	// Quick return if possible.
@@ -6286,13 +7788,13 @@ func (Implementation) Dsymm(s blas.Side, ul blas.Uplo, m, n int, alpha float64,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(k-1)+k {
-		panic(shortA)
+		panic(Error{Routine: "Dsymm", Param: "a", Message: shortA})
 	}
 	if len(b) < ldb*(m-1)+n {
-		panic(shortB)
+		panic(Error{Routine: "Dsymm", Param: "b", Message: shortB})
 	}
 	if len(c) < ldc*(m-1)+n {
-		panic(shortC)
+		panic(Error{Routine: "Dsymm", Param: "c", Message: shortC})
 	}
 	var _a *float64
 	if len(a) > 0 {
@@ -6306,7 +7808,23 @@ func (Implementation) Dsymm(s blas.Side, ul blas.Uplo, m, n int, alpha float64,
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_dsymm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.int(m), C.int(n), C.double(alpha), (*C.double)(_a), C.int(lda), (*C.double)(_b), C.int(ldb), C.double(beta), (*C.double)(_c), C.int(ldc))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Dsymm", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dsymm", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Dsymm", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldb < minInt || ldb > maxInt {
+		panic(Error{Routine: "Dsymm", Param: "ldb", Message: ldbTooLarge})
+	}
+	if ldc < minInt || ldc > maxInt {
+		panic(Error{Routine: "Dsymm", Param: "ldc", Message: ldcTooLarge})
+	}
+	defer admit(2 * sideFlops(s == C.CblasLeft, m, n))()
+	C.cblas_dsymm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.blasint(m), C.blasint(n), C.double(alpha), (*C.double)(_a), C.blasint(lda), (*C.double)(_b), C.blasint(ldb), C.double(beta), (*C.double)(_c), C.blasint(ldc))
 }
 
 // Dsyrk performs one of the symmetric rank-k operations
@@ -6315,8 +7833,11 @@ func (Implementation) Dsymm(s blas.Side, ul blas.Uplo, m, n int, alpha float64,
 // where A is an n×k or k×n matrix, C is an n×n symmetric matrix, and alpha and
 // beta are scalars.
 func (Implementation) Dsyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha float64, a []float64, lda int, beta float64, c []float64, ldc int) {
-	// declared at cblas.h:480:6 void cblas_dsyrk ...
+	// declared at cblas.h:490:6 void cblas_dsyrk ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dsyrk", ul, t, n, k, alpha, lda, beta, ldc)()
+	}
 	switch t {
 	case blas.NoTrans:
 		t = C.CblasNoTrans
@@ -6325,7 +7846,7 @@ func (Implementation) Dsyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha floa
 	case blas.ConjTrans:
 		t = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Dsyrk", Param: "t", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -6333,13 +7854,13 @@ func (Implementation) Dsyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha floa
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Dsyrk", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dsyrk", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Dsyrk", Param: "k", Message: kLT0})
 	}
 	var row, col int
 	if t == C.CblasNoTrans {
@@ -6348,10 +7869,10 @@ func (Implementation) Dsyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha floa
 		row, col = k, n
 	}
 	if lda < max(1, col) {
-		panic(badLdA)
+		panic(Error{Routine: "Dsyrk", Param: "lda", Message: badLdA})
 	}
 	if ldc < max(1, n) {
-		panic(badLdC)
+		panic(Error{Routine: "Dsyrk", Param: "ldc", Message: badLdC})
 	}
 
 	// Quick return if possible.
@@ -6361,10 +7882,10 @@ func (Implementation) Dsyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha floa
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(row-1)+col {
-		panic(shortA)
+		panic(Error{Routine: "Dsyrk", Param: "a", Message: shortA})
 	}
 	if len(c) < ldc*(n-1)+n {
-		panic(shortC)
+		panic(Error{Routine: "Dsyrk", Param: "c", Message: shortC})
 	}
 	var _a *float64
 	if len(a) > 0 {
@@ -6374,7 +7895,20 @@ func (Implementation) Dsyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha floa
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_dsyrk(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(t), C.int(n), C.int(k), C.double(alpha), (*C.double)(_a), C.int(lda), C.double(beta), (*C.double)(_c), C.int(ldc))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dsyrk", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Dsyrk", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Dsyrk", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldc < minInt || ldc > maxInt {
+		panic(Error{Routine: "Dsyrk", Param: "ldc", Message: ldcTooLarge})
+	}
+	defer admit(float64(n) * float64(n) * float64(k))()
+	C.cblas_dsyrk(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(t), C.blasint(n), C.blasint(k), C.double(alpha), (*C.double)(_a), C.blasint(lda), C.double(beta), (*C.double)(_c), C.blasint(ldc))
 }
 
 // Dsyr2k performs one of the symmetric rank 2k operations
@@ -6383,8 +7917,11 @@ func (Implementation) Dsyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha floa
 // where A and B are n×k or k×n matrices, C is an n×n symmetric matrix, and
 // alpha and beta are scalars.
 func (Implementation) Dsyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha float64, a []float64, lda int, b []float64, ldb int, beta float64, c []float64, ldc int) {
-	// declared at cblas.h:484:6 void cblas_dsyr2k ...
+	// declared at cblas.h:494:6 void cblas_dsyr2k ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dsyr2k", ul, t, n, k, alpha, lda, ldb, beta, ldc)()
+	}
 	switch t {
 	case blas.NoTrans:
 		t = C.CblasNoTrans
@@ -6393,7 +7930,7 @@ func (Implementation) Dsyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha flo
 	case blas.ConjTrans:
 		t = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Dsyr2k", Param: "t", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -6401,13 +7938,13 @@ func (Implementation) Dsyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha flo
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Dsyr2k", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dsyr2k", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Dsyr2k", Param: "k", Message: kLT0})
 	}
 	var row, col int
 	if t == C.CblasNoTrans {
@@ -6416,13 +7953,13 @@ func (Implementation) Dsyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha flo
 		row, col = k, n
 	}
 	if lda < max(1, col) {
-		panic(badLdA)
+		panic(Error{Routine: "Dsyr2k", Param: "lda", Message: badLdA})
 	}
 	if ldb < max(1, col) {
-		panic(badLdB)
+		panic(Error{Routine: "Dsyr2k", Param: "ldb", Message: badLdB})
 	}
 	if ldc < max(1, n) {
-		panic(badLdC)
+		panic(Error{Routine: "Dsyr2k", Param: "ldc", Message: badLdC})
 	}
 
 	// Quick return if possible.
@@ -6432,13 +7969,13 @@ func (Implementation) Dsyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha flo
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(row-1)+col {
-		panic(shortA)
+		panic(Error{Routine: "Dsyr2k", Param: "a", Message: shortA})
 	}
 	if len(b) < ldb*(row-1)+col {
-		panic(shortB)
+		panic(Error{Routine: "Dsyr2k", Param: "b", Message: shortB})
 	}
 	if len(c) < ldc*(n-1)+n {
-		panic(shortC)
+		panic(Error{Routine: "Dsyr2k", Param: "c", Message: shortC})
 	}
 	var _a *float64
 	if len(a) > 0 {
@@ -6452,7 +7989,23 @@ func (Implementation) Dsyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha flo
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_dsyr2k(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(t), C.int(n), C.int(k), C.double(alpha), (*C.double)(_a), C.int(lda), (*C.double)(_b), C.int(ldb), C.double(beta), (*C.double)(_c), C.int(ldc))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dsyr2k", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Dsyr2k", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Dsyr2k", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldb < minInt || ldb > maxInt {
+		panic(Error{Routine: "Dsyr2k", Param: "ldb", Message: ldbTooLarge})
+	}
+	if ldc < minInt || ldc > maxInt {
+		panic(Error{Routine: "Dsyr2k", Param: "ldc", Message: ldcTooLarge})
+	}
+	defer admit(2 * float64(n) * float64(n) * float64(k))()
+	C.cblas_dsyr2k(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(t), C.blasint(n), C.blasint(k), C.double(alpha), (*C.double)(_a), C.blasint(lda), (*C.double)(_b), C.blasint(ldb), C.double(beta), (*C.double)(_c), C.blasint(ldc))
 }
 
 // Dtrmm performs one of the matrix-matrix operations
@@ -6462,8 +8015,11 @@ func (Implementation) Dsyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha flo
 //  B = alpha * B * Aᵀ  if tA == blas.Trans or blas.ConjTrans, and side == blas.Right
 // where A is an n×n or m×m triangular matrix, B is an m×n matrix, and alpha is a scalar.
 func (Implementation) Dtrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float64, a []float64, lda int, b []float64, ldb int) {
-	// declared at cblas.h:489:6 void cblas_dtrmm ...
+	// declared at cblas.h:499:6 void cblas_dtrmm ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dtrmm", s, ul, tA, d, m, n, alpha, lda, ldb)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -6472,7 +8028,7 @@ func (Implementation) Dtrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Dtrmm", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -6480,7 +8036,7 @@ func (Implementation) Dtrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Dtrmm", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -6488,7 +8044,7 @@ func (Implementation) Dtrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Dtrmm", Param: "d", Message: badDiag})
 	}
 	switch s {
 	case blas.Left:
@@ -6496,13 +8052,13 @@ func (Implementation) Dtrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.Right:
 		s = C.CblasRight
 	default:
-		panic(badSide)
+		panic(Error{Routine: "Dtrmm", Param: "s", Message: badSide})
 	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Dtrmm", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dtrmm", Param: "n", Message: nLT0})
 	}
 	var k int
 	if s == C.CblasLeft {
@@ -6511,10 +8067,10 @@ func (Implementation) Dtrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 		k = n
 	}
 	if lda < max(1, k) {
-		panic(badLdA)
+		panic(Error{Routine: "Dtrmm", Param: "lda", Message: badLdA})
 	}
 	if ldb < max(1, n) {
-		panic(badLdB)
+		panic(Error{Routine: "Dtrmm", Param: "ldb", Message: badLdB})
 	}
 
 	// Quick return if possible.
@@ -6524,10 +8080,10 @@ func (Implementation) Dtrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(k-1)+k {
-		panic(shortA)
+		panic(Error{Routine: "Dtrmm", Param: "a", Message: shortA})
 	}
 	if len(b) < ldb*(m-1)+n {
-		panic(shortB)
+		panic(Error{Routine: "Dtrmm", Param: "b", Message: shortB})
 	}
 	var _a *float64
 	if len(a) > 0 {
@@ -6537,7 +8093,20 @@ func (Implementation) Dtrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	C.cblas_dtrmm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(m), C.int(n), C.double(alpha), (*C.double)(_a), C.int(lda), (*C.double)(_b), C.int(ldb))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Dtrmm", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dtrmm", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Dtrmm", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldb < minInt || ldb > maxInt {
+		panic(Error{Routine: "Dtrmm", Param: "ldb", Message: ldbTooLarge})
+	}
+	defer admit(sideFlops(s == C.CblasLeft, m, n))()
+	C.cblas_dtrmm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(m), C.blasint(n), C.double(alpha), (*C.double)(_a), C.blasint(lda), (*C.double)(_b), C.blasint(ldb))
 }
 
 // Dtrsm solves one of the matrix equations
@@ -6553,8 +8122,11 @@ func (Implementation) Dtrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 //
 // No check is made that A is invertible.
 func (Implementation) Dtrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float64, a []float64, lda int, b []float64, ldb int) {
-	// declared at cblas.h:494:6 void cblas_dtrsm ...
+	// declared at cblas.h:504:6 void cblas_dtrsm ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dtrsm", s, ul, tA, d, m, n, alpha, lda, ldb)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -6563,7 +8135,7 @@ func (Implementation) Dtrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Dtrsm", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -6571,7 +8143,7 @@ func (Implementation) Dtrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Dtrsm", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -6579,7 +8151,7 @@ func (Implementation) Dtrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Dtrsm", Param: "d", Message: badDiag})
 	}
 	switch s {
 	case blas.Left:
@@ -6587,13 +8159,13 @@ func (Implementation) Dtrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.Right:
 		s = C.CblasRight
 	default:
-		panic(badSide)
+		panic(Error{Routine: "Dtrsm", Param: "s", Message: badSide})
 	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Dtrsm", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dtrsm", Param: "n", Message: nLT0})
 	}
 	var k int
 	if s == C.CblasLeft {
@@ -6602,10 +8174,10 @@ func (Implementation) Dtrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 		k = n
 	}
 	if lda < max(1, k) {
-		panic(badLdA)
+		panic(Error{Routine: "Dtrsm", Param: "lda", Message: badLdA})
 	}
 	if ldb < max(1, n) {
-		panic(badLdB)
+		panic(Error{Routine: "Dtrsm", Param: "ldb", Message: badLdB})
 	}
 
 	// Quick return if possible.
@@ -6615,10 +8187,10 @@ func (Implementation) Dtrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(k-1)+k {
-		panic(shortA)
+		panic(Error{Routine: "Dtrsm", Param: "a", Message: shortA})
 	}
 	if len(b) < ldb*(m-1)+n {
-		panic(shortB)
+		panic(Error{Routine: "Dtrsm", Param: "b", Message: shortB})
 	}
 	var _a *float64
 	if len(a) > 0 {
@@ -6628,7 +8200,20 @@ func (Implementation) Dtrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	C.cblas_dtrsm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(m), C.int(n), C.double(alpha), (*C.double)(_a), C.int(lda), (*C.double)(_b), C.int(ldb))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Dtrsm", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Dtrsm", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Dtrsm", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldb < minInt || ldb > maxInt {
+		panic(Error{Routine: "Dtrsm", Param: "ldb", Message: ldbTooLarge})
+	}
+	defer admit(sideFlops(s == C.CblasLeft, m, n))()
+	C.cblas_dtrsm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(m), C.blasint(n), C.double(alpha), (*C.double)(_a), C.blasint(lda), (*C.double)(_b), C.blasint(ldb))
 }
 
 // Cgemm performs one of the matrix-matrix operations
@@ -6640,8 +8225,11 @@ func (Implementation) Dtrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Cgemm(tA, tB blas.Transpose, m, n, k int, alpha complex64, a []complex64, lda int, b []complex64, ldb int, beta complex64, c []complex64, ldc int) {
-	// declared at cblas.h:500:6 void cblas_cgemm ...
+	// declared at cblas.h:510:6 void cblas_cgemm ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Cgemm", tA, tB, m, n, k, alpha, lda, ldb, beta, ldc)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -6650,7 +8238,7 @@ func (Implementation) Cgemm(tA, tB blas.Transpose, m, n, k int, alpha complex64,
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Cgemm", Param: "tA", Message: badTranspose})
 	}
 	switch tB {
 	case blas.NoTrans:
@@ -6660,16 +8248,16 @@ func (Implementation) Cgemm(tA, tB blas.Transpose, m, n, k int, alpha complex64,
 	case blas.ConjTrans:
 		tB = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Cgemm", Param: "tB", Message: badTranspose})
 	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Cgemm", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Cgemm", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Cgemm", Param: "k", Message: kLT0})
 	}
 	var rowA, colA, rowB, colB int
 	if tA == C.CblasNoTrans {
@@ -6683,13 +8271,13 @@ func (Implementation) Cgemm(tA, tB blas.Transpose, m, n, k int, alpha complex64,
 		rowB, colB = n, k
 	}
 	if lda < max(1, colA) {
-		panic(badLdA)
+		panic(Error{Routine: "Cgemm", Param: "lda", Message: badLdA})
 	}
 	if ldb < max(1, colB) {
-		panic(badLdB)
+		panic(Error{Routine: "Cgemm", Param: "ldb", Message: badLdB})
 	}
 	if ldc < max(1, n) {
-		panic(badLdC)
+		panic(Error{Routine: "Cgemm", Param: "ldc", Message: badLdC})
 	}
 
 	// Quick return if possible.
@@ -6699,13 +8287,13 @@ func (Implementation) Cgemm(tA, tB blas.Transpose, m, n, k int, alpha complex64,
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(rowA-1)+colA {
-		panic(shortA)
+		panic(Error{Routine: "Cgemm", Param: "a", Message: shortA})
 	}
 	if len(b) < ldb*(rowB-1)+colB {
-		panic(shortB)
+		panic(Error{Routine: "Cgemm", Param: "b", Message: shortB})
 	}
 	if len(c) < ldc*(m-1)+n {
-		panic(shortC)
+		panic(Error{Routine: "Cgemm", Param: "c", Message: shortC})
 	}
 	var _a *complex64
 	if len(a) > 0 {
@@ -6719,7 +8307,26 @@ func (Implementation) Cgemm(tA, tB blas.Transpose, m, n, k int, alpha complex64,
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_cgemm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_TRANSPOSE(tB), C.int(m), C.int(n), C.int(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.int(ldc))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Cgemm", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Cgemm", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Cgemm", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Cgemm", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldb < minInt || ldb > maxInt {
+		panic(Error{Routine: "Cgemm", Param: "ldb", Message: ldbTooLarge})
+	}
+	if ldc < minInt || ldc > maxInt {
+		panic(Error{Routine: "Cgemm", Param: "ldc", Message: ldcTooLarge})
+	}
+	defer admit(4 * 2 * float64(m) * float64(n) * float64(k))()
+	C.cblas_cgemm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_TRANSPOSE(tB), C.blasint(m), C.blasint(n), C.blasint(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_b), C.blasint(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.blasint(ldc))
 }
 
 // Csymm performs one of the matrix-matrix operations
@@ -6730,15 +8337,18 @@ func (Implementation) Cgemm(tA, tB blas.Transpose, m, n, k int, alpha complex64,
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Csymm(s blas.Side, ul blas.Uplo, m, n int, alpha complex64, a []complex64, lda int, b []complex64, ldb int, beta complex64, c []complex64, ldc int) {
-	// declared at cblas.h:505:6 void cblas_csymm ...
+	// declared at cblas.h:515:6 void cblas_csymm ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Csymm", s, ul, m, n, alpha, lda, ldb, beta, ldc)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Csymm", Param: "ul", Message: badUplo})
 	}
 	switch s {
 	case blas.Left:
@@ -6746,13 +8356,13 @@ func (Implementation) Csymm(s blas.Side, ul blas.Uplo, m, n int, alpha complex64
 	case blas.Right:
 		s = C.CblasRight
 	default:
-		panic(badSide)
+		panic(Error{Routine: "Csymm", Param: "s", Message: badSide})
 	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Csymm", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Csymm", Param: "n", Message: nLT0})
 	}
 	var k int
 	if s == C.CblasLeft {
@@ -6761,13 +8371,13 @@ func (Implementation) Csymm(s blas.Side, ul blas.Uplo, m, n int, alpha complex64
 		k = n
 	}
 	if lda < max(1, k) {
-		panic(badLdA)
+		panic(Error{Routine: "Csymm", Param: "lda", Message: badLdA})
 	}
 	if ldb < max(1, n) {
-		panic(badLdB)
+		panic(Error{Routine: "Csymm", Param: "ldb", Message: badLdB})
 	}
 	if ldc < max(1, n) {
-		panic(badLdC)
+		panic(Error{Routine: "Csymm", Param: "ldc", Message: badLdC})
 	}
 
 	// Quick return if possible.
@@ -6777,13 +8387,13 @@ func (Implementation) Csymm(s blas.Side, ul blas.Uplo, m, n int, alpha complex64
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(k-1)+k {
-		panic(shortA)
+		panic(Error{Routine: "Csymm", Param: "a", Message: shortA})
 	}
 	if len(b) < ldb*(m-1)+n {
-		panic(shortB)
+		panic(Error{Routine: "Csymm", Param: "b", Message: shortB})
 	}
 	if len(c) < ldc*(m-1)+n {
-		panic(shortC)
+		panic(Error{Routine: "Csymm", Param: "c", Message: shortC})
 	}
 	var _a *complex64
 	if len(a) > 0 {
@@ -6797,7 +8407,23 @@ func (Implementation) Csymm(s blas.Side, ul blas.Uplo, m, n int, alpha complex64
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_csymm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.int(ldc))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Csymm", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Csymm", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Csymm", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldb < minInt || ldb > maxInt {
+		panic(Error{Routine: "Csymm", Param: "ldb", Message: ldbTooLarge})
+	}
+	if ldc < minInt || ldc > maxInt {
+		panic(Error{Routine: "Csymm", Param: "ldc", Message: ldcTooLarge})
+	}
+	defer admit(4 * 2 * sideFlops(s == C.CblasLeft, m, n))()
+	C.cblas_csymm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.blasint(m), C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_b), C.blasint(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.blasint(ldc))
 }
 
 // Csyrk performs one of the symmetric rank-k operations
@@ -6808,15 +8434,18 @@ func (Implementation) Csymm(s blas.Side, ul blas.Uplo, m, n int, alpha complex64
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Csyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha complex64, a []complex64, lda int, beta complex64, c []complex64, ldc int) {
-	// declared at cblas.h:510:6 void cblas_csyrk ...
+	// declared at cblas.h:520:6 void cblas_csyrk ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Csyrk", ul, t, n, k, alpha, lda, beta, ldc)()
+	}
 	switch t {
 	case blas.NoTrans:
 		t = C.CblasNoTrans
 	case blas.Trans:
 		t = C.CblasTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Csyrk", Param: "t", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -6824,13 +8453,13 @@ func (Implementation) Csyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha comp
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Csyrk", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Csyrk", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Csyrk", Param: "k", Message: kLT0})
 	}
 	var row, col int
 	if t == C.CblasNoTrans {
@@ -6839,10 +8468,10 @@ func (Implementation) Csyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha comp
 		row, col = k, n
 	}
 	if lda < max(1, col) {
-		panic(badLdA)
+		panic(Error{Routine: "Csyrk", Param: "lda", Message: badLdA})
 	}
 	if ldc < max(1, n) {
-		panic(badLdC)
+		panic(Error{Routine: "Csyrk", Param: "ldc", Message: badLdC})
 	}
 
 	// Quick return if possible.
@@ -6852,10 +8481,10 @@ func (Implementation) Csyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha comp
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(row-1)+col {
-		panic(shortA)
+		panic(Error{Routine: "Csyrk", Param: "a", Message: shortA})
 	}
 	if len(c) < ldc*(n-1)+n {
-		panic(shortC)
+		panic(Error{Routine: "Csyrk", Param: "c", Message: shortC})
 	}
 	var _a *complex64
 	if len(a) > 0 {
@@ -6865,7 +8494,20 @@ func (Implementation) Csyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha comp
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_csyrk(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(t), C.int(n), C.int(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.int(ldc))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Csyrk", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Csyrk", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Csyrk", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldc < minInt || ldc > maxInt {
+		panic(Error{Routine: "Csyrk", Param: "ldc", Message: ldcTooLarge})
+	}
+	defer admit(4 * float64(n) * float64(n) * float64(k))()
+	C.cblas_csyrk(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(t), C.blasint(n), C.blasint(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.blasint(ldc))
 }
 
 // Csyr2k performs one of the symmetric rank-2k operations
@@ -6876,15 +8518,18 @@ func (Implementation) Csyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha comp
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Csyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha complex64, a []complex64, lda int, b []complex64, ldb int, beta complex64, c []complex64, ldc int) {
-	// declared at cblas.h:514:6 void cblas_csyr2k ...
+	// declared at cblas.h:524:6 void cblas_csyr2k ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Csyr2k", ul, t, n, k, alpha, lda, ldb, beta, ldc)()
+	}
 	switch t {
 	case blas.NoTrans:
 		t = C.CblasNoTrans
 	case blas.Trans:
 		t = C.CblasTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Csyr2k", Param: "t", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -6892,13 +8537,13 @@ func (Implementation) Csyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha com
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Csyr2k", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Csyr2k", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Csyr2k", Param: "k", Message: kLT0})
 	}
 	var row, col int
 	if t == C.CblasNoTrans {
@@ -6907,13 +8552,13 @@ func (Implementation) Csyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha com
 		row, col = k, n
 	}
 	if lda < max(1, col) {
-		panic(badLdA)
+		panic(Error{Routine: "Csyr2k", Param: "lda", Message: badLdA})
 	}
 	if ldb < max(1, col) {
-		panic(badLdB)
+		panic(Error{Routine: "Csyr2k", Param: "ldb", Message: badLdB})
 	}
 	if ldc < max(1, n) {
-		panic(badLdC)
+		panic(Error{Routine: "Csyr2k", Param: "ldc", Message: badLdC})
 	}
 
 	// Quick return if possible.
@@ -6923,13 +8568,13 @@ func (Implementation) Csyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha com
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(row-1)+col {
-		panic(shortA)
+		panic(Error{Routine: "Csyr2k", Param: "a", Message: shortA})
 	}
 	if len(b) < ldb*(row-1)+col {
-		panic(shortB)
+		panic(Error{Routine: "Csyr2k", Param: "b", Message: shortB})
 	}
 	if len(c) < ldc*(n-1)+n {
-		panic(shortC)
+		panic(Error{Routine: "Csyr2k", Param: "c", Message: shortC})
 	}
 	var _a *complex64
 	if len(a) > 0 {
@@ -6943,7 +8588,23 @@ func (Implementation) Csyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha com
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_csyr2k(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(t), C.int(n), C.int(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.int(ldc))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Csyr2k", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Csyr2k", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Csyr2k", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldb < minInt || ldb > maxInt {
+		panic(Error{Routine: "Csyr2k", Param: "ldb", Message: ldbTooLarge})
+	}
+	if ldc < minInt || ldc > maxInt {
+		panic(Error{Routine: "Csyr2k", Param: "ldc", Message: ldcTooLarge})
+	}
+	defer admit(4 * 2 * float64(n) * float64(n) * float64(k))()
+	C.cblas_csyr2k(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(t), C.blasint(n), C.blasint(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_b), C.blasint(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.blasint(ldc))
 }
 
 // Ctrmm performs one of the matrix-matrix operations
@@ -6957,8 +8618,11 @@ func (Implementation) Csyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha com
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Ctrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha complex64, a []complex64, lda int, b []complex64, ldb int) {
-	// declared at cblas.h:519:6 void cblas_ctrmm ...
+	// declared at cblas.h:529:6 void cblas_ctrmm ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Ctrmm", s, ul, tA, d, m, n, alpha, lda, ldb)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -6967,7 +8631,7 @@ func (Implementation) Ctrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Ctrmm", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -6975,7 +8639,7 @@ func (Implementation) Ctrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Ctrmm", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -6983,7 +8647,7 @@ func (Implementation) Ctrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Ctrmm", Param: "d", Message: badDiag})
 	}
 	switch s {
 	case blas.Left:
@@ -6991,13 +8655,13 @@ func (Implementation) Ctrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.Right:
 		s = C.CblasRight
 	default:
-		panic(badSide)
+		panic(Error{Routine: "Ctrmm", Param: "s", Message: badSide})
 	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Ctrmm", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Ctrmm", Param: "n", Message: nLT0})
 	}
 	var k int
 	if s == C.CblasLeft {
@@ -7006,10 +8670,10 @@ func (Implementation) Ctrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 		k = n
 	}
 	if lda < max(1, k) {
-		panic(badLdA)
+		panic(Error{Routine: "Ctrmm", Param: "lda", Message: badLdA})
 	}
 	if ldb < max(1, n) {
-		panic(badLdB)
+		panic(Error{Routine: "Ctrmm", Param: "ldb", Message: badLdB})
 	}
 
 	// Quick return if possible.
@@ -7019,10 +8683,10 @@ func (Implementation) Ctrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(k-1)+k {
-		panic(shortA)
+		panic(Error{Routine: "Ctrmm", Param: "a", Message: shortA})
 	}
 	if len(b) < ldb*(m-1)+n {
-		panic(shortB)
+		panic(Error{Routine: "Ctrmm", Param: "b", Message: shortB})
 	}
 	var _a *complex64
 	if len(a) > 0 {
@@ -7032,7 +8696,20 @@ func (Implementation) Ctrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	C.cblas_ctrmm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Ctrmm", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Ctrmm", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Ctrmm", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldb < minInt || ldb > maxInt {
+		panic(Error{Routine: "Ctrmm", Param: "ldb", Message: ldbTooLarge})
+	}
+	defer admit(4 * sideFlops(s == C.CblasLeft, m, n))()
+	C.cblas_ctrmm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(m), C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_b), C.blasint(ldb))
 }
 
 // Ctrsm solves one of the matrix equations
@@ -7047,8 +8724,11 @@ func (Implementation) Ctrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Ctrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha complex64, a []complex64, lda int, b []complex64, ldb int) {
-	// declared at cblas.h:524:6 void cblas_ctrsm ...
+	// declared at cblas.h:534:6 void cblas_ctrsm ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Ctrsm", s, ul, tA, d, m, n, alpha, lda, ldb)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -7057,7 +8737,7 @@ func (Implementation) Ctrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Ctrsm", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -7065,7 +8745,7 @@ func (Implementation) Ctrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Ctrsm", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -7073,7 +8753,7 @@ func (Implementation) Ctrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Ctrsm", Param: "d", Message: badDiag})
 	}
 	switch s {
 	case blas.Left:
@@ -7081,13 +8761,13 @@ func (Implementation) Ctrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.Right:
 		s = C.CblasRight
 	default:
-		panic(badSide)
+		panic(Error{Routine: "Ctrsm", Param: "s", Message: badSide})
 	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Ctrsm", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Ctrsm", Param: "n", Message: nLT0})
 	}
 	var k int
 	if s == C.CblasLeft {
@@ -7096,10 +8776,10 @@ func (Implementation) Ctrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 		k = n
 	}
 	if lda < max(1, k) {
-		panic(badLdA)
+		panic(Error{Routine: "Ctrsm", Param: "lda", Message: badLdA})
 	}
 	if ldb < max(1, n) {
-		panic(badLdB)
+		panic(Error{Routine: "Ctrsm", Param: "ldb", Message: badLdB})
 	}
 
 	// Quick return if possible.
@@ -7109,10 +8789,10 @@ func (Implementation) Ctrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(k-1)+k {
-		panic(shortA)
+		panic(Error{Routine: "Ctrsm", Param: "a", Message: shortA})
 	}
 	if len(b) < ldb*(m-1)+n {
-		panic(shortB)
+		panic(Error{Routine: "Ctrsm", Param: "b", Message: shortB})
 	}
 	var _a *complex64
 	if len(a) > 0 {
@@ -7122,7 +8802,20 @@ func (Implementation) Ctrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	C.cblas_ctrsm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Ctrsm", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Ctrsm", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Ctrsm", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldb < minInt || ldb > maxInt {
+		panic(Error{Routine: "Ctrsm", Param: "ldb", Message: ldbTooLarge})
+	}
+	defer admit(4 * sideFlops(s == C.CblasLeft, m, n))()
+	C.cblas_ctrsm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(m), C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_b), C.blasint(ldb))
 }
 
 // Zgemm performs one of the matrix-matrix operations
@@ -7132,8 +8825,11 @@ func (Implementation) Ctrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 // alpha and beta are scalars, and A, B and C are matrices, with op(A) an m×k matrix,
 // op(B) a k×n matrix and C an m×n matrix.
 func (Implementation) Zgemm(tA, tB blas.Transpose, m, n, k int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
-	// declared at cblas.h:530:6 void cblas_zgemm ...
+	// declared at cblas.h:540:6 void cblas_zgemm ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zgemm", tA, tB, m, n, k, alpha, lda, ldb, beta, ldc)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -7142,7 +8838,7 @@ func (Implementation) Zgemm(tA, tB blas.Transpose, m, n, k int, alpha complex128
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Zgemm", Param: "tA", Message: badTranspose})
 	}
 	switch tB {
 	case blas.NoTrans:
@@ -7152,16 +8848,16 @@ func (Implementation) Zgemm(tA, tB blas.Transpose, m, n, k int, alpha complex128
 	case blas.ConjTrans:
 		tB = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Zgemm", Param: "tB", Message: badTranspose})
 	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Zgemm", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Zgemm", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Zgemm", Param: "k", Message: kLT0})
 	}
 	var rowA, colA, rowB, colB int
 	if tA == C.CblasNoTrans {
@@ -7175,13 +8871,13 @@ func (Implementation) Zgemm(tA, tB blas.Transpose, m, n, k int, alpha complex128
 		rowB, colB = n, k
 	}
 	if lda < max(1, colA) {
-		panic(badLdA)
+		panic(Error{Routine: "Zgemm", Param: "lda", Message: badLdA})
 	}
 	if ldb < max(1, colB) {
-		panic(badLdB)
+		panic(Error{Routine: "Zgemm", Param: "ldb", Message: badLdB})
 	}
 	if ldc < max(1, n) {
-		panic(badLdC)
+		panic(Error{Routine: "Zgemm", Param: "ldc", Message: badLdC})
 	}
 
 	// Quick return if possible.
@@ -7191,13 +8887,13 @@ func (Implementation) Zgemm(tA, tB blas.Transpose, m, n, k int, alpha complex128
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(rowA-1)+colA {
-		panic(shortA)
+		panic(Error{Routine: "Zgemm", Param: "a", Message: shortA})
 	}
 	if len(b) < ldb*(rowB-1)+colB {
-		panic(shortB)
+		panic(Error{Routine: "Zgemm", Param: "b", Message: shortB})
 	}
 	if len(c) < ldc*(m-1)+n {
-		panic(shortC)
+		panic(Error{Routine: "Zgemm", Param: "c", Message: shortC})
 	}
 	var _a *complex128
 	if len(a) > 0 {
@@ -7211,7 +8907,26 @@ func (Implementation) Zgemm(tA, tB blas.Transpose, m, n, k int, alpha complex128
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_zgemm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_TRANSPOSE(tB), C.int(m), C.int(n), C.int(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.int(ldc))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Zgemm", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Zgemm", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Zgemm", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Zgemm", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldb < minInt || ldb > maxInt {
+		panic(Error{Routine: "Zgemm", Param: "ldb", Message: ldbTooLarge})
+	}
+	if ldc < minInt || ldc > maxInt {
+		panic(Error{Routine: "Zgemm", Param: "ldc", Message: ldcTooLarge})
+	}
+	defer admit(4 * 2 * float64(m) * float64(n) * float64(k))()
+	C.cblas_zgemm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_TRANSPOSE(tB), C.blasint(m), C.blasint(n), C.blasint(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_b), C.blasint(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.blasint(ldc))
 }
 
 // Zsymm performs one of the matrix-matrix operations
@@ -7220,15 +8935,18 @@ func (Implementation) Zgemm(tA, tB blas.Transpose, m, n, k int, alpha complex128
 // where alpha and beta are scalars, A is an m×m or n×n symmetric matrix and B
 // and C are m×n matrices.
 func (Implementation) Zsymm(s blas.Side, ul blas.Uplo, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
-	// declared at cblas.h:535:6 void cblas_zsymm ...
+	// declared at cblas.h:545:6 void cblas_zsymm ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zsymm", s, ul, m, n, alpha, lda, ldb, beta, ldc)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Zsymm", Param: "ul", Message: badUplo})
 	}
 	switch s {
 	case blas.Left:
@@ -7236,13 +8954,13 @@ func (Implementation) Zsymm(s blas.Side, ul blas.Uplo, m, n int, alpha complex12
 	case blas.Right:
 		s = C.CblasRight
 	default:
-		panic(badSide)
+		panic(Error{Routine: "Zsymm", Param: "s", Message: badSide})
 	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Zsymm", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Zsymm", Param: "n", Message: nLT0})
 	}
 	var k int
 	if s == C.CblasLeft {
@@ -7251,13 +8969,13 @@ func (Implementation) Zsymm(s blas.Side, ul blas.Uplo, m, n int, alpha complex12
 		k = n
 	}
 	if lda < max(1, k) {
-		panic(badLdA)
+		panic(Error{Routine: "Zsymm", Param: "lda", Message: badLdA})
 	}
 	if ldb < max(1, n) {
-		panic(badLdB)
+		panic(Error{Routine: "Zsymm", Param: "ldb", Message: badLdB})
 	}
 	if ldc < max(1, n) {
-		panic(badLdC)
+		panic(Error{Routine: "Zsymm", Param: "ldc", Message: badLdC})
 	}
 
 	// Quick return if possible.
@@ -7267,13 +8985,13 @@ func (Implementation) Zsymm(s blas.Side, ul blas.Uplo, m, n int, alpha complex12
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(k-1)+k {
-		panic(shortA)
+		panic(Error{Routine: "Zsymm", Param: "a", Message: shortA})
 	}
 	if len(b) < ldb*(m-1)+n {
-		panic(shortB)
+		panic(Error{Routine: "Zsymm", Param: "b", Message: shortB})
 	}
 	if len(c) < ldc*(m-1)+n {
-		panic(shortC)
+		panic(Error{Routine: "Zsymm", Param: "c", Message: shortC})
 	}
 	var _a *complex128
 	if len(a) > 0 {
@@ -7287,7 +9005,23 @@ func (Implementation) Zsymm(s blas.Side, ul blas.Uplo, m, n int, alpha complex12
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_zsymm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.int(ldc))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Zsymm", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Zsymm", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Zsymm", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldb < minInt || ldb > maxInt {
+		panic(Error{Routine: "Zsymm", Param: "ldb", Message: ldbTooLarge})
+	}
+	if ldc < minInt || ldc > maxInt {
+		panic(Error{Routine: "Zsymm", Param: "ldc", Message: ldcTooLarge})
+	}
+	defer admit(4 * 2 * sideFlops(s == C.CblasLeft, m, n))()
+	C.cblas_zsymm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.blasint(m), C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_b), C.blasint(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.blasint(ldc))
 }
 
 // Zsyrk performs one of the symmetric rank-k operations
@@ -7296,15 +9030,18 @@ func (Implementation) Zsymm(s blas.Side, ul blas.Uplo, m, n int, alpha complex12
 // where alpha and beta are scalars, C is an n×n symmetric matrix and A is
 // an n×k matrix in the first case and a k×n matrix in the second case.
 func (Implementation) Zsyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha complex128, a []complex128, lda int, beta complex128, c []complex128, ldc int) {
-	// declared at cblas.h:540:6 void cblas_zsyrk ...
+	// declared at cblas.h:550:6 void cblas_zsyrk ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zsyrk", ul, t, n, k, alpha, lda, beta, ldc)()
+	}
 	switch t {
 	case blas.NoTrans:
 		t = C.CblasNoTrans
 	case blas.Trans:
 		t = C.CblasTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Zsyrk", Param: "t", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -7312,13 +9049,13 @@ func (Implementation) Zsyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha comp
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Zsyrk", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Zsyrk", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Zsyrk", Param: "k", Message: kLT0})
 	}
 	var row, col int
 	if t == C.CblasNoTrans {
@@ -7327,10 +9064,10 @@ func (Implementation) Zsyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha comp
 		row, col = k, n
 	}
 	if lda < max(1, col) {
-		panic(badLdA)
+		panic(Error{Routine: "Zsyrk", Param: "lda", Message: badLdA})
 	}
 	if ldc < max(1, n) {
-		panic(badLdC)
+		panic(Error{Routine: "Zsyrk", Param: "ldc", Message: badLdC})
 	}
 
 	// Quick return if possible.
@@ -7340,10 +9077,10 @@ func (Implementation) Zsyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha comp
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(row-1)+col {
-		panic(shortA)
+		panic(Error{Routine: "Zsyrk", Param: "a", Message: shortA})
 	}
 	if len(c) < ldc*(n-1)+n {
-		panic(shortC)
+		panic(Error{Routine: "Zsyrk", Param: "c", Message: shortC})
 	}
 	var _a *complex128
 	if len(a) > 0 {
@@ -7353,7 +9090,20 @@ func (Implementation) Zsyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha comp
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_zsyrk(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(t), C.int(n), C.int(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.int(ldc))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Zsyrk", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Zsyrk", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Zsyrk", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldc < minInt || ldc > maxInt {
+		panic(Error{Routine: "Zsyrk", Param: "ldc", Message: ldcTooLarge})
+	}
+	defer admit(4 * float64(n) * float64(n) * float64(k))()
+	C.cblas_zsyrk(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(t), C.blasint(n), C.blasint(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.blasint(ldc))
 }
 
 // Zsyr2k performs one of the symmetric rank-2k operations
@@ -7362,15 +9112,18 @@ func (Implementation) Zsyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha comp
 // where alpha and beta are scalars, C is an n×n symmetric matrix and A and B
 // are n×k matrices in the first case and k×n matrices in the second case.
 func (Implementation) Zsyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
-	// declared at cblas.h:544:6 void cblas_zsyr2k ...
+	// declared at cblas.h:554:6 void cblas_zsyr2k ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zsyr2k", ul, t, n, k, alpha, lda, ldb, beta, ldc)()
+	}
 	switch t {
 	case blas.NoTrans:
 		t = C.CblasNoTrans
 	case blas.Trans:
 		t = C.CblasTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Zsyr2k", Param: "t", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -7378,13 +9131,13 @@ func (Implementation) Zsyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha com
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Zsyr2k", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Zsyr2k", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Zsyr2k", Param: "k", Message: kLT0})
 	}
 	var row, col int
 	if t == C.CblasNoTrans {
@@ -7393,13 +9146,13 @@ func (Implementation) Zsyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha com
 		row, col = k, n
 	}
 	if lda < max(1, col) {
-		panic(badLdA)
+		panic(Error{Routine: "Zsyr2k", Param: "lda", Message: badLdA})
 	}
 	if ldb < max(1, col) {
-		panic(badLdB)
+		panic(Error{Routine: "Zsyr2k", Param: "ldb", Message: badLdB})
 	}
 	if ldc < max(1, n) {
-		panic(badLdC)
+		panic(Error{Routine: "Zsyr2k", Param: "ldc", Message: badLdC})
 	}
 
 	// Quick return if possible.
@@ -7409,13 +9162,13 @@ func (Implementation) Zsyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha com
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(row-1)+col {
-		panic(shortA)
+		panic(Error{Routine: "Zsyr2k", Param: "a", Message: shortA})
 	}
 	if len(b) < ldb*(row-1)+col {
-		panic(shortB)
+		panic(Error{Routine: "Zsyr2k", Param: "b", Message: shortB})
 	}
 	if len(c) < ldc*(n-1)+n {
-		panic(shortC)
+		panic(Error{Routine: "Zsyr2k", Param: "c", Message: shortC})
 	}
 	var _a *complex128
 	if len(a) > 0 {
@@ -7429,7 +9182,23 @@ func (Implementation) Zsyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha com
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_zsyr2k(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(t), C.int(n), C.int(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.int(ldc))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Zsyr2k", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Zsyr2k", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Zsyr2k", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldb < minInt || ldb > maxInt {
+		panic(Error{Routine: "Zsyr2k", Param: "ldb", Message: ldbTooLarge})
+	}
+	if ldc < minInt || ldc > maxInt {
+		panic(Error{Routine: "Zsyr2k", Param: "ldc", Message: ldcTooLarge})
+	}
+	defer admit(4 * 2 * float64(n) * float64(n) * float64(k))()
+	C.cblas_zsyr2k(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(t), C.blasint(n), C.blasint(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_b), C.blasint(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.blasint(ldc))
 }
 
 // Ztrmm performs one of the matrix-matrix operations
@@ -7441,8 +9210,11 @@ func (Implementation) Zsyr2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha com
 //  op(A) = Aᵀ  if trans == blas.Trans,
 //  op(A) = Aᴴ  if trans == blas.ConjTrans.
 func (Implementation) Ztrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int) {
-	// declared at cblas.h:549:6 void cblas_ztrmm ...
+	// declared at cblas.h:559:6 void cblas_ztrmm ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Ztrmm", s, ul, tA, d, m, n, alpha, lda, ldb)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -7451,7 +9223,7 @@ func (Implementation) Ztrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Ztrmm", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -7459,7 +9231,7 @@ func (Implementation) Ztrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Ztrmm", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -7467,7 +9239,7 @@ func (Implementation) Ztrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Ztrmm", Param: "d", Message: badDiag})
 	}
 	switch s {
 	case blas.Left:
@@ -7475,13 +9247,13 @@ func (Implementation) Ztrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.Right:
 		s = C.CblasRight
 	default:
-		panic(badSide)
+		panic(Error{Routine: "Ztrmm", Param: "s", Message: badSide})
 	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Ztrmm", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Ztrmm", Param: "n", Message: nLT0})
 	}
 	var k int
 	if s == C.CblasLeft {
@@ -7490,10 +9262,10 @@ func (Implementation) Ztrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 		k = n
 	}
 	if lda < max(1, k) {
-		panic(badLdA)
+		panic(Error{Routine: "Ztrmm", Param: "lda", Message: badLdA})
 	}
 	if ldb < max(1, n) {
-		panic(badLdB)
+		panic(Error{Routine: "Ztrmm", Param: "ldb", Message: badLdB})
 	}
 
 	// Quick return if possible.
@@ -7503,10 +9275,10 @@ func (Implementation) Ztrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(k-1)+k {
-		panic(shortA)
+		panic(Error{Routine: "Ztrmm", Param: "a", Message: shortA})
 	}
 	if len(b) < ldb*(m-1)+n {
-		panic(shortB)
+		panic(Error{Routine: "Ztrmm", Param: "b", Message: shortB})
 	}
 	var _a *complex128
 	if len(a) > 0 {
@@ -7516,7 +9288,20 @@ func (Implementation) Ztrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	C.cblas_ztrmm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Ztrmm", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Ztrmm", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Ztrmm", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldb < minInt || ldb > maxInt {
+		panic(Error{Routine: "Ztrmm", Param: "ldb", Message: ldbTooLarge})
+	}
+	defer admit(4 * sideFlops(s == C.CblasLeft, m, n))()
+	C.cblas_ztrmm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(m), C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_b), C.blasint(ldb))
 }
 
 // Ztrsm solves one of the matrix equations
@@ -7529,8 +9314,11 @@ func (Implementation) Ztrmm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 //  op(A) = Aᴴ  if transA == blas.ConjTrans.
 // On return the matrix X is overwritten on B.
 func (Implementation) Ztrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int) {
-	// declared at cblas.h:554:6 void cblas_ztrsm ...
+	// declared at cblas.h:564:6 void cblas_ztrsm ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Ztrsm", s, ul, tA, d, m, n, alpha, lda, ldb)()
+	}
 	switch tA {
 	case blas.NoTrans:
 		tA = C.CblasNoTrans
@@ -7539,7 +9327,7 @@ func (Implementation) Ztrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.ConjTrans:
 		tA = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Ztrsm", Param: "tA", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -7547,7 +9335,7 @@ func (Implementation) Ztrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Ztrsm", Param: "ul", Message: badUplo})
 	}
 	switch d {
 	case blas.NonUnit:
@@ -7555,7 +9343,7 @@ func (Implementation) Ztrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.Unit:
 		d = C.CblasUnit
 	default:
-		panic(badDiag)
+		panic(Error{Routine: "Ztrsm", Param: "d", Message: badDiag})
 	}
 	switch s {
 	case blas.Left:
@@ -7563,13 +9351,13 @@ func (Implementation) Ztrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	case blas.Right:
 		s = C.CblasRight
 	default:
-		panic(badSide)
+		panic(Error{Routine: "Ztrsm", Param: "s", Message: badSide})
 	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Ztrsm", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Ztrsm", Param: "n", Message: nLT0})
 	}
 	var k int
 	if s == C.CblasLeft {
@@ -7578,10 +9366,10 @@ func (Implementation) Ztrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 		k = n
 	}
 	if lda < max(1, k) {
-		panic(badLdA)
+		panic(Error{Routine: "Ztrsm", Param: "lda", Message: badLdA})
 	}
 	if ldb < max(1, n) {
-		panic(badLdB)
+		panic(Error{Routine: "Ztrsm", Param: "ldb", Message: badLdB})
 	}
 
 	// Quick return if possible.
@@ -7591,10 +9379,10 @@ func (Implementation) Ztrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(k-1)+k {
-		panic(shortA)
+		panic(Error{Routine: "Ztrsm", Param: "a", Message: shortA})
 	}
 	if len(b) < ldb*(m-1)+n {
-		panic(shortB)
+		panic(Error{Routine: "Ztrsm", Param: "b", Message: shortB})
 	}
 	var _a *complex128
 	if len(a) > 0 {
@@ -7604,7 +9392,20 @@ func (Implementation) Ztrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 	if len(b) > 0 {
 		_b = &b[0]
 	}
-	C.cblas_ztrsm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Ztrsm", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Ztrsm", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Ztrsm", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldb < minInt || ldb > maxInt {
+		panic(Error{Routine: "Ztrsm", Param: "ldb", Message: ldbTooLarge})
+	}
+	defer admit(4 * sideFlops(s == C.CblasLeft, m, n))()
+	C.cblas_ztrsm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(tA), C.enum_CBLAS_DIAG(d), C.blasint(m), C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_b), C.blasint(ldb))
 }
 
 // Chemm performs one of the matrix-matrix operations
@@ -7616,15 +9417,18 @@ func (Implementation) Ztrsm(s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Chemm(s blas.Side, ul blas.Uplo, m, n int, alpha complex64, a []complex64, lda int, b []complex64, ldb int, beta complex64, c []complex64, ldc int) {
-	// declared at cblas.h:564:6 void cblas_chemm ...
+	// declared at cblas.h:574:6 void cblas_chemm ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Chemm", s, ul, m, n, alpha, lda, ldb, beta, ldc)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Chemm", Param: "ul", Message: badUplo})
 	}
 	switch s {
 	case blas.Left:
@@ -7632,13 +9436,13 @@ func (Implementation) Chemm(s blas.Side, ul blas.Uplo, m, n int, alpha complex64
 	case blas.Right:
 		s = C.CblasRight
 	default:
-		panic(badSide)
+		panic(Error{Routine: "Chemm", Param: "s", Message: badSide})
 	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Chemm", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Chemm", Param: "n", Message: nLT0})
 	}
 	var k int
 	if s == C.CblasLeft {
@@ -7647,13 +9451,13 @@ func (Implementation) Chemm(s blas.Side, ul blas.Uplo, m, n int, alpha complex64
 		k = n
 	}
 	if lda < max(1, k) {
-		panic(badLdA)
+		panic(Error{Routine: "Chemm", Param: "lda", Message: badLdA})
 	}
 	if ldb < max(1, n) {
-		panic(badLdB)
+		panic(Error{Routine: "Chemm", Param: "ldb", Message: badLdB})
 	}
 	if ldc < max(1, n) {
-		panic(badLdC)
+		panic(Error{Routine: "Chemm", Param: "ldc", Message: badLdC})
 	}
 
 	// Quick return if possible.
@@ -7663,13 +9467,13 @@ func (Implementation) Chemm(s blas.Side, ul blas.Uplo, m, n int, alpha complex64
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(k-1)+k {
-		panic(shortA)
+		panic(Error{Routine: "Chemm", Param: "a", Message: shortA})
 	}
 	if len(b) < ldb*(m-1)+n {
-		panic(shortB)
+		panic(Error{Routine: "Chemm", Param: "b", Message: shortB})
 	}
 	if len(c) < ldc*(m-1)+n {
-		panic(shortC)
+		panic(Error{Routine: "Chemm", Param: "c", Message: shortC})
 	}
 	var _a *complex64
 	if len(a) > 0 {
@@ -7683,7 +9487,23 @@ func (Implementation) Chemm(s blas.Side, ul blas.Uplo, m, n int, alpha complex64
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_chemm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.int(ldc))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Chemm", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Chemm", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Chemm", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldb < minInt || ldb > maxInt {
+		panic(Error{Routine: "Chemm", Param: "ldb", Message: ldbTooLarge})
+	}
+	if ldc < minInt || ldc > maxInt {
+		panic(Error{Routine: "Chemm", Param: "ldc", Message: ldcTooLarge})
+	}
+	defer admit(4 * 2 * sideFlops(s == C.CblasLeft, m, n))()
+	C.cblas_chemm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.blasint(m), C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_b), C.blasint(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.blasint(ldc))
 }
 
 // Cherk performs one of the hermitian rank-k operations
@@ -7697,15 +9517,18 @@ func (Implementation) Chemm(s blas.Side, ul blas.Uplo, m, n int, alpha complex64
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Cherk(ul blas.Uplo, t blas.Transpose, n, k int, alpha float32, a []complex64, lda int, beta float32, c []complex64, ldc int) {
-	// declared at cblas.h:569:6 void cblas_cherk ...
+	// declared at cblas.h:579:6 void cblas_cherk ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Cherk", ul, t, n, k, alpha, lda, beta, ldc)()
+	}
 	switch t {
 	case blas.NoTrans:
 		t = C.CblasNoTrans
 	case blas.ConjTrans:
 		t = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Cherk", Param: "t", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -7713,13 +9536,13 @@ func (Implementation) Cherk(ul blas.Uplo, t blas.Transpose, n, k int, alpha floa
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Cherk", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Cherk", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Cherk", Param: "k", Message: kLT0})
 	}
 	var row, col int
 	if t == C.CblasNoTrans {
@@ -7728,10 +9551,10 @@ func (Implementation) Cherk(ul blas.Uplo, t blas.Transpose, n, k int, alpha floa
 		row, col = k, n
 	}
 	if lda < max(1, col) {
-		panic(badLdA)
+		panic(Error{Routine: "Cherk", Param: "lda", Message: badLdA})
 	}
 	if ldc < max(1, n) {
-		panic(badLdC)
+		panic(Error{Routine: "Cherk", Param: "ldc", Message: badLdC})
 	}
 
 	// Quick return if possible.
@@ -7741,10 +9564,10 @@ func (Implementation) Cherk(ul blas.Uplo, t blas.Transpose, n, k int, alpha floa
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(row-1)+col {
-		panic(shortA)
+		panic(Error{Routine: "Cherk", Param: "a", Message: shortA})
 	}
 	if len(c) < ldc*(n-1)+n {
-		panic(shortC)
+		panic(Error{Routine: "Cherk", Param: "c", Message: shortC})
 	}
 	var _a *complex64
 	if len(a) > 0 {
@@ -7754,7 +9577,20 @@ func (Implementation) Cherk(ul blas.Uplo, t blas.Transpose, n, k int, alpha floa
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_cherk(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(t), C.int(n), C.int(k), C.float(alpha), unsafe.Pointer(_a), C.int(lda), C.float(beta), unsafe.Pointer(_c), C.int(ldc))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Cherk", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Cherk", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Cherk", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldc < minInt || ldc > maxInt {
+		panic(Error{Routine: "Cherk", Param: "ldc", Message: ldcTooLarge})
+	}
+	defer admit(4 * float64(n) * float64(n) * float64(k))()
+	C.cblas_cherk(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(t), C.blasint(n), C.blasint(k), C.float(alpha), unsafe.Pointer(_a), C.blasint(lda), C.float(beta), unsafe.Pointer(_c), C.blasint(ldc))
 }
 
 // Cher2k performs one of the hermitian rank-2k operations
@@ -7768,15 +9604,18 @@ func (Implementation) Cherk(ul blas.Uplo, t blas.Transpose, n, k int, alpha floa
 //
 // Complex64 implementations are autogenerated and not directly tested.
 func (Implementation) Cher2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha complex64, a []complex64, lda int, b []complex64, ldb int, beta float32, c []complex64, ldc int) {
-	// declared at cblas.h:573:6 void cblas_cher2k ...
+	// declared at cblas.h:583:6 void cblas_cher2k ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Cher2k", ul, t, n, k, alpha, lda, ldb, beta, ldc)()
+	}
 	switch t {
 	case blas.NoTrans:
 		t = C.CblasNoTrans
 	case blas.ConjTrans:
 		t = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Cher2k", Param: "t", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -7784,13 +9623,13 @@ func (Implementation) Cher2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha com
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Cher2k", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Cher2k", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Cher2k", Param: "k", Message: kLT0})
 	}
 	var row, col int
 	if t == C.CblasNoTrans {
@@ -7799,13 +9638,13 @@ func (Implementation) Cher2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha com
 		row, col = k, n
 	}
 	if lda < max(1, col) {
-		panic(badLdA)
+		panic(Error{Routine: "Cher2k", Param: "lda", Message: badLdA})
 	}
 	if ldb < max(1, col) {
-		panic(badLdB)
+		panic(Error{Routine: "Cher2k", Param: "ldb", Message: badLdB})
 	}
 	if ldc < max(1, n) {
-		panic(badLdC)
+		panic(Error{Routine: "Cher2k", Param: "ldc", Message: badLdC})
 	}
 
 	// Quick return if possible.
@@ -7815,13 +9654,13 @@ func (Implementation) Cher2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha com
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(row-1)+col {
-		panic(shortA)
+		panic(Error{Routine: "Cher2k", Param: "a", Message: shortA})
 	}
 	if len(b) < ldb*(row-1)+col {
-		panic(shortB)
+		panic(Error{Routine: "Cher2k", Param: "b", Message: shortB})
 	}
 	if len(c) < ldc*(n-1)+n {
-		panic(shortC)
+		panic(Error{Routine: "Cher2k", Param: "c", Message: shortC})
 	}
 	var _a *complex64
 	if len(a) > 0 {
@@ -7835,7 +9674,23 @@ func (Implementation) Cher2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha com
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_cher2k(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(t), C.int(n), C.int(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb), C.float(beta), unsafe.Pointer(_c), C.int(ldc))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Cher2k", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Cher2k", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Cher2k", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldb < minInt || ldb > maxInt {
+		panic(Error{Routine: "Cher2k", Param: "ldb", Message: ldbTooLarge})
+	}
+	if ldc < minInt || ldc > maxInt {
+		panic(Error{Routine: "Cher2k", Param: "ldc", Message: ldcTooLarge})
+	}
+	defer admit(4 * 2 * float64(n) * float64(n) * float64(k))()
+	C.cblas_cher2k(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(t), C.blasint(n), C.blasint(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_b), C.blasint(ldb), C.float(beta), unsafe.Pointer(_c), C.blasint(ldc))
 }
 
 // Zhemm performs one of the matrix-matrix operations
@@ -7845,15 +9700,18 @@ func (Implementation) Cher2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha com
 // and C are m×n matrices. The imaginary parts of the diagonal elements of A are
 // assumed to be zero.
 func (Implementation) Zhemm(s blas.Side, ul blas.Uplo, m, n int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
-	// declared at cblas.h:578:6 void cblas_zhemm ...
+	// declared at cblas.h:588:6 void cblas_zhemm ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zhemm", s, ul, m, n, alpha, lda, ldb, beta, ldc)()
+	}
 	switch ul {
 	case blas.Upper:
 		ul = C.CblasUpper
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Zhemm", Param: "ul", Message: badUplo})
 	}
 	switch s {
 	case blas.Left:
@@ -7861,13 +9719,13 @@ func (Implementation) Zhemm(s blas.Side, ul blas.Uplo, m, n int, alpha complex12
 	case blas.Right:
 		s = C.CblasRight
 	default:
-		panic(badSide)
+		panic(Error{Routine: "Zhemm", Param: "s", Message: badSide})
 	}
 	if m < 0 {
-		panic(mLT0)
+		panic(Error{Routine: "Zhemm", Param: "m", Message: mLT0})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Zhemm", Param: "n", Message: nLT0})
 	}
 	var k int
 	if s == C.CblasLeft {
@@ -7876,13 +9734,13 @@ func (Implementation) Zhemm(s blas.Side, ul blas.Uplo, m, n int, alpha complex12
 		k = n
 	}
 	if lda < max(1, k) {
-		panic(badLdA)
+		panic(Error{Routine: "Zhemm", Param: "lda", Message: badLdA})
 	}
 	if ldb < max(1, n) {
-		panic(badLdB)
+		panic(Error{Routine: "Zhemm", Param: "ldb", Message: badLdB})
 	}
 	if ldc < max(1, n) {
-		panic(badLdC)
+		panic(Error{Routine: "Zhemm", Param: "ldc", Message: badLdC})
 	}
 
 	// Quick return if possible.
@@ -7892,13 +9750,13 @@ func (Implementation) Zhemm(s blas.Side, ul blas.Uplo, m, n int, alpha complex12
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(k-1)+k {
-		panic(shortA)
+		panic(Error{Routine: "Zhemm", Param: "a", Message: shortA})
 	}
 	if len(b) < ldb*(m-1)+n {
-		panic(shortB)
+		panic(Error{Routine: "Zhemm", Param: "b", Message: shortB})
 	}
 	if len(c) < ldc*(m-1)+n {
-		panic(shortC)
+		panic(Error{Routine: "Zhemm", Param: "c", Message: shortC})
 	}
 	var _a *complex128
 	if len(a) > 0 {
@@ -7912,7 +9770,23 @@ func (Implementation) Zhemm(s blas.Side, ul blas.Uplo, m, n int, alpha complex12
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_zhemm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.int(m), C.int(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.int(ldc))
+	if m < minInt || m > maxInt {
+		panic(Error{Routine: "Zhemm", Param: "m", Message: mTooLarge})
+	}
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Zhemm", Param: "n", Message: nTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Zhemm", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldb < minInt || ldb > maxInt {
+		panic(Error{Routine: "Zhemm", Param: "ldb", Message: ldbTooLarge})
+	}
+	if ldc < minInt || ldc > maxInt {
+		panic(Error{Routine: "Zhemm", Param: "ldc", Message: ldcTooLarge})
+	}
+	defer admit(4 * 2 * sideFlops(s == C.CblasLeft, m, n))()
+	C.cblas_zhemm(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_SIDE(s), C.enum_CBLAS_UPLO(ul), C.blasint(m), C.blasint(n), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_b), C.blasint(ldb), unsafe.Pointer(&beta), unsafe.Pointer(_c), C.blasint(ldc))
 }
 
 // Zherk performs one of the hermitian rank-k operations
@@ -7924,15 +9798,18 @@ func (Implementation) Zhemm(s blas.Side, ul blas.Uplo, m, n int, alpha complex12
 // The imaginary parts of the diagonal elements of C are assumed to be zero, and
 // on return they will be set to zero.
 func (Implementation) Zherk(ul blas.Uplo, t blas.Transpose, n, k int, alpha float64, a []complex128, lda int, beta float64, c []complex128, ldc int) {
-	// declared at cblas.h:583:6 void cblas_zherk ...
+	// declared at cblas.h:593:6 void cblas_zherk ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zherk", ul, t, n, k, alpha, lda, beta, ldc)()
+	}
 	switch t {
 	case blas.NoTrans:
 		t = C.CblasNoTrans
 	case blas.ConjTrans:
 		t = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Zherk", Param: "t", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -7940,13 +9817,13 @@ func (Implementation) Zherk(ul blas.Uplo, t blas.Transpose, n, k int, alpha floa
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Zherk", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Zherk", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Zherk", Param: "k", Message: kLT0})
 	}
 	var row, col int
 	if t == C.CblasNoTrans {
@@ -7955,10 +9832,10 @@ func (Implementation) Zherk(ul blas.Uplo, t blas.Transpose, n, k int, alpha floa
 		row, col = k, n
 	}
 	if lda < max(1, col) {
-		panic(badLdA)
+		panic(Error{Routine: "Zherk", Param: "lda", Message: badLdA})
 	}
 	if ldc < max(1, n) {
-		panic(badLdC)
+		panic(Error{Routine: "Zherk", Param: "ldc", Message: badLdC})
 	}
 
 	// Quick return if possible.
@@ -7968,10 +9845,10 @@ func (Implementation) Zherk(ul blas.Uplo, t blas.Transpose, n, k int, alpha floa
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(row-1)+col {
-		panic(shortA)
+		panic(Error{Routine: "Zherk", Param: "a", Message: shortA})
 	}
 	if len(c) < ldc*(n-1)+n {
-		panic(shortC)
+		panic(Error{Routine: "Zherk", Param: "c", Message: shortC})
 	}
 	var _a *complex128
 	if len(a) > 0 {
@@ -7981,7 +9858,20 @@ func (Implementation) Zherk(ul blas.Uplo, t blas.Transpose, n, k int, alpha floa
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_zherk(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(t), C.int(n), C.int(k), C.double(alpha), unsafe.Pointer(_a), C.int(lda), C.double(beta), unsafe.Pointer(_c), C.int(ldc))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Zherk", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Zherk", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Zherk", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldc < minInt || ldc > maxInt {
+		panic(Error{Routine: "Zherk", Param: "ldc", Message: ldcTooLarge})
+	}
+	defer admit(4 * float64(n) * float64(n) * float64(k))()
+	C.cblas_zherk(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(t), C.blasint(n), C.blasint(k), C.double(alpha), unsafe.Pointer(_a), C.blasint(lda), C.double(beta), unsafe.Pointer(_c), C.blasint(ldc))
 }
 
 // Zher2k performs one of the hermitian rank-2k operations
@@ -7993,15 +9883,18 @@ func (Implementation) Zherk(ul blas.Uplo, t blas.Transpose, n, k int, alpha floa
 // The imaginary parts of the diagonal elements of C are assumed to be zero, and
 // on return they will be set to zero.
 func (Implementation) Zher2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta float64, c []complex128, ldc int) {
-	// declared at cblas.h:587:6 void cblas_zher2k ...
+	// declared at cblas.h:597:6 void cblas_zher2k ...
 
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zher2k", ul, t, n, k, alpha, lda, ldb, beta, ldc)()
+	}
 	switch t {
 	case blas.NoTrans:
 		t = C.CblasNoTrans
 	case blas.ConjTrans:
 		t = C.CblasConjTrans
 	default:
-		panic(badTranspose)
+		panic(Error{Routine: "Zher2k", Param: "t", Message: badTranspose})
 	}
 	switch ul {
 	case blas.Upper:
@@ -8009,13 +9902,13 @@ func (Implementation) Zher2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha com
 	case blas.Lower:
 		ul = C.CblasLower
 	default:
-		panic(badUplo)
+		panic(Error{Routine: "Zher2k", Param: "ul", Message: badUplo})
 	}
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Zher2k", Param: "n", Message: nLT0})
 	}
 	if k < 0 {
-		panic(kLT0)
+		panic(Error{Routine: "Zher2k", Param: "k", Message: kLT0})
 	}
 	var row, col int
 	if t == C.CblasNoTrans {
@@ -8024,13 +9917,13 @@ func (Implementation) Zher2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha com
 		row, col = k, n
 	}
 	if lda < max(1, col) {
-		panic(badLdA)
+		panic(Error{Routine: "Zher2k", Param: "lda", Message: badLdA})
 	}
 	if ldb < max(1, col) {
-		panic(badLdB)
+		panic(Error{Routine: "Zher2k", Param: "ldb", Message: badLdB})
 	}
 	if ldc < max(1, n) {
-		panic(badLdC)
+		panic(Error{Routine: "Zher2k", Param: "ldc", Message: badLdC})
 	}
 
 	// Quick return if possible.
@@ -8040,13 +9933,13 @@ func (Implementation) Zher2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha com
 
 	// For zero matrix size the following slice length checks are trivially satisfied.
 	if len(a) < lda*(row-1)+col {
-		panic(shortA)
+		panic(Error{Routine: "Zher2k", Param: "a", Message: shortA})
 	}
 	if len(b) < ldb*(row-1)+col {
-		panic(shortB)
+		panic(Error{Routine: "Zher2k", Param: "b", Message: shortB})
 	}
 	if len(c) < ldc*(n-1)+n {
-		panic(shortC)
+		panic(Error{Routine: "Zher2k", Param: "c", Message: shortC})
 	}
 	var _a *complex128
 	if len(a) > 0 {
@@ -8060,5 +9953,36 @@ func (Implementation) Zher2k(ul blas.Uplo, t blas.Transpose, n, k int, alpha com
 	if len(c) > 0 {
 		_c = &c[0]
 	}
-	C.cblas_zher2k(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(t), C.int(n), C.int(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.int(lda), unsafe.Pointer(_b), C.int(ldb), C.double(beta), unsafe.Pointer(_c), C.int(ldc))
+	if n < minInt || n > maxInt {
+		panic(Error{Routine: "Zher2k", Param: "n", Message: nTooLarge})
+	}
+	if k < minInt || k > maxInt {
+		panic(Error{Routine: "Zher2k", Param: "k", Message: kTooLarge})
+	}
+	if lda < minInt || lda > maxInt {
+		panic(Error{Routine: "Zher2k", Param: "lda", Message: ldaTooLarge})
+	}
+	if ldb < minInt || ldb > maxInt {
+		panic(Error{Routine: "Zher2k", Param: "ldb", Message: ldbTooLarge})
+	}
+	if ldc < minInt || ldc > maxInt {
+		panic(Error{Routine: "Zher2k", Param: "ldc", Message: ldcTooLarge})
+	}
+	defer admit(4 * 2 * float64(n) * float64(n) * float64(k))()
+	C.cblas_zher2k(C.enum_CBLAS_ORDER(rowMajor), C.enum_CBLAS_UPLO(ul), C.enum_CBLAS_TRANSPOSE(t), C.blasint(n), C.blasint(k), unsafe.Pointer(&alpha), unsafe.Pointer(_a), C.blasint(lda), unsafe.Pointer(_b), C.blasint(ldb), C.double(beta), unsafe.Pointer(_c), C.blasint(ldc))
 }
+
+// Panic strings for parameters that cannot be represented by the
+// integer type of the backend.
+const (
+	incXTooLarge = "blas: incX too large"
+	incYTooLarge = "blas: incY too large"
+	kTooLarge    = "blas: k too large"
+	kLTooLarge   = "blas: kL too large"
+	kUTooLarge   = "blas: kU too large"
+	ldaTooLarge  = "blas: lda too large"
+	ldbTooLarge  = "blas: ldb too large"
+	ldcTooLarge  = "blas: ldc too large"
+	mTooLarge    = "blas: m too large"
+	nTooLarge    = "blas: n too large"
+)
@@ -0,0 +1,184 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Caps is a set of capabilities of the CBLAS library the package calls
+// into. Packages built on this one consult Caps instead of probing the
+// library themselves, so that the decisions are made once and consistently.
+type Caps uint32
+
+const (
+	// CapBatched is set if the library provides the cblas_?gemm_batch
+	// routines, so that DgemmBatch and its variants make a single call
+	// into the library instead of a loop over ?gemm.
+	CapBatched Caps = 1 << iota
+
+	// CapAxpby is set if the library provides the cblas_?axpby
+	// extension computing y = alpha*x + beta*y, so that Daxpby and its
+	// variants make a single pass over y.
+	CapAxpby
+
+	// CapGemm3m is set if the library provides the cblas_?gemm3m
+	// extension for complex matrix products using three real products.
+	CapGemm3m
+
+	// CapILP64 is set if the package is built with the ilp64 build tag,
+	// so that the CBLAS integer type is 64 bits wide.
+	CapILP64
+
+	// CapThreadSafe is set if the library is known to be safe to call
+	// from several goroutines concurrently. It is set for Intel MKL,
+	// BLIS and OpenBLAS 0.3.7 and later, and clear for other libraries,
+	// including older versions of OpenBLAS built without locking.
+	CapThreadSafe
+
+	// CapDeterministic is set if the library uses a single thread, so
+	// that repeated calls with the same operands give bitwise identical
+	// results.
+	CapDeterministic
+
+	// CapGemmt is set if the library provides the cblas_?gemmt extension
+	// computing one triangle of a matrix product, so that Dgemmt and its
+	// variants make a single call into the library instead of a loop over
+	// ?gemm.
+	CapGemmt
+
+	// CapMatcopy is set if the library provides the cblas_?omatcopy and
+	// cblas_?imatcopy extensions for scaled matrix copies and
+	// transpositions, which Domatcopy and its variants call instead of
+	// copying in a loop.
+	CapMatcopy
+
+	// CapGeadd is set if the library provides the cblas_?geadd extension
+	// computing C = alpha*A + beta*C, which Dgeadd and its variants call
+	// instead of adding in a loop.
+	CapGeadd
+)
+
+var capNames = []string{
+	"batched",
+	"axpby",
+	"gemm3m",
+	"ilp64",
+	"threadsafe",
+	"deterministic",
+	"gemmt",
+	"matcopy",
+	"geadd",
+}
+
+// Has returns whether all the capabilities in want are set in c.
+func (c Caps) Has(want Caps) bool {
+	return c&want == want
+}
+
+// String returns the names of the capabilities in c separated by "|", or
+// "none" if c is empty.
+func (c Caps) String() string {
+	if c == 0 {
+		return "none"
+	}
+	var names []string
+	for i, name := range capNames {
+		if c&(1<<uint(i)) != 0 {
+			names = append(names, name)
+		}
+	}
+	if rest := c &^ (1<<uint(len(capNames)) - 1); rest != 0 {
+		names = append(names, "0x"+strconv.FormatUint(uint64(rest), 16))
+	}
+	return strings.Join(names, "|")
+}
+
+var (
+	capsOnce sync.Once
+	libCaps  Caps
+)
+
+// Capabilities returns the capabilities of the library. The capabilities
+// that depend on the symbols and version of the library are probed once,
+// when the package is initialized or, with the dlopen build tag, by the
+// first call to Capabilities after which the library is loaded. The
+// CapDeterministic flag reflects the current number of threads, as set by
+// SetNumThreads.
+func Capabilities() Caps {
+	capsOnce.Do(func() {
+		libCaps = probeCaps()
+	})
+	c := libCaps
+	if NumThreads() == 1 {
+		c |= CapDeterministic
+	}
+	return c
+}
+
+// probeCaps returns the capabilities of the library that do not change
+// while the program runs.
+func probeCaps() Caps {
+	var c Caps
+	if hasSymbol("cblas_dgemm_batch") {
+		c |= CapBatched
+	}
+	if hasSymbol("cblas_daxpby") {
+		c |= CapAxpby
+	}
+	if hasSymbol("cblas_zgemm3m") {
+		c |= CapGemm3m
+	}
+	if hasSymbol("cblas_dgemmt") {
+		c |= CapGemmt
+	}
+	if hasSymbol("cblas_domatcopy") {
+		c |= CapMatcopy
+	}
+	if hasSymbol("cblas_dgeadd") {
+		c |= CapGeadd
+	}
+	if ilp64 {
+		c |= CapILP64
+	}
+	switch {
+	case hasSymbol("MKL_Get_Version"), hasSymbol("bli_info_get_version_str"):
+		c |= CapThreadSafe
+	case hasSymbol("openblas_get_config"):
+		if openBLASVersionAtLeast(openBLASConfig(), 0, 3, 7) {
+			c |= CapThreadSafe
+		}
+	}
+	return c
+}
+
+// openBLASVersionAtLeast returns whether the version in the configuration
+// string returned by openblas_get_config, such as
+// "OpenBLAS 0.3.21 NO_AFFINITY Haswell MAX_THREADS=64", is at least
+// major.minor.patch. It returns false if the string holds no version.
+func openBLASVersionAtLeast(config string, major, minor, patch int) bool {
+	f := strings.Fields(config)
+	if len(f) < 2 || f[0] != "OpenBLAS" {
+		return false
+	}
+	// Development versions are reported as, for example, 0.3.22.dev.
+	parts := strings.Split(f[1], ".")
+	want := []int{major, minor, patch}
+	for i, w := range want {
+		if i >= len(parts) {
+			return w == 0 && i > 0
+		}
+		v, err := strconv.Atoi(parts[i])
+		if err != nil {
+			return false
+		}
+		if v != w {
+			return v > w
+		}
+	}
+	return true
+}
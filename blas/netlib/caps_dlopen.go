@@ -0,0 +1,44 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build dlopen
+// +build dlopen
+
+package netlib
+
+/*
+#include <stddef.h>
+#include <stdlib.h>
+
+void *netlib_cblas_symbol(const char *name);
+
+static const char *netlib_openblas_config(void)
+{
+	void *fn = netlib_cblas_symbol("openblas_get_config");
+	if (fn == NULL) {
+		return NULL;
+	}
+	return ((char *(*)(void))fn)();
+}
+*/
+import "C"
+
+import "unsafe"
+
+// hasSymbol returns whether the loaded library provides the named symbol.
+func hasSymbol(name string) bool {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	return C.netlib_cblas_symbol(cname) != nil
+}
+
+// openBLASConfig returns the configuration string of OpenBLAS, or "" if the
+// loaded library does not report it.
+func openBLASConfig() string {
+	p := C.netlib_openblas_config()
+	if p == nil {
+		return ""
+	}
+	return C.GoString(p)
+}
@@ -0,0 +1,96 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !dlopen
+// +build !dlopen
+
+package netlib
+
+/*
+#include <stddef.h>
+#include <stdlib.h>
+#include <string.h>
+
+// The probed symbols are declared weak where possible, so that their
+// presence in the linked library can be tested. Elsewhere none of them is
+// reported as present.
+#if defined(__ELF__)
+#define NETLIB_CAPS_PROBE 1
+__attribute__((weak)) void cblas_dgemm_batch(void);
+__attribute__((weak)) void cblas_daxpby(void);
+__attribute__((weak)) void cblas_zgemm3m(void);
+__attribute__((weak)) void cblas_dgemmt(void);
+__attribute__((weak)) void cblas_domatcopy(void);
+__attribute__((weak)) void cblas_dgeadd(void);
+__attribute__((weak)) void MKL_Get_Version(void);
+__attribute__((weak)) void bli_info_get_version_str(void);
+__attribute__((weak)) char *openblas_get_config(void);
+#else
+#define NETLIB_CAPS_PROBE 0
+#endif
+
+static void *netlib_caps_symbol(const char *name)
+{
+#if NETLIB_CAPS_PROBE
+	static const struct {
+		const char *name;
+		void *addr;
+	} syms[] = {
+		{"cblas_dgemm_batch", (void *)cblas_dgemm_batch},
+		{"cblas_daxpby", (void *)cblas_daxpby},
+		{"cblas_zgemm3m", (void *)cblas_zgemm3m},
+		{"cblas_dgemmt", (void *)cblas_dgemmt},
+		{"cblas_domatcopy", (void *)cblas_domatcopy},
+		{"cblas_dgeadd", (void *)cblas_dgeadd},
+		{"MKL_Get_Version", (void *)MKL_Get_Version},
+		{"bli_info_get_version_str", (void *)bli_info_get_version_str},
+		{"openblas_get_config", (void *)openblas_get_config},
+	};
+	for (size_t i = 0; i < sizeof(syms) / sizeof(syms[0]); i++) {
+		if (strcmp(syms[i].name, name) == 0) {
+			return syms[i].addr;
+		}
+	}
+#endif
+	return NULL;
+}
+
+static const char *netlib_openblas_config(void)
+{
+	char *(*fn)(void) = (char *(*)(void))netlib_caps_symbol("openblas_get_config");
+	if (fn == NULL) {
+		return NULL;
+	}
+	return fn();
+}
+*/
+import "C"
+
+import "unsafe"
+
+func init() {
+	// The library is linked, so its capabilities are known when the
+	// program starts.
+	capsOnce.Do(func() {
+		libCaps = probeCaps()
+	})
+}
+
+// hasSymbol returns whether the linked library provides the named symbol,
+// which must be one of the symbols probed by probeCaps.
+func hasSymbol(name string) bool {
+	cname := C.CString(name)
+	defer C.free(unsafe.Pointer(cname))
+	return C.netlib_caps_symbol(cname) != nil
+}
+
+// openBLASConfig returns the configuration string of OpenBLAS, or "" if the
+// library does not report it.
+func openBLASConfig() string {
+	p := C.netlib_openblas_config()
+	if p == nil {
+		return ""
+	}
+	return C.GoString(p)
+}
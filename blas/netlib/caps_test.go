@@ -0,0 +1,99 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import "testing"
+
+func TestCapsString(t *testing.T) {
+	for _, test := range []struct {
+		c    Caps
+		want string
+	}{
+		{0, "none"},
+		{CapBatched, "batched"},
+		{CapAxpby | CapThreadSafe, "axpby|threadsafe"},
+		{CapGemm3m | CapILP64 | CapDeterministic, "gemm3m|ilp64|deterministic"},
+		{CapBatched | CapGemmt, "batched|gemmt"},
+		{CapMatcopy | CapAxpby, "axpby|matcopy"},
+		{CapGeadd | CapDeterministic, "deterministic|geadd"},
+		{CapBatched | 1<<10, "batched|0x400"},
+	} {
+		if got := test.c.String(); got != test.want {
+			t.Errorf("unexpected string for %#x: got %q want %q", uint32(test.c), got, test.want)
+		}
+	}
+	c := CapAxpby | CapGemm3m
+	if !c.Has(CapAxpby) || !c.Has(CapAxpby|CapGemm3m) || c.Has(CapAxpby|CapBatched) || !c.Has(0) {
+		t.Errorf("unexpected result of Has for %v", c)
+	}
+}
+
+func TestOpenBLASVersionAtLeast(t *testing.T) {
+	for _, test := range []struct {
+		config string
+		want   bool
+	}{
+		{"OpenBLAS 0.3.7 DYNAMIC_ARCH NO_AFFINITY Haswell MAX_THREADS=64", true},
+		{"OpenBLAS 0.3.21 NO_AFFINITY USE_OPENMP Zen", true},
+		{"OpenBLAS 0.3.22.dev DYNAMIC_ARCH", true},
+		{"OpenBLAS 1.0 Haswell", true},
+		{"OpenBLAS 0.3.6 NO_AFFINITY Haswell", false},
+		{"OpenBLAS 0.2.20 Sandybridge", false},
+		{"OpenBLAS 0.3 Haswell", false},
+		{"OpenBLAS", false},
+		{"OpenBLAS unknown", false},
+		{"", false},
+		{"BLIS 0.9.0", false},
+	} {
+		if got := openBLASVersionAtLeast(test.config, 0, 3, 7); got != test.want {
+			t.Errorf("unexpected result for %q: got %t want %t", test.config, got, test.want)
+		}
+	}
+}
+
+func TestCapabilities(t *testing.T) {
+	c := Capabilities()
+	if c.Has(CapILP64) != ilp64 {
+		t.Errorf("unexpected ilp64 flag: %v", c)
+	}
+	if c.Has(CapBatched) != hasSymbol("cblas_dgemm_batch") {
+		t.Errorf("unexpected batched flag: %v", c)
+	}
+	if c.Has(CapAxpby) != hasSymbol("cblas_daxpby") {
+		t.Errorf("unexpected axpby flag: %v", c)
+	}
+	if c.Has(CapGemm3m) != hasSymbol("cblas_zgemm3m") {
+		t.Errorf("unexpected gemm3m flag: %v", c)
+	}
+	if c.Has(CapGemmt) != hasSymbol("cblas_dgemmt") {
+		t.Errorf("unexpected gemmt flag: %v", c)
+	}
+	if c.Has(CapMatcopy) != hasSymbol("cblas_domatcopy") {
+		t.Errorf("unexpected matcopy flag: %v", c)
+	}
+	if c.Has(CapGeadd) != hasSymbol("cblas_dgeadd") {
+		t.Errorf("unexpected geadd flag: %v", c)
+	}
+	if Backend().Caps&^CapDeterministic != c&^CapDeterministic {
+		t.Errorf("capabilities of Backend differ: got %v want %v", Backend().Caps, c)
+	}
+
+	prev := NumThreads()
+	if prev == 0 {
+		if c.Has(CapDeterministic) {
+			t.Errorf("deterministic flag set without thread control: %v", c)
+		}
+		return
+	}
+	defer SetNumThreads(prev)
+	SetNumThreads(1)
+	if !Capabilities().Has(CapDeterministic) {
+		t.Errorf("deterministic flag not set with one thread: %v", Capabilities())
+	}
+	SetNumThreads(2)
+	if Capabilities().Has(CapDeterministic) {
+		t.Errorf("deterministic flag set with two threads: %v", Capabilities())
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+/*
+#include <stdio.h>
+#include <unistd.h>
+
+// netlib_redirect flushes the C streams and points the standard output and
+// standard error descriptors at fd, saving the previous descriptors in saved.
+static int netlib_redirect(int fd, int *saved) {
+	fflush(NULL);
+	saved[0] = dup(1);
+	saved[1] = dup(2);
+	if (saved[0] < 0 || saved[1] < 0 || dup2(fd, 1) < 0 || dup2(fd, 2) < 0) {
+		return -1;
+	}
+	return 0;
+}
+
+// netlib_restore flushes the C streams and restores the descriptors saved
+// by netlib_redirect.
+static void netlib_restore(int *saved) {
+	fflush(NULL);
+	if (saved[0] >= 0) {
+		dup2(saved[0], 1);
+		close(saved[0]);
+	}
+	if (saved[1] >= 0) {
+		dup2(saved[1], 2);
+		close(saved[1]);
+	}
+}
+*/
+import "C"
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"runtime"
+	"sync"
+)
+
+// captureMu serializes the redirections of CaptureOutput.
+var captureMu sync.Mutex
+
+// maxCaptureLine is the length beyond which the lines passed to the
+// handler of CaptureOutput are truncated.
+const maxCaptureLine = 1 << 20
+
+// CaptureOutput calls f with the standard output and standard error of the
+// process redirected to a pipe and passes each line written to them during
+// the call to h, for example log.Print. It is intended for the diagnostics
+// that the library prints from C, such as the xerbla messages and the
+// warnings of OpenBLAS, which would otherwise interleave with the output of
+// the application.
+//
+// The file descriptors are shared by all threads, so output written by
+// other goroutines while f runs is captured as well. Calls of CaptureOutput
+// are serialized. The lines are collected while f runs and passed to h in
+// the calling goroutine after the descriptors have been restored, so h may
+// write to the standard output and error. Lines longer than 1 MiB are
+// truncated. If f panics, the descriptors are restored and the lines passed
+// to h before the panic continues.
+func CaptureOutput(h func(line string), f func()) error {
+	captureMu.Lock()
+	defer captureMu.Unlock()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		return err
+	}
+	var lines []string
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		lines = readLines(r)
+		r.Close()
+	}()
+
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+	var saved [2]C.int
+	if C.netlib_redirect(C.int(w.Fd()), &saved[0]) != 0 {
+		C.netlib_restore(&saved[0])
+		w.Close()
+		<-done
+		return errors.New("blas: cannot redirect standard output")
+	}
+	defer func() {
+		C.netlib_restore(&saved[0])
+		w.Close()
+		<-done
+		for _, line := range lines {
+			h(line)
+		}
+	}()
+	f()
+	return nil
+}
+
+// readLines reads r to the end and returns its lines without the line
+// terminators, truncating those longer than maxCaptureLine.
+func readLines(r *os.File) []string {
+	var (
+		lines []string
+		line  []byte
+	)
+	br := bufio.NewReader(r)
+	for {
+		frag, isPrefix, err := br.ReadLine()
+		if err != nil {
+			return lines
+		}
+		if n := maxCaptureLine - len(line); n > 0 {
+			line = append(line, frag[:min(n, len(frag))]...)
+		}
+		if !isPrefix {
+			lines = append(lines, string(line))
+			line = line[:0]
+		}
+	}
+}
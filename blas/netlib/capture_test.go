@@ -0,0 +1,89 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCaptureOutput(t *testing.T) {
+	var got []string
+	err := CaptureOutput(func(line string) { got = append(got, line) }, func() {
+		fmt.Fprintln(os.Stderr, " ** On entry to DGEMM  parameter number  8 had an illegal value")
+		fmt.Fprintln(os.Stdout, "OpenBLAS Warning : Detect OpenMP Loop")
+		fmt.Fprint(os.Stderr, "unterminated")
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{
+		" ** On entry to DGEMM  parameter number  8 had an illegal value",
+		"OpenBLAS Warning : Detect OpenMP Loop",
+		"unterminated",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected captured lines: got %q want %q", got, want)
+	}
+
+	got = got[:0]
+	func() {
+		defer func() {
+			if r := recover(); r != "stop" {
+				t.Errorf("unexpected panic value: %v", r)
+			}
+		}()
+		CaptureOutput(func(line string) { got = append(got, line) }, func() {
+			fmt.Fprintln(os.Stderr, "before panic")
+			panic("stop")
+		})
+	}()
+	if !reflect.DeepEqual(got, []string{"before panic"}) {
+		t.Errorf("unexpected captured lines after panic: got %q", got)
+	}
+}
+
+func TestCaptureOutputLogging(t *testing.T) {
+	// h writes to the standard error, which must not be captured again.
+	var got []string
+	err := CaptureOutput(func(line string) {
+		log.Print(line)
+		got = append(got, line)
+	}, func() {
+		fmt.Fprintln(os.Stderr, "first")
+		fmt.Fprintln(os.Stdout, "second")
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"first", "second"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected captured lines: got %q want %q", got, want)
+	}
+}
+
+func TestCaptureOutputLongLine(t *testing.T) {
+	long := strings.Repeat("x", maxCaptureLine+100)
+	var got []string
+	err := CaptureOutput(func(line string) { got = append(got, line) }, func() {
+		fmt.Fprintln(os.Stderr, long)
+		fmt.Fprintln(os.Stderr, "after")
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("unexpected number of captured lines: got %d want 2", len(got))
+	}
+	if got[0] != long[:maxCaptureLine] {
+		t.Errorf("unexpected long line: got length %d want %d", len(got[0]), maxCaptureLine)
+	}
+	if got[1] != "after" {
+		t.Errorf("unexpected line after long line: got %q want %q", got[1], "after")
+	}
+}
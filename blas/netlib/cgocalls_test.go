@@ -0,0 +1,104 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"runtime"
+	"testing"
+
+	"gonum.org/v1/netlib/admission"
+	"gonum.org/v1/netlib/diag"
+)
+
+// cgoCallTest is a call of a method of Implementation with valid arguments.
+// The calls are generated by generate_cgocalls.go into
+// cgocalls_auto_test.go.
+type cgoCallTest struct {
+	name string
+
+	// vars is the number of variables of the wrapper that escape to the
+	// heap since their address is passed to C, and the number of
+	// allocations made by fn if each is allocated alone.
+	vars int
+	// allocs is the number of allocations made by fn if the compiler
+	// places small variables without pointers that escape to the heap
+	// together in one allocation.
+	allocs int
+
+	fn func()
+}
+
+// The operands of the calls in cgoCallTests, which are large enough for
+// the 3×3 matrices and vectors of length 3 of any routine.
+var (
+	sbuf = [3][]float32{make([]float32, 16), make([]float32, 16), make([]float32, 16)}
+	dbuf = [3][]float64{make([]float64, 16), make([]float64, 16), make([]float64, 16)}
+	cbuf = [3][]complex64{make([]complex64, 16), make([]complex64, 16), make([]complex64, 16)}
+	zbuf = [3][]complex128{make([]complex128, 16), make([]complex128, 16), make([]complex128, 16)}
+)
+
+// TestCgoCalls checks that each wrapper makes exactly one cgo call and no
+// allocations other than those of the scalars passed to C by address, so
+// that layers added to the wrappers do not add overhead to every call when
+// they are not in use.
+func TestCgoCalls(t *testing.T) {
+	defer diag.SetLevel(diag.SetLevel(diag.Off))
+	defer admission.SetDefault(admission.Default())
+	admission.SetDefault(nil)
+
+	combined := testing.AllocsPerRun(10, func() { escape(1, 2) }) == 1
+
+	var missing []string
+	for _, test := range cgoCallTests {
+		if !supported(test.fn) {
+			missing = append(missing, test.name)
+			continue
+		}
+
+		want := test.vars
+		if combined {
+			want = test.allocs
+		}
+		allocs := testing.AllocsPerRun(10, test.fn)
+		if allocs != float64(want) {
+			t.Errorf("%s: unexpected number of allocations: got %v want %d", test.name, allocs, want)
+		}
+
+		calls := runtime.NumCgoCall()
+		test.fn()
+		calls = runtime.NumCgoCall() - calls
+		if calls != 1 {
+			t.Errorf("%s: unexpected number of cgo calls: got %d want 1", test.name, calls)
+		}
+	}
+	if len(missing) != 0 {
+		t.Logf("routines missing from %s: %v", library(), missing)
+	}
+}
+
+// escaped holds the address of the parameters of escape.
+var escaped *float32
+
+// escape makes its parameters escape to the heap, so that it makes a single
+// allocation if the compiler combines small variables, and two otherwise.
+//
+//go:noinline
+func escape(a, b float32) {
+	escaped = &a
+	escaped = &b
+}
+
+// supported returns whether fn can be called, which is not the case if it
+// calls a routine that is missing from the library loaded at run time.
+func supported(fn func()) (ok bool) {
+	defer func() {
+		r := recover()
+		if _, missing := r.(ErrUnsupported); r != nil && !missing {
+			panic(r)
+		}
+	}()
+	fn()
+	return true
+}
@@ -0,0 +1,30 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/netlib/internal/apicompat"
+)
+
+func TestUpstreamAPI(t *testing.T) {
+	missing, err := apicompat.Missing(".", "testdata/upstream_api.txt", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range missing {
+		t.Errorf("upstream API not provided: %s", e)
+	}
+
+	var b interface{} = Implementation{}
+	if _, ok := b.(blas.Float64); !ok {
+		t.Error("Implementation does not satisfy blas.Float64")
+	}
+	if _, ok := b.(blas.Complex128); !ok {
+		t.Error("Implementation does not satisfy blas.Complex128")
+	}
+}
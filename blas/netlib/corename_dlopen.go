@@ -0,0 +1,34 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build dlopen
+// +build dlopen
+
+package netlib
+
+/*
+#include <stddef.h>
+
+void *netlib_cblas_symbol(const char *name);
+
+static const char *netlib_corename(void)
+{
+	void *fn = netlib_cblas_symbol("openblas_get_corename");
+	if (fn == NULL) {
+		return NULL;
+	}
+	return ((char *(*)(void))fn)();
+}
+*/
+import "C"
+
+// coreName returns the name of the kernels selected by the loaded library,
+// or "" if the library does not report it.
+func coreName() string {
+	p := C.netlib_corename()
+	if p == nil {
+		return ""
+	}
+	return C.GoString(p)
+}
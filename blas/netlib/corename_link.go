@@ -0,0 +1,46 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !dlopen
+// +build !dlopen
+
+package netlib
+
+/*
+#cgo openblas CFLAGS: -DNETLIB_OPENBLAS=1
+#include <stddef.h>
+
+// openblas_get_corename is declared strong with the openblas build tag and
+// weak otherwise, where possible, so that other libraries link.
+#if defined(NETLIB_OPENBLAS)
+char *openblas_get_corename(void);
+#define NETLIB_HAVE_CORENAME 1
+#elif defined(__ELF__)
+__attribute__((weak)) char *openblas_get_corename(void);
+#define NETLIB_HAVE_CORENAME (openblas_get_corename != NULL)
+#else
+#define NETLIB_HAVE_CORENAME 0
+#endif
+
+static const char *netlib_corename(void)
+{
+#if defined(NETLIB_OPENBLAS) || defined(__ELF__)
+	if (NETLIB_HAVE_CORENAME) {
+		return openblas_get_corename();
+	}
+#endif
+	return NULL;
+}
+*/
+import "C"
+
+// coreName returns the name of the kernels selected by the library, or ""
+// if the library does not report it.
+func coreName() string {
+	p := C.netlib_corename()
+	if p == nil {
+		return ""
+	}
+	return C.GoString(p)
+}
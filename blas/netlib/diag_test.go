@@ -0,0 +1,63 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+
+	"gonum.org/v1/netlib/diag"
+)
+
+func TestDiag(t *testing.T) {
+	var events []diag.Event
+	diag.SetHandler(func(e diag.Event) { events = append(events, e) })
+	defer func() {
+		diag.SetHandler(nil)
+		diag.SetLevel(diag.Off)
+		diag.ResetCounts()
+	}()
+
+	a := []float64{1, 2, 3, 4}
+	b := []float64{5, 6, 7, 8}
+	c := make([]float64, 4)
+	diag.SetLevel(diag.Off)
+	impl.Dgemm(blas.NoTrans, blas.NoTrans, 2, 2, 2, 1, a, 2, b, 2, 0, c, 2)
+	if len(events) != 0 {
+		t.Fatalf("unexpected events with diagnostics off: %v", events)
+	}
+
+	diag.SetLevel(diag.Full)
+	impl.Dgemm(blas.NoTrans, blas.Trans, 2, 2, 2, 1.5, a, 2, b, 2, 0, c, 2)
+	if len(events) != 1 {
+		t.Fatalf("unexpected number of events: %d", len(events))
+	}
+	e := events[0]
+	want := []interface{}{blas.NoTrans, blas.Trans, 2, 2, 2, 1.5, 2, 2, 0.0, 2}
+	if e.Package != "blas" || e.Routine != "Dgemm" || e.Level != diag.Calls || len(e.Args) != len(want) {
+		t.Fatalf("unexpected event: %v", e)
+	}
+	for i, v := range want {
+		if e.Args[i] != v {
+			t.Errorf("unexpected argument %d: got %v want %v", i, e.Args[i], v)
+		}
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("expected panic for invalid lda")
+			}
+		}()
+		impl.Dgemm(blas.NoTrans, blas.NoTrans, 2, 2, 2, 1, a, 1, b, 2, 0, c, 2)
+	}()
+	if len(events) != 2 || events[1].Level != diag.Errors || events[1].Panic == nil {
+		t.Errorf("panic not reported: %v", events)
+	}
+	if got := diag.ReadCounts()["blas.Dgemm"]; got.Calls != 1 || got.Panics != 1 {
+		t.Errorf("unexpected counts: %+v", got)
+	}
+}
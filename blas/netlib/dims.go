@@ -0,0 +1,98 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import "gonum.org/v1/gonum/blas"
+
+// The descriptor types below replace the long lists of positional int
+// parameters of the BLAS routines, in which the dimensions and leading
+// dimensions are easily transposed by mistake, by named fields. The methods
+// of Implementation taking a descriptor operate on matrices stored
+// contiguously, so that the leading dimensions follow from the descriptor,
+// and they are checked once by the routine that is called.
+
+// Dims holds the dimensions of a matrix operation. For a matrix product
+//
+//	C = alpha * op(A) * op(B) + beta * C
+//
+// op(A) is an M×K matrix, op(B) is a K×N matrix and C is an M×N matrix. For
+// a matrix-vector product A is an M×N matrix and K is not used.
+type Dims struct {
+	M, N, K int
+}
+
+// Band holds the number of sub-diagonals KL and the number of
+// super-diagonals KU of a band matrix.
+type Band struct {
+	KL, KU int
+}
+
+// StrideA returns the leading dimension of the contiguous matrix A of a
+// product with dimensions d, where A is transposed as specified by tA.
+func (d Dims) StrideA(tA blas.Transpose) int {
+	if tA == blas.NoTrans {
+		return max(1, d.K)
+	}
+	return max(1, d.M)
+}
+
+// StrideB returns the leading dimension of the contiguous matrix B of a
+// product with dimensions d, where B is transposed as specified by tB.
+func (d Dims) StrideB(tB blas.Transpose) int {
+	if tB == blas.NoTrans {
+		return max(1, d.N)
+	}
+	return max(1, d.K)
+}
+
+// StrideC returns the leading dimension of the contiguous M×N matrix C.
+func (d Dims) StrideC() int {
+	return max(1, d.N)
+}
+
+// Stride returns the leading dimension of a band matrix with the
+// sub-diagonals and super-diagonals of b in the band storage of Dgbmv.
+func (b Band) Stride() int {
+	return b.KL + b.KU + 1
+}
+
+// DgemmDims computes
+//
+//	C = alpha * op(A) * op(B) + beta * C
+//
+// as Dgemm with the dimensions d, where A, B and C are stored contiguously
+// with the leading dimensions returned by d.StrideA, d.StrideB and
+// d.StrideC.
+func (impl Implementation) DgemmDims(tA, tB blas.Transpose, d Dims, alpha float64, a, b []float64, beta float64, c []float64) {
+	impl.Dgemm(tA, tB, d.M, d.N, d.K, alpha, a, d.StrideA(tA), b, d.StrideB(tB), beta, c, d.StrideC())
+}
+
+// SgemmDims is the float32 version of DgemmDims.
+func (impl Implementation) SgemmDims(tA, tB blas.Transpose, d Dims, alpha float32, a, b []float32, beta float32, c []float32) {
+	impl.Sgemm(tA, tB, d.M, d.N, d.K, alpha, a, d.StrideA(tA), b, d.StrideB(tB), beta, c, d.StrideC())
+}
+
+// DgemvDims computes
+//
+//	y = alpha * A * x + beta * y    if tA == blas.NoTrans,
+//	y = alpha * A^T * x + beta * y  otherwise,
+//
+// as Dgemv, where A is a contiguous d.M×d.N matrix and x and y have unit
+// increments.
+func (impl Implementation) DgemvDims(tA blas.Transpose, d Dims, alpha float64, a, x []float64, beta float64, y []float64) {
+	impl.Dgemv(tA, d.M, d.N, alpha, a, d.StrideC(), x, 1, beta, y, 1)
+}
+
+// DgbmvDims computes
+//
+//	y = alpha * A * x + beta * y    if tA == blas.NoTrans,
+//	y = alpha * A^T * x + beta * y  otherwise,
+//
+// as Dgbmv, where A is a d.M×d.N band matrix with the sub-diagonals and
+// super-diagonals of band, stored contiguously with the leading dimension
+// band.Stride(), and x and y have unit increments.
+func (impl Implementation) DgbmvDims(tA blas.Transpose, d Dims, band Band, alpha float64, a, x []float64, beta float64, y []float64) {
+	impl.Dgbmv(tA, d.M, d.N, band.KL, band.KU, alpha, a, band.Stride(), x, 1, beta, y, 1)
+}
@@ -0,0 +1,97 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDgemmDims(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, d := range []Dims{{M: 1, N: 1, K: 1}, {M: 3, N: 5, K: 4}, {M: 7, N: 2, K: 0}} {
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, tB := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				name := fmt.Sprintf("%+v,tA=%c,tB=%c", d, tA, tB)
+				a := randomFloats(rnd, d.M*d.K)
+				b := randomFloats(rnd, d.K*d.N)
+				c := randomFloats(rnd, d.M*d.N)
+				want := make([]float64, len(c))
+				copy(want, c)
+				for i := 0; i < d.M; i++ {
+					for j := 0; j < d.N; j++ {
+						var sum float64
+						for l := 0; l < d.K; l++ {
+							aij := a[i*d.K+l]
+							if tA != blas.NoTrans {
+								aij = a[l*d.M+i]
+							}
+							bij := b[l*d.N+j]
+							if tB != blas.NoTrans {
+								bij = b[j*d.K+l]
+							}
+							sum += aij * bij
+						}
+						want[i*d.N+j] = 2*sum - 0.5*want[i*d.N+j]
+					}
+				}
+				impl.DgemmDims(tA, tB, d, 2, a, b, -0.5, c)
+				if !floats.EqualApprox(c, want, tol) {
+					t.Errorf("%s: unexpected result", name)
+				}
+			}
+		}
+	}
+}
+
+func TestDgbmvDims(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		d    Dims
+		band Band
+	}{
+		{Dims{M: 1, N: 1}, Band{}},
+		{Dims{M: 6, N: 6}, Band{KL: 1, KU: 1}},
+		{Dims{M: 8, N: 5}, Band{KL: 3, KU: 2}},
+	} {
+		d, band := test.d, test.band
+		a := randomFloats(rnd, d.M*band.Stride())
+		x := randomFloats(rnd, d.N)
+		y := randomFloats(rnd, d.M)
+		ax := make([]float64, d.M)
+		want := make([]float64, d.M)
+		for i := range want {
+			for j := max(0, i-band.KL); j < min(d.N, i+band.KU+1); j++ {
+				ax[i] += a[i*band.Stride()+band.KL+j-i] * x[j]
+			}
+			want[i] = ax[i] + y[i]
+		}
+		impl.DgbmvDims(blas.NoTrans, d, band, 1, a, x, 1, y)
+		if !floats.EqualApprox(y, want, tol) {
+			t.Errorf("%+v: unexpected result", test)
+		}
+
+		// DgemvDims computes the same product with the band expanded to a
+		// dense matrix.
+		dense := make([]float64, d.M*d.N)
+		for i := 0; i < d.M; i++ {
+			for j := max(0, i-band.KL); j < min(d.N, i+band.KU+1); j++ {
+				dense[i*d.N+j] = a[i*band.Stride()+band.KL+j-i]
+			}
+		}
+		got := make([]float64, d.M)
+		impl.DgemvDims(blas.NoTrans, d, 1, dense, x, 0, got)
+		if !floats.EqualApprox(got, ax, tol) {
+			t.Errorf("%+v: unexpected result of DgemvDims", test)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build dlopen
+// +build dlopen
+
+package netlib
+
+/*
+#cgo linux LDFLAGS: -ldl -lpthread
+#include <stdlib.h>
+int netlib_cblas_open(const char *path, char **err);
+const char *netlib_cblas_path(void);
+*/
+import "C"
+
+import (
+	"errors"
+	"unsafe"
+)
+
+func open(path string) error {
+	cpath := C.CString(path)
+	defer C.free(unsafe.Pointer(cpath))
+	var msg *C.char
+	if C.netlib_cblas_open(cpath, &msg) != 0 {
+		defer C.free(unsafe.Pointer(msg))
+		return errors.New("blas: " + C.GoString(msg))
+	}
+	return nil
+}
+
+func library() string {
+	return C.GoString(C.netlib_cblas_path())
+}
+
+// netlib_cblas_unsupported is called by the trampoline of a routine that is
+// missing from the loaded library, or with a non-nil err holding the
+// reason if no library could be loaded.
+//
+//export netlib_cblas_unsupported
+func netlib_cblas_unsupported(name, err *C.char) {
+	e := ErrUnsupported{Symbol: C.GoString(name), Library: library()}
+	if err != nil {
+		e.Err = errors.New(C.GoString(err))
+	}
+	panic(e)
+}
@@ -0,0 +1,170 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build dlopen
+// +build dlopen
+
+package netlib
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// fakeCBLAS is a library providing a single CBLAS routine.
+const fakeCBLAS = `
+double cblas_ddot(const int N, const double *X, const int incX, const double *Y, const int incY)
+{
+	double sum = 0;
+	for (int i = 0; i < N; i++) {
+		sum += X[i*incX] * Y[i*incY];
+	}
+	return sum;
+}
+`
+
+// The loaded library is bound for the whole process, so the tests using the
+// fake library run in a child process.
+const fakeEnv = "NETLIB_TEST_FAKE_CBLAS"
+
+func TestOpen(t *testing.T) {
+	if lib := os.Getenv(fakeEnv); lib != "" {
+		testOpenChild(t, lib)
+		return
+	}
+	if runtime.GOOS == "windows" {
+		t.Skip("fake library build not supported on windows")
+	}
+
+	dir, err := ioutil.TempDir("", "netlib")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	src := filepath.Join(dir, "fake.c")
+	lib := filepath.Join(dir, "libfakecblas.so")
+	err = ioutil.WriteFile(src, []byte(fakeCBLAS), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cc, err := exec.Command("go", "env", "CC").Output()
+	if err != nil {
+		t.Skipf("no C compiler: %v", err)
+	}
+	out, err := exec.Command(strings.TrimSpace(string(cc)), "-shared", "-fPIC", "-o", lib, src).CombinedOutput()
+	if err != nil {
+		t.Skipf("could not build fake library: %v\n%s", err, out)
+	}
+
+	for _, test := range []struct {
+		name    string
+		wantErr string
+	}{
+		{name: "Open"},
+		{name: "Missing"},
+		{name: "Unrecovered", wantErr: "blas: symbol cblas_dnrm2 not found in " + lib},
+	} {
+		cmd := exec.Command(os.Args[0], "-test.run=^TestOpen$", "-test.v")
+		cmd.Env = append(os.Environ(), fakeEnv+"="+lib, "NETLIB_TEST_CASE="+test.name)
+		out, err := cmd.CombinedOutput()
+		if test.wantErr == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected failure: %v\n%s", test.name, err, out)
+			}
+			continue
+		}
+		if err == nil {
+			t.Errorf("%s: expected failure", test.name)
+		}
+		if !strings.Contains(string(out), test.wantErr) {
+			t.Errorf("%s: missing error message %q in output:\n%s", test.name, test.wantErr, out)
+		}
+	}
+}
+
+func testOpenChild(t *testing.T, lib string) {
+	if got := Library(); got != "" {
+		t.Fatalf("unexpected library before Open: %q", got)
+	}
+	impl, err := Open(lib)
+	if err != nil {
+		t.Fatalf("unexpected error opening %s: %v", lib, err)
+	}
+	if got := Library(); got != lib {
+		t.Errorf("unexpected library: got %q want %q", got, lib)
+	}
+	_, err = Open(lib)
+	if err != nil {
+		t.Errorf("unexpected error reopening %s: %v", lib, err)
+	}
+	_, err = Open(lib + ".other")
+	if err == nil {
+		t.Errorf("expected error opening a second library")
+	}
+
+	switch os.Getenv("NETLIB_TEST_CASE") {
+	case "Open":
+		x := []float64{1, 2, 3}
+		y := []float64{4, 5, 6}
+		if got := impl.Ddot(3, x, 1, y, 1); got != 32 {
+			t.Errorf("unexpected dot product: got %v want 32", got)
+		}
+	case "Missing":
+		for i := 0; i < 2; i++ {
+			func() {
+				defer func() {
+					r := recover()
+					want := ErrUnsupported{Symbol: "cblas_dnrm2", Library: lib}
+					if r != want {
+						t.Errorf("unexpected panic value: got %#v want %#v", r, want)
+					}
+				}()
+				impl.Dnrm2(1, []float64{1}, 1)
+			}()
+		}
+		// The library remains usable after the failed call.
+		if got := impl.Ddot(1, []float64{2}, 1, []float64{3}, 1); got != 6 {
+			t.Errorf("unexpected dot product after missing routine: got %v want 6", got)
+		}
+	case "Unrecovered":
+		// Fails the process.
+		impl.Dnrm2(1, []float64{1}, 1)
+	}
+}
+
+// noLibraryEnv is set in the child process of TestNoLibrary.
+const noLibraryEnv = "NETLIB_TEST_NO_LIBRARY"
+
+func TestNoLibrary(t *testing.T) {
+	if lib := os.Getenv(noLibraryEnv); lib != "" {
+		for i := 0; i < 2; i++ {
+			func() {
+				defer func() {
+					e, ok := recover().(ErrUnsupported)
+					if !ok {
+						t.Fatalf("unexpected panic value: got %#v want ErrUnsupported", e)
+					}
+					if e.Symbol != "cblas_ddot" || e.Library != "" || e.Err == nil || !strings.Contains(e.Err.Error(), lib) {
+						t.Errorf("unexpected panic value: %#v", e)
+					}
+				}()
+				Implementation{}.Ddot(1, []float64{2}, 1, []float64{3}, 1)
+			}()
+		}
+		return
+	}
+
+	lib := filepath.Join(os.TempDir(), "netlib-missing-library.so")
+	cmd := exec.Command(os.Args[0], "-test.run=^TestNoLibrary$", "-test.v")
+	cmd.Env = append(os.Environ(), noLibraryEnv+"="+lib, "NETLIB_CBLAS_LIBRARY="+lib)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Errorf("unexpected failure: %v\n%s", err, out)
+	}
+}
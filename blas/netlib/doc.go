@@ -4,6 +4,9 @@
 
 //go:generate go run generate_blas.go
 //go:generate go run generate_errors.go
+//go:generate go run generate_dlopen.go
+//go:generate go run generate_errimpl.go
+//go:generate go run generate_cgocalls.go
 
 /*
 Package netlib provides bindings to a C BLAS library. This wrapper interface
@@ -0,0 +1,34 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+#ifndef NETLIB_EXTENSION_H
+#define NETLIB_EXTENSION_H
+
+// The CBLAS extensions of OpenBLAS, Intel MKL and BLIS are declared weak by
+// the files that call them, so that a linked library without them leaves
+// the symbols NULL. With the dlopen build tag nothing defines the symbols,
+// and the extensions are looked up in the loaded library instead.
+//
+// NETLIB_EXTENSION declares fn in a function body as a pointer to the
+// extension routine name, which is NULL if the library does not provide
+// it. With the dlopen build tag the routine is looked up by the first call
+// and the address is kept for later calls, since the loaded library does
+// not change.
+#if defined(NETLIB_DLOPEN)
+void *netlib_cblas_symbol(const char *name);
+
+#define NETLIB_EXTENSION(fn, name) \
+	static void *fn##_addr = (void *)-1; \
+	void *fn##_sym = __atomic_load_n(&fn##_addr, __ATOMIC_ACQUIRE); \
+	if (fn##_sym == (void *)-1) { \
+		fn##_sym = netlib_cblas_symbol(#name); \
+		__atomic_store_n(&fn##_addr, fn##_sym, __ATOMIC_RELEASE); \
+	} \
+	__typeof__(name) *fn = (__typeof__(name) *)fn##_sym;
+#else
+#define NETLIB_EXTENSION(fn, name) \
+	__typeof__(name) *fn = name;
+#endif
+
+#endif // NETLIB_EXTENSION_H
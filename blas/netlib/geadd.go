@@ -0,0 +1,197 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+/*
+#cgo openblas,!dlopen CFLAGS: -DNETLIB_GEADD=1
+#cgo dlopen CFLAGS: -DNETLIB_DLOPEN=1
+#include <complex.h>
+#include <stddef.h>
+#include "cblas.h"
+#include "extension.h"
+
+// The ?geadd routines are extensions of OpenBLAS that are not part of the
+// reference CBLAS. As for ?omatcopy they are declared strong with the
+// openblas build tag, looked up in the loaded library with the dlopen build
+// tag and weak where possible otherwise, and the matrices are added by a
+// loop in C if the library does not provide them.
+#if defined(NETLIB_GEADD)
+#define NETLIB_WEAK
+#define NETLIB_HAS(fn) 1
+#elif defined(NETLIB_DLOPEN)
+#define NETLIB_WEAK
+#define NETLIB_HAS(fn) (fn != NULL)
+#elif defined(__ELF__) && !defined(NETLIB_NO_GEADD)
+#define NETLIB_WEAK __attribute__((weak))
+#define NETLIB_HAS(fn) (fn != NULL)
+#else
+#define NETLIB_NO_EXTENSION 1
+#endif
+
+#if !defined(NETLIB_NO_EXTENSION)
+#define NETLIB_DECLARE_GEADD(geadd, S, P) \
+	NETLIB_WEAK void geadd(const enum CBLAS_ORDER order, const blasint rows, const blasint cols, \
+		const S alpha, P *a, const blasint lda, const S beta, P *c, const blasint ldc);
+
+NETLIB_DECLARE_GEADD(cblas_sgeadd, float, float)
+NETLIB_DECLARE_GEADD(cblas_dgeadd, double, double)
+NETLIB_DECLARE_GEADD(cblas_cgeadd, float *, float)
+NETLIB_DECLARE_GEADD(cblas_zgeadd, double *, double)
+
+#define NETLIB_CALL_GEADD(geadd, P) \
+	NETLIB_EXTENSION(fn, geadd) \
+	if (NETLIB_HAS(fn)) { \
+		fn(CblasRowMajor, m, n, alpha, (P *)a, lda, beta, (P *)c, ldc); \
+		return; \
+	}
+#else
+#define NETLIB_CALL_GEADD(geadd, P)
+#endif
+
+#define NETLIB_VALUE(p) (p)
+#define NETLIB_CVALUE(p) (*(const float complex *)(p))
+#define NETLIB_ZVALUE(p) (*(const double complex *)(p))
+
+// NETLIB_DEFINE_GEADD defines netlib_?geadd, which computes
+// C = alpha * A + beta * C for m×n matrices A and C. As in the reference
+// BLAS, C is not read if beta is zero.
+#define NETLIB_DEFINE_GEADD(name, geadd, T, S, P, VALUE) \
+	static void name(const blasint m, const blasint n, const S alpha, const T *a, const blasint lda, \
+		const S beta, T *c, const blasint ldc) \
+	{ \
+		NETLIB_CALL_GEADD(geadd, P) \
+		const T al = VALUE(alpha), be = VALUE(beta); \
+		for (blasint i = 0; i < m; i++) { \
+			const T *ai = a + (ptrdiff_t)i*lda; \
+			T *ci = c + (ptrdiff_t)i*ldc; \
+			if (be == 0) { \
+				for (blasint j = 0; j < n; j++) { \
+					ci[j] = al * ai[j]; \
+				} \
+				continue; \
+			} \
+			for (blasint j = 0; j < n; j++) { \
+				ci[j] = al * ai[j] + be * ci[j]; \
+			} \
+		} \
+	}
+
+NETLIB_DEFINE_GEADD(netlib_sgeadd, cblas_sgeadd, float, float, float, NETLIB_VALUE)
+NETLIB_DEFINE_GEADD(netlib_dgeadd, cblas_dgeadd, double, double, double, NETLIB_VALUE)
+NETLIB_DEFINE_GEADD(netlib_cgeadd, cblas_cgeadd, float complex, float *, float, NETLIB_CVALUE)
+NETLIB_DEFINE_GEADD(netlib_zgeadd, cblas_zgeadd, double complex, double *, double, NETLIB_ZVALUE)
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"gonum.org/v1/netlib/diag"
+)
+
+// Dgeadd computes
+//  C = alpha * A + beta * C
+// for m×n matrices A and C using the cblas_dgeadd extension of OpenBLAS, as
+// reported by CapGeadd. The extension is not part of the reference CBLAS,
+// so unless the package is built with the openblas build tag the matrices
+// are added by a loop in C if the library does not provide it. With the
+// dlopen build tag the extension is looked up in the loaded library. If
+// beta is zero, C is not read.
+func (Implementation) Dgeadd(m, n int, alpha float64, a []float64, lda int, beta float64, c []float64, ldc int) {
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dgeadd", m, n, alpha, lda, beta, ldc)()
+	}
+	checkGeadd("Dgeadd", m, n, lda, ldc, len(a), len(c))
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	C.netlib_dgeadd(C.blasint(m), C.blasint(n), C.double(alpha), (*C.double)(&a[0]), C.blasint(lda), C.double(beta), (*C.double)(&c[0]), C.blasint(ldc))
+}
+
+// Sgeadd is the float32 version of Dgeadd.
+func (Implementation) Sgeadd(m, n int, alpha float32, a []float32, lda int, beta float32, c []float32, ldc int) {
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Sgeadd", m, n, alpha, lda, beta, ldc)()
+	}
+	checkGeadd("Sgeadd", m, n, lda, ldc, len(a), len(c))
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	C.netlib_sgeadd(C.blasint(m), C.blasint(n), C.float(alpha), (*C.float)(&a[0]), C.blasint(lda), C.float(beta), (*C.float)(&c[0]), C.blasint(ldc))
+}
+
+// Zgeadd is the complex128 version of Dgeadd.
+func (Implementation) Zgeadd(m, n int, alpha complex128, a []complex128, lda int, beta complex128, c []complex128, ldc int) {
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zgeadd", m, n, alpha, lda, beta, ldc)()
+	}
+	checkGeadd("Zgeadd", m, n, lda, ldc, len(a), len(c))
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	C.netlib_zgeadd(C.blasint(m), C.blasint(n), (*C.double)(unsafe.Pointer(&alpha)), (*C.complexdouble)(unsafe.Pointer(&a[0])), C.blasint(lda), (*C.double)(unsafe.Pointer(&beta)), (*C.complexdouble)(unsafe.Pointer(&c[0])), C.blasint(ldc))
+}
+
+// Cgeadd is the complex64 version of Dgeadd.
+func (Implementation) Cgeadd(m, n int, alpha complex64, a []complex64, lda int, beta complex64, c []complex64, ldc int) {
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Cgeadd", m, n, alpha, lda, beta, ldc)()
+	}
+	checkGeadd("Cgeadd", m, n, lda, ldc, len(a), len(c))
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return
+	}
+
+	C.netlib_cgeadd(C.blasint(m), C.blasint(n), (*C.float)(unsafe.Pointer(&alpha)), (*C.complexfloat)(unsafe.Pointer(&a[0])), C.blasint(lda), (*C.float)(unsafe.Pointer(&beta)), (*C.complexfloat)(unsafe.Pointer(&c[0])), C.blasint(ldc))
+}
+
+// checkGeadd panics as the ?geadd routine name does if its arguments are
+// invalid. The lengths of the slices are la and lc.
+func checkGeadd(name string, m, n, lda, ldc, la, lc int) {
+	if m < 0 {
+		panic(Error{Routine: name, Param: "m", Message: mLT0})
+	}
+	if n < 0 {
+		panic(Error{Routine: name, Param: "n", Message: nLT0})
+	}
+	if lda < max(1, n) {
+		panic(Error{Routine: name, Param: "lda", Message: badLdA})
+	}
+	if ldc < max(1, n) {
+		panic(Error{Routine: name, Param: "ldc", Message: badLdC})
+	}
+	if m == 0 || n == 0 {
+		return
+	}
+	if la < (m-1)*lda+n {
+		panic(Error{Routine: name, Param: "a", Message: shortA})
+	}
+	if lc < (m-1)*ldc+n {
+		panic(Error{Routine: name, Param: "c", Message: shortC})
+	}
+	if m > maxInt {
+		panic(Error{Routine: name, Param: "m", Message: mTooLarge})
+	}
+	if n > maxInt {
+		panic(Error{Routine: name, Param: "n", Message: nTooLarge})
+	}
+	if lda > maxInt {
+		panic(Error{Routine: name, Param: "lda", Message: ldaTooLarge})
+	}
+	if ldc > maxInt {
+		panic(Error{Routine: name, Param: "ldc", Message: ldcTooLarge})
+	}
+}
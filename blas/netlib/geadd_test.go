@@ -0,0 +1,119 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDgeadd(t *testing.T) {
+	const tol = 1e-14
+	rnd := rand.New(rand.NewSource(1))
+	for _, dims := range [][2]int{{0, 3}, {3, 0}, {1, 1}, {3, 5}, {6, 2}} {
+		m, n := dims[0], dims[1]
+		for _, ab := range [][2]float64{{1, 1}, {0.5, -2}, {0, 3}, {-1, 0}} {
+			alpha, beta := ab[0], ab[1]
+			name := fmt.Sprintf("m=%d,n=%d,alpha=%v,beta=%v", m, n, alpha, beta)
+			lda, ldc := n+2, n+1
+			a := randomFloats(rnd, matrixLen(m, n, lda))
+			c := randomFloats(rnd, matrixLen(m, n, ldc))
+			if beta == 0 {
+				// C must not be read if beta is zero.
+				for i := range c {
+					c[i] = math.NaN()
+				}
+			}
+			want := append([]float64(nil), c...)
+			for i := 0; i < m; i++ {
+				for j := 0; j < n; j++ {
+					v := alpha * a[i*lda+j]
+					if beta != 0 {
+						v += beta * want[i*ldc+j]
+					}
+					want[i*ldc+j] = v
+				}
+			}
+			impl.Dgeadd(m, n, alpha, a, lda, beta, c, ldc)
+			for i := 0; i < m; i++ {
+				for j := 0; j < n; j++ {
+					if !floats.EqualWithinAbsOrRel(c[i*ldc+j], want[i*ldc+j], tol, tol) {
+						t.Errorf("%s: unexpected element (%d,%d): got %v want %v", name, i, j, c[i*ldc+j], want[i*ldc+j])
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestZgeadd(t *testing.T) {
+	const tol = 1e-14
+	rnd := rand.New(rand.NewSource(1))
+	const m, n, lda, ldc = 3, 4, 5, 4
+	a := make([]complex128, (m-1)*lda+n)
+	c := make([]complex128, (m-1)*ldc+n)
+	for _, s := range [][]complex128{a, c} {
+		for i := range s {
+			s[i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+		}
+	}
+	alpha, beta := complex(0.5, 2), complex(-1, 0.25)
+	want := append([]complex128(nil), c...)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			want[i*ldc+j] = alpha*a[i*lda+j] + beta*want[i*ldc+j]
+		}
+	}
+	impl.Zgeadd(m, n, alpha, a, lda, beta, c, ldc)
+	for i := range c {
+		if cmplx.Abs(c[i]-want[i]) > tol {
+			t.Errorf("unexpected c[%d]: got %v want %v", i, c[i], want[i])
+		}
+	}
+}
+
+func TestGeaddPanics(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		fn   func()
+		want Error
+	}{
+		{
+			name: "Dgeadd",
+			fn:   func() { impl.Dgeadd(2, 3, 1, make([]float64, 6), 3, 1, make([]float64, 5), 3) },
+			want: Error{Routine: "Dgeadd", Param: "c", Message: shortC},
+		},
+		{
+			name: "Sgeadd",
+			fn:   func() { impl.Sgeadd(2, 3, 1, make([]float32, 6), 2, 1, make([]float32, 6), 3) },
+			want: Error{Routine: "Sgeadd", Param: "lda", Message: badLdA},
+		},
+		{
+			name: "Zgeadd",
+			fn:   func() { impl.Zgeadd(-1, 3, 1, nil, 3, 1, nil, 3) },
+			want: Error{Routine: "Zgeadd", Param: "m", Message: mLT0},
+		},
+		{
+			name: "Cgeadd",
+			fn:   func() { impl.Cgeadd(2, 3, 1, make([]complex64, 6), 3, 1, make([]complex64, 6), 1) },
+			want: Error{Routine: "Cgeadd", Param: "ldc", Message: badLdC},
+		},
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r != test.want {
+					t.Errorf("%s: unexpected panic: got %#v want %#v", test.name, r, test.want)
+				}
+			}()
+			test.fn()
+		}()
+	}
+}
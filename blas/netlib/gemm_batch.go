@@ -0,0 +1,626 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+/*
+#cgo dlopen CFLAGS: -DNETLIB_DLOPEN=1
+#include <complex.h>
+#include <stddef.h>
+#include <stdlib.h>
+#include "cblas.h"
+#include "extension.h"
+
+// The batched GEMM routines are extensions provided by Intel MKL and recent
+// versions of OpenBLAS. Where weak symbols are available they are declared
+// weak, so that a library without them can still be linked and the products
+// are computed by a loop over ?gemm in C instead. With the dlopen build tag
+// they are looked up in the loaded library.
+#if defined(NETLIB_DLOPEN)
+#define NETLIB_GEMM_BATCH 1
+#define NETLIB_WEAK
+#elif defined(__ELF__) && !defined(NETLIB_NO_GEMM_BATCH)
+#define NETLIB_GEMM_BATCH 1
+#define NETLIB_WEAK __attribute__((weak))
+#else
+#define NETLIB_GEMM_BATCH 0
+#define NETLIB_WEAK
+#endif
+
+#define NETLIB_BATCH_ARGS(T) blasint ngroups, const blasint *size, blasint total, \
+	const enum CBLAS_TRANSPOSE *ta, const enum CBLAS_TRANSPOSE *tb, \
+	const blasint *m, const blasint *n, const blasint *k, const T *alpha, \
+	const T *a, const ptrdiff_t *offa, const blasint *lda, \
+	const T *b, const ptrdiff_t *offb, const blasint *ldb, const T *beta, \
+	T *c, const ptrdiff_t *offc, const blasint *ldc
+
+#define NETLIB_DECLARE_BATCH(name, S, P) \
+	NETLIB_WEAK void name(const enum CBLAS_ORDER order, \
+		const enum CBLAS_TRANSPOSE *ta, const enum CBLAS_TRANSPOSE *tb, \
+		const blasint *m, const blasint *n, const blasint *k, const S *alpha, \
+		const P **a, const blasint *lda, const P **b, const blasint *ldb, \
+		const S *beta, P **c, const blasint *ldc, \
+		const blasint ngroups, const blasint *size);
+
+NETLIB_DECLARE_BATCH(cblas_sgemm_batch, float, float)
+NETLIB_DECLARE_BATCH(cblas_dgemm_batch, double, double)
+NETLIB_DECLARE_BATCH(cblas_cgemm_batch, void, void)
+NETLIB_DECLARE_BATCH(cblas_zgemm_batch, void, void)
+
+#if NETLIB_GEMM_BATCH
+// NETLIB_CALL_BATCH calls the batched routine if the library provides it,
+// building the arrays of matrix pointers from the offsets, and returns.
+#define NETLIB_CALL_BATCH(batch, T, P) \
+	NETLIB_EXTENSION(fn, batch) \
+	if (fn != NULL) { \
+		const P **ap = malloc(total * sizeof(*ap)); \
+		const P **bp = malloc(total * sizeof(*bp)); \
+		P **cp = malloc(total * sizeof(*cp)); \
+		if (ap != NULL && bp != NULL && cp != NULL) { \
+			for (blasint i = 0; i < total; i++) { \
+				ap[i] = a + offa[i]; \
+				bp[i] = b + offb[i]; \
+				cp[i] = c + offc[i]; \
+			} \
+			fn(CblasRowMajor, ta, tb, m, n, k, alpha, ap, lda, bp, ldb, beta, cp, ldc, ngroups, size); \
+		} \
+		free(ap); \
+		free(bp); \
+		free(cp); \
+		if (ap != NULL && bp != NULL && cp != NULL) { \
+			return; \
+		} \
+	}
+#else
+#define NETLIB_CALL_BATCH(batch, T, P)
+#endif
+
+// NETLIB_DEFINE_BATCH defines netlib_?gemm_batch, which computes the
+// products of a batch with a single call into the library where possible.
+#define NETLIB_DEFINE_BATCH(name, batch, gemm, T, S, ALPHA) \
+	static void name(NETLIB_BATCH_ARGS(T)) \
+	{ \
+		NETLIB_CALL_BATCH(batch, T, S) \
+		blasint i = 0; \
+		for (blasint g = 0; g < ngroups; g++) { \
+			for (blasint j = 0; j < size[g]; j++, i++) { \
+				gemm(CblasRowMajor, ta[g], tb[g], m[g], n[g], k[g], ALPHA(alpha+g), \
+					a + offa[i], lda[g], b + offb[i], ldb[g], ALPHA(beta+g), c + offc[i], ldc[g]); \
+			} \
+		} \
+	}
+
+#define NETLIB_VALUE(p) (*(p))
+#define NETLIB_POINTER(p) ((const void *)(p))
+
+NETLIB_DEFINE_BATCH(netlib_sgemm_batch, cblas_sgemm_batch, cblas_sgemm, float, float, NETLIB_VALUE)
+NETLIB_DEFINE_BATCH(netlib_dgemm_batch, cblas_dgemm_batch, cblas_dgemm, double, double, NETLIB_VALUE)
+NETLIB_DEFINE_BATCH(netlib_cgemm_batch, cblas_cgemm_batch, cblas_cgemm, float complex, void, NETLIB_POINTER)
+NETLIB_DEFINE_BATCH(netlib_zgemm_batch, cblas_zgemm_batch, cblas_zgemm, double complex, void, NETLIB_POINTER)
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// The batched matrix multiplications below compute many independent
+// products with a single call into the library. The products are arranged
+// in groups that share their shape, transposition and scalars, as in the
+// ?gemm_batch routines of Intel MKL and OpenBLAS. If the library does not
+// provide ?gemm_batch, the products are computed by a loop over ?gemm on
+// the C side, which still avoids the cost of a cgo call per product.
+//
+// The matrices of all products are held in three slices a, b and c.
+// Products are numbered consecutively through the groups, and the
+// matrices of product i start at a[offA[i]], b[offB[i]] and c[offC[i]].
+// The C matrices of different products must not overlap.
+
+const (
+	badGroupSize = "blas: group size < 0"
+	badOffA      = "blas: bad length of offA"
+	badOffB      = "blas: bad length of offB"
+	badOffC      = "blas: bad length of offC"
+	badOffset    = "blas: offset < 0"
+	sizeTooLarge = "blas: size too large"
+)
+
+// batchShape holds the checked dimensions of a GEMM group.
+type batchShape struct {
+	tA, tB        blas.Transpose
+	m, n, k       int
+	lda, ldb, ldc int
+	size          int
+}
+
+// check panics if the dimensions of g are not valid and returns the number
+// of elements of a, b and c referenced from the start of each matrix.
+func (g batchShape) check() (needA, needB, needC int) {
+	switch {
+	case g.tA != blas.NoTrans && g.tA != blas.Trans && g.tA != blas.ConjTrans:
+		panic(badTranspose)
+	case g.tB != blas.NoTrans && g.tB != blas.Trans && g.tB != blas.ConjTrans:
+		panic(badTranspose)
+	case g.m < 0:
+		panic(mLT0)
+	case g.n < 0:
+		panic(nLT0)
+	case g.k < 0:
+		panic(kLT0)
+	case g.size < 0:
+		panic(badGroupSize)
+	}
+	rowA, colA := g.m, g.k
+	if g.tA != blas.NoTrans {
+		rowA, colA = g.k, g.m
+	}
+	rowB, colB := g.k, g.n
+	if g.tB != blas.NoTrans {
+		rowB, colB = g.n, g.k
+	}
+	switch {
+	case g.lda < max(1, colA):
+		panic(badLdA)
+	case g.ldb < max(1, colB):
+		panic(badLdB)
+	case g.ldc < max(1, g.n):
+		panic(badLdC)
+	}
+	for _, v := range []struct {
+		v   int
+		msg string
+	}{
+		{g.m, mTooLarge}, {g.n, nTooLarge}, {g.k, kTooLarge},
+		{g.lda, ldaTooLarge}, {g.ldb, ldbTooLarge}, {g.ldc, ldcTooLarge},
+		{g.size, sizeTooLarge},
+	} {
+		if v.v > maxInt {
+			panic(v.msg)
+		}
+	}
+	return matrixLen(rowA, colA, g.lda), matrixLen(rowB, colB, g.ldb), matrixLen(g.m, g.n, g.ldc)
+}
+
+// cblasTranspose returns the CBLAS value of t.
+func cblasTranspose(t blas.Transpose) C.enum_CBLAS_TRANSPOSE {
+	switch t {
+	case blas.NoTrans:
+		return C.CblasNoTrans
+	case blas.Trans:
+		return C.CblasTrans
+	case blas.ConjTrans:
+		return C.CblasConjTrans
+	}
+	panic(badTranspose)
+}
+
+// matrixLen returns the number of elements spanned by an r×c row-major
+// matrix with leading dimension ld.
+func matrixLen(r, c, ld int) int {
+	if r == 0 || c == 0 {
+		return 0
+	}
+	return (r-1)*ld + c
+}
+
+// batchOffsets checks the offsets of the matrices of one group, starting at
+// product i, and stores them in dst.
+func batchOffsets(dst []C.ptrdiff_t, off []int, i, size, need, n int, short string) {
+	for j := i; j < i+size; j++ {
+		o := off[j]
+		if o < 0 {
+			panic(badOffset)
+		}
+		if n-o < need {
+			panic(short)
+		}
+		dst[j] = C.ptrdiff_t(o)
+	}
+}
+
+// DgemmGroup describes a group of products of the same shape computed by
+// DgemmBatch. Each product of the group computes
+//
+//	C = Alpha * op(A) * op(B) + Beta * C
+//
+// where op(A) is an M×K matrix, op(B) is a K×N matrix and C is an M×N
+// matrix, with op determined by TransA and TransB as for Dgemm.
+type DgemmGroup struct {
+	TransA, TransB blas.Transpose
+	M, N, K        int
+	Alpha, Beta    float64
+	Lda, Ldb, Ldc  int
+
+	// Size is the number of products in the group.
+	Size int
+}
+
+// DgemmBatch computes the products of the groups with a single call into
+// the library as described above. offA, offB and offC must each hold one
+// offset per product, otherwise DgemmBatch will panic.
+func (Implementation) DgemmBatch(groups []DgemmGroup, a []float64, offA []int, b []float64, offB []int, c []float64, offC []int) {
+	ng := len(groups)
+	size := make([]C.blasint, ng)
+	ta := make([]C.enum_CBLAS_TRANSPOSE, ng)
+	tb := make([]C.enum_CBLAS_TRANSPOSE, ng)
+	m := make([]C.blasint, ng)
+	n := make([]C.blasint, ng)
+	k := make([]C.blasint, ng)
+	lda := make([]C.blasint, ng)
+	ldb := make([]C.blasint, ng)
+	ldc := make([]C.blasint, ng)
+	alpha := make([]float64, ng)
+	beta := make([]float64, ng)
+	var total int
+	for _, g := range groups {
+		if g.Size > 0 {
+			total += g.Size
+		}
+	}
+	switch {
+	case len(offA) != total:
+		panic(badOffA)
+	case len(offB) != total:
+		panic(badOffB)
+	case len(offC) != total:
+		panic(badOffC)
+	case total > maxInt:
+		panic(sizeTooLarge)
+	}
+	oa := make([]C.ptrdiff_t, total)
+	ob := make([]C.ptrdiff_t, total)
+	oc := make([]C.ptrdiff_t, total)
+
+	var flops float64
+	var i int
+	for j, g := range groups {
+		needA, needB, needC := batchShape{
+			tA: g.TransA, tB: g.TransB,
+			m: g.M, n: g.N, k: g.K,
+			lda: g.Lda, ldb: g.Ldb, ldc: g.Ldc,
+			size: g.Size,
+		}.check()
+		batchOffsets(oa, offA, i, g.Size, needA, len(a), shortA)
+		batchOffsets(ob, offB, i, g.Size, needB, len(b), shortB)
+		batchOffsets(oc, offC, i, g.Size, needC, len(c), shortC)
+		i += g.Size
+
+		size[j] = C.blasint(g.Size)
+		ta[j] = cblasTranspose(g.TransA)
+		tb[j] = cblasTranspose(g.TransB)
+		m[j] = C.blasint(g.M)
+		n[j] = C.blasint(g.N)
+		k[j] = C.blasint(g.K)
+		lda[j] = C.blasint(g.Lda)
+		ldb[j] = C.blasint(g.Ldb)
+		ldc[j] = C.blasint(g.Ldc)
+		alpha[j] = g.Alpha
+		beta[j] = g.Beta
+		flops += 2 * float64(g.Size) * float64(g.M) * float64(g.N) * float64(g.K)
+	}
+	if total == 0 {
+		return
+	}
+
+	var _a, _b, _c *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	defer admit(flops)()
+	C.netlib_dgemm_batch(C.blasint(ng), &size[0], C.blasint(total), &ta[0], &tb[0], &m[0], &n[0], &k[0],
+		(*C.double)(unsafe.Pointer(&alpha[0])), (*C.double)(unsafe.Pointer(_a)), &oa[0], &lda[0],
+		(*C.double)(unsafe.Pointer(_b)), &ob[0], &ldb[0], (*C.double)(unsafe.Pointer(&beta[0])),
+		(*C.double)(unsafe.Pointer(_c)), &oc[0], &ldc[0])
+}
+
+// SgemmGroup describes a group of products of the same shape computed by
+// SgemmBatch. Each product of the group computes
+//
+//	C = Alpha * op(A) * op(B) + Beta * C
+//
+// where op(A) is an M×K matrix, op(B) is a K×N matrix and C is an M×N
+// matrix, with op determined by TransA and TransB as for Sgemm.
+type SgemmGroup struct {
+	TransA, TransB blas.Transpose
+	M, N, K        int
+	Alpha, Beta    float32
+	Lda, Ldb, Ldc  int
+
+	// Size is the number of products in the group.
+	Size int
+}
+
+// SgemmBatch computes the products of the groups with a single call into
+// the library as described above. offA, offB and offC must each hold one
+// offset per product, otherwise SgemmBatch will panic.
+func (Implementation) SgemmBatch(groups []SgemmGroup, a []float32, offA []int, b []float32, offB []int, c []float32, offC []int) {
+	ng := len(groups)
+	size := make([]C.blasint, ng)
+	ta := make([]C.enum_CBLAS_TRANSPOSE, ng)
+	tb := make([]C.enum_CBLAS_TRANSPOSE, ng)
+	m := make([]C.blasint, ng)
+	n := make([]C.blasint, ng)
+	k := make([]C.blasint, ng)
+	lda := make([]C.blasint, ng)
+	ldb := make([]C.blasint, ng)
+	ldc := make([]C.blasint, ng)
+	alpha := make([]float32, ng)
+	beta := make([]float32, ng)
+	var total int
+	for _, g := range groups {
+		if g.Size > 0 {
+			total += g.Size
+		}
+	}
+	switch {
+	case len(offA) != total:
+		panic(badOffA)
+	case len(offB) != total:
+		panic(badOffB)
+	case len(offC) != total:
+		panic(badOffC)
+	case total > maxInt:
+		panic(sizeTooLarge)
+	}
+	oa := make([]C.ptrdiff_t, total)
+	ob := make([]C.ptrdiff_t, total)
+	oc := make([]C.ptrdiff_t, total)
+
+	var flops float64
+	var i int
+	for j, g := range groups {
+		needA, needB, needC := batchShape{
+			tA: g.TransA, tB: g.TransB,
+			m: g.M, n: g.N, k: g.K,
+			lda: g.Lda, ldb: g.Ldb, ldc: g.Ldc,
+			size: g.Size,
+		}.check()
+		batchOffsets(oa, offA, i, g.Size, needA, len(a), shortA)
+		batchOffsets(ob, offB, i, g.Size, needB, len(b), shortB)
+		batchOffsets(oc, offC, i, g.Size, needC, len(c), shortC)
+		i += g.Size
+
+		size[j] = C.blasint(g.Size)
+		ta[j] = cblasTranspose(g.TransA)
+		tb[j] = cblasTranspose(g.TransB)
+		m[j] = C.blasint(g.M)
+		n[j] = C.blasint(g.N)
+		k[j] = C.blasint(g.K)
+		lda[j] = C.blasint(g.Lda)
+		ldb[j] = C.blasint(g.Ldb)
+		ldc[j] = C.blasint(g.Ldc)
+		alpha[j] = g.Alpha
+		beta[j] = g.Beta
+		flops += 2 * float64(g.Size) * float64(g.M) * float64(g.N) * float64(g.K)
+	}
+	if total == 0 {
+		return
+	}
+
+	var _a, _b, _c *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	defer admit(flops)()
+	C.netlib_sgemm_batch(C.blasint(ng), &size[0], C.blasint(total), &ta[0], &tb[0], &m[0], &n[0], &k[0],
+		(*C.float)(unsafe.Pointer(&alpha[0])), (*C.float)(unsafe.Pointer(_a)), &oa[0], &lda[0],
+		(*C.float)(unsafe.Pointer(_b)), &ob[0], &ldb[0], (*C.float)(unsafe.Pointer(&beta[0])),
+		(*C.float)(unsafe.Pointer(_c)), &oc[0], &ldc[0])
+}
+
+// ZgemmGroup describes a group of products of the same shape computed by
+// ZgemmBatch. Each product of the group computes
+//
+//	C = Alpha * op(A) * op(B) + Beta * C
+//
+// where op(A) is an M×K matrix, op(B) is a K×N matrix and C is an M×N
+// matrix, with op determined by TransA and TransB as for Zgemm.
+type ZgemmGroup struct {
+	TransA, TransB blas.Transpose
+	M, N, K        int
+	Alpha, Beta    complex128
+	Lda, Ldb, Ldc  int
+
+	// Size is the number of products in the group.
+	Size int
+}
+
+// ZgemmBatch computes the products of the groups with a single call into
+// the library as described above. offA, offB and offC must each hold one
+// offset per product, otherwise ZgemmBatch will panic.
+func (Implementation) ZgemmBatch(groups []ZgemmGroup, a []complex128, offA []int, b []complex128, offB []int, c []complex128, offC []int) {
+	ng := len(groups)
+	size := make([]C.blasint, ng)
+	ta := make([]C.enum_CBLAS_TRANSPOSE, ng)
+	tb := make([]C.enum_CBLAS_TRANSPOSE, ng)
+	m := make([]C.blasint, ng)
+	n := make([]C.blasint, ng)
+	k := make([]C.blasint, ng)
+	lda := make([]C.blasint, ng)
+	ldb := make([]C.blasint, ng)
+	ldc := make([]C.blasint, ng)
+	alpha := make([]complex128, ng)
+	beta := make([]complex128, ng)
+	var total int
+	for _, g := range groups {
+		if g.Size > 0 {
+			total += g.Size
+		}
+	}
+	switch {
+	case len(offA) != total:
+		panic(badOffA)
+	case len(offB) != total:
+		panic(badOffB)
+	case len(offC) != total:
+		panic(badOffC)
+	case total > maxInt:
+		panic(sizeTooLarge)
+	}
+	oa := make([]C.ptrdiff_t, total)
+	ob := make([]C.ptrdiff_t, total)
+	oc := make([]C.ptrdiff_t, total)
+
+	var flops float64
+	var i int
+	for j, g := range groups {
+		needA, needB, needC := batchShape{
+			tA: g.TransA, tB: g.TransB,
+			m: g.M, n: g.N, k: g.K,
+			lda: g.Lda, ldb: g.Ldb, ldc: g.Ldc,
+			size: g.Size,
+		}.check()
+		batchOffsets(oa, offA, i, g.Size, needA, len(a), shortA)
+		batchOffsets(ob, offB, i, g.Size, needB, len(b), shortB)
+		batchOffsets(oc, offC, i, g.Size, needC, len(c), shortC)
+		i += g.Size
+
+		size[j] = C.blasint(g.Size)
+		ta[j] = cblasTranspose(g.TransA)
+		tb[j] = cblasTranspose(g.TransB)
+		m[j] = C.blasint(g.M)
+		n[j] = C.blasint(g.N)
+		k[j] = C.blasint(g.K)
+		lda[j] = C.blasint(g.Lda)
+		ldb[j] = C.blasint(g.Ldb)
+		ldc[j] = C.blasint(g.Ldc)
+		alpha[j] = g.Alpha
+		beta[j] = g.Beta
+		flops += 8 * float64(g.Size) * float64(g.M) * float64(g.N) * float64(g.K)
+	}
+	if total == 0 {
+		return
+	}
+
+	var _a, _b, _c *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	defer admit(flops)()
+	C.netlib_zgemm_batch(C.blasint(ng), &size[0], C.blasint(total), &ta[0], &tb[0], &m[0], &n[0], &k[0],
+		(*C.complexdouble)(unsafe.Pointer(&alpha[0])), (*C.complexdouble)(unsafe.Pointer(_a)), &oa[0], &lda[0],
+		(*C.complexdouble)(unsafe.Pointer(_b)), &ob[0], &ldb[0], (*C.complexdouble)(unsafe.Pointer(&beta[0])),
+		(*C.complexdouble)(unsafe.Pointer(_c)), &oc[0], &ldc[0])
+}
+
+// CgemmGroup describes a group of products of the same shape computed by
+// CgemmBatch. Each product of the group computes
+//
+//	C = Alpha * op(A) * op(B) + Beta * C
+//
+// where op(A) is an M×K matrix, op(B) is a K×N matrix and C is an M×N
+// matrix, with op determined by TransA and TransB as for Cgemm.
+type CgemmGroup struct {
+	TransA, TransB blas.Transpose
+	M, N, K        int
+	Alpha, Beta    complex64
+	Lda, Ldb, Ldc  int
+
+	// Size is the number of products in the group.
+	Size int
+}
+
+// CgemmBatch computes the products of the groups with a single call into
+// the library as described above. offA, offB and offC must each hold one
+// offset per product, otherwise CgemmBatch will panic.
+func (Implementation) CgemmBatch(groups []CgemmGroup, a []complex64, offA []int, b []complex64, offB []int, c []complex64, offC []int) {
+	ng := len(groups)
+	size := make([]C.blasint, ng)
+	ta := make([]C.enum_CBLAS_TRANSPOSE, ng)
+	tb := make([]C.enum_CBLAS_TRANSPOSE, ng)
+	m := make([]C.blasint, ng)
+	n := make([]C.blasint, ng)
+	k := make([]C.blasint, ng)
+	lda := make([]C.blasint, ng)
+	ldb := make([]C.blasint, ng)
+	ldc := make([]C.blasint, ng)
+	alpha := make([]complex64, ng)
+	beta := make([]complex64, ng)
+	var total int
+	for _, g := range groups {
+		if g.Size > 0 {
+			total += g.Size
+		}
+	}
+	switch {
+	case len(offA) != total:
+		panic(badOffA)
+	case len(offB) != total:
+		panic(badOffB)
+	case len(offC) != total:
+		panic(badOffC)
+	case total > maxInt:
+		panic(sizeTooLarge)
+	}
+	oa := make([]C.ptrdiff_t, total)
+	ob := make([]C.ptrdiff_t, total)
+	oc := make([]C.ptrdiff_t, total)
+
+	var flops float64
+	var i int
+	for j, g := range groups {
+		needA, needB, needC := batchShape{
+			tA: g.TransA, tB: g.TransB,
+			m: g.M, n: g.N, k: g.K,
+			lda: g.Lda, ldb: g.Ldb, ldc: g.Ldc,
+			size: g.Size,
+		}.check()
+		batchOffsets(oa, offA, i, g.Size, needA, len(a), shortA)
+		batchOffsets(ob, offB, i, g.Size, needB, len(b), shortB)
+		batchOffsets(oc, offC, i, g.Size, needC, len(c), shortC)
+		i += g.Size
+
+		size[j] = C.blasint(g.Size)
+		ta[j] = cblasTranspose(g.TransA)
+		tb[j] = cblasTranspose(g.TransB)
+		m[j] = C.blasint(g.M)
+		n[j] = C.blasint(g.N)
+		k[j] = C.blasint(g.K)
+		lda[j] = C.blasint(g.Lda)
+		ldb[j] = C.blasint(g.Ldb)
+		ldc[j] = C.blasint(g.Ldc)
+		alpha[j] = g.Alpha
+		beta[j] = g.Beta
+		flops += 8 * float64(g.Size) * float64(g.M) * float64(g.N) * float64(g.K)
+	}
+	if total == 0 {
+		return
+	}
+
+	var _a, _b, _c *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	if len(c) > 0 {
+		_c = &c[0]
+	}
+	defer admit(flops)()
+	C.netlib_cgemm_batch(C.blasint(ng), &size[0], C.blasint(total), &ta[0], &tb[0], &m[0], &n[0], &k[0],
+		(*C.complexfloat)(unsafe.Pointer(&alpha[0])), (*C.complexfloat)(unsafe.Pointer(_a)), &oa[0], &lda[0],
+		(*C.complexfloat)(unsafe.Pointer(_b)), &ob[0], &ldb[0], (*C.complexfloat)(unsafe.Pointer(&beta[0])),
+		(*C.complexfloat)(unsafe.Pointer(_c)), &oc[0], &ldc[0])
+}
@@ -0,0 +1,250 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+/*
+#cgo dlopen CFLAGS: -DNETLIB_DLOPEN=1
+#include <complex.h>
+#include <stddef.h>
+#include "cblas.h"
+#include "extension.h"
+
+// The strided batched GEMM routines are extensions provided by Intel MKL.
+// As for ?gemm_batch they are declared weak where possible or looked up in
+// the library loaded with the dlopen build tag, and the products are
+// computed by a loop over ?gemm in C when the library lacks them.
+#if defined(NETLIB_DLOPEN)
+#define NETLIB_GEMM_BATCH_STRIDED 1
+#define NETLIB_STRIDED_WEAK
+#elif defined(__ELF__) && !defined(NETLIB_NO_GEMM_BATCH)
+#define NETLIB_GEMM_BATCH_STRIDED 1
+#define NETLIB_STRIDED_WEAK __attribute__((weak))
+#else
+#define NETLIB_GEMM_BATCH_STRIDED 0
+#define NETLIB_STRIDED_WEAK
+#endif
+
+#define NETLIB_DECLARE_STRIDED(name, S, P, ALPHA) \
+	NETLIB_STRIDED_WEAK void name(const enum CBLAS_ORDER order, \
+		const enum CBLAS_TRANSPOSE ta, const enum CBLAS_TRANSPOSE tb, \
+		const blasint m, const blasint n, const blasint k, ALPHA alpha, \
+		const P *a, const blasint lda, const blasint stridea, \
+		const P *b, const blasint ldb, const blasint strideb, ALPHA beta, \
+		P *c, const blasint ldc, const blasint stridec, const blasint size);
+
+NETLIB_DECLARE_STRIDED(cblas_sgemm_batch_strided, float, float, const float)
+NETLIB_DECLARE_STRIDED(cblas_dgemm_batch_strided, double, double, const double)
+NETLIB_DECLARE_STRIDED(cblas_cgemm_batch_strided, void, void, const void *)
+NETLIB_DECLARE_STRIDED(cblas_zgemm_batch_strided, void, void, const void *)
+
+#if NETLIB_GEMM_BATCH_STRIDED
+#define NETLIB_CALL_STRIDED(batch, ALPHA) \
+	NETLIB_EXTENSION(fn, batch) \
+	if (fn != NULL) { \
+		fn(CblasRowMajor, ta, tb, m, n, k, ALPHA(alpha), a, lda, stridea, \
+			b, ldb, strideb, ALPHA(beta), c, ldc, stridec, size); \
+		return; \
+	}
+#else
+#define NETLIB_CALL_STRIDED(batch, ALPHA)
+#endif
+
+// NETLIB_DEFINE_STRIDED defines netlib_?gemm_batch_strided, which computes
+// the products of a strided batch with a single call into the library where
+// possible.
+#define NETLIB_DEFINE_STRIDED(name, batch, gemm, T, ALPHA) \
+	static void name(enum CBLAS_TRANSPOSE ta, enum CBLAS_TRANSPOSE tb, \
+		blasint m, blasint n, blasint k, const T *alpha, \
+		const T *a, blasint lda, ptrdiff_t stridea, \
+		const T *b, blasint ldb, ptrdiff_t strideb, const T *beta, \
+		T *c, blasint ldc, ptrdiff_t stridec, blasint size) \
+	{ \
+		NETLIB_CALL_STRIDED(batch, ALPHA) \
+		for (blasint i = 0; i < size; i++) { \
+			gemm(CblasRowMajor, ta, tb, m, n, k, ALPHA(alpha), a + i*stridea, lda, \
+				b + i*strideb, ldb, ALPHA(beta), c + i*stridec, ldc); \
+		} \
+	}
+
+#define NETLIB_STRIDED_VALUE(p) (*(p))
+#define NETLIB_STRIDED_POINTER(p) ((const void *)(p))
+
+NETLIB_DEFINE_STRIDED(netlib_sgemm_batch_strided, cblas_sgemm_batch_strided, cblas_sgemm, float, NETLIB_STRIDED_VALUE)
+NETLIB_DEFINE_STRIDED(netlib_dgemm_batch_strided, cblas_dgemm_batch_strided, cblas_dgemm, double, NETLIB_STRIDED_VALUE)
+NETLIB_DEFINE_STRIDED(netlib_cgemm_batch_strided, cblas_cgemm_batch_strided, cblas_cgemm, float complex, NETLIB_STRIDED_POINTER)
+NETLIB_DEFINE_STRIDED(netlib_zgemm_batch_strided, cblas_zgemm_batch_strided, cblas_zgemm, double complex, NETLIB_STRIDED_POINTER)
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// The strided batched matrix multiplications below compute size products
+// of the same shape with a single call into the library, where the matrices
+// of product i start at a[i*strideA], b[i*strideB] and c[i*strideC]. This is
+// the layout of a contiguous 3-D tensor, and unlike the grouped batch
+// routines it needs no per-product offsets. A stride of zero for A or B
+// uses the same matrix for all products. The C matrices must not overlap.
+//
+// The routines call ?gemm_batch_strided of Intel MKL if the library
+// provides it and otherwise loop over ?gemm on the C side.
+
+const (
+	badStrideA = "blas: bad stride of A"
+	badStrideB = "blas: bad stride of B"
+	badStrideC = "blas: bad stride of C"
+)
+
+// checkStrided panics if the dimensions of a strided batch are not valid.
+func checkStrided(g batchShape, strideA, lenA, strideB, lenB, strideC, lenC int) {
+	needA, needB, needC := g.check()
+	switch {
+	case strideA < 0 || strideA > maxInt:
+		panic(badStrideA)
+	case strideB < 0 || strideB > maxInt:
+		panic(badStrideB)
+	case strideC < 0 || strideC > maxInt || (g.size > 1 && needC > 0 && strideC < needC):
+		panic(badStrideC)
+	}
+	if g.size == 0 {
+		return
+	}
+	if !stridedFits(needA, strideA, g.size, lenA) {
+		panic(shortA)
+	}
+	if !stridedFits(needB, strideB, g.size, lenB) {
+		panic(shortB)
+	}
+	if !stridedFits(needC, strideC, g.size, lenC) {
+		panic(shortC)
+	}
+}
+
+// stridedFits returns whether size matrices spanning need elements each
+// and separated by stride fit in a slice of length n.
+func stridedFits(need, stride, size, n int) bool {
+	if need == 0 {
+		return true
+	}
+	if n < need {
+		return false
+	}
+	return size == 1 || stride <= (n-need)/(size-1)
+}
+
+// DgemmBatchStrided computes
+//
+//	C_i = alpha * op(A_i) * op(B_i) + beta * C_i
+//
+// for the size products of a strided batch as described above, where op(A_i)
+// is an m×k matrix, op(B_i) is a k×n matrix and C_i is an m×n matrix, with op
+// determined by tA and tB as for Dgemm.
+func (Implementation) DgemmBatchStrided(tA, tB blas.Transpose, m, n, k int, alpha float64, a []float64, lda, strideA int, b []float64, ldb, strideB int, beta float64, c []float64, ldc, strideC int, size int) {
+	checkStrided(batchShape{tA: tA, tB: tB, m: m, n: n, k: k, lda: lda, ldb: ldb, ldc: ldc, size: size},
+		strideA, len(a), strideB, len(b), strideC, len(c))
+	if size == 0 || m == 0 || n == 0 {
+		return
+	}
+
+	var _a, _b *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	defer admit(2 * float64(size) * float64(m) * float64(n) * float64(k))()
+	C.netlib_dgemm_batch_strided(cblasTranspose(tA), cblasTranspose(tB), C.blasint(m), C.blasint(n), C.blasint(k),
+		(*C.double)(&alpha), (*C.double)(_a), C.blasint(lda), C.ptrdiff_t(strideA),
+		(*C.double)(_b), C.blasint(ldb), C.ptrdiff_t(strideB), (*C.double)(&beta),
+		(*C.double)(&c[0]), C.blasint(ldc), C.ptrdiff_t(strideC), C.blasint(size))
+}
+
+// SgemmBatchStrided computes
+//
+//	C_i = alpha * op(A_i) * op(B_i) + beta * C_i
+//
+// for the size products of a strided batch as described above, where op(A_i)
+// is an m×k matrix, op(B_i) is a k×n matrix and C_i is an m×n matrix, with op
+// determined by tA and tB as for Sgemm.
+func (Implementation) SgemmBatchStrided(tA, tB blas.Transpose, m, n, k int, alpha float32, a []float32, lda, strideA int, b []float32, ldb, strideB int, beta float32, c []float32, ldc, strideC int, size int) {
+	checkStrided(batchShape{tA: tA, tB: tB, m: m, n: n, k: k, lda: lda, ldb: ldb, ldc: ldc, size: size},
+		strideA, len(a), strideB, len(b), strideC, len(c))
+	if size == 0 || m == 0 || n == 0 {
+		return
+	}
+
+	var _a, _b *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	defer admit(2 * float64(size) * float64(m) * float64(n) * float64(k))()
+	C.netlib_sgemm_batch_strided(cblasTranspose(tA), cblasTranspose(tB), C.blasint(m), C.blasint(n), C.blasint(k),
+		(*C.float)(&alpha), (*C.float)(_a), C.blasint(lda), C.ptrdiff_t(strideA),
+		(*C.float)(_b), C.blasint(ldb), C.ptrdiff_t(strideB), (*C.float)(&beta),
+		(*C.float)(&c[0]), C.blasint(ldc), C.ptrdiff_t(strideC), C.blasint(size))
+}
+
+// ZgemmBatchStrided computes
+//
+//	C_i = alpha * op(A_i) * op(B_i) + beta * C_i
+//
+// for the size products of a strided batch as described above, where op(A_i)
+// is an m×k matrix, op(B_i) is a k×n matrix and C_i is an m×n matrix, with op
+// determined by tA and tB as for Zgemm.
+func (Implementation) ZgemmBatchStrided(tA, tB blas.Transpose, m, n, k int, alpha complex128, a []complex128, lda, strideA int, b []complex128, ldb, strideB int, beta complex128, c []complex128, ldc, strideC int, size int) {
+	checkStrided(batchShape{tA: tA, tB: tB, m: m, n: n, k: k, lda: lda, ldb: ldb, ldc: ldc, size: size},
+		strideA, len(a), strideB, len(b), strideC, len(c))
+	if size == 0 || m == 0 || n == 0 {
+		return
+	}
+
+	var _a, _b *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	defer admit(8 * float64(size) * float64(m) * float64(n) * float64(k))()
+	C.netlib_zgemm_batch_strided(cblasTranspose(tA), cblasTranspose(tB), C.blasint(m), C.blasint(n), C.blasint(k),
+		(*C.complexdouble)(unsafe.Pointer(&alpha)), (*C.complexdouble)(unsafe.Pointer(_a)), C.blasint(lda), C.ptrdiff_t(strideA),
+		(*C.complexdouble)(unsafe.Pointer(_b)), C.blasint(ldb), C.ptrdiff_t(strideB), (*C.complexdouble)(unsafe.Pointer(&beta)),
+		(*C.complexdouble)(unsafe.Pointer(&c[0])), C.blasint(ldc), C.ptrdiff_t(strideC), C.blasint(size))
+}
+
+// CgemmBatchStrided computes
+//
+//	C_i = alpha * op(A_i) * op(B_i) + beta * C_i
+//
+// for the size products of a strided batch as described above, where op(A_i)
+// is an m×k matrix, op(B_i) is a k×n matrix and C_i is an m×n matrix, with op
+// determined by tA and tB as for Cgemm.
+func (Implementation) CgemmBatchStrided(tA, tB blas.Transpose, m, n, k int, alpha complex64, a []complex64, lda, strideA int, b []complex64, ldb, strideB int, beta complex64, c []complex64, ldc, strideC int, size int) {
+	checkStrided(batchShape{tA: tA, tB: tB, m: m, n: n, k: k, lda: lda, ldb: ldb, ldc: ldc, size: size},
+		strideA, len(a), strideB, len(b), strideC, len(c))
+	if size == 0 || m == 0 || n == 0 {
+		return
+	}
+
+	var _a, _b *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	defer admit(8 * float64(size) * float64(m) * float64(n) * float64(k))()
+	C.netlib_cgemm_batch_strided(cblasTranspose(tA), cblasTranspose(tB), C.blasint(m), C.blasint(n), C.blasint(k),
+		(*C.complexfloat)(unsafe.Pointer(&alpha)), (*C.complexfloat)(unsafe.Pointer(_a)), C.blasint(lda), C.ptrdiff_t(strideA),
+		(*C.complexfloat)(unsafe.Pointer(_b)), C.blasint(ldb), C.ptrdiff_t(strideB), (*C.complexfloat)(unsafe.Pointer(&beta)),
+		(*C.complexfloat)(unsafe.Pointer(&c[0])), C.blasint(ldc), C.ptrdiff_t(strideC), C.blasint(size))
+}
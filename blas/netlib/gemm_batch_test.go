@@ -0,0 +1,292 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+// batchLayout places the matrices of the products of groups in shared
+// slices with random gaps and returns their offsets and the slice lengths.
+func batchLayout(rnd *rand.Rand, sizes []int, need func(g int) (a, b, c int)) (offA, offB, offC []int, lenA, lenB, lenC int) {
+	for g, size := range sizes {
+		na, nb, nc := need(g)
+		for j := 0; j < size; j++ {
+			lenA += rnd.Intn(3)
+			lenB += rnd.Intn(3)
+			lenC += rnd.Intn(3)
+			offA = append(offA, lenA)
+			offB = append(offB, lenB)
+			offC = append(offC, lenC)
+			lenA += na
+			lenB += nb
+			lenC += nc
+		}
+	}
+	return offA, offB, offC, lenA, lenB, lenC
+}
+
+func TestDgemmBatch(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	groups := []DgemmGroup{
+		{TransA: blas.NoTrans, TransB: blas.NoTrans, M: 3, N: 4, K: 5, Alpha: 1, Beta: 0, Lda: 5, Ldb: 4, Ldc: 4, Size: 7},
+		{TransA: blas.Trans, TransB: blas.NoTrans, M: 2, N: 2, K: 3, Alpha: 0.5, Beta: 2, Lda: 4, Ldb: 2, Ldc: 3, Size: 1},
+		{TransA: blas.NoTrans, TransB: blas.Trans, M: 0, N: 3, K: 2, Alpha: 1, Beta: 1, Lda: 2, Ldb: 2, Ldc: 3, Size: 2},
+		{TransA: blas.Trans, TransB: blas.Trans, M: 4, N: 1, K: 6, Alpha: -1, Beta: 0.5, Lda: 4, Ldb: 6, Ldc: 1, Size: 0},
+		{TransA: blas.ConjTrans, TransB: blas.Trans, M: 5, N: 3, K: 2, Alpha: 2, Beta: -1, Lda: 7, Ldb: 2, Ldc: 5, Size: 12},
+	}
+	sizes := make([]int, len(groups))
+	for i, g := range groups {
+		sizes[i] = g.Size
+	}
+	offA, offB, offC, lenA, lenB, lenC := batchLayout(rnd, sizes, func(i int) (int, int, int) {
+		g := groups[i]
+		rowA, colA := g.M, g.K
+		if g.TransA != blas.NoTrans {
+			rowA, colA = g.K, g.M
+		}
+		rowB, colB := g.K, g.N
+		if g.TransB != blas.NoTrans {
+			rowB, colB = g.N, g.K
+		}
+		return matrixLen(rowA, colA, g.Lda), matrixLen(rowB, colB, g.Ldb), matrixLen(g.M, g.N, g.Ldc)
+	})
+	a := randomFloats(rnd, lenA)
+	b := randomFloats(rnd, lenB)
+	c := randomFloats(rnd, lenC)
+
+	want := make([]float64, len(c))
+	copy(want, c)
+	var i int
+	for _, g := range groups {
+		for j := 0; j < g.Size; j++ {
+			impl.Dgemm(g.TransA, g.TransB, g.M, g.N, g.K, g.Alpha, a[offA[i]:], g.Lda, b[offB[i]:], g.Ldb, g.Beta, want[offC[i]:], g.Ldc)
+			i++
+		}
+	}
+
+	impl.DgemmBatch(groups, a, offA, b, offB, c, offC)
+	if !floats.EqualApprox(c, want, tol) {
+		t.Errorf("unexpected result:\ngot  %v\nwant %v", c, want)
+	}
+
+	impl.DgemmBatch(nil, nil, nil, nil, nil, nil, nil)
+
+	for _, test := range []struct {
+		name string
+		fn   func()
+		want string
+	}{
+		{
+			name: "offsets",
+			fn:   func() { impl.DgemmBatch(groups, a, offA[1:], b, offB, c, offC) },
+			want: badOffA,
+		},
+		{
+			name: "negative offset",
+			fn: func() {
+				off := append([]int(nil), offC...)
+				off[len(off)-1] = -1
+				impl.DgemmBatch(groups, a, offA, b, offB, c, off)
+			},
+			want: badOffset,
+		},
+		{
+			name: "short",
+			fn:   func() { impl.DgemmBatch(groups, a, offA, b[:len(b)-1], offB, c, offC) },
+			want: shortB,
+		},
+		{
+			name: "leading dimension",
+			fn: func() {
+				impl.DgemmBatch([]DgemmGroup{{TransA: blas.NoTrans, TransB: blas.NoTrans, M: 1, N: 2, K: 3, Lda: 2, Ldb: 2, Ldc: 2, Size: 1}},
+					a, []int{0}, b, []int{0}, c, []int{0})
+			},
+			want: badLdA,
+		},
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r != test.want {
+					t.Errorf("%s: unexpected panic: got %v want %q", test.name, r, test.want)
+				}
+			}()
+			test.fn()
+		}()
+	}
+}
+
+func TestZgemmBatch(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	groups := []ZgemmGroup{
+		{TransA: blas.NoTrans, TransB: blas.ConjTrans, M: 3, N: 2, K: 4, Alpha: 1 + 2i, Beta: 0.5i, Lda: 4, Ldb: 4, Ldc: 2, Size: 5},
+		{TransA: blas.ConjTrans, TransB: blas.NoTrans, M: 2, N: 3, K: 2, Alpha: -1, Beta: 1, Lda: 3, Ldb: 3, Ldc: 3, Size: 3},
+	}
+	offA, offB, offC, lenA, lenB, lenC := batchLayout(rnd, []int{5, 3}, func(i int) (int, int, int) {
+		if i == 0 {
+			return matrixLen(3, 4, 4), matrixLen(2, 4, 4), matrixLen(3, 2, 2)
+		}
+		return matrixLen(2, 2, 3), matrixLen(2, 3, 3), matrixLen(2, 3, 3)
+	})
+	random := func(n int) []complex128 {
+		s := make([]complex128, n)
+		for i := range s {
+			s[i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+		}
+		return s
+	}
+	a := random(lenA)
+	b := random(lenB)
+	c := random(lenC)
+
+	want := make([]complex128, len(c))
+	copy(want, c)
+	var i int
+	for _, g := range groups {
+		for j := 0; j < g.Size; j++ {
+			impl.Zgemm(g.TransA, g.TransB, g.M, g.N, g.K, g.Alpha, a[offA[i]:], g.Lda, b[offB[i]:], g.Ldb, g.Beta, want[offC[i]:], g.Ldc)
+			i++
+		}
+	}
+
+	impl.ZgemmBatch(groups, a, offA, b, offB, c, offC)
+	for i := range c {
+		if d := c[i] - want[i]; real(d)*real(d)+imag(d)*imag(d) > tol*tol {
+			t.Errorf("unexpected result at %d: got %v want %v", i, c[i], want[i])
+		}
+	}
+}
+
+func TestDgemmBatchStrided(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		tA, tB                    blas.Transpose
+		m, n, k                   int
+		lda, ldb, ldc             int
+		strideA, strideB, strideC int
+		size                      int
+	}{
+		{blas.NoTrans, blas.NoTrans, 3, 4, 5, 5, 4, 4, 15, 20, 12, 6},
+		{blas.Trans, blas.NoTrans, 2, 3, 4, 3, 5, 4, 13, 0, 9, 4},
+		{blas.NoTrans, blas.Trans, 4, 2, 3, 3, 3, 2, 0, 7, 8, 3},
+		{blas.Trans, blas.Trans, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+		{blas.NoTrans, blas.NoTrans, 3, 3, 3, 3, 3, 3, 9, 9, 9, 0},
+	} {
+		rowA, colA := test.m, test.k
+		if test.tA != blas.NoTrans {
+			rowA, colA = test.k, test.m
+		}
+		rowB, colB := test.k, test.n
+		if test.tB != blas.NoTrans {
+			rowB, colB = test.n, test.k
+		}
+		n := max(test.size, 1)
+		a := randomFloats(rnd, (n-1)*test.strideA+matrixLen(rowA, colA, test.lda))
+		b := randomFloats(rnd, (n-1)*test.strideB+matrixLen(rowB, colB, test.ldb))
+		c := randomFloats(rnd, (n-1)*test.strideC+matrixLen(test.m, test.n, test.ldc))
+		alpha, beta := 0.5, -2.0
+
+		want := make([]float64, len(c))
+		copy(want, c)
+		for i := 0; i < test.size; i++ {
+			impl.Dgemm(test.tA, test.tB, test.m, test.n, test.k, alpha, a[i*test.strideA:], test.lda,
+				b[i*test.strideB:], test.ldb, beta, want[i*test.strideC:], test.ldc)
+		}
+
+		impl.DgemmBatchStrided(test.tA, test.tB, test.m, test.n, test.k, alpha, a, test.lda, test.strideA,
+			b, test.ldb, test.strideB, beta, c, test.ldc, test.strideC, test.size)
+		if !floats.EqualApprox(c, want, tol) {
+			t.Errorf("%+v: unexpected result:\ngot  %v\nwant %v", test, c, want)
+		}
+	}
+
+	a := make([]float64, 4*6)
+	b := make([]float64, 6*5)
+	c := make([]float64, 4*5*3)
+	for _, test := range []struct {
+		name string
+		fn   func()
+		want string
+	}{
+		{
+			name: "negative stride",
+			fn: func() {
+				impl.DgemmBatchStrided(blas.NoTrans, blas.NoTrans, 4, 5, 6, 1, a, 6, -1, b, 5, 0, 0, c, 5, 20, 3)
+			},
+			want: badStrideA,
+		},
+		{
+			name: "overlapping C",
+			fn: func() {
+				impl.DgemmBatchStrided(blas.NoTrans, blas.NoTrans, 4, 5, 6, 1, a, 6, 0, b, 5, 0, 0, c, 5, 19, 3)
+			},
+			want: badStrideC,
+		},
+		{
+			name: "short",
+			fn: func() {
+				impl.DgemmBatchStrided(blas.NoTrans, blas.NoTrans, 4, 5, 6, 1, a, 6, 1, b, 5, 0, 0, c, 5, 20, 3)
+			},
+			want: shortA,
+		},
+		{
+			name: "leading dimension",
+			fn: func() {
+				impl.DgemmBatchStrided(blas.NoTrans, blas.NoTrans, 4, 5, 6, 1, a, 5, 0, b, 5, 0, 0, c, 5, 20, 3)
+			},
+			want: badLdA,
+		},
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r != test.want {
+					t.Errorf("%s: unexpected panic: got %v want %q", test.name, r, test.want)
+				}
+			}()
+			test.fn()
+		}()
+	}
+}
+
+func TestZgemmBatchStrided(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	random := func(n int) []complex128 {
+		s := make([]complex128, n)
+		for i := range s {
+			s[i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+		}
+		return s
+	}
+	const (
+		m, n, k = 3, 2, 4
+		size    = 5
+	)
+	a := random(size * m * k)
+	b := random(k * n)
+	c := random(size * m * n)
+	alpha, beta := complex(1, 2), complex(0, 0.5)
+
+	want := make([]complex128, len(c))
+	copy(want, c)
+	for i := 0; i < size; i++ {
+		impl.Zgemm(blas.ConjTrans, blas.NoTrans, m, n, k, alpha, a[i*m*k:], m, b, n, beta, want[i*m*n:], n)
+	}
+
+	impl.ZgemmBatchStrided(blas.ConjTrans, blas.NoTrans, m, n, k, alpha, a, m, m*k, b, n, 0, beta, c, n, m*n, size)
+	for i := range c {
+		if d := c[i] - want[i]; real(d)*real(d)+imag(d)*imag(d) > tol*tol {
+			t.Errorf("unexpected result at %d: got %v want %v", i, c[i], want[i])
+		}
+	}
+}
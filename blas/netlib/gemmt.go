@@ -0,0 +1,242 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+/*
+#cgo dlopen CFLAGS: -DNETLIB_DLOPEN=1
+#include <complex.h>
+#include <stddef.h>
+#include "cblas.h"
+#include "extension.h"
+
+// The ?gemmt routines are extensions provided by Intel MKL, OpenBLAS and
+// BLIS. As for the batched routines they are declared weak where possible
+// or looked up in the library loaded with the dlopen build tag, and the
+// triangle is computed by a loop over ?gemm in C if the library does not
+// provide them.
+#if defined(NETLIB_DLOPEN)
+#define NETLIB_GEMMT 1
+#define NETLIB_WEAK
+#elif defined(__ELF__) && !defined(NETLIB_NO_GEMMT)
+#define NETLIB_GEMMT 1
+#define NETLIB_WEAK __attribute__((weak))
+#else
+#define NETLIB_GEMMT 0
+#define NETLIB_WEAK
+#endif
+
+#define NETLIB_DECLARE_GEMMT(name, S, P) \
+	NETLIB_WEAK void name(const enum CBLAS_ORDER order, const enum CBLAS_UPLO uplo, \
+		const enum CBLAS_TRANSPOSE ta, const enum CBLAS_TRANSPOSE tb, \
+		const blasint n, const blasint k, const S alpha, \
+		const P *a, const blasint lda, const P *b, const blasint ldb, \
+		const S beta, P *c, const blasint ldc);
+
+NETLIB_DECLARE_GEMMT(cblas_sgemmt, float, float)
+NETLIB_DECLARE_GEMMT(cblas_dgemmt, double, double)
+NETLIB_DECLARE_GEMMT(cblas_cgemmt, void *, void)
+NETLIB_DECLARE_GEMMT(cblas_zgemmt, void *, void)
+
+#if NETLIB_GEMMT
+#define NETLIB_CALL_GEMMT(gemmt) \
+	NETLIB_EXTENSION(fn, gemmt) \
+	if (fn != NULL) { \
+		fn(CblasRowMajor, uplo, ta, tb, n, k, alpha, a, lda, b, ldb, beta, c, ldc); \
+		return; \
+	}
+#else
+#define NETLIB_CALL_GEMMT(gemmt)
+#endif
+
+// NETLIB_DEFINE_GEMMT defines netlib_?gemmt, which computes the upper or
+// lower triangle of C = alpha * op(A) * op(B) + beta * C. Without the
+// extension each row of the triangle is computed by ?gemm as the product
+// of a row of op(A) and the columns of op(B) in the triangle.
+#define NETLIB_DEFINE_GEMMT(name, gemmt, gemm, S, P) \
+	static void name(const enum CBLAS_UPLO uplo, \
+		const enum CBLAS_TRANSPOSE ta, const enum CBLAS_TRANSPOSE tb, \
+		const blasint n, const blasint k, const S alpha, \
+		const P *a, const blasint lda, const P *b, const blasint ldb, \
+		const S beta, P *c, const blasint ldc) \
+	{ \
+		NETLIB_CALL_GEMMT(gemmt) \
+		for (blasint i = 0; i < n; i++) { \
+			blasint j = uplo == CblasUpper ? i : 0; \
+			blasint nj = uplo == CblasUpper ? n - i : i + 1; \
+			const P *ai = ta == CblasNoTrans ? a + (ptrdiff_t)i*lda : a + i; \
+			const P *bj = tb == CblasNoTrans ? b + j : b + (ptrdiff_t)j*ldb; \
+			gemm(CblasRowMajor, ta, tb, 1, nj, k, alpha, ai, lda, bj, ldb, beta, c + (ptrdiff_t)i*ldc + j, ldc); \
+		} \
+	}
+
+NETLIB_DEFINE_GEMMT(netlib_sgemmt, cblas_sgemmt, cblas_sgemm, float, float)
+NETLIB_DEFINE_GEMMT(netlib_dgemmt, cblas_dgemmt, cblas_dgemm, double, double)
+NETLIB_DEFINE_GEMMT(netlib_cgemmt, cblas_cgemmt, cblas_cgemm, void *, float complex)
+NETLIB_DEFINE_GEMMT(netlib_zgemmt, cblas_zgemmt, cblas_zgemm, void *, double complex)
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/netlib/diag"
+)
+
+// The ?gemmt routines compute only the upper or lower triangle of the n×n
+// matrix product
+//  C = alpha * op(A) * op(B) + beta * C
+// where op(A) is an n×k matrix and op(B) is a k×n matrix. The elements of C
+// in the other triangle are not referenced. For a Gram matrix such as
+// A * A^T, which is symmetric without being formed by ?syrk from a single
+// operand, this halves the work of a full ?gemm.
+//
+// The routines call the cblas_?gemmt extension of Intel MKL, OpenBLAS or
+// BLIS, as reported by CapGemmt. If the library does not provide it, each
+// row of the triangle is computed by ?gemm on the C side.
+
+// Dgemmt computes the triangle of C selected by ul of
+//  C = alpha * op(A) * op(B) + beta * C
+// where op(A) is an n×k matrix and op(B) is a k×n matrix, with op
+// determined by tA and tB as for Dgemm.
+func (Implementation) Dgemmt(ul blas.Uplo, tA, tB blas.Transpose, n, k int, alpha float64, a []float64, lda int, b []float64, ldb int, beta float64, c []float64, ldc int) {
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dgemmt", ul, tA, tB, n, k, alpha, lda, ldb, beta, ldc)()
+	}
+	checkGemmt("Dgemmt", ul, tA, tB, n, k, lda, ldb, ldc, len(a), len(b), len(c))
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	var _a, _b *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	defer admit(float64(n) * float64(n+1) * float64(k))()
+	C.netlib_dgemmt(cblasUplo(ul), cblasTranspose(tA), cblasTranspose(tB), C.blasint(n), C.blasint(k),
+		C.double(alpha), (*C.double)(_a), C.blasint(lda), (*C.double)(_b), C.blasint(ldb),
+		C.double(beta), (*C.double)(&c[0]), C.blasint(ldc))
+}
+
+// Sgemmt computes the triangle of C selected by ul of
+//  C = alpha * op(A) * op(B) + beta * C
+// where op(A) is an n×k matrix and op(B) is a k×n matrix, with op
+// determined by tA and tB as for Sgemm.
+func (Implementation) Sgemmt(ul blas.Uplo, tA, tB blas.Transpose, n, k int, alpha float32, a []float32, lda int, b []float32, ldb int, beta float32, c []float32, ldc int) {
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Sgemmt", ul, tA, tB, n, k, alpha, lda, ldb, beta, ldc)()
+	}
+	checkGemmt("Sgemmt", ul, tA, tB, n, k, lda, ldb, ldc, len(a), len(b), len(c))
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	var _a, _b *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	defer admit(float64(n) * float64(n+1) * float64(k))()
+	C.netlib_sgemmt(cblasUplo(ul), cblasTranspose(tA), cblasTranspose(tB), C.blasint(n), C.blasint(k),
+		C.float(alpha), (*C.float)(_a), C.blasint(lda), (*C.float)(_b), C.blasint(ldb),
+		C.float(beta), (*C.float)(&c[0]), C.blasint(ldc))
+}
+
+// Zgemmt computes the triangle of C selected by ul of
+//  C = alpha * op(A) * op(B) + beta * C
+// where op(A) is an n×k matrix and op(B) is a k×n matrix, with op
+// determined by tA and tB as for Zgemm.
+func (Implementation) Zgemmt(ul blas.Uplo, tA, tB blas.Transpose, n, k int, alpha complex128, a []complex128, lda int, b []complex128, ldb int, beta complex128, c []complex128, ldc int) {
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zgemmt", ul, tA, tB, n, k, alpha, lda, ldb, beta, ldc)()
+	}
+	checkGemmt("Zgemmt", ul, tA, tB, n, k, lda, ldb, ldc, len(a), len(b), len(c))
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	var _a, _b *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	defer admit(4 * float64(n) * float64(n+1) * float64(k))()
+	C.netlib_zgemmt(cblasUplo(ul), cblasTranspose(tA), cblasTranspose(tB), C.blasint(n), C.blasint(k),
+		unsafe.Pointer(&alpha), (*C.complexdouble)(unsafe.Pointer(_a)), C.blasint(lda), (*C.complexdouble)(unsafe.Pointer(_b)), C.blasint(ldb),
+		unsafe.Pointer(&beta), (*C.complexdouble)(unsafe.Pointer(&c[0])), C.blasint(ldc))
+}
+
+// Cgemmt computes the triangle of C selected by ul of
+//  C = alpha * op(A) * op(B) + beta * C
+// where op(A) is an n×k matrix and op(B) is a k×n matrix, with op
+// determined by tA and tB as for Cgemm.
+func (Implementation) Cgemmt(ul blas.Uplo, tA, tB blas.Transpose, n, k int, alpha complex64, a []complex64, lda int, b []complex64, ldb int, beta complex64, c []complex64, ldc int) {
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Cgemmt", ul, tA, tB, n, k, alpha, lda, ldb, beta, ldc)()
+	}
+	checkGemmt("Cgemmt", ul, tA, tB, n, k, lda, ldb, ldc, len(a), len(b), len(c))
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	var _a, _b *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	defer admit(4 * float64(n) * float64(n+1) * float64(k))()
+	C.netlib_cgemmt(cblasUplo(ul), cblasTranspose(tA), cblasTranspose(tB), C.blasint(n), C.blasint(k),
+		unsafe.Pointer(&alpha), (*C.complexfloat)(unsafe.Pointer(_a)), C.blasint(lda), (*C.complexfloat)(unsafe.Pointer(_b)), C.blasint(ldb),
+		unsafe.Pointer(&beta), (*C.complexfloat)(unsafe.Pointer(&c[0])), C.blasint(ldc))
+}
+
+// checkGemmt panics as the ?gemmt routine name does if its arguments are
+// invalid. The lengths of the slices are la, lb and lc.
+func checkGemmt(name string, ul blas.Uplo, tA, tB blas.Transpose, n, k, lda, ldb, ldc, la, lb, lc int) {
+	if ul != blas.Upper && ul != blas.Lower {
+		panic(Error{Routine: name, Param: "ul", Message: badUplo})
+	}
+	checkGemm(name, tA, tB, n, n, k, lda, ldb, ldc, la, lb, lc)
+	if n > maxInt {
+		panic(Error{Routine: name, Param: "n", Message: nTooLarge})
+	}
+	if k > maxInt {
+		panic(Error{Routine: name, Param: "k", Message: kTooLarge})
+	}
+	if lda > maxInt {
+		panic(Error{Routine: name, Param: "lda", Message: ldaTooLarge})
+	}
+	if ldb > maxInt {
+		panic(Error{Routine: name, Param: "ldb", Message: ldbTooLarge})
+	}
+	if ldc > maxInt {
+		panic(Error{Routine: name, Param: "ldc", Message: ldcTooLarge})
+	}
+}
+
+// cblasUplo returns the CBLAS value of ul.
+func cblasUplo(ul blas.Uplo) C.enum_CBLAS_UPLO {
+	if ul == blas.Upper {
+		return C.CblasUpper
+	}
+	return C.CblasLower
+}
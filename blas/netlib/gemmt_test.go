@@ -0,0 +1,130 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDgemmt(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, dims := range [][2]int{{0, 3}, {1, 0}, {1, 4}, {5, 3}, {7, 8}} {
+		n, k := dims[0], dims[1]
+		for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, tA := range splitTrans {
+				for _, tB := range splitTrans {
+					name := fmt.Sprintf("n=%d,k=%d,ul=%c,tA=%c,tB=%c", n, k, ul, tA, tB)
+					rowA, colA := n, k
+					if tA != blas.NoTrans {
+						rowA, colA = k, n
+					}
+					rowB, colB := k, n
+					if tB != blas.NoTrans {
+						rowB, colB = n, k
+					}
+					lda, ldb, ldc := colA+2, colB+1, n+3
+					a := randomFloats(rnd, matrixLen(rowA, colA, lda))
+					b := randomFloats(rnd, matrixLen(rowB, colB, ldb))
+					c := randomFloats(rnd, matrixLen(n, n, ldc))
+					full := append([]float64(nil), c...)
+					impl.Dgemm(tA, tB, n, n, k, 0.5, a, lda, b, ldb, -2, full, ldc)
+
+					// The elements outside the triangle, including the
+					// padding of each row, must be left unchanged.
+					want := append([]float64(nil), c...)
+					for i := 0; i < n; i++ {
+						j0, j1 := i, n
+						if ul == blas.Lower {
+							j0, j1 = 0, i+1
+						}
+						copy(want[i*ldc+j0:i*ldc+j1], full[i*ldc+j0:i*ldc+j1])
+					}
+					impl.Dgemmt(ul, tA, tB, n, k, 0.5, a, lda, b, ldb, -2, c, ldc)
+					if !floats.EqualApprox(c, want, tol) {
+						t.Errorf("%s: unexpected result", name)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestZgemmt(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	const n, k, lda, ldb, ldc = 5, 3, 6, 7, 8
+	a := make([]complex128, (n-1)*lda+k)
+	b := make([]complex128, (n-1)*ldb+k)
+	c := make([]complex128, (n-1)*ldc+n)
+	for _, s := range [][]complex128{a, b, c} {
+		for i := range s {
+			s[i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+		}
+	}
+	alpha, beta := complex(0.5, 1), complex(-1, 0.25)
+	for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+		full := append([]complex128(nil), c...)
+		impl.Zgemm(blas.NoTrans, blas.ConjTrans, n, n, k, alpha, a, lda, b, ldb, beta, full, ldc)
+		got := append([]complex128(nil), c...)
+		impl.Zgemmt(ul, blas.NoTrans, blas.ConjTrans, n, k, alpha, a, lda, b, ldb, beta, got, ldc)
+		for i := 0; i < n; i++ {
+			for j := 0; j < ldc && i*ldc+j < len(c); j++ {
+				want := c[i*ldc+j]
+				if j < n && (ul == blas.Upper) == (j >= i) {
+					want = full[i*ldc+j]
+				}
+				if d := got[i*ldc+j] - want; real(d)*real(d)+imag(d)*imag(d) > tol*tol {
+					t.Errorf("ul=%c: unexpected element (%d,%d): got %v want %v", ul, i, j, got[i*ldc+j], want)
+				}
+			}
+		}
+	}
+}
+
+func TestGemmtPanics(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		fn   func()
+		want Error
+	}{
+		{
+			name: "Dgemmt",
+			fn: func() {
+				impl.Dgemmt(0, blas.NoTrans, blas.NoTrans, 3, 3, 1, make([]float64, 9), 3, make([]float64, 9), 3, 0, make([]float64, 9), 3)
+			},
+			want: Error{Routine: "Dgemmt", Param: "ul", Message: badUplo},
+		},
+		{
+			name: "Sgemmt",
+			fn: func() {
+				impl.Sgemmt(blas.Lower, blas.Trans, blas.NoTrans, 3, 2, 1, make([]float32, 6), 2, make([]float32, 9), 3, 0, make([]float32, 9), 3)
+			},
+			want: Error{Routine: "Sgemmt", Param: "lda", Message: badLdA},
+		},
+		{
+			name: "Zgemmt",
+			fn: func() {
+				impl.Zgemmt(blas.Upper, blas.NoTrans, blas.NoTrans, 3, 3, 1, make([]complex128, 9), 3, make([]complex128, 9), 3, 0, make([]complex128, 8), 3)
+			},
+			want: Error{Routine: "Zgemmt", Param: "c", Message: shortC},
+		},
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r != test.want {
+					t.Errorf("%s: unexpected panic: got %#v want %#v", test.name, r, test.want)
+				}
+			}()
+			test.fn()
+		}()
+	}
+}
@@ -11,18 +11,17 @@ package main
 import (
 	"bytes"
 	"fmt"
-	"go/ast"
 	"go/format"
 	"io/ioutil"
 	"log"
-	"os"
-	"os/exec"
-	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
 	"text/template"
 
 	"modernc.org/cc"
 
+	"gonum.org/v1/netlib/doccrib"
 	"gonum.org/v1/netlib/internal/binding"
 )
 
@@ -89,6 +88,8 @@ var cgoEnums = map[string]*template.Template{
 }
 
 var cgoTypes = map[binding.TypeKey]*template.Template{
+	{Kind: cc.Int}: template.Must(template.New("int").Parse("C.blasint({{.}})")),
+
 	{Kind: cc.Float, IsPointer: true}: template.Must(template.New("float*").Parse(
 		`(*C.float)({{if eq . "alpha" "beta"}}&{{else}}_{{end}}{{.}})`,
 	)),
@@ -134,9 +135,9 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
-	var docs map[string]map[string][]*ast.Comment
+	var docs doccrib.Docs
 	if cribDocs {
-		docs, err = binding.DocComments(pathTo(srcModule, documentation))
+		docs, err = doccrib.Load(srcModule + "/" + documentation)
 		if err != nil {
 			log.Fatal(err)
 		}
@@ -148,11 +149,14 @@ func main() {
 	if err != nil {
 		log.Fatal(err)
 	}
-	err = h.Execute(&buf, header)
+	var hw bytes.Buffer
+	err = h.Execute(&hw, header)
 	if err != nil {
 		log.Fatal(err)
 	}
+	buf.Write(structuredPanics(hw.Bytes(), ""))
 
+	tooLarge := make(map[string]bool)
 	var n int
 	for _, d := range decls {
 		if !strings.HasPrefix(d.Name, prefix) || skip[d.Name] {
@@ -162,15 +166,21 @@ func main() {
 			buf.WriteByte('\n')
 		}
 		n++
-		goSignature(&buf, d, docs[typ])
+		goSignature(&buf, d, docs)
 		if noteOrigin {
 			fmt.Fprintf(&buf, "\t// declared at %s %s %s ...\n\n", d.Position(), d.Return, d.Name)
 		}
-		parameterChecks(&buf, d, parameterCheckRules)
+		trace(&buf, d)
+		var body bytes.Buffer
+		parameterChecks(&body, d, parameterCheckRules)
+		intRange(&body, d, tooLarge)
+		buf.Write(structuredPanics(body.Bytes(), binding.UpperCaseFirst(strings.TrimPrefix(d.Name, prefix))))
+		admission(&buf, d)
 		buf.WriteByte('\t')
 		cgoCall(&buf, d)
 		buf.WriteString("}\n")
 	}
+	tooLargeConsts(&buf, tooLarge)
 
 	b, err := format.Source(buf.Bytes())
 	if err != nil {
@@ -182,36 +192,17 @@ func main() {
 	}
 }
 
-func goSignature(buf *bytes.Buffer, d binding.Declaration, docs map[string][]*ast.Comment) {
+func goSignature(buf *bytes.Buffer, d binding.Declaration, docs doccrib.Docs) {
 	blasName := strings.TrimPrefix(d.Name, prefix)
 	goName := binding.UpperCaseFirst(blasName)
 
-	if docs != nil {
-		if doc, ok := docs[goName]; ok {
-			if strings.Contains(doc[len(doc)-1].Text, warning) {
-				doc = doc[:len(doc)-2]
-			}
-			for _, c := range doc {
-				buf.WriteString(c.Text)
-				buf.WriteByte('\n')
-			}
-		}
+	for _, c := range docs.Comment(typ, goName, warning) {
+		buf.WriteString(c)
+		buf.WriteByte('\n')
 	}
 
 	parameters := d.Parameters()
-
-	var voidPtrType map[binding.TypeKey]*template.Template
-	for _, p := range parameters {
-		if p.Kind() == cc.Ptr && p.Elem().Kind() == cc.Void {
-			switch {
-			case blasName[0] == 'c', blasName[1] == 'c' && blasName[0] != 'z':
-				voidPtrType = complex64Type
-			case blasName[0] == 'z', blasName[1] == 'z':
-				voidPtrType = complex128Type
-			}
-			break
-		}
-	}
+	voidPtrType := voidPtrTypeFor(d)
 
 	fmt.Fprintf(buf, "func (%s) %s(", typ, goName)
 	c := 0
@@ -255,6 +246,45 @@ func goSignature(buf *bytes.Buffer, d binding.Declaration, docs map[string][]*as
 	}
 }
 
+// voidPtrTypeFor returns the Go types of the void pointer parameters of d.
+func voidPtrTypeFor(d binding.Declaration) map[binding.TypeKey]*template.Template {
+	blasName := strings.TrimPrefix(d.Name, prefix)
+	for _, p := range d.Parameters() {
+		if p.Kind() == cc.Ptr && p.Elem().Kind() == cc.Void {
+			switch {
+			case blasName[0] == 'c', blasName[1] == 'c' && blasName[0] != 'z':
+				return complex64Type
+			case blasName[0] == 'z', blasName[1] == 'z':
+				return complex128Type
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+// trace writes the diagnostics of d, which are started before the
+// parameters are checked so that argument errors are reported. The scalar
+// parameters are passed as the arguments of the call.
+func trace(buf *bytes.Buffer, d binding.Declaration) {
+	voidPtrType := voidPtrTypeFor(d)
+	fmt.Fprintf(buf, "\tif diag.Current() != diag.Off {\n\t\tdefer diag.Trace(\"blas\", %q", binding.UpperCaseFirst(strings.TrimPrefix(d.Name, prefix)))
+	for _, p := range d.Parameters() {
+		n := shorten(binding.LowerCaseFirst(p.Name()))
+		var typ string
+		if p.Kind() == cc.Enum {
+			typ = binding.GoTypeForEnum(p.Type(), n, blasEnums)
+		} else {
+			typ = binding.GoTypeFor(p.Type(), n, voidPtrType)
+		}
+		if typ == "order" || strings.HasPrefix(typ, "[]") {
+			continue
+		}
+		fmt.Fprintf(buf, ", %s", n)
+	}
+	buf.WriteString(")()\n\t}\n")
+}
+
 func parameterChecks(buf *bytes.Buffer, d binding.Declaration, rules []func(*bytes.Buffer, binding.Declaration, binding.Parameter)) {
 	for _, r := range rules {
 		for _, p := range d.Parameters() {
@@ -284,6 +314,104 @@ func cgoCall(buf *bytes.Buffer, d binding.Declaration) {
 	buf.WriteString(")\n")
 }
 
+var (
+	method     = regexp.MustCompile(`^func \(\w+\) (\w+)\(`)
+	panicConst = regexp.MustCompile(`panic\((\w+)\)`)
+	switchTag  = regexp.MustCompile(`^\s*switch (\w+) {`)
+	lenArg     = regexp.MustCompile(`len\((\w+)\)`)
+	ifArg      = regexp.MustCompile(`^\s*if \(?(\w+)`)
+)
+
+// structuredPanics rewrites the panics with a constant message in src to
+// panic with an Error holding the routine and the checked parameter. The
+// routine is the last method declared in src, or routine if there is none.
+// The parameter is the slice whose length is checked or the first operand
+// of the condition of an if statement, or the tag of the switch statement
+// of a default case.
+func structuredPanics(src []byte, routine string) []byte {
+	lines := strings.Split(string(src), "\n")
+	var tag, prev string
+	for i, l := range lines {
+		if m := method.FindStringSubmatch(l); m != nil {
+			routine = m[1]
+		}
+		if m := switchTag.FindStringSubmatch(l); m != nil {
+			tag = m[1]
+		}
+		if m := panicConst.FindStringSubmatch(l); m != nil {
+			param := tag
+			if m := lenArg.FindStringSubmatch(prev); m != nil {
+				param = m[1]
+			} else if m := ifArg.FindStringSubmatch(prev); m != nil {
+				param = m[1]
+			}
+			lines[i] = strings.Replace(l, m[0], fmt.Sprintf("panic(Error{Routine: %q, Param: %q, Message: %s})", routine, param, m[1]), 1)
+		}
+		prev = l
+	}
+	return []byte(strings.Join(lines, "\n"))
+}
+
+// intRange writes checks that the int parameters of d can be represented by
+// the integer type of the backend, recording the parameter names in names.
+func intRange(buf *bytes.Buffer, d binding.Declaration, names map[string]bool) {
+	for _, p := range d.Parameters() {
+		if p.Kind() != cc.Int {
+			continue
+		}
+		n := shorten(binding.LowerCaseFirst(p.Name()))
+		names[n] = true
+		fmt.Fprintf(buf, `	if %[1]s < minInt || %[1]s > maxInt {
+		panic(%[1]sTooLarge)
+	}
+`, n)
+	}
+}
+
+// tooLargeConsts writes the panic strings used by the checks written by
+// intRange.
+func tooLargeConsts(buf *bytes.Buffer, names map[string]bool) {
+	var sorted []string
+	for n := range names {
+		sorted = append(sorted, n)
+	}
+	sort.Strings(sorted)
+	buf.WriteString("\n// Panic strings for parameters that cannot be represented by the\n// integer type of the backend.\nconst (\n")
+	for _, n := range sorted {
+		fmt.Fprintf(buf, "\t%[1]sTooLarge = \"blas: %[1]s too large\"\n", n)
+	}
+	buf.WriteString(")\n")
+}
+
+// flops holds the number of floating point operations performed by the
+// Level 3 routines, which are admitted by the process-wide admission
+// controller. The keys are routine names without the type prefix.
+var flops = map[string]string{
+	"gemm":  "2 * float64(m) * float64(n) * float64(k)",
+	"symm":  "2 * sideFlops(s == C.CblasLeft, m, n)",
+	"hemm":  "2 * sideFlops(s == C.CblasLeft, m, n)",
+	"syrk":  "float64(n) * float64(n) * float64(k)",
+	"herk":  "float64(n) * float64(n) * float64(k)",
+	"syr2k": "2 * float64(n) * float64(n) * float64(k)",
+	"her2k": "2 * float64(n) * float64(n) * float64(k)",
+	"trmm":  "sideFlops(s == C.CblasLeft, m, n)",
+	"trsm":  "sideFlops(s == C.CblasLeft, m, n)",
+}
+
+// admission writes the admission of Level 3 routines. A complex operation
+// is counted as four real operations.
+func admission(buf *bytes.Buffer, d binding.Declaration) {
+	blasName := strings.TrimPrefix(d.Name, prefix)
+	f, ok := flops[blasName[1:]]
+	if !ok {
+		return
+	}
+	if blasName[0] == 'c' || blasName[0] == 'z' {
+		f = "4 * " + f
+	}
+	fmt.Fprintf(buf, "\tdefer admit(%s)()\n", f)
+}
+
 var parameterCheckRules = []func(*bytes.Buffer, binding.Declaration, binding.Parameter){
 	trans,
 	uplo,
@@ -806,33 +934,6 @@ func ldToPanicString(ld string) string {
 	}
 }
 
-// pathTo returns the path to package within the given module. If running
-// in module mode, this will look within the module in $GOPATH/pkg/mod
-// at the correct version, otherwise it will find the version installed
-// at $GOPATH/src/module/pkg.
-func pathTo(module, pkg string) string {
-	gopath, ok := os.LookupEnv("GOPATH")
-	if !ok {
-		var err error
-		gopath, err = os.UserHomeDir()
-		if err != nil {
-			log.Fatal(err)
-		}
-		gopath = filepath.Join(gopath, "go")
-	}
-
-	cmd := exec.Command("go", "list", "-m", module)
-	var buf, stderr bytes.Buffer
-	cmd.Stdout = &buf
-	cmd.Stderr = &stderr
-	err := cmd.Run()
-	if err != nil {
-		log.Fatalf("module aware go list failed with stderr output %q: %v", stderr.String(), err)
-	}
-	version := strings.TrimSpace(strings.Join(strings.Split(buf.String(), " "), "@"))
-	return filepath.Join(gopath, "pkg", "mod", version, pkg)
-}
-
 const handwritten = `// Code generated by "go generate gonum.org/v1/netlib/blas/netlib" from {{.}}; DO NOT EDIT.
 
 // Copyright ©2014 The Gonum Authors. All rights reserved.
@@ -851,6 +952,7 @@ import (
 	"unsafe"
 
 	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/netlib/diag"
 )
 
 // Type check assertions:
@@ -942,7 +1044,16 @@ func (Implementation) Srotm(n int, x []float32, incX int, y []float32, incY int,
 		flag: float32(p.Flag),
 		h:    p.H,
 	}
-	C.cblas_srotm(C.int(n), (*C.float)(_x), C.int(incX), (*C.float)(_y), C.int(incY), (*C.float)(unsafe.Pointer(&pi)))
+	if n < minInt || n > maxInt {
+		panic(nTooLarge)
+	}
+	if incX < minInt || incX > maxInt {
+		panic(incXTooLarge)
+	}
+	if incY < minInt || incY > maxInt {
+		panic(incYTooLarge)
+	}
+	C.cblas_srotm(C.blasint(n), (*C.float)(_x), C.blasint(incX), (*C.float)(_y), C.blasint(incY), (*C.float)(unsafe.Pointer(&pi)))
 }
 func (Implementation) Drotg(a float64, b float64) (c float64, s float64, r float64, z float64) {
 	C.cblas_drotg((*C.double)(&a), (*C.double)(&b), (*C.double)(&c), (*C.double)(&s))
@@ -991,7 +1102,16 @@ func (Implementation) Drotm(n int, x []float64, incX int, y []float64, incY int,
 		flag: float64(p.Flag),
 		h:    p.H,
 	}
-	C.cblas_drotm(C.int(n), (*C.double)(_x), C.int(incX), (*C.double)(_y), C.int(incY), (*C.double)(unsafe.Pointer(&pi)))
+	if n < minInt || n > maxInt {
+		panic(nTooLarge)
+	}
+	if incX < minInt || incX > maxInt {
+		panic(incXTooLarge)
+	}
+	if incY < minInt || incY > maxInt {
+		panic(incYTooLarge)
+	}
+	C.cblas_drotm(C.blasint(n), (*C.double)(_x), C.blasint(incX), (*C.double)(_y), C.blasint(incY), (*C.double)(unsafe.Pointer(&pi)))
 }
 func (Implementation) Cdotu(n int, x []complex64, incX int, y []complex64, incY int) (dotu complex64) {
 	if n < 0 {
@@ -1024,7 +1144,16 @@ func (Implementation) Cdotu(n int, x []complex64, incX int, y []complex64, incY
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_cdotu_sub(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(&dotu))
+	if n < minInt || n > maxInt {
+		panic(nTooLarge)
+	}
+	if incX < minInt || incX > maxInt {
+		panic(incXTooLarge)
+	}
+	if incY < minInt || incY > maxInt {
+		panic(incYTooLarge)
+	}
+	C.cblas_cdotu_sub(C.blasint(n), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(_y), C.blasint(incY), unsafe.Pointer(&dotu))
 	return dotu
 }
 func (Implementation) Cdotc(n int, x []complex64, incX int, y []complex64, incY int) (dotc complex64) {
@@ -1058,7 +1187,16 @@ func (Implementation) Cdotc(n int, x []complex64, incX int, y []complex64, incY
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_cdotc_sub(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(&dotc))
+	if n < minInt || n > maxInt {
+		panic(nTooLarge)
+	}
+	if incX < minInt || incX > maxInt {
+		panic(incXTooLarge)
+	}
+	if incY < minInt || incY > maxInt {
+		panic(incYTooLarge)
+	}
+	C.cblas_cdotc_sub(C.blasint(n), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(_y), C.blasint(incY), unsafe.Pointer(&dotc))
 	return dotc
 }
 func (Implementation) Zdotu(n int, x []complex128, incX int, y []complex128, incY int) (dotu complex128) {
@@ -1092,7 +1230,16 @@ func (Implementation) Zdotu(n int, x []complex128, incX int, y []complex128, inc
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_zdotu_sub(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(&dotu))
+	if n < minInt || n > maxInt {
+		panic(nTooLarge)
+	}
+	if incX < minInt || incX > maxInt {
+		panic(incXTooLarge)
+	}
+	if incY < minInt || incY > maxInt {
+		panic(incYTooLarge)
+	}
+	C.cblas_zdotu_sub(C.blasint(n), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(_y), C.blasint(incY), unsafe.Pointer(&dotu))
 	return dotu
 }
 func (Implementation) Zdotc(n int, x []complex128, incX int, y []complex128, incY int) (dotc complex128) {
@@ -1126,7 +1273,16 @@ func (Implementation) Zdotc(n int, x []complex128, incX int, y []complex128, inc
 	if len(y) > 0 {
 		_y = &y[0]
 	}
-	C.cblas_zdotc_sub(C.int(n), unsafe.Pointer(_x), C.int(incX), unsafe.Pointer(_y), C.int(incY), unsafe.Pointer(&dotc))
+	if n < minInt || n > maxInt {
+		panic(nTooLarge)
+	}
+	if incX < minInt || incX > maxInt {
+		panic(incXTooLarge)
+	}
+	if incY < minInt || incY > maxInt {
+		panic(incYTooLarge)
+	}
+	C.cblas_zdotc_sub(C.blasint(n), unsafe.Pointer(_x), C.blasint(incX), unsafe.Pointer(_y), C.blasint(incY), unsafe.Pointer(&dotc))
 	return dotc
 }
 
@@ -0,0 +1,262 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+// generate_cgocalls creates a cgocalls_auto_test.go file holding a call with
+// valid arguments of each method declared in blas.go, and the number of
+// variables of the method that escape to the heap and of the allocations
+// made for them, for the tests in cgocalls_test.go.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+)
+
+const (
+	source = "blas.go"
+	target = "cgocalls_auto_test.go"
+
+	typ = "Implementation"
+)
+
+// enums holds the arguments passed for the parameters of a type of the blas
+// package.
+var enums = map[string]string{
+	"Uplo":        "blas.Upper",
+	"Transpose":   "blas.NoTrans",
+	"Diag":        "blas.NonUnit",
+	"Side":        "blas.Left",
+	"DrotmParams": "blas.DrotmParams{Flag: blas.Identity}",
+	"SrotmParams": "blas.SrotmParams{Flag: blas.Identity}",
+}
+
+// buffers holds the names of the arrays of slices declared in
+// cgocalls_test.go that are passed for the slice parameters of each element
+// type.
+var buffers = map[string]string{
+	"float32":    "sbuf",
+	"float64":    "dbuf",
+	"complex64":  "cbuf",
+	"complex128": "zbuf",
+}
+
+// bandWidths holds the int parameters that are passed 1 instead of the
+// dimension of the operands so that band matrices have the width of the
+// leading dimension.
+var bandWidths = map[string]bool{
+	"k":  true,
+	"kL": true,
+	"kU": true,
+}
+
+const (
+	dim    = "3"
+	ld     = "3"
+	inc    = "1"
+	scalar = "1"
+
+	// maxBuffers is the length of the arrays of slices in
+	// cgocalls_test.go.
+	maxBuffers = 3
+)
+
+func main() {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, source, nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`// Code generated by "go run generate_cgocalls.go"; DO NOT EDIT.
+
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import "gonum.org/v1/gonum/blas"
+
+// cgoCallTests holds a call of each method declared in blas.go, the
+// number of its variables whose address is passed to C and the number of
+// allocations made for them when small variables are combined.
+var cgoCallTests = []cgoCallTest{
+`)
+	for _, d := range f.Decls {
+		fn, ok := d.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || !fn.Name.IsExported() || receiver(fn) != typ {
+			continue
+		}
+		args, err := arguments(fn)
+		if err != nil {
+			log.Fatal(err)
+		}
+		vars, allocs, err := allocations(fn)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Fprintf(&buf, "\t{name: %q, vars: %d, allocs: %d, fn: func() { impl.%s(%s) }},\n", fn.Name.Name, vars, allocs, fn.Name.Name, args)
+	}
+	buf.WriteString("}\n")
+
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = ioutil.WriteFile(target, b, 0664)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// receiver returns the name of the receiver type of fn.
+func receiver(fn *ast.FuncDecl) string {
+	id, ok := fn.Recv.List[0].Type.(*ast.Ident)
+	if !ok {
+		return ""
+	}
+	return id.Name
+}
+
+// arguments returns the arguments of a valid call of fn with all dimensions
+// 3, unit increments and the leading dimension of a 3×3 matrix.
+func arguments(fn *ast.FuncDecl) (string, error) {
+	var buf bytes.Buffer
+	used := make(map[string]int)
+	for _, field := range fn.Type.Params.List {
+		for _, name := range field.Names {
+			if buf.Len() != 0 {
+				buf.WriteString(", ")
+			}
+			var arg string
+			switch t := field.Type.(type) {
+			case *ast.Ident:
+				switch {
+				case t.Name != "int":
+					arg = scalar
+				case bandWidths[name.Name]:
+					arg = "1"
+				case name.Name == "incX", name.Name == "incY":
+					arg = inc
+				case name.Name == "lda", name.Name == "ldb", name.Name == "ldc":
+					arg = ld
+				default:
+					arg = dim
+				}
+			case *ast.SelectorExpr:
+				arg = enums[t.Sel.Name]
+			case *ast.ArrayType:
+				if elem, ok := t.Elt.(*ast.Ident); ok && used[elem.Name] < maxBuffers {
+					if b, ok := buffers[elem.Name]; ok {
+						arg = fmt.Sprintf("%s[%d]", b, used[elem.Name])
+						used[elem.Name]++
+					}
+				}
+			}
+			if arg == "" {
+				return "", fmt.Errorf("%s: no argument for parameter %s", fn.Name.Name, name.Name)
+			}
+			buf.WriteString(arg)
+		}
+	}
+	return buf.String(), nil
+}
+
+// sizes holds the sizes in bytes of the types of the variables whose
+// address is passed to C.
+var sizes = map[string]int{
+	"float32":     4,
+	"float64":     8,
+	"complex64":   8,
+	"complex128":  16,
+	"srotmParams": 20,
+	"drotmParams": 40,
+}
+
+// maxCombined is the largest total size of the variables without pointers
+// that a compiler may place in one allocation. A variable of that size or
+// larger is allocated alone.
+const maxCombined = 16
+
+// allocations returns the number of distinct variables whose address is
+// taken in the body of fn, which escape to the heap since their address is
+// passed to C, and the number of allocations made for them by a compiler
+// that combines small variables.
+//
+// Such a compiler allocates the parameters and results of fn when fn is
+// entered and the local variables where they are declared, so the
+// parameters and results are combined in their order in the signature, and
+// the local variables, which are declared after the checks of the
+// arguments, in their order in the body.
+func allocations(fn *ast.FuncDecl) (vars, allocs int, err error) {
+	taken := make(map[string]bool)
+	var locals []*ast.Field
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.UnaryExpr:
+			if id, ok := n.X.(*ast.Ident); ok && n.Op == token.AND {
+				taken[id.Name] = true
+			}
+		case *ast.ValueSpec:
+			if n.Type != nil {
+				locals = append(locals, &ast.Field{Names: n.Names, Type: n.Type})
+			}
+		case *ast.AssignStmt:
+			if n.Tok != token.DEFINE || len(n.Lhs) != 1 || len(n.Rhs) != 1 {
+				break
+			}
+			lit, ok := n.Rhs[0].(*ast.CompositeLit)
+			id, isIdent := n.Lhs[0].(*ast.Ident)
+			if ok && isIdent {
+				locals = append(locals, &ast.Field{Names: []*ast.Ident{id}, Type: lit.Type})
+			}
+		}
+		return true
+	})
+
+	signature := fn.Type.Params.List
+	if fn.Type.Results != nil {
+		signature = append(signature[:len(signature):len(signature)], fn.Type.Results.List...)
+	}
+	for _, fields := range [][]*ast.Field{signature, locals} {
+		var used int
+		for _, field := range fields {
+			for _, name := range field.Names {
+				if !taken[name.Name] {
+					continue
+				}
+				id, ok := field.Type.(*ast.Ident)
+				if !ok || sizes[id.Name] == 0 {
+					return 0, 0, fmt.Errorf("%s: unknown size of %s", fn.Name.Name, name.Name)
+				}
+				size := sizes[id.Name]
+				vars++
+				if size >= maxCombined {
+					allocs++
+					continue
+				}
+				if used+size > maxCombined {
+					used = 0
+				}
+				if used == 0 {
+					allocs++
+				}
+				used += size
+			}
+		}
+	}
+	if vars != len(taken) {
+		return 0, 0, fmt.Errorf("%s: address of undeclared variable taken", fn.Name.Name)
+	}
+	return vars, allocs, nil
+}
@@ -0,0 +1,77 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+// generate_dlopen creates a cblas_dlopen.c file defining the CBLAS functions
+// called by blas.go as trampolines into a library loaded at run time.
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"regexp"
+
+	"gonum.org/v1/netlib/internal/binding"
+)
+
+const (
+	header = "cblas.h"
+	source = "blas.go"
+	target = "cblas_dlopen.c"
+)
+
+var dlopen = binding.Dlopen{
+	Header:   header,
+	Prefix:   "cblas",
+	Env:      "NETLIB_CBLAS_LIBRARY",
+	BuildTag: "dlopen",
+	Candidates: []string{
+		"libopenblas.so.0",
+		"libopenblas.so",
+		"libmkl_rt.so.2",
+		"libmkl_rt.so",
+		"libtatlas.so.3",
+		"libsatlas.so.3",
+		"libcblas.so.3",
+		"libblas.so.3",
+	},
+	DarwinCandidates: []string{
+		"libopenblas.dylib",
+		"/System/Library/Frameworks/Accelerate.framework/Accelerate",
+	},
+	WindowsCandidates: []string{
+		"libopenblas.dll",
+		"mkl_rt.2.dll",
+	},
+	Generator: "gonum.org/v1/netlib/blas/netlib",
+}
+
+var call = regexp.MustCompile(`C\.(cblas_\w+)\(`)
+
+func main() {
+	decls, err := binding.Declarations(header)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := ioutil.ReadFile(source)
+	if err != nil {
+		log.Fatal(err)
+	}
+	names := make(map[string]bool)
+	for _, m := range call.FindAllSubmatch(src, -1) {
+		names[string(m[1])] = true
+	}
+
+	var buf bytes.Buffer
+	err = dlopen.Generate(&buf, decls, names)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = ioutil.WriteFile(target, buf.Bytes(), 0664)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
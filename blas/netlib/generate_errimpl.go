@@ -0,0 +1,39 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+// generate_errimpl creates an errimpl.go file defining the methods of
+// ErrImplementation from the methods of Implementation.
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+
+	"gonum.org/v1/netlib/internal/binding"
+)
+
+const target = "errimpl.go"
+
+var methods = binding.ErrMethods{
+	Package:   "netlib",
+	Receiver:  "Implementation",
+	Type:      "ErrImplementation",
+	Catch:     "catch",
+	Generator: "gonum.org/v1/netlib/blas/netlib",
+}
+
+func main() {
+	var buf bytes.Buffer
+	err := methods.Generate(&buf, ".", target)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = ioutil.WriteFile(target, buf.Bytes(), 0664)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
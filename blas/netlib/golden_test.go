@@ -0,0 +1,404 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"flag"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/netlib/internal/golden"
+)
+
+var recordGolden = flag.String("golden.record", "", "record testdata/golden.json from the linked library, naming it as the source")
+
+const goldenPath = "testdata/golden.json"
+
+// TestGolden verifies the linked library against the results recorded from
+// the reference CBLAS. To record the corpus, link the reference CBLAS and run
+//
+//	go test -run Golden -golden.record "Netlib reference CBLAS 3.12.0"
+func TestGolden(t *testing.T) {
+	if *recordGolden != "" {
+		err := golden.Record(goldenRoutines, 1, *recordGolden).Save(goldenPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	c, err := golden.Load(goldenPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range golden.Verify(c, goldenRoutines) {
+		t.Error(m)
+	}
+}
+
+var (
+	goldenTrans = []blas.Transpose{blas.NoTrans, blas.Trans, blas.ConjTrans}
+	goldenUplo  = []blas.Uplo{blas.Upper, blas.Lower}
+	goldenDiag  = []blas.Diag{blas.NonUnit, blas.Unit}
+	goldenSide  = []blas.Side{blas.Left, blas.Right}
+)
+
+// vectorCases returns cases for a Level 1 routine of the vectors x and y.
+func vectorCases(rnd *rand.Rand) []golden.Case {
+	var cases []golden.Case
+	for _, n := range []int{1, 4, 17} {
+		for _, inc := range [][2]int{{1, 1}, {2, 3}, {-2, 1}} {
+			cases = append(cases, golden.Case{
+				Args: map[string]int{"n": n, "incX": inc[0], "incY": inc[1]},
+				In: map[string][]float64{
+					"alpha": {rnd.NormFloat64()},
+					"x":     golden.Random(rnd, 1+(n-1)*abs(inc[0])),
+					"y":     golden.Random(rnd, 1+(n-1)*abs(inc[1])),
+				},
+			})
+		}
+	}
+	return cases
+}
+
+// triangular returns an n×n matrix with a dominant diagonal, so that the
+// triangular solves are well conditioned.
+func triangular(rnd *rand.Rand, n int) []float64 {
+	a := golden.Random(rnd, n*n)
+	for i := 0; i < n; i++ {
+		a[i*n+i] += float64(2 * n)
+	}
+	return a
+}
+
+func cmplx128(s []float64) []complex128 {
+	c := make([]complex128, len(s)/2)
+	for i := range c {
+		c[i] = complex(s[2*i], s[2*i+1])
+	}
+	return c
+}
+
+func float64s(c []complex128) []float64 {
+	s := make([]float64, 2*len(c))
+	for i, v := range c {
+		s[2*i] = real(v)
+		s[2*i+1] = imag(v)
+	}
+	return s
+}
+
+var goldenRoutines = []golden.Routine{
+	{
+		Name:  "ddot",
+		Tol:   1e-14,
+		Cases: vectorCases,
+		Run: func(c golden.Case) map[string][]float64 {
+			n, incX, incY := c.Args["n"], c.Args["incX"], c.Args["incY"]
+			return map[string][]float64{"r": {impl.Ddot(n, c.In["x"], incX, c.In["y"], incY)}}
+		},
+	},
+	{
+		Name:  "dnrm2",
+		Tol:   1e-14,
+		Cases: vectorCases,
+		Run: func(c golden.Case) map[string][]float64 {
+			n, incX := c.Args["n"], abs(c.Args["incX"])
+			return map[string][]float64{"r": {impl.Dnrm2(n, c.In["x"], incX)}}
+		},
+	},
+	{
+		Name:  "dasum",
+		Tol:   1e-14,
+		Cases: vectorCases,
+		Run: func(c golden.Case) map[string][]float64 {
+			n, incX := c.Args["n"], abs(c.Args["incX"])
+			return map[string][]float64{"r": {impl.Dasum(n, c.In["x"], incX)}}
+		},
+	},
+	{
+		Name:  "daxpy",
+		Tol:   1e-14,
+		Cases: vectorCases,
+		Run: func(c golden.Case) map[string][]float64 {
+			n, incX, incY := c.Args["n"], c.Args["incX"], c.Args["incY"]
+			y := golden.Float64s(c.In["y"])
+			impl.Daxpy(n, c.In["alpha"][0], c.In["x"], incX, y, incY)
+			return map[string][]float64{"y": y}
+		},
+	},
+	{
+		Name: "dgemv",
+		Tol:  1e-13,
+		Cases: func(rnd *rand.Rand) []golden.Case {
+			var cases []golden.Case
+			for trans := 0; trans < 2; trans++ {
+				for _, mn := range [][2]int{{1, 1}, {4, 7}, {9, 3}} {
+					m, n := mn[0], mn[1]
+					lx, ly := n, m
+					if trans != 0 {
+						lx, ly = m, n
+					}
+					cases = append(cases, golden.Case{
+						Args: map[string]int{"trans": trans, "m": m, "n": n},
+						In: map[string][]float64{
+							"alpha": {rnd.NormFloat64()},
+							"beta":  {rnd.NormFloat64()},
+							"a":     golden.Random(rnd, m*n),
+							"x":     golden.Random(rnd, lx),
+							"y":     golden.Random(rnd, ly),
+						},
+					})
+				}
+			}
+			return cases
+		},
+		Run: func(c golden.Case) map[string][]float64 {
+			m, n := c.Args["m"], c.Args["n"]
+			y := golden.Float64s(c.In["y"])
+			impl.Dgemv(goldenTrans[c.Args["trans"]], m, n, c.In["alpha"][0], c.In["a"], n, c.In["x"], 1, c.In["beta"][0], y, 1)
+			return map[string][]float64{"y": y}
+		},
+	},
+	{
+		Name: "dger",
+		Tol:  1e-14,
+		Cases: func(rnd *rand.Rand) []golden.Case {
+			var cases []golden.Case
+			for _, mn := range [][2]int{{1, 1}, {4, 7}, {9, 3}} {
+				m, n := mn[0], mn[1]
+				cases = append(cases, golden.Case{
+					Args: map[string]int{"m": m, "n": n},
+					In: map[string][]float64{
+						"alpha": {rnd.NormFloat64()},
+						"x":     golden.Random(rnd, m),
+						"y":     golden.Random(rnd, n),
+						"a":     golden.Random(rnd, m*n),
+					},
+				})
+			}
+			return cases
+		},
+		Run: func(c golden.Case) map[string][]float64 {
+			m, n := c.Args["m"], c.Args["n"]
+			a := golden.Float64s(c.In["a"])
+			impl.Dger(m, n, c.In["alpha"][0], c.In["x"], 1, c.In["y"], 1, a, n)
+			return map[string][]float64{"a": a}
+		},
+	},
+	{
+		Name: "dsymv",
+		Tol:  1e-13,
+		Cases: func(rnd *rand.Rand) []golden.Case {
+			var cases []golden.Case
+			for uplo := range goldenUplo {
+				for _, n := range []int{1, 6} {
+					cases = append(cases, golden.Case{
+						Args: map[string]int{"uplo": uplo, "n": n},
+						In: map[string][]float64{
+							"alpha": {rnd.NormFloat64()},
+							"beta":  {rnd.NormFloat64()},
+							"a":     golden.Random(rnd, n*n),
+							"x":     golden.Random(rnd, n),
+							"y":     golden.Random(rnd, n),
+						},
+					})
+				}
+			}
+			return cases
+		},
+		Run: func(c golden.Case) map[string][]float64 {
+			n := c.Args["n"]
+			y := golden.Float64s(c.In["y"])
+			impl.Dsymv(goldenUplo[c.Args["uplo"]], n, c.In["alpha"][0], c.In["a"], n, c.In["x"], 1, c.In["beta"][0], y, 1)
+			return map[string][]float64{"y": y}
+		},
+	},
+	{
+		Name: "dtrsv",
+		Tol:  1e-13,
+		Cases: func(rnd *rand.Rand) []golden.Case {
+			var cases []golden.Case
+			for uplo := range goldenUplo {
+				for trans := 0; trans < 2; trans++ {
+					for diag := range goldenDiag {
+						const n = 7
+						cases = append(cases, golden.Case{
+							Args: map[string]int{"uplo": uplo, "trans": trans, "diag": diag, "n": n},
+							In: map[string][]float64{
+								"a": triangular(rnd, n),
+								"x": golden.Random(rnd, n),
+							},
+						})
+					}
+				}
+			}
+			return cases
+		},
+		Run: func(c golden.Case) map[string][]float64 {
+			n := c.Args["n"]
+			x := golden.Float64s(c.In["x"])
+			impl.Dtrsv(goldenUplo[c.Args["uplo"]], goldenTrans[c.Args["trans"]], goldenDiag[c.Args["diag"]], n, c.In["a"], n, x, 1)
+			return map[string][]float64{"x": x}
+		},
+	},
+	{
+		Name: "dgemm",
+		Tol:  1e-13,
+		Cases: func(rnd *rand.Rand) []golden.Case {
+			var cases []golden.Case
+			for tA := 0; tA < 2; tA++ {
+				for tB := 0; tB < 2; tB++ {
+					for _, mnk := range [][3]int{{1, 1, 1}, {3, 4, 5}, {17, 9, 13}} {
+						m, n, k := mnk[0], mnk[1], mnk[2]
+						cases = append(cases, golden.Case{
+							Args: map[string]int{"tA": tA, "tB": tB, "m": m, "n": n, "k": k},
+							In: map[string][]float64{
+								"alpha": {rnd.NormFloat64()},
+								"beta":  {rnd.NormFloat64()},
+								"a":     golden.Random(rnd, m*k),
+								"b":     golden.Random(rnd, k*n),
+								"c":     golden.Random(rnd, m*n),
+							},
+						})
+					}
+				}
+			}
+			return cases
+		},
+		Run: func(c golden.Case) map[string][]float64 {
+			tA, tB := goldenTrans[c.Args["tA"]], goldenTrans[c.Args["tB"]]
+			m, n, k := c.Args["m"], c.Args["n"], c.Args["k"]
+			lda, ldb := k, n
+			if tA != blas.NoTrans {
+				lda = m
+			}
+			if tB != blas.NoTrans {
+				ldb = k
+			}
+			cc := golden.Float64s(c.In["c"])
+			impl.Dgemm(tA, tB, m, n, k, c.In["alpha"][0], c.In["a"], lda, c.In["b"], ldb, c.In["beta"][0], cc, n)
+			return map[string][]float64{"c": cc}
+		},
+	},
+	{
+		Name: "dsyrk",
+		Tol:  1e-13,
+		Cases: func(rnd *rand.Rand) []golden.Case {
+			var cases []golden.Case
+			for uplo := range goldenUplo {
+				for trans := 0; trans < 2; trans++ {
+					const n, k = 6, 4
+					cases = append(cases, golden.Case{
+						Args: map[string]int{"uplo": uplo, "trans": trans, "n": n, "k": k},
+						In: map[string][]float64{
+							"alpha": {rnd.NormFloat64()},
+							"beta":  {rnd.NormFloat64()},
+							"a":     golden.Random(rnd, n*k),
+							"c":     golden.Random(rnd, n*n),
+						},
+					})
+				}
+			}
+			return cases
+		},
+		Run: func(c golden.Case) map[string][]float64 {
+			trans := goldenTrans[c.Args["trans"]]
+			n, k := c.Args["n"], c.Args["k"]
+			lda := k
+			if trans != blas.NoTrans {
+				lda = n
+			}
+			cc := golden.Float64s(c.In["c"])
+			impl.Dsyrk(goldenUplo[c.Args["uplo"]], trans, n, k, c.In["alpha"][0], c.In["a"], lda, c.In["beta"][0], cc, n)
+			return map[string][]float64{"c": cc}
+		},
+	},
+	{
+		Name: "dtrsm",
+		Tol:  1e-13,
+		Cases: func(rnd *rand.Rand) []golden.Case {
+			var cases []golden.Case
+			for side := range goldenSide {
+				for uplo := range goldenUplo {
+					for trans := 0; trans < 2; trans++ {
+						const m, n = 5, 3
+						na := m
+						if goldenSide[side] == blas.Right {
+							na = n
+						}
+						cases = append(cases, golden.Case{
+							Args: map[string]int{"side": side, "uplo": uplo, "trans": trans, "diag": 0, "m": m, "n": n},
+							In: map[string][]float64{
+								"alpha": {rnd.NormFloat64()},
+								"a":     triangular(rnd, na),
+								"b":     golden.Random(rnd, m*n),
+							},
+						})
+					}
+				}
+			}
+			return cases
+		},
+		Run: func(c golden.Case) map[string][]float64 {
+			side := goldenSide[c.Args["side"]]
+			m, n := c.Args["m"], c.Args["n"]
+			lda := m
+			if side == blas.Right {
+				lda = n
+			}
+			b := golden.Float64s(c.In["b"])
+			impl.Dtrsm(side, goldenUplo[c.Args["uplo"]], goldenTrans[c.Args["trans"]], goldenDiag[c.Args["diag"]],
+				m, n, c.In["alpha"][0], c.In["a"], lda, b, n)
+			return map[string][]float64{"b": b}
+		},
+	},
+	{
+		Name: "zgemm",
+		Tol:  1e-13,
+		Cases: func(rnd *rand.Rand) []golden.Case {
+			var cases []golden.Case
+			for tA := range goldenTrans {
+				for tB := range goldenTrans {
+					const m, n, k = 4, 3, 5
+					cases = append(cases, golden.Case{
+						Args: map[string]int{"tA": tA, "tB": tB, "m": m, "n": n, "k": k},
+						In: map[string][]float64{
+							"alpha": golden.Random(rnd, 2),
+							"beta":  golden.Random(rnd, 2),
+							"a":     golden.Random(rnd, 2*m*k),
+							"b":     golden.Random(rnd, 2*k*n),
+							"c":     golden.Random(rnd, 2*m*n),
+						},
+					})
+				}
+			}
+			return cases
+		},
+		Run: func(c golden.Case) map[string][]float64 {
+			tA, tB := goldenTrans[c.Args["tA"]], goldenTrans[c.Args["tB"]]
+			m, n, k := c.Args["m"], c.Args["n"], c.Args["k"]
+			lda, ldb := k, n
+			if tA != blas.NoTrans {
+				lda = m
+			}
+			if tB != blas.NoTrans {
+				ldb = k
+			}
+			alpha, beta := cmplx128(c.In["alpha"])[0], cmplx128(c.In["beta"])[0]
+			cc := cmplx128(c.In["c"])
+			impl.Zgemm(tA, tB, m, n, k, alpha, cmplx128(c.In["a"]), lda, cmplx128(c.In["b"]), ldb, beta, cc, n)
+			return map[string][]float64{"c": float64s(cc)}
+		},
+	},
+}
+
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
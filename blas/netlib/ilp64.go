@@ -0,0 +1,21 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build ilp64
+// +build ilp64
+
+package netlib
+
+// #cgo CFLAGS: -DCBLAS_ILP64
+import "C"
+
+// minInt and maxInt are the bounds of the 64-bit CBLAS integer type,
+// blasint, that can be held by a Go int.
+const (
+	maxInt = int(^uint(0) >> 1)
+	minInt = -maxInt - 1
+)
+
+// ilp64 is whether the package is built with the ilp64 build tag.
+const ilp64 = true
@@ -0,0 +1,145 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"strings"
+	"sync"
+
+	"gonum.org/v1/netlib/backend"
+)
+
+// DType is the element type of the data passed to the BLAS routines.
+type DType int
+
+const (
+	Float32 DType = iota
+	Float64
+	Complex64
+	Complex128
+)
+
+// Size returns the size of an element of type t in bytes.
+func (t DType) Size() int {
+	switch t {
+	case Float32:
+		return 4
+	case Float64, Complex64:
+		return 8
+	case Complex128:
+		return 16
+	}
+	panic(badDType)
+}
+
+const badDType = "blas: bad element type"
+
+// Layout is the data layout advised for the kernels of a BLAS library.
+// Vectors and matrices laid out this way let the kernels use aligned
+// vector loads on every row without peeling.
+type Layout struct {
+	// VectorBytes is the width in bytes of the vector registers used
+	// by the kernels.
+	VectorBytes int
+
+	// Inc is the preferred vector increment. The kernels vectorize
+	// only unit increments, so Inc is always 1.
+	Inc int
+
+	// LdMultiple is the number of elements that leading dimensions
+	// should be a multiple of, so that each row of a matrix starts
+	// on a vector boundary when the first row does.
+	LdMultiple int
+
+	// Alignment is the preferred alignment in bytes of the first
+	// element of a vector or matrix.
+	Alignment int
+}
+
+// Stride returns the smallest leading dimension for a matrix with cols
+// columns that is a multiple of l.LdMultiple.
+func (l Layout) Stride(cols int) int {
+	m := max(1, l.LdMultiple)
+	return max(1, (cols+m-1)/m*m)
+}
+
+// Advise returns the layout advised for data of type t passed to the BLAS
+// library named name, as recorded by the backend package ("openblas",
+// "mkl" or "reference"), or "" if the library is not known.
+//
+// The vector width is that of the kernels selected by the library if it
+// reports them, as OpenBLAS does through openblas_get_corename, and
+// otherwise that of the widest vector extension supported by the CPU. The
+// reference BLAS has no vector kernels, so no leading dimension multiple
+// is advised for it. Intel MKL documents a preferred alignment of 64 bytes
+// regardless of the vector width.
+func Advise(name string, t DType) Layout {
+	return advise(name, t, vectorBytes())
+}
+
+// DefaultLayout returns the layout advised for data of type t passed to
+// the backend library selected by importing a backend package, or to an
+// unknown library if there is none. It is used to lay out the temporary
+// matrices allocated by this package.
+func DefaultLayout(t DType) Layout {
+	info, _ := backend.Selected()
+	return Advise(info.Name, t)
+}
+
+func advise(name string, t DType, vec int) Layout {
+	size := t.Size()
+	l := Layout{
+		VectorBytes: vec,
+		Inc:         1,
+		LdMultiple:  max(1, vec/size),
+		Alignment:   max(size, vec),
+	}
+	switch name {
+	case "reference":
+		l.LdMultiple = 1
+		l.Alignment = size
+	case "mkl":
+		l.Alignment = 64
+	}
+	return l
+}
+
+var (
+	vectorOnce  sync.Once
+	vectorWidth int
+)
+
+// vectorBytes returns the vector width of the kernels of the loaded
+// library, queried once.
+func vectorBytes() int {
+	vectorOnce.Do(func() {
+		vectorWidth = coreVectorBytes(coreName())
+		if vectorWidth == 0 {
+			vectorWidth = cpuVectorBytes()
+		}
+		if vectorWidth == 0 {
+			vectorWidth = 16
+		}
+	})
+	return vectorWidth
+}
+
+// coreVectorBytes returns the vector width of the OpenBLAS kernels named
+// core, or zero if core is not known.
+func coreVectorBytes(core string) int {
+	switch core = strings.ToLower(core); {
+	case core == "":
+		return 0
+	case strings.Contains(core, "skylakex"), strings.Contains(core, "cooperlake"), strings.Contains(core, "sapphirerapids"):
+		return 64
+	case strings.Contains(core, "haswell"), strings.Contains(core, "zen"), strings.Contains(core, "sandybridge"), strings.Contains(core, "excavator"):
+		return 32
+	case strings.Contains(core, "armv8"), strings.Contains(core, "neoverse"), strings.Contains(core, "cortex"), strings.Contains(core, "nehalem"), strings.Contains(core, "core2"), strings.Contains(core, "power"):
+		return 16
+	case strings.Contains(core, "a64fx"):
+		return 64
+	}
+	return 0
+}
@@ -0,0 +1,35 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+/*
+static int netlib_cpu_vector_bytes(void)
+{
+#if (defined(__x86_64__) || defined(__i386__)) && (defined(__GNUC__) || defined(__clang__))
+	__builtin_cpu_init();
+	if (__builtin_cpu_supports("avx512f")) {
+		return 64;
+	}
+	if (__builtin_cpu_supports("avx")) {
+		return 32;
+	}
+	if (__builtin_cpu_supports("sse2")) {
+		return 16;
+	}
+	return 0;
+#elif defined(__aarch64__) || defined(__ARM_NEON) || defined(__powerpc64__)
+	return 16;
+#else
+	return 0;
+#endif
+}
+*/
+import "C"
+
+// cpuVectorBytes returns the width in bytes of the widest vector extension
+// supported by the CPU, or zero if it is not known.
+func cpuVectorBytes() int {
+	return int(C.netlib_cpu_vector_bytes())
+}
@@ -0,0 +1,66 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import "testing"
+
+func TestAdvise(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		t    DType
+		vec  int
+		want Layout
+	}{
+		{name: "openblas", t: Float64, vec: 32, want: Layout{VectorBytes: 32, Inc: 1, LdMultiple: 4, Alignment: 32}},
+		{name: "openblas", t: Float32, vec: 64, want: Layout{VectorBytes: 64, Inc: 1, LdMultiple: 16, Alignment: 64}},
+		{name: "", t: Complex128, vec: 16, want: Layout{VectorBytes: 16, Inc: 1, LdMultiple: 1, Alignment: 16}},
+		{name: "", t: Complex128, vec: 64, want: Layout{VectorBytes: 64, Inc: 1, LdMultiple: 4, Alignment: 64}},
+		{name: "mkl", t: Complex64, vec: 32, want: Layout{VectorBytes: 32, Inc: 1, LdMultiple: 4, Alignment: 64}},
+		{name: "reference", t: Float64, vec: 64, want: Layout{VectorBytes: 64, Inc: 1, LdMultiple: 1, Alignment: 8}},
+	} {
+		if got := advise(test.name, test.t, test.vec); got != test.want {
+			t.Errorf("unexpected layout for %q with %d-byte vectors: got %+v want %+v", test.name, test.vec, got, test.want)
+		}
+	}
+
+	for _, dt := range []DType{Float32, Float64, Complex64, Complex128} {
+		l := DefaultLayout(dt)
+		if l.VectorBytes < 16 || l.LdMultiple < 1 || l.Inc != 1 || l.Alignment < dt.Size() {
+			t.Errorf("unexpected default layout for type %d: %+v", dt, l)
+		}
+	}
+}
+
+func TestLayoutStride(t *testing.T) {
+	l := Layout{LdMultiple: 4}
+	for _, test := range []struct{ cols, want int }{
+		{0, 1}, {1, 4}, {4, 4}, {5, 8}, {9, 12},
+	} {
+		if got := l.Stride(test.cols); got != test.want {
+			t.Errorf("unexpected stride for %d columns: got %d want %d", test.cols, got, test.want)
+		}
+	}
+	if got := (Layout{}).Stride(3); got != 3 {
+		t.Errorf("unexpected stride for zero layout: got %d want 3", got)
+	}
+}
+
+func TestCoreVectorBytes(t *testing.T) {
+	for _, test := range []struct {
+		core string
+		want int
+	}{
+		{"", 0},
+		{"SkylakeX", 64},
+		{"Haswell", 32},
+		{"Zen", 32},
+		{"ARMV8", 16},
+		{"Unknown", 0},
+	} {
+		if got := coreVectorBytes(test.core); got != test.want {
+			t.Errorf("unexpected vector width for %q: got %d want %d", test.core, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !ilp64
+// +build !ilp64
+
+package netlib
+
+import "math"
+
+// minInt and maxInt are the bounds of the CBLAS integer type, blasint,
+// which is a 32-bit int unless the package is built with the ilp64 build
+// tag.
+const (
+	minInt = math.MinInt32
+	maxInt = math.MaxInt32
+)
+
+// ilp64 is whether the package is built with the ilp64 build tag.
+const ilp64 = false
@@ -0,0 +1,64 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !ilp64
+// +build !ilp64
+
+package netlib
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func TestIntRange(t *testing.T) {
+	if strconv.IntSize == 32 {
+		t.Skip("int cannot exceed the range of a 32-bit blasint")
+	}
+	const big = math.MaxInt32 + 1
+	for _, test := range []struct {
+		name  string
+		param string
+		fn    func()
+		want  string
+	}{
+		{
+			name:  "Ddot",
+			param: "incX",
+			fn:    func() { impl.Ddot(1, []float64{1}, big, []float64{1}, 1) },
+			want:  incXTooLarge,
+		},
+		{
+			name:  "Daxpy",
+			param: "incY",
+			fn:    func() { impl.Daxpy(1, 2, []float64{1}, 1, []float64{1}, -big-1) },
+			want:  incYTooLarge,
+		},
+		{
+			name:  "Dgemv",
+			param: "lda",
+			fn:    func() { impl.Dgemv(blas.NoTrans, 1, 1, 1, []float64{1}, big, []float64{1}, 1, 0, []float64{0}, 1) },
+			want:  ldaTooLarge,
+		},
+		{
+			name:  "Drotm",
+			param: "incX",
+			fn:    func() { impl.Drotm(1, []float64{1}, big, []float64{1}, 1, blas.DrotmParams{Flag: blas.Identity}) },
+			want:  incXTooLarge,
+		},
+	} {
+		func() {
+			defer func() {
+				want := Error{Routine: test.name, Param: test.param, Message: test.want}
+				if r := recover(); r != want {
+					t.Errorf("%s: unexpected panic: got %#v want %#v", test.name, r, want)
+				}
+			}()
+			test.fn()
+		}()
+	}
+}
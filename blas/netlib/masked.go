@@ -0,0 +1,243 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+
+	"gonum.org/v1/netlib/internal/buffer"
+)
+
+// The masked and segmented operations below are not part of the BLAS
+// specification. They gather the selected elements into pooled buffers
+// and pass the compacted data to the native routines, so that the cost
+// of an operation scales with the number of selected elements. Matrices
+// in the buffers have the leading dimension advised by DefaultLayout.
+
+const (
+	badMask    = "blas: mask length mismatch"
+	badLenX    = "blas: x length mismatch"
+	badLenY    = "blas: y length mismatch"
+	badOffsets = "blas: bad segment offsets"
+	badLdD     = "blas: bad leading dimension of dst"
+	shortDst   = "blas: insufficient length of dst"
+)
+
+// MaskedDdot computes the dot product of the elements of x and y selected by
+// mask,
+//  sum_{i : mask[i]} x[i] * y[i].
+// x, y and mask must have the same length, otherwise MaskedDdot will panic.
+func (impl Implementation) MaskedDdot(mask []bool, x, y []float64) float64 {
+	n := len(mask)
+	switch {
+	case len(x) != n:
+		panic(badLenX)
+	case len(y) != n:
+		panic(badLenY)
+	}
+	k := count(mask)
+	if k == 0 {
+		return 0
+	}
+	if k == n {
+		return impl.Ddot(n, x, 1, y, 1)
+	}
+
+	s := buffer.NewScope()
+	defer s.Release()
+	xs := s.Float64s(k)
+	ys := s.Float64s(k)
+	var j int
+	for i, ok := range mask {
+		if ok {
+			xs[j] = x[i]
+			ys[j] = y[i]
+			j++
+		}
+	}
+	return impl.Ddot(k, xs, 1, ys, 1)
+}
+
+// MaskedDgemv computes
+//  y = alpha * A[:, mask] * x[mask] + beta * y,
+// where A is an m×n matrix and only the columns of A and the elements of x
+// selected by mask take part in the product. mask and x must have length n
+// and y must have length m, otherwise MaskedDgemv will panic.
+func (impl Implementation) MaskedDgemv(m, n int, alpha float64, a []float64, lda int, mask []bool, x []float64, beta float64, y []float64) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case len(mask) != n:
+		panic(badMask)
+	case len(x) != n:
+		panic(badLenX)
+	case len(y) != m:
+		panic(badLenY)
+	case m > 0 && n > 0 && len(a) < lda*(m-1)+n:
+		panic(shortA)
+	}
+	if m == 0 {
+		return
+	}
+	k := count(mask)
+	if k == n {
+		impl.Dgemv(blas.NoTrans, m, n, alpha, a, lda, x, 1, beta, y, 1)
+		return
+	}
+	if k == 0 || alpha == 0 {
+		scale(beta, y)
+		return
+	}
+
+	s := buffer.NewScope()
+	defer s.Release()
+	ldc := DefaultLayout(Float64).Stride(k)
+	ac := s.Float64s((m-1)*ldc + k)
+	xs := s.Float64s(k)
+	var j int
+	for c, ok := range mask {
+		if ok {
+			xs[j] = x[c]
+			j++
+		}
+	}
+	for r := 0; r < m; r++ {
+		row := a[r*lda : r*lda+n]
+		dst := ac[r*ldc : r*ldc+k]
+		j = 0
+		for c, ok := range mask {
+			if ok {
+				dst[j] = row[c]
+				j++
+			}
+		}
+	}
+	impl.Dgemv(blas.NoTrans, m, k, alpha, ac, ldc, xs, 1, beta, y, 1)
+}
+
+// SegmentSums sums the rows of the m×n matrix A over segments of consecutive
+// rows. Segment s consists of rows offsets[s] to offsets[s+1]-1, and its sum
+// is stored in row s of dst. offsets must be non-decreasing, start at zero
+// and end at m, otherwise SegmentSums will panic. dst must hold a
+// (len(offsets)-1)×n matrix with leading dimension ldd.
+func (impl Implementation) SegmentSums(m, n int, a []float64, lda int, offsets []int, dst []float64, ldd int) {
+	nseg := checkSegments(m, n, a, lda, offsets)
+	switch {
+	case ldd < max(1, n):
+		panic(badLdD)
+	case nseg > 0 && n > 0 && len(dst) < ldd*(nseg-1)+n:
+		panic(shortDst)
+	}
+	if nseg == 0 || n == 0 {
+		return
+	}
+
+	s := buffer.NewScope()
+	defer s.Release()
+	ones := s.Float64s(maxSegment(offsets))
+	for i := range ones {
+		ones[i] = 1
+	}
+	for seg := 0; seg < nseg; seg++ {
+		r0, r1 := offsets[seg], offsets[seg+1]
+		if r0 == r1 {
+			for j := range dst[seg*ldd : seg*ldd+n] {
+				dst[seg*ldd+j] = 0
+			}
+			continue
+		}
+		impl.Dgemv(blas.Trans, r1-r0, n, 1, a[r0*lda:], lda, ones, 1, 0, dst[seg*ldd:], 1)
+	}
+}
+
+// SegmentedDgemv computes
+//  y[s] = alpha * sum_{offsets[s] <= i < offsets[s+1]} (A * x)[i] + beta * y[s]
+// for each segment s of consecutive rows of the m×n matrix A. The segment
+// sums of the rows of A are formed first, so the product is computed with a
+// single matrix-vector multiplication of size (len(offsets)-1)×n. offsets
+// must satisfy the conditions described for SegmentSums, x must have length n
+// and y must have length len(offsets)-1, otherwise SegmentedDgemv will panic.
+func (impl Implementation) SegmentedDgemv(m, n int, alpha float64, a []float64, lda int, offsets []int, x []float64, beta float64, y []float64) {
+	nseg := checkSegments(m, n, a, lda, offsets)
+	switch {
+	case len(x) != n:
+		panic(badLenX)
+	case len(y) != nseg:
+		panic(badLenY)
+	}
+	if nseg == 0 {
+		return
+	}
+	if n == 0 || alpha == 0 {
+		scale(beta, y)
+		return
+	}
+
+	s := buffer.NewScope()
+	defer s.Release()
+	ld := DefaultLayout(Float64).Stride(n)
+	sums := s.Float64s((nseg-1)*ld + n)
+	impl.SegmentSums(m, n, a, lda, offsets, sums, ld)
+	impl.Dgemv(blas.NoTrans, nseg, n, alpha, sums, ld, x, 1, beta, y, 1)
+}
+
+// checkSegments checks the arguments of the segmented operations and returns
+// the number of segments.
+func checkSegments(m, n int, a []float64, lda int, offsets []int) int {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case m > 0 && n > 0 && len(a) < lda*(m-1)+n:
+		panic(shortA)
+	case len(offsets) == 0 || offsets[0] != 0 || offsets[len(offsets)-1] != m:
+		panic(badOffsets)
+	}
+	for i := 1; i < len(offsets); i++ {
+		if offsets[i] < offsets[i-1] {
+			panic(badOffsets)
+		}
+	}
+	return len(offsets) - 1
+}
+
+// maxSegment returns the number of rows in the largest segment.
+func maxSegment(offsets []int) int {
+	var n int
+	for i := 1; i < len(offsets); i++ {
+		n = max(n, offsets[i]-offsets[i-1])
+	}
+	return n
+}
+
+// scale computes y = beta * y, setting y to zero if beta is zero.
+func scale(beta float64, y []float64) {
+	if beta == 0 {
+		for i := range y {
+			y[i] = 0
+		}
+		return
+	}
+	for i := range y {
+		y[i] *= beta
+	}
+}
+
+func count(mask []bool) int {
+	var k int
+	for _, ok := range mask {
+		if ok {
+			k++
+		}
+	}
+	return k
+}
@@ -0,0 +1,138 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func randomFloats(rnd *rand.Rand, n int) []float64 {
+	s := make([]float64, n)
+	for i := range s {
+		s[i] = rnd.NormFloat64()
+	}
+	return s
+}
+
+func randomMask(rnd *rand.Rand, n int, p float64) []bool {
+	mask := make([]bool, n)
+	for i := range mask {
+		mask[i] = rnd.Float64() < p
+	}
+	return mask
+}
+
+func TestMaskedDdot(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 7, 100} {
+		for _, p := range []float64{0, 0.3, 1} {
+			x := randomFloats(rnd, n)
+			y := randomFloats(rnd, n)
+			mask := randomMask(rnd, n, p)
+			var want float64
+			for i, ok := range mask {
+				if ok {
+					want += x[i] * y[i]
+				}
+			}
+			got := impl.MaskedDdot(mask, x, y)
+			if math.Abs(got-want) > tol {
+				t.Errorf("n=%d,p=%v: unexpected result: got %v want %v", n, p, got, want)
+			}
+		}
+	}
+}
+
+func TestMaskedDgemv(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, lda int
+	}{
+		{m: 1, n: 1, lda: 1},
+		{m: 5, n: 8, lda: 8},
+		{m: 9, n: 4, lda: 6},
+	} {
+		for _, p := range []float64{0, 0.5, 1} {
+			for _, beta := range []float64{0, 0.5} {
+				name := fmt.Sprintf("m=%d,n=%d,lda=%d,p=%v,beta=%v", test.m, test.n, test.lda, p, beta)
+				a := randomFloats(rnd, (test.m-1)*test.lda+test.n)
+				x := randomFloats(rnd, test.n)
+				y := randomFloats(rnd, test.m)
+				mask := randomMask(rnd, test.n, p)
+				const alpha = 1.5
+				want := make([]float64, test.m)
+				for i := range want {
+					var sum float64
+					for j, ok := range mask {
+						if ok {
+							sum += a[i*test.lda+j] * x[j]
+						}
+					}
+					want[i] = alpha*sum + beta*y[i]
+				}
+				impl.MaskedDgemv(test.m, test.n, alpha, a, test.lda, mask, x, beta, y)
+				if !floats.EqualApprox(y, want, tol) {
+					t.Errorf("%s: unexpected result: got %v want %v", name, y, want)
+				}
+			}
+		}
+	}
+}
+
+func TestSegmentedDgemv(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, lda int
+		offsets   []int
+	}{
+		{m: 0, n: 3, lda: 3, offsets: []int{0}},
+		{m: 1, n: 1, lda: 1, offsets: []int{0, 1}},
+		{m: 6, n: 4, lda: 5, offsets: []int{0, 2, 2, 6}},
+		{m: 10, n: 3, lda: 3, offsets: []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10}},
+	} {
+		name := fmt.Sprintf("m=%d,n=%d,offsets=%v", test.m, test.n, test.offsets)
+		a := randomFloats(rnd, max(0, (test.m-1)*test.lda+test.n))
+		nseg := len(test.offsets) - 1
+
+		wantSums := make([]float64, nseg*test.n)
+		for s := 0; s < nseg; s++ {
+			for i := test.offsets[s]; i < test.offsets[s+1]; i++ {
+				for j := 0; j < test.n; j++ {
+					wantSums[s*test.n+j] += a[i*test.lda+j]
+				}
+			}
+		}
+		sums := randomFloats(rnd, nseg*test.n)
+		impl.SegmentSums(test.m, test.n, a, test.lda, test.offsets, sums, test.n)
+		if !floats.EqualApprox(sums, wantSums, tol) {
+			t.Errorf("%s: unexpected segment sums: got %v want %v", name, sums, wantSums)
+		}
+
+		x := randomFloats(rnd, test.n)
+		y := randomFloats(rnd, nseg)
+		const alpha, beta = 2, -1
+		want := make([]float64, nseg)
+		for s := range want {
+			var sum float64
+			for j := 0; j < test.n; j++ {
+				sum += wantSums[s*test.n+j] * x[j]
+			}
+			want[s] = alpha*sum + beta*y[s]
+		}
+		impl.SegmentedDgemv(test.m, test.n, alpha, a, test.lda, test.offsets, x, beta, y)
+		if !floats.EqualApprox(y, want, tol) {
+			t.Errorf("%s: unexpected result: got %v want %v", name, y, want)
+		}
+	}
+}
@@ -0,0 +1,336 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+/*
+#cgo openblas,!dlopen CFLAGS: -DNETLIB_MATCOPY=1
+#cgo dlopen CFLAGS: -DNETLIB_DLOPEN=1
+#include <complex.h>
+#include <stddef.h>
+#include <stdlib.h>
+#include <string.h>
+#include "cblas.h"
+#include "extension.h"
+
+// The ?omatcopy and ?imatcopy routines are extensions of OpenBLAS that are
+// not part of the reference CBLAS. With the openblas build tag they are
+// declared strong and always called. Otherwise they are declared weak where
+// possible or looked up in the library loaded with the dlopen build tag,
+// and the matrices are copied by a loop in C if the library does not
+// provide them.
+#if defined(NETLIB_MATCOPY)
+#define NETLIB_WEAK
+#define NETLIB_HAS(fn) 1
+#elif defined(NETLIB_DLOPEN)
+#define NETLIB_WEAK
+#define NETLIB_HAS(fn) (fn != NULL)
+#elif defined(__ELF__) && !defined(NETLIB_NO_MATCOPY)
+#define NETLIB_WEAK __attribute__((weak))
+#define NETLIB_HAS(fn) (fn != NULL)
+#else
+#define NETLIB_NO_EXTENSION 1
+#endif
+
+#if !defined(NETLIB_NO_EXTENSION)
+#define NETLIB_DECLARE_MATCOPY(omatcopy, imatcopy, S, P) \
+	NETLIB_WEAK void omatcopy(const enum CBLAS_ORDER order, const enum CBLAS_TRANSPOSE trans, \
+		const blasint rows, const blasint cols, const S alpha, \
+		const P *a, const blasint lda, P *b, const blasint ldb); \
+	NETLIB_WEAK void imatcopy(const enum CBLAS_ORDER order, const enum CBLAS_TRANSPOSE trans, \
+		const blasint rows, const blasint cols, const S alpha, \
+		P *a, const blasint lda, const blasint ldb);
+
+NETLIB_DECLARE_MATCOPY(cblas_somatcopy, cblas_simatcopy, float, float)
+NETLIB_DECLARE_MATCOPY(cblas_domatcopy, cblas_dimatcopy, double, double)
+NETLIB_DECLARE_MATCOPY(cblas_comatcopy, cblas_cimatcopy, float *, float)
+NETLIB_DECLARE_MATCOPY(cblas_zomatcopy, cblas_zimatcopy, double *, double)
+
+#define NETLIB_CALL_OMATCOPY(omatcopy, P) \
+	NETLIB_EXTENSION(fn, omatcopy) \
+	if (NETLIB_HAS(fn)) { \
+		fn(CblasRowMajor, trans, rows, cols, alpha, (const P *)a, lda, (P *)b, ldb); \
+		return; \
+	}
+#define NETLIB_CALL_IMATCOPY(imatcopy, P) \
+	NETLIB_EXTENSION(fn, imatcopy) \
+	if (NETLIB_HAS(fn)) { \
+		fn(CblasRowMajor, trans, rows, cols, alpha, (P *)a, lda, ldb); \
+		return 1; \
+	}
+#else
+#define NETLIB_CALL_OMATCOPY(omatcopy, P)
+#define NETLIB_CALL_IMATCOPY(imatcopy, P)
+#endif
+
+#define NETLIB_IDENTITY(v) (v)
+#define NETLIB_VALUE(p) (p)
+#define NETLIB_COMPLEX(T, p) (*(const T *)(p))
+#define NETLIB_CVALUE(p) NETLIB_COMPLEX(float complex, p)
+#define NETLIB_ZVALUE(p) NETLIB_COMPLEX(double complex, p)
+
+// NETLIB_DEFINE_MATCOPY defines netlib_?omatcopy, which stores
+// alpha * op(A) for the rows×cols matrix A in B, and netlib_?imatcopy, which
+// does so in place. Without the extension the in-place copy goes through a
+// temporary matrix, as OpenBLAS does for a transposition that is not square.
+#define NETLIB_DEFINE_MATCOPY(oname, iname, omatcopy, imatcopy, T, S, P, VALUE, CONJ) \
+	static void oname(const enum CBLAS_TRANSPOSE trans, const blasint rows, const blasint cols, \
+		const S alpha, const T *a, const blasint lda, T *b, const blasint ldb) \
+	{ \
+		NETLIB_CALL_OMATCOPY(omatcopy, P) \
+		const T al = VALUE(alpha); \
+		for (blasint i = 0; i < rows; i++) { \
+			const T *ai = a + (ptrdiff_t)i*lda; \
+			switch (trans) { \
+			case CblasNoTrans: \
+				for (blasint j = 0; j < cols; j++) { \
+					b[(ptrdiff_t)i*ldb + j] = al * ai[j]; \
+				} \
+				break; \
+			case CblasTrans: \
+				for (blasint j = 0; j < cols; j++) { \
+					b[(ptrdiff_t)j*ldb + i] = al * ai[j]; \
+				} \
+				break; \
+			default: \
+				for (blasint j = 0; j < cols; j++) { \
+					b[(ptrdiff_t)j*ldb + i] = al * CONJ(ai[j]); \
+				} \
+			} \
+		} \
+	} \
+	\
+	static int iname(const enum CBLAS_TRANSPOSE trans, const blasint rows, const blasint cols, \
+		const S alpha, T *a, const blasint lda, const blasint ldb) \
+	{ \
+		NETLIB_CALL_IMATCOPY(imatcopy, P) \
+		blasint r = rows, c = cols; \
+		if (trans != CblasNoTrans) { \
+			r = cols; \
+			c = rows; \
+		} \
+		T *tmp = malloc((size_t)rows * cols * sizeof(T)); \
+		if (tmp == NULL) { \
+			return 0; \
+		} \
+		oname(trans, rows, cols, alpha, a, lda, tmp, c); \
+		for (blasint i = 0; i < r; i++) { \
+			memcpy(a + (ptrdiff_t)i*ldb, tmp + (ptrdiff_t)i*c, c * sizeof(T)); \
+		} \
+		free(tmp); \
+		return 1; \
+	}
+
+NETLIB_DEFINE_MATCOPY(netlib_somatcopy, netlib_simatcopy, cblas_somatcopy, cblas_simatcopy, float, float, float, NETLIB_VALUE, NETLIB_IDENTITY)
+NETLIB_DEFINE_MATCOPY(netlib_domatcopy, netlib_dimatcopy, cblas_domatcopy, cblas_dimatcopy, double, double, double, NETLIB_VALUE, NETLIB_IDENTITY)
+NETLIB_DEFINE_MATCOPY(netlib_comatcopy, netlib_cimatcopy, cblas_comatcopy, cblas_cimatcopy, float complex, float *, float, NETLIB_CVALUE, conjf)
+NETLIB_DEFINE_MATCOPY(netlib_zomatcopy, netlib_zimatcopy, cblas_zomatcopy, cblas_zimatcopy, double complex, double *, double, NETLIB_ZVALUE, conj)
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/netlib/diag"
+)
+
+// The ?omatcopy routines compute
+//  B = alpha * op(A)
+// for a rows×cols matrix A, where op(A) is A, A^T or, for complex
+// matrices, A^H. The ?imatcopy routines do so in place, replacing A by
+// alpha * op(A) with leading dimension ldb. They call the cache-blocked
+// kernels of the cblas_?omatcopy and cblas_?imatcopy extensions of
+// OpenBLAS, as reported by CapMatcopy. The extensions are not part of the
+// reference CBLAS, so unless the package is built with the openblas build
+// tag the matrices are copied by a loop in C if the library does not
+// provide them. With the dlopen build tag the extensions are looked up in
+// the loaded library.
+
+const errMatcopyAlloc = "blas: cannot allocate temporary matrix"
+
+// Domatcopy stores alpha * op(A) in B, where A is a rows×cols matrix and op
+// is determined by trans. B is a rows×cols matrix if trans is blas.NoTrans
+// and a cols×rows matrix otherwise. A and B must not overlap.
+func (Implementation) Domatcopy(trans blas.Transpose, rows, cols int, alpha float64, a []float64, lda int, b []float64, ldb int) {
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Domatcopy", trans, rows, cols, alpha, lda, ldb)()
+	}
+	checkMatcopy("Domatcopy", trans, rows, cols, lda, ldb, len(a), len(b), false)
+
+	// Quick return if possible.
+	if rows == 0 || cols == 0 {
+		return
+	}
+
+	C.netlib_domatcopy(cblasTranspose(trans), C.blasint(rows), C.blasint(cols), C.double(alpha), (*C.double)(&a[0]), C.blasint(lda), (*C.double)(&b[0]), C.blasint(ldb))
+}
+
+// Dimatcopy replaces the rows×cols matrix A with leading dimension lda by
+// alpha * op(A) with leading dimension ldb, where op is determined by
+// trans. a must be long enough to hold both matrices.
+func (Implementation) Dimatcopy(trans blas.Transpose, rows, cols int, alpha float64, a []float64, lda, ldb int) {
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Dimatcopy", trans, rows, cols, alpha, lda, ldb)()
+	}
+	checkMatcopy("Dimatcopy", trans, rows, cols, lda, ldb, len(a), len(a), true)
+
+	// Quick return if possible.
+	if rows == 0 || cols == 0 {
+		return
+	}
+
+	if C.netlib_dimatcopy(cblasTranspose(trans), C.blasint(rows), C.blasint(cols), C.double(alpha), (*C.double)(&a[0]), C.blasint(lda), C.blasint(ldb)) == 0 {
+		panic(errMatcopyAlloc)
+	}
+}
+
+// Somatcopy is the float32 version of Domatcopy.
+func (Implementation) Somatcopy(trans blas.Transpose, rows, cols int, alpha float32, a []float32, lda int, b []float32, ldb int) {
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Somatcopy", trans, rows, cols, alpha, lda, ldb)()
+	}
+	checkMatcopy("Somatcopy", trans, rows, cols, lda, ldb, len(a), len(b), false)
+
+	// Quick return if possible.
+	if rows == 0 || cols == 0 {
+		return
+	}
+
+	C.netlib_somatcopy(cblasTranspose(trans), C.blasint(rows), C.blasint(cols), C.float(alpha), (*C.float)(&a[0]), C.blasint(lda), (*C.float)(&b[0]), C.blasint(ldb))
+}
+
+// Simatcopy is the float32 version of Dimatcopy.
+func (Implementation) Simatcopy(trans blas.Transpose, rows, cols int, alpha float32, a []float32, lda, ldb int) {
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Simatcopy", trans, rows, cols, alpha, lda, ldb)()
+	}
+	checkMatcopy("Simatcopy", trans, rows, cols, lda, ldb, len(a), len(a), true)
+
+	// Quick return if possible.
+	if rows == 0 || cols == 0 {
+		return
+	}
+
+	if C.netlib_simatcopy(cblasTranspose(trans), C.blasint(rows), C.blasint(cols), C.float(alpha), (*C.float)(&a[0]), C.blasint(lda), C.blasint(ldb)) == 0 {
+		panic(errMatcopyAlloc)
+	}
+}
+
+// Zomatcopy is the complex128 version of Domatcopy. If trans is
+// blas.ConjTrans, op(A) is the conjugate transpose of A.
+func (Implementation) Zomatcopy(trans blas.Transpose, rows, cols int, alpha complex128, a []complex128, lda int, b []complex128, ldb int) {
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zomatcopy", trans, rows, cols, alpha, lda, ldb)()
+	}
+	checkMatcopy("Zomatcopy", trans, rows, cols, lda, ldb, len(a), len(b), false)
+
+	// Quick return if possible.
+	if rows == 0 || cols == 0 {
+		return
+	}
+
+	C.netlib_zomatcopy(cblasTranspose(trans), C.blasint(rows), C.blasint(cols), (*C.double)(unsafe.Pointer(&alpha)), (*C.complexdouble)(unsafe.Pointer(&a[0])), C.blasint(lda), (*C.complexdouble)(unsafe.Pointer(&b[0])), C.blasint(ldb))
+}
+
+// Zimatcopy is the complex128 version of Dimatcopy. If trans is
+// blas.ConjTrans, op(A) is the conjugate transpose of A.
+func (Implementation) Zimatcopy(trans blas.Transpose, rows, cols int, alpha complex128, a []complex128, lda, ldb int) {
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Zimatcopy", trans, rows, cols, alpha, lda, ldb)()
+	}
+	checkMatcopy("Zimatcopy", trans, rows, cols, lda, ldb, len(a), len(a), true)
+
+	// Quick return if possible.
+	if rows == 0 || cols == 0 {
+		return
+	}
+
+	if C.netlib_zimatcopy(cblasTranspose(trans), C.blasint(rows), C.blasint(cols), (*C.double)(unsafe.Pointer(&alpha)), (*C.complexdouble)(unsafe.Pointer(&a[0])), C.blasint(lda), C.blasint(ldb)) == 0 {
+		panic(errMatcopyAlloc)
+	}
+}
+
+// Comatcopy is the complex64 version of Domatcopy. If trans is
+// blas.ConjTrans, op(A) is the conjugate transpose of A.
+func (Implementation) Comatcopy(trans blas.Transpose, rows, cols int, alpha complex64, a []complex64, lda int, b []complex64, ldb int) {
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Comatcopy", trans, rows, cols, alpha, lda, ldb)()
+	}
+	checkMatcopy("Comatcopy", trans, rows, cols, lda, ldb, len(a), len(b), false)
+
+	// Quick return if possible.
+	if rows == 0 || cols == 0 {
+		return
+	}
+
+	C.netlib_comatcopy(cblasTranspose(trans), C.blasint(rows), C.blasint(cols), (*C.float)(unsafe.Pointer(&alpha)), (*C.complexfloat)(unsafe.Pointer(&a[0])), C.blasint(lda), (*C.complexfloat)(unsafe.Pointer(&b[0])), C.blasint(ldb))
+}
+
+// Cimatcopy is the complex64 version of Dimatcopy. If trans is
+// blas.ConjTrans, op(A) is the conjugate transpose of A.
+func (Implementation) Cimatcopy(trans blas.Transpose, rows, cols int, alpha complex64, a []complex64, lda, ldb int) {
+	if diag.Current() != diag.Off {
+		defer diag.Trace("blas", "Cimatcopy", trans, rows, cols, alpha, lda, ldb)()
+	}
+	checkMatcopy("Cimatcopy", trans, rows, cols, lda, ldb, len(a), len(a), true)
+
+	// Quick return if possible.
+	if rows == 0 || cols == 0 {
+		return
+	}
+
+	if C.netlib_cimatcopy(cblasTranspose(trans), C.blasint(rows), C.blasint(cols), (*C.float)(unsafe.Pointer(&alpha)), (*C.complexfloat)(unsafe.Pointer(&a[0])), C.blasint(lda), C.blasint(ldb)) == 0 {
+		panic(errMatcopyAlloc)
+	}
+}
+
+// checkMatcopy panics as the ?omatcopy or, if inPlace is true, ?imatcopy
+// routine name does if its arguments are invalid. The lengths of the
+// slices are la and lb, which are both the length of a for ?imatcopy.
+func checkMatcopy(name string, trans blas.Transpose, rows, cols, lda, ldb, la, lb int, inPlace bool) {
+	if trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans {
+		panic(Error{Routine: name, Param: "trans", Message: badTranspose})
+	}
+	if rows < 0 {
+		panic(Error{Routine: name, Param: "rows", Message: mLT0})
+	}
+	if cols < 0 {
+		panic(Error{Routine: name, Param: "cols", Message: nLT0})
+	}
+	rowB, colB := rows, cols
+	if trans != blas.NoTrans {
+		rowB, colB = cols, rows
+	}
+	if lda < max(1, cols) {
+		panic(Error{Routine: name, Param: "lda", Message: badLdA})
+	}
+	if ldb < max(1, colB) {
+		panic(Error{Routine: name, Param: "ldb", Message: badLdB})
+	}
+	if rows == 0 || cols == 0 {
+		return
+	}
+	if la < (rows-1)*lda+cols {
+		panic(Error{Routine: name, Param: "a", Message: shortA})
+	}
+	if lb < (rowB-1)*ldb+colB {
+		if inPlace {
+			panic(Error{Routine: name, Param: "a", Message: shortA})
+		}
+		panic(Error{Routine: name, Param: "b", Message: shortB})
+	}
+	if rows > maxInt {
+		panic(Error{Routine: name, Param: "rows", Message: mTooLarge})
+	}
+	if cols > maxInt {
+		panic(Error{Routine: name, Param: "cols", Message: nTooLarge})
+	}
+	if lda > maxInt {
+		panic(Error{Routine: name, Param: "lda", Message: ldaTooLarge})
+	}
+	if ldb > maxInt {
+		panic(Error{Routine: name, Param: "ldb", Message: ldbTooLarge})
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+// matcopyWant returns alpha * op(A) for the rows×cols matrix a with leading
+// dimension ldb, stored in a copy of b.
+func matcopyWant(trans blas.Transpose, rows, cols int, alpha float64, a []float64, lda int, b []float64, ldb int) []float64 {
+	want := append([]float64(nil), b...)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			if trans == blas.NoTrans {
+				want[i*ldb+j] = alpha * a[i*lda+j]
+			} else {
+				want[j*ldb+i] = alpha * a[i*lda+j]
+			}
+		}
+	}
+	return want
+}
+
+func TestDomatcopy(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, dims := range [][2]int{{0, 3}, {1, 1}, {3, 5}, {6, 2}} {
+		rows, cols := dims[0], dims[1]
+		for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans, blas.ConjTrans} {
+			name := fmt.Sprintf("rows=%d,cols=%d,trans=%c", rows, cols, trans)
+			rowB, colB := rows, cols
+			if trans != blas.NoTrans {
+				rowB, colB = cols, rows
+			}
+			lda, ldb := cols+2, colB+1
+			a := randomFloats(rnd, matrixLen(rows, cols, lda))
+			b := randomFloats(rnd, matrixLen(rowB, colB, ldb))
+			want := matcopyWant(trans, rows, cols, -0.5, a, lda, b, ldb)
+			impl.Domatcopy(trans, rows, cols, -0.5, a, lda, b, ldb)
+			if !floats.Equal(b, want) {
+				t.Errorf("%s: unexpected result: got %v want %v", name, b, want)
+			}
+		}
+	}
+}
+
+func TestDimatcopy(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, dims := range [][2]int{{1, 1}, {4, 4}, {3, 5}, {6, 2}} {
+		rows, cols := dims[0], dims[1]
+		for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, pad := range []int{0, 2} {
+				name := fmt.Sprintf("rows=%d,cols=%d,trans=%c,pad=%d", rows, cols, trans, pad)
+				rowB, colB := rows, cols
+				if trans != blas.NoTrans {
+					rowB, colB = cols, rows
+				}
+				lda, ldb := cols+pad, colB+1
+				n := matrixLen(rows, cols, lda)
+				if m := matrixLen(rowB, colB, ldb); m > n {
+					n = m
+				}
+				a := randomFloats(rnd, n)
+				want := matcopyWant(trans, rows, cols, 2, a, lda, a, ldb)
+				impl.Dimatcopy(trans, rows, cols, 2, a, lda, ldb)
+				for i := 0; i < rowB; i++ {
+					for j := 0; j < colB; j++ {
+						if a[i*ldb+j] != want[i*ldb+j] {
+							t.Errorf("%s: unexpected element (%d,%d): got %v want %v", name, i, j, a[i*ldb+j], want[i*ldb+j])
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestZomatcopy(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	const rows, cols, lda, ldb = 3, 4, 5, 4
+	a := make([]complex128, (rows-1)*lda+cols)
+	for i := range a {
+		a[i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+	}
+	alpha := complex(0.5, 2)
+	for _, trans := range []blas.Transpose{blas.Trans, blas.ConjTrans} {
+		b := make([]complex128, (cols-1)*ldb+rows)
+		impl.Zomatcopy(trans, rows, cols, alpha, a, lda, b, ldb)
+		for i := 0; i < rows; i++ {
+			for j := 0; j < cols; j++ {
+				want := a[i*lda+j]
+				if trans == blas.ConjTrans {
+					want = cmplx.Conj(want)
+				}
+				want *= alpha
+				if got := b[j*ldb+i]; cmplx.Abs(got-want) > 1e-15 {
+					t.Errorf("trans=%c: unexpected element (%d,%d): got %v want %v", trans, j, i, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestMatcopyPanics(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		fn   func()
+		want Error
+	}{
+		{
+			name: "Domatcopy",
+			fn:   func() { impl.Domatcopy(blas.Trans, 2, 3, 1, make([]float64, 6), 3, make([]float64, 6), 3) },
+			want: Error{Routine: "Domatcopy", Param: "b", Message: shortB},
+		},
+		{
+			name: "Dimatcopy",
+			fn:   func() { impl.Dimatcopy(blas.Trans, 2, 3, 1, make([]float64, 6), 3, 3) },
+			want: Error{Routine: "Dimatcopy", Param: "a", Message: shortA},
+		},
+		{
+			name: "Somatcopy",
+			fn:   func() { impl.Somatcopy(blas.NoTrans, 2, 3, 1, make([]float32, 6), 2, make([]float32, 6), 3) },
+			want: Error{Routine: "Somatcopy", Param: "lda", Message: badLdA},
+		},
+		{
+			name: "Zimatcopy",
+			fn:   func() { impl.Zimatcopy('X', 2, 2, 1, make([]complex128, 4), 2, 2) },
+			want: Error{Routine: "Zimatcopy", Param: "trans", Message: badTranspose},
+		},
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r != test.want {
+					t.Errorf("%s: unexpected panic: got %#v want %#v", test.name, r, test.want)
+				}
+			}()
+			test.fn()
+		}()
+	}
+}
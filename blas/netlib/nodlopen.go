@@ -0,0 +1,16 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !dlopen
+// +build !dlopen
+
+package netlib
+
+import "errors"
+
+func open(path string) error {
+	return errors.New("blas: Open requires the dlopen build tag")
+}
+
+func library() string { return "" }
@@ -0,0 +1,36 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+// Open loads the CBLAS library at path and returns an Implementation that
+// calls into it. Open is only supported when the package is built with the
+// dlopen build tag, in which case the CBLAS symbols are resolved from the
+// loaded library when each routine is first called instead of being linked
+// at build time, so that one binary can use whichever of OpenBLAS, ATLAS or
+// MKL is installed on the host.
+//
+// The symbols are bound for the whole process, so all Implementation values
+// call into the same library and only one library can be loaded. Opening
+// the library that is already loaded is a no-op; opening a different one
+// returns an error. If no library has been opened when a routine is first
+// called, the library named by the NETLIB_CBLAS_LIBRARY environment
+// variable is loaded, or if it is not set, the first of a list of well
+// known CBLAS libraries for the target system that can be loaded. A call
+// of a routine that is missing from the library, or of any routine if no
+// library can be loaded, panics with an ErrUnsupported.
+func Open(path string) (*Implementation, error) {
+	err := open(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Implementation{}, nil
+}
+
+// Library returns the path of the CBLAS library loaded by Open or by the
+// first call to a routine. It returns the empty string if no library has
+// been loaded yet or if the package was built without the dlopen build tag.
+func Library() string {
+	return library()
+}
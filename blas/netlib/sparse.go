@@ -0,0 +1,193 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+
+	"gonum.org/v1/netlib/internal/buffer"
+)
+
+// The sparse operations below multiply a dense matrix by a sparse matrix in
+// compressed sparse row (CSR) format, so that callers do not have to
+// densify the sparse matrix themselves. With the mkl build tag they call
+// the sparse routines of Intel MKL. Otherwise, or if MKL does not accept
+// the matrix, they choose between converting the sparse matrix to a dense
+// one and gathering the rows of the dense operand selected by its nonzero
+// elements, and pass the result to the dense routines.
+
+const (
+	badRowPtr   = "blas: bad sparse row pointers"
+	badColIdx   = "blas: sparse column index out of range"
+	shortColIdx = "blas: insufficient length of colIdx"
+	shortValues = "blas: insufficient length of values"
+)
+
+// SparseDgemm computes
+//  C = alpha * op(A) * B + beta * C
+// where op(A) is an m×k matrix, B is a k×n matrix and C is an m×n matrix.
+// A is a sparse matrix in CSR format, m×k if tA is blas.NoTrans and k×m
+// otherwise: the elements of row i of A are values[rowPtr[i]:rowPtr[i+1]]
+// and lie in the columns colIdx[rowPtr[i]:rowPtr[i+1]]. rowPtr must have
+// one more element than A has rows, start at zero and be non-decreasing,
+// otherwise SparseDgemm will panic. Elements stored more than once are
+// summed.
+//
+// With the mkl build tag the product is computed by mkl_sparse_d_mm if the
+// column indices of each row of A are strictly increasing. Otherwise, if
+// op(A) has enough nonzero elements, as estimated by densify, it is
+// converted to a dense matrix and the product is computed by Dgemm.
+// Otherwise each row of C is computed by Dgemv from the rows of B selected
+// by the nonzero elements of the row of op(A), after A is converted to the
+// CSR format of its transpose if tA is not blas.NoTrans.
+func (impl Implementation) SparseDgemm(tA blas.Transpose, m, n, k int, alpha float64, rowPtr, colIdx []int, values []float64, b []float64, ldb int, beta float64, c []float64, ldc int) {
+	rows, cols := m, k
+	switch tA {
+	case blas.NoTrans:
+	case blas.Trans, blas.ConjTrans:
+		rows, cols = k, m
+	default:
+		panic(badTranspose)
+	}
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case k < 0:
+		panic(kLT0)
+	case ldb < max(1, n):
+		panic(badLdB)
+	case ldc < max(1, n):
+		panic(badLdC)
+	case k > 0 && n > 0 && len(b) < ldb*(k-1)+n:
+		panic(shortB)
+	case m > 0 && n > 0 && len(c) < ldc*(m-1)+n:
+		panic(shortC)
+	}
+	nnz := checkCSR(rows, cols, rowPtr, colIdx, values)
+	if m == 0 || n == 0 {
+		return
+	}
+	if k == 0 || alpha == 0 || nnz == 0 {
+		for i := 0; i < m; i++ {
+			scale(beta, c[i*ldc:i*ldc+n])
+		}
+		return
+	}
+
+	if sparseDgemm(tA, m, n, k, alpha, rowPtr, colIdx, values, b, ldb, beta, c, ldc) {
+		return
+	}
+
+	s := buffer.NewScope()
+	defer s.Release()
+	if densify(m, n, k, nnz) {
+		lda := DefaultLayout(Float64).Stride(k)
+		a := s.Float64s((m-1)*lda + k)
+		for r := 0; r < rows; r++ {
+			for p := rowPtr[r]; p < rowPtr[r+1]; p++ {
+				if tA == blas.NoTrans {
+					a[r*lda+colIdx[p]] += values[p]
+				} else {
+					a[colIdx[p]*lda+r] += values[p]
+				}
+			}
+		}
+		impl.Dgemm(blas.NoTrans, blas.NoTrans, m, n, k, alpha, a, lda, b, ldb, beta, c, ldc)
+		return
+	}
+
+	if tA != blas.NoTrans {
+		rowPtr, colIdx, values = transposeCSR(rows, cols, rowPtr, colIdx, values)
+	}
+	var width int
+	for i := 0; i < m; i++ {
+		width = max(width, rowPtr[i+1]-rowPtr[i])
+	}
+	ldg := DefaultLayout(Float64).Stride(n)
+	g := s.Float64s((width-1)*ldg + n)
+	for i := 0; i < m; i++ {
+		p0, p1 := rowPtr[i], rowPtr[i+1]
+		ci := c[i*ldc : i*ldc+n]
+		if p0 == p1 {
+			scale(beta, ci)
+			continue
+		}
+		for j, p := 0, p0; p < p1; j, p = j+1, p+1 {
+			copy(g[j*ldg:j*ldg+n], b[colIdx[p]*ldb:colIdx[p]*ldb+n])
+		}
+		impl.Dgemv(blas.Trans, p1-p0, n, alpha, g, ldg, values[p0:p1], 1, beta, ci, 1)
+	}
+}
+
+// densify returns whether the product of an m×k sparse matrix with nnz
+// stored elements and a k×n matrix is computed faster by Dgemm after the
+// sparse matrix is converted to a dense one than by a Dgemv for each row.
+// Dgemm performs m*k*n multiply-adds, assumed to run gemmSpeedup times as
+// fast as those of Dgemv, once the m*k elements of the dense matrix are
+// written. The Dgemv calls copy and multiply nnz rows of length n and each
+// make a cgo call, assumed to cost as much as callCost multiply-adds.
+func densify(m, n, k, nnz int) bool {
+	const (
+		gemmSpeedup = 8
+		callCost    = 100
+	)
+	dense := float64(m) * float64(k) * (float64(n)/gemmSpeedup + 1)
+	sparse := 2*float64(nnz)*float64(n) + float64(m)*callCost
+	return dense < sparse
+}
+
+// checkCSR checks a rows×cols sparse matrix in CSR format and returns the
+// number of its stored elements.
+func checkCSR(rows, cols int, rowPtr, colIdx []int, values []float64) int {
+	if len(rowPtr) != rows+1 || rowPtr[0] != 0 {
+		panic(badRowPtr)
+	}
+	for i := 1; i < len(rowPtr); i++ {
+		if rowPtr[i] < rowPtr[i-1] {
+			panic(badRowPtr)
+		}
+	}
+	nnz := rowPtr[rows]
+	switch {
+	case len(colIdx) < nnz:
+		panic(shortColIdx)
+	case len(values) < nnz:
+		panic(shortValues)
+	}
+	for _, j := range colIdx[:nnz] {
+		if j < 0 || cols <= j {
+			panic(badColIdx)
+		}
+	}
+	return nnz
+}
+
+// transposeCSR returns the CSR format of the transpose of a rows×cols
+// sparse matrix in CSR format. The elements of each row of the transpose
+// are in the order of the rows of the matrix.
+func transposeCSR(rows, cols int, rowPtr, colIdx []int, values []float64) (tPtr, tIdx []int, tValues []float64) {
+	nnz := rowPtr[rows]
+	tPtr = make([]int, cols+1)
+	for _, j := range colIdx[:nnz] {
+		tPtr[j+1]++
+	}
+	for j := 0; j < cols; j++ {
+		tPtr[j+1] += tPtr[j]
+	}
+	next := append([]int(nil), tPtr[:cols]...)
+	tIdx = make([]int, nnz)
+	tValues = make([]float64, nnz)
+	for i := 0; i < rows; i++ {
+		for p := rowPtr[i]; p < rowPtr[i+1]; p++ {
+			q := next[colIdx[p]]
+			tIdx[q] = i
+			tValues[q] = values[p]
+			next[colIdx[p]]++
+		}
+	}
+	return tPtr, tIdx, tValues
+}
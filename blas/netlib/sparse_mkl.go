@@ -0,0 +1,123 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build mkl
+// +build mkl
+
+package netlib
+
+/*
+#cgo dlopen CFLAGS: -DNETLIB_DLOPEN=1
+#include <stddef.h>
+#include "cblas.h"
+#include "extension.h"
+
+// Declarations from mkl_spblas.h. The enumerations are passed as int.
+typedef struct sparse_matrix *sparse_matrix_t;
+
+struct matrix_descr {
+	int type;
+	int mode;
+	int diag;
+};
+
+enum {
+	SPARSE_STATUS_SUCCESS = 0,
+	SPARSE_OPERATION_NON_TRANSPOSE = 10,
+	SPARSE_OPERATION_TRANSPOSE = 11,
+	SPARSE_MATRIX_TYPE_GENERAL = 20,
+	SPARSE_FILL_MODE_LOWER = 40,
+	SPARSE_DIAG_NON_UNIT = 50,
+	SPARSE_INDEX_BASE_ZERO = 0,
+	SPARSE_LAYOUT_ROW_MAJOR = 101,
+};
+
+int mkl_sparse_d_create_csr(sparse_matrix_t *A, int indexing, blasint rows, blasint cols,
+	blasint *rows_start, blasint *rows_end, blasint *col_indx, double *values);
+int mkl_sparse_d_mm(int operation, double alpha, sparse_matrix_t A, struct matrix_descr descr,
+	int layout, const double *B, blasint columns, blasint ldb, double beta, double *C, blasint ldc);
+int mkl_sparse_destroy(sparse_matrix_t A);
+
+// netlib_sparse_dmm computes C = alpha * op(A) * B + beta * C for the
+// rows×cols matrix A in zero-based CSR format by mkl_sparse_d_mm. It
+// returns zero without touching C if the routines are missing from the
+// library or MKL does not accept the matrix.
+static int netlib_sparse_dmm(int trans, blasint rows, blasint cols, blasint n, double alpha,
+	blasint *ptr, blasint *idx, double *values, const double *b, blasint ldb,
+	double beta, double *c, blasint ldc)
+{
+	NETLIB_EXTENSION(create, mkl_sparse_d_create_csr)
+	NETLIB_EXTENSION(mm, mkl_sparse_d_mm)
+	NETLIB_EXTENSION(destroy, mkl_sparse_destroy)
+	if (create == NULL || mm == NULL || destroy == NULL) {
+		return 0;
+	}
+	sparse_matrix_t a;
+	if (create(&a, SPARSE_INDEX_BASE_ZERO, rows, cols, ptr, ptr + 1, idx, values) != SPARSE_STATUS_SUCCESS) {
+		return 0;
+	}
+	struct matrix_descr descr = {SPARSE_MATRIX_TYPE_GENERAL, SPARSE_FILL_MODE_LOWER, SPARSE_DIAG_NON_UNIT};
+	int op = trans ? SPARSE_OPERATION_TRANSPOSE : SPARSE_OPERATION_NON_TRANSPOSE;
+	int status = mm(op, alpha, a, descr, SPARSE_LAYOUT_ROW_MAJOR, b, n, ldb, beta, c, ldc);
+	destroy(a);
+	return status == SPARSE_STATUS_SUCCESS;
+}
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+// sparseDgemm computes the product of SparseDgemm with the
+// mkl_sparse_d_mm routine of Intel MKL and returns whether it did. The
+// routine is not used for matrices with an element stored more than once
+// or with the elements of a row out of order, which MKL does not accept,
+// or with more elements than blasint can index. With the dlopen build tag
+// the routines are looked up in the loaded library.
+func sparseDgemm(tA blas.Transpose, m, n, k int, alpha float64, rowPtr, colIdx []int, values []float64, b []float64, ldb int, beta float64, c []float64, ldc int) bool {
+	rows, cols := m, k
+	if tA != blas.NoTrans {
+		rows, cols = k, m
+	}
+	nnz := rowPtr[rows]
+	if nnz > maxInt || !increasing(rows, rowPtr, colIdx) {
+		return false
+	}
+	ptr := make([]C.blasint, rows+1)
+	for i, p := range rowPtr {
+		ptr[i] = C.blasint(p)
+	}
+	idx := make([]C.blasint, nnz)
+	for i, j := range colIdx[:nnz] {
+		idx[i] = C.blasint(j)
+	}
+	var trans C.int
+	if tA != blas.NoTrans {
+		trans = 1
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	ok := C.netlib_sparse_dmm(trans, C.blasint(rows), C.blasint(cols), C.blasint(n), C.double(alpha),
+		&ptr[0], &idx[0], (*C.double)(unsafe.Pointer(&values[0])), (*C.double)(_b), C.blasint(ldb),
+		C.double(beta), (*C.double)(unsafe.Pointer(&c[0])), C.blasint(ldc))
+	return ok != 0
+}
+
+// increasing returns whether the column indices of each row of a sparse
+// matrix in CSR format are strictly increasing.
+func increasing(rows int, rowPtr, colIdx []int) bool {
+	for i := 0; i < rows; i++ {
+		for p := rowPtr[i] + 1; p < rowPtr[i+1]; p++ {
+			if colIdx[p] <= colIdx[p-1] {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,16 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !mkl
+// +build !mkl
+
+package netlib
+
+import "gonum.org/v1/gonum/blas"
+
+// sparseDgemm reports that no sparse routine of the library is bound
+// without the mkl build tag.
+func sparseDgemm(tA blas.Transpose, m, n, k int, alpha float64, rowPtr, colIdx []int, values []float64, b []float64, ldb int, beta float64, c []float64, ldc int) bool {
+	return false
+}
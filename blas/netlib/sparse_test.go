@@ -0,0 +1,136 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+// randomCSR returns a rows×cols sparse matrix in CSR format whose elements
+// are stored with probability p, and its dense form. Some elements are
+// stored twice.
+func randomCSR(rnd *rand.Rand, rows, cols int, p float64) (rowPtr, colIdx []int, values, dense []float64) {
+	rowPtr = make([]int, rows+1)
+	dense = make([]float64, rows*cols)
+	for i := 0; i < rows; i++ {
+		for j := 0; j < cols; j++ {
+			if rnd.Float64() >= p {
+				continue
+			}
+			v := rnd.NormFloat64()
+			colIdx = append(colIdx, j)
+			values = append(values, v)
+			dense[i*cols+j] += v
+			if rnd.Float64() < 0.1 {
+				v = rnd.NormFloat64()
+				colIdx = append(colIdx, j)
+				values = append(values, v)
+				dense[i*cols+j] += v
+			}
+		}
+		rowPtr[i+1] = len(colIdx)
+	}
+	return rowPtr, colIdx, values, dense
+}
+
+func TestSparseDgemm(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, k, ldb, ldc int
+	}{
+		{m: 0, n: 3, k: 2, ldb: 3, ldc: 3},
+		{m: 3, n: 2, k: 0, ldb: 2, ldc: 2},
+		{m: 1, n: 1, k: 1, ldb: 1, ldc: 1},
+		{m: 7, n: 5, k: 9, ldb: 6, ldc: 8},
+		{m: 100, n: 50, k: 100, ldb: 50, ldc: 50},
+	} {
+		for _, tA := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			for _, p := range []float64{0, 0.01, 0.5} {
+				for _, beta := range []float64{0, 0.5} {
+					m, n, k := test.m, test.n, test.k
+					name := fmt.Sprintf("tA=%v,m=%d,n=%d,k=%d,p=%v,beta=%v", tA, m, n, k, p, beta)
+					rows, cols := m, k
+					if tA != blas.NoTrans {
+						rows, cols = k, m
+					}
+					rowPtr, colIdx, values, a := randomCSR(rnd, rows, cols, p)
+					b := randomFloats(rnd, max(0, (k-1)*test.ldb+n))
+					c := randomFloats(rnd, max(0, (m-1)*test.ldc+n))
+					const alpha = 1.5
+
+					want := append([]float64(nil), c...)
+					for i := 0; i < m; i++ {
+						for j := 0; j < n; j++ {
+							var sum float64
+							for l := 0; l < k; l++ {
+								aij := a[i*k+l]
+								if tA != blas.NoTrans {
+									aij = a[l*m+i]
+								}
+								sum += aij * b[l*test.ldb+j]
+							}
+							want[i*test.ldc+j] = alpha*sum + beta*c[i*test.ldc+j]
+						}
+					}
+					impl.SparseDgemm(tA, m, n, k, alpha, rowPtr, colIdx, values, b, test.ldb, beta, c, test.ldc)
+					if !floats.EqualApprox(c, want, tol) {
+						t.Errorf("%s: unexpected result: got %v want %v", name, c, want)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDensify(t *testing.T) {
+	for _, test := range []struct {
+		m, n, k, nnz int
+		want         bool
+	}{
+		{m: 100, n: 50, k: 100, nnz: 100, want: false},
+		{m: 100, n: 50, k: 100, nnz: 5000, want: true},
+		{m: 1000, n: 1, k: 1000, nnz: 10000, want: false},
+		{m: 10, n: 10, k: 10, nnz: 10, want: true},
+	} {
+		got := densify(test.m, test.n, test.k, test.nnz)
+		if got != test.want {
+			t.Errorf("m=%d,n=%d,k=%d,nnz=%d: unexpected result: got %t want %t", test.m, test.n, test.k, test.nnz, got, test.want)
+		}
+	}
+}
+
+func TestSparseDgemmPanics(t *testing.T) {
+	b := make([]float64, 4)
+	c := make([]float64, 4)
+	for _, test := range []struct {
+		name           string
+		rowPtr, colIdx []int
+		values         []float64
+		want           string
+	}{
+		{name: "short rowPtr", rowPtr: []int{0, 1}, colIdx: []int{0}, values: []float64{1}, want: badRowPtr},
+		{name: "nonzero start", rowPtr: []int{1, 1, 1}, colIdx: []int{0}, values: []float64{1}, want: badRowPtr},
+		{name: "decreasing", rowPtr: []int{0, 1, 0}, colIdx: []int{0}, values: []float64{1}, want: badRowPtr},
+		{name: "short colIdx", rowPtr: []int{0, 1, 2}, colIdx: []int{0}, values: []float64{1, 2}, want: shortColIdx},
+		{name: "short values", rowPtr: []int{0, 1, 2}, colIdx: []int{0, 1}, values: []float64{1}, want: shortValues},
+		{name: "column", rowPtr: []int{0, 1, 2}, colIdx: []int{0, 2}, values: []float64{1, 2}, want: badColIdx},
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r != test.want {
+					t.Errorf("%s: unexpected panic: got %v want %q", test.name, r, test.want)
+				}
+			}()
+			impl.SparseDgemm(blas.NoTrans, 2, 2, 2, 1, test.rowPtr, test.colIdx, test.values, b, 2, 0, c, 2)
+		}()
+	}
+}
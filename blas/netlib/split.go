@@ -0,0 +1,331 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import "gonum.org/v1/gonum/blas"
+
+// Split is an Implementation whose routines Dgemm, Dsyrk and Dtrsm, and
+// their float32 versions, accept dimensions beyond the range of the CBLAS
+// integer type. Unless the package is built with the ilp64 build tag that
+// type is a 32-bit int, and Implementation panics for larger dimensions.
+// Split instead decomposes the operation into panels whose dimensions fit
+// and calls the library once for each panel, so that a program linked
+// against an LP64 library can operate on matrices with more than 2^31-1
+// rows without an ILP64 build.
+//
+// The matrices are stored in row-major order, so the leading dimension of
+// a matrix is at least its number of columns and is passed to the library
+// unchanged. Split panics as Implementation does if a leading dimension is
+// out of range, which limits the number of columns of each matrix but not
+// the number of rows.
+//
+// Operations whose dimensions all fit are passed to Implementation
+// unchanged. The other routines of Split are those of Implementation.
+type Split struct {
+	Implementation
+
+	// Panel is the largest dimension of a panel. If Panel is not positive
+	// or exceeds the range of the CBLAS integer type, the largest value of
+	// that type is used.
+	Panel int
+}
+
+// panel returns the largest dimension of a panel.
+func (s Split) panel() int {
+	if s.Panel <= 0 || s.Panel > maxInt {
+		return maxInt
+	}
+	return s.Panel
+}
+
+// Dgemm performs one of the matrix-matrix operations
+//  C = alpha * A * B + beta * C
+//  C = alpha * A^T * B + beta * C
+//  C = alpha * A * B^T + beta * C
+//  C = alpha * A^T * B^T + beta * C
+// as described for Implementation.Dgemm. If a dimension exceeds the panel
+// size, C is computed in blocks of at most Panel rows and columns, each
+// accumulated from the products of panels of op(A) and op(B) of at most
+// Panel columns and rows.
+func (s Split) Dgemm(tA, tB blas.Transpose, m, n, k int, alpha float64, a []float64, lda int, b []float64, ldb int, beta float64, c []float64, ldc int) {
+	p := s.panel()
+	if m <= p && n <= p && k <= p {
+		s.Implementation.Dgemm(tA, tB, m, n, k, alpha, a, lda, b, ldb, beta, c, ldc)
+		return
+	}
+	checkGemm("Dgemm", tA, tB, m, n, k, lda, ldb, ldc, len(a), len(b), len(c))
+	for i := 0; i < m; i += p {
+		mb := min(p, m-i)
+		for j := 0; j < n; j += p {
+			nb := min(p, n-j)
+			// The first panel of the inner dimension scales C by beta,
+			// and the following ones accumulate into it. An empty inner
+			// dimension still scales C.
+			bt := beta
+			for l := 0; l < k || l == 0; l += p {
+				kb := min(p, k-l)
+				s.Implementation.Dgemm(tA, tB, mb, nb, kb, alpha, tail(a, index(tA, i, l, lda)), lda, tail(b, index(tB, l, j, ldb)), ldb, bt, c[i*ldc+j:], ldc)
+				bt = 1
+			}
+		}
+	}
+}
+
+// Dsyrk performs one of the symmetric rank-k operations
+//  C = alpha * A * A^T + beta * C  if tA == blas.NoTrans
+//  C = alpha * A^T * A + beta * C  if tA == blas.Trans or tA == blas.ConjTrans
+// as described for Implementation.Dsyrk. If a dimension exceeds the panel
+// size, the diagonal blocks of C are updated by Dsyrk and the blocks of its
+// triangle off the diagonal by Dgemm.
+func (s Split) Dsyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha float64, a []float64, lda int, beta float64, c []float64, ldc int) {
+	p := s.panel()
+	if n <= p && k <= p {
+		s.Implementation.Dsyrk(ul, t, n, k, alpha, a, lda, beta, c, ldc)
+		return
+	}
+	checkSyrk("Dsyrk", ul, t, n, k, lda, ldc, len(a), len(c))
+	// The block of C in rows i and columns j is op(A)_i * op(A)_j^T.
+	tA, tB := blas.NoTrans, blas.Trans
+	if t != blas.NoTrans {
+		tA, tB = blas.Trans, blas.NoTrans
+	}
+	for i := 0; i < n; i += p {
+		ib := min(p, n-i)
+		j0, j1 := i+ib, n
+		if ul == blas.Lower {
+			j0, j1 = 0, i
+		}
+		bt := beta
+		for l := 0; l < k || l == 0; l += p {
+			kb := min(p, k-l)
+			ai := tail(a, index(t, i, l, lda))
+			s.Implementation.Dsyrk(ul, t, ib, kb, alpha, ai, lda, bt, c[i*ldc+i:], ldc)
+			for j := j0; j < j1; j += p {
+				jb := min(p, j1-j)
+				s.Implementation.Dgemm(tA, tB, ib, jb, kb, alpha, ai, lda, tail(a, index(t, j, l, lda)), lda, bt, c[i*ldc+j:], ldc)
+			}
+			bt = 1
+		}
+	}
+}
+
+// Dtrsm solves one of the matrix equations
+//  A * X = alpha * B    if tA == blas.NoTrans and side == blas.Left
+//  A^T * X = alpha * B  if tA == blas.Trans or blas.ConjTrans, and side == blas.Left
+//  X * A = alpha * B    if tA == blas.NoTrans and side == blas.Right
+//  X * A^T = alpha * B  if tA == blas.Trans or blas.ConjTrans, and side == blas.Right
+// as described for Implementation.Dtrsm. If a dimension exceeds the panel
+// size, X is computed by blocks of at most Panel rows and columns in the
+// order of a block substitution, subtracting the contribution of the blocks
+// already computed with Dgemm before each diagonal block is solved by Dtrsm.
+func (s Split) Dtrsm(side blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float64, a []float64, lda int, b []float64, ldb int) {
+	p := s.panel()
+	if m <= p && n <= p {
+		s.Implementation.Dtrsm(side, ul, tA, d, m, n, alpha, a, lda, b, ldb)
+		return
+	}
+	checkTrsm("Dtrsm", side, ul, tA, d, m, n, lda, ldb, len(a), len(b))
+	// upper is whether op(A) is upper triangular.
+	upper := (ul == blas.Upper) == (tA == blas.NoTrans)
+	if side == blas.Left {
+		// The columns of B are independent. The block rows of X are
+		// computed forwards if op(A) is lower triangular and backwards
+		// otherwise.
+		nblk := (m + p - 1) / p
+		for j := 0; j < n; j += p {
+			jb := min(p, n-j)
+			for q := 0; q < nblk; q++ {
+				r := q
+				if upper {
+					r = nblk - 1 - q
+				}
+				i := r * p
+				ib := min(p, m-i)
+				al := alpha
+				for qh := 0; qh < q; qh++ {
+					h := qh * p
+					if upper {
+						h = (nblk - 1 - qh) * p
+					}
+					hb := min(p, m-h)
+					s.Implementation.Dgemm(tA, blas.NoTrans, ib, jb, hb, -1, a[index(tA, i, h, lda):], lda, b[h*ldb+j:], ldb, al, b[i*ldb+j:], ldb)
+					al = 1
+				}
+				s.Implementation.Dtrsm(side, ul, tA, d, ib, jb, al, a[i*lda+i:], lda, b[i*ldb+j:], ldb)
+			}
+		}
+		return
+	}
+	// The rows of B are independent. The block columns of X are computed
+	// forwards if op(A) is upper triangular and backwards otherwise.
+	nblk := (n + p - 1) / p
+	for i := 0; i < m; i += p {
+		ib := min(p, m-i)
+		for q := 0; q < nblk; q++ {
+			r := q
+			if !upper {
+				r = nblk - 1 - q
+			}
+			j := r * p
+			jb := min(p, n-j)
+			al := alpha
+			for qh := 0; qh < q; qh++ {
+				h := qh * p
+				if !upper {
+					h = (nblk - 1 - qh) * p
+				}
+				hb := min(p, n-h)
+				s.Implementation.Dgemm(blas.NoTrans, tA, ib, jb, hb, -1, b[i*ldb+h:], ldb, a[index(tA, h, j, lda):], lda, al, b[i*ldb+j:], ldb)
+				al = 1
+			}
+			s.Implementation.Dtrsm(side, ul, tA, d, ib, jb, al, a[j*lda+j:], lda, b[i*ldb+j:], ldb)
+		}
+	}
+}
+
+// index returns the index of the element in row i and column j of op(X)
+// in the storage of X with leading dimension ld.
+func index(t blas.Transpose, i, j, ld int) int {
+	if t == blas.NoTrans {
+		return i*ld + j
+	}
+	return j*ld + i
+}
+
+// tail returns x[i:], or nil if x is not longer than i. This only happens
+// for a panel of a matrix with no rows or columns, which is not accessed.
+func tail(x []float64, i int) []float64 {
+	if i >= len(x) {
+		return nil
+	}
+	return x[i:]
+}
+
+// checkGemm panics as the ?gemm routine name does if its arguments are
+// invalid. The lengths of the slices are la, lb and lc.
+func checkGemm(name string, tA, tB blas.Transpose, m, n, k, lda, ldb, ldc, la, lb, lc int) {
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(Error{Routine: name, Param: "tA", Message: badTranspose})
+	}
+	if tB != blas.NoTrans && tB != blas.Trans && tB != blas.ConjTrans {
+		panic(Error{Routine: name, Param: "tB", Message: badTranspose})
+	}
+	if m < 0 {
+		panic(Error{Routine: name, Param: "m", Message: mLT0})
+	}
+	if n < 0 {
+		panic(Error{Routine: name, Param: "n", Message: nLT0})
+	}
+	if k < 0 {
+		panic(Error{Routine: name, Param: "k", Message: kLT0})
+	}
+	rowA, colA := m, k
+	if tA != blas.NoTrans {
+		rowA, colA = k, m
+	}
+	rowB, colB := k, n
+	if tB != blas.NoTrans {
+		rowB, colB = n, k
+	}
+	if lda < max(1, colA) {
+		panic(Error{Routine: name, Param: "lda", Message: badLdA})
+	}
+	if ldb < max(1, colB) {
+		panic(Error{Routine: name, Param: "ldb", Message: badLdB})
+	}
+	if ldc < max(1, n) {
+		panic(Error{Routine: name, Param: "ldc", Message: badLdC})
+	}
+	if m == 0 || n == 0 {
+		return
+	}
+	if la < lda*(rowA-1)+colA {
+		panic(Error{Routine: name, Param: "a", Message: shortA})
+	}
+	if lb < ldb*(rowB-1)+colB {
+		panic(Error{Routine: name, Param: "b", Message: shortB})
+	}
+	if lc < ldc*(m-1)+n {
+		panic(Error{Routine: name, Param: "c", Message: shortC})
+	}
+}
+
+// checkSyrk panics as the ?syrk routine name does if its arguments are
+// invalid. The lengths of the slices are la and lc.
+func checkSyrk(name string, ul blas.Uplo, t blas.Transpose, n, k, lda, ldc, la, lc int) {
+	if t != blas.NoTrans && t != blas.Trans && t != blas.ConjTrans {
+		panic(Error{Routine: name, Param: "t", Message: badTranspose})
+	}
+	if ul != blas.Upper && ul != blas.Lower {
+		panic(Error{Routine: name, Param: "ul", Message: badUplo})
+	}
+	if n < 0 {
+		panic(Error{Routine: name, Param: "n", Message: nLT0})
+	}
+	if k < 0 {
+		panic(Error{Routine: name, Param: "k", Message: kLT0})
+	}
+	row, col := n, k
+	if t != blas.NoTrans {
+		row, col = k, n
+	}
+	if lda < max(1, col) {
+		panic(Error{Routine: name, Param: "lda", Message: badLdA})
+	}
+	if ldc < max(1, n) {
+		panic(Error{Routine: name, Param: "ldc", Message: badLdC})
+	}
+	if n == 0 {
+		return
+	}
+	if la < lda*(row-1)+col {
+		panic(Error{Routine: name, Param: "a", Message: shortA})
+	}
+	if lc < ldc*(n-1)+n {
+		panic(Error{Routine: name, Param: "c", Message: shortC})
+	}
+}
+
+// checkTrsm panics as the ?trsm routine name does if its arguments are
+// invalid. The lengths of the slices are la and lb.
+func checkTrsm(name string, s blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n, lda, ldb, la, lb int) {
+	if tA != blas.NoTrans && tA != blas.Trans && tA != blas.ConjTrans {
+		panic(Error{Routine: name, Param: "tA", Message: badTranspose})
+	}
+	if ul != blas.Upper && ul != blas.Lower {
+		panic(Error{Routine: name, Param: "ul", Message: badUplo})
+	}
+	if d != blas.NonUnit && d != blas.Unit {
+		panic(Error{Routine: name, Param: "d", Message: badDiag})
+	}
+	if s != blas.Left && s != blas.Right {
+		panic(Error{Routine: name, Param: "s", Message: badSide})
+	}
+	if m < 0 {
+		panic(Error{Routine: name, Param: "m", Message: mLT0})
+	}
+	if n < 0 {
+		panic(Error{Routine: name, Param: "n", Message: nLT0})
+	}
+	k := n
+	if s == blas.Left {
+		k = m
+	}
+	if lda < max(1, k) {
+		panic(Error{Routine: name, Param: "lda", Message: badLdA})
+	}
+	if ldb < max(1, n) {
+		panic(Error{Routine: name, Param: "ldb", Message: badLdB})
+	}
+	if m == 0 || n == 0 {
+		return
+	}
+	if la < lda*(k-1)+k {
+		panic(Error{Routine: name, Param: "a", Message: shortA})
+	}
+	if lb < ldb*(m-1)+n {
+		panic(Error{Routine: name, Param: "b", Message: shortB})
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import "gonum.org/v1/gonum/blas"
+
+// Sgemm is the float32 version of Dgemm.
+func (s Split) Sgemm(tA, tB blas.Transpose, m, n, k int, alpha float32, a []float32, lda int, b []float32, ldb int, beta float32, c []float32, ldc int) {
+	p := s.panel()
+	if m <= p && n <= p && k <= p {
+		s.Implementation.Sgemm(tA, tB, m, n, k, alpha, a, lda, b, ldb, beta, c, ldc)
+		return
+	}
+	checkGemm("Sgemm", tA, tB, m, n, k, lda, ldb, ldc, len(a), len(b), len(c))
+	for i := 0; i < m; i += p {
+		mb := min(p, m-i)
+		for j := 0; j < n; j += p {
+			nb := min(p, n-j)
+			// The first panel of the inner dimension scales C by beta,
+			// and the following ones accumulate into it. An empty inner
+			// dimension still scales C.
+			bt := beta
+			for l := 0; l < k || l == 0; l += p {
+				kb := min(p, k-l)
+				s.Implementation.Sgemm(tA, tB, mb, nb, kb, alpha, tail32(a, index(tA, i, l, lda)), lda, tail32(b, index(tB, l, j, ldb)), ldb, bt, c[i*ldc+j:], ldc)
+				bt = 1
+			}
+		}
+	}
+}
+
+// Ssyrk is the float32 version of Dsyrk.
+func (s Split) Ssyrk(ul blas.Uplo, t blas.Transpose, n, k int, alpha float32, a []float32, lda int, beta float32, c []float32, ldc int) {
+	p := s.panel()
+	if n <= p && k <= p {
+		s.Implementation.Ssyrk(ul, t, n, k, alpha, a, lda, beta, c, ldc)
+		return
+	}
+	checkSyrk("Ssyrk", ul, t, n, k, lda, ldc, len(a), len(c))
+	// The block of C in rows i and columns j is op(A)_i * op(A)_j^T.
+	tA, tB := blas.NoTrans, blas.Trans
+	if t != blas.NoTrans {
+		tA, tB = blas.Trans, blas.NoTrans
+	}
+	for i := 0; i < n; i += p {
+		ib := min(p, n-i)
+		j0, j1 := i+ib, n
+		if ul == blas.Lower {
+			j0, j1 = 0, i
+		}
+		bt := beta
+		for l := 0; l < k || l == 0; l += p {
+			kb := min(p, k-l)
+			ai := tail32(a, index(t, i, l, lda))
+			s.Implementation.Ssyrk(ul, t, ib, kb, alpha, ai, lda, bt, c[i*ldc+i:], ldc)
+			for j := j0; j < j1; j += p {
+				jb := min(p, j1-j)
+				s.Implementation.Sgemm(tA, tB, ib, jb, kb, alpha, ai, lda, tail32(a, index(t, j, l, lda)), lda, bt, c[i*ldc+j:], ldc)
+			}
+			bt = 1
+		}
+	}
+}
+
+// Strsm is the float32 version of Dtrsm.
+func (s Split) Strsm(side blas.Side, ul blas.Uplo, tA blas.Transpose, d blas.Diag, m, n int, alpha float32, a []float32, lda int, b []float32, ldb int) {
+	p := s.panel()
+	if m <= p && n <= p {
+		s.Implementation.Strsm(side, ul, tA, d, m, n, alpha, a, lda, b, ldb)
+		return
+	}
+	checkTrsm("Strsm", side, ul, tA, d, m, n, lda, ldb, len(a), len(b))
+	// upper is whether op(A) is upper triangular.
+	upper := (ul == blas.Upper) == (tA == blas.NoTrans)
+	if side == blas.Left {
+		// The columns of B are independent. The block rows of X are
+		// computed forwards if op(A) is lower triangular and backwards
+		// otherwise.
+		nblk := (m + p - 1) / p
+		for j := 0; j < n; j += p {
+			jb := min(p, n-j)
+			for q := 0; q < nblk; q++ {
+				r := q
+				if upper {
+					r = nblk - 1 - q
+				}
+				i := r * p
+				ib := min(p, m-i)
+				al := alpha
+				for qh := 0; qh < q; qh++ {
+					h := qh * p
+					if upper {
+						h = (nblk - 1 - qh) * p
+					}
+					hb := min(p, m-h)
+					s.Implementation.Sgemm(tA, blas.NoTrans, ib, jb, hb, -1, a[index(tA, i, h, lda):], lda, b[h*ldb+j:], ldb, al, b[i*ldb+j:], ldb)
+					al = 1
+				}
+				s.Implementation.Strsm(side, ul, tA, d, ib, jb, al, a[i*lda+i:], lda, b[i*ldb+j:], ldb)
+			}
+		}
+		return
+	}
+	// The rows of B are independent. The block columns of X are computed
+	// forwards if op(A) is upper triangular and backwards otherwise.
+	nblk := (n + p - 1) / p
+	for i := 0; i < m; i += p {
+		ib := min(p, m-i)
+		for q := 0; q < nblk; q++ {
+			r := q
+			if !upper {
+				r = nblk - 1 - q
+			}
+			j := r * p
+			jb := min(p, n-j)
+			al := alpha
+			for qh := 0; qh < q; qh++ {
+				h := qh * p
+				if !upper {
+					h = (nblk - 1 - qh) * p
+				}
+				hb := min(p, n-h)
+				s.Implementation.Sgemm(blas.NoTrans, tA, ib, jb, hb, -1, b[i*ldb+h:], ldb, a[index(tA, h, j, lda):], lda, al, b[i*ldb+j:], ldb)
+				al = 1
+			}
+			s.Implementation.Strsm(side, ul, tA, d, ib, jb, al, a[j*lda+j:], lda, b[i*ldb+j:], ldb)
+		}
+	}
+}
+
+
+// tail32 is the float32 version of tail.
+func tail32(x []float32, i int) []float32 {
+	if i >= len(x) {
+		return nil
+	}
+	return x[i:]
+}
@@ -0,0 +1,222 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+// The tests of Split use small panels, so that the decomposition is
+// exercised without matrices beyond the range of a 32-bit blasint, and
+// compare the results with those of a single call of Implementation.
+
+var splitTrans = []blas.Transpose{blas.NoTrans, blas.Trans}
+
+func TestSplitDgemm(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, p := range []int{1, 2, 5} {
+		s := Split{Panel: p}
+		for _, dims := range [][3]int{{0, 5, 7}, {4, 0, 7}, {7, 8, 0}, {1, 5, 3}, {7, 8, 7}} {
+			m, n, k := dims[0], dims[1], dims[2]
+			for _, tA := range splitTrans {
+				for _, tB := range splitTrans {
+					name := fmt.Sprintf("p=%d,m=%d,n=%d,k=%d,tA=%c,tB=%c", p, m, n, k, tA, tB)
+					rowA, colA := m, k
+					if tA != blas.NoTrans {
+						rowA, colA = k, m
+					}
+					rowB, colB := k, n
+					if tB != blas.NoTrans {
+						rowB, colB = n, k
+					}
+					lda, ldb, ldc := colA+2, colB+1, n+3
+					a := randomFloats(rnd, matrixLen(rowA, colA, lda))
+					b := randomFloats(rnd, matrixLen(rowB, colB, ldb))
+					c := randomFloats(rnd, matrixLen(m, n, ldc))
+					want := append([]float64(nil), c...)
+					impl.Dgemm(tA, tB, m, n, k, 0.5, a, lda, b, ldb, -2, want, ldc)
+					s.Dgemm(tA, tB, m, n, k, 0.5, a, lda, b, ldb, -2, c, ldc)
+					if !floats.EqualApprox(c, want, tol) {
+						t.Errorf("%s: unexpected result", name)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSplitDsyrk(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, p := range []int{1, 2, 5} {
+		s := Split{Panel: p}
+		for _, dims := range [][2]int{{7, 0}, {1, 8}, {7, 3}, {8, 8}} {
+			n, k := dims[0], dims[1]
+			for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+				for _, tA := range splitTrans {
+					name := fmt.Sprintf("p=%d,n=%d,k=%d,ul=%c,t=%c", p, n, k, ul, tA)
+					row, col := n, k
+					if tA != blas.NoTrans {
+						row, col = k, n
+					}
+					lda, ldc := col+1, n+2
+					a := randomFloats(rnd, matrixLen(row, col, lda))
+					c := randomFloats(rnd, matrixLen(n, n, ldc))
+					want := append([]float64(nil), c...)
+					impl.Dsyrk(ul, tA, n, k, 0.5, a, lda, 3, want, ldc)
+					s.Dsyrk(ul, tA, n, k, 0.5, a, lda, 3, c, ldc)
+					if !floats.EqualApprox(c, want, tol) {
+						t.Errorf("%s: unexpected result", name)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSplitDtrsm(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, p := range []int{1, 2, 5} {
+		s := Split{Panel: p}
+		for _, dims := range [][2]int{{0, 7}, {7, 0}, {1, 8}, {8, 1}, {7, 8}} {
+			m, n := dims[0], dims[1]
+			for _, side := range []blas.Side{blas.Left, blas.Right} {
+				for _, ul := range []blas.Uplo{blas.Upper, blas.Lower} {
+					for _, tA := range splitTrans {
+						for _, d := range []blas.Diag{blas.NonUnit, blas.Unit} {
+							name := fmt.Sprintf("p=%d,m=%d,n=%d,s=%c,ul=%c,tA=%c,d=%c", p, m, n, side, ul, tA, d)
+							k := n
+							if side == blas.Left {
+								k = m
+							}
+							lda, ldb := k+1, n+2
+							// A well conditioned triangle with a dominant
+							// diagonal.
+							a := randomFloats(rnd, matrixLen(k, k, lda))
+							for i := range a {
+								a[i] *= 0.1
+							}
+							for i := 0; i < k; i++ {
+								a[i*lda+i] += 2
+							}
+							b := randomFloats(rnd, matrixLen(m, n, ldb))
+							want := append([]float64(nil), b...)
+							impl.Dtrsm(side, ul, tA, d, m, n, 0.5, a, lda, want, ldb)
+							s.Dtrsm(side, ul, tA, d, m, n, 0.5, a, lda, b, ldb)
+							if !floats.EqualApprox(b, want, tol) {
+								t.Errorf("%s: unexpected result", name)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSplitFloat32(t *testing.T) {
+	const tol = 1e-5
+	rnd := rand.New(rand.NewSource(1))
+	s := Split{Panel: 2}
+	const m, n, k = 5, 4, 7
+	a := float32s(randomFloats(rnd, m*k))
+	b := float32s(randomFloats(rnd, k*n))
+	c := float32s(randomFloats(rnd, m*n))
+	want := append([]float32(nil), c...)
+	impl.Sgemm(blas.NoTrans, blas.NoTrans, m, n, k, 1, a, k, b, n, 1, want, n)
+	s.Sgemm(blas.NoTrans, blas.NoTrans, m, n, k, 1, a, k, b, n, 1, c, n)
+	if d := maxDiff32(c, want); d > tol {
+		t.Errorf("unexpected Sgemm result: max difference %v", d)
+	}
+
+	c = float32s(randomFloats(rnd, n*n))
+	want = append([]float32(nil), c...)
+	impl.Ssyrk(blas.Lower, blas.Trans, n, k, 1, b, n, 1, want, n)
+	s.Ssyrk(blas.Lower, blas.Trans, n, k, 1, b, n, 1, c, n)
+	if d := maxDiff32(c, want); d > tol {
+		t.Errorf("unexpected Ssyrk result: max difference %v", d)
+	}
+
+	tri := float32s(randomFloats(rnd, m*m))
+	for i := 0; i < m; i++ {
+		tri[i*m+i] += 4
+	}
+	x := float32s(randomFloats(rnd, m*n))
+	want = append([]float32(nil), x...)
+	impl.Strsm(blas.Left, blas.Upper, blas.NoTrans, blas.NonUnit, m, n, 1, tri, m, want, n)
+	s.Strsm(blas.Left, blas.Upper, blas.NoTrans, blas.NonUnit, m, n, 1, tri, m, x, n)
+	if d := maxDiff32(x, want); d > tol {
+		t.Errorf("unexpected Strsm result: max difference %v", d)
+	}
+}
+
+func TestSplitPanics(t *testing.T) {
+	s := Split{Panel: 2}
+	for _, test := range []struct {
+		name string
+		fn   func()
+		want Error
+	}{
+		{
+			name: "Dgemm",
+			fn: func() {
+				s.Dgemm(blas.NoTrans, blas.NoTrans, 3, 3, 3, 1, make([]float64, 8), 3, make([]float64, 9), 3, 0, make([]float64, 9), 3)
+			},
+			want: Error{Routine: "Dgemm", Param: "a", Message: shortA},
+		},
+		{
+			name: "Dsyrk",
+			fn:   func() { s.Dsyrk(blas.Upper, blas.NoTrans, 3, 3, 1, make([]float64, 9), 2, 0, make([]float64, 9), 3) },
+			want: Error{Routine: "Dsyrk", Param: "lda", Message: badLdA},
+		},
+		{
+			name: "Strsm",
+			fn: func() {
+				s.Strsm(blas.Left, blas.Upper, blas.NoTrans, 0, 3, 3, 1, make([]float32, 9), 3, make([]float32, 9), 3)
+			},
+			want: Error{Routine: "Strsm", Param: "d", Message: badDiag},
+		},
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r != test.want {
+					t.Errorf("%s: unexpected panic: got %#v want %#v", test.name, r, test.want)
+				}
+			}()
+			test.fn()
+		}()
+	}
+}
+
+// float32s returns the elements of x rounded to float32.
+func float32s(x []float64) []float32 {
+	s := make([]float32, len(x))
+	for i, v := range x {
+		s[i] = float32(v)
+	}
+	return s
+}
+
+// maxDiff32 returns the largest absolute difference between the elements
+// of x and y.
+func maxDiff32(x, y []float32) float32 {
+	var d float32
+	for i := range x {
+		if v := x[i] - y[i]; v > d {
+			d = v
+		} else if -v > d {
+			d = -v
+		}
+	}
+	return d
+}
@@ -0,0 +1,36 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+const badNumThreads = "blas: number of threads < 1"
+
+// SetNumThreads sets the number of threads used by the linked library for
+// subsequent calls. It calls openblas_set_num_threads of OpenBLAS,
+// mkl_set_num_threads of Intel MKL or bli_thread_set_num_threads of BLIS,
+// whichever the library provides, and does nothing if the library provides
+// none of them. SetNumThreads panics if n < 1.
+//
+// Programs that already parallelize their work over goroutines should
+// usually call SetNumThreads(1) so that the library does not oversubscribe
+// the processors.
+//
+// By default the functions are detected when the program starts, or with
+// the dlopen build tag, in the loaded library. With one of the build tags
+// openblas, mkl or blis only the function of that library is used, and the
+// program fails to link if the library does not provide it.
+func SetNumThreads(n int) {
+	if n < 1 {
+		panic(badNumThreads)
+	}
+	setNumThreads(min(n, maxInt))
+}
+
+// NumThreads returns the number of threads used by the linked library, as
+// reported by openblas_get_num_threads, mkl_get_max_threads or
+// bli_thread_get_num_threads. It returns zero if the library provides none
+// of them.
+func NumThreads() int {
+	return numThreads()
+}
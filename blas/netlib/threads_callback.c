@@ -0,0 +1,24 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+#include <stddef.h>
+
+#include "_cgo_export.h"
+
+// netlib_dojob_fn is openblas_dojob_callback, the function that executes
+// one job of a parallel region of OpenBLAS.
+typedef void (*netlib_dojob_fn)(int thread_num, void *jobdata, int dojob_data);
+
+// netlib_threads_callback is installed with
+// openblas_set_threads_callback_function in place of the thread pool of
+// OpenBLAS. It passes the parallel region to Go, which returns when all
+// numjobs jobs have completed, as is required whether or not sync is set.
+void netlib_threads_callback(int sync, netlib_dojob_fn dojob, int numjobs, size_t jobdata_elsize, void *jobdata, int dojob_data) {
+	netlibRunJobs((void *)dojob, numjobs, jobdata_elsize, jobdata, dojob_data);
+}
+
+// netlib_dojob executes job i of a parallel region.
+void netlib_dojob(void *dojob, int i, void *jobdata, size_t jobdata_elsize, int dojob_data) {
+	((netlib_dojob_fn)dojob)(i, (char *)jobdata + (size_t)i * jobdata_elsize, dojob_data);
+}
@@ -0,0 +1,83 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+/*
+#include <stddef.h>
+
+void netlib_dojob(void *dojob, int i, void *jobdata, size_t jobdata_elsize, int dojob_data);
+int netlib_set_threads_callback(int on);
+*/
+import "C"
+
+import (
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// Runner executes the n jobs of a parallel region of the library by calling
+// job(i) for each i in [0, n) and returns when all of them have completed.
+// The jobs of a region synchronize with each other, so a Runner must execute
+// them concurrently: running them one after the other or on a bounded pool
+// smaller than n deadlocks the library.
+type Runner func(n int, job func(i int))
+
+// GoroutineRunner is a Runner that executes job 0 on the calling goroutine
+// and each other job on a new goroutine.
+func GoroutineRunner(n int, job func(i int)) {
+	if n < 1 {
+		return
+	}
+	var wg sync.WaitGroup
+	wg.Add(n - 1)
+	for i := 1; i < n; i++ {
+		go func(i int) {
+			defer wg.Done()
+			job(i)
+		}(i)
+	}
+	job(0)
+	wg.Wait()
+}
+
+// runner holds the current Runner.
+var runner atomic.Value
+
+type runnerHolder struct {
+	r Runner
+}
+
+// SetThreadRunner makes the library execute its parallel regions with r
+// instead of its internal thread pool, so that the work of the library
+// threads runs on goroutines that are visible to the Go scheduler,
+// profiler and execution tracer, and whose CPU time is accounted to the
+// process as that of other goroutines. The number of jobs of a region is
+// still determined by SetNumThreads, which should usually be at most
+// runtime.GOMAXPROCS(0) so that the parallelism follows the CPU quota of
+// the process. A nil r restores the thread pool of the library.
+//
+// SetThreadRunner calls openblas_set_threads_callback_function, which was
+// added in OpenBLAS 0.3.27, and returns whether the library provides it. It
+// must not be called while a BLAS or LAPACK routine is running.
+func SetThreadRunner(r Runner) (ok bool) {
+	runner.Store(runnerHolder{r})
+	on := C.int(0)
+	if r != nil {
+		on = 1
+	}
+	return C.netlib_set_threads_callback(on) != 0
+}
+
+//export netlibRunJobs
+func netlibRunJobs(dojob unsafe.Pointer, n C.int, elsize C.size_t, jobdata unsafe.Pointer, data C.int) {
+	r := GoroutineRunner
+	if h, _ := runner.Load().(runnerHolder); h.r != nil {
+		r = h.r
+	}
+	r(int(n), func(i int) {
+		C.netlib_dojob(dojob, C.int(i), jobdata, elsize, data)
+	})
+}
@@ -0,0 +1,95 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build dlopen
+// +build dlopen
+
+package netlib
+
+/*
+#cgo openblas CFLAGS: -DNETLIB_THREADS=1
+#cgo mkl CFLAGS: -DNETLIB_THREADS=2
+#cgo blis CFLAGS: -DNETLIB_THREADS=3
+#include <stddef.h>
+#include <stdint.h>
+
+void *netlib_cblas_symbol(const char *name);
+
+// The thread control functions are looked up in the loaded library. The
+// openblas, mkl and blis build tags restrict the lookup to the functions of
+// that library.
+#ifndef NETLIB_THREADS
+#define NETLIB_THREADS 0
+#endif
+#define NETLIB_USE(lib) (NETLIB_THREADS == 0 || NETLIB_THREADS == (lib))
+
+static void netlib_set_num_threads(int n)
+{
+	void *fn;
+#if NETLIB_USE(1)
+	if ((fn = netlib_cblas_symbol("openblas_set_num_threads")) != NULL) {
+		((void (*)(int))fn)(n);
+		return;
+	}
+#endif
+#if NETLIB_USE(2)
+	if ((fn = netlib_cblas_symbol("MKL_Set_Num_Threads")) != NULL) {
+		((void (*)(int))fn)(n);
+		return;
+	}
+#endif
+#if NETLIB_USE(3)
+	if ((fn = netlib_cblas_symbol("bli_thread_set_num_threads")) != NULL) {
+		((void (*)(int64_t))fn)(n);
+		return;
+	}
+#endif
+}
+
+static int netlib_get_num_threads(void)
+{
+	void *fn;
+#if NETLIB_USE(1)
+	if ((fn = netlib_cblas_symbol("openblas_get_num_threads")) != NULL) {
+		return ((int (*)(void))fn)();
+	}
+#endif
+#if NETLIB_USE(2)
+	if ((fn = netlib_cblas_symbol("MKL_Get_Max_Threads")) != NULL) {
+		return ((int (*)(void))fn)();
+	}
+#endif
+#if NETLIB_USE(3)
+	if ((fn = netlib_cblas_symbol("bli_thread_get_num_threads")) != NULL) {
+		return (int)((int64_t (*)(void))fn)();
+	}
+#endif
+	return 0;
+}
+
+typedef void (*netlib_dojob_fn)(int, void *, int);
+typedef void (*netlib_threads_fn)(int, netlib_dojob_fn, int, size_t, void *, int);
+void netlib_threads_callback(int sync, netlib_dojob_fn dojob, int numjobs, size_t jobdata_elsize, void *jobdata, int dojob_data);
+
+int netlib_set_threads_callback(int on)
+{
+#if NETLIB_USE(1)
+	void *fn;
+	if ((fn = netlib_cblas_symbol("openblas_set_threads_callback_function")) != NULL) {
+		((void (*)(netlib_threads_fn))fn)(on ? netlib_threads_callback : NULL);
+		return 1;
+	}
+#endif
+	return 0;
+}
+*/
+import "C"
+
+func setNumThreads(n int) {
+	C.netlib_set_num_threads(C.int(n))
+}
+
+func numThreads() int {
+	return int(C.netlib_get_num_threads())
+}
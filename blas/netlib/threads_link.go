@@ -0,0 +1,116 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !dlopen
+// +build !dlopen
+
+package netlib
+
+/*
+#cgo openblas CFLAGS: -DNETLIB_THREADS=1
+#cgo mkl CFLAGS: -DNETLIB_THREADS=2
+#cgo blis CFLAGS: -DNETLIB_THREADS=3
+#include <stddef.h>
+#include <stdint.h>
+
+// The thread control functions of the library selected by a build tag are
+// declared strong, so that linking fails if the library lacks them. Without
+// a tag all of them are declared weak where possible and the first one the
+// library provides is used.
+#if defined(NETLIB_THREADS)
+#define NETLIB_THREADS_DECL
+#define NETLIB_PRESENT(f) 1
+#elif defined(__ELF__)
+#define NETLIB_THREADS 0
+#define NETLIB_THREADS_DECL __attribute__((weak))
+#define NETLIB_PRESENT(f) ((f) != NULL)
+#else
+#define NETLIB_THREADS -1
+#endif
+
+#define NETLIB_USE(lib) (NETLIB_THREADS == 0 || NETLIB_THREADS == (lib))
+
+#if NETLIB_USE(1)
+NETLIB_THREADS_DECL void openblas_set_num_threads(int n);
+NETLIB_THREADS_DECL int openblas_get_num_threads(void);
+#endif
+#if NETLIB_USE(2)
+NETLIB_THREADS_DECL void MKL_Set_Num_Threads(int n);
+NETLIB_THREADS_DECL int MKL_Get_Max_Threads(void);
+#endif
+#if NETLIB_USE(3)
+NETLIB_THREADS_DECL void bli_thread_set_num_threads(int64_t n);
+NETLIB_THREADS_DECL int64_t bli_thread_get_num_threads(void);
+#endif
+
+static void netlib_set_num_threads(int n)
+{
+#if NETLIB_USE(1)
+	if (NETLIB_PRESENT(openblas_set_num_threads)) {
+		openblas_set_num_threads(n);
+		return;
+	}
+#endif
+#if NETLIB_USE(2)
+	if (NETLIB_PRESENT(MKL_Set_Num_Threads)) {
+		MKL_Set_Num_Threads(n);
+		return;
+	}
+#endif
+#if NETLIB_USE(3)
+	if (NETLIB_PRESENT(bli_thread_set_num_threads)) {
+		bli_thread_set_num_threads(n);
+		return;
+	}
+#endif
+}
+
+static int netlib_get_num_threads(void)
+{
+#if NETLIB_USE(1)
+	if (NETLIB_PRESENT(openblas_get_num_threads)) {
+		return openblas_get_num_threads();
+	}
+#endif
+#if NETLIB_USE(2)
+	if (NETLIB_PRESENT(MKL_Get_Max_Threads)) {
+		return MKL_Get_Max_Threads();
+	}
+#endif
+#if NETLIB_USE(3)
+	if (NETLIB_PRESENT(bli_thread_get_num_threads)) {
+		return (int)bli_thread_get_num_threads();
+	}
+#endif
+	return 0;
+}
+
+// openblas_set_threads_callback_function is recent, so it is declared weak
+// even with the openblas build tag and only used where that is possible.
+#if NETLIB_USE(1) && defined(__ELF__)
+typedef void (*netlib_dojob_fn)(int, void *, int);
+void netlib_threads_callback(int sync, netlib_dojob_fn dojob, int numjobs, size_t jobdata_elsize, void *jobdata, int dojob_data);
+__attribute__((weak)) void openblas_set_threads_callback_function(void (*callback)(int, netlib_dojob_fn, int, size_t, void *, int));
+#endif
+
+int netlib_set_threads_callback(int on)
+{
+#if NETLIB_USE(1) && defined(__ELF__)
+	if (openblas_set_threads_callback_function != NULL) {
+		openblas_set_threads_callback_function(on ? netlib_threads_callback : NULL);
+		return 1;
+	}
+#endif
+	return 0;
+}
+*/
+import "C"
+
+func setNumThreads(n int) {
+	C.netlib_set_num_threads(C.int(n))
+}
+
+func numThreads() int {
+	return int(C.netlib_get_num_threads())
+}
@@ -0,0 +1,83 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"sync"
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func TestNumThreads(t *testing.T) {
+	func() {
+		defer func() {
+			if r := recover(); r != badNumThreads {
+				t.Errorf("unexpected panic for zero threads: %v", r)
+			}
+		}()
+		SetNumThreads(0)
+	}()
+
+	prev := NumThreads()
+	if prev == 0 {
+		// The library has no thread control, so SetNumThreads
+		// must do nothing.
+		SetNumThreads(2)
+		if n := NumThreads(); n != 0 {
+			t.Errorf("unexpected number of threads without thread control: %d", n)
+		}
+		return
+	}
+	defer SetNumThreads(prev)
+	for _, n := range []int{1, 2} {
+		SetNumThreads(n)
+		if got := NumThreads(); got != n {
+			t.Errorf("unexpected number of threads: got %d want %d", got, n)
+		}
+	}
+}
+
+func TestSetThreadRunner(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		jobs int
+	)
+	counting := func(n int, job func(i int)) {
+		mu.Lock()
+		jobs += n
+		mu.Unlock()
+		GoroutineRunner(n, job)
+	}
+	if !SetThreadRunner(counting) {
+		t.Skip("library does not provide openblas_set_threads_callback_function")
+	}
+	defer SetThreadRunner(nil)
+	prev := NumThreads()
+	defer SetNumThreads(max(prev, 1))
+	SetNumThreads(4)
+
+	const n = 256
+	a := make([]float64, n*n)
+	b := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		a[i*n+i] = 2
+		for j := 0; j < n; j++ {
+			b[i*n+j] = float64(i - j)
+		}
+	}
+	c := make([]float64, n*n)
+	impl.Dgemm(blas.NoTrans, blas.NoTrans, n, n, n, 1, a, n, b, n, 0, c, n)
+	for i, v := range c {
+		if v != 2*b[i] {
+			t.Fatalf("unexpected result at %d: got %v want %v", i, v, 2*b[i])
+		}
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if jobs == 0 {
+		t.Errorf("parallel region not executed by the runner")
+	}
+}
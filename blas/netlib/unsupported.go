@@ -0,0 +1,30 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import "fmt"
+
+// ErrUnsupported is the panic value of a call of a routine that is missing
+// from the CBLAS library loaded by a package built with the dlopen build
+// tag, or of any routine if no library can be loaded. The call does not
+// reach the library, so the panic can be recovered
+// and the routine computed by other means, for example by the native
+// implementation in gonum.org/v1/gonum/blas/gonum.
+type ErrUnsupported struct {
+	// Symbol is the name of the missing C symbol.
+	Symbol string
+	// Library is the path of the loaded library.
+	Library string
+	// Err is the reason no library could be loaded, or nil if a library
+	// was loaded.
+	Err error
+}
+
+func (e ErrUnsupported) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("blas: symbol %s not found: no library loaded: %v", e.Symbol, e.Err)
+	}
+	return fmt.Sprintf("blas: symbol %s not found in %s", e.Symbol, e.Library)
+}
@@ -0,0 +1,43 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux || freebsd || darwin
+// +build linux freebsd darwin
+
+#include <stddef.h>
+
+#include "cblas.h"
+#include "_cgo_export.h"
+
+// xerbla_name is the longest routine name passed on to Go.
+#define XERBLA_NAME 32
+
+// xerbla_report trims the blank padding of the Fortran routine name of
+// length n and passes it with the parameter position info to Go.
+static void xerbla_report(const char *name, size_t n, long long info) {
+	char buf[XERBLA_NAME + 1];
+	size_t i;
+	// Callers that do not pass the hidden Fortran length leave an
+	// arbitrary value in n, so the name is also ended by a NUL.
+	if (n == 0 || n > XERBLA_NAME) {
+		n = XERBLA_NAME;
+	}
+	for (i = 0; i < n && name[i] != '\0' && name[i] != ' '; i++) {
+		buf[i] = name[i];
+	}
+	buf[i] = '\0';
+	netlibXerbla(buf, (long long)info);
+}
+
+// xerbla_ replaces the Fortran error handler of BLAS and LAPACK, which
+// prints a message and stops the program.
+void xerbla_(const char *srname, const blasint *info, size_t len) {
+	xerbla_report(srname, len, *info);
+}
+
+// cblas_xerbla replaces the error handler of the reference CBLAS, which
+// prints a message and exits.
+void cblas_xerbla(int p, const char *rout, const char *form, ...) {
+	xerbla_report(rout, 0, p);
+}
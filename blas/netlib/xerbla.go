@@ -0,0 +1,59 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux || freebsd || darwin
+// +build linux freebsd darwin
+
+package netlib
+
+/*
+#cgo linux freebsd LDFLAGS: -Wl,--export-dynamic
+#cgo darwin LDFLAGS: -Wl,-export_dynamic
+#include <stddef.h>
+#include "cblas.h"
+
+void xerbla_(const char *srname, const blasint *info, size_t len);
+*/
+import "C"
+
+import (
+	"strconv"
+	"unsafe"
+)
+
+// badBackendParam is the message of the Error raised by the library error
+// handlers.
+const badBackendParam = "blas: illegal parameter value reported by library"
+
+// The package defines the xerbla_ and cblas_xerbla error handlers called by
+// the Fortran and C interfaces of the library when they detect an invalid
+// argument. Instead of printing a message and stopping the program as the
+// library handlers do, they panic with an Error naming the routine and the
+// position of the invalid parameter. The program symbols are exported so
+// that a shared library resolves the handlers to them rather than to its
+// own copies. The linker flags that export them are only known for ELF
+// systems and Darwin, so the handlers are not defined elsewhere and the
+// library handlers are used there.
+//
+// The panic unwinds through the C frames of the library routine, which
+// reports the error before it acquires any resource.
+
+//export netlibXerbla
+func netlibXerbla(name *C.char, info C.longlong) {
+	panic(Error{
+		Routine: C.GoString(name),
+		Param:   strconv.FormatInt(int64(info), 10),
+		Message: badBackendParam,
+	})
+}
+
+// xerbla calls the xerbla_ handler as a library routine does.
+func xerbla(name string, info int) {
+	cname := []byte(name)
+	if len(cname) == 0 {
+		cname = []byte{' '}
+	}
+	cinfo := C.blasint(info)
+	C.xerbla_((*C.char)(unsafe.Pointer(&cname[0])), &cinfo, C.size_t(len(name)))
+}
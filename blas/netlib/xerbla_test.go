@@ -0,0 +1,41 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build linux || freebsd || darwin
+// +build linux freebsd darwin
+
+package netlib
+
+import "testing"
+
+func TestXerbla(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		info int
+		want Error
+	}{
+		{name: "DGEMM ", info: 8, want: Error{Routine: "DGEMM", Param: "8", Message: badBackendParam}},
+		{name: "DGESVD", info: 2, want: Error{Routine: "DGESVD", Param: "2", Message: badBackendParam}},
+		{name: "dsyrk", info: 1, want: Error{Routine: "dsyrk", Param: "1", Message: badBackendParam}},
+	} {
+		got := func() (r interface{}) {
+			defer func() { r = recover() }()
+			xerbla(test.name, test.info)
+			return nil
+		}()
+		if got != test.want {
+			t.Errorf("unexpected panic for %q %d: got %#v want %#v", test.name, test.info, got, test.want)
+		}
+	}
+
+	err := func() (err error) {
+		defer catch("Dgemm", &err)
+		xerbla("DGEMM", 10)
+		return nil
+	}()
+	want := Error{Routine: "DGEMM", Param: "10", Message: badBackendParam}
+	if err != want {
+		t.Errorf("unexpected error: got %v want %v", err, want)
+	}
+}
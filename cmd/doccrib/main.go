@@ -0,0 +1,101 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command doccrib copies the documentation comments of a Go package onto
+// the undocumented functions and methods of the same name in Go source
+// files, such as generated bindings of an API that is documented in
+// another package.
+//
+// Usage:
+//  doccrib -from package [-types src=doc,...] [-omit text,...] [-replace] [-w] file.go...
+//
+// The package named by -from is an import path, located by the go command,
+// or a directory if it starts with "." or "/". Methods are matched by the
+// name of their receiver type, which -types maps from the names in the
+// files to those in the package. With -omit, paragraphs of the comments
+// containing one of the given strings are not copied. With -replace,
+// existing comments are replaced. The result is written to standard output
+// unless -w is given, in which case the files are rewritten.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"gonum.org/v1/netlib/doccrib"
+)
+
+var (
+	from    = flag.String("from", "", "documented package")
+	types   = flag.String("types", "", "comma-separated src=doc receiver type mappings")
+	omit    = flag.String("omit", "", "comma-separated strings whose paragraphs are not copied")
+	replace = flag.Bool("replace", false, "replace existing documentation comments")
+	write   = flag.Bool("w", false, "write the result to the files instead of standard output")
+)
+
+func main() {
+	flag.Parse()
+	if *from == "" || flag.NArg() == 0 {
+		fmt.Fprintln(os.Stderr, "usage: doccrib -from package [-types src=doc,...] [-omit text,...] [-replace] [-w] file.go...")
+		os.Exit(2)
+	}
+
+	var (
+		docs doccrib.Docs
+		err  error
+	)
+	if strings.HasPrefix(*from, ".") || strings.HasPrefix(*from, "/") {
+		docs, err = doccrib.ParseDir(*from)
+	} else {
+		docs, err = doccrib.Load(*from)
+	}
+	if err != nil {
+		fatal(err)
+	}
+
+	opts := doccrib.MergeOptions{Replace: *replace, Types: make(map[string]string)}
+	if *omit != "" {
+		opts.Omit = strings.Split(*omit, ",")
+	}
+	if *types != "" {
+		for _, m := range strings.Split(*types, ",") {
+			i := strings.Index(m, "=")
+			if i < 0 {
+				fatal(fmt.Errorf("invalid type mapping %q", m))
+			}
+			opts.Types[m[:i]] = m[i+1:]
+		}
+	}
+
+	for _, path := range flag.Args() {
+		src, err := ioutil.ReadFile(path)
+		if err != nil {
+			fatal(err)
+		}
+		out, err := doccrib.Merge(src, docs, opts)
+		if err != nil {
+			fatal(fmt.Errorf("%s: %v", path, err))
+		}
+		if !*write {
+			os.Stdout.Write(out)
+			continue
+		}
+		if bytes.Equal(src, out) {
+			continue
+		}
+		err = ioutil.WriteFile(path, out, 0664)
+		if err != nil {
+			fatal(err)
+		}
+	}
+}
+
+func fatal(err error) {
+	fmt.Fprintf(os.Stderr, "doccrib: %v\n", err)
+	os.Exit(1)
+}
@@ -0,0 +1,355 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cshared
+// +build cshared
+
+// Command libnetlib builds a shared library exposing the convenience layer of
+// gonum.org/v1/netlib/lapack/netlib through a C ABI, so that programs written
+// in other languages share the argument validation, workspace management and
+// error reporting of the Go API.
+//
+// The library is built with
+//  CGO_LDFLAGS="-L/path/to/OpenBLAS -lopenblas" go build -tags cshared -buildmode=c-shared -o libnetlib.so gonum.org/v1/netlib/cmd/libnetlib
+// which also writes the C header libnetlib.h. The cshared build tag is
+// required so that the command is not built as an ordinary executable.
+//
+// All matrices are stored in row-major order with the given leading
+// dimension. Every function returns one of the NETLIB_* status codes
+// declared in the header; netlib_strerror returns a description of a code.
+// Invalid arguments are reported with NETLIB_EINVAL rather than aborting the
+// calling program.
+//
+// The library exports netlib_solve, netlib_cholesky, netlib_symeig,
+// netlib_pinv, netlib_lstsq, netlib_inverse, netlib_slogdet and netlib_nnls,
+// which call SolveExpert, CholeskySPD, SymEig, PInv, LeastSquaresSVD,
+// Inverse, SlogDet and NNLS and allocate the workspace they need. The
+// results are copied into arrays provided by the caller. The other functions
+// of the convenience layer are not exported.
+package main
+
+/*
+#include <stddef.h>
+
+enum {
+	NETLIB_OK = 0,
+	NETLIB_EINVAL = -1,
+	NETLIB_SINGULAR = 1,
+	NETLIB_ILL_CONDITIONED = 2,
+	NETLIB_NOT_POSITIVE_DEFINITE = 3,
+	NETLIB_ITERATION_LIMIT = 4,
+};
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+
+	blasnetlib "gonum.org/v1/netlib/blas/netlib"
+	"gonum.org/v1/netlib/lapack/netlib"
+)
+
+// maxLen is the largest array length that can be addressed from C.
+const maxLen = 1 << 40
+
+// errInvalid is the panic value for invalid arguments detected by the
+// ABI layer itself.
+type errInvalid struct{}
+
+// doubles returns a slice backed by the C array p of length n.
+func doubles(p *C.double, n int) []float64 {
+	if n < 0 {
+		panic(errInvalid{})
+	}
+	if n == 0 {
+		return nil
+	}
+	if p == nil {
+		panic(errInvalid{})
+	}
+	return (*[maxLen]float64)(unsafe.Pointer(p))[:n:n]
+}
+
+// general returns the r×c matrix stored at p with leading dimension ld.
+func general(p *C.double, r, c, ld int) blas64.General {
+	if r < 0 || c < 0 || ld < max(1, c) {
+		panic(errInvalid{})
+	}
+	var n int
+	if r > 0 && c > 0 {
+		n = (r-1)*ld + c
+	}
+	return blas64.General{Rows: r, Cols: c, Stride: ld, Data: doubles(p, n)}
+}
+
+// copyGeneral copies src into dst.
+func copyGeneral(dst, src blas64.General) {
+	for i := 0; i < src.Rows; i++ {
+		copy(dst.Data[i*dst.Stride:i*dst.Stride+src.Cols], src.Data[i*src.Stride:i*src.Stride+src.Cols])
+	}
+}
+
+// status converts the error returned by the convenience layer into a status
+// code.
+func status(err error) C.int {
+	switch err := err.(type) {
+	case nil:
+		return C.NETLIB_OK
+	case netlib.SingularError:
+		return C.NETLIB_SINGULAR
+	case netlib.Condition:
+		return C.NETLIB_ILL_CONDITIONED
+	case netlib.ErrNotPositiveDefinite:
+		return C.NETLIB_NOT_POSITIVE_DEFINITE
+	default:
+		if err == netlib.ErrIterationLimit {
+			return C.NETLIB_ITERATION_LIMIT
+		}
+	}
+	panic(err)
+}
+
+// guard converts a panic caused by invalid arguments into NETLIB_EINVAL.
+// Panics with other values are not recovered.
+func guard(code *C.int) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	switch r.(type) {
+	case string, errInvalid, netlib.Error, blasnetlib.Error:
+		*code = C.NETLIB_EINVAL
+	default:
+		panic(r)
+	}
+}
+
+// messages holds the descriptions of the status codes. The strings are
+// allocated once and never freed.
+var messages = map[C.int]*C.char{
+	C.NETLIB_OK:                    C.CString("success"),
+	C.NETLIB_EINVAL:                C.CString("invalid argument"),
+	C.NETLIB_SINGULAR:              C.CString("matrix is singular"),
+	C.NETLIB_ILL_CONDITIONED:       C.CString("matrix is singular to working precision"),
+	C.NETLIB_NOT_POSITIVE_DEFINITE: C.CString("matrix is not positive definite"),
+	C.NETLIB_ITERATION_LIMIT:       C.CString("iteration limit reached"),
+}
+
+var unknownStatus = C.CString("unknown status code")
+
+// netlib_strerror returns a description of the status code. The returned
+// string must not be modified or freed.
+//
+//export netlib_strerror
+func netlib_strerror(code C.int) *C.char {
+	if msg, ok := messages[code]; ok {
+		return msg
+	}
+	return unknownStatus
+}
+
+// netlib_solve solves op(A) * X = B for the n×n matrix A using the LU based
+// expert driver. trans is 'N' or 'T'. If equilibrate is nonzero, A is scaled
+// before it is factorized when this improves its condition. The reciprocal
+// condition number of A is stored in rcond if it is not NULL.
+//
+//export netlib_solve
+func netlib_solve(trans C.char, n, nrhs C.int, a *C.double, lda C.int, b *C.double, ldb C.int, x *C.double, ldx C.int, equilibrate C.int, rcond *C.double) (code C.int) {
+	defer guard(&code)
+	am := general(a, int(n), int(n), int(lda))
+	bm := general(b, int(n), int(nrhs), int(ldb))
+	xm := general(x, int(n), int(nrhs), int(ldx))
+	sol, info, err := netlib.SolveExpert(blas.Transpose(trans), am, bm, equilibrate != 0)
+	if rcond != nil {
+		*rcond = C.double(info.RCond)
+	}
+	if _, ok := err.(netlib.SingularError); !ok {
+		copyGeneral(xm, sol)
+	}
+	return status(err)
+}
+
+// netlib_cholesky computes the Cholesky factor of the symmetric positive
+// definite n×n matrix A, referencing the triangle given by uplo ('U' or 'L'),
+// and stores it into the same triangle of t. If max_tries is positive, a
+// failed factorization is retried up to max_tries times with a ridge added to
+// the diagonal, starting at ridge_init and growing tenfold; the ridge used is
+// stored in ridge if it is not NULL. If A is not positive definite, the
+// zero-based index of the failing pivot is stored in index if it is not NULL.
+//
+//export netlib_cholesky
+func netlib_cholesky(uplo C.char, n C.int, a *C.double, lda C.int, t *C.double, ldt C.int, ridgeInit C.double, maxTries C.int, ridge *C.double, index *C.int) (code C.int) {
+	defer guard(&code)
+	am := general(a, int(n), int(n), int(lda))
+	tm := general(t, int(n), int(n), int(ldt))
+	var jitter *netlib.Jitter
+	if maxTries > 0 {
+		jitter = &netlib.Jitter{Ridge: float64(ridgeInit), MaxTries: int(maxTries)}
+	}
+	f, r, err := netlib.CholeskySPD(blas64.Symmetric{
+		Uplo:   blas.Uplo(uplo),
+		N:      am.Rows,
+		Stride: am.Stride,
+		Data:   am.Data,
+	}, jitter)
+	if ridge != nil {
+		*ridge = C.double(r)
+	}
+	if e, ok := err.(netlib.ErrNotPositiveDefinite); ok && index != nil {
+		*index = C.int(e.Index)
+	}
+	if err == nil {
+		for i := 0; i < f.N; i++ {
+			lo, hi := 0, i+1
+			if f.Uplo == blas.Upper {
+				lo, hi = i, f.N
+			}
+			copy(tm.Data[i*tm.Stride+lo:i*tm.Stride+hi], f.Data[i*f.Stride+lo:i*f.Stride+hi])
+		}
+	}
+	return status(err)
+}
+
+// netlib_nnls solves the nonnegative least squares problem
+// min ||A*x - b||_2 subject to x >= 0 for the m×n matrix A. b has length m
+// and x has length n. The residual norm is stored in rnorm if it is not NULL.
+//
+//export netlib_nnls
+func netlib_nnls(m, n C.int, a *C.double, lda C.int, b *C.double, x *C.double, rnorm *C.double) (code C.int) {
+	defer guard(&code)
+	am := general(a, int(m), int(n), int(lda))
+	bs := doubles(b, int(m))
+	xs := doubles(x, int(n))
+	sol, r, err := netlib.NNLS(am, bs)
+	copy(xs, sol)
+	if rnorm != nil {
+		*rnorm = C.double(r)
+	}
+	return status(err)
+}
+
+// netlib_symeig computes the eigenvalues of the symmetric n×n matrix A,
+// referencing the triangle given by uplo ('U' or 'L'), in ascending order
+// into w of length n and the corresponding orthonormal eigenvectors into
+// the columns of v.
+//
+//export netlib_symeig
+func netlib_symeig(uplo C.char, n C.int, a *C.double, lda C.int, w *C.double, v *C.double, ldv C.int) (code C.int) {
+	defer guard(&code)
+	am := general(a, int(n), int(n), int(lda))
+	ws := doubles(w, int(n))
+	vm := general(v, int(n), int(n), int(ldv))
+	vals, vecs, err := netlib.SymEig(blas64.Symmetric{
+		Uplo:   blas.Uplo(uplo),
+		N:      am.Rows,
+		Stride: am.Stride,
+		Data:   am.Data,
+	})
+	if err == nil {
+		copy(ws, vals)
+		copyGeneral(vm, vecs)
+	}
+	return status(err)
+}
+
+// netlib_pinv computes the n×m pseudo-inverse of the m×n matrix A into p.
+// Singular values less than or equal to rcond times the largest singular
+// value are treated as zero. The effective rank of A is stored in rank if
+// it is not NULL.
+//
+//export netlib_pinv
+func netlib_pinv(m, n C.int, a *C.double, lda C.int, rcond C.double, p *C.double, ldp C.int, rank *C.int) (code C.int) {
+	defer guard(&code)
+	am := general(a, int(m), int(n), int(lda))
+	pm := general(p, int(n), int(m), int(ldp))
+	pinv, r, err := netlib.PInv(am, float64(rcond))
+	if err == nil {
+		copyGeneral(pm, pinv)
+		if rank != nil {
+			*rank = C.int(r)
+		}
+	}
+	return status(err)
+}
+
+// netlib_lstsq computes the minimum norm solution X of min ||A*X - B||_F
+// for the m×n matrix A, which may be rank deficient, into the n×nrhs matrix
+// x. Singular values less than or equal to rcond times the largest singular
+// value are treated as zero; if rcond is negative, a multiple of the machine
+// epsilon is used. The singular values of A are stored in decreasing order
+// in s of length min(m, n) if it is not NULL, and the effective rank of A in
+// rank if it is not NULL.
+//
+//export netlib_lstsq
+func netlib_lstsq(m, n, nrhs C.int, a *C.double, lda C.int, b *C.double, ldb C.int, rcond C.double, x *C.double, ldx C.int, s *C.double, rank *C.int) (code C.int) {
+	defer guard(&code)
+	am := general(a, int(m), int(n), int(lda))
+	bm := general(b, int(m), int(nrhs), int(ldb))
+	xm := general(x, int(n), int(nrhs), int(ldx))
+	var ss []float64
+	if s != nil {
+		ss = doubles(s, min(int(m), int(n)))
+	}
+	sol, sv, r, err := netlib.LeastSquaresSVD(am, bm, float64(rcond))
+	if err == nil {
+		copyGeneral(xm, sol)
+		copy(ss, sv)
+		if rank != nil {
+			*rank = C.int(r)
+		}
+	}
+	return status(err)
+}
+
+// netlib_inverse computes the inverse of the n×n matrix A into inv. If A is
+// exactly singular, NETLIB_SINGULAR is returned and inv is not modified.
+//
+//export netlib_inverse
+func netlib_inverse(n C.int, a *C.double, lda C.int, inv *C.double, ldinv C.int) (code C.int) {
+	defer guard(&code)
+	am := general(a, int(n), int(n), int(lda))
+	im := general(inv, int(n), int(n), int(ldinv))
+	ainv, err := netlib.Inverse(am)
+	if err == nil {
+		copyGeneral(im, ainv)
+	}
+	return status(err)
+}
+
+// netlib_slogdet computes the sign and the natural logarithm of the absolute
+// value of the determinant of the n×n matrix A, so that
+// det(A) = sign * exp(logabsdet). If A is exactly singular, sign is 0 and
+// logabsdet is -infinity.
+//
+//export netlib_slogdet
+func netlib_slogdet(n C.int, a *C.double, lda C.int, sign, logAbsDet *C.double) (code C.int) {
+	defer guard(&code)
+	am := general(a, int(n), int(n), int(lda))
+	if sign == nil || logAbsDet == nil {
+		panic(errInvalid{})
+	}
+	sg, ld := netlib.SlogDet(am)
+	*sign = C.double(sg)
+	*logAbsDet = C.double(ld)
+	return C.NETLIB_OK
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func main() {}
@@ -0,0 +1,125 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cshared
+// +build cshared
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// smoke is a C program calling the exported functions through the
+// generated header. It exits with a nonzero status after printing the first
+// unexpected result.
+const smoke = `
+#include <math.h>
+#include <stdio.h>
+#include <string.h>
+#include "libnetlib.h"
+
+#define CHECK(cond) do { if (!(cond)) { fprintf(stderr, "line %d: %s\n", __LINE__, #cond); return 1; } } while (0)
+#define CLOSE(x, y) (fabs((x) - (y)) < 1e-12)
+
+int main(void)
+{
+	CHECK(strcmp(netlib_strerror(NETLIB_OK), "success") == 0);
+	CHECK(strcmp(netlib_strerror(NETLIB_EINVAL), "invalid argument") == 0);
+	CHECK(strcmp(netlib_strerror(42), "unknown status code") == 0);
+
+	double a[4] = {4, 1, 1, 3};
+	double b[2] = {1, 2};
+	double x[2];
+	double rcond;
+
+	// Invalid arguments are reported without calling the library.
+	CHECK(netlib_solve('N', -1, 1, a, 2, b, 1, x, 1, 0, NULL) == NETLIB_EINVAL);
+	CHECK(netlib_solve('N', 2, 1, a, 1, b, 1, x, 1, 0, NULL) == NETLIB_EINVAL);
+	CHECK(netlib_solve('N', 2, 1, NULL, 2, b, 1, x, 1, 0, NULL) == NETLIB_EINVAL);
+	CHECK(netlib_symeig('X', 2, a, 2, x, a, 2) == NETLIB_EINVAL);
+
+	CHECK(netlib_solve('N', 2, 1, a, 2, b, 1, x, 1, 1, &rcond) == NETLIB_OK);
+	CHECK(CLOSE(x[0], 1.0 / 11) && CLOSE(x[1], 7.0 / 11));
+	CHECK(rcond > 0 && rcond <= 1);
+
+	double inv[4];
+	CHECK(netlib_inverse(2, a, 2, inv, 2) == NETLIB_OK);
+	CHECK(CLOSE(inv[0], 3.0 / 11) && CLOSE(inv[1], -1.0 / 11) && CLOSE(inv[2], -1.0 / 11) && CLOSE(inv[3], 4.0 / 11));
+	double singular[4] = {1, 2, 2, 4};
+	CHECK(netlib_inverse(2, singular, 2, inv, 2) == NETLIB_SINGULAR);
+
+	double sign, logdet;
+	CHECK(netlib_slogdet(2, a, 2, &sign, &logdet) == NETLIB_OK);
+	CHECK(sign == 1 && CLOSE(logdet, log(11)));
+
+	double w[2], v[4];
+	CHECK(netlib_symeig('U', 2, a, 2, w, v, 2) == NETLIB_OK);
+	CHECK(CLOSE(w[0], (7 - sqrt(5)) / 2) && CLOSE(w[1], (7 + sqrt(5)) / 2));
+
+	double p[4];
+	int rank;
+	CHECK(netlib_pinv(2, 2, singular, 2, 1e-10, p, 2, &rank) == NETLIB_OK);
+	CHECK(rank == 1 && CLOSE(p[0], 1.0 / 25) && CLOSE(p[1], 2.0 / 25));
+
+	double s[2];
+	CHECK(netlib_lstsq(2, 2, 1, a, 2, b, 1, -1, x, 1, s, &rank) == NETLIB_OK);
+	CHECK(rank == 2 && CLOSE(x[0], 1.0 / 11) && CLOSE(x[1], 7.0 / 11));
+
+	double t[4] = {0};
+	double ridge;
+	CHECK(netlib_cholesky('L', 2, a, 2, t, 2, 0, 0, &ridge, NULL) == NETLIB_OK);
+	CHECK(CLOSE(t[0], 2) && CLOSE(t[2], 0.5) && CLOSE(t[3], sqrt(2.75)));
+
+	double nb[2] = {-1, 1};
+	double nrm;
+	CHECK(netlib_nnls(2, 2, a, 2, nb, x, &nrm) == NETLIB_OK);
+	CHECK(x[0] >= 0 && x[1] >= 0);
+	return 0;
+}
+`
+
+// TestSmoke builds the package as a shared library and calls it from C
+// through the generated header. The test is skipped if the library cannot
+// be built, for example because no LAPACK library is available to link.
+func TestSmoke(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shared library test not supported on windows")
+	}
+	dir, err := ioutil.TempDir("", "libnetlib")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	lib := filepath.Join(dir, "libnetlib.so")
+	out, err := exec.Command("go", "build", "-tags", "cshared", "-buildmode=c-shared", "-o", lib, ".").CombinedOutput()
+	if err != nil {
+		t.Skipf("could not build shared library: %v\n%s", err, out)
+	}
+	src := filepath.Join(dir, "smoke.c")
+	err = ioutil.WriteFile(src, []byte(smoke), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cc, err := exec.Command("go", "env", "CC").Output()
+	if err != nil {
+		t.Skipf("no C compiler: %v", err)
+	}
+	bin := filepath.Join(dir, "smoke")
+	out, err = exec.Command(strings.TrimSpace(string(cc)), "-o", bin, src, "-I", dir, "-L", dir, "-lnetlib", "-Wl,-rpath,"+dir, "-lm").CombinedOutput()
+	if err != nil {
+		t.Fatalf("could not build smoke test: %v\n%s", err, out)
+	}
+	out, err = exec.Command(bin).CombinedOutput()
+	if err != nil {
+		t.Errorf("smoke test failed: %v\n%s", err, out)
+	}
+}
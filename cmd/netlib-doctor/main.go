@@ -0,0 +1,168 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Command netlib-doctor diagnoses the BLAS and LAPACKE installation used by
+// the netlib packages and suggests how to fix the problems it finds.
+//
+// The command does not use cgo itself, so it runs even when the netlib
+// packages cannot be built. It
+//  - checks that cgo is enabled and that a C compiler is available,
+//  - reports the cgo flags set in the environment,
+//  - queries pkg-config for the known BLAS and LAPACKE packages,
+//  - looks for the libraries in the dynamic linker cache and in the
+//    library search path of the platform,
+//  - compiles, links and runs a small self-test program against each
+//    candidate library, calling cblas_ddot and LAPACKE_dgesv.
+//
+// Usage:
+//  netlib-doctor [-v] [-cc compiler]
+//
+// The exit status is zero if at least one candidate passed the self-test.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+var (
+	verbose = flag.Bool("v", false, "print the output of failed commands")
+	ccFlag  = flag.String("cc", "", "C compiler used for trial links (default: go env CC)")
+)
+
+// status is the outcome of a check.
+type status int
+
+const (
+	ok status = iota
+	warn
+	fail
+)
+
+func (s status) String() string {
+	switch s {
+	case ok:
+		return "ok"
+	case warn:
+		return "warn"
+	default:
+		return "FAIL"
+	}
+}
+
+// reporter prints the results of checks.
+type reporter struct {
+	w     io.Writer
+	fixes []string
+}
+
+// report prints the result of a check.
+func (r *reporter) report(s status, format string, args ...interface{}) {
+	fmt.Fprintf(r.w, "[%-4s] %s\n", s, fmt.Sprintf(format, args...))
+}
+
+// detail prints indented additional output.
+func (r *reporter) detail(text string) {
+	for _, l := range strings.Split(strings.TrimRight(text, "\n"), "\n") {
+		fmt.Fprintf(r.w, "         %s\n", l)
+	}
+}
+
+// fix records a suggested fix, printed at the end of the report.
+func (r *reporter) fix(format string, args ...interface{}) {
+	f := fmt.Sprintf(format, args...)
+	for _, v := range r.fixes {
+		if v == f {
+			return
+		}
+	}
+	r.fixes = append(r.fixes, f)
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: netlib-doctor [-v] [-cc compiler]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	r := &reporter{w: os.Stdout}
+	cc := checkToolchain(r)
+	checkEnv(r)
+	candidates := checkPkgConfig(r)
+	found := checkLibraries(r)
+	candidates = append(candidates, defaultCandidates(found)...)
+
+	passed := 0
+	if cc != "" {
+		passed = trialLinks(r, cc, candidates)
+	}
+
+	if len(r.fixes) != 0 {
+		fmt.Fprintln(r.w, "\nSuggested fixes:")
+		for _, f := range r.fixes {
+			fmt.Fprintf(r.w, "  - %s\n", f)
+		}
+	}
+	if passed == 0 {
+		fmt.Fprintln(r.w, "\nNo working BLAS/LAPACKE library was found.")
+		os.Exit(1)
+	}
+}
+
+// goEnv returns the value of a go environment variable.
+func goEnv(key string) string {
+	out, err := exec.Command("go", "env", key).Output()
+	if err != nil {
+		return os.Getenv(key)
+	}
+	return strings.TrimSpace(string(out))
+}
+
+// checkToolchain checks that cgo is enabled and returns the C compiler, or
+// the empty string if none is usable.
+func checkToolchain(r *reporter) string {
+	if goEnv("CGO_ENABLED") != "1" {
+		r.report(fail, "cgo is disabled")
+		r.fix("enable cgo with CGO_ENABLED=1; the netlib packages are cgo bindings")
+	} else {
+		r.report(ok, "cgo is enabled")
+	}
+
+	cc := *ccFlag
+	if cc == "" {
+		cc = goEnv("CC")
+	}
+	if cc == "" {
+		cc = "gcc"
+	}
+	fields := strings.Fields(cc)
+	path, err := exec.LookPath(fields[0])
+	if err != nil {
+		r.report(fail, "C compiler %q not found", cc)
+		r.fix("install a C compiler (gcc or clang) or set CC to its path")
+		return ""
+	}
+	r.report(ok, "C compiler: %s", path)
+	return cc
+}
+
+// checkEnv reports the cgo flags set in the environment.
+func checkEnv(r *reporter) {
+	for _, key := range []string{"CGO_CFLAGS", "CGO_LDFLAGS"} {
+		v := os.Getenv(key)
+		if v == "" {
+			r.report(warn, "%s is not set", key)
+			continue
+		}
+		r.report(ok, "%s=%s", key, v)
+	}
+	if os.Getenv("CGO_LDFLAGS") == "" {
+		r.fix(`set CGO_LDFLAGS to link a library (e.g. CGO_LDFLAGS="-L/path/to/lib -lopenblas"), or import one of gonum.org/v1/netlib/openblas, /mkl or /reference`)
+	}
+}
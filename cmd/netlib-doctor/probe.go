@@ -0,0 +1,308 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// candidate is a set of linker flags that may provide CBLAS and LAPACKE.
+type candidate struct {
+	name    string
+	ldflags []string
+}
+
+// pkgConfigPackages are the pkg-config packages queried for linker flags.
+var pkgConfigPackages = []string{"openblas", "mkl-dynamic-lp64-seq", "cblas", "lapacke", "blas", "lapack"}
+
+// checkPkgConfig queries pkg-config and returns the candidates it knows of.
+func checkPkgConfig(r *reporter) []candidate {
+	if _, err := exec.LookPath("pkg-config"); err != nil {
+		r.report(warn, "pkg-config not found")
+		return nil
+	}
+	libs := make(map[string][]string)
+	for _, p := range pkgConfigPackages {
+		out, err := exec.Command("pkg-config", "--libs", p).Output()
+		if err != nil {
+			continue
+		}
+		flags := strings.Fields(string(out))
+		libs[p] = flags
+		r.report(ok, "pkg-config %s: %s", p, strings.Join(flags, " "))
+	}
+	if len(libs) == 0 {
+		r.report(warn, "pkg-config knows none of %s", strings.Join(pkgConfigPackages, ", "))
+		if os.Getenv("PKG_CONFIG_PATH") == "" {
+			r.fix("if the libraries are installed in a non-standard prefix, add its lib/pkgconfig directory to PKG_CONFIG_PATH")
+		}
+	}
+
+	var c []candidate
+	if flags, ok := libs["openblas"]; ok {
+		c = append(c, candidate{name: "pkg-config openblas", ldflags: flags})
+	}
+	if flags, ok := libs["mkl-dynamic-lp64-seq"]; ok {
+		c = append(c, candidate{name: "pkg-config mkl-dynamic-lp64-seq", ldflags: flags})
+	}
+	if cblas, ok := libs["cblas"]; ok {
+		if lapacke, ok := libs["lapacke"]; ok {
+			c = append(c, candidate{name: "pkg-config lapacke cblas", ldflags: append(lapacke, cblas...)})
+		}
+	}
+	return c
+}
+
+// libraries are the base names of the libraries searched for.
+var libraries = []string{"openblas", "mkl_rt", "cblas", "lapacke", "blas", "lapack"}
+
+// checkLibraries looks for the libraries in the dynamic linker cache and the
+// library search path and returns the directory of each library found. The
+// directory is empty for libraries found in the linker cache.
+func checkLibraries(r *reporter) map[string]string {
+	found := make(map[string]string)
+	if runtime.GOOS == "linux" {
+		out, err := exec.Command("ldconfig", "-p").Output()
+		if err != nil {
+			out, err = exec.Command("/sbin/ldconfig", "-p").Output()
+		}
+		if err == nil {
+			for _, lib := range libraries {
+				if bytes.Contains(out, []byte("lib"+lib+".so")) {
+					found[lib] = ""
+					r.report(ok, "lib%s found in the dynamic linker cache", lib)
+				}
+			}
+		} else {
+			r.report(warn, "could not read the dynamic linker cache: %v", err)
+		}
+	}
+
+	pathVar, dirs := searchPath()
+	for _, dir := range dirs {
+		entries, err := ioutil.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, lib := range libraries {
+			if _, ok := found[lib]; ok {
+				continue
+			}
+			for _, e := range entries {
+				if isLibrary(e.Name(), lib) {
+					found[lib] = dir
+					r.report(ok, "%s found in %s", e.Name(), dir)
+					break
+				}
+			}
+		}
+	}
+
+	if len(found) == 0 {
+		r.report(fail, "no BLAS or LAPACKE library found in the linker cache or %s", pathVar)
+		r.fix("install OpenBLAS (e.g. apt install libopenblas-dev, brew install openblas) or add the directory holding the libraries to " + pathVar)
+		return found
+	}
+	if _, ok := found["lapacke"]; !ok {
+		if _, ok := found["openblas"]; !ok {
+			if _, ok := found["mkl_rt"]; !ok {
+				r.report(warn, "no library providing LAPACKE found")
+				r.fix("install LAPACKE (e.g. apt install liblapacke-dev) or use OpenBLAS, which includes it")
+			}
+		}
+	}
+	return found
+}
+
+// searchPath returns the name of the environment variable holding the
+// library search path of the platform and the directories to search.
+func searchPath() (string, []string) {
+	var name string
+	var dirs []string
+	switch runtime.GOOS {
+	case "windows":
+		name = "PATH"
+	case "darwin":
+		name = "DYLD_LIBRARY_PATH"
+		dirs = []string{"/usr/local/lib", "/usr/local/opt/openblas/lib", "/opt/homebrew/lib", "/opt/homebrew/opt/openblas/lib"}
+	default:
+		name = "LD_LIBRARY_PATH"
+		dirs = []string{"/usr/local/lib", "/opt/OpenBLAS/lib", "/opt/intel/oneapi/mkl/latest/lib/intel64"}
+	}
+	return name, append(filepath.SplitList(os.Getenv(name)), dirs...)
+}
+
+// isLibrary reports whether the file name is a shared or static library
+// with the given base name.
+func isLibrary(name, lib string) bool {
+	switch runtime.GOOS {
+	case "windows":
+		name = strings.ToLower(name)
+		return strings.HasSuffix(name, ".dll") &&
+			(strings.HasPrefix(name, "lib"+lib) || strings.HasPrefix(name, lib))
+	case "darwin":
+		return strings.HasPrefix(name, "lib"+lib+".") && strings.HasSuffix(name, ".dylib")
+	}
+	return strings.HasPrefix(name, "lib"+lib+".so") || name == "lib"+lib+".a"
+}
+
+// defaultCandidates returns the candidates derived from the environment and
+// from the libraries found on the system.
+func defaultCandidates(found map[string]string) []candidate {
+	var c []candidate
+	if v := os.Getenv("CGO_LDFLAGS"); v != "" {
+		c = append(c, candidate{name: "CGO_LDFLAGS", ldflags: strings.Fields(v)})
+	}
+	withDir := func(lib string, flags ...string) []string {
+		if dir := found[lib]; dir != "" {
+			return append([]string{"-L" + dir}, flags...)
+		}
+		return flags
+	}
+	if _, ok := found["openblas"]; ok {
+		c = append(c, candidate{name: "openblas", ldflags: withDir("openblas", "-lopenblas")})
+	}
+	if _, ok := found["mkl_rt"]; ok {
+		c = append(c, candidate{name: "mkl", ldflags: withDir("mkl_rt", "-lmkl_rt")})
+	}
+	_, cblas := found["cblas"]
+	_, lapacke := found["lapacke"]
+	if lapacke && cblas {
+		flags := withDir("lapacke", "-llapacke", "-llapack")
+		flags = append(flags, withDir("cblas", "-lcblas", "-lblas", "-lgfortran")...)
+		c = append(c, candidate{name: "reference", ldflags: flags})
+	}
+	return c
+}
+
+const selfTest = `#include <math.h>
+#include <stdio.h>
+
+double cblas_ddot(const int n, const double *x, const int incx, const double *y, const int incy);
+int LAPACKE_dgesv(int layout, int n, int nrhs, double *a, int lda, int *ipiv, double *b, int ldb);
+
+int main(void) {
+	double x[3] = {1, 2, 3}, y[3] = {4, 5, 6};
+	double a[4] = {4, 3, 6, 3}, b[2] = {10, 12};
+	int ipiv[2];
+
+	if (cblas_ddot(3, x, 1, y, 1) != 32) {
+		printf("cblas_ddot returned a wrong result\n");
+		return 1;
+	}
+	if (LAPACKE_dgesv(101, 2, 1, a, 2, ipiv, b, 1) != 0) {
+		printf("LAPACKE_dgesv failed\n");
+		return 1;
+	}
+	if (fabs(b[0] - 1) > 1e-12 || fabs(b[1] - 2) > 1e-12) {
+		printf("LAPACKE_dgesv returned a wrong result\n");
+		return 1;
+	}
+	printf("ok\n");
+	return 0;
+}
+`
+
+// trialLinks compiles, links and runs the self-test against each candidate
+// and returns the number of candidates that passed.
+func trialLinks(r *reporter, cc string, candidates []candidate) int {
+	if len(candidates) == 0 {
+		return 0
+	}
+	dir, err := ioutil.TempDir("", "netlib-doctor")
+	if err != nil {
+		r.report(fail, "could not create temporary directory: %v", err)
+		return 0
+	}
+	defer os.RemoveAll(dir)
+	src := filepath.Join(dir, "selftest.c")
+	err = ioutil.WriteFile(src, []byte(selfTest), 0644)
+	if err != nil {
+		r.report(fail, "could not write self-test: %v", err)
+		return 0
+	}
+	exe := filepath.Join(dir, "selftest")
+	if runtime.GOOS == "windows" {
+		exe += ".exe"
+	}
+
+	var passed int
+	seen := make(map[string]bool)
+	for _, c := range candidates {
+		flags := strings.Join(c.ldflags, " ")
+		if seen[flags] {
+			continue
+		}
+		seen[flags] = true
+
+		args := strings.Fields(cc)
+		args = append(args, "-o", exe, src)
+		args = append(args, strings.Fields(os.Getenv("CGO_CFLAGS"))...)
+		args = append(args, c.ldflags...)
+		args = append(args, "-lm")
+		out, err := exec.Command(args[0], args[1:]...).CombinedOutput()
+		if err != nil {
+			r.report(fail, "%s (%s): trial link failed", c.name, flags)
+			if *verbose {
+				r.detail(string(out))
+			}
+			diagnoseLink(r, c, string(out))
+			continue
+		}
+
+		out, err = exec.Command(exe).CombinedOutput()
+		if err != nil || strings.TrimSpace(string(out)) != "ok" {
+			r.report(fail, "%s (%s): self-test failed", c.name, flags)
+			r.detail(string(out))
+			diagnoseRun(r, c, string(out))
+			continue
+		}
+		r.report(ok, "%s (%s): self-test passed", c.name, flags)
+		passed++
+		if c.name != "CGO_LDFLAGS" && os.Getenv("CGO_LDFLAGS") == "" {
+			r.fix(`build with CGO_LDFLAGS="` + flags + `"`)
+		}
+	}
+	return passed
+}
+
+// diagnoseLink records fixes for a failed trial link.
+func diagnoseLink(r *reporter, c candidate, out string) {
+	switch {
+	case strings.Contains(out, "cannot find -l") || strings.Contains(out, "library not found"):
+		r.fix("a library named in " + c.name + " is not in the linker search path: add -L/path/to/lib to CGO_LDFLAGS")
+	case strings.Contains(out, "cblas_ddot"):
+		r.fix("the libraries of " + c.name + " do not provide the CBLAS interface: link libcblas as well, or rebuild OpenBLAS with CBLAS enabled")
+	case strings.Contains(out, "LAPACKE_dgesv"):
+		r.fix("the libraries of " + c.name + " do not provide LAPACKE: link liblapacke as well, or rebuild OpenBLAS without NO_LAPACKE")
+	case strings.Contains(out, "undefined reference to `_gfortran") || strings.Contains(out, "_gfortran_"):
+		r.fix("the libraries of " + c.name + " need the Fortran runtime: add -lgfortran to CGO_LDFLAGS")
+	default:
+		r.fix("run netlib-doctor -v to see the linker output for " + c.name)
+	}
+}
+
+// diagnoseRun records fixes for a self-test that linked but failed to run
+// or returned wrong results.
+func diagnoseRun(r *reporter, c candidate, out string) {
+	pathVar, _ := searchPath()
+	switch {
+	case strings.Contains(out, "error while loading shared libraries") ||
+		strings.Contains(out, "Library not loaded") ||
+		strings.Contains(out, "not found"):
+		r.fix("the dynamic loader cannot find a library of " + c.name + " at run time: add its directory to " + pathVar +
+			", run ldconfig after installing it, or link with -Wl,-rpath,/path/to/lib")
+	case strings.Contains(out, "wrong result"):
+		r.fix(c.name + " returned wrong results: check that the library uses 32-bit integers (LP64), not ILP64")
+	default:
+		r.fix("the self-test against " + c.name + " crashed: check that CBLAS and LAPACKE come from matching builds")
+	}
+}
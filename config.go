@@ -0,0 +1,142 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package netlib configures the process-wide defaults of the netlib
+// packages in one place. The defaults are otherwise held by the packages
+// that consume them, the tracing of calls by gonum.org/v1/netlib/diag, the
+// admission controller by gonum.org/v1/netlib/admission and the thread count
+// by the linked library, and a program that sets several of them would
+// have to know which package owns each one:
+//
+//	netlib.Configure(
+//		netlib.WithTracer(diag.LogHandler(logger), diag.Errors),
+//		netlib.WithThreads(1),
+//		netlib.WithAdmission(admission.New(cfg)),
+//	)
+//
+// Configure only changes the defaults named by its options, and the setters
+// of the owning packages remain available for code that changes one default
+// temporarily. The defaults are not overridden per Implementation: the
+// Implementation types of the BLAS and LAPACK packages have no fields, so
+// every call uses the defaults current when it starts. The storage order
+// and the handling of invalid arguments are fixed by each package and are
+// not configured here.
+//
+// SingleThreaded limits the library to one thread for the duration of a
+// function, for sections that are parallelized by the caller.
+package netlib // import "gonum.org/v1/netlib"
+
+import (
+	"gonum.org/v1/netlib/admission"
+	"gonum.org/v1/netlib/diag"
+)
+
+const (
+	badLevel   = "netlib: invalid trace level"
+	badThreads = "netlib: number of threads < 1"
+	badFPTrap  = "netlib: invalid floating-point exception"
+)
+
+// Option is a default set by Configure.
+type Option func(*config)
+
+// config holds the defaults named by the options passed to Configure. The
+// set fields record which defaults are changed.
+type config struct {
+	handler    diag.Handler
+	level      diag.Level
+	setTracer  bool
+	threads    int
+	setThreads bool
+	controller *admission.Controller
+	setAdmit   bool
+	fpTrap     diag.FPException
+	setFPTrap  bool
+}
+
+// WithTracer sets the receiver of the diagnostic events of the BLAS and
+// LAPACKE bindings to h and the level of the diagnostics to l, as set by
+// diag.SetHandler and diag.SetLevel. A nil h discards the events, which are
+// then only reflected in the counters of diag.
+func WithTracer(h diag.Handler, l diag.Level) Option {
+	return func(c *config) {
+		c.handler = h
+		c.level = l
+		c.setTracer = true
+	}
+}
+
+// WithThreads sets the number of threads used by the linked library, as
+// set by SetNumThreads of gonum.org/v1/netlib/blas/netlib. Inside a region
+// run by SingleThreaded, the number takes effect when the region returns.
+func WithThreads(n int) Option {
+	return func(c *config) {
+		c.threads = n
+		c.setThreads = true
+	}
+}
+
+// WithAdmission installs ctl as the admission controller consulted by the
+// Level 3 BLAS routines and the LAPACK drivers, as set by
+// admission.SetDefault. A nil ctl removes the controller.
+func WithAdmission(ctl *admission.Controller) Option {
+	return func(c *config) {
+		c.controller = ctl
+		c.setAdmit = true
+	}
+}
+
+// WithFPTrap selects the floating-point exceptions that make a call of a
+// BLAS or LAPACKE binding panic with a diag.FPError naming the routine, as
+// set by diag.SetFPTrap. The checks are made by the diagnostics, so they
+// require a level other than diag.Off, set with WithTracer, and only see
+// the exceptions of the calling thread, so a library using several threads
+// should be limited to one with WithThreads.
+func WithFPTrap(e diag.FPException) Option {
+	return func(c *config) {
+		c.fpTrap = e
+		c.setFPTrap = true
+	}
+}
+
+// Configure sets the process-wide defaults named by opts and leaves the
+// others unchanged. The options are checked before any default is changed,
+// so Configure panics without effect if one of them is invalid. Later
+// options override earlier ones that set the same default.
+//
+// Calls in progress in other goroutines keep the defaults that were
+// current when they started.
+func Configure(opts ...Option) {
+	var c config
+	for _, opt := range opts {
+		opt(&c)
+	}
+	if c.setTracer && (c.level < diag.Off || diag.Full < c.level) {
+		panic(badLevel)
+	}
+	if c.setThreads && c.threads < 1 {
+		panic(badThreads)
+	}
+	if c.setFPTrap && c.fpTrap&^allFPExceptions != 0 {
+		panic(badFPTrap)
+	}
+
+	if c.setTracer {
+		// The handler is installed first so that no event of the new
+		// level is delivered to the previous handler.
+		diag.SetHandler(c.handler)
+		diag.SetLevel(c.level)
+	}
+	if c.setThreads {
+		setThreads(c.threads)
+	}
+	if c.setAdmit {
+		admission.SetDefault(c.controller)
+	}
+	if c.setFPTrap {
+		diag.SetFPTrap(c.fpTrap)
+	}
+}
+
+const allFPExceptions = diag.FPInvalid | diag.FPDivByZero | diag.FPOverflow | diag.FPUnderflow | diag.FPInexact
@@ -0,0 +1,71 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"testing"
+
+	"gonum.org/v1/netlib/admission"
+	"gonum.org/v1/netlib/diag"
+)
+
+func TestConfigure(t *testing.T) {
+	defer func() {
+		diag.SetHandler(nil)
+		diag.SetLevel(diag.Off)
+		admission.SetDefault(nil)
+		diag.SetFPTrap(0)
+	}()
+
+	var events int
+	ctl := admission.New(admission.Config{MaxConcurrent: 2})
+	Configure(WithTracer(func(diag.Event) { events++ }, diag.Calls), WithAdmission(ctl))
+	if got := diag.Current(); got != diag.Calls {
+		t.Errorf("unexpected trace level: got %v want %v", got, diag.Calls)
+	}
+	if admission.Default() != ctl {
+		t.Error("admission controller not installed")
+	}
+	diag.Trace("test", "Routine")()
+	if events != 1 {
+		t.Errorf("unexpected number of events: got %d want 1", events)
+	}
+
+	// Options that are not passed leave their defaults unchanged.
+	Configure(WithTracer(nil, diag.Errors))
+	if admission.Default() != ctl {
+		t.Error("admission controller changed by unrelated option")
+	}
+	Configure(WithAdmission(nil))
+	if admission.Default() != nil {
+		t.Error("admission controller not removed")
+	}
+	Configure(WithFPTrap(diag.FPInvalid))
+	if prev := diag.SetFPTrap(0); prev != diag.FPInvalid {
+		t.Errorf("unexpected floating-point exceptions: got %v want %v", prev, diag.FPInvalid)
+	}
+
+	// An invalid option panics before any default is changed.
+	for _, opts := range [][]Option{
+		{WithAdmission(ctl), WithThreads(0)},
+		{WithAdmission(ctl), WithTracer(nil, diag.Full+1)},
+		{WithAdmission(ctl), WithFPTrap(1 << 10)},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Error("expected panic for invalid option")
+				}
+			}()
+			Configure(opts...)
+		}()
+		if admission.Default() != nil {
+			t.Error("default changed by invalid configuration")
+		}
+		if got := diag.Current(); got != diag.Errors {
+			t.Errorf("trace level changed by invalid configuration: %v", got)
+		}
+	}
+}
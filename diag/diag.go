@@ -0,0 +1,300 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package diag controls the diagnostics of the generated BLAS and LAPACKE
+// bindings: tracing of calls, logging, dumping of arguments and per-routine
+// call counters are all governed by a single process-wide Level that can be
+// changed at any time.
+//
+// Every routine of gonum.org/v1/netlib/blas/netlib.Implementation and every
+// function of gonum.org/v1/netlib/lapack/lapacke generated from the C
+// headers starts with an atomic load of the level, so the cost of the
+// diagnostics when the level is Off is one load and one branch per call.
+// The level is initially read from the NETLIB_DIAG environment variable,
+// which holds a level name as accepted by ParseLevel, and is Off if the
+// variable is unset. A diagnostic window is opened in a running process
+// with SetLevelFor:
+//
+//	diag.SetHandler(diag.LogHandler(logger))
+//	diag.SetLevelFor(diag.Calls, time.Minute)
+//
+// Events are delivered synchronously to the Handler installed with
+// SetHandler on the goroutine that made the call, so a Handler must be safe
+// for concurrent use and should return quickly.
+package diag // import "gonum.org/v1/netlib/diag"
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Level is the verbosity of the diagnostics.
+type Level int32
+
+const (
+	// Off disables the diagnostics.
+	Off Level = iota
+	// Errors reports the calls that panic, such as calls with invalid
+	// arguments.
+	Errors
+	// Calls additionally reports every completed call with its duration
+	// and counts the calls of each routine.
+	Calls
+	// Full additionally records the scalar arguments of each call.
+	Full
+)
+
+var levelNames = [...]string{
+	Off:    "off",
+	Errors: "errors",
+	Calls:  "calls",
+	Full:   "full",
+}
+
+func (l Level) String() string {
+	if l < Off || Full < l {
+		return fmt.Sprintf("Level(%d)", int32(l))
+	}
+	return levelNames[l]
+}
+
+// ParseLevel returns the Level with the name s, ignoring case. The empty
+// string is parsed as Off.
+func ParseLevel(s string) (Level, error) {
+	if s == "" {
+		return Off, nil
+	}
+	for l, name := range levelNames {
+		if strings.EqualFold(s, name) {
+			return Level(l), nil
+		}
+	}
+	return Off, fmt.Errorf("diag: unknown level %q", s)
+}
+
+// level holds the current Level.
+var level int32
+
+func init() {
+	l, err := ParseLevel(os.Getenv("NETLIB_DIAG"))
+	if err != nil {
+		log.Print(err)
+		return
+	}
+	level = int32(l)
+}
+
+// Current returns the current level. It is called by the generated code
+// at the start of every call.
+func Current() Level {
+	return Level(atomic.LoadInt32(&level))
+}
+
+// SetLevel sets the current level and returns the previous one. Calls that
+// are in progress keep the level that was current when they started.
+func SetLevel(l Level) (prev Level) {
+	if l < Off || Full < l {
+		panic("diag: invalid level")
+	}
+	return Level(atomic.SwapInt32(&level, int32(l)))
+}
+
+// SetLevelFor sets the current level to l for the duration d, after which
+// the previous level is restored unless the level has been changed again in
+// the meantime.
+func SetLevelFor(l Level, d time.Duration) {
+	prev := SetLevel(l)
+	time.AfterFunc(d, func() {
+		atomic.CompareAndSwapInt32(&level, int32(l), int32(prev))
+	})
+}
+
+// Event describes a call of a BLAS or LAPACKE routine.
+type Event struct {
+	// Level is Errors for a call that panicked and Calls for a call that
+	// completed normally.
+	Level Level
+
+	// Package is the name of the package of the routine, "blas" or
+	// "lapacke".
+	Package string
+
+	// Routine is the name of the routine, for example "Dgemm".
+	Routine string
+
+	// Args holds the scalar arguments of the call in the order of the
+	// parameters if the level was Full when the call started, and is nil
+	// otherwise. Slice arguments are omitted.
+	Args []interface{}
+
+	// Start is the time at which the call started and Duration is the
+	// time it took.
+	Start    time.Time
+	Duration time.Duration
+
+	// Panic is the value with which the call panicked, or nil.
+	Panic interface{}
+}
+
+func (e Event) String() string {
+	var b strings.Builder
+	b.WriteString(e.Package)
+	b.WriteByte('.')
+	b.WriteString(e.Routine)
+	b.WriteByte('(')
+	for i, a := range e.Args {
+		if i != 0 {
+			b.WriteString(", ")
+		}
+		if c, ok := a.(byte); ok {
+			fmt.Fprintf(&b, "%q", rune(c))
+		} else {
+			fmt.Fprint(&b, a)
+		}
+	}
+	b.WriteByte(')')
+	if e.Panic != nil {
+		fmt.Fprintf(&b, " panic: %v", e.Panic)
+	} else {
+		fmt.Fprintf(&b, " %v", e.Duration)
+	}
+	return b.String()
+}
+
+// Handler receives the events of the diagnostics.
+type Handler func(Event)
+
+// handler holds the current Handler.
+var handler atomic.Value
+
+type handlerHolder struct {
+	h Handler
+}
+
+// SetHandler installs h as the receiver of the events. A nil h discards the
+// events, which are then only reflected in the counters.
+func SetHandler(h Handler) {
+	handler.Store(handlerHolder{h})
+}
+
+// LogHandler returns a Handler that writes each event to l on one line. If l
+// is nil, the standard logger is used.
+func LogHandler(l *log.Logger) Handler {
+	if l == nil {
+		return func(e Event) { log.Print(e) }
+	}
+	return func(e Event) { l.Print(e) }
+}
+
+// Count holds the counters of a routine.
+type Count struct {
+	// Calls is the number of completed calls and Time is their total
+	// duration. Calls are counted at the Calls and Full levels.
+	Calls int64
+	Time  time.Duration
+
+	// Panics is the number of calls that panicked. Panics are counted at
+	// all levels except Off.
+	Panics int64
+}
+
+var (
+	countsMu sync.Mutex
+	counts   = make(map[string]*Count)
+)
+
+// ReadCounts returns the counters of the routines that have been counted,
+// keyed by package and routine name, for example "blas.Dgemm".
+func ReadCounts() map[string]Count {
+	countsMu.Lock()
+	defer countsMu.Unlock()
+	c := make(map[string]Count, len(counts))
+	for k, v := range counts {
+		c[k] = *v
+	}
+	return c
+}
+
+// ResetCounts sets all counters to zero.
+func ResetCounts() {
+	countsMu.Lock()
+	counts = make(map[string]*Count)
+	countsMu.Unlock()
+}
+
+// Trace starts the diagnostics of a call of routine in pkg with the scalar
+// arguments args and returns the function that ends them, which must be
+// deferred by the routine:
+//
+//	if diag.Current() != diag.Off {
+//		defer diag.Trace("blas", "Dgemm", tA, tB, m, n, k, alpha, lda, ldb, beta, ldc)()
+//	}
+//
+// If the routine panics, the returned function reports the panic and
+// panics again with the same value. If the routine raised one of the
+// floating-point exceptions selected by SetFPTrap, it panics with an
+// FPError.
+func Trace(pkg, routine string, args ...interface{}) func() {
+	l := Current()
+	if l == Off {
+		return nop
+	}
+	e := Event{Package: pkg, Routine: routine, Start: time.Now()}
+	if l == Full {
+		e.Args = args
+	}
+	var fp *fpEnv
+	trap := FPException(atomic.LoadInt32(&fpTrap))
+	if trap != 0 {
+		fp = holdFP()
+	}
+	return func() {
+		r := recover()
+		e.Duration = time.Since(e.Start)
+		if fp != nil {
+			if raised := fp.restore() & trap; raised != 0 && r == nil {
+				r = FPError{Package: pkg, Routine: routine, Raised: raised}
+			}
+		}
+		if r != nil {
+			e.Level = Errors
+			e.Panic = r
+			record(e)
+			panic(r)
+		}
+		if l >= Calls {
+			e.Level = Calls
+			record(e)
+		}
+	}
+}
+
+func nop() {}
+
+// record updates the counters with e and passes it to the handler.
+func record(e Event) {
+	key := e.Package + "." + e.Routine
+	countsMu.Lock()
+	c, ok := counts[key]
+	if !ok {
+		c = &Count{}
+		counts[key] = c
+	}
+	if e.Panic != nil {
+		c.Panics++
+	} else {
+		c.Calls++
+		c.Time += e.Duration
+	}
+	countsMu.Unlock()
+
+	if h, _ := handler.Load().(handlerHolder); h.h != nil {
+		h.h(e)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package diag
+
+import (
+	"bytes"
+	"log"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// routine simulates a generated binding.
+func routine(n int, fail bool) {
+	if Current() != Off {
+		defer Trace("test", "Routine", n, byte('N'))()
+	}
+	if fail {
+		panic("test: bad n")
+	}
+}
+
+// reset restores the initial state of the package.
+func reset() {
+	SetHandler(nil)
+	SetLevel(Off)
+	ResetCounts()
+}
+
+// collect installs a handler recording the events and returns a function
+// returning them.
+func collect() func() []Event {
+	var (
+		mu     sync.Mutex
+		events []Event
+	)
+	SetHandler(func(e Event) {
+		mu.Lock()
+		events = append(events, e)
+		mu.Unlock()
+	})
+	return func() []Event {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]Event(nil), events...)
+	}
+}
+
+func call(n int, fail bool) (panicked bool) {
+	defer func() {
+		panicked = recover() != nil
+	}()
+	routine(n, fail)
+	return false
+}
+
+func TestLevels(t *testing.T) {
+	for _, test := range []struct {
+		level  Level
+		events int
+		args   bool
+		counts Count
+	}{
+		{level: Off},
+		{level: Errors, events: 1, counts: Count{Panics: 1}},
+		{level: Calls, events: 3, counts: Count{Calls: 2, Panics: 1}},
+		{level: Full, events: 3, args: true, counts: Count{Calls: 2, Panics: 1}},
+	} {
+		events := collect()
+		SetLevel(test.level)
+		if call(3, false) || call(4, false) || !call(5, true) {
+			t.Fatalf("%v: unexpected panic behavior", test.level)
+		}
+		got := events()
+		if len(got) != test.events {
+			t.Errorf("%v: unexpected number of events: got %d want %d", test.level, len(got), test.events)
+		}
+		for _, e := range got {
+			if e.Package != "test" || e.Routine != "Routine" {
+				t.Errorf("%v: unexpected routine %s.%s", test.level, e.Package, e.Routine)
+			}
+			if (e.Args != nil) != test.args {
+				t.Errorf("%v: unexpected args %v", test.level, e.Args)
+			}
+			if (e.Panic != nil) != (e.Level == Errors) {
+				t.Errorf("%v: panic %v reported at level %v", test.level, e.Panic, e.Level)
+			}
+		}
+		c := ReadCounts()["test.Routine"]
+		c.Time = 0
+		if c != test.counts {
+			t.Errorf("%v: unexpected counts: got %+v want %+v", test.level, c, test.counts)
+		}
+		reset()
+	}
+}
+
+func TestEventString(t *testing.T) {
+	defer reset()
+	var buf bytes.Buffer
+	SetHandler(LogHandler(log.New(&buf, "", 0)))
+	SetLevel(Full)
+	call(7, false)
+	call(8, true)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("unexpected output %q", buf.String())
+	}
+	if !strings.HasPrefix(lines[0], "test.Routine(7, 'N') ") {
+		t.Errorf("unexpected call line %q", lines[0])
+	}
+	if lines[1] != "test.Routine(8, 'N') panic: test: bad n" {
+		t.Errorf("unexpected panic line %q", lines[1])
+	}
+}
+
+func TestSetLevelFor(t *testing.T) {
+	defer reset()
+	SetLevel(Errors)
+	SetLevelFor(Calls, 10*time.Millisecond)
+	if got := Current(); got != Calls {
+		t.Fatalf("unexpected level %v", got)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for Current() != Errors {
+		if time.Now().After(deadline) {
+			t.Fatalf("level not restored: %v", Current())
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// A level set during the window is kept.
+	SetLevelFor(Calls, 10*time.Millisecond)
+	SetLevel(Full)
+	time.Sleep(50 * time.Millisecond)
+	if got := Current(); got != Full {
+		t.Errorf("level changed during the window was overwritten: %v", got)
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	for _, l := range []Level{Off, Errors, Calls, Full} {
+		got, err := ParseLevel(strings.ToUpper(l.String()))
+		if err != nil || got != l {
+			t.Errorf("unexpected result parsing %v: %v, %v", l, got, err)
+		}
+	}
+	if l, err := ParseLevel(""); l != Off || err != nil {
+		t.Errorf("unexpected result parsing empty string: %v, %v", l, err)
+	}
+	if _, err := ParseLevel("verbose"); err == nil {
+		t.Error("expected error for unknown level")
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package diag
+
+/*
+#cgo linux LDFLAGS: -lm
+#include <fenv.h>
+
+#ifndef FE_INVALID
+#define FE_INVALID 0
+#endif
+#ifndef FE_DIVBYZERO
+#define FE_DIVBYZERO 0
+#endif
+#ifndef FE_OVERFLOW
+#define FE_OVERFLOW 0
+#endif
+#ifndef FE_UNDERFLOW
+#define FE_UNDERFLOW 0
+#endif
+#ifndef FE_INEXACT
+#define FE_INEXACT 0
+#endif
+
+// netlib_fe_hold saves the floating-point environment of the thread in env,
+// clears the exception flags and masks the traps, so that no SIGFPE can be
+// raised by the code that follows.
+static void netlib_fe_hold(fenv_t *env)
+{
+	feholdexcept(env);
+}
+
+// netlib_fe_restore returns the exceptions raised since netlib_fe_hold, in
+// the bit order of FPException, and restores the environment saved in env.
+static int netlib_fe_restore(fenv_t *env)
+{
+	int raised = fetestexcept(FE_ALL_EXCEPT);
+	int e = 0;
+	if (FE_INVALID != 0 && (raised & FE_INVALID)) e |= 1;
+	if (FE_DIVBYZERO != 0 && (raised & FE_DIVBYZERO)) e |= 2;
+	if (FE_OVERFLOW != 0 && (raised & FE_OVERFLOW)) e |= 4;
+	if (FE_UNDERFLOW != 0 && (raised & FE_UNDERFLOW)) e |= 8;
+	if (FE_INEXACT != 0 && (raised & FE_INEXACT)) e |= 16;
+	fesetenv(env);
+	return e;
+}
+*/
+import "C"
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"runtime"
+	"strings"
+	"sync/atomic"
+)
+
+// FPException is a set of IEEE 754 floating-point exceptions.
+type FPException int32
+
+const (
+	// FPInvalid is raised by invalid operations such as 0/0, Inf-Inf and
+	// the square root of a negative number, which produce NaN.
+	FPInvalid FPException = 1 << iota
+	// FPDivByZero is raised by the division of a finite nonzero number by
+	// zero, which produces an infinity.
+	FPDivByZero
+	// FPOverflow is raised when a rounded result is too large to be
+	// represented and is replaced by an infinity.
+	FPOverflow
+	// FPUnderflow is raised when a result is subnormal or zero and
+	// inexact.
+	FPUnderflow
+	// FPInexact is raised when a result is rounded. It is raised by most
+	// computations and is only useful for testing.
+	FPInexact
+)
+
+var fpNames = [...]string{"invalid", "divbyzero", "overflow", "underflow", "inexact"}
+
+func (e FPException) String() string {
+	if e == 0 {
+		return "none"
+	}
+	var names []string
+	for i, name := range fpNames {
+		if e&(1<<uint(i)) != 0 {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// ParseFPException returns the set of the exceptions named in s, separated
+// by "|" or ",", ignoring case. The empty string is parsed as no
+// exceptions.
+func ParseFPException(s string) (FPException, error) {
+	var e FPException
+	for _, f := range strings.FieldsFunc(s, func(r rune) bool { return r == '|' || r == ',' }) {
+		f = strings.TrimSpace(f)
+		found := false
+		for i, name := range fpNames {
+			if strings.EqualFold(f, name) {
+				e |= 1 << uint(i)
+				found = true
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("diag: unknown floating-point exception %q", f)
+		}
+	}
+	return e, nil
+}
+
+// FPError is the value with which a call panics if it raised one of the
+// floating-point exceptions selected by SetFPTrap.
+type FPError struct {
+	// Package and Routine name the routine, as in Event.
+	Package string
+	Routine string
+
+	// Raised holds the selected exceptions raised by the call.
+	Raised FPException
+}
+
+func (e FPError) Error() string {
+	return fmt.Sprintf("diag: floating-point exception %v in %s.%s", e.Raised, e.Package, e.Routine)
+}
+
+// fpTrap holds the exceptions selected by SetFPTrap.
+var fpTrap int32
+
+func init() {
+	e, err := ParseFPException(os.Getenv("NETLIB_FPE"))
+	if err != nil {
+		log.Print(err)
+		return
+	}
+	fpTrap = int32(e)
+}
+
+// SetFPTrap selects the floating-point exceptions that make a call of a
+// BLAS or LAPACKE routine panic with an FPError naming the routine, and
+// returns the previous selection. Zero disables the checks, which is the
+// default unless the NETLIB_FPE environment variable names exceptions as
+// accepted by ParseFPException.
+//
+// The checks are made by Trace, so they only take effect while the level is
+// not Off, and the panics are reported as events at the Errors level. Trace
+// locks the goroutine to its thread, saves the floating-point environment
+// and clears the exception flags before the call, and tests the flags and
+// restores the environment after it, so the flags and trap masks seen by
+// the caller are unchanged. The traps themselves are never enabled with
+// feenableexcept: the Go runtime treats a SIGFPE raised in C code as a
+// fatal error, so a trap could not be turned into a panic. The checks
+// therefore report which call produced an exception, not the instruction.
+//
+// The exception flags belong to a thread, so only the operations executed
+// by the calling thread are checked. An exception raised by a worker
+// thread of a multithreaded library such as OpenBLAS or Intel MKL is not
+// seen. The calls to check should be made with the library limited to one
+// thread, by SetNumThreads of gonum.org/v1/netlib/blas/netlib or within
+// SingleThreaded of gonum.org/v1/netlib.
+func SetFPTrap(e FPException) (prev FPException) {
+	if e&^(1<<uint(len(fpNames))-1) != 0 {
+		panic("diag: invalid floating-point exception")
+	}
+	return FPException(atomic.SwapInt32(&fpTrap, int32(e)))
+}
+
+// fpEnv is the saved floating-point environment of a traced call.
+type fpEnv struct {
+	env C.fenv_t
+}
+
+// holdFP locks the goroutine to its thread and saves and clears its
+// floating-point environment.
+func holdFP() *fpEnv {
+	runtime.LockOSThread()
+	e := &fpEnv{}
+	C.netlib_fe_hold(&e.env)
+	return e
+}
+
+// restore returns the exceptions raised since holdFP, restores the saved
+// environment and unlocks the goroutine from its thread.
+func (e *fpEnv) restore() FPException {
+	raised := FPException(C.netlib_fe_restore(&e.env))
+	runtime.UnlockOSThread()
+	return raised
+}
@@ -0,0 +1,87 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package diag
+
+import (
+	"math"
+	"testing"
+)
+
+// divide simulates a generated binding that divides x by y. The division
+// sets the exception flags of the thread like a native routine would.
+func divide(x, y float64) float64 {
+	if Current() != Off {
+		defer Trace("test", "Divide", x, y)()
+	}
+	return x / y
+}
+
+func callDivide(x, y float64) (r interface{}) {
+	defer func() {
+		r = recover()
+	}()
+	divide(x, y)
+	return nil
+}
+
+func TestFPTrap(t *testing.T) {
+	defer reset()
+	defer SetFPTrap(0)
+	events := collect()
+
+	SetLevel(Errors)
+	if prev := SetFPTrap(FPInvalid | FPDivByZero); prev != 0 {
+		t.Errorf("unexpected initial selection: %v", prev)
+	}
+	if r := callDivide(1, 2); r != nil {
+		t.Errorf("unexpected panic for 1/2: %v", r)
+	}
+	r := callDivide(1, 0)
+	e, ok := r.(FPError)
+	if !ok || e.Routine != "Divide" || e.Raised != FPDivByZero {
+		t.Fatalf("unexpected panic for 1/0: %v", r)
+	}
+	if r := callDivide(0, 0); r == nil || r.(FPError).Raised&FPInvalid == 0 {
+		t.Errorf("unexpected panic for 0/0: %v", r)
+	}
+	if got := len(events()); got != 2 {
+		t.Errorf("unexpected number of events: got %d want 2", got)
+	}
+
+	// Exceptions that are not selected and calls made at level Off are
+	// not reported.
+	SetFPTrap(FPOverflow)
+	if r := callDivide(1, 0); r != nil {
+		t.Errorf("unexpected panic for unselected exception: %v", r)
+	}
+	SetFPTrap(FPDivByZero)
+	SetLevel(Off)
+	if v := divide(1, 0); !math.IsInf(v, 1) {
+		t.Errorf("unexpected result of 1/0: %v", v)
+	}
+}
+
+func TestParseFPException(t *testing.T) {
+	for _, test := range []struct {
+		s    string
+		want FPException
+	}{
+		{"", 0},
+		{"invalid", FPInvalid},
+		{"Invalid|DivByZero", FPInvalid | FPDivByZero},
+		{"overflow, underflow,inexact", FPOverflow | FPUnderflow | FPInexact},
+	} {
+		got, err := ParseFPException(test.s)
+		if err != nil || got != test.want {
+			t.Errorf("unexpected result parsing %q: %v, %v", test.s, got, err)
+		}
+	}
+	if _, err := ParseFPException("nan"); err == nil {
+		t.Error("expected error for unknown exception")
+	}
+	if s := (FPInvalid | FPOverflow).String(); s != "invalid|overflow" {
+		t.Errorf("unexpected string: %q", s)
+	}
+}
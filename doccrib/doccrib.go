@@ -0,0 +1,149 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package doccrib copies the documentation comments of the functions and
+// methods of one Go package onto functions and methods of the same name in
+// generated source, as the generator of gonum.org/v1/netlib/blas/netlib
+// does with the documentation of gonum.org/v1/gonum/blas/gonum. It is meant
+// for binding generators whose wrappers implement an API that is documented
+// elsewhere.
+//
+// The documentation is read with Load or ParseDir and either written by the
+// generator itself, using Docs.Comment, or merged into existing source with
+// Merge. The command gonum.org/v1/netlib/cmd/doccrib merges it into files.
+package doccrib // import "gonum.org/v1/netlib/doccrib"
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os/exec"
+	"strings"
+)
+
+// Docs holds the documentation comments of the functions and methods of a
+// package. The first key is the name of the receiver type, which is empty
+// for functions, and the second is the name of the function or method. The
+// comments are held as lines including their comment markers, as in the
+// Text field of an ast.Comment.
+type Docs map[string]map[string][]string
+
+// Load returns the documentation of the package with the given import path,
+// which is located by the go command as for go list. In module mode the
+// package is found in the module cache at the version required by the main
+// module, so that it must be a dependency of the module that runs Load.
+func Load(pkg string) (Docs, error) {
+	cmd := exec.Command("go", "list", "-f", "{{.Dir}}", pkg)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	if err != nil {
+		return nil, fmt.Errorf("doccrib: go list %s failed with stderr output %q: %v", pkg, stderr.String(), err)
+	}
+	return ParseDir(strings.TrimSpace(stdout.String()))
+}
+
+// ParseDir returns the documentation of the package in the directory dir.
+// Test files are included, so dir should not hold tests with documented
+// functions that share names with those of the package.
+func ParseDir(dir string) (Docs, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, nil, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	docs := make(Docs)
+	for _, p := range pkgs {
+		for _, f := range p.Files {
+			docs.add(f)
+		}
+	}
+	return docs, nil
+}
+
+// add adds the documentation of the functions and methods of f to d.
+func (d Docs) add(f *ast.File) {
+	for _, n := range f.Decls {
+		fn, ok := n.(*ast.FuncDecl)
+		if !ok || fn.Doc == nil {
+			continue
+		}
+		typ := receiver(fn)
+		doc, ok := d[typ]
+		if !ok {
+			doc = make(map[string][]string)
+			d[typ] = doc
+		}
+		lines := make([]string, len(fn.Doc.List))
+		for i, c := range fn.Doc.List {
+			lines[i] = c.Text
+		}
+		doc[fn.Name.Name] = lines
+	}
+}
+
+// Comment returns the documentation of the method name of typ, or of the
+// function name if typ is empty, without the paragraphs that contain any of
+// the strings in omit. It returns nil if there is no documentation.
+func (d Docs) Comment(typ, name string, omit ...string) []string {
+	lines, ok := d[typ][name]
+	if !ok {
+		return nil
+	}
+	if len(omit) == 0 {
+		return append([]string(nil), lines...)
+	}
+
+	// Paragraphs are separated by empty comment lines. The separator
+	// before a paragraph is removed with it, or the separator after it if
+	// it is the first.
+	var out, para []string
+	flush := func() {
+		for _, l := range para {
+			for _, o := range omit {
+				if strings.Contains(l, o) {
+					para = para[:0]
+					return
+				}
+			}
+		}
+		if len(out) > 0 && len(para) > 0 {
+			out = append(out, "//")
+		}
+		out = append(out, para...)
+		para = para[:0]
+	}
+	for _, l := range lines {
+		if strings.TrimSpace(l) == "//" {
+			flush()
+			continue
+		}
+		para = append(para, l)
+	}
+	flush()
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// receiver returns the name of the receiver type of fn, or the empty string
+// if fn is a function.
+func receiver(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return ""
+	}
+	t := fn.Recv.List[0].Type
+	if s, ok := t.(*ast.StarExpr); ok {
+		t = s.X
+	}
+	if id, ok := t.(*ast.Ident); ok {
+		return id.Name
+	}
+	return ""
+}
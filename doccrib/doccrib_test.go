@@ -0,0 +1,184 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package doccrib
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const documented = `package doc
+
+// Implementation is documented.
+type Implementation struct{}
+
+// Ddot computes the dot product of the two vectors
+//  \sum_i x[i]*y[i]
+//
+// Float32 implementations are autogenerated and not directly tested.
+func (Implementation) Ddot(n int, x []float64, incX int, y []float64, incY int) float64 { return 0 }
+
+// Daxpy adds alpha * x to y.
+//
+// Float32 implementations are autogenerated and not directly tested.
+func (Implementation) Daxpy(n int, alpha float64, x []float64, incX int, y []float64, incY int) {}
+
+// Dscal scales x by alpha.
+func (*Implementation) Dscal(n int, alpha float64, x []float64, incX int) {}
+
+// Max returns the larger of a and b.
+func Max(a, b int) int { return a }
+
+func undocumented() {}
+`
+
+func parseTestDocs(t *testing.T) Docs {
+	dir, err := ioutil.TempDir("", "doccrib")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	err = ioutil.WriteFile(filepath.Join(dir, "doc.go"), []byte(documented), 0664)
+	if err != nil {
+		t.Fatal(err)
+	}
+	docs, err := ParseDir(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return docs
+}
+
+func TestComment(t *testing.T) {
+	docs := parseTestDocs(t)
+	for _, test := range []struct {
+		typ, name string
+		omit      []string
+		want      []string
+	}{
+		{
+			typ: "Implementation", name: "Ddot",
+			want: []string{
+				"// Ddot computes the dot product of the two vectors",
+				`//  \sum_i x[i]*y[i]`,
+				"//",
+				"// Float32 implementations are autogenerated and not directly tested.",
+			},
+		},
+		{
+			typ: "Implementation", name: "Ddot",
+			omit: []string{"autogenerated"},
+			want: []string{
+				"// Ddot computes the dot product of the two vectors",
+				`//  \sum_i x[i]*y[i]`,
+			},
+		},
+		{
+			typ: "Implementation", name: "Dscal",
+			want: []string{"// Dscal scales x by alpha."},
+		},
+		{
+			typ: "Implementation", name: "Dscal",
+			omit: []string{"scales"},
+		},
+		{
+			typ: "", name: "Max",
+			want: []string{"// Max returns the larger of a and b."},
+		},
+		{typ: "", name: "undocumented"},
+		{typ: "Implementation", name: "Max"},
+	} {
+		got := docs.Comment(test.typ, test.name, test.omit...)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("unexpected comment for %s.%s omitting %q:\ngot  %q\nwant %q", test.typ, test.name, test.omit, got, test.want)
+		}
+	}
+}
+
+func TestMerge(t *testing.T) {
+	docs := parseTestDocs(t)
+	const src = `package bind
+
+type Impl struct{}
+
+func (Impl) Daxpy(n int, alpha float64, x []float64, incX int, y []float64, incY int) {}
+
+// Dscal has its own documentation.
+func (Impl) Dscal(n int, alpha float64, x []float64, incX int) {}
+
+func Max(a, b int) int { return a }
+
+func Other() {}
+`
+	for _, test := range []struct {
+		opts MergeOptions
+		want string
+	}{
+		{
+			opts: MergeOptions{Types: map[string]string{"Impl": "Implementation"}, Omit: []string{"autogenerated"}},
+			want: `package bind
+
+type Impl struct{}
+
+// Daxpy adds alpha * x to y.
+func (Impl) Daxpy(n int, alpha float64, x []float64, incX int, y []float64, incY int) {}
+
+// Dscal has its own documentation.
+func (Impl) Dscal(n int, alpha float64, x []float64, incX int) {}
+
+// Max returns the larger of a and b.
+func Max(a, b int) int { return a }
+
+func Other() {}
+`,
+		},
+		{
+			opts: MergeOptions{Types: map[string]string{"Impl": "Implementation"}, Replace: true, Omit: []string{"autogenerated"}},
+			want: `package bind
+
+type Impl struct{}
+
+// Daxpy adds alpha * x to y.
+func (Impl) Daxpy(n int, alpha float64, x []float64, incX int, y []float64, incY int) {}
+
+// Dscal scales x by alpha.
+func (Impl) Dscal(n int, alpha float64, x []float64, incX int) {}
+
+// Max returns the larger of a and b.
+func Max(a, b int) int { return a }
+
+func Other() {}
+`,
+		},
+		{
+			// Without a type mapping only the function is documented.
+			want: `package bind
+
+type Impl struct{}
+
+func (Impl) Daxpy(n int, alpha float64, x []float64, incX int, y []float64, incY int) {}
+
+// Dscal has its own documentation.
+func (Impl) Dscal(n int, alpha float64, x []float64, incX int) {}
+
+// Max returns the larger of a and b.
+func Max(a, b int) int { return a }
+
+func Other() {}
+`,
+		},
+	} {
+		got, err := Merge([]byte(src), docs, test.opts)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(got) != test.want {
+			t.Errorf("unexpected result for %+v:\ngot:\n%s\nwant:\n%s", test.opts, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package doccrib
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strings"
+)
+
+// MergeOptions controls how Merge applies documentation.
+type MergeOptions struct {
+	// Types maps the receiver types of the source to those of the
+	// documented package. Receiver types that are not in Types are looked
+	// up by their own name. Functions are only documented by functions.
+	Types map[string]string
+
+	// Replace is whether existing documentation comments of the source
+	// are replaced. By default they are kept.
+	Replace bool
+
+	// Omit lists strings whose paragraphs are removed from the copied
+	// comments, as for Docs.Comment.
+	Omit []string
+}
+
+// Merge returns the Go source src with the documentation in docs added to
+// its functions and methods, and formatted with go/format. Functions and
+// methods without a match in docs are left unchanged.
+func Merge(src []byte, docs Docs, opts MergeOptions) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	type edit struct {
+		start, end int
+		text       string
+	}
+	var edits []edit
+	for _, n := range f.Decls {
+		fn, ok := n.(*ast.FuncDecl)
+		if !ok || (fn.Doc != nil && !opts.Replace) {
+			continue
+		}
+		typ := receiver(fn)
+		if t, ok := opts.Types[typ]; ok && typ != "" {
+			typ = t
+		}
+		doc := docs.Comment(typ, fn.Name.Name, opts.Omit...)
+		if doc == nil {
+			continue
+		}
+		text := strings.Join(doc, "\n") + "\n"
+		pos := fset.Position(fn.Pos()).Offset
+		e := edit{start: pos, end: pos, text: text}
+		if fn.Doc != nil {
+			e.start = fset.Position(fn.Doc.Pos()).Offset
+			// Keep the line break between the comment and the declaration.
+			e.text = strings.Join(doc, "\n")
+			e.end = fset.Position(fn.Doc.End()).Offset
+		}
+		edits = append(edits, e)
+	}
+	if len(edits) == 0 {
+		return format.Source(src)
+	}
+
+	// Apply the edits from the end so that the offsets of the earlier ones
+	// remain valid.
+	sort.Slice(edits, func(i, j int) bool { return edits[i].start > edits[j].start })
+	buf := append([]byte(nil), src...)
+	for _, e := range edits {
+		var b bytes.Buffer
+		b.Write(buf[:e.start])
+		b.WriteString(e.text)
+		b.Write(buf[e.end:])
+		buf = b.Bytes()
+	}
+	return format.Source(buf)
+}
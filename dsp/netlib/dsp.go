@@ -0,0 +1,266 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package netlib provides signal and image processing helpers built on the
+// cgo BLAS bindings in gonum.org/v1/netlib/blas/netlib.
+//
+// Multichannel images are stored channel-major: an image with c channels,
+// h rows and w columns is a slice of length c*h*w where element (ch, i, j)
+// is at index (ch*h+i)*w+j.
+//
+// Convolutions are lowered to matrix multiplication by unfolding image
+// patches into the columns of a matrix (im2col). The unfolded matrix has
+// c*kh*kw rows and outH*outW columns, where for an image dimension n, kernel
+// dimension k, padding p and stride s the output dimension is
+//  (n+2*p-k)/s + 1.
+package netlib // import "gonum.org/v1/netlib/dsp/netlib"
+
+import (
+	"gonum.org/v1/gonum/blas"
+
+	blasnetlib "gonum.org/v1/netlib/blas/netlib"
+)
+
+var impl blasnetlib.Implementation
+
+const (
+	cLT0       = "dsp: c < 0"
+	hLT0       = "dsp: h < 0"
+	wLT0       = "dsp: w < 0"
+	kLT0       = "dsp: k < 0"
+	kernLT1    = "dsp: kernel dimension < 1"
+	padLT0     = "dsp: negative padding"
+	strideLT1  = "dsp: stride < 1"
+	badOutput  = "dsp: kernel larger than padded input"
+	shortIm    = "dsp: insufficient length of im"
+	shortCol   = "dsp: insufficient length of col"
+	shortDst   = "dsp: insufficient length of dst"
+	shortWork  = "dsp: insufficient length of work"
+	shortF     = "dsp: insufficient length of filter"
+	shortX     = "dsp: insufficient length of x"
+	shortKern  = "dsp: insufficient length of kernel"
+	badLenKern = "dsp: kernel longer than signal"
+)
+
+// OutputDims returns the dimensions of the output of a two-dimensional
+// correlation of an h×w image with a kh×kw kernel using the given padding and
+// stride. OutputDims will panic if the kernel does not fit in the padded image.
+func OutputDims(h, w, kh, kw, padH, padW, strideH, strideW int) (outH, outW int) {
+	switch {
+	case h < 0:
+		panic(hLT0)
+	case w < 0:
+		panic(wLT0)
+	case kh < 1, kw < 1:
+		panic(kernLT1)
+	case padH < 0, padW < 0:
+		panic(padLT0)
+	case strideH < 1, strideW < 1:
+		panic(strideLT1)
+	case h+2*padH < kh, w+2*padW < kw:
+		panic(badOutput)
+	}
+	return (h+2*padH-kh)/strideH + 1, (w+2*padW-kw)/strideW + 1
+}
+
+// Im2col unfolds the kh×kw patches of the c×h×w image im into the columns
+// of the (c*kh*kw)×(outH*outW) row-major matrix stored in col, where outH and
+// outW are given by OutputDims. Elements of a patch that fall in the padding
+// are set to zero.
+//
+// im must have length at least c*h*w and col must have length at least
+// c*kh*kw*outH*outW, otherwise Im2col will panic.
+func Im2col(c, h, w, kh, kw, padH, padW, strideH, strideW int, im, col []float64) {
+	if c < 0 {
+		panic(cLT0)
+	}
+	outH, outW := OutputDims(h, w, kh, kw, padH, padW, strideH, strideW)
+	switch {
+	case len(im) < c*h*w:
+		panic(shortIm)
+	case len(col) < c*kh*kw*outH*outW:
+		panic(shortCol)
+	}
+
+	n := outH * outW
+	for ch := 0; ch < c; ch++ {
+		for ki := 0; ki < kh; ki++ {
+			for kj := 0; kj < kw; kj++ {
+				row := col[((ch*kh+ki)*kw+kj)*n:]
+				for oi := 0; oi < outH; oi++ {
+					i := oi*strideH - padH + ki
+					dst := row[oi*outW : (oi+1)*outW]
+					if i < 0 || h <= i {
+						for k := range dst {
+							dst[k] = 0
+						}
+						continue
+					}
+					src := im[(ch*h+i)*w : (ch*h+i+1)*w]
+					for oj := range dst {
+						j := oj*strideW - padW + kj
+						if j < 0 || w <= j {
+							dst[oj] = 0
+						} else {
+							dst[oj] = src[j]
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// Col2im folds the (c*kh*kw)×(outH*outW) row-major matrix stored in col back
+// into the c×h×w image im, summing the contributions of overlapping patches.
+// It is the adjoint of Im2col. The first c*h*w elements of im are overwritten.
+//
+// im must have length at least c*h*w and col must have length at least
+// c*kh*kw*outH*outW, otherwise Col2im will panic.
+func Col2im(c, h, w, kh, kw, padH, padW, strideH, strideW int, col, im []float64) {
+	if c < 0 {
+		panic(cLT0)
+	}
+	outH, outW := OutputDims(h, w, kh, kw, padH, padW, strideH, strideW)
+	switch {
+	case len(im) < c*h*w:
+		panic(shortIm)
+	case len(col) < c*kh*kw*outH*outW:
+		panic(shortCol)
+	}
+
+	im = im[:c*h*w]
+	for i := range im {
+		im[i] = 0
+	}
+	n := outH * outW
+	for ch := 0; ch < c; ch++ {
+		for ki := 0; ki < kh; ki++ {
+			for kj := 0; kj < kw; kj++ {
+				row := col[((ch*kh+ki)*kw+kj)*n:]
+				for oi := 0; oi < outH; oi++ {
+					i := oi*strideH - padH + ki
+					if i < 0 || h <= i {
+						continue
+					}
+					src := row[oi*outW : (oi+1)*outW]
+					dst := im[(ch*h+i)*w : (ch*h+i+1)*w]
+					for oj, v := range src {
+						j := oj*strideW - padW + kj
+						if 0 <= j && j < w {
+							dst[j] += v
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// Correlate2D computes the two-dimensional cross-correlation of the c×h×w
+// image im with k filters, as used by convolutional layers of neural
+// networks. The filters are stored in filter as a k×(c*kh*kw) row-major
+// matrix, with each row holding a c×kh×kw kernel in image order. On return
+// dst holds the k×outH×outW output, where outH and outW are given by
+// OutputDims.
+//
+// The correlation is computed as a single matrix product of filter with the
+// unfolded image. work is used to hold the unfolded image and must have
+// length at least c*kh*kw*outH*outW. If work is nil it is allocated.
+//
+// filter must have length at least k*c*kh*kw and dst must have length at
+// least k*outH*outW, otherwise Correlate2D will panic.
+func Correlate2D(c, h, w, k, kh, kw, padH, padW, strideH, strideW int, im, filter, dst, work []float64) {
+	if k < 0 {
+		panic(kLT0)
+	}
+	if c < 0 {
+		panic(cLT0)
+	}
+	outH, outW := OutputDims(h, w, kh, kw, padH, padW, strideH, strideW)
+	rows := c * kh * kw
+	n := outH * outW
+	if work == nil {
+		work = make([]float64, rows*n)
+	}
+	switch {
+	case len(filter) < k*rows:
+		panic(shortF)
+	case len(dst) < k*n:
+		panic(shortDst)
+	case len(work) < rows*n:
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if k == 0 || n == 0 {
+		return
+	}
+	if rows == 0 {
+		dst = dst[:k*n]
+		for i := range dst {
+			dst[i] = 0
+		}
+		return
+	}
+
+	Im2col(c, h, w, kh, kw, padH, padW, strideH, strideW, im, work)
+	impl.Dgemm(blas.NoTrans, blas.NoTrans, k, n, rows, 1, filter, rows, work, n, 0, dst, n)
+}
+
+// Correlate computes the valid cross-correlation of the signal x with the
+// kernel,
+//  dst[i] = \sum_j x[i+j] * kernel[j]
+// for i in [0, len(x)-len(kernel)]. The product is computed with a single
+// matrix-vector multiplication of the unfolded signal.
+//
+// kernel must not be longer than x and dst must have length at least
+// len(x)-len(kernel)+1, otherwise Correlate will panic.
+func Correlate(dst, x, kernel []float64) {
+	m := len(kernel)
+	if m == 0 {
+		panic(shortKern)
+	}
+	if len(x) < m {
+		panic(badLenKern)
+	}
+	n := len(x) - m + 1
+	if len(dst) < n {
+		panic(shortDst)
+	}
+
+	col := make([]float64, m*n)
+	Im2col(1, 1, len(x), 1, m, 0, 0, 1, 1, x, col)
+	impl.Dgemv(blas.Trans, m, n, 1, col, n, kernel, 1, 0, dst, 1)
+}
+
+// Convolve computes the full discrete convolution of the signal x with the
+// kernel, as applied by a finite impulse response filter,
+//  dst[i] = \sum_j x[i-j] * kernel[j]
+// for i in [0, len(x)+len(kernel)-1), where out of range elements of x are
+// treated as zero.
+//
+// x and kernel must not be empty and dst must have length at least
+// len(x)+len(kernel)-1, otherwise Convolve will panic.
+func Convolve(dst, x, kernel []float64) {
+	m := len(kernel)
+	switch {
+	case m == 0:
+		panic(shortKern)
+	case len(x) == 0:
+		panic(shortX)
+	}
+	n := len(x) + m - 1
+	if len(dst) < n {
+		panic(shortDst)
+	}
+
+	flipped := make([]float64, m)
+	for i, v := range kernel {
+		flipped[m-1-i] = v
+	}
+	col := make([]float64, m*n)
+	Im2col(1, 1, len(x), 1, m, 0, m-1, 1, 1, x, col)
+	impl.Dgemv(blas.Trans, m, n, 1, col, n, flipped, 1, 0, dst, 1)
+}
@@ -0,0 +1,171 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import "gonum.org/v1/gonum/blas"
+
+// Im2col32 is the float32 version of Im2col.
+func Im2col32(c, h, w, kh, kw, padH, padW, strideH, strideW int, im, col []float32) {
+	if c < 0 {
+		panic(cLT0)
+	}
+	outH, outW := OutputDims(h, w, kh, kw, padH, padW, strideH, strideW)
+	switch {
+	case len(im) < c*h*w:
+		panic(shortIm)
+	case len(col) < c*kh*kw*outH*outW:
+		panic(shortCol)
+	}
+
+	n := outH * outW
+	for ch := 0; ch < c; ch++ {
+		for ki := 0; ki < kh; ki++ {
+			for kj := 0; kj < kw; kj++ {
+				row := col[((ch*kh+ki)*kw+kj)*n:]
+				for oi := 0; oi < outH; oi++ {
+					i := oi*strideH - padH + ki
+					dst := row[oi*outW : (oi+1)*outW]
+					if i < 0 || h <= i {
+						for k := range dst {
+							dst[k] = 0
+						}
+						continue
+					}
+					src := im[(ch*h+i)*w : (ch*h+i+1)*w]
+					for oj := range dst {
+						j := oj*strideW - padW + kj
+						if j < 0 || w <= j {
+							dst[oj] = 0
+						} else {
+							dst[oj] = src[j]
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// Col2im32 is the float32 version of Col2im.
+func Col2im32(c, h, w, kh, kw, padH, padW, strideH, strideW int, col, im []float32) {
+	if c < 0 {
+		panic(cLT0)
+	}
+	outH, outW := OutputDims(h, w, kh, kw, padH, padW, strideH, strideW)
+	switch {
+	case len(im) < c*h*w:
+		panic(shortIm)
+	case len(col) < c*kh*kw*outH*outW:
+		panic(shortCol)
+	}
+
+	im = im[:c*h*w]
+	for i := range im {
+		im[i] = 0
+	}
+	n := outH * outW
+	for ch := 0; ch < c; ch++ {
+		for ki := 0; ki < kh; ki++ {
+			for kj := 0; kj < kw; kj++ {
+				row := col[((ch*kh+ki)*kw+kj)*n:]
+				for oi := 0; oi < outH; oi++ {
+					i := oi*strideH - padH + ki
+					if i < 0 || h <= i {
+						continue
+					}
+					src := row[oi*outW : (oi+1)*outW]
+					dst := im[(ch*h+i)*w : (ch*h+i+1)*w]
+					for oj, v := range src {
+						j := oj*strideW - padW + kj
+						if 0 <= j && j < w {
+							dst[j] += v
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// Correlate2D32 is the float32 version of Correlate2D.
+func Correlate2D32(c, h, w, k, kh, kw, padH, padW, strideH, strideW int, im, filter, dst, work []float32) {
+	if k < 0 {
+		panic(kLT0)
+	}
+	if c < 0 {
+		panic(cLT0)
+	}
+	outH, outW := OutputDims(h, w, kh, kw, padH, padW, strideH, strideW)
+	rows := c * kh * kw
+	n := outH * outW
+	if work == nil {
+		work = make([]float32, rows*n)
+	}
+	switch {
+	case len(filter) < k*rows:
+		panic(shortF)
+	case len(dst) < k*n:
+		panic(shortDst)
+	case len(work) < rows*n:
+		panic(shortWork)
+	}
+
+	// Quick return if possible.
+	if k == 0 || n == 0 {
+		return
+	}
+	if rows == 0 {
+		dst = dst[:k*n]
+		for i := range dst {
+			dst[i] = 0
+		}
+		return
+	}
+
+	Im2col32(c, h, w, kh, kw, padH, padW, strideH, strideW, im, work)
+	impl.Sgemm(blas.NoTrans, blas.NoTrans, k, n, rows, 1, filter, rows, work, n, 0, dst, n)
+}
+
+// Correlate32 is the float32 version of Correlate.
+func Correlate32(dst, x, kernel []float32) {
+	m := len(kernel)
+	if m == 0 {
+		panic(shortKern)
+	}
+	if len(x) < m {
+		panic(badLenKern)
+	}
+	n := len(x) - m + 1
+	if len(dst) < n {
+		panic(shortDst)
+	}
+
+	col := make([]float32, m*n)
+	Im2col32(1, 1, len(x), 1, m, 0, 0, 1, 1, x, col)
+	impl.Sgemv(blas.Trans, m, n, 1, col, n, kernel, 1, 0, dst, 1)
+}
+
+// Convolve32 is the float32 version of Convolve.
+func Convolve32(dst, x, kernel []float32) {
+	m := len(kernel)
+	switch {
+	case m == 0:
+		panic(shortKern)
+	case len(x) == 0:
+		panic(shortX)
+	}
+	n := len(x) + m - 1
+	if len(dst) < n {
+		panic(shortDst)
+	}
+
+	flipped := make([]float32, m)
+	for i, v := range kernel {
+		flipped[m-1-i] = v
+	}
+	col := make([]float32, m*n)
+	Im2col32(1, 1, len(x), 1, m, 0, m-1, 1, 1, x, col)
+	impl.Sgemv(blas.Trans, m, n, 1, col, n, flipped, 1, 0, dst, 1)
+}
@@ -0,0 +1,104 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func toFloat32(s []float64) []float32 {
+	d := make([]float32, len(s))
+	for i, v := range s {
+		d[i] = float32(v)
+	}
+	return d
+}
+
+// equalApprox32 returns whether got and want agree to within tol relative to
+// the largest magnitude in want.
+func equalApprox32(got []float32, want []float64, tol float64) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	scale := 1.0
+	for _, v := range want {
+		scale = math.Max(scale, math.Abs(v))
+	}
+	for i, v := range got {
+		if math.Abs(float64(v)-want[i]) > tol*scale {
+			return false
+		}
+	}
+	return true
+}
+
+func TestFloat32(t *testing.T) {
+	const tol = 1e-5
+	rnd := rand.New(rand.NewSource(1))
+	const c, h, w, k, kh, kw, padH, padW, strideH, strideW = 2, 9, 7, 3, 3, 3, 1, 2, 2, 1
+	outH, outW := OutputDims(h, w, kh, kw, padH, padW, strideH, strideW)
+	// Use inputs that are exact in float32 so that both precisions start
+	// from the same values.
+	im := toFloat64(toFloat32(randomSlice(rnd, c*h*w)))
+	filter := toFloat64(toFloat32(randomSlice(rnd, k*c*kh*kw)))
+
+	col := make([]float64, c*kh*kw*outH*outW)
+	Im2col(c, h, w, kh, kw, padH, padW, strideH, strideW, im, col)
+	col32 := make([]float32, len(col))
+	Im2col32(c, h, w, kh, kw, padH, padW, strideH, strideW, toFloat32(im), col32)
+	if !equalApprox32(col32, col, 0) {
+		t.Error("Im2col32 mismatch")
+	}
+
+	back := make([]float64, len(im))
+	Col2im(c, h, w, kh, kw, padH, padW, strideH, strideW, col, back)
+	back32 := make([]float32, len(im))
+	Col2im32(c, h, w, kh, kw, padH, padW, strideH, strideW, col32, back32)
+	if !equalApprox32(back32, back, tol) {
+		t.Error("Col2im32 mismatch")
+	}
+
+	want := make([]float64, k*outH*outW)
+	Correlate2D(c, h, w, k, kh, kw, padH, padW, strideH, strideW, im, filter, want, nil)
+	got := make([]float32, len(want))
+	Correlate2D32(c, h, w, k, kh, kw, padH, padW, strideH, strideW, toFloat32(im), toFloat32(filter), got, nil)
+	if !equalApprox32(got, want, tol) {
+		t.Errorf("Correlate2D32 mismatch\ngot: %v\nwant:%v", got, want)
+	}
+
+	for _, m := range []int{1, 3, 7} {
+		name := fmt.Sprintf("m=%d", m)
+		x := toFloat64(toFloat32(randomSlice(rnd, 20)))
+		kernel := toFloat64(toFloat32(randomSlice(rnd, m)))
+
+		want := make([]float64, len(x)-m+1)
+		Correlate(want, x, kernel)
+		got := make([]float32, len(want))
+		Correlate32(got, toFloat32(x), toFloat32(kernel))
+		if !equalApprox32(got, want, tol) {
+			t.Errorf("%s: Correlate32 mismatch", name)
+		}
+
+		want = make([]float64, len(x)+m-1)
+		Convolve(want, x, kernel)
+		got = make([]float32, len(want))
+		Convolve32(got, toFloat32(x), toFloat32(kernel))
+		if !equalApprox32(got, want, tol) {
+			t.Errorf("%s: Convolve32 mismatch", name)
+		}
+	}
+}
+
+func toFloat64(s []float32) []float64 {
+	d := make([]float64, len(s))
+	for i, v := range s {
+		d[i] = float64(v)
+	}
+	return d
+}
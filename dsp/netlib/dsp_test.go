@@ -0,0 +1,146 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func randomSlice(rnd *rand.Rand, n int) []float64 {
+	s := make([]float64, n)
+	for i := range s {
+		s[i] = rnd.NormFloat64()
+	}
+	return s
+}
+
+// correlate2DNaive is a direct implementation of Correlate2D.
+func correlate2DNaive(c, h, w, k, kh, kw, padH, padW, strideH, strideW int, im, filter []float64) []float64 {
+	outH, outW := OutputDims(h, w, kh, kw, padH, padW, strideH, strideW)
+	dst := make([]float64, k*outH*outW)
+	for f := 0; f < k; f++ {
+		for oi := 0; oi < outH; oi++ {
+			for oj := 0; oj < outW; oj++ {
+				var sum float64
+				for ch := 0; ch < c; ch++ {
+					for ki := 0; ki < kh; ki++ {
+						for kj := 0; kj < kw; kj++ {
+							i := oi*strideH - padH + ki
+							j := oj*strideW - padW + kj
+							if i < 0 || h <= i || j < 0 || w <= j {
+								continue
+							}
+							sum += im[(ch*h+i)*w+j] * filter[((f*c+ch)*kh+ki)*kw+kj]
+						}
+					}
+				}
+				dst[(f*outH+oi)*outW+oj] = sum
+			}
+		}
+	}
+	return dst
+}
+
+func TestCorrelate2D(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		c, h, w, k, kh, kw, padH, padW, strideH, strideW int
+	}{
+		{c: 1, h: 1, w: 1, k: 1, kh: 1, kw: 1, strideH: 1, strideW: 1},
+		{c: 1, h: 5, w: 5, k: 1, kh: 3, kw: 3, strideH: 1, strideW: 1},
+		{c: 3, h: 7, w: 6, k: 4, kh: 3, kw: 2, strideH: 1, strideW: 1},
+		{c: 2, h: 8, w: 8, k: 3, kh: 3, kw: 3, padH: 1, padW: 1, strideH: 1, strideW: 1},
+		{c: 2, h: 9, w: 7, k: 2, kh: 3, kw: 3, padH: 1, padW: 2, strideH: 2, strideW: 3},
+		{c: 4, h: 4, w: 4, k: 5, kh: 4, kw: 4, padH: 2, padW: 0, strideH: 3, strideW: 1},
+	} {
+		name := fmt.Sprintf("%+v", test)
+		im := randomSlice(rnd, test.c*test.h*test.w)
+		filter := randomSlice(rnd, test.k*test.c*test.kh*test.kw)
+		want := correlate2DNaive(test.c, test.h, test.w, test.k, test.kh, test.kw, test.padH, test.padW, test.strideH, test.strideW, im, filter)
+		got := make([]float64, len(want))
+		Correlate2D(test.c, test.h, test.w, test.k, test.kh, test.kw, test.padH, test.padW, test.strideH, test.strideW, im, filter, got, nil)
+		if !floats.EqualApprox(got, want, tol) {
+			t.Errorf("%s: unexpected result\ngot: %v\nwant:%v", name, got, want)
+		}
+	}
+}
+
+func TestCol2imAdjoint(t *testing.T) {
+	// Col2im is the adjoint of Im2col, so <Im2col(x), y> == <x, Col2im(y)>.
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		c, h, w, kh, kw, padH, padW, strideH, strideW int
+	}{
+		{c: 1, h: 4, w: 4, kh: 2, kw: 2, strideH: 1, strideW: 1},
+		{c: 3, h: 6, w: 5, kh: 3, kw: 3, padH: 1, padW: 1, strideH: 2, strideW: 1},
+		{c: 2, h: 7, w: 9, kh: 2, kw: 4, padH: 2, padW: 1, strideH: 3, strideW: 2},
+	} {
+		name := fmt.Sprintf("%+v", test)
+		outH, outW := OutputDims(test.h, test.w, test.kh, test.kw, test.padH, test.padW, test.strideH, test.strideW)
+		x := randomSlice(rnd, test.c*test.h*test.w)
+		y := randomSlice(rnd, test.c*test.kh*test.kw*outH*outW)
+
+		col := make([]float64, len(y))
+		Im2col(test.c, test.h, test.w, test.kh, test.kw, test.padH, test.padW, test.strideH, test.strideW, x, col)
+		im := make([]float64, len(x))
+		for i := range im {
+			im[i] = rnd.NormFloat64() // Col2im must overwrite im.
+		}
+		Col2im(test.c, test.h, test.w, test.kh, test.kw, test.padH, test.padW, test.strideH, test.strideW, y, im)
+
+		lhs := floats.Dot(col, y)
+		rhs := floats.Dot(x, im)
+		if !floats.EqualWithinAbsOrRel(lhs, rhs, tol, tol) {
+			t.Errorf("%s: adjoint mismatch: %v != %v", name, lhs, rhs)
+		}
+	}
+}
+
+func TestCorrelateConvolve(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 5, 17} {
+		for _, m := range []int{1, 2, 3, 5} {
+			x := randomSlice(rnd, n)
+			kernel := randomSlice(rnd, m)
+
+			full := make([]float64, n+m-1)
+			for i := range full {
+				for j, v := range kernel {
+					if 0 <= i-j && i-j < n {
+						full[i] += x[i-j] * v
+					}
+				}
+			}
+			got := make([]float64, n+m-1)
+			Convolve(got, x, kernel)
+			if !floats.EqualApprox(got, full, tol) {
+				t.Errorf("n=%d m=%d: unexpected convolution\ngot: %v\nwant:%v", n, m, got, full)
+			}
+
+			if m > n {
+				continue
+			}
+			valid := make([]float64, n-m+1)
+			for i := range valid {
+				for j, v := range kernel {
+					valid[i] += x[i+j] * v
+				}
+			}
+			got = make([]float64, n-m+1)
+			Correlate(got, x, kernel)
+			if !floats.EqualApprox(got, valid, tol) {
+				t.Errorf("n=%d m=%d: unexpected correlation\ngot: %v\nwant:%v", n, m, got, valid)
+			}
+		}
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:generate go run generate_report.go
+
+// Package exp is the root of the experimental packages of this module.
+//
+// New bindings whose API is not settled, for example bindings to vendor
+// extensions or to libraries that are not part of the CBLAS and LAPACKE
+// interfaces, are added as subpackages of exp before they are added to the
+// supported packages. The experimental packages follow these rules:
+//
+//   - They are imported by their own path below gonum.org/v1/netlib/exp and
+//     are only built with the netlib_exp build tag. Without the tag a
+//     package contains its documentation only, so a program cannot use an
+//     experimental API by accident.
+//   - Their API may change or be removed in any release without notice.
+//   - The supported packages never import them.
+//
+// When the API of an experimental package is settled it graduates: its
+// declarations are moved to a supported package and the package is reduced
+// to its documentation, which states the new location in a line of the form
+//
+//	Graduated to gonum.org/v1/netlib/blas/netlib.
+//
+// The list of experimental and graduated packages in GRADUATED.md is
+// generated from the package documentation by go generate.
+package exp // import "gonum.org/v1/netlib/exp"
@@ -0,0 +1,11 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build netlib_exp
+// +build netlib_exp
+
+package exp
+
+// Enabled is whether the experimental packages are built.
+const Enabled = true
@@ -0,0 +1,132 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+// generate_report writes GRADUATED.md, the list of the experimental
+// packages below exp and of the packages that have graduated from it.
+package main
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const root = "gonum.org/v1/netlib/exp"
+
+type pkg struct {
+	Path     string
+	Synopsis string
+	Target   string
+}
+
+func main() {
+	var exp, grad []pkg
+	err := filepath.Walk(".", func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() || path == "." {
+			return nil
+		}
+		if name := info.Name(); strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") || name == "testdata" {
+			return filepath.SkipDir
+		}
+		p, ok, err := parseDir(path)
+		if err != nil || !ok {
+			return err
+		}
+		if p.Target != "" {
+			grad = append(grad, p)
+		} else {
+			exp = append(exp, p)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	sort.Slice(exp, func(i, j int) bool { return exp[i].Path < exp[j].Path })
+	sort.Slice(grad, func(i, j int) bool { return grad[i].Path < grad[j].Path })
+
+	var buf bytes.Buffer
+	err = report.Execute(&buf, struct{ Experimental, Graduated []pkg }{exp, grad})
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = ioutil.WriteFile("GRADUATED.md", buf.Bytes(), 0664)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parseDir returns the package in dir, read from its package documentation,
+// and whether dir holds a package.
+func parseDir(dir string) (pkg, bool, error) {
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, nil, parser.PackageClauseOnly|parser.ParseComments)
+	if err != nil {
+		return pkg{}, false, err
+	}
+	var doc string
+	var found bool
+	for name, p := range pkgs {
+		if strings.HasSuffix(name, "_test") || name == "main" {
+			continue
+		}
+		found = true
+		for _, f := range p.Files {
+			if f.Doc != nil {
+				doc = f.Doc.Text()
+			}
+		}
+	}
+	if !found {
+		return pkg{}, false, nil
+	}
+	p := pkg{Path: root + "/" + filepath.ToSlash(dir)}
+	if i := strings.Index(doc, "\n\n"); i >= 0 {
+		p.Synopsis = strings.Join(strings.Fields(doc[:i]), " ")
+	} else {
+		p.Synopsis = strings.Join(strings.Fields(doc), " ")
+	}
+	for _, line := range strings.Split(doc, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "Graduated to ") {
+			p.Target = strings.TrimSuffix(strings.TrimPrefix(line, "Graduated to "), ".")
+		}
+	}
+	return p, true, nil
+}
+
+var report = template.Must(template.New("report").Parse(`<!-- Code generated by "go generate gonum.org/v1/netlib/exp"; DO NOT EDIT. -->
+
+# Experimental packages
+
+The packages below ` + "`exp`" + ` are only built with the ` + "`netlib_exp`" + ` build tag and
+their API may change without notice. See the documentation of package exp.
+
+## Experimental
+{{if .Experimental}}
+| Package | Synopsis |
+|---|---|
+{{range .Experimental}}| ` + "`{{.Path}}`" + ` | {{.Synopsis}} |
+{{end}}{{else}}
+There are no experimental packages.
+{{end}}
+## Graduated
+{{if .Graduated}}
+| Package | Graduated to |
+|---|---|
+{{range .Graduated}}| ` + "`{{.Path}}`" + ` | ` + "`{{.Target}}`" + ` |
+{{end}}{{else}}
+No package has graduated yet.
+{{end}}`))
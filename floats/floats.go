@@ -0,0 +1,158 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package floats provides comparisons of floating point results, for
+// example to validate the results of one backend library against those of
+// another.
+//
+// RelError and MixedError measure the norm-wise error of a whole vector or
+// matrix, which is the appropriate measure for the results of most BLAS and
+// LAPACK routines. ULP measures the componentwise distance in units in the
+// last place, which is appropriate for results that are expected to be
+// correctly rounded. A Tolerance combines absolute, relative and ULP bounds
+// into a single componentwise policy.
+//
+// NaN elements are considered equal to NaN elements, so that the NaN
+// results of two implementations compare equal, and different from all
+// other values.
+package floats // import "gonum.org/v1/netlib/floats"
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// RelError returns the norm-wise relative error of got with respect to want
+//  ||got - want||_∞ / ||want||_∞.
+// RelError returns +Inf if the lengths differ or if want is zero and got is
+// not, and NaN if an element of got is NaN and the corresponding element of
+// want is not.
+func RelError(got, want []float64) float64 {
+	diff, scale := maxDiff(got, want)
+	if diff == 0 || math.IsNaN(diff) {
+		return diff
+	}
+	return diff / scale
+}
+
+// MixedError returns the error of got with respect to want
+//  ||got - want||_∞ / max(1, ||want||_∞),
+// which is a relative error for large results and an absolute error for
+// results smaller than one. The special cases are as for RelError.
+func MixedError(got, want []float64) float64 {
+	diff, scale := maxDiff(got, want)
+	return diff / math.Max(1, scale)
+}
+
+// maxDiff returns the largest absolute difference between the elements of
+// got and want and the largest absolute element of want.
+func maxDiff(got, want []float64) (diff, scale float64) {
+	if len(got) != len(want) {
+		return math.Inf(1), 1
+	}
+	for i, w := range want {
+		g := got[i]
+		if math.IsNaN(w) {
+			if !math.IsNaN(g) {
+				return math.Inf(1), 1
+			}
+			continue
+		}
+		if math.IsNaN(g) {
+			return math.NaN(), 1
+		}
+		scale = math.Max(scale, math.Abs(w))
+		if g != w {
+			diff = math.Max(diff, math.Abs(g-w))
+		}
+	}
+	return diff, scale
+}
+
+// RelErrorGeneral returns the norm-wise relative error of the matrix got
+// with respect to want in the Frobenius norm
+//  ||got - want||_F / ||want||_F.
+// RelErrorGeneral returns +Inf if the dimensions differ or if want is zero
+// and got is not, and NaN if got or want has a NaN element.
+func RelErrorGeneral(got, want blas64.General) float64 {
+	if got.Rows != want.Rows || got.Cols != want.Cols {
+		return math.Inf(1)
+	}
+	var diff, norm float64
+	for i := 0; i < want.Rows; i++ {
+		g := got.Data[i*got.Stride : i*got.Stride+got.Cols]
+		for j, w := range want.Data[i*want.Stride : i*want.Stride+want.Cols] {
+			diff = math.Hypot(diff, g[j]-w)
+			norm = math.Hypot(norm, w)
+		}
+	}
+	if diff == 0 || math.IsNaN(diff) {
+		return diff
+	}
+	return diff / norm
+}
+
+// Tolerance is a componentwise comparison policy. Two finite numbers are
+// equal under the policy if their absolute difference is at most Abs, if
+// their difference relative to the larger of their magnitudes is at most
+// Rel, or if they are at most ULP units in the last place apart. Infinities
+// are only equal to themselves and NaNs are equal to each other.
+//
+// The zero Tolerance is exact equality.
+type Tolerance struct {
+	Abs, Rel float64
+	ULP      uint64
+}
+
+// Equal returns whether a and b are equal under t.
+func (t Tolerance) Equal(a, b float64) bool {
+	switch {
+	case a == b:
+		return true
+	case math.IsNaN(a) || math.IsNaN(b):
+		return math.IsNaN(a) && math.IsNaN(b)
+	case math.IsInf(a, 0) || math.IsInf(b, 0):
+		return false
+	}
+	d := math.Abs(a - b)
+	return d <= t.Abs ||
+		d <= t.Rel*math.Max(math.Abs(a), math.Abs(b)) ||
+		ULP(a, b) <= t.ULP
+}
+
+// Mismatch returns the index of the first element of got that is not equal
+// to the corresponding element of want under t, or -1 if all elements are
+// equal. Mismatch returns the shorter length if the lengths differ.
+func (t Tolerance) Mismatch(got, want []float64) int {
+	n := len(want)
+	if len(got) < n {
+		n = len(got)
+	}
+	for i, g := range got[:n] {
+		if !t.Equal(g, want[i]) {
+			return i
+		}
+	}
+	if len(got) != len(want) {
+		return n
+	}
+	return -1
+}
+
+// EqualGeneral returns whether the matrices got and want have the same
+// dimensions and equal elements under t.
+func (t Tolerance) EqualGeneral(got, want blas64.General) bool {
+	if got.Rows != want.Rows || got.Cols != want.Cols {
+		return false
+	}
+	for i := 0; i < want.Rows; i++ {
+		g := got.Data[i*got.Stride : i*got.Stride+got.Cols]
+		w := want.Data[i*want.Stride : i*want.Stride+want.Cols]
+		if t.Mismatch(g, w) >= 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,134 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package floats
+
+import (
+	"math"
+	"testing"
+
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+var (
+	inf = math.Inf(1)
+	nan = math.NaN()
+)
+
+func same(a, b float64) bool {
+	return a == b || math.IsNaN(a) && math.IsNaN(b)
+}
+
+func TestRelError(t *testing.T) {
+	for _, test := range []struct {
+		got, want  []float64
+		rel, mixed float64
+	}{
+		{got: nil, want: nil, rel: 0, mixed: 0},
+		{got: []float64{1, 2}, want: []float64{1, 2}, rel: 0, mixed: 0},
+		{got: []float64{1, 2}, want: []float64{1}, rel: inf, mixed: inf},
+		{got: []float64{4, 1}, want: []float64{4, 2}, rel: 0.25, mixed: 0.25},
+		{got: []float64{0.25}, want: []float64{0.5}, rel: 0.5, mixed: 0.25},
+		{got: []float64{1e-3}, want: []float64{0}, rel: inf, mixed: 1e-3},
+		{got: []float64{nan, 1}, want: []float64{nan, 1}, rel: 0, mixed: 0},
+		{got: []float64{nan}, want: []float64{1}, rel: nan, mixed: nan},
+		{got: []float64{1}, want: []float64{nan}, rel: inf, mixed: inf},
+	} {
+		if got := RelError(test.got, test.want); !same(got, test.rel) {
+			t.Errorf("unexpected RelError(%v, %v): got %v want %v", test.got, test.want, got, test.rel)
+		}
+		if got := MixedError(test.got, test.want); !same(got, test.mixed) {
+			t.Errorf("unexpected MixedError(%v, %v): got %v want %v", test.got, test.want, got, test.mixed)
+		}
+	}
+}
+
+func TestRelErrorGeneral(t *testing.T) {
+	want := blas64.General{Rows: 2, Cols: 2, Stride: 3, Data: []float64{3, 0, -1, 0, 4, -1}}
+	got := blas64.General{Rows: 2, Cols: 2, Stride: 2, Data: []float64{3, 0, 0, 4.5}}
+	if e := RelErrorGeneral(got, want); math.Abs(e-0.1) > 1e-15 {
+		t.Errorf("unexpected error: got %v want 0.1", e)
+	}
+	if e := RelErrorGeneral(want, want); e != 0 {
+		t.Errorf("unexpected error of equal matrices: %v", e)
+	}
+	if e := RelErrorGeneral(blas64.General{Rows: 2, Cols: 1, Stride: 1, Data: []float64{3, 0}}, want); !math.IsInf(e, 1) {
+		t.Errorf("unexpected error for dimension mismatch: %v", e)
+	}
+}
+
+func TestULP(t *testing.T) {
+	for _, test := range []struct {
+		a, b float64
+		want uint64
+	}{
+		{a: 1, b: 1, want: 0},
+		{a: 0, b: math.Copysign(0, -1), want: 0},
+		{a: 1, b: math.Nextafter(1, 2), want: 1},
+		{a: math.Nextafter(1, 2), b: 1, want: 1},
+		{a: 1, b: 1 + 0x1p-50, want: 4},
+		{a: math.SmallestNonzeroFloat64, b: -math.SmallestNonzeroFloat64, want: 2},
+		{a: math.MaxFloat64, b: inf, want: 1},
+		{a: 1, b: nan, want: math.MaxUint64},
+		{a: -inf, b: inf, want: 0xffe0000000000000},
+	} {
+		if got := ULP(test.a, test.b); got != test.want {
+			t.Errorf("unexpected ULP(%v, %v): got %d want %d", test.a, test.b, got, test.want)
+		}
+	}
+
+	if got := ULP32(1, math.Nextafter32(1, -1)); got != 1 {
+		t.Errorf("unexpected ULP32: got %d want 1", got)
+	}
+	if got := ULP32(-1, 1); got != 0x7f000000 {
+		t.Errorf("unexpected ULP32 across zero: got %#x want 0x7f000000", got)
+	}
+	if got := MaxULP([]float64{1, nan, 2}, []float64{math.Nextafter(1, 0), nan, 2}); got != 1 {
+		t.Errorf("unexpected MaxULP: got %d want 1", got)
+	}
+}
+
+func TestTolerance(t *testing.T) {
+	for _, test := range []struct {
+		tol  Tolerance
+		a, b float64
+		want bool
+	}{
+		{tol: Tolerance{}, a: 1, b: 1, want: true},
+		{tol: Tolerance{}, a: 1, b: math.Nextafter(1, 2), want: false},
+		{tol: Tolerance{ULP: 1}, a: 1, b: math.Nextafter(1, 2), want: true},
+		{tol: Tolerance{Abs: 1e-10}, a: 0, b: 1e-11, want: true},
+		{tol: Tolerance{Rel: 1e-10}, a: 0, b: 1e-11, want: false},
+		{tol: Tolerance{Rel: 1e-3}, a: 1000, b: 1000.5, want: true},
+		{tol: Tolerance{Abs: 1}, a: inf, b: math.MaxFloat64, want: false},
+		{tol: Tolerance{}, a: inf, b: inf, want: true},
+		{tol: Tolerance{}, a: nan, b: nan, want: true},
+		{tol: Tolerance{Abs: inf}, a: nan, b: 0, want: false},
+	} {
+		if got := test.tol.Equal(test.a, test.b); got != test.want {
+			t.Errorf("unexpected %+v.Equal(%v, %v): got %t", test.tol, test.a, test.b, got)
+		}
+	}
+
+	tol := Tolerance{Rel: 1e-12}
+	if i := tol.Mismatch([]float64{1, 2, 3}, []float64{1, 2 + 1e-14, 3.1}); i != 2 {
+		t.Errorf("unexpected mismatch: got %d want 2", i)
+	}
+	if i := tol.Mismatch([]float64{1, 2}, []float64{1, 2, 3}); i != 2 {
+		t.Errorf("unexpected mismatch for length difference: got %d want 2", i)
+	}
+	if i := tol.Mismatch([]float64{1, 2}, []float64{1, 2}); i != -1 {
+		t.Errorf("unexpected mismatch of equal slices: got %d want -1", i)
+	}
+
+	a := blas64.General{Rows: 2, Cols: 2, Stride: 3, Data: []float64{1, 2, nan, 3, 4, nan}}
+	b := blas64.General{Rows: 2, Cols: 2, Stride: 2, Data: []float64{1, 2, 3, 4}}
+	if !tol.EqualGeneral(a, b) {
+		t.Error("matrices with different strides not equal")
+	}
+	b.Data[3] = 5
+	if tol.EqualGeneral(a, b) {
+		t.Error("different matrices equal")
+	}
+}
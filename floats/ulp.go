@@ -0,0 +1,73 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package floats
+
+import "math"
+
+// ULP returns the number of representable float64 values between a and b,
+// counting one of the two, so that ULP(a, math.Nextafter(a, b)) is one.
+// Positive and negative zero are zero units apart and an infinity is one
+// unit from the largest finite number of its sign. ULP returns
+// math.MaxUint64 if either argument is NaN.
+func ULP(a, b float64) uint64 {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return math.MaxUint64
+	}
+	oa, ob := ordered64(a), ordered64(b)
+	if oa < ob {
+		oa, ob = ob, oa
+	}
+	return uint64(oa) - uint64(ob)
+}
+
+// ordered64 maps x to an integer so that the order of the integers is the
+// order of the floating point values and adjacent values are adjacent.
+func ordered64(x float64) int64 {
+	b := int64(math.Float64bits(x))
+	if b < 0 {
+		b = math.MinInt64 - b
+	}
+	return b
+}
+
+// ULP32 is the float32 version of ULP.
+func ULP32(a, b float32) uint32 {
+	if a != a || b != b {
+		return math.MaxUint32
+	}
+	oa, ob := ordered32(a), ordered32(b)
+	if oa < ob {
+		oa, ob = ob, oa
+	}
+	return uint32(oa) - uint32(ob)
+}
+
+func ordered32(x float32) int32 {
+	b := int32(math.Float32bits(x))
+	if b < 0 {
+		b = math.MinInt32 - b
+	}
+	return b
+}
+
+// MaxULP returns the largest ULP distance between the corresponding
+// elements of got and want. NaN elements of want match NaN elements of got.
+// MaxULP returns math.MaxUint64 if the lengths differ.
+func MaxULP(got, want []float64) uint64 {
+	if len(got) != len(want) {
+		return math.MaxUint64
+	}
+	var d uint64
+	for i, w := range want {
+		g := got[i]
+		if math.IsNaN(g) && math.IsNaN(w) {
+			continue
+		}
+		if u := ULP(g, w); u > d {
+			d = u
+		}
+	}
+	return d
+}
@@ -0,0 +1,235 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package apicompat lists the exported API of a package from its source, so
+// that the packages of this module can check that they still provide the API
+// of the corresponding gonum.org/v1/netlib packages.
+//
+// This module keeps the module path and the package layout of
+// gonum.org/v1/netlib, so a program switches to it with a replace directive
+// in its go.mod and no change to its imports. The BLAS and LAPACK interfaces
+// that Implementation satisfies are declared in gonum.org/v1/gonum, so type
+// assertions such as impl.(lapack.Float64) hold unchanged as well. What can
+// break the switch is the removal or a change of signature of an exported
+// identifier, which is what the upstream API lists in the testdata
+// directories of the packages guard against.
+//
+// An API list has one entry per line, for example
+//
+//	func Dgetrf(int, int, []float64, int, []int32) bool
+//	method Implementation.Dgemm(blas.Transpose, blas.Transpose, int, int, int, float64, []float64, int, []float64, int, float64, []float64, int)
+//	type Implementation
+//
+// Parameter names are omitted since they are not part of the API.
+package apicompat // import "gonum.org/v1/netlib/internal/apicompat"
+
+import (
+	"bufio"
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Exported returns the sorted API list of the package in dir. Generator
+// programs excluded by the ignore build tag and test files are skipped. The
+// identifiers that are keys of aliases are replaced by their values in the
+// signatures, so that a type alias such as Int = int32 is listed as the type
+// it stands for.
+func Exported(dir string, aliases map[string]string) ([]string, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	set := make(map[string]bool)
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			if ignored(f) {
+				continue
+			}
+			for _, d := range f.Decls {
+				for _, e := range entries(fset, d, aliases) {
+					set[e] = true
+				}
+			}
+		}
+	}
+	api := make([]string, 0, len(set))
+	for e := range set {
+		api = append(api, e)
+	}
+	sort.Strings(api)
+	return api, nil
+}
+
+// Missing returns the entries of the API list in the file at path that are
+// not provided by the package in dir.
+func Missing(dir, path string, aliases map[string]string) ([]string, error) {
+	api, err := Exported(dir, aliases)
+	if err != nil {
+		return nil, err
+	}
+	have := make(map[string]bool, len(api))
+	for _, e := range api {
+		have[e] = true
+	}
+	f, err := os.Open(filepath.FromSlash(path))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var missing []string
+	sc := bufio.NewScanner(f)
+	sc.Buffer(nil, 1<<20)
+	for sc.Scan() {
+		e := strings.TrimSpace(sc.Text())
+		if e != "" && !have[e] {
+			missing = append(missing, e)
+		}
+	}
+	return missing, sc.Err()
+}
+
+// ignored returns whether f is excluded from builds by the ignore tag.
+func ignored(f *ast.File) bool {
+	for _, g := range f.Comments {
+		if g.Pos() > f.Package {
+			break
+		}
+		for _, c := range g.List {
+			if c.Text == "//go:build ignore" || c.Text == "// +build ignore" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// entries returns the API list entries of the declaration d.
+func entries(fset *token.FileSet, d ast.Decl, aliases map[string]string) []string {
+	switch d := d.(type) {
+	case *ast.FuncDecl:
+		if !d.Name.IsExported() {
+			return nil
+		}
+		sig := signature(fset, d.Type, aliases)
+		if d.Recv == nil {
+			return []string{"func " + d.Name.Name + sig}
+		}
+		recv := receiver(d.Recv.List[0].Type)
+		if !ast.IsExported(recv) {
+			return nil
+		}
+		return []string{"method " + recv + "." + d.Name.Name + sig}
+	case *ast.GenDecl:
+		var list []string
+		for _, s := range d.Specs {
+			switch s := s.(type) {
+			case *ast.TypeSpec:
+				if s.Name.IsExported() {
+					list = append(list, "type "+s.Name.Name)
+				}
+			case *ast.ValueSpec:
+				for _, n := range s.Names {
+					if n.IsExported() {
+						list = append(list, d.Tok.String()+" "+n.Name)
+					}
+				}
+			}
+		}
+		return list
+	}
+	return nil
+}
+
+// receiver returns the name of the receiver base type t.
+func receiver(t ast.Expr) string {
+	if s, ok := t.(*ast.StarExpr); ok {
+		t = s.X
+	}
+	if id, ok := t.(*ast.Ident); ok {
+		return id.Name
+	}
+	return ""
+}
+
+// signature returns the parameter and result types of t without their
+// names.
+func signature(fset *token.FileSet, t *ast.FuncType, aliases map[string]string) string {
+	sig := "(" + strings.Join(types(fset, t.Params, aliases), ", ") + ")"
+	res := types(fset, t.Results, aliases)
+	switch len(res) {
+	case 0:
+	case 1:
+		sig += " " + res[0]
+	default:
+		sig += " (" + strings.Join(res, ", ") + ")"
+	}
+	return sig
+}
+
+// types returns the types of the fields in l, with a type repeated for each
+// name that it declares.
+func types(fset *token.FileSet, l *ast.FieldList, aliases map[string]string) []string {
+	if l == nil {
+		return nil
+	}
+	var list []string
+	for _, f := range l.List {
+		var buf bytes.Buffer
+		printer.Fprint(&buf, fset, f.Type)
+		typ := replaceIdents(buf.String(), aliases)
+		for i := 0; i < max(1, len(f.Names)); i++ {
+			list = append(list, typ)
+		}
+	}
+	return list
+}
+
+// replaceIdents replaces the identifiers in the type expression s that are
+// keys of aliases by their values.
+func replaceIdents(s string, aliases map[string]string) string {
+	if len(aliases) == 0 {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		j := i
+		for j < len(s) && isIdentByte(s[j]) {
+			j++
+		}
+		if j == i {
+			b.WriteByte(s[i])
+			i++
+			continue
+		}
+		id := s[i:j]
+		// A selector such as blas.Int is not replaced.
+		if v, ok := aliases[id]; ok && (i == 0 || s[i-1] != '.') {
+			id = v
+		}
+		b.WriteString(id)
+		i = j
+	}
+	return b.String()
+}
+
+func isIdentByte(c byte) bool {
+	return c == '_' || '0' <= c && c <= '9' || 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,92 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package apicompat
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const src = `package p
+
+import "gonum.org/v1/gonum/blas"
+
+type Int = int32
+
+type T struct{}
+
+type u struct{}
+
+const C, d = 1, 2
+
+var V int
+
+func (T) M(a, b int, t blas.Transpose) (x []Int, ok bool) { return nil, false }
+
+func (*T) P(v Int) {}
+
+func (u) M() {}
+
+func F(ipiv []Int, s blas.Int) Int { return 0 }
+
+func f() {}
+`
+
+const generator = `// +build ignore
+
+package main
+
+func Exported() {}
+`
+
+func TestExported(t *testing.T) {
+	dir, err := ioutil.TempDir("", "apicompat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for name, s := range map[string]string{
+		"p.go":      src,
+		"p_test.go": "package p\n\nfunc Test() {}\n",
+		"gen.go":    generator,
+	} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(s), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := Exported(dir, map[string]string{"Int": "int32"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"const C",
+		"func F([]int32, blas.Int) int32",
+		"method T.M(int, int, blas.Transpose) ([]int32, bool)",
+		"method T.P(int32)",
+		"type Int",
+		"type T",
+		"var V",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected API:\ngot  %q\nwant %q", got, want)
+	}
+
+	list := filepath.Join(dir, "api.txt")
+	err = ioutil.WriteFile(list, []byte("func F([]int32, blas.Int) int32\nfunc G()\n\ntype T\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	missing, err := Missing(dir, list, map[string]string{"Int": "int32"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(missing, []string{"func G()"}) {
+		t.Errorf("unexpected missing entries: %q", missing)
+	}
+}
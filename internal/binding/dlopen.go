@@ -0,0 +1,345 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package binding
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"text/template"
+)
+
+// Dlopen describes a C source file that defines the functions of a C
+// interface as trampolines into a shared library that is loaded at run time
+// with dlopen or LoadLibrary instead of being linked at build time.
+//
+// Each trampoline resolves its symbol from the loaded library on its first
+// call and then calls through the cached function pointer. If no library has
+// been loaded when the first trampoline is called, the library named by the
+// environment variable Env is loaded, or if Env is not set, the first of the
+// candidates for the target system that can be loaded.
+//
+// If the symbol is missing from the library, the trampoline calls through a
+// generated stub instead, which passes the name of the symbol to the Go
+// function exported by the package as
+//  //export netlib_<Prefix>_unsupported
+//  func netlib_<Prefix>_unsupported(name, err *C.char)
+// with a nil err. If no library can be loaded, the trampoline passes the
+// error of the library named by Env, or of the first candidate, as err.
+// The Go function is expected to panic, so that a call of a routine that
+// the library lacks can be recovered instead of aborting the program.
+type Dlopen struct {
+	// Header is the path of the C header declaring the functions.
+	Header string
+
+	// Prefix is used to name the C loader functions so that the loaders
+	// of several packages can be linked into one program. The loader of
+	// a package is called as
+	//  int netlib_<Prefix>_open(const char *path, char **err);
+	//  const char *netlib_<Prefix>_path(void);
+	//  void *netlib_<Prefix>_symbol(const char *name);
+	Prefix string
+
+	// Env is the name of the environment variable holding the path of the
+	// library to load by default.
+	Env string
+
+	// Candidates, DarwinCandidates and WindowsCandidates are the lists of
+	// libraries tried in order when Env is not set on the systems other
+	// than Darwin and Windows, on Darwin and on Windows respectively.
+	Candidates        []string
+	DarwinCandidates  []string
+	WindowsCandidates []string
+
+	// BuildTag is the build tag that selects the generated file.
+	BuildTag string
+
+	// Generator is the package path used in the generated code header.
+	Generator string
+}
+
+// Generate writes the C source for trampolines to the functions in decls
+// that are named in names, in the order of decls.
+func (c Dlopen) Generate(w io.Writer, decls []Declaration, names map[string]bool) error {
+	src, err := ioutil.ReadFile(c.Header)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	err = dlopenLoader.Execute(&buf, c)
+	if err != nil {
+		return err
+	}
+	for _, d := range decls {
+		if !names[d.Name] {
+			continue
+		}
+		ret, params, err := prototype(src, d.Name)
+		if err != nil {
+			return err
+		}
+		var args []string
+		for _, p := range d.Parameters() {
+			args = append(args, p.Name())
+		}
+		call := fmt.Sprintf("fn(%s);", strings.Join(args, ", "))
+		if ret != "void" {
+			call = "return " + call
+		}
+		fmt.Fprintf(&buf, `
+static %[1]s netlib_missing_%[2]s(%[3]s)
+{
+	netlib_%[5]s_unsupported((char *)%[2]q, NULL);
+	abort();
+}
+
+%[1]s %[2]s(%[3]s)
+{
+	static __typeof__(%[2]s) *fn;
+	if (fn == NULL) {
+		fn = (__typeof__(%[2]s) *)netlib_resolve(%[2]q);
+		if (fn == NULL) {
+			fn = netlib_missing_%[2]s;
+		}
+	}
+	%[4]s
+}
+`, ret, d.Name, params, call, c.Prefix)
+	}
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// prototype returns the return type and the parameter list of the function
+// declaration of name in the header source src, as written in the header.
+func prototype(src []byte, name string) (ret, params string, err error) {
+	s := string(src)
+	var i int
+	for {
+		j := strings.Index(s[i:], name)
+		if j < 0 {
+			return "", "", fmt.Errorf("binding: no declaration of %s", name)
+		}
+		i += j
+		k := i + len(name)
+		rest := strings.TrimLeft(s[k:], " \t")
+		if (i == 0 || !isIdent(s[i-1])) && strings.HasPrefix(rest, "(") {
+			break
+		}
+		i = k
+	}
+
+	start := strings.LastIndex(s[:i], "\n") + 1
+	ret = strings.TrimSpace(s[start:i])
+
+	open := strings.Index(s[i:], "(") + i
+	depth := 0
+	for k := open; k < len(s); k++ {
+		switch s[k] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				params = strings.Join(strings.Fields(s[open+1:k]), " ")
+				return ret, params, nil
+			}
+		}
+	}
+	return "", "", fmt.Errorf("binding: unterminated declaration of %s", name)
+}
+
+func isIdent(b byte) bool {
+	return b == '_' || '0' <= b && b <= '9' || 'a' <= b && b <= 'z' || 'A' <= b && b <= 'Z'
+}
+
+var dlopenLoader = template.Must(template.New("loader").Parse(`// Code generated by "go generate {{.Generator}}" from {{.Header}}; DO NOT EDIT.
+
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build {{.BuildTag}}
+// +build {{.BuildTag}}
+
+#include <stdio.h>
+#include <stdlib.h>
+#include <string.h>
+#ifdef _WIN32
+#include <windows.h>
+#else
+#include <dlfcn.h>
+#include <pthread.h>
+#endif
+
+#include "{{.Header}}"
+
+#ifdef _WIN32
+static SRWLOCK netlib_lock = SRWLOCK_INIT;
+#define netlib_acquire() AcquireSRWLockExclusive(&netlib_lock)
+#define netlib_release() ReleaseSRWLockExclusive(&netlib_lock)
+#else
+static pthread_mutex_t netlib_lock = PTHREAD_MUTEX_INITIALIZER;
+#define netlib_acquire() pthread_mutex_lock(&netlib_lock)
+#define netlib_release() pthread_mutex_unlock(&netlib_lock)
+#endif
+
+// netlib_{{.Prefix}}_unsupported is implemented in Go and panics with an
+// error naming the missing symbol and, if err is not NULL, the reason no
+// library could be loaded.
+extern void netlib_{{.Prefix}}_unsupported(char *name, char *err);
+
+static void *netlib_handle;
+static char *netlib_library;
+static char netlib_error[1024];
+
+static const char *netlib_candidates[] = {
+#if defined(__APPLE__)
+{{- range .DarwinCandidates}}
+	"{{.}}",
+{{- end}}
+#elif defined(_WIN32)
+{{- range .WindowsCandidates}}
+	"{{.}}",
+{{- end}}
+#else
+{{- range .Candidates}}
+	"{{.}}",
+{{- end}}
+#endif
+	NULL,
+};
+
+// netlib_load loads the library at path. It must be called with
+// netlib_lock held.
+static int netlib_load(const char *path)
+{
+#ifdef _WIN32
+	void *h = (void *)LoadLibraryA(path);
+	if (h == NULL) {
+		snprintf(netlib_error, sizeof(netlib_error), "%s: LoadLibrary failed with error %lu", path, (unsigned long)GetLastError());
+		return -1;
+	}
+#else
+	void *h = dlopen(path, RTLD_NOW | RTLD_LOCAL);
+	if (h == NULL) {
+		snprintf(netlib_error, sizeof(netlib_error), "%s", dlerror());
+		return -1;
+	}
+#endif
+	netlib_handle = h;
+	netlib_library = strdup(path);
+	return 0;
+}
+
+// netlib_{{.Prefix}}_open loads the library at path if no library has been
+// loaded. It returns non-zero and sets err if the library cannot be loaded
+// or if a different library has already been loaded.
+int netlib_{{.Prefix}}_open(const char *path, char **err)
+{
+	int ret = 0;
+	netlib_acquire();
+	if (netlib_handle == NULL) {
+		ret = netlib_load(path);
+	} else if (strcmp(path, netlib_library) != 0) {
+		snprintf(netlib_error, sizeof(netlib_error), "%s already loaded", netlib_library);
+		ret = -1;
+	}
+	if (ret != 0) {
+		*err = strdup(netlib_error);
+	}
+	netlib_release();
+	return ret;
+}
+
+// netlib_{{.Prefix}}_path returns the path of the loaded library, or NULL
+// if no library has been loaded.
+const char *netlib_{{.Prefix}}_path(void)
+{
+	const char *path;
+	netlib_acquire();
+	path = netlib_library;
+	netlib_release();
+	return path;
+}
+
+// netlib_load_default loads the default library if no library has been
+// loaded. If none can be loaded, it leaves the error of the library named
+// by the environment variable, or of the first candidate, in netlib_error.
+// It must be called with netlib_lock held.
+static void netlib_load_default(void)
+{
+	if (netlib_handle != NULL) {
+		return;
+	}
+	const char *path = getenv("{{.Env}}");
+	if (path != NULL && path[0] != '\0') {
+		netlib_load(path);
+		return;
+	}
+	char first[sizeof(netlib_error)] = "no candidate library";
+	for (int i = 0; netlib_candidates[i] != NULL; i++) {
+		if (netlib_load(netlib_candidates[i]) == 0) {
+			return;
+		}
+		if (i == 0) {
+			memcpy(first, netlib_error, sizeof(first));
+		}
+	}
+	memcpy(netlib_error, first, sizeof(netlib_error));
+}
+
+// netlib_lookup returns the address of the named symbol in the loaded
+// library, or NULL if it is missing. It must be called with netlib_lock
+// held and a library loaded.
+static void *netlib_lookup(const char *name)
+{
+#ifdef _WIN32
+	return (void *)GetProcAddress((HMODULE)netlib_handle, name);
+#else
+	return dlsym(netlib_handle, name);
+#endif
+}
+
+// netlib_resolve returns the address of the named symbol in the loaded
+// library, loading the default library first if necessary, or NULL if the
+// symbol is missing. If no library can be loaded, it passes the error to
+// netlib_{{.Prefix}}_unsupported, which panics.
+static void *netlib_resolve(const char *name)
+{
+	void *fn;
+	netlib_acquire();
+	netlib_load_default();
+	if (netlib_handle == NULL) {
+		char err[sizeof(netlib_error) + 64];
+		snprintf(err, sizeof(err), "%s (set {{.Env}})", netlib_error);
+		netlib_release();
+		netlib_{{.Prefix}}_unsupported((char *)name, err);
+		abort();
+	}
+	fn = netlib_lookup(name);
+	netlib_release();
+	return fn;
+}
+
+// netlib_{{.Prefix}}_symbol returns the address of the named symbol in the
+// loaded library, loading the default library first if necessary. Unlike
+// the trampolines it returns NULL if no library can be loaded or the symbol
+// is missing, so that optional extensions of a library can be detected.
+void *netlib_{{.Prefix}}_symbol(const char *name)
+{
+	void *fn = NULL;
+	netlib_acquire();
+	netlib_load_default();
+	if (netlib_handle != NULL) {
+		fn = netlib_lookup(name);
+	}
+	netlib_release();
+	return fn;
+}
+`))
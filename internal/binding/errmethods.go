@@ -0,0 +1,192 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package binding
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrMethods describes a Go source file that defines, for each exported
+// method of a panicking receiver type, a method of an error-returning type
+// with the same parameters and an additional error result. Each generated
+// method defers a call to the Catch function, which recovers argument
+// check panics, and then calls the method of the panicking type.
+type ErrMethods struct {
+	// Package is the name of the package holding both types.
+	Package string
+
+	// Receiver is the name of the panicking type.
+	Receiver string
+
+	// Type is the name of the error-returning type.
+	Type string
+
+	// Catch is the name of the function deferred by each method. It is
+	// called as
+	//  defer Catch("<method name>", &err)
+	Catch string
+
+	// Generator is the package path used in the generated code header.
+	Generator string
+}
+
+// Generate writes the Go source for the error-returning methods of the
+// Receiver methods declared in the Go files of the directory dir. Test
+// files and files in other packages are ignored, as is the output file
+// skip. The methods are written in the order of their declaration, with
+// the files taken in lexical order.
+func (e ErrMethods) Generate(w io.Writer, dir, skip string) error {
+	fset := token.NewFileSet()
+	filter := func(fi os.FileInfo) bool {
+		name := fi.Name()
+		return name != skip && !strings.HasSuffix(name, "_test.go")
+	}
+	pkgs, err := parser.ParseDir(fset, dir, filter, 0)
+	if err != nil {
+		return err
+	}
+	pkg, ok := pkgs[e.Package]
+	if !ok {
+		return fmt.Errorf("binding: no package %s in %s", e.Package, dir)
+	}
+	var files []string
+	for name := range pkg.Files {
+		files = append(files, name)
+	}
+	sort.Strings(files)
+
+	imports := make(map[string]string)
+	used := make(map[string]bool)
+	seen := make(map[string]bool)
+	var body bytes.Buffer
+	for _, name := range files {
+		f := pkg.Files[name]
+		for _, spec := range f.Imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+			local := filepath.Base(path)
+			if spec.Name != nil {
+				local = spec.Name.Name
+			}
+			imports[local] = path
+		}
+		for _, d := range f.Decls {
+			fn, ok := d.(*ast.FuncDecl)
+			if !ok || !fn.Name.IsExported() || !e.isReceiver(fn) || seen[fn.Name.Name] {
+				continue
+			}
+			seen[fn.Name.Name] = true
+			err := e.method(&body, fset, fn, used)
+			if err != nil {
+				return fmt.Errorf("binding: %s: %v", fset.Position(fn.Pos()), err)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by \"go generate %s\"; DO NOT EDIT.\n\npackage %s\n\n", e.Generator, e.Package)
+	if len(used) != 0 {
+		var paths []string
+		for local := range used {
+			path, ok := imports[local]
+			if !ok {
+				return fmt.Errorf("binding: no import for %s", local)
+			}
+			paths = append(paths, strconv.Quote(path))
+		}
+		sort.Strings(paths)
+		fmt.Fprintf(&buf, "import (\n%s\n)\n", strings.Join(paths, "\n"))
+	}
+	buf.Write(body.Bytes())
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+// isReceiver returns whether fn is a method with a value receiver of the
+// Receiver type.
+func (e ErrMethods) isReceiver(fn *ast.FuncDecl) bool {
+	if fn.Recv == nil || len(fn.Recv.List) != 1 {
+		return false
+	}
+	id, ok := fn.Recv.List[0].Type.(*ast.Ident)
+	return ok && id.Name == e.Receiver
+}
+
+// method writes the error-returning version of fn to w and records the
+// imported packages referred to by its signature in used.
+func (e ErrMethods) method(w io.Writer, fset *token.FileSet, fn *ast.FuncDecl, used map[string]bool) error {
+	ast.Inspect(fn.Type, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok {
+				used[id.Name] = true
+			}
+		}
+		return true
+	})
+
+	var params, args []string
+	for _, f := range fn.Type.Params.List {
+		if len(f.Names) == 0 {
+			return fmt.Errorf("unnamed parameter of %s", fn.Name.Name)
+		}
+		var names []string
+		for _, n := range f.Names {
+			if n.Name == "err" {
+				return fmt.Errorf("parameter of %s named err", fn.Name.Name)
+			}
+			names = append(names, n.Name)
+		}
+		args = append(args, names...)
+		params = append(params, strings.Join(names, ", ")+" "+expr(fset, f.Type))
+	}
+
+	var results, names []string
+	if fn.Type.Results != nil {
+		for _, f := range fn.Type.Results.List {
+			var fieldNames []string
+			for _, n := range f.Names {
+				fieldNames = append(fieldNames, n.Name)
+			}
+			if len(fieldNames) == 0 {
+				fieldNames = []string{fmt.Sprintf("r%d", len(names))}
+			}
+			names = append(names, fieldNames...)
+			results = append(results, strings.Join(fieldNames, ", ")+" "+expr(fset, f.Type))
+		}
+	}
+	results = append(results, "err error")
+
+	call := fmt.Sprintf("%s{}.%s(%s)", e.Receiver, fn.Name.Name, strings.Join(args, ", "))
+	fmt.Fprintf(w, "\n// %[1]s is the error-returning version of %[2]s.%[1]s.\n", fn.Name.Name, e.Receiver)
+	fmt.Fprintf(w, "func (%s) %s(%s) (%s) {\n", e.Type, fn.Name.Name, strings.Join(params, ", "), strings.Join(results, ", "))
+	fmt.Fprintf(w, "\tdefer %s(%q, &err)\n", e.Catch, fn.Name.Name)
+	if len(names) == 0 {
+		fmt.Fprintf(w, "\t%s\n\treturn nil\n}\n", call)
+		return nil
+	}
+	fmt.Fprintf(w, "\t%s = %s\n\treturn %s, nil\n}\n", strings.Join(names, ", "), call, strings.Join(names, ", "))
+	return nil
+}
+
+func expr(fset *token.FileSet, x ast.Expr) string {
+	var buf bytes.Buffer
+	format.Node(&buf, fset, x)
+	return buf.String()
+}
@@ -0,0 +1,393 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package buffer provides a registry of temporary buffers for data that must
+// be copied into a different layout, for example transposed or packed, before
+// it is passed to a C routine.
+//
+// Buffers are obtained from a Scope and are returned to the Registry when the
+// Scope is released, so their lifetime is bounded by the caller:
+//
+//	s := buffer.NewScope()
+//	defer s.Release()
+//	at := s.Float64s(m * n)
+//
+// Buffers are ordinary Go allocations that contain no Go pointers, so they
+// may be passed to C for the duration of a call. A buffer must not be used
+// after the Scope it was obtained from has been released.
+package buffer // import "gonum.org/v1/netlib/internal/buffer"
+
+import (
+	"math/bits"
+	"sync"
+)
+
+// Default is the registry used by NewScope.
+var Default = &Registry{}
+
+// NewScope returns a new Scope drawing buffers from the Default registry.
+func NewScope() *Scope {
+	return Default.NewScope()
+}
+
+// maxClass is the largest size class kept for reuse. Buffers with more than
+// 1<<maxClass elements are allocated directly and dropped on release.
+const maxClass = 26
+
+// Stats holds usage metrics of a Registry.
+type Stats struct {
+	// Gets is the number of buffers handed out.
+	Gets uint64
+	// Allocs is the number of buffers that had to be allocated
+	// because no free buffer of the required size was available.
+	Allocs uint64
+	// Live is the number of buffers that have been handed out and
+	// not yet released.
+	Live int
+	// LiveBytes and PeakBytes are the current and the largest number of
+	// bytes held by buffers that have been handed out.
+	LiveBytes, PeakBytes int64
+	// FreeBytes is the number of bytes held by free buffers kept for
+	// reuse.
+	FreeBytes int64
+}
+
+// Registry is a lazily grown set of reusable buffers, grouped by element
+// type and power of two size class. A Registry is safe for concurrent use.
+// The zero value is an empty Registry ready to use.
+type Registry struct {
+	mu sync.Mutex
+
+	f64  [maxClass + 1][][]float64
+	f32  [maxClass + 1][][]float32
+	c128 [maxClass + 1][][]complex128
+	c64  [maxClass + 1][][]complex64
+	i32  [maxClass + 1][][]int32
+
+	stats Stats
+}
+
+// NewScope returns a new Scope drawing buffers from r.
+func (r *Registry) NewScope() *Scope {
+	return &Scope{r: r}
+}
+
+// Stats returns the current usage metrics of r.
+func (r *Registry) Stats() Stats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.stats
+}
+
+// Reset drops all free buffers held by r. Buffers that are in use are not
+// affected.
+func (r *Registry) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.f64 = [maxClass + 1][][]float64{}
+	r.f32 = [maxClass + 1][][]float32{}
+	r.c128 = [maxClass + 1][][]complex128{}
+	r.c64 = [maxClass + 1][][]complex64{}
+	r.i32 = [maxClass + 1][][]int32{}
+	r.stats.FreeBytes = 0
+}
+
+// class returns the size class for n elements and whether buffers of that
+// class are kept for reuse.
+func class(n int) (int, bool) {
+	if n <= 1 {
+		return 0, true
+	}
+	c := bits.Len(uint(n - 1))
+	return c, c <= maxClass
+}
+
+// capacity returns the capacity of a newly allocated buffer for n elements
+// in size class c. Buffers that are not kept for reuse are not rounded up.
+func capacity(n, c int, ok bool) int {
+	if !ok {
+		return n
+	}
+	return 1 << uint(c)
+}
+
+// acquired records that a buffer of the given size has been handed out.
+// It must be called with r.mu held.
+func (r *Registry) acquired(bytes int64, alloc bool) {
+	r.stats.Gets++
+	if alloc {
+		r.stats.Allocs++
+	}
+	r.stats.Live++
+	r.stats.LiveBytes += bytes
+	if !alloc {
+		r.stats.FreeBytes -= bytes
+	}
+	if r.stats.LiveBytes > r.stats.PeakBytes {
+		r.stats.PeakBytes = r.stats.LiveBytes
+	}
+}
+
+// released records that a buffer of the given size has been returned and
+// whether it is kept for reuse. It must be called with r.mu held.
+func (r *Registry) released(bytes int64, kept bool) {
+	r.stats.Live--
+	r.stats.LiveBytes -= bytes
+	if kept {
+		r.stats.FreeBytes += bytes
+	}
+}
+
+func (r *Registry) getFloat64s(n int) []float64 {
+	c, ok := class(n)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var buf []float64
+	if ok {
+		if free := r.f64[c]; len(free) != 0 {
+			buf = free[len(free)-1]
+			r.f64[c] = free[:len(free)-1]
+		}
+	}
+	alloc := buf == nil
+	if alloc {
+		buf = make([]float64, capacity(n, c, ok))
+	}
+	r.acquired(int64(cap(buf))*8, alloc)
+	buf = buf[:n]
+	for i := range buf {
+		buf[i] = 0
+	}
+	return buf
+}
+
+func (r *Registry) putFloat64s(buf []float64) {
+	c, ok := class(cap(buf))
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.released(int64(cap(buf))*8, ok)
+	if ok {
+		r.f64[c] = append(r.f64[c], buf[:0])
+	}
+}
+
+func (r *Registry) getFloat32s(n int) []float32 {
+	c, ok := class(n)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var buf []float32
+	if ok {
+		if free := r.f32[c]; len(free) != 0 {
+			buf = free[len(free)-1]
+			r.f32[c] = free[:len(free)-1]
+		}
+	}
+	alloc := buf == nil
+	if alloc {
+		buf = make([]float32, capacity(n, c, ok))
+	}
+	r.acquired(int64(cap(buf))*4, alloc)
+	buf = buf[:n]
+	for i := range buf {
+		buf[i] = 0
+	}
+	return buf
+}
+
+func (r *Registry) putFloat32s(buf []float32) {
+	c, ok := class(cap(buf))
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.released(int64(cap(buf))*4, ok)
+	if ok {
+		r.f32[c] = append(r.f32[c], buf[:0])
+	}
+}
+
+func (r *Registry) getComplex128s(n int) []complex128 {
+	c, ok := class(n)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var buf []complex128
+	if ok {
+		if free := r.c128[c]; len(free) != 0 {
+			buf = free[len(free)-1]
+			r.c128[c] = free[:len(free)-1]
+		}
+	}
+	alloc := buf == nil
+	if alloc {
+		buf = make([]complex128, capacity(n, c, ok))
+	}
+	r.acquired(int64(cap(buf))*16, alloc)
+	buf = buf[:n]
+	for i := range buf {
+		buf[i] = 0
+	}
+	return buf
+}
+
+func (r *Registry) putComplex128s(buf []complex128) {
+	c, ok := class(cap(buf))
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.released(int64(cap(buf))*16, ok)
+	if ok {
+		r.c128[c] = append(r.c128[c], buf[:0])
+	}
+}
+
+func (r *Registry) getComplex64s(n int) []complex64 {
+	c, ok := class(n)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var buf []complex64
+	if ok {
+		if free := r.c64[c]; len(free) != 0 {
+			buf = free[len(free)-1]
+			r.c64[c] = free[:len(free)-1]
+		}
+	}
+	alloc := buf == nil
+	if alloc {
+		buf = make([]complex64, capacity(n, c, ok))
+	}
+	r.acquired(int64(cap(buf))*8, alloc)
+	buf = buf[:n]
+	for i := range buf {
+		buf[i] = 0
+	}
+	return buf
+}
+
+func (r *Registry) putComplex64s(buf []complex64) {
+	c, ok := class(cap(buf))
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.released(int64(cap(buf))*8, ok)
+	if ok {
+		r.c64[c] = append(r.c64[c], buf[:0])
+	}
+}
+
+func (r *Registry) getInt32s(n int) []int32 {
+	c, ok := class(n)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var buf []int32
+	if ok {
+		if free := r.i32[c]; len(free) != 0 {
+			buf = free[len(free)-1]
+			r.i32[c] = free[:len(free)-1]
+		}
+	}
+	alloc := buf == nil
+	if alloc {
+		buf = make([]int32, capacity(n, c, ok))
+	}
+	r.acquired(int64(cap(buf))*4, alloc)
+	buf = buf[:n]
+	for i := range buf {
+		buf[i] = 0
+	}
+	return buf
+}
+
+func (r *Registry) putInt32s(buf []int32) {
+	c, ok := class(cap(buf))
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.released(int64(cap(buf))*4, ok)
+	if ok {
+		r.i32[c] = append(r.i32[c], buf[:0])
+	}
+}
+
+// Scope tracks the buffers obtained by a single operation and returns them
+// to their Registry when it is released. A Scope must not be used
+// concurrently by multiple goroutines.
+type Scope struct {
+	r *Registry
+
+	f64      [][]float64
+	f32      [][]float32
+	c128     [][]complex128
+	c64      [][]complex64
+	i32      [][]int32
+	released bool
+}
+
+func (s *Scope) check(n int) {
+	if s.released {
+		panic("buffer: use of released scope")
+	}
+	if n < 0 {
+		panic("buffer: negative length")
+	}
+}
+
+// Float64s returns a zeroed buffer of n float64 values.
+func (s *Scope) Float64s(n int) []float64 {
+	s.check(n)
+	buf := s.r.getFloat64s(n)
+	s.f64 = append(s.f64, buf)
+	return buf
+}
+
+// Float32s returns a zeroed buffer of n float32 values.
+func (s *Scope) Float32s(n int) []float32 {
+	s.check(n)
+	buf := s.r.getFloat32s(n)
+	s.f32 = append(s.f32, buf)
+	return buf
+}
+
+// Complex128s returns a zeroed buffer of n complex128 values.
+func (s *Scope) Complex128s(n int) []complex128 {
+	s.check(n)
+	buf := s.r.getComplex128s(n)
+	s.c128 = append(s.c128, buf)
+	return buf
+}
+
+// Complex64s returns a zeroed buffer of n complex64 values.
+func (s *Scope) Complex64s(n int) []complex64 {
+	s.check(n)
+	buf := s.r.getComplex64s(n)
+	s.c64 = append(s.c64, buf)
+	return buf
+}
+
+// Int32s returns a zeroed buffer of n int32 values.
+func (s *Scope) Int32s(n int) []int32 {
+	s.check(n)
+	buf := s.r.getInt32s(n)
+	s.i32 = append(s.i32, buf)
+	return buf
+}
+
+// Release returns all buffers obtained from s to its Registry. Release
+// may be called more than once; calls after the first have no effect.
+func (s *Scope) Release() {
+	if s.released {
+		return
+	}
+	s.released = true
+	for _, buf := range s.f64 {
+		s.r.putFloat64s(buf)
+	}
+	for _, buf := range s.f32 {
+		s.r.putFloat32s(buf)
+	}
+	for _, buf := range s.c128 {
+		s.r.putComplex128s(buf)
+	}
+	for _, buf := range s.c64 {
+		s.r.putComplex64s(buf)
+	}
+	for _, buf := range s.i32 {
+		s.r.putInt32s(buf)
+	}
+	s.f64, s.f32, s.c128, s.c64, s.i32 = nil, nil, nil, nil, nil
+}
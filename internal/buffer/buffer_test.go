@@ -0,0 +1,127 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package buffer
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestScopeRelease(t *testing.T) {
+	var r Registry
+	s := r.NewScope()
+	lens := []int{0, 1, 3, 100, 1 << 10}
+	for _, n := range lens {
+		if got := len(s.Float64s(n)); got != n {
+			t.Errorf("unexpected Float64s length: got %d want %d", got, n)
+		}
+		if got := len(s.Float32s(n)); got != n {
+			t.Errorf("unexpected Float32s length: got %d want %d", got, n)
+		}
+		if got := len(s.Complex128s(n)); got != n {
+			t.Errorf("unexpected Complex128s length: got %d want %d", got, n)
+		}
+		if got := len(s.Complex64s(n)); got != n {
+			t.Errorf("unexpected Complex64s length: got %d want %d", got, n)
+		}
+		if got := len(s.Int32s(n)); got != n {
+			t.Errorf("unexpected Int32s length: got %d want %d", got, n)
+		}
+	}
+	stats := r.Stats()
+	if want := 5 * len(lens); stats.Live != want {
+		t.Errorf("unexpected live buffer count: got %d want %d", stats.Live, want)
+	}
+	if stats.LiveBytes == 0 || stats.PeakBytes != stats.LiveBytes {
+		t.Errorf("unexpected byte counts: live=%d peak=%d", stats.LiveBytes, stats.PeakBytes)
+	}
+
+	s.Release()
+	s.Release()
+	stats = r.Stats()
+	if stats.Live != 0 || stats.LiveBytes != 0 {
+		t.Errorf("buffers leaked after release: %+v", stats)
+	}
+	if stats.FreeBytes != stats.PeakBytes {
+		t.Errorf("unexpected free bytes after release: got %d want %d", stats.FreeBytes, stats.PeakBytes)
+	}
+	r.Reset()
+	if stats = r.Stats(); stats.FreeBytes != 0 {
+		t.Errorf("unexpected free bytes after reset: %d", stats.FreeBytes)
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected panic for use of released scope")
+			}
+		}()
+		s.Float64s(1)
+	}()
+}
+
+func TestReuse(t *testing.T) {
+	var r Registry
+	s := r.NewScope()
+	buf := s.Float64s(100)
+	for i := range buf {
+		buf[i] = float64(i + 1)
+	}
+	s.Release()
+
+	s = r.NewScope()
+	buf = s.Float64s(70)
+	for i, v := range buf {
+		if v != 0 {
+			t.Fatalf("reused buffer not zeroed at %d: %v", i, v)
+		}
+	}
+	s.Release()
+	stats := r.Stats()
+	if stats.Gets != 2 || stats.Allocs != 1 {
+		t.Errorf("unexpected reuse: gets=%d allocs=%d", stats.Gets, stats.Allocs)
+	}
+
+	r.Reset()
+	s = r.NewScope()
+	s.Float64s(100)
+	s.Release()
+	if stats := r.Stats(); stats.Allocs != 2 {
+		t.Errorf("unexpected allocation count after reset: %d", stats.Allocs)
+	}
+}
+
+func TestConcurrentScopes(t *testing.T) {
+	var r Registry
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				s := r.NewScope()
+				a := s.Float64s(g*10 + i)
+				b := s.Int32s(i)
+				for j := range a {
+					a[j] = float64(g)
+				}
+				for j := range b {
+					b[j] = int32(g)
+				}
+				for j := range a {
+					if a[j] != float64(g) {
+						t.Errorf("buffer shared between scopes")
+						break
+					}
+				}
+				s.Release()
+			}
+		}(g)
+	}
+	wg.Wait()
+	if stats := r.Stats(); stats.Live != 0 || stats.LiveBytes != 0 {
+		t.Errorf("buffers leaked after release: %+v", stats)
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package golden provides a corpus of results of BLAS and LAPACK routines
+// recorded from a reference implementation, against which the results of
+// other implementations are verified.
+//
+// A Routine describes a canonical set of inputs for a routine and how the
+// routine is called. Record calls the routines on their canonical inputs
+// and stores the inputs together with the results in a Corpus. Verify calls
+// the routines again on the stored inputs and compares the results with the
+// stored ones, so that an implementation that computes wrong results, and
+// not only one that crashes, is detected.
+//
+// The corpora of the netlib packages are kept in their testdata directories.
+// The Source field of a corpus names the implementation it was recorded from.
+package golden // import "gonum.org/v1/netlib/internal/golden"
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/netlib/floats"
+)
+
+// Case holds the inputs and results of a single call of a routine.
+type Case struct {
+	// Routine is the name of the routine.
+	Routine string `json:"routine"`
+
+	// Args holds the integer arguments of the call, including flags.
+	Args map[string]int `json:"args,omitempty"`
+
+	// In holds the input slices of the call. Complex slices are stored
+	// as interleaved real and imaginary parts.
+	In map[string][]float64 `json:"in"`
+
+	// Out holds the results of the call.
+	Out map[string][]float64 `json:"out"`
+}
+
+// Routine describes how a routine is verified.
+type Routine struct {
+	Name string
+
+	// Tol is the largest accepted difference between a result and the
+	// recorded result, relative to the largest recorded element of the
+	// result or to one, whichever is larger.
+	Tol float64
+
+	// Cases returns the canonical inputs of the routine. The Routine
+	// fields of the returned cases are set by Record.
+	Cases func(rnd *rand.Rand) []Case
+
+	// Run calls the routine with the arguments and inputs of c and
+	// returns its results. Run must not modify c.
+	Run func(c Case) map[string][]float64
+}
+
+// Corpus is a set of recorded cases.
+type Corpus struct {
+	// Source names the implementation the results were recorded from.
+	Source string `json:"source"`
+
+	Cases []Case `json:"cases"`
+}
+
+// Record returns a corpus holding the results of the routines on their
+// canonical inputs, generated from seed.
+func Record(routines []Routine, seed uint64, source string) *Corpus {
+	c := &Corpus{Source: source}
+	for _, r := range routines {
+		rnd := rand.New(rand.NewSource(seed))
+		for _, cas := range r.Cases(rnd) {
+			cas.Routine = r.Name
+			cas.Out = r.Run(cas)
+			c.Cases = append(c.Cases, cas)
+		}
+	}
+	return c
+}
+
+// Load reads a corpus from the JSON file at path.
+func Load(path string) (*Corpus, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var c Corpus
+	err = json.Unmarshal(b, &c)
+	if err != nil {
+		return nil, fmt.Errorf("golden: %s: %v", path, err)
+	}
+	return &c, nil
+}
+
+// Save writes c to the JSON file at path, with one case per line.
+func (c *Corpus) Save(path string) error {
+	src, err := json.Marshal(c.Source)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "{\n\"source\": %s,\n\"cases\": [\n", src)
+	for i, cas := range c.Cases {
+		b, err := json.Marshal(cas)
+		if err != nil {
+			return err
+		}
+		buf.Write(b)
+		if i < len(c.Cases)-1 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('\n')
+	}
+	buf.WriteString("]\n}\n")
+	return ioutil.WriteFile(path, buf.Bytes(), 0664)
+}
+
+// Mismatch describes a result that differs from the recorded result.
+type Mismatch struct {
+	// Case is the index of the case in the corpus.
+	Case    int
+	Routine string
+
+	// Output is the name of the result. It is empty if the routine is
+	// not known.
+	Output string
+
+	// Diff is the relative difference from the recorded result. It is
+	// +Inf if the result is missing or has the wrong length.
+	Diff, Tol float64
+}
+
+func (m Mismatch) Error() string {
+	if m.Output == "" {
+		return fmt.Sprintf("golden: case %d: unknown routine %s", m.Case, m.Routine)
+	}
+	return fmt.Sprintf("golden: case %d: %s: %s differs from the recorded result by %g (tolerance %g)",
+		m.Case, m.Routine, m.Output, m.Diff, m.Tol)
+}
+
+// Verify calls the routines on the inputs of the cases of c and returns
+// the results that do not match the recorded results.
+func Verify(c *Corpus, routines []Routine) []Mismatch {
+	byName := make(map[string]Routine, len(routines))
+	for _, r := range routines {
+		byName[r.Name] = r
+	}
+	var bad []Mismatch
+	for i, cas := range c.Cases {
+		r, ok := byName[cas.Routine]
+		if !ok {
+			bad = append(bad, Mismatch{Case: i, Routine: cas.Routine})
+			continue
+		}
+		got := r.Run(cas)
+		for name, want := range cas.Out {
+			d := Diff(got[name], want)
+			if !(d <= r.Tol) {
+				bad = append(bad, Mismatch{Case: i, Routine: cas.Routine, Output: name, Diff: d, Tol: r.Tol})
+			}
+		}
+	}
+	return bad
+}
+
+// Diff returns the largest absolute difference between the elements of got
+// and want divided by the largest absolute element of want or one, whichever
+// is larger, as computed by floats.MixedError. Diff returns +Inf if the
+// lengths differ and NaN if an element of got is NaN and the corresponding
+// element of want is not.
+func Diff(got, want []float64) float64 {
+	return floats.MixedError(got, want)
+}
+
+// Float64s returns a copy of s.
+func Float64s(s []float64) []float64 {
+	return append([]float64(nil), s...)
+}
+
+// Random returns a slice of n normally distributed random numbers rounded
+// to two decimal places, which keeps the stored inputs short.
+func Random(rnd *rand.Rand, n int) []float64 {
+	s := make([]float64, n)
+	for i := range s {
+		s[i] = math.Round(100*rnd.NormFloat64()) / 100
+	}
+	return s
+}
@@ -0,0 +1,89 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package golden
+
+import (
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestDiff(t *testing.T) {
+	for _, test := range []struct {
+		got, want []float64
+		diff      float64
+	}{
+		{nil, nil, 0},
+		{[]float64{1, 2}, []float64{1, 2}, 0},
+		{[]float64{1, 2.5}, []float64{1, 2}, 0.25},
+		{[]float64{0.5}, []float64{0.25}, 0.25},
+		{[]float64{1}, []float64{1, 2}, math.Inf(1)},
+		{[]float64{math.Inf(1)}, []float64{math.Inf(1)}, 0},
+	} {
+		if d := Diff(test.got, test.want); d != test.diff {
+			t.Errorf("unexpected difference of %v and %v: got %v want %v", test.got, test.want, d, test.diff)
+		}
+	}
+	if d := Diff([]float64{math.NaN()}, []float64{1}); !math.IsNaN(d) {
+		t.Errorf("unexpected difference for NaN result: %v", d)
+	}
+}
+
+func TestRecordVerify(t *testing.T) {
+	scale := 2.0
+	routines := []Routine{{
+		Name: "scale",
+		Tol:  1e-15,
+		Cases: func(rnd *rand.Rand) []Case {
+			return []Case{
+				{Args: map[string]int{"n": 3}, In: map[string][]float64{"x": Random(rnd, 3)}},
+				{Args: map[string]int{"n": 5}, In: map[string][]float64{"x": Random(rnd, 5)}},
+			}
+		},
+		Run: func(c Case) map[string][]float64 {
+			x := Float64s(c.In["x"])
+			for i := range x {
+				x[i] *= scale
+			}
+			return map[string][]float64{"x": x}
+		},
+	}}
+
+	dir, err := ioutil.TempDir("", "golden")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "golden.json")
+
+	c := Record(routines, 1, "test")
+	err = c.Save(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, c) {
+		t.Fatalf("corpus changed by Save and Load:\ngot  %+v\nwant %+v", got, c)
+	}
+	if bad := Verify(got, routines); len(bad) != 0 {
+		t.Errorf("unexpected mismatches: %v", bad)
+	}
+
+	scale = 2.5
+	if bad := Verify(got, routines); len(bad) != 2 {
+		t.Errorf("unexpected number of mismatches for wrong results: got %d want 2", len(bad))
+	}
+	if bad := Verify(got, nil); len(bad) != 2 || bad[0].Output != "" {
+		t.Errorf("unexpected mismatches for unknown routine: %v", bad)
+	}
+}
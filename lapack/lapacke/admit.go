@@ -0,0 +1,64 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapacke
+
+import "gonum.org/v1/netlib/admission"
+
+// admit admits a call performing flops floating point operations with the
+// process-wide admission controller and returns the function that ends the
+// call. If no controller is installed, admit returns immediately. If the
+// controller rejects the call, admit panics with the admission error.
+//
+// The factorizations and drivers listed in the flops table of
+// generate_lapacke.go, and ?gees and ?gges, call admit after their
+// parameters have been checked unless they are called to query the size of
+// the workspace.
+func admit(flops float64) func() {
+	c := admission.Default()
+	if c == nil {
+		return nop
+	}
+	release, err := c.Acquire(flops)
+	if err != nil {
+		panic(err)
+	}
+	return release
+}
+
+func nop() {}
+
+// mnk returns the product of m, n and k.
+func mnk(m, n, k int) float64 {
+	return float64(m) * float64(n) * float64(k)
+}
+
+// trapezoid returns the number of multiplications of the LU factorization
+// of an m×n matrix. The QR factorization and the reduction to bidiagonal
+// form take two and four times as many operations.
+func trapezoid(m, n int) float64 {
+	k := min(m, n)
+	return mnk(m, n, k) - mnk(k, k, k)/3
+}
+
+// svd returns the number of operations of the singular value
+// decomposition of an m×n matrix with the singular vectors.
+func svd(m, n int) float64 {
+	k := min(m, n)
+	return 12*mnk(max(m, n), k, k) + 9*mnk(k, k, k)
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
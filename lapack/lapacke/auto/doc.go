@@ -0,0 +1,20 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:generate go run generate_auto.go
+
+// Package auto provides wrappers of the lapacke functions that allocate
+// their workspace internally.
+//
+// Each function has the name and the parameters of the lapacke function it
+// wraps, without the work, iwork and rwork arrays and their lengths. The
+// wrapper calls the lapacke function once with the lengths set to -1 to
+// query the optimal workspace, allocates the arrays with the returned sizes
+// and calls it again. Workspace arrays that LAPACK does not size through the
+// query, such as the iwork array of ?gesdd, are allocated with the minimum
+// length given in the LAPACK documentation.
+//
+// Routines that return results in their workspace, ?gejsv and ?gesvj, and
+// the drivers that take a SELECT function are not wrapped.
+package auto // import "gonum.org/v1/netlib/lapack/lapacke/auto"
@@ -0,0 +1,303 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+// generate_auto creates an auto.go file with wrappers of the lapacke
+// functions that take a work array and its length.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"strings"
+)
+
+const target = "auto.go"
+
+// sources are the lapacke files that are searched for functions.
+var sources = []string{
+	"../lapacke.go",
+	"../getsls.go",
+}
+
+// skip is a list of routines that return results in their work arrays, so
+// the arrays cannot be hidden. The list keys are truncated by one character
+// to cover all four numeric types.
+var skip = map[string]bool{
+	"gejsv": true,
+	"gesvj": true,
+}
+
+// fixedReal and fixedComplex are the lengths of the workspace arrays that
+// do not have a length parameter, for the real and the complex routines.
+// The list keys are truncated by one character. Lengths of zero are
+// increased to one.
+var fixedReal = map[string]map[string]string{
+	"geevx":     {"iwork": "2*n - 2"},
+	"gelsd":     {"iwork": "gelsdIWork(m, n)"},
+	"gesdd":     {"iwork": "8 * min(m, n)"},
+	"gesvdx":    {"iwork": "12 * min(m, n)"},
+	"ggevx":     {"iwork": "n + 6", "bwork": "n"},
+	"ggsvd3":    {"iwork": "n"},
+	"ggsvp3":    {"iwork": "n"},
+	"orcsd":     {"iwork": "csdIWork(m, p, q)"},
+	"orcsd2by1": {"iwork": "csdIWork(m, p, q)"},
+	"syevx":     {"iwork": "5 * n"},
+	"sygvx":     {"iwork": "5 * n"},
+	"sysvx":     {"iwork": "n"},
+	"tgsna":     {"iwork": "n + 6"},
+	"tgsyl":     {"iwork": "m + n + 6"},
+}
+
+var fixedComplex = map[string]map[string]string{
+	"geev":      {"rwork": "2 * n"},
+	"geevx":     {"rwork": "2 * n"},
+	"gelsd":     {"rwork": "gelsdRWork(m, n, nrhs)", "iwork": "gelsdIWork(m, n)"},
+	"gelss":     {"rwork": "5 * min(m, n)"},
+	"gelsy":     {"rwork": "2 * n"},
+	"geqp3":     {"rwork": "2 * n"},
+	"gesdd":     {"rwork": "gesddRWork(jobz, m, n)", "iwork": "8 * min(m, n)"},
+	"gesvd":     {"rwork": "5 * min(m, n)"},
+	"gesvdx":    {"rwork": "17 * min(m, n) * min(m, n)", "iwork": "12 * min(m, n)"},
+	"ggev":      {"rwork": "8 * n"},
+	"ggev3":     {"rwork": "8 * n"},
+	"ggevx":     {"rwork": "6 * n", "iwork": "n + 2", "bwork": "n"},
+	"ggsvd3":    {"rwork": "2 * n", "iwork": "n"},
+	"ggsvp3":    {"rwork": "2 * n", "iwork": "n"},
+	"heev":      {"rwork": "3*n - 2"},
+	"heevx":     {"rwork": "7 * n", "iwork": "5 * n"},
+	"hegv":      {"rwork": "3*n - 2"},
+	"hegvx":     {"rwork": "7 * n", "iwork": "5 * n"},
+	"hesvx":     {"rwork": "n"},
+	"hgeqz":     {"rwork": "n"},
+	"sysvx":     {"rwork": "n"},
+	"tgsna":     {"iwork": "n + 2"},
+	"tgsyl":     {"iwork": "m + n + 2"},
+	"uncsd":     {"iwork": "csdIWork(m, p, q)"},
+	"uncsd2by1": {"iwork": "csdIWork(m, p, q)"},
+}
+
+// queried are the workspace arrays whose optimal length is returned in
+// their first element by a workspace query, and their length parameters.
+var queried = map[string]string{
+	"work":  "lwork",
+	"iwork": "liwork",
+	"rwork": "lrwork",
+}
+
+type param struct {
+	name, typ string
+}
+
+type wrapper struct {
+	name   string
+	params []param
+	result string
+
+	// hidden are the workspace arrays in the order they are allocated.
+	hidden []string
+	// fixed holds the lengths of the arrays without length parameters.
+	fixed map[string]string
+}
+
+func main() {
+	fset := token.NewFileSet()
+	var wrappers []wrapper
+	for _, src := range sources {
+		f, err := parser.ParseFile(fset, src, nil, 0)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, d := range f.Decls {
+			fn, ok := d.(*ast.FuncDecl)
+			if !ok || !fn.Name.IsExported() || fn.Recv != nil {
+				continue
+			}
+			if w, ok := newWrapper(fset, fn); ok {
+				wrappers = append(wrappers, w)
+			}
+		}
+	}
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	for _, w := range wrappers {
+		w.write(&buf)
+	}
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = ioutil.WriteFile(target, b, 0664)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// newWrapper returns the wrapper of fn and whether fn can be wrapped.
+func newWrapper(fset *token.FileSet, fn *ast.FuncDecl) (wrapper, bool) {
+	w := wrapper{name: fn.Name.Name}
+	if fn.Type.Results == nil || len(fn.Type.Results.List) != 1 {
+		return w, false
+	}
+	w.result = typeString(fset, fn.Type.Results.List[0].Type)
+	for _, f := range fn.Type.Params.List {
+		t := typeString(fset, f.Type)
+		if strings.HasPrefix(t, "func(") {
+			return w, false
+		}
+		for _, n := range f.Names {
+			w.params = append(w.params, param{name: n.Name, typ: t})
+		}
+	}
+	if !w.has("work") || !w.has("lwork") {
+		return w, false
+	}
+	key := strings.ToLower(w.name[1:])
+	if skip[key] {
+		return w, false
+	}
+	fixed := fixedReal
+	if w.name[0] == 'C' || w.name[0] == 'Z' {
+		fixed = fixedComplex
+	}
+	w.fixed = fixed[key]
+	for _, p := range w.params {
+		if !strings.HasSuffix(p.name, "work") || !strings.HasPrefix(p.typ, "[]") {
+			continue
+		}
+		if _, ok := w.fixed[p.name]; ok {
+			w.hidden = append(w.hidden, p.name)
+			continue
+		}
+		l, ok := queried[p.name]
+		if !ok || !w.has(l) {
+			return w, false
+		}
+		w.hidden = append(w.hidden, p.name)
+	}
+	return w, true
+}
+
+func typeString(fset *token.FileSet, e ast.Expr) string {
+	var buf bytes.Buffer
+	printer.Fprint(&buf, fset, e)
+	if buf.String() == "[]Int" {
+		return "[]lapacke.Int"
+	}
+	return buf.String()
+}
+
+func (w wrapper) has(name string) bool {
+	for _, p := range w.params {
+		if p.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (w wrapper) typeOf(name string) string {
+	for _, p := range w.params {
+		if p.name == name {
+			return p.typ
+		}
+	}
+	panic("no parameter " + name)
+}
+
+// isHidden returns whether the parameter is a hidden array or its length.
+func (w wrapper) isHidden(name string) bool {
+	for _, h := range w.hidden {
+		if name == h || name == queried[h] && w.fixed[h] == "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (w wrapper) write(buf *bytes.Buffer) {
+	fmt.Fprintf(buf, "\n// %[1]s calls lapacke.%[1]s with workspace of the size returned by a\n// workspace query.\n", w.name)
+	fmt.Fprintf(buf, "func %s(", w.name)
+	var visible []param
+	for _, p := range w.params {
+		if !w.isHidden(p.name) {
+			visible = append(visible, p)
+		}
+	}
+	for i, p := range visible {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(p.name)
+		if i == len(visible)-1 || visible[i+1].typ != p.typ {
+			fmt.Fprintf(buf, " %s", p.typ)
+		}
+	}
+	fmt.Fprintf(buf, ") %s {\n", w.result)
+
+	for _, h := range w.hidden {
+		n := "1"
+		if l, ok := w.fixed[h]; ok {
+			n = fmt.Sprintf("max(1, %s)", l)
+		}
+		fmt.Fprintf(buf, "\t%s := make(%s, %s)\n", h, w.typeOf(h), n)
+	}
+	query := w.call(true)
+	if w.result == "bool" {
+		fmt.Fprintf(buf, "\tif !%s {\n\t\treturn false\n\t}\n", query)
+	} else {
+		fmt.Fprintf(buf, "\tif info := %s; info != 0 {\n\t\treturn info\n\t}\n", query)
+	}
+	for _, h := range w.hidden {
+		if _, ok := w.fixed[h]; ok {
+			continue
+		}
+		l := queried[h]
+		fmt.Fprintf(buf, "\t%s := %s(%s[0])\n", l, lenFunc[w.typeOf(h)], h)
+		fmt.Fprintf(buf, "\t%s = make(%s, %s)\n", h, w.typeOf(h), l)
+	}
+	fmt.Fprintf(buf, "\treturn %s\n}\n", w.call(false))
+}
+
+// lenFunc is the name of the function that converts the first element of a
+// queried workspace array to its length.
+var lenFunc = map[string]string{
+	"[]float32":     "lenFloat32",
+	"[]float64":     "lenFloat64",
+	"[]complex64":   "lenComplex64",
+	"[]complex128":  "lenComplex128",
+	"[]lapacke.Int": "lenInt",
+}
+
+// call returns the call of the wrapped function, as a workspace query if
+// query is true.
+func (w wrapper) call(query bool) string {
+	args := make([]string, len(w.params))
+	for i, p := range w.params {
+		args[i] = p.name
+		if query && p.typ == "int" && w.isHidden(p.name) {
+			args[i] = "-1"
+		}
+	}
+	return fmt.Sprintf("lapacke.%s(%s)", w.name, strings.Join(args, ", "))
+}
+
+const header = `// Code generated by "go generate gonum.org/v1/netlib/lapack/lapacke/auto"; DO NOT EDIT.
+
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package auto
+
+import "gonum.org/v1/netlib/lapack/lapacke"
+`
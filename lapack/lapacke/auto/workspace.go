@@ -0,0 +1,94 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package auto
+
+import (
+	"math"
+
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// lenFloat64 returns the workspace length returned by a query in v.
+func lenFloat64(v float64) int {
+	return max(1, int(v))
+}
+
+// lenFloat32 returns the workspace length returned by a query in v. Lengths
+// above 2^24 may have been rounded down to a float32 value by LAPACK, so
+// they are rounded up by one ulp.
+func lenFloat32(v float32) int {
+	if v < 1<<24 {
+		return max(1, int(v))
+	}
+	return int(math.Nextafter32(v, math.MaxFloat32))
+}
+
+func lenComplex128(v complex128) int { return lenFloat64(real(v)) }
+
+func lenComplex64(v complex64) int { return lenFloat32(real(v)) }
+
+func lenInt(v lapacke.Int) int {
+	return max(1, int(v))
+}
+
+// smlsiz is the size of the subproblems at the bottom of the computation
+// tree of ?gelsd returned by ILAENV in the reference implementation.
+const smlsiz = 25
+
+// gelsdLevels returns the number of levels of the computation tree of
+// ?gelsd for a problem with the given minimum dimension.
+func gelsdLevels(minmn int) int {
+	return max(0, int(math.Log2(float64(minmn)/(smlsiz+1)))+1)
+}
+
+// gelsdIWork returns the minimum length of the iwork array of ?gelsd.
+func gelsdIWork(m, n int) int {
+	minmn := min(m, n)
+	if minmn <= 0 {
+		return 1
+	}
+	return 3*minmn*gelsdLevels(minmn) + 11*minmn
+}
+
+// gelsdRWork returns the minimum length of the rwork array of ?gelsd.
+func gelsdRWork(m, n, nrhs int) int {
+	minmn := min(m, n)
+	if minmn <= 0 {
+		return 1
+	}
+	nlvl := gelsdLevels(minmn)
+	return 10*minmn + 2*minmn*smlsiz + 8*minmn*nlvl + 3*smlsiz*nrhs +
+		max((smlsiz+1)*(smlsiz+1), minmn*(1+nrhs)+2*nrhs)
+}
+
+// gesddRWork returns the minimum length of the rwork array of ?gesdd. The
+// lengths are those of LAPACK 3.6, which are larger than the later ones.
+func gesddRWork(jobz byte, m, n int) int {
+	mn, mx := min(m, n), max(m, n)
+	if jobz == 'N' {
+		return 7 * mn
+	}
+	return max(5*mn*mn+5*mn, 2*mx*mn+2*mn*mn+mn)
+}
+
+// csdIWork returns the length of the iwork array of the CS decomposition
+// routines.
+func csdIWork(m, p, q int) int {
+	return m - min(min(p, m-p), min(q, m-q))
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
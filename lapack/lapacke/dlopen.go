@@ -0,0 +1,66 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build dlopen
+// +build dlopen
+
+package lapacke
+
+/*
+#cgo linux LDFLAGS: -ldl -lpthread
+#cgo CFLAGS: -DNETLIB_LAPACKE_DLOPEN
+#include <stdlib.h>
+int netlib_lapacke_open(const char *path, char **err);
+const char *netlib_lapacke_path(void);
+*/
+import "C"
+
+import (
+	"errors"
+	"unsafe"
+)
+
+// Open loads the LAPACKE library at path. Open is only supported when the
+// package is built with the dlopen build tag, in which case the LAPACKE
+// symbols are resolved from the loaded library when each function is first
+// called instead of being linked at build time.
+//
+// The symbols are bound for the whole process, so only one library can be
+// loaded. Opening the library that is already loaded is a no-op; opening a
+// different one returns an error. If no library has been opened when a
+// function is first called, the library named by the NETLIB_LAPACKE_LIBRARY
+// environment variable is loaded, or if it is not set, the first of a list
+// of well known LAPACKE libraries for the target system that can be loaded.
+// A call of a function that is missing from the library, or of any function
+// if no library can be loaded, panics with an ErrUnsupported.
+func Open(path string) error {
+	cpath := C.CString(path)
+	defer C.free(unsafe.Pointer(cpath))
+	var msg *C.char
+	if C.netlib_lapacke_open(cpath, &msg) != 0 {
+		defer C.free(unsafe.Pointer(msg))
+		return errors.New("lapack: " + C.GoString(msg))
+	}
+	return nil
+}
+
+// Library returns the path of the LAPACKE library loaded by Open or by the
+// first call to a function, or the empty string if no library has been
+// loaded yet or the package was built without the dlopen build tag.
+func Library() string {
+	return C.GoString(C.netlib_lapacke_path())
+}
+
+// netlib_lapacke_unsupported is called by the trampoline of a function that is
+// missing from the loaded library, or with a non-nil err holding the
+// reason if no library could be loaded.
+//
+//export netlib_lapacke_unsupported
+func netlib_lapacke_unsupported(name, err *C.char) {
+	e := ErrUnsupported{Symbol: C.GoString(name), Library: Library()}
+	if err != nil {
+		e.Err = errors.New(C.GoString(err))
+	}
+	panic(e)
+}
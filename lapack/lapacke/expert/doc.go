@@ -0,0 +1,20 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:generate go run generate_expert.go
+
+// Package expert provides raw bindings to LAPACK computational kernels that
+// are not part of the LAPACKE interface, for example the multishift QR
+// kernels xLAQR0 and xLAQR5 and the small Sylvester and linear system
+// solvers xLASY2 and xLALN2 used internally by xTRSYL and xTREVC.
+//
+// The bindings are only built when the lapacke_expert build tag is set.
+// They are not part of the supported surface of this module and their API
+// may change without notice.
+//
+// The functions call the Fortran routines directly. All matrices are stored
+// in column-major order, LOGICAL arguments are passed as int32 values and no
+// argument checking is performed beyond what the Fortran routine itself does.
+// Scalar output arguments, including INFO, are returned as results.
+package expert // import "gonum.org/v1/netlib/lapack/lapacke/expert"
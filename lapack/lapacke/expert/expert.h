@@ -0,0 +1,91 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Fortran prototypes for the LAPACK computational kernels exposed by
+// package expert. These routines are not part of the LAPACKE interface.
+//
+// Parameter declarations follow a convention that is read by
+// generate_expert.go:
+//  - array arguments are declared as T name[],
+//  - scalar inputs passed by reference are declared as const T*,
+//  - scalar outputs are declared as T*.
+
+#ifndef _NETLIB_EXPERT_H_
+#define _NETLIB_EXPERT_H_
+
+#include "../lapacke_config.h"
+#include "../lapacke_mangling.h"
+
+#define LAPACK_slaqr0 LAPACK_GLOBAL(slaqr0,SLAQR0)
+#define LAPACK_dlaqr0 LAPACK_GLOBAL(dlaqr0,DLAQR0)
+#define LAPACK_slaqr5 LAPACK_GLOBAL(slaqr5,SLAQR5)
+#define LAPACK_dlaqr5 LAPACK_GLOBAL(dlaqr5,DLAQR5)
+#define LAPACK_slasy2 LAPACK_GLOBAL(slasy2,SLASY2)
+#define LAPACK_dlasy2 LAPACK_GLOBAL(dlasy2,DLASY2)
+#define LAPACK_slaln2 LAPACK_GLOBAL(slaln2,SLALN2)
+#define LAPACK_dlaln2 LAPACK_GLOBAL(dlaln2,DLALN2)
+
+void LAPACK_slaqr0( const lapack_logical* wantt, const lapack_logical* wantz,
+                    const lapack_int* n, const lapack_int* ilo,
+                    const lapack_int* ihi, float h[], const lapack_int* ldh,
+                    float wr[], float wi[], const lapack_int* iloz,
+                    const lapack_int* ihiz, float z[], const lapack_int* ldz,
+                    float work[], const lapack_int* lwork, lapack_int* info );
+void LAPACK_dlaqr0( const lapack_logical* wantt, const lapack_logical* wantz,
+                    const lapack_int* n, const lapack_int* ilo,
+                    const lapack_int* ihi, double h[], const lapack_int* ldh,
+                    double wr[], double wi[], const lapack_int* iloz,
+                    const lapack_int* ihiz, double z[], const lapack_int* ldz,
+                    double work[], const lapack_int* lwork, lapack_int* info );
+
+void LAPACK_slaqr5( const lapack_logical* wantt, const lapack_logical* wantz,
+                    const lapack_int* kacc22, const lapack_int* n,
+                    const lapack_int* ktop, const lapack_int* kbot,
+                    const lapack_int* nshfts, float sr[], float si[],
+                    float h[], const lapack_int* ldh, const lapack_int* iloz,
+                    const lapack_int* ihiz, float z[], const lapack_int* ldz,
+                    float v[], const lapack_int* ldv, float u[],
+                    const lapack_int* ldu, const lapack_int* nv, float wv[],
+                    const lapack_int* ldwv, const lapack_int* nh, float wh[],
+                    const lapack_int* ldwh );
+void LAPACK_dlaqr5( const lapack_logical* wantt, const lapack_logical* wantz,
+                    const lapack_int* kacc22, const lapack_int* n,
+                    const lapack_int* ktop, const lapack_int* kbot,
+                    const lapack_int* nshfts, double sr[], double si[],
+                    double h[], const lapack_int* ldh, const lapack_int* iloz,
+                    const lapack_int* ihiz, double z[], const lapack_int* ldz,
+                    double v[], const lapack_int* ldv, double u[],
+                    const lapack_int* ldu, const lapack_int* nv, double wv[],
+                    const lapack_int* ldwv, const lapack_int* nh, double wh[],
+                    const lapack_int* ldwh );
+
+void LAPACK_slasy2( const lapack_logical* ltranl, const lapack_logical* ltranr,
+                    const lapack_int* isgn, const lapack_int* n1,
+                    const lapack_int* n2, float tl[], const lapack_int* ldtl,
+                    float tr[], const lapack_int* ldtr, float b[],
+                    const lapack_int* ldb, float* scale, float x[],
+                    const lapack_int* ldx, float* xnorm, lapack_int* info );
+void LAPACK_dlasy2( const lapack_logical* ltranl, const lapack_logical* ltranr,
+                    const lapack_int* isgn, const lapack_int* n1,
+                    const lapack_int* n2, double tl[], const lapack_int* ldtl,
+                    double tr[], const lapack_int* ldtr, double b[],
+                    const lapack_int* ldb, double* scale, double x[],
+                    const lapack_int* ldx, double* xnorm, lapack_int* info );
+
+void LAPACK_slaln2( const lapack_logical* ltrans, const lapack_int* na,
+                    const lapack_int* nw, const float* smin, const float* ca,
+                    float a[], const lapack_int* lda, const float* d1,
+                    const float* d2, float b[], const lapack_int* ldb,
+                    const float* wr, const float* wi, float x[],
+                    const lapack_int* ldx, float* scale, float* xnorm,
+                    lapack_int* info );
+void LAPACK_dlaln2( const lapack_logical* ltrans, const lapack_int* na,
+                    const lapack_int* nw, const double* smin, const double* ca,
+                    double a[], const lapack_int* lda, const double* d1,
+                    const double* d2, double b[], const lapack_int* ldb,
+                    const double* wr, const double* wi, double x[],
+                    const lapack_int* ldx, double* scale, double* xnorm,
+                    lapack_int* info );
+
+#endif /* _NETLIB_EXPERT_H_ */
@@ -0,0 +1,270 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+// generate_expert creates an expert.go file from the Fortran prototypes
+// in the provided C header file.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+
+	"modernc.org/cc"
+
+	"gonum.org/v1/netlib/internal/binding"
+)
+
+const (
+	header = "expert.h"
+	target = "expert.go"
+
+	suffix = "_"
+
+	buildTag = "lapacke_expert"
+)
+
+// logicals is a list of scalar parameters that are Fortran LOGICAL values.
+var logicals = map[string]bool{
+	"wantt":  true,
+	"wantz":  true,
+	"ltranl": true,
+	"ltranr": true,
+	"ltrans": true,
+}
+
+var goTypes = map[string]string{
+	"int":    "int",
+	"float":  "float32",
+	"double": "float64",
+}
+
+var cTypes = map[string]string{
+	"int":    "C.lapack_int",
+	"float":  "C.float",
+	"double": "C.double",
+}
+
+var sliceTypes = map[string]string{
+	"int":    "int32",
+	"float":  "float32",
+	"double": "float64",
+}
+
+// kind describes how a Fortran by-reference parameter is presented in Go.
+type kind int
+
+const (
+	array kind = iota
+	input
+	output
+)
+
+type param struct {
+	name string
+	elem string // C element type without qualifiers.
+	kind kind
+}
+
+func (p param) goType() string {
+	switch p.kind {
+	case array:
+		return "[]" + sliceTypes[p.elem]
+	default:
+		if logicals[p.name] {
+			return "int32"
+		}
+		return goTypes[p.elem]
+	}
+}
+
+func (p param) cType() string {
+	if logicals[p.name] {
+		return "C.lapack_logical"
+	}
+	return cTypes[p.elem]
+}
+
+func params(d binding.Declaration) []param {
+	var ps []param
+	for _, p := range d.Parameters() {
+		if p.Kind() != cc.Ptr {
+			log.Fatalf("%s: parameter %s is not passed by reference", d.Name, p.Name())
+		}
+		typ := p.Type().String()
+		elem := strings.TrimPrefix(p.Elem().String(), "const ")
+		var k kind
+		switch {
+		case strings.HasSuffix(typ, "[]"):
+			k = array
+		case strings.HasPrefix(typ, "const "):
+			k = input
+		default:
+			k = output
+		}
+		if _, ok := goTypes[elem]; !ok {
+			log.Fatalf("%s: unhandled type %s for %s", d.Name, typ, p.Name())
+		}
+		ps = append(ps, param{name: p.Name(), elem: elem, kind: k})
+	}
+	return ps
+}
+
+func main() {
+	decls, err := binding.Declarations(header)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+
+	h, err := template.New("handwritten").
+		Funcs(map[string]interface{}{"join": join}).
+		Parse(handwritten)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = h.Execute(&buf, struct {
+		Header string
+		Tag    string
+		Lib    []string
+	}{
+		Header: header,
+		Tag:    buildTag,
+		Lib:    os.Args[1:],
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, d := range decls {
+		if d.Position().Filename != header || !strings.HasSuffix(d.Name, suffix) {
+			continue
+		}
+		ps := params(d)
+		goSignature(&buf, d, ps)
+		cgoCall(&buf, d, ps)
+		buf.WriteString("}\n")
+	}
+
+	b, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = ioutil.WriteFile(target, b, 0664)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func join(a []string) string {
+	return strings.Join(a, " ")
+}
+
+// elide writes the name and type pairs in list using Go's elision of
+// repeated types.
+func elide(buf *bytes.Buffer, names, types []string) {
+	for i, n := range names {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		if i < len(names)-1 && types[i] == types[i+1] {
+			buf.WriteString(n)
+		} else {
+			fmt.Fprintf(buf, "%s %s", n, types[i])
+		}
+	}
+}
+
+func goSignature(buf *bytes.Buffer, d binding.Declaration, ps []param) {
+	lapackName := strings.TrimSuffix(d.Name, suffix)
+	goName := binding.UpperCaseFirst(lapackName)
+
+	var inNames, inTypes, outNames, outTypes []string
+	for _, p := range ps {
+		if p.kind == output {
+			outNames = append(outNames, p.name)
+			outTypes = append(outTypes, p.goType())
+			continue
+		}
+		inNames = append(inNames, p.name)
+		inTypes = append(inTypes, p.goType())
+	}
+
+	fmt.Fprintf(buf, "\n// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/%s.f.\n", lapackName)
+	fmt.Fprintf(buf, "func %s(", goName)
+	elide(buf, inNames, inTypes)
+	buf.WriteString(")")
+	if len(outNames) != 0 {
+		buf.WriteString(" (")
+		elide(buf, outNames, outTypes)
+		buf.WriteString(")")
+	}
+	buf.WriteString(" {\n")
+}
+
+func cgoCall(buf *bytes.Buffer, d binding.Declaration, ps []param) {
+	for _, p := range ps {
+		switch p.kind {
+		case array:
+			fmt.Fprintf(buf, `	var _%[1]s *%[2]s
+	if len(%[1]s) > 0 {
+		_%[1]s = &%[1]s[0]
+	}
+`, p.name, sliceTypes[p.elem])
+		case input:
+			fmt.Fprintf(buf, "\t_%[1]s := %[2]s(%[1]s)\n", p.name, p.cType())
+		case output:
+			fmt.Fprintf(buf, "\tvar _%s %s\n", p.name, p.cType())
+		}
+	}
+
+	fmt.Fprintf(buf, "\tC.%s(", d.Name)
+	for i, p := range ps {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		if p.kind == array {
+			fmt.Fprintf(buf, "(*%s)(_%s)", p.cType(), p.name)
+		} else {
+			fmt.Fprintf(buf, "&_%s", p.name)
+		}
+	}
+	buf.WriteString(")\n")
+
+	var results []string
+	for _, p := range ps {
+		if p.kind == output {
+			results = append(results, fmt.Sprintf("%s(_%s)", p.goType(), p.name))
+		}
+	}
+	if len(results) != 0 {
+		fmt.Fprintf(buf, "\treturn %s\n", strings.Join(results, ", "))
+	}
+}
+
+const handwritten = `// Code generated by "go generate gonum.org/v1/netlib/lapack/lapacke/expert" from {{.Header}}; DO NOT EDIT.
+
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build {{.Tag}}
+
+package expert
+
+/*
+#cgo CFLAGS: -g -O2{{if .Lib}}
+#cgo LDFLAGS: {{join .Lib}}{{end}}
+#include "{{.Header}}"
+*/
+import "C"
+`
@@ -0,0 +1,11 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build lapacke_expert && ilp64
+// +build lapacke_expert,ilp64
+
+package expert
+
+// #cgo CFLAGS: -DLAPACK_ILP64
+import "C"
@@ -0,0 +1,104 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file bridges the SELECT arguments of the ?gees drivers to Go. LAPACK
+// calls SELECT without a user data pointer, so the handle of the Go function
+// is passed to the trampolines in a thread-local variable, which is valid
+// because LAPACK calls SELECT on the thread that called the driver. The
+// previous handle is restored on return so that the drivers can be called
+// again from within a SELECT function.
+
+#include <stdint.h>
+#include <stdlib.h>
+
+#include "lapacke.h"
+#include "_cgo_export.h"
+
+static __thread uintptr_t netlib_select_handle;
+
+static lapack_logical netlib_sselect2(const float *wr, const float *wi)
+{
+	return netlibSelectS(netlib_select_handle, *wr, *wi);
+}
+
+static lapack_logical netlib_dselect2(const double *wr, const double *wi)
+{
+	return netlibSelectD(netlib_select_handle, *wr, *wi);
+}
+
+static lapack_logical netlib_cselect1(const lapack_complex_float *w)
+{
+	return netlibSelectC(netlib_select_handle, lapack_complex_float_real(*w), lapack_complex_float_imag(*w));
+}
+
+static lapack_logical netlib_zselect1(const lapack_complex_double *w)
+{
+	return netlibSelectZ(netlib_select_handle, lapack_complex_double_real(*w), lapack_complex_double_imag(*w));
+}
+
+#ifdef NETLIB_LAPACKE_DLOPEN
+void *netlib_lapacke_symbol(const char *name);
+
+// netlib_gees_resolve returns the address of the named driver in the library
+// loaded by the dlopen trampolines. If the driver is missing, the Go
+// function netlib_lapacke_unsupported panics with an ErrUnsupported.
+static void *netlib_gees_resolve(const char *name)
+{
+	void *fn = netlib_lapacke_symbol(name);
+	if (fn == NULL) {
+		netlib_lapacke_unsupported((char *)name, NULL);
+		abort();
+	}
+	return fn;
+}
+
+#define NETLIB_GEES(name) \
+	static __typeof__(name) *fn; \
+	if (fn == NULL) { \
+		fn = (__typeof__(name) *)netlib_gees_resolve(#name); \
+	}
+#else
+#define NETLIB_GEES(name) \
+	__typeof__(name) *fn = name;
+#endif
+
+lapack_int netlib_sgees(uintptr_t h, int matrix_layout, char jobvs, char sort, lapack_int n, float *a, lapack_int lda, lapack_int *sdim, float *wr, float *wi, float *vs, lapack_int ldvs, float *work, lapack_int lwork, lapack_logical *bwork)
+{
+	NETLIB_GEES(LAPACKE_sgees_work)
+	uintptr_t prev = netlib_select_handle;
+	netlib_select_handle = h;
+	lapack_int info = fn(matrix_layout, jobvs, sort, netlib_sselect2, n, a, lda, sdim, wr, wi, vs, ldvs, work, lwork, bwork);
+	netlib_select_handle = prev;
+	return info;
+}
+
+lapack_int netlib_dgees(uintptr_t h, int matrix_layout, char jobvs, char sort, lapack_int n, double *a, lapack_int lda, lapack_int *sdim, double *wr, double *wi, double *vs, lapack_int ldvs, double *work, lapack_int lwork, lapack_logical *bwork)
+{
+	NETLIB_GEES(LAPACKE_dgees_work)
+	uintptr_t prev = netlib_select_handle;
+	netlib_select_handle = h;
+	lapack_int info = fn(matrix_layout, jobvs, sort, netlib_dselect2, n, a, lda, sdim, wr, wi, vs, ldvs, work, lwork, bwork);
+	netlib_select_handle = prev;
+	return info;
+}
+
+lapack_int netlib_cgees(uintptr_t h, int matrix_layout, char jobvs, char sort, lapack_int n, lapack_complex_float *a, lapack_int lda, lapack_int *sdim, lapack_complex_float *w, lapack_complex_float *vs, lapack_int ldvs, lapack_complex_float *work, lapack_int lwork, float *rwork, lapack_logical *bwork)
+{
+	NETLIB_GEES(LAPACKE_cgees_work)
+	uintptr_t prev = netlib_select_handle;
+	netlib_select_handle = h;
+	lapack_int info = fn(matrix_layout, jobvs, sort, netlib_cselect1, n, a, lda, sdim, w, vs, ldvs, work, lwork, rwork, bwork);
+	netlib_select_handle = prev;
+	return info;
+}
+
+lapack_int netlib_zgees(uintptr_t h, int matrix_layout, char jobvs, char sort, lapack_int n, lapack_complex_double *a, lapack_int lda, lapack_int *sdim, lapack_complex_double *w, lapack_complex_double *vs, lapack_int ldvs, lapack_complex_double *work, lapack_int lwork, double *rwork, lapack_logical *bwork)
+{
+	NETLIB_GEES(LAPACKE_zgees_work)
+	uintptr_t prev = netlib_select_handle;
+	netlib_select_handle = h;
+	lapack_int info = fn(matrix_layout, jobvs, sort, netlib_zselect1, n, a, lda, sdim, w, vs, ldvs, work, lwork, rwork, bwork);
+	netlib_select_handle = prev;
+	return info;
+}
@@ -0,0 +1,298 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapacke
+
+/*
+#include <stdint.h>
+#include "lapacke.h"
+
+lapack_int netlib_sgees(uintptr_t h, int matrix_layout, char jobvs, char sort, lapack_int n, float *a, lapack_int lda, lapack_int *sdim, float *wr, float *wi, float *vs, lapack_int ldvs, float *work, lapack_int lwork, lapack_logical *bwork);
+lapack_int netlib_dgees(uintptr_t h, int matrix_layout, char jobvs, char sort, lapack_int n, double *a, lapack_int lda, lapack_int *sdim, double *wr, double *wi, double *vs, lapack_int ldvs, double *work, lapack_int lwork, lapack_logical *bwork);
+lapack_int netlib_cgees(uintptr_t h, int matrix_layout, char jobvs, char sort, lapack_int n, lapack_complex_float *a, lapack_int lda, lapack_int *sdim, lapack_complex_float *w, lapack_complex_float *vs, lapack_int ldvs, lapack_complex_float *work, lapack_int lwork, float *rwork, lapack_logical *bwork);
+lapack_int netlib_zgees(uintptr_t h, int matrix_layout, char jobvs, char sort, lapack_int n, lapack_complex_double *a, lapack_int lda, lapack_int *sdim, lapack_complex_double *w, lapack_complex_double *vs, lapack_int ldvs, lapack_complex_double *work, lapack_int lwork, double *rwork, lapack_logical *bwork);
+*/
+import "C"
+
+import "sync"
+
+// The ?gees drivers take a SELECT function, which is not supported by the
+// generated bindings. The bindings below pass a C trampoline to LAPACK that
+// calls the Go function registered in selectFuncs under a handle.
+
+var selectFuncs struct {
+	sync.Mutex
+	next uintptr
+	m    map[uintptr]interface{}
+}
+
+// registerSelect registers fn and returns its handle. If fn is a nil
+// function, the trampolines select no eigenvalue.
+func registerSelect(fn interface{}) uintptr {
+	selectFuncs.Lock()
+	defer selectFuncs.Unlock()
+	if selectFuncs.m == nil {
+		selectFuncs.m = make(map[uintptr]interface{})
+	}
+	selectFuncs.next++
+	selectFuncs.m[selectFuncs.next] = fn
+	return selectFuncs.next
+}
+
+// unregisterSelect removes the function registered under h.
+func unregisterSelect(h uintptr) {
+	selectFuncs.Lock()
+	delete(selectFuncs.m, h)
+	selectFuncs.Unlock()
+}
+
+// lookupSelect returns the function registered under h.
+func lookupSelect(h C.uintptr_t) interface{} {
+	selectFuncs.Lock()
+	fn := selectFuncs.m[uintptr(h)]
+	selectFuncs.Unlock()
+	return fn
+}
+
+func logical(b bool) C.lapack_logical {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+//export netlibSelectS
+func netlibSelectS(h C.uintptr_t, wr, wi C.float) C.lapack_logical {
+	fn, _ := lookupSelect(h).(func(wr, wi float32) bool)
+	return logical(fn != nil && fn(float32(wr), float32(wi)))
+}
+
+//export netlibSelectD
+func netlibSelectD(h C.uintptr_t, wr, wi C.double) C.lapack_logical {
+	fn, _ := lookupSelect(h).(func(wr, wi float64) bool)
+	return logical(fn != nil && fn(float64(wr), float64(wi)))
+}
+
+//export netlibSelectC
+func netlibSelectC(h C.uintptr_t, re, im C.float) C.lapack_logical {
+	fn, _ := lookupSelect(h).(func(w complex64) bool)
+	return logical(fn != nil && fn(complex(float32(re), float32(im))))
+}
+
+//export netlibSelectZ
+func netlibSelectZ(h C.uintptr_t, re, im C.double) C.lapack_logical {
+	fn, _ := lookupSelect(h).(func(w complex128) bool)
+	return logical(fn != nil && fn(complex(float64(re), float64(im))))
+}
+
+// Sgees computes the real Schur form of a with optional ordering of the
+// eigenvalues selected by sel, which is only called if sort is 'S' and may
+// be nil otherwise. It returns the LAPACK info value.
+//
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgees.f.
+func Sgees(jobvs, sort byte, sel func(wr, wi float32) bool, n int, a []float32, lda int, sdim []Int, wr, wi, vs []float32, ldvs int, work []float32, lwork int, bwork []Int) int {
+	return RowMajor.Sgees(jobvs, sort, sel, n, a, lda, sdim, wr, wi, vs, ldvs, work, lwork, bwork)
+}
+
+// Sgees is the function Sgees for matrices stored in the order given by
+// layout.
+func (layout Layout) Sgees(jobvs, sort byte, sel func(wr, wi float32) bool, n int, a []float32, lda int, sdim []Int, wr, wi, vs []float32, ldvs int, work []float32, lwork int, bwork []Int) int {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _wr *float32
+	if len(wr) > 0 {
+		_wr = &wr[0]
+	}
+	var _wi *float32
+	if len(wi) > 0 {
+		_wi = &wi[0]
+	}
+	var _vs *float32
+	if len(vs) > 0 {
+		_vs = &vs[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	checkGees(n, lda, ldvs, lwork)
+	if lwork != -1 {
+		defer admit(25 * mnk(n, n, n))()
+	}
+	h := registerSelect(sel)
+	defer unregisterSelect(h)
+	return int(C.netlib_sgees(C.uintptr_t(h), (C.int)(layout), (C.char)(jobvs), (C.char)(sort), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_sdim), (*C.float)(_wr), (*C.float)(_wi), (*C.float)(_vs), (C.lapack_int)(ldvs), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_logical)(_bwork)))
+}
+
+// Dgees computes the real Schur form of a with optional ordering of the
+// eigenvalues selected by sel, which is only called if sort is 'S' and may
+// be nil otherwise. It returns the LAPACK info value.
+//
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgees.f.
+func Dgees(jobvs, sort byte, sel func(wr, wi float64) bool, n int, a []float64, lda int, sdim []Int, wr, wi, vs []float64, ldvs int, work []float64, lwork int, bwork []Int) int {
+	return RowMajor.Dgees(jobvs, sort, sel, n, a, lda, sdim, wr, wi, vs, ldvs, work, lwork, bwork)
+}
+
+// Dgees is the function Dgees for matrices stored in the order given by
+// layout.
+func (layout Layout) Dgees(jobvs, sort byte, sel func(wr, wi float64) bool, n int, a []float64, lda int, sdim []Int, wr, wi, vs []float64, ldvs int, work []float64, lwork int, bwork []Int) int {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _wr *float64
+	if len(wr) > 0 {
+		_wr = &wr[0]
+	}
+	var _wi *float64
+	if len(wi) > 0 {
+		_wi = &wi[0]
+	}
+	var _vs *float64
+	if len(vs) > 0 {
+		_vs = &vs[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	checkGees(n, lda, ldvs, lwork)
+	if lwork != -1 {
+		defer admit(25 * mnk(n, n, n))()
+	}
+	h := registerSelect(sel)
+	defer unregisterSelect(h)
+	return int(C.netlib_dgees(C.uintptr_t(h), (C.int)(layout), (C.char)(jobvs), (C.char)(sort), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_sdim), (*C.double)(_wr), (*C.double)(_wi), (*C.double)(_vs), (C.lapack_int)(ldvs), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_logical)(_bwork)))
+}
+
+// Cgees computes the complex Schur form of a with optional ordering of the
+// eigenvalues selected by sel, which is only called if sort is 'S' and may
+// be nil otherwise. It returns the LAPACK info value.
+//
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgees.f.
+func Cgees(jobvs, sort byte, sel func(w complex64) bool, n int, a []complex64, lda int, sdim []Int, w, vs []complex64, ldvs int, work []complex64, lwork int, rwork []float32, bwork []Int) int {
+	return RowMajor.Cgees(jobvs, sort, sel, n, a, lda, sdim, w, vs, ldvs, work, lwork, rwork, bwork)
+}
+
+// Cgees is the function Cgees for matrices stored in the order given by
+// layout.
+func (layout Layout) Cgees(jobvs, sort byte, sel func(w complex64) bool, n int, a []complex64, lda int, sdim []Int, w, vs []complex64, ldvs int, work []complex64, lwork int, rwork []float32, bwork []Int) int {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _w *complex64
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _vs *complex64
+	if len(vs) > 0 {
+		_vs = &vs[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	checkGees(n, lda, ldvs, lwork)
+	if lwork != -1 {
+		defer admit(4 * 25 * mnk(n, n, n))()
+	}
+	h := registerSelect(sel)
+	defer unregisterSelect(h)
+	return int(C.netlib_cgees(C.uintptr_t(h), (C.int)(layout), (C.char)(jobvs), (C.char)(sort), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_sdim), (*C.lapack_complex_float)(_w), (*C.lapack_complex_float)(_vs), (C.lapack_int)(ldvs), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (*C.lapack_logical)(_bwork)))
+}
+
+// Zgees computes the complex Schur form of a with optional ordering of the
+// eigenvalues selected by sel, which is only called if sort is 'S' and may
+// be nil otherwise. It returns the LAPACK info value.
+//
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgees.f.
+func Zgees(jobvs, sort byte, sel func(w complex128) bool, n int, a []complex128, lda int, sdim []Int, w, vs []complex128, ldvs int, work []complex128, lwork int, rwork []float64, bwork []Int) int {
+	return RowMajor.Zgees(jobvs, sort, sel, n, a, lda, sdim, w, vs, ldvs, work, lwork, rwork, bwork)
+}
+
+// Zgees is the function Zgees for matrices stored in the order given by
+// layout.
+func (layout Layout) Zgees(jobvs, sort byte, sel func(w complex128) bool, n int, a []complex128, lda int, sdim []Int, w, vs []complex128, ldvs int, work []complex128, lwork int, rwork []float64, bwork []Int) int {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _w *complex128
+	if len(w) > 0 {
+		_w = &w[0]
+	}
+	var _vs *complex128
+	if len(vs) > 0 {
+		_vs = &vs[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	checkGees(n, lda, ldvs, lwork)
+	if lwork != -1 {
+		defer admit(4 * 25 * mnk(n, n, n))()
+	}
+	h := registerSelect(sel)
+	defer unregisterSelect(h)
+	return int(C.netlib_zgees(C.uintptr_t(h), (C.int)(layout), (C.char)(jobvs), (C.char)(sort), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_int)(_sdim), (*C.lapack_complex_double)(_w), (*C.lapack_complex_double)(_vs), (C.lapack_int)(ldvs), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (*C.lapack_logical)(_bwork)))
+}
+
+func checkGees(n, lda, ldvs, lwork int) {
+	if n < minInt || n > maxInt {
+		panic("lapack: n too large")
+	}
+	if lda < minInt || lda > maxInt {
+		panic("lapack: lda too large")
+	}
+	if ldvs < minInt || ldvs > maxInt {
+		panic("lapack: ldvs too large")
+	}
+	if lwork < minInt || lwork > maxInt {
+		panic("lapack: lwork too large")
+	}
+}
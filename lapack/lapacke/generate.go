@@ -3,5 +3,6 @@
 // license that can be found in the LICENSE file.
 
 //go:generate go run generate_lapacke.go
+//go:generate go run generate_dlopen.go
 
 package lapacke
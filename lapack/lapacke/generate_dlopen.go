@@ -0,0 +1,76 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build ignore
+
+// generate_dlopen creates a lapacke_dlopen.c file defining the LAPACKE functions
+// called by lapacke.go as trampolines into a library loaded at run time.
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"regexp"
+
+	"gonum.org/v1/netlib/internal/binding"
+)
+
+const (
+	header = "lapacke.h"
+	source = "lapacke.go"
+	target = "lapacke_dlopen.c"
+)
+
+var dlopen = binding.Dlopen{
+	Header:   header,
+	Prefix:   "lapacke",
+	Env:      "NETLIB_LAPACKE_LIBRARY",
+	BuildTag: "dlopen",
+	Candidates: []string{
+		"libopenblas.so.0",
+		"libopenblas.so",
+		"libmkl_rt.so.2",
+		"libmkl_rt.so",
+		"liblapacke.so.3",
+		"liblapacke.so",
+	},
+	DarwinCandidates: []string{
+		"libopenblas.dylib",
+		"liblapacke.dylib",
+	},
+	WindowsCandidates: []string{
+		"libopenblas.dll",
+		"mkl_rt.2.dll",
+		"liblapacke.dll",
+	},
+	Generator: "gonum.org/v1/netlib/lapack/lapacke",
+}
+
+var call = regexp.MustCompile(`C\.(LAPACKE_\w+)\(`)
+
+func main() {
+	decls, err := binding.Declarations(header)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := ioutil.ReadFile(source)
+	if err != nil {
+		log.Fatal(err)
+	}
+	names := make(map[string]bool)
+	for _, m := range call.FindAllSubmatch(src, -1) {
+		names[string(m[1])] = true
+	}
+
+	var buf bytes.Buffer
+	err = dlopen.Generate(&buf, decls, names)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = ioutil.WriteFile(target, buf.Bytes(), 0664)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -60,6 +60,18 @@ var needsInt = map[string]bool{
 	"geevx": true,
 }
 
+// infoVariants is a list of routines that keep the success boolean of their
+// wrapper and also have an Info variant returning the integer info value,
+// for callers that need the index of a failing pivot or must distinguish an
+// illegal argument from a numerical failure.
+var infoVariants = map[string]bool{
+	"gesvx": true,
+	"pftrf": true,
+	"posvx": true,
+	"potrf": true,
+	"sytrf": true,
+}
+
 // allUplo is a list of routines that allow any value for their uplo argument.
 // The list keys are truncated by one character to cover all four numeric types.
 var allUplo = map[string]bool{
@@ -111,7 +123,7 @@ func typeForInt(n string) string {
 
 func convForInt(n string) string {
 	switch n {
-	case "rowMajor":
+	case "layout":
 		return "C.int"
 	case "forwrd", "wantq", "wantz":
 		return "C.lapack_logical"
@@ -133,7 +145,7 @@ var goTypes = map[binding.TypeKey]*template.Template{
 	{Kind: cc.Int}:                            template.Must(template.New("int").Funcs(map[string]interface{}{"typefor": typeForInt}).Parse("{{typefor .}}")),
 	{Kind: cc.Char}:                           template.Must(template.New("byte").Parse("byte")),
 	{Kind: cc.Char, IsPointer: true}:          template.Must(template.New("[]byte").Parse("[]byte")),
-	{Kind: cc.Int, IsPointer: true}:           template.Must(template.New("[]int32").Parse("[]int32")),
+	{Kind: cc.Int, IsPointer: true}:           template.Must(template.New("[]Int").Parse("[]Int")),
 	{Kind: cc.FloatComplex, IsPointer: true}:  template.Must(template.New("[]complex64").Parse("[]complex64")),
 	{Kind: cc.DoubleComplex, IsPointer: true}: template.Must(template.New("[]complex128").Parse("[]complex128")),
 }
@@ -154,7 +166,7 @@ var cgoTypes = map[binding.TypeKey]*template.Template{
 }
 
 var names = map[string]string{
-	"matrix_layout": "rowMajor",
+	"matrix_layout": "layout",
 	"uplo":          "ul",
 	"range":         "rng",
 	"diag":          "d",
@@ -209,21 +221,31 @@ func main() {
 			continue
 		case strings.HasSuffix(lapackeName, "vxx"):
 			continue
-		case strings.HasSuffix(lapackeName, "rook"):
-			continue
 		}
 		if hasFuncParameter(d) {
 			continue
 		}
 
-		goSignature(&buf, d)
-		if noteOrigin {
-			fmt.Fprintf(&buf, "\t// %s %s %s ...\n\n", d.Position(), d.Return, d.Name)
+		for _, info := range []bool{false, true} {
+			if info && !infoVariants[lapackeName[1:]] {
+				continue
+			}
+			method := hasLayout(d)
+			if method {
+				rowMajorCall(&buf, d, info)
+			}
+			goSignature(&buf, d, info, method)
+			if noteOrigin {
+				fmt.Fprintf(&buf, "\t// %s %s %s ...\n\n", d.Position(), d.Return, d.Name)
+			}
+			trace(&buf, d, info)
+			parameterChecks(&buf, d, parameterCheckRules)
+			intRange(&buf, d)
+			admission(&buf, d)
+			buf.WriteByte('\t')
+			cgoCall(&buf, d, info)
+			buf.WriteString("}\n")
 		}
-		parameterChecks(&buf, d, parameterCheckRules)
-		buf.WriteByte('\t')
-		cgoCall(&buf, d)
-		buf.WriteString("}\n")
 	}
 
 	b, err := format.Source(buf.Bytes())
@@ -249,14 +271,82 @@ func hasFuncParameter(d binding.Declaration) bool {
 	return false
 }
 
-func goSignature(buf *bytes.Buffer, d binding.Declaration) {
+// goNameFor returns the Go function name for the LAPACKE routine name.
+// Variant suffixes are camel-cased, so csytrf_rook becomes CsytrfRook.
+func goNameFor(lapackeName string) string {
+	parts := strings.Split(lapackeName, "_")
+	for i, p := range parts {
+		parts[i] = binding.UpperCaseFirst(p)
+	}
+	return strings.Join(parts, "")
+}
+
+// goSignature writes the signature of the wrapper of d, or of its Info
+// variant if info is true.
+// hasLayout returns whether d takes the storage order of its matrices. Such
+// routines are bound by a method of Layout and by a function calling the
+// method of RowMajor.
+func hasLayout(d binding.Declaration) bool {
+	for _, p := range d.Parameters() {
+		if p.Name() == "matrix_layout" {
+			return true
+		}
+	}
+	return false
+}
+
+// rowMajorCall writes the function binding d, which calls the method of
+// RowMajor written by goSignature with method set.
+func rowMajorCall(buf *bytes.Buffer, d binding.Declaration, info bool) {
 	lapackeName := strings.TrimSuffix(strings.TrimPrefix(d.Name, prefix), suffix)
-	goName := binding.UpperCaseFirst(lapackeName)
+	goName := goNameFor(lapackeName)
+	if info {
+		goName += "Info"
+	}
+	goSignature(buf, d, info, false)
+	buf.WriteByte('\t')
+	if d.Return.Kind() != cc.Void {
+		buf.WriteString("return ")
+	}
+	fmt.Fprintf(buf, "RowMajor.%s(", goName)
+	c := 0
+	for _, p := range d.Parameters() {
+		if p.Name() == "matrix_layout" {
+			continue
+		}
+		if c != 0 {
+			buf.WriteString(", ")
+		}
+		c++
+		buf.WriteString(shorten(binding.LowerCaseFirst(p.Name())))
+	}
+	buf.WriteString(")\n}\n")
+}
+
+// goSignature writes the documentation and signature of the binding of d.
+// If method is true, the binding is a method of Layout with the receiver
+// named layout.
+func goSignature(buf *bytes.Buffer, d binding.Declaration, info, method bool) {
+	lapackeName := strings.TrimSuffix(strings.TrimPrefix(d.Name, prefix), suffix)
+	goName := goNameFor(lapackeName)
 
 	parameters := d.Parameters()
 
-	fmt.Fprintf(buf, "\n// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/%s.f.\n", lapackeName)
-	fmt.Fprintf(buf, "func %s(", goName)
+	if info {
+		goName += "Info"
+	}
+	var recv string
+	switch {
+	case method:
+		fmt.Fprintf(buf, "\n// %[1]s is the function %[1]s for matrices stored in the order given by\n// layout.\n", goName)
+		recv = "(layout Layout) "
+	case info:
+		fmt.Fprintf(buf, "\n// %[1]s is %[2]s returning the info value of the routine instead of\n// whether it succeeded.\n//", goName, strings.TrimSuffix(goName, "Info"))
+		fallthrough
+	default:
+		fmt.Fprintf(buf, "\n// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/%s.f.\n", lapackeName)
+	}
+	fmt.Fprintf(buf, "func %s%s(", recv, goName)
 	c := 0
 	for i, p := range parameters {
 		if p.Name() == "matrix_layout" {
@@ -293,7 +383,7 @@ func goSignature(buf *bytes.Buffer, d binding.Declaration) {
 	}
 	if d.Return.Kind() != cc.Void {
 		var must string
-		if needsInt[lapackeName[1:]] {
+		if info || needsInt[lapackeName[1:]] {
 			must = "_must"
 		}
 		fmt.Fprintf(buf, ") %s {\n", cToGoType[d.Return.String()+must])
@@ -302,6 +392,36 @@ func goSignature(buf *bytes.Buffer, d binding.Declaration) {
 	}
 }
 
+// trace writes the diagnostics of d, which are started before the
+// parameters are checked so that argument errors are reported. The scalar
+// parameters are passed as the arguments of the call. The routine is named
+// as the Info variant if info is true.
+func trace(buf *bytes.Buffer, d binding.Declaration, info bool) {
+	lapackeName := strings.TrimSuffix(strings.TrimPrefix(d.Name, prefix), suffix)
+	goName := goNameFor(lapackeName)
+	if info {
+		goName += "Info"
+	}
+	fmt.Fprintf(buf, "\tif diag.Current() != diag.Off {\n\t\tdefer diag.Trace(\"lapacke\", %q", goName)
+	for _, p := range d.Parameters() {
+		if p.Name() == "matrix_layout" {
+			continue
+		}
+		n := shorten(binding.LowerCaseFirst(p.Name()))
+		var typ string
+		if p.Kind() == cc.Enum {
+			typ = binding.GoTypeForEnum(p.Type(), n)
+		} else {
+			typ = binding.GoTypeFor(p.Type(), n, goTypes)
+		}
+		if strings.HasPrefix(typ, "[]") {
+			continue
+		}
+		fmt.Fprintf(buf, ", %s", n)
+	}
+	buf.WriteString(")()\n\t}\n")
+}
+
 func parameterChecks(buf *bytes.Buffer, d binding.Declaration, rules []func(*bytes.Buffer, binding.Declaration, binding.Parameter) bool) {
 	done := make(map[int]bool)
 	for _, p := range d.Parameters() {
@@ -314,11 +434,11 @@ func parameterChecks(buf *bytes.Buffer, d binding.Declaration, rules []func(*byt
 	}
 }
 
-func cgoCall(buf *bytes.Buffer, d binding.Declaration) {
+func cgoCall(buf *bytes.Buffer, d binding.Declaration, info bool) {
 	if d.Return.Kind() != cc.Void {
 		lapackeName := strings.TrimSuffix(strings.TrimPrefix(d.Name, prefix), suffix)
 		var must string
-		if needsInt[lapackeName[1:]] {
+		if info || needsInt[lapackeName[1:]] {
 			must = "_must"
 		}
 		fmt.Fprintf(buf, "return %s(", cToGoTypeConv[d.Return.String()+must])
@@ -348,6 +468,112 @@ var parameterCheckRules = []func(*bytes.Buffer, binding.Declaration, binding.Par
 	address,
 }
 
+// flops holds the number of floating point operations of the routines whose
+// calls are admitted by the process-wide admission controller, as Go
+// expressions of their parameters keyed by the routine name without its
+// type prefix. The counts are the leading terms for real matrices with the
+// eigenvectors or singular vectors computed, and the complex routines are
+// counted four times.
+var flops = map[string]string{
+	"gesv":  "2*mnk(n, n, n)/3 + 2*mnk(n, n, nrhs)",
+	"getrf": "trapezoid(m, n)",
+	"getri": "4 * mnk(n, n, n) / 3",
+
+	"posv":  "mnk(n, n, n)/3 + 2*mnk(n, n, nrhs)",
+	"potrf": "mnk(n, n, n) / 3",
+	"potri": "2 * mnk(n, n, n) / 3",
+	"trtri": "mnk(n, n, n) / 3",
+
+	"sysv":  "mnk(n, n, n)/3 + 2*mnk(n, n, nrhs)",
+	"hesv":  "mnk(n, n, n)/3 + 2*mnk(n, n, nrhs)",
+	"sytrf": "mnk(n, n, n) / 3",
+	"hetrf": "mnk(n, n, n) / 3",
+
+	"geqrf": "2 * trapezoid(m, n)",
+	"gelqf": "2 * trapezoid(m, n)",
+	"geqlf": "2 * trapezoid(m, n)",
+	"gerqf": "2 * trapezoid(m, n)",
+	"geqp3": "2 * trapezoid(m, n)",
+	"gels":  "2*trapezoid(m, n) + 4*mnk(m, n, nrhs)",
+	"gelsy": "2*trapezoid(m, n) + 4*mnk(m, n, nrhs)",
+	"gelsd": "svd(m, n)",
+	"gelss": "svd(m, n)",
+
+	"gebrd":  "4 * trapezoid(m, n)",
+	"gesvd":  "svd(m, n)",
+	"gesdd":  "svd(m, n)",
+	"gesvdx": "svd(m, n)",
+
+	"sytrd": "4 * mnk(n, n, n) / 3",
+	"hetrd": "4 * mnk(n, n, n) / 3",
+	"syev":  "9 * mnk(n, n, n)",
+	"heev":  "9 * mnk(n, n, n)",
+	"syevd": "9 * mnk(n, n, n)",
+	"heevd": "9 * mnk(n, n, n)",
+	"syevr": "9 * mnk(n, n, n)",
+	"heevr": "9 * mnk(n, n, n)",
+	"syevx": "9 * mnk(n, n, n)",
+	"heevx": "9 * mnk(n, n, n)",
+	"sygv":  "10 * mnk(n, n, n)",
+	"hegv":  "10 * mnk(n, n, n)",
+	"sygvd": "10 * mnk(n, n, n)",
+	"hegvd": "10 * mnk(n, n, n)",
+
+	"gehrd": "10 * mnk(n, n, n) / 3",
+	"hseqr": "10 * mnk(n, n, n)",
+	"geev":  "25 * mnk(n, n, n)",
+	"ggev":  "66 * mnk(n, n, n)",
+	"ggev3": "66 * mnk(n, n, n)",
+}
+
+// admission writes the admission of a call of d by the process-wide
+// admission controller if its number of operations is in flops. Calls
+// that query the size of the workspace are not admitted.
+func admission(buf *bytes.Buffer, d binding.Declaration) {
+	lapackeName := strings.TrimSuffix(strings.TrimPrefix(d.Name, prefix), suffix)
+	f, ok := flops[lapackeName[1:]]
+	if !ok {
+		return
+	}
+	switch {
+	case lapackeName[0] != 'c' && lapackeName[0] != 'z':
+	case strings.ContainsAny(f, "+-"):
+		f = "4 * (" + f + ")"
+	default:
+		f = "4 * " + f
+	}
+	var query []string
+	for _, p := range d.Parameters() {
+		switch n := p.Name(); n {
+		case "lwork", "liwork", "lrwork":
+			query = append(query, n+" != -1")
+		}
+	}
+	if len(query) == 0 {
+		fmt.Fprintf(buf, "\tdefer admit(%s)()\n", f)
+		return
+	}
+	fmt.Fprintf(buf, "\tif %s {\n\t\tdefer admit(%s)()\n\t}\n", strings.Join(query, " && "), f)
+}
+
+// intRange writes checks that the Go int parameters of d can be represented
+// by lapack_int.
+func intRange(buf *bytes.Buffer, d binding.Declaration) {
+	for _, p := range d.Parameters() {
+		if p.Kind() != cc.Int || p.Name() == "matrix_layout" {
+			continue
+		}
+		n := shorten(binding.LowerCaseFirst(p.Name()))
+		if typeForInt(n) != "int" {
+			continue
+		}
+		fmt.Fprintf(buf, `	if %[1]s < minInt || %[1]s > maxInt {
+		panic("lapack: %[1]s too large")
+	}
+`, n)
+	}
+}
+
 func uplo(buf *bytes.Buffer, d binding.Declaration, p binding.Parameter) bool {
 	if p.Name() != "uplo" {
 		return false
@@ -406,7 +632,7 @@ func trans(buf *bytes.Buffer, d binding.Declaration, p binding.Parameter) bool {
 
 var addrTypes = map[string]string{
 	"char":           "byte",
-	"int":            "int32",
+	"int":            "Int",
 	"float":          "float32",
 	"double":         "float64",
 	"float complex":  "complex64",
@@ -435,6 +661,18 @@ const handwritten = `// Code generated by "go generate gonum.org/v1/netlib/lapac
 // Package lapacke provides bindings to the LAPACKE C Interface to LAPACK.
 //
 // Links are provided to the NETLIB fortran implementation/dependencies for each function.
+//
+// Every LAPACKE_*_work routine declared in lapacke.h has a binding named
+// after the routine, with variant suffixes camel-cased so that
+// LAPACKE_dsytrf_rook_work is bound by DsytrfRook. The exceptions are the
+// deprecated ?geqpf, ?ggsvd and ?ggsvp, the extra-precise ?rfsx and ?svxx
+// drivers and ?geesx and ?ggesx. The drivers that take a SELECT or SELCTG
+// function, ?gees, ?gges and ?gges3, are written by hand and take a Go
+// function in its place.
+//
+// The matrices are stored in row-major order. The routines that take the
+// storage order of their matrices are also bound by methods of Layout, so
+// that ColMajor.Dgetrf factorizes a column-major matrix.
 package lapacke
 
 /*
@@ -444,7 +682,11 @@ package lapacke
 */
 import "C"
 
-import "unsafe"
+import (
+	"unsafe"
+
+	"gonum.org/v1/netlib/diag"
+)
 
 // Type order is used to specify the matrix storage format. We still interact with
 // an API that allows client calls to specify order, so this is here to document that fact.
@@ -455,5 +697,5 @@ const (
 	colMajor
 )
 
-func isZero(ret C.int) bool { return ret == 0 }
+func isZero(ret C.lapack_int) bool { return ret == 0 }
 `
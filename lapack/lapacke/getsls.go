@@ -0,0 +1,162 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapacke
+
+/*
+#include <stddef.h>
+#include "lapacke.h"
+
+// The ?getsls drivers were added in LAPACK 3.7 and are not declared in
+// lapacke.h. They are looked up at run time when the library is loaded by
+// the dlopen trampolines and declared weak where possible otherwise, and
+// the problem is solved by ?gels when the library lacks them.
+#if defined(NETLIB_LAPACKE_DLOPEN)
+void *netlib_lapacke_symbol(const char *name);
+#define NETLIB_GETSLS_WEAK
+#elif defined(__ELF__)
+#define NETLIB_GETSLS_WEAK __attribute__((weak))
+#else
+#define NETLIB_GETSLS_MISSING 1
+#define NETLIB_GETSLS_WEAK
+#endif
+
+#define NETLIB_DECLARE_GETSLS(name, T) \
+	NETLIB_GETSLS_WEAK lapack_int name(int matrix_layout, char trans, \
+		lapack_int m, lapack_int n, lapack_int nrhs, T *a, lapack_int lda, \
+		T *b, lapack_int ldb, T *work, lapack_int lwork);
+
+NETLIB_DECLARE_GETSLS(LAPACKE_sgetsls_work, float)
+NETLIB_DECLARE_GETSLS(LAPACKE_dgetsls_work, double)
+
+typedef lapack_int (*netlib_sgetsls_fn)(int, char, lapack_int, lapack_int, lapack_int, float *, lapack_int, float *, lapack_int, float *, lapack_int);
+typedef lapack_int (*netlib_dgetsls_fn)(int, char, lapack_int, lapack_int, lapack_int, double *, lapack_int, double *, lapack_int, double *, lapack_int);
+
+// NETLIB_GETSLS_FN evaluates to the address of the named driver, or NULL
+// if the library does not provide it.
+#if defined(NETLIB_LAPACKE_DLOPEN)
+#define NETLIB_GETSLS_FN(name) netlib_lapacke_symbol(#name)
+#elif defined(NETLIB_GETSLS_MISSING)
+#define NETLIB_GETSLS_FN(name) NULL
+#else
+#define NETLIB_GETSLS_FN(name) ((void *)name)
+#endif
+
+static int netlib_has_getsls(void)
+{
+	return NETLIB_GETSLS_FN(LAPACKE_dgetsls_work) != NULL && NETLIB_GETSLS_FN(LAPACKE_sgetsls_work) != NULL;
+}
+
+static lapack_int netlib_sgetsls(int layout, char trans, lapack_int m, lapack_int n, lapack_int nrhs, float *a, lapack_int lda, float *b, lapack_int ldb, float *work, lapack_int lwork)
+{
+	netlib_sgetsls_fn fn = (netlib_sgetsls_fn)NETLIB_GETSLS_FN(LAPACKE_sgetsls_work);
+	if (fn == NULL) {
+		return LAPACKE_sgels_work(layout, trans, m, n, nrhs, a, lda, b, ldb, work, lwork);
+	}
+	return fn(layout, trans, m, n, nrhs, a, lda, b, ldb, work, lwork);
+}
+
+static lapack_int netlib_dgetsls(int layout, char trans, lapack_int m, lapack_int n, lapack_int nrhs, double *a, lapack_int lda, double *b, lapack_int ldb, double *work, lapack_int lwork)
+{
+	netlib_dgetsls_fn fn = (netlib_dgetsls_fn)NETLIB_GETSLS_FN(LAPACKE_dgetsls_work);
+	if (fn == NULL) {
+		return LAPACKE_dgels_work(layout, trans, m, n, nrhs, a, lda, b, ldb, work, lwork);
+	}
+	return fn(layout, trans, m, n, nrhs, a, lda, b, ldb, work, lwork);
+}
+*/
+import "C"
+
+// HasGetsls returns whether the LAPACKE library provides the ?getsls
+// drivers. If it does not, Sgetsls and Dgetsls solve the problem by ?gels.
+func HasGetsls() bool {
+	return C.netlib_has_getsls() != 0
+}
+
+// Sgetsls solves overdetermined or underdetermined linear systems using the
+// tall skinny QR or short wide LQ factorization of a, falling back to Sgels
+// if the library lacks sgetsls.
+//
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgetsls.f.
+func Sgetsls(trans byte, m, n, nrhs int, a []float32, lda int, b []float32, ldb int, work []float32, lwork int) bool {
+	return RowMajor.Sgetsls(trans, m, n, nrhs, a, lda, b, ldb, work, lwork)
+}
+
+// Sgetsls is the function Sgetsls for matrices stored in the order given by
+// layout.
+func (layout Layout) Sgetsls(trans byte, m, n, nrhs int, a []float32, lda int, b []float32, ldb int, work []float32, lwork int) bool {
+	switch trans {
+	case 'N', 'T':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	checkGetsls(m, n, nrhs, lda, ldb, lwork)
+	return isZero(C.netlib_sgetsls((C.int)(layout), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.float)(_work), (C.lapack_int)(lwork)))
+}
+
+// Dgetsls solves overdetermined or underdetermined linear systems using the
+// tall skinny QR or short wide LQ factorization of a, falling back to Dgels
+// if the library lacks dgetsls.
+//
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgetsls.f.
+func Dgetsls(trans byte, m, n, nrhs int, a []float64, lda int, b []float64, ldb int, work []float64, lwork int) bool {
+	return RowMajor.Dgetsls(trans, m, n, nrhs, a, lda, b, ldb, work, lwork)
+}
+
+// Dgetsls is the function Dgetsls for matrices stored in the order given by
+// layout.
+func (layout Layout) Dgetsls(trans byte, m, n, nrhs int, a []float64, lda int, b []float64, ldb int, work []float64, lwork int) bool {
+	switch trans {
+	case 'N', 'T':
+	default:
+		panic("lapack: bad trans")
+	}
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	checkGetsls(m, n, nrhs, lda, ldb, lwork)
+	return isZero(C.netlib_dgetsls((C.int)(layout), (C.char)(trans), (C.lapack_int)(m), (C.lapack_int)(n), (C.lapack_int)(nrhs), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.double)(_work), (C.lapack_int)(lwork)))
+}
+
+func checkGetsls(m, n, nrhs, lda, ldb, lwork int) {
+	if m < minInt || m > maxInt {
+		panic("lapack: m too large")
+	}
+	if n < minInt || n > maxInt {
+		panic("lapack: n too large")
+	}
+	if nrhs < minInt || nrhs > maxInt {
+		panic("lapack: nrhs too large")
+	}
+	if lda < minInt || lda > maxInt {
+		panic("lapack: lda too large")
+	}
+	if ldb < minInt || ldb > maxInt {
+		panic("lapack: ldb too large")
+	}
+	if lwork < minInt || lwork > maxInt {
+		panic("lapack: lwork too large")
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file bridges the SELCTG arguments of the ?gges and ?gges3 drivers to
+// Go in the same way as gees.c does for the SELECT arguments of ?gees.
+
+#include <stdint.h>
+#include <stdlib.h>
+
+#include "lapacke.h"
+#include "_cgo_export.h"
+
+static __thread uintptr_t netlib_selctg_handle;
+
+static lapack_logical netlib_sselect3(const float *alphar, const float *alphai, const float *beta)
+{
+	return netlibSelctgS(netlib_selctg_handle, *alphar, *alphai, *beta);
+}
+
+static lapack_logical netlib_dselect3(const double *alphar, const double *alphai, const double *beta)
+{
+	return netlibSelctgD(netlib_selctg_handle, *alphar, *alphai, *beta);
+}
+
+static lapack_logical netlib_cselect2(const lapack_complex_float *alpha, const lapack_complex_float *beta)
+{
+	return netlibSelctgC(netlib_selctg_handle, lapack_complex_float_real(*alpha), lapack_complex_float_imag(*alpha), lapack_complex_float_real(*beta), lapack_complex_float_imag(*beta));
+}
+
+static lapack_logical netlib_zselect2(const lapack_complex_double *alpha, const lapack_complex_double *beta)
+{
+	return netlibSelctgZ(netlib_selctg_handle, lapack_complex_double_real(*alpha), lapack_complex_double_imag(*alpha), lapack_complex_double_real(*beta), lapack_complex_double_imag(*beta));
+}
+
+#ifdef NETLIB_LAPACKE_DLOPEN
+void *netlib_lapacke_symbol(const char *name);
+
+// netlib_gges_resolve returns the address of the named driver in the library
+// loaded by the dlopen trampolines. If the driver is missing, the Go
+// function netlib_lapacke_unsupported panics with an ErrUnsupported.
+static void *netlib_gges_resolve(const char *name)
+{
+	void *fn = netlib_lapacke_symbol(name);
+	if (fn == NULL) {
+		netlib_lapacke_unsupported((char *)name, NULL);
+		abort();
+	}
+	return fn;
+}
+
+#define NETLIB_GGES(name) \
+	static __typeof__(name) *fn; \
+	if (fn == NULL) { \
+		fn = (__typeof__(name) *)netlib_gges_resolve(#name); \
+	}
+#else
+#define NETLIB_GGES(name) \
+	__typeof__(name) *fn = name;
+#endif
+
+lapack_int netlib_sgges(uintptr_t h, int matrix_layout, char jobvsl, char jobvsr, char sort, lapack_int n, float *a, lapack_int lda, float *b, lapack_int ldb, lapack_int *sdim, float *alphar, float *alphai, float *beta, float *vsl, lapack_int ldvsl, float *vsr, lapack_int ldvsr, float *work, lapack_int lwork, lapack_logical *bwork)
+{
+	NETLIB_GGES(LAPACKE_sgges_work)
+	uintptr_t prev = netlib_selctg_handle;
+	netlib_selctg_handle = h;
+	lapack_int info = fn(matrix_layout, jobvsl, jobvsr, sort, netlib_sselect3, n, a, lda, b, ldb, sdim, alphar, alphai, beta, vsl, ldvsl, vsr, ldvsr, work, lwork, bwork);
+	netlib_selctg_handle = prev;
+	return info;
+}
+
+lapack_int netlib_dgges(uintptr_t h, int matrix_layout, char jobvsl, char jobvsr, char sort, lapack_int n, double *a, lapack_int lda, double *b, lapack_int ldb, lapack_int *sdim, double *alphar, double *alphai, double *beta, double *vsl, lapack_int ldvsl, double *vsr, lapack_int ldvsr, double *work, lapack_int lwork, lapack_logical *bwork)
+{
+	NETLIB_GGES(LAPACKE_dgges_work)
+	uintptr_t prev = netlib_selctg_handle;
+	netlib_selctg_handle = h;
+	lapack_int info = fn(matrix_layout, jobvsl, jobvsr, sort, netlib_dselect3, n, a, lda, b, ldb, sdim, alphar, alphai, beta, vsl, ldvsl, vsr, ldvsr, work, lwork, bwork);
+	netlib_selctg_handle = prev;
+	return info;
+}
+
+lapack_int netlib_cgges(uintptr_t h, int matrix_layout, char jobvsl, char jobvsr, char sort, lapack_int n, lapack_complex_float *a, lapack_int lda, lapack_complex_float *b, lapack_int ldb, lapack_int *sdim, lapack_complex_float *alpha, lapack_complex_float *beta, lapack_complex_float *vsl, lapack_int ldvsl, lapack_complex_float *vsr, lapack_int ldvsr, lapack_complex_float *work, lapack_int lwork, float *rwork, lapack_logical *bwork)
+{
+	NETLIB_GGES(LAPACKE_cgges_work)
+	uintptr_t prev = netlib_selctg_handle;
+	netlib_selctg_handle = h;
+	lapack_int info = fn(matrix_layout, jobvsl, jobvsr, sort, netlib_cselect2, n, a, lda, b, ldb, sdim, alpha, beta, vsl, ldvsl, vsr, ldvsr, work, lwork, rwork, bwork);
+	netlib_selctg_handle = prev;
+	return info;
+}
+
+lapack_int netlib_zgges(uintptr_t h, int matrix_layout, char jobvsl, char jobvsr, char sort, lapack_int n, lapack_complex_double *a, lapack_int lda, lapack_complex_double *b, lapack_int ldb, lapack_int *sdim, lapack_complex_double *alpha, lapack_complex_double *beta, lapack_complex_double *vsl, lapack_int ldvsl, lapack_complex_double *vsr, lapack_int ldvsr, lapack_complex_double *work, lapack_int lwork, double *rwork, lapack_logical *bwork)
+{
+	NETLIB_GGES(LAPACKE_zgges_work)
+	uintptr_t prev = netlib_selctg_handle;
+	netlib_selctg_handle = h;
+	lapack_int info = fn(matrix_layout, jobvsl, jobvsr, sort, netlib_zselect2, n, a, lda, b, ldb, sdim, alpha, beta, vsl, ldvsl, vsr, ldvsr, work, lwork, rwork, bwork);
+	netlib_selctg_handle = prev;
+	return info;
+}
+
+lapack_int netlib_sgges3(uintptr_t h, int matrix_layout, char jobvsl, char jobvsr, char sort, lapack_int n, float *a, lapack_int lda, float *b, lapack_int ldb, lapack_int *sdim, float *alphar, float *alphai, float *beta, float *vsl, lapack_int ldvsl, float *vsr, lapack_int ldvsr, float *work, lapack_int lwork, lapack_logical *bwork)
+{
+	NETLIB_GGES(LAPACKE_sgges3_work)
+	uintptr_t prev = netlib_selctg_handle;
+	netlib_selctg_handle = h;
+	lapack_int info = fn(matrix_layout, jobvsl, jobvsr, sort, netlib_sselect3, n, a, lda, b, ldb, sdim, alphar, alphai, beta, vsl, ldvsl, vsr, ldvsr, work, lwork, bwork);
+	netlib_selctg_handle = prev;
+	return info;
+}
+
+lapack_int netlib_dgges3(uintptr_t h, int matrix_layout, char jobvsl, char jobvsr, char sort, lapack_int n, double *a, lapack_int lda, double *b, lapack_int ldb, lapack_int *sdim, double *alphar, double *alphai, double *beta, double *vsl, lapack_int ldvsl, double *vsr, lapack_int ldvsr, double *work, lapack_int lwork, lapack_logical *bwork)
+{
+	NETLIB_GGES(LAPACKE_dgges3_work)
+	uintptr_t prev = netlib_selctg_handle;
+	netlib_selctg_handle = h;
+	lapack_int info = fn(matrix_layout, jobvsl, jobvsr, sort, netlib_dselect3, n, a, lda, b, ldb, sdim, alphar, alphai, beta, vsl, ldvsl, vsr, ldvsr, work, lwork, bwork);
+	netlib_selctg_handle = prev;
+	return info;
+}
+
+lapack_int netlib_cgges3(uintptr_t h, int matrix_layout, char jobvsl, char jobvsr, char sort, lapack_int n, lapack_complex_float *a, lapack_int lda, lapack_complex_float *b, lapack_int ldb, lapack_int *sdim, lapack_complex_float *alpha, lapack_complex_float *beta, lapack_complex_float *vsl, lapack_int ldvsl, lapack_complex_float *vsr, lapack_int ldvsr, lapack_complex_float *work, lapack_int lwork, float *rwork, lapack_logical *bwork)
+{
+	NETLIB_GGES(LAPACKE_cgges3_work)
+	uintptr_t prev = netlib_selctg_handle;
+	netlib_selctg_handle = h;
+	lapack_int info = fn(matrix_layout, jobvsl, jobvsr, sort, netlib_cselect2, n, a, lda, b, ldb, sdim, alpha, beta, vsl, ldvsl, vsr, ldvsr, work, lwork, rwork, bwork);
+	netlib_selctg_handle = prev;
+	return info;
+}
+
+lapack_int netlib_zgges3(uintptr_t h, int matrix_layout, char jobvsl, char jobvsr, char sort, lapack_int n, lapack_complex_double *a, lapack_int lda, lapack_complex_double *b, lapack_int ldb, lapack_int *sdim, lapack_complex_double *alpha, lapack_complex_double *beta, lapack_complex_double *vsl, lapack_int ldvsl, lapack_complex_double *vsr, lapack_int ldvsr, lapack_complex_double *work, lapack_int lwork, double *rwork, lapack_logical *bwork)
+{
+	NETLIB_GGES(LAPACKE_zgges3_work)
+	uintptr_t prev = netlib_selctg_handle;
+	netlib_selctg_handle = h;
+	lapack_int info = fn(matrix_layout, jobvsl, jobvsr, sort, netlib_zselect2, n, a, lda, b, ldb, sdim, alpha, beta, vsl, ldvsl, vsr, ldvsr, work, lwork, rwork, bwork);
+	netlib_selctg_handle = prev;
+	return info;
+}
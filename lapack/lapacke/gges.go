@@ -0,0 +1,557 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapacke
+
+/*
+#include <stdint.h>
+#include "lapacke.h"
+
+lapack_int netlib_sgges(uintptr_t h, int matrix_layout, char jobvsl, char jobvsr, char sort, lapack_int n, float *a, lapack_int lda, float *b, lapack_int ldb, lapack_int *sdim, float *alphar, float *alphai, float *beta, float *vsl, lapack_int ldvsl, float *vsr, lapack_int ldvsr, float *work, lapack_int lwork, lapack_logical *bwork);
+lapack_int netlib_dgges(uintptr_t h, int matrix_layout, char jobvsl, char jobvsr, char sort, lapack_int n, double *a, lapack_int lda, double *b, lapack_int ldb, lapack_int *sdim, double *alphar, double *alphai, double *beta, double *vsl, lapack_int ldvsl, double *vsr, lapack_int ldvsr, double *work, lapack_int lwork, lapack_logical *bwork);
+lapack_int netlib_cgges(uintptr_t h, int matrix_layout, char jobvsl, char jobvsr, char sort, lapack_int n, lapack_complex_float *a, lapack_int lda, lapack_complex_float *b, lapack_int ldb, lapack_int *sdim, lapack_complex_float *alpha, lapack_complex_float *beta, lapack_complex_float *vsl, lapack_int ldvsl, lapack_complex_float *vsr, lapack_int ldvsr, lapack_complex_float *work, lapack_int lwork, float *rwork, lapack_logical *bwork);
+lapack_int netlib_zgges(uintptr_t h, int matrix_layout, char jobvsl, char jobvsr, char sort, lapack_int n, lapack_complex_double *a, lapack_int lda, lapack_complex_double *b, lapack_int ldb, lapack_int *sdim, lapack_complex_double *alpha, lapack_complex_double *beta, lapack_complex_double *vsl, lapack_int ldvsl, lapack_complex_double *vsr, lapack_int ldvsr, lapack_complex_double *work, lapack_int lwork, double *rwork, lapack_logical *bwork);
+lapack_int netlib_sgges3(uintptr_t h, int matrix_layout, char jobvsl, char jobvsr, char sort, lapack_int n, float *a, lapack_int lda, float *b, lapack_int ldb, lapack_int *sdim, float *alphar, float *alphai, float *beta, float *vsl, lapack_int ldvsl, float *vsr, lapack_int ldvsr, float *work, lapack_int lwork, lapack_logical *bwork);
+lapack_int netlib_dgges3(uintptr_t h, int matrix_layout, char jobvsl, char jobvsr, char sort, lapack_int n, double *a, lapack_int lda, double *b, lapack_int ldb, lapack_int *sdim, double *alphar, double *alphai, double *beta, double *vsl, lapack_int ldvsl, double *vsr, lapack_int ldvsr, double *work, lapack_int lwork, lapack_logical *bwork);
+lapack_int netlib_cgges3(uintptr_t h, int matrix_layout, char jobvsl, char jobvsr, char sort, lapack_int n, lapack_complex_float *a, lapack_int lda, lapack_complex_float *b, lapack_int ldb, lapack_int *sdim, lapack_complex_float *alpha, lapack_complex_float *beta, lapack_complex_float *vsl, lapack_int ldvsl, lapack_complex_float *vsr, lapack_int ldvsr, lapack_complex_float *work, lapack_int lwork, float *rwork, lapack_logical *bwork);
+lapack_int netlib_zgges3(uintptr_t h, int matrix_layout, char jobvsl, char jobvsr, char sort, lapack_int n, lapack_complex_double *a, lapack_int lda, lapack_complex_double *b, lapack_int ldb, lapack_int *sdim, lapack_complex_double *alpha, lapack_complex_double *beta, lapack_complex_double *vsl, lapack_int ldvsl, lapack_complex_double *vsr, lapack_int ldvsr, lapack_complex_double *work, lapack_int lwork, double *rwork, lapack_logical *bwork);
+*/
+import "C"
+
+// The ?gges and ?gges3 drivers take a SELCTG function, which is not
+// supported by the generated bindings. They are bridged to Go through the
+// handles of registerSelect in the same way as the ?gees drivers.
+
+//export netlibSelctgS
+func netlibSelctgS(h C.uintptr_t, alphar, alphai, beta C.float) C.lapack_logical {
+	fn, _ := lookupSelect(h).(func(alphar, alphai, beta float32) bool)
+	return logical(fn != nil && fn(float32(alphar), float32(alphai), float32(beta)))
+}
+
+//export netlibSelctgD
+func netlibSelctgD(h C.uintptr_t, alphar, alphai, beta C.double) C.lapack_logical {
+	fn, _ := lookupSelect(h).(func(alphar, alphai, beta float64) bool)
+	return logical(fn != nil && fn(float64(alphar), float64(alphai), float64(beta)))
+}
+
+//export netlibSelctgC
+func netlibSelctgC(h C.uintptr_t, are, aim, bre, bim C.float) C.lapack_logical {
+	fn, _ := lookupSelect(h).(func(alpha, beta complex64) bool)
+	return logical(fn != nil && fn(complex(float32(are), float32(aim)), complex(float32(bre), float32(bim))))
+}
+
+//export netlibSelctgZ
+func netlibSelctgZ(h C.uintptr_t, are, aim, bre, bim C.double) C.lapack_logical {
+	fn, _ := lookupSelect(h).(func(alpha, beta complex128) bool)
+	return logical(fn != nil && fn(complex(float64(are), float64(aim)), complex(float64(bre), float64(bim))))
+}
+
+// Sgges computes the generalized real Schur form of the pair (a, b) with optional
+// ordering of the eigenvalues selected by sel, which is only called if sort
+// is 'S' and may be nil otherwise. It returns the LAPACK info value.
+//
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgges.f.
+func Sgges(jobvsl, jobvsr, sort byte, sel func(alphar, alphai, beta float32) bool, n int, a []float32, lda int, b []float32, ldb int, sdim []Int, alphar, alphai, beta, vsl []float32, ldvsl int, vsr []float32, ldvsr int, work []float32, lwork int, bwork []Int) int {
+	return RowMajor.Sgges(jobvsl, jobvsr, sort, sel, n, a, lda, b, ldb, sdim, alphar, alphai, beta, vsl, ldvsl, vsr, ldvsr, work, lwork, bwork)
+}
+
+// Sgges is the function Sgges for matrices stored in the order given by
+// layout.
+func (layout Layout) Sgges(jobvsl, jobvsr, sort byte, sel func(alphar, alphai, beta float32) bool, n int, a []float32, lda int, b []float32, ldb int, sdim []Int, alphar, alphai, beta, vsl []float32, ldvsl int, vsr []float32, ldvsr int, work []float32, lwork int, bwork []Int) int {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alphar *float32
+	if len(alphar) > 0 {
+		_alphar = &alphar[0]
+	}
+	var _alphai *float32
+	if len(alphai) > 0 {
+		_alphai = &alphai[0]
+	}
+	var _beta *float32
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *float32
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *float32
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	checkGges(n, lda, ldb, ldvsl, ldvsr, lwork)
+	if lwork != -1 {
+		defer admit(66 * mnk(n, n, n))()
+	}
+	h := registerSelect(sel)
+	defer unregisterSelect(h)
+	return int(C.netlib_sgges(C.uintptr_t(h), (C.int)(layout), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.float)(_alphar), (*C.float)(_alphai), (*C.float)(_beta), (*C.float)(_vsl), (C.lapack_int)(ldvsl), (*C.float)(_vsr), (C.lapack_int)(ldvsr), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_logical)(_bwork)))
+}
+
+// Dgges computes the generalized real Schur form of the pair (a, b) with optional
+// ordering of the eigenvalues selected by sel, which is only called if sort
+// is 'S' and may be nil otherwise. It returns the LAPACK info value.
+//
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgges.f.
+func Dgges(jobvsl, jobvsr, sort byte, sel func(alphar, alphai, beta float64) bool, n int, a []float64, lda int, b []float64, ldb int, sdim []Int, alphar, alphai, beta, vsl []float64, ldvsl int, vsr []float64, ldvsr int, work []float64, lwork int, bwork []Int) int {
+	return RowMajor.Dgges(jobvsl, jobvsr, sort, sel, n, a, lda, b, ldb, sdim, alphar, alphai, beta, vsl, ldvsl, vsr, ldvsr, work, lwork, bwork)
+}
+
+// Dgges is the function Dgges for matrices stored in the order given by
+// layout.
+func (layout Layout) Dgges(jobvsl, jobvsr, sort byte, sel func(alphar, alphai, beta float64) bool, n int, a []float64, lda int, b []float64, ldb int, sdim []Int, alphar, alphai, beta, vsl []float64, ldvsl int, vsr []float64, ldvsr int, work []float64, lwork int, bwork []Int) int {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alphar *float64
+	if len(alphar) > 0 {
+		_alphar = &alphar[0]
+	}
+	var _alphai *float64
+	if len(alphai) > 0 {
+		_alphai = &alphai[0]
+	}
+	var _beta *float64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *float64
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *float64
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	checkGges(n, lda, ldb, ldvsl, ldvsr, lwork)
+	if lwork != -1 {
+		defer admit(66 * mnk(n, n, n))()
+	}
+	h := registerSelect(sel)
+	defer unregisterSelect(h)
+	return int(C.netlib_dgges(C.uintptr_t(h), (C.int)(layout), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.double)(_alphar), (*C.double)(_alphai), (*C.double)(_beta), (*C.double)(_vsl), (C.lapack_int)(ldvsl), (*C.double)(_vsr), (C.lapack_int)(ldvsr), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_logical)(_bwork)))
+}
+
+// Cgges computes the generalized complex Schur form of the pair (a, b) with optional
+// ordering of the eigenvalues selected by sel, which is only called if sort
+// is 'S' and may be nil otherwise. It returns the LAPACK info value.
+//
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgges.f.
+func Cgges(jobvsl, jobvsr, sort byte, sel func(alpha, beta complex64) bool, n int, a []complex64, lda int, b []complex64, ldb int, sdim []Int, alpha, beta, vsl []complex64, ldvsl int, vsr []complex64, ldvsr int, work []complex64, lwork int, rwork []float32, bwork []Int) int {
+	return RowMajor.Cgges(jobvsl, jobvsr, sort, sel, n, a, lda, b, ldb, sdim, alpha, beta, vsl, ldvsl, vsr, ldvsr, work, lwork, rwork, bwork)
+}
+
+// Cgges is the function Cgges for matrices stored in the order given by
+// layout.
+func (layout Layout) Cgges(jobvsl, jobvsr, sort byte, sel func(alpha, beta complex64) bool, n int, a []complex64, lda int, b []complex64, ldb int, sdim []Int, alpha, beta, vsl []complex64, ldvsl int, vsr []complex64, ldvsr int, work []complex64, lwork int, rwork []float32, bwork []Int) int {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alpha *complex64
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *complex64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *complex64
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *complex64
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	checkGges(n, lda, ldb, ldvsl, ldvsr, lwork)
+	if lwork != -1 {
+		defer admit(4 * 66 * mnk(n, n, n))()
+	}
+	h := registerSelect(sel)
+	defer unregisterSelect(h)
+	return int(C.netlib_cgges(C.uintptr_t(h), (C.int)(layout), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.lapack_complex_float)(_alpha), (*C.lapack_complex_float)(_beta), (*C.lapack_complex_float)(_vsl), (C.lapack_int)(ldvsl), (*C.lapack_complex_float)(_vsr), (C.lapack_int)(ldvsr), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (*C.lapack_logical)(_bwork)))
+}
+
+// Zgges computes the generalized complex Schur form of the pair (a, b) with optional
+// ordering of the eigenvalues selected by sel, which is only called if sort
+// is 'S' and may be nil otherwise. It returns the LAPACK info value.
+//
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgges.f.
+func Zgges(jobvsl, jobvsr, sort byte, sel func(alpha, beta complex128) bool, n int, a []complex128, lda int, b []complex128, ldb int, sdim []Int, alpha, beta, vsl []complex128, ldvsl int, vsr []complex128, ldvsr int, work []complex128, lwork int, rwork []float64, bwork []Int) int {
+	return RowMajor.Zgges(jobvsl, jobvsr, sort, sel, n, a, lda, b, ldb, sdim, alpha, beta, vsl, ldvsl, vsr, ldvsr, work, lwork, rwork, bwork)
+}
+
+// Zgges is the function Zgges for matrices stored in the order given by
+// layout.
+func (layout Layout) Zgges(jobvsl, jobvsr, sort byte, sel func(alpha, beta complex128) bool, n int, a []complex128, lda int, b []complex128, ldb int, sdim []Int, alpha, beta, vsl []complex128, ldvsl int, vsr []complex128, ldvsr int, work []complex128, lwork int, rwork []float64, bwork []Int) int {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alpha *complex128
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *complex128
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *complex128
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *complex128
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	checkGges(n, lda, ldb, ldvsl, ldvsr, lwork)
+	if lwork != -1 {
+		defer admit(4 * 66 * mnk(n, n, n))()
+	}
+	h := registerSelect(sel)
+	defer unregisterSelect(h)
+	return int(C.netlib_zgges(C.uintptr_t(h), (C.int)(layout), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.lapack_complex_double)(_alpha), (*C.lapack_complex_double)(_beta), (*C.lapack_complex_double)(_vsl), (C.lapack_int)(ldvsl), (*C.lapack_complex_double)(_vsr), (C.lapack_int)(ldvsr), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (*C.lapack_logical)(_bwork)))
+}
+
+// Sgges3 computes the generalized real Schur form of the pair (a, b) as Sgges does,
+// using the blocked reduction to Hessenberg-triangular form of sgghd3. It
+// returns the LAPACK info value.
+//
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/sgges3.f.
+func Sgges3(jobvsl, jobvsr, sort byte, sel func(alphar, alphai, beta float32) bool, n int, a []float32, lda int, b []float32, ldb int, sdim []Int, alphar, alphai, beta, vsl []float32, ldvsl int, vsr []float32, ldvsr int, work []float32, lwork int, bwork []Int) int {
+	return RowMajor.Sgges3(jobvsl, jobvsr, sort, sel, n, a, lda, b, ldb, sdim, alphar, alphai, beta, vsl, ldvsl, vsr, ldvsr, work, lwork, bwork)
+}
+
+// Sgges3 is the function Sgges3 for matrices stored in the order given by
+// layout.
+func (layout Layout) Sgges3(jobvsl, jobvsr, sort byte, sel func(alphar, alphai, beta float32) bool, n int, a []float32, lda int, b []float32, ldb int, sdim []Int, alphar, alphai, beta, vsl []float32, ldvsl int, vsr []float32, ldvsr int, work []float32, lwork int, bwork []Int) int {
+	var _a *float32
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float32
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alphar *float32
+	if len(alphar) > 0 {
+		_alphar = &alphar[0]
+	}
+	var _alphai *float32
+	if len(alphai) > 0 {
+		_alphai = &alphai[0]
+	}
+	var _beta *float32
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *float32
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *float32
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _work *float32
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	checkGges(n, lda, ldb, ldvsl, ldvsr, lwork)
+	if lwork != -1 {
+		defer admit(66 * mnk(n, n, n))()
+	}
+	h := registerSelect(sel)
+	defer unregisterSelect(h)
+	return int(C.netlib_sgges3(C.uintptr_t(h), (C.int)(layout), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), (C.lapack_int)(n), (*C.float)(_a), (C.lapack_int)(lda), (*C.float)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.float)(_alphar), (*C.float)(_alphai), (*C.float)(_beta), (*C.float)(_vsl), (C.lapack_int)(ldvsl), (*C.float)(_vsr), (C.lapack_int)(ldvsr), (*C.float)(_work), (C.lapack_int)(lwork), (*C.lapack_logical)(_bwork)))
+}
+
+// Dgges3 computes the generalized real Schur form of the pair (a, b) as Dgges does,
+// using the blocked reduction to Hessenberg-triangular form of dgghd3. It
+// returns the LAPACK info value.
+//
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dgges3.f.
+func Dgges3(jobvsl, jobvsr, sort byte, sel func(alphar, alphai, beta float64) bool, n int, a []float64, lda int, b []float64, ldb int, sdim []Int, alphar, alphai, beta, vsl []float64, ldvsl int, vsr []float64, ldvsr int, work []float64, lwork int, bwork []Int) int {
+	return RowMajor.Dgges3(jobvsl, jobvsr, sort, sel, n, a, lda, b, ldb, sdim, alphar, alphai, beta, vsl, ldvsl, vsr, ldvsr, work, lwork, bwork)
+}
+
+// Dgges3 is the function Dgges3 for matrices stored in the order given by
+// layout.
+func (layout Layout) Dgges3(jobvsl, jobvsr, sort byte, sel func(alphar, alphai, beta float64) bool, n int, a []float64, lda int, b []float64, ldb int, sdim []Int, alphar, alphai, beta, vsl []float64, ldvsl int, vsr []float64, ldvsr int, work []float64, lwork int, bwork []Int) int {
+	var _a *float64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *float64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alphar *float64
+	if len(alphar) > 0 {
+		_alphar = &alphar[0]
+	}
+	var _alphai *float64
+	if len(alphai) > 0 {
+		_alphai = &alphai[0]
+	}
+	var _beta *float64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *float64
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *float64
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _work *float64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	checkGges(n, lda, ldb, ldvsl, ldvsr, lwork)
+	if lwork != -1 {
+		defer admit(66 * mnk(n, n, n))()
+	}
+	h := registerSelect(sel)
+	defer unregisterSelect(h)
+	return int(C.netlib_dgges3(C.uintptr_t(h), (C.int)(layout), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), (C.lapack_int)(n), (*C.double)(_a), (C.lapack_int)(lda), (*C.double)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.double)(_alphar), (*C.double)(_alphai), (*C.double)(_beta), (*C.double)(_vsl), (C.lapack_int)(ldvsl), (*C.double)(_vsr), (C.lapack_int)(ldvsr), (*C.double)(_work), (C.lapack_int)(lwork), (*C.lapack_logical)(_bwork)))
+}
+
+// Cgges3 computes the generalized complex Schur form of the pair (a, b) as Cgges does,
+// using the blocked reduction to Hessenberg-triangular form of cgghd3. It
+// returns the LAPACK info value.
+//
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/cgges3.f.
+func Cgges3(jobvsl, jobvsr, sort byte, sel func(alpha, beta complex64) bool, n int, a []complex64, lda int, b []complex64, ldb int, sdim []Int, alpha, beta, vsl []complex64, ldvsl int, vsr []complex64, ldvsr int, work []complex64, lwork int, rwork []float32, bwork []Int) int {
+	return RowMajor.Cgges3(jobvsl, jobvsr, sort, sel, n, a, lda, b, ldb, sdim, alpha, beta, vsl, ldvsl, vsr, ldvsr, work, lwork, rwork, bwork)
+}
+
+// Cgges3 is the function Cgges3 for matrices stored in the order given by
+// layout.
+func (layout Layout) Cgges3(jobvsl, jobvsr, sort byte, sel func(alpha, beta complex64) bool, n int, a []complex64, lda int, b []complex64, ldb int, sdim []Int, alpha, beta, vsl []complex64, ldvsl int, vsr []complex64, ldvsr int, work []complex64, lwork int, rwork []float32, bwork []Int) int {
+	var _a *complex64
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex64
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alpha *complex64
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *complex64
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *complex64
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *complex64
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _work *complex64
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float32
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	checkGges(n, lda, ldb, ldvsl, ldvsr, lwork)
+	if lwork != -1 {
+		defer admit(4 * 66 * mnk(n, n, n))()
+	}
+	h := registerSelect(sel)
+	defer unregisterSelect(h)
+	return int(C.netlib_cgges3(C.uintptr_t(h), (C.int)(layout), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), (C.lapack_int)(n), (*C.lapack_complex_float)(_a), (C.lapack_int)(lda), (*C.lapack_complex_float)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.lapack_complex_float)(_alpha), (*C.lapack_complex_float)(_beta), (*C.lapack_complex_float)(_vsl), (C.lapack_int)(ldvsl), (*C.lapack_complex_float)(_vsr), (C.lapack_int)(ldvsr), (*C.lapack_complex_float)(_work), (C.lapack_int)(lwork), (*C.float)(_rwork), (*C.lapack_logical)(_bwork)))
+}
+
+// Zgges3 computes the generalized complex Schur form of the pair (a, b) as Zgges does,
+// using the blocked reduction to Hessenberg-triangular form of zgghd3. It
+// returns the LAPACK info value.
+//
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/zgges3.f.
+func Zgges3(jobvsl, jobvsr, sort byte, sel func(alpha, beta complex128) bool, n int, a []complex128, lda int, b []complex128, ldb int, sdim []Int, alpha, beta, vsl []complex128, ldvsl int, vsr []complex128, ldvsr int, work []complex128, lwork int, rwork []float64, bwork []Int) int {
+	return RowMajor.Zgges3(jobvsl, jobvsr, sort, sel, n, a, lda, b, ldb, sdim, alpha, beta, vsl, ldvsl, vsr, ldvsr, work, lwork, rwork, bwork)
+}
+
+// Zgges3 is the function Zgges3 for matrices stored in the order given by
+// layout.
+func (layout Layout) Zgges3(jobvsl, jobvsr, sort byte, sel func(alpha, beta complex128) bool, n int, a []complex128, lda int, b []complex128, ldb int, sdim []Int, alpha, beta, vsl []complex128, ldvsl int, vsr []complex128, ldvsr int, work []complex128, lwork int, rwork []float64, bwork []Int) int {
+	var _a *complex128
+	if len(a) > 0 {
+		_a = &a[0]
+	}
+	var _b *complex128
+	if len(b) > 0 {
+		_b = &b[0]
+	}
+	var _sdim *Int
+	if len(sdim) > 0 {
+		_sdim = &sdim[0]
+	}
+	var _alpha *complex128
+	if len(alpha) > 0 {
+		_alpha = &alpha[0]
+	}
+	var _beta *complex128
+	if len(beta) > 0 {
+		_beta = &beta[0]
+	}
+	var _vsl *complex128
+	if len(vsl) > 0 {
+		_vsl = &vsl[0]
+	}
+	var _vsr *complex128
+	if len(vsr) > 0 {
+		_vsr = &vsr[0]
+	}
+	var _work *complex128
+	if len(work) > 0 {
+		_work = &work[0]
+	}
+	var _rwork *float64
+	if len(rwork) > 0 {
+		_rwork = &rwork[0]
+	}
+	var _bwork *Int
+	if len(bwork) > 0 {
+		_bwork = &bwork[0]
+	}
+	checkGges(n, lda, ldb, ldvsl, ldvsr, lwork)
+	if lwork != -1 {
+		defer admit(4 * 66 * mnk(n, n, n))()
+	}
+	h := registerSelect(sel)
+	defer unregisterSelect(h)
+	return int(C.netlib_zgges3(C.uintptr_t(h), (C.int)(layout), (C.char)(jobvsl), (C.char)(jobvsr), (C.char)(sort), (C.lapack_int)(n), (*C.lapack_complex_double)(_a), (C.lapack_int)(lda), (*C.lapack_complex_double)(_b), (C.lapack_int)(ldb), (*C.lapack_int)(_sdim), (*C.lapack_complex_double)(_alpha), (*C.lapack_complex_double)(_beta), (*C.lapack_complex_double)(_vsl), (C.lapack_int)(ldvsl), (*C.lapack_complex_double)(_vsr), (C.lapack_int)(ldvsr), (*C.lapack_complex_double)(_work), (C.lapack_int)(lwork), (*C.double)(_rwork), (*C.lapack_logical)(_bwork)))
+}
+
+func checkGges(n, lda, ldb, ldvsl, ldvsr, lwork int) {
+	if n < minInt || n > maxInt {
+		panic("lapack: n too large")
+	}
+	if lda < minInt || lda > maxInt {
+		panic("lapack: lda too large")
+	}
+	if ldb < minInt || ldb > maxInt {
+		panic("lapack: ldb too large")
+	}
+	if ldvsl < minInt || ldvsl > maxInt {
+		panic("lapack: ldvsl too large")
+	}
+	if ldvsr < minInt || ldvsr > maxInt {
+		panic("lapack: ldvsr too large")
+	}
+	if lwork < minInt || lwork > maxInt {
+		panic("lapack: lwork too large")
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build ilp64
+// +build ilp64
+
+package lapacke
+
+// #cgo CFLAGS: -DLAPACK_ILP64
+import "C"
+
+// Int is the Go type of the LAPACK integer, lapack_int, used by the
+// backend library. With the ilp64 build tag it is int64, matching LAPACKE
+// libraries built for 64-bit integers such as OpenBLAS with INTERFACE64=1
+// and MKL's ILP64 interface.
+type Int = int64
+
+// minInt and maxInt are the bounds of Int that can be held by a Go int.
+const (
+	maxInt = int(^uint(0) >> 1)
+	minInt = -maxInt - 1
+)
@@ -0,0 +1,272 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapacke
+
+/*
+#include <stddef.h>
+#include "lapacke.h"
+
+// The ?laqge and ?laqsy auxiliary routines have no LAPACKE interface, so
+// the Fortran routines are called directly and looked up as the ?latrs
+// routines are.
+#if defined(NETLIB_LAPACKE_DLOPEN)
+void *netlib_lapacke_symbol(const char *name);
+#define NETLIB_LAQ_WEAK
+#elif defined(__ELF__)
+#define NETLIB_LAQ_WEAK __attribute__((weak))
+#else
+#define NETLIB_LAQ_MISSING 1
+#define NETLIB_LAQ_WEAK
+#endif
+
+#define NETLIB_DECLARE_LAQGE(name, T) \
+	NETLIB_LAQ_WEAK void name(const lapack_int *m, const lapack_int *n, \
+		T *a, const lapack_int *lda, const T *r, const T *c, \
+		const T *rowcnd, const T *colcnd, const T *amax, char *equed, size_t);
+
+#define NETLIB_DECLARE_LAQSY(name, T) \
+	NETLIB_LAQ_WEAK void name(const char *uplo, const lapack_int *n, \
+		T *a, const lapack_int *lda, const T *s, const T *scond, \
+		const T *amax, char *equed, size_t, size_t);
+
+NETLIB_DECLARE_LAQGE(LAPACK_GLOBAL(slaqge,SLAQGE), float)
+NETLIB_DECLARE_LAQGE(LAPACK_GLOBAL(dlaqge,DLAQGE), double)
+NETLIB_DECLARE_LAQSY(LAPACK_GLOBAL(slaqsy,SLAQSY), float)
+NETLIB_DECLARE_LAQSY(LAPACK_GLOBAL(dlaqsy,DLAQSY), double)
+
+typedef void (*netlib_slaqge_fn)(const lapack_int *, const lapack_int *, float *, const lapack_int *, const float *, const float *, const float *, const float *, const float *, char *, size_t);
+typedef void (*netlib_dlaqge_fn)(const lapack_int *, const lapack_int *, double *, const lapack_int *, const double *, const double *, const double *, const double *, const double *, char *, size_t);
+typedef void (*netlib_slaqsy_fn)(const char *, const lapack_int *, float *, const lapack_int *, const float *, const float *, const float *, char *, size_t, size_t);
+typedef void (*netlib_dlaqsy_fn)(const char *, const lapack_int *, double *, const lapack_int *, const double *, const double *, const double *, char *, size_t, size_t);
+
+// NETLIB_LAQ_FN evaluates to the address of the named routine, or NULL if
+// the library does not provide it.
+#if defined(NETLIB_LAPACKE_DLOPEN)
+#define NETLIB_LAQ_FN(name, sym) netlib_lapacke_symbol(sym)
+#elif defined(NETLIB_LAQ_MISSING)
+#define NETLIB_LAQ_FN(name, sym) NULL
+#else
+#define NETLIB_LAQ_FN(name, sym) ((void *)name)
+#endif
+
+static int netlib_has_laq(void)
+{
+	return NETLIB_LAQ_FN(LAPACK_GLOBAL(slaqge,SLAQGE), "slaqge_") != NULL &&
+		NETLIB_LAQ_FN(LAPACK_GLOBAL(dlaqge,DLAQGE), "dlaqge_") != NULL &&
+		NETLIB_LAQ_FN(LAPACK_GLOBAL(slaqsy,SLAQSY), "slaqsy_") != NULL &&
+		NETLIB_LAQ_FN(LAPACK_GLOBAL(dlaqsy,DLAQSY), "dlaqsy_") != NULL;
+}
+
+static char netlib_slaqge(lapack_int m, lapack_int n, float *a, lapack_int lda, const float *r, const float *c, float rowcnd, float colcnd, float amax)
+{
+	netlib_slaqge_fn fn = (netlib_slaqge_fn)NETLIB_LAQ_FN(LAPACK_GLOBAL(slaqge,SLAQGE), "slaqge_");
+	char equed = 'N';
+	fn(&m, &n, a, &lda, r, c, &rowcnd, &colcnd, &amax, &equed, 1);
+	return equed;
+}
+
+static char netlib_dlaqge(lapack_int m, lapack_int n, double *a, lapack_int lda, const double *r, const double *c, double rowcnd, double colcnd, double amax)
+{
+	netlib_dlaqge_fn fn = (netlib_dlaqge_fn)NETLIB_LAQ_FN(LAPACK_GLOBAL(dlaqge,DLAQGE), "dlaqge_");
+	char equed = 'N';
+	fn(&m, &n, a, &lda, r, c, &rowcnd, &colcnd, &amax, &equed, 1);
+	return equed;
+}
+
+static char netlib_slaqsy(char uplo, lapack_int n, float *a, lapack_int lda, const float *s, float scond, float amax)
+{
+	netlib_slaqsy_fn fn = (netlib_slaqsy_fn)NETLIB_LAQ_FN(LAPACK_GLOBAL(slaqsy,SLAQSY), "slaqsy_");
+	char equed = 'N';
+	fn(&uplo, &n, a, &lda, s, &scond, &amax, &equed, 1, 1);
+	return equed;
+}
+
+static char netlib_dlaqsy(char uplo, lapack_int n, double *a, lapack_int lda, const double *s, double scond, double amax)
+{
+	netlib_dlaqsy_fn fn = (netlib_dlaqsy_fn)NETLIB_LAQ_FN(LAPACK_GLOBAL(dlaqsy,DLAQSY), "dlaqsy_");
+	char equed = 'N';
+	fn(&uplo, &n, a, &lda, s, &scond, &amax, &equed, 1, 1);
+	return equed;
+}
+*/
+import "C"
+
+// The routines below apply the scalings computed by ?geequ and ?poequ to a
+// matrix. A row-major matrix is the transpose of the column-major matrix
+// with the same elements and leading dimension, so in the row-major
+// layout the Fortran routines are called on the transpose without copying
+// a: the roles of the row and column scalings of ?laqge are exchanged, and
+// the triangle referenced by ?laqsy is the other one.
+
+// HasLaq returns whether the LAPACK library provides the ?laqge and ?laqsy
+// routines. Slaqge, Dlaqge, Slaqsy and Dlaqsy panic if it does not.
+func HasLaq() bool {
+	return C.netlib_has_laq() != 0
+}
+
+// Slaqge scales the m×n general matrix a by the row scale factors in r and
+// the column scale factors in c, computed by Sgeequ, if the ratios rowcnd
+// and colcnd and the largest element amax indicate that scaling is needed.
+// It returns 'N' if a is unchanged, 'R' if it is replaced by diag(r)*a,
+// 'C' if it is replaced by a*diag(c) and 'B' if it is replaced by
+// diag(r)*a*diag(c).
+//
+// The routine has no LAPACKE interface and is called directly.
+//
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slaqge.f.
+func Slaqge(m, n int, a []float32, lda int, r, c []float32, rowcnd, colcnd, amax float32) byte {
+	return RowMajor.Slaqge(m, n, a, lda, r, c, rowcnd, colcnd, amax)
+}
+
+// Slaqge is the function Slaqge for matrices stored in the order given by
+// layout.
+func (layout Layout) Slaqge(m, n int, a []float32, lda int, r, c []float32, rowcnd, colcnd, amax float32) byte {
+	checkLaqge(m, n, lda)
+	if !HasLaq() {
+		panic("lapack: slaqge not available")
+	}
+	if m == 0 || n == 0 {
+		return 'N'
+	}
+	if layout == ColMajor {
+		return byte(C.netlib_slaqge((C.lapack_int)(m), (C.lapack_int)(n), (*C.float)(&a[0]), (C.lapack_int)(lda), (*C.float)(&r[0]), (*C.float)(&c[0]), (C.float)(rowcnd), (C.float)(colcnd), (C.float)(amax)))
+	}
+	return transposeEqued(byte(C.netlib_slaqge((C.lapack_int)(n), (C.lapack_int)(m), (*C.float)(&a[0]), (C.lapack_int)(lda), (*C.float)(&c[0]), (*C.float)(&r[0]), (C.float)(colcnd), (C.float)(rowcnd), (C.float)(amax))))
+}
+
+// Dlaqge scales the m×n general matrix a by the row scale factors in r and
+// the column scale factors in c, computed by Dgeequ, if the ratios rowcnd
+// and colcnd and the largest element amax indicate that scaling is needed.
+// It returns 'N' if a is unchanged, 'R' if it is replaced by diag(r)*a,
+// 'C' if it is replaced by a*diag(c) and 'B' if it is replaced by
+// diag(r)*a*diag(c).
+//
+// The routine has no LAPACKE interface and is called directly.
+//
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlaqge.f.
+func Dlaqge(m, n int, a []float64, lda int, r, c []float64, rowcnd, colcnd, amax float64) byte {
+	return RowMajor.Dlaqge(m, n, a, lda, r, c, rowcnd, colcnd, amax)
+}
+
+// Dlaqge is the function Dlaqge for matrices stored in the order given by
+// layout.
+func (layout Layout) Dlaqge(m, n int, a []float64, lda int, r, c []float64, rowcnd, colcnd, amax float64) byte {
+	checkLaqge(m, n, lda)
+	if !HasLaq() {
+		panic("lapack: dlaqge not available")
+	}
+	if m == 0 || n == 0 {
+		return 'N'
+	}
+	if layout == ColMajor {
+		return byte(C.netlib_dlaqge((C.lapack_int)(m), (C.lapack_int)(n), (*C.double)(&a[0]), (C.lapack_int)(lda), (*C.double)(&r[0]), (*C.double)(&c[0]), (C.double)(rowcnd), (C.double)(colcnd), (C.double)(amax)))
+	}
+	return transposeEqued(byte(C.netlib_dlaqge((C.lapack_int)(n), (C.lapack_int)(m), (*C.double)(&a[0]), (C.lapack_int)(lda), (*C.double)(&c[0]), (*C.double)(&r[0]), (C.double)(colcnd), (C.double)(rowcnd), (C.double)(amax))))
+}
+
+// Slaqsy scales the n×n symmetric matrix a, of which the triangle given by
+// uplo is referenced, by the scale factors in s computed by Spoequ, so that
+// it is replaced by diag(s)*a*diag(s), if the ratio scond and the largest
+// element amax indicate that scaling is needed. It returns 'Y' if a is
+// scaled and 'N' otherwise.
+//
+// The routine has no LAPACKE interface and is called directly.
+//
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slaqsy.f.
+func Slaqsy(uplo byte, n int, a []float32, lda int, s []float32, scond, amax float32) byte {
+	return RowMajor.Slaqsy(uplo, n, a, lda, s, scond, amax)
+}
+
+// Slaqsy is the function Slaqsy for matrices stored in the order given by
+// layout.
+func (layout Layout) Slaqsy(uplo byte, n int, a []float32, lda int, s []float32, scond, amax float32) byte {
+	checkLaqsy(uplo, n, lda)
+	if !HasLaq() {
+		panic("lapack: slaqsy not available")
+	}
+	if n == 0 {
+		return 'N'
+	}
+	if layout != ColMajor {
+		uplo = transposeUplo(uplo)
+	}
+	return byte(C.netlib_slaqsy((C.char)(uplo), (C.lapack_int)(n), (*C.float)(&a[0]), (C.lapack_int)(lda), (*C.float)(&s[0]), (C.float)(scond), (C.float)(amax)))
+}
+
+// Dlaqsy scales the n×n symmetric matrix a, of which the triangle given by
+// uplo is referenced, by the scale factors in s computed by Dpoequ, so that
+// it is replaced by diag(s)*a*diag(s), if the ratio scond and the largest
+// element amax indicate that scaling is needed. It returns 'Y' if a is
+// scaled and 'N' otherwise.
+//
+// The routine has no LAPACKE interface and is called directly.
+//
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlaqsy.f.
+func Dlaqsy(uplo byte, n int, a []float64, lda int, s []float64, scond, amax float64) byte {
+	return RowMajor.Dlaqsy(uplo, n, a, lda, s, scond, amax)
+}
+
+// Dlaqsy is the function Dlaqsy for matrices stored in the order given by
+// layout.
+func (layout Layout) Dlaqsy(uplo byte, n int, a []float64, lda int, s []float64, scond, amax float64) byte {
+	checkLaqsy(uplo, n, lda)
+	if !HasLaq() {
+		panic("lapack: dlaqsy not available")
+	}
+	if n == 0 {
+		return 'N'
+	}
+	if layout != ColMajor {
+		uplo = transposeUplo(uplo)
+	}
+	return byte(C.netlib_dlaqsy((C.char)(uplo), (C.lapack_int)(n), (*C.double)(&a[0]), (C.lapack_int)(lda), (*C.double)(&s[0]), (C.double)(scond), (C.double)(amax)))
+}
+
+// transposeEqued returns the scaling of ?laqge of the transpose of a
+// matrix that was scaled as given by equed.
+func transposeEqued(equed byte) byte {
+	switch equed {
+	case 'R':
+		return 'C'
+	case 'C':
+		return 'R'
+	}
+	return equed
+}
+
+// transposeUplo returns the triangle of the transpose of a matrix that
+// holds the triangle uplo.
+func transposeUplo(uplo byte) byte {
+	if uplo == 'U' {
+		return 'L'
+	}
+	return 'U'
+}
+
+func checkLaqge(m, n, lda int) {
+	if m < minInt || m > maxInt {
+		panic("lapack: m too large")
+	}
+	if n < minInt || n > maxInt {
+		panic("lapack: n too large")
+	}
+	if lda < minInt || lda > maxInt {
+		panic("lapack: lda too large")
+	}
+}
+
+func checkLaqsy(uplo byte, n, lda int) {
+	switch uplo {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad uplo")
+	}
+	if n < minInt || n > maxInt {
+		panic("lapack: n too large")
+	}
+	if lda < minInt || lda > maxInt {
+		panic("lapack: lda too large")
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapacke
+
+/*
+#include <stddef.h>
+#include "lapacke.h"
+
+// The ?latrs auxiliary routines have no LAPACKE interface, so the Fortran
+// routines are called directly. They are looked up at run time when the
+// library is loaded by the dlopen trampolines and declared weak where
+// possible otherwise, so that their absence can be detected.
+#if defined(NETLIB_LAPACKE_DLOPEN)
+void *netlib_lapacke_symbol(const char *name);
+#define NETLIB_LATRS_WEAK
+#elif defined(__ELF__)
+#define NETLIB_LATRS_WEAK __attribute__((weak))
+#else
+#define NETLIB_LATRS_MISSING 1
+#define NETLIB_LATRS_WEAK
+#endif
+
+#define NETLIB_DECLARE_LATRS(name, T) \
+	NETLIB_LATRS_WEAK void name(const char *uplo, const char *trans, \
+		const char *diag, const char *normin, const lapack_int *n, \
+		const T *a, const lapack_int *lda, T *x, T *scale, T *cnorm, \
+		lapack_int *info, size_t, size_t, size_t, size_t);
+
+NETLIB_DECLARE_LATRS(LAPACK_GLOBAL(slatrs,SLATRS), float)
+NETLIB_DECLARE_LATRS(LAPACK_GLOBAL(dlatrs,DLATRS), double)
+
+typedef void (*netlib_slatrs_fn)(const char *, const char *, const char *, const char *, const lapack_int *, const float *, const lapack_int *, float *, float *, float *, lapack_int *, size_t, size_t, size_t, size_t);
+typedef void (*netlib_dlatrs_fn)(const char *, const char *, const char *, const char *, const lapack_int *, const double *, const lapack_int *, double *, double *, double *, lapack_int *, size_t, size_t, size_t, size_t);
+
+// NETLIB_LATRS_FN evaluates to the address of the named routine, or NULL
+// if the library does not provide it.
+#if defined(NETLIB_LAPACKE_DLOPEN)
+#define NETLIB_LATRS_FN(name, sym) netlib_lapacke_symbol(sym)
+#elif defined(NETLIB_LATRS_MISSING)
+#define NETLIB_LATRS_FN(name, sym) NULL
+#else
+#define NETLIB_LATRS_FN(name, sym) ((void *)name)
+#endif
+
+static int netlib_has_latrs(void)
+{
+	return NETLIB_LATRS_FN(LAPACK_GLOBAL(dlatrs,DLATRS), "dlatrs_") != NULL && NETLIB_LATRS_FN(LAPACK_GLOBAL(slatrs,SLATRS), "slatrs_") != NULL;
+}
+
+static lapack_int netlib_slatrs(char uplo, char trans, char diag, char normin, lapack_int n, const float *a, lapack_int lda, float *x, float *scale, float *cnorm)
+{
+	netlib_slatrs_fn fn = (netlib_slatrs_fn)NETLIB_LATRS_FN(LAPACK_GLOBAL(slatrs,SLATRS), "slatrs_");
+	lapack_int info = 0;
+	fn(&uplo, &trans, &diag, &normin, &n, a, &lda, x, scale, cnorm, &info, 1, 1, 1, 1);
+	return info;
+}
+
+static lapack_int netlib_dlatrs(char uplo, char trans, char diag, char normin, lapack_int n, const double *a, lapack_int lda, double *x, double *scale, double *cnorm)
+{
+	netlib_dlatrs_fn fn = (netlib_dlatrs_fn)NETLIB_LATRS_FN(LAPACK_GLOBAL(dlatrs,DLATRS), "dlatrs_");
+	lapack_int info = 0;
+	fn(&uplo, &trans, &diag, &normin, &n, a, &lda, x, scale, cnorm, &info, 1, 1, 1, 1);
+	return info;
+}
+*/
+import "C"
+
+// HasLatrs returns whether the LAPACK library provides the ?latrs routines.
+// Slatrs and Dlatrs panic if it does not.
+func HasLatrs() bool {
+	return C.netlib_has_latrs() != 0
+}
+
+// Slatrs solves the triangular system
+//
+//	A * x = s * b  if trans == 'N',
+//	A^T * x = s * b  otherwise,
+//
+// with the n×n row-major triangular matrix a, choosing the scale factor
+// s ≤ 1 returned in scale[0] to prevent overflow. On entry x holds b and on
+// return it holds the solution. If normin is 'Y', cnorm holds the 1-norms of
+// the off-diagonal parts of the columns of a on entry, otherwise they are
+// computed and returned in cnorm.
+//
+// The routine has no LAPACKE interface and is called in column-major
+// layout on a transposed copy of a.
+//
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/slatrs.f.
+func Slatrs(uplo, trans, diag, normin byte, n int, a []float32, lda int, x, scale, cnorm []float32) bool {
+	checkLatrs(uplo, trans, diag, normin, n, lda)
+	if !HasLatrs() {
+		panic("lapack: slatrs not available")
+	}
+	if n == 0 {
+		scale[0] = 1
+		return true
+	}
+	at := make([]float32, n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			at[j*n+i] = a[i*lda+j]
+		}
+	}
+	return isZero(C.netlib_slatrs((C.char)(uplo), (C.char)(trans), (C.char)(diag), (C.char)(normin), (C.lapack_int)(n), (*C.float)(&at[0]), (C.lapack_int)(n), (*C.float)(&x[0]), (*C.float)(&scale[0]), (*C.float)(&cnorm[0])))
+}
+
+// Dlatrs solves the triangular system
+//
+//	A * x = s * b  if trans == 'N',
+//	A^T * x = s * b  otherwise,
+//
+// with the n×n row-major triangular matrix a, choosing the scale factor
+// s ≤ 1 returned in scale[0] to prevent overflow. On entry x holds b and on
+// return it holds the solution. If normin is 'Y', cnorm holds the 1-norms of
+// the off-diagonal parts of the columns of a on entry, otherwise they are
+// computed and returned in cnorm.
+//
+// The routine has no LAPACKE interface and is called in column-major
+// layout on a transposed copy of a.
+//
+// See http://www.netlib.org/cgi-bin/netlibfiles.txt?format=txt&filename=/lapack/lapack_routine/dlatrs.f.
+func Dlatrs(uplo, trans, diag, normin byte, n int, a []float64, lda int, x, scale, cnorm []float64) bool {
+	checkLatrs(uplo, trans, diag, normin, n, lda)
+	if !HasLatrs() {
+		panic("lapack: dlatrs not available")
+	}
+	if n == 0 {
+		scale[0] = 1
+		return true
+	}
+	at := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			at[j*n+i] = a[i*lda+j]
+		}
+	}
+	return isZero(C.netlib_dlatrs((C.char)(uplo), (C.char)(trans), (C.char)(diag), (C.char)(normin), (C.lapack_int)(n), (*C.double)(&at[0]), (C.lapack_int)(n), (*C.double)(&x[0]), (*C.double)(&scale[0]), (*C.double)(&cnorm[0])))
+}
+
+func checkLatrs(uplo, trans, diag, normin byte, n, lda int) {
+	switch uplo {
+	case 'U', 'L':
+	default:
+		panic("lapack: bad uplo")
+	}
+	switch trans {
+	case 'N', 'T', 'C':
+	default:
+		panic("lapack: bad trans")
+	}
+	switch diag {
+	case 'N', 'U':
+	default:
+		panic("lapack: bad diag")
+	}
+	switch normin {
+	case 'Y', 'N':
+	default:
+		panic("lapack: bad normin")
+	}
+	if n < minInt || n > maxInt {
+		panic("lapack: n too large")
+	}
+	if lda < minInt || lda > maxInt {
+		panic("lapack: lda too large")
+	}
+}
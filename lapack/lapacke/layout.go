@@ -0,0 +1,23 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package lapacke
+
+// Layout is the storage order of the matrices passed to the methods of
+// Layout that bind the LAPACKE routines. The functions of this package
+// with the same names pass RowMajor, so that a layout used by one caller
+// does not affect another, such as gonum.org/v1/netlib/lapack/netlib.
+type Layout int
+
+const (
+	// RowMajor stores the elements of a row contiguously, with the leading
+	// dimension giving the distance between rows. This is the order of the
+	// gonum matrix types and of the functions of this package.
+	RowMajor = Layout(rowMajor)
+	// ColMajor stores the elements of a column contiguously, with the
+	// leading dimension giving the distance between columns, as Fortran
+	// and R do. LAPACKE passes column-major matrices to LAPACK unchanged
+	// instead of transposing them into temporary storage.
+	ColMajor = Layout(colMajor)
+)
@@ -0,0 +1,21 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !ilp64
+// +build !ilp64
+
+package lapacke
+
+import "math"
+
+// Int is the Go type of the LAPACK integer, lapack_int, used by the
+// backend library. It is int32 unless the package is built with the
+// ilp64 build tag.
+type Int = int32
+
+// minInt and maxInt are the bounds of Int.
+const (
+	minInt = math.MinInt32
+	maxInt = math.MaxInt32
+)
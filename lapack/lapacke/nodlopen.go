@@ -0,0 +1,21 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !dlopen
+// +build !dlopen
+
+package lapacke
+
+import "errors"
+
+// Open loads the LAPACKE library at path. Open is only supported when the
+// package is built with the dlopen build tag; otherwise it returns an error.
+func Open(path string) error {
+	return errors.New("lapack: Open requires the dlopen build tag")
+}
+
+// Library returns the path of the LAPACKE library loaded at run time. It
+// returns the empty string when the package was built without the dlopen
+// build tag.
+func Library() string { return "" }
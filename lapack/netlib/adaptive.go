@@ -0,0 +1,151 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+	"strconv"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// Precision is the floating point precision in which a result was computed.
+type Precision int
+
+const (
+	SinglePrecision Precision = iota + 1 // The result was computed in float32.
+	DoublePrecision                      // The result was computed in float64.
+)
+
+func (p Precision) String() string {
+	switch p {
+	case SinglePrecision:
+		return "single"
+	case DoublePrecision:
+		return "double"
+	}
+	return "Precision(" + strconv.Itoa(int(p)) + ")"
+}
+
+// Adaptive runs a computation in single precision and falls back to double
+// precision if the single precision result is not good enough. It calls
+// single, which computes the result in float32 and returns a measure of its
+// quality such as a relative residual. If single returns no error and a
+// residual that is not greater than tol, the result is accepted and
+// Adaptive returns SinglePrecision. Otherwise double is called to compute
+// the result in float64 and Adaptive returns DoublePrecision and the error
+// returned by double. A NaN residual is never accepted, and an error of
+// single leads to the retry as well, since a matrix that is singular to
+// float32 precision may not be singular to float64 precision.
+//
+// single and double typically store the result in a variable of the
+// caller, so that Adaptive works with any pipeline of bound routines, as
+// AdaptiveSolve does for linear systems.
+func Adaptive(tol float64, single func() (residual float64, err error), double func() error) (Precision, error) {
+	residual, err := single()
+	if err == nil && residual <= tol {
+		return SinglePrecision, nil
+	}
+	return DoublePrecision, double()
+}
+
+// AdaptiveSolve solves the system of linear equations A * X = B for the n×n
+// matrix A by Adaptive. The system is first solved in float32 by Sgetrf and
+// Sgetrs and the normwise backward error
+//  ‖B - A*X‖ / (‖A‖*‖X‖ + ‖B‖)
+// of the solution is computed in float64 with the infinity norm. If it
+// exceeds tol, or A is singular in float32, the system is solved again in
+// float64 by Dgetrf and Dgetrs. If tol is not positive, n times the machine
+// epsilon of float32 is used. The inputs a and b are not modified.
+//
+// AdaptiveSolve returns the solution and the precision in which it was
+// computed. If A is exactly singular in float64, AdaptiveSolve returns a
+// SingularError holding the index of the first zero pivot.
+func AdaptiveSolve(a, b blas64.General, tol float64) (x blas64.General, prec Precision, err error) {
+	switch {
+	case a.Rows != a.Cols:
+		panic(badShapeA)
+	case b.Rows != a.Rows:
+		panic(badShapeB)
+	}
+	n, nrhs := a.Rows, b.Cols
+	if n == 0 || nrhs == 0 {
+		return newGeneral(n, nrhs), SinglePrecision, nil
+	}
+	if tol <= 0 {
+		tol = float64(n) * slamchE
+	}
+
+	ipiv := make([]int, n)
+	prec, err = Adaptive(tol, func() (float64, error) {
+		lu := toGeneral32(a)
+		if !lapackImpl.Sgetrf(n, n, lu.Data, lu.Stride, ipiv) {
+			return 0, SingularError{Index: zeroDiag32(lu.Data, lu.Stride, n)}
+		}
+		x32 := toGeneral32(b)
+		lapackImpl.Sgetrs(blas.NoTrans, n, nrhs, lu.Data, lu.Stride, ipiv, x32.Data, x32.Stride)
+		x = fromGeneral32(x32)
+		return backwardError(a, x, b), nil
+	}, func() error {
+		lu := cloneGeneral(a)
+		if !lapackImpl.Dgetrf(n, n, lu.Data, lu.Stride, ipiv) {
+			return SingularError{Index: zeroDiag(lu.Data, lu.Stride, n)}
+		}
+		x = cloneGeneral(b)
+		lapackImpl.Dgetrs(blas.NoTrans, n, nrhs, lu.Data, lu.Stride, ipiv, x.Data, x.Stride)
+		return nil
+	})
+	if err != nil {
+		return blas64.General{}, prec, err
+	}
+	return x, prec, nil
+}
+
+// backwardError returns the normwise backward error
+//  ‖B - A*X‖ / (‖A‖*‖X‖ + ‖B‖)
+// of the solution X of A * X = B in the infinity norm. It returns NaN if X
+// is not finite.
+func backwardError(a, x, b blas64.General) float64 {
+	r := cloneGeneral(b)
+	blasImpl.Dgemm(blas.NoTrans, blas.NoTrans, a.Rows, x.Cols, a.Cols, -1, a.Data, a.Stride, x.Data, x.Stride, 1, r.Data, r.Stride)
+	norm := func(m blas64.General) float64 {
+		return lapackImpl.Dlange(lapack.MaxRowSum, m.Rows, m.Cols, m.Data, m.Stride, make([]float64, m.Cols))
+	}
+	den := norm(a)*norm(x) + norm(b)
+	rnorm := norm(r)
+	if math.IsNaN(rnorm) || math.IsInf(rnorm, 0) {
+		return math.NaN()
+	}
+	if den == 0 {
+		return rnorm
+	}
+	return rnorm / den
+}
+
+// toGeneral32 returns a copy of a rounded to float32 with a compact stride.
+func toGeneral32(a blas64.General) blas32.General {
+	c := newGeneral32(a.Rows, a.Cols)
+	for i := 0; i < a.Rows; i++ {
+		for j, v := range a.Data[i*a.Stride : i*a.Stride+a.Cols] {
+			c.Data[i*c.Stride+j] = float32(v)
+		}
+	}
+	return c
+}
+
+// fromGeneral32 returns a copy of a converted to float64 with a compact
+// stride.
+func fromGeneral32(a blas32.General) blas64.General {
+	c := newGeneral(a.Rows, a.Cols)
+	for i := 0; i < a.Rows; i++ {
+		for j, v := range a.Data[i*a.Stride : i*a.Stride+a.Cols] {
+			c.Data[i*c.Stride+j] = float64(v)
+		}
+	}
+	return c
+}
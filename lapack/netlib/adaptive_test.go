@@ -0,0 +1,144 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+func TestAdaptive(t *testing.T) {
+	errSingle := errors.New("single failed")
+	errDouble := errors.New("double failed")
+	for _, test := range []struct {
+		name      string
+		residual  float64
+		singleErr error
+		doubleErr error
+		want      Precision
+		wantErr   error
+	}{
+		{name: "accepted", residual: 1e-8, want: SinglePrecision},
+		{name: "at tolerance", residual: 1e-6, want: SinglePrecision},
+		{name: "residual", residual: 1e-3, want: DoublePrecision},
+		{name: "NaN", residual: math.NaN(), want: DoublePrecision},
+		{name: "single error", residual: 0, singleErr: errSingle, want: DoublePrecision},
+		{name: "double error", residual: 1, doubleErr: errDouble, want: DoublePrecision, wantErr: errDouble},
+	} {
+		var ranDouble bool
+		prec, err := Adaptive(1e-6, func() (float64, error) {
+			return test.residual, test.singleErr
+		}, func() error {
+			ranDouble = true
+			return test.doubleErr
+		})
+		if prec != test.want || err != test.wantErr {
+			t.Errorf("%s: unexpected result: got %v, %v want %v, %v", test.name, prec, err, test.want, test.wantErr)
+		}
+		if ranDouble != (test.want == DoublePrecision) {
+			t.Errorf("%s: unexpected double precision run: %t", test.name, ranDouble)
+		}
+	}
+}
+
+func TestAdaptiveSolve(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		name string
+		a    blas64.General
+		tol  float64
+		want Precision
+	}{
+		{name: "well conditioned", a: diagonallyDominant(rnd, 10), want: SinglePrecision},
+		{name: "tight tolerance", a: diagonallyDominant(rnd, 10), tol: 1e-12, want: DoublePrecision},
+		{name: "ill conditioned", a: hilbert(8), tol: 1e-12, want: DoublePrecision},
+		{
+			// The matrix is singular when rounded to float32.
+			name: "singular in float32",
+			a:    blas64.General{Rows: 2, Cols: 2, Stride: 2, Data: []float64{1, 1, 1, 1 + 1e-10}},
+			want: DoublePrecision,
+		},
+	} {
+		n := test.a.Rows
+		b := blas64.General{Rows: n, Cols: 2, Stride: 2, Data: make([]float64, 2*n)}
+		for i := range b.Data {
+			b.Data[i] = rnd.NormFloat64()
+		}
+		a := cloneGeneral(test.a)
+		bc := cloneGeneral(b)
+
+		x, prec, err := AdaptiveSolve(test.a, b, test.tol)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.name, err)
+			continue
+		}
+		if prec != test.want {
+			t.Errorf("%s: unexpected precision: got %v want %v", test.name, prec, test.want)
+		}
+		if !equalGeneral(test.a, a) || !equalGeneral(b, bc) {
+			t.Errorf("%s: input modified", test.name)
+		}
+		tol := test.tol
+		if tol <= 0 {
+			tol = float64(n) * slamchE
+		}
+		if prec == DoublePrecision {
+			tol = 1e-10
+		}
+		if e := backwardError(test.a, x, b); e > tol {
+			t.Errorf("%s: backward error %v exceeds %v", test.name, e, tol)
+		}
+	}
+
+	singular := blas64.General{Rows: 2, Cols: 2, Stride: 2, Data: []float64{1, 2, 2, 4}}
+	b := blas64.General{Rows: 2, Cols: 1, Stride: 1, Data: []float64{1, 1}}
+	_, prec, err := AdaptiveSolve(singular, b, 0)
+	if _, ok := err.(SingularError); !ok || prec != DoublePrecision {
+		t.Errorf("unexpected result for singular matrix: %v, %v", prec, err)
+	}
+}
+
+// diagonallyDominant returns a random n×n matrix with a dominant diagonal.
+func diagonallyDominant(rnd *rand.Rand, n int) blas64.General {
+	a := newGeneral(n, n)
+	for i := range a.Data {
+		a.Data[i] = rnd.NormFloat64()
+	}
+	for i := 0; i < n; i++ {
+		a.Data[i*a.Stride+i] += 2 * float64(n)
+	}
+	return a
+}
+
+// hilbert returns the n×n Hilbert matrix.
+func hilbert(n int) blas64.General {
+	a := newGeneral(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			a.Data[i*a.Stride+j] = 1 / float64(i+j+1)
+		}
+	}
+	return a
+}
+
+// equalGeneral returns whether a and b have the same shape and elements.
+func equalGeneral(a, b blas64.General) bool {
+	if a.Rows != b.Rows || a.Cols != b.Cols {
+		return false
+	}
+	for i := 0; i < a.Rows; i++ {
+		for j := 0; j < a.Cols; j++ {
+			if a.Data[i*a.Stride+j] != b.Data[i*b.Stride+j] {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,72 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"strings"
+
+	blasnetlib "gonum.org/v1/netlib/blas/netlib"
+)
+
+// Error is the panic value of the Implementation methods when an argument
+// check fails, and the error returned by the methods of ErrImplementation.
+// Callers that recover the panic can use Routine and Param to identify the
+// failing call.
+type Error struct {
+	// Routine is the name of the method, for example "Dgetrf".
+	Routine string
+
+	// Param is the name of the method parameter that failed the
+	// check, for example "lda". Param is empty if the check is not
+	// specific to one parameter.
+	Param string
+
+	// Message is the description of the failure, for example
+	// "lapack: bad leading dimension of A".
+	Message string
+}
+
+func (e Error) Error() string {
+	if e.Param == "" {
+		return e.Message + " in " + e.Routine
+	}
+	return e.Message + " in " + e.Routine + " (" + e.Param + ")"
+}
+
+// ErrImplementation is the cgo-based C implementation of LAPACK routines
+// with methods that return an error instead of panicking when the input
+// arguments are invalid. Its methods are generated from those of
+// Implementation, which remains the type to use with gonum.org/v1/gonum/mat
+// and other consumers of the lapack interfaces.
+//
+// The returned error is an Error, or a blas/netlib Error if the library
+// itself detects an invalid argument and calls its error handler. Panics
+// that are not caused by an argument check, such as a nil method value, are
+// not recovered.
+type ErrImplementation struct{}
+
+// catch recovers an argument check panic of routine and stores it in err.
+// A panic holding only the message is converted to an Error for routine,
+// and the blas/netlib Error raised by the library error handler is kept.
+// Other panics are propagated.
+func catch(routine string, err *error) {
+	r := recover()
+	switch r := r.(type) {
+	case nil:
+		return
+	case Error:
+		*err = r
+		return
+	case blasnetlib.Error:
+		*err = r
+		return
+	case string:
+		if strings.HasPrefix(r, "lapack: ") {
+			*err = Error{Routine: routine, Message: r}
+			return
+		}
+	}
+	panic(r)
+}
@@ -0,0 +1,63 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func TestErrImplementation(t *testing.T) {
+	var e ErrImplementation
+
+	a := []float64{4, 2, 2, 3}
+	ok, err := e.Dpotrf(blas.Upper, 2, a, 2)
+	if err != nil || !ok {
+		t.Fatalf("unexpected result for valid arguments: got %t, %v", ok, err)
+	}
+	if a[0] != 2 || a[1] != 1 {
+		t.Errorf("unexpected factor: %v", a)
+	}
+
+	for _, test := range []struct {
+		call  func() error
+		param string
+		want  string
+	}{
+		{
+			call:  func() error { _, err := e.Dpotrf(blas.Upper, 2, a, 1); return err },
+			param: "lda",
+			want:  badLdA,
+		},
+		{
+			call:  func() error { _, err := e.Dpotrf('X', 2, a, 2); return err },
+			param: "ul",
+			want:  badUplo,
+		},
+		{
+			call:  func() error { _, err := e.Dpotrf(blas.Upper, 2, a[:3], 2); return err },
+			param: "a",
+			want:  shortA,
+		},
+	} {
+		err := test.call()
+		want := Error{Routine: "Dpotrf", Param: test.param, Message: test.want}
+		if err != want {
+			t.Errorf("unexpected error: got %#v want %#v", err, want)
+		}
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r != "blas: other" {
+				t.Errorf("unexpected recovered value: %v", r)
+			}
+		}()
+		var err error
+		defer catch("Dpotrf", &err)
+		panic("blas: other")
+	}()
+}
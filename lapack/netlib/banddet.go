@@ -0,0 +1,261 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// BandSlogDet returns the sign and the natural logarithm of the absolute
+// value of the determinant of the n×n band matrix A, computed from its LU
+// factorization with partial pivoting by Dgbtrf, so that
+//  det(A) = sign * exp(logAbsDet).
+// The cost is O(n*kl*(kl+ku)) and the input a is not modified. As for
+// SlogDet, BandSlogDet returns sign 0 and logAbsDet -∞ if A is exactly
+// singular, and sign 1 and logAbsDet 0 if n is zero.
+func BandSlogDet(a blas64.Band) (sign, logAbsDet float64) {
+	n := a.Rows
+	kl, ku := a.KL, a.KU
+	switch {
+	case n < 0 || kl < 0 || ku < 0 || a.Stride < kl+ku+1:
+		panic(badBand)
+	case a.Cols != n:
+		panic(badShapeA)
+	}
+	if n == 0 {
+		return 1, 0
+	}
+
+	// Leave room for the kl super-diagonals of fill-in in each row.
+	ldab := 2*kl + ku + 1
+	ab := make([]float64, n*ldab)
+	for i := 0; i < n; i++ {
+		copy(ab[i*ldab:i*ldab+kl+ku+1], a.Data[i*a.Stride:])
+	}
+	ipiv := make([]int, n)
+	lapackImpl.Dgbtrf(n, n, kl, ku, ab, ldab, ipiv)
+
+	sign = 1
+	var acc logAccumulator
+	for i := 0; i < n; i++ {
+		u := ab[i*ldab+kl]
+		if u == 0 {
+			return 0, math.Inf(-1)
+		}
+		if u < 0 {
+			sign = -sign
+		}
+		if ipiv[i] != i {
+			sign = -sign
+		}
+		acc.mul(math.Abs(u))
+	}
+	return sign, acc.log()
+}
+
+// SPDBandLogDet returns the natural logarithm of the determinant of the
+// symmetric positive definite band matrix A, computed from its band
+// Cholesky factorization by Dpbtrf as
+//  log(det(A)) = 2 * Σ log(T_ii).
+// The input a is not modified.
+//
+// If A is not positive definite, SPDBandLogDet returns NaN and an
+// ErrNotPositiveDefinite; use SymBandInertia for indefinite matrices.
+func SPDBandLogDet(a blas64.SymmetricBand) (float64, error) {
+	n, kd := a.N, a.K
+	switch {
+	case a.Uplo != blas.Upper && a.Uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0 || kd < 0 || a.Stride < kd+1:
+		panic(badBand)
+	}
+	if n == 0 {
+		return 0, nil
+	}
+
+	ldab := kd + 1
+	ab := make([]float64, n*ldab)
+	for i := 0; i < n; i++ {
+		copy(ab[i*ldab:i*ldab+ldab], a.Data[i*a.Stride:])
+	}
+	// The diagonal is the first column of each row of the upper band and
+	// the last of the lower band.
+	d := 0
+	if a.Uplo == blas.Lower {
+		d = kd
+	}
+	if !lapackImpl.Dpbtrf(a.Uplo, n, kd, ab, ldab) {
+		// The factorization stops at the first non-positive pivot, which
+		// is left on the diagonal.
+		i := 0
+		for i < n-1 && ab[i*ldab+d] > 0 {
+			i++
+		}
+		return math.NaN(), ErrNotPositiveDefinite{Index: i}
+	}
+	var acc logAccumulator
+	for i := 0; i < n; i++ {
+		acc.mul(ab[i*ldab+d])
+	}
+	return 2 * acc.log(), nil
+}
+
+// TridiagSlogDet returns the sign and the natural logarithm of the absolute
+// value of the determinant of the n×n tridiagonal matrix with the
+// sub-diagonal dl, the diagonal d and the super-diagonal du, computed from
+// its LU factorization with partial pivoting by Dgttrf. d must have length
+// n and dl and du length n-1. The inputs are not modified, and the result is
+// returned as described for BandSlogDet.
+func TridiagSlogDet(dl, d, du []float64) (sign, logAbsDet float64) {
+	n := len(d)
+	if len(dl) != max(0, n-1) || len(du) != max(0, n-1) {
+		panic(badTridiag)
+	}
+	if n == 0 {
+		return 1, 0
+	}
+
+	dlc := append([]float64(nil), dl...)
+	dc := append([]float64(nil), d...)
+	duc := append([]float64(nil), du...)
+	du2 := make([]float64, max(0, n-2))
+	ipiv := make([]int, n)
+	lapackImpl.Dgttrf(n, dlc, dc, duc, du2, ipiv)
+
+	sign = 1
+	var acc logAccumulator
+	for i, u := range dc {
+		if u == 0 {
+			return 0, math.Inf(-1)
+		}
+		if u < 0 {
+			sign = -sign
+		}
+		if ipiv[i] != i {
+			sign = -sign
+		}
+		acc.mul(math.Abs(u))
+	}
+	return sign, acc.log()
+}
+
+// SPDTridiagLogDet returns the natural logarithm of the determinant of the
+// symmetric positive definite tridiagonal matrix with the diagonal d and the
+// off-diagonal e, computed from its L*D*L^T factorization by Dpttrf as
+//  log(det(A)) = Σ log(D_ii).
+// d must have length n and e length n-1. The inputs are not modified.
+//
+// If A is not positive definite, SPDTridiagLogDet returns NaN and an
+// ErrNotPositiveDefinite; use SymTridiagInertia for indefinite matrices.
+func SPDTridiagLogDet(d, e []float64) (float64, error) {
+	n := len(d)
+	if len(e) != max(0, n-1) {
+		panic(badTridiag)
+	}
+	if n == 0 {
+		return 0, nil
+	}
+
+	dc := append([]float64(nil), d...)
+	ec := append([]float64(nil), e...)
+	if !lapackImpl.Dpttrf(n, dc, ec) {
+		i := 0
+		for i < n-1 && dc[i] > 0 {
+			i++
+		}
+		return math.NaN(), ErrNotPositiveDefinite{Index: i}
+	}
+	var acc logAccumulator
+	for _, v := range dc {
+		acc.mul(v)
+	}
+	return acc.log(), nil
+}
+
+// SymTridiagInertia returns the inertia of T - shift*I for the symmetric
+// n×n tridiagonal matrix T with the diagonal d and the off-diagonal e. d
+// must have length n and e length n-1.
+//
+// Neg and Pos are the numbers of negative pivots of the L*D*L^T
+// factorizations of T - shift*I and shift*I - T, which by Sylvester's law
+// of inertia are the numbers of eigenvalues of T less than and greater than
+// shift. Pivots smaller in magnitude than a tiny threshold are replaced by
+// it, as in the Sturm sequence count of Dstebz, so that eigenvalues within
+// roundoff of shift are counted in Zero. The cost is O(n) and the inputs are
+// not modified.
+func SymTridiagInertia(d, e []float64, shift float64) Inertia {
+	n := len(d)
+	if len(e) != max(0, n-1) {
+		panic(badTridiag)
+	}
+	pivmin := tridiagPivmin(e)
+	neg := sturmCount(d, e, shift, 1, pivmin)
+	pos := sturmCount(d, e, shift, -1, pivmin)
+	return Inertia{Neg: neg, Zero: n - neg - pos, Pos: pos}
+}
+
+// SymBandInertia returns the inertia of A - shift*I for the symmetric n×n
+// band matrix A. A is reduced to tridiagonal form by the orthogonal
+// similarity transformation of Dsbtrd, which preserves the eigenvalues, and
+// the inertia of the tridiagonal matrix is computed as described for
+// SymTridiagInertia. The cost is O(n²*kd) and the input a is not modified.
+func SymBandInertia(a blas64.SymmetricBand, shift float64) Inertia {
+	n, kd := a.N, a.K
+	switch {
+	case a.Uplo != blas.Upper && a.Uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0 || kd < 0 || a.Stride < kd+1:
+		panic(badBand)
+	}
+	if n == 0 {
+		return Inertia{}
+	}
+
+	// LAPACKE expects the band in its own row-major band storage.
+	ab := make([]float64, (kd+1)*n)
+	bandTriToLapacke(a.Uplo, n, kd, a.Data, a.Stride, ab, n)
+	d := make([]float64, n)
+	e := make([]float64, n-1)
+	if !lapacke.Dsbtrd('N', byte(a.Uplo), n, kd, ab, n, d, e, nil, 1, make([]float64, n)) {
+		panic("lapack: invalid argument to Dsbtrd")
+	}
+	return SymTridiagInertia(d, e, shift)
+}
+
+// tridiagPivmin returns the smallest pivot allowed in the Sturm sequence of
+// a tridiagonal matrix with the off-diagonal e, as chosen by Dstebz.
+func tridiagPivmin(e []float64) float64 {
+	emax := 1.0
+	for _, v := range e {
+		emax = math.Max(emax, v*v)
+	}
+	return dlamchS * emax
+}
+
+// sturmCount returns the number of negative pivots of the L*D*L^T
+// factorization of s*(T - shift*I), where s is 1 or -1. Pivots smaller in
+// magnitude than pivmin are replaced by pivmin.
+func sturmCount(d, e []float64, shift, s, pivmin float64) int {
+	var count int
+	var q float64
+	for i, v := range d {
+		if i == 0 {
+			q = s * (v - shift)
+		} else {
+			q = s*(v-shift) - e[i-1]*e[i-1]/q
+		}
+		if math.Abs(q) < pivmin {
+			q = pivmin
+		}
+		if q < 0 {
+			count++
+		}
+	}
+	return count
+}
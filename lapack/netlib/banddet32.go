@@ -0,0 +1,215 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// BandSlogDet32 is the float32 version of BandSlogDet. The LU factorization
+// is computed by Sgbtrf and the pivots are accumulated in float64.
+func BandSlogDet32(a blas32.Band) (sign, logAbsDet float32) {
+	n := a.Rows
+	kl, ku := a.KL, a.KU
+	switch {
+	case n < 0 || kl < 0 || ku < 0 || a.Stride < kl+ku+1:
+		panic(badBand)
+	case a.Cols != n:
+		panic(badShapeA)
+	}
+	if n == 0 {
+		return 1, 0
+	}
+
+	ldab := 2*kl + ku + 1
+	ab := make([]float32, n*ldab)
+	for i := 0; i < n; i++ {
+		copy(ab[i*ldab:i*ldab+kl+ku+1], a.Data[i*a.Stride:])
+	}
+	ipiv := make([]int, n)
+	lapackImpl.Sgbtrf(n, n, kl, ku, ab, ldab, ipiv)
+
+	sign = 1
+	var acc logAccumulator
+	for i := 0; i < n; i++ {
+		u := ab[i*ldab+kl]
+		if u == 0 {
+			return 0, float32(math.Inf(-1))
+		}
+		if u < 0 {
+			sign = -sign
+		}
+		if ipiv[i] != i {
+			sign = -sign
+		}
+		acc.mul(math.Abs(float64(u)))
+	}
+	return sign, float32(acc.log())
+}
+
+// SPDBandLogDet32 is the float32 version of SPDBandLogDet. The Cholesky
+// factorization is computed by Spbtrf.
+func SPDBandLogDet32(a blas32.SymmetricBand) (float32, error) {
+	n, kd := a.N, a.K
+	switch {
+	case a.Uplo != blas.Upper && a.Uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0 || kd < 0 || a.Stride < kd+1:
+		panic(badBand)
+	}
+	if n == 0 {
+		return 0, nil
+	}
+
+	ldab := kd + 1
+	ab := make([]float32, n*ldab)
+	for i := 0; i < n; i++ {
+		copy(ab[i*ldab:i*ldab+ldab], a.Data[i*a.Stride:])
+	}
+	d := 0
+	if a.Uplo == blas.Lower {
+		d = kd
+	}
+	if !lapackImpl.Spbtrf(a.Uplo, n, kd, ab, ldab) {
+		i := 0
+		for i < n-1 && ab[i*ldab+d] > 0 {
+			i++
+		}
+		return float32(math.NaN()), ErrNotPositiveDefinite{Index: i}
+	}
+	var acc logAccumulator
+	for i := 0; i < n; i++ {
+		acc.mul(float64(ab[i*ldab+d]))
+	}
+	return float32(2 * acc.log()), nil
+}
+
+// TridiagSlogDet32 is the float32 version of TridiagSlogDet. The LU
+// factorization is computed by Sgttrf.
+func TridiagSlogDet32(dl, d, du []float32) (sign, logAbsDet float32) {
+	n := len(d)
+	if len(dl) != max(0, n-1) || len(du) != max(0, n-1) {
+		panic(badTridiag)
+	}
+	if n == 0 {
+		return 1, 0
+	}
+
+	dlc := append([]float32(nil), dl...)
+	dc := append([]float32(nil), d...)
+	duc := append([]float32(nil), du...)
+	du2 := make([]float32, max(0, n-2))
+	ipiv := make([]int, n)
+	lapackImpl.Sgttrf(n, dlc, dc, duc, du2, ipiv)
+
+	sign = 1
+	var acc logAccumulator
+	for i, u := range dc {
+		if u == 0 {
+			return 0, float32(math.Inf(-1))
+		}
+		if u < 0 {
+			sign = -sign
+		}
+		if ipiv[i] != i {
+			sign = -sign
+		}
+		acc.mul(math.Abs(float64(u)))
+	}
+	return sign, float32(acc.log())
+}
+
+// SPDTridiagLogDet32 is the float32 version of SPDTridiagLogDet. The
+// factorization is computed by Spttrf.
+func SPDTridiagLogDet32(d, e []float32) (float32, error) {
+	n := len(d)
+	if len(e) != max(0, n-1) {
+		panic(badTridiag)
+	}
+	if n == 0 {
+		return 0, nil
+	}
+
+	dc := append([]float32(nil), d...)
+	ec := append([]float32(nil), e...)
+	if !lapackImpl.Spttrf(n, dc, ec) {
+		i := 0
+		for i < n-1 && dc[i] > 0 {
+			i++
+		}
+		return float32(math.NaN()), ErrNotPositiveDefinite{Index: i}
+	}
+	var acc logAccumulator
+	for _, v := range dc {
+		acc.mul(float64(v))
+	}
+	return float32(acc.log()), nil
+}
+
+// SymTridiagInertia32 is the float32 version of SymTridiagInertia.
+func SymTridiagInertia32(d, e []float32, shift float32) Inertia {
+	n := len(d)
+	if len(e) != max(0, n-1) {
+		panic(badTridiag)
+	}
+	emax := float32(1)
+	for _, v := range e {
+		if v*v > emax {
+			emax = v * v
+		}
+	}
+	pivmin := slamchS * emax
+	neg := sturmCount32(d, e, shift, 1, pivmin)
+	pos := sturmCount32(d, e, shift, -1, pivmin)
+	return Inertia{Neg: neg, Zero: n - neg - pos, Pos: pos}
+}
+
+// SymBandInertia32 is the float32 version of SymBandInertia. The reduction
+// to tridiagonal form is computed by Ssbtrd.
+func SymBandInertia32(a blas32.SymmetricBand, shift float32) Inertia {
+	n, kd := a.N, a.K
+	switch {
+	case a.Uplo != blas.Upper && a.Uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0 || kd < 0 || a.Stride < kd+1:
+		panic(badBand)
+	}
+	if n == 0 {
+		return Inertia{}
+	}
+
+	ab := make([]float32, (kd+1)*n)
+	bandTriToLapacke32(a.Uplo, n, kd, a.Data, a.Stride, ab, n)
+	d := make([]float32, n)
+	e := make([]float32, n-1)
+	if !lapacke.Ssbtrd('N', byte(a.Uplo), n, kd, ab, n, d, e, nil, 1, make([]float32, n)) {
+		panic("lapack: invalid argument to Ssbtrd")
+	}
+	return SymTridiagInertia32(d, e, shift)
+}
+
+// sturmCount32 is the float32 version of sturmCount.
+func sturmCount32(d, e []float32, shift, s, pivmin float32) int {
+	var count int
+	var q float32
+	for i, v := range d {
+		if i == 0 {
+			q = s * (v - shift)
+		} else {
+			q = s*(v-shift) - e[i-1]*e[i-1]/q
+		}
+		if float32(math.Abs(float64(q))) < pivmin {
+			q = pivmin
+		}
+		if q < 0 {
+			count++
+		}
+	}
+	return count
+}
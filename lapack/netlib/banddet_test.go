@@ -0,0 +1,193 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// randomSymBand returns a random n×n symmetric matrix with kd
+// off-diagonals. If spd is true, the matrix is diagonally dominant with a
+// positive diagonal.
+func randomSymBand(rnd *rand.Rand, n, kd int, spd bool) blas64.Symmetric {
+	a := newGeneral(n, n)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < min(n, i+kd+1); j++ {
+			v := rnd.NormFloat64()
+			a.Data[i*a.Stride+j] = v
+			a.Data[j*a.Stride+i] = v
+		}
+	}
+	for i := 0; i < n; i++ {
+		v := rnd.NormFloat64()
+		if spd {
+			v = 1 + math.Abs(v)
+			for j := 0; j < n; j++ {
+				v += math.Abs(a.Data[i*a.Stride+j])
+			}
+		}
+		a.Data[i*a.Stride+i] = v
+	}
+	return blas64.Symmetric{Uplo: blas.Upper, N: n, Stride: a.Stride, Data: a.Data}
+}
+
+// denseInertia returns the inertia of a - shift*I from the eigenvalues of
+// the dense symmetric matrix a computed by Dsyev.
+func denseInertia(a blas64.General, shift float64) Inertia {
+	n := a.Rows
+	var in Inertia
+	if n == 0 {
+		return in
+	}
+	c := cloneGeneral(a)
+	w := make([]float64, n)
+	work := make([]float64, 3*n)
+	impl.Dsyev(lapack.EVNone, blas.Upper, n, c.Data, c.Stride, w, work, len(work))
+	for _, v := range w {
+		switch {
+		case v < shift:
+			in.Neg++
+		case v > shift:
+			in.Pos++
+		default:
+			in.Zero++
+		}
+	}
+	return in
+}
+
+func TestBandSlogDet(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 5, 17} {
+		for _, kl := range []int{0, 1, 3} {
+			for _, ku := range []int{0, 2} {
+				name := fmt.Sprintf("n=%d,kl=%d,ku=%d", n, kl, ku)
+				a, dense := randomBand(rnd, n, n, kl, ku)
+				data := append([]float64(nil), a.Data...)
+				sign, got := BandSlogDet(a)
+				wantSign, want := SlogDet(dense)
+				if sign != wantSign || math.Abs(got-want) > tol*math.Max(1, math.Abs(want)) {
+					t.Errorf("%s: unexpected result: got %v, %v want %v, %v", name, sign, got, wantSign, want)
+				}
+				for i, v := range data {
+					if a.Data[i] != v {
+						t.Errorf("%s: input modified", name)
+						break
+					}
+				}
+			}
+		}
+	}
+
+	a := blas64.Band{Rows: 3, Cols: 3, KL: 1, KU: 1, Stride: 3, Data: []float64{0, 1, 1, 1, 1, 1, 1, 1, 0}}
+	if sign, got := BandSlogDet(a); sign != 0 || !math.IsInf(got, -1) {
+		t.Errorf("unexpected result for singular matrix: %v, %v", sign, got)
+	}
+}
+
+func TestSPDBandLogDet(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 6, 15} {
+		for _, kd := range []int{0, 1, 4} {
+			for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+				name := fmt.Sprintf("n=%d,kd=%d,uplo=%c", n, kd, uplo)
+				ab, dense := symBand(randomSymBand(rnd, n, kd, true), kd, uplo)
+				got, err := SPDBandLogDet(ab)
+				if err != nil {
+					t.Fatalf("%s: unexpected error: %v", name, err)
+				}
+				_, want := SlogDet(dense)
+				if math.Abs(got-want) > tol*math.Max(1, math.Abs(want)) {
+					t.Errorf("%s: unexpected log determinant: got %v want %v", name, got, want)
+				}
+			}
+		}
+	}
+
+	ab := blas64.SymmetricBand{Uplo: blas.Upper, N: 3, K: 1, Stride: 2, Data: []float64{1, 0, 1, 2, 1, 0}}
+	got, err := SPDBandLogDet(ab)
+	if e, ok := err.(ErrNotPositiveDefinite); !ok || e.Index != 2 || !math.IsNaN(got) {
+		t.Errorf("unexpected result for indefinite matrix: %v, %v", got, err)
+	}
+}
+
+func TestTridiagLogDet(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 9, 30} {
+		dl, d, du, dense := randomTridiag(rnd, n, false)
+		sign, got := TridiagSlogDet(dl, d, du)
+		wantSign, want := SlogDet(dense)
+		if sign != wantSign || math.Abs(got-want) > tol*math.Max(1, math.Abs(want)) {
+			t.Errorf("n=%d: unexpected result of TridiagSlogDet: got %v, %v want %v, %v", n, sign, got, wantSign, want)
+		}
+
+		e, d, _, dense := randomTridiag(rnd, n, true)
+		got, err := SPDTridiagLogDet(d, e)
+		if err != nil {
+			t.Fatalf("n=%d: unexpected error: %v", n, err)
+		}
+		_, want = SlogDet(dense)
+		if math.Abs(got-want) > tol*math.Max(1, math.Abs(want)) {
+			t.Errorf("n=%d: unexpected result of SPDTridiagLogDet: got %v want %v", n, got, want)
+		}
+	}
+
+	got, err := SPDTridiagLogDet([]float64{1, 1}, []float64{2})
+	if e, ok := err.(ErrNotPositiveDefinite); !ok || e.Index != 1 || !math.IsNaN(got) {
+		t.Errorf("unexpected result for indefinite matrix: %v, %v", got, err)
+	}
+}
+
+func TestSymTridiagInertia(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 4, 25} {
+		dl, d, _, dense := randomTridiag(rnd, n, false)
+		// Make the dense matrix symmetric with the sub-diagonal.
+		for i, v := range dl {
+			dense.Data[i*dense.Stride+i+1] = v
+		}
+		for _, shift := range []float64{-1, 0, 0.5} {
+			got := SymTridiagInertia(d, dl, shift)
+			want := denseInertia(dense, shift)
+			if got != want {
+				t.Errorf("n=%d,shift=%v: unexpected inertia: got %+v want %+v", n, shift, got, want)
+			}
+		}
+	}
+
+	// The eigenvalues of [1 1; 1 1] are 0 and 2.
+	if got, want := SymTridiagInertia([]float64{1, 1}, []float64{1}, 0), (Inertia{Zero: 1, Pos: 1}); got != want {
+		t.Errorf("unexpected inertia of singular matrix: got %+v want %+v", got, want)
+	}
+}
+
+func TestSymBandInertia(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 6, 20} {
+		for _, kd := range []int{0, 1, 3} {
+			for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+				ab, dense := symBand(randomSymBand(rnd, n, kd, false), kd, uplo)
+				for _, shift := range []float64{-0.5, 0, 1} {
+					got := SymBandInertia(ab, shift)
+					want := denseInertia(dense, shift)
+					if got != want {
+						t.Errorf("n=%d,kd=%d,uplo=%c,shift=%v: unexpected inertia: got %+v want %+v", n, kd, uplo, shift, got, want)
+					}
+				}
+			}
+		}
+	}
+}
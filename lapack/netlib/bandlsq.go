@@ -0,0 +1,100 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+const badBand = "lapack: bad band matrix"
+
+// BandLeastSquares computes the minimum norm solution of the linear least
+// squares problem
+//  minimize ||A*x - b||_2
+// for the m×n band matrix A with a.KL sub-diagonals and a.KU super-diagonals.
+// The band matrix is never expanded to dense storage: A is reduced to
+// bidiagonal form
+//  A = Q * B * P^T
+// by Dgbbrd, which applies Q^T to b as it proceeds, and the singular value
+// decomposition of B is then computed by Dbdsqr. The memory used is
+// proportional to (a.KL+a.KU+1)*n + n*n, independently of the number of rows
+// of A, which makes BandLeastSquares suitable for tall banded systems.
+//
+// Singular values less than or equal to rcond times the largest singular
+// value are treated as zero. If rcond is negative, machine precision is used
+// instead. BandLeastSquares returns the solution x, the effective rank of A
+// and the Euclidean norm of the residual b - A*x. The inputs a and b are not
+// modified. b must have length m, otherwise BandLeastSquares will panic.
+//
+// If the singular value decomposition fails to converge, BandLeastSquares
+// returns ErrIterationLimit.
+func BandLeastSquares(a blas64.Band, b []float64, rcond float64) (x []float64, rank int, rnorm float64, err error) {
+	m, n := a.Rows, a.Cols
+	kl, ku := a.KL, a.KU
+	switch {
+	case m < 0 || n < 0 || kl < 0 || ku < 0 || a.Stride < kl+ku+1:
+		panic(badBand)
+	case len(b) != m:
+		panic(badShapeB)
+	}
+	x = make([]float64, n)
+	k := min(m, n)
+	if k == 0 {
+		return x, 0, blasImpl.Dnrm2(m, b, 1), nil
+	}
+
+	// Copy A from the row-major band storage of blas64.Band into the
+	// row-major band storage expected by LAPACKE, in which the
+	// (kl+ku+1)×n array ab holds A[i][j] at ab[(ku+i-j)*n+j].
+	ab := make([]float64, (kl+ku+1)*n)
+	for i := 0; i < m; i++ {
+		for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+			ab[(ku+i-j)*n+j] = a.Data[i*a.Stride+kl+j-i]
+		}
+	}
+
+	c := make([]float64, m)
+	copy(c, b)
+	d := make([]float64, k)
+	e := make([]float64, k)
+	pt := make([]float64, n*n)
+	work := make([]float64, 2*max(m, n))
+	if !lapacke.Dgbbrd('P', m, n, 1, kl, ku, ab, n, d, e, nil, max(1, m), pt, n, c, 1, work) {
+		panic("lapack: invalid argument to Dgbbrd")
+	}
+
+	// B is upper bidiagonal if m >= n and lower bidiagonal otherwise.
+	uplo := blas.Upper
+	if m < n {
+		uplo = blas.Lower
+	}
+	// Only the first k rows of P^T are needed. On return they hold the
+	// right singular vectors of A as rows and c[:k] holds U^T*Q^T*b.
+	if !lapackImpl.Dbdsqr(uplo, k, n, 0, 1, d, e, pt, n, nil, k, c, 1, make([]float64, 4*k)) {
+		return x, 0, blasImpl.Dnrm2(m, b, 1), ErrIterationLimit
+	}
+
+	if rcond < 0 {
+		rcond = dlamchE
+	}
+	thresh := rcond * d[0]
+	var r2 float64
+	for i := k; i < m; i++ {
+		r2 += c[i] * c[i]
+	}
+	for i := 0; i < k; i++ {
+		if d[i] <= thresh || d[i] == 0 {
+			r2 += c[i] * c[i]
+			continue
+		}
+		rank++
+		blasImpl.Daxpy(n, c[i]/d[i], pt[i*n:i*n+n], 1, x, 1)
+	}
+	return x, rank, math.Sqrt(r2), nil
+}
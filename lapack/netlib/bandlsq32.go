@@ -0,0 +1,74 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// BandLeastSquares32 is the float32 version of BandLeastSquares. The
+// bidiagonal reduction is computed by Sgbbrd and the singular value
+// decomposition by Sbdsqr. The residual norm is accumulated in float64.
+func BandLeastSquares32(a blas32.Band, b []float32, rcond float32) (x []float32, rank int, rnorm float32, err error) {
+	m, n := a.Rows, a.Cols
+	kl, ku := a.KL, a.KU
+	switch {
+	case m < 0 || n < 0 || kl < 0 || ku < 0 || a.Stride < kl+ku+1:
+		panic(badBand)
+	case len(b) != m:
+		panic(badShapeB)
+	}
+	x = make([]float32, n)
+	k := min(m, n)
+	if k == 0 {
+		return x, 0, blasImpl.Snrm2(m, b, 1), nil
+	}
+
+	ab := make([]float32, (kl+ku+1)*n)
+	for i := 0; i < m; i++ {
+		for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+			ab[(ku+i-j)*n+j] = a.Data[i*a.Stride+kl+j-i]
+		}
+	}
+
+	c := make([]float32, m)
+	copy(c, b)
+	d := make([]float32, k)
+	e := make([]float32, k)
+	pt := make([]float32, n*n)
+	work := make([]float32, 2*max(m, n))
+	if !lapacke.Sgbbrd('P', m, n, 1, kl, ku, ab, n, d, e, nil, max(1, m), pt, n, c, 1, work) {
+		panic("lapack: invalid argument to Sgbbrd")
+	}
+
+	uplo := byte('U')
+	if m < n {
+		uplo = 'L'
+	}
+	if !lapacke.Sbdsqr(uplo, k, n, 0, 1, d, e, pt, n, nil, k, c, 1, make([]float32, 4*k)) {
+		return x, 0, blasImpl.Snrm2(m, b, 1), ErrIterationLimit
+	}
+
+	if rcond < 0 {
+		rcond = slamchE
+	}
+	thresh := rcond * d[0]
+	var r2 float64
+	for i := k; i < m; i++ {
+		r2 += float64(c[i]) * float64(c[i])
+	}
+	for i := 0; i < k; i++ {
+		if d[i] <= thresh || d[i] == 0 {
+			r2 += float64(c[i]) * float64(c[i])
+			continue
+		}
+		rank++
+		blasImpl.Saxpy(n, c[i]/d[i], pt[i*n:i*n+n], 1, x, 1)
+	}
+	return x, rank, float32(math.Sqrt(r2)), nil
+}
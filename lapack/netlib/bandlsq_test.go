@@ -0,0 +1,138 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+)
+
+// randomBand returns a random m×n band matrix with kl sub-diagonals and ku
+// super-diagonals in band and dense storage.
+func randomBand(rnd *rand.Rand, m, n, kl, ku int) (blas64.Band, blas64.General) {
+	band := blas64.Band{
+		Rows: m, Cols: n, KL: kl, KU: ku,
+		Stride: kl + ku + 1,
+		Data:   make([]float64, m*(kl+ku+1)),
+	}
+	for i := range band.Data {
+		band.Data[i] = math.NaN()
+	}
+	dense := newGeneral(m, n)
+	for i := 0; i < m; i++ {
+		for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+			v := rnd.NormFloat64()
+			band.Data[i*band.Stride+kl+j-i] = v
+			dense.Data[i*dense.Stride+j] = v
+		}
+	}
+	return band, dense
+}
+
+func TestBandLeastSquares(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, kl, ku int
+	}{
+		{m: 1, n: 1, kl: 0, ku: 0},
+		{m: 10, n: 10, kl: 1, ku: 1},
+		{m: 50, n: 8, kl: 42, ku: 2},
+		{m: 40, n: 12, kl: 30, ku: 0},
+		{m: 6, n: 15, kl: 2, ku: 9},
+	} {
+		name := fmt.Sprintf("m=%d,n=%d,kl=%d,ku=%d", test.m, test.n, test.kl, test.ku)
+		band, dense := randomBand(rnd, test.m, test.n, test.kl, test.ku)
+		b := make([]float64, test.m)
+		for i := range b {
+			b[i] = rnd.NormFloat64()
+		}
+		bandCopy := make([]float64, len(band.Data))
+		copy(bandCopy, band.Data)
+		bCopy := make([]float64, len(b))
+		copy(bCopy, b)
+
+		x, rank, rnorm, err := BandLeastSquares(band, b, -1)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if !floats.Same(band.Data, bandCopy) || !floats.Equal(b, bCopy) {
+			t.Errorf("%s: input modified", name)
+		}
+		if rank != min(test.m, test.n) {
+			t.Errorf("%s: unexpected rank: got %d want %d", name, rank, min(test.m, test.n))
+		}
+
+		// Compare with the dense solution computed by Dgels.
+		nb := max(test.m, test.n)
+		want := make([]float64, nb)
+		copy(want, b)
+		work := make([]float64, 1)
+		impl.Dgels(blas.NoTrans, test.m, test.n, 1, dense.Data, dense.Stride, want, 1, work, -1)
+		work = make([]float64, int(work[0]))
+		a := cloneGeneral(dense)
+		impl.Dgels(blas.NoTrans, test.m, test.n, 1, a.Data, a.Stride, want, 1, work, len(work))
+		if !floats.EqualApprox(x, want[:test.n], tol) {
+			t.Errorf("%s: unexpected solution: got %v want %v", name, x, want[:test.n])
+		}
+
+		r := make([]float64, test.m)
+		copy(r, b)
+		blas64.Gemv(blas.NoTrans, -1, dense, blas64.Vector{N: test.n, Inc: 1, Data: x}, 1, blas64.Vector{N: test.m, Inc: 1, Data: r})
+		if got := floats.Norm(r, 2); math.Abs(got-rnorm) > tol {
+			t.Errorf("%s: unexpected residual norm: got %v want %v", name, rnorm, got)
+		}
+	}
+}
+
+func TestBandLeastSquaresRankDeficient(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	const m, n, kl, ku = 30, 7, 5, 1
+	band, dense := randomBand(rnd, m, n, kl, ku)
+	// Zero column 3 so that A has rank n-1 and the minimum norm solution
+	// has a zero in position 3.
+	const zero = 3
+	for i := max(0, zero-ku); i < min(m, zero+kl+1); i++ {
+		band.Data[i*band.Stride+kl+zero-i] = 0
+		dense.Data[i*dense.Stride+zero] = 0
+	}
+	b := make([]float64, m)
+	for i := range b {
+		b[i] = rnd.NormFloat64()
+	}
+
+	x, rank, rnorm, err := BandLeastSquares(band, b, 1e-12)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rank != n-1 {
+		t.Errorf("unexpected rank: got %d want %d", rank, n-1)
+	}
+	if math.Abs(x[zero]) > tol {
+		t.Errorf("solution is not minimum norm: x[%d] = %v", zero, x[zero])
+	}
+
+	// The residual must be orthogonal to the columns of A.
+	r := make([]float64, m)
+	copy(r, b)
+	blas64.Gemv(blas.NoTrans, -1, dense, blas64.Vector{N: n, Inc: 1, Data: x}, 1, blas64.Vector{N: m, Inc: 1, Data: r})
+	g := make([]float64, n)
+	blas64.Gemv(blas.Trans, 1, dense, blas64.Vector{N: m, Inc: 1, Data: r}, 0, blas64.Vector{N: n, Inc: 1, Data: g})
+	if floats.Norm(g, math.Inf(1)) > tol {
+		t.Errorf("normal equations not satisfied: A^T*r = %v", g)
+	}
+	if got := floats.Norm(r, 2); math.Abs(got-rnorm) > tol {
+		t.Errorf("unexpected residual norm: got %v want %v", rnorm, got)
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// BandLUSolveInfo holds the diagnostic output of BandSolveExpert.
+type BandLUSolveInfo struct {
+	// RCond is the estimate of the reciprocal condition number of A in
+	// the 1-norm for blas.NoTrans and in the ∞-norm otherwise.
+	RCond float64
+
+	// ForwardErr and BackwardErr hold for each column of the solution
+	// the estimated forward error bound and the componentwise relative
+	// backward error after iterative refinement.
+	ForwardErr, BackwardErr []float64
+}
+
+// BandSolveExpert solves the system of linear equations
+//
+//	A * X = B    if trans == blas.NoTrans,
+//	A^T * X = B  if trans == blas.Trans or blas.ConjTrans,
+//
+// where A is an n×n band matrix with a.KL sub-diagonals and a.KU
+// super-diagonals, using the band LU factorization with partial pivoting
+// computed by Dgbtrf. It is the band counterpart of SolveExpert: the
+// condition of A is estimated by Dgbcon, and the solution computed by Dgbtrs
+// is improved by iterative refinement with Dgbrfs, which also returns the
+// error bounds. The band matrix is never expanded to dense storage, so the
+// memory used is proportional to (2*a.KL+a.KU+1)*n.
+//
+// The inputs a and b are not modified.
+//
+// If A is exactly singular, BandSolveExpert returns a SingularError and no
+// solution is computed. If A is singular to working precision, the solution
+// is returned with a Condition error holding 1/RCond.
+func BandSolveExpert(trans blas.Transpose, a blas64.Band, b blas64.General) (x blas64.General, info BandLUSolveInfo, err error) {
+	n := a.Rows
+	kl, ku := a.KL, a.KU
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case n < 0 || kl < 0 || ku < 0 || a.Stride < kl+ku+1:
+		panic(badBand)
+	case a.Cols != n:
+		panic(badShapeA)
+	case b.Rows != n:
+		panic(badShapeB)
+	}
+	nrhs := b.Cols
+
+	x = newGeneral(n, nrhs)
+	info = BandLUSolveInfo{
+		ForwardErr:  make([]float64, nrhs),
+		BackwardErr: make([]float64, nrhs),
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		info.RCond = 1
+		return x, info, nil
+	}
+
+	// Copy A into the LAPACKE row-major band storage, once as it is for
+	// Dgbrfs and once with kl additional super-diagonals for the fill-in
+	// of the factorization.
+	ldab := n
+	ab := make([]float64, (kl+ku+1)*ldab)
+	bandGenToLapacke(n, kl, ku, a.Data, a.Stride, ab, ldab)
+	afb := make([]float64, (2*kl+ku+1)*ldab)
+	copy(afb[kl*ldab:], ab)
+
+	ipiv := make([]lapacke.Int, n)
+	if !lapacke.Dgbtrf(n, n, kl, ku, afb, ldab, ipiv) {
+		// Find the first zero on the diagonal of U.
+		for i := 0; i < n; i++ {
+			if afb[(kl+ku)*ldab+i] == 0 {
+				return x, info, SingularError{Index: i}
+			}
+		}
+		panic("lapack: invalid argument to Dgbtrf")
+	}
+
+	norm := lapack.MaxColumnSum
+	if trans != blas.NoTrans {
+		norm = lapack.MaxRowSum
+	}
+	anorm := lapackImpl.Dlangb(norm, n, kl, ku, a.Data, a.Stride, make([]float64, n))
+	rcond := make([]float64, 1)
+	work := make([]float64, 3*n)
+	iwork := make([]lapacke.Int, n)
+	lapacke.Dgbcon(byte(norm), n, kl, ku, afb, ldab, ipiv, anorm, rcond, work, iwork)
+	info.RCond = rcond[0]
+
+	if nrhs > 0 {
+		for i := 0; i < n; i++ {
+			copy(x.Data[i*x.Stride:i*x.Stride+nrhs], b.Data[i*b.Stride:])
+		}
+		lapacke.Dgbtrs(byte(trans), n, kl, ku, nrhs, afb, ldab, ipiv, x.Data, x.Stride)
+		lapacke.Dgbrfs(byte(trans), n, kl, ku, nrhs, ab, ldab, afb, ldab, ipiv, b.Data, b.Stride, x.Data, x.Stride,
+			info.ForwardErr, info.BackwardErr, work, iwork)
+	}
+
+	if info.RCond < dlamchE {
+		return x, info, Condition(1 / info.RCond)
+	}
+	return x, info, nil
+}
+
+// BandSolve solves the system of linear equations A * X = B, where A is an
+// n×n band matrix with a.KL sub-diagonals and a.KU super-diagonals, by the
+// band LU factorization with partial pivoting computed by Dgbsv. Unlike
+// BandSolveExpert it neither estimates the condition of A nor refines the
+// solution, so it is the cheaper choice for the repeated solves of, for
+// example, implicit finite difference schemes.
+//
+// The inputs a and b are not modified. If A is exactly singular, BandSolve
+// returns a SingularError and no solution is computed.
+func BandSolve(a blas64.Band, b blas64.General) (blas64.General, error) {
+	n := a.Rows
+	kl, ku := a.KL, a.KU
+	switch {
+	case n < 0 || kl < 0 || ku < 0 || a.Stride < kl+ku+1:
+		panic(badBand)
+	case a.Cols != n:
+		panic(badShapeA)
+	case b.Rows != n:
+		panic(badShapeB)
+	}
+	x := cloneGeneral(b)
+	if n == 0 {
+		return x, nil
+	}
+
+	// Leave room for the kl super-diagonals of fill-in in each row.
+	ldab := 2*kl + ku + 1
+	ab := make([]float64, n*ldab)
+	for i := 0; i < n; i++ {
+		copy(ab[i*ldab:i*ldab+kl+ku+1], a.Data[i*a.Stride:])
+	}
+	if !lapackImpl.Dgbsv(n, kl, ku, x.Cols, ab, ldab, make([]int, n), x.Data, x.Stride) {
+		// Find the first zero on the diagonal of U.
+		i := 0
+		for i < n-1 && ab[i*ldab+kl] != 0 {
+			i++
+		}
+		return x, SingularError{Index: i}
+	}
+	return x, nil
+}
+
+// SPDBandSolve solves the system of linear equations A * X = B, where A is
+// an n×n symmetric positive definite band matrix, by the band Cholesky
+// factorization computed by Dpbsv.
+//
+// The inputs a and b are not modified. If A is not positive definite,
+// SPDBandSolve returns an ErrNotPositiveDefinite and no solution is
+// computed.
+func SPDBandSolve(a blas64.SymmetricBand, b blas64.General) (blas64.General, error) {
+	n, kd := a.N, a.K
+	switch {
+	case a.Uplo != blas.Upper && a.Uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0 || kd < 0 || a.Stride < kd+1:
+		panic(badBand)
+	case b.Rows != n:
+		panic(badShapeB)
+	}
+	x := cloneGeneral(b)
+	if n == 0 {
+		return x, nil
+	}
+
+	ldab := kd + 1
+	ab := make([]float64, n*ldab)
+	for i := 0; i < n; i++ {
+		copy(ab[i*ldab:i*ldab+ldab], a.Data[i*a.Stride:])
+	}
+	if !lapackImpl.Dpbsv(a.Uplo, n, kd, x.Cols, ab, ldab, x.Data, x.Stride) {
+		// The factorization stops at the first non-positive pivot, which
+		// is left on the diagonal.
+		d := 0
+		if a.Uplo == blas.Lower {
+			d = kd
+		}
+		i := 0
+		for i < n-1 && ab[i*ldab+d] > 0 {
+			i++
+		}
+		return x, ErrNotPositiveDefinite{Index: i}
+	}
+	return x, nil
+}
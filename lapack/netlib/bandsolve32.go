@@ -0,0 +1,162 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// BandLUSolveInfo32 is the float32 version of BandLUSolveInfo.
+type BandLUSolveInfo32 struct {
+	RCond                   float32
+	ForwardErr, BackwardErr []float32
+}
+
+// BandSolveExpert32 is the float32 version of BandSolveExpert. The system is
+// solved by Sgbtrf, Sgbcon, Sgbtrs and Sgbrfs.
+func BandSolveExpert32(trans blas.Transpose, a blas32.Band, b blas32.General) (x blas32.General, info BandLUSolveInfo32, err error) {
+	n := a.Rows
+	kl, ku := a.KL, a.KU
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case n < 0 || kl < 0 || ku < 0 || a.Stride < kl+ku+1:
+		panic(badBand)
+	case a.Cols != n:
+		panic(badShapeA)
+	case b.Rows != n:
+		panic(badShapeB)
+	}
+	nrhs := b.Cols
+
+	x = newGeneral32(n, nrhs)
+	info = BandLUSolveInfo32{
+		ForwardErr:  make([]float32, nrhs),
+		BackwardErr: make([]float32, nrhs),
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		info.RCond = 1
+		return x, info, nil
+	}
+
+	ldab := n
+	ab := make([]float32, (kl+ku+1)*ldab)
+	for i := 0; i < n; i++ {
+		for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+			ab[(ku+i-j)*ldab+j] = a.Data[i*a.Stride+kl+j-i]
+		}
+	}
+	afb := make([]float32, (2*kl+ku+1)*ldab)
+	copy(afb[kl*ldab:], ab)
+
+	ipiv := make([]lapacke.Int, n)
+	if !lapacke.Sgbtrf(n, n, kl, ku, afb, ldab, ipiv) {
+		for i := 0; i < n; i++ {
+			if afb[(kl+ku)*ldab+i] == 0 {
+				return x, info, SingularError{Index: i}
+			}
+		}
+		panic("lapack: invalid argument to Sgbtrf")
+	}
+
+	norm := lapack.MaxColumnSum
+	if trans != blas.NoTrans {
+		norm = lapack.MaxRowSum
+	}
+	anorm := lapacke.Slangb(byte(norm), n, kl, ku, a.Data, a.Stride, make([]float32, n))
+	rcond := make([]float32, 1)
+	work := make([]float32, 3*n)
+	iwork := make([]lapacke.Int, n)
+	lapacke.Sgbcon(byte(norm), n, kl, ku, afb, ldab, ipiv, anorm, rcond, work, iwork)
+	info.RCond = rcond[0]
+
+	if nrhs > 0 {
+		for i := 0; i < n; i++ {
+			copy(x.Data[i*x.Stride:i*x.Stride+nrhs], b.Data[i*b.Stride:])
+		}
+		lapacke.Sgbtrs(byte(trans), n, kl, ku, nrhs, afb, ldab, ipiv, x.Data, x.Stride)
+		lapacke.Sgbrfs(byte(trans), n, kl, ku, nrhs, ab, ldab, afb, ldab, ipiv, b.Data, b.Stride, x.Data, x.Stride,
+			info.ForwardErr, info.BackwardErr, work, iwork)
+	}
+
+	if info.RCond < slamchE {
+		return x, info, Condition(1 / info.RCond)
+	}
+	return x, info, nil
+}
+
+// BandSolve32 is the float32 version of BandSolve. The system is solved by
+// Sgbsv.
+func BandSolve32(a blas32.Band, b blas32.General) (blas32.General, error) {
+	n := a.Rows
+	kl, ku := a.KL, a.KU
+	switch {
+	case n < 0 || kl < 0 || ku < 0 || a.Stride < kl+ku+1:
+		panic(badBand)
+	case a.Cols != n:
+		panic(badShapeA)
+	case b.Rows != n:
+		panic(badShapeB)
+	}
+	x := cloneGeneral32(b)
+	if n == 0 {
+		return x, nil
+	}
+
+	ldab := 2*kl + ku + 1
+	ab := make([]float32, n*ldab)
+	for i := 0; i < n; i++ {
+		copy(ab[i*ldab:i*ldab+kl+ku+1], a.Data[i*a.Stride:])
+	}
+	if !lapackImpl.Sgbsv(n, kl, ku, x.Cols, ab, ldab, make([]int, n), x.Data, x.Stride) {
+		i := 0
+		for i < n-1 && ab[i*ldab+kl] != 0 {
+			i++
+		}
+		return x, SingularError{Index: i}
+	}
+	return x, nil
+}
+
+// SPDBandSolve32 is the float32 version of SPDBandSolve. The system is
+// solved by Spbsv.
+func SPDBandSolve32(a blas32.SymmetricBand, b blas32.General) (blas32.General, error) {
+	n, kd := a.N, a.K
+	switch {
+	case a.Uplo != blas.Upper && a.Uplo != blas.Lower:
+		panic(badUplo)
+	case n < 0 || kd < 0 || a.Stride < kd+1:
+		panic(badBand)
+	case b.Rows != n:
+		panic(badShapeB)
+	}
+	x := cloneGeneral32(b)
+	if n == 0 {
+		return x, nil
+	}
+
+	ldab := kd + 1
+	ab := make([]float32, n*ldab)
+	for i := 0; i < n; i++ {
+		copy(ab[i*ldab:i*ldab+ldab], a.Data[i*a.Stride:])
+	}
+	if !lapackImpl.Spbsv(a.Uplo, n, kd, x.Cols, ab, ldab, x.Data, x.Stride) {
+		d := 0
+		if a.Uplo == blas.Lower {
+			d = kd
+		}
+		i := 0
+		for i < n-1 && ab[i*ldab+d] > 0 {
+			i++
+		}
+		return x, ErrNotPositiveDefinite{Index: i}
+	}
+	return x, nil
+}
@@ -0,0 +1,450 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// bandLU returns the LU factorization of the n×n band matrix a computed by
+// LAPACKE_dgbtrf in the storage scheme of Dgbcon and the zero-indexed
+// pivots.
+func bandLU(a blas64.Band) (afb []float64, ldafb int, ipiv []int) {
+	n, kl, ku := a.Rows, a.KL, a.KU
+	ldafb = 2*kl + ku + 1
+	lapackeAB := make([]float64, ldafb*n)
+	bandGenToLapacke(n, kl, ku, a.Data, a.Stride, lapackeAB[kl*n:], n)
+	ipiv32 := make([]lapacke.Int, n)
+	lapacke.Dgbtrf(n, n, kl, ku, lapackeAB, n, ipiv32)
+	afb = make([]float64, n*ldafb)
+	bandGenToGonum(n, kl, kl+ku, lapackeAB, n, afb, ldafb)
+	ipiv = make([]int, n)
+	for i, v := range ipiv32 {
+		ipiv[i] = int(v) - 1
+	}
+	return afb, ldafb, ipiv
+}
+
+func TestDgbcon(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		n, kl, ku int
+	}{
+		{n: 1, kl: 0, ku: 0},
+		{n: 8, kl: 1, ku: 1},
+		{n: 12, kl: 3, ku: 1},
+		{n: 10, kl: 0, ku: 4},
+		{n: 6, kl: 7, ku: 7},
+	} {
+		n := test.n
+		band, dense := randomBand(rnd, n, n, test.kl, test.ku)
+		afb, ldafb, ipiv := bandLU(band)
+
+		// Compute the exact condition number from the dense inverse.
+		inv := cloneGeneral(dense)
+		dipiv := make([]int, n)
+		impl.Dgetrf(n, n, inv.Data, inv.Stride, dipiv)
+		work := make([]float64, 4*n)
+		impl.Dgetri(n, inv.Data, inv.Stride, dipiv, work, len(work))
+
+		for _, norm := range []lapack.MatrixNorm{lapack.MaxColumnSum, lapack.MaxRowSum} {
+			name := fmt.Sprintf("n=%d,kl=%d,ku=%d,norm=%c", n, test.kl, test.ku, norm)
+			anorm := impl.Dlange(norm, n, n, dense.Data, dense.Stride, make([]float64, n))
+			want := 1 / (anorm * impl.Dlange(norm, n, n, inv.Data, inv.Stride, make([]float64, n)))
+			got := impl.Dgbcon(norm, n, test.kl, test.ku, afb, ldafb, ipiv, anorm, make([]float64, 3*n), make([]int, n))
+			// The estimate of norm(inv(A)) is a lower bound that is
+			// usually within a factor of 3 of the exact value.
+			if got < want*(1-1e-12) || 10*want < got {
+				t.Errorf("%s: unexpected rcond estimate: got %v, want %v", name, got, want)
+			}
+		}
+	}
+}
+
+func TestDgbrfs(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, test := range []struct {
+			n, kl, ku, nrhs int
+		}{
+			{n: 1, kl: 0, ku: 0, nrhs: 1},
+			{n: 9, kl: 2, ku: 1, nrhs: 3},
+			{n: 15, kl: 1, ku: 4, nrhs: 2},
+		} {
+			n, nrhs := test.n, test.nrhs
+			name := fmt.Sprintf("trans=%c,%+v", trans, test)
+			band, dense := randomBand(rnd, n, n, test.kl, test.ku)
+			afb, ldafb, ipiv := bandLU(band)
+			b := randomGeneral(rnd, n, nrhs, nrhs)
+
+			// Start the refinement from a perturbed solution.
+			x := cloneGeneral(b)
+			ipiv32 := make([]lapacke.Int, n)
+			for i, v := range ipiv {
+				ipiv32[i] = lapacke.Int(v + 1)
+			}
+			lapackeAFB := make([]float64, ldafb*n)
+			bandGenToLapacke(n, test.kl, test.kl+test.ku, afb, ldafb, lapackeAFB, n)
+			lapacke.Dgbtrs(byte(trans), n, test.kl, test.ku, nrhs, lapackeAFB, n, ipiv32, x.Data, x.Stride)
+			for i := range x.Data {
+				x.Data[i] *= 1 + 1e-6*rnd.NormFloat64()
+			}
+			bandCopy := make([]float64, len(band.Data))
+			copy(bandCopy, band.Data)
+
+			ferr := make([]float64, nrhs)
+			berr := make([]float64, nrhs)
+			impl.Dgbrfs(trans, n, test.kl, test.ku, nrhs, band.Data, band.Stride, afb, ldafb, ipiv, b.Data, b.Stride, x.Data, x.Stride,
+				ferr, berr, make([]float64, 3*n), make([]int, n))
+			if !floats.Same(band.Data, bandCopy) {
+				t.Errorf("%s: ab modified", name)
+			}
+			if res := residual(trans, dense, x, b); res > tol {
+				t.Errorf("%s: residual too large after refinement: %v", name, res)
+			}
+			for j := 0; j < nrhs; j++ {
+				if ferr[j] < 0 || ferr[j] > 1e-6 || berr[j] > tol {
+					t.Errorf("%s: invalid error bounds for column %d: ferr=%v berr=%v", name, j, ferr[j], berr[j])
+				}
+			}
+		}
+	}
+}
+
+func TestBandSolveExpert(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, test := range []struct {
+			n, kl, ku, nrhs int
+		}{
+			{n: 0, kl: 1, ku: 1, nrhs: 2},
+			{n: 1, kl: 0, ku: 0, nrhs: 1},
+			{n: 10, kl: 2, ku: 3, nrhs: 0},
+			{n: 30, kl: 1, ku: 1, nrhs: 2},
+			{n: 25, kl: 5, ku: 0, nrhs: 3},
+			{n: 8, kl: 9, ku: 9, nrhs: 1},
+		} {
+			name := fmt.Sprintf("trans=%c,%+v", trans, test)
+			a, dense := randomBand(rnd, test.n, test.n, test.kl, test.ku)
+			b := randomGeneral(rnd, test.n, test.nrhs, max(1, test.nrhs))
+			aCopy := make([]float64, len(a.Data))
+			copy(aCopy, a.Data)
+			bCopy := cloneGeneral(b)
+
+			x, info, err := BandSolveExpert(trans, a, b)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			if !floats.Same(a.Data, aCopy) || !floats.Equal(cloneGeneral(b).Data, bCopy.Data) {
+				t.Errorf("%s: inputs modified", name)
+			}
+			if x.Rows != test.n || x.Cols != test.nrhs || len(info.ForwardErr) != test.nrhs || len(info.BackwardErr) != test.nrhs {
+				t.Errorf("%s: unexpected result shape", name)
+				continue
+			}
+			if info.RCond <= 0 || 1 < info.RCond {
+				t.Errorf("%s: invalid RCond: %v", name, info.RCond)
+			}
+			if test.n == 0 || test.nrhs == 0 {
+				continue
+			}
+			if res := residual(trans, dense, x, b); res > tol {
+				t.Errorf("%s: residual too large: %v", name, res)
+			}
+			// The dense driver must report the same condition estimate.
+			_, dinfo, _ := SolveExpert(trans, dense, b, false)
+			if math.Abs(info.RCond-dinfo.RCond) > 1e-8*dinfo.RCond {
+				t.Errorf("%s: RCond mismatch: got %v, want %v", name, info.RCond, dinfo.RCond)
+			}
+			for j := range info.BackwardErr {
+				if info.ForwardErr[j] < 0 || info.BackwardErr[j] > tol {
+					t.Errorf("%s: invalid error bounds for column %d: ferr=%v berr=%v", name, j, info.ForwardErr[j], info.BackwardErr[j])
+				}
+			}
+		}
+	}
+}
+
+func TestBandSolveExpertSingular(t *testing.T) {
+	// The tridiagonal matrix has a zero second row.
+	a := blas64.Band{
+		Rows: 3, Cols: 3, KL: 1, KU: 1, Stride: 3,
+		Data: []float64{
+			0, 1, 2,
+			0, 0, 0,
+			1, 2, 0,
+		},
+	}
+	b := blas64.General{Rows: 3, Cols: 1, Stride: 1, Data: []float64{1, 2, 3}}
+	_, _, err := BandSolveExpert(blas.NoTrans, a, b)
+	if _, ok := err.(SingularError); !ok {
+		t.Fatalf("unexpected error for singular matrix: %v", err)
+	}
+}
+
+// symBand returns the uplo triangle of the symmetric band matrix a with kd
+// off-diagonals in band storage, and a as a general matrix.
+func symBand(a blas64.Symmetric, kd int, uplo blas.Uplo) (blas64.SymmetricBand, blas64.General) {
+	n := a.N
+	ab := blas64.SymmetricBand{
+		Uplo: uplo, N: n, K: kd,
+		Stride: kd + 1,
+		Data:   make([]float64, n*(kd+1)),
+	}
+	for i := 0; i < n; i++ {
+		if uplo == blas.Upper {
+			for j := i; j < min(n, i+kd+1); j++ {
+				ab.Data[i*ab.Stride+j-i] = a.Data[i*a.Stride+j]
+			}
+		} else {
+			for j := max(0, i-kd); j <= i; j++ {
+				ab.Data[i*ab.Stride+kd+j-i] = a.Data[i*a.Stride+j]
+			}
+		}
+	}
+	return ab, blas64.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data}
+}
+
+// bandWithFill returns the band matrix a in the storage scheme of Dgbtrf,
+// with room for kl additional super-diagonals in each row.
+func bandWithFill(a blas64.Band) (ab []float64, ldab int) {
+	ldab = 2*a.KL + a.KU + 1
+	ab = make([]float64, max(a.Rows, 1)*ldab)
+	for i := 0; i < a.Rows; i++ {
+		copy(ab[i*ldab:i*ldab+a.KL+a.KU+1], a.Data[i*a.Stride:])
+	}
+	return ab, ldab
+}
+
+func TestDgbtrf(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, kl, ku int
+	}{
+		{m: 1, n: 1, kl: 0, ku: 0},
+		{m: 10, n: 10, kl: 2, ku: 1},
+		{m: 12, n: 7, kl: 3, ku: 2},
+		{m: 6, n: 11, kl: 1, ku: 4},
+		{m: 9, n: 9, kl: 10, ku: 10},
+	} {
+		m, n, kl, ku := test.m, test.n, test.kl, test.ku
+		name := fmt.Sprintf("%+v", test)
+		band, dense := randomBand(rnd, m, n, kl, ku)
+		ab, ldab := bandWithFill(band)
+		ipiv := make([]int, min(m, n))
+		if !impl.Dgbtrf(m, n, kl, ku, ab, ldab, ipiv) {
+			t.Errorf("%s: unexpected singular matrix", name)
+			continue
+		}
+
+		// The band factorization chooses the same pivots as the dense one,
+		// and U is the upper trapezoid of the dense factors.
+		lu := cloneGeneral(dense)
+		wantIpiv := make([]int, min(m, n))
+		impl.Dgetrf(m, n, lu.Data, lu.Stride, wantIpiv)
+		for i, v := range ipiv {
+			if v != wantIpiv[i] {
+				t.Errorf("%s: pivot mismatch: got %v, want %v", name, ipiv, wantIpiv)
+				break
+			}
+		}
+		var diff float64
+		for i := 0; i < min(m, n); i++ {
+			for j := i; j < n; j++ {
+				want := lu.Data[i*lu.Stride+j]
+				var got float64
+				if j-i <= kl+ku {
+					got = ab[i*ldab+kl+j-i]
+				}
+				diff = math.Max(diff, math.Abs(got-want))
+			}
+		}
+		if diff > tol {
+			t.Errorf("%s: U mismatch: %v", name, diff)
+		}
+	}
+}
+
+func TestDgbsv(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		n, kl, ku, nrhs int
+	}{
+		{n: 1, kl: 0, ku: 0, nrhs: 1},
+		{n: 10, kl: 1, ku: 1, nrhs: 2},
+		{n: 17, kl: 3, ku: 0, nrhs: 3},
+		{n: 8, kl: 2, ku: 9, nrhs: 1},
+	} {
+		n, kl, ku, nrhs := test.n, test.kl, test.ku, test.nrhs
+		name := fmt.Sprintf("%+v", test)
+		band, dense := randomBand(rnd, n, n, kl, ku)
+		b := randomGeneral(rnd, n, nrhs, nrhs)
+
+		ab, ldab := bandWithFill(band)
+		ipiv := make([]int, n)
+		x := cloneGeneral(b)
+		if !impl.Dgbsv(n, kl, ku, nrhs, ab, ldab, ipiv, x.Data, x.Stride) {
+			t.Errorf("%s: unexpected singular matrix", name)
+			continue
+		}
+		if res := residual(blas.NoTrans, dense, x, b); res > tol {
+			t.Errorf("%s: residual too large: %v", name, res)
+		}
+
+		// The returned factors solve the transposed system with Dgbtrs.
+		xt := cloneGeneral(b)
+		impl.Dgbtrs(blas.Trans, n, kl, ku, nrhs, ab, ldab, ipiv, xt.Data, xt.Stride)
+		if res := residual(blas.Trans, dense, xt, b); res > tol {
+			t.Errorf("%s: residual of Dgbtrs too large: %v", name, res)
+		}
+	}
+}
+
+func TestDpbsv(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, test := range []struct {
+			n, kd, nrhs int
+		}{
+			{n: 1, kd: 0, nrhs: 1},
+			{n: 12, kd: 1, nrhs: 2},
+			{n: 20, kd: 4, nrhs: 3},
+			{n: 5, kd: 7, nrhs: 1},
+		} {
+			n, kd, nrhs := test.n, test.kd, test.nrhs
+			name := fmt.Sprintf("uplo=%c,%+v", uplo, test)
+			a, dense := symBand(randomSPDBand(rnd, n, kd), kd, uplo)
+			b := randomGeneral(rnd, n, nrhs, nrhs)
+			x := cloneGeneral(b)
+			if !impl.Dpbsv(uplo, n, kd, nrhs, a.Data, a.Stride, x.Data, x.Stride) {
+				t.Errorf("%s: unexpected failure", name)
+				continue
+			}
+			if res := residual(blas.NoTrans, dense, x, b); res > tol {
+				t.Errorf("%s: residual too large: %v", name, res)
+			}
+		}
+	}
+}
+
+func TestBandSolve(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		n, kl, ku, nrhs int
+	}{
+		{n: 0, kl: 1, ku: 1, nrhs: 1},
+		{n: 1, kl: 0, ku: 0, nrhs: 1},
+		{n: 40, kl: 1, ku: 1, nrhs: 2},
+		{n: 13, kl: 2, ku: 5, nrhs: 0},
+		{n: 25, kl: 4, ku: 3, nrhs: 3},
+	} {
+		name := fmt.Sprintf("%+v", test)
+		a, dense := randomBand(rnd, test.n, test.n, test.kl, test.ku)
+		b := randomGeneral(rnd, test.n, test.nrhs, max(1, test.nrhs))
+		aCopy := make([]float64, len(a.Data))
+		copy(aCopy, a.Data)
+		bCopy := cloneGeneral(b)
+
+		x, err := BandSolve(a, b)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if !floats.Same(a.Data, aCopy) || !floats.Equal(cloneGeneral(b).Data, bCopy.Data) {
+			t.Errorf("%s: inputs modified", name)
+		}
+		if x.Rows != test.n || x.Cols != test.nrhs {
+			t.Errorf("%s: unexpected result shape", name)
+			continue
+		}
+		if test.n == 0 || test.nrhs == 0 {
+			continue
+		}
+		if res := residual(blas.NoTrans, dense, x, b); res > tol {
+			t.Errorf("%s: residual too large: %v", name, res)
+		}
+	}
+
+	// The tridiagonal matrix has a zero second row.
+	a := blas64.Band{
+		Rows: 3, Cols: 3, KL: 1, KU: 1, Stride: 3,
+		Data: []float64{
+			0, 1, 2,
+			0, 0, 0,
+			1, 2, 0,
+		},
+	}
+	b := blas64.General{Rows: 3, Cols: 1, Stride: 1, Data: []float64{1, 2, 3}}
+	if _, err := BandSolve(a, b); err == nil {
+		t.Errorf("no error for singular matrix")
+	} else if _, ok := err.(SingularError); !ok {
+		t.Errorf("unexpected error for singular matrix: %v", err)
+	}
+}
+
+func TestSPDBandSolve(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, test := range []struct {
+			n, kd, nrhs int
+		}{
+			{n: 0, kd: 1, nrhs: 1},
+			{n: 30, kd: 1, nrhs: 2},
+			{n: 16, kd: 5, nrhs: 3},
+		} {
+			name := fmt.Sprintf("uplo=%c,%+v", uplo, test)
+			a, dense := symBand(randomSPDBand(rnd, test.n, test.kd), test.kd, uplo)
+			b := randomGeneral(rnd, test.n, test.nrhs, test.nrhs)
+			aCopy := make([]float64, len(a.Data))
+			copy(aCopy, a.Data)
+
+			x, err := SPDBandSolve(a, b)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			if !floats.Equal(a.Data, aCopy) {
+				t.Errorf("%s: a modified", name)
+			}
+			if test.n == 0 {
+				continue
+			}
+			if res := residual(blas.NoTrans, dense, x, b); res > tol {
+				t.Errorf("%s: residual too large: %v", name, res)
+			}
+		}
+
+		// The third leading minor is not positive definite.
+		a, _ := symBand(randomSPDBand(rnd, 6, 1), 1, uplo)
+		if uplo == blas.Upper {
+			a.Data[2*a.Stride] = -1
+		} else {
+			a.Data[2*a.Stride+1] = -1
+		}
+		b := newGeneral(6, 1)
+		_, err := SPDBandSolve(a, b)
+		if e, ok := err.(ErrNotPositiveDefinite); !ok || e.Index != 2 {
+			t.Errorf("uplo=%c: unexpected error for indefinite matrix: %v", uplo, err)
+		}
+	}
+}
@@ -0,0 +1,256 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"runtime"
+	"sync"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+
+	blasnetlib "gonum.org/v1/netlib/blas/netlib"
+)
+
+// Batch processes many independent matrices concurrently with a pool of
+// worker goroutines. Each worker keeps its own workspace, which is reused
+// for every matrix it processes, so that a batch of matrices of similar size
+// performs few allocations beyond those of the results.
+//
+// The methods of Batch read matrices from an input channel until it is
+// closed and send one result per matrix on the returned channel, which is
+// closed after the last result has been sent. Results are sent in
+// completion order; the Index field of a result is the position of its
+// matrix in the input. The input matrices must not be modified until their
+// results have been received.
+//
+// Invalid matrices cause a panic in the worker goroutine, as they would in
+// the corresponding single matrix functions.
+type Batch struct {
+	// Workers is the number of worker goroutines. If Workers is zero,
+	// runtime.GOMAXPROCS(0) is used if the backend library is known to be
+	// thread-safe, as reported by the CapThreadSafe capability of
+	// gonum.org/v1/netlib/blas/netlib, and a single worker otherwise.
+	Workers int
+
+	// SetThreads, if not nil, is called with 1 before the workers start
+	// and with its previous return value after all workers have finished.
+	// It should set the number of threads used by the backend library and
+	// return the previous setting, so that the workers do not
+	// oversubscribe the processors with backend threads, for example
+	//  func(n int) int {
+	//  	prev := NumThreads()
+	//  	SetNumThreads(n)
+	//  	return prev
+	//  }
+	SetThreads func(n int) int
+}
+
+// SymEigResult is the result of a symmetric eigendecomposition computed by
+// Batch.SymEig.
+type SymEigResult struct {
+	Index   int
+	Values  []float64
+	Vectors blas64.General
+	Err     error
+}
+
+// SVDResult is the result of a thin singular value decomposition
+//  A = U * Σ * V^T
+// computed by Batch.SVD. S holds the singular values in decreasing order.
+type SVDResult struct {
+	Index int
+	S     []float64
+	U, VT blas64.General
+	Err   error
+}
+
+// PInvResult is the result of a pseudo-inverse computed by Batch.PInv.
+type PInvResult struct {
+	Index int
+	PInv  blas64.General
+	Rank  int
+	Err   error
+}
+
+// batchWorkspace is the memory owned by one worker, or borrowed from
+// workspaces by a single matrix function.
+type batchWorkspace struct {
+	workspace
+	a   []float64
+	a32 []float32
+}
+
+// workspaces holds the scratch memory of the single matrix functions between
+// calls, so that repeated calls with matrices of similar size reuse the copy
+// of the input and the LAPACK work arrays instead of allocating them anew.
+// Unused memory is released by the garbage collector as for any sync.Pool.
+var workspaces = sync.Pool{
+	New: func() interface{} { return new(batchWorkspace) },
+}
+
+// getWorkspace returns scratch memory from workspaces. It must be released
+// with putWorkspace once no slice taken from it is referenced.
+func getWorkspace() *batchWorkspace {
+	return workspaces.Get().(*batchWorkspace)
+}
+
+// putWorkspace releases ws to workspaces.
+func putWorkspace(ws *batchWorkspace) {
+	workspaces.Put(ws)
+}
+
+// general returns a copy of a with a compact stride in the worker's scratch
+// memory.
+func (w *batchWorkspace) general(a blas64.General) blas64.General {
+	n := a.Rows * a.Cols
+	if cap(w.a) < n {
+		w.a = make([]float64, n)
+	}
+	c := blas64.General{Rows: a.Rows, Cols: a.Cols, Stride: max(1, a.Cols), Data: w.a[:n]}
+	for i := 0; i < a.Rows && a.Cols > 0; i++ {
+		copy(c.Data[i*c.Stride:i*c.Stride+a.Cols], a.Data[i*a.Stride:i*a.Stride+a.Cols])
+	}
+	return c
+}
+
+// SymEig computes the eigendecomposition of each symmetric matrix received
+// from in as described for the function SymEig.
+func (b Batch) SymEig(in <-chan blas64.Symmetric) <-chan SymEigResult {
+	type job struct {
+		index int
+		a     blas64.Symmetric
+	}
+	jobs := make(chan job)
+	go func() {
+		var i int
+		for a := range in {
+			jobs <- job{index: i, a: a}
+			i++
+		}
+		close(jobs)
+	}()
+
+	out := make(chan SymEigResult, b.workers())
+	b.run(func(ws *batchWorkspace) {
+		for j := range jobs {
+			a := j.a
+			if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+				panic(badUplo)
+			}
+			ac := ws.general(blas64.General{Rows: a.N, Cols: a.N, Stride: a.Stride, Data: a.Data})
+			w, v, ok := symEig(DefaultDrivers.Select(SymEigProblem, a.N, a.N), blas64.Symmetric{
+				Uplo:   a.Uplo,
+				N:      a.N,
+				Stride: ac.Stride,
+				Data:   ac.Data,
+			}, &ws.workspace)
+			r := SymEigResult{Index: j.index, Values: w, Vectors: v}
+			if !ok {
+				r.Err = ErrIterationLimit
+			}
+			out <- r
+		}
+	}, func() { close(out) })
+	return out
+}
+
+// SVD computes the thin singular value decomposition of each matrix received
+// from in, using the driver chosen by DefaultDrivers.
+func (b Batch) SVD(in <-chan blas64.General) <-chan SVDResult {
+	jobs := b.dispatch(in)
+	out := make(chan SVDResult, b.workers())
+	b.run(func(ws *batchWorkspace) {
+		for j := range jobs {
+			m, n := j.a.Rows, j.a.Cols
+			k := min(m, n)
+			s, u, vt, ok := svd(DefaultDrivers.Select(SVDProblem, m, n), ws.general(j.a), 'S', &ws.workspace)
+			r := SVDResult{
+				Index: j.index,
+				S:     s,
+				U:     blas64.General{Rows: m, Cols: k, Stride: max(1, k), Data: u},
+				VT:    blas64.General{Rows: k, Cols: n, Stride: max(1, n), Data: vt},
+			}
+			if !ok {
+				r.Err = ErrIterationLimit
+			}
+			out <- r
+		}
+	}, func() { close(out) })
+	return out
+}
+
+// PInv computes the pseudo-inverse of each matrix received from in as
+// described for the function PInv.
+func (b Batch) PInv(in <-chan blas64.General, rcond float64) <-chan PInvResult {
+	if rcond < 0 {
+		panic("lapack: negative rcond")
+	}
+	jobs := b.dispatch(in)
+	out := make(chan PInvResult, b.workers())
+	b.run(func(ws *batchWorkspace) {
+		for j := range jobs {
+			p, rank, err := pinv(ws.general(j.a), rcond, &ws.workspace)
+			out <- PInvResult{Index: j.index, PInv: p, Rank: rank, Err: err}
+		}
+	}, func() { close(out) })
+	return out
+}
+
+// generalJob is a general matrix and its position in the input of a batch.
+type generalJob struct {
+	index int
+	a     blas64.General
+}
+
+// dispatch numbers the matrices received from in and sends them on the
+// returned channel, which is closed when in is closed.
+func (b Batch) dispatch(in <-chan blas64.General) <-chan generalJob {
+	jobs := make(chan generalJob)
+	go func() {
+		var i int
+		for a := range in {
+			jobs <- generalJob{index: i, a: a}
+			i++
+		}
+		close(jobs)
+	}()
+	return jobs
+}
+
+func (b Batch) workers() int {
+	if b.Workers > 0 {
+		return b.Workers
+	}
+	if !blasnetlib.Capabilities().Has(blasnetlib.CapThreadSafe) {
+		return 1
+	}
+	return runtime.GOMAXPROCS(0)
+}
+
+// run starts the workers, each calling work with its own workspace, and
+// calls done after all of them have returned.
+func (b Batch) run(work func(ws *batchWorkspace), done func()) {
+	n := b.workers()
+	var prev int
+	if b.SetThreads != nil {
+		prev = b.SetThreads(1)
+	}
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for i := 0; i < n; i++ {
+		go func() {
+			defer wg.Done()
+			work(&batchWorkspace{})
+		}()
+	}
+	go func() {
+		wg.Wait()
+		if b.SetThreads != nil {
+			b.SetThreads(prev)
+		}
+		done()
+	}()
+}
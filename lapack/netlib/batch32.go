@@ -0,0 +1,149 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+)
+
+// SymEigResult32 is the float32 version of SymEigResult.
+type SymEigResult32 struct {
+	Index   int
+	Values  []float32
+	Vectors blas32.General
+	Err     error
+}
+
+// SVDResult32 is the float32 version of SVDResult.
+type SVDResult32 struct {
+	Index int
+	S     []float32
+	U, VT blas32.General
+	Err   error
+}
+
+// PInvResult32 is the float32 version of PInvResult.
+type PInvResult32 struct {
+	Index int
+	PInv  blas32.General
+	Rank  int
+	Err   error
+}
+
+// general32 is the float32 version of general.
+func (w *batchWorkspace) general32(a blas32.General) blas32.General {
+	n := a.Rows * a.Cols
+	if cap(w.a32) < n {
+		w.a32 = make([]float32, n)
+	}
+	c := blas32.General{Rows: a.Rows, Cols: a.Cols, Stride: max(1, a.Cols), Data: w.a32[:n]}
+	for i := 0; i < a.Rows && a.Cols > 0; i++ {
+		copy(c.Data[i*c.Stride:i*c.Stride+a.Cols], a.Data[i*a.Stride:i*a.Stride+a.Cols])
+	}
+	return c
+}
+
+// SymEig32 computes the eigendecomposition of each symmetric matrix received
+// from in as described for the function SymEig32.
+func (b Batch) SymEig32(in <-chan blas32.Symmetric) <-chan SymEigResult32 {
+	type job struct {
+		index int
+		a     blas32.Symmetric
+	}
+	jobs := make(chan job)
+	go func() {
+		var i int
+		for a := range in {
+			jobs <- job{index: i, a: a}
+			i++
+		}
+		close(jobs)
+	}()
+
+	out := make(chan SymEigResult32, b.workers())
+	b.run(func(ws *batchWorkspace) {
+		for j := range jobs {
+			a := j.a
+			if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+				panic(badUplo)
+			}
+			ac := ws.general32(blas32.General{Rows: a.N, Cols: a.N, Stride: a.Stride, Data: a.Data})
+			w, v, ok := symEig32(DefaultDrivers.Select(SymEigProblem, a.N, a.N), blas32.Symmetric{
+				Uplo:   a.Uplo,
+				N:      a.N,
+				Stride: ac.Stride,
+				Data:   ac.Data,
+			}, &ws.workspace)
+			r := SymEigResult32{Index: j.index, Values: w, Vectors: v}
+			if !ok {
+				r.Err = ErrIterationLimit
+			}
+			out <- r
+		}
+	}, func() { close(out) })
+	return out
+}
+
+// SVD32 is the float32 version of SVD.
+func (b Batch) SVD32(in <-chan blas32.General) <-chan SVDResult32 {
+	jobs := b.dispatch32(in)
+	out := make(chan SVDResult32, b.workers())
+	b.run(func(ws *batchWorkspace) {
+		for j := range jobs {
+			m, n := j.a.Rows, j.a.Cols
+			k := min(m, n)
+			s, u, vt, ok := svd32(DefaultDrivers.Select(SVDProblem, m, n), ws.general32(j.a), 'S', &ws.workspace)
+			r := SVDResult32{
+				Index: j.index,
+				S:     s,
+				U:     blas32.General{Rows: m, Cols: k, Stride: max(1, k), Data: u},
+				VT:    blas32.General{Rows: k, Cols: n, Stride: max(1, n), Data: vt},
+			}
+			if !ok {
+				r.Err = ErrIterationLimit
+			}
+			out <- r
+		}
+	}, func() { close(out) })
+	return out
+}
+
+// PInv32 computes the pseudo-inverse of each matrix received from in as
+// described for the function PInv32.
+func (b Batch) PInv32(in <-chan blas32.General, rcond float32) <-chan PInvResult32 {
+	if rcond < 0 {
+		panic("lapack: negative rcond")
+	}
+	jobs := b.dispatch32(in)
+	out := make(chan PInvResult32, b.workers())
+	b.run(func(ws *batchWorkspace) {
+		for j := range jobs {
+			p, rank, err := pinv32(ws.general32(j.a), rcond, &ws.workspace)
+			out <- PInvResult32{Index: j.index, PInv: p, Rank: rank, Err: err}
+		}
+	}, func() { close(out) })
+	return out
+}
+
+// generalJob32 is the float32 version of generalJob.
+type generalJob32 struct {
+	index int
+	a     blas32.General
+}
+
+// dispatch32 is the float32 version of dispatch.
+func (b Batch) dispatch32(in <-chan blas32.General) <-chan generalJob32 {
+	jobs := make(chan generalJob32)
+	go func() {
+		var i int
+		for a := range in {
+			jobs <- generalJob32{index: i, a: a}
+			i++
+		}
+		close(jobs)
+	}()
+	return jobs
+}
@@ -0,0 +1,135 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestBatchSymEig(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	var mats []blas64.Symmetric
+	for i := 0; i < 40; i++ {
+		a := randomSPD(rnd, 1+rnd.Intn(12), 12)
+		if i%2 == 1 {
+			a.Uplo = blas.Lower
+		}
+		mats = append(mats, a)
+	}
+
+	var threads []int
+	b := Batch{
+		Workers: 4,
+		SetThreads: func(n int) int {
+			threads = append(threads, n)
+			return 8
+		},
+	}
+	in := make(chan blas64.Symmetric)
+	go func() {
+		for _, a := range mats {
+			in <- a
+		}
+		close(in)
+	}()
+	seen := make([]bool, len(mats))
+	for r := range b.SymEig(in) {
+		if r.Index < 0 || r.Index >= len(mats) || seen[r.Index] {
+			t.Errorf("unexpected result index %d", r.Index)
+			continue
+		}
+		seen[r.Index] = true
+		if r.Err != nil {
+			t.Errorf("matrix %d: unexpected error: %v", r.Index, r.Err)
+			continue
+		}
+		want, _, err := SymEig(mats[r.Index])
+		if err != nil {
+			t.Fatalf("matrix %d: unexpected error from SymEig: %v", r.Index, err)
+		}
+		if !floats.EqualApprox(r.Values, want, tol) {
+			t.Errorf("matrix %d: unexpected eigenvalues: got %v want %v", r.Index, r.Values, want)
+		}
+	}
+	for i, ok := range seen {
+		if !ok {
+			t.Errorf("missing result for matrix %d", i)
+		}
+	}
+	if len(threads) != 2 || threads[0] != 1 || threads[1] != 8 {
+		t.Errorf("unexpected thread settings: %v", threads)
+	}
+}
+
+func TestBatchSVDPInv(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	var mats []blas64.General
+	for i := 0; i < 30; i++ {
+		m := rnd.Intn(10)
+		n := rnd.Intn(10)
+		mats = append(mats, randomGeneral(rnd, m, n, max(1, n)))
+	}
+	feed := func() <-chan blas64.General {
+		in := make(chan blas64.General, len(mats))
+		for _, a := range mats {
+			in <- a
+		}
+		close(in)
+		return in
+	}
+
+	var count int
+	for r := range (Batch{}).SVD(feed()) {
+		count++
+		if r.Err != nil {
+			t.Errorf("matrix %d: unexpected error: %v", r.Index, r.Err)
+			continue
+		}
+		a := mats[r.Index]
+		us := cloneGeneral(r.U)
+		for i := 0; i < us.Rows; i++ {
+			for j := 0; j < us.Cols; j++ {
+				us.Data[i*us.Stride+j] *= r.S[j]
+			}
+		}
+		got := newGeneral(a.Rows, a.Cols)
+		if len(r.S) > 0 {
+			got = mul(us, r.VT)
+		}
+		if d := maxDiff(got, a, false); d > tol {
+			t.Errorf("matrix %d: reconstruction error %v", r.Index, d)
+		}
+	}
+	if count != len(mats) {
+		t.Errorf("unexpected number of SVD results: got %d want %d", count, len(mats))
+	}
+
+	count = 0
+	for r := range (Batch{Workers: 3}).PInv(feed(), DefaultRCond) {
+		count++
+		want, rank, err := PInv(mats[r.Index], DefaultRCond)
+		if err != nil || r.Err != nil {
+			t.Errorf("matrix %d: unexpected error: %v, %v", r.Index, r.Err, err)
+			continue
+		}
+		if r.Rank != rank {
+			t.Errorf("matrix %d: unexpected rank: got %d want %d", r.Index, r.Rank, rank)
+		}
+		if !floats.EqualApprox(r.PInv.Data, want.Data, tol) {
+			t.Errorf("matrix %d: unexpected pseudo-inverse", r.Index)
+		}
+	}
+	if count != len(mats) {
+		t.Errorf("unexpected number of PInv results: got %d want %d", count, len(mats))
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// SymEigBisect computes the eigenvalues of the symmetric n×n matrix A with
+// indices il through iu-1 in ascending order and the corresponding
+// orthonormal eigenvectors, returned as the columns of the n×(iu-il) matrix
+// v, as described for SymEigRange.
+//
+// The eigenpairs are computed by the pipeline of the expert driver Dsyevx:
+// A is reduced to tridiagonal form T = Q^T * A * Q by Dsytrd, the selected
+// eigenvalues of T are found by bisection with Dstebz, their eigenvectors by
+// inverse iteration with Dstein, and these are transformed back to those of
+// A by Dormtr. Apart from the copy of A and the results, only O(n) memory is
+// needed, which is less than Dsyevr uses, so SymEigBisect is suited to
+// computing a few eigenpairs of a large matrix with little memory to spare.
+// Inverse iteration may lose orthogonality between eigenvectors of tightly
+// clustered eigenvalues, for which SymEigRange is more robust.
+//
+// SymEigBisect panics unless 0 <= il <= iu <= n. The input a is not
+// modified. If bisection or inverse iteration fails to converge,
+// SymEigBisect returns ErrIterationLimit.
+func SymEigBisect(a blas64.Symmetric, il, iu int) (w []float64, v blas64.General, err error) {
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	if il < 0 || iu < il || a.N < iu {
+		panic(badEVIndex)
+	}
+	n := a.N
+	k := iu - il
+	if k == 0 {
+		return nil, newGeneral(n, 0), nil
+	}
+	uplo := byte(a.Uplo)
+	c := cloneGeneral(blas64.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data})
+
+	// Reduce A to tridiagonal form.
+	d := make([]float64, n)
+	e := make([]float64, max(1, n-1))
+	tau := make([]float64, max(1, n-1))
+	work := make([]float64, 1)
+	lapacke.Dsytrd(uplo, n, c.Data, c.Stride, d, e, tau, work, -1)
+	lwork := max(5*n, int(work[0]))
+	work = make([]float64, lwork)
+	lapacke.Dsytrd(uplo, n, c.Data, c.Stride, d, e, tau, work, lwork)
+
+	// Compute the selected eigenvalues of T by bisection, ordered by
+	// splitting block as required by Dstein.
+	var m, nsplit [1]lapacke.Int
+	w = make([]float64, n)
+	iblock := make([]lapacke.Int, n)
+	isplit := make([]lapacke.Int, n)
+	iwork := make([]lapacke.Int, 3*n)
+	if !lapacke.Dstebz('I', 'B', n, 0, 0, il+1, iu, 2*dlamchS, d, e, m[:], nsplit[:], w, iblock, isplit, work, iwork) {
+		return nil, newGeneral(n, 0), ErrIterationLimit
+	}
+	k = int(m[0])
+	w = w[:k]
+
+	// Compute the eigenvectors of T by inverse iteration and transform them
+	// back to those of A.
+	v = newGeneral(n, k)
+	ifail := make([]lapacke.Int, k)
+	if !lapacke.Dstein(n, d, e, k, w, iblock, isplit, v.Data, v.Stride, work, iwork[:n], ifail) {
+		return nil, v, ErrIterationLimit
+	}
+	lapacke.Dormtr('L', uplo, 'N', n, k, c.Data, c.Stride, tau, v.Data, v.Stride, work, -1)
+	if lw := int(work[0]); lw > len(work) {
+		work = make([]float64, lw)
+	}
+	lapacke.Dormtr('L', uplo, 'N', n, k, c.Data, c.Stride, tau, v.Data, v.Stride, work, len(work))
+
+	// Sort the eigenpairs into ascending order, since eigenvalues from
+	// different blocks of T are interleaved.
+	for j := 0; j < k-1; j++ {
+		p := j
+		for i := j + 1; i < k; i++ {
+			if w[i] < w[p] {
+				p = i
+			}
+		}
+		if p != j {
+			w[j], w[p] = w[p], w[j]
+			blasImpl.Dswap(n, v.Data[j:], v.Stride, v.Data[p:], v.Stride)
+		}
+	}
+	return w, v, nil
+}
@@ -0,0 +1,81 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// SymEigBisect32 is the float32 version of SymEigBisect. The eigenpairs are
+// computed by Ssytrd, Sstebz, Sstein and Sormtr.
+func SymEigBisect32(a blas32.Symmetric, il, iu int) (w []float32, v blas32.General, err error) {
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	if il < 0 || iu < il || a.N < iu {
+		panic(badEVIndex)
+	}
+	n := a.N
+	k := iu - il
+	if k == 0 {
+		return nil, newGeneral32(n, 0), nil
+	}
+	uplo := byte(a.Uplo)
+	c := cloneGeneral32(blas32.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data})
+
+	// Reduce A to tridiagonal form.
+	d := make([]float32, n)
+	e := make([]float32, max(1, n-1))
+	tau := make([]float32, max(1, n-1))
+	work := make([]float32, 1)
+	lapacke.Ssytrd(uplo, n, c.Data, c.Stride, d, e, tau, work, -1)
+	lwork := max(5*n, int(work[0]))
+	work = make([]float32, lwork)
+	lapacke.Ssytrd(uplo, n, c.Data, c.Stride, d, e, tau, work, lwork)
+
+	// Compute the selected eigenvalues of T by bisection, ordered by
+	// splitting block as required by Dstein.
+	var m, nsplit [1]lapacke.Int
+	w = make([]float32, n)
+	iblock := make([]lapacke.Int, n)
+	isplit := make([]lapacke.Int, n)
+	iwork := make([]lapacke.Int, 3*n)
+	if !lapacke.Sstebz('I', 'B', n, 0, 0, il+1, iu, 2*slamchS, d, e, m[:], nsplit[:], w, iblock, isplit, work, iwork) {
+		return nil, newGeneral32(n, 0), ErrIterationLimit
+	}
+	k = int(m[0])
+	w = w[:k]
+
+	// Compute the eigenvectors of T by inverse iteration and transform them
+	// back to those of A.
+	v = newGeneral32(n, k)
+	ifail := make([]lapacke.Int, k)
+	if !lapacke.Sstein(n, d, e, k, w, iblock, isplit, v.Data, v.Stride, work, iwork[:n], ifail) {
+		return nil, v, ErrIterationLimit
+	}
+	lapacke.Sormtr('L', uplo, 'N', n, k, c.Data, c.Stride, tau, v.Data, v.Stride, work, -1)
+	if lw := int(work[0]); lw > len(work) {
+		work = make([]float32, lw)
+	}
+	lapacke.Sormtr('L', uplo, 'N', n, k, c.Data, c.Stride, tau, v.Data, v.Stride, work, len(work))
+
+	// Sort the eigenpairs into ascending order, since eigenvalues from
+	// different blocks of T are interleaved.
+	for j := 0; j < k-1; j++ {
+		p := j
+		for i := j + 1; i < k; i++ {
+			if w[i] < w[p] {
+				p = i
+			}
+		}
+		if p != j {
+			w[j], w[p] = w[p], w[j]
+			blasImpl.Sswap(n, v.Data[j:], v.Stride, v.Data[p:], v.Stride)
+		}
+	}
+	return w, v, nil
+}
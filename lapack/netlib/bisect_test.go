@@ -0,0 +1,100 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestSymEigBisect(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		n, il, iu int
+		uplo      blas.Uplo
+	}{
+		{n: 0, il: 0, iu: 0, uplo: blas.Upper},
+		{n: 1, il: 0, iu: 1, uplo: blas.Lower},
+		{n: 8, il: 0, iu: 3, uplo: blas.Upper},
+		{n: 8, il: 5, iu: 8, uplo: blas.Lower},
+		{n: 20, il: 7, iu: 7, uplo: blas.Upper},
+		{n: 20, il: 0, iu: 20, uplo: blas.Lower},
+	} {
+		name := fmt.Sprintf("n=%d,il=%d,iu=%d,uplo=%c", test.n, test.il, test.iu, test.uplo)
+		lambda := evrSpectrum(test.n)
+		a := symmetricWithSpectrum(rnd, lambda, test.uplo)
+		orig := append([]float64(nil), a.Data...)
+
+		w, v, err := SymEigBisect(a, test.il, test.iu)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if !floats.Equal(a.Data, orig) {
+			t.Errorf("%s: input modified", name)
+		}
+		want := lambda[test.il:test.iu]
+		if len(w) != len(want) || v.Rows != test.n || v.Cols != len(want) {
+			t.Errorf("%s: unexpected result shape: len(w)=%d, v %d×%d", name, len(w), v.Rows, v.Cols)
+			continue
+		}
+		for i := range w {
+			if math.Abs(w[i]-want[i]) > tol*float64(test.n) {
+				t.Errorf("%s: w[%d] = %v, want %v", name, i, w[i], want[i])
+			}
+		}
+		if r := eigResidual(a, w, v); r > tol*float64(test.n*test.n) {
+			t.Errorf("%s: residual %v too large", name, r)
+		}
+		if e := orthonormalityError(v); e > tol*float64(test.n) {
+			t.Errorf("%s: eigenvectors not orthonormal: %v", name, e)
+		}
+	}
+}
+
+// TestSymEigBisectSplit checks that the eigenpairs are sorted when the
+// tridiagonal matrix splits into blocks whose eigenvalues interleave.
+func TestSymEigBisectSplit(t *testing.T) {
+	diag := []float64{5, -1, 3, 0, 4, 2}
+	n := len(diag)
+	a := blas64.Symmetric{Uplo: blas.Upper, N: n, Stride: n, Data: make([]float64, n*n)}
+	for i, d := range diag {
+		a.Data[i*n+i] = d
+	}
+	w, v, err := SymEigBisect(a, 1, 5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !floats.EqualApprox(w, []float64{0, 2, 3, 4}, 1e-14) {
+		t.Errorf("unexpected eigenvalues: %v", w)
+	}
+	if r := eigResidual(a, w, v); r > 1e-14 {
+		t.Errorf("residual %v too large", r)
+	}
+}
+
+func TestSymEigBisect32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	const n = 10
+	lambda := evrSpectrum(n)
+	a := symmetricWithSpectrum(rnd, lambda, blas.Lower)
+	a32 := symmetric32(a)
+
+	w, v, err := SymEigBisect32(a32, 2, 6)
+	if err != nil || v.Rows != n || v.Cols != 4 {
+		t.Fatalf("unexpected SymEigBisect32 result: err=%v, %d columns", err, v.Cols)
+	}
+	if d := maxDiff32(w, lambda[2:6]); d > tol32 {
+		t.Errorf("unexpected SymEigBisect32 eigenvalues: got %v, want %v", w, lambda[2:6])
+	}
+}
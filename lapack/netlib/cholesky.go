@@ -0,0 +1,130 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// ErrNotPositiveDefinite is returned when a Cholesky factorization fails
+// because the matrix is not positive definite.
+type ErrNotPositiveDefinite struct {
+	// Index is the zero-based index of the failing pivot. The leading
+	// minor of order Index+1 is not positive definite.
+	Index int
+}
+
+func (e ErrNotPositiveDefinite) Error() string {
+	return fmt.Sprintf("lapack: matrix is not positive definite: leading minor of order %d", e.Index+1)
+}
+
+// Jitter specifies how CholeskySPD retries a failed factorization by adding
+// an increasing ridge to the diagonal of the matrix.
+type Jitter struct {
+	// Ridge is the value added to the diagonal for the first retry. If
+	// Ridge is zero, 1e-10 times the mean absolute value of the diagonal
+	// of A is used, or 1e-10 if the diagonal is zero.
+	Ridge float64
+
+	// Growth is the factor by which the ridge is multiplied for each
+	// subsequent retry. If Growth is zero, 10 is used.
+	Growth float64
+
+	// MaxTries is the maximum number of retries. If MaxTries is zero,
+	// 6 is used.
+	MaxTries int
+}
+
+// CholeskySPD computes the Cholesky factorization of the symmetric positive
+// definite matrix A,
+//  A = U^T * U  if a.Uplo == blas.Upper,
+//  A = L * L^T  if a.Uplo == blas.Lower,
+// and returns the triangular factor stored in the same triangle as A. The
+// input a is not modified.
+//
+// If A is not positive definite and jitter is nil, CholeskySPD returns an
+// ErrNotPositiveDefinite holding the failing pivot. If jitter is not nil, the
+// factorization is retried for A + ridge*I with the ridge chosen according to
+// jitter, and the ridge of the successful factorization is returned. If all
+// retries fail, the error of the last attempt is returned.
+func CholeskySPD(a blas64.Symmetric, jitter *Jitter) (t blas64.Triangular, ridge float64, err error) {
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	n := a.N
+	t = blas64.Triangular{
+		Uplo:   a.Uplo,
+		Diag:   blas.NonUnit,
+		N:      n,
+		Stride: max(1, n),
+		Data:   make([]float64, n*n),
+	}
+
+	err = cholesky(a, t, 0)
+	if err == nil || jitter == nil {
+		return t, 0, err
+	}
+
+	ridge = jitter.Ridge
+	if ridge == 0 {
+		var sum float64
+		for i := 0; i < n; i++ {
+			sum += math.Abs(a.Data[i*a.Stride+i])
+		}
+		ridge = 1e-10
+		if sum != 0 {
+			ridge *= sum / float64(n)
+		}
+	}
+	growth := jitter.Growth
+	if growth == 0 {
+		growth = 10
+	}
+	tries := jitter.MaxTries
+	if tries == 0 {
+		tries = 6
+	}
+	for i := 0; i < tries; i++ {
+		err = cholesky(a, t, ridge)
+		if err == nil {
+			return t, ridge, nil
+		}
+		ridge *= growth
+	}
+	return t, 0, err
+}
+
+// cholesky stores the Cholesky factor of A + ridge*I into t.
+func cholesky(a blas64.Symmetric, t blas64.Triangular, ridge float64) error {
+	n := a.N
+	for i := 0; i < n; i++ {
+		row := t.Data[i*t.Stride : i*t.Stride+n]
+		for j := range row {
+			row[j] = 0
+		}
+		if a.Uplo == blas.Upper {
+			copy(row[i:], a.Data[i*a.Stride+i:i*a.Stride+n])
+		} else {
+			copy(row[:i+1], a.Data[i*a.Stride:i*a.Stride+i+1])
+		}
+		row[i] += ridge
+	}
+	if n == 0 {
+		return nil
+	}
+	info := lapacke.DpotrfInfo(byte(a.Uplo), n, t.Data, t.Stride)
+	switch {
+	case info < 0:
+		panic("lapack: invalid argument to Dpotrf")
+	case info > 0:
+		return ErrNotPositiveDefinite{Index: info - 1}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// CholeskySPD32 is the float32 version of CholeskySPD. The ridge is chosen
+// as described by jitter and rounded to float32. Since float32 matrices lose
+// definiteness more easily, a larger Jitter.Ridge than the default of 1e-10
+// times the mean diagonal may avoid retries.
+func CholeskySPD32(a blas32.Symmetric, jitter *Jitter) (t blas32.Triangular, ridge float32, err error) {
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	n := a.N
+	t = blas32.Triangular{
+		Uplo:   a.Uplo,
+		Diag:   blas.NonUnit,
+		N:      n,
+		Stride: max(1, n),
+		Data:   make([]float32, n*n),
+	}
+
+	err = cholesky32(a, t, 0)
+	if err == nil || jitter == nil {
+		return t, 0, err
+	}
+
+	r := jitter.Ridge
+	if r == 0 {
+		var sum float64
+		for i := 0; i < n; i++ {
+			sum += math.Abs(float64(a.Data[i*a.Stride+i]))
+		}
+		r = 1e-10
+		if sum != 0 {
+			r *= sum / float64(n)
+		}
+	}
+	growth := jitter.Growth
+	if growth == 0 {
+		growth = 10
+	}
+	tries := jitter.MaxTries
+	if tries == 0 {
+		tries = 6
+	}
+	for i := 0; i < tries; i++ {
+		err = cholesky32(a, t, float32(r))
+		if err == nil {
+			return t, float32(r), nil
+		}
+		r *= growth
+	}
+	return t, 0, err
+}
+
+// cholesky32 is the float32 version of cholesky.
+func cholesky32(a blas32.Symmetric, t blas32.Triangular, ridge float32) error {
+	n := a.N
+	for i := 0; i < n; i++ {
+		row := t.Data[i*t.Stride : i*t.Stride+n]
+		for j := range row {
+			row[j] = 0
+		}
+		if a.Uplo == blas.Upper {
+			copy(row[i:], a.Data[i*a.Stride+i:i*a.Stride+n])
+		} else {
+			copy(row[:i+1], a.Data[i*a.Stride:i*a.Stride+i+1])
+		}
+		row[i] += ridge
+	}
+	if n == 0 {
+		return nil
+	}
+	info := lapacke.SpotrfInfo(byte(a.Uplo), n, t.Data, t.Stride)
+	switch {
+	case info < 0:
+		panic("lapack: invalid argument to Spotrf")
+	case info > 0:
+		return ErrNotPositiveDefinite{Index: info - 1}
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+)
+
+// randomSPD returns a random symmetric positive semi-definite n×n matrix of
+// the given rank, with both triangles stored.
+func randomSPD(rnd *rand.Rand, n, rank int) blas64.Symmetric {
+	x := randomGeneral(rnd, n, rank, max(1, rank))
+	a := newGeneral(n, n)
+	blas64.Gemm(blas.NoTrans, blas.Trans, 1, x, x, 0, a)
+	return blas64.Symmetric{Uplo: blas.Upper, N: n, Stride: a.Stride, Data: a.Data}
+}
+
+// choleskyResidual returns max |A + ridge*I - op(T)^T*op(T)| for the factor T.
+func choleskyResidual(a blas64.Symmetric, t blas64.Triangular, ridge float64) float64 {
+	n := a.N
+	f := newGeneral(n, n)
+	for i := 0; i < n; i++ {
+		copy(f.Data[i*f.Stride:i*f.Stride+n], t.Data[i*t.Stride:i*t.Stride+n])
+	}
+	p := newGeneral(n, n)
+	if t.Uplo == blas.Upper {
+		blas64.Gemm(blas.Trans, blas.NoTrans, 1, f, f, 0, p)
+	} else {
+		blas64.Gemm(blas.NoTrans, blas.Trans, 1, f, f, 0, p)
+	}
+	var res float64
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			want := a.Data[i*a.Stride+j]
+			if i == j {
+				want += ridge
+			}
+			res = math.Max(res, math.Abs(p.Data[i*p.Stride+j]-want))
+		}
+	}
+	return res
+}
+
+func TestCholeskySPD(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 4, 15} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			name := fmt.Sprintf("n=%d,uplo=%c", n, uplo)
+			a := randomSPD(rnd, n, n+2)
+			a.Uplo = uplo
+			aCopy := make([]float64, len(a.Data))
+			copy(aCopy, a.Data)
+
+			f, ridge, err := CholeskySPD(a, nil)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			if ridge != 0 {
+				t.Errorf("%s: unexpected ridge: %v", name, ridge)
+			}
+			if !floats.Equal(a.Data, aCopy) {
+				t.Errorf("%s: input modified", name)
+			}
+			if f.Uplo != uplo {
+				t.Errorf("%s: unexpected triangle", name)
+			}
+			if res := choleskyResidual(a, f, 0); res > tol {
+				t.Errorf("%s: residual too large: %v", name, res)
+			}
+		}
+	}
+}
+
+func TestCholeskySPDNotPositiveDefinite(t *testing.T) {
+	a := blas64.Symmetric{
+		Uplo: blas.Lower, N: 3, Stride: 3,
+		Data: []float64{
+			4, 2, 0,
+			2, 1, 0,
+			0, 0, 1,
+		},
+	}
+	_, _, err := CholeskySPD(a, nil)
+	e, ok := err.(ErrNotPositiveDefinite)
+	if !ok {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e.Index != 1 {
+		t.Errorf("unexpected failing index: got %d want 1", e.Index)
+	}
+
+	// Indefinite matrices are not rescued by a small ridge.
+	a.Data[0] = -1
+	_, _, err = CholeskySPD(a, &Jitter{Ridge: 1e-8, MaxTries: 3})
+	if _, ok := err.(ErrNotPositiveDefinite); !ok {
+		t.Errorf("unexpected error for indefinite matrix with jitter: %v", err)
+	}
+}
+
+func TestCholeskySPDJitter(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	const n = 10
+	for _, jitter := range []*Jitter{
+		{},
+		{Ridge: 1e-12, Growth: 100, MaxTries: 10},
+	} {
+		a := randomSPD(rnd, n, n/2)
+		f, ridge, err := CholeskySPD(a, jitter)
+		if err != nil {
+			t.Errorf("%+v: unexpected error: %v", *jitter, err)
+			continue
+		}
+		if ridge <= 0 {
+			t.Errorf("%+v: expected positive ridge for singular matrix, got %v", *jitter, ridge)
+		}
+		if res := choleskyResidual(a, f, ridge); res > tol {
+			t.Errorf("%+v: residual too large: %v", *jitter, res)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"testing"
+
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/netlib/internal/apicompat"
+)
+
+// lapackeChanges are the entries of the upstream lapacke API that were
+// changed deliberately, with the reason. Only fixes of wrong upstream
+// signatures belong here: code written for gonum.org/v1/netlib must keep
+// compiling, so routines whose callers need more than the upstream result
+// get a separate function, such as lapacke.DpotrfInfo, instead.
+var lapackeChanges = map[string]string{
+	// The bounds vl and vu of the value range were declared as int.
+	"func Cgesvdx(byte, byte, byte, int, int, []complex64, int, int, int, int, int, int, []float32, []complex64, int, []complex64, int, []complex64, int, []float32, []int32) bool":     "vl and vu are floating point",
+	"func Dgesvdx(byte, byte, byte, int, int, []float64, int, int, int, int, int, int, []float64, []float64, int, []float64, int, []float64, int, []int32) bool":                        "vl and vu are floating point",
+	"func Sgesvdx(byte, byte, byte, int, int, []float32, int, int, int, int, int, int, []float32, []float32, int, []float32, int, []float32, int, []int32) bool":                        "vl and vu are floating point",
+	"func Zgesvdx(byte, byte, byte, int, int, []complex128, int, int, int, int, int, int, []float64, []complex128, int, []complex128, int, []complex128, int, []float64, []int32) bool": "vl and vu are floating point",
+}
+
+func TestUpstreamAPI(t *testing.T) {
+	// Int is an alias of int32 unless the ilp64 tag is given.
+	aliases := map[string]string{"Int": "int32"}
+	for _, test := range []struct {
+		dir, list string
+		changes   map[string]string
+	}{
+		{dir: ".", list: "testdata/upstream_api.txt"},
+		{dir: "../lapacke", list: "testdata/upstream_lapacke_api.txt", changes: lapackeChanges},
+	} {
+		missing, err := apicompat.Missing(test.dir, test.list, aliases)
+		if err != nil {
+			t.Fatalf("%s: %v", test.dir, err)
+		}
+		changed := make(map[string]bool)
+		for _, e := range missing {
+			if _, ok := test.changes[e]; !ok {
+				t.Errorf("%s: upstream API not provided: %s", test.dir, e)
+			}
+			changed[e] = true
+		}
+		for e := range test.changes {
+			if !changed[e] {
+				t.Errorf("%s: recorded change not made: %s", test.dir, e)
+			}
+		}
+	}
+
+	var l interface{} = Implementation{}
+	if _, ok := l.(lapack.Float64); !ok {
+		t.Error("Implementation does not satisfy lapack.Float64")
+	}
+	if _, ok := l.(lapack.Complex128); !ok {
+		t.Error("Implementation does not satisfy lapack.Complex128")
+	}
+}
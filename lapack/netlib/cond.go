@@ -0,0 +1,60 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// The condition estimators below return an estimate of the reciprocal
+// condition number 1/(‖A‖ ‖A⁻¹‖) from a factorization that has already been
+// computed, in O(n²) operations. A result close to or below n times the
+// machine epsilon indicates that a solve with the factorization is not
+// reliable and that a rank-revealing method such as LeastSquaresSVD or PInv
+// should be used instead.
+
+// LUCond returns the estimate of the reciprocal condition number of the n×n
+// matrix A computed by Dgecon, where lu holds the LU factorization of A
+// computed by Dgetrf and anorm is the norm of the original A. norm must be
+// lapack.MaxColumnSum or lapack.MaxRowSum and anorm the corresponding norm,
+// as returned by Dlange.
+func LUCond(norm lapack.MatrixNorm, lu blas64.General, anorm float64) float64 {
+	switch {
+	case norm != lapack.MaxColumnSum && norm != lapack.MaxRowSum:
+		panic(badNorm)
+	case lu.Rows != lu.Cols:
+		panic(badShapeA)
+	}
+	n := lu.Rows
+	return lapackImpl.Dgecon(norm, n, lu.Data, max(1, lu.Stride), anorm, make([]float64, 4*n), make([]int, n))
+}
+
+// CholCond returns the estimate of the reciprocal condition number in the
+// 1-norm of the symmetric positive definite matrix A computed by Dpocon,
+// where t is the Cholesky factor of A, as returned by CholeskySPD, and anorm
+// is the 1-norm of the original A.
+func CholCond(t blas64.Triangular, anorm float64) float64 {
+	if t.Uplo != blas.Upper && t.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	n := t.N
+	return lapackImpl.Dpocon(t.Uplo, n, t.Data, max(1, t.Stride), anorm, make([]float64, 3*n), make([]int, n))
+}
+
+// TriangularCond returns the estimate of the reciprocal condition number of
+// the triangular matrix T in the given norm computed by Dtrcon. norm must be
+// lapack.MaxColumnSum or lapack.MaxRowSum.
+func TriangularCond(norm lapack.MatrixNorm, t blas64.Triangular) float64 {
+	switch {
+	case norm != lapack.MaxColumnSum && norm != lapack.MaxRowSum:
+		panic(badNorm)
+	case t.Uplo != blas.Upper && t.Uplo != blas.Lower:
+		panic(badUplo)
+	}
+	n := t.N
+	return lapackImpl.Dtrcon(norm, t.Uplo, t.Diag, n, t.Data, max(1, t.Stride), make([]float64, 3*n), make([]int, n))
+}
@@ -0,0 +1,47 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// LUCond32 is the float32 version of LUCond. The estimate is computed by
+// Sgecon.
+func LUCond32(norm lapack.MatrixNorm, lu blas32.General, anorm float32) float32 {
+	switch {
+	case norm != lapack.MaxColumnSum && norm != lapack.MaxRowSum:
+		panic(badNorm)
+	case lu.Rows != lu.Cols:
+		panic(badShapeA)
+	}
+	n := lu.Rows
+	return lapackImpl.Sgecon(norm, n, lu.Data, max(1, lu.Stride), anorm, make([]float32, 4*n), make([]int, n))
+}
+
+// CholCond32 is the float32 version of CholCond. The estimate is computed
+// by Spocon.
+func CholCond32(t blas32.Triangular, anorm float32) float32 {
+	if t.Uplo != blas.Upper && t.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	n := t.N
+	return lapackImpl.Spocon(t.Uplo, n, t.Data, max(1, t.Stride), anorm, make([]float32, 3*n), make([]int, n))
+}
+
+// TriangularCond32 is the float32 version of TriangularCond. The estimate
+// is computed by Strcon.
+func TriangularCond32(norm lapack.MatrixNorm, t blas32.Triangular) float32 {
+	switch {
+	case norm != lapack.MaxColumnSum && norm != lapack.MaxRowSum:
+		panic(badNorm)
+	case t.Uplo != blas.Upper && t.Uplo != blas.Lower:
+		panic(badUplo)
+	}
+	n := t.N
+	return lapackImpl.Strcon(norm, t.Uplo, t.Diag, n, t.Data, max(1, t.Stride), make([]float32, 3*n), make([]int, n))
+}
@@ -0,0 +1,190 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// checkRCond reports whether the estimate est of the reciprocal condition
+// number is within the factor 10 of the exact value want. The estimators
+// underestimate the norm of the inverse, so est is not less than want.
+func checkRCond(est, want float64) bool {
+	return want*(1-1e-10) <= est && est <= 10*want
+}
+
+// exactRCond returns 1/(‖A‖ ‖inv‖) in the given norm.
+func exactRCond(norm lapack.MatrixNorm, a, inv blas64.General) float64 {
+	n := a.Rows
+	anorm := impl.Dlange(norm, n, n, a.Data, a.Stride, make([]float64, n))
+	inorm := impl.Dlange(norm, n, n, inv.Data, inv.Stride, make([]float64, n))
+	return 1 / (anorm * inorm)
+}
+
+// graded returns a random n×n matrix whose rows are scaled by 10^(-i*scale).
+func graded(rnd *rand.Rand, n int, scale float64) blas64.General {
+	a := randomGeneral(rnd, n, n, n)
+	for i := 0; i < n; i++ {
+		a.Data[i*a.Stride+i] += 2
+		for j := 0; j < n; j++ {
+			a.Data[i*a.Stride+j] *= math.Pow(10, -float64(i)*scale)
+		}
+	}
+	return a
+}
+
+func TestLUCond(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 5, 20} {
+		for _, scale := range []float64{0, 0.5} {
+			for _, norm := range []lapack.MatrixNorm{lapack.MaxColumnSum, lapack.MaxRowSum} {
+				name := fmt.Sprintf("n=%d,scale=%v,norm=%c", n, scale, norm)
+				a := graded(rnd, n, scale)
+				inv, err := Inverse(a)
+				if err != nil {
+					t.Fatalf("%s: unexpected error: %v", name, err)
+				}
+				lu := cloneGeneral(a)
+				impl.Dgetrf(n, n, lu.Data, lu.Stride, make([]int, n))
+				anorm := impl.Dlange(norm, n, n, a.Data, a.Stride, make([]float64, n))
+				got := LUCond(norm, lu, anorm)
+				if want := exactRCond(norm, a, inv); !checkRCond(got, want) {
+					t.Errorf("%s: unexpected rcond: got %v want %v", name, got, want)
+				}
+			}
+		}
+	}
+	if got := LUCond(lapack.MaxColumnSum, newGeneral(0, 0), 0); got != 1 {
+		t.Errorf("unexpected rcond for empty matrix: %v", got)
+	}
+}
+
+func TestCholCond(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 6, 25} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			name := fmt.Sprintf("n=%d,uplo=%c", n, uplo)
+			a := randomSPD(rnd, n, n+1)
+			a.Uplo = uplo
+			dense := blas64.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data}
+			inv, err := Inverse(dense)
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", name, err)
+			}
+			f, _, err := CholeskySPD(a, nil)
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", name, err)
+			}
+			anorm := impl.Dlange(lapack.MaxColumnSum, n, n, dense.Data, dense.Stride, make([]float64, n))
+			got := CholCond(f, anorm)
+			if want := exactRCond(lapack.MaxColumnSum, dense, inv); !checkRCond(got, want) {
+				t.Errorf("%s: unexpected rcond: got %v want %v", name, got, want)
+			}
+		}
+	}
+}
+
+func TestTriangularCond(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 7, 30} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, norm := range []lapack.MatrixNorm{lapack.MaxColumnSum, lapack.MaxRowSum} {
+				name := fmt.Sprintf("n=%d,uplo=%c,norm=%c", n, uplo, norm)
+				a := graded(rnd, n, 0.2)
+				for i := 0; i < n; i++ {
+					for j := 0; j < n; j++ {
+						if uplo == blas.Upper && j < i || uplo == blas.Lower && i < j {
+							a.Data[i*a.Stride+j] = 0
+						}
+					}
+				}
+				tri := blas64.Triangular{Uplo: uplo, Diag: blas.NonUnit, N: n, Stride: a.Stride, Data: a.Data}
+				inv, err := Inverse(a)
+				if err != nil {
+					t.Fatalf("%s: unexpected error: %v", name, err)
+				}
+				got := TriangularCond(norm, tri)
+				if want := exactRCond(norm, a, inv); !checkRCond(got, want) {
+					t.Errorf("%s: unexpected rcond: got %v want %v", name, got, want)
+				}
+			}
+		}
+	}
+}
+
+// cnorm1 returns the 1-norm of the complex matrix a.
+func cnorm1(a cblas128.General) float64 {
+	var norm float64
+	for j := 0; j < a.Cols; j++ {
+		var s float64
+		for i := 0; i < a.Rows; i++ {
+			s += cmplx.Abs(a.Data[i*a.Stride+j])
+		}
+		norm = math.Max(norm, s)
+	}
+	return norm
+}
+
+func TestZcon(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 5, 18} {
+		a := randomCGeneral(rnd, n, n, n)
+		for i := 0; i < n; i++ {
+			a.Data[i*a.Stride+i] += 2
+		}
+		anorm := cnorm1(a)
+
+		lu := cloneCGeneral(a)
+		ipiv := make([]int, n)
+		if !impl.Zgetrf(n, n, lu.Data, lu.Stride, ipiv) {
+			t.Fatalf("n=%d: unexpected singular matrix", n)
+		}
+		got := impl.Zgecon(lapack.MaxColumnSum, n, lu.Data, lu.Stride, anorm, make([]complex128, 2*n), make([]float64, 2*n))
+		inv := cloneCGeneral(lu)
+		work := make([]complex128, n)
+		impl.Zgetri(n, inv.Data, inv.Stride, ipiv, work, len(work))
+		if want := 1 / (anorm * cnorm1(inv)); !checkRCond(got, want) {
+			t.Errorf("n=%d: unexpected Zgecon rcond: got %v want %v", n, got, want)
+		}
+
+		// The triangular factor U of the LU factorization.
+		u := cloneCGeneral(lu)
+		for i := 0; i < n; i++ {
+			for j := 0; j < i; j++ {
+				u.Data[i*u.Stride+j] = 0
+			}
+		}
+		got = impl.Ztrcon(lapack.MaxColumnSum, blas.Upper, blas.NonUnit, n, u.Data, u.Stride, make([]complex128, 2*n), make([]float64, n))
+		uinv := cloneCGeneral(u)
+		impl.Ztrtri(blas.Upper, blas.NonUnit, n, uinv.Data, uinv.Stride)
+		if want := 1 / (cnorm1(u) * cnorm1(uinv)); !checkRCond(got, want) {
+			t.Errorf("n=%d: unexpected Ztrcon rcond: got %v want %v", n, got, want)
+		}
+
+		h := randomHPD(rnd, n)
+		hnorm := cnorm1(h)
+		f := cloneCGeneral(h)
+		if !impl.Zpotrf(blas.Lower, n, f.Data, f.Stride) {
+			t.Fatalf("n=%d: unexpected failure of Zpotrf", n)
+		}
+		got = impl.Zpocon(blas.Lower, n, f.Data, f.Stride, hnorm, make([]complex128, 2*n), make([]float64, n))
+		hinv := cloneCGeneral(h)
+		impl.Zgetrf(n, n, hinv.Data, hinv.Stride, ipiv)
+		impl.Zgetri(n, hinv.Data, hinv.Stride, ipiv, work, len(work))
+		if want := 1 / (hnorm * cnorm1(hinv)); !checkRCond(got, want) {
+			t.Errorf("n=%d: unexpected Zpocon rcond: got %v want %v", n, got, want)
+		}
+	}
+}
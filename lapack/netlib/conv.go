@@ -80,3 +80,95 @@ func bandTriToGonum(uplo blas.Uplo, n, kd int, a []float64, lda int, b []float64
 		}
 	}
 }
+
+// bandGenToLapacke converts an n×n general band matrix A with kl sub-diagonals
+// and ku super-diagonals in CBLAS row-major layout to LAPACKE row-major layout
+// and stores the result in B.
+//
+// For example, when n = 5, kl = 1 and ku = 2, bandGenToLapacke converts
+//  A =  *   a00  a01  a02
+//      a10  a11  a12  a13
+//      a21  a22  a23  a24
+//      a32  a33  a34   *
+//      a43  a44   *    *
+// to
+//  B =  *    *   a02  a13  a24
+//       *   a01  a12  a23  a34
+//      a00  a11  a22  a33  a44
+//      a10  a21  a32  a43   *
+//
+// In this example elements marked as * are not referenced.
+func bandGenToLapacke(n, kl, ku int, a []float64, lda int, b []float64, ldb int) {
+	for i := 0; i < n; i++ {
+		for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+			b[(ku+i-j)*ldb+j] = a[i*lda+kl+j-i]
+		}
+	}
+}
+
+// bandGenToGonum converts an n×n general band matrix A in LAPACKE row-major
+// layout to CBLAS row-major layout and stores the result in B. In other words,
+// it performs the inverse conversion to bandGenToLapacke.
+func bandGenToGonum(n, kl, ku int, a []float64, lda int, b []float64, ldb int) {
+	for i := 0; i < n; i++ {
+		for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+			b[i*ldb+kl+j-i] = a[(ku+i-j)*lda+j]
+		}
+	}
+}
+
+// bandTriToLapacke32 is the float32 version of bandTriToLapacke.
+func bandTriToLapacke32(uplo blas.Uplo, n, kd int, a []float32, lda int, b []float32, ldb int) {
+	if uplo == blas.Upper {
+		for i := 0; i < n; i++ {
+			for jb := 0; jb < min(n-i, kd+1); jb++ {
+				j := i + jb // Column index in the full matrix
+				b[(kd-jb)*ldb+j] = a[i*lda+jb]
+			}
+		}
+	} else {
+		for i := 0; i < n; i++ {
+			for jb := max(0, kd-i); jb < kd+1; jb++ {
+				j := i - kd + jb // Column index in the full matrix
+				b[(kd-jb)*ldb+j] = a[i*lda+jb]
+			}
+		}
+	}
+}
+
+// bandTriToGonum32 is the float32 version of bandTriToGonum.
+func bandTriToGonum32(uplo blas.Uplo, n, kd int, a []float32, lda int, b []float32, ldb int) {
+	if uplo == blas.Upper {
+		for j := 0; j < n; j++ {
+			for ib := max(0, kd-j); ib < kd+1; ib++ {
+				i := j - kd + ib // Row index in the full matrix
+				b[i*ldb+kd-ib] = a[ib*lda+j]
+			}
+		}
+	} else {
+		for j := 0; j < n; j++ {
+			for ib := 0; ib < min(n-j, kd+1); ib++ {
+				i := j + ib // Row index in the full matrix
+				b[i*ldb+kd-ib] = a[ib*lda+j]
+			}
+		}
+	}
+}
+
+// bandGenToLapacke32 is the float32 version of bandGenToLapacke.
+func bandGenToLapacke32(n, kl, ku int, a []float32, lda int, b []float32, ldb int) {
+	for i := 0; i < n; i++ {
+		for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+			b[(ku+i-j)*ldb+j] = a[i*lda+kl+j-i]
+		}
+	}
+}
+
+// bandGenToGonum32 is the float32 version of bandGenToGonum.
+func bandGenToGonum32(n, kl, ku int, a []float32, lda int, b []float32, ldb int) {
+	for i := 0; i < n; i++ {
+		for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+			b[i*ldb+kl+j-i] = a[(ku+i-j)*lda+j]
+		}
+	}
+}
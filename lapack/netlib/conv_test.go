@@ -129,3 +129,69 @@ func TestConvBandTri(t *testing.T) {
 		}
 	}
 }
+
+func TestConvBandGen(t *testing.T) {
+	const n, kl, ku = 5, 1, 2
+	a := []float64{
+		-1, 1, 2, 3, // 1. row
+		4, 5, 6, 7,
+		8, 9, 10, 11,
+		12, 13, 14, -1,
+		15, 16, -1, -1, // 5. row
+	}
+	want := []float64{
+		-1, -1, 3, 7, 11, // 2. super-diagonal
+		-1, 2, 6, 10, 14,
+		1, 5, 9, 13, 16, // main diagonal
+		4, 8, 12, 15, -1, // 1. sub-diagonal
+	}
+	lda := kl + ku + 1
+	got := make([]float64, len(want))
+	for i := range got {
+		got[i] = -1
+	}
+	bandGenToLapacke(n, kl, ku, a, lda, got, n)
+	if !floats.Equal(want, got) {
+		t.Errorf("unexpected conversion to LAPACKE row-major;\ngot  %v\nwant %v", got, want)
+	}
+	got = make([]float64, len(a))
+	for i := range got {
+		got[i] = -1
+	}
+	bandGenToGonum(n, kl, ku, want, n, got, lda)
+	if !floats.Equal(a, got) {
+		t.Errorf("unexpected conversion to Gonum row-major;\ngot  %v\nwant %v", got, a)
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 3, 4, 5, 10} {
+		for _, kl := range []int{0, (n + 1) / 4, (5*n + 1) / 4} {
+			for _, ku := range []int{0, (3*n - 1) / 4, (5*n + 1) / 4} {
+				for _, ldextra := range []int{0, 3} {
+					name := fmt.Sprintf("n=%v,kl=%v,ku=%v", n, kl, ku)
+
+					lda := kl + ku + 1 + ldextra
+					a := make([]float64, n*lda)
+					for i := range a {
+						a[i] = rnd.NormFloat64()
+					}
+					aCopy := make([]float64, len(a))
+					copy(aCopy, a)
+
+					ldb := max(1, n) + ldextra
+					b := make([]float64, (kl+ku+1)*ldb)
+					for i := range b {
+						b[i] = rnd.NormFloat64()
+					}
+
+					bandGenToLapacke(n, kl, ku, a, lda, b, ldb)
+					bandGenToGonum(n, kl, ku, b, ldb, a, lda)
+
+					if !floats.Equal(a, aCopy) {
+						t.Errorf("%v: conversion does not roundtrip", name)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"errors"
+	"fmt"
+
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/gonum/blas/blas64"
+
+	blasnetlib "gonum.org/v1/netlib/blas/netlib"
+)
+
+// The functions in this package that are not methods of Implementation
+// provide a convenience layer over the LAPACK drivers. They operate on the
+// gonum blas64 matrix types, allocate any workspace they need, leave their
+// inputs unmodified unless documented otherwise and report numerical
+// failures as errors. Invalid arguments result in a panic, as with the
+// Implementation methods.
+//
+// SymEig, MatPow, PInv, Orth and Null take the copy of their input and the
+// LAPACK work arrays from a package-level sync.Pool, so that repeated calls
+// with matrices of similar size, for example in a Monte Carlo loop, do not
+// allocate scratch memory on every call. Only the results are allocated.
+//
+// Each function has a float32 counterpart with the suffix 32 that operates
+// on the blas32 matrix types and calls the single precision LAPACK drivers,
+// so that single precision data is never converted.
+
+var (
+	blasImpl   blasnetlib.Implementation
+	lapackImpl Implementation
+)
+
+// dlamchE is the machine epsilon.
+const dlamchE = 1.0 / (1 << 53)
+
+// slamchE is the machine epsilon of float32.
+const slamchE = 1.0 / (1 << 24)
+
+// ErrIterationLimit is returned by iterative methods that fail to converge
+// within their iteration limit.
+var ErrIterationLimit = errors.New("lapack: iteration limit reached")
+
+// Panic strings for the convenience layer.
+const (
+	badShapeA = "lapack: a is not square"
+	badShapeB = "lapack: dimension mismatch between a and b"
+)
+
+// SingularError is returned when a factorization finds an exactly zero pivot.
+type SingularError struct {
+	// Index is the zero-based index of the first zero pivot.
+	Index int
+}
+
+func (e SingularError) Error() string {
+	return fmt.Sprintf("lapack: matrix is singular: zero pivot at index %d", e.Index)
+}
+
+// Condition is the condition number of a matrix. It is returned as an error
+// when a result has been computed but the matrix is singular to working
+// precision, so the result may be inaccurate.
+type Condition float64
+
+func (c Condition) Error() string {
+	return fmt.Sprintf("lapack: matrix singular or near-singular with condition number %.4e", float64(c))
+}
+
+// cloneGeneral returns a copy of a with a compact stride.
+func cloneGeneral(a blas64.General) blas64.General {
+	c := blas64.General{
+		Rows:   a.Rows,
+		Cols:   a.Cols,
+		Stride: max(1, a.Cols),
+		Data:   make([]float64, a.Rows*a.Cols),
+	}
+	if a.Cols == 0 {
+		return c
+	}
+	for i := 0; i < a.Rows; i++ {
+		copy(c.Data[i*c.Stride:i*c.Stride+a.Cols], a.Data[i*a.Stride:i*a.Stride+a.Cols])
+	}
+	return c
+}
+
+// newGeneral returns a zeroed r×c matrix with a compact stride.
+func newGeneral(r, c int) blas64.General {
+	return blas64.General{
+		Rows:   r,
+		Cols:   c,
+		Stride: max(1, c),
+		Data:   make([]float64, r*c),
+	}
+}
+
+// cloneGeneral32 returns a copy of a with a compact stride.
+func cloneGeneral32(a blas32.General) blas32.General {
+	c := blas32.General{
+		Rows:   a.Rows,
+		Cols:   a.Cols,
+		Stride: max(1, a.Cols),
+		Data:   make([]float32, a.Rows*a.Cols),
+	}
+	if a.Cols == 0 {
+		return c
+	}
+	for i := 0; i < a.Rows; i++ {
+		copy(c.Data[i*c.Stride:i*c.Stride+a.Cols], a.Data[i*a.Stride:i*a.Stride+a.Cols])
+	}
+	return c
+}
+
+// newGeneral32 returns a zeroed r×c matrix with a compact stride.
+func newGeneral32(r, c int) blas32.General {
+	return blas32.General{
+		Rows:   r,
+		Cols:   c,
+		Stride: max(1, c),
+		Data:   make([]float32, r*c),
+	}
+}
@@ -0,0 +1,29 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+// CSDJob specifies whether a factor of the CS decomposition is computed by
+// Dorcsd2by1 and Zuncsd.
+type CSDJob byte
+
+const (
+	// CSDNone does not compute the factor.
+	CSDNone CSDJob = 'N'
+	// CSDCompute computes the factor.
+	CSDCompute CSDJob = 'Y'
+)
+
+const (
+	badCSDJob  = "lapack: bad CSDJob"
+	badCSDP    = "lapack: p out of range"
+	badCSDQ    = "lapack: q out of range"
+	shortTheta = "lapack: insufficient length of theta"
+)
+
+// csdRank returns the number of angles of the CS decomposition of an m×m
+// matrix partitioned at row p and column q.
+func csdRank(m, p, q int) int {
+	return min(min(p, m-p), min(q, m-q))
+}
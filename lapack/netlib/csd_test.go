@@ -0,0 +1,259 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+const csdTol = 1e-10
+
+// isCSDBlock returns whether the r×c matrix with elements of magnitude
+// abs(i, j) has at most one nonzero element in each row and column, and
+// whether each nonzero element is 1 or matches one of vals.
+func isCSDBlock(r, c int, abs func(i, j int) float64, vals []float64) bool {
+	rows := make([]int, r)
+	cols := make([]int, c)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			v := abs(i, j)
+			if v < csdTol {
+				continue
+			}
+			rows[i]++
+			cols[j]++
+			if rows[i] > 1 || cols[j] > 1 {
+				return false
+			}
+			found := math.Abs(v-1) < csdTol
+			for _, w := range vals {
+				found = found || math.Abs(v-w) < csdTol
+			}
+			if !found {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// csdBlock returns U^T * B * V, where B is the r×c block of X starting at
+// row i and column j and V is given by its transpose vt.
+func csdBlock(u, x blas64.General, i, j, r, c int, vt blas64.General) blas64.General {
+	blk := newGeneral(r, c)
+	for k := 0; k < r; k++ {
+		copy(blk.Data[k*blk.Stride:k*blk.Stride+c], x.Data[(i+k)*x.Stride+j:])
+	}
+	d := newGeneral(r, c)
+	if r == 0 || c == 0 {
+		return d
+	}
+	tmp := newGeneral(r, c)
+	blas64.Gemm(blas.Trans, blas.NoTrans, 1, u, blk, 0, tmp)
+	blas64.Gemm(blas.NoTrans, blas.Trans, 1, tmp, vt, 0, d)
+	return d
+}
+
+func TestDorcsd2by1(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, p, q int
+	}{
+		{1, 0, 1},
+		{1, 1, 1},
+		{4, 2, 2},
+		{8, 5, 3},
+		{8, 2, 5},
+		{9, 3, 4},
+		{10, 7, 6},
+	} {
+		m, p, q := test.m, test.p, test.q
+		name := fmt.Sprintf("m=%d,p=%d,q=%d", m, p, q)
+		x, err := Orth(randomGeneral(rnd, m, q, q), -1)
+		if err != nil || x.Cols != q {
+			t.Fatalf("%s: unexpected failure of Orth: %v", name, err)
+		}
+		x11 := newGeneral(p, q)
+		x21 := newGeneral(m-p, q)
+		for i := 0; i < m; i++ {
+			if i < p {
+				copy(x11.Data[i*x11.Stride:], x.Data[i*x.Stride:i*x.Stride+q])
+			} else {
+				copy(x21.Data[(i-p)*x21.Stride:], x.Data[i*x.Stride:i*x.Stride+q])
+			}
+		}
+
+		r := csdRank(m, p, q)
+		theta := make([]float64, r)
+		u1 := newGeneral(p, p)
+		u2 := newGeneral(m-p, m-p)
+		v1t := newGeneral(q, q)
+		work := make([]float64, 1)
+		impl.Dorcsd2by1(CSDCompute, CSDCompute, CSDCompute, m, p, q, x11.Data, x11.Stride, x21.Data, x21.Stride, theta, u1.Data, u1.Stride, u2.Data, u2.Stride, v1t.Data, v1t.Stride, work, -1)
+		work = make([]float64, int(work[0]))
+		if !impl.Dorcsd2by1(CSDCompute, CSDCompute, CSDCompute, m, p, q, x11.Data, x11.Stride, x21.Data, x21.Stride, theta, u1.Data, u1.Stride, u2.Data, u2.Stride, v1t.Data, v1t.Stride, work, len(work)) {
+			t.Fatalf("%s: Dorcsd2by1 did not converge", name)
+		}
+
+		if !sort.Float64sAreSorted(theta) {
+			t.Errorf("%s: angles not in increasing order: %v", name, theta)
+		}
+		cos := make([]float64, r)
+		sin := make([]float64, r)
+		for i, v := range theta {
+			if v < -csdTol || math.Pi/2+csdTol < v {
+				t.Errorf("%s: angle %d out of range: %v", name, i, v)
+			}
+			cos[i], sin[i] = math.Cos(v), math.Sin(v)
+		}
+		for _, f := range []struct {
+			name string
+			q    blas64.General
+		}{{"U1", u1}, {"U2", u2}, {"V1", v1t}} {
+			if d := orthonormalityError(f.q); d > csdTol {
+				t.Errorf("%s: %s not orthogonal: %v", name, f.name, d)
+			}
+		}
+
+		d11 := csdBlock(u1, x, 0, 0, p, q, v1t)
+		if !isCSDBlock(p, q, func(i, j int) float64 { return math.Abs(d11.Data[i*d11.Stride+j]) }, cos) {
+			t.Errorf("%s: U1^T * X11 * V1 is not a cosine block", name)
+		}
+		d21 := csdBlock(u2, x, p, 0, m-p, q, v1t)
+		if !isCSDBlock(m-p, q, func(i, j int) float64 { return math.Abs(d21.Data[i*d21.Stride+j]) }, sin) {
+			t.Errorf("%s: U2^T * X21 * V1 is not a sine block", name)
+		}
+	}
+}
+
+// randomUnitary returns a random n×n unitary matrix.
+func randomUnitary(rnd *rand.Rand, n int) cblas128.General {
+	q := randomCGeneral(rnd, n, n, n)
+	tau := make([]complex128, n)
+	work := make([]complex128, max(1, n))
+	impl.Zgeqrf(n, n, q.Data, q.Stride, tau, work, len(work))
+	impl.Zungqr(n, n, n, q.Data, q.Stride, tau, work, len(work))
+	return q
+}
+
+// eyeC returns the n×n identity matrix.
+func eyeC(n int) cblas128.General {
+	a := newCGeneral(n, n)
+	for i := 0; i < n; i++ {
+		a.Data[i*a.Stride+i] = 1
+	}
+	return a
+}
+
+// cblock returns the r×c block of a starting at row i and column j.
+func cblock(a cblas128.General, i, j, r, c int) cblas128.General {
+	b := newCGeneral(r, c)
+	for k := 0; k < r; k++ {
+		copy(b.Data[k*b.Stride:k*b.Stride+c], a.Data[(i+k)*a.Stride+j:])
+	}
+	return b
+}
+
+func TestZuncsd(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, p, q int
+	}{
+		{2, 1, 1},
+		{4, 2, 2},
+		{7, 3, 5},
+		{8, 5, 3},
+		{9, 4, 4},
+	} {
+		m, p, q := test.m, test.p, test.q
+		name := fmt.Sprintf("m=%d,p=%d,q=%d", m, p, q)
+		x := randomUnitary(rnd, m)
+		x11 := cblock(x, 0, 0, p, q)
+		x12 := cblock(x, 0, q, p, m-q)
+		x21 := cblock(x, p, 0, m-p, q)
+		x22 := cblock(x, p, q, m-p, m-q)
+
+		r := csdRank(m, p, q)
+		theta := make([]float64, r)
+		u1 := newCGeneral(p, p)
+		u2 := newCGeneral(m-p, m-p)
+		v1t := newCGeneral(q, q)
+		v2t := newCGeneral(m-q, m-q)
+		work := make([]complex128, 1)
+		rwork := make([]float64, 1)
+		impl.Zuncsd(CSDCompute, CSDCompute, CSDCompute, CSDCompute, m, p, q, x11.Data, x11.Stride, x12.Data, x12.Stride, x21.Data, x21.Stride, x22.Data, x22.Stride, theta, u1.Data, u1.Stride, u2.Data, u2.Stride, v1t.Data, v1t.Stride, v2t.Data, v2t.Stride, work, -1, rwork, -1)
+		work = make([]complex128, int(real(work[0])))
+		rwork = make([]float64, int(rwork[0]))
+		if !impl.Zuncsd(CSDCompute, CSDCompute, CSDCompute, CSDCompute, m, p, q, x11.Data, x11.Stride, x12.Data, x12.Stride, x21.Data, x21.Stride, x22.Data, x22.Stride, theta, u1.Data, u1.Stride, u2.Data, u2.Stride, v1t.Data, v1t.Stride, v2t.Data, v2t.Stride, work, len(work), rwork, len(rwork)) {
+			t.Fatalf("%s: Zuncsd did not converge", name)
+		}
+
+		if !sort.Float64sAreSorted(theta) {
+			t.Errorf("%s: angles not in increasing order: %v", name, theta)
+		}
+		cos := make([]float64, r)
+		sin := make([]float64, r)
+		for i, v := range theta {
+			cos[i], sin[i] = math.Cos(v), math.Sin(v)
+		}
+
+		// D = diag(U1, U2)ᴴ * X * diag(V1ᴴ, V2ᴴ)ᴴ
+		u := newCGeneral(m, m)
+		vt := newCGeneral(m, m)
+		for i := 0; i < m; i++ {
+			if i < p {
+				copy(u.Data[i*u.Stride:], u1.Data[i*u1.Stride:i*u1.Stride+p])
+			} else {
+				copy(u.Data[i*u.Stride+p:], u2.Data[(i-p)*u2.Stride:(i-p)*u2.Stride+m-p])
+			}
+			if i < q {
+				copy(vt.Data[i*vt.Stride:], v1t.Data[i*v1t.Stride:i*v1t.Stride+q])
+			} else {
+				copy(vt.Data[i*vt.Stride+q:], v2t.Data[(i-q)*v2t.Stride:(i-q)*v2t.Stride+m-q])
+			}
+		}
+		for _, f := range []struct {
+			name string
+			q    cblas128.General
+		}{{"U", u}, {"V", vt}} {
+			if d := cmaxAbsDiff(naiveCMul(conjTrans(f.q), f.q), eyeC(m)); d > csdTol {
+				t.Errorf("%s: %s not unitary: %v", name, f.name, d)
+			}
+		}
+		d := naiveCMul(naiveCMul(conjTrans(u), x), conjTrans(vt))
+		for _, blk := range []struct {
+			name       string
+			i, j, r, c int
+			vals       []float64
+			sign       float64
+		}{
+			{"D11", 0, 0, p, q, cos, 1},
+			{"D12", 0, q, p, m - q, sin, -1},
+			{"D21", p, 0, m - p, q, sin, 1},
+			{"D22", p, q, m - p, m - q, cos, 1},
+		} {
+			abs := func(i, j int) float64 {
+				v := d.Data[(blk.i+i)*d.Stride+blk.j+j]
+				if math.Abs(imag(v)) > csdTol || real(v)*blk.sign < -csdTol {
+					return math.NaN()
+				}
+				return cmplx.Abs(v)
+			}
+			if !isCSDBlock(blk.r, blk.c, abs, blk.vals) {
+				t.Errorf("%s: %s is not a block of a CS matrix", name, blk.name)
+			}
+		}
+	}
+}
@@ -0,0 +1,421 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"encoding/json"
+	"io"
+	"math/bits"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// Problem identifies a computation that more than one LAPACK driver can
+// perform with the same result.
+type Problem int
+
+const (
+	// SVDProblem is the thin singular value decomposition of a general
+	// matrix, computed by Dgesdd or Dgesvd.
+	SVDProblem Problem = iota
+	// SymEigProblem is the eigendecomposition of a symmetric matrix,
+	// computed by Dsyevd, Dsyevr or Dsyev.
+	SymEigProblem
+)
+
+// Driver is the name of a LAPACK driver routine without its type prefix.
+type Driver string
+
+const (
+	Gesdd Driver = "gesdd"
+	Gesvd Driver = "gesvd"
+	Syevd Driver = "syevd"
+	Syevr Driver = "syevr"
+	Syev  Driver = "syev"
+)
+
+// Drivers returns the drivers that solve p. The first driver is used when no
+// other has been selected.
+func (p Problem) Drivers() []Driver {
+	switch p {
+	case SVDProblem:
+		return []Driver{Gesdd, Gesvd}
+	case SymEigProblem:
+		return []Driver{Syevd, Syevr, Syev}
+	}
+	panic("lapack: bad Problem")
+}
+
+// validDriver reports whether d solves p.
+func (p Problem) validDriver(d Driver) bool {
+	for _, v := range p.Drivers() {
+		if v == d {
+			return true
+		}
+	}
+	return false
+}
+
+// DriverTable records the fastest driver for each problem and matrix shape.
+// Shapes are grouped into classes by the binary order of magnitude of each
+// dimension, so that one calibration run covers matrices of similar size.
+//
+// A DriverTable is safe for concurrent use.
+type DriverTable struct {
+	mu     sync.RWMutex
+	choice map[shapeClass]Driver
+}
+
+// shapeClass is the key of a DriverTable.
+type shapeClass struct {
+	problem    Problem
+	rows, cols int
+}
+
+func classOf(p Problem, m, n int) shapeClass {
+	return shapeClass{problem: p, rows: bits.Len(uint(m)), cols: bits.Len(uint(n))}
+}
+
+// NewDriverTable returns an empty driver table.
+func NewDriverTable() *DriverTable {
+	return &DriverTable{choice: make(map[shapeClass]Driver)}
+}
+
+// DefaultDrivers is the table consulted by the convenience functions of this
+// package. It is empty unless it is populated by calibration, by Set or by
+// LoadDriverCache.
+var DefaultDrivers = NewDriverTable()
+
+// Select returns the driver to use for problem p on an m×n matrix. If no
+// driver has been recorded for the shape class of m×n, the first of
+// p.Drivers() is returned.
+func (t *DriverTable) Select(p Problem, m, n int) Driver {
+	t.mu.RLock()
+	d, ok := t.choice[classOf(p, m, n)]
+	t.mu.RUnlock()
+	if ok {
+		return d
+	}
+	return p.Drivers()[0]
+}
+
+// Set records d as the driver for problem p on matrices in the shape class of
+// m×n. Set will panic if d does not solve p.
+func (t *DriverTable) Set(p Problem, m, n int, d Driver) {
+	if !p.validDriver(d) {
+		panic("lapack: driver does not solve problem")
+	}
+	t.mu.Lock()
+	t.choice[classOf(p, m, n)] = d
+	t.mu.Unlock()
+}
+
+// calibrationRuns is the number of timed runs of each driver during
+// calibration. The fastest run is used.
+const calibrationRuns = 3
+
+// Calibrate times each driver for problem p on a random m×n matrix, records
+// the fastest in the table and returns it. For SymEigProblem m and n must be
+// equal. Calibration of large shapes is expensive; it is intended to be run
+// once per machine and the result saved with Save or SaveDriverCache.
+func (t *DriverTable) Calibrate(p Problem, m, n int) Driver {
+	if m < 0 || n < 0 {
+		panic("lapack: negative dimension")
+	}
+	if p == SymEigProblem && m != n {
+		panic(badShapeA)
+	}
+	rnd := rand.New(rand.NewSource(1))
+	a := newGeneral(m, n)
+	for i := range a.Data {
+		a.Data[i] = rnd.NormFloat64()
+	}
+	if p == SymEigProblem {
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				a.Data[j*a.Stride+i] = a.Data[i*a.Stride+j]
+			}
+		}
+	}
+
+	best := p.Drivers()[0]
+	bestTime := time.Duration(1<<63 - 1)
+	for _, d := range p.Drivers() {
+		for r := 0; r < calibrationRuns; r++ {
+			ac := cloneGeneral(a)
+			start := time.Now()
+			switch p {
+			case SVDProblem:
+				svd(d, ac, 'S', nil)
+			case SymEigProblem:
+				symEig(d, blas64.Symmetric{Uplo: blas.Upper, N: n, Stride: ac.Stride, Data: ac.Data}, nil)
+			}
+			if elapsed := time.Since(start); elapsed < bestTime {
+				best, bestTime = d, elapsed
+			}
+		}
+	}
+	t.Set(p, m, n, best)
+	return best
+}
+
+// driverEntry is the serialized form of a DriverTable entry.
+type driverEntry struct {
+	Problem Problem `json:"problem"`
+	Rows    int     `json:"rows"`
+	Cols    int     `json:"cols"`
+	Driver  Driver  `json:"driver"`
+}
+
+// Save writes the table to w as JSON.
+func (t *DriverTable) Save(w io.Writer) error {
+	t.mu.RLock()
+	entries := make([]driverEntry, 0, len(t.choice))
+	for k, d := range t.choice {
+		entries = append(entries, driverEntry{Problem: k.problem, Rows: k.rows, Cols: k.cols, Driver: d})
+	}
+	t.mu.RUnlock()
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.Problem != b.Problem {
+			return a.Problem < b.Problem
+		}
+		if a.Rows != b.Rows {
+			return a.Rows < b.Rows
+		}
+		return a.Cols < b.Cols
+	})
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(entries)
+}
+
+// Load reads a table written by Save from r and merges it into t. Entries
+// naming unknown problems or drivers are ignored.
+func (t *DriverTable) Load(r io.Reader) error {
+	var entries []driverEntry
+	err := json.NewDecoder(r).Decode(&entries)
+	if err != nil {
+		return err
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, e := range entries {
+		if e.Problem != SVDProblem && e.Problem != SymEigProblem {
+			continue
+		}
+		if !e.Problem.validDriver(e.Driver) {
+			continue
+		}
+		t.choice[shapeClass{problem: e.Problem, rows: e.Rows, cols: e.Cols}] = e.Driver
+	}
+	return nil
+}
+
+// DriverCachePath returns the path of the per-machine driver table used by
+// LoadDriverCache and SaveDriverCache, within the user cache directory.
+func DriverCachePath() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "gonum-netlib", "drivers.json"), nil
+}
+
+// LoadDriverCache loads the per-machine driver table into DefaultDrivers.
+func LoadDriverCache() error {
+	path, err := DriverCachePath()
+	if err != nil {
+		return err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return DefaultDrivers.Load(f)
+}
+
+// SaveDriverCache saves DefaultDrivers as the per-machine driver table.
+func SaveDriverCache() error {
+	path, err := DriverCachePath()
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	err = DefaultDrivers.Save(f)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// workspace holds scratch memory that is reused between driver calls. The
+// methods of a nil *workspace allocate on every call.
+type workspace struct {
+	f   []float64
+	f32 []float32
+	i   []lapacke.Int
+}
+
+// float64s returns a scratch slice of length n. Its contents are undefined.
+func (ws *workspace) float64s(n int) []float64 {
+	if ws == nil {
+		return make([]float64, n)
+	}
+	if cap(ws.f) < n {
+		ws.f = make([]float64, n)
+	}
+	return ws.f[:n]
+}
+
+// float32s returns a scratch slice of length n. Its contents are undefined.
+func (ws *workspace) float32s(n int) []float32 {
+	if ws == nil {
+		return make([]float32, n)
+	}
+	if cap(ws.f32) < n {
+		ws.f32 = make([]float32, n)
+	}
+	return ws.f32[:n]
+}
+
+// ints returns a scratch slice of length n. Its contents are undefined.
+func (ws *workspace) ints(n int) []lapacke.Int {
+	if ws == nil {
+		return make([]lapacke.Int, n)
+	}
+	if cap(ws.i) < n {
+		ws.i = make([]lapacke.Int, n)
+	}
+	return ws.i[:n]
+}
+
+// svd computes the singular value decomposition A = U * Σ * V^T of the m×n
+// matrix A using driver d, overwriting a. If job is 'S', the thin
+// decomposition is computed and U is m×min(m,n) and V^T is min(m,n)×n. If job
+// is 'A', U is m×m and V^T is n×n. U and V^T have compact strides. ok is false
+// if the driver failed to converge. Scratch memory is taken from ws, which
+// may be nil.
+func svd(d Driver, a blas64.General, job byte, ws *workspace) (s, u, vt []float64, ok bool) {
+	m, n := a.Rows, a.Cols
+	k := min(m, n)
+	ucols, vrows := k, k
+	if job == 'A' {
+		ucols, vrows = m, n
+	}
+	s = make([]float64, k)
+	u = make([]float64, m*ucols)
+	vt = make([]float64, vrows*n)
+	if k == 0 {
+		for i := 0; i < vrows; i++ {
+			vt[i*n+i] = 1
+		}
+		for i := 0; i < m && ucols == m; i++ {
+			u[i*m+i] = 1
+		}
+		return s, u, vt, true
+	}
+	ldu := max(1, ucols)
+	work := make([]float64, 1)
+	switch d {
+	case Gesdd:
+		iwork := ws.ints(8 * k)
+		lapacke.Dgesdd(job, m, n, a.Data, a.Stride, s, u, ldu, vt, n, work, -1, iwork)
+		work = ws.float64s(int(work[0]))
+		ok = lapacke.Dgesdd(job, m, n, a.Data, a.Stride, s, u, ldu, vt, n, work, len(work), iwork)
+	case Gesvd:
+		lapacke.Dgesvd(job, job, m, n, a.Data, a.Stride, s, u, ldu, vt, n, work, -1)
+		work = ws.float64s(int(work[0]))
+		ok = lapacke.Dgesvd(job, job, m, n, a.Data, a.Stride, s, u, ldu, vt, n, work, len(work))
+	default:
+		panic("lapack: driver does not solve problem")
+	}
+	return s, u, vt, ok
+}
+
+// symEig computes the eigenvalues of the symmetric matrix A in ascending
+// order and the corresponding eigenvectors using driver d. The eigenvectors
+// are returned as the columns of an n×n matrix with a compact stride. a may
+// be overwritten. ok is false if the driver failed to converge. Scratch memory
+// is taken from ws, which may be nil.
+func symEig(d Driver, a blas64.Symmetric, ws *workspace) (w []float64, z blas64.General, ok bool) {
+	n := a.N
+	w = make([]float64, n)
+	if n == 0 {
+		return w, newGeneral(0, 0), true
+	}
+	uplo := byte(a.Uplo)
+	work := make([]float64, 1)
+	switch d {
+	case Syev:
+		lapacke.Dsyev('V', uplo, n, a.Data, a.Stride, w, work, -1)
+		work = ws.float64s(int(work[0]))
+		ok = lapacke.Dsyev('V', uplo, n, a.Data, a.Stride, w, work, len(work))
+		z = cloneGeneral(blas64.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data})
+	case Syevd:
+		iwork := make([]lapacke.Int, 1)
+		lapacke.Dsyevd('V', uplo, n, a.Data, a.Stride, w, work, -1, iwork, -1)
+		work = ws.float64s(int(work[0]))
+		iwork = ws.ints(int(iwork[0]))
+		ok = lapacke.Dsyevd('V', uplo, n, a.Data, a.Stride, w, work, len(work), iwork, len(iwork))
+		z = cloneGeneral(blas64.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data})
+	case Syevr:
+		z = newGeneral(n, n)
+		var found [1]lapacke.Int
+		isuppz := make([]lapacke.Int, 2*n)
+		iwork := make([]lapacke.Int, 1)
+		lapacke.Dsyevr('V', 'A', uplo, n, a.Data, a.Stride, 0, 0, 0, 0, 0, found[:], w, z.Data, z.Stride, isuppz, work, -1, iwork, -1)
+		work = ws.float64s(int(work[0]))
+		iwork = ws.ints(int(iwork[0]))
+		ok = lapacke.Dsyevr('V', 'A', uplo, n, a.Data, a.Stride, 0, 0, 0, 0, 0, found[:], w, z.Data, z.Stride, isuppz, work, len(work), iwork, len(iwork))
+	default:
+		panic("lapack: driver does not solve problem")
+	}
+	return w, z, ok
+}
+
+// SymEig computes the eigenvalues of the symmetric n×n matrix A in ascending
+// order and the corresponding orthonormal eigenvectors, returned as the
+// columns of v. The driver is chosen by DefaultDrivers. The input a is not
+// modified.
+//
+// If the driver fails to converge, SymEig returns ErrIterationLimit.
+func SymEig(a blas64.Symmetric) (w []float64, v blas64.General, err error) {
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	n := a.N
+	ws := getWorkspace()
+	defer putWorkspace(ws)
+	ac := ws.general(blas64.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data})
+	w, v, ok := symEig(DefaultDrivers.Select(SymEigProblem, n, n), blas64.Symmetric{
+		Uplo:   a.Uplo,
+		N:      n,
+		Stride: ac.Stride,
+		Data:   ac.Data,
+	}, &ws.workspace)
+	if !ok {
+		return w, v, ErrIterationLimit
+	}
+	return w, v, nil
+}
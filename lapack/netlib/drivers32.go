@@ -0,0 +1,108 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// svd32 is the float32 version of svd.
+func svd32(d Driver, a blas32.General, job byte, ws *workspace) (s, u, vt []float32, ok bool) {
+	m, n := a.Rows, a.Cols
+	k := min(m, n)
+	ucols, vrows := k, k
+	if job == 'A' {
+		ucols, vrows = m, n
+	}
+	s = make([]float32, k)
+	u = make([]float32, m*ucols)
+	vt = make([]float32, vrows*n)
+	if k == 0 {
+		for i := 0; i < vrows; i++ {
+			vt[i*n+i] = 1
+		}
+		for i := 0; i < m && ucols == m; i++ {
+			u[i*m+i] = 1
+		}
+		return s, u, vt, true
+	}
+	ldu := max(1, ucols)
+	work := make([]float32, 1)
+	switch d {
+	case Gesdd:
+		iwork := ws.ints(8 * k)
+		lapacke.Sgesdd(job, m, n, a.Data, a.Stride, s, u, ldu, vt, n, work, -1, iwork)
+		work = ws.float32s(int(work[0]))
+		ok = lapacke.Sgesdd(job, m, n, a.Data, a.Stride, s, u, ldu, vt, n, work, len(work), iwork)
+	case Gesvd:
+		lapacke.Sgesvd(job, job, m, n, a.Data, a.Stride, s, u, ldu, vt, n, work, -1)
+		work = ws.float32s(int(work[0]))
+		ok = lapacke.Sgesvd(job, job, m, n, a.Data, a.Stride, s, u, ldu, vt, n, work, len(work))
+	default:
+		panic("lapack: driver does not solve problem")
+	}
+	return s, u, vt, ok
+}
+
+// symEig32 is the float32 version of symEig.
+func symEig32(d Driver, a blas32.Symmetric, ws *workspace) (w []float32, z blas32.General, ok bool) {
+	n := a.N
+	w = make([]float32, n)
+	if n == 0 {
+		return w, newGeneral32(0, 0), true
+	}
+	uplo := byte(a.Uplo)
+	work := make([]float32, 1)
+	switch d {
+	case Syev:
+		lapacke.Ssyev('V', uplo, n, a.Data, a.Stride, w, work, -1)
+		work = ws.float32s(int(work[0]))
+		ok = lapacke.Ssyev('V', uplo, n, a.Data, a.Stride, w, work, len(work))
+		z = cloneGeneral32(blas32.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data})
+	case Syevd:
+		iwork := make([]lapacke.Int, 1)
+		lapacke.Ssyevd('V', uplo, n, a.Data, a.Stride, w, work, -1, iwork, -1)
+		work = ws.float32s(int(work[0]))
+		iwork = ws.ints(int(iwork[0]))
+		ok = lapacke.Ssyevd('V', uplo, n, a.Data, a.Stride, w, work, len(work), iwork, len(iwork))
+		z = cloneGeneral32(blas32.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data})
+	case Syevr:
+		z = newGeneral32(n, n)
+		var found [1]lapacke.Int
+		isuppz := make([]lapacke.Int, 2*n)
+		iwork := make([]lapacke.Int, 1)
+		lapacke.Ssyevr('V', 'A', uplo, n, a.Data, a.Stride, 0, 0, 0, 0, 0, found[:], w, z.Data, z.Stride, isuppz, work, -1, iwork, -1)
+		work = ws.float32s(int(work[0]))
+		iwork = ws.ints(int(iwork[0]))
+		ok = lapacke.Ssyevr('V', 'A', uplo, n, a.Data, a.Stride, 0, 0, 0, 0, 0, found[:], w, z.Data, z.Stride, isuppz, work, len(work), iwork, len(iwork))
+	default:
+		panic("lapack: driver does not solve problem")
+	}
+	return w, z, ok
+}
+
+// SymEig32 is the float32 version of SymEig. The driver chosen by
+// DefaultDrivers is called with the S prefix.
+func SymEig32(a blas32.Symmetric) (w []float32, v blas32.General, err error) {
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	n := a.N
+	ws := getWorkspace()
+	defer putWorkspace(ws)
+	ac := ws.general32(blas32.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data})
+	w, v, ok := symEig32(DefaultDrivers.Select(SymEigProblem, n, n), blas32.Symmetric{
+		Uplo:   a.Uplo,
+		N:      n,
+		Stride: ac.Stride,
+		Data:   ac.Data,
+	}, &ws.workspace)
+	if !ok {
+		return w, v, ErrIterationLimit
+	}
+	return w, v, nil
+}
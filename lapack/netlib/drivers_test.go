@@ -0,0 +1,223 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDriverTable(t *testing.T) {
+	tab := NewDriverTable()
+	if got := tab.Select(SVDProblem, 100, 10); got != Gesdd {
+		t.Errorf("unexpected default SVD driver: %v", got)
+	}
+	if got := tab.Select(SymEigProblem, 10, 10); got != Syevd {
+		t.Errorf("unexpected default eigen driver: %v", got)
+	}
+
+	tab.Set(SVDProblem, 100, 10, Gesvd)
+	tab.Set(SymEigProblem, 10, 10, Syevr)
+	for _, test := range []struct {
+		p    Problem
+		m, n int
+		want Driver
+	}{
+		{p: SVDProblem, m: 100, n: 10, want: Gesvd},
+		{p: SVDProblem, m: 127, n: 15, want: Gesvd},
+		{p: SVDProblem, m: 128, n: 10, want: Gesdd},
+		{p: SVDProblem, m: 10, n: 100, want: Gesdd},
+		{p: SymEigProblem, m: 9, n: 9, want: Syevr},
+		{p: SymEigProblem, m: 20, n: 20, want: Syevd},
+	} {
+		if got := tab.Select(test.p, test.m, test.n); got != test.want {
+			t.Errorf("problem=%d,m=%d,n=%d: unexpected driver: got %v want %v", test.p, test.m, test.n, got, test.want)
+		}
+	}
+
+	var buf bytes.Buffer
+	err := tab.Save(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error saving table: %v", err)
+	}
+	loaded := NewDriverTable()
+	err = loaded.Load(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error loading table: %v", err)
+	}
+	if got := loaded.Select(SVDProblem, 100, 10); got != Gesvd {
+		t.Errorf("unexpected SVD driver after round trip: %v", got)
+	}
+	if got := loaded.Select(SymEigProblem, 10, 10); got != Syevr {
+		t.Errorf("unexpected eigen driver after round trip: %v", got)
+	}
+
+	err = loaded.Load(bytes.NewBufferString(`[{"problem":0,"rows":1,"cols":1,"driver":"syev"}]`))
+	if err != nil {
+		t.Fatalf("unexpected error loading table: %v", err)
+	}
+	if got := loaded.Select(SVDProblem, 1, 1); got != Gesdd {
+		t.Errorf("invalid entry was loaded: %v", got)
+	}
+
+	panicked := func() (panicked bool) {
+		defer func() { panicked = recover() != nil }()
+		tab.Set(SVDProblem, 1, 1, Syev)
+		return false
+	}()
+	if !panicked {
+		t.Errorf("expected panic for driver that does not solve problem")
+	}
+}
+
+func TestCalibrate(t *testing.T) {
+	tab := NewDriverTable()
+	for _, p := range []Problem{SVDProblem, SymEigProblem} {
+		d := tab.Calibrate(p, 20, 20)
+		if !p.validDriver(d) {
+			t.Errorf("problem=%d: calibration chose invalid driver %v", p, d)
+		}
+		if got := tab.Select(p, 20, 20); got != d {
+			t.Errorf("problem=%d: calibrated driver not recorded: got %v want %v", p, got, d)
+		}
+	}
+}
+
+func TestSVDDrivers(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, n int }{{0, 0}, {1, 1}, {6, 6}, {12, 5}, {5, 12}} {
+		a := randomGeneral(rnd, test.m, test.n, max(1, test.n))
+		k := min(test.m, test.n)
+		for _, d := range SVDProblem.Drivers() {
+			name := fmt.Sprintf("m=%d,n=%d,driver=%v", test.m, test.n, d)
+			s, u, vt, ok := svd(d, cloneGeneral(a), 'S', nil)
+			if !ok {
+				t.Errorf("%s: unexpected failure", name)
+				continue
+			}
+			// Reconstruct A from its singular value decomposition.
+			us := make([]float64, len(u))
+			copy(us, u)
+			for i := 0; i < test.m; i++ {
+				for j := 0; j < k; j++ {
+					us[i*k+j] *= s[j]
+				}
+			}
+			got := newGeneral(test.m, test.n)
+			if k > 0 {
+				blas64.Gemm(blas.NoTrans, blas.NoTrans, 1,
+					blas64.General{Rows: test.m, Cols: k, Stride: k, Data: us},
+					blas64.General{Rows: k, Cols: test.n, Stride: test.n, Data: vt},
+					0, got)
+			}
+			if d := maxDiff(got, a, false); d > tol {
+				t.Errorf("%s: reconstruction error %v", name, d)
+			}
+		}
+	}
+}
+
+func TestSymEigDrivers(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 4, 17} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			a := randomSPD(rnd, n, n)
+			a.Uplo = uplo
+			for _, d := range SymEigProblem.Drivers() {
+				name := fmt.Sprintf("n=%d,uplo=%c,driver=%v", n, uplo, d)
+				ac := cloneGeneral(blas64.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data})
+				w, z, ok := symEig(d, blas64.Symmetric{Uplo: uplo, N: n, Stride: ac.Stride, Data: ac.Data}, nil)
+				if !ok {
+					t.Errorf("%s: unexpected failure", name)
+					continue
+				}
+				for i := 1; i < n; i++ {
+					if w[i] < w[i-1] {
+						t.Errorf("%s: eigenvalues not in ascending order", name)
+						break
+					}
+				}
+				// Check that A * Z = Z * diag(w).
+				az := mul(blas64.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data}, z)
+				var res float64
+				for i := 0; i < n; i++ {
+					for j := 0; j < n; j++ {
+						res = math.Max(res, math.Abs(az.Data[i*az.Stride+j]-z.Data[i*z.Stride+j]*w[j]))
+					}
+				}
+				if res > tol*math.Max(1, floats.Norm(w, math.Inf(1))) {
+					t.Errorf("%s: residual too large: %v", name, res)
+				}
+			}
+		}
+	}
+}
+
+func TestSymEig(t *testing.T) {
+	a := blas64.Symmetric{
+		Uplo: blas.Lower, N: 2, Stride: 2,
+		Data: []float64{
+			2, math.NaN(),
+			1, 2,
+		},
+	}
+	w, v, err := SymEig(a)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !floats.EqualApprox(w, []float64{1, 3}, 1e-14) {
+		t.Errorf("unexpected eigenvalues: %v", w)
+	}
+	if v.Rows != 2 || v.Cols != 2 {
+		t.Errorf("unexpected eigenvector shape %d×%d", v.Rows, v.Cols)
+	}
+	if !math.IsNaN(a.Data[1]) {
+		t.Errorf("input modified")
+	}
+}
+
+// TestSymEigPooled checks that the results of SymEig and PInv do not share
+// memory with the pooled scratch memory reused by later calls.
+func TestSymEigPooled(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	a := randomSPD(rnd, 10, 10)
+	w, v, err := SymEig(a)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p, _, err := PInv(blas64.General{Rows: a.N, Cols: a.N, Stride: a.Stride, Data: a.Data}, DefaultRCond)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	w0 := append([]float64(nil), w...)
+	v0 := append([]float64(nil), v.Data...)
+	p0 := append([]float64(nil), p.Data...)
+	for _, n := range []int{10, 4, 12, 10} {
+		b := randomSPD(rnd, n, n)
+		if _, _, err := SymEig(b); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, _, err := PInv(blas64.General{Rows: n, Cols: n, Stride: b.Stride, Data: b.Data}, DefaultRCond); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if !floats.Equal(w, w0) || !floats.Equal(v.Data, v0) || !floats.Equal(p.Data, p0) {
+		t.Error("result modified by later call")
+	}
+	w1, v1, _ := SymEig(a)
+	if !floats.EqualApprox(w1, w0, 1e-13) || !floats.EqualApprox(v1.Data, v0, 1e-12) {
+		t.Error("results differ between calls with pooled workspace")
+	}
+}
@@ -0,0 +1,864 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// The float32 functions are tested against their float64 counterparts on
+// inputs that are exactly representable in float32.
+
+const tol32 = 1e-3
+
+// round32 rounds the elements of a to float32 in place and returns a
+// float32 copy of a.
+func round32(a blas64.General) blas32.General {
+	b := blas32.General{Rows: a.Rows, Cols: a.Cols, Stride: a.Stride, Data: make([]float32, len(a.Data))}
+	for i, v := range a.Data {
+		b.Data[i] = float32(v)
+		a.Data[i] = float64(b.Data[i])
+	}
+	return b
+}
+
+func symmetric32(a blas64.Symmetric) blas32.Symmetric {
+	g := round32(blas64.General{Rows: a.N, Cols: a.N, Stride: a.Stride, Data: a.Data})
+	return blas32.Symmetric{Uplo: a.Uplo, N: a.N, Stride: g.Stride, Data: g.Data}
+}
+
+// maxDiff32 returns the largest absolute difference between the elements of
+// got and want relative to the largest element of want.
+func maxDiff32(got []float32, want []float64) float64 {
+	if len(got) != len(want) {
+		return math.Inf(1)
+	}
+	var diff float64
+	scale := 1.0
+	for i, v := range want {
+		scale = math.Max(scale, math.Abs(v))
+		diff = math.Max(diff, math.Abs(float64(got[i])-v))
+	}
+	return diff / scale
+}
+
+func general64(a blas32.General) blas64.General {
+	b := blas64.General{Rows: a.Rows, Cols: a.Cols, Stride: a.Stride, Data: make([]float64, len(a.Data))}
+	for i, v := range a.Data {
+		b.Data[i] = float64(v)
+	}
+	return b
+}
+
+func TestSymEig32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 4, 10} {
+		a64 := randomSPD(rnd, n, n+1)
+		a := symmetric32(a64)
+		w64, _, err := SymEig(a64)
+		if err != nil {
+			t.Fatalf("n=%d: unexpected error: %v", n, err)
+		}
+		w, v, err := SymEig32(a)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error: %v", n, err)
+			continue
+		}
+		if d := maxDiff32(w, w64); d > tol32 {
+			t.Errorf("n=%d: eigenvalue mismatch: %v", n, d)
+		}
+		// Check A*V = V*diag(w).
+		av := mul(blas64.General{Rows: n, Cols: n, Stride: a64.Stride, Data: symmetricData(a64)}, general64(v))
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				av.Data[i*av.Stride+j] -= float64(v.Data[i*v.Stride+j] * w[j])
+			}
+		}
+		if d := maxAbs(av.Data) / math.Max(1, maxAbs(w64)); d > tol32 {
+			t.Errorf("n=%d: unexpected eigenvector residual: %v", n, d)
+		}
+	}
+}
+
+// symmetricData returns both triangles of a in a slice with stride a.Stride.
+func symmetricData(a blas64.Symmetric) []float64 {
+	d := make([]float64, len(a.Data))
+	for i := 0; i < a.N; i++ {
+		for j := i; j < a.N; j++ {
+			v := a.Data[i*a.Stride+j]
+			if a.Uplo == blas.Lower {
+				v = a.Data[j*a.Stride+i]
+			}
+			d[i*a.Stride+j] = v
+			d[j*a.Stride+i] = v
+		}
+	}
+	return d
+}
+
+func maxAbs(s []float64) float64 {
+	var v float64
+	for _, e := range s {
+		v = math.Max(v, math.Abs(e))
+	}
+	return v
+}
+
+func TestPInvOrthNull32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, n, rank int }{
+		{0, 0, 0}, {3, 3, 3}, {6, 4, 4}, {4, 6, 4}, {8, 5, 2},
+	} {
+		m, n := test.m, test.n
+		x := randomGeneral(rnd, m, test.rank, max(1, test.rank))
+		y := randomGeneral(rnd, test.rank, n, max(1, n))
+		a64 := mul(x, y)
+		a := round32(a64)
+
+		p64, rank64, _ := PInv(a64, 1e-6)
+		p, rank, err := PInv32(a, 1e-6)
+		if err != nil || rank != rank64 {
+			t.Errorf("m=%d,n=%d: unexpected PInv32 result: rank %d want %d, %v", m, n, rank, rank64, err)
+		} else if d := maxDiff32(p.Data, p64.Data); d > tol32 {
+			t.Errorf("m=%d,n=%d: PInv32 mismatch: %v", m, n, d)
+		}
+
+		q, err := Orth32(a, -1)
+		if err != nil || q.Cols != test.rank {
+			t.Errorf("m=%d,n=%d: unexpected Orth32 result: %d columns, %v", m, n, q.Cols, err)
+		}
+		z, err := Null32(a, -1)
+		if err != nil || z.Cols != n-test.rank {
+			t.Errorf("m=%d,n=%d: unexpected Null32 result: %d columns, %v", m, n, z.Cols, err)
+		}
+		if q.Cols > 0 {
+			if d := orthonormalityError(general64(q)); d > tol32 {
+				t.Errorf("m=%d,n=%d: Orth32 not orthonormal: %v", m, n, d)
+			}
+		}
+		if z.Cols > 0 {
+			if d := maxAbs(mul(a64, general64(z)).Data); d > tol32*math.Max(1, maxAbs(a64.Data)) {
+				t.Errorf("m=%d,n=%d: Null32 not in null space: %v", m, n, d)
+			}
+		}
+	}
+}
+
+func TestDeterminants32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 5, 12} {
+		a64 := randomGeneral(rnd, n, n, max(1, n))
+		a := round32(a64)
+		sign64, logDet64 := SlogDet(a64)
+		sign, logDet := SlogDet32(a)
+		if float64(sign) != sign64 || math.Abs(float64(logDet)-logDet64) > tol32*math.Max(1, math.Abs(logDet64)) {
+			t.Errorf("n=%d: SlogDet32 mismatch: got (%v, %v) want (%v, %v)", n, sign, logDet, sign64, logDet64)
+		}
+
+		s64 := randomSPD(rnd, n, n+2)
+		s := symmetric32(s64)
+		want, err := LogDet(s64)
+		if err != nil {
+			t.Fatalf("n=%d: unexpected error: %v", n, err)
+		}
+		got, err := LogDet32(s)
+		if err != nil || math.Abs(float64(got)-want) > tol32*math.Max(1, math.Abs(want)) {
+			t.Errorf("n=%d: LogDet32 mismatch: got %v, %v want %v", n, got, err, want)
+		}
+
+		c64, _, _ := CholeskySPD(s64, nil)
+		c, _, err := CholeskySPD32(s, nil)
+		if err != nil {
+			t.Errorf("n=%d: unexpected CholeskySPD32 error: %v", n, err)
+		} else if d := maxDiff32(c.Data, c64.Data); d > tol32 {
+			t.Errorf("n=%d: CholeskySPD32 mismatch: %v", n, d)
+		}
+	}
+
+	// A singular matrix needs a ridge.
+	s64 := randomSPD(rnd, 6, 3)
+	s := symmetric32(s64)
+	_, ridge, err := CholeskySPD32(s, &Jitter{Ridge: 1e-4})
+	if err != nil || ridge <= 0 {
+		t.Errorf("unexpected jittered CholeskySPD32 result: ridge %v, %v", ridge, err)
+	}
+}
+
+func TestSolvers32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, n, nrhs int }{
+		{1, 1, 1}, {5, 5, 2}, {9, 4, 3}, {4, 7, 1},
+	} {
+		m, n, nrhs := test.m, test.n, test.nrhs
+		a64 := randomGeneral(rnd, m, n, n)
+		b64 := randomGeneral(rnd, m, nrhs, nrhs)
+		a := round32(a64)
+		b := round32(b64)
+
+		want := MinNormSolve(a64, b64, -1)
+		got := MinNormSolve32(a, b, -1)
+		if got.Rank != want.Rank {
+			t.Errorf("m=%d,n=%d: MinNormSolve32 rank mismatch: got %d want %d", m, n, got.Rank, want.Rank)
+		} else if d := maxDiff32(got.X.Data, want.X.Data); d > tol32 {
+			t.Errorf("m=%d,n=%d: MinNormSolve32 mismatch: %v", m, n, d)
+		}
+
+		if m >= n {
+			c64, err64 := QRCond(a64)
+			c, err := QRCond32(a)
+			if (err == nil) != (err64 == nil) || len(c.Deficient) != len(c64.Deficient) {
+				t.Errorf("m=%d,n=%d: QRCond32 mismatch: %v, %v", m, n, err, err64)
+			} else if r := float64(c.RCond) / c64.RCond; r < 0.5 || r > 2 {
+				t.Errorf("m=%d,n=%d: QRCond32 rcond mismatch: got %v want %v", m, n, c.RCond, c64.RCond)
+			}
+		}
+
+		if m == n {
+			for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				x64, _, err := SolveExpert(trans, a64, b64, true)
+				if err != nil {
+					t.Fatalf("m=%d: unexpected error: %v", m, err)
+				}
+				x, info, err := SolveExpert32(trans, a, b, true)
+				if err != nil || len(info.ForwardErr) != nrhs {
+					t.Errorf("m=%d: unexpected SolveExpert32 result: %v", m, err)
+				} else if d := maxDiff32(x.Data, x64.Data); d > tol32 {
+					t.Errorf("m=%d: SolveExpert32 mismatch: %v", m, d)
+				}
+			}
+
+			spd64 := newGeneral(m, m)
+			blas64.Gemm(blas.NoTrans, blas.Trans, 1, a64, a64, 0, spd64)
+			for i := 0; i < m; i++ {
+				spd64.Data[i*spd64.Stride+i] += float64(m)
+			}
+			spd := round32(spd64)
+			spd64 = general64(spd)
+			x64, _, err := SPDSolveExpert(blas64.Symmetric{Uplo: blas.Lower, N: m, Stride: spd64.Stride, Data: spd64.Data}, b64, true)
+			if err != nil {
+				t.Fatalf("m=%d: unexpected error: %v", m, err)
+			}
+			x, info, err := SPDSolveExpert32(blas32.Symmetric{Uplo: blas.Lower, N: m, Stride: spd.Stride, Data: spd.Data}, b, true)
+			if err != nil || len(info.ForwardErr) != nrhs {
+				t.Errorf("m=%d: unexpected SPDSolveExpert32 result: %v", m, err)
+			} else if d := maxDiff32(x.Data, x64.Data); d > tol32 {
+				t.Errorf("m=%d: SPDSolveExpert32 mismatch: %v", m, d)
+			}
+		}
+
+		bv64 := make([]float64, m)
+		copy(bv64, b64.Data)
+		bv := make([]float32, m)
+		for i, v := range bv64 {
+			bv[i] = float32(v)
+		}
+		x64, rnorm64, _ := NNLS(a64, bv64)
+		x, rnorm, err := NNLS32(a, bv)
+		if err != nil || math.Abs(float64(rnorm)-rnorm64) > tol32*math.Max(1, rnorm64) {
+			t.Errorf("m=%d,n=%d: NNLS32 mismatch: rnorm %v want %v, %v", m, n, rnorm, rnorm64, err)
+		} else if d := maxDiff32(x, x64); d > tol32 {
+			t.Errorf("m=%d,n=%d: NNLS32 solution mismatch: %v", m, n, d)
+		}
+	}
+}
+
+func TestBandLeastSquares32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, n, kl, ku int }{
+		{1, 1, 0, 0}, {10, 4, 2, 1}, {6, 6, 1, 1}, {4, 8, 0, 2},
+	} {
+		a64, _ := randomBand(rnd, test.m, test.n, test.kl, test.ku)
+		a := blas32.Band{Rows: a64.Rows, Cols: a64.Cols, KL: a64.KL, KU: a64.KU, Stride: a64.Stride}
+		a.Data = round32(blas64.General{Rows: 1, Cols: len(a64.Data), Stride: len(a64.Data), Data: a64.Data}).Data
+		b64 := randomGeneral(rnd, 1, test.m, test.m).Data
+		b := round32(blas64.General{Rows: 1, Cols: len(b64), Stride: len(b64), Data: b64}).Data
+
+		x64, rank64, rnorm64, _ := BandLeastSquares(a64, b64, -1)
+		x, rank, rnorm, err := BandLeastSquares32(a, b, -1)
+		if err != nil || rank != rank64 || math.Abs(float64(rnorm)-rnorm64) > tol32*math.Max(1, rnorm64) {
+			t.Errorf("%+v: unexpected result: rank %d want %d, rnorm %v want %v, %v", test, rank, rank64, rnorm, rnorm64, err)
+		} else if d := maxDiff32(x, x64); d > tol32 {
+			t.Errorf("%+v: solution mismatch: %v", test, d)
+		}
+	}
+}
+
+func TestBandSolveExpert32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ n, kl, ku, nrhs int }{
+		{1, 0, 0, 1}, {10, 2, 1, 2}, {6, 1, 3, 1},
+	} {
+		a64, _ := randomBand(rnd, test.n, test.n, test.kl, test.ku)
+		// Make A diagonally dominant so that it is well conditioned.
+		for i := 0; i < test.n; i++ {
+			a64.Data[i*a64.Stride+test.kl] += 10
+		}
+		a := blas32.Band{Rows: a64.Rows, Cols: a64.Cols, KL: a64.KL, KU: a64.KU, Stride: a64.Stride}
+		a.Data = round32(blas64.General{Rows: 1, Cols: len(a64.Data), Stride: len(a64.Data), Data: a64.Data}).Data
+		b64 := randomGeneral(rnd, test.n, test.nrhs, test.nrhs)
+		b := round32(b64)
+
+		x64, info64, _ := BandSolveExpert(blas.NoTrans, a64, b64)
+		x, info, err := BandSolveExpert32(blas.NoTrans, a, b)
+		if err != nil || math.Abs(float64(info.RCond)-info64.RCond) > tol32*info64.RCond {
+			t.Errorf("%+v: unexpected result: RCond %v want %v, %v", test, info.RCond, info64.RCond, err)
+		} else if d := maxDiff32(x.Data, x64.Data); d > tol32 {
+			t.Errorf("%+v: solution mismatch: %v", test, d)
+		}
+	}
+}
+
+func TestInertia32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	w := []float64{-3, -1, 0.5, 2, 2, 7}
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		a64 := symmetricWithEigenvalues(rnd, uplo, w)
+		a := symmetric32(a64)
+		for _, shift := range []float64{-4, 0, 1, 2.5, 8} {
+			want := SymInertia(a64, shift)
+			if got := SymInertia32(a, float32(shift)); got != want {
+				t.Errorf("uplo=%c,shift=%v: SymInertia32 mismatch: got %+v want %+v", uplo, shift, got, want)
+			}
+		}
+
+		c := NewEigenCounter32(a)
+		if got := c.Count(-2, 2.5); got != 4 {
+			t.Errorf("uplo=%c: unexpected count: got %d want 4", uplo, got)
+		}
+		ev, err := c.Eigenvalues(0, 10)
+		if err != nil || maxDiff32(ev, w[2:]) > tol32 {
+			t.Errorf("uplo=%c: unexpected eigenvalues: got %v want %v, %v", uplo, ev, w[2:], err)
+		}
+	}
+}
+
+func TestKron32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	a64 := randomGeneral(rnd, 3, 4, 5)
+	b64 := randomGeneral(rnd, 2, 4, 4)
+	a := round32(a64)
+	b := round32(b64)
+
+	if d := maxDiff32(Kron32(a, b).Data, Kron(a64, b64).Data); d > tol32 {
+		t.Errorf("Kron32 mismatch: %v", d)
+	}
+	if d := maxDiff32(KhatriRao32(a, b).Data, KhatriRao(a64, b64).Data); d > tol32 {
+		t.Errorf("KhatriRao32 mismatch: %v", d)
+	}
+	for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		n := 16
+		if trans != blas.NoTrans {
+			n = 6
+		}
+		x64 := randomGeneral(rnd, 1, n, n).Data
+		x := round32(blas64.General{Rows: 1, Cols: n, Stride: n, Data: x64}).Data
+		if d := maxDiff32(KronMulVec32(trans, a, b, x), KronMulVec(trans, a64, b64, x64)); d > tol32 {
+			t.Errorf("trans=%c: KronMulVec32 mismatch: %v", trans, d)
+		}
+	}
+	x64 := randomGeneral(rnd, 1, 4, 4).Data
+	x := round32(blas64.General{Rows: 1, Cols: 4, Stride: 4, Data: x64}).Data
+	if d := maxDiff32(KhatriRaoMulVec32(a, b, x), KhatriRaoMulVec(a64, b64, x64)); d > tol32 {
+		t.Errorf("KhatriRaoMulVec32 mismatch: %v", d)
+	}
+	y64 := randomGeneral(rnd, 1, 6, 6).Data
+	y := round32(blas64.General{Rows: 1, Cols: 6, Stride: 6, Data: y64}).Data
+	if d := maxDiff32(KhatriRaoTransMulVec32(a, b, y), KhatriRaoTransMulVec(a64, b64, y64)); d > tol32 {
+		t.Errorf("KhatriRaoTransMulVec32 mismatch: %v", d)
+	}
+}
+
+func TestBatch32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	const count = 5
+	var mats []blas64.General
+	in := make(chan blas32.General, count)
+	for i := 0; i < count; i++ {
+		a64 := randomGeneral(rnd, 4+i, 3, 3)
+		in <- round32(a64)
+		mats = append(mats, a64)
+	}
+	close(in)
+	var got int
+	for r := range (Batch{Workers: 2}).SVD32(in) {
+		got++
+		s64, _, _, _ := svd(Gesvd, cloneGeneral(mats[r.Index]), 'S', nil)
+		if r.Err != nil || maxDiff32(r.S, s64) > tol32 {
+			t.Errorf("matrix %d: unexpected singular values: got %v want %v, %v", r.Index, r.S, s64, r.Err)
+		}
+	}
+	if got != count {
+		t.Errorf("unexpected number of results: got %d want %d", got, count)
+	}
+
+	sin := make(chan blas32.Symmetric, 1)
+	sin <- symmetric32(randomSPD(rnd, 4, 4))
+	close(sin)
+	for r := range (Batch{}).SymEig32(sin) {
+		if r.Err != nil || len(r.Values) != 4 || r.Vectors.Rows != 4 {
+			t.Errorf("unexpected SymEig32 result: %+v", r)
+		}
+	}
+
+	pin := make(chan blas32.General, 1)
+	pin <- round32(randomGeneral(rnd, 5, 3, 3))
+	close(pin)
+	for r := range (Batch{}).PInv32(pin, 1e-6) {
+		if r.Err != nil || r.Rank != 3 || r.PInv.Rows != 3 || r.PInv.Cols != 5 {
+			t.Errorf("unexpected PInv32 result: %+v", r)
+		}
+	}
+}
+
+func TestResize32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	a64 := randomGeneral(rnd, 4, 3, 5)
+	a := round32(a64)
+	want := Reshape(Compact(Resize(a64, 3, 2)), 2, 3)
+	got := Reshape32(Compact32(Resize32(a, 3, 2)), 2, 3)
+	if got.Rows != 2 || got.Cols != 3 || got.Stride != 3 {
+		t.Fatalf("unexpected shape %d×%d, stride %d", got.Rows, got.Cols, got.Stride)
+	}
+	if d := maxDiff32(got.Data, want.Data); d != 0 {
+		t.Errorf("unexpected elements: difference %v", d)
+	}
+	Release32(got)
+}
+
+func TestSchur32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	a64 := randomGeneral(rnd, 7, 7, 7)
+	a := round32(a64)
+	tm, z, w, sdim, err := Schur32(a, func(λ complex64) bool { return real(λ) < 0 })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fact, orth := schurResidual(general64(a), general64(tm), general64(z))
+	if fact > tol32 || orth > tol32 {
+		t.Errorf("unexpected residual: |A-ZTZ^T|=%v, |Z^TZ-I|=%v", fact, orth)
+	}
+	for i, v := range w {
+		if (real(v) < 0) != (i < sdim) {
+			t.Errorf("selected eigenvalues not leading: sdim=%d, w=%v", sdim, w)
+			break
+		}
+	}
+}
+
+func TestQRPivot32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	a64 := mul(randomGeneral(rnd, 8, 3, 3), randomGeneral(rnd, 3, 5, 5))
+	a := round32(a64)
+	f := QRPivot32(a)
+	if got := f.Rank(-1); got != 3 {
+		t.Errorf("unexpected rank %d", got)
+	}
+	r := f.R()
+	rp := newGeneral32(8, 5)
+	for i := 0; i < r.Rows; i++ {
+		copy(rp.Data[i*rp.Stride:i*rp.Stride+5], r.Data[i*r.Stride:])
+	}
+	qr := general64(f.ApplyQ(blas.NoTrans, rp))
+	ap := newGeneral(8, 5)
+	for i := 0; i < 8; i++ {
+		for j, p := range f.Perm {
+			ap.Data[i*ap.Stride+j] = float64(a.Data[i*a.Stride+p])
+		}
+	}
+	if d := maxDiff(qr, ap, false); d > tol32 {
+		t.Errorf("|Q*R - A*P| = %v", d)
+	}
+}
+
+func TestLeastSquares32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, n, nrhs int }{
+		{1, 1, 1}, {5, 5, 2}, {40, 4, 3}, {4, 7, 1},
+	} {
+		m, n, nrhs := test.m, test.n, test.nrhs
+		a64 := randomGeneral(rnd, m, n, n)
+		b64 := randomGeneral(rnd, m, nrhs, nrhs)
+		a := round32(a64)
+		b := round32(b64)
+
+		want, err := LeastSquares(a64, b64)
+		if err != nil {
+			t.Fatalf("m=%d,n=%d: unexpected error: %v", m, n, err)
+		}
+		got, err := LeastSquares32(a, b)
+		if err != nil {
+			t.Errorf("m=%d,n=%d: unexpected error from LeastSquares32: %v", m, n, err)
+		} else if d := maxDiff32(got.Data, want.Data); d > tol32 {
+			t.Errorf("m=%d,n=%d: LeastSquares32 mismatch: %v", m, n, d)
+		}
+
+		wantX, _, wantRank, err := LeastSquaresSVD(a64, b64, -1)
+		if err != nil {
+			t.Fatalf("m=%d,n=%d: unexpected error: %v", m, n, err)
+		}
+		gotX, _, gotRank, err := LeastSquaresSVD32(a, b, -1)
+		switch {
+		case err != nil:
+			t.Errorf("m=%d,n=%d: unexpected error from LeastSquaresSVD32: %v", m, n, err)
+		case gotRank != wantRank:
+			t.Errorf("m=%d,n=%d: LeastSquaresSVD32 rank mismatch: got %d want %d", m, n, gotRank, wantRank)
+		default:
+			if d := maxDiff32(gotX.Data, wantX.Data); d > tol32 {
+				t.Errorf("m=%d,n=%d: LeastSquaresSVD32 mismatch: %v", m, n, d)
+			}
+		}
+	}
+}
+
+func TestInverse32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 4, 9} {
+		a64 := randomGeneral(rnd, n, n, n)
+		for i := 0; i < n; i++ {
+			a64.Data[i*a64.Stride+i] += float64(n)
+		}
+		a := round32(a64)
+		a64 = general64(a)
+
+		want, err := Inverse(a64)
+		if err != nil {
+			t.Fatalf("n=%d: unexpected error: %v", n, err)
+		}
+		got, err := Inverse32(a)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error from Inverse32: %v", n, err)
+		} else if d := maxDiff32(got.Data, want.Data); d > tol32 {
+			t.Errorf("n=%d: Inverse32 mismatch: %v", n, d)
+		}
+
+		aat := newGeneral(n, n)
+		blas64.Gemm(blas.NoTrans, blas.Trans, 1, a64, a64, 0, aat)
+		aat32 := round32(aat)
+		s := blas32.Symmetric{Uplo: blas.Lower, N: n, Stride: aat32.Stride, Data: aat32.Data}
+		s64 := blas64.Symmetric{Uplo: blas.Lower, N: n, Stride: n, Data: general64(aat32).Data}
+		wantS, err := SPDInverse(s64)
+		if err != nil {
+			t.Fatalf("n=%d: unexpected error: %v", n, err)
+		}
+		gotS, err := SPDInverse32(s)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error from SPDInverse32: %v", n, err)
+		} else if d := maxDiff32(gotS.Data, wantS.Data); d > tol32 {
+			t.Errorf("n=%d: SPDInverse32 mismatch: %v", n, d)
+		}
+
+		tr := blas32.Triangular{Uplo: blas.Upper, Diag: blas.NonUnit, N: n, Stride: a.Stride, Data: a.Data}
+		wantT, err := TriangularInverse(blas64.Triangular{Uplo: blas.Upper, Diag: blas.NonUnit, N: n, Stride: a64.Stride, Data: a64.Data})
+		if err != nil {
+			t.Fatalf("n=%d: unexpected error: %v", n, err)
+		}
+		gotT, err := TriangularInverse32(tr)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error from TriangularInverse32: %v", n, err)
+		} else if d := maxDiff32(gotT.Data, wantT.Data); d > tol32 {
+			t.Errorf("n=%d: TriangularInverse32 mismatch: %v", n, d)
+		}
+	}
+}
+
+func TestBandSolve32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ n, kl, ku, nrhs int }{
+		{1, 0, 0, 1}, {12, 2, 1, 2}, {7, 1, 3, 1},
+	} {
+		a64, _ := randomBand(rnd, test.n, test.n, test.kl, test.ku)
+		for i := 0; i < test.n; i++ {
+			a64.Data[i*a64.Stride+test.kl] += 10
+		}
+		a := blas32.Band{Rows: a64.Rows, Cols: a64.Cols, KL: a64.KL, KU: a64.KU, Stride: a64.Stride}
+		a.Data = round32(blas64.General{Rows: 1, Cols: len(a64.Data), Stride: len(a64.Data), Data: a64.Data}).Data
+		b64 := randomGeneral(rnd, test.n, test.nrhs, test.nrhs)
+		b := round32(b64)
+
+		x64, _ := BandSolve(a64, b64)
+		x, err := BandSolve32(a, b)
+		if err != nil {
+			t.Errorf("%+v: unexpected error: %v", test, err)
+		} else if d := maxDiff32(x.Data, x64.Data); d > tol32 {
+			t.Errorf("%+v: solution mismatch: %v", test, d)
+		}
+
+		kd := test.kl
+		s64, _ := symBand(randomSPDBand(rnd, test.n, kd), kd, blas.Lower)
+		s := blas32.SymmetricBand{Uplo: blas.Lower, N: test.n, K: kd, Stride: s64.Stride}
+		s.Data = round32(blas64.General{Rows: 1, Cols: len(s64.Data), Stride: len(s64.Data), Data: s64.Data}).Data
+		xs64, _ := SPDBandSolve(s64, b64)
+		xs, err := SPDBandSolve32(s, b)
+		if err != nil {
+			t.Errorf("%+v: unexpected error: %v", test, err)
+		} else if d := maxDiff32(xs.Data, xs64.Data); d > tol32 {
+			t.Errorf("%+v: SPD solution mismatch: %v", test, d)
+		}
+	}
+}
+
+func TestTridiag32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	vec32 := func(x []float64) []float32 {
+		return round32(blas64.General{Rows: 1, Cols: len(x), Stride: max(1, len(x)), Data: x}).Data
+	}
+	for _, n := range []int{1, 2, 15} {
+		dl64, d64, du64, _ := randomTridiag(rnd, n, false)
+		for i := range d64 {
+			d64[i] += 4
+		}
+		b64 := randomGeneral(rnd, n, 2, 2)
+		b := round32(b64)
+
+		x64, _ := TridiagSolve(dl64, d64, du64, b64)
+		x, err := TridiagSolve32(vec32(dl64), vec32(d64), vec32(du64), b)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error: %v", n, err)
+		} else if d := maxDiff32(x.Data, x64.Data); d > tol32 {
+			t.Errorf("n=%d: solution mismatch: %v", n, d)
+		}
+
+		e64, d64, _, _ := randomTridiag(rnd, n, true)
+		xs64, _ := SPDTridiagSolve(d64, e64, b64)
+		xs, err := SPDTridiagSolve32(vec32(d64), vec32(e64), b)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error: %v", n, err)
+		} else if d := maxDiff32(xs.Data, xs64.Data); d > tol32 {
+			t.Errorf("n=%d: SPD solution mismatch: %v", n, d)
+		}
+
+		w64, _, _ := SymTridiagEig(d64, e64, 0, n)
+		w, v, err := SymTridiagEig32(vec32(d64), vec32(e64), 0, n)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error: %v", n, err)
+			continue
+		}
+		if d := maxDiff32(w, w64); d > tol32 {
+			t.Errorf("n=%d: eigenvalue mismatch: %v", n, d)
+		}
+		if d := orthonormalityError(general64(v)); d > tol32 {
+			t.Errorf("n=%d: eigenvectors not orthonormal: %v", n, d)
+		}
+	}
+}
+
+func TestQRFactorize32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	a64 := randomGeneral(rnd, 9, 4, 4)
+	a := round32(a64)
+	q, r := QRFactorize32(a)
+	x := newGeneral32(9, 4)
+	for i := 0; i < r.Rows; i++ {
+		copy(x.Data[i*x.Stride:i*x.Stride+4], r.Data[i*r.Stride:])
+	}
+	q.ApplyTo(blas.NoTrans, x)
+	if d := maxDiff32(x.Data, general64(a).Data); d > tol32 {
+		t.Errorf("|Q*R - A| = %v", d)
+	}
+	if d := orthonormalityError(general64(q.Materialize())); d > tol32 {
+		t.Errorf("Q not orthogonal: %v", d)
+	}
+	if d := orthonormalityError(general64(q.MaterializeThin())); d > tol32 {
+		t.Errorf("thin Q not orthonormal: %v", d)
+	}
+}
+
+func TestCond32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	a64 := general64(round32(graded(rnd, 12, 0.3)))
+	a := round32(a64)
+	for _, norm := range []lapack.MatrixNorm{lapack.MaxColumnSum, lapack.MaxRowSum} {
+		anorm := impl.Dlange(norm, 12, 12, a64.Data, a64.Stride, make([]float64, 12))
+		lu64 := cloneGeneral(a64)
+		impl.Dgetrf(12, 12, lu64.Data, lu64.Stride, make([]int, 12))
+		want := LUCond(norm, lu64, anorm)
+		lu := cloneGeneral32(a)
+		impl.Sgetrf(12, 12, lu.Data, lu.Stride, make([]int, 12))
+		got := LUCond32(norm, lu, float32(anorm))
+		if r := float64(got) / want; r < 0.5 || r > 2 {
+			t.Errorf("norm=%c: LUCond32 mismatch: got %v want %v", norm, got, want)
+		}
+
+		tri := blas32.Triangular{Uplo: blas.Upper, Diag: blas.NonUnit, N: 12, Stride: lu.Stride, Data: lu.Data}
+		tri64 := blas64.Triangular{Uplo: blas.Upper, Diag: blas.NonUnit, N: 12, Stride: lu64.Stride, Data: lu64.Data}
+		if r := float64(TriangularCond32(norm, tri)) / TriangularCond(norm, tri64); r < 0.5 || r > 2 {
+			t.Errorf("norm=%c: TriangularCond32 mismatch", norm)
+		}
+	}
+
+	s64 := randomSPD(rnd, 9, 10)
+	s := round32(blas64.General{Rows: 9, Cols: 9, Stride: s64.Stride, Data: s64.Data})
+	s64.Data = general64(s).Data
+	anorm := impl.Dlange(lapack.MaxColumnSum, 9, 9, s64.Data, s64.Stride, make([]float64, 9))
+	f64, _, err64 := CholeskySPD(s64, nil)
+	f, _, err := CholeskySPD32(blas32.Symmetric{Uplo: blas.Upper, N: 9, Stride: s.Stride, Data: s.Data}, nil)
+	if err != nil || err64 != nil {
+		t.Fatalf("unexpected errors: %v, %v", err, err64)
+	}
+	if r := float64(CholCond32(f, float32(anorm))) / CholCond(f64, anorm); r < 0.5 || r > 2 {
+		t.Errorf("CholCond32 mismatch")
+	}
+}
+
+func TestSafeTriangularSolve32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	a64 := general64(round32(randomGeneral(rnd, 10, 10, 10)))
+	for i := 0; i < 10; i++ {
+		a64.Data[i*a64.Stride+i] += 10
+	}
+	a := round32(a64)
+	bg := round32(randomGeneral(rnd, 1, 10, 10))
+	b := bg.Data
+	b64 := general64(bg).Data
+	tri64 := blas64.Triangular{Uplo: blas.Lower, Diag: blas.NonUnit, N: 10, Stride: a64.Stride, Data: a64.Data}
+	tri := blas32.Triangular{Uplo: blas.Lower, Diag: blas.NonUnit, N: 10, Stride: a.Stride, Data: a.Data}
+	want, _ := SafeTriangularSolve(blas.Trans, tri64, b64)
+	got, scale := SafeTriangularSolve32(blas.Trans, tri, b)
+	if scale != 1 {
+		t.Errorf("unexpected scale %v", scale)
+	}
+	if d := maxDiff32(got, want); d > tol32 {
+		t.Errorf("unexpected solution: difference %v", d)
+	}
+
+	// A zero diagonal element makes the solve by Strsv overflow.
+	a.Data[4*a.Stride+4] = 0
+	x, scale := SafeTriangularSolve32(blas.NoTrans, tri, b)
+	if scale != 0 || !allFinite32(x) {
+		t.Errorf("unexpected result for singular matrix: scale %v", scale)
+	}
+}
+
+func TestSylvester32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	a64 := general64(round32(shifted(rnd, 7, 8)))
+	b64 := general64(round32(shifted(rnd, 5, 8)))
+	c64 := general64(round32(randomGeneral(rnd, 7, 5, 5)))
+	want, err := Sylvester(a64, b64, c64)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, err := Sylvester32(round32(a64), round32(b64), round32(c64))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d := maxDiff32(got.Data, want.Data); d > tol32 {
+		t.Errorf("unexpected Sylvester32 solution: difference %v", d)
+	}
+
+	a64 = general64(round32(shifted(rnd, 6, -8)))
+	c64 = general64(round32(randomGeneral(rnd, 6, 6, 6)))
+	want, err = Lyapunov(a64, c64)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, err = Lyapunov32(round32(a64), round32(c64))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d := maxDiff32(got.Data, want.Data); d > tol32 {
+		t.Errorf("unexpected Lyapunov32 solution: difference %v", d)
+	}
+}
+
+func TestMatPow32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	s64 := randomSPD(rnd, 9, 12)
+	s := round32(blas64.General{Rows: 9, Cols: 9, Stride: s64.Stride, Data: s64.Data})
+	s64.Data = general64(s).Data
+	for _, p := range []float64{2, -1, 0.5, -0.5} {
+		want, err := MatPow(s64, p, nil)
+		if err != nil {
+			t.Fatalf("p=%v: unexpected error: %v", p, err)
+		}
+		got, err := MatPow32(blas32.Symmetric{Uplo: blas.Upper, N: 9, Stride: s.Stride, Data: s.Data}, p, nil)
+		if err != nil {
+			t.Fatalf("p=%v: unexpected error: %v", p, err)
+		}
+		if d := maxDiff32(got.Data, want.Data) / math.Max(1, maxAbs(want.Data)); d > tol32 {
+			t.Errorf("p=%v: unexpected power: relative difference %v", p, d)
+		}
+	}
+}
+
+func TestCholeskyRFP32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	s64 := randomSPD(rnd, 9, 12)
+	s := round32(blas64.General{Rows: 9, Cols: 9, Stride: s64.Stride, Data: s64.Data})
+	s64.Data = general64(s).Data
+	b := round32(randomGeneral(rnd, 9, 2, 2))
+	for _, transr := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		r := ToRFP32(blas32.Symmetric{Uplo: blas.Upper, N: 9, Stride: s.Stride, Data: s.Data}, transr)
+		if d := maxDiff32(r.Packed().Data, ToPacked(s64).Data); d != 0 {
+			t.Errorf("transr=%c: unexpected packed matrix", transr)
+		}
+		f, err := CholeskyRFP32(r)
+		if err != nil {
+			t.Fatalf("transr=%c: unexpected error: %v", transr, err)
+		}
+		want, err := CholeskyRFP(ToRFP(s64, transr))
+		if err != nil {
+			t.Fatalf("transr=%c: unexpected error: %v", transr, err)
+		}
+		got := CholSolveRFP32(f, b)
+		x := CholSolveRFP(want, general64(b))
+		if d := maxDiff32(got.Data, x.Data) / math.Max(1, maxAbs(x.Data)); d > tol32 {
+			t.Errorf("transr=%c: unexpected solution: relative difference %v", transr, d)
+		}
+	}
+}
+
+func TestBandDeterminants32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	a64, _ := randomBand(rnd, 12, 12, 2, 1)
+	a := blas32.Band{Rows: a64.Rows, Cols: a64.Cols, KL: a64.KL, KU: a64.KU, Stride: a64.Stride}
+	a.Data = round32(blas64.General{Rows: 1, Cols: len(a64.Data), Stride: len(a64.Data), Data: a64.Data}).Data
+	wantSign, want := BandSlogDet(a64)
+	if sign, got := BandSlogDet32(a); float64(sign) != wantSign || math.Abs(float64(got)-want) > tol32*math.Max(1, math.Abs(want)) {
+		t.Errorf("unexpected BandSlogDet32: got %v, %v want %v, %v", sign, got, wantSign, want)
+	}
+
+	ab64, _ := symBand(randomSymBand(rnd, 10, 2, true), 2, blas.Lower)
+	ab := blas32.SymmetricBand{Uplo: ab64.Uplo, N: ab64.N, K: ab64.K, Stride: ab64.Stride}
+	ab.Data = round32(blas64.General{Rows: 1, Cols: len(ab64.Data), Stride: len(ab64.Data), Data: ab64.Data}).Data
+	want, err := SPDBandLogDet(ab64)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, err := SPDBandLogDet32(ab); err != nil || math.Abs(float64(got)-want) > tol32*math.Max(1, math.Abs(want)) {
+		t.Errorf("unexpected SPDBandLogDet32: got %v want %v, %v", got, want, err)
+	}
+	for _, shift := range []float64{0, 2, 5} {
+		if got, want := SymBandInertia32(ab, float32(shift)), SymBandInertia(ab64, shift); got != want {
+			t.Errorf("shift=%v: SymBandInertia32 mismatch: got %+v want %+v", shift, got, want)
+		}
+	}
+
+	dl64, d64, du64, _ := randomTridiag(rnd, 15, false)
+	dl, d, du := make([]float32, 14), make([]float32, 15), make([]float32, 14)
+	for i := range d64 {
+		d[i] = float32(d64[i])
+		d64[i] = float64(d[i])
+		if i < 14 {
+			dl[i], du[i] = float32(dl64[i]), float32(du64[i])
+			dl64[i], du64[i] = float64(dl[i]), float64(du[i])
+		}
+	}
+	wantSign, want = TridiagSlogDet(dl64, d64, du64)
+	if sign, got := TridiagSlogDet32(dl, d, du); float64(sign) != wantSign || math.Abs(float64(got)-want) > tol32*math.Max(1, math.Abs(want)) {
+		t.Errorf("unexpected TridiagSlogDet32: got %v, %v want %v, %v", sign, got, wantSign, want)
+	}
+	if got, want := SymTridiagInertia32(d, dl, 0.5), SymTridiagInertia(d64, dl64, 0.5); got != want {
+		t.Errorf("SymTridiagInertia32 mismatch: got %+v want %+v", got, want)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// GenEVType specifies the form of the generalized symmetric-definite
+// eigenproblem solved by Dsygv and Dsygvd.
+type GenEVType int
+
+const (
+	// AxLambdaBx is the problem A * x = λ * B * x.
+	AxLambdaBx GenEVType = 1
+	// ABxLambdax is the problem A * B * x = λ * x.
+	ABxLambdax GenEVType = 2
+	// BAxLambdax is the problem B * A * x = λ * x.
+	BAxLambdax GenEVType = 3
+)
+
+const badGenEVType = "lapack: bad GenEVType"
+
+// GenSymEig solves the generalized symmetric-definite eigenproblem
+//
+//	A * x = λ * B * x
+//
+// for the symmetric n×n matrix A and the symmetric positive definite n×n
+// matrix B by the divide and conquer driver Dsygvd. The eigenvalues are
+// returned in ascending order in w, and the corresponding eigenvectors are
+// the columns of v, normalized so that
+//
+//	V^T * B * V = I.
+//
+// The inputs a and b are not modified and may store different triangles.
+//
+// If B is not positive definite, GenSymEig returns an ErrNotPositiveDefinite.
+// If the eigenvalue algorithm fails to converge, it returns
+// ErrIterationLimit.
+func GenSymEig(a, b blas64.Symmetric) (w []float64, v blas64.General, err error) {
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	if b.Uplo != blas.Upper && b.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	if a.N != b.N {
+		panic(badShapeB)
+	}
+	n := a.N
+	v = fullSymmetric(a)
+	w = make([]float64, n)
+	if n == 0 {
+		return w, v, nil
+	}
+	bc := fullSymmetric(b)
+	work := make([]float64, 1)
+	iwork := make([]lapacke.Int, 1)
+	lapacke.Dsygvd(int(AxLambdaBx), 'V', 'U', n, v.Data, v.Stride, bc.Data, bc.Stride, w, work, -1, iwork, -1)
+	work = make([]float64, int(work[0]))
+	iwork = make([]lapacke.Int, int(iwork[0]))
+	if lapacke.Dsygvd(int(AxLambdaBx), 'V', 'U', n, v.Data, v.Stride, bc.Data, bc.Stride, w, work, len(work), iwork, len(iwork)) {
+		return w, v, nil
+	}
+	// Dsygvd does not distinguish between a failed Cholesky factorization
+	// of B and a failure of the eigenvalue iteration, so factor B again to
+	// find out.
+	bc = fullSymmetric(b)
+	if info := lapacke.DpotrfInfo('U', n, bc.Data, bc.Stride); info > 0 {
+		return nil, v, ErrNotPositiveDefinite{Index: info - 1}
+	}
+	return nil, v, ErrIterationLimit
+}
+
+// GenEig computes the generalized eigenvalues and the right generalized
+// eigenvectors of the pair of n×n real matrices (A, B) by the QZ driver
+// Dggev. The eigenvalues are λ_j = alpha[j] / beta[j], where beta[j] >= 0 is
+// zero for an infinite eigenvalue, which arises when B is singular, and
+// the columns of v hold the eigenvectors, so that
+//
+//	beta[j] * A * v_j = alpha[j] * B * v_j.
+//
+// Complex conjugate eigenvalues appear consecutively with the one with
+// positive imaginary part first. Each eigenvector is scaled so that its
+// largest component has |real part| + |imag part| = 1. The inputs a and b
+// are not modified.
+//
+// If the QZ iteration fails to converge, GenEig returns ErrIterationLimit.
+func GenEig(a, b blas64.General) (alpha []complex128, beta []float64, v cblas128.General, err error) {
+	if a.Rows != a.Cols {
+		panic(badShapeA)
+	}
+	if b.Rows != a.Rows || b.Cols != a.Cols {
+		panic(badShapeB)
+	}
+	n := a.Rows
+	v = newCGeneral(n, n)
+	if n == 0 {
+		return nil, nil, v, nil
+	}
+	ac := cloneGeneral(a)
+	bc := cloneGeneral(b)
+	alphar := make([]float64, n)
+	alphai := make([]float64, n)
+	beta = make([]float64, n)
+	vr := newGeneral(n, n)
+	work := make([]float64, 1)
+	lapacke.Dggev('N', 'V', n, ac.Data, ac.Stride, bc.Data, bc.Stride, alphar, alphai, beta, nil, n, vr.Data, vr.Stride, work, -1)
+	work = make([]float64, int(work[0]))
+	if !lapacke.Dggev('N', 'V', n, ac.Data, ac.Stride, bc.Data, bc.Stride, alphar, alphai, beta, nil, n, vr.Data, vr.Stride, work, len(work)) {
+		return nil, nil, v, ErrIterationLimit
+	}
+
+	alpha = make([]complex128, n)
+	for j := 0; j < n; j++ {
+		alpha[j] = complex(alphar[j], alphai[j])
+		if alphai[j] == 0 {
+			for i := 0; i < n; i++ {
+				v.Data[i*v.Stride+j] = complex(vr.Data[i*vr.Stride+j], 0)
+			}
+			continue
+		}
+		// The columns j and j+1 hold the real and imaginary parts of the
+		// eigenvector of the eigenvalue with positive imaginary part.
+		alpha[j+1] = complex(alphar[j+1], alphai[j+1])
+		for i := 0; i < n; i++ {
+			re, im := vr.Data[i*vr.Stride+j], vr.Data[i*vr.Stride+j+1]
+			v.Data[i*v.Stride+j] = complex(re, im)
+			v.Data[i*v.Stride+j+1] = complex(re, -im)
+		}
+		j++
+	}
+	return alpha, beta, v, nil
+}
+
+// fullSymmetric returns a compact n×n general matrix holding both triangles
+// of the symmetric matrix a.
+func fullSymmetric(a blas64.Symmetric) blas64.General {
+	n := a.N
+	c := newGeneral(n, n)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			v := a.Data[i*a.Stride+j]
+			if a.Uplo == blas.Lower {
+				v = a.Data[j*a.Stride+i]
+			}
+			c.Data[i*c.Stride+j] = v
+			c.Data[j*c.Stride+i] = v
+		}
+	}
+	return c
+}
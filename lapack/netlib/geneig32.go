@@ -0,0 +1,62 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// GenSymEig32 is the float32 version of GenSymEig. The eigenpairs are
+// computed by Ssygvd.
+func GenSymEig32(a, b blas32.Symmetric) (w []float32, v blas32.General, err error) {
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	if b.Uplo != blas.Upper && b.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	if a.N != b.N {
+		panic(badShapeB)
+	}
+	n := a.N
+	v = fullSymmetric32(a)
+	w = make([]float32, n)
+	if n == 0 {
+		return w, v, nil
+	}
+	bc := fullSymmetric32(b)
+	work := make([]float32, 1)
+	iwork := make([]lapacke.Int, 1)
+	lapacke.Ssygvd(int(AxLambdaBx), 'V', 'U', n, v.Data, v.Stride, bc.Data, bc.Stride, w, work, -1, iwork, -1)
+	work = make([]float32, int(work[0]))
+	iwork = make([]lapacke.Int, int(iwork[0]))
+	if lapacke.Ssygvd(int(AxLambdaBx), 'V', 'U', n, v.Data, v.Stride, bc.Data, bc.Stride, w, work, len(work), iwork, len(iwork)) {
+		return w, v, nil
+	}
+	bc = fullSymmetric32(b)
+	if info := lapacke.SpotrfInfo('U', n, bc.Data, bc.Stride); info > 0 {
+		return nil, v, ErrNotPositiveDefinite{Index: info - 1}
+	}
+	return nil, v, ErrIterationLimit
+}
+
+// fullSymmetric32 is the float32 version of fullSymmetric.
+func fullSymmetric32(a blas32.Symmetric) blas32.General {
+	n := a.N
+	c := newGeneral32(n, n)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			v := a.Data[i*a.Stride+j]
+			if a.Uplo == blas.Lower {
+				v = a.Data[j*a.Stride+i]
+			}
+			c.Data[i*c.Stride+j] = v
+			c.Data[j*c.Stride+i] = v
+		}
+	}
+	return c
+}
@@ -0,0 +1,275 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// definitePencil returns a random symmetric n×n matrix A stored in the lower
+// triangle and a random symmetric positive definite n×n matrix B stored in
+// the upper triangle.
+func definitePencil(rnd *rand.Rand, n int) (a, b blas64.Symmetric) {
+	la := make([]float64, n)
+	lb := make([]float64, n)
+	for i := range la {
+		la[i] = 2*rnd.Float64() - 1
+		lb[i] = 1 + rnd.Float64()
+	}
+	return symmetricWithSpectrum(rnd, la, blas.Lower), symmetricWithSpectrum(rnd, lb, blas.Upper)
+}
+
+func TestDsygv(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 4, 11} {
+		for _, itype := range []GenEVType{AxLambdaBx, ABxLambdax, BAxLambdax} {
+			for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+				for _, divide := range []bool{false, true} {
+					name := fmt.Sprintf("n=%d,itype=%d,uplo=%c,divide=%t", n, itype, uplo, divide)
+					sa, sb := definitePencil(rnd, n)
+					a, b := fullSymmetric(sa), fullSymmetric(sb)
+					z, bc := cloneGeneral(a), cloneGeneral(b)
+					w := make([]float64, n)
+					work := make([]float64, 1)
+					var ok bool
+					if divide {
+						iwork := make([]int, 1)
+						impl.Dsygvd(itype, lapack.EVCompute, uplo, n, z.Data, max(1, z.Stride), bc.Data, max(1, bc.Stride), w, work, -1, iwork, -1)
+						work = make([]float64, int(work[0]))
+						iwork = make([]int, iwork[0])
+						ok = impl.Dsygvd(itype, lapack.EVCompute, uplo, n, z.Data, max(1, z.Stride), bc.Data, max(1, bc.Stride), w, work, len(work), iwork, len(iwork))
+					} else {
+						impl.Dsygv(itype, lapack.EVCompute, uplo, n, z.Data, max(1, z.Stride), bc.Data, max(1, bc.Stride), w, work, -1)
+						work = make([]float64, int(work[0]))
+						ok = impl.Dsygv(itype, lapack.EVCompute, uplo, n, z.Data, max(1, z.Stride), bc.Data, max(1, bc.Stride), w, work, len(work))
+					}
+					if !ok {
+						t.Errorf("%s: unexpected failure", name)
+						continue
+					}
+					if n == 0 {
+						continue
+					}
+					for i := 1; i < n; i++ {
+						if w[i] < w[i-1] {
+							t.Errorf("%s: eigenvalues not in ascending order", name)
+							break
+						}
+					}
+					// Compare both sides of the eigenproblem for each
+					// eigenvector z_j.
+					var lhs, rhs blas64.General
+					switch itype {
+					case AxLambdaBx:
+						lhs, rhs = mul(a, z), mul(b, z)
+					case ABxLambdax:
+						lhs, rhs = mul(a, mul(b, z)), z
+					case BAxLambdax:
+						lhs, rhs = mul(b, mul(a, z)), z
+					}
+					var r float64
+					for i := 0; i < n; i++ {
+						for j := 0; j < n; j++ {
+							r = math.Max(r, math.Abs(lhs.Data[i*lhs.Stride+j]-w[j]*rhs.Data[i*rhs.Stride+j]))
+						}
+					}
+					if r > tol*float64(n) {
+						t.Errorf("%s: residual %v too large", name, r)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestGenSymEig(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 5, 12} {
+		name := fmt.Sprintf("n=%d", n)
+		a, b := definitePencil(rnd, n)
+		origA, origB := cloneGeneral(fullSymmetric(a)), cloneGeneral(fullSymmetric(b))
+
+		w, v, err := GenSymEig(a, b)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if maxDiff(fullSymmetric(a), origA, false) != 0 || maxDiff(fullSymmetric(b), origB, false) != 0 {
+			t.Errorf("%s: input modified", name)
+		}
+		if len(w) != n || v.Rows != n || v.Cols != n {
+			t.Errorf("%s: unexpected result shape", name)
+			continue
+		}
+		if n == 0 {
+			continue
+		}
+		av := mul(origA, v)
+		bv := mul(origB, v)
+		var r float64
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				r = math.Max(r, math.Abs(av.Data[i*av.Stride+j]-w[j]*bv.Data[i*bv.Stride+j]))
+			}
+		}
+		if r > tol*float64(n) {
+			t.Errorf("%s: residual %v too large", name, r)
+		}
+		// The eigenvectors are B-orthonormal.
+		vtbv := newGeneral(n, n)
+		blas64.Gemm(blas.Trans, blas.NoTrans, 1, v, bv, 0, vtbv)
+		for i := 0; i < n; i++ {
+			vtbv.Data[i*vtbv.Stride+i] -= 1
+		}
+		if d := maxDiff(vtbv, newGeneral(n, n), false); d > tol*float64(n) {
+			t.Errorf("%s: V^T * B * V differs from the identity by %v", name, d)
+		}
+	}
+
+	// B with a negative eigenvalue is not positive definite.
+	rnd = rand.New(rand.NewSource(1))
+	a, _ := definitePencil(rnd, 4)
+	b := symmetricWithSpectrum(rnd, []float64{1, 2, -1, 3}, blas.Upper)
+	_, _, err := GenSymEig(a, b)
+	if _, ok := err.(ErrNotPositiveDefinite); !ok {
+		t.Errorf("unexpected error for indefinite B: got %v, want ErrNotPositiveDefinite", err)
+	}
+}
+
+func TestGenEig(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 6, 13} {
+		name := fmt.Sprintf("n=%d", n)
+		a := randomGeneral(rnd, n, n, max(1, n))
+		b := randomGeneral(rnd, n, n, max(1, n))
+		origA, origB := cloneGeneral(a), cloneGeneral(b)
+
+		alpha, beta, v, err := GenEig(a, b)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if maxDiff(a, origA, false) != 0 || maxDiff(b, origB, false) != 0 {
+			t.Errorf("%s: input modified", name)
+		}
+		if len(alpha) != n || len(beta) != n || v.Rows != n || v.Cols != n {
+			t.Errorf("%s: unexpected result shape", name)
+			continue
+		}
+		// Check beta[j] * A * v_j = alpha[j] * B * v_j.
+		var r float64
+		for j := 0; j < n; j++ {
+			for i := 0; i < n; i++ {
+				var av, bv complex128
+				for k := 0; k < n; k++ {
+					av += complex(a.Data[i*a.Stride+k], 0) * v.Data[k*v.Stride+j]
+					bv += complex(b.Data[i*b.Stride+k], 0) * v.Data[k*v.Stride+j]
+				}
+				r = math.Max(r, cmplx.Abs(complex(beta[j], 0)*av-alpha[j]*bv))
+			}
+		}
+		if r > tol*float64(n) {
+			t.Errorf("%s: residual %v too large", name, r)
+		}
+	}
+
+	// A singular B gives an infinite eigenvalue.
+	a := blas64.General{Rows: 3, Cols: 3, Stride: 3, Data: []float64{1, 0, 0, 0, 2, 0, 0, 0, 3}}
+	b := blas64.General{Rows: 3, Cols: 3, Stride: 3, Data: []float64{2, 0, 0, 0, 2, 0, 0, 0, 0}}
+	alpha, beta, _, err := GenEig(a, b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var infinite int
+	for j := range alpha {
+		if beta[j] == 0 {
+			infinite++
+			continue
+		}
+		if l := real(alpha[j]) / beta[j]; math.Abs(l-0.5) > tol && math.Abs(l-1) > tol {
+			t.Errorf("unexpected finite eigenvalue %v", l)
+		}
+	}
+	if infinite != 1 {
+		t.Errorf("unexpected number of infinite eigenvalues: got %d, want 1", infinite)
+	}
+}
+
+func TestDggev(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 7} {
+		name := fmt.Sprintf("n=%d", n)
+		// A symmetric-definite pencil has real eigenvalues, so the left
+		// and right eigenvectors are real.
+		sa, sb := definitePencil(rnd, n)
+		a, b := fullSymmetric(sa), fullSymmetric(sb)
+		ac, bc := cloneGeneral(a), cloneGeneral(b)
+		ld := max(1, n)
+		alphar := make([]float64, n)
+		alphai := make([]float64, n)
+		beta := make([]float64, n)
+		vl := make([]float64, n*ld)
+		vr := make([]float64, n*ld)
+		work := make([]float64, 1)
+		impl.Dggev(lapack.LeftEVCompute, lapack.RightEVCompute, n, ac.Data, ld, bc.Data, ld, alphar, alphai, beta, vl, ld, vr, ld, work, -1)
+		work = make([]float64, int(work[0]))
+		if !impl.Dggev(lapack.LeftEVCompute, lapack.RightEVCompute, n, ac.Data, ld, bc.Data, ld, alphar, alphai, beta, vl, ld, vr, ld, work, len(work)) {
+			t.Errorf("%s: unexpected failure", name)
+			continue
+		}
+		if n == 0 {
+			continue
+		}
+		right := blas64.General{Rows: n, Cols: n, Stride: ld, Data: vr}
+		left := blas64.General{Rows: n, Cols: n, Stride: ld, Data: vl}
+		avr, bvr := mul(a, right), mul(b, right)
+		// A and B are symmetric, so u^H * A = (A * u)^H.
+		avl, bvl := mul(a, left), mul(b, left)
+		var r float64
+		for j := 0; j < n; j++ {
+			if alphai[j] != 0 {
+				t.Errorf("%s: unexpected complex eigenvalue", name)
+				break
+			}
+			for i := 0; i < n; i++ {
+				r = math.Max(r, math.Abs(beta[j]*avr.Data[i*ld+j]-alphar[j]*bvr.Data[i*ld+j]))
+				r = math.Max(r, math.Abs(beta[j]*avl.Data[i*ld+j]-alphar[j]*bvl.Data[i*ld+j]))
+			}
+		}
+		if r > tol*float64(n) {
+			t.Errorf("%s: residual %v too large", name, r)
+		}
+	}
+}
+
+func TestGenSymEig32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	const n = 6
+	a, b := definitePencil(rnd, n)
+	want, _, err := GenSymEig(a, b)
+	if err != nil {
+		t.Fatalf("unexpected GenSymEig error: %v", err)
+	}
+	w, v, err := GenSymEig32(symmetric32(a), symmetric32(b))
+	if err != nil || v.Rows != n || v.Cols != n {
+		t.Fatalf("unexpected GenSymEig32 result: err=%v", err)
+	}
+	if d := maxDiff32(w, want); d > tol32 {
+		t.Errorf("unexpected GenSymEig32 eigenvalues: got %v, want %v", w, want)
+	}
+}
@@ -3,5 +3,6 @@
 // license that can be found in the LICENSE file.
 
 //go:generate go run generate_errors.go
+//go:generate go run generate_errimpl.go
 
 package netlib
@@ -0,0 +1,151 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// badlyScaledPencil returns an n×n matrix with rows and columns scaled by
+// powers of two of widely differing magnitudes. If isolate is true, the
+// last row is zero except for its diagonal element, so that balancing can
+// isolate an eigenvalue by permutation.
+func badlyScaledPencil(rnd *rand.Rand, n int, isolate bool) blas64.General {
+	a := randomGeneral(rnd, n, n, n)
+	for i := 0; i < n; i++ {
+		ri := math.Ldexp(1, rnd.Intn(21)-10)
+		for j := 0; j < n; j++ {
+			a.Data[i*a.Stride+j] *= ri
+			a.Data[j*a.Stride+i] *= math.Ldexp(1, rnd.Intn(21)-10)
+		}
+	}
+	if isolate && n > 1 {
+		for j := 0; j < n-1; j++ {
+			a.Data[(n-1)*a.Stride+j] = 0
+		}
+	}
+	return a
+}
+
+// eye returns the n×n identity matrix.
+func eye(n int) blas64.General {
+	e := newGeneral(n, n)
+	for i := 0; i < n; i++ {
+		e.Data[i*e.Stride+i] = 1
+	}
+	return e
+}
+
+func TestDggbal(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 5, 12} {
+		for _, job := range []lapack.BalanceJob{lapack.BalanceNone, lapack.Permute, lapack.Scale, lapack.PermuteScale} {
+			name := fmt.Sprintf("n=%d,job=%c", n, job)
+			a := badlyScaledPencil(rnd, n, true)
+			b := badlyScaledPencil(rnd, n, true)
+			ab, bb := cloneGeneral(a), cloneGeneral(b)
+			lscale := make([]float64, n)
+			rscale := make([]float64, n)
+			work := make([]float64, 6*n)
+			ilo, ihi := impl.Dggbal(job, n, ab.Data, max(1, ab.Stride), bb.Data, max(1, bb.Stride), lscale, rscale, work)
+			if n == 0 {
+				if ilo != 0 || ihi != -1 {
+					t.Errorf("%s: unexpected ilo=%d, ihi=%d", name, ilo, ihi)
+				}
+				continue
+			}
+			if ilo < 0 || ihi < ilo || n <= ihi {
+				t.Errorf("%s: invalid ilo=%d, ihi=%d", name, ilo, ihi)
+				continue
+			}
+			if (job == lapack.BalanceNone || job == lapack.Scale) && (ilo != 0 || ihi != n-1) {
+				t.Errorf("%s: unexpected ilo=%d, ihi=%d", name, ilo, ihi)
+			}
+
+			// The transformations X_l and X_r formed by Dggbak from the
+			// identity satisfy A' = X_l^T * A * X_r and B' = X_l^T * B * X_r.
+			xl, xr := eye(n), eye(n)
+			impl.Dggbak(job, lapack.EVLeft, n, ilo, ihi, lscale, rscale, n, xl.Data, xl.Stride)
+			impl.Dggbak(job, lapack.EVRight, n, ilo, ihi, lscale, rscale, n, xr.Data, xr.Stride)
+			for _, m := range []struct {
+				name      string
+				orig, bal blas64.General
+			}{
+				{"A", a, ab},
+				{"B", b, bb},
+			} {
+				got := newGeneral(n, n)
+				blas64.Gemm(blas.Trans, blas.NoTrans, 1, xl, mul(m.orig, xr), 0, got)
+				if d := maxDiff(got, m.bal, false); d > 1e-14*maxAbs(m.bal.Data) {
+					t.Errorf("%s: balanced %s does not match transformations: %v", name, m.name, d)
+				}
+			}
+		}
+	}
+}
+
+func TestZggbal(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 4, 9} {
+		for _, job := range []lapack.BalanceJob{lapack.BalanceNone, lapack.Permute, lapack.Scale, lapack.PermuteScale} {
+			name := fmt.Sprintf("n=%d,job=%c", n, job)
+			a := complexOf(badlyScaledPencil(rnd, n, true), badlyScaledPencil(rnd, n, false))
+			b := complexOf(badlyScaledPencil(rnd, n, true), badlyScaledPencil(rnd, n, false))
+			ab, bb := cloneCGeneral(a), cloneCGeneral(b)
+			lscale := make([]float64, n)
+			rscale := make([]float64, n)
+			work := make([]float64, 6*n)
+			ilo, ihi := impl.Zggbal(job, n, ab.Data, max(1, ab.Stride), bb.Data, max(1, bb.Stride), lscale, rscale, work)
+			if n == 0 {
+				continue
+			}
+			if ilo < 0 || ihi < ilo || n <= ihi {
+				t.Errorf("%s: invalid ilo=%d, ihi=%d", name, ilo, ihi)
+				continue
+			}
+
+			cxl := complexOf(eye(n), newGeneral(n, n))
+			cxr := complexOf(eye(n), newGeneral(n, n))
+			impl.Zggbak(job, lapack.EVLeft, n, ilo, ihi, lscale, rscale, n, cxl.Data, cxl.Stride)
+			impl.Zggbak(job, lapack.EVRight, n, ilo, ihi, lscale, rscale, n, cxr.Data, cxr.Stride)
+			for _, m := range []struct {
+				name      string
+				orig, bal cblas128.General
+			}{
+				{"A", a, ab},
+				{"B", b, bb},
+			} {
+				got := naiveCMul(naiveCMul(conjTrans(cxl), m.orig), cxr)
+				var scale float64
+				for _, v := range m.bal.Data {
+					scale = math.Max(scale, math.Abs(real(v))+math.Abs(imag(v)))
+				}
+				if d := cmaxAbsDiff(got, m.bal); d > 1e-14*scale {
+					t.Errorf("%s: balanced %s does not match transformations: %v", name, m.name, d)
+				}
+			}
+		}
+	}
+}
+
+// complexOf returns the complex matrix re + i*im.
+func complexOf(re, im blas64.General) cblas128.General {
+	c := newCGeneral(re.Rows, re.Cols)
+	for i := 0; i < re.Rows; i++ {
+		for j := 0; j < re.Cols; j++ {
+			c.Data[i*c.Stride+j] = complex(re.Data[i*re.Stride+j], im.Data[i*im.Stride+j])
+		}
+	}
+	return c
+}
@@ -0,0 +1,230 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"flag"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/netlib/internal/golden"
+)
+
+var recordGolden = flag.String("golden.record", "", "record testdata/golden.json from the linked library, naming it as the source")
+
+const goldenPath = "testdata/golden.json"
+
+// TestGolden verifies the linked library against the results recorded from
+// the reference LAPACKE. To record the corpus, link the reference LAPACKE
+// and run
+//
+//	go test -run Golden -golden.record "Netlib reference LAPACKE 3.12.0"
+func TestGolden(t *testing.T) {
+	if *recordGolden != "" {
+		err := golden.Record(goldenRoutines, 1, *recordGolden).Save(goldenPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	c, err := golden.Load(goldenPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range golden.Verify(c, goldenRoutines) {
+		t.Error(m)
+	}
+}
+
+var (
+	goldenTrans = []blas.Transpose{blas.NoTrans, blas.Trans}
+	goldenUplo  = []blas.Uplo{blas.Upper, blas.Lower}
+
+	// goldenShapes are the shapes of the general matrices and
+	// goldenSizes the orders of the symmetric matrices. The blocked
+	// factorizations are also given a matrix of order 65, which exceeds
+	// the reference block size. The tolerances of the routines allow for
+	// the different order of operations of other blocked implementations.
+	goldenShapes = [][2]int{{1, 1}, {5, 5}, {9, 4}, {4, 9}}
+	goldenSizes  = []int{1, 6, 10}
+	goldenLarge  = 65
+)
+
+// generalCases returns a function returning a case of a general m×n matrix
+// a and a right-hand side b with nrhs columns and max(m, n) rows for each of
+// the shapes.
+func generalCases(shapes ...[2]int) func(rnd *rand.Rand) []golden.Case {
+	return func(rnd *rand.Rand) []golden.Case {
+		var cases []golden.Case
+		for _, mn := range shapes {
+			m, n := mn[0], mn[1]
+			const nrhs = 3
+			cases = append(cases, golden.Case{
+				Args: map[string]int{"m": m, "n": n, "nrhs": nrhs},
+				In: map[string][]float64{
+					"a": golden.Random(rnd, m*n),
+					"b": golden.Random(rnd, max(m, n)*nrhs),
+				},
+			})
+		}
+		return cases
+	}
+}
+
+// symmetricCases returns a function returning a case of a symmetric positive
+// definite n×n matrix a, of which both triangles are stored, for each of the
+// sizes and triangles.
+func symmetricCases(sizes ...int) func(rnd *rand.Rand) []golden.Case {
+	return func(rnd *rand.Rand) []golden.Case {
+		var cases []golden.Case
+		for uplo := range goldenUplo {
+			for _, n := range sizes {
+				x := golden.Random(rnd, n*n)
+				a := make([]float64, n*n)
+				blasImpl.Dsyrk(blas.Upper, blas.NoTrans, n, n, 1, x, n, 0, a, n)
+				for i := 0; i < n; i++ {
+					a[i*n+i] += float64(n)
+					for j := i; j < n; j++ {
+						// Round as golden.Random does. The shift of
+						// the diagonal keeps a positive definite.
+						a[i*n+j] = math.Round(100*a[i*n+j]) / 100
+						a[j*n+i] = a[i*n+j]
+					}
+				}
+				cases = append(cases, golden.Case{
+					Args: map[string]int{"uplo": uplo, "n": n},
+					In:   map[string][]float64{"a": a},
+				})
+			}
+		}
+		return cases
+	}
+}
+
+// ints returns s converted to float64.
+func ints(s []int) []float64 {
+	f := make([]float64, len(s))
+	for i, v := range s {
+		f[i] = float64(v)
+	}
+	return f
+}
+
+var goldenRoutines = []golden.Routine{
+	{
+		Name:  "dgetrf",
+		Tol:   1e-10,
+		Cases: generalCases(append(goldenShapes, [2]int{goldenLarge, goldenLarge})...),
+		Run: func(c golden.Case) map[string][]float64 {
+			m, n := c.Args["m"], c.Args["n"]
+			a := golden.Float64s(c.In["a"])
+			ipiv := make([]int, min(m, n))
+			impl.Dgetrf(m, n, a, n, ipiv)
+			return map[string][]float64{"a": a, "ipiv": ints(ipiv)}
+		},
+	},
+	{
+		Name: "dgetrs",
+		Tol:  1e-9,
+		Cases: func(rnd *rand.Rand) []golden.Case {
+			var cases []golden.Case
+			for _, c := range generalCases(append(goldenShapes, [2]int{goldenLarge, goldenLarge})...)(rnd) {
+				if c.Args["m"] != c.Args["n"] {
+					continue
+				}
+				for trans := range goldenTrans {
+					c.Args = map[string]int{"trans": trans, "n": c.Args["n"], "nrhs": c.Args["nrhs"]}
+					cases = append(cases, c)
+				}
+			}
+			return cases
+		},
+		Run: func(c golden.Case) map[string][]float64 {
+			n, nrhs := c.Args["n"], c.Args["nrhs"]
+			a := golden.Float64s(c.In["a"])
+			b := golden.Float64s(c.In["b"])
+			ipiv := make([]int, n)
+			impl.Dgetrf(n, n, a, n, ipiv)
+			impl.Dgetrs(goldenTrans[c.Args["trans"]], n, nrhs, a, n, ipiv, b, nrhs)
+			return map[string][]float64{"b": b}
+		},
+	},
+	{
+		Name:  "dpotrf",
+		Tol:   1e-10,
+		Cases: symmetricCases(append(goldenSizes, goldenLarge)...),
+		Run: func(c golden.Case) map[string][]float64 {
+			n := c.Args["n"]
+			a := golden.Float64s(c.In["a"])
+			impl.Dpotrf(goldenUplo[c.Args["uplo"]], n, a, n)
+			return map[string][]float64{"a": a}
+		},
+	},
+	{
+		Name:  "dgeqrf",
+		Tol:   1e-10,
+		Cases: generalCases(goldenShapes...),
+		Run: func(c golden.Case) map[string][]float64 {
+			m, n := c.Args["m"], c.Args["n"]
+			a := golden.Float64s(c.In["a"])
+			tau := make([]float64, min(m, n))
+			work := make([]float64, 1)
+			impl.Dgeqrf(m, n, a, n, tau, work, -1)
+			work = make([]float64, int(work[0]))
+			impl.Dgeqrf(m, n, a, n, tau, work, len(work))
+			return map[string][]float64{"a": a, "tau": tau}
+		},
+	},
+	{
+		Name:  "dsyev",
+		Tol:   1e-12,
+		Cases: symmetricCases(goldenSizes...),
+		Run: func(c golden.Case) map[string][]float64 {
+			n := c.Args["n"]
+			a := golden.Float64s(c.In["a"])
+			w := make([]float64, n)
+			work := make([]float64, 1)
+			uplo := goldenUplo[c.Args["uplo"]]
+			impl.Dsyev(lapack.EVNone, uplo, n, a, n, w, work, -1)
+			work = make([]float64, int(work[0]))
+			impl.Dsyev(lapack.EVNone, uplo, n, a, n, w, work, len(work))
+			return map[string][]float64{"w": w}
+		},
+	},
+	{
+		Name:  "dgesvd",
+		Tol:   1e-12,
+		Cases: generalCases(goldenShapes...),
+		Run: func(c golden.Case) map[string][]float64 {
+			m, n := c.Args["m"], c.Args["n"]
+			a := golden.Float64s(c.In["a"])
+			s := make([]float64, min(m, n))
+			work := make([]float64, 1)
+			impl.Dgesvd(lapack.SVDNone, lapack.SVDNone, m, n, a, n, s, nil, 1, nil, 1, work, -1)
+			work = make([]float64, int(work[0]))
+			impl.Dgesvd(lapack.SVDNone, lapack.SVDNone, m, n, a, n, s, nil, 1, nil, 1, work, len(work))
+			return map[string][]float64{"s": s}
+		},
+	},
+	{
+		Name:  "dgels",
+		Tol:   1e-9,
+		Cases: generalCases(goldenShapes...),
+		Run: func(c golden.Case) map[string][]float64 {
+			m, n, nrhs := c.Args["m"], c.Args["n"], c.Args["nrhs"]
+			a := golden.Float64s(c.In["a"])
+			b := golden.Float64s(c.In["b"])
+			work := make([]float64, 1)
+			impl.Dgels(blas.NoTrans, m, n, nrhs, a, n, b, nrhs, work, -1)
+			work = make([]float64, int(work[0]))
+			impl.Dgels(blas.NoTrans, m, n, nrhs, a, n, b, nrhs, work, len(work))
+			return map[string][]float64{"x": b[:n*nrhs]}
+		},
+	},
+}
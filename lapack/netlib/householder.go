@@ -0,0 +1,117 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// HouseholderQ is the m×m orthogonal factor Q of a QR factorization held
+// in the compact form returned by Dgeqrf, as the product
+//
+//	Q = H_0 * H_1 * ... * H_{k-1}
+//
+// of k elementary reflectors. Q is applied by Dormqr in O(m*k) storage and
+// is only formed explicitly, by Dorgqr, when Materialize or MaterializeThin
+// is called.
+type HouseholderQ struct {
+	// Reflectors holds the vectors defining the elementary reflectors
+	// below the diagonal of its first k columns. Its other elements are
+	// not referenced.
+	Reflectors blas64.General
+	// Tau holds the k scalar factors of the elementary reflectors.
+	Tau []float64
+}
+
+// NewHouseholderQ returns the Q factor represented by the outputs a and tau
+// of Dgeqrf. The storage of a and tau is shared.
+func NewHouseholderQ(a blas64.General, tau []float64) HouseholderQ {
+	if len(tau) > min(a.Rows, a.Cols) {
+		panic(badShapeA)
+	}
+	return HouseholderQ{Reflectors: a, Tau: tau}
+}
+
+// QRFactorize computes the QR factorization A = Q * R of the m×n matrix A
+// by Dgeqrf. It returns the orthogonal factor in compact form and the
+// min(m,n)×n upper trapezoidal factor R. The input a is not modified.
+func QRFactorize(a blas64.General) (q HouseholderQ, r blas64.General) {
+	m, n := a.Rows, a.Cols
+	k := min(m, n)
+	q = HouseholderQ{
+		Reflectors: cloneGeneral(a),
+		Tau:        make([]float64, k),
+	}
+	r = newGeneral(k, n)
+	if k == 0 {
+		return q, r
+	}
+	qr := q.Reflectors
+	work := make([]float64, 1)
+	lapackImpl.Dgeqrf(m, n, qr.Data, qr.Stride, q.Tau, work, -1)
+	work = make([]float64, int(work[0]))
+	lapackImpl.Dgeqrf(m, n, qr.Data, qr.Stride, q.Tau, work, len(work))
+	for i := 0; i < k; i++ {
+		copy(r.Data[i*r.Stride+i:i*r.Stride+n], qr.Data[i*qr.Stride+i:])
+	}
+	return q, r
+}
+
+// Rows returns the order m of Q.
+func (q HouseholderQ) Rows() int {
+	return q.Reflectors.Rows
+}
+
+// ApplyTo overwrites x with Q * X if trans is blas.NoTrans and with Q^T * X
+// if trans is blas.Trans, where X has m rows. The product is computed by
+// Dormqr without forming Q.
+func (q HouseholderQ) ApplyTo(trans blas.Transpose, x blas64.General) {
+	m, k := q.Reflectors.Rows, len(q.Tau)
+	if trans != blas.NoTrans && trans != blas.Trans {
+		panic(badTrans)
+	}
+	if x.Rows != m {
+		panic(badShapeB)
+	}
+	if m == 0 || x.Cols == 0 || k == 0 {
+		return
+	}
+	v := q.Reflectors
+	work := make([]float64, 1)
+	lapackImpl.Dormqr(blas.Left, trans, m, x.Cols, k, v.Data, v.Stride, q.Tau, x.Data, x.Stride, work, -1)
+	work = make([]float64, int(work[0]))
+	lapackImpl.Dormqr(blas.Left, trans, m, x.Cols, k, v.Data, v.Stride, q.Tau, x.Data, x.Stride, work, len(work))
+}
+
+// Materialize returns the explicit m×m matrix Q formed by Dorgqr.
+func (q HouseholderQ) Materialize() blas64.General {
+	return q.materialize(q.Reflectors.Rows)
+}
+
+// MaterializeThin returns the first k columns of Q formed by Dorgqr, which
+// for a tall m×n matrix A with k = n are an orthonormal basis of the range
+// of A.
+func (q HouseholderQ) MaterializeThin() blas64.General {
+	return q.materialize(len(q.Tau))
+}
+
+// materialize returns the first n columns of Q, where k <= n <= m.
+func (q HouseholderQ) materialize(n int) blas64.General {
+	m, k := q.Reflectors.Rows, len(q.Tau)
+	v := q.Reflectors
+	z := newGeneral(m, n)
+	for i := 1; i < m && k > 0; i++ {
+		copy(z.Data[i*z.Stride:i*z.Stride+min(i, k)], v.Data[i*v.Stride:])
+	}
+	if m == 0 || n == 0 {
+		return z
+	}
+	work := make([]float64, 1)
+	lapackImpl.Dorgqr(m, n, k, z.Data, z.Stride, q.Tau, work, -1)
+	work = make([]float64, int(work[0]))
+	lapackImpl.Dorgqr(m, n, k, z.Data, z.Stride, q.Tau, work, len(work))
+	return z
+}
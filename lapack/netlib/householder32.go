@@ -0,0 +1,100 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+)
+
+// HouseholderQ32 is the float32 version of HouseholderQ.
+type HouseholderQ32 struct {
+	Reflectors blas32.General
+	Tau        []float32
+}
+
+// NewHouseholderQ32 is the float32 version of NewHouseholderQ.
+func NewHouseholderQ32(a blas32.General, tau []float32) HouseholderQ32 {
+	if len(tau) > min(a.Rows, a.Cols) {
+		panic(badShapeA)
+	}
+	return HouseholderQ32{Reflectors: a, Tau: tau}
+}
+
+// QRFactorize32 is the float32 version of QRFactorize. The factorization is
+// computed by Sgeqrf.
+func QRFactorize32(a blas32.General) (q HouseholderQ32, r blas32.General) {
+	m, n := a.Rows, a.Cols
+	k := min(m, n)
+	q = HouseholderQ32{
+		Reflectors: cloneGeneral32(a),
+		Tau:        make([]float32, k),
+	}
+	r = newGeneral32(k, n)
+	if k == 0 {
+		return q, r
+	}
+	qr := q.Reflectors
+	work := make([]float32, 1)
+	lapackImpl.Sgeqrf(m, n, qr.Data, qr.Stride, q.Tau, work, -1)
+	work = make([]float32, int(work[0]))
+	lapackImpl.Sgeqrf(m, n, qr.Data, qr.Stride, q.Tau, work, len(work))
+	for i := 0; i < k; i++ {
+		copy(r.Data[i*r.Stride+i:i*r.Stride+n], qr.Data[i*qr.Stride+i:])
+	}
+	return q, r
+}
+
+// Rows returns the order m of Q.
+func (q HouseholderQ32) Rows() int {
+	return q.Reflectors.Rows
+}
+
+// ApplyTo is the float32 version of HouseholderQ.ApplyTo. The product is
+// computed by Sormqr.
+func (q HouseholderQ32) ApplyTo(trans blas.Transpose, x blas32.General) {
+	m, k := q.Reflectors.Rows, len(q.Tau)
+	if trans != blas.NoTrans && trans != blas.Trans {
+		panic(badTrans)
+	}
+	if x.Rows != m {
+		panic(badShapeB)
+	}
+	if m == 0 || x.Cols == 0 || k == 0 {
+		return
+	}
+	v := q.Reflectors
+	work := make([]float32, 1)
+	lapackImpl.Sormqr(blas.Left, trans, m, x.Cols, k, v.Data, v.Stride, q.Tau, x.Data, x.Stride, work, -1)
+	work = make([]float32, int(work[0]))
+	lapackImpl.Sormqr(blas.Left, trans, m, x.Cols, k, v.Data, v.Stride, q.Tau, x.Data, x.Stride, work, len(work))
+}
+
+// Materialize is the float32 version of HouseholderQ.Materialize.
+func (q HouseholderQ32) Materialize() blas32.General {
+	return q.materialize(q.Reflectors.Rows)
+}
+
+// MaterializeThin is the float32 version of HouseholderQ.MaterializeThin.
+func (q HouseholderQ32) MaterializeThin() blas32.General {
+	return q.materialize(len(q.Tau))
+}
+
+func (q HouseholderQ32) materialize(n int) blas32.General {
+	m, k := q.Reflectors.Rows, len(q.Tau)
+	v := q.Reflectors
+	z := newGeneral32(m, n)
+	for i := 1; i < m && k > 0; i++ {
+		copy(z.Data[i*z.Stride:i*z.Stride+min(i, k)], v.Data[i*v.Stride:])
+	}
+	if m == 0 || n == 0 {
+		return z
+	}
+	work := make([]float32, 1)
+	lapackImpl.Sorgqr(m, n, k, z.Data, z.Stride, q.Tau, work, -1)
+	work = make([]float32, int(work[0]))
+	lapackImpl.Sorgqr(m, n, k, z.Data, z.Stride, q.Tau, work, len(work))
+	return z
+}
@@ -0,0 +1,114 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func TestQRFactorize(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, n int }{
+		{0, 0}, {3, 0}, {0, 4}, {1, 1}, {6, 4}, {4, 6}, {20, 7}, {9, 9},
+	} {
+		m, n := test.m, test.n
+		k := min(m, n)
+		name := fmt.Sprintf("m=%d,n=%d", m, n)
+		a := randomGeneral(rnd, m, n, max(1, n))
+		orig := cloneGeneral(a)
+		q, r := QRFactorize(a)
+		if maxDiff(a, orig, false) != 0 {
+			t.Errorf("%s: a modified", name)
+		}
+		if q.Rows() != m || len(q.Tau) != k || r.Rows != k || r.Cols != n {
+			t.Fatalf("%s: unexpected shapes", name)
+		}
+		for i := 0; i < k; i++ {
+			for j := 0; j < i; j++ {
+				if r.Data[i*r.Stride+j] != 0 {
+					t.Fatalf("%s: R not upper triangular", name)
+				}
+			}
+		}
+
+		// Q*[R; 0] must reproduce A.
+		x := newGeneral(m, n)
+		for i := 0; i < k; i++ {
+			copy(x.Data[i*x.Stride:i*x.Stride+n], r.Data[i*r.Stride:])
+		}
+		rz := cloneGeneral(x)
+		q.ApplyTo(blas.NoTrans, x)
+		if d := maxDiff(x, a, false); d > tol {
+			t.Errorf("%s: |Q*R - A| = %v", name, d)
+		}
+		q.ApplyTo(blas.Trans, x)
+		if d := maxDiff(x, rz, false); d > tol {
+			t.Errorf("%s: |Q^T*Q*R - R| = %v", name, d)
+		}
+
+		full := q.Materialize()
+		if full.Rows != m || full.Cols != m {
+			t.Fatalf("%s: unexpected shape of Q %d×%d", name, full.Rows, full.Cols)
+		}
+		if d := orthonormalityError(full); d > tol {
+			t.Errorf("%s: Q not orthogonal: %v", name, d)
+		}
+		b := randomGeneral(rnd, m, 3, 3)
+		qb := cloneGeneral(b)
+		q.ApplyTo(blas.NoTrans, qb)
+		if d := maxDiff(qb, mul(full, b), false); d > tol {
+			t.Errorf("%s: ApplyTo and Materialize disagree: %v", name, d)
+		}
+
+		thin := q.MaterializeThin()
+		if thin.Rows != m || thin.Cols != k {
+			t.Fatalf("%s: unexpected shape of thin Q %d×%d", name, thin.Rows, thin.Cols)
+		}
+		for i := 0; i < m; i++ {
+			for j := 0; j < k; j++ {
+				if thin.Data[i*thin.Stride+j] != full.Data[i*full.Stride+j] {
+					t.Fatalf("%s: thin Q differs from leading columns of Q", name)
+				}
+			}
+		}
+
+		// A HouseholderQ built from the outputs of Dgeqrf is the same.
+		if k > 0 {
+			qr := cloneGeneral(a)
+			tau := make([]float64, k)
+			work := make([]float64, n)
+			impl.Dgeqrf(m, n, qr.Data, qr.Stride, tau, work, len(work))
+			if d := maxDiff(NewHouseholderQ(qr, tau).Materialize(), full, false); d > tol {
+				t.Errorf("%s: NewHouseholderQ mismatch: %v", name, d)
+			}
+		}
+	}
+
+	q, _ := QRFactorize(newGeneral(3, 2))
+	for _, test := range []struct {
+		name string
+		fn   func()
+		want string
+	}{
+		{"trans", func() { q.ApplyTo(blas.ConjTrans, newGeneral(3, 1)) }, badTrans},
+		{"shape", func() { q.ApplyTo(blas.NoTrans, newGeneral(2, 1)) }, badShapeB},
+		{"tau", func() { NewHouseholderQ(newGeneral(3, 2), make([]float64, 3)) }, badShapeA},
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r != test.want {
+					t.Errorf("%s: unexpected panic: got %v want %q", test.name, r, test.want)
+				}
+			}()
+			test.fn()
+		}()
+	}
+}
@@ -0,0 +1,203 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// Inertia is the number of negative, zero and positive eigenvalues of a
+// symmetric matrix.
+type Inertia struct {
+	Neg, Zero, Pos int
+}
+
+// DetSign returns the sign of the determinant of a matrix with inertia i,
+// which is -1, 0 or 1.
+func (i Inertia) DetSign() int {
+	switch {
+	case i.Zero > 0:
+		return 0
+	case i.Neg%2 == 1:
+		return -1
+	}
+	return 1
+}
+
+// SymInertia returns the inertia of A - shift*I for the symmetric n×n matrix
+// A. By Sylvester's law of inertia it is equal to the inertia of the block
+// diagonal factor D of the Bunch-Kaufman factorization
+//  A - shift*I = U * D * U^T  or  A - shift*I = L * D * L^T
+// computed by Dsytrf. In particular, Neg is the number of eigenvalues of A
+// that are less than shift. Zero counts exactly singular blocks of D and is
+// only meaningful when A - shift*I is exactly singular in floating point.
+// The input a is not modified.
+func SymInertia(a blas64.Symmetric, shift float64) Inertia {
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	n := a.N
+	if n == 0 {
+		return Inertia{}
+	}
+	f := cloneGeneral(blas64.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data})
+	for i := 0; i < n; i++ {
+		f.Data[i*f.Stride+i] -= shift
+	}
+	ipiv := make([]lapacke.Int, n)
+	work := make([]float64, 1)
+	lapacke.Dsytrf(byte(a.Uplo), n, f.Data, f.Stride, ipiv, work, -1)
+	work = make([]float64, int(work[0]))
+	if info := lapacke.DsytrfInfo(byte(a.Uplo), n, f.Data, f.Stride, ipiv, work, len(work)); info < 0 {
+		panic("lapack: invalid argument to Dsytrf")
+	}
+
+	var in Inertia
+	for k := 0; k < n; {
+		d := f.Data[k*f.Stride+k]
+		if ipiv[k] > 0 {
+			in.add(d)
+			k++
+			continue
+		}
+		// A 2×2 block occupies rows k and k+1 in either storage.
+		c := f.Data[(k+1)*f.Stride+k+1]
+		var b float64
+		if a.Uplo == blas.Upper {
+			b = f.Data[k*f.Stride+k+1]
+		} else {
+			b = f.Data[(k+1)*f.Stride+k]
+		}
+		// The eigenvalues of the block have the signs of its determinant
+		// and trace.
+		det := d*c - b*b
+		switch {
+		case det < 0:
+			in.Neg++
+			in.Pos++
+		case det > 0:
+			in.add(d + c)
+			in.add(d + c)
+		default:
+			in.Zero++
+			in.add(d + c)
+		}
+		k += 2
+	}
+	return in
+}
+
+func (i *Inertia) add(v float64) {
+	switch {
+	case v < 0:
+		i.Neg++
+	case v > 0:
+		i.Pos++
+	default:
+		i.Zero++
+	}
+}
+
+// EigenCounter counts and computes the eigenvalues of a symmetric matrix
+// that lie in intervals, for example to divide the spectrum into slices of
+// similar size. The matrix is reduced to tridiagonal form T once by Dsytrd,
+// so that each count costs O(n) operations.
+type EigenCounter struct {
+	d, e   []float64
+	pivmin float64
+}
+
+// NewEigenCounter returns an EigenCounter for the symmetric matrix A. The
+// input a is not modified.
+func NewEigenCounter(a blas64.Symmetric) *EigenCounter {
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	n := a.N
+	c := &EigenCounter{d: make([]float64, n), e: make([]float64, max(0, n-1))}
+	if n == 0 {
+		return c
+	}
+	f := cloneGeneral(blas64.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data})
+	tau := make([]float64, max(1, n-1))
+	work := make([]float64, 1)
+	lapacke.Dsytrd(byte(a.Uplo), n, f.Data, f.Stride, c.d, c.e, tau, work, -1)
+	work = make([]float64, int(work[0]))
+	if !lapacke.Dsytrd(byte(a.Uplo), n, f.Data, f.Stride, c.d, c.e, tau, work, len(work)) {
+		panic("lapack: invalid argument to Dsytrd")
+	}
+
+	// pivmin is the smallest pivot allowed in the Sturm sequence, as
+	// chosen by Dstebz.
+	emax := 1.0
+	for _, v := range c.e {
+		emax = math.Max(emax, v*v)
+	}
+	c.pivmin = dlamchS * emax
+	return c
+}
+
+// dlamchS is the smallest normalized floating point number.
+const dlamchS = 0x1p-1022
+
+// less returns the number of eigenvalues of T that are less than sigma. It is
+// the number of negative pivots of the LDL^T factorization of T - sigma*I,
+// by Sylvester's law of inertia.
+func (c *EigenCounter) less(sigma float64) int {
+	var count int
+	var q float64
+	for i, d := range c.d {
+		if i == 0 {
+			q = d - sigma
+		} else {
+			q = d - sigma - c.e[i-1]*c.e[i-1]/q
+		}
+		if math.Abs(q) < c.pivmin {
+			q = -c.pivmin
+		}
+		if q < 0 {
+			count++
+		}
+	}
+	return count
+}
+
+// Count returns the number of eigenvalues in the half-open interval
+// (vl, vu]. Count panics if vl > vu.
+func (c *EigenCounter) Count(vl, vu float64) int {
+	if vl > vu {
+		panic("lapack: bad interval")
+	}
+	return c.less(vu) - c.less(vl)
+}
+
+// Eigenvalues returns the eigenvalues in the half-open interval (vl, vu] in
+// ascending order, computed by bisection with Dstebz. Eigenvalues panics if
+// vl >= vu.
+//
+// If the bisection fails to converge for some eigenvalues, Eigenvalues
+// returns the approximations computed so far with ErrIterationLimit.
+func (c *EigenCounter) Eigenvalues(vl, vu float64) ([]float64, error) {
+	if vl >= vu {
+		panic("lapack: bad interval")
+	}
+	n := len(c.d)
+	if n == 0 {
+		return nil, nil
+	}
+	var m, nsplit [1]lapacke.Int
+	w := make([]float64, n)
+	iblock := make([]lapacke.Int, n)
+	isplit := make([]lapacke.Int, n)
+	ok := lapacke.Dstebz('V', 'E', n, vl, vu, 0, 0, 0, c.d, c.e, m[:], nsplit[:], w, iblock, isplit, make([]float64, 4*n), make([]lapacke.Int, 3*n))
+	if !ok {
+		return w[:m[0]], ErrIterationLimit
+	}
+	return w[:m[0]], nil
+}
@@ -0,0 +1,157 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// SymInertia32 is the float32 version of SymInertia. The factorization is
+// computed by Ssytrf.
+func SymInertia32(a blas32.Symmetric, shift float32) Inertia {
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	n := a.N
+	if n == 0 {
+		return Inertia{}
+	}
+	f := cloneGeneral32(blas32.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data})
+	for i := 0; i < n; i++ {
+		f.Data[i*f.Stride+i] -= shift
+	}
+	ipiv := make([]lapacke.Int, n)
+	work := make([]float32, 1)
+	lapacke.Ssytrf(byte(a.Uplo), n, f.Data, f.Stride, ipiv, work, -1)
+	work = make([]float32, int(work[0]))
+	if info := lapacke.SsytrfInfo(byte(a.Uplo), n, f.Data, f.Stride, ipiv, work, len(work)); info < 0 {
+		panic("lapack: invalid argument to Ssytrf")
+	}
+
+	var in Inertia
+	for k := 0; k < n; {
+		d := float64(f.Data[k*f.Stride+k])
+		if ipiv[k] > 0 {
+			in.add(d)
+			k++
+			continue
+		}
+		c := float64(f.Data[(k+1)*f.Stride+k+1])
+		var b float64
+		if a.Uplo == blas.Upper {
+			b = float64(f.Data[k*f.Stride+k+1])
+		} else {
+			b = float64(f.Data[(k+1)*f.Stride+k])
+		}
+		det := d*c - b*b
+		switch {
+		case det < 0:
+			in.Neg++
+			in.Pos++
+		case det > 0:
+			in.add(d + c)
+			in.add(d + c)
+		default:
+			in.Zero++
+			in.add(d + c)
+		}
+		k += 2
+	}
+	return in
+}
+
+// EigenCounter32 is the float32 version of EigenCounter. The reduction to
+// tridiagonal form is computed by Ssytrd and the eigenvalues by Sstebz.
+type EigenCounter32 struct {
+	d, e   []float32
+	pivmin float32
+}
+
+// NewEigenCounter32 returns an EigenCounter32 for the symmetric matrix A.
+// The input a is not modified.
+func NewEigenCounter32(a blas32.Symmetric) *EigenCounter32 {
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	n := a.N
+	c := &EigenCounter32{d: make([]float32, n), e: make([]float32, max(0, n-1))}
+	if n == 0 {
+		return c
+	}
+	f := cloneGeneral32(blas32.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data})
+	tau := make([]float32, max(1, n-1))
+	work := make([]float32, 1)
+	lapacke.Ssytrd(byte(a.Uplo), n, f.Data, f.Stride, c.d, c.e, tau, work, -1)
+	work = make([]float32, int(work[0]))
+	if !lapacke.Ssytrd(byte(a.Uplo), n, f.Data, f.Stride, c.d, c.e, tau, work, len(work)) {
+		panic("lapack: invalid argument to Ssytrd")
+	}
+
+	emax := float32(1)
+	for _, v := range c.e {
+		if v*v > emax {
+			emax = v * v
+		}
+	}
+	c.pivmin = slamchS * emax
+	return c
+}
+
+// slamchS is the smallest normalized float32 number.
+const slamchS = 0x1p-126
+
+// less is the float32 version of EigenCounter.less.
+func (c *EigenCounter32) less(sigma float32) int {
+	var count int
+	var q float32
+	for i, d := range c.d {
+		if i == 0 {
+			q = d - sigma
+		} else {
+			q = d - sigma - c.e[i-1]*c.e[i-1]/q
+		}
+		if float32(math.Abs(float64(q))) < c.pivmin {
+			q = -c.pivmin
+		}
+		if q < 0 {
+			count++
+		}
+	}
+	return count
+}
+
+// Count returns the number of eigenvalues in the half-open interval
+// (vl, vu]. Count panics if vl > vu.
+func (c *EigenCounter32) Count(vl, vu float32) int {
+	if vl > vu {
+		panic("lapack: bad interval")
+	}
+	return c.less(vu) - c.less(vl)
+}
+
+// Eigenvalues returns the eigenvalues in the half-open interval (vl, vu] in
+// ascending order as described for EigenCounter.Eigenvalues.
+func (c *EigenCounter32) Eigenvalues(vl, vu float32) ([]float32, error) {
+	if vl >= vu {
+		panic("lapack: bad interval")
+	}
+	n := len(c.d)
+	if n == 0 {
+		return nil, nil
+	}
+	var m, nsplit [1]lapacke.Int
+	w := make([]float32, n)
+	iblock := make([]lapacke.Int, n)
+	isplit := make([]lapacke.Int, n)
+	ok := lapacke.Sstebz('V', 'E', n, vl, vu, 0, 0, 0, c.d, c.e, m[:], nsplit[:], w, iblock, isplit, make([]float32, 4*n), make([]lapacke.Int, 3*n))
+	if !ok {
+		return w[:m[0]], ErrIterationLimit
+	}
+	return w[:m[0]], nil
+}
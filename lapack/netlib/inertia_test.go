@@ -0,0 +1,119 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+)
+
+// symmetricWithEigenvalues returns a random symmetric matrix with the given
+// eigenvalues, with both triangles stored.
+func symmetricWithEigenvalues(rnd *rand.Rand, uplo blas.Uplo, w []float64) blas64.Symmetric {
+	n := len(w)
+	q, err := Orth(randomGeneral(rnd, n, n, max(1, n)), -1)
+	if err != nil {
+		panic(err)
+	}
+	qw := cloneGeneral(q)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			qw.Data[i*qw.Stride+j] *= w[j]
+		}
+	}
+	a := newGeneral(n, n)
+	if n > 0 {
+		blas64.Gemm(blas.NoTrans, blas.Trans, 1, qw, q, 0, a)
+	}
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			v := (a.Data[i*a.Stride+j] + a.Data[j*a.Stride+i]) / 2
+			a.Data[i*a.Stride+j] = v
+			a.Data[j*a.Stride+i] = v
+		}
+	}
+	return blas64.Symmetric{Uplo: uplo, N: n, Stride: a.Stride, Data: a.Data}
+}
+
+func TestSymInertia(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	w := []float64{-5, -2.5, -1, 0.5, 1, 3, 3, 7}
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		a := symmetricWithEigenvalues(rnd, uplo, w)
+		aCopy := make([]float64, len(a.Data))
+		copy(aCopy, a.Data)
+		for _, shift := range []float64{-10, -3, 0, 0.75, 2, 5, 10} {
+			var want Inertia
+			for _, v := range w {
+				if v < shift {
+					want.Neg++
+				} else {
+					want.Pos++
+				}
+			}
+			got := SymInertia(a, shift)
+			if got != want {
+				t.Errorf("uplo=%c,shift=%v: unexpected inertia: got %+v want %+v", uplo, shift, got, want)
+			}
+			wantSign := 1
+			if want.Neg%2 == 1 {
+				wantSign = -1
+			}
+			if got.DetSign() != wantSign {
+				t.Errorf("uplo=%c,shift=%v: unexpected determinant sign: got %d want %d", uplo, shift, got.DetSign(), wantSign)
+			}
+		}
+		if !floats.Equal(a.Data, aCopy) {
+			t.Errorf("uplo=%c: input modified", uplo)
+		}
+	}
+
+	// An exactly singular matrix has a zero pivot.
+	a := blas64.Symmetric{Uplo: blas.Upper, N: 2, Stride: 2, Data: []float64{1, 1, 1, 1}}
+	if got := SymInertia(a, 0); got.Zero != 1 || got.Pos != 1 || got.DetSign() != 0 {
+		t.Errorf("unexpected inertia of singular matrix: %+v", got)
+	}
+}
+
+func TestEigenCounter(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 5, 20} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			w := make([]float64, n)
+			for i := range w {
+				w[i] = float64(i) - float64(n)/2 + 0.5*rnd.Float64()
+			}
+			a := symmetricWithEigenvalues(rnd, uplo, w)
+			c := NewEigenCounter(a)
+			for _, iv := range [][2]float64{{-100, 100}, {-3, 3}, {0, 0.25}, {-1.5, 7.5}} {
+				name := fmt.Sprintf("n=%d,uplo=%c,interval=(%v,%v]", n, uplo, iv[0], iv[1])
+				var want []float64
+				for _, v := range w {
+					if iv[0] < v && v <= iv[1] {
+						want = append(want, v)
+					}
+				}
+				if got := c.Count(iv[0], iv[1]); got != len(want) {
+					t.Errorf("%s: unexpected count: got %d want %d", name, got, len(want))
+				}
+				got, err := c.Eigenvalues(iv[0], iv[1])
+				if err != nil {
+					t.Errorf("%s: unexpected error: %v", name, err)
+					continue
+				}
+				if len(got) != len(want) || !floats.EqualApprox(got, want, tol) {
+					t.Errorf("%s: unexpected eigenvalues: got %v want %v", name, got, want)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// Inverse returns the inverse of the n×n matrix A, computed from its LU
+// factorization by Dgetrf and Dgetri with the optimal workspace. The input a
+// is not modified.
+//
+// Forming the inverse is slower and less accurate than solving with the
+// factorization, so Inverse is intended for the cases that need the
+// elements of the inverse themselves. If A is exactly singular, Inverse
+// returns a SingularError holding the index of the first zero pivot.
+func Inverse(a blas64.General) (blas64.General, error) {
+	if a.Rows != a.Cols {
+		panic(badShapeA)
+	}
+	n := a.Rows
+	inv := cloneGeneral(a)
+	if n == 0 {
+		return inv, nil
+	}
+	ipiv := make([]int, n)
+	if !lapackImpl.Dgetrf(n, n, inv.Data, inv.Stride, ipiv) {
+		return blas64.General{}, SingularError{Index: zeroDiag(inv.Data, inv.Stride, n)}
+	}
+	work := make([]float64, 1)
+	lapackImpl.Dgetri(n, inv.Data, inv.Stride, ipiv, work, -1)
+	work = make([]float64, max(n, int(work[0])))
+	lapackImpl.Dgetri(n, inv.Data, inv.Stride, ipiv, work, len(work))
+	return inv, nil
+}
+
+// SPDInverse returns the inverse of the n×n symmetric positive definite
+// matrix A, computed from its Cholesky factorization by Dpotrf and Dpotri.
+// Only the triangle of A indicated by a.Uplo is referenced and the input a
+// is not modified. Both triangles of the returned matrix are set, so its
+// data can also be used as a general matrix.
+//
+// If A is not positive definite, SPDInverse returns an
+// ErrNotPositiveDefinite.
+func SPDInverse(a blas64.Symmetric) (blas64.Symmetric, error) {
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	n := a.N
+	t := blas64.Triangular{
+		Uplo:   a.Uplo,
+		Diag:   blas.NonUnit,
+		N:      n,
+		Stride: max(1, n),
+		Data:   make([]float64, n*n),
+	}
+	err := cholesky(a, t, 0)
+	if err != nil {
+		return blas64.Symmetric{}, err
+	}
+	inv := blas64.Symmetric{Uplo: a.Uplo, N: n, Stride: t.Stride, Data: t.Data}
+	if n == 0 {
+		return inv, nil
+	}
+	lapackImpl.Dpotri(a.Uplo, n, inv.Data, inv.Stride)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if a.Uplo == blas.Upper {
+				inv.Data[j*inv.Stride+i] = inv.Data[i*inv.Stride+j]
+			} else {
+				inv.Data[i*inv.Stride+j] = inv.Data[j*inv.Stride+i]
+			}
+		}
+	}
+	return inv, nil
+}
+
+// TriangularInverse returns the inverse of the n×n triangular matrix T,
+// computed by Dtrtri. The inverse has the same Uplo and Diag as t, and the
+// input t is not modified. If T is exactly singular, TriangularInverse
+// returns a SingularError holding the index of the first zero diagonal
+// element.
+func TriangularInverse(t blas64.Triangular) (blas64.Triangular, error) {
+	switch {
+	case t.Uplo != blas.Upper && t.Uplo != blas.Lower:
+		panic(badUplo)
+	case t.Diag != blas.NonUnit && t.Diag != blas.Unit:
+		panic(badDiag)
+	}
+	n := t.N
+	inv := blas64.Triangular{
+		Uplo:   t.Uplo,
+		Diag:   t.Diag,
+		N:      n,
+		Stride: max(1, n),
+		Data:   make([]float64, n*n),
+	}
+	for i := 0; i < n; i++ {
+		if t.Uplo == blas.Upper {
+			copy(inv.Data[i*inv.Stride+i:i*inv.Stride+n], t.Data[i*t.Stride+i:])
+		} else {
+			copy(inv.Data[i*inv.Stride:i*inv.Stride+i+1], t.Data[i*t.Stride:])
+		}
+	}
+	if n == 0 {
+		return inv, nil
+	}
+	if !lapackImpl.Dtrtri(t.Uplo, t.Diag, n, inv.Data, inv.Stride) {
+		return blas64.Triangular{}, SingularError{Index: zeroDiag(inv.Data, inv.Stride, n)}
+	}
+	return inv, nil
+}
+
+// zeroDiag returns the index of the first zero diagonal element of the n×n
+// matrix held in a, or -1 if there is none.
+func zeroDiag(a []float64, lda, n int) int {
+	for i := 0; i < n; i++ {
+		if a[i*lda+i] == 0 {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,107 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+)
+
+// Inverse32 is the float32 version of Inverse.
+func Inverse32(a blas32.General) (blas32.General, error) {
+	if a.Rows != a.Cols {
+		panic(badShapeA)
+	}
+	n := a.Rows
+	inv := cloneGeneral32(a)
+	if n == 0 {
+		return inv, nil
+	}
+	ipiv := make([]int, n)
+	if !lapackImpl.Sgetrf(n, n, inv.Data, inv.Stride, ipiv) {
+		return blas32.General{}, SingularError{Index: zeroDiag32(inv.Data, inv.Stride, n)}
+	}
+	work := make([]float32, 1)
+	lapackImpl.Sgetri(n, inv.Data, inv.Stride, ipiv, work, -1)
+	work = make([]float32, max(n, int(work[0])))
+	lapackImpl.Sgetri(n, inv.Data, inv.Stride, ipiv, work, len(work))
+	return inv, nil
+}
+
+// SPDInverse32 is the float32 version of SPDInverse.
+func SPDInverse32(a blas32.Symmetric) (blas32.Symmetric, error) {
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	n := a.N
+	t := blas32.Triangular{
+		Uplo:   a.Uplo,
+		Diag:   blas.NonUnit,
+		N:      n,
+		Stride: max(1, n),
+		Data:   make([]float32, n*n),
+	}
+	err := cholesky32(a, t, 0)
+	if err != nil {
+		return blas32.Symmetric{}, err
+	}
+	inv := blas32.Symmetric{Uplo: a.Uplo, N: n, Stride: t.Stride, Data: t.Data}
+	if n == 0 {
+		return inv, nil
+	}
+	lapackImpl.Spotri(a.Uplo, n, inv.Data, inv.Stride)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			if a.Uplo == blas.Upper {
+				inv.Data[j*inv.Stride+i] = inv.Data[i*inv.Stride+j]
+			} else {
+				inv.Data[i*inv.Stride+j] = inv.Data[j*inv.Stride+i]
+			}
+		}
+	}
+	return inv, nil
+}
+
+// TriangularInverse32 is the float32 version of TriangularInverse.
+func TriangularInverse32(t blas32.Triangular) (blas32.Triangular, error) {
+	switch {
+	case t.Uplo != blas.Upper && t.Uplo != blas.Lower:
+		panic(badUplo)
+	case t.Diag != blas.NonUnit && t.Diag != blas.Unit:
+		panic(badDiag)
+	}
+	n := t.N
+	inv := blas32.Triangular{
+		Uplo:   t.Uplo,
+		Diag:   t.Diag,
+		N:      n,
+		Stride: max(1, n),
+		Data:   make([]float32, n*n),
+	}
+	for i := 0; i < n; i++ {
+		if t.Uplo == blas.Upper {
+			copy(inv.Data[i*inv.Stride+i:i*inv.Stride+n], t.Data[i*t.Stride+i:])
+		} else {
+			copy(inv.Data[i*inv.Stride:i*inv.Stride+i+1], t.Data[i*t.Stride:])
+		}
+	}
+	if n == 0 {
+		return inv, nil
+	}
+	if !lapackImpl.Strtri(t.Uplo, t.Diag, n, inv.Data, inv.Stride) {
+		return blas32.Triangular{}, SingularError{Index: zeroDiag32(inv.Data, inv.Stride, n)}
+	}
+	return inv, nil
+}
+
+// zeroDiag32 is the float32 version of zeroDiag.
+func zeroDiag32(a []float32, lda, n int) int {
+	for i := 0; i < n; i++ {
+		if a[i*lda+i] == 0 {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,227 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// identityResidual returns max |A*B - I| for the n×n matrices A and B.
+func identityResidual(a, b blas64.General) float64 {
+	return maxDiff(mul(a, b), eye(a.Rows), false)
+}
+
+func TestInverse(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 7, 40} {
+		a := randomGeneral(rnd, n, n, n+3)
+		orig := cloneGeneral(a)
+		inv, err := Inverse(a)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error: %v", n, err)
+			continue
+		}
+		if maxDiff(a, orig, false) != 0 {
+			t.Errorf("n=%d: a modified", n)
+		}
+		if d := identityResidual(orig, inv); d > tol {
+			t.Errorf("n=%d: |A*inv(A)-I| = %v", n, d)
+		}
+	}
+
+	// A matrix with a zero column is singular.
+	a := randomGeneral(rnd, 4, 4, 4)
+	for i := 0; i < 4; i++ {
+		a.Data[i*a.Stride+2] = 0
+	}
+	if _, err := Inverse(a); err == nil {
+		t.Error("expected error for singular matrix")
+	} else if _, ok := err.(SingularError); !ok {
+		t.Errorf("unexpected error type %T", err)
+	}
+}
+
+func TestSPDInverse(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 3, 10, 33} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			name := fmt.Sprintf("n=%d,uplo=%c", n, uplo)
+			// Shift the spectrum so that the inverse is well conditioned.
+			a := randomSPD(rnd, n, n)
+			for i := 0; i < n; i++ {
+				a.Data[i*a.Stride+i] += float64(n)
+			}
+			a.Uplo = uplo
+			g := blas64.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data}
+			orig := cloneGeneral(g)
+			inv, err := SPDInverse(a)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			if maxDiff(g, orig, false) != 0 {
+				t.Errorf("%s: a modified", name)
+			}
+			if inv.Uplo != uplo {
+				t.Errorf("%s: unexpected uplo %c", name, inv.Uplo)
+			}
+			full := blas64.General{Rows: n, Cols: n, Stride: inv.Stride, Data: inv.Data}
+			if d := maxDiff(full, full, true); d != 0 {
+				t.Errorf("%s: inverse not symmetric", name)
+			}
+			if d := identityResidual(orig, full); d > tol {
+				t.Errorf("%s: |A*inv(A)-I| = %v", name, d)
+			}
+		}
+	}
+
+	a := randomSPD(rnd, 5, 3)
+	if _, err := SPDInverse(a); err == nil {
+		t.Error("expected error for singular matrix")
+	} else if _, ok := err.(ErrNotPositiveDefinite); !ok {
+		t.Errorf("unexpected error type %T", err)
+	}
+}
+
+func TestTriangularInverse(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 4, 19} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, diag := range []blas.Diag{blas.NonUnit, blas.Unit} {
+				name := fmt.Sprintf("n=%d,uplo=%c,diag=%c", n, uplo, diag)
+				// Use a diagonally dominant matrix so that the inverse is
+				// well conditioned.
+				g := randomGeneral(rnd, n, n, n+1)
+				for i := 0; i < n; i++ {
+					for j := 0; j < n; j++ {
+						g.Data[i*g.Stride+j] /= float64(n)
+					}
+					g.Data[i*g.Stride+i] += 2
+				}
+				tm := blas64.Triangular{Uplo: uplo, Diag: diag, N: n, Stride: g.Stride, Data: g.Data}
+				inv, err := TriangularInverse(tm)
+				if err != nil {
+					t.Errorf("%s: unexpected error: %v", name, err)
+					continue
+				}
+				dense := func(tr blas64.Triangular) blas64.General {
+					d := newGeneral(n, n)
+					for i := 0; i < n; i++ {
+						for j := 0; j < n; j++ {
+							switch {
+							case i == j && diag == blas.Unit:
+								d.Data[i*d.Stride+j] = 1
+							case i == j, uplo == blas.Upper && i < j, uplo == blas.Lower && i > j:
+								d.Data[i*d.Stride+j] = tr.Data[i*tr.Stride+j]
+							}
+						}
+					}
+					return d
+				}
+				if d := identityResidual(dense(tm), dense(inv)); d > tol {
+					t.Errorf("%s: |T*inv(T)-I| = %v", name, d)
+				}
+			}
+		}
+	}
+
+	g := eye(3)
+	g.Data[1*g.Stride+1] = 0
+	_, err := TriangularInverse(blas64.Triangular{Uplo: blas.Upper, Diag: blas.NonUnit, N: 3, Stride: 3, Data: g.Data})
+	if err != (SingularError{Index: 1}) {
+		t.Errorf("unexpected error for singular matrix: %v", err)
+	}
+}
+
+func TestZgetri(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 5, 16} {
+		a := randomCGeneral(rnd, n, n, n+1)
+		inv := cloneCGeneral(a)
+		ipiv := make([]int, n)
+		if !impl.Zgetrf(n, n, inv.Data, inv.Stride, ipiv) {
+			t.Fatalf("n=%d: unexpected singular matrix", n)
+		}
+		work := make([]complex128, 1)
+		impl.Zgetri(n, inv.Data, inv.Stride, ipiv, work, -1)
+		work = make([]complex128, int(real(work[0])))
+		if !impl.Zgetri(n, inv.Data, inv.Stride, ipiv, work, len(work)) {
+			t.Fatalf("n=%d: unexpected singular matrix", n)
+		}
+		if d := cmaxAbsDiff(naiveCMul(a, inv), ceye(n)); d > ztol {
+			t.Errorf("n=%d: |A*inv(A)-I| = %v", n, d)
+		}
+	}
+}
+
+func TestZpotri(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 6, 13} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			a := randomHPD(rnd, n)
+			inv := cloneCGeneral(a)
+			if !impl.Zpotrf(uplo, n, inv.Data, inv.Stride) || !impl.Zpotri(uplo, n, inv.Data, inv.Stride) {
+				t.Fatalf("n=%d: unexpected failure", n)
+			}
+			// Fill the other triangle from the Hermitian symmetry.
+			for i := 0; i < n; i++ {
+				for j := i + 1; j < n; j++ {
+					if uplo == blas.Upper {
+						inv.Data[j*inv.Stride+i] = cmplx.Conj(inv.Data[i*inv.Stride+j])
+					} else {
+						inv.Data[i*inv.Stride+j] = cmplx.Conj(inv.Data[j*inv.Stride+i])
+					}
+				}
+			}
+			if d := cmaxAbsDiff(naiveCMul(a, inv), ceye(n)); d > ztol {
+				t.Errorf("n=%d,uplo=%c: |A*inv(A)-I| = %v", n, uplo, d)
+			}
+		}
+	}
+}
+
+func TestZtrtri(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 4, 11} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			a := randomCGeneral(rnd, n, n, n)
+			for i := 0; i < n; i++ {
+				a.Data[i*a.Stride+i] += complex(float64(2*n), 0)
+				for j := 0; j < n; j++ {
+					if uplo == blas.Upper && j < i || uplo == blas.Lower && j > i {
+						a.Data[i*a.Stride+j] = 0
+					}
+				}
+			}
+			inv := cloneCGeneral(a)
+			if !impl.Ztrtri(uplo, blas.NonUnit, n, inv.Data, inv.Stride) {
+				t.Fatalf("n=%d: unexpected singular matrix", n)
+			}
+			if d := cmaxAbsDiff(naiveCMul(a, inv), ceye(n)); d > ztol {
+				t.Errorf("n=%d,uplo=%c: |T*inv(T)-I| = %v", n, uplo, d)
+			}
+		}
+	}
+}
+
+// ceye returns the n×n complex identity matrix.
+func ceye(n int) cblas128.General {
+	e := newCGeneral(n, n)
+	for i := 0; i < n; i++ {
+		e.Data[i*e.Stride+i] = 1
+	}
+	return e
+}
@@ -0,0 +1,182 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+const (
+	badLenX      = "lapack: bad length of x"
+	badKhatriRao = "lapack: a and b have different numbers of columns"
+)
+
+// Kron returns the Kronecker product of the m×n matrix A and the p×q matrix B,
+// the mp×nq matrix
+//  A ⊗ B = [ A_00*B  A_01*B  ... ]
+//          [ A_10*B  A_11*B  ... ]
+//          [  ...     ...        ]
+// Kron materializes the product and is intended for small factors; use
+// KronMulVec to apply the product to a vector.
+func Kron(a, b blas64.General) blas64.General {
+	m, n := a.Rows, a.Cols
+	p, q := b.Rows, b.Cols
+	k := newGeneral(m*p, n*q)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			aij := a.Data[i*a.Stride+j]
+			for r := 0; r < p; r++ {
+				dst := k.Data[(i*p+r)*k.Stride+j*q : (i*p+r)*k.Stride+(j+1)*q]
+				src := b.Data[r*b.Stride : r*b.Stride+q]
+				for c, v := range src {
+					dst[c] = aij * v
+				}
+			}
+		}
+	}
+	return k
+}
+
+// KronMulVec computes
+//  y = (A ⊗ B) * x      if trans == blas.NoTrans,
+//  y = (A ⊗ B)^T * x    if trans == blas.Trans or blas.ConjTrans,
+// without forming the Kronecker product. A is an m×n matrix and B is a p×q
+// matrix. Viewing x as the row-major matrix X and y as the row-major matrix Y,
+// the product is computed with two calls to Dgemm as
+//  Y = op(A) * X * op(B)^T,
+// in the order that requires fewer operations. This needs O(mn + pq) memory
+// instead of the O(mnpq) of the Kronecker product.
+//
+// For trans == blas.NoTrans, x must have length n*q and the returned y has
+// length m*p; otherwise the roles of m, p and n, q are exchanged. KronMulVec
+// will panic if x has the wrong length.
+func KronMulVec(trans blas.Transpose, a, b blas64.General, x []float64) []float64 {
+	if trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans {
+		panic(badTrans)
+	}
+	// op(A) is m×n and op(B) is p×q.
+	m, n := a.Rows, a.Cols
+	p, q := b.Rows, b.Cols
+	tA, tB := blas.NoTrans, blas.Trans
+	if trans != blas.NoTrans {
+		m, n = n, m
+		p, q = q, p
+		tA, tB = blas.Trans, blas.NoTrans
+	}
+	if len(x) != n*q {
+		panic(badLenX)
+	}
+	y := make([]float64, m*p)
+	if m == 0 || p == 0 || n == 0 || q == 0 {
+		return y
+	}
+	lda, ldb := a.Stride, b.Stride
+	if m*q*(n+p) <= n*p*(q+m) {
+		// T = op(A) * X is m×q, then Y = T * op(B)^T.
+		t := make([]float64, m*q)
+		blasImpl.Dgemm(tA, blas.NoTrans, m, q, n, 1, a.Data, lda, x, q, 0, t, q)
+		blasImpl.Dgemm(blas.NoTrans, tB, m, p, q, 1, t, q, b.Data, ldb, 0, y, p)
+		return y
+	}
+	// T = X * op(B)^T is n×p, then Y = op(A) * T.
+	t := make([]float64, n*p)
+	blasImpl.Dgemm(blas.NoTrans, tB, n, p, q, 1, x, q, b.Data, ldb, 0, t, p)
+	blasImpl.Dgemm(tA, blas.NoTrans, m, p, n, 1, a.Data, lda, t, p, 0, y, p)
+	return y
+}
+
+// KhatriRao returns the Khatri-Rao product of the m×n matrix A and the p×n
+// matrix B, the mp×n matrix whose j-th column is the Kronecker product of the
+// j-th columns of A and B,
+//  (A ⊙ B)_{i*p+r, j} = A_ij * B_rj.
+// KhatriRao will panic if A and B have different numbers of columns.
+func KhatriRao(a, b blas64.General) blas64.General {
+	if a.Cols != b.Cols {
+		panic(badKhatriRao)
+	}
+	m, n := a.Rows, a.Cols
+	p := b.Rows
+	k := newGeneral(m*p, n)
+	for i := 0; i < m; i++ {
+		arow := a.Data[i*a.Stride : i*a.Stride+n]
+		for r := 0; r < p; r++ {
+			brow := b.Data[r*b.Stride : r*b.Stride+n]
+			dst := k.Data[(i*p+r)*k.Stride : (i*p+r)*k.Stride+n]
+			for j := range dst {
+				dst[j] = arow[j] * brow[j]
+			}
+		}
+	}
+	return k
+}
+
+// KhatriRaoMulVec computes
+//  y = (A ⊙ B) * x
+// without forming the Khatri-Rao product, where A is an m×n matrix and B is a
+// p×n matrix. Viewing y as the row-major m×p matrix Y, the product is
+// computed with a single call to Dgemm as
+//  Y = A * diag(x) * B^T.
+// x must have length n and the returned y has length m*p. KhatriRaoMulVec
+// will panic if A and B have different numbers of columns or if x has the
+// wrong length.
+func KhatriRaoMulVec(a, b blas64.General, x []float64) []float64 {
+	if a.Cols != b.Cols {
+		panic(badKhatriRao)
+	}
+	m, n := a.Rows, a.Cols
+	p := b.Rows
+	if len(x) != n {
+		panic(badLenX)
+	}
+	y := make([]float64, m*p)
+	if m == 0 || p == 0 || n == 0 {
+		return y
+	}
+	ax := cloneGeneral(a)
+	for i := 0; i < m; i++ {
+		row := ax.Data[i*ax.Stride : i*ax.Stride+n]
+		for j, v := range x {
+			row[j] *= v
+		}
+	}
+	blasImpl.Dgemm(blas.NoTrans, blas.Trans, m, p, n, 1, ax.Data, ax.Stride, b.Data, b.Stride, 0, y, p)
+	return y
+}
+
+// KhatriRaoTransMulVec computes
+//  y = (A ⊙ B)^T * x
+// without forming the Khatri-Rao product, where A is an m×n matrix and B is a
+// p×n matrix. This is the matricized tensor times Khatri-Rao product kernel
+// of the alternating least squares tensor decompositions. Viewing x as the
+// row-major m×p matrix X, y_j is the j-th diagonal element of A^T * X * B,
+// which is computed with a single call to Dgemm as the column sums of
+//  A .* (X * B).
+// x must have length m*p and the returned y has length n. KhatriRaoTransMulVec
+// will panic if A and B have different numbers of columns or if x has the
+// wrong length.
+func KhatriRaoTransMulVec(a, b blas64.General, x []float64) []float64 {
+	if a.Cols != b.Cols {
+		panic(badKhatriRao)
+	}
+	m, n := a.Rows, a.Cols
+	p := b.Rows
+	if len(x) != m*p {
+		panic(badLenX)
+	}
+	y := make([]float64, n)
+	if m == 0 || p == 0 || n == 0 {
+		return y
+	}
+	t := make([]float64, m*n)
+	blasImpl.Dgemm(blas.NoTrans, blas.NoTrans, m, n, p, 1, x, p, b.Data, b.Stride, 0, t, n)
+	for i := 0; i < m; i++ {
+		arow := a.Data[i*a.Stride : i*a.Stride+n]
+		for j, v := range t[i*n : (i+1)*n] {
+			y[j] += arow[j] * v
+		}
+	}
+	return y
+}
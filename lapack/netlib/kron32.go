@@ -0,0 +1,137 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+)
+
+// Kron32 is the float32 version of Kron.
+func Kron32(a, b blas32.General) blas32.General {
+	m, n := a.Rows, a.Cols
+	p, q := b.Rows, b.Cols
+	k := newGeneral32(m*p, n*q)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			aij := a.Data[i*a.Stride+j]
+			for r := 0; r < p; r++ {
+				dst := k.Data[(i*p+r)*k.Stride+j*q : (i*p+r)*k.Stride+(j+1)*q]
+				src := b.Data[r*b.Stride : r*b.Stride+q]
+				for c, v := range src {
+					dst[c] = aij * v
+				}
+			}
+		}
+	}
+	return k
+}
+
+// KronMulVec32 is the float32 version of KronMulVec.
+func KronMulVec32(trans blas.Transpose, a, b blas32.General, x []float32) []float32 {
+	if trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans {
+		panic(badTrans)
+	}
+	// op(A) is m×n and op(B) is p×q.
+	m, n := a.Rows, a.Cols
+	p, q := b.Rows, b.Cols
+	tA, tB := blas.NoTrans, blas.Trans
+	if trans != blas.NoTrans {
+		m, n = n, m
+		p, q = q, p
+		tA, tB = blas.Trans, blas.NoTrans
+	}
+	if len(x) != n*q {
+		panic(badLenX)
+	}
+	y := make([]float32, m*p)
+	if m == 0 || p == 0 || n == 0 || q == 0 {
+		return y
+	}
+	lda, ldb := a.Stride, b.Stride
+	if m*q*(n+p) <= n*p*(q+m) {
+		// T = op(A) * X is m×q, then Y = T * op(B)^T.
+		t := make([]float32, m*q)
+		blasImpl.Sgemm(tA, blas.NoTrans, m, q, n, 1, a.Data, lda, x, q, 0, t, q)
+		blasImpl.Sgemm(blas.NoTrans, tB, m, p, q, 1, t, q, b.Data, ldb, 0, y, p)
+		return y
+	}
+	// T = X * op(B)^T is n×p, then Y = op(A) * T.
+	t := make([]float32, n*p)
+	blasImpl.Sgemm(blas.NoTrans, tB, n, p, q, 1, x, q, b.Data, ldb, 0, t, p)
+	blasImpl.Sgemm(tA, blas.NoTrans, m, p, n, 1, a.Data, lda, t, p, 0, y, p)
+	return y
+}
+
+// KhatriRao32 is the float32 version of KhatriRao.
+func KhatriRao32(a, b blas32.General) blas32.General {
+	if a.Cols != b.Cols {
+		panic(badKhatriRao)
+	}
+	m, n := a.Rows, a.Cols
+	p := b.Rows
+	k := newGeneral32(m*p, n)
+	for i := 0; i < m; i++ {
+		arow := a.Data[i*a.Stride : i*a.Stride+n]
+		for r := 0; r < p; r++ {
+			brow := b.Data[r*b.Stride : r*b.Stride+n]
+			dst := k.Data[(i*p+r)*k.Stride : (i*p+r)*k.Stride+n]
+			for j := range dst {
+				dst[j] = arow[j] * brow[j]
+			}
+		}
+	}
+	return k
+}
+
+// KhatriRaoMulVec32 is the float32 version of KhatriRaoMulVec.
+func KhatriRaoMulVec32(a, b blas32.General, x []float32) []float32 {
+	if a.Cols != b.Cols {
+		panic(badKhatriRao)
+	}
+	m, n := a.Rows, a.Cols
+	p := b.Rows
+	if len(x) != n {
+		panic(badLenX)
+	}
+	y := make([]float32, m*p)
+	if m == 0 || p == 0 || n == 0 {
+		return y
+	}
+	ax := cloneGeneral32(a)
+	for i := 0; i < m; i++ {
+		row := ax.Data[i*ax.Stride : i*ax.Stride+n]
+		for j, v := range x {
+			row[j] *= v
+		}
+	}
+	blasImpl.Sgemm(blas.NoTrans, blas.Trans, m, p, n, 1, ax.Data, ax.Stride, b.Data, b.Stride, 0, y, p)
+	return y
+}
+
+// KhatriRaoTransMulVec32 is the float32 version of KhatriRaoTransMulVec.
+func KhatriRaoTransMulVec32(a, b blas32.General, x []float32) []float32 {
+	if a.Cols != b.Cols {
+		panic(badKhatriRao)
+	}
+	m, n := a.Rows, a.Cols
+	p := b.Rows
+	if len(x) != m*p {
+		panic(badLenX)
+	}
+	y := make([]float32, n)
+	if m == 0 || p == 0 || n == 0 {
+		return y
+	}
+	t := make([]float32, m*n)
+	blasImpl.Sgemm(blas.NoTrans, blas.NoTrans, m, n, p, 1, x, p, b.Data, b.Stride, 0, t, n)
+	for i := 0; i < m; i++ {
+		arow := a.Data[i*a.Stride : i*a.Stride+n]
+		for j, v := range t[i*n : (i+1)*n] {
+			y[j] += arow[j] * v
+		}
+	}
+	return y
+}
@@ -0,0 +1,120 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestKron(t *testing.T) {
+	a := blas64.General{Rows: 2, Cols: 2, Stride: 3, Data: []float64{
+		1, 2, 0,
+		3, 4, 0,
+	}}
+	b := blas64.General{Rows: 1, Cols: 2, Stride: 2, Data: []float64{1, -1}}
+	want := []float64{
+		1, -1, 2, -2,
+		3, -3, 4, -4,
+	}
+	k := Kron(a, b)
+	if k.Rows != 2 || k.Cols != 4 || !floats.Equal(k.Data, want) {
+		t.Errorf("unexpected Kronecker product: %v", k)
+	}
+
+	a = blas64.General{Rows: 2, Cols: 2, Stride: 2, Data: []float64{1, 2, 3, 4}}
+	b = blas64.General{Rows: 2, Cols: 2, Stride: 2, Data: []float64{5, 6, 7, 8}}
+	want = []float64{
+		5, 12,
+		7, 16,
+		15, 24,
+		21, 32,
+	}
+	k = KhatriRao(a, b)
+	if k.Rows != 4 || k.Cols != 2 || !floats.Equal(k.Data, want) {
+		t.Errorf("unexpected Khatri-Rao product: %v", k)
+	}
+}
+
+func TestKronMulVec(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, n, p, q int }{
+		{0, 3, 2, 2},
+		{1, 1, 1, 1},
+		{3, 4, 5, 2},
+		{7, 2, 2, 6},
+		{4, 4, 4, 4},
+	} {
+		a := randomGeneral(rnd, test.m, test.n, test.n+2)
+		b := randomGeneral(rnd, test.p, test.q, test.q+1)
+		k := Kron(a, b)
+		for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			name := fmt.Sprintf("m=%d,n=%d,p=%d,q=%d,trans=%c", test.m, test.n, test.p, test.q, trans)
+			xlen, ylen := k.Cols, k.Rows
+			if trans == blas.Trans {
+				xlen, ylen = ylen, xlen
+			}
+			x := make([]float64, xlen)
+			for i := range x {
+				x[i] = rnd.NormFloat64()
+			}
+			want := make([]float64, ylen)
+			if xlen > 0 && ylen > 0 {
+				blas64.Gemv(trans, 1, k, blas64.Vector{N: xlen, Inc: 1, Data: x}, 0, blas64.Vector{N: ylen, Inc: 1, Data: want})
+			}
+			got := KronMulVec(trans, a, b, x)
+			if !floats.EqualApprox(got, want, tol) {
+				t.Errorf("%s: unexpected result: got %v want %v", name, got, want)
+			}
+		}
+	}
+}
+
+func TestKhatriRaoMulVec(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, p, n int }{
+		{0, 2, 3},
+		{1, 1, 1},
+		{3, 4, 5},
+		{6, 2, 3},
+	} {
+		name := fmt.Sprintf("m=%d,p=%d,n=%d", test.m, test.p, test.n)
+		a := randomGeneral(rnd, test.m, test.n, test.n+1)
+		b := randomGeneral(rnd, test.p, test.n, test.n+3)
+		k := KhatriRao(a, b)
+
+		x := make([]float64, test.n)
+		for i := range x {
+			x[i] = rnd.NormFloat64()
+		}
+		want := make([]float64, k.Rows)
+		if k.Rows > 0 {
+			blas64.Gemv(blas.NoTrans, 1, k, blas64.Vector{N: len(x), Inc: 1, Data: x}, 0, blas64.Vector{N: len(want), Inc: 1, Data: want})
+		}
+		if got := KhatriRaoMulVec(a, b, x); !floats.EqualApprox(got, want, tol) {
+			t.Errorf("%s: unexpected product: got %v want %v", name, got, want)
+		}
+
+		x = make([]float64, k.Rows)
+		for i := range x {
+			x[i] = rnd.NormFloat64()
+		}
+		want = make([]float64, test.n)
+		if k.Rows > 0 {
+			blas64.Gemv(blas.Trans, 1, k, blas64.Vector{N: len(x), Inc: 1, Data: x}, 0, blas64.Vector{N: len(want), Inc: 1, Data: want})
+		}
+		if got := KhatriRaoTransMulVec(a, b, x); !floats.EqualApprox(got, want, tol) {
+			t.Errorf("%s: unexpected transposed product: got %v want %v", name, got, want)
+		}
+	}
+}
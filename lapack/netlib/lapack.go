@@ -10,6 +10,7 @@ import (
 
 	"gonum.org/v1/gonum/blas"
 	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/gonum"
 	"gonum.org/v1/netlib/lapack/lapacke"
 )
 
@@ -18,6 +19,43 @@ type Implementation struct{}
 
 var _ lapack.Float64 = Implementation{}
 
+// Panic strings for band matrix routines that are not in gonum/lapack/gonum.
+const (
+	klLT0 = "lapack: kl < 0"
+	kuLT0 = "lapack: ku < 0"
+
+	shortAFB  = "lapack: insufficient length of afb"
+	shortFerr = "lapack: insufficient length of ferr"
+	shortBerr = "lapack: insufficient length of berr"
+)
+
+// Panic strings for tridiagonal matrix routines.
+const (
+	shortDL  = "lapack: insufficient length of dl"
+	shortDU  = "lapack: insufficient length of du"
+	shortDU2 = "lapack: insufficient length of du2"
+)
+
+// shortSWork is the panic message for a too short single precision
+// workspace of the mixed precision routines.
+const shortSWork = "lapack: insufficient length of swork"
+
+// badIsgn is the panic message for a sign of the Sylvester equation that is
+// neither 1 nor -1.
+const badIsgn = "lapack: bad isgn"
+
+// The panic messages of the routines operating on matrices in Rectangular
+// Full Packed and standard packed format.
+const (
+	badTransR = "lapack: bad transr"
+	shortARF  = "lapack: insufficient length of arf"
+	shortAP   = "lapack: insufficient length of ap"
+)
+
+// shortR is the panic message for a too short vector of row scale factors
+// of the equilibration routines.
+const shortR = "lapack: insufficient length of r"
+
 // Dgeqp3 computes a QR factorization with column pivoting of the
 // m×n matrix A: A*P = Q*R using Level 3 BLAS.
 //
@@ -56,15 +94,15 @@ func (impl Implementation) Dgeqp3(m, n int, a []float64, lda int, jpvt []int, ta
 	}
 	switch {
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dgeqp3", Param: "m", Message: mLT0})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dgeqp3", Param: "n", Message: nLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dgeqp3", Param: "lda", Message: badLdA})
 	case lwork < iws && lwork != -1:
-		panic(badLWork)
+		panic(Error{Routine: "Dgeqp3", Param: "lwork", Message: badLWork})
 	case len(work) < max(1, lwork):
-		panic(shortWork)
+		panic(Error{Routine: "Dgeqp3", Param: "work", Message: shortWork})
 	}
 
 	// Quick return if possible.
@@ -81,20 +119,20 @@ func (impl Implementation) Dgeqp3(m, n int, a []float64, lda int, jpvt []int, ta
 
 	switch {
 	case len(a) < (m-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dgeqp3", Param: "a", Message: shortA})
 	case len(jpvt) != n:
-		panic(badLenJpvt)
+		panic(Error{Routine: "Dgeqp3", Param: "jpvt", Message: badLenJpvt})
 	case len(tau) < minmn:
-		panic(shortTau)
+		panic(Error{Routine: "Dgeqp3", Param: "tau", Message: shortTau})
 	}
 
-	jpvt32 := make([]int32, n)
+	jpvt32 := make([]lapacke.Int, n)
 	for i, v := range jpvt {
 		v++
-		if v != int(int32(v)) || v < 0 || n < v {
-			panic(badJpvt)
+		if v != int(lapacke.Int(v)) || v < 0 || n < v {
+			panic(Error{Routine: "Dgeqp3", Param: "jpvt", Message: badJpvt})
 		}
-		jpvt32[i] = int32(v)
+		jpvt32[i] = lapacke.Int(v)
 	}
 	lapacke.Dgeqp3(m, n, a, lda, jpvt32, tau, work, lwork)
 	for i, v := range jpvt32 {
@@ -127,15 +165,15 @@ func (impl Implementation) Dgeqp3(m, n int, a []float64, lda int, jpvt []int, ta
 func (impl Implementation) Dgerqf(m, n int, a []float64, lda int, tau, work []float64, lwork int) {
 	switch {
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dgerqf", Param: "m", Message: mLT0})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dgerqf", Param: "n", Message: nLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dgerqf", Param: "lda", Message: badLdA})
 	case lwork < max(1, m) && lwork != -1:
-		panic(badLWork)
+		panic(Error{Routine: "Dgerqf", Param: "lwork", Message: badLWork})
 	case len(work) < max(1, lwork):
-		panic(shortWork)
+		panic(Error{Routine: "Dgerqf", Param: "work", Message: shortWork})
 	}
 
 	// Quick return if possible.
@@ -152,9 +190,9 @@ func (impl Implementation) Dgerqf(m, n int, a []float64, lda int, tau, work []fl
 
 	switch {
 	case len(a) < (m-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dgerqf", Param: "a", Message: shortA})
 	case len(tau) != k:
-		panic(badLenTau)
+		panic(Error{Routine: "Dgerqf", Param: "tau", Message: badLenTau})
 	}
 
 	lapacke.Dgerqf(m, n, a, lda, tau, work, lwork)
@@ -180,23 +218,23 @@ func (impl Implementation) Dgerqf(m, n int, a []float64, lda int, tau, work []fl
 func (impl Implementation) Dlacn2(n int, v, x []float64, isgn []int, est float64, kase int, isave *[3]int) (float64, int) {
 	switch {
 	case n < 1:
-		panic(nLT1)
+		panic(Error{Routine: "Dlacn2", Param: "n", Message: nLT1})
 	case len(v) < n:
-		panic(shortV)
+		panic(Error{Routine: "Dlacn2", Param: "v", Message: shortV})
 	case len(x) < n:
-		panic(shortX)
+		panic(Error{Routine: "Dlacn2", Param: "x", Message: shortX})
 	case len(isgn) < n:
-		panic(shortIsgn)
+		panic(Error{Routine: "Dlacn2", Param: "isgn", Message: shortIsgn})
 	case isave[0] < 0 || 5 < isave[0]:
-		panic(badIsave)
+		panic(Error{Routine: "Dlacn2", Param: "isave", Message: badIsave})
 	case isave[0] == 0 && kase != 0:
-		panic(badIsave)
+		panic(Error{Routine: "Dlacn2", Param: "isave", Message: badIsave})
 	}
 
-	isgn32 := make([]int32, n)
+	isgn32 := make([]lapacke.Int, n)
 	pest := []float64{est}
 	// Save one allocation by putting isave and kase into the same slice.
-	isavekase := []int32{int32(isave[0]), int32(isave[1]), int32(isave[2]), int32(kase)}
+	isavekase := []lapacke.Int{lapacke.Int(isave[0]), lapacke.Int(isave[1]), lapacke.Int(isave[2]), lapacke.Int(kase)}
 	lapacke.Dlacn2(n, v, x, isgn32, pest, isavekase[3:], isavekase[:3])
 	for i, v := range isgn32 {
 		isgn[i] = int(v)
@@ -214,15 +252,15 @@ func (impl Implementation) Dlacn2(n int, v, x []float64, isgn []int, est float64
 func (impl Implementation) Dlacpy(uplo blas.Uplo, m, n int, a []float64, lda int, b []float64, ldb int) {
 	switch {
 	case uplo != blas.Upper && uplo != blas.Lower && uplo != blas.All:
-		panic(badUplo)
+		panic(Error{Routine: "Dlacpy", Param: "uplo", Message: badUplo})
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dlacpy", Param: "m", Message: mLT0})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dlacpy", Param: "n", Message: nLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dlacpy", Param: "lda", Message: badLdA})
 	case ldb < max(1, n):
-		panic(badLdB)
+		panic(Error{Routine: "Dlacpy", Param: "ldb", Message: badLdB})
 	}
 
 	if m == 0 || n == 0 {
@@ -231,9 +269,9 @@ func (impl Implementation) Dlacpy(uplo blas.Uplo, m, n int, a []float64, lda int
 
 	switch {
 	case len(a) < (m-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dlacpy", Param: "a", Message: shortA})
 	case len(b) < (m-1)*ldb+n:
-		panic(shortB)
+		panic(Error{Routine: "Dlacpy", Param: "b", Message: shortB})
 	}
 
 	lapacke.Dlacpy(byte(uplo), m, n, a, lda, b, ldb)
@@ -254,11 +292,11 @@ func (impl Implementation) Dlacpy(uplo blas.Uplo, m, n int, a []float64, lda int
 func (impl Implementation) Dlapmt(forward bool, m, n int, x []float64, ldx int, k []int) {
 	switch {
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dlapmt", Param: "m", Message: mLT0})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dlapmt", Param: "n", Message: nLT0})
 	case ldx < max(1, n):
-		panic(badLdX)
+		panic(Error{Routine: "Dlapmt", Param: "ldx", Message: badLdX})
 	}
 
 	// Quick return if possible.
@@ -268,9 +306,9 @@ func (impl Implementation) Dlapmt(forward bool, m, n int, x []float64, ldx int,
 
 	switch {
 	case len(x) < (m-1)*ldx+n:
-		panic(shortX)
+		panic(Error{Routine: "Dlapmt", Param: "x", Message: shortX})
 	case len(k) != n:
-		panic(badLenK)
+		panic(Error{Routine: "Dlapmt", Param: "k", Message: badLenK})
 	}
 
 	// Quick return if possible.
@@ -282,17 +320,103 @@ func (impl Implementation) Dlapmt(forward bool, m, n int, x []float64, ldx int,
 	if forward {
 		forwrd = 1
 	}
-	k32 := make([]int32, n)
+	k32 := make([]lapacke.Int, n)
 	for i, v := range k {
 		v++ // Convert to 1-based indexing.
-		if v != int(int32(v)) {
-			panic("lapack: k element out of range")
+		if v != int(lapacke.Int(v)) {
+			panic(Error{Routine: "Dlapmt", Param: "k", Message: "lapack: k element out of range"})
 		}
-		k32[i] = int32(v)
+		k32[i] = lapacke.Int(v)
 	}
 	lapacke.Dlapmt(forwrd, m, n, x, ldx, k32)
 }
 
+// Dlaqge equilibrates the m×n general matrix A using the row scale factors
+// in r and the column scale factors in c computed by Dgeequ. rowcnd and
+// colcnd are the ratios of the smallest to the largest row and column scale
+// factors and amax is the absolute value of the largest element of A, as
+// returned by Dgeequ. A is scaled by rows if rowcnd is less than 0.1 or amax
+// is close to underflow or overflow, and by columns if colcnd is less than
+// 0.1. Dlaqge returns the scaling that was applied:
+//  NoEquilibration    A is unchanged,
+//  RowEquilibration   A is replaced by diag(r)*A,
+//  ColEquilibration   A is replaced by A*diag(c),
+//  BothEquilibration  A is replaced by diag(r)*A*diag(c).
+//
+// r must have length at least m and c at least n.
+//
+// LAPACKE has no interface to dlaqge, so the Fortran routine is called
+// directly. If the library does not provide it, A is scaled in Go.
+func (impl Implementation) Dlaqge(m, n int, a []float64, lda int, r, c []float64, rowcnd, colcnd, amax float64) Equilibration {
+	switch {
+	case m < 0:
+		panic(Error{Routine: "Dlaqge", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Dlaqge", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Dlaqge", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return NoEquilibration
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(Error{Routine: "Dlaqge", Param: "a", Message: shortA})
+	case len(r) < m:
+		panic(Error{Routine: "Dlaqge", Param: "r", Message: shortR})
+	case len(c) < n:
+		panic(Error{Routine: "Dlaqge", Param: "c", Message: shortC})
+	}
+
+	if !lapacke.HasLaq() {
+		return dlaqge(m, n, a, lda, r, c, rowcnd, colcnd, amax)
+	}
+	return Equilibration(lapacke.Dlaqge(m, n, a, lda, r, c, rowcnd, colcnd, amax))
+}
+
+// Dlaqsy equilibrates the n×n symmetric matrix A, of which only the triangle
+// given by uplo is referenced and scaled, using the scale factors in s
+// computed by Dpoequ, so that A is replaced by diag(s)*A*diag(s). scond is
+// the ratio of the smallest to the largest scale factor and amax is the
+// absolute value of the largest element of A, as returned by Dpoequ. A is
+// scaled if scond is less than 0.1 or amax is close to underflow or
+// overflow. Dlaqsy returns whether A was scaled.
+//
+// s must have length at least n.
+//
+// LAPACKE has no interface to dlaqsy, so the Fortran routine is called
+// directly. If the library does not provide it, A is scaled in Go.
+func (impl Implementation) Dlaqsy(uplo blas.Uplo, n int, a []float64, lda int, s []float64, scond, amax float64) (scaled bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Dlaqsy", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Dlaqsy", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Dlaqsy", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return false
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Dlaqsy", Param: "a", Message: shortA})
+	case len(s) < n:
+		panic(Error{Routine: "Dlaqsy", Param: "s", Message: shortS})
+	}
+
+	if !lapacke.HasLaq() {
+		return dlaqsy(uplo, n, a, lda, s, scond, amax)
+	}
+	return lapacke.Dlaqsy(byte(uplo), n, a, lda, s, scond, amax) == 'Y'
+}
+
 // Dlapy2 is the LAPACK version of math.Hypot.
 //
 // Dlapy2 is an internal routine. It is exported for testing purposes.
@@ -352,29 +476,29 @@ func (Implementation) Dlarfb(side blas.Side, trans blas.Transpose, direct lapack
 	}
 	switch {
 	case side != blas.Left && side != blas.Right:
-		panic(badSide)
+		panic(Error{Routine: "Dlarfb", Param: "side", Message: badSide})
 	case trans != blas.Trans && trans != blas.NoTrans:
-		panic(badTrans)
+		panic(Error{Routine: "Dlarfb", Param: "trans", Message: badTrans})
 	case direct != lapack.Forward && direct != lapack.Backward:
-		panic(badDirect)
+		panic(Error{Routine: "Dlarfb", Param: "direct", Message: badDirect})
 	case store != lapack.ColumnWise && store != lapack.RowWise:
-		panic(badStoreV)
+		panic(Error{Routine: "Dlarfb", Param: "store", Message: badStoreV})
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dlarfb", Param: "m", Message: mLT0})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dlarfb", Param: "n", Message: nLT0})
 	case k < 0:
-		panic(kLT0)
+		panic(Error{Routine: "Dlarfb", Param: "k", Message: kLT0})
 	case store == lapack.ColumnWise && ldv < max(1, k):
-		panic(badLdV)
+		panic(Error{Routine: "Dlarfb", Param: "ldv", Message: badLdV})
 	case store == lapack.RowWise && ldv < max(1, nv):
-		panic(badLdV)
+		panic(Error{Routine: "Dlarfb", Param: "ldv", Message: badLdV})
 	case ldt < max(1, k):
-		panic(badLdT)
+		panic(Error{Routine: "Dlarfb", Param: "ldt", Message: badLdT})
 	case ldc < max(1, n):
-		panic(badLdC)
+		panic(Error{Routine: "Dlarfb", Param: "ldc", Message: badLdC})
 	case ldwork < max(1, k):
-		panic(badLdWork)
+		panic(Error{Routine: "Dlarfb", Param: "ldwork", Message: badLdWork})
 	}
 
 	if m == 0 || n == 0 {
@@ -387,15 +511,15 @@ func (Implementation) Dlarfb(side blas.Side, trans blas.Transpose, direct lapack
 	}
 	switch {
 	case store == lapack.ColumnWise && len(v) < (nv-1)*ldv+k:
-		panic(shortV)
+		panic(Error{Routine: "Dlarfb", Param: "v", Message: shortV})
 	case store == lapack.RowWise && len(v) < (k-1)*ldv+nv:
-		panic(shortV)
+		panic(Error{Routine: "Dlarfb", Param: "v", Message: shortV})
 	case len(t) < (k-1)*ldt+k:
-		panic(shortT)
+		panic(Error{Routine: "Dlarfb", Param: "t", Message: shortT})
 	case len(c) < (m-1)*ldc+n:
-		panic(shortC)
+		panic(Error{Routine: "Dlarfb", Param: "c", Message: shortC})
 	case len(work) < (nw-1)*ldwork+k:
-		panic(shortWork)
+		panic(Error{Routine: "Dlarfb", Param: "work", Message: shortWork})
 	}
 
 	// TODO(vladimir-ch): Replace the following two lines with
@@ -424,9 +548,9 @@ func (Implementation) Dlarfb(side blas.Side, trans blas.Transpose, direct lapack
 func (impl Implementation) Dlarfg(n int, alpha float64, x []float64, incX int) (beta, tau float64) {
 	switch {
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dlarfg", Param: "n", Message: nLT0})
 	case incX <= 0:
-		panic(badIncX)
+		panic(Error{Routine: "Dlarfg", Param: "incX", Message: badIncX})
 	}
 
 	if n <= 1 {
@@ -438,7 +562,7 @@ func (impl Implementation) Dlarfg(n int, alpha float64, x []float64, incX int) (
 		aincX = -aincX
 	}
 	if len(x) < 1+(n-2)*aincX {
-		panic(shortX)
+		panic(Error{Routine: "Dlarfg", Param: "x", Message: shortX})
 	}
 
 	_alpha := []float64{alpha}
@@ -472,19 +596,19 @@ func (Implementation) Dlarft(direct lapack.Direct, store lapack.StoreV, n, k int
 	}
 	switch {
 	case direct != lapack.Forward && direct != lapack.Backward:
-		panic(badDirect)
+		panic(Error{Routine: "Dlarft", Param: "direct", Message: badDirect})
 	case store != lapack.RowWise && store != lapack.ColumnWise:
-		panic(badStoreV)
+		panic(Error{Routine: "Dlarft", Param: "store", Message: badStoreV})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dlarft", Param: "n", Message: nLT0})
 	case k < 1:
-		panic(kLT1)
+		panic(Error{Routine: "Dlarft", Param: "k", Message: kLT1})
 	case ldv < max(1, nv):
-		panic(badLdV)
+		panic(Error{Routine: "Dlarft", Param: "ldv", Message: badLdV})
 	case len(tau) < k:
-		panic(shortTau)
+		panic(Error{Routine: "Dlarft", Param: "tau", Message: shortTau})
 	case ldt < max(1, k):
-		panic(shortT)
+		panic(Error{Routine: "Dlarft", Param: "ldt", Message: shortT})
 	}
 
 	if n == 0 {
@@ -493,14 +617,59 @@ func (Implementation) Dlarft(direct lapack.Direct, store lapack.StoreV, n, k int
 
 	switch {
 	case len(v) < (mv-1)*ldv+nv:
-		panic(shortV)
+		panic(Error{Routine: "Dlarft", Param: "v", Message: shortV})
 	case len(t) < (k-1)*ldt+k:
-		panic(shortT)
+		panic(Error{Routine: "Dlarft", Param: "t", Message: shortT})
 	}
 
 	lapacke.Dlarft(byte(direct), byte(store), n, k, v, ldv, tau, t, ldt)
 }
 
+// Dlangb returns the given norm of an n×n general band matrix A with kl
+// sub-diagonals and ku super-diagonals.
+//
+// The band storage scheme is the same as for blas64.Band and is illustrated
+// below when n = 5, kl = 1 and ku = 2. Elements marked * are not used by the
+// function.
+//   *   a00  a01  a02
+//  a10  a11  a12  a13
+//  a21  a22  a23  a24
+//  a32  a33  a34   *
+//  a43  a44   *    *
+//
+// When norm is lapack.MaxColumnSum or lapack.MaxRowSum, the length of work
+// must be at least n.
+func (impl Implementation) Dlangb(norm lapack.MatrixNorm, n, kl, ku int, ab []float64, ldab int, work []float64) float64 {
+	switch {
+	case norm != lapack.MaxAbs && norm != lapack.MaxRowSum && norm != lapack.MaxColumnSum && norm != lapack.Frobenius:
+		panic(Error{Routine: "Dlangb", Param: "norm", Message: badNorm})
+	case n < 0:
+		panic(Error{Routine: "Dlangb", Param: "n", Message: nLT0})
+	case kl < 0:
+		panic(Error{Routine: "Dlangb", Param: "kl", Message: klLT0})
+	case ku < 0:
+		panic(Error{Routine: "Dlangb", Param: "ku", Message: kuLT0})
+	case ldab < kl+ku+1:
+		panic(Error{Routine: "Dlangb", Param: "ldab", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+kl+1:
+		panic(Error{Routine: "Dlangb", Param: "ab", Message: shortAB})
+	case (norm == lapack.MaxColumnSum || norm == lapack.MaxRowSum) && len(work) < n:
+		panic(Error{Routine: "Dlangb", Param: "work", Message: shortWork})
+	}
+
+	// Unlike the other band routines, LAPACKE_dlangb takes row-major band
+	// matrices in the CBLAS layout, so no conversion is needed.
+	return lapacke.Dlangb(byte(norm), n, kl, ku, ab, ldab, work)
+}
+
 // Dlange computes the matrix norm of the general m×n matrix a. The input norm
 // specifies the norm computed.
 //  lapack.MaxAbs: the maximum absolute value of an element.
@@ -512,9 +681,9 @@ func (Implementation) Dlarft(direct lapack.Direct, store lapack.StoreV, n, k int
 func (impl Implementation) Dlange(norm lapack.MatrixNorm, m, n int, a []float64, lda int, work []float64) float64 {
 	switch {
 	case norm != lapack.MaxRowSum && norm != lapack.MaxColumnSum && norm != lapack.Frobenius && norm != lapack.MaxAbs:
-		panic(badNorm)
+		panic(Error{Routine: "Dlange", Param: "norm", Message: badNorm})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dlange", Param: "lda", Message: badLdA})
 	}
 
 	// Quick return if possible.
@@ -524,27 +693,84 @@ func (impl Implementation) Dlange(norm lapack.MatrixNorm, m, n int, a []float64,
 
 	switch {
 	case len(a) < (m-1)*lda+n:
-		panic(badLdA)
+		panic(Error{Routine: "Dlange", Param: "a", Message: shortA})
 	case norm == lapack.MaxColumnSum && len(work) < n:
-		panic(shortWork)
+		panic(Error{Routine: "Dlange", Param: "work", Message: shortWork})
 	}
 
 	return lapacke.Dlange(byte(norm), m, n, a, lda, work)
 }
 
+// Dlansb returns the given norm of an n×n symmetric band matrix with kd
+// super-diagonals. The band storage scheme is the same as for Dpbtrf.
+//
+// LAPACKE has no interface to Dlansb, so the band of A is expanded to a
+// general band matrix, which requires (2*kd+1)*n additional memory, and its
+// norm is computed by Dlangb.
+//
+// When norm is lapack.MaxColumnSum or lapack.MaxRowSum, the length of work
+// must be at least n.
+func (impl Implementation) Dlansb(norm lapack.MatrixNorm, uplo blas.Uplo, n, kd int, ab []float64, ldab int, work []float64) float64 {
+	switch {
+	case norm != lapack.MaxAbs && norm != lapack.MaxRowSum && norm != lapack.MaxColumnSum && norm != lapack.Frobenius:
+		panic(Error{Routine: "Dlansb", Param: "norm", Message: badNorm})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Dlansb", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Dlansb", Param: "n", Message: nLT0})
+	case kd < 0:
+		panic(Error{Routine: "Dlansb", Param: "kd", Message: kdLT0})
+	case ldab < kd+1:
+		panic(Error{Routine: "Dlansb", Param: "ldab", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+kd+1:
+		panic(Error{Routine: "Dlansb", Param: "ab", Message: shortAB})
+	case (norm == lapack.MaxColumnSum || norm == lapack.MaxRowSum) && len(work) < n:
+		panic(Error{Routine: "Dlansb", Param: "work", Message: shortWork})
+	}
+
+	ldg := 2*kd + 1
+	g := make([]float64, n*ldg)
+	for i := 0; i < n; i++ {
+		if uplo == blas.Upper {
+			for jb := 0; jb < min(n-i, kd+1); jb++ {
+				v := ab[i*ldab+jb]
+				j := i + jb
+				g[i*ldg+kd+jb] = v
+				g[j*ldg+kd-jb] = v
+			}
+		} else {
+			for jb := max(0, kd-i); jb < kd+1; jb++ {
+				v := ab[i*ldab+jb]
+				j := i - kd + jb
+				g[i*ldg+jb] = v
+				g[j*ldg+2*kd-jb] = v
+			}
+		}
+	}
+	return lapacke.Dlangb(byte(norm), n, kd, kd, g, ldg, work)
+}
+
 // Dlansy computes the specified norm of an n×n symmetric matrix. If
 // norm == lapack.MaxColumnSum or norm == lapackMaxRowSum work must have length
 // at least n, otherwise work is unused.
 func (impl Implementation) Dlansy(norm lapack.MatrixNorm, uplo blas.Uplo, n int, a []float64, lda int, work []float64) float64 {
 	switch {
 	case norm != lapack.MaxRowSum && norm != lapack.MaxColumnSum && norm != lapack.Frobenius && norm != lapack.MaxAbs:
-		panic(badNorm)
+		panic(Error{Routine: "Dlansy", Param: "norm", Message: badNorm})
 	case uplo != blas.Upper && uplo != blas.Lower:
-		panic(badUplo)
+		panic(Error{Routine: "Dlansy", Param: "uplo", Message: badUplo})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dlansy", Param: "n", Message: nLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dlansy", Param: "lda", Message: badLdA})
 	}
 
 	// Quick return if possible.
@@ -554,9 +780,9 @@ func (impl Implementation) Dlansy(norm lapack.MatrixNorm, uplo blas.Uplo, n int,
 
 	switch {
 	case len(a) < (n-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dlansy", Param: "a", Message: shortA})
 	case (norm == lapack.MaxColumnSum || norm == lapack.MaxRowSum) && len(work) < n:
-		panic(shortWork)
+		panic(Error{Routine: "Dlansy", Param: "work", Message: shortWork})
 	}
 
 	return lapacke.Dlansy(byte(norm), byte(uplo), n, a, lda, work)
@@ -568,21 +794,21 @@ func (impl Implementation) Dlansy(norm lapack.MatrixNorm, uplo blas.Uplo, n int,
 func (impl Implementation) Dlantr(norm lapack.MatrixNorm, uplo blas.Uplo, diag blas.Diag, m, n int, a []float64, lda int, work []float64) float64 {
 	switch {
 	case norm != lapack.MaxRowSum && norm != lapack.MaxColumnSum && norm != lapack.Frobenius && norm != lapack.MaxAbs:
-		panic(badNorm)
+		panic(Error{Routine: "Dlantr", Param: "norm", Message: badNorm})
 	case uplo != blas.Upper && uplo != blas.Lower:
-		panic(badUplo)
+		panic(Error{Routine: "Dlantr", Param: "uplo", Message: badUplo})
 	case diag != blas.Unit && diag != blas.NonUnit:
-		panic(badDiag)
+		panic(Error{Routine: "Dlantr", Param: "diag", Message: badDiag})
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dlantr", Param: "m", Message: mLT0})
 	case uplo == blas.Upper && m > n:
-		panic(mGTN)
+		panic(Error{Routine: "Dlantr", Param: "m", Message: mGTN})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dlantr", Param: "n", Message: nLT0})
 	case uplo == blas.Lower && n > m:
-		panic(nGTM)
+		panic(Error{Routine: "Dlantr", Param: "n", Message: nGTM})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dlantr", Param: "lda", Message: badLdA})
 	}
 
 	// Quick return if possible.
@@ -593,9 +819,9 @@ func (impl Implementation) Dlantr(norm lapack.MatrixNorm, uplo blas.Uplo, diag b
 
 	switch {
 	case len(a) < (m-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dlantr", Param: "a", Message: shortA})
 	case norm == lapack.MaxColumnSum && len(work) < n:
-		panic(shortWork)
+		panic(Error{Routine: "Dlantr", Param: "work", Message: shortWork})
 	}
 
 	if norm == lapack.MaxRowSum && len(work) < m {
@@ -627,13 +853,13 @@ func (impl Implementation) Dlantr(norm lapack.MatrixNorm, uplo blas.Uplo, diag b
 func (impl Implementation) Dlarfx(side blas.Side, m, n int, v []float64, tau float64, c []float64, ldc int, work []float64) {
 	switch {
 	case side != blas.Left && side != blas.Right:
-		panic(badSide)
+		panic(Error{Routine: "Dlarfx", Param: "side", Message: badSide})
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dlarfx", Param: "m", Message: mLT0})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dlarfx", Param: "n", Message: nLT0})
 	case ldc < max(1, n):
-		panic(badLdC)
+		panic(Error{Routine: "Dlarfx", Param: "ldc", Message: badLdC})
 	}
 
 	// Quick return if possible.
@@ -649,11 +875,11 @@ func (impl Implementation) Dlarfx(side blas.Side, m, n int, v []float64, tau flo
 	}
 	switch {
 	case len(v) < nh:
-		panic(shortV)
+		panic(Error{Routine: "Dlarfx", Param: "v", Message: shortV})
 	case len(c) < (m-1)*ldc+n:
-		panic(shortC)
+		panic(Error{Routine: "Dlarfx", Param: "c", Message: shortC})
 	case nh > 10 && len(work) < lwork:
-		panic(shortWork)
+		panic(Error{Routine: "Dlarfx", Param: "work", Message: shortWork})
 	}
 
 	lapacke.Dlarfx(byte(side), m, n, v, tau, c, ldc, work)
@@ -668,24 +894,24 @@ func (impl Implementation) Dlarfx(side blas.Side, m, n int, v []float64, tau flo
 func (impl Implementation) Dlascl(kind lapack.MatrixType, kl, ku int, cfrom, cto float64, m, n int, a []float64, lda int) {
 	switch kind {
 	default:
-		panic(badMatrixType)
+		panic(Error{Routine: "Dlascl", Param: "kind", Message: badMatrixType})
 	case 'H', 'B', 'Q', 'Z': // See dlascl.f.
 	case lapack.General, lapack.UpperTri, lapack.LowerTri:
 		if lda < max(1, n) {
-			panic(badLdA)
+			panic(Error{Routine: "Dlascl", Param: "lda", Message: badLdA})
 		}
 	}
 	switch {
 	case cfrom == 0:
-		panic(zeroCFrom)
+		panic(Error{Routine: "Dlascl", Param: "cfrom", Message: zeroCFrom})
 	case math.IsNaN(cfrom):
-		panic(nanCFrom)
+		panic(Error{Routine: "Dlascl", Param: "cfrom", Message: nanCFrom})
 	case math.IsNaN(cto):
-		panic(nanCTo)
+		panic(Error{Routine: "Dlascl", Param: "cto", Message: nanCTo})
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dlascl", Param: "m", Message: mLT0})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dlascl", Param: "n", Message: nLT0})
 	}
 
 	if n == 0 || m == 0 {
@@ -695,7 +921,7 @@ func (impl Implementation) Dlascl(kind lapack.MatrixType, kl, ku int, cfrom, cto
 	switch kind {
 	case lapack.General, lapack.UpperTri, lapack.LowerTri:
 		if len(a) < (m-1)*lda+n {
-			panic(shortA)
+			panic(Error{Routine: "Dlascl", Param: "a", Message: shortA})
 		}
 	}
 
@@ -712,11 +938,11 @@ func (impl Implementation) Dlascl(kind lapack.MatrixType, kl, ku int, cfrom, cto
 func (impl Implementation) Dlaset(uplo blas.Uplo, m, n int, alpha, beta float64, a []float64, lda int) {
 	switch {
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dlaset", Param: "m", Message: mLT0})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dlaset", Param: "n", Message: nLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dlaset", Param: "lda", Message: badLdA})
 	}
 
 	minmn := min(m, n)
@@ -725,7 +951,7 @@ func (impl Implementation) Dlaset(uplo blas.Uplo, m, n int, alpha, beta float64,
 	}
 
 	if len(a) < (m-1)*lda+n {
-		panic(shortA)
+		panic(Error{Routine: "Dlaset", Param: "a", Message: shortA})
 	}
 
 	lapacke.Dlaset(byte(uplo), m, n, alpha, beta, a, lda)
@@ -740,11 +966,11 @@ func (impl Implementation) Dlaset(uplo blas.Uplo, m, n int, alpha, beta float64,
 func (impl Implementation) Dlasrt(s lapack.Sort, n int, d []float64) {
 	switch {
 	case s != lapack.SortIncreasing && s != lapack.SortDecreasing:
-		panic(badSort)
+		panic(Error{Routine: "Dlasrt", Param: "s", Message: badSort})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dlasrt", Param: "n", Message: nLT0})
 	case len(d) < n:
-		panic(shortD)
+		panic(Error{Routine: "Dlasrt", Param: "d", Message: shortD})
 	}
 
 	lapacke.Dlasrt(byte(s), n, d[:n])
@@ -764,36 +990,94 @@ func (impl Implementation) Dlasrt(s lapack.Sort, n int, d []float64) {
 func (impl Implementation) Dlaswp(n int, a []float64, lda, k1, k2 int, ipiv []int, incX int) {
 	switch {
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dlaswp", Param: "n", Message: nLT0})
 	case k2 < 0:
-		panic(badK2)
+		panic(Error{Routine: "Dlaswp", Param: "k2", Message: badK2})
 	case k1 < 0 || k2 < k1:
-		panic(badK1)
+		panic(Error{Routine: "Dlaswp", Param: "k1", Message: badK1})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dlaswp", Param: "lda", Message: badLdA})
 	case len(a) < (k2-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dlaswp", Param: "a", Message: shortA})
 	case len(ipiv) != k2+1:
-		panic(badLenIpiv)
+		panic(Error{Routine: "Dlaswp", Param: "ipiv", Message: badLenIpiv})
 	case incX != 1 && incX != -1:
-		panic(absIncNotOne)
+		panic(Error{Routine: "Dlaswp", Param: "incX", Message: absIncNotOne})
 	}
 
 	if n == 0 {
 		return
 	}
 
-	ipiv32 := make([]int32, k2+1)
+	ipiv32 := make([]lapacke.Int, k2+1)
 	for i, v := range ipiv {
 		v++
-		if v != int(int32(v)) {
-			panic("lapack: ipiv element out of range")
+		if v != int(lapacke.Int(v)) {
+			panic(Error{Routine: "Dlaswp", Param: "ipiv", Message: "lapack: ipiv element out of range"})
 		}
-		ipiv32[i] = int32(v)
+		ipiv32[i] = lapacke.Int(v)
 	}
 	lapacke.Dlaswp(n, a, lda, k1+1, k2+1, ipiv32, incX)
 }
 
+// Dlatrs solves a triangular system of equations scaled to prevent overflow. It
+// solves
+//  A * x = scale * b if trans == blas.NoTrans
+//  Aᵀ * x = scale * b if trans == blas.Trans
+// where the scale s is set for numeric stability.
+//
+// A is an n×n triangular matrix. On entry, the slice x contains the values of
+// b, and on exit it contains the solution vector x.
+//
+// If normin == true, cnorm is an input and cnorm[j] contains the norm of the off-diagonal
+// part of the j^th column of A. If trans == blas.NoTrans, cnorm[j] must be greater
+// than or equal to the infinity norm, and greater than or equal to the one-norm
+// otherwise. If normin == false, then cnorm is treated as an output, and is set
+// to contain the 1-norm of the off-diagonal part of the j^th column of A.
+//
+// LAPACKE has no interface to dlatrs, so the Fortran routine is called
+// directly. If the library does not provide it, Dlatrs uses the
+// implementation in gonum/lapack/gonum.
+func (impl Implementation) Dlatrs(uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, normin bool, n int, a []float64, lda int, x []float64, cnorm []float64) (scale float64) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Dlatrs", Param: "uplo", Message: badUplo})
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(Error{Routine: "Dlatrs", Param: "trans", Message: badTrans})
+	case diag != blas.Unit && diag != blas.NonUnit:
+		panic(Error{Routine: "Dlatrs", Param: "diag", Message: badDiag})
+	case n < 0:
+		panic(Error{Routine: "Dlatrs", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Dlatrs", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 1
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Dlatrs", Param: "a", Message: shortA})
+	case len(x) < n:
+		panic(Error{Routine: "Dlatrs", Param: "x", Message: shortX})
+	case len(cnorm) < n:
+		panic(Error{Routine: "Dlatrs", Param: "cnorm", Message: shortCNorm})
+	}
+
+	if !lapacke.HasLatrs() {
+		return gonum.Implementation{}.Dlatrs(uplo, trans, diag, normin, n, a, lda, x, cnorm)
+	}
+	norm := byte('N')
+	if normin {
+		norm = 'Y'
+	}
+	s := []float64{0}
+	lapacke.Dlatrs(byte(uplo), byte(trans), byte(diag), norm, n, a, lda, x, s, cnorm)
+	return s[0]
+}
+
 // Dpbcon returns an estimate of the reciprocal of the condition number (in the
 // 1-norm) of an n×n symmetric positive definite band matrix using the Cholesky
 // factorization
@@ -808,15 +1092,15 @@ func (impl Implementation) Dlaswp(n int, a []float64, lda, k1, k2 int, ipiv []in
 func (impl Implementation) Dpbcon(uplo blas.Uplo, n, kd int, ab []float64, ldab int, anorm float64, work []float64, iwork []int) (rcond float64) {
 	switch {
 	case uplo != blas.Upper && uplo != blas.Lower:
-		panic(badUplo)
+		panic(Error{Routine: "Dpbcon", Param: "uplo", Message: badUplo})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dpbcon", Param: "n", Message: nLT0})
 	case kd < 0:
-		panic(kdLT0)
+		panic(Error{Routine: "Dpbcon", Param: "kd", Message: kdLT0})
 	case ldab < kd+1:
-		panic(badLdA)
+		panic(Error{Routine: "Dpbcon", Param: "ldab", Message: badLdA})
 	case anorm < 0:
-		panic(badNorm)
+		panic(Error{Routine: "Dpbcon", Param: "anorm", Message: badNorm})
 	}
 
 	// Quick return if possible.
@@ -826,22 +1110,73 @@ func (impl Implementation) Dpbcon(uplo blas.Uplo, n, kd int, ab []float64, ldab
 
 	switch {
 	case len(ab) < (n-1)*ldab+kd+1:
-		panic(shortAB)
+		panic(Error{Routine: "Dpbcon", Param: "ab", Message: shortAB})
 	case len(work) < 3*n:
-		panic(shortWork)
+		panic(Error{Routine: "Dpbcon", Param: "work", Message: shortWork})
 	case len(iwork) < n:
-		panic(shortIWork)
+		panic(Error{Routine: "Dpbcon", Param: "iwork", Message: shortIWork})
 	}
 
 	_ldab := n
 	_ab := make([]float64, (kd+1)*_ldab)
 	bandTriToLapacke(uplo, n, kd, ab, ldab, _ab, _ldab)
 	_rcond := []float64{0}
-	_iwork := make([]int32, n)
+	_iwork := make([]lapacke.Int, n)
 	lapacke.Dpbcon(byte(uplo), n, kd, _ab, _ldab, anorm, _rcond, work, _iwork)
 	return _rcond[0]
 }
 
+// Dpbsv computes the solution to the system of linear equations
+//  A * X = B,
+// where A is an n×n symmetric positive definite band matrix with kd super-
+// or sub-diagonals, and X and B are n×nrhs matrices. The Cholesky
+// factorization
+//  A = U^T * U  if uplo == blas.Upper
+//  A = L * L^T  if uplo == blas.Lower
+// is computed by Dpbtrf and used to solve the system by Dpbtrs.
+//
+// On entry, ab holds the upper or lower triangle of A in the band storage
+// scheme described for Dpbtrf, and on return it holds the factor U or L.
+//
+// On entry, b holds the n×nrhs right hand side matrix B, and on return it is
+// overwritten by the solution X. If A is not positive definite, Dpbsv
+// returns false and the solution is not computed.
+func (impl Implementation) Dpbsv(uplo blas.Uplo, n, kd, nrhs int, ab []float64, ldab int, b []float64, ldb int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Dpbsv", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Dpbsv", Param: "n", Message: nLT0})
+	case kd < 0:
+		panic(Error{Routine: "Dpbsv", Param: "kd", Message: kdLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Dpbsv", Param: "nrhs", Message: nrhsLT0})
+	case ldab < kd+1:
+		panic(Error{Routine: "Dpbsv", Param: "ldab", Message: badLdA})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Dpbsv", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+kd+1:
+		panic(Error{Routine: "Dpbsv", Param: "ab", Message: shortAB})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Dpbsv", Param: "b", Message: shortB})
+	}
+
+	ldabConv := n
+	abConv := make([]float64, (kd+1)*ldabConv)
+	bandTriToLapacke(uplo, n, kd, ab, ldab, abConv, ldabConv)
+	ok = lapacke.Dpbsv(byte(uplo), n, kd, nrhs, abConv, ldabConv, b, ldb)
+	bandTriToGonum(uplo, n, kd, abConv, ldabConv, ab, ldab)
+	return ok
+}
+
 // Dpbtrf computes the Cholesky factorization of an n×n symmetric positive
 // definite band matrix
 //  A = U^T * U  if uplo == blas.Upper
@@ -872,13 +1207,13 @@ func (impl Implementation) Dpbcon(uplo blas.Uplo, n, kd int, ab []float64, ldab
 func (impl Implementation) Dpbtrf(uplo blas.Uplo, n, kd int, ab []float64, ldab int) (ok bool) {
 	switch {
 	case uplo != blas.Upper && uplo != blas.Lower:
-		panic(badUplo)
+		panic(Error{Routine: "Dpbtrf", Param: "uplo", Message: badUplo})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dpbtrf", Param: "n", Message: nLT0})
 	case kd < 0:
-		panic(kdLT0)
+		panic(Error{Routine: "Dpbtrf", Param: "kd", Message: kdLT0})
 	case ldab < kd+1:
-		panic(badLdA)
+		panic(Error{Routine: "Dpbtrf", Param: "ldab", Message: badLdA})
 	}
 
 	// Quick return if possible.
@@ -887,7 +1222,7 @@ func (impl Implementation) Dpbtrf(uplo blas.Uplo, n, kd int, ab []float64, ldab
 	}
 
 	if len(ab) < (n-1)*ldab+kd+1 {
-		panic(shortAB)
+		panic(Error{Routine: "Dpbtrf", Param: "ab", Message: shortAB})
 	}
 
 	ldabConv := n
@@ -910,17 +1245,17 @@ func (impl Implementation) Dpbtrf(uplo blas.Uplo, n, kd int, ab []float64, ldab
 func (Implementation) Dpbtrs(uplo blas.Uplo, n, kd, nrhs int, ab []float64, ldab int, b []float64, ldb int) {
 	switch {
 	case uplo != blas.Upper && uplo != blas.Lower:
-		panic(badUplo)
+		panic(Error{Routine: "Dpbtrs", Param: "uplo", Message: badUplo})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dpbtrs", Param: "n", Message: nLT0})
 	case kd < 0:
-		panic(kdLT0)
+		panic(Error{Routine: "Dpbtrs", Param: "kd", Message: kdLT0})
 	case nrhs < 0:
-		panic(nrhsLT0)
+		panic(Error{Routine: "Dpbtrs", Param: "nrhs", Message: nrhsLT0})
 	case ldab < kd+1:
-		panic(badLdA)
+		panic(Error{Routine: "Dpbtrs", Param: "ldab", Message: badLdA})
 	case ldb < max(1, nrhs):
-		panic(badLdB)
+		panic(Error{Routine: "Dpbtrs", Param: "ldb", Message: badLdB})
 	}
 
 	// Quick return if possible.
@@ -929,10 +1264,10 @@ func (Implementation) Dpbtrs(uplo blas.Uplo, n, kd, nrhs int, ab []float64, ldab
 	}
 
 	if len(ab) < (n-1)*ldab+kd {
-		panic(shortAB)
+		panic(Error{Routine: "Dpbtrs", Param: "ab", Message: shortAB})
 	}
 	if len(b) < (n-1)*ldb+nrhs {
-		panic(shortB)
+		panic(Error{Routine: "Dpbtrs", Param: "b", Message: shortB})
 	}
 
 	ldabConv := n
@@ -949,11 +1284,11 @@ func (Implementation) Dpbtrs(uplo blas.Uplo, n, kd, nrhs int, ab []float64, ldab
 func (impl Implementation) Dpotrf(ul blas.Uplo, n int, a []float64, lda int) (ok bool) {
 	switch {
 	case ul != blas.Upper && ul != blas.Lower:
-		panic(badUplo)
+		panic(Error{Routine: "Dpotrf", Param: "ul", Message: badUplo})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dpotrf", Param: "n", Message: nLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dpotrf", Param: "lda", Message: badLdA})
 	}
 
 	// Quick return if possible.
@@ -962,7 +1297,7 @@ func (impl Implementation) Dpotrf(ul blas.Uplo, n int, a []float64, lda int) (ok
 	}
 
 	if len(a) < (n-1)*lda+n {
-		panic(shortA)
+		panic(Error{Routine: "Dpotrf", Param: "a", Message: shortA})
 	}
 
 	return lapacke.Dpotrf(byte(ul), n, a, lda)
@@ -978,11 +1313,11 @@ func (impl Implementation) Dpotrf(ul blas.Uplo, n int, a []float64, lda int) (ok
 func (impl Implementation) Dpotri(uplo blas.Uplo, n int, a []float64, lda int) (ok bool) {
 	switch {
 	case uplo != blas.Upper && uplo != blas.Lower:
-		panic(badUplo)
+		panic(Error{Routine: "Dpotri", Param: "uplo", Message: badUplo})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dpotri", Param: "n", Message: nLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dpotri", Param: "lda", Message: badLdA})
 	}
 
 	// Quick return if possible.
@@ -991,7 +1326,7 @@ func (impl Implementation) Dpotri(uplo blas.Uplo, n int, a []float64, lda int) (
 	}
 
 	if len(a) < (n-1)*lda+n {
-		panic(shortA)
+		panic(Error{Routine: "Dpotri", Param: "a", Message: shortA})
 	}
 
 	return lapacke.Dpotri(byte(uplo), n, a, lda)
@@ -1007,15 +1342,15 @@ func (impl Implementation) Dpotri(uplo blas.Uplo, n int, a []float64, lda int) (
 func (Implementation) Dpotrs(uplo blas.Uplo, n, nrhs int, a []float64, lda int, b []float64, ldb int) {
 	switch {
 	case uplo != blas.Upper && uplo != blas.Lower:
-		panic(badUplo)
+		panic(Error{Routine: "Dpotrs", Param: "uplo", Message: badUplo})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dpotrs", Param: "n", Message: nLT0})
 	case nrhs < 0:
-		panic(nrhsLT0)
+		panic(Error{Routine: "Dpotrs", Param: "nrhs", Message: nrhsLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dpotrs", Param: "lda", Message: badLdA})
 	case ldb < max(1, nrhs):
-		panic(badLdB)
+		panic(Error{Routine: "Dpotrs", Param: "ldb", Message: badLdB})
 	}
 
 	// Quick return if possible.
@@ -1025,9 +1360,9 @@ func (Implementation) Dpotrs(uplo blas.Uplo, n, nrhs int, a []float64, lda int,
 
 	switch {
 	case len(a) < (n-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dpotrs", Param: "a", Message: shortA})
 	case len(b) < (n-1)*ldb+nrhs:
-		panic(shortB)
+		panic(Error{Routine: "Dpotrs", Param: "b", Message: shortB})
 	}
 
 	lapacke.Dpotrs(byte(uplo), n, nrhs, a, lda, b, ldb)
@@ -1079,11 +1414,11 @@ func (Implementation) Dpotrs(uplo blas.Uplo, n, nrhs int, a []float64, lda int,
 func (impl Implementation) Dgebal(job lapack.BalanceJob, n int, a []float64, lda int, scale []float64) (ilo, ihi int) {
 	switch {
 	case job != lapack.BalanceNone && job != lapack.Permute && job != lapack.Scale && job != lapack.PermuteScale:
-		panic(badBalanceJob)
+		panic(Error{Routine: "Dgebal", Param: "job", Message: badBalanceJob})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dgebal", Param: "n", Message: nLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dgebal", Param: "lda", Message: badLdA})
 	}
 
 	ilo = 0
@@ -1095,13 +1430,13 @@ func (impl Implementation) Dgebal(job lapack.BalanceJob, n int, a []float64, lda
 
 	switch {
 	case len(scale) != n:
-		panic(shortScale)
+		panic(Error{Routine: "Dgebal", Param: "scale", Message: shortScale})
 	case len(a) < (n-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dgebal", Param: "a", Message: shortA})
 	}
 
-	ilo32 := []int32{0}
-	ihi32 := []int32{0}
+	ilo32 := []lapacke.Int{0}
+	ihi32 := []lapacke.Int{0}
 	lapacke.Dgebal(byte(job), n, a, lda, ilo32, ihi32, scale)
 	ilo = int(ilo32[0]) - 1
 	ihi = int(ihi32[0]) - 1
@@ -1128,19 +1463,19 @@ func (impl Implementation) Dgebal(job lapack.BalanceJob, n int, a []float64, lda
 func (impl Implementation) Dgebak(job lapack.BalanceJob, side lapack.EVSide, n, ilo, ihi int, scale []float64, m int, v []float64, ldv int) {
 	switch {
 	case job != lapack.BalanceNone && job != lapack.Permute && job != lapack.Scale && job != lapack.PermuteScale:
-		panic(badBalanceJob)
+		panic(Error{Routine: "Dgebak", Param: "job", Message: badBalanceJob})
 	case side != lapack.EVLeft && side != lapack.EVRight:
-		panic(badEVSide)
+		panic(Error{Routine: "Dgebak", Param: "side", Message: badEVSide})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dgebak", Param: "n", Message: nLT0})
 	case ilo < 0 || max(0, n-1) < ilo:
-		panic(badIlo)
+		panic(Error{Routine: "Dgebak", Param: "ilo", Message: badIlo})
 	case ihi < min(ilo, n-1) || n <= ihi:
-		panic(badIhi)
+		panic(Error{Routine: "Dgebak", Param: "ihi", Message: badIhi})
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dgebak", Param: "m", Message: mLT0})
 	case ldv < max(1, m):
-		panic(badLdV)
+		panic(Error{Routine: "Dgebak", Param: "ldv", Message: badLdV})
 	}
 
 	// Quick return if possible.
@@ -1150,9 +1485,9 @@ func (impl Implementation) Dgebak(job lapack.BalanceJob, side lapack.EVSide, n,
 
 	switch {
 	case len(scale) < n:
-		panic(shortScale)
+		panic(Error{Routine: "Dgebak", Param: "scale", Message: shortScale})
 	case len(v) < (n-1)*ldv+m:
-		panic(shortV)
+		panic(Error{Routine: "Dgebak", Param: "v", Message: shortV})
 	}
 
 	// Quick return if possible.
@@ -1177,6 +1512,150 @@ func (impl Implementation) Dgebak(job lapack.BalanceJob, side lapack.EVSide, n,
 	}
 }
 
+// Dggbal balances the pair of n×n matrices (A,B) of a generalized eigenvalue
+// problem
+//  A*x = λ*B*x
+// by permuting the rows and columns of A and B to isolate eigenvalues and by
+// applying diagonal equivalence transformations to make the rows and columns
+// of A and B as close in norm as possible. The balanced pair is
+//  A' = D_l * P_l * A * P_r * D_r,
+//  B' = D_l * P_l * B * P_r * D_r,
+// and the eigenvalues of (A',B') are those of (A,B). Balancing may improve the
+// accuracy of the eigenvalues and eigenvectors computed by the QZ algorithm.
+//
+// job specifies the operations that will be performed as for Dgebal. On
+// return, A[i,j] and B[i,j] are zero for i > j and j ∈ {0, ..., ilo-1,
+// ihi+1, ..., n-1}. If job is lapack.BalanceNone or lapack.Scale, or if
+// n == 0, ilo == 0 and ihi == n-1.
+//
+// On return, lscale and rscale describe the left and right transformations:
+// if π(j) denotes the index of the row or column interchanged with row or
+// column j and D[j,j] the scaling factor applied to it, then
+//  scale[j] == π(j),     for j ∈ {0, ..., ilo-1, ihi+1, ..., n-1},
+//           == D[j,j],   for j ∈ {ilo, ..., ihi}.
+// lscale and rscale must have length n, and work must have length at least
+// 6*n if job is lapack.Scale or lapack.PermuteScale, otherwise Dggbal will
+// panic. work is not referenced for other values of job.
+//
+// The eigenvectors of (A',B') are transformed to those of (A,B) by Dggbak.
+func (impl Implementation) Dggbal(job lapack.BalanceJob, n int, a []float64, lda int, b []float64, ldb int, lscale, rscale, work []float64) (ilo, ihi int) {
+	switch {
+	case job != lapack.BalanceNone && job != lapack.Permute && job != lapack.Scale && job != lapack.PermuteScale:
+		panic(Error{Routine: "Dggbal", Param: "job", Message: badBalanceJob})
+	case n < 0:
+		panic(Error{Routine: "Dggbal", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Dggbal", Param: "lda", Message: badLdA})
+	case ldb < max(1, n):
+		panic(Error{Routine: "Dggbal", Param: "ldb", Message: badLdB})
+	}
+
+	ilo = 0
+	ihi = n - 1
+
+	if n == 0 {
+		return ilo, ihi
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Dggbal", Param: "a", Message: shortA})
+	case len(b) < (n-1)*ldb+n:
+		panic(Error{Routine: "Dggbal", Param: "b", Message: shortB})
+	case len(lscale) != n:
+		panic(Error{Routine: "Dggbal", Param: "lscale", Message: shortScale})
+	case len(rscale) != n:
+		panic(Error{Routine: "Dggbal", Param: "rscale", Message: shortScale})
+	case (job == lapack.Scale || job == lapack.PermuteScale) && len(work) < 6*n:
+		panic(Error{Routine: "Dggbal", Param: "work", Message: shortWork})
+	}
+
+	if len(work) == 0 {
+		work = make([]float64, 1)
+	}
+	ilo32 := []lapacke.Int{0}
+	ihi32 := []lapacke.Int{0}
+	lapacke.Dggbal(byte(job), n, a, lda, b, ldb, ilo32, ihi32, lscale, rscale, work)
+	ilo = int(ilo32[0]) - 1
+	ihi = int(ihi32[0]) - 1
+	for j := 0; j < ilo; j++ {
+		lscale[j]--
+		rscale[j]--
+	}
+	for j := ihi + 1; j < n; j++ {
+		lscale[j]--
+		rscale[j]--
+	}
+	return ilo, ihi
+}
+
+// Dggbak transforms an n×m matrix V of eigenvectors of the generalized
+// eigenvalue problem balanced by Dggbal to those of the original problem,
+// as
+//  V = P_r * D_r * V,   if side == lapack.EVRight,
+//  V = P_l * D_l * V,   if side == lapack.EVLeft,
+// where the permutations P and scalings D are represented by job, ilo, ihi
+// and lscale and rscale as returned by Dggbal. Only the scale for the
+// requested side is referenced, but both must have length at least n,
+// otherwise Dggbak will panic.
+func (impl Implementation) Dggbak(job lapack.BalanceJob, side lapack.EVSide, n, ilo, ihi int, lscale, rscale []float64, m int, v []float64, ldv int) {
+	switch {
+	case job != lapack.BalanceNone && job != lapack.Permute && job != lapack.Scale && job != lapack.PermuteScale:
+		panic(Error{Routine: "Dggbak", Param: "job", Message: badBalanceJob})
+	case side != lapack.EVLeft && side != lapack.EVRight:
+		panic(Error{Routine: "Dggbak", Param: "side", Message: badEVSide})
+	case n < 0:
+		panic(Error{Routine: "Dggbak", Param: "n", Message: nLT0})
+	case ilo < 0 || max(0, n-1) < ilo:
+		panic(Error{Routine: "Dggbak", Param: "ilo", Message: badIlo})
+	case ihi < min(ilo, n-1) || n <= ihi:
+		panic(Error{Routine: "Dggbak", Param: "ihi", Message: badIhi})
+	case m < 0:
+		panic(Error{Routine: "Dggbak", Param: "m", Message: mLT0})
+	case ldv < max(1, m):
+		panic(Error{Routine: "Dggbak", Param: "ldv", Message: badLdV})
+	}
+
+	// Quick return if possible.
+	if n == 0 || m == 0 {
+		return
+	}
+
+	switch {
+	case len(lscale) < n:
+		panic(Error{Routine: "Dggbak", Param: "lscale", Message: shortScale})
+	case len(rscale) < n:
+		panic(Error{Routine: "Dggbak", Param: "rscale", Message: shortScale})
+	case len(v) < (n-1)*ldv+m:
+		panic(Error{Routine: "Dggbak", Param: "v", Message: shortV})
+	}
+
+	// Quick return if possible.
+	if job == lapack.BalanceNone {
+		return
+	}
+
+	// Convert permutation indices to 1-based.
+	for j := 0; j < ilo; j++ {
+		lscale[j]++
+		rscale[j]++
+	}
+	for j := ihi + 1; j < n; j++ {
+		lscale[j]++
+		rscale[j]++
+	}
+	lapacke.Dggbak(byte(job), byte(side), n, ilo+1, ihi+1, lscale, rscale, m, v, ldv)
+	// Convert permutation indices back to 0-based.
+	for j := 0; j < ilo; j++ {
+		lscale[j]--
+		rscale[j]--
+	}
+	for j := ihi + 1; j < n; j++ {
+		lscale[j]--
+		rscale[j]--
+	}
+}
+
 // Dbdsqr performs a singular value decomposition of a real n×n bidiagonal matrix.
 //
 // The SVD of the bidiagonal matrix B is
@@ -1215,21 +1694,21 @@ func (impl Implementation) Dgebak(job lapack.BalanceJob, side lapack.EVSide, n,
 func (impl Implementation) Dbdsqr(uplo blas.Uplo, n, ncvt, nru, ncc int, d, e, vt []float64, ldvt int, u []float64, ldu int, c []float64, ldc int, work []float64) (ok bool) {
 	switch {
 	case uplo != blas.Upper && uplo != blas.Lower:
-		panic(badUplo)
+		panic(Error{Routine: "Dbdsqr", Param: "uplo", Message: badUplo})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dbdsqr", Param: "n", Message: nLT0})
 	case ncvt < 0:
-		panic(ncvtLT0)
+		panic(Error{Routine: "Dbdsqr", Param: "ncvt", Message: ncvtLT0})
 	case nru < 0:
-		panic(nruLT0)
+		panic(Error{Routine: "Dbdsqr", Param: "nru", Message: nruLT0})
 	case ncc < 0:
-		panic(nccLT0)
+		panic(Error{Routine: "Dbdsqr", Param: "ncc", Message: nccLT0})
 	case ldvt < max(1, ncvt):
-		panic(badLdVT)
+		panic(Error{Routine: "Dbdsqr", Param: "ldvt", Message: badLdVT})
 	case (ldu < max(1, n) && nru > 0) || (ldu < 1 && nru == 0):
-		panic(badLdU)
+		panic(Error{Routine: "Dbdsqr", Param: "ldu", Message: badLdU})
 	case ldc < max(1, ncc):
-		panic(badLdC)
+		panic(Error{Routine: "Dbdsqr", Param: "ldc", Message: badLdC})
 	}
 
 	// Quick return if possible.
@@ -1238,89 +1717,433 @@ func (impl Implementation) Dbdsqr(uplo blas.Uplo, n, ncvt, nru, ncc int, d, e, v
 	}
 
 	if len(vt) < (n-1)*ldvt+ncvt && ncvt != 0 {
-		panic(shortVT)
+		panic(Error{Routine: "Dbdsqr", Param: "vt", Message: shortVT})
 	}
 	if len(u) < (nru-1)*ldu+n && nru != 0 {
-		panic(shortU)
+		panic(Error{Routine: "Dbdsqr", Param: "u", Message: shortU})
 	}
 	if len(c) < (n-1)*ldc+ncc && ncc != 0 {
-		panic(shortC)
+		panic(Error{Routine: "Dbdsqr", Param: "c", Message: shortC})
 	}
 	if len(d) < n {
-		panic(shortD)
+		panic(Error{Routine: "Dbdsqr", Param: "d", Message: shortD})
 	}
 	if len(e) < n-1 {
-		panic(shortE)
+		panic(Error{Routine: "Dbdsqr", Param: "e", Message: shortE})
 	}
 	if len(work) < 4*(n-1) {
-		panic(shortWork)
+		panic(Error{Routine: "Dbdsqr", Param: "work", Message: shortWork})
 	}
 
 	return lapacke.Dbdsqr(byte(uplo), n, ncvt, nru, ncc, d, e, vt, ldvt, u, ldu, c, ldc, work)
 }
 
-// Dgebrd reduces a general m×n matrix A to upper or lower bidiagonal form B by
-// an orthogonal transformation:
-//  Q^T * A * P = B.
-// The diagonal elements of B are stored in d and the off-diagonal elements are
-// stored in e. These are additionally stored along the diagonal of A and the
-// off-diagonal of A. If m >= n B is an upper-bidiagonal matrix, and if m < n B
-// is a lower-bidiagonal matrix.
-//
-// The remaining elements of A store the data needed to construct Q and P.
-// The matrices Q and P are products of elementary reflectors
-//  if m >= n, Q = H_0 * H_1 * ... * H_{n-1},
-//             P = G_0 * G_1 * ... * G_{n-2},
-//  if m < n,  Q = H_0 * H_1 * ... * H_{m-2},
-//             P = G_0 * G_1 * ... * G_{m-1},
-// where
-//  H_i = I - tauQ[i] * v_i * v_i^T,
-//  G_i = I - tauP[i] * u_i * u_i^T.
-//
-// As an example, on exit the entries of A when m = 6, and n = 5
-//  [ d   e  u1  u1  u1]
-//  [v1   d   e  u2  u2]
-//  [v1  v2   d   e  u3]
-//  [v1  v2  v3   d   e]
-//  [v1  v2  v3  v4   d]
-//  [v1  v2  v3  v4  v5]
-// and when m = 5, n = 6
-//  [ d  u1  u1  u1  u1  u1]
-//  [ e   d  u2  u2  u2  u2]
-//  [v1   e   d  u3  u3  u3]
-//  [v1  v2   e   d  u4  u4]
-//  [v1  v2  v3   e   d  u5]
-//
-// d, tauQ, and tauP must all have length at least min(m,n), and e must have
-// length min(m,n) - 1, unless lwork is -1 when there is no check except for
-// work which must have a length of at least one.
+// Dgbcon estimates the reciprocal of the condition number of the n×n general
+// band matrix A with kl sub-diagonals and ku super-diagonals, in either the
+// 1-norm or the ∞-norm, using the LU factorization
+//  A = P * L * U
+// computed by Dgbtrf. The estimate is obtained for norm(inv(A)), and the
+// reciprocal of the condition number is computed as
+//  rcond = 1 / (anorm * norm(inv(A))),
+// where anorm is the corresponding norm of the original matrix A, as
+// computed by Dlangb.
 //
-// work is temporary storage, and lwork specifies the usable memory length.
-// At minimum, lwork >= max(1,m,n) or be -1 and this function will panic otherwise.
-// Dgebrd is blocked decomposition, but the block size is limited
-// by the temporary space available. If lwork == -1, instead of performing Dgebrd,
-// the optimal work length will be stored into work[0].
+// The factors are stored in ab in the band storage scheme of blas64.Band
+// with kl sub-diagonals and kl+ku super-diagonals, so ldab must be at least
+// 2*kl+ku+1. U occupies the main diagonal and the kl+ku super-diagonals and
+// the multipliers of L the kl sub-diagonals. ipiv holds the zero-indexed
+// row interchanges and must have length n.
 //
-// Dgebrd is an internal routine. It is exported for testing purposes.
-func (impl Implementation) Dgebrd(m, n int, a []float64, lda int, d, e, tauQ, tauP, work []float64, lwork int) {
+// The length of work must be at least 3*n and the length of iwork must be at
+// least n.
+func (impl Implementation) Dgbcon(norm lapack.MatrixNorm, n, kl, ku int, ab []float64, ldab int, ipiv []int, anorm float64, work []float64, iwork []int) (rcond float64) {
 	switch {
-	case m < 0:
-		panic(mLT0)
+	case norm != lapack.MaxColumnSum && norm != lapack.MaxRowSum:
+		panic(Error{Routine: "Dgbcon", Param: "norm", Message: badNorm})
 	case n < 0:
-		panic(nLT0)
-	case lda < max(1, n):
-		panic(badLdA)
-	case lwork < max(1, max(m, n)) && lwork != -1:
-		panic(badLWork)
-	case len(work) < max(1, lwork):
-		panic(shortWork)
+		panic(Error{Routine: "Dgbcon", Param: "n", Message: nLT0})
+	case kl < 0:
+		panic(Error{Routine: "Dgbcon", Param: "kl", Message: klLT0})
+	case ku < 0:
+		panic(Error{Routine: "Dgbcon", Param: "ku", Message: kuLT0})
+	case ldab < 2*kl+ku+1:
+		panic(Error{Routine: "Dgbcon", Param: "ldab", Message: badLdA})
+	case anorm < 0:
+		panic(Error{Routine: "Dgbcon", Param: "anorm", Message: badNorm})
 	}
 
 	// Quick return if possible.
-	minmn := min(m, n)
-	if minmn == 0 {
-		work[0] = 1
-		return
+	if n == 0 {
+		return 1
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+2*kl+ku+1:
+		panic(Error{Routine: "Dgbcon", Param: "ab", Message: shortAB})
+	case len(ipiv) != n:
+		panic(Error{Routine: "Dgbcon", Param: "ipiv", Message: badLenIpiv})
+	case len(work) < 3*n:
+		panic(Error{Routine: "Dgbcon", Param: "work", Message: shortWork})
+	case len(iwork) < n:
+		panic(Error{Routine: "Dgbcon", Param: "iwork", Message: shortIWork})
+	}
+
+	_ldab := n
+	_ab := make([]float64, (2*kl+ku+1)*_ldab)
+	bandGenToLapacke(n, kl, kl+ku, ab, ldab, _ab, _ldab)
+	ipiv32 := make([]lapacke.Int, n)
+	for i, v := range ipiv {
+		v++ // Transform to one-indexed.
+		if v != int(lapacke.Int(v)) {
+			panic(Error{Routine: "Dgbcon", Param: "ipiv", Message: "lapack: ipiv element out of range"})
+		}
+		ipiv32[i] = lapacke.Int(v)
+	}
+	_rcond := []float64{0}
+	_iwork := make([]lapacke.Int, n)
+	lapacke.Dgbcon(byte(norm), n, kl, ku, _ab, _ldab, ipiv32, anorm, _rcond, work, _iwork)
+	return _rcond[0]
+}
+
+// Dgbrfs improves the computed solution X of the system of linear equations
+//  A * X = B    if trans == blas.NoTrans,
+//  A^T * X = B  if trans == blas.Trans or blas.ConjTrans,
+// where A is an n×n general band matrix with kl sub-diagonals and ku
+// super-diagonals, by iterative refinement, and computes error bounds for
+// the solution.
+//
+// ab holds the original matrix A in the band storage scheme of blas64.Band,
+// and ldab must be at least kl+ku+1. afb and ipiv hold the LU factorization
+// of A computed by Dgbtrf in the storage scheme described for Dgbcon, and
+// ldafb must be at least 2*kl+ku+1. On entry, x holds the solution computed
+// by Dgbtrs, and on return it is overwritten by the improved solution.
+//
+// For each column j of X, ferr[j] is the estimated forward error bound
+//  ‖x_j - xtrue_j‖_∞ / ‖x_j‖_∞
+// and berr[j] is the componentwise relative backward error of x_j. ferr and
+// berr must have length at least nrhs.
+//
+// The length of work must be at least 3*n and the length of iwork must be at
+// least n.
+func (impl Implementation) Dgbrfs(trans blas.Transpose, n, kl, ku, nrhs int, ab []float64, ldab int, afb []float64, ldafb int, ipiv []int, b []float64, ldb int, x []float64, ldx int, ferr, berr, work []float64, iwork []int) {
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(Error{Routine: "Dgbrfs", Param: "trans", Message: badTrans})
+	case n < 0:
+		panic(Error{Routine: "Dgbrfs", Param: "n", Message: nLT0})
+	case kl < 0:
+		panic(Error{Routine: "Dgbrfs", Param: "kl", Message: klLT0})
+	case ku < 0:
+		panic(Error{Routine: "Dgbrfs", Param: "ku", Message: kuLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Dgbrfs", Param: "nrhs", Message: nrhsLT0})
+	case ldab < kl+ku+1:
+		panic(Error{Routine: "Dgbrfs", Param: "ldab", Message: badLdA})
+	case ldafb < 2*kl+ku+1:
+		panic(Error{Routine: "Dgbrfs", Param: "ldafb", Message: badLdA})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Dgbrfs", Param: "ldb", Message: badLdB})
+	case ldx < max(1, nrhs):
+		panic(Error{Routine: "Dgbrfs", Param: "ldx", Message: badLdX})
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		for j := 0; j < nrhs; j++ {
+			ferr[j] = 0
+			berr[j] = 0
+		}
+		return
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+kl+ku+1:
+		panic(Error{Routine: "Dgbrfs", Param: "ab", Message: shortAB})
+	case len(afb) < (n-1)*ldafb+2*kl+ku+1:
+		panic(Error{Routine: "Dgbrfs", Param: "afb", Message: shortAFB})
+	case len(ipiv) != n:
+		panic(Error{Routine: "Dgbrfs", Param: "ipiv", Message: badLenIpiv})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Dgbrfs", Param: "b", Message: shortB})
+	case len(x) < (n-1)*ldx+nrhs:
+		panic(Error{Routine: "Dgbrfs", Param: "x", Message: shortX})
+	case len(ferr) < nrhs:
+		panic(Error{Routine: "Dgbrfs", Param: "ferr", Message: shortFerr})
+	case len(berr) < nrhs:
+		panic(Error{Routine: "Dgbrfs", Param: "berr", Message: shortBerr})
+	case len(work) < 3*n:
+		panic(Error{Routine: "Dgbrfs", Param: "work", Message: shortWork})
+	case len(iwork) < n:
+		panic(Error{Routine: "Dgbrfs", Param: "iwork", Message: shortIWork})
+	}
+
+	_ldab := n
+	_ab := make([]float64, (kl+ku+1)*_ldab)
+	bandGenToLapacke(n, kl, ku, ab, ldab, _ab, _ldab)
+	_afb := make([]float64, (2*kl+ku+1)*_ldab)
+	bandGenToLapacke(n, kl, kl+ku, afb, ldafb, _afb, _ldab)
+	ipiv32 := make([]lapacke.Int, n)
+	for i, v := range ipiv {
+		v++ // Transform to one-indexed.
+		if v != int(lapacke.Int(v)) {
+			panic(Error{Routine: "Dgbrfs", Param: "ipiv", Message: "lapack: ipiv element out of range"})
+		}
+		ipiv32[i] = lapacke.Int(v)
+	}
+	_iwork := make([]lapacke.Int, n)
+	lapacke.Dgbrfs(byte(trans), n, kl, ku, nrhs, _ab, _ldab, _afb, _ldab, ipiv32, b, ldb, x, ldx, ferr, berr, work, _iwork)
+}
+
+// Dgbsv computes the solution to the system of linear equations
+//  A * X = B,
+// where A is an n×n general band matrix with kl sub-diagonals and ku
+// super-diagonals, and X and B are n×nrhs matrices. The LU factorization
+// with partial pivoting of A is computed by Dgbtrf and used to solve the
+// system by Dgbtrs.
+//
+// On entry, ab holds A in the band storage scheme of blas64.Band with kl
+// sub-diagonals and ku super-diagonals in the first kl+ku+1 columns of each
+// row. ldab must be at least 2*kl+ku+1, the remaining kl columns of each row
+// being used for the fill-in of the factorization. On return, ab holds the
+// factors in the storage scheme described for Dgbcon and ipiv holds the
+// zero-indexed row interchanges. ipiv must have length n.
+//
+// On entry, b holds the n×nrhs right hand side matrix B, and on return it is
+// overwritten by the solution X. If U is exactly singular, Dgbsv returns
+// false and the solution is not computed.
+func (impl Implementation) Dgbsv(n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []int, b []float64, ldb int) (ok bool) {
+	switch {
+	case n < 0:
+		panic(Error{Routine: "Dgbsv", Param: "n", Message: nLT0})
+	case kl < 0:
+		panic(Error{Routine: "Dgbsv", Param: "kl", Message: klLT0})
+	case ku < 0:
+		panic(Error{Routine: "Dgbsv", Param: "ku", Message: kuLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Dgbsv", Param: "nrhs", Message: nrhsLT0})
+	case ldab < 2*kl+ku+1:
+		panic(Error{Routine: "Dgbsv", Param: "ldab", Message: badLdA})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Dgbsv", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+2*kl+ku+1:
+		panic(Error{Routine: "Dgbsv", Param: "ab", Message: shortAB})
+	case len(ipiv) != n:
+		panic(Error{Routine: "Dgbsv", Param: "ipiv", Message: badLenIpiv})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Dgbsv", Param: "b", Message: shortB})
+	}
+
+	// The first kl rows of the LAPACKE band storage are workspace for the
+	// fill-in.
+	_ldab := n
+	_ab := make([]float64, (2*kl+ku+1)*_ldab)
+	bandGenToLapacke(n, kl, ku, ab, ldab, _ab[kl*_ldab:], _ldab)
+	ipiv32 := make([]lapacke.Int, n)
+	ok = lapacke.Dgbsv(n, kl, ku, nrhs, _ab, _ldab, ipiv32, b, ldb)
+	bandGenToGonum(n, kl, kl+ku, _ab, _ldab, ab, ldab)
+	for i, v := range ipiv32 {
+		ipiv[i] = int(v) - 1 // Transform to zero-indexed.
+	}
+	return ok
+}
+
+// Dgbtrf computes the LU factorization with partial pivoting
+//  A = P * L * U
+// of an m×n general band matrix A with kl sub-diagonals and ku
+// super-diagonals, where P is a permutation matrix, L is lower triangular
+// with unit diagonal and at most kl non-zero elements below the diagonal in
+// each column, and U is upper triangular with kl+ku super-diagonals.
+//
+// On entry, ab holds A in the band storage scheme of blas64.Band with kl
+// sub-diagonals and ku super-diagonals in the first kl+ku+1 columns of each
+// row. ldab must be at least 2*kl+ku+1, the remaining kl columns of each row
+// being used for the fill-in of U. On return, ab holds the factors in the
+// storage scheme described for Dgbcon. ipiv holds the zero-indexed row
+// interchanges and must have length min(m,n).
+//
+// Dgbtrf returns whether U is non-singular. If it is exactly singular, the
+// factorization is completed, but U cannot be used to solve a system of
+// equations.
+func (impl Implementation) Dgbtrf(m, n, kl, ku int, ab []float64, ldab int, ipiv []int) (ok bool) {
+	mn := min(m, n)
+	switch {
+	case m < 0:
+		panic(Error{Routine: "Dgbtrf", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Dgbtrf", Param: "n", Message: nLT0})
+	case kl < 0:
+		panic(Error{Routine: "Dgbtrf", Param: "kl", Message: klLT0})
+	case ku < 0:
+		panic(Error{Routine: "Dgbtrf", Param: "ku", Message: kuLT0})
+	case ldab < 2*kl+ku+1:
+		panic(Error{Routine: "Dgbtrf", Param: "ldab", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if mn == 0 {
+		return true
+	}
+
+	// Rows below n+kl have no elements in the band.
+	rows := min(m, n+kl)
+	switch {
+	case len(ab) < (rows-1)*ldab+2*kl+ku+1:
+		panic(Error{Routine: "Dgbtrf", Param: "ab", Message: shortAB})
+	case len(ipiv) != mn:
+		panic(Error{Routine: "Dgbtrf", Param: "ipiv", Message: badLenIpiv})
+	}
+
+	// Convert to the LAPACKE band storage of the m×n matrix with kl+ku
+	// super-diagonals, the first kl of which are workspace on entry.
+	kv := kl + ku
+	_ldab := n
+	_ab := make([]float64, (kl+kv+1)*_ldab)
+	for i := 0; i < rows; i++ {
+		for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+			_ab[(kv+i-j)*_ldab+j] = ab[i*ldab+kl+j-i]
+		}
+	}
+	ipiv32 := make([]lapacke.Int, mn)
+	ok = lapacke.Dgbtrf(m, n, kl, ku, _ab, _ldab, ipiv32)
+	for i := 0; i < rows; i++ {
+		for j := max(0, i-kl); j < min(n, i+kv+1); j++ {
+			ab[i*ldab+kl+j-i] = _ab[(kv+i-j)*_ldab+j]
+		}
+	}
+	for i, v := range ipiv32 {
+		ipiv[i] = int(v) - 1 // Transform to zero-indexed.
+	}
+	return ok
+}
+
+// Dgbtrs solves a system of linear equations
+//  A * X = B    if trans == blas.NoTrans,
+//  A^T * X = B  if trans == blas.Trans or blas.ConjTrans,
+// where A is an n×n general band matrix with kl sub-diagonals and ku
+// super-diagonals, using the LU factorization computed by Dgbtrf.
+//
+// ab and ipiv hold the factors of A and the zero-indexed row interchanges in
+// the storage scheme described for Dgbcon, and ldab must be at least
+// 2*kl+ku+1.
+//
+// On entry, b holds the n×nrhs right hand side matrix B, and on return it is
+// overwritten by the solution X.
+func (impl Implementation) Dgbtrs(trans blas.Transpose, n, kl, ku, nrhs int, ab []float64, ldab int, ipiv []int, b []float64, ldb int) {
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(Error{Routine: "Dgbtrs", Param: "trans", Message: badTrans})
+	case n < 0:
+		panic(Error{Routine: "Dgbtrs", Param: "n", Message: nLT0})
+	case kl < 0:
+		panic(Error{Routine: "Dgbtrs", Param: "kl", Message: klLT0})
+	case ku < 0:
+		panic(Error{Routine: "Dgbtrs", Param: "ku", Message: kuLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Dgbtrs", Param: "nrhs", Message: nrhsLT0})
+	case ldab < 2*kl+ku+1:
+		panic(Error{Routine: "Dgbtrs", Param: "ldab", Message: badLdA})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Dgbtrs", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+2*kl+ku+1:
+		panic(Error{Routine: "Dgbtrs", Param: "ab", Message: shortAB})
+	case len(ipiv) != n:
+		panic(Error{Routine: "Dgbtrs", Param: "ipiv", Message: badLenIpiv})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Dgbtrs", Param: "b", Message: shortB})
+	}
+
+	_ldab := n
+	_ab := make([]float64, (2*kl+ku+1)*_ldab)
+	bandGenToLapacke(n, kl, kl+ku, ab, ldab, _ab, _ldab)
+	ipiv32 := make([]lapacke.Int, n)
+	for i, v := range ipiv {
+		v++ // Transform to one-indexed.
+		if v != int(lapacke.Int(v)) {
+			panic(Error{Routine: "Dgbtrs", Param: "ipiv", Message: "lapack: ipiv element out of range"})
+		}
+		ipiv32[i] = lapacke.Int(v)
+	}
+	lapacke.Dgbtrs(byte(trans), n, kl, ku, nrhs, _ab, _ldab, ipiv32, b, ldb)
+}
+
+// Dgebrd reduces a general m×n matrix A to upper or lower bidiagonal form B by
+// an orthogonal transformation:
+//  Q^T * A * P = B.
+// The diagonal elements of B are stored in d and the off-diagonal elements are
+// stored in e. These are additionally stored along the diagonal of A and the
+// off-diagonal of A. If m >= n B is an upper-bidiagonal matrix, and if m < n B
+// is a lower-bidiagonal matrix.
+//
+// The remaining elements of A store the data needed to construct Q and P.
+// The matrices Q and P are products of elementary reflectors
+//  if m >= n, Q = H_0 * H_1 * ... * H_{n-1},
+//             P = G_0 * G_1 * ... * G_{n-2},
+//  if m < n,  Q = H_0 * H_1 * ... * H_{m-2},
+//             P = G_0 * G_1 * ... * G_{m-1},
+// where
+//  H_i = I - tauQ[i] * v_i * v_i^T,
+//  G_i = I - tauP[i] * u_i * u_i^T.
+//
+// As an example, on exit the entries of A when m = 6, and n = 5
+//  [ d   e  u1  u1  u1]
+//  [v1   d   e  u2  u2]
+//  [v1  v2   d   e  u3]
+//  [v1  v2  v3   d   e]
+//  [v1  v2  v3  v4   d]
+//  [v1  v2  v3  v4  v5]
+// and when m = 5, n = 6
+//  [ d  u1  u1  u1  u1  u1]
+//  [ e   d  u2  u2  u2  u2]
+//  [v1   e   d  u3  u3  u3]
+//  [v1  v2   e   d  u4  u4]
+//  [v1  v2  v3   e   d  u5]
+//
+// d, tauQ, and tauP must all have length at least min(m,n), and e must have
+// length min(m,n) - 1, unless lwork is -1 when there is no check except for
+// work which must have a length of at least one.
+//
+// work is temporary storage, and lwork specifies the usable memory length.
+// At minimum, lwork >= max(1,m,n) or be -1 and this function will panic otherwise.
+// Dgebrd is blocked decomposition, but the block size is limited
+// by the temporary space available. If lwork == -1, instead of performing Dgebrd,
+// the optimal work length will be stored into work[0].
+//
+// Dgebrd is an internal routine. It is exported for testing purposes.
+func (impl Implementation) Dgebrd(m, n int, a []float64, lda int, d, e, tauQ, tauP, work []float64, lwork int) {
+	switch {
+	case m < 0:
+		panic(Error{Routine: "Dgebrd", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Dgebrd", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Dgebrd", Param: "lda", Message: badLdA})
+	case lwork < max(1, max(m, n)) && lwork != -1:
+		panic(Error{Routine: "Dgebrd", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Dgebrd", Param: "work", Message: shortWork})
+	}
+
+	// Quick return if possible.
+	minmn := min(m, n)
+	if minmn == 0 {
+		work[0] = 1
+		return
 	}
 
 	if lwork == -1 {
@@ -1330,15 +2153,15 @@ func (impl Implementation) Dgebrd(m, n int, a []float64, lda int, d, e, tauQ, ta
 
 	switch {
 	case len(a) < (m-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dgebrd", Param: "a", Message: shortA})
 	case len(d) < minmn:
-		panic(shortD)
+		panic(Error{Routine: "Dgebrd", Param: "d", Message: shortD})
 	case len(e) < minmn-1:
-		panic(shortE)
+		panic(Error{Routine: "Dgebrd", Param: "e", Message: shortE})
 	case len(tauQ) < minmn:
-		panic(shortTauQ)
+		panic(Error{Routine: "Dgebrd", Param: "tauQ", Message: shortTauQ})
 	case len(tauP) < minmn:
-		panic(shortTauP)
+		panic(Error{Routine: "Dgebrd", Param: "tauP", Message: shortTauP})
 	}
 
 	lapacke.Dgebrd(m, n, a, lda, d, e, tauQ, tauP, work, lwork)
@@ -1358,11 +2181,11 @@ func (impl Implementation) Dgebrd(m, n int, a []float64, lda int, d, e, tauQ, ta
 func (impl Implementation) Dgecon(norm lapack.MatrixNorm, n int, a []float64, lda int, anorm float64, work []float64, iwork []int) float64 {
 	switch {
 	case norm != lapack.MaxColumnSum && norm != lapack.MaxRowSum:
-		panic(badNorm)
+		panic(Error{Routine: "Dgecon", Param: "norm", Message: badNorm})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dgecon", Param: "n", Message: nLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dgecon", Param: "lda", Message: badLdA})
 	}
 
 	// Quick return if possible.
@@ -1372,15 +2195,15 @@ func (impl Implementation) Dgecon(norm lapack.MatrixNorm, n int, a []float64, ld
 
 	switch {
 	case len(a) < (n-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dgecon", Param: "a", Message: shortA})
 	case len(work) < 4*n:
-		panic(shortWork)
+		panic(Error{Routine: "Dgecon", Param: "work", Message: shortWork})
 	case len(iwork) < n:
-		panic(shortIWork)
+		panic(Error{Routine: "Dgecon", Param: "iwork", Message: shortIWork})
 	}
 
 	rcond := []float64{0}
-	_iwork := make([]int32, n)
+	_iwork := make([]lapacke.Int, n)
 	lapacke.Dgecon(byte(norm), n, a, lda, anorm, rcond, work, _iwork)
 	return rcond[0]
 }
@@ -1405,11 +2228,11 @@ func (impl Implementation) Dgecon(norm lapack.MatrixNorm, n int, a []float64, ld
 func (impl Implementation) Dgelq2(m, n int, a []float64, lda int, tau, work []float64) {
 	switch {
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dgelq2", Param: "m", Message: mLT0})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dgelq2", Param: "n", Message: nLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dgelq2", Param: "lda", Message: badLdA})
 	}
 
 	// Quick return if possible.
@@ -1420,11 +2243,11 @@ func (impl Implementation) Dgelq2(m, n int, a []float64, lda int, tau, work []fl
 
 	switch {
 	case len(a) < (m-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dgelq2", Param: "a", Message: shortA})
 	case len(tau) < k:
-		panic(shortTau)
+		panic(Error{Routine: "Dgelq2", Param: "tau", Message: shortTau})
 	case len(work) < m:
-		panic(shortWork)
+		panic(Error{Routine: "Dgelq2", Param: "work", Message: shortWork})
 	}
 
 	lapacke.Dgelq2(m, n, a, lda, tau, work)
@@ -1444,15 +2267,15 @@ func (impl Implementation) Dgelq2(m, n int, a []float64, lda int, tau, work []fl
 func (impl Implementation) Dgelqf(m, n int, a []float64, lda int, tau, work []float64, lwork int) {
 	switch {
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dgelqf", Param: "m", Message: mLT0})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dgelqf", Param: "n", Message: nLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dgelqf", Param: "lda", Message: badLdA})
 	case lwork < max(1, m) && lwork != -1:
-		panic(badLWork)
+		panic(Error{Routine: "Dgelqf", Param: "lwork", Message: badLWork})
 	case len(work) < max(1, lwork):
-		panic(shortWork)
+		panic(Error{Routine: "Dgelqf", Param: "work", Message: shortWork})
 	}
 
 	k := min(m, n)
@@ -1468,9 +2291,9 @@ func (impl Implementation) Dgelqf(m, n int, a []float64, lda int, tau, work []fl
 
 	switch {
 	case len(a) < (m-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dgelqf", Param: "a", Message: shortA})
 	case len(tau) < k:
-		panic(shortTau)
+		panic(Error{Routine: "Dgelqf", Param: "tau", Message: shortTau})
 	}
 
 	lapacke.Dgelqf(m, n, a, lda, tau, work, lwork)
@@ -1501,13 +2324,13 @@ func (impl Implementation) Dgelqf(m, n int, a []float64, lda int, tau, work []fl
 func (impl Implementation) Dgeqr2(m, n int, a []float64, lda int, tau, work []float64) {
 	switch {
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dgeqr2", Param: "m", Message: mLT0})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dgeqr2", Param: "n", Message: nLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dgeqr2", Param: "lda", Message: badLdA})
 	case len(work) < n:
-		panic(shortWork)
+		panic(Error{Routine: "Dgeqr2", Param: "work", Message: shortWork})
 	}
 
 	// Quick return if possible.
@@ -1518,9 +2341,9 @@ func (impl Implementation) Dgeqr2(m, n int, a []float64, lda int, tau, work []fl
 
 	switch {
 	case len(a) < (m-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dgeqr2", Param: "a", Message: shortA})
 	case len(tau) < k:
-		panic(shortTau)
+		panic(Error{Routine: "Dgeqr2", Param: "tau", Message: shortTau})
 	}
 
 	lapacke.Dgeqr2(m, n, a, lda, tau, work)
@@ -1541,15 +2364,15 @@ func (impl Implementation) Dgeqr2(m, n int, a []float64, lda int, tau, work []fl
 func (impl Implementation) Dgeqrf(m, n int, a []float64, lda int, tau, work []float64, lwork int) {
 	switch {
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dgeqrf", Param: "m", Message: mLT0})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dgeqrf", Param: "n", Message: nLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dgeqrf", Param: "lda", Message: badLdA})
 	case lwork < max(1, n) && lwork != -1:
-		panic(badLWork)
+		panic(Error{Routine: "Dgeqrf", Param: "lwork", Message: badLWork})
 	case len(work) < max(1, lwork):
-		panic(shortWork)
+		panic(Error{Routine: "Dgeqrf", Param: "work", Message: shortWork})
 	}
 
 	// Quick return if possible.
@@ -1566,9 +2389,9 @@ func (impl Implementation) Dgeqrf(m, n int, a []float64, lda int, tau, work []fl
 
 	switch {
 	case len(a) < (m-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dgeqrf", Param: "a", Message: shortA})
 	case len(tau) < k:
-		panic(shortTau)
+		panic(Error{Routine: "Dgeqrf", Param: "tau", Message: shortTau})
 	}
 
 	lapacke.Dgeqrf(m, n, a, lda, tau, work, lwork)
@@ -1632,17 +2455,17 @@ func (impl Implementation) Dgeqrf(m, n int, a []float64, lda int, tau, work []fl
 func (impl Implementation) Dgehrd(n, ilo, ihi int, a []float64, lda int, tau, work []float64, lwork int) {
 	switch {
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dgehrd", Param: "n", Message: nLT0})
 	case ilo < 0 || max(0, n-1) < ilo:
-		panic(badIlo)
+		panic(Error{Routine: "Dgehrd", Param: "ilo", Message: badIlo})
 	case ihi < min(ilo, n-1) || n <= ihi:
-		panic(badIhi)
+		panic(Error{Routine: "Dgehrd", Param: "ihi", Message: badIhi})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dgehrd", Param: "lda", Message: badLdA})
 	case lwork < max(1, n) && lwork != -1:
-		panic(badLWork)
+		panic(Error{Routine: "Dgehrd", Param: "lwork", Message: badLWork})
 	case len(work) < lwork:
-		panic(shortWork)
+		panic(Error{Routine: "Dgehrd", Param: "work", Message: shortWork})
 	}
 
 	// Quick return if possible.
@@ -1658,9 +2481,9 @@ func (impl Implementation) Dgehrd(n, ilo, ihi int, a []float64, lda int, tau, wo
 
 	switch {
 	case len(a) < (n-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dgehrd", Param: "a", Message: shortA})
 	case len(tau) != n-1:
-		panic(badLenTau)
+		panic(Error{Routine: "Dgehrd", Param: "tau", Message: badLenTau})
 	}
 
 	lapacke.Dgehrd(n, ilo+1, ihi+1, a, lda, tau, work, lwork)
@@ -1700,21 +2523,21 @@ func (impl Implementation) Dgels(trans blas.Transpose, m, n, nrhs int, a []float
 	minwrk := mn + max(mn, nrhs)
 	switch {
 	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
-		panic(badTrans)
+		panic(Error{Routine: "Dgels", Param: "trans", Message: badTrans})
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dgels", Param: "m", Message: mLT0})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dgels", Param: "n", Message: nLT0})
 	case nrhs < 0:
-		panic(nrhsLT0)
+		panic(Error{Routine: "Dgels", Param: "nrhs", Message: nrhsLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dgels", Param: "lda", Message: badLdA})
 	case ldb < max(1, nrhs):
-		panic(badLdB)
+		panic(Error{Routine: "Dgels", Param: "ldb", Message: badLdB})
 	case lwork < max(1, minwrk) && lwork != -1:
-		panic(badLWork)
+		panic(Error{Routine: "Dgels", Param: "lwork", Message: badLWork})
 	case len(work) < max(1, lwork):
-		panic(shortWork)
+		panic(Error{Routine: "Dgels", Param: "work", Message: shortWork})
 	}
 
 	// Quick return if possible.
@@ -1730,102 +2553,339 @@ func (impl Implementation) Dgels(trans blas.Transpose, m, n, nrhs int, a []float
 
 	switch {
 	case len(a) < (m-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dgels", Param: "a", Message: shortA})
 	case len(b) < (max(m, n)-1)*ldb+nrhs:
-		panic(shortB)
+		panic(Error{Routine: "Dgels", Param: "b", Message: shortB})
 	}
 
 	return lapacke.Dgels(byte(trans), m, n, nrhs, a, lda, b, ldb, work, lwork)
 }
 
-// Dgesvd computes the singular value decomposition of the input matrix A.
-//
-// The singular value decomposition is
-//  A = U * Sigma * V^T
-// where Sigma is an m×n diagonal matrix containing the singular values of A,
-// U is an m×m orthogonal matrix and V is an n×n orthogonal matrix. The first
-// min(m,n) columns of U and V are the left and right singular vectors of A
-// respectively.
-//
-// jobU and jobVT are options for computing the singular vectors. The behavior
-// is as follows
-//  jobU == lapack.SVDAll       All m columns of U are returned in u
-//  jobU == lapack.SVDStore     The first min(m,n) columns are returned in u
-//  jobU == lapack.SVDOverwrite The first min(m,n) columns of U are written into a
-//  jobU == lapack.SVDNone      The columns of U are not computed.
-// The behavior is the same for jobVT and the rows of V^T. At most one of jobU
-// and jobVT can equal lapack.SVDOverwrite, and Dgesvd will panic otherwise.
-//
-// On entry, a contains the data for the m×n matrix A. During the call to Dgesvd
-// the data is overwritten. On exit, A contains the appropriate singular vectors
-// if either job is lapack.SVDOverwrite.
-//
-// s is a slice of length at least min(m,n) and on exit contains the singular
-// values in decreasing order.
-//
-// u contains the left singular vectors on exit, stored column-wise. If
-// jobU == lapack.SVDAll, u is of size m×m. If jobU == lapack.SVDStore u is
-// of size m×min(m,n). If jobU == lapack.SVDOverwrite or lapack.SVDNone, u is
-// not used.
-//
-// vt contains the left singular vectors on exit, stored rowwise. If
-// jobV == lapack.SVDAll, vt is of size n×m. If jobVT == lapack.SVDStore vt is
-// of size min(m,n)×n. If jobVT == lapack.SVDOverwrite or lapack.SVDNone, vt is
-// not used.
-//
-// work is a slice for storing temporary memory, and lwork is the usable size of
-// the slice. lwork must be at least max(5*min(m,n), 3*min(m,n)+max(m,n)).
-// If lwork == -1, instead of performing Dgesvd, the optimal work length will be
-// stored into work[0]. Dgesvd will panic if the working memory has insufficient
-// storage.
-//
-// Dgesvd returns whether the decomposition successfully completed.
-func (impl Implementation) Dgesvd(jobU, jobVT lapack.SVDJob, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int) (ok bool) {
-	wantua := jobU == lapack.SVDAll
-	wantus := jobU == lapack.SVDStore
-	wantuo := jobU == lapack.SVDOverwrite
-	wantun := jobU == lapack.SVDNone
-	if !(wantua || wantus || wantuo || wantun) {
-		panic(badSVDJob)
-	}
-
-	wantva := jobVT == lapack.SVDAll
-	wantvs := jobVT == lapack.SVDStore
-	wantvas := wantva || wantvs
-	wantvo := jobVT == lapack.SVDOverwrite
-	wantvn := jobVT == lapack.SVDNone
-	if !(wantva || wantvs || wantvo || wantvn) {
-		panic(badSVDJob)
-	}
-
-	if wantuo && wantvo {
-		panic(bothSVDOver)
+// Dgelsd computes the minimum norm solution of the linear least squares
+// problem
+//  minimize ||A*X - B||_2
+// for the m×n matrix A, which may be rank deficient, using the singular value
+// decomposition of A computed by a divide and conquer method. B is a
+// max(m,n)×nrhs matrix whose leading m rows hold the right hand sides on
+// entry and whose leading n rows hold the solution on return.
+//
+// On return, the singular values of A are stored in decreasing order in s,
+// which must have length min(m,n), and a is overwritten. Singular values less
+// than or equal to rcond times the largest singular value are treated as
+// zero. If rcond is negative, machine precision is used instead. The
+// effective rank of A is returned in rank.
+//
+// work must have length at least lwork and lwork must be at least
+//  3*k + max(max(m,n), nrhs, 9*k + 2*k*25 + 8*k*nlvl + k*nrhs + 26*26),
+// where k = min(m,n) and nlvl = max(0, floor(log2(k/26))+1), and iwork must
+// have length at least max(1, 3*k*nlvl+11*k). Dgelsd will panic otherwise.
+// If lwork == -1, instead of computing the solution, the optimal value of
+// lwork is stored into work[0] and the minimum length of iwork into
+// iwork[0].
+//
+// ok is false if the singular value decomposition failed to converge.
+func (impl Implementation) Dgelsd(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, s []float64, rcond float64, work []float64, lwork int, iwork []int) (rank int, ok bool) {
+	const smlsiz = 25
+	mn := min(m, n)
+	var nlvl int
+	if mn > 0 {
+		nlvl = max(0, int(math.Log2(float64(mn)/(smlsiz+1)))+1)
 	}
-
-	minmn := min(m, n)
-	minwork := 1
-	if minmn > 0 {
-		minwork = max(3*minmn+max(m, n), 5*minmn)
+	minwrk, liwork := 1, 1
+	if mn > 0 {
+		wlalsd := 9*mn + 2*mn*smlsiz + 8*mn*nlvl + mn*nrhs + (smlsiz+1)*(smlsiz+1)
+		minwrk = 3*mn + max(max(m, n), max(nrhs, wlalsd))
+		liwork = max(1, 3*mn*nlvl+11*mn)
 	}
 	switch {
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dgelsd", Param: "m", Message: mLT0})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dgelsd", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Dgelsd", Param: "nrhs", Message: nrhsLT0})
 	case lda < max(1, n):
-		panic(badLdA)
-	case ldu < 1, wantua && ldu < m, wantus && ldu < minmn:
-		panic(badLdU)
-	case ldvt < 1 || (wantvas && ldvt < n):
-		panic(badLdVT)
-	case lwork < minwork && lwork != -1:
-		panic(badLWork)
+		panic(Error{Routine: "Dgelsd", Param: "lda", Message: badLdA})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Dgelsd", Param: "ldb", Message: badLdB})
+	case lwork < minwrk && lwork != -1:
+		panic(Error{Routine: "Dgelsd", Param: "lwork", Message: badLWork})
 	case len(work) < max(1, lwork):
-		panic(shortWork)
+		panic(Error{Routine: "Dgelsd", Param: "work", Message: shortWork})
+	case len(iwork) < liwork:
+		panic(Error{Routine: "Dgelsd", Param: "iwork", Message: shortIWork})
 	}
 
 	// Quick return if possible.
-	if minmn == 0 {
+	if mn == 0 || nrhs == 0 {
+		if lwork == -1 {
+			work[0] = float64(minwrk)
+			iwork[0] = liwork
+			return 0, true
+		}
+		impl.Dlaset(blas.All, max(m, n), nrhs, 0, 0, b, ldb)
+		return 0, true
+	}
+
+	_rank := []lapacke.Int{0}
+	if lwork == -1 {
+		_iwork := []lapacke.Int{0}
+		ok = lapacke.Dgelsd(m, n, nrhs, a, lda, b, ldb, s, rcond, _rank, work, -1, _iwork)
+		iwork[0] = int(_iwork[0])
+		return 0, ok
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(Error{Routine: "Dgelsd", Param: "a", Message: shortA})
+	case len(b) < (max(m, n)-1)*ldb+nrhs:
+		panic(Error{Routine: "Dgelsd", Param: "b", Message: shortB})
+	case len(s) < mn:
+		panic(Error{Routine: "Dgelsd", Param: "s", Message: shortS})
+	}
+
+	_iwork := make([]lapacke.Int, liwork)
+	ok = lapacke.Dgelsd(m, n, nrhs, a, lda, b, ldb, s, rcond, _rank, work, lwork, _iwork)
+	return int(_rank[0]), ok
+}
+
+// Dgelsy computes the minimum norm solution of the linear least squares
+// problem
+//  minimize ||A*X - B||_2
+// for the m×n matrix A, which may be rank deficient, using the complete
+// orthogonal factorization
+//  A * P = Q * [ T11 0 ] * Z
+//              [  0  0 ]
+// based on the QR factorization with column pivoting. B is a max(m,n)×nrhs
+// matrix whose leading m rows hold the right hand sides on entry and whose
+// leading n rows hold the solution on return. On return, a is overwritten by
+// the factorization.
+//
+// The effective rank of A, returned in rank, is the order of the largest
+// leading triangular block R11 of the QR factorization with pivoting whose
+// estimated condition number is less than 1/rcond.
+//
+// jpvt specifies the column pivoting as for Dgeqp3: on entry, a column j
+// with jpvt[j] >= 0 is moved to the front of A*P and the other columns, for
+// which jpvt[j] must be -1, are free. On return, column j of A*P was column
+// jpvt[j] of A. jpvt must have length n, otherwise Dgelsy will panic.
+//
+// work must have length at least lwork and lwork must be at least
+// max(1, min(m,n)+3*n+1, 2*min(m,n)+nrhs), otherwise Dgelsy will panic. If
+// lwork == -1, instead of computing the solution, the optimal value of lwork
+// is stored into work[0].
+func (impl Implementation) Dgelsy(m, n, nrhs int, a []float64, lda int, b []float64, ldb int, jpvt []int, rcond float64, work []float64, lwork int) (rank int) {
+	mn := min(m, n)
+	minwrk := 1
+	if mn > 0 {
+		minwrk = max(mn+3*n+1, 2*mn+nrhs)
+	}
+	switch {
+	case m < 0:
+		panic(Error{Routine: "Dgelsy", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Dgelsy", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Dgelsy", Param: "nrhs", Message: nrhsLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Dgelsy", Param: "lda", Message: badLdA})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Dgelsy", Param: "ldb", Message: badLdB})
+	case lwork < minwrk && lwork != -1:
+		panic(Error{Routine: "Dgelsy", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Dgelsy", Param: "work", Message: shortWork})
+	}
+
+	// Quick return if possible.
+	if mn == 0 || nrhs == 0 {
+		if lwork == -1 {
+			work[0] = float64(minwrk)
+			return 0
+		}
+		impl.Dlaset(blas.All, max(m, n), nrhs, 0, 0, b, ldb)
+		return 0
+	}
+
+	_rank := []lapacke.Int{0}
+	if lwork == -1 {
+		lapacke.Dgelsy(m, n, nrhs, a, lda, b, ldb, nil, rcond, _rank, work, -1)
+		return 0
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(Error{Routine: "Dgelsy", Param: "a", Message: shortA})
+	case len(b) < (max(m, n)-1)*ldb+nrhs:
+		panic(Error{Routine: "Dgelsy", Param: "b", Message: shortB})
+	case len(jpvt) != n:
+		panic(Error{Routine: "Dgelsy", Param: "jpvt", Message: badLenJpvt})
+	}
+
+	jpvt32 := make([]lapacke.Int, n)
+	for i, v := range jpvt {
+		v++
+		if v != int(lapacke.Int(v)) || v < 0 || n < v {
+			panic(Error{Routine: "Dgelsy", Param: "jpvt", Message: badJpvt})
+		}
+		jpvt32[i] = lapacke.Int(v)
+	}
+	lapacke.Dgelsy(m, n, nrhs, a, lda, b, ldb, jpvt32, rcond, _rank, work, lwork)
+	for i, v := range jpvt32 {
+		jpvt[i] = int(v - 1)
+	}
+	return int(_rank[0])
+}
+
+// Dgetsls solves the overdetermined or underdetermined linear system
+// involving the m×n matrix A or its transpose as described for Dgels, using
+// the tall skinny QR factorization of A if m >= n and the short wide LQ
+// factorization otherwise. For a matrix with many more rows than columns it
+// is considerably faster than Dgels. A must have full rank.
+//
+// If the LAPACKE library does not provide dgetsls, which was added in LAPACK
+// 3.7, the system is solved by Dgels instead.
+//
+// work must have length at least lwork and lwork must be at least 1,
+// otherwise Dgetsls will panic. A workspace query with lwork == -1 stores the
+// optimal value of lwork into work[0], and lwork should be at least that
+// value, otherwise LAPACK reports an illegal argument and Dgetsls returns
+// false.
+//
+// Dgetsls returns false if A does not have full rank, in which case no
+// solution has been computed.
+func (impl Implementation) Dgetsls(trans blas.Transpose, m, n, nrhs int, a []float64, lda int, b []float64, ldb int, work []float64, lwork int) bool {
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans:
+		panic(Error{Routine: "Dgetsls", Param: "trans", Message: badTrans})
+	case m < 0:
+		panic(Error{Routine: "Dgetsls", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Dgetsls", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Dgetsls", Param: "nrhs", Message: nrhsLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Dgetsls", Param: "lda", Message: badLdA})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Dgetsls", Param: "ldb", Message: badLdB})
+	case lwork < 1 && lwork != -1:
+		panic(Error{Routine: "Dgetsls", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Dgetsls", Param: "work", Message: shortWork})
+	}
+
+	// Quick return if possible.
+	if min(m, n) == 0 || nrhs == 0 {
+		if lwork == -1 {
+			work[0] = 1
+			return true
+		}
+		impl.Dlaset(blas.All, max(m, n), nrhs, 0, 0, b, ldb)
+		return true
+	}
+
+	if lwork == -1 {
+		return lapacke.Dgetsls(byte(trans), m, n, nrhs, a, lda, b, ldb, work, -1)
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(Error{Routine: "Dgetsls", Param: "a", Message: shortA})
+	case len(b) < (max(m, n)-1)*ldb+nrhs:
+		panic(Error{Routine: "Dgetsls", Param: "b", Message: shortB})
+	}
+
+	return lapacke.Dgetsls(byte(trans), m, n, nrhs, a, lda, b, ldb, work, lwork)
+}
+
+// Dgesvd computes the singular value decomposition of the input matrix A.
+//
+// The singular value decomposition is
+//  A = U * Sigma * V^T
+// where Sigma is an m×n diagonal matrix containing the singular values of A,
+// U is an m×m orthogonal matrix and V is an n×n orthogonal matrix. The first
+// min(m,n) columns of U and V are the left and right singular vectors of A
+// respectively.
+//
+// jobU and jobVT are options for computing the singular vectors. The behavior
+// is as follows
+//  jobU == lapack.SVDAll       All m columns of U are returned in u
+//  jobU == lapack.SVDStore     The first min(m,n) columns are returned in u
+//  jobU == lapack.SVDOverwrite The first min(m,n) columns of U are written into a
+//  jobU == lapack.SVDNone      The columns of U are not computed.
+// The behavior is the same for jobVT and the rows of V^T. At most one of jobU
+// and jobVT can equal lapack.SVDOverwrite, and Dgesvd will panic otherwise.
+//
+// On entry, a contains the data for the m×n matrix A. During the call to Dgesvd
+// the data is overwritten. On exit, A contains the appropriate singular vectors
+// if either job is lapack.SVDOverwrite.
+//
+// s is a slice of length at least min(m,n) and on exit contains the singular
+// values in decreasing order.
+//
+// u contains the left singular vectors on exit, stored column-wise. If
+// jobU == lapack.SVDAll, u is of size m×m. If jobU == lapack.SVDStore u is
+// of size m×min(m,n). If jobU == lapack.SVDOverwrite or lapack.SVDNone, u is
+// not used.
+//
+// vt contains the left singular vectors on exit, stored rowwise. If
+// jobV == lapack.SVDAll, vt is of size n×m. If jobVT == lapack.SVDStore vt is
+// of size min(m,n)×n. If jobVT == lapack.SVDOverwrite or lapack.SVDNone, vt is
+// not used.
+//
+// work is a slice for storing temporary memory, and lwork is the usable size of
+// the slice. lwork must be at least max(5*min(m,n), 3*min(m,n)+max(m,n)).
+// If lwork == -1, instead of performing Dgesvd, the optimal work length will be
+// stored into work[0]. Dgesvd will panic if the working memory has insufficient
+// storage.
+//
+// Dgesvd returns whether the decomposition successfully completed.
+func (impl Implementation) Dgesvd(jobU, jobVT lapack.SVDJob, m, n int, a []float64, lda int, s, u []float64, ldu int, vt []float64, ldvt int, work []float64, lwork int) (ok bool) {
+	wantua := jobU == lapack.SVDAll
+	wantus := jobU == lapack.SVDStore
+	wantuo := jobU == lapack.SVDOverwrite
+	wantun := jobU == lapack.SVDNone
+	if !(wantua || wantus || wantuo || wantun) {
+		panic(Error{Routine: "Dgesvd", Param: "jobU", Message: badSVDJob})
+	}
+
+	wantva := jobVT == lapack.SVDAll
+	wantvs := jobVT == lapack.SVDStore
+	wantvas := wantva || wantvs
+	wantvo := jobVT == lapack.SVDOverwrite
+	wantvn := jobVT == lapack.SVDNone
+	if !(wantva || wantvs || wantvo || wantvn) {
+		panic(Error{Routine: "Dgesvd", Param: "jobVT", Message: badSVDJob})
+	}
+
+	if wantuo && wantvo {
+		panic(Error{Routine: "Dgesvd", Param: "", Message: bothSVDOver})
+	}
+
+	minmn := min(m, n)
+	minwork := 1
+	if minmn > 0 {
+		minwork = max(3*minmn+max(m, n), 5*minmn)
+	}
+	switch {
+	case m < 0:
+		panic(Error{Routine: "Dgesvd", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Dgesvd", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Dgesvd", Param: "lda", Message: badLdA})
+	case ldu < 1, wantua && ldu < m, wantus && ldu < minmn:
+		panic(Error{Routine: "Dgesvd", Param: "ldu", Message: badLdU})
+	case ldvt < 1 || (wantvas && ldvt < n):
+		panic(Error{Routine: "Dgesvd", Param: "ldvt", Message: badLdVT})
+	case lwork < minwork && lwork != -1:
+		panic(Error{Routine: "Dgesvd", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Dgesvd", Param: "work", Message: shortWork})
+	}
+
+	// Quick return if possible.
+	if minmn == 0 {
 		work[0] = 1
 		return true
 	}
@@ -1836,13 +2896,13 @@ func (impl Implementation) Dgesvd(jobU, jobVT lapack.SVDJob, m, n int, a []float
 
 	switch {
 	case len(a) < (m-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dgesvd", Param: "a", Message: shortA})
 	case len(s) < minmn:
-		panic(shortS)
+		panic(Error{Routine: "Dgesvd", Param: "s", Message: shortS})
 	case (len(u) < (m-1)*ldu+m && wantua) || (len(u) < (m-1)*ldu+minmn && wantus):
-		panic(shortU)
+		panic(Error{Routine: "Dgesvd", Param: "u", Message: shortU})
 	case (len(vt) < (n-1)*ldvt+n && wantva) || (len(vt) < (minmn-1)*ldvt+n && wantvs):
-		panic(shortVT)
+		panic(Error{Routine: "Dgesvd", Param: "vt", Message: shortVT})
 	}
 
 	return lapacke.Dgesvd(byte(jobU), byte(jobVT), m, n, a, lda, s, u, ldu, vt, ldvt, work, lwork)
@@ -1867,11 +2927,11 @@ func (Implementation) Dgetf2(m, n int, a []float64, lda int, ipiv []int) (ok boo
 	mn := min(m, n)
 	switch {
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dgetf2", Param: "m", Message: mLT0})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dgetf2", Param: "n", Message: nLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dgetf2", Param: "lda", Message: badLdA})
 	}
 
 	// Quick return if possible.
@@ -1881,12 +2941,12 @@ func (Implementation) Dgetf2(m, n int, a []float64, lda int, ipiv []int) (ok boo
 
 	switch {
 	case len(a) < (m-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dgetf2", Param: "a", Message: shortA})
 	case len(ipiv) != mn:
-		panic(badLenIpiv)
+		panic(Error{Routine: "Dgetf2", Param: "ipiv", Message: badLenIpiv})
 	}
 
-	ipiv32 := make([]int32, mn)
+	ipiv32 := make([]lapacke.Int, mn)
 	ok = lapacke.Dgetf2(m, n, a, lda, ipiv32)
 	for i, v := range ipiv32 {
 		ipiv[i] = int(v) - 1 // Transform to zero-indexed.
@@ -1915,11 +2975,11 @@ func (impl Implementation) Dgetrf(m, n int, a []float64, lda int, ipiv []int) (o
 	mn := min(m, n)
 	switch {
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dgetrf", Param: "m", Message: mLT0})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dgetrf", Param: "n", Message: nLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dgetrf", Param: "lda", Message: badLdA})
 	}
 
 	// Quick return if possible.
@@ -1929,12 +2989,12 @@ func (impl Implementation) Dgetrf(m, n int, a []float64, lda int, ipiv []int) (o
 
 	switch {
 	case len(a) < (m-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dgetrf", Param: "a", Message: shortA})
 	case len(ipiv) != mn:
-		panic(badLenIpiv)
+		panic(Error{Routine: "Dgetrf", Param: "ipiv", Message: badLenIpiv})
 	}
 
-	ipiv32 := make([]int32, mn)
+	ipiv32 := make([]lapacke.Int, mn)
 	ok = lapacke.Dgetrf(m, n, a, lda, ipiv32)
 	for i, v := range ipiv32 {
 		ipiv[i] = int(v) - 1 // Transform to zero-indexed.
@@ -1958,13 +3018,13 @@ func (impl Implementation) Dgetri(n int, a []float64, lda int, ipiv []int, work
 	iws := max(1, n)
 	switch {
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dgetri", Param: "n", Message: nLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dgetri", Param: "lda", Message: badLdA})
 	case lwork < iws && lwork != -1:
-		panic(badLWork)
+		panic(Error{Routine: "Dgetri", Param: "lwork", Message: badLWork})
 	case len(work) < max(1, lwork):
-		panic(shortWork)
+		panic(Error{Routine: "Dgetri", Param: "work", Message: shortWork})
 	}
 
 	if n == 0 {
@@ -1979,18 +3039,18 @@ func (impl Implementation) Dgetri(n int, a []float64, lda int, ipiv []int, work
 
 	switch {
 	case len(a) < (n-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dgetri", Param: "a", Message: shortA})
 	case len(ipiv) != n:
-		panic(badLenIpiv)
+		panic(Error{Routine: "Dgetri", Param: "ipiv", Message: badLenIpiv})
 	}
 
-	ipiv32 := make([]int32, n)
+	ipiv32 := make([]lapacke.Int, n)
 	for i, v := range ipiv {
 		v++ // Transform to one-indexed.
-		if v != int(int32(v)) {
-			panic("lapack: ipiv element out of range")
+		if v != int(lapacke.Int(v)) {
+			panic(Error{Routine: "Dgetri", Param: "ipiv", Message: "lapack: ipiv element out of range"})
 		}
-		ipiv32[i] = int32(v)
+		ipiv32[i] = lapacke.Int(v)
 	}
 	return lapacke.Dgetri(n, a, lda, ipiv32, work, lwork)
 }
@@ -2009,15 +3069,15 @@ func (impl Implementation) Dgetri(n int, a []float64, lda int, ipiv []int, work
 func (impl Implementation) Dgetrs(trans blas.Transpose, n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int) {
 	switch {
 	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
-		panic(badTrans)
+		panic(Error{Routine: "Dgetrs", Param: "trans", Message: badTrans})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dgetrs", Param: "n", Message: nLT0})
 	case nrhs < 0:
-		panic(nrhsLT0)
+		panic(Error{Routine: "Dgetrs", Param: "nrhs", Message: nrhsLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dgetrs", Param: "lda", Message: badLdA})
 	case ldb < max(1, nrhs):
-		panic(badLdB)
+		panic(Error{Routine: "Dgetrs", Param: "ldb", Message: badLdB})
 	}
 
 	// Quick return if possible.
@@ -2027,20 +3087,20 @@ func (impl Implementation) Dgetrs(trans blas.Transpose, n, nrhs int, a []float64
 
 	switch {
 	case len(a) < (n-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dgetrs", Param: "a", Message: shortA})
 	case len(b) < (n-1)*ldb+nrhs:
-		panic(shortB)
+		panic(Error{Routine: "Dgetrs", Param: "b", Message: shortB})
 	case len(ipiv) != n:
-		panic(badLenIpiv)
+		panic(Error{Routine: "Dgetrs", Param: "ipiv", Message: badLenIpiv})
 	}
 
-	ipiv32 := make([]int32, n)
+	ipiv32 := make([]lapacke.Int, n)
 	for i, v := range ipiv {
 		v++ // Transform to one-indexed.
-		if v != int(int32(v)) {
-			panic("lapack: ipiv element out of range")
+		if v != int(lapacke.Int(v)) {
+			panic(Error{Routine: "Dgetrs", Param: "ipiv", Message: "lapack: ipiv element out of range"})
 		}
-		ipiv32[i] = int32(v)
+		ipiv32[i] = lapacke.Int(v)
 	}
 	lapacke.Dgetrs(byte(trans), n, nrhs, a, lda, ipiv32, b, ldb)
 }
@@ -2147,33 +3207,33 @@ func (impl Implementation) Dggsvd3(jobU, jobV, jobQ lapack.GSVDJob, m, n, p int,
 	wantq := jobQ == lapack.GSVDQ
 	switch {
 	case !wantu && jobU != lapack.GSVDNone:
-		panic(badGSVDJob + "U")
+		panic(Error{Routine: "Dggsvd3", Param: "jobU", Message: badGSVDJob + "U"})
 	case !wantv && jobV != lapack.GSVDNone:
-		panic(badGSVDJob + "V")
+		panic(Error{Routine: "Dggsvd3", Param: "jobV", Message: badGSVDJob + "V"})
 	case !wantq && jobQ != lapack.GSVDNone:
-		panic(badGSVDJob + "Q")
+		panic(Error{Routine: "Dggsvd3", Param: "jobQ", Message: badGSVDJob + "Q"})
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dggsvd3", Param: "m", Message: mLT0})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dggsvd3", Param: "n", Message: nLT0})
 	case p < 0:
-		panic(pLT0)
+		panic(Error{Routine: "Dggsvd3", Param: "p", Message: pLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dggsvd3", Param: "lda", Message: badLdA})
 	case ldb < max(1, n):
-		panic(badLdB)
+		panic(Error{Routine: "Dggsvd3", Param: "ldb", Message: badLdB})
 	case ldu < 1, wantu && ldu < m:
-		panic(badLdU)
+		panic(Error{Routine: "Dggsvd3", Param: "ldu", Message: badLdU})
 	case ldv < 1, wantv && ldv < p:
-		panic(badLdV)
+		panic(Error{Routine: "Dggsvd3", Param: "ldv", Message: badLdV})
 	case ldq < 1, wantq && ldq < n:
-		panic(badLdQ)
+		panic(Error{Routine: "Dggsvd3", Param: "ldq", Message: badLdQ})
 	case len(iwork) < n:
-		panic(shortWork)
+		panic(Error{Routine: "Dggsvd3", Param: "iwork", Message: shortWork})
 	case lwork < 1 && lwork != -1:
-		panic(badLWork)
+		panic(Error{Routine: "Dggsvd3", Param: "lwork", Message: badLWork})
 	case len(work) < max(1, lwork):
-		panic(shortWork)
+		panic(Error{Routine: "Dggsvd3", Param: "work", Message: shortWork})
 	}
 
 	// Determine optimal work length.
@@ -2184,24 +3244,24 @@ func (impl Implementation) Dggsvd3(jobU, jobV, jobQ lapack.GSVDJob, m, n, p int,
 
 	switch {
 	case len(a) < (m-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dggsvd3", Param: "a", Message: shortA})
 	case len(b) < (p-1)*ldb+n:
-		panic(shortB)
+		panic(Error{Routine: "Dggsvd3", Param: "b", Message: shortB})
 	case wantu && len(u) < (m-1)*ldu+m:
-		panic(shortU)
+		panic(Error{Routine: "Dggsvd3", Param: "u", Message: shortU})
 	case wantv && len(v) < (p-1)*ldv+p:
-		panic(shortV)
+		panic(Error{Routine: "Dggsvd3", Param: "v", Message: shortV})
 	case wantq && len(q) < (n-1)*ldq+n:
-		panic(shortQ)
+		panic(Error{Routine: "Dggsvd3", Param: "q", Message: shortQ})
 	case len(alpha) != n:
-		panic(badLenAlpha)
+		panic(Error{Routine: "Dggsvd3", Param: "alpha", Message: badLenAlpha})
 	case len(beta) != n:
-		panic(badLenBeta)
+		panic(Error{Routine: "Dggsvd3", Param: "beta", Message: badLenBeta})
 	}
 
-	_k := []int32{0}
-	_l := []int32{0}
-	_iwork := make([]int32, n)
+	_k := []lapacke.Int{0}
+	_l := []lapacke.Int{0}
+	_iwork := make([]lapacke.Int, n)
 	ok = lapacke.Dggsvd3(byte(jobU), byte(jobV), byte(jobQ), m, n, p, _k, _l, a, lda, b, ldb, alpha, beta, u, ldu, v, ldv, q, ldq, work, lwork, _iwork)
 	for i, v := range _iwork {
 		iwork[i] = int(v - 1)
@@ -2258,33 +3318,33 @@ func (impl Implementation) Dggsvp3(jobU, jobV, jobQ lapack.GSVDJob, m, p, n int,
 	wantq := jobQ == lapack.GSVDQ
 	switch {
 	case !wantu && jobU != lapack.GSVDNone:
-		panic(badGSVDJob + "U")
+		panic(Error{Routine: "Dggsvp3", Param: "jobU", Message: badGSVDJob + "U"})
 	case !wantv && jobV != lapack.GSVDNone:
-		panic(badGSVDJob + "V")
+		panic(Error{Routine: "Dggsvp3", Param: "jobV", Message: badGSVDJob + "V"})
 	case !wantq && jobQ != lapack.GSVDNone:
-		panic(badGSVDJob + "Q")
+		panic(Error{Routine: "Dggsvp3", Param: "jobQ", Message: badGSVDJob + "Q"})
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dggsvp3", Param: "m", Message: mLT0})
 	case p < 0:
-		panic(pLT0)
+		panic(Error{Routine: "Dggsvp3", Param: "p", Message: pLT0})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dggsvp3", Param: "n", Message: nLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dggsvp3", Param: "lda", Message: badLdA})
 	case ldb < max(1, n):
-		panic(badLdB)
+		panic(Error{Routine: "Dggsvp3", Param: "ldb", Message: badLdB})
 	case ldu < 1, wantu && ldu < m:
-		panic(badLdU)
+		panic(Error{Routine: "Dggsvp3", Param: "ldu", Message: badLdU})
 	case ldv < 1, wantv && ldv < p:
-		panic(badLdV)
+		panic(Error{Routine: "Dggsvp3", Param: "ldv", Message: badLdV})
 	case ldq < 1, wantq && ldq < n:
-		panic(badLdQ)
+		panic(Error{Routine: "Dggsvp3", Param: "ldq", Message: badLdQ})
 	case len(iwork) != n:
-		panic(shortWork)
+		panic(Error{Routine: "Dggsvp3", Param: "iwork", Message: shortWork})
 	case lwork < 1 && lwork != -1:
-		panic(badLWork)
+		panic(Error{Routine: "Dggsvp3", Param: "lwork", Message: badLWork})
 	case len(work) < max(1, lwork):
-		panic(shortWork)
+		panic(Error{Routine: "Dggsvp3", Param: "work", Message: shortWork})
 	}
 
 	if lwork == -1 {
@@ -2294,29 +3354,260 @@ func (impl Implementation) Dggsvp3(jobU, jobV, jobQ lapack.GSVDJob, m, p, n int,
 
 	switch {
 	case len(a) < (m-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dggsvp3", Param: "a", Message: shortA})
 	case len(b) < (p-1)*ldb+n:
-		panic(shortB)
+		panic(Error{Routine: "Dggsvp3", Param: "b", Message: shortB})
 	case wantu && len(u) < (m-1)*ldu+m:
-		panic(shortU)
+		panic(Error{Routine: "Dggsvp3", Param: "u", Message: shortU})
 	case wantv && len(v) < (p-1)*ldv+p:
-		panic(shortV)
+		panic(Error{Routine: "Dggsvp3", Param: "v", Message: shortV})
 	case wantq && len(q) < (n-1)*ldq+n:
-		panic(shortQ)
+		panic(Error{Routine: "Dggsvp3", Param: "q", Message: shortQ})
 	case len(tau) < n:
 		// tau check must come after lwkopt query since
 		// the Dggsvd3 call for lwkopt query may have
 		// lwork == -1, and tau is provided by work.
-		panic(shortTau)
+		panic(Error{Routine: "Dggsvp3", Param: "tau", Message: shortTau})
 	}
 
-	_k := []int32{0}
-	_l := []int32{0}
-	_iwork := make([]int32, n)
+	_k := []lapacke.Int{0}
+	_l := []lapacke.Int{0}
+	_iwork := make([]lapacke.Int, n)
 	lapacke.Dggsvp3(byte(jobU), byte(jobV), byte(jobQ), m, p, n, a, lda, b, ldb, tola, tolb, _k, _l, u, ldu, v, ldv, q, ldq, _iwork, tau, work, lwork)
 	return int(_k[0]), int(_l[0])
 }
 
+// Dgtsv computes the solution to the system of linear equations
+//  A * X = B,
+// where A is an n×n tridiagonal matrix and X and B are n×nrhs matrices, by
+// Gaussian elimination with partial pivoting.
+//
+// On entry, dl, d and du hold the n-1 sub-diagonal, n diagonal and n-1
+// super-diagonal elements of A. On return, they are overwritten by the
+// factors of the LU factorization of A: dl holds the n-2 elements of the
+// second super-diagonal of U in its first n-2 elements, d the diagonal of U
+// and du the first super-diagonal of U.
+//
+// On entry, b holds the n×nrhs right hand side matrix B, and on return it is
+// overwritten by the solution X. If U is exactly singular, Dgtsv returns
+// false and the solution is not computed.
+func (impl Implementation) Dgtsv(n, nrhs int, dl, d, du, b []float64, ldb int) (ok bool) {
+	switch {
+	case n < 0:
+		panic(Error{Routine: "Dgtsv", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Dgtsv", Param: "nrhs", Message: nrhsLT0})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Dgtsv", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(dl) < n-1:
+		panic(Error{Routine: "Dgtsv", Param: "dl", Message: shortDL})
+	case len(d) < n:
+		panic(Error{Routine: "Dgtsv", Param: "d", Message: shortD})
+	case len(du) < n-1:
+		panic(Error{Routine: "Dgtsv", Param: "du", Message: shortDU})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Dgtsv", Param: "b", Message: shortB})
+	}
+
+	return lapacke.Dgtsv(n, nrhs, dl, d, du, b, ldb)
+}
+
+// Dgttrf computes the LU factorization with partial pivoting
+//  A = L * U
+// of an n×n tridiagonal matrix A, where L is a product of permutation and
+// unit lower bidiagonal matrices and U is upper triangular with non-zero
+// elements only on the diagonal and the first two super-diagonals.
+//
+// On entry, dl, d and du hold the n-1 sub-diagonal, n diagonal and n-1
+// super-diagonal elements of A. On return, dl holds the multipliers of L, d
+// the diagonal of U and du the first super-diagonal of U, and du2, which
+// must have length at least n-2, holds the second super-diagonal of U. ipiv
+// holds the zero-indexed row interchanges and must have length n.
+//
+// Dgttrf returns whether U is non-singular. If it is exactly singular, the
+// factorization is completed, but U cannot be used to solve a system of
+// equations.
+func (impl Implementation) Dgttrf(n int, dl, d, du, du2 []float64, ipiv []int) (ok bool) {
+	if n < 0 {
+		panic(Error{Routine: "Dgttrf", Param: "n", Message: nLT0})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(dl) < n-1:
+		panic(Error{Routine: "Dgttrf", Param: "dl", Message: shortDL})
+	case len(d) < n:
+		panic(Error{Routine: "Dgttrf", Param: "d", Message: shortD})
+	case len(du) < n-1:
+		panic(Error{Routine: "Dgttrf", Param: "du", Message: shortDU})
+	case len(du2) < n-2:
+		panic(Error{Routine: "Dgttrf", Param: "du2", Message: shortDU2})
+	case len(ipiv) != n:
+		panic(Error{Routine: "Dgttrf", Param: "ipiv", Message: badLenIpiv})
+	}
+
+	ipiv32 := make([]lapacke.Int, n)
+	ok = lapacke.Dgttrf(n, dl, d, du, du2, ipiv32)
+	for i, v := range ipiv32 {
+		ipiv[i] = int(v) - 1 // Transform to zero-indexed.
+	}
+	return ok
+}
+
+// Dgttrs solves a system of linear equations
+//  A * X = B    if trans == blas.NoTrans,
+//  A^T * X = B  if trans == blas.Trans or blas.ConjTrans,
+// where A is an n×n tridiagonal matrix, using the LU factorization computed
+// by Dgttrf. dl, d, du, du2 and ipiv hold the factors and the zero-indexed
+// row interchanges as returned by Dgttrf.
+//
+// On entry, b holds the n×nrhs right hand side matrix B, and on return it is
+// overwritten by the solution X.
+func (impl Implementation) Dgttrs(trans blas.Transpose, n, nrhs int, dl, d, du, du2 []float64, ipiv []int, b []float64, ldb int) {
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(Error{Routine: "Dgttrs", Param: "trans", Message: badTrans})
+	case n < 0:
+		panic(Error{Routine: "Dgttrs", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Dgttrs", Param: "nrhs", Message: nrhsLT0})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Dgttrs", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(dl) < n-1:
+		panic(Error{Routine: "Dgttrs", Param: "dl", Message: shortDL})
+	case len(d) < n:
+		panic(Error{Routine: "Dgttrs", Param: "d", Message: shortD})
+	case len(du) < n-1:
+		panic(Error{Routine: "Dgttrs", Param: "du", Message: shortDU})
+	case len(du2) < n-2:
+		panic(Error{Routine: "Dgttrs", Param: "du2", Message: shortDU2})
+	case len(ipiv) != n:
+		panic(Error{Routine: "Dgttrs", Param: "ipiv", Message: badLenIpiv})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Dgttrs", Param: "b", Message: shortB})
+	}
+
+	ipiv32 := make([]lapacke.Int, n)
+	for i, v := range ipiv {
+		v++ // Transform to one-indexed.
+		if v != int(lapacke.Int(v)) {
+			panic(Error{Routine: "Dgttrs", Param: "ipiv", Message: "lapack: ipiv element out of range"})
+		}
+		ipiv32[i] = lapacke.Int(v)
+	}
+	lapacke.Dgttrs(byte(trans), n, nrhs, dl, d, du, du2, ipiv32, b, ldb)
+}
+
+// Dorcsd2by1 computes the CS decomposition of the m×q matrix X with
+// orthonormal columns, partitioned into the p×q block X11 and the (m-p)×q
+// block X21,
+//  X11 = U1 * D11 * V1^T,
+//  X21 = U2 * D21 * V1^T,
+// where U1, U2 and V1 are orthogonal matrices of order p, m-p and q, and
+// D11 and D21 are diagonal matrices padded with zero rows and columns. D11
+// holds the cosines and D21 the sines of r = min(p, m-p, q, m-q) angles in
+// [0, π/2], together with ones and zeros. The cosines are the singular values
+// of X11 that are not 0 or 1, so that with X11 = Q_A^T * Q_B for matrices Q_A
+// and Q_B with orthonormal columns, the angles are the nontrivial principal
+// angles between their column spaces.
+//
+// On entry, x11 and x21 hold the blocks of X. On return their contents are
+// destroyed and theta, which must have length r, holds the angles in
+// increasing order.
+//
+// U1, U2 and V1^T are computed into u1, u2 and v1t if the corresponding job
+// is CSDCompute, and u1, u2 and v1t are not referenced if it is CSDNone.
+//
+// lwork must be -1 or at least 1, and work must have length at least
+// max(1, lwork). If lwork is -1, instead of performing the decomposition
+// the optimal lwork is stored into work[0]. The minimum workspace depends
+// on the dimensions in a complicated way, so lwork is usually obtained by
+// a workspace query.
+//
+// Dorcsd2by1 returns whether the decomposition converged.
+func (impl Implementation) Dorcsd2by1(jobU1, jobU2, jobV1T CSDJob, m, p, q int, x11 []float64, ldx11 int, x21 []float64, ldx21 int, theta, u1 []float64, ldu1 int, u2 []float64, ldu2 int, v1t []float64, ldv1t int, work []float64, lwork int) (ok bool) {
+	wantu1 := jobU1 == CSDCompute
+	wantu2 := jobU2 == CSDCompute
+	wantv1t := jobV1T == CSDCompute
+	switch {
+	case !wantu1 && jobU1 != CSDNone:
+		panic(Error{Routine: "Dorcsd2by1", Param: "jobU1", Message: badCSDJob})
+	case !wantu2 && jobU2 != CSDNone:
+		panic(Error{Routine: "Dorcsd2by1", Param: "jobU2", Message: badCSDJob})
+	case !wantv1t && jobV1T != CSDNone:
+		panic(Error{Routine: "Dorcsd2by1", Param: "jobV1T", Message: badCSDJob})
+	case m < 0:
+		panic(Error{Routine: "Dorcsd2by1", Param: "m", Message: mLT0})
+	case p < 0 || m < p:
+		panic(Error{Routine: "Dorcsd2by1", Param: "p", Message: badCSDP})
+	case q < 0 || m < q:
+		panic(Error{Routine: "Dorcsd2by1", Param: "q", Message: badCSDQ})
+	case ldx11 < max(1, q):
+		panic(Error{Routine: "Dorcsd2by1", Param: "ldx11", Message: badLdX})
+	case ldx21 < max(1, q):
+		panic(Error{Routine: "Dorcsd2by1", Param: "ldx21", Message: badLdX})
+	case ldu1 < 1, wantu1 && ldu1 < p:
+		panic(Error{Routine: "Dorcsd2by1", Param: "ldu1", Message: badLdU})
+	case ldu2 < 1, wantu2 && ldu2 < m-p:
+		panic(Error{Routine: "Dorcsd2by1", Param: "ldu2", Message: badLdU})
+	case ldv1t < 1, wantv1t && ldv1t < q:
+		panic(Error{Routine: "Dorcsd2by1", Param: "ldv1t", Message: badLdV})
+	case lwork < 1 && lwork != -1:
+		panic(Error{Routine: "Dorcsd2by1", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Dorcsd2by1", Param: "work", Message: shortWork})
+	}
+
+	// Quick return if possible.
+	if m == 0 {
+		work[0] = 1
+		return true
+	}
+
+	if lwork == -1 {
+		return lapacke.Dorcsd2by1(byte(jobU1), byte(jobU2), byte(jobV1T), m, p, q, x11, ldx11, x21, ldx21, theta, u1, ldu1, u2, ldu2, v1t, ldv1t, work, -1, nil)
+	}
+
+	r := csdRank(m, p, q)
+	switch {
+	case len(x11) < (p-1)*ldx11+q:
+		panic(Error{Routine: "Dorcsd2by1", Param: "x11", Message: shortX})
+	case len(x21) < (m-p-1)*ldx21+q:
+		panic(Error{Routine: "Dorcsd2by1", Param: "x21", Message: shortX})
+	case len(theta) != r:
+		panic(Error{Routine: "Dorcsd2by1", Param: "theta", Message: shortTheta})
+	case wantu1 && len(u1) < (p-1)*ldu1+p:
+		panic(Error{Routine: "Dorcsd2by1", Param: "u1", Message: shortU})
+	case wantu2 && len(u2) < (m-p-1)*ldu2+m-p:
+		panic(Error{Routine: "Dorcsd2by1", Param: "u2", Message: shortU})
+	case wantv1t && len(v1t) < (q-1)*ldv1t+q:
+		panic(Error{Routine: "Dorcsd2by1", Param: "v1t", Message: shortV})
+	}
+
+	_iwork := make([]lapacke.Int, max(1, m-r))
+	return lapacke.Dorcsd2by1(byte(jobU1), byte(jobU2), byte(jobV1T), m, p, q, x11, ldx11, x21, ldx21, theta, u1, ldu1, u2, ldu2, v1t, ldv1t, work, lwork, _iwork)
+}
+
 // Dorgbr generates one of the matrices Q or P^T computed by Dgebrd.
 // See Dgebrd for the description of Q and P^T.
 //
@@ -2332,27 +3623,27 @@ func (impl Implementation) Dorgbr(vect lapack.GenOrtho, m, n, k int, a []float64
 	mn := min(m, n)
 	switch {
 	case vect != lapack.GenerateQ && vect != lapack.GeneratePT:
-		panic(badGenOrtho)
+		panic(Error{Routine: "Dorgbr", Param: "vect", Message: badGenOrtho})
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dorgbr", Param: "m", Message: mLT0})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dorgbr", Param: "n", Message: nLT0})
 	case k < 0:
-		panic(kLT0)
+		panic(Error{Routine: "Dorgbr", Param: "k", Message: kLT0})
 	case wantq && n > m:
-		panic(nGTM)
+		panic(Error{Routine: "Dorgbr", Param: "n", Message: nGTM})
 	case wantq && n < min(m, k):
-		panic("lapack: n < min(m,k)")
+		panic(Error{Routine: "Dorgbr", Param: "n", Message: "lapack: n < min(m,k)"})
 	case !wantq && m > n:
-		panic(mGTN)
+		panic(Error{Routine: "Dorgbr", Param: "m", Message: mGTN})
 	case !wantq && m < min(n, k):
-		panic("lapack: m < min(n,k)")
+		panic(Error{Routine: "Dorgbr", Param: "m", Message: "lapack: m < min(n,k)"})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dorgbr", Param: "lda", Message: badLdA})
 	case lwork < max(1, mn) && lwork != -1:
-		panic(badLWork)
+		panic(Error{Routine: "Dorgbr", Param: "lwork", Message: badLWork})
 	case len(work) < max(1, lwork):
-		panic(shortWork)
+		panic(Error{Routine: "Dorgbr", Param: "work", Message: shortWork})
 	}
 
 	// Quick return if possible.
@@ -2368,11 +3659,11 @@ func (impl Implementation) Dorgbr(vect lapack.GenOrtho, m, n, k int, a []float64
 
 	switch {
 	case len(a) < (m-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dorgbr", Param: "a", Message: shortA})
 	case wantq && len(tau) < min(m, k):
-		panic(shortTau)
+		panic(Error{Routine: "Dorgbr", Param: "tau", Message: shortTau})
 	case !wantq && len(tau) < min(n, k):
-		panic(shortTau)
+		panic(Error{Routine: "Dorgbr", Param: "tau", Message: shortTau})
 	}
 
 	lapacke.Dorgbr(byte(vect), m, n, k, a, lda, tau, work, lwork)
@@ -2410,15 +3701,15 @@ func (impl Implementation) Dorghr(n, ilo, ihi int, a []float64, lda int, tau, wo
 	nh := ihi - ilo
 	switch {
 	case ilo < 0 || max(1, n) <= ilo:
-		panic(badIlo)
+		panic(Error{Routine: "Dorghr", Param: "ilo", Message: badIlo})
 	case ihi < min(ilo, n-1) || n <= ihi:
-		panic(badIhi)
+		panic(Error{Routine: "Dorghr", Param: "ihi", Message: badIhi})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dorghr", Param: "lda", Message: badLdA})
 	case lwork < max(1, nh) && lwork != -1:
-		panic(badLWork)
+		panic(Error{Routine: "Dorghr", Param: "lwork", Message: badLWork})
 	case len(work) < max(1, lwork):
-		panic(shortWork)
+		panic(Error{Routine: "Dorghr", Param: "work", Message: shortWork})
 	}
 
 	// Quick return if possible.
@@ -2434,9 +3725,9 @@ func (impl Implementation) Dorghr(n, ilo, ihi int, a []float64, lda int, tau, wo
 
 	switch {
 	case len(a) < (n-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dorghr", Param: "a", Message: shortA})
 	case len(tau) < n-1:
-		panic(shortTau)
+		panic(Error{Routine: "Dorghr", Param: "tau", Message: shortTau})
 	}
 
 	lapacke.Dorghr(n, ilo+1, ihi+1, a, lda, tau, work, lwork)
@@ -2461,19 +3752,19 @@ func (impl Implementation) Dorghr(n, ilo, ihi int, a []float64, lda int, tau, wo
 func (impl Implementation) Dorglq(m, n, k int, a []float64, lda int, tau, work []float64, lwork int) {
 	switch {
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dorglq", Param: "m", Message: mLT0})
 	case n < m:
-		panic(nLTM)
+		panic(Error{Routine: "Dorglq", Param: "n", Message: nLTM})
 	case k < 0:
-		panic(kLT0)
+		panic(Error{Routine: "Dorglq", Param: "k", Message: kLT0})
 	case k > m:
-		panic(kGTM)
+		panic(Error{Routine: "Dorglq", Param: "k", Message: kGTM})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dorglq", Param: "lda", Message: badLdA})
 	case lwork < max(1, m) && lwork != -1:
-		panic(badLWork)
+		panic(Error{Routine: "Dorglq", Param: "lwork", Message: badLWork})
 	case len(work) < max(1, lwork):
-		panic(shortWork)
+		panic(Error{Routine: "Dorglq", Param: "work", Message: shortWork})
 	}
 
 	if m == 0 {
@@ -2488,9 +3779,9 @@ func (impl Implementation) Dorglq(m, n, k int, a []float64, lda int, tau, work [
 
 	switch {
 	case len(a) < (m-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dorglq", Param: "a", Message: shortA})
 	case len(tau) < k:
-		panic(shortTau)
+		panic(Error{Routine: "Dorglq", Param: "tau", Message: shortTau})
 	}
 
 	lapacke.Dorglq(m, n, k, a, lda, tau, work, lwork)
@@ -2521,21 +3812,21 @@ func (impl Implementation) Dorglq(m, n, k int, a []float64, lda int, tau, work [
 func (impl Implementation) Dorgql(m, n, k int, a []float64, lda int, tau, work []float64, lwork int) {
 	switch {
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dorgql", Param: "m", Message: mLT0})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dorgql", Param: "n", Message: nLT0})
 	case n > m:
-		panic(nGTM)
+		panic(Error{Routine: "Dorgql", Param: "n", Message: nGTM})
 	case k < 0:
-		panic(kLT0)
+		panic(Error{Routine: "Dorgql", Param: "k", Message: kLT0})
 	case k > n:
-		panic(kGTN)
+		panic(Error{Routine: "Dorgql", Param: "k", Message: kGTN})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dorgql", Param: "lda", Message: badLdA})
 	case lwork < max(1, n) && lwork != -1:
-		panic(badLWork)
+		panic(Error{Routine: "Dorgql", Param: "lwork", Message: badLWork})
 	case len(work) < max(1, lwork):
-		panic(shortWork)
+		panic(Error{Routine: "Dorgql", Param: "work", Message: shortWork})
 	}
 
 	// Quick return if possible.
@@ -2551,9 +3842,9 @@ func (impl Implementation) Dorgql(m, n, k int, a []float64, lda int, tau, work [
 
 	switch {
 	case len(a) < (m-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dorgql", Param: "a", Message: shortA})
 	case len(tau) < k:
-		panic(shortTau)
+		panic(Error{Routine: "Dorgql", Param: "tau", Message: shortTau})
 	}
 
 	lapacke.Dorgql(m, n, k, a, lda, tau, work, lwork)
@@ -2579,21 +3870,21 @@ func (impl Implementation) Dorgql(m, n, k int, a []float64, lda int, tau, work [
 func (impl Implementation) Dorgqr(m, n, k int, a []float64, lda int, tau, work []float64, lwork int) {
 	switch {
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dorgqr", Param: "m", Message: mLT0})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dorgqr", Param: "n", Message: nLT0})
 	case n > m:
-		panic(nGTM)
+		panic(Error{Routine: "Dorgqr", Param: "n", Message: nGTM})
 	case k < 0:
-		panic(kLT0)
+		panic(Error{Routine: "Dorgqr", Param: "k", Message: kLT0})
 	case k > n:
-		panic(kGTN)
+		panic(Error{Routine: "Dorgqr", Param: "k", Message: kGTN})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dorgqr", Param: "lda", Message: badLdA})
 	case lwork < max(1, n) && lwork != -1:
-		panic(badLWork)
+		panic(Error{Routine: "Dorgqr", Param: "lwork", Message: badLWork})
 	case len(work) < max(1, lwork):
-		panic(shortWork)
+		panic(Error{Routine: "Dorgqr", Param: "work", Message: shortWork})
 	}
 
 	if n == 0 {
@@ -2608,9 +3899,9 @@ func (impl Implementation) Dorgqr(m, n, k int, a []float64, lda int, tau, work [
 
 	switch {
 	case len(a) < (m-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dorgqr", Param: "a", Message: shortA})
 	case len(tau) < k:
-		panic(shortTau)
+		panic(Error{Routine: "Dorgqr", Param: "tau", Message: shortTau})
 	}
 
 	lapacke.Dorgqr(m, n, k, a, lda, tau, work, lwork)
@@ -2637,15 +3928,15 @@ func (impl Implementation) Dorgqr(m, n, k int, a []float64, lda int, tau, work [
 func (impl Implementation) Dorgtr(uplo blas.Uplo, n int, a []float64, lda int, tau, work []float64, lwork int) {
 	switch {
 	case uplo != blas.Upper && uplo != blas.Lower:
-		panic(badUplo)
+		panic(Error{Routine: "Dorgtr", Param: "uplo", Message: badUplo})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dorgtr", Param: "n", Message: nLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dorgtr", Param: "lda", Message: badLdA})
 	case lwork < max(1, n-1) && lwork != -1:
-		panic(badLWork)
+		panic(Error{Routine: "Dorgtr", Param: "lwork", Message: badLWork})
 	case len(work) < max(1, lwork):
-		panic(shortWork)
+		panic(Error{Routine: "Dorgtr", Param: "work", Message: shortWork})
 	}
 
 	if n == 0 {
@@ -2660,9 +3951,9 @@ func (impl Implementation) Dorgtr(uplo blas.Uplo, n int, a []float64, lda int, t
 
 	switch {
 	case len(a) < (n-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dorgtr", Param: "a", Message: shortA})
 	case len(tau) < n-1:
-		panic(shortTau)
+		panic(Error{Routine: "Dorgtr", Param: "tau", Message: shortTau})
 	}
 
 	lapacke.Dorgtr(byte(uplo), n, a, lda, tau, work, lwork)
@@ -2713,27 +4004,27 @@ func (impl Implementation) Dormbr(vect lapack.ApplyOrtho, side blas.Side, trans
 	applyQ := vect == lapack.ApplyQ
 	switch {
 	case !applyQ && vect != lapack.ApplyP:
-		panic(badApplyOrtho)
+		panic(Error{Routine: "Dormbr", Param: "vect", Message: badApplyOrtho})
 	case side != blas.Left && side != blas.Right:
-		panic(badSide)
+		panic(Error{Routine: "Dormbr", Param: "side", Message: badSide})
 	case trans != blas.NoTrans && trans != blas.Trans:
-		panic(badTrans)
+		panic(Error{Routine: "Dormbr", Param: "trans", Message: badTrans})
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dormbr", Param: "m", Message: mLT0})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dormbr", Param: "n", Message: nLT0})
 	case k < 0:
-		panic(kLT0)
+		panic(Error{Routine: "Dormbr", Param: "k", Message: kLT0})
 	case applyQ && lda < max(1, min(nq, k)):
-		panic(badLdA)
+		panic(Error{Routine: "Dormbr", Param: "lda", Message: badLdA})
 	case !applyQ && lda < max(1, nq):
-		panic(badLdA)
+		panic(Error{Routine: "Dormbr", Param: "lda", Message: badLdA})
 	case ldc < max(1, n):
-		panic(badLdC)
+		panic(Error{Routine: "Dormbr", Param: "ldc", Message: badLdC})
 	case lwork < max(1, nw) && lwork != -1:
-		panic(badLWork)
+		panic(Error{Routine: "Dormbr", Param: "lwork", Message: badLWork})
 	case len(work) < max(1, lwork):
-		panic(shortWork)
+		panic(Error{Routine: "Dormbr", Param: "work", Message: shortWork})
 	}
 
 	// Quick return if possible.
@@ -2750,13 +4041,13 @@ func (impl Implementation) Dormbr(vect lapack.ApplyOrtho, side blas.Side, trans
 	minnqk := min(nq, k)
 	switch {
 	case applyQ && len(a) < (nq-1)*lda+minnqk:
-		panic(shortA)
+		panic(Error{Routine: "Dormbr", Param: "a", Message: shortA})
 	case !applyQ && len(a) < (minnqk-1)*lda+nq:
-		panic(shortA)
+		panic(Error{Routine: "Dormbr", Param: "a", Message: shortA})
 	case len(tau) < minnqk:
-		panic(shortTau)
+		panic(Error{Routine: "Dormbr", Param: "tau", Message: shortTau})
 	case len(c) < (m-1)*ldc+n:
-		panic(shortC)
+		panic(Error{Routine: "Dormbr", Param: "c", Message: shortC})
 	}
 
 	lapacke.Dormbr(byte(vect), byte(side), byte(trans), m, n, k, a, lda, tau, c, ldc, work, lwork)
@@ -2814,23 +4105,23 @@ func (impl Implementation) Dormhr(side blas.Side, trans blas.Transpose, m, n, il
 	}
 	switch {
 	case side != blas.Left && side != blas.Right:
-		panic(badSide)
+		panic(Error{Routine: "Dormhr", Param: "side", Message: badSide})
 	case trans != blas.NoTrans && trans != blas.Trans:
-		panic(badTrans)
+		panic(Error{Routine: "Dormhr", Param: "trans", Message: badTrans})
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dormhr", Param: "m", Message: mLT0})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dormhr", Param: "n", Message: nLT0})
 	case ilo < 0 || max(1, nq) <= ilo:
-		panic(badIlo)
+		panic(Error{Routine: "Dormhr", Param: "ilo", Message: badIlo})
 	case ihi < min(ilo, nq-1) || nq <= ihi:
-		panic(badIhi)
+		panic(Error{Routine: "Dormhr", Param: "ihi", Message: badIhi})
 	case lda < max(1, nq):
-		panic(badLdA)
+		panic(Error{Routine: "Dormhr", Param: "lda", Message: badLdA})
 	case lwork < max(1, nw) && lwork != -1:
-		panic(badLWork)
+		panic(Error{Routine: "Dormhr", Param: "lwork", Message: badLWork})
 	case len(work) < max(1, lwork):
-		panic(shortWork)
+		panic(Error{Routine: "Dormhr", Param: "work", Message: shortWork})
 	}
 
 	// Quick return if possible.
@@ -2846,11 +4137,11 @@ func (impl Implementation) Dormhr(side blas.Side, trans blas.Transpose, m, n, il
 
 	switch {
 	case len(a) < (nq-1)*lda+nq:
-		panic(shortA)
+		panic(Error{Routine: "Dormhr", Param: "a", Message: shortA})
 	case len(c) < (m-1)*ldc+n:
-		panic(shortC)
+		panic(Error{Routine: "Dormhr", Param: "c", Message: shortC})
 	case len(tau) != nq-1:
-		panic(badLenTau)
+		panic(Error{Routine: "Dormhr", Param: "tau", Message: badLenTau})
 	}
 
 	lapacke.Dormhr(byte(side), byte(trans), m, n, ilo+1, ihi+1, a, lda, tau, c, ldc, work, lwork)
@@ -2882,27 +4173,27 @@ func (impl Implementation) Dormlq(side blas.Side, trans blas.Transpose, m, n, k
 	}
 	switch {
 	case !left && side != blas.Right:
-		panic(badSide)
+		panic(Error{Routine: "Dormlq", Param: "side", Message: badSide})
 	case trans != blas.Trans && trans != blas.NoTrans:
-		panic(badTrans)
+		panic(Error{Routine: "Dormlq", Param: "trans", Message: badTrans})
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dormlq", Param: "m", Message: mLT0})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dormlq", Param: "n", Message: nLT0})
 	case k < 0:
-		panic(kLT0)
+		panic(Error{Routine: "Dormlq", Param: "k", Message: kLT0})
 	case left && k > m:
-		panic(kGTM)
+		panic(Error{Routine: "Dormlq", Param: "k", Message: kGTM})
 	case !left && k > n:
-		panic(kGTN)
+		panic(Error{Routine: "Dormlq", Param: "k", Message: kGTN})
 	case left && lda < max(1, m):
-		panic(badLdA)
+		panic(Error{Routine: "Dormlq", Param: "lda", Message: badLdA})
 	case !left && lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dormlq", Param: "lda", Message: badLdA})
 	case lwork < max(1, nw) && lwork != -1:
-		panic(badLWork)
+		panic(Error{Routine: "Dormlq", Param: "lwork", Message: badLWork})
 	case len(work) < max(1, lwork):
-		panic(shortWork)
+		panic(Error{Routine: "Dormlq", Param: "work", Message: shortWork})
 	}
 
 	// Quick return if possible.
@@ -2918,13 +4209,13 @@ func (impl Implementation) Dormlq(side blas.Side, trans blas.Transpose, m, n, k
 
 	switch {
 	case left && len(a) < (k-1)*lda+m:
-		panic(shortA)
+		panic(Error{Routine: "Dormlq", Param: "a", Message: shortA})
 	case !left && len(a) < (k-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dormlq", Param: "a", Message: shortA})
 	case len(tau) < k:
-		panic(shortTau)
+		panic(Error{Routine: "Dormlq", Param: "tau", Message: shortTau})
 	case len(c) < (m-1)*ldc+n:
-		panic(shortC)
+		panic(Error{Routine: "Dormlq", Param: "c", Message: shortC})
 	}
 
 	lapacke.Dormlq(byte(side), byte(trans), m, n, k, a, lda, tau, c, ldc, work, lwork)
@@ -2967,27 +4258,27 @@ func (impl Implementation) Dormqr(side blas.Side, trans blas.Transpose, m, n, k
 	}
 	switch {
 	case !left && side != blas.Right:
-		panic(badSide)
+		panic(Error{Routine: "Dormqr", Param: "side", Message: badSide})
 	case trans != blas.NoTrans && trans != blas.Trans:
-		panic(badTrans)
+		panic(Error{Routine: "Dormqr", Param: "trans", Message: badTrans})
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dormqr", Param: "m", Message: mLT0})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dormqr", Param: "n", Message: nLT0})
 	case k < 0:
-		panic(kLT0)
+		panic(Error{Routine: "Dormqr", Param: "k", Message: kLT0})
 	case left && k > m:
-		panic(kGTM)
+		panic(Error{Routine: "Dormqr", Param: "k", Message: kGTM})
 	case !left && k > n:
-		panic(kGTN)
+		panic(Error{Routine: "Dormqr", Param: "k", Message: kGTN})
 	case lda < max(1, k):
-		panic(badLdA)
+		panic(Error{Routine: "Dormqr", Param: "lda", Message: badLdA})
 	case ldc < max(1, n):
-		panic(badLdC)
+		panic(Error{Routine: "Dormqr", Param: "ldc", Message: badLdC})
 	case lwork < max(1, nw) && lwork != -1:
-		panic(badLWork)
+		panic(Error{Routine: "Dormqr", Param: "lwork", Message: badLWork})
 	case len(work) < max(1, lwork):
-		panic(shortWork)
+		panic(Error{Routine: "Dormqr", Param: "work", Message: shortWork})
 	}
 
 	// Quick return if possible.
@@ -3003,35 +4294,138 @@ func (impl Implementation) Dormqr(side blas.Side, trans blas.Transpose, m, n, k
 
 	switch {
 	case len(a) < (nq-1)*lda+k:
-		panic(shortA)
+		panic(Error{Routine: "Dormqr", Param: "a", Message: shortA})
 	case len(tau) != k:
-		panic(badLenTau)
+		panic(Error{Routine: "Dormqr", Param: "tau", Message: badLenTau})
 	case len(c) < (m-1)*ldc+n:
-		panic(shortC)
+		panic(Error{Routine: "Dormqr", Param: "c", Message: shortC})
 	}
 
 	lapacke.Dormqr(byte(side), byte(trans), m, n, k, a, lda, tau, c, ldc, work, lwork)
 }
 
-// Dpocon estimates the reciprocal of the condition number of a positive-definite
-// matrix A given the Cholesky decomposition of A. The condition number computed
-// is based on the 1-norm and the ∞-norm.
-//
-// anorm is the 1-norm and the ∞-norm of the original matrix A.
-//
-// work is a temporary data slice of length at least 3*n and Dpocon will panic otherwise.
-//
-// iwork is a temporary data slice of length at least n and Dpocon will panic otherwise.
-func (impl Implementation) Dpocon(uplo blas.Uplo, n int, a []float64, lda int, anorm float64, work []float64, iwork []int) float64 {
+// Dpftrf computes the Cholesky factorization of the n×n symmetric positive
+// definite matrix A stored in Rectangular Full Packed format as computed by
+// Dtrttf. If uplo == blas.Upper, the factorization has the form
+//  A = U^T * U,
+// and if uplo == blas.Lower, it has the form
+//  A = L * L^T.
+// On return, a holds the factor U or L in the same format. transr specifies
+// whether a holds the normal (blas.NoTrans) or the transposed (blas.Trans)
+// form of the packed matrix.
+//
+// a must have length at least n*(n+1)/2.
+//
+// Dpftrf returns whether A is positive definite. If it is not, the
+// factorization has not been completed.
+func (impl Implementation) Dpftrf(transr blas.Transpose, uplo blas.Uplo, n int, a []float64) (ok bool) {
 	switch {
+	case transr != blas.NoTrans && transr != blas.Trans:
+		panic(Error{Routine: "Dpftrf", Param: "transr", Message: badTransR})
 	case uplo != blas.Upper && uplo != blas.Lower:
-		panic(badUplo)
+		panic(Error{Routine: "Dpftrf", Param: "uplo", Message: badUplo})
 	case n < 0:
-		panic(nLT0)
-	case lda < max(1, n):
-		panic(badLdA)
-	case anorm < 0:
-		panic(negANorm)
+		panic(Error{Routine: "Dpftrf", Param: "n", Message: nLT0})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	if len(a) < n*(n+1)/2 {
+		panic(Error{Routine: "Dpftrf", Param: "a", Message: shortA})
+	}
+
+	return lapacke.Dpftrf(byte(transr), byte(uplo), n, a)
+}
+
+// Dpftri computes the inverse of the n×n symmetric positive definite matrix
+// A using its Cholesky factorization in Rectangular Full Packed format, as
+// computed by Dpftrf. On return, a holds the corresponding triangle of the
+// inverse of A in the same format.
+//
+// a must have length at least n*(n+1)/2.
+//
+// Dpftri returns whether the factor is non-singular. If it is not, the
+// inverse has not been computed.
+func (impl Implementation) Dpftri(transr blas.Transpose, uplo blas.Uplo, n int, a []float64) (ok bool) {
+	switch {
+	case transr != blas.NoTrans && transr != blas.Trans:
+		panic(Error{Routine: "Dpftri", Param: "transr", Message: badTransR})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Dpftri", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Dpftri", Param: "n", Message: nLT0})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	if len(a) < n*(n+1)/2 {
+		panic(Error{Routine: "Dpftri", Param: "a", Message: shortA})
+	}
+
+	return lapacke.Dpftri(byte(transr), byte(uplo), n, a)
+}
+
+// Dpftrs solves a system of n linear equations A*X = B where A is an n×n
+// symmetric positive definite matrix and B is an n×nrhs matrix. The matrix A
+// is represented by its Cholesky factorization in Rectangular Full Packed
+// format, as computed by Dpftrf. On entry, B contains the right-hand side
+// matrix B, on return it contains the solution matrix X.
+//
+// a must have length at least n*(n+1)/2.
+func (impl Implementation) Dpftrs(transr blas.Transpose, uplo blas.Uplo, n, nrhs int, a, b []float64, ldb int) {
+	switch {
+	case transr != blas.NoTrans && transr != blas.Trans:
+		panic(Error{Routine: "Dpftrs", Param: "transr", Message: badTransR})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Dpftrs", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Dpftrs", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Dpftrs", Param: "nrhs", Message: nrhsLT0})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Dpftrs", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < n*(n+1)/2:
+		panic(Error{Routine: "Dpftrs", Param: "a", Message: shortA})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Dpftrs", Param: "b", Message: shortB})
+	}
+
+	lapacke.Dpftrs(byte(transr), byte(uplo), n, nrhs, a, b, ldb)
+}
+
+// Dpocon estimates the reciprocal of the condition number of a positive-definite
+// matrix A given the Cholesky decomposition of A. The condition number computed
+// is based on the 1-norm and the ∞-norm.
+//
+// anorm is the 1-norm and the ∞-norm of the original matrix A.
+//
+// work is a temporary data slice of length at least 3*n and Dpocon will panic otherwise.
+//
+// iwork is a temporary data slice of length at least n and Dpocon will panic otherwise.
+func (impl Implementation) Dpocon(uplo blas.Uplo, n int, a []float64, lda int, anorm float64, work []float64, iwork []int) float64 {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Dpocon", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Dpocon", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Dpocon", Param: "lda", Message: badLdA})
+	case anorm < 0:
+		panic(Error{Routine: "Dpocon", Param: "anorm", Message: negANorm})
 	}
 
 	// Quick return if possible.
@@ -3041,19 +4435,589 @@ func (impl Implementation) Dpocon(uplo blas.Uplo, n int, a []float64, lda int, a
 
 	switch {
 	case len(a) < (n-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dpocon", Param: "a", Message: shortA})
 	case len(work) < 3*n:
-		panic(shortWork)
+		panic(Error{Routine: "Dpocon", Param: "work", Message: shortWork})
 	case len(iwork) < n:
-		panic(shortIWork)
+		panic(Error{Routine: "Dpocon", Param: "iwork", Message: shortIWork})
 	}
 
 	rcond := []float64{0}
-	_iwork := make([]int32, n)
+	_iwork := make([]lapacke.Int, n)
 	lapacke.Dpocon(byte(uplo), n, a, lda, anorm, rcond, work, _iwork)
 	return rcond[0]
 }
 
+// Dppsv computes the solution of the system of linear equations
+//  A * X = B
+// where A is an n×n symmetric positive definite matrix stored in packed
+// format in ap and B is an n×nrhs matrix. ap holds the triangle of A given by
+// uplo packed row by row, as for the packed BLAS routines, and must have
+// length at least n*(n+1)/2. On return, ap holds the Cholesky factor of A in
+// the same format, as computed by Dpptrf.
+//
+// On entry, b holds the right hand side matrix B, and on return it is
+// overwritten by the solution X. If A is not positive definite, Dppsv
+// returns false and the solution is not computed.
+func (impl Implementation) Dppsv(uplo blas.Uplo, n, nrhs int, ap, b []float64, ldb int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Dppsv", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Dppsv", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Dppsv", Param: "nrhs", Message: nrhsLT0})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Dppsv", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(ap) < n*(n+1)/2:
+		panic(Error{Routine: "Dppsv", Param: "ap", Message: shortAP})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Dppsv", Param: "b", Message: shortB})
+	}
+
+	return lapacke.Dppsv(byte(uplo), n, nrhs, ap, b, ldb)
+}
+
+// Dpptrf computes the Cholesky factorization of the n×n symmetric positive
+// definite matrix A stored in packed format in ap,
+//  A = U^T * U  if uplo == blas.Upper
+//  A = L * L^T  if uplo == blas.Lower
+// and stores the factor in place into ap, which must have length at least
+// n*(n+1)/2. If A is not positive definite, false is returned.
+func (impl Implementation) Dpptrf(uplo blas.Uplo, n int, ap []float64) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Dpptrf", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Dpptrf", Param: "n", Message: nLT0})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	if len(ap) < n*(n+1)/2 {
+		panic(Error{Routine: "Dpptrf", Param: "ap", Message: shortAP})
+	}
+
+	return lapacke.Dpptrf(byte(uplo), n, ap)
+}
+
+// Dpptrs solves a system of n linear equations A*X = B where A is an n×n
+// symmetric positive definite matrix represented by its Cholesky
+// factorization in packed format, as computed by Dpptrf. On entry, B
+// contains the n×nrhs right-hand side matrix, on return it contains the
+// solution matrix X.
+func (impl Implementation) Dpptrs(uplo blas.Uplo, n, nrhs int, ap, b []float64, ldb int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Dpptrs", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Dpptrs", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Dpptrs", Param: "nrhs", Message: nrhsLT0})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Dpptrs", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(ap) < n*(n+1)/2:
+		panic(Error{Routine: "Dpptrs", Param: "ap", Message: shortAP})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Dpptrs", Param: "b", Message: shortB})
+	}
+
+	lapacke.Dpptrs(byte(uplo), n, nrhs, ap, b, ldb)
+}
+
+// Dptsv computes the solution to the system of linear equations
+//  A * X = B,
+// where A is an n×n symmetric positive definite tridiagonal matrix and X and
+// B are n×nrhs matrices, using the factorization A = L*D*L^T computed by
+// Dpttrf.
+//
+// On entry, d and e hold the n diagonal and n-1 off-diagonal elements of A.
+// On return, they are overwritten by the diagonal of D and the
+// sub-diagonal of the unit bidiagonal factor L.
+//
+// On entry, b holds the n×nrhs right hand side matrix B, and on return it is
+// overwritten by the solution X. If A is not positive definite, Dptsv
+// returns false and the solution is not computed.
+func (impl Implementation) Dptsv(n, nrhs int, d, e, b []float64, ldb int) (ok bool) {
+	switch {
+	case n < 0:
+		panic(Error{Routine: "Dptsv", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Dptsv", Param: "nrhs", Message: nrhsLT0})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Dptsv", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(Error{Routine: "Dptsv", Param: "d", Message: shortD})
+	case len(e) < n-1:
+		panic(Error{Routine: "Dptsv", Param: "e", Message: shortE})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Dptsv", Param: "b", Message: shortB})
+	}
+
+	return lapacke.Dptsv(n, nrhs, d, e, b, ldb)
+}
+
+// Dpttrf computes the factorization
+//  A = L * D * L^T
+// of an n×n symmetric positive definite tridiagonal matrix A, where L is a
+// unit lower bidiagonal matrix and D is diagonal.
+//
+// On entry, d and e hold the n diagonal and n-1 off-diagonal elements of A.
+// On return, they are overwritten by the diagonal of D and the
+// sub-diagonal of L.
+//
+// Dpttrf returns whether A is positive definite. If it is not, the
+// factorization has not been completed.
+func (impl Implementation) Dpttrf(n int, d, e []float64) (ok bool) {
+	if n < 0 {
+		panic(Error{Routine: "Dpttrf", Param: "n", Message: nLT0})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(Error{Routine: "Dpttrf", Param: "d", Message: shortD})
+	case len(e) < n-1:
+		panic(Error{Routine: "Dpttrf", Param: "e", Message: shortE})
+	}
+
+	return lapacke.Dpttrf(n, d, e)
+}
+
+// Dpttrs solves a system of linear equations A * X = B with an n×n symmetric
+// positive definite tridiagonal matrix A using the factorization
+//  A = L * D * L^T
+// computed by Dpttrf. d and e hold the diagonal of D and the sub-diagonal
+// of L as returned by Dpttrf.
+//
+// On entry, b holds the n×nrhs right hand side matrix B, and on return it is
+// overwritten by the solution X.
+func (impl Implementation) Dpttrs(n, nrhs int, d, e, b []float64, ldb int) {
+	switch {
+	case n < 0:
+		panic(Error{Routine: "Dpttrs", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Dpttrs", Param: "nrhs", Message: nrhsLT0})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Dpttrs", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(d) < n:
+		panic(Error{Routine: "Dpttrs", Param: "d", Message: shortD})
+	case len(e) < n-1:
+		panic(Error{Routine: "Dpttrs", Param: "e", Message: shortE})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Dpttrs", Param: "b", Message: shortB})
+	}
+
+	lapacke.Dpttrs(n, nrhs, d, e, b, ldb)
+}
+
+// Dsgesv computes the solution of the system of linear equations
+//
+//	A * X = B
+//
+// for the n×n matrix A and the n×nrhs matrices B and X by mixed precision
+// iterative refinement. A is factored in single precision by Sgetrf and the
+// solution is refined to double precision accuracy using residuals computed
+// in double precision, which is up to twice as fast as Dgesv when the
+// single precision factorization is faster. If the refinement does not
+// converge, A is factored and the system solved in double precision.
+//
+// The solution is stored into x and b is not modified. If the single
+// precision factorization was used, a is not modified and ipiv holds its
+// zero-indexed pivot indices; otherwise a holds the double precision factors
+// L and U and ipiv their pivot indices.
+//
+// work must have length at least n*nrhs and swork at least n*(n+nrhs).
+//
+// iter is the number of refinement iterations if the refinement converged,
+// and it is negative if the system was solved in double precision:
+//   - -1: the refinement was not attempted
+//   - -2: an element of A or B overflowed in the conversion to float32
+//   - -3: the single precision factorization failed
+//   - -31: the refinement did not converge
+//
+// Dsgesv returns whether A is nonsingular. If it is singular, the double
+// precision factorization is completed but X is not computed.
+func (impl Implementation) Dsgesv(n, nrhs int, a []float64, lda int, ipiv []int, b []float64, ldb int, x []float64, ldx int, work []float64, swork []float32) (iter int, ok bool) {
+	switch {
+	case n < 0:
+		panic(Error{Routine: "Dsgesv", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Dsgesv", Param: "nrhs", Message: nrhsLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Dsgesv", Param: "lda", Message: badLdA})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Dsgesv", Param: "ldb", Message: badLdB})
+	case ldx < max(1, nrhs):
+		panic(Error{Routine: "Dsgesv", Param: "ldx", Message: badLdX})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0, true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Dsgesv", Param: "a", Message: shortA})
+	case len(ipiv) != n:
+		panic(Error{Routine: "Dsgesv", Param: "ipiv", Message: badLenIpiv})
+	case nrhs > 0 && len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Dsgesv", Param: "b", Message: shortB})
+	case nrhs > 0 && len(x) < (n-1)*ldx+nrhs:
+		panic(Error{Routine: "Dsgesv", Param: "x", Message: shortX})
+	case len(work) < n*nrhs:
+		panic(Error{Routine: "Dsgesv", Param: "work", Message: shortWork})
+	case len(swork) < n*(n+nrhs):
+		panic(Error{Routine: "Dsgesv", Param: "swork", Message: shortSWork})
+	}
+
+	ipiv32 := make([]lapacke.Int, n)
+	_iter := make([]lapacke.Int, 1)
+	ok = lapacke.Dsgesv(n, nrhs, a, lda, ipiv32, b, ldb, x, ldx, work, swork, _iter)
+	for i, v := range ipiv32 {
+		ipiv[i] = int(v) - 1 // Transform to zero-indexed.
+	}
+	return int(_iter[0]), ok
+}
+
+// Dspev computes all eigenvalues and, optionally, the eigenvectors of the
+// n×n symmetric matrix A stored in packed format in ap, which must have
+// length at least n*(n+1)/2 and is overwritten on return.
+//
+// w contains the eigenvalues in ascending order upon return and must have
+// length at least n. If jobz == lapack.EVCompute, z holds the orthonormal
+// eigenvectors of A in its columns on return and ldz must be at least
+// max(1, n). Otherwise z is not referenced.
+//
+// work must have length at least 3*n.
+//
+// Dspev returns whether the algorithm converged.
+func (impl Implementation) Dspev(jobz lapack.EVJob, uplo blas.Uplo, n int, ap, w, z []float64, ldz int, work []float64) (ok bool) {
+	switch {
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(Error{Routine: "Dspev", Param: "jobz", Message: badEVJob})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Dspev", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Dspev", Param: "n", Message: nLT0})
+	case ldz < 1 || (jobz == lapack.EVCompute && ldz < n):
+		panic(Error{Routine: "Dspev", Param: "ldz", Message: badLdZ})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(ap) < n*(n+1)/2:
+		panic(Error{Routine: "Dspev", Param: "ap", Message: shortAP})
+	case len(w) < n:
+		panic(Error{Routine: "Dspev", Param: "w", Message: shortW})
+	case jobz == lapack.EVCompute && len(z) < (n-1)*ldz+n:
+		panic(Error{Routine: "Dspev", Param: "z", Message: shortZ})
+	case len(work) < 3*n:
+		panic(Error{Routine: "Dspev", Param: "work", Message: shortWork})
+	}
+
+	return lapacke.Dspev(byte(jobz), byte(uplo), n, ap, w, z, ldz, work)
+}
+
+// Dspsv computes the solution of the system of linear equations
+//  A * X = B
+// where A is an n×n symmetric matrix stored in packed format in ap and B is
+// an n×nrhs matrix, using the diagonal pivoting factorization of A computed
+// by Dsptrf. On return, ap and ipiv hold the factorization as described for
+// Dsptrf.
+//
+// On entry, b holds the right hand side matrix B, and on return it is
+// overwritten by the solution X. If D is exactly singular, Dspsv returns
+// false and the solution is not computed.
+func (impl Implementation) Dspsv(uplo blas.Uplo, n, nrhs int, ap []float64, ipiv []int, b []float64, ldb int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Dspsv", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Dspsv", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Dspsv", Param: "nrhs", Message: nrhsLT0})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Dspsv", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(ap) < n*(n+1)/2:
+		panic(Error{Routine: "Dspsv", Param: "ap", Message: shortAP})
+	case len(ipiv) != n:
+		panic(Error{Routine: "Dspsv", Param: "ipiv", Message: badLenIpiv})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Dspsv", Param: "b", Message: shortB})
+	}
+
+	ipiv32 := make([]lapacke.Int, n)
+	ok = lapacke.Dspsv(byte(uplo), n, nrhs, ap, ipiv32, b, ldb)
+	symPivotsToGonum(ipiv, ipiv32)
+	return ok
+}
+
+// Dsptrf computes the factorization
+//  A = U * D * U^T  if uplo == blas.Upper
+//  A = L * D * L^T  if uplo == blas.Lower
+// of the n×n symmetric matrix A stored in packed format in ap by the
+// Bunch-Kaufman diagonal pivoting method, where U and L are products of
+// permutation and unit triangular matrices and D is block diagonal with 1×1
+// and 2×2 blocks. On return, ap holds D and the multipliers of U or L in the
+// same format.
+//
+// ipiv must have length n and holds the zero-indexed interchanges and the
+// block structure of D on return. If ipiv[k] >= 0, D[k,k] is a 1×1 block and
+// rows and columns k and ipiv[k] were interchanged. If uplo == blas.Upper and
+// ipiv[k] = ipiv[k-1] < 0, D has a 2×2 block in rows and columns k-1 and k,
+// and rows and columns k-1 and ^ipiv[k] were interchanged. If
+// uplo == blas.Lower and ipiv[k] = ipiv[k+1] < 0, D has a 2×2 block in rows
+// and columns k and k+1, and rows and columns k+1 and ^ipiv[k] were
+// interchanged.
+//
+// Dsptrf returns whether D is non-singular. The factorization is completed
+// in either case, but a singular D cannot be used to solve a system.
+func (impl Implementation) Dsptrf(uplo blas.Uplo, n int, ap []float64, ipiv []int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Dsptrf", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Dsptrf", Param: "n", Message: nLT0})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(ap) < n*(n+1)/2:
+		panic(Error{Routine: "Dsptrf", Param: "ap", Message: shortAP})
+	case len(ipiv) != n:
+		panic(Error{Routine: "Dsptrf", Param: "ipiv", Message: badLenIpiv})
+	}
+
+	ipiv32 := make([]lapacke.Int, n)
+	ok = lapacke.Dsptrf(byte(uplo), n, ap, ipiv32)
+	symPivotsToGonum(ipiv, ipiv32)
+	return ok
+}
+
+// Dsptrs solves a system of linear equations A * X = B with the n×n
+// symmetric matrix A stored in packed format, using the factorization in ap
+// and ipiv computed by Dsptrf. On entry, b holds the n×nrhs right hand side
+// matrix B, and on return it is overwritten by the solution X.
+func (impl Implementation) Dsptrs(uplo blas.Uplo, n, nrhs int, ap []float64, ipiv []int, b []float64, ldb int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Dsptrs", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Dsptrs", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Dsptrs", Param: "nrhs", Message: nrhsLT0})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Dsptrs", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(ap) < n*(n+1)/2:
+		panic(Error{Routine: "Dsptrs", Param: "ap", Message: shortAP})
+	case len(ipiv) != n:
+		panic(Error{Routine: "Dsptrs", Param: "ipiv", Message: badLenIpiv})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Dsptrs", Param: "b", Message: shortB})
+	}
+
+	lapacke.Dsptrs(byte(uplo), n, nrhs, ap, symPivotsToLapacke(ipiv), b, ldb)
+}
+
+// symPivotsToGonum stores the pivots of a diagonal pivoting factorization
+// returned by LAPACK in ipiv32 into ipiv as described for Dsptrf. The
+// one-based indices of 1×1 blocks are made zero-based and the negated
+// one-based indices of 2×2 blocks, which are the complements of the
+// zero-based ones, are kept.
+func symPivotsToGonum(ipiv []int, ipiv32 []lapacke.Int) {
+	for i, v := range ipiv32 {
+		if v > 0 {
+			v-- // Transform to zero-indexed.
+		}
+		ipiv[i] = int(v)
+	}
+}
+
+// symPivotsToLapacke returns the pivots in ipiv, described for Dsptrf, in
+// the convention of LAPACK.
+func symPivotsToLapacke(ipiv []int) []lapacke.Int {
+	ipiv32 := make([]lapacke.Int, len(ipiv))
+	for i, v := range ipiv {
+		if v < -len(ipiv) || len(ipiv) <= v {
+			panic("lapack: ipiv element out of range")
+		}
+		if v >= 0 {
+			v++ // Transform to one-indexed.
+		}
+		ipiv32[i] = lapacke.Int(v)
+	}
+	return ipiv32
+}
+
+// Dstemr computes selected eigenvalues and, optionally, the eigenvectors of
+// the n×n symmetric tridiagonal matrix T by the relatively robust
+// representations (MRRR) algorithm. The eigenvalues computed are specified
+// by rng as for Dsyevr. The number of eigenvalues found, m, is returned and
+// the eigenvalues are stored in ascending order in w[:m], which must have
+// length at least n.
+//
+// On entry, d holds the n diagonal elements and e[:n-1] the off-diagonal
+// elements of T. e must have length at least n, e[n-1] being used as
+// workspace. Both are overwritten.
+//
+// If jobz == lapack.EVCompute, the m orthonormal eigenvectors are stored in
+// the leading m columns of the n×n matrix z, and isuppz holds the zero-based
+// indices of the first and last non-zero elements of each eigenvector in
+// isuppz[2*i] and isuppz[2*i+1]. z and isuppz are not referenced if
+// jobz == lapack.EVNone.
+//
+// If tryrac is true, Dstemr checks whether T defines its eigenvalues to
+// high relative accuracy and, if so, computes them to that accuracy. rac
+// reports whether it did.
+//
+// lwork must be at least max(1, 18*n) and liwork at least max(1, 10*n) if
+// the eigenvectors are computed, and max(1, 12*n) and max(1, 8*n) otherwise,
+// and Dstemr will panic otherwise. If lwork or liwork is -1, instead of
+// computing the eigenvalues the optimal lengths of work and iwork are stored
+// into work[0] and iwork[0].
+//
+// Dstemr returns whether the algorithm converged.
+func (impl Implementation) Dstemr(jobz lapack.EVJob, rng EVRange, n int, d, e []float64, vl, vu float64, il, iu int, w, z []float64, ldz int, isuppz []int, tryrac bool, work []float64, lwork int, iwork []int, liwork int) (m int, rac, ok bool) {
+	minwork, miniwork := max(1, 12*n), max(1, 8*n)
+	if jobz == lapack.EVCompute {
+		minwork, miniwork = max(1, 18*n), max(1, 10*n)
+	}
+	switch {
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(Error{Routine: "Dstemr", Param: "jobz", Message: badEVJob})
+	case rng != EVAll && rng != EVValue && rng != EVIndex:
+		panic(Error{Routine: "Dstemr", Param: "rng", Message: badEVRange})
+	case n < 0:
+		panic(Error{Routine: "Dstemr", Param: "n", Message: nLT0})
+	case rng == EVValue && n > 0 && vu <= vl:
+		panic(Error{Routine: "Dstemr", Param: "vu", Message: badEVInterval})
+	case rng == EVIndex && n > 0 && (il < 0 || n <= il):
+		panic(Error{Routine: "Dstemr", Param: "il", Message: badEVIndex})
+	case rng == EVIndex && n > 0 && (iu < il || n <= iu):
+		panic(Error{Routine: "Dstemr", Param: "iu", Message: badEVIndex})
+	case jobz == lapack.EVCompute && ldz < max(1, n):
+		panic(Error{Routine: "Dstemr", Param: "ldz", Message: badLdZ})
+	case lwork < minwork && lwork != -1:
+		panic(Error{Routine: "Dstemr", Param: "lwork", Message: badLWork})
+	case liwork < miniwork && liwork != -1:
+		panic(Error{Routine: "Dstemr", Param: "liwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Dstemr", Param: "work", Message: shortWork})
+	case len(iwork) < max(1, liwork):
+		panic(Error{Routine: "Dstemr", Param: "iwork", Message: shortIWork})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		if lwork == -1 || liwork == -1 {
+			work[0] = 1
+			iwork[0] = 1
+		}
+		return 0, false, true
+	}
+
+	_m := make([]lapacke.Int, 1)
+	_tryrac := []lapacke.Int{0}
+	if tryrac {
+		_tryrac[0] = 1
+	}
+	if lwork == -1 || liwork == -1 {
+		_iwork := make([]lapacke.Int, 1)
+		ok = lapacke.Dstemr(byte(jobz), byte(rng), n, d, e, vl, vu, il+1, iu+1, _m, w, z, max(1, ldz), n, nil, _tryrac, work, -1, _iwork, -1)
+		iwork[0] = int(_iwork[0])
+		return 0, false, ok
+	}
+
+	switch {
+	case len(d) < n:
+		panic(Error{Routine: "Dstemr", Param: "d", Message: shortD})
+	case len(e) < n:
+		panic(Error{Routine: "Dstemr", Param: "e", Message: shortE})
+	case len(w) < n:
+		panic(Error{Routine: "Dstemr", Param: "w", Message: shortW})
+	case jobz == lapack.EVCompute && len(z) < (n-1)*ldz+n:
+		panic(Error{Routine: "Dstemr", Param: "z", Message: shortZ})
+	case jobz == lapack.EVCompute && len(isuppz) < 2*n:
+		panic(Error{Routine: "Dstemr", Param: "isuppz", Message: shortIsuppz})
+	}
+
+	_isuppz := make([]lapacke.Int, 2*n)
+	_iwork := make([]lapacke.Int, liwork)
+	ok = lapacke.Dstemr(byte(jobz), byte(rng), n, d, e, vl, vu, il+1, iu+1, _m, w, z, max(1, ldz), n, _isuppz, _tryrac, work, lwork, _iwork, liwork)
+	m = int(_m[0])
+	if jobz == lapack.EVCompute {
+		for i, v := range _isuppz[:2*m] {
+			isuppz[i] = int(v - 1)
+		}
+	}
+	return m, _tryrac[0] != 0, ok
+}
+
 // Dsteqr computes the eigenvalues and optionally the eigenvectors of a symmetric
 // tridiagonal matrix using the implicit QL or QR method. The eigenvectors of a
 // full or band symmetric matrix can also be found if Dsytrd, Dsptrd, or Dsbtrd
@@ -3081,11 +5045,11 @@ func (impl Implementation) Dpocon(uplo blas.Uplo, n int, a []float64, lda int, a
 func (impl Implementation) Dsteqr(compz lapack.EVComp, n int, d, e, z []float64, ldz int, work []float64) (ok bool) {
 	switch {
 	case compz != lapack.EVCompNone && compz != lapack.EVTridiag && compz != lapack.EVOrig:
-		panic(badEVComp)
+		panic(Error{Routine: "Dsteqr", Param: "compz", Message: badEVComp})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dsteqr", Param: "n", Message: nLT0})
 	case ldz < 1, compz != lapack.EVCompNone && ldz < n:
-		panic(badLdZ)
+		panic(Error{Routine: "Dsteqr", Param: "ldz", Message: badLdZ})
 	}
 
 	// Quick return if possible.
@@ -3095,13 +5059,13 @@ func (impl Implementation) Dsteqr(compz lapack.EVComp, n int, d, e, z []float64,
 
 	switch {
 	case len(d) < n:
-		panic(shortD)
+		panic(Error{Routine: "Dsteqr", Param: "d", Message: shortD})
 	case len(e) < n-1:
-		panic(shortE)
+		panic(Error{Routine: "Dsteqr", Param: "e", Message: shortE})
 	case compz != lapack.EVCompNone && len(z) < (n-1)*ldz+n:
-		panic(shortZ)
+		panic(Error{Routine: "Dsteqr", Param: "z", Message: shortZ})
 	case compz != lapack.EVCompNone && len(work) < max(1, 2*n-2):
-		panic(shortWork)
+		panic(Error{Routine: "Dsteqr", Param: "work", Message: shortWork})
 	}
 
 	return lapacke.Dsteqr(byte(compz), n, d, e, z, ldz, work)
@@ -3121,73 +5085,313 @@ func (impl Implementation) Dsteqr(compz lapack.EVComp, n int, d, e, z []float64,
 // Dsterf is an internal routine. It is exported for testing purposes.
 func (impl Implementation) Dsterf(n int, d, e []float64) (ok bool) {
 	if n < 0 {
-		panic(nLT0)
+		panic(Error{Routine: "Dsterf", Param: "n", Message: nLT0})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(Error{Routine: "Dsterf", Param: "d", Message: shortD})
+	case len(e) < n-1:
+		panic(Error{Routine: "Dsterf", Param: "e", Message: shortE})
+	}
+
+	return lapacke.Dsterf(n, d, e)
+}
+
+// Dsyev computes all eigenvalues and, optionally, the eigenvectors of a real
+// symmetric matrix A.
+//
+// w contains the eigenvalues in ascending order upon return. w must have length
+// at least n, and Dsyev will panic otherwise.
+//
+// On entry, a contains the elements of the symmetric matrix A in the triangular
+// portion specified by uplo. If jobz == lapack.ComputeEV a contains the
+// orthonormal eigenvectors of A on exit, otherwise on exit the specified
+// triangular region is overwritten.
+//
+// work is temporary storage, and lwork specifies the usable memory length. At minimum,
+// lwork >= 3*n-1, and Dsyev will panic otherwise. The amount of blocking is
+// limited by the usable length. If lwork == -1, instead of computing Dsyev the
+// optimal work length is stored into work[0].
+func (impl Implementation) Dsyev(jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, w, work []float64, lwork int) (ok bool) {
+	switch {
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(Error{Routine: "Dsyev", Param: "jobz", Message: badEVJob})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Dsyev", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Dsyev", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Dsyev", Param: "lda", Message: badLdA})
+	case lwork < max(1, 3*n-1) && lwork != -1:
+		panic(Error{Routine: "Dsyev", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Dsyev", Param: "work", Message: shortWork})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	if lwork == -1 {
+		return lapacke.Dsyev(byte(jobz), byte(uplo), n, a, lda, w, work, -1)
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Dsyev", Param: "a", Message: shortA})
+	case len(w) < n:
+		panic(Error{Routine: "Dsyev", Param: "w", Message: shortW})
+	}
+
+	return lapacke.Dsyev(byte(jobz), byte(uplo), n, a, lda, w, work, lwork)
+}
+
+// Dsyevr computes selected eigenvalues and, optionally, the eigenvectors of
+// the real symmetric n×n matrix A by the relatively robust representations
+// (MRRR) algorithm. The eigenvalues computed are specified by rng:
+//  - EVAll: all eigenvalues,
+//  - EVValue: the eigenvalues in the half-open interval (vl, vu],
+//  - EVIndex: the eigenvalues with the zero-based indices il through iu
+//    inclusive in ascending order, where 0 <= il <= iu < n.
+// The number of eigenvalues found, m, is returned and the eigenvalues are
+// stored in ascending order in w[:m].
+//
+// On entry, a contains the elements of the symmetric matrix A in the triangular
+// portion specified by uplo. On exit, the triangle of a, including the
+// diagonal, is overwritten.
+//
+// If jobz == lapack.EVCompute, the m orthonormal eigenvectors are stored in the
+// leading m columns of the n×ncols matrix z, where ncols is iu-il+1 for
+// EVIndex and n otherwise, and isuppz holds the zero-based indices of the
+// first and last non-zero elements of each eigenvector in isuppz[2*i] and
+// isuppz[2*i+1]. z and isuppz are not referenced if jobz == lapack.EVNone.
+//
+// abstol is the absolute error tolerance of the eigenvalues. If abstol is
+// not positive, a default based on the machine precision is used.
+//
+// lwork must be at least max(1, 26*n) and liwork at least max(1, 10*n), and
+// Dsyevr will panic otherwise. If lwork or liwork is -1, instead of computing
+// the eigenvalues the optimal lengths of work and iwork are stored into
+// work[0] and iwork[0].
+//
+// Dsyevr returns whether the algorithm converged.
+func (impl Implementation) Dsyevr(jobz lapack.EVJob, rng EVRange, uplo blas.Uplo, n int, a []float64, lda int, vl, vu float64, il, iu int, abstol float64, w, z []float64, ldz int, isuppz []int, work []float64, lwork int, iwork []int, liwork int) (m int, ok bool) {
+	ncols := n
+	switch {
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(Error{Routine: "Dsyevr", Param: "jobz", Message: badEVJob})
+	case rng != EVAll && rng != EVValue && rng != EVIndex:
+		panic(Error{Routine: "Dsyevr", Param: "rng", Message: badEVRange})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Dsyevr", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Dsyevr", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Dsyevr", Param: "lda", Message: badLdA})
+	case rng == EVValue && n > 0 && vu <= vl:
+		panic(Error{Routine: "Dsyevr", Param: "vu", Message: badEVInterval})
+	case rng == EVIndex && n > 0 && (il < 0 || n <= il):
+		panic(Error{Routine: "Dsyevr", Param: "il", Message: badEVIndex})
+	case rng == EVIndex && n > 0 && (iu < il || n <= iu):
+		panic(Error{Routine: "Dsyevr", Param: "iu", Message: badEVIndex})
+	}
+	if rng == EVIndex {
+		ncols = iu - il + 1
+	}
+	switch {
+	case jobz == lapack.EVCompute && ldz < max(1, ncols):
+		panic(Error{Routine: "Dsyevr", Param: "ldz", Message: badLdZ})
+	case lwork < max(1, 26*n) && lwork != -1:
+		panic(Error{Routine: "Dsyevr", Param: "lwork", Message: badLWork})
+	case liwork < max(1, 10*n) && liwork != -1:
+		panic(Error{Routine: "Dsyevr", Param: "liwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Dsyevr", Param: "work", Message: shortWork})
+	case len(iwork) < max(1, liwork):
+		panic(Error{Routine: "Dsyevr", Param: "iwork", Message: shortIWork})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		if lwork == -1 || liwork == -1 {
+			work[0] = 1
+			iwork[0] = 1
+		}
+		return 0, true
+	}
+
+	_m := make([]lapacke.Int, 1)
+	if lwork == -1 || liwork == -1 {
+		_iwork := make([]lapacke.Int, 1)
+		ok = lapacke.Dsyevr(byte(jobz), byte(rng), byte(uplo), n, a, lda, vl, vu, il+1, iu+1, abstol, _m, w, z, max(1, ldz), nil, work, -1, _iwork, -1)
+		iwork[0] = int(_iwork[0])
+		return 0, ok
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Dsyevr", Param: "a", Message: shortA})
+	case len(w) < n:
+		panic(Error{Routine: "Dsyevr", Param: "w", Message: shortW})
+	case jobz == lapack.EVCompute && len(z) < (n-1)*ldz+ncols:
+		panic(Error{Routine: "Dsyevr", Param: "z", Message: shortZ})
+	case jobz == lapack.EVCompute && len(isuppz) < 2*ncols:
+		panic(Error{Routine: "Dsyevr", Param: "isuppz", Message: shortIsuppz})
+	}
+
+	_isuppz := make([]lapacke.Int, 2*ncols)
+	_iwork := make([]lapacke.Int, liwork)
+	ok = lapacke.Dsyevr(byte(jobz), byte(rng), byte(uplo), n, a, lda, vl, vu, il+1, iu+1, abstol, _m, w, z, max(1, ldz), _isuppz, work, lwork, _iwork, liwork)
+	m = int(_m[0])
+	if jobz == lapack.EVCompute {
+		for i, v := range _isuppz[:2*m] {
+			isuppz[i] = int(v - 1)
+		}
+	}
+	return m, ok
+}
+
+// Dsygv computes all eigenvalues and, optionally, the eigenvectors of the
+// real generalized symmetric-definite eigenproblem of the form given by itype
+//  A * x = λ * B * x,  A * B * x = λ * x  or  B * A * x = λ * x,
+// where A and B are n×n symmetric matrices and B is positive definite. Both
+// matrices are stored in the triangle given by uplo.
+//
+// The eigenvalues are stored in ascending order in w, which must have length
+// at least n. If jobz == lapack.EVCompute, a is overwritten by the matrix Z
+// of eigenvectors, normalized as Z^T * B * Z = I for AxLambdaBx and
+// ABxLambdax and as Z^T * B^{-1} * Z = I for BAxLambdax. Otherwise the
+// triangle of a is destroyed. On return, b holds the Cholesky factor of B in
+// the triangle given by uplo.
+//
+// lwork must be at least max(1, 3*n-1), and Dsygv will panic otherwise. If
+// lwork == -1, instead of computing the eigenvalues the optimal work length
+// is stored into work[0].
+//
+// Dsygv returns false if B is not positive definite or the eigenvalue
+// algorithm failed to converge.
+func (impl Implementation) Dsygv(itype GenEVType, jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, b []float64, ldb int, w, work []float64, lwork int) (ok bool) {
+	switch {
+	case itype != AxLambdaBx && itype != ABxLambdax && itype != BAxLambdax:
+		panic(Error{Routine: "Dsygv", Param: "itype", Message: badGenEVType})
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(Error{Routine: "Dsygv", Param: "jobz", Message: badEVJob})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Dsygv", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Dsygv", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Dsygv", Param: "lda", Message: badLdA})
+	case ldb < max(1, n):
+		panic(Error{Routine: "Dsygv", Param: "ldb", Message: badLdB})
+	case lwork < max(1, 3*n-1) && lwork != -1:
+		panic(Error{Routine: "Dsygv", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Dsygv", Param: "work", Message: shortWork})
 	}
 
 	// Quick return if possible.
 	if n == 0 {
+		work[0] = 1
 		return true
 	}
 
+	if lwork == -1 {
+		return lapacke.Dsygv(int(itype), byte(jobz), byte(uplo), n, a, lda, b, ldb, w, work, -1)
+	}
+
 	switch {
-	case len(d) < n:
-		panic(shortD)
-	case len(e) < n-1:
-		panic(shortE)
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Dsygv", Param: "a", Message: shortA})
+	case len(b) < (n-1)*ldb+n:
+		panic(Error{Routine: "Dsygv", Param: "b", Message: shortB})
+	case len(w) < n:
+		panic(Error{Routine: "Dsygv", Param: "w", Message: shortW})
 	}
 
-	return lapacke.Dsterf(n, d, e)
+	return lapacke.Dsygv(int(itype), byte(jobz), byte(uplo), n, a, lda, b, ldb, w, work, lwork)
 }
 
-// Dsyev computes all eigenvalues and, optionally, the eigenvectors of a real
-// symmetric matrix A.
-//
-// w contains the eigenvalues in ascending order upon return. w must have length
-// at least n, and Dsyev will panic otherwise.
-//
-// On entry, a contains the elements of the symmetric matrix A in the triangular
-// portion specified by uplo. If jobz == lapack.ComputeEV a contains the
-// orthonormal eigenvectors of A on exit, otherwise on exit the specified
-// triangular region is overwritten.
-//
-// work is temporary storage, and lwork specifies the usable memory length. At minimum,
-// lwork >= 3*n-1, and Dsyev will panic otherwise. The amount of blocking is
-// limited by the usable length. If lwork == -1, instead of computing Dsyev the
-// optimal work length is stored into work[0].
-func (impl Implementation) Dsyev(jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, w, work []float64, lwork int) (ok bool) {
+// Dsygvd computes all eigenvalues and, optionally, the eigenvectors of the
+// real generalized symmetric-definite eigenproblem as described for Dsygv,
+// using the divide and conquer algorithm for the eigenvectors, which is
+// faster than Dsygv for large n.
+//
+// If n <= 1, lwork and liwork must be at least 1. Otherwise lwork must be at
+// least 2*n+1 and liwork at least 1 if jobz == lapack.EVNone, and lwork must
+// be at least 1+6*n+2*n*n and liwork at least 3+5*n if
+// jobz == lapack.EVCompute. Dsygvd will panic otherwise. If lwork or liwork
+// is -1, instead of computing the eigenvalues the optimal lengths of work and
+// iwork are stored into work[0] and iwork[0].
+//
+// Dsygvd returns false if B is not positive definite or the eigenvalue
+// algorithm failed to converge.
+func (impl Implementation) Dsygvd(itype GenEVType, jobz lapack.EVJob, uplo blas.Uplo, n int, a []float64, lda int, b []float64, ldb int, w, work []float64, lwork int, iwork []int, liwork int) (ok bool) {
+	minwrk, miniwrk := 1, 1
+	switch {
+	case n <= 1:
+	case jobz == lapack.EVCompute:
+		minwrk = 1 + 6*n + 2*n*n
+		miniwrk = 3 + 5*n
+	default:
+		minwrk = 2*n + 1
+	}
 	switch {
+	case itype != AxLambdaBx && itype != ABxLambdax && itype != BAxLambdax:
+		panic(Error{Routine: "Dsygvd", Param: "itype", Message: badGenEVType})
 	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
-		panic(badEVJob)
+		panic(Error{Routine: "Dsygvd", Param: "jobz", Message: badEVJob})
 	case uplo != blas.Upper && uplo != blas.Lower:
-		panic(badUplo)
+		panic(Error{Routine: "Dsygvd", Param: "uplo", Message: badUplo})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dsygvd", Param: "n", Message: nLT0})
 	case lda < max(1, n):
-		panic(badLdA)
-	case lwork < max(1, 3*n-1) && lwork != -1:
-		panic(badLWork)
+		panic(Error{Routine: "Dsygvd", Param: "lda", Message: badLdA})
+	case ldb < max(1, n):
+		panic(Error{Routine: "Dsygvd", Param: "ldb", Message: badLdB})
+	case lwork < minwrk && lwork != -1:
+		panic(Error{Routine: "Dsygvd", Param: "lwork", Message: badLWork})
+	case liwork < miniwrk && liwork != -1:
+		panic(Error{Routine: "Dsygvd", Param: "liwork", Message: badLWork})
 	case len(work) < max(1, lwork):
-		panic(shortWork)
+		panic(Error{Routine: "Dsygvd", Param: "work", Message: shortWork})
+	case len(iwork) < max(1, liwork):
+		panic(Error{Routine: "Dsygvd", Param: "iwork", Message: shortIWork})
 	}
 
 	// Quick return if possible.
 	if n == 0 {
+		work[0] = 1
+		iwork[0] = 1
 		return true
 	}
 
-	if lwork == -1 {
-		return lapacke.Dsyev(byte(jobz), byte(uplo), n, a, lda, w, work, -1)
-		return
+	if lwork == -1 || liwork == -1 {
+		_iwork := make([]lapacke.Int, 1)
+		ok = lapacke.Dsygvd(int(itype), byte(jobz), byte(uplo), n, a, lda, b, ldb, w, work, -1, _iwork, -1)
+		iwork[0] = int(_iwork[0])
+		return ok
 	}
 
 	switch {
 	case len(a) < (n-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dsygvd", Param: "a", Message: shortA})
+	case len(b) < (n-1)*ldb+n:
+		panic(Error{Routine: "Dsygvd", Param: "b", Message: shortB})
 	case len(w) < n:
-		panic(shortW)
+		panic(Error{Routine: "Dsygvd", Param: "w", Message: shortW})
 	}
 
-	return lapacke.Dsyev(byte(jobz), byte(uplo), n, a, lda, w, work, lwork)
+	_iwork := make([]lapacke.Int, liwork)
+	return lapacke.Dsygvd(int(itype), byte(jobz), byte(uplo), n, a, lda, b, ldb, w, work, lwork, _iwork, liwork)
 }
 
 // Dsytrd reduces a symmetric n×n matrix A to symmetric tridiagonal form by an
@@ -3238,15 +5442,15 @@ func (impl Implementation) Dsyev(jobz lapack.EVJob, uplo blas.Uplo, n int, a []f
 func (impl Implementation) Dsytrd(uplo blas.Uplo, n int, a []float64, lda int, d, e, tau, work []float64, lwork int) {
 	switch {
 	case uplo != blas.Upper && uplo != blas.Lower:
-		panic(badUplo)
+		panic(Error{Routine: "Dsytrd", Param: "uplo", Message: badUplo})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dsytrd", Param: "n", Message: nLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dsytrd", Param: "lda", Message: badLdA})
 	case lwork < 1 && lwork != -1:
-		panic(badLWork)
+		panic(Error{Routine: "Dsytrd", Param: "lwork", Message: badLWork})
 	case len(work) < max(1, lwork):
-		panic(shortWork)
+		panic(Error{Routine: "Dsytrd", Param: "work", Message: shortWork})
 	}
 
 	// Quick return if possible.
@@ -3262,13 +5466,13 @@ func (impl Implementation) Dsytrd(uplo blas.Uplo, n int, a []float64, lda int, d
 
 	switch {
 	case len(a) < (n-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dsytrd", Param: "a", Message: shortA})
 	case len(d) < n:
-		panic(shortD)
+		panic(Error{Routine: "Dsytrd", Param: "d", Message: shortD})
 	case len(e) < n-1:
-		panic(shortE)
+		panic(Error{Routine: "Dsytrd", Param: "e", Message: shortE})
 	case len(tau) < n-1:
-		panic(shortTau)
+		panic(Error{Routine: "Dsytrd", Param: "tau", Message: shortTau})
 	}
 
 	lapacke.Dsytrd(byte(uplo), n, a, lda, d, e, tau, work, lwork)
@@ -3285,21 +5489,21 @@ func (impl Implementation) Dsytrd(uplo blas.Uplo, n int, a []float64, lda int, d
 func (impl Implementation) Dtbtrs(uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, n, kd, nrhs int, a []float64, lda int, b []float64, ldb int) (ok bool) {
 	switch {
 	case uplo != blas.Upper && uplo != blas.Lower:
-		panic(badUplo)
+		panic(Error{Routine: "Dtbtrs", Param: "uplo", Message: badUplo})
 	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
-		panic(badTrans)
+		panic(Error{Routine: "Dtbtrs", Param: "trans", Message: badTrans})
 	case diag != blas.NonUnit && diag != blas.Unit:
-		panic(badDiag)
+		panic(Error{Routine: "Dtbtrs", Param: "diag", Message: badDiag})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dtbtrs", Param: "n", Message: nLT0})
 	case kd < 0:
-		panic(kdLT0)
+		panic(Error{Routine: "Dtbtrs", Param: "kd", Message: kdLT0})
 	case nrhs < 0:
-		panic(nrhsLT0)
+		panic(Error{Routine: "Dtbtrs", Param: "nrhs", Message: nrhsLT0})
 	case lda < kd+1:
-		panic(badLdA)
+		panic(Error{Routine: "Dtbtrs", Param: "lda", Message: badLdA})
 	case ldb < max(1, nrhs):
-		panic(badLdB)
+		panic(Error{Routine: "Dtbtrs", Param: "ldb", Message: badLdB})
 	}
 
 	// Quick return if possible.
@@ -3309,9 +5513,9 @@ func (impl Implementation) Dtbtrs(uplo blas.Uplo, trans blas.Transpose, diag bla
 
 	switch {
 	case len(a) < (n-1)*lda+kd+1:
-		panic(shortA)
+		panic(Error{Routine: "Dtbtrs", Param: "a", Message: shortA})
 	case len(b) < (n-1)*ldb+nrhs:
-		panic(shortB)
+		panic(Error{Routine: "Dtbtrs", Param: "b", Message: shortB})
 	}
 
 	ldaConv := n
@@ -3320,6 +5524,132 @@ func (impl Implementation) Dtbtrs(uplo blas.Uplo, trans blas.Transpose, diag bla
 	return lapacke.Dtbtrs(byte(uplo), byte(trans), byte(diag), n, kd, nrhs, aConv, ldaConv, b, ldb)
 }
 
+// Dtfttp copies the n×n triangular matrix A from Rectangular Full Packed
+// format in arf to standard packed format in ap. transr specifies whether
+// arf holds the normal (blas.NoTrans) or the transposed (blas.Trans) form of
+// the packed matrix.
+//
+// arf and ap must have length at least n*(n+1)/2.
+func (impl Implementation) Dtfttp(transr blas.Transpose, uplo blas.Uplo, n int, arf, ap []float64) {
+	switch {
+	case transr != blas.NoTrans && transr != blas.Trans:
+		panic(Error{Routine: "Dtfttp", Param: "transr", Message: badTransR})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Dtfttp", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Dtfttp", Param: "n", Message: nLT0})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	switch {
+	case len(arf) < n*(n+1)/2:
+		panic(Error{Routine: "Dtfttp", Param: "arf", Message: shortARF})
+	case len(ap) < n*(n+1)/2:
+		panic(Error{Routine: "Dtfttp", Param: "ap", Message: shortAP})
+	}
+
+	lapacke.Dtfttp(byte(transr), byte(uplo), n, arf, ap)
+}
+
+// Dtfttr copies the n×n triangular matrix A from Rectangular Full Packed
+// format in arf to the corresponding triangle of the standard full format
+// in a. The opposite triangle of a is not referenced. transr specifies
+// whether arf holds the normal (blas.NoTrans) or the transposed (blas.Trans)
+// form of the packed matrix.
+//
+// arf must have length at least n*(n+1)/2.
+func (impl Implementation) Dtfttr(transr blas.Transpose, uplo blas.Uplo, n int, arf, a []float64, lda int) {
+	switch {
+	case transr != blas.NoTrans && transr != blas.Trans:
+		panic(Error{Routine: "Dtfttr", Param: "transr", Message: badTransR})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Dtfttr", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Dtfttr", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Dtfttr", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	switch {
+	case len(arf) < n*(n+1)/2:
+		panic(Error{Routine: "Dtfttr", Param: "arf", Message: shortARF})
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Dtfttr", Param: "a", Message: shortA})
+	}
+
+	lapacke.Dtfttr(byte(transr), byte(uplo), n, arf, a, lda)
+}
+
+// Dtpttf copies the n×n triangular matrix A from standard packed format in
+// ap to Rectangular Full Packed format in arf. transr specifies whether arf
+// holds the normal (blas.NoTrans) or the transposed (blas.Trans) form of the
+// packed matrix.
+//
+// ap and arf must have length at least n*(n+1)/2.
+func (impl Implementation) Dtpttf(transr blas.Transpose, uplo blas.Uplo, n int, ap, arf []float64) {
+	switch {
+	case transr != blas.NoTrans && transr != blas.Trans:
+		panic(Error{Routine: "Dtpttf", Param: "transr", Message: badTransR})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Dtpttf", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Dtpttf", Param: "n", Message: nLT0})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	switch {
+	case len(ap) < n*(n+1)/2:
+		panic(Error{Routine: "Dtpttf", Param: "ap", Message: shortAP})
+	case len(arf) < n*(n+1)/2:
+		panic(Error{Routine: "Dtpttf", Param: "arf", Message: shortARF})
+	}
+
+	lapacke.Dtpttf(byte(transr), byte(uplo), n, ap, arf)
+}
+
+// Dtpttr copies the n×n triangular matrix A from standard packed format in
+// ap to the corresponding triangle of the standard full format in a. The
+// opposite triangle of a is not referenced.
+//
+// ap must have length at least n*(n+1)/2.
+func (impl Implementation) Dtpttr(uplo blas.Uplo, n int, ap, a []float64, lda int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Dtpttr", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Dtpttr", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Dtpttr", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	switch {
+	case len(ap) < n*(n+1)/2:
+		panic(Error{Routine: "Dtpttr", Param: "ap", Message: shortAP})
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Dtpttr", Param: "a", Message: shortA})
+	}
+
+	lapacke.Dtpttr(byte(uplo), n, ap, a, lda)
+}
+
 // Dtrcon estimates the reciprocal of the condition number of a triangular matrix A.
 // The condition number computed may be based on the 1-norm or the ∞-norm.
 //
@@ -3329,15 +5659,15 @@ func (impl Implementation) Dtbtrs(uplo blas.Uplo, trans blas.Transpose, diag bla
 func (impl Implementation) Dtrcon(norm lapack.MatrixNorm, uplo blas.Uplo, diag blas.Diag, n int, a []float64, lda int, work []float64, iwork []int) float64 {
 	switch {
 	case norm != lapack.MaxColumnSum && norm != lapack.MaxRowSum:
-		panic(badNorm)
+		panic(Error{Routine: "Dtrcon", Param: "norm", Message: badNorm})
 	case uplo != blas.Upper && uplo != blas.Lower:
-		panic(badUplo)
+		panic(Error{Routine: "Dtrcon", Param: "uplo", Message: badUplo})
 	case diag != blas.NonUnit && diag != blas.Unit:
-		panic(badDiag)
+		panic(Error{Routine: "Dtrcon", Param: "diag", Message: badDiag})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dtrcon", Param: "n", Message: nLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dtrcon", Param: "lda", Message: badLdA})
 	}
 
 	if n == 0 {
@@ -3346,15 +5676,15 @@ func (impl Implementation) Dtrcon(norm lapack.MatrixNorm, uplo blas.Uplo, diag b
 
 	switch {
 	case len(a) < (n-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dtrcon", Param: "a", Message: shortA})
 	case len(work) < 3*n:
-		panic(shortWork)
+		panic(Error{Routine: "Dtrcon", Param: "work", Message: shortWork})
 	case len(iwork) < n:
-		panic(shortIWork)
+		panic(Error{Routine: "Dtrcon", Param: "iwork", Message: shortIWork})
 	}
 
 	rcond := []float64{0}
-	_iwork := make([]int32, n)
+	_iwork := make([]lapacke.Int, n)
 	lapacke.Dtrcon(byte(norm), byte(uplo), byte(diag), n, a, lda, rcond, work, _iwork)
 	return rcond[0]
 }
@@ -3401,17 +5731,17 @@ func (impl Implementation) Dtrcon(norm lapack.MatrixNorm, uplo blas.Uplo, diag b
 func (impl Implementation) Dtrexc(compq lapack.UpdateSchurComp, n int, t []float64, ldt int, q []float64, ldq int, ifst, ilst int, work []float64) (ifstOut, ilstOut int, ok bool) {
 	switch {
 	case compq != lapack.UpdateSchur && compq != lapack.UpdateSchurNone:
-		panic(badUpdateSchurComp)
+		panic(Error{Routine: "Dtrexc", Param: "compq", Message: badUpdateSchurComp})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dtrexc", Param: "n", Message: nLT0})
 	case ldt < max(1, n):
-		panic(badLdT)
+		panic(Error{Routine: "Dtrexc", Param: "ldt", Message: badLdT})
 	case ldq < 1, compq == lapack.UpdateSchur && ldq < n:
-		panic(badLdQ)
+		panic(Error{Routine: "Dtrexc", Param: "ldq", Message: badLdQ})
 	case (ifst < 0 || n <= ifst) && n > 0:
-		panic(badIfst)
+		panic(Error{Routine: "Dtrexc", Param: "ifst", Message: badIfst})
 	case (ilst < 0 || n <= ilst) && n > 0:
-		panic(badIlst)
+		panic(Error{Routine: "Dtrexc", Param: "ilst", Message: badIlst})
 	}
 
 	// Quick return if possible.
@@ -3421,11 +5751,11 @@ func (impl Implementation) Dtrexc(compq lapack.UpdateSchurComp, n int, t []float
 
 	switch {
 	case len(t) < (n-1)*ldt+n:
-		panic(shortT)
+		panic(Error{Routine: "Dtrexc", Param: "t", Message: shortT})
 	case compq == lapack.UpdateSchur && len(q) < (n-1)*ldq+n:
-		panic(shortQ)
+		panic(Error{Routine: "Dtrexc", Param: "q", Message: shortQ})
 	case len(work) < n:
-		panic(shortWork)
+		panic(Error{Routine: "Dtrexc", Param: "work", Message: shortWork})
 	}
 
 	// Quick return if possible.
@@ -3433,14 +5763,192 @@ func (impl Implementation) Dtrexc(compq lapack.UpdateSchurComp, n int, t []float
 		return ifst, ilst, true
 	}
 
-	ifst32 := []int32{int32(ifst + 1)}
-	ilst32 := []int32{int32(ilst + 1)}
+	ifst32 := []lapacke.Int{lapacke.Int(ifst + 1)}
+	ilst32 := []lapacke.Int{lapacke.Int(ilst + 1)}
 	ok = lapacke.Dtrexc(byte(compq), n, t, ldt, q, ldq, ifst32, ilst32, work)
 	ifst = int(ifst32[0] - 1)
 	ilst = int(ilst32[0] - 1)
 	return ifst, ilst, ok
 }
 
+// Dtrsen reorders the real Schur factorization of a n×n real matrix
+//  A = Q*T*Q^T
+// so that the eigenvalues selected by sel form the leading m×m block T11 of
+// the upper quasi-triangular matrix T
+//  T = [ T11 T12 ]
+//      [  0  T22 ],
+// and the leading m columns of Q form an orthonormal basis of the
+// corresponding right invariant subspace. A complex conjugate pair of
+// eigenvalues is selected if sel is true for either of them. sel must have
+// length n, otherwise Dtrsen will panic.
+//
+// On entry, T must be in Schur canonical form as returned by Dgees or Dhseqr.
+// On return, T is overwritten by the reordered matrix, which is again in
+// Schur canonical form.
+//
+// If compq is lapack.UpdateSchur, the matrix Q of Schur vectors is
+// postmultiplied by the orthogonal transformation. If compq is
+// lapack.UpdateSchurNone, q is not referenced. For other values of compq
+// Dtrsen will panic.
+//
+// job specifies the condition numbers that are computed. If job is
+// CondEigenvalues or CondBoth, s is a lower bound on the reciprocal condition
+// number of the average of the selected eigenvalues, and if job is
+// CondSubspace or CondBoth, sep is the estimated reciprocal condition number
+// of the invariant subspace. If m is 0 or n, s is 1 and sep is the norm of T.
+//
+// wr and wi contain the real and imaginary parts, respectively, of the
+// reordered eigenvalues. They must have length n, otherwise Dtrsen will panic.
+//
+// work must have length at least lwork and lwork must be at least max(1,n) if
+// job is CondNone, max(1,m*(n-m)) if job is CondEigenvalues and
+// max(1,2*m*(n-m)) otherwise. iwork must have length at least liwork and liwork
+// must be at least max(1,m*(n-m)) if job is CondSubspace or CondBoth and at
+// least 1 otherwise. Dtrsen will panic if these conditions are not met. If
+// lwork or liwork is -1, instead of reordering T, the optimal lengths of work
+// and iwork are stored into work[0] and iwork[0].
+//
+// ok is false if the reordering failed because some eigenvalues were too
+// close to separate. In that case T may have been partially reordered, and wr
+// and wi hold the eigenvalues of the partially reordered T.
+func (impl Implementation) Dtrsen(job SchurCondJob, compq lapack.UpdateSchurComp, sel []bool, n int, t []float64, ldt int, q []float64, ldq int, wr, wi []float64, work []float64, lwork int, iwork []int, liwork int) (m int, s, sep float64, ok bool) {
+	wantq := compq == lapack.UpdateSchur
+	switch {
+	case job != CondNone && job != CondEigenvalues && job != CondSubspace && job != CondBoth:
+		panic(Error{Routine: "Dtrsen", Param: "job", Message: badSchurCondJob})
+	case compq != lapack.UpdateSchur && compq != lapack.UpdateSchurNone:
+		panic(Error{Routine: "Dtrsen", Param: "compq", Message: badUpdateSchurComp})
+	case n < 0:
+		panic(Error{Routine: "Dtrsen", Param: "n", Message: nLT0})
+	case ldt < max(1, n):
+		panic(Error{Routine: "Dtrsen", Param: "ldt", Message: badLdT})
+	case ldq < 1, wantq && ldq < n:
+		panic(Error{Routine: "Dtrsen", Param: "ldq", Message: badLdQ})
+	case len(sel) != n:
+		panic(Error{Routine: "Dtrsen", Param: "sel", Message: badLenSelected})
+	case len(t) < max(0, (n-1)*ldt+n):
+		panic(Error{Routine: "Dtrsen", Param: "t", Message: shortT})
+	}
+
+	// Count the selected eigenvalues, including both of a complex conjugate
+	// pair if either of them is selected.
+	for k := 0; k < n; k++ {
+		if k < n-1 && t[(k+1)*ldt+k] != 0 {
+			if sel[k] || sel[k+1] {
+				m += 2
+			}
+			k++
+			continue
+		}
+		if sel[k] {
+			m++
+		}
+	}
+	nn := m * (n - m)
+	minwork, miniwork := max(1, n), 1
+	switch job {
+	case CondEigenvalues:
+		minwork = max(1, nn)
+	case CondSubspace, CondBoth:
+		minwork, miniwork = max(1, 2*nn), max(1, nn)
+	}
+	switch {
+	case lwork < minwork && lwork != -1:
+		panic(Error{Routine: "Dtrsen", Param: "lwork", Message: badLWork})
+	case liwork < miniwork && liwork != -1:
+		panic(Error{Routine: "Dtrsen", Param: "liwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Dtrsen", Param: "work", Message: shortWork})
+	case len(iwork) < max(1, liwork):
+		panic(Error{Routine: "Dtrsen", Param: "iwork", Message: shortIWork})
+	}
+
+	// Quick return in case of a workspace query.
+	if lwork == -1 || liwork == -1 {
+		work[0] = float64(minwork)
+		iwork[0] = miniwork
+		return m, 0, 0, true
+	}
+
+	switch {
+	case wantq && len(q) < (n-1)*ldq+n:
+		panic(Error{Routine: "Dtrsen", Param: "q", Message: shortQ})
+	case len(wr) != n:
+		panic(Error{Routine: "Dtrsen", Param: "wr", Message: badLenWr})
+	case len(wi) != n:
+		panic(Error{Routine: "Dtrsen", Param: "wi", Message: badLenWi})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0, 1, 0, true
+	}
+
+	_sel := make([]lapacke.Int, n)
+	for i, v := range sel {
+		if v {
+			_sel[i] = 1
+		}
+	}
+	_m := []lapacke.Int{0}
+	_s := []float64{0}
+	_sep := []float64{0}
+	_iwork := make([]lapacke.Int, max(1, liwork))
+	ok = lapacke.Dtrsen(byte(job), byte(compq), _sel, n, t, ldt, q, max(n, ldq), wr, wi, _m, _s, _sep, work, lwork, _iwork, max(1, liwork))
+	return int(_m[0]), _s[0], _sep[0], ok
+}
+
+// Dtrsyl solves the real Sylvester matrix equation
+//  op(A)*X + isgn*X*op(B) = scale*C
+// where op(A) is A or Aᵀ as specified by trana and op(B) is B or Bᵀ as
+// specified by tranb, A is an m×m and B an n×n upper quasi-triangular matrix
+// in Schur canonical form, and C and X are m×n matrices. isgn must be 1 or
+// -1, otherwise Dtrsyl will panic.
+//
+// On entry c holds C and on return it is overwritten by the solution X. The
+// scale factor 0 < scale ≤ 1 is chosen to avoid overflow in X.
+//
+// ok is false if A and -isgn*B have common or very close eigenvalues, in
+// which case perturbed values were used to solve the equation.
+func (impl Implementation) Dtrsyl(trana, tranb blas.Transpose, isgn, m, n int, a []float64, lda int, b []float64, ldb int, c []float64, ldc int) (scale float64, ok bool) {
+	switch {
+	case trana != blas.NoTrans && trana != blas.Trans && trana != blas.ConjTrans:
+		panic(Error{Routine: "Dtrsyl", Param: "trana", Message: badTrans})
+	case tranb != blas.NoTrans && tranb != blas.Trans && tranb != blas.ConjTrans:
+		panic(Error{Routine: "Dtrsyl", Param: "tranb", Message: badTrans})
+	case isgn != 1 && isgn != -1:
+		panic(Error{Routine: "Dtrsyl", Param: "isgn", Message: badIsgn})
+	case m < 0:
+		panic(Error{Routine: "Dtrsyl", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Dtrsyl", Param: "n", Message: nLT0})
+	case lda < max(1, m):
+		panic(Error{Routine: "Dtrsyl", Param: "lda", Message: badLdA})
+	case ldb < max(1, n):
+		panic(Error{Routine: "Dtrsyl", Param: "ldb", Message: badLdB})
+	case ldc < max(1, n):
+		panic(Error{Routine: "Dtrsyl", Param: "ldc", Message: badLdC})
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return 1, true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+m:
+		panic(Error{Routine: "Dtrsyl", Param: "a", Message: shortA})
+	case len(b) < (n-1)*ldb+n:
+		panic(Error{Routine: "Dtrsyl", Param: "b", Message: shortB})
+	case len(c) < (m-1)*ldc+n:
+		panic(Error{Routine: "Dtrsyl", Param: "c", Message: shortC})
+	}
+
+	_scale := []float64{0}
+	ok = lapacke.Dtrsyl(byte(trana), byte(tranb), isgn, m, n, a, lda, b, ldb, c, ldc, _scale)
+	return _scale[0], ok
+}
+
 // Dtrtri computes the inverse of a triangular matrix, storing the result in place
 // into a. This is the BLAS level 3 version of the algorithm which builds upon
 // Dtrti2 to operate on matrix blocks instead of only individual columns.
@@ -3450,13 +5958,13 @@ func (impl Implementation) Dtrexc(compq lapack.UpdateSchurComp, n int, t []float
 func (impl Implementation) Dtrtri(uplo blas.Uplo, diag blas.Diag, n int, a []float64, lda int) (ok bool) {
 	switch {
 	case uplo != blas.Upper && uplo != blas.Lower:
-		panic(badUplo)
+		panic(Error{Routine: "Dtrtri", Param: "uplo", Message: badUplo})
 	case diag != blas.NonUnit && diag != blas.Unit:
-		panic(badDiag)
+		panic(Error{Routine: "Dtrtri", Param: "diag", Message: badDiag})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dtrtri", Param: "n", Message: nLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dtrtri", Param: "lda", Message: badLdA})
 	}
 
 	if n == 0 {
@@ -3464,7 +5972,7 @@ func (impl Implementation) Dtrtri(uplo blas.Uplo, diag blas.Diag, n int, a []flo
 	}
 
 	if len(a) < (n-1)*lda+n {
-		panic(shortA)
+		panic(Error{Routine: "Dtrtri", Param: "a", Message: shortA})
 	}
 
 	return lapacke.Dtrtri(byte(uplo), byte(diag), n, a, lda)
@@ -3476,19 +5984,19 @@ func (impl Implementation) Dtrtri(uplo blas.Uplo, diag blas.Diag, n int, a []flo
 func (impl Implementation) Dtrtrs(uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, n, nrhs int, a []float64, lda int, b []float64, ldb int) (ok bool) {
 	switch {
 	case uplo != blas.Upper && uplo != blas.Lower:
-		panic(badUplo)
+		panic(Error{Routine: "Dtrtrs", Param: "uplo", Message: badUplo})
 	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
-		panic(badTrans)
+		panic(Error{Routine: "Dtrtrs", Param: "trans", Message: badTrans})
 	case diag != blas.NonUnit && diag != blas.Unit:
-		panic(badDiag)
+		panic(Error{Routine: "Dtrtrs", Param: "diag", Message: badDiag})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dtrtrs", Param: "n", Message: nLT0})
 	case nrhs < 0:
-		panic(nrhsLT0)
+		panic(Error{Routine: "Dtrtrs", Param: "nrhs", Message: nrhsLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dtrtrs", Param: "lda", Message: badLdA})
 	case ldb < max(1, nrhs):
-		panic(badLdB)
+		panic(Error{Routine: "Dtrtrs", Param: "ldb", Message: badLdB})
 	}
 
 	if n == 0 {
@@ -3497,13 +6005,77 @@ func (impl Implementation) Dtrtrs(uplo blas.Uplo, trans blas.Transpose, diag bla
 
 	switch {
 	case len(a) < (n-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dtrtrs", Param: "a", Message: shortA})
 	case len(b) < (n-1)*ldb+nrhs:
-		panic(shortB)
+		panic(Error{Routine: "Dtrtrs", Param: "b", Message: shortB})
 	}
 
 	return lapacke.Dtrtrs(byte(uplo), byte(trans), byte(diag), n, nrhs, a, lda, b, ldb)
 }
+// Dtrttf copies the triangle of the n×n matrix A in standard full format
+// specified by uplo to Rectangular Full Packed format in arf. The
+// Rectangular Full Packed format stores the triangle in n*(n+1)/2 elements
+// arranged so that the Level 3 BLAS can operate on it. transr specifies
+// whether arf receives the normal (blas.NoTrans) or the transposed
+// (blas.Trans) form of the packed matrix.
+//
+// arf must have length at least n*(n+1)/2.
+func (impl Implementation) Dtrttf(transr blas.Transpose, uplo blas.Uplo, n int, a []float64, lda int, arf []float64) {
+	switch {
+	case transr != blas.NoTrans && transr != blas.Trans:
+		panic(Error{Routine: "Dtrttf", Param: "transr", Message: badTransR})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Dtrttf", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Dtrttf", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Dtrttf", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Dtrttf", Param: "a", Message: shortA})
+	case len(arf) < n*(n+1)/2:
+		panic(Error{Routine: "Dtrttf", Param: "arf", Message: shortARF})
+	}
+
+	lapacke.Dtrttf(byte(transr), byte(uplo), n, a, lda, arf)
+}
+
+// Dtrttp copies the triangle of the n×n matrix A in standard full format
+// specified by uplo to standard packed format in ap.
+//
+// ap must have length at least n*(n+1)/2.
+func (impl Implementation) Dtrttp(uplo blas.Uplo, n int, a []float64, lda int, ap []float64) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Dtrttp", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Dtrttp", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Dtrttp", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Dtrttp", Param: "a", Message: shortA})
+	case len(ap) < n*(n+1)/2:
+		panic(Error{Routine: "Dtrttp", Param: "ap", Message: shortAP})
+	}
+
+	lapacke.Dtrttp(byte(uplo), n, a, lda, ap)
+}
+
 
 // Dhseqr computes the eigenvalues of an n×n Hessenberg matrix H and,
 // optionally, the matrices T and Z from the Schur decomposition
@@ -3617,23 +6189,23 @@ func (impl Implementation) Dhseqr(job lapack.SchurJob, compz lapack.SchurComp, n
 
 	switch {
 	case job != lapack.EigenvaluesOnly && job != lapack.EigenvaluesAndSchur:
-		panic(badSchurJob)
+		panic(Error{Routine: "Dhseqr", Param: "job", Message: badSchurJob})
 	case compz != lapack.SchurNone && compz != lapack.SchurHess && compz != lapack.SchurOrig:
-		panic(badSchurComp)
+		panic(Error{Routine: "Dhseqr", Param: "compz", Message: badSchurComp})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dhseqr", Param: "n", Message: nLT0})
 	case ilo < 0 || max(0, n-1) < ilo:
-		panic(badIlo)
+		panic(Error{Routine: "Dhseqr", Param: "ilo", Message: badIlo})
 	case ihi < min(ilo, n-1) || n <= ihi:
-		panic(badIhi)
+		panic(Error{Routine: "Dhseqr", Param: "ihi", Message: badIhi})
 	case ldh < max(1, n):
-		panic(badLdH)
+		panic(Error{Routine: "Dhseqr", Param: "ldh", Message: badLdH})
 	case ldz < 1, wantz && ldz < n:
-		panic(badLdZ)
+		panic(Error{Routine: "Dhseqr", Param: "ldz", Message: badLdZ})
 	case lwork < max(1, n) && lwork != -1:
-		panic(badLWork)
+		panic(Error{Routine: "Dhseqr", Param: "lwork", Message: badLWork})
 	case len(work) < max(1, lwork):
-		panic(shortWork)
+		panic(Error{Routine: "Dhseqr", Param: "work", Message: shortWork})
 	}
 
 	// Quick return if possible.
@@ -3649,13 +6221,13 @@ func (impl Implementation) Dhseqr(job lapack.SchurJob, compz lapack.SchurComp, n
 
 	switch {
 	case len(h) < (n-1)*ldh+n:
-		panic(shortH)
+		panic(Error{Routine: "Dhseqr", Param: "h", Message: shortH})
 	case wantz && len(z) < (n-1)*ldz+n:
-		panic(shortZ)
+		panic(Error{Routine: "Dhseqr", Param: "z", Message: shortZ})
 	case len(wr) < n:
-		panic(shortWr)
+		panic(Error{Routine: "Dhseqr", Param: "wr", Message: shortWr})
 	case len(wi) < n:
-		panic(shortWi)
+		panic(Error{Routine: "Dhseqr", Param: "wi", Message: shortWi})
 	}
 
 	return lapacke.Dhseqr(byte(job), byte(compz), n, ilo+1, ihi+1, h, ldh, wr, wi, z, ldz, work, lwork)
@@ -3719,21 +6291,21 @@ func (impl Implementation) Dgeev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob
 	}
 	switch {
 	case jobvl != lapack.LeftEVCompute && jobvl != lapack.LeftEVNone:
-		panic(badLeftEVJob)
+		panic(Error{Routine: "Dgeev", Param: "jobvl", Message: badLeftEVJob})
 	case jobvr != lapack.RightEVCompute && jobvr != lapack.RightEVNone:
-		panic(badRightEVJob)
+		panic(Error{Routine: "Dgeev", Param: "jobvr", Message: badRightEVJob})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dgeev", Param: "n", Message: nLT0})
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dgeev", Param: "lda", Message: badLdA})
 	case ldvl < 1 || (ldvl < n && wantvl):
-		panic(badLdVL)
+		panic(Error{Routine: "Dgeev", Param: "ldvl", Message: badLdVL})
 	case ldvr < 1 || (ldvr < n && wantvr):
-		panic(badLdVR)
+		panic(Error{Routine: "Dgeev", Param: "ldvr", Message: badLdVR})
 	case lwork < minwrk && lwork != -1:
-		panic(badLWork)
+		panic(Error{Routine: "Dgeev", Param: "lwork", Message: badLWork})
 	case len(work) < lwork:
-		panic(shortWork)
+		panic(Error{Routine: "Dgeev", Param: "work", Message: shortWork})
 	}
 
 	// Quick return if possible.
@@ -3755,20 +6327,193 @@ func (impl Implementation) Dgeev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob
 
 	switch {
 	case len(a) < (n-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dgeev", Param: "a", Message: shortA})
 	case len(wr) != n:
-		panic(badLenWr)
+		panic(Error{Routine: "Dgeev", Param: "wr", Message: badLenWr})
 	case len(wi) != n:
-		panic(badLenWi)
+		panic(Error{Routine: "Dgeev", Param: "wi", Message: badLenWi})
 	case len(vl) < (n-1)*ldvl+n && wantvl:
-		panic(shortVL)
+		panic(Error{Routine: "Dgeev", Param: "vl", Message: shortVL})
 	case len(vr) < (n-1)*ldvr+n && wantvr:
-		panic(shortVR)
+		panic(Error{Routine: "Dgeev", Param: "vr", Message: shortVR})
 	}
 
 	return lapacke.Dgeev(byte(jobvl), byte(jobvr), n, a, lda, wr, wi, vl, max(n, ldvl), vr, max(n, ldvr), work, lwork)
 }
 
+// Dgees computes the eigenvalues, the real Schur form T and, optionally, the
+// matrix of Schur vectors Z of an n×n real nonsymmetric matrix A
+//  A = Z*T*Z^T.
+//
+// If sel is not nil, the eigenvalues are ordered so that those for which
+// sel(wr, wi) returns true are at the top left of T, and the leading sdim
+// columns of Z form an orthonormal basis of the corresponding invariant
+// subspace. A complex conjugate pair of eigenvalues is selected if sel returns
+// true for either of them, in which case sdim counts both. If sel is nil, the
+// eigenvalues are not ordered and sdim is zero.
+//
+// On return, a is overwritten by T, which is block upper triangular with 1×1
+// and 2×2 diagonal blocks in Schur canonical form.
+//
+// The Schur vectors are computed and stored in vs if jobvs is lapack.SchurOrig,
+// otherwise jobvs must be lapack.SchurNone and vs is not referenced. For other
+// values of jobvs Dgees will panic.
+//
+// wr and wi contain the real and imaginary parts, respectively, of the
+// eigenvalues in the order in which they appear on the diagonal of T. Complex
+// conjugate pairs of eigenvalues appear consecutively with the eigenvalue
+// having the positive imaginary part first. wr and wi must have length n, and
+// Dgees will panic otherwise.
+//
+// work must have length at least lwork and lwork must be at least max(1,3*n),
+// otherwise Dgees will panic. For good performance, lwork must generally be
+// larger. On return, the optimal value of lwork will be stored in work[0].
+//
+// If lwork == -1, instead of performing Dgees, the function only calculates the
+// optimal value of lwork and stores it into work[0].
+//
+// On return, info is zero if the computation succeeded. If 0 < info <= n, the
+// QR algorithm failed to compute all the eigenvalues and wr[info:] and
+// wi[info:] contain those which have converged. If info == n+1, the
+// eigenvalues could not be reordered because some of them were too close to
+// separate. If info == n+2, the reordered eigenvalues changed due to roundoff
+// and no longer satisfy sel.
+func (impl Implementation) Dgees(jobvs lapack.SchurComp, sel func(wr, wi float64) bool, n int, a []float64, lda int, wr, wi []float64, vs []float64, ldvs int, work []float64, lwork int) (sdim, info int) {
+	wantvs := jobvs == lapack.SchurOrig
+	switch {
+	case jobvs != lapack.SchurNone && jobvs != lapack.SchurOrig:
+		panic(Error{Routine: "Dgees", Param: "jobvs", Message: badSchurComp})
+	case n < 0:
+		panic(Error{Routine: "Dgees", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Dgees", Param: "lda", Message: badLdA})
+	case ldvs < 1, wantvs && ldvs < n:
+		panic(Error{Routine: "Dgees", Param: "ldvs", Message: badLdVS})
+	case lwork < max(1, 3*n) && lwork != -1:
+		panic(Error{Routine: "Dgees", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Dgees", Param: "work", Message: shortWork})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return 0, 0
+	}
+
+	sort := byte('N')
+	if sel != nil {
+		sort = 'S'
+	}
+	_sdim := []lapacke.Int{0}
+
+	// Quick return in case of a workspace query.
+	if lwork == -1 {
+		lapacke.Dgees(byte(jobvs), sort, sel, n, a, lda, _sdim, wr, wi, vs, max(n, ldvs), work, -1, nil)
+		return 0, 0
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Dgees", Param: "a", Message: shortA})
+	case len(wr) != n:
+		panic(Error{Routine: "Dgees", Param: "wr", Message: badLenWr})
+	case len(wi) != n:
+		panic(Error{Routine: "Dgees", Param: "wi", Message: badLenWi})
+	case wantvs && len(vs) < (n-1)*ldvs+n:
+		panic(Error{Routine: "Dgees", Param: "vs", Message: shortVS})
+	}
+
+	var bwork []lapacke.Int
+	if sel != nil {
+		bwork = make([]lapacke.Int, n)
+	}
+	info = lapacke.Dgees(byte(jobvs), sort, sel, n, a, lda, _sdim, wr, wi, vs, max(n, ldvs), work, lwork, bwork)
+	return int(_sdim[0]), info
+}
+
+// Dggev computes the generalized eigenvalues and, optionally, the left and/or
+// right generalized eigenvectors of the pair of n×n real nonsymmetric
+// matrices (A, B).
+//
+// A generalized eigenvalue is a scalar λ = alpha/beta such that A - λ*B is
+// singular, where beta may be zero for an infinite eigenvalue. The right
+// eigenvector v_j and the left eigenvector u_j corresponding to λ_j satisfy
+//  A * v_j = λ_j * B * v_j,
+//  u_j^H * A = λ_j * u_j^H * B.
+//
+// On return, the eigenvalues are given by
+//  λ_j = (alphar[j] + i*alphai[j]) / beta[j],
+// and alphar, alphai and beta must have length n, and Dggev will panic
+// otherwise. Complex conjugate pairs of eigenvalues appear consecutively with
+// the eigenvalue having the positive imaginary part first, and their
+// eigenvectors are stored in the columns of VL and VR as described for
+// Dgeev. Each eigenvector is scaled so that the largest component has
+// |real part| + |imag part| = 1. a and b are overwritten.
+//
+// Left eigenvectors are computed only if jobvl == lapack.LeftEVCompute and
+// right eigenvectors only if jobvr == lapack.RightEVCompute.
+//
+// lwork must be at least max(1, 8*n), and Dggev will panic otherwise. If
+// lwork == -1, instead of computing the eigenvalues the optimal work length
+// is stored into work[0].
+//
+// Dggev returns whether the QZ iteration converged.
+func (impl Implementation) Dggev(jobvl lapack.LeftEVJob, jobvr lapack.RightEVJob, n int, a []float64, lda int, b []float64, ldb int, alphar, alphai, beta, vl []float64, ldvl int, vr []float64, ldvr int, work []float64, lwork int) (ok bool) {
+	wantvl := jobvl == lapack.LeftEVCompute
+	wantvr := jobvr == lapack.RightEVCompute
+	switch {
+	case jobvl != lapack.LeftEVCompute && jobvl != lapack.LeftEVNone:
+		panic(Error{Routine: "Dggev", Param: "jobvl", Message: badLeftEVJob})
+	case jobvr != lapack.RightEVCompute && jobvr != lapack.RightEVNone:
+		panic(Error{Routine: "Dggev", Param: "jobvr", Message: badRightEVJob})
+	case n < 0:
+		panic(Error{Routine: "Dggev", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Dggev", Param: "lda", Message: badLdA})
+	case ldb < max(1, n):
+		panic(Error{Routine: "Dggev", Param: "ldb", Message: badLdB})
+	case ldvl < 1 || (ldvl < n && wantvl):
+		panic(Error{Routine: "Dggev", Param: "ldvl", Message: badLdVL})
+	case ldvr < 1 || (ldvr < n && wantvr):
+		panic(Error{Routine: "Dggev", Param: "ldvr", Message: badLdVR})
+	case lwork < max(1, 8*n) && lwork != -1:
+		panic(Error{Routine: "Dggev", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Dggev", Param: "work", Message: shortWork})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return true
+	}
+
+	// The leading dimensions of VL and VR are raised to n as for Dgeev.
+	if lwork == -1 {
+		return lapacke.Dggev(byte(jobvl), byte(jobvr), n, a, lda, b, ldb, alphar, alphai, beta, vl, max(n, ldvl), vr, max(n, ldvr), work, -1)
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Dggev", Param: "a", Message: shortA})
+	case len(b) < (n-1)*ldb+n:
+		panic(Error{Routine: "Dggev", Param: "b", Message: shortB})
+	case len(alphar) != n:
+		panic(Error{Routine: "Dggev", Param: "alphar", Message: badLenAlpha})
+	case len(alphai) != n:
+		panic(Error{Routine: "Dggev", Param: "alphai", Message: badLenAlpha})
+	case len(beta) != n:
+		panic(Error{Routine: "Dggev", Param: "beta", Message: badLenBeta})
+	case len(vl) < (n-1)*ldvl+n && wantvl:
+		panic(Error{Routine: "Dggev", Param: "vl", Message: shortVL})
+	case len(vr) < (n-1)*ldvr+n && wantvr:
+		panic(Error{Routine: "Dggev", Param: "vr", Message: shortVR})
+	}
+
+	return lapacke.Dggev(byte(jobvl), byte(jobvr), n, a, lda, b, ldb, alphar, alphai, beta, vl, max(n, ldvl), vr, max(n, ldvr), work, lwork)
+}
+
 // Dtgsja computes the generalized singular value decomposition (GSVD)
 // of two real upper triangular or trapezoidal matrices A and B.
 //
@@ -3925,53 +6670,53 @@ func (impl Implementation) Dtgsja(jobU, jobV, jobQ lapack.GSVDJob, m, p, n, k, l
 
 	switch {
 	case !initu && !wantu && jobU != lapack.GSVDNone:
-		panic(badGSVDJob + "U")
+		panic(Error{Routine: "Dtgsja", Param: "jobU", Message: badGSVDJob + "U"})
 	case !initv && !wantv && jobV != lapack.GSVDNone:
-		panic(badGSVDJob + "V")
+		panic(Error{Routine: "Dtgsja", Param: "jobV", Message: badGSVDJob + "V"})
 	case !initq && !wantq && jobQ != lapack.GSVDNone:
-		panic(badGSVDJob + "Q")
+		panic(Error{Routine: "Dtgsja", Param: "jobQ", Message: badGSVDJob + "Q"})
 	case m < 0:
-		panic(mLT0)
+		panic(Error{Routine: "Dtgsja", Param: "m", Message: mLT0})
 	case p < 0:
-		panic(pLT0)
+		panic(Error{Routine: "Dtgsja", Param: "p", Message: pLT0})
 	case n < 0:
-		panic(nLT0)
+		panic(Error{Routine: "Dtgsja", Param: "n", Message: nLT0})
 
 	case lda < max(1, n):
-		panic(badLdA)
+		panic(Error{Routine: "Dtgsja", Param: "lda", Message: badLdA})
 	case len(a) < (m-1)*lda+n:
-		panic(shortA)
+		panic(Error{Routine: "Dtgsja", Param: "a", Message: shortA})
 
 	case ldb < max(1, n):
-		panic(badLdB)
+		panic(Error{Routine: "Dtgsja", Param: "ldb", Message: badLdB})
 	case len(b) < (p-1)*ldb+n:
-		panic(shortB)
+		panic(Error{Routine: "Dtgsja", Param: "b", Message: shortB})
 
 	case len(alpha) != n:
-		panic(badLenAlpha)
+		panic(Error{Routine: "Dtgsja", Param: "alpha", Message: badLenAlpha})
 	case len(beta) != n:
-		panic(badLenBeta)
+		panic(Error{Routine: "Dtgsja", Param: "beta", Message: badLenBeta})
 
 	case ldu < 1, wantu && ldu < m:
-		panic(badLdU)
+		panic(Error{Routine: "Dtgsja", Param: "ldu", Message: badLdU})
 	case wantu && len(u) < (m-1)*ldu+m:
-		panic(shortU)
+		panic(Error{Routine: "Dtgsja", Param: "u", Message: shortU})
 
 	case ldv < 1, wantv && ldv < p:
-		panic(badLdV)
+		panic(Error{Routine: "Dtgsja", Param: "ldv", Message: badLdV})
 	case wantv && len(v) < (p-1)*ldv+p:
-		panic(shortV)
+		panic(Error{Routine: "Dtgsja", Param: "v", Message: shortV})
 
 	case ldq < 1, wantq && ldq < n:
-		panic(badLdQ)
+		panic(Error{Routine: "Dtgsja", Param: "ldq", Message: badLdQ})
 	case wantq && len(q) < (n-1)*ldq+n:
-		panic(shortQ)
+		panic(Error{Routine: "Dtgsja", Param: "q", Message: shortQ})
 
 	case len(work) < 2*n:
-		panic(shortWork)
+		panic(Error{Routine: "Dtgsja", Param: "work", Message: shortWork})
 	}
 
-	ncycle := []int32{0}
+	ncycle := []lapacke.Int{0}
 	ok = lapacke.Dtgsja(byte(jobU), byte(jobV), byte(jobQ), m, p, n, k, l, a, lda, b, ldb, tola, tolb, alpha, beta, u, ldu, v, ldv, q, ldq, work, ncycle)
 	return int(ncycle[0]), ok
 }
@@ -5,9 +5,14 @@
 package netlib
 
 import (
+	"fmt"
+	"math"
 	"testing"
 
+	"golang.org/x/exp/rand"
+
 	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
 	"gonum.org/v1/gonum/lapack/testlapack"
 )
 
@@ -64,6 +69,44 @@ func TestDlange(t *testing.T) {
 	testlapack.DlangeTest(t, impl)
 }
 
+func TestDlangb(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 5, 10} {
+		for _, kl := range []int{0, 1, 3} {
+			for _, ku := range []int{0, 2, 4} {
+				for _, extra := range []int{0, 3} {
+					ldab := kl + ku + 1 + extra
+					ab := make([]float64, max(1, n)*ldab)
+					for i := range ab {
+						ab[i] = rnd.NormFloat64()
+					}
+					// Form the dense matrix from the band.
+					lda := max(1, n)
+					a := make([]float64, n*lda)
+					for i := 0; i < n; i++ {
+						for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+							a[i*lda+j] = ab[i*ldab+kl+j-i]
+						}
+					}
+					for _, norm := range []lapack.MatrixNorm{lapack.MaxAbs, lapack.MaxRowSum, lapack.MaxColumnSum, lapack.Frobenius} {
+						name := fmt.Sprintf("n=%d,kl=%d,ku=%d,ldab=%d,norm=%c", n, kl, ku, ldab, norm)
+						work := make([]float64, n)
+						got := impl.Dlangb(norm, n, kl, ku, ab, ldab, work)
+						want := impl.Dlange(norm, n, n, a, lda, work)
+						if math.Abs(got-want) > 1e-14*math.Max(1, want) {
+							t.Errorf("%s: unexpected norm: got %v want %v", name, got, want)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDlansb(t *testing.T) {
+	testlapack.DlansbTest(t, impl)
+}
+
 func TestDlansy(t *testing.T) {
 	testlapack.DlansyTest(t, impl)
 }
@@ -108,6 +151,10 @@ func TestDlaswp(t *testing.T) {
 	testlapack.DlaswpTest(t, impl)
 }
 
+func TestDlatrs(t *testing.T) {
+	testlapack.DlatrsTest(t, impl)
+}
+
 func TestDpbcon(t *testing.T) {
 	testlapack.DpbconTest(t, impl)
 }
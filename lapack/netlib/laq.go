@@ -0,0 +1,119 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import "gonum.org/v1/gonum/blas"
+
+// The functions below scale a matrix as the ?laqge and ?laqsy routines of
+// LAPACK do. They are used by Dlaqge, Dlaqsy and their float32 versions if
+// the library does not provide the routines.
+
+// laqThresh is the ratio of the smallest to the largest scale factor below
+// which a matrix is scaled.
+const laqThresh = 0.1
+
+// dlaqge scales the m×n matrix a as described for Dlaqge.
+func dlaqge(m, n int, a []float64, lda int, r, c []float64, rowcnd, colcnd, amax float64) Equilibration {
+	// small is dlamch('S')/dlamch('P').
+	const small = dlamchS / (2 * dlamchE)
+	const large = 1 / small
+
+	rows := rowcnd < laqThresh || amax < small || amax > large
+	cols := colcnd < laqThresh
+	for i := 0; i < m; i++ {
+		row := a[i*lda : i*lda+n]
+		for j := range row {
+			switch {
+			case rows && cols:
+				row[j] *= r[i] * c[j]
+			case rows:
+				row[j] *= r[i]
+			case cols:
+				row[j] *= c[j]
+			}
+		}
+	}
+	switch {
+	case rows && cols:
+		return BothEquilibration
+	case rows:
+		return RowEquilibration
+	case cols:
+		return ColEquilibration
+	}
+	return NoEquilibration
+}
+
+// slaqge is the float32 version of dlaqge.
+func slaqge(m, n int, a []float32, lda int, r, c []float32, rowcnd, colcnd, amax float32) Equilibration {
+	const small = slamchS / (2 * slamchE)
+	const large = 1 / small
+
+	rows := rowcnd < laqThresh || amax < small || amax > large
+	cols := colcnd < laqThresh
+	for i := 0; i < m; i++ {
+		row := a[i*lda : i*lda+n]
+		for j := range row {
+			switch {
+			case rows && cols:
+				row[j] *= r[i] * c[j]
+			case rows:
+				row[j] *= r[i]
+			case cols:
+				row[j] *= c[j]
+			}
+		}
+	}
+	switch {
+	case rows && cols:
+		return BothEquilibration
+	case rows:
+		return RowEquilibration
+	case cols:
+		return ColEquilibration
+	}
+	return NoEquilibration
+}
+
+// dlaqsy scales the triangle uplo of the n×n symmetric matrix a as
+// described for Dlaqsy.
+func dlaqsy(uplo blas.Uplo, n int, a []float64, lda int, s []float64, scond, amax float64) bool {
+	const small = dlamchS / (2 * dlamchE)
+	const large = 1 / small
+
+	if scond >= laqThresh && amax >= small && amax <= large {
+		return false
+	}
+	for i := 0; i < n; i++ {
+		j0, j1 := i, n
+		if uplo == blas.Lower {
+			j0, j1 = 0, i+1
+		}
+		for j := j0; j < j1; j++ {
+			a[i*lda+j] *= s[i] * s[j]
+		}
+	}
+	return true
+}
+
+// slaqsy is the float32 version of dlaqsy.
+func slaqsy(uplo blas.Uplo, n int, a []float32, lda int, s []float32, scond, amax float32) bool {
+	const small = slamchS / (2 * slamchE)
+	const large = 1 / small
+
+	if scond >= laqThresh && amax >= small && amax <= large {
+		return false
+	}
+	for i := 0; i < n; i++ {
+		j0, j1 := i, n
+		if uplo == blas.Lower {
+			j0, j1 = 0, i+1
+		}
+		for j := j0; j < j1; j++ {
+			a[i*lda+j] *= s[i] * s[j]
+		}
+	}
+	return true
+}
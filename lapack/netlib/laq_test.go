@@ -0,0 +1,171 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestDlaqge(t *testing.T) {
+	const tol = 1e-14
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		rowcnd, colcnd, amax float64
+		want                 Equilibration
+	}{
+		{rowcnd: 0.5, colcnd: 0.5, amax: 1, want: NoEquilibration},
+		{rowcnd: 0.01, colcnd: 0.5, amax: 1, want: RowEquilibration},
+		{rowcnd: 0.5, colcnd: 0.01, amax: 1, want: ColEquilibration},
+		{rowcnd: 0.01, colcnd: 0.01, amax: 1, want: BothEquilibration},
+		{rowcnd: 0.5, colcnd: 0.5, amax: 1e300, want: RowEquilibration},
+		{rowcnd: 0.5, colcnd: 0.01, amax: 1e-300, want: BothEquilibration},
+	} {
+		for _, dims := range [][2]int{{1, 1}, {4, 3}, {3, 5}} {
+			m, n := dims[0], dims[1]
+			lda := n + 2
+			name := fmt.Sprintf("m=%d,n=%d,rowcnd=%v,colcnd=%v,amax=%v", m, n, test.rowcnd, test.colcnd, test.amax)
+			a := randomSlice(rnd, (m-1)*lda+n)
+			r := randomSlice(rnd, m)
+			c := randomSlice(rnd, n)
+			want := append([]float64(nil), a...)
+			for i := 0; i < m; i++ {
+				for j := 0; j < n; j++ {
+					if test.want == RowEquilibration || test.want == BothEquilibration {
+						want[i*lda+j] *= r[i]
+					}
+					if test.want == ColEquilibration || test.want == BothEquilibration {
+						want[i*lda+j] *= c[j]
+					}
+				}
+			}
+
+			for _, f := range []struct {
+				name string
+				fn   func(a []float64) Equilibration
+			}{
+				{"Dlaqge", func(a []float64) Equilibration {
+					return impl.Dlaqge(m, n, a, lda, r, c, test.rowcnd, test.colcnd, test.amax)
+				}},
+				{"dlaqge", func(a []float64) Equilibration {
+					return dlaqge(m, n, a, lda, r, c, test.rowcnd, test.colcnd, test.amax)
+				}},
+			} {
+				got := append([]float64(nil), a...)
+				equed := f.fn(got)
+				if equed != test.want {
+					t.Errorf("%s: %s: unexpected equilibration: got %c want %c", name, f.name, equed, test.want)
+				}
+				if !floats.EqualApprox(got, want, tol) {
+					t.Errorf("%s: %s: unexpected scaled matrix", name, f.name)
+				}
+			}
+		}
+	}
+}
+
+func TestDlaqsy(t *testing.T) {
+	const tol = 1e-14
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		scond, amax float64
+		want        bool
+	}{
+		{scond: 0.5, amax: 1, want: false},
+		{scond: 0.01, amax: 1, want: true},
+		{scond: 0.5, amax: 1e300, want: true},
+	} {
+		for _, n := range []int{1, 4, 7} {
+			for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+				lda := n + 1
+				name := fmt.Sprintf("n=%d,uplo=%c,scond=%v,amax=%v", n, uplo, test.scond, test.amax)
+				a := randomSlice(rnd, (n-1)*lda+n)
+				s := randomSlice(rnd, n)
+				want := append([]float64(nil), a...)
+				if test.want {
+					for i := 0; i < n; i++ {
+						for j := 0; j < n; j++ {
+							if (uplo == blas.Upper) == (j >= i) {
+								want[i*lda+j] *= s[i] * s[j]
+							}
+						}
+					}
+				}
+
+				got := append([]float64(nil), a...)
+				if scaled := impl.Dlaqsy(uplo, n, got, lda, s, test.scond, test.amax); scaled != test.want {
+					t.Errorf("%s: unexpected result of Dlaqsy: got %t want %t", name, scaled, test.want)
+				}
+				if !floats.EqualApprox(got, want, tol) {
+					t.Errorf("%s: unexpected matrix scaled by Dlaqsy", name)
+				}
+				got = append(got[:0], a...)
+				if scaled := dlaqsy(uplo, n, got, lda, s, test.scond, test.amax); scaled != test.want {
+					t.Errorf("%s: unexpected result of dlaqsy: got %t want %t", name, scaled, test.want)
+				}
+				if !floats.EqualApprox(got, want, tol) {
+					t.Errorf("%s: unexpected matrix scaled by dlaqsy", name)
+				}
+			}
+		}
+	}
+}
+
+func TestSlaqge(t *testing.T) {
+	const m, n, lda = 3, 4, 5
+	a := make([]float32, (m-1)*lda+n)
+	for i := range a {
+		a[i] = float32(i + 1)
+	}
+	r := []float32{1, 2, 4}
+	c := []float32{0.5, 1, 2, 4}
+	got := append([]float32(nil), a...)
+	if equed := impl.Slaqge(m, n, got, lda, r, c, 0.05, 0.05, 12); equed != BothEquilibration {
+		t.Errorf("unexpected equilibration: got %c want %c", equed, BothEquilibration)
+	}
+	for i := 0; i < m; i++ {
+		for j := 0; j < lda && i*lda+j < len(a); j++ {
+			want := a[i*lda+j]
+			if j < n {
+				want *= r[i] * c[j]
+			}
+			if got[i*lda+j] != want {
+				t.Errorf("unexpected element (%d,%d): got %v want %v", i, j, got[i*lda+j], want)
+			}
+		}
+	}
+
+	s := []float32{2, 0.5, 1}
+	got = append(got[:0], a...)
+	if !impl.Slaqsy(blas.Lower, m, got, lda, s, 0.05, 12) {
+		t.Error("unexpected unscaled matrix")
+	}
+	for i := 0; i < m; i++ {
+		for j := 0; j < m; j++ {
+			want := a[i*lda+j]
+			if j <= i {
+				want *= s[i] * s[j]
+			}
+			if got[i*lda+j] != want {
+				t.Errorf("unexpected element (%d,%d) of the symmetric matrix: got %v want %v", i, j, got[i*lda+j], want)
+			}
+		}
+	}
+}
+
+// randomSlice returns a slice of n elements drawn from the standard normal
+// distribution.
+func randomSlice(rnd *rand.Rand, n int) []float64 {
+	s := make([]float64, n)
+	for i := range s {
+		s[i] = rnd.NormFloat64()
+	}
+	return s
+}
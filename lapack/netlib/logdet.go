@@ -0,0 +1,112 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// SlogDet returns the sign and the natural logarithm of the absolute value of
+// the determinant of the n×n matrix A, computed from its LU factorization
+// with partial pivoting by Dgetrf, so that
+//  det(A) = sign * exp(logAbsDet).
+// The magnitudes of the pivots are accumulated as a fraction and a binary
+// exponent, so the result is accurate even when the determinant itself
+// overflows or underflows. The input a is not modified.
+//
+// As with numpy.linalg.slogdet, SlogDet returns sign 0 and logAbsDet -∞ if A
+// is exactly singular, and sign 1 and logAbsDet 0 if n is zero. SlogDet will
+// panic if A is not square.
+func SlogDet(a blas64.General) (sign, logAbsDet float64) {
+	if a.Rows != a.Cols {
+		panic(badShapeA)
+	}
+	n := a.Rows
+	if n == 0 {
+		return 1, 0
+	}
+
+	lu := cloneGeneral(a)
+	ipiv := make([]int, n)
+	lapackImpl.Dgetrf(n, n, lu.Data, lu.Stride, ipiv)
+
+	sign = 1
+	var acc logAccumulator
+	for i := 0; i < n; i++ {
+		u := lu.Data[i*lu.Stride+i]
+		if u == 0 {
+			return 0, math.Inf(-1)
+		}
+		if u < 0 {
+			sign = -sign
+		}
+		if ipiv[i] != i {
+			sign = -sign
+		}
+		acc.mul(math.Abs(u))
+	}
+	return sign, acc.log()
+}
+
+// LogDet returns the natural logarithm of the determinant of the symmetric
+// positive definite matrix A, computed from its Cholesky factorization by
+// Dpotrf as
+//  log(det(A)) = 2 * Σ log(T_ii).
+// Only the triangle of A indicated by a.Uplo is referenced, and the input a
+// is not modified. As for SlogDet, the result does not overflow or underflow
+// for matrices whose determinant is not representable.
+//
+// If A is not positive definite, LogDet returns NaN and an
+// ErrNotPositiveDefinite; use SlogDet for indefinite matrices.
+func LogDet(a blas64.Symmetric) (float64, error) {
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	n := a.N
+	t := blas64.Triangular{
+		Uplo:   a.Uplo,
+		Diag:   blas.NonUnit,
+		N:      n,
+		Stride: max(1, n),
+		Data:   make([]float64, n*n),
+	}
+	err := cholesky(a, t, 0)
+	if err != nil {
+		return math.NaN(), err
+	}
+	var acc logAccumulator
+	for i := 0; i < n; i++ {
+		acc.mul(t.Data[i*t.Stride+i])
+	}
+	return 2 * acc.log(), nil
+}
+
+// logAccumulator forms a product of positive numbers as frac * 2^exp with
+// frac in [0.5, 1), so that the product neither overflows nor underflows.
+type logAccumulator struct {
+	frac float64
+	exp  int
+}
+
+func (l *logAccumulator) mul(v float64) {
+	if l.frac == 0 {
+		l.frac = 1
+	}
+	f, e := math.Frexp(v)
+	frac, e2 := math.Frexp(l.frac * f)
+	l.frac = frac
+	l.exp += e + e2
+}
+
+// log returns the natural logarithm of the accumulated product.
+func (l *logAccumulator) log() float64 {
+	if l.frac == 0 {
+		return 0
+	}
+	return math.Log(l.frac) + float64(l.exp)*math.Ln2
+}
@@ -0,0 +1,73 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// SlogDet32 is the float32 version of SlogDet. The LU factorization is
+// computed by Sgetrf and the pivots are accumulated in float64, so the
+// result is as accurate as the factorization.
+func SlogDet32(a blas32.General) (sign, logAbsDet float32) {
+	if a.Rows != a.Cols {
+		panic(badShapeA)
+	}
+	n := a.Rows
+	if n == 0 {
+		return 1, 0
+	}
+
+	lu := cloneGeneral32(a)
+	ipiv := make([]lapacke.Int, n)
+	lapacke.Sgetrf(n, n, lu.Data, lu.Stride, ipiv)
+
+	sign = 1
+	var acc logAccumulator
+	for i := 0; i < n; i++ {
+		u := lu.Data[i*lu.Stride+i]
+		if u == 0 {
+			return 0, float32(math.Inf(-1))
+		}
+		if u < 0 {
+			sign = -sign
+		}
+		// ipiv is one-based.
+		if int(ipiv[i]) != i+1 {
+			sign = -sign
+		}
+		acc.mul(math.Abs(float64(u)))
+	}
+	return sign, float32(acc.log())
+}
+
+// LogDet32 is the float32 version of LogDet. The Cholesky factorization is
+// computed by Spotrf.
+func LogDet32(a blas32.Symmetric) (float32, error) {
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	n := a.N
+	t := blas32.Triangular{
+		Uplo:   a.Uplo,
+		Diag:   blas.NonUnit,
+		N:      n,
+		Stride: max(1, n),
+		Data:   make([]float32, n*n),
+	}
+	err := cholesky32(a, t, 0)
+	if err != nil {
+		return float32(math.NaN()), err
+	}
+	var acc logAccumulator
+	for i := 0; i < n; i++ {
+		acc.mul(float64(t.Data[i*t.Stride+i]))
+	}
+	return float32(2 * acc.log()), nil
+}
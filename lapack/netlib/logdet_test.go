@@ -0,0 +1,119 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestSlogDet(t *testing.T) {
+	const tol = 1e-12
+	for _, test := range []struct {
+		a         blas64.General
+		sign      float64
+		logAbsDet float64
+	}{
+		{
+			a:         blas64.General{Stride: 1},
+			sign:      1,
+			logAbsDet: 0,
+		},
+		{
+			a:         blas64.General{Rows: 1, Cols: 1, Stride: 1, Data: []float64{-3}},
+			sign:      -1,
+			logAbsDet: math.Log(3),
+		},
+		{
+			// Requires a row interchange.
+			a: blas64.General{Rows: 2, Cols: 2, Stride: 3, Data: []float64{
+				1, 2, math.NaN(),
+				3, 4, math.NaN(),
+			}},
+			sign:      -1,
+			logAbsDet: math.Log(2),
+		},
+		{
+			a: blas64.General{Rows: 3, Cols: 3, Stride: 3, Data: []float64{
+				1, 2, 3,
+				2, 4, 6,
+				1, 0, 1,
+			}},
+			sign:      0,
+			logAbsDet: math.Inf(-1),
+		},
+	} {
+		aCopy := cloneGeneral(test.a)
+		sign, logAbsDet := SlogDet(test.a)
+		if sign != test.sign {
+			t.Errorf("n=%d: unexpected sign: got %v want %v", test.a.Rows, sign, test.sign)
+		}
+		if math.Abs(logAbsDet-test.logAbsDet) > tol && logAbsDet != test.logAbsDet {
+			t.Errorf("n=%d: unexpected log determinant: got %v want %v", test.a.Rows, logAbsDet, test.logAbsDet)
+		}
+		if maxDiff(cloneGeneral(test.a), aCopy, false) != 0 {
+			t.Errorf("n=%d: input modified", test.a.Rows)
+		}
+	}
+
+	// The determinant of 1e200*I overflows, and that of 1e-200*I
+	// underflows, but their logarithms are representable.
+	for _, scale := range []float64{1e200, -1e200, 1e-200} {
+		const n = 9
+		a := newGeneral(n, n)
+		for i := 0; i < n; i++ {
+			a.Data[i*a.Stride+i] = scale
+		}
+		sign, logAbsDet := SlogDet(a)
+		wantSign := math.Copysign(1, scale) // n is odd.
+		want := n * math.Log(math.Abs(scale))
+		if sign != wantSign || math.Abs(logAbsDet-want) > tol*math.Abs(want) {
+			t.Errorf("scale=%v: unexpected result: got (%v, %v) want (%v, %v)", scale, sign, logAbsDet, wantSign, want)
+		}
+	}
+}
+
+func TestLogDet(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 4, 20} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			name := fmt.Sprintf("n=%d,uplo=%c", n, uplo)
+			a := randomSPD(rnd, n, n)
+			a.Uplo = uplo
+			aCopy := make([]float64, len(a.Data))
+			copy(aCopy, a.Data)
+
+			got, err := LogDet(a)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			sign, want := SlogDet(blas64.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data})
+			if sign != 1 {
+				t.Errorf("%s: unexpected sign of determinant: %v", name, sign)
+			}
+			if math.Abs(got-want) > tol*math.Max(1, math.Abs(want)) {
+				t.Errorf("%s: unexpected log determinant: got %v want %v", name, got, want)
+			}
+			if !floats.Equal(a.Data, aCopy) {
+				t.Errorf("%s: input modified", name)
+			}
+		}
+	}
+
+	a := blas64.Symmetric{Uplo: blas.Upper, N: 2, Stride: 2, Data: []float64{1, 2, 2, 1}}
+	got, err := LogDet(a)
+	if _, ok := err.(ErrNotPositiveDefinite); !ok || !math.IsNaN(got) {
+		t.Errorf("unexpected result for indefinite matrix: %v, %v", got, err)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"errors"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// ErrRankDeficient is returned by LeastSquares when A does not have full
+// rank. LeastSquaresSVD and MinNormSolve handle rank deficient matrices.
+var ErrRankDeficient = errors.New("lapack: matrix is rank deficient")
+
+// LeastSquares computes the solution of the linear least squares problem
+//
+//	minimize ||A*X - B||_F
+//
+// if the m×n matrix A has m >= n, and the minimum norm solution of the
+// underdetermined system A*X = B otherwise, by Dgetsls. A must have full
+// rank. For a tall and skinny A the tall skinny QR factorization used by
+// Dgetsls is considerably faster than the blocked QR factorization of Dgels,
+// which is used instead if the LAPACKE library predates LAPACK 3.7.
+//
+// b must have m rows, otherwise LeastSquares will panic. The returned x is
+// n×b.Cols. The inputs a and b are not modified. If A does not have full
+// rank, LeastSquares returns ErrRankDeficient.
+func LeastSquares(a, b blas64.General) (x blas64.General, err error) {
+	m, n := a.Rows, a.Cols
+	if b.Rows != m {
+		panic(badShapeB)
+	}
+	nrhs := b.Cols
+	x = newGeneral(n, nrhs)
+	if m == 0 || n == 0 || nrhs == 0 {
+		return x, nil
+	}
+
+	ac := cloneGeneral(a)
+	bc := newLstsqRHS(b, max(m, n))
+	work := make([]float64, 1)
+	lapackImpl.Dgetsls(blas.NoTrans, m, n, nrhs, ac.Data, ac.Stride, bc.Data, bc.Stride, work, -1)
+	work = make([]float64, max(1, int(work[0])))
+	if !lapackImpl.Dgetsls(blas.NoTrans, m, n, nrhs, ac.Data, ac.Stride, bc.Data, bc.Stride, work, len(work)) {
+		return x, ErrRankDeficient
+	}
+	copyRows(x, bc)
+	return x, nil
+}
+
+// LeastSquaresSVD computes the minimum norm solution of the linear least
+// squares problem
+//
+//	minimize ||A*X - B||_F
+//
+// for the m×n matrix A, which may be rank deficient, using the singular value
+// decomposition of A computed by the divide and conquer driver Dgelsd. It is
+// more expensive than MinNormSolve but determines the rank reliably, and the
+// singular values of A are returned in decreasing order in s.
+//
+// Singular values less than or equal to rcond times the largest singular
+// value are treated as zero. If rcond is negative, max(m,n)*2^-52 is used as
+// for Orth. b must have m rows, otherwise LeastSquaresSVD will panic. The
+// returned x is n×b.Cols. The inputs a and b are not modified.
+//
+// If the singular value decomposition fails to converge, LeastSquaresSVD
+// returns ErrIterationLimit.
+func LeastSquaresSVD(a, b blas64.General, rcond float64) (x blas64.General, s []float64, rank int, err error) {
+	m, n := a.Rows, a.Cols
+	if b.Rows != m {
+		panic(badShapeB)
+	}
+	nrhs := b.Cols
+	if rcond < 0 {
+		rcond = float64(max(m, n)) * 2 * dlamchE
+	}
+	x = newGeneral(n, nrhs)
+	s = make([]float64, min(m, n))
+	if m == 0 || n == 0 || nrhs == 0 {
+		return x, s, 0, nil
+	}
+
+	ac := cloneGeneral(a)
+	bc := newLstsqRHS(b, max(m, n))
+	work := make([]float64, 1)
+	iwork := make([]int, 1)
+	lapackImpl.Dgelsd(m, n, nrhs, ac.Data, ac.Stride, bc.Data, bc.Stride, s, rcond, work, -1, iwork)
+	work = make([]float64, int(work[0]))
+	iwork = make([]int, iwork[0])
+	rank, ok := lapackImpl.Dgelsd(m, n, nrhs, ac.Data, ac.Stride, bc.Data, bc.Stride, s, rcond, work, len(work), iwork)
+	if !ok {
+		return x, s, 0, ErrIterationLimit
+	}
+	copyRows(x, bc)
+	return x, s, rank, nil
+}
+
+// newLstsqRHS returns a copy of b with r >= b.Rows rows, as required for the
+// right-hand side of the least squares drivers.
+func newLstsqRHS(b blas64.General, r int) blas64.General {
+	c := newGeneral(r, b.Cols)
+	for i := 0; i < b.Rows; i++ {
+		copy(c.Data[i*c.Stride:i*c.Stride+b.Cols], b.Data[i*b.Stride:])
+	}
+	return c
+}
+
+// copyRows copies the leading dst.Rows rows of src into dst.
+func copyRows(dst, src blas64.General) {
+	for i := 0; i < dst.Rows; i++ {
+		copy(dst.Data[i*dst.Stride:i*dst.Stride+dst.Cols], src.Data[i*src.Stride:i*src.Stride+dst.Cols])
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+)
+
+// LeastSquares32 is the float32 version of LeastSquares. The problem is
+// solved by Sgetsls.
+func LeastSquares32(a, b blas32.General) (x blas32.General, err error) {
+	m, n := a.Rows, a.Cols
+	if b.Rows != m {
+		panic(badShapeB)
+	}
+	nrhs := b.Cols
+	x = newGeneral32(n, nrhs)
+	if m == 0 || n == 0 || nrhs == 0 {
+		return x, nil
+	}
+
+	ac := cloneGeneral32(a)
+	bc := newLstsqRHS32(b, max(m, n))
+	work := make([]float32, 1)
+	lapackImpl.Sgetsls(blas.NoTrans, m, n, nrhs, ac.Data, ac.Stride, bc.Data, bc.Stride, work, -1)
+	work = make([]float32, max(1, int(work[0])))
+	if !lapackImpl.Sgetsls(blas.NoTrans, m, n, nrhs, ac.Data, ac.Stride, bc.Data, bc.Stride, work, len(work)) {
+		return x, ErrRankDeficient
+	}
+	copyRows32(x, bc)
+	return x, nil
+}
+
+// LeastSquaresSVD32 is the float32 version of LeastSquaresSVD. The problem
+// is solved by Sgelsd. If rcond is negative, max(m,n)*2^-23 is used.
+func LeastSquaresSVD32(a, b blas32.General, rcond float32) (x blas32.General, s []float32, rank int, err error) {
+	m, n := a.Rows, a.Cols
+	if b.Rows != m {
+		panic(badShapeB)
+	}
+	nrhs := b.Cols
+	if rcond < 0 {
+		rcond = float32(max(m, n)) * 2 * slamchE
+	}
+	x = newGeneral32(n, nrhs)
+	s = make([]float32, min(m, n))
+	if m == 0 || n == 0 || nrhs == 0 {
+		return x, s, 0, nil
+	}
+
+	ac := cloneGeneral32(a)
+	bc := newLstsqRHS32(b, max(m, n))
+	work := make([]float32, 1)
+	iwork := make([]int, 1)
+	lapackImpl.Sgelsd(m, n, nrhs, ac.Data, ac.Stride, bc.Data, bc.Stride, s, rcond, work, -1, iwork)
+	work = make([]float32, int(work[0]))
+	iwork = make([]int, iwork[0])
+	rank, ok := lapackImpl.Sgelsd(m, n, nrhs, ac.Data, ac.Stride, bc.Data, bc.Stride, s, rcond, work, len(work), iwork)
+	if !ok {
+		return x, s, 0, ErrIterationLimit
+	}
+	copyRows32(x, bc)
+	return x, s, rank, nil
+}
+
+// newLstsqRHS32 is the float32 version of newLstsqRHS.
+func newLstsqRHS32(b blas32.General, r int) blas32.General {
+	c := newGeneral32(r, b.Cols)
+	for i := 0; i < b.Rows; i++ {
+		copy(c.Data[i*c.Stride:i*c.Stride+b.Cols], b.Data[i*b.Stride:])
+	}
+	return c
+}
+
+// copyRows32 is the float32 version of copyRows.
+func copyRows32(dst, src blas32.General) {
+	for i := 0; i < dst.Rows; i++ {
+		copy(dst.Data[i*dst.Stride:i*dst.Stride+dst.Cols], src.Data[i*src.Stride:i*src.Stride+dst.Cols])
+	}
+}
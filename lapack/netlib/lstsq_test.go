@@ -0,0 +1,137 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestLeastSquares(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, nrhs int
+	}{
+		{m: 0, n: 3, nrhs: 2},
+		{m: 1, n: 1, nrhs: 1},
+		{m: 6, n: 6, nrhs: 2},
+		{m: 200, n: 5, nrhs: 3},
+		{m: 4, n: 10, nrhs: 1},
+	} {
+		name := fmt.Sprintf("m=%d,n=%d,nrhs=%d", test.m, test.n, test.nrhs)
+		a := randomGeneral(rnd, test.m, test.n, test.n+1)
+		b := randomGeneral(rnd, test.m, test.nrhs, test.nrhs+2)
+		aCopy := cloneGeneral(a)
+		bCopy := cloneGeneral(b)
+
+		x, err := LeastSquares(a, b)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if !floats.Equal(cloneGeneral(a).Data, aCopy.Data) || !floats.Equal(cloneGeneral(b).Data, bCopy.Data) {
+			t.Errorf("%s: input modified", name)
+		}
+		if x.Rows != test.n || x.Cols != test.nrhs {
+			t.Errorf("%s: unexpected solution shape %d×%d", name, x.Rows, x.Cols)
+			continue
+		}
+		if test.m == 0 {
+			continue
+		}
+		p, _, err := PInv(a, 1e-12)
+		if err != nil {
+			t.Fatalf("%s: unexpected error from PInv: %v", name, err)
+		}
+		want := mul(p, cloneGeneral(b))
+		if d := maxDiff(x, want, false); d > tol {
+			t.Errorf("%s: solution differs from pseudo-inverse solution: %v", name, d)
+		}
+	}
+
+	// A matrix with a zero column does not have full rank.
+	a := randomGeneral(rnd, 8, 3, 3)
+	for i := 0; i < a.Rows; i++ {
+		a.Data[i*a.Stride+1] = 0
+	}
+	if _, err := LeastSquares(a, randomGeneral(rnd, 8, 1, 1)); err != ErrRankDeficient {
+		t.Errorf("unexpected error for rank deficient matrix: got %v want %v", err, ErrRankDeficient)
+	}
+}
+
+func TestLeastSquaresSVD(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, rank, nrhs int
+	}{
+		{m: 0, n: 3, rank: 0, nrhs: 2},
+		{m: 1, n: 1, rank: 1, nrhs: 1},
+		{m: 6, n: 6, rank: 6, nrhs: 2},
+		{m: 60, n: 4, rank: 4, nrhs: 3},
+		{m: 4, n: 10, rank: 4, nrhs: 1},
+		{m: 9, n: 7, rank: 3, nrhs: 2},
+		{m: 5, n: 8, rank: 2, nrhs: 4},
+	} {
+		name := fmt.Sprintf("m=%d,n=%d,rank=%d,nrhs=%d", test.m, test.n, test.rank, test.nrhs)
+		a := newGeneral(test.m, test.n)
+		if test.rank > 0 {
+			a = mul(randomGeneral(rnd, test.m, test.rank, test.rank), randomGeneral(rnd, test.rank, test.n, test.n))
+		}
+		b := randomGeneral(rnd, test.m, test.nrhs, test.nrhs+2)
+		aCopy := cloneGeneral(a)
+
+		x, s, rank, err := LeastSquaresSVD(a, b, 1e-10)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if !floats.Equal(a.Data, aCopy.Data) {
+			t.Errorf("%s: input modified", name)
+		}
+		if rank != test.rank {
+			t.Errorf("%s: unexpected rank: got %d want %d", name, rank, test.rank)
+		}
+		if len(s) != min(test.m, test.n) {
+			t.Errorf("%s: unexpected number of singular values %d", name, len(s))
+		}
+		for i := 1; i < len(s); i++ {
+			if s[i] > s[i-1] {
+				t.Errorf("%s: singular values not sorted: %v", name, s)
+				break
+			}
+		}
+		if test.m == 0 {
+			continue
+		}
+		// The Frobenius norm of A is the 2-norm of its singular values.
+		var fa, fs float64
+		for _, v := range a.Data {
+			fa += v * v
+		}
+		for _, v := range s {
+			fs += v * v
+		}
+		if math.Abs(math.Sqrt(fa)-math.Sqrt(fs)) > tol*math.Sqrt(fa) {
+			t.Errorf("%s: singular values do not match the norm of A", name)
+		}
+
+		// The minimum norm solution is A^+ * B.
+		p, _, err := PInv(a, 1e-10)
+		if err != nil {
+			t.Fatalf("%s: unexpected error from PInv: %v", name, err)
+		}
+		want := mul(p, cloneGeneral(b))
+		if d := maxDiff(x, want, false); d > tol {
+			t.Errorf("%s: solution differs from pseudo-inverse solution: %v", name, d)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"errors"
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// ErrNegativeEigenvalue is returned by MatPow and HermPow when a fractional
+// power is requested of a matrix with an eigenvalue that is negative beyond
+// the threshold of the PowOptions.
+var ErrNegativeEigenvalue = errors.New("lapack: negative eigenvalue for fractional power")
+
+// PowOptions specifies how MatPow and HermPow treat the eigenvalues that
+// are zero to working precision.
+type PowOptions struct {
+	// RTol is the threshold relative to the largest eigenvalue magnitude
+	// below which an eigenvalue is treated as zero. If RTol is zero, n
+	// times the machine epsilon is used.
+	RTol float64
+
+	// Floor specifies that eigenvalues below the threshold are replaced by
+	// the threshold before the power is taken, which regularizes negative
+	// powers such as the inverse square root used for whitening. If Floor
+	// is false, they are set to zero, and for p < 0 their power is zero,
+	// so that a negative power is the corresponding power of the
+	// pseudo-inverse.
+	Floor bool
+}
+
+// MatPow returns A^p for the symmetric n×n matrix A and real power p,
+// computed from the eigendecomposition A = V * diag(λ) * V^T by Dsyevd as
+//
+//	A^p = V * diag(λ^p) * V^T.
+//
+// Eigenvalues with magnitude at most the threshold given by opts are
+// treated as described by PowOptions, and a nil opts is equivalent to the
+// zero PowOptions. For p = -1/2 MatPow returns the inverse square root of
+// a covariance matrix. The result is stored with both triangles and the
+// Uplo of a. The input a is not modified.
+//
+// If p is not an integer and A has an eigenvalue below minus the threshold,
+// MatPow returns ErrNegativeEigenvalue. If the eigensolver fails to
+// converge, it returns ErrIterationLimit.
+func MatPow(a blas64.Symmetric, p float64, opts *PowOptions) (blas64.Symmetric, error) {
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	n := a.N
+	ws := getWorkspace()
+	defer putWorkspace(ws)
+	ac := ws.general(blas64.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data})
+	w, v, ok := symEig(Syevd, blas64.Symmetric{Uplo: a.Uplo, N: n, Stride: ac.Stride, Data: ac.Data}, &ws.workspace)
+	if !ok {
+		return blas64.Symmetric{}, ErrIterationLimit
+	}
+	f, err := spectralPow(w, p, opts, dlamchE)
+	if err != nil {
+		return blas64.Symmetric{}, err
+	}
+	// Form V * diag(f) * V^T from the scaled columns of V.
+	vf := cloneGeneral(v)
+	for i := 0; i < n; i++ {
+		for j, fj := range f {
+			vf.Data[i*vf.Stride+j] *= fj
+		}
+	}
+	r := newGeneral(n, n)
+	if n > 0 {
+		blasImpl.Dgemm(blas.NoTrans, blas.Trans, n, n, n, 1, vf.Data, vf.Stride, v.Data, v.Stride, 0, r.Data, r.Stride)
+	}
+	return blas64.Symmetric{Uplo: a.Uplo, N: n, Stride: r.Stride, Data: r.Data}, nil
+}
+
+// HermPow returns A^p for the Hermitian n×n matrix A and real power p,
+// computed from the eigendecomposition A = V * diag(λ) * V^H by Zheevd as
+//
+//	A^p = V * diag(λ^p) * V^H.
+//
+// The eigenvalues are treated as by MatPow. The result is stored with both
+// triangles and the Uplo of a. The input a is not modified.
+func HermPow(a cblas128.Hermitian, p float64, opts *PowOptions) (cblas128.Hermitian, error) {
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	n := a.N
+	v := newCGeneral(n, n)
+	for i := 0; i < n; i++ {
+		copy(v.Data[i*v.Stride:i*v.Stride+n], a.Data[i*a.Stride:])
+	}
+	w := make([]float64, n)
+	if n > 0 {
+		work := make([]complex128, 1)
+		rwork := make([]float64, 1)
+		iwork := make([]lapacke.Int, 1)
+		lapacke.Zheevd('V', byte(a.Uplo), n, v.Data, v.Stride, w, work, -1, rwork, -1, iwork, -1)
+		work = make([]complex128, int(real(work[0])))
+		rwork = make([]float64, int(rwork[0]))
+		iwork = make([]lapacke.Int, iwork[0])
+		if !lapacke.Zheevd('V', byte(a.Uplo), n, v.Data, v.Stride, w, work, len(work), rwork, len(rwork), iwork, len(iwork)) {
+			return cblas128.Hermitian{}, ErrIterationLimit
+		}
+	}
+	f, err := spectralPow(w, p, opts, dlamchE)
+	if err != nil {
+		return cblas128.Hermitian{}, err
+	}
+	// Form V * diag(f) * V^H from the scaled columns of V.
+	vf := newCGeneral(n, n)
+	for i := 0; i < n; i++ {
+		for j, fj := range f {
+			vf.Data[i*vf.Stride+j] = v.Data[i*v.Stride+j] * complex(fj, 0)
+		}
+	}
+	r := newCGeneral(n, n)
+	if n > 0 {
+		blasImpl.Zgemm(blas.NoTrans, blas.ConjTrans, n, n, n, 1, vf.Data, vf.Stride, v.Data, v.Stride, 0, r.Data, r.Stride)
+	}
+	return cblas128.Hermitian{Uplo: a.Uplo, N: n, Stride: r.Stride, Data: r.Data}, nil
+}
+
+// spectralPow returns the powers of the eigenvalues w as described by
+// MatPow, where eps is the machine epsilon of the precision of w.
+func spectralPow(w []float64, p float64, opts *PowOptions, eps float64) ([]float64, error) {
+	var o PowOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.RTol == 0 {
+		o.RTol = float64(len(w)) * eps
+	}
+	var wmax float64
+	for _, l := range w {
+		wmax = math.Max(wmax, math.Abs(l))
+	}
+	tol := o.RTol * wmax
+	integer := p == math.Trunc(p)
+	f := make([]float64, len(w))
+	for i, l := range w {
+		switch {
+		case math.Abs(l) <= tol && o.Floor && tol > 0:
+			l = tol
+		case math.Abs(l) <= tol:
+			if p < 0 {
+				continue
+			}
+			l = 0
+		case l < 0 && !integer:
+			return nil, ErrNegativeEigenvalue
+		}
+		f[i] = math.Pow(l, p)
+	}
+	return f, nil
+}
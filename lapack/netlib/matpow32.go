@@ -0,0 +1,46 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+)
+
+// MatPow32 is the float32 version of MatPow. The eigendecomposition is
+// computed by Ssyevd and the default threshold uses the float32 machine
+// epsilon.
+func MatPow32(a blas32.Symmetric, p float64, opts *PowOptions) (blas32.Symmetric, error) {
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	n := a.N
+	ws := getWorkspace()
+	defer putWorkspace(ws)
+	ac := ws.general32(blas32.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data})
+	w, v, ok := symEig32(Syevd, blas32.Symmetric{Uplo: a.Uplo, N: n, Stride: ac.Stride, Data: ac.Data}, &ws.workspace)
+	if !ok {
+		return blas32.Symmetric{}, ErrIterationLimit
+	}
+	w64 := make([]float64, n)
+	for i, l := range w {
+		w64[i] = float64(l)
+	}
+	f, err := spectralPow(w64, p, opts, slamchE)
+	if err != nil {
+		return blas32.Symmetric{}, err
+	}
+	vf := cloneGeneral32(v)
+	for i := 0; i < n; i++ {
+		for j, fj := range f {
+			vf.Data[i*vf.Stride+j] *= float32(fj)
+		}
+	}
+	r := newGeneral32(n, n)
+	if n > 0 {
+		blasImpl.Sgemm(blas.NoTrans, blas.Trans, n, n, n, 1, vf.Data, vf.Stride, v.Data, v.Stride, 0, r.Data, r.Stride)
+	}
+	return blas32.Symmetric{Uplo: a.Uplo, N: n, Stride: r.Stride, Data: r.Data}, nil
+}
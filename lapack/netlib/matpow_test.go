@@ -0,0 +1,156 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+// symGeneral returns the full matrix of a symmetric matrix stored with both
+// triangles.
+func symGeneral(a blas64.Symmetric) blas64.General {
+	return blas64.General{Rows: a.N, Cols: a.N, Stride: a.Stride, Data: a.Data}
+}
+
+func TestMatPow(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 5, 12} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			name := fmt.Sprintf("n=%d,uplo=%c", n, uplo)
+			a := randomSPD(rnd, n, n+2)
+			a.Uplo = uplo
+			g := symGeneral(a)
+			aCopy := cloneGeneral(g)
+			tol := 1e-10 * float64(max(1, n))
+
+			sq, err := MatPow(a, 2, nil)
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", name, err)
+			}
+			if sq.Uplo != uplo {
+				t.Errorf("%s: unexpected uplo %c", name, sq.Uplo)
+			}
+			if d := maxDiff(symGeneral(sq), mul(g, g), false); d > tol*maxAbs(g.Data)*maxAbs(g.Data) {
+				t.Errorf("%s: A^2 mismatch: difference %v", name, d)
+			}
+
+			inv, err := MatPow(a, -1, nil)
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", name, err)
+			}
+			if d := maxDiff(mul(g, symGeneral(inv)), eye(n), false); d > tol {
+				t.Errorf("%s: A*A^-1 differs from I by %v", name, d)
+			}
+
+			root, err := MatPow(a, 0.5, nil)
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", name, err)
+			}
+			r := symGeneral(root)
+			if d := maxDiff(mul(r, r), g, false); d > tol*maxAbs(g.Data) {
+				t.Errorf("%s: square of A^1/2 differs from A by %v", name, d)
+			}
+			if d := maxDiff(r, r, true); d > tol {
+				t.Errorf("%s: A^1/2 not symmetric: difference %v", name, d)
+			}
+
+			// Whitening with the inverse square root.
+			isq, err := MatPow(a, -0.5, nil)
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", name, err)
+			}
+			w := symGeneral(isq)
+			if d := maxDiff(mul(mul(w, g), w), eye(n), false); d > tol {
+				t.Errorf("%s: A^-1/2*A*A^-1/2 differs from I by %v", name, d)
+			}
+
+			if maxDiff(g, aCopy, false) != 0 {
+				t.Errorf("%s: input modified", name)
+			}
+		}
+	}
+}
+
+func TestMatPowSingular(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	const n, rank = 8, 5
+	a := randomSPD(rnd, n, rank)
+	g := symGeneral(a)
+
+	// Without flooring a negative power is that of the pseudo-inverse.
+	pinv, err := MatPow(a, -1, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d := maxDiff(mul(mul(g, symGeneral(pinv)), g), g, false); d > 1e-8*maxAbs(g.Data) {
+		t.Errorf("A*A^+*A differs from A by %v", d)
+	}
+
+	// With flooring the result is finite and of full rank.
+	floored, err := MatPow(a, -0.5, &PowOptions{RTol: 1e-6, Floor: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, v := range floored.Data {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			t.Fatalf("non-finite element in floored power")
+		}
+	}
+	w, _, err := SymEig(floored)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if w[0] <= 0 {
+		t.Errorf("floored power not positive definite: smallest eigenvalue %v", w[0])
+	}
+}
+
+func TestMatPowNegative(t *testing.T) {
+	a := blas64.Symmetric{Uplo: blas.Upper, N: 2, Stride: 2, Data: []float64{
+		-1, 0,
+		0, 2,
+	}}
+	if _, err := MatPow(a, 0.5, nil); err != ErrNegativeEigenvalue {
+		t.Errorf("unexpected error for fractional power: got %v want %v", err, ErrNegativeEigenvalue)
+	}
+	sq, err := MatPow(a, 3, nil)
+	if err != nil {
+		t.Fatalf("unexpected error for integer power: %v", err)
+	}
+	want := blas64.General{Rows: 2, Cols: 2, Stride: 2, Data: []float64{
+		-1, 0,
+		0, 8,
+	}}
+	if d := maxDiff(symGeneral(sq), want, false); d > 1e-14 {
+		t.Errorf("unexpected integer power: difference %v", d)
+	}
+}
+
+func TestHermPow(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 6} {
+		a := randomHPD(rnd, n)
+		h := cblas128.Hermitian{Uplo: blas.Lower, N: n, Stride: a.Stride, Data: a.Data}
+		root, err := HermPow(h, 0.5, nil)
+		if err != nil {
+			t.Fatalf("n=%d: unexpected error: %v", n, err)
+		}
+		r := cblas128.General{Rows: n, Cols: n, Stride: root.Stride, Data: root.Data}
+		if d := cmaxAbsDiff(naiveCMul(r, r), a); d > 1e-10*float64(max(1, n*n)) {
+			t.Errorf("n=%d: square of A^1/2 differs from A by %v", n, d)
+		}
+		if d := cmaxAbsDiff(conjTrans(r), r); d > 1e-12*float64(max(1, n)) {
+			t.Errorf("n=%d: A^1/2 not Hermitian: difference %v", n, d)
+		}
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math/cmplx"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+const (
+	badIndex    = "lapack: index out of range"
+	badShapeMul = "lapack: dimension mismatch in product"
+)
+
+// Matrix is a general matrix together with a transpose that has not been
+// applied to its elements. Transposing a Matrix only changes Trans, and the
+// products computed by Mul and MulVec pass Trans to Dgemm and Dgemv, so
+//
+//	c := netlib.Mul(netlib.Matrix{General: a}.T(), netlib.Matrix{General: b})
+//
+// computes A^T * B without copying A. For a real matrix blas.ConjTrans is
+// the same operation as blas.Trans. The zero Trans is treated as
+// blas.NoTrans.
+type Matrix struct {
+	General blas64.General
+	Trans   blas.Transpose
+}
+
+// T returns the transpose of m. The elements are not copied.
+func (m Matrix) T() Matrix {
+	m.Trans = normTrans(m.Trans)
+	if m.Trans == blas.NoTrans {
+		m.Trans = blas.Trans
+	} else {
+		m.Trans = blas.NoTrans
+	}
+	return m
+}
+
+// Dims returns the number of rows and columns of m after the transpose.
+func (m Matrix) Dims() (r, c int) {
+	m.Trans = normTrans(m.Trans)
+	if m.Trans == blas.NoTrans {
+		return m.General.Rows, m.General.Cols
+	}
+	return m.General.Cols, m.General.Rows
+}
+
+// At returns the element of m at row i and column j after the transpose.
+func (m Matrix) At(i, j int) float64 {
+	m.Trans = normTrans(m.Trans)
+	r, c := m.Dims()
+	if uint(i) >= uint(r) || uint(j) >= uint(c) {
+		panic(badIndex)
+	}
+	if m.Trans != blas.NoTrans {
+		i, j = j, i
+	}
+	return m.General.Data[i*m.General.Stride+j]
+}
+
+// Dense returns a copy of m with the transpose applied to the elements.
+func (m Matrix) Dense() blas64.General {
+	r, c := m.Dims()
+	d := newGeneral(r, c)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			d.Data[i*d.Stride+j] = m.At(i, j)
+		}
+	}
+	return d
+}
+
+// Mul returns the product A * B computed by a single call to Dgemm, with
+// the transposes of a and b passed as its transpose arguments. Mul panics
+// if the columns of A and the rows of B differ.
+func Mul(a, b Matrix) blas64.General {
+	a.Trans, b.Trans = normTrans(a.Trans), normTrans(b.Trans)
+	m, k := a.Dims()
+	kb, n := b.Dims()
+	if k != kb {
+		panic(badShapeMul)
+	}
+	c := newGeneral(m, n)
+	if m == 0 || n == 0 || k == 0 {
+		return c
+	}
+	blasImpl.Dgemm(a.Trans, b.Trans, m, n, k, 1, a.General.Data, a.General.Stride, b.General.Data, b.General.Stride, 0, c.Data, c.Stride)
+	return c
+}
+
+// MulVec returns the product A * x computed by a single call to Dgemv,
+// with the transpose of a passed as its transpose argument. MulVec panics
+// if the length of x differs from the columns of A.
+func MulVec(a Matrix, x []float64) []float64 {
+	a.Trans = normTrans(a.Trans)
+	m, n := a.Dims()
+	if len(x) != n {
+		panic(badLenX)
+	}
+	y := make([]float64, m)
+	if m == 0 || n == 0 {
+		return y
+	}
+	blasImpl.Dgemv(a.Trans, a.General.Rows, a.General.Cols, 1, a.General.Data, a.General.Stride, x, 1, 0, y, 1)
+	return y
+}
+
+// CMatrix is a general complex matrix together with a transpose or conjugate
+// transpose that has not been applied to its elements, as described for
+// Matrix. The products computed by CMul and CMulVec pass Trans to Zgemm and
+// Zgemv.
+//
+// The elementwise conjugate of a matrix cannot be passed to Zgemm or Zgemv,
+// so the transpose of a conjugate transposed CMatrix and the conjugate
+// transpose of a transposed CMatrix, which are both the conjugate of the
+// original matrix, copy the elements.
+type CMatrix struct {
+	General cblas128.General
+	Trans   blas.Transpose
+}
+
+// T returns the transpose of m. The elements are only copied if m is
+// conjugate transposed.
+func (m CMatrix) T() CMatrix {
+	switch normTrans(m.Trans) {
+	case blas.NoTrans:
+		m.Trans = blas.Trans
+	case blas.Trans:
+		m.Trans = blas.NoTrans
+	case blas.ConjTrans:
+		m = CMatrix{General: conjGeneral(m.General), Trans: blas.NoTrans}
+	}
+	return m
+}
+
+// H returns the conjugate transpose of m. The elements are only copied if m
+// is transposed.
+func (m CMatrix) H() CMatrix {
+	switch normTrans(m.Trans) {
+	case blas.NoTrans:
+		m.Trans = blas.ConjTrans
+	case blas.ConjTrans:
+		m.Trans = blas.NoTrans
+	case blas.Trans:
+		m = CMatrix{General: conjGeneral(m.General), Trans: blas.NoTrans}
+	}
+	return m
+}
+
+// Dims returns the number of rows and columns of m after the transpose.
+func (m CMatrix) Dims() (r, c int) {
+	m.Trans = normTrans(m.Trans)
+	if m.Trans == blas.NoTrans {
+		return m.General.Rows, m.General.Cols
+	}
+	return m.General.Cols, m.General.Rows
+}
+
+// At returns the element of m at row i and column j after the transpose.
+func (m CMatrix) At(i, j int) complex128 {
+	m.Trans = normTrans(m.Trans)
+	r, c := m.Dims()
+	if uint(i) >= uint(r) || uint(j) >= uint(c) {
+		panic(badIndex)
+	}
+	if m.Trans == blas.NoTrans {
+		return m.General.Data[i*m.General.Stride+j]
+	}
+	v := m.General.Data[j*m.General.Stride+i]
+	if m.Trans == blas.ConjTrans {
+		v = cmplx.Conj(v)
+	}
+	return v
+}
+
+// Dense returns a copy of m with the transpose applied to the elements.
+func (m CMatrix) Dense() cblas128.General {
+	r, c := m.Dims()
+	d := newCGeneral(r, c)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			d.Data[i*d.Stride+j] = m.At(i, j)
+		}
+	}
+	return d
+}
+
+// CMul returns the product A * B computed by a single call to Zgemm, with
+// the transposes of a and b passed as its transpose arguments. CMul panics
+// if the columns of A and the rows of B differ.
+func CMul(a, b CMatrix) cblas128.General {
+	a.Trans, b.Trans = normTrans(a.Trans), normTrans(b.Trans)
+	m, k := a.Dims()
+	kb, n := b.Dims()
+	if k != kb {
+		panic(badShapeMul)
+	}
+	c := newCGeneral(m, n)
+	if m == 0 || n == 0 || k == 0 {
+		return c
+	}
+	blasImpl.Zgemm(a.Trans, b.Trans, m, n, k, 1, a.General.Data, a.General.Stride, b.General.Data, b.General.Stride, 0, c.Data, c.Stride)
+	return c
+}
+
+// CMulVec returns the product A * x computed by a single call to Zgemv,
+// with the transpose of a passed as its transpose argument. CMulVec panics
+// if the length of x differs from the columns of A.
+func CMulVec(a CMatrix, x []complex128) []complex128 {
+	a.Trans = normTrans(a.Trans)
+	m, n := a.Dims()
+	if len(x) != n {
+		panic(badLenX)
+	}
+	y := make([]complex128, m)
+	if m == 0 || n == 0 {
+		return y
+	}
+	blasImpl.Zgemv(a.Trans, a.General.Rows, a.General.Cols, 1, a.General.Data, a.General.Stride, x, 1, 0, y, 1)
+	return y
+}
+
+// normTrans returns t, or blas.NoTrans if t is the zero value. It panics
+// if t is not a valid transpose.
+func normTrans(t blas.Transpose) blas.Transpose {
+	switch t {
+	case 0:
+		return blas.NoTrans
+	case blas.NoTrans, blas.Trans, blas.ConjTrans:
+		return t
+	}
+	panic(badTrans)
+}
+
+// newCGeneral returns a zeroed r×c complex matrix with a compact stride.
+func newCGeneral(r, c int) cblas128.General {
+	return cblas128.General{
+		Rows:   r,
+		Cols:   c,
+		Stride: max(1, c),
+		Data:   make([]complex128, r*c),
+	}
+}
+
+// conjGeneral returns a copy of a with conjugated elements.
+func conjGeneral(a cblas128.General) cblas128.General {
+	c := newCGeneral(a.Rows, a.Cols)
+	for i := 0; i < a.Rows; i++ {
+		for j := 0; j < a.Cols; j++ {
+			c.Data[i*c.Stride+j] = cmplx.Conj(a.Data[i*a.Stride+j])
+		}
+	}
+	return c
+}
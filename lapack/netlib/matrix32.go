@@ -0,0 +1,93 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+)
+
+// Matrix32 is the float32 version of Matrix.
+type Matrix32 struct {
+	General blas32.General
+	Trans   blas.Transpose
+}
+
+// T returns the transpose of m. The elements are not copied.
+func (m Matrix32) T() Matrix32 {
+	m.Trans = normTrans(m.Trans)
+	if m.Trans == blas.NoTrans {
+		m.Trans = blas.Trans
+	} else {
+		m.Trans = blas.NoTrans
+	}
+	return m
+}
+
+// Dims returns the number of rows and columns of m after the transpose.
+func (m Matrix32) Dims() (r, c int) {
+	m.Trans = normTrans(m.Trans)
+	if m.Trans == blas.NoTrans {
+		return m.General.Rows, m.General.Cols
+	}
+	return m.General.Cols, m.General.Rows
+}
+
+// At returns the element of m at row i and column j after the transpose.
+func (m Matrix32) At(i, j int) float32 {
+	m.Trans = normTrans(m.Trans)
+	r, c := m.Dims()
+	if uint(i) >= uint(r) || uint(j) >= uint(c) {
+		panic(badIndex)
+	}
+	if m.Trans != blas.NoTrans {
+		i, j = j, i
+	}
+	return m.General.Data[i*m.General.Stride+j]
+}
+
+// Dense returns a copy of m with the transpose applied to the elements.
+func (m Matrix32) Dense() blas32.General {
+	r, c := m.Dims()
+	d := newGeneral32(r, c)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			d.Data[i*d.Stride+j] = m.At(i, j)
+		}
+	}
+	return d
+}
+
+// Mul32 is the float32 version of Mul. The product is computed by Sgemm.
+func Mul32(a, b Matrix32) blas32.General {
+	a.Trans, b.Trans = normTrans(a.Trans), normTrans(b.Trans)
+	m, k := a.Dims()
+	kb, n := b.Dims()
+	if k != kb {
+		panic(badShapeMul)
+	}
+	c := newGeneral32(m, n)
+	if m == 0 || n == 0 || k == 0 {
+		return c
+	}
+	blasImpl.Sgemm(a.Trans, b.Trans, m, n, k, 1, a.General.Data, a.General.Stride, b.General.Data, b.General.Stride, 0, c.Data, c.Stride)
+	return c
+}
+
+// MulVec32 is the float32 version of MulVec. The product is computed by
+// Sgemv.
+func MulVec32(a Matrix32, x []float32) []float32 {
+	a.Trans = normTrans(a.Trans)
+	m, n := a.Dims()
+	if len(x) != n {
+		panic(badLenX)
+	}
+	y := make([]float32, m)
+	if m == 0 || n == 0 {
+		return y
+	}
+	blasImpl.Sgemv(a.Trans, a.General.Rows, a.General.Cols, 1, a.General.Data, a.General.Stride, x, 1, 0, y, 1)
+	return y
+}
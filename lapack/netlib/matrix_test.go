@@ -0,0 +1,293 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/blas/cblas128"
+)
+
+var transposes = []blas.Transpose{blas.NoTrans, blas.Trans, blas.ConjTrans}
+
+// viewOf returns a Matrix with the transpose trans whose elements after the
+// transpose are those of the r×c matrix d, stored with a padded stride.
+func viewOf(rnd *rand.Rand, r, c int, trans blas.Transpose) (Matrix, blas64.General) {
+	gr, gc := r, c
+	if trans != blas.NoTrans {
+		gr, gc = c, r
+	}
+	g := randomGeneral(rnd, gr, gc, gc+3)
+	m := Matrix{General: g, Trans: trans}
+	return m, m.Dense()
+}
+
+func naiveMul(a, b blas64.General) blas64.General {
+	c := newGeneral(a.Rows, b.Cols)
+	for i := 0; i < a.Rows; i++ {
+		for j := 0; j < b.Cols; j++ {
+			var s float64
+			for l := 0; l < a.Cols; l++ {
+				s += a.Data[i*a.Stride+l] * b.Data[l*b.Stride+j]
+			}
+			c.Data[i*c.Stride+j] = s
+		}
+	}
+	return c
+}
+
+func TestMatrixTranspose(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	a := Matrix{General: randomGeneral(rnd, 3, 5, 7)}
+	for _, m := range []Matrix{a, a.T(), a.T().T(), {General: a.General, Trans: blas.ConjTrans}} {
+		r, c := m.Dims()
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				ii, jj := i, j
+				if normTrans(m.Trans) != blas.NoTrans {
+					ii, jj = j, i
+				}
+				want := a.General.Data[ii*a.General.Stride+jj]
+				if m.At(i, j) != want {
+					t.Fatalf("trans=%c: unexpected element at (%d,%d)", m.Trans, i, j)
+				}
+			}
+		}
+		if &m.General.Data[0] != &a.General.Data[0] {
+			t.Errorf("trans=%c: elements copied", m.Trans)
+		}
+	}
+	if a.Trans != 0 || a.T().Trans != blas.Trans || a.T().T().Trans != blas.NoTrans {
+		t.Errorf("unexpected transpose flags")
+	}
+	if c := (Matrix{General: a.General, Trans: blas.ConjTrans}).T(); c.Trans != blas.NoTrans {
+		t.Errorf("unexpected transpose of conjugate transpose: %c", c.Trans)
+	}
+}
+
+func TestMul(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, tA := range transposes {
+		for _, tB := range transposes {
+			for _, mnk := range [][3]int{{0, 2, 3}, {2, 0, 3}, {2, 3, 0}, {1, 1, 1}, {4, 5, 3}, {7, 2, 6}} {
+				m, n, k := mnk[0], mnk[1], mnk[2]
+				name := fmt.Sprintf("tA=%c,tB=%c,m=%d,n=%d,k=%d", tA, tB, m, n, k)
+				a, ad := viewOf(rnd, m, k, tA)
+				b, bd := viewOf(rnd, k, n, tB)
+				aData := append([]float64(nil), a.General.Data...)
+
+				got := Mul(a, b)
+				want := naiveMul(ad, bd)
+				if got.Rows != m || got.Cols != n {
+					t.Errorf("%s: unexpected shape %d×%d", name, got.Rows, got.Cols)
+					continue
+				}
+				if d := maxAbsDiff(got, want); d > tol {
+					t.Errorf("%s: unexpected product: difference %v", name, d)
+				}
+				for i, v := range a.General.Data {
+					if v != aData[i] {
+						t.Fatalf("%s: a modified", name)
+					}
+				}
+
+				x := make([]float64, k)
+				for i := range x {
+					x[i] = rnd.NormFloat64()
+				}
+				y := MulVec(a, x)
+				wantY := naiveMul(ad, blas64.General{Rows: k, Cols: 1, Stride: 1, Data: x})
+				if d := maxAbsDiff(blas64.General{Rows: m, Cols: 1, Stride: 1, Data: y}, wantY); d > tol {
+					t.Errorf("%s: unexpected matrix-vector product: difference %v", name, d)
+				}
+			}
+		}
+	}
+
+	a := Matrix{General: newGeneral(2, 3)}
+	for _, test := range []struct {
+		name string
+		fn   func()
+		want string
+	}{
+		{"shape", func() { Mul(a, a) }, badShapeMul},
+		{"length", func() { MulVec(a.T(), make([]float64, 3)) }, badLenX},
+		{"trans", func() { Mul(Matrix{General: a.General, Trans: 'X'}, a.T()) }, badTrans},
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r != test.want {
+					t.Errorf("%s: unexpected panic: got %v want %q", test.name, r, test.want)
+				}
+			}()
+			test.fn()
+		}()
+	}
+}
+
+// maxAbsDiff returns the largest absolute difference between the elements
+// of a and b, which must have the same shape.
+func maxAbsDiff(a, b blas64.General) float64 {
+	var d float64
+	for i := 0; i < a.Rows; i++ {
+		for j := 0; j < a.Cols; j++ {
+			d = max64(d, abs64(a.Data[i*a.Stride+j]-b.Data[i*b.Stride+j]))
+		}
+	}
+	return d
+}
+
+func max64(a, b float64) float64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func abs64(a float64) float64 {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+func TestMul32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, tA := range transposes {
+		for _, tB := range transposes {
+			a64, ad := viewOf(rnd, 4, 3, tA)
+			b64, bd := viewOf(rnd, 3, 5, tB)
+			a := Matrix32{General: round32(a64.General), Trans: tA}
+			b := Matrix32{General: round32(b64.General), Trans: tB}
+
+			got := Mul32(a, b)
+			if d := maxDiff32(got.Data, naiveMul(ad, bd).Data); d > tol32 {
+				t.Errorf("tA=%c,tB=%c: unexpected product: difference %v", tA, tB, d)
+			}
+			x := []float32{1, -2, 0.5}
+			y := MulVec32(a, x)
+			want := naiveMul(ad, blas64.General{Rows: 3, Cols: 1, Stride: 1, Data: []float64{1, -2, 0.5}})
+			if d := maxDiff32(y, want.Data); d > tol32 {
+				t.Errorf("tA=%c: unexpected matrix-vector product: difference %v", tA, d)
+			}
+			if d := a.Dense(); d.Rows != 4 || d.Cols != 3 {
+				t.Errorf("tA=%c: unexpected dense shape %d×%d", tA, d.Rows, d.Cols)
+			}
+		}
+	}
+}
+
+func randomCGeneral(rnd *rand.Rand, r, c, stride int) cblas128.General {
+	g := cblas128.General{Rows: r, Cols: c, Stride: stride, Data: make([]complex128, max(0, (r-1)*stride+c))}
+	for i := range g.Data {
+		g.Data[i] = complex(rnd.NormFloat64(), rnd.NormFloat64())
+	}
+	return g
+}
+
+func naiveCMul(a, b cblas128.General) cblas128.General {
+	c := newCGeneral(a.Rows, b.Cols)
+	for i := 0; i < a.Rows; i++ {
+		for j := 0; j < b.Cols; j++ {
+			var s complex128
+			for l := 0; l < a.Cols; l++ {
+				s += a.Data[i*a.Stride+l] * b.Data[l*b.Stride+j]
+			}
+			c.Data[i*c.Stride+j] = s
+		}
+	}
+	return c
+}
+
+// cviews returns the views of g reachable by T and H, and whether each
+// shares the elements of g.
+func cviews(g cblas128.General) (views []CMatrix, shared []bool) {
+	a := CMatrix{General: g}
+	return []CMatrix{a, a.T(), a.H(), a.T().T(), a.H().H(), a.H().T(), a.T().H()},
+		[]bool{true, true, true, true, true, false, false}
+}
+
+func TestCMatrixTranspose(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	g := randomCGeneral(rnd, 3, 4, 6)
+	views, shared := cviews(g)
+	wants := []func(i, j int) complex128{
+		func(i, j int) complex128 { return g.Data[i*g.Stride+j] },
+		func(i, j int) complex128 { return g.Data[j*g.Stride+i] },
+		func(i, j int) complex128 { return cmplx.Conj(g.Data[j*g.Stride+i]) },
+		func(i, j int) complex128 { return g.Data[i*g.Stride+j] },
+		func(i, j int) complex128 { return g.Data[i*g.Stride+j] },
+		func(i, j int) complex128 { return cmplx.Conj(g.Data[i*g.Stride+j]) },
+		func(i, j int) complex128 { return cmplx.Conj(g.Data[i*g.Stride+j]) },
+	}
+	for k, v := range views {
+		r, c := v.Dims()
+		for i := 0; i < r; i++ {
+			for j := 0; j < c; j++ {
+				if got, want := v.At(i, j), wants[k](i, j); got != want {
+					t.Fatalf("view %d: unexpected element at (%d,%d): got %v want %v", k, i, j, got, want)
+				}
+			}
+		}
+		if got := &v.General.Data[0] == &g.Data[0]; got != shared[k] {
+			t.Errorf("view %d: unexpected sharing of elements: got %t want %t", k, got, shared[k])
+		}
+	}
+}
+
+func TestCMul(t *testing.T) {
+	const tol = 1e-13
+	rnd := rand.New(rand.NewSource(1))
+	for _, mnk := range [][3]int{{1, 1, 1}, {4, 5, 3}, {2, 6, 7}} {
+		m, n, k := mnk[0], mnk[1], mnk[2]
+		// The views of ga are m×k or k×m and those of gb are k×n or n×k;
+		// only the pairs with matching inner dimensions are multiplied.
+		as, _ := cviews(randomCGeneral(rnd, m, k, k+2))
+		bs, _ := cviews(randomCGeneral(rnd, k, n, n+1))
+		var tested int
+		for i, a := range as {
+			for j, b := range bs {
+				ar, ac := a.Dims()
+				br, bc := b.Dims()
+				if ac != br {
+					continue
+				}
+				tested++
+				got := CMul(a, b)
+				want := naiveCMul(a.Dense(), b.Dense())
+				if got.Rows != ar || got.Cols != bc {
+					t.Errorf("views %d,%d: unexpected shape %d×%d", i, j, got.Rows, got.Cols)
+					continue
+				}
+				for l := range want.Data {
+					if cmplx.Abs(got.Data[l]-want.Data[l]) > tol {
+						t.Errorf("views %d,%d: unexpected product", i, j)
+						break
+					}
+				}
+			}
+			_, c := a.Dims()
+			x := randomCGeneral(rnd, 1, c, max(1, c)).Data
+			y := CMulVec(a, x)
+			want := naiveCMul(a.Dense(), cblas128.General{Rows: c, Cols: 1, Stride: 1, Data: x})
+			for l := range y {
+				if cmplx.Abs(y[l]-want.Data[l]) > tol {
+					t.Errorf("view %d: unexpected matrix-vector product", i)
+					break
+				}
+			}
+		}
+		if tested == 0 {
+			t.Errorf("m=%d,n=%d,k=%d: no product tested", m, n, k)
+		}
+	}
+}
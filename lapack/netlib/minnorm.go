@@ -0,0 +1,86 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// MinNormResult holds the output of MinNormSolve.
+type MinNormResult struct {
+	// X is the n×nrhs minimum norm solution.
+	X blas64.General
+
+	// Rank is the effective rank of A, the order of the leading
+	// triangular block R11 of the factorization.
+	Rank int
+
+	// Perm is the column permutation P of the factorization. Column j
+	// of A*P is column Perm[j] of A.
+	Perm []int
+}
+
+// MinNormSolve computes the minimum norm solution of the linear least
+// squares problem
+//  minimize ||A*X - B||_F
+// for the m×n matrix A, which may be rank deficient, using the complete
+// orthogonal factorization
+//  A * P = Q * [ T11 0 ] * Z
+//              [  0  0 ]
+// computed by Dgelsy. The factorization is based on the QR factorization with
+// column pivoting, so its cost is fixed by the dimensions of A, unlike the
+// iterative singular value decomposition used by PInv.
+//
+// The effective rank of A is the order of the largest leading triangular
+// block R11 of the QR factorization with pivoting whose estimated condition
+// number is less than 1/rcond. If rcond is negative, max(m,n)*2^-52 is
+// used. b must have m rows, otherwise MinNormSolve will panic. The inputs a
+// and b are not modified.
+func MinNormSolve(a, b blas64.General, rcond float64) MinNormResult {
+	m, n := a.Rows, a.Cols
+	if b.Rows != m {
+		panic(badShapeB)
+	}
+	nrhs := b.Cols
+	if rcond < 0 {
+		rcond = float64(max(m, n)) * 2 * dlamchE
+	}
+
+	res := MinNormResult{
+		X:    newGeneral(n, nrhs),
+		Perm: make([]int, n),
+	}
+	for j := range res.Perm {
+		res.Perm[j] = j
+	}
+	if m == 0 || n == 0 || nrhs == 0 {
+		return res
+	}
+
+	ac := cloneGeneral(a)
+	ldb := max(1, nrhs)
+	bc := make([]float64, max(m, n)*ldb)
+	for i := 0; i < m; i++ {
+		copy(bc[i*ldb:i*ldb+nrhs], b.Data[i*b.Stride:i*b.Stride+nrhs])
+	}
+	jpvt := make([]lapacke.Int, n)
+	var rank [1]lapacke.Int
+	work := make([]float64, 1)
+	lapacke.Dgelsy(m, n, nrhs, ac.Data, ac.Stride, bc, ldb, jpvt, rcond, rank[:], work, -1)
+	work = make([]float64, int(work[0]))
+	if !lapacke.Dgelsy(m, n, nrhs, ac.Data, ac.Stride, bc, ldb, jpvt, rcond, rank[:], work, len(work)) {
+		panic("lapack: invalid argument to Dgelsy")
+	}
+
+	res.Rank = int(rank[0])
+	for j, p := range jpvt {
+		res.Perm[j] = int(p) - 1
+	}
+	for i := 0; i < n; i++ {
+		copy(res.X.Data[i*res.X.Stride:i*res.X.Stride+nrhs], bc[i*ldb:i*ldb+nrhs])
+	}
+	return res
+}
@@ -0,0 +1,65 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// MinNormResult32 is the float32 version of MinNormResult.
+type MinNormResult32 struct {
+	X    blas32.General
+	Rank int
+	Perm []int
+}
+
+// MinNormSolve32 is the float32 version of MinNormSolve. The factorization
+// is computed by Sgelsy. If rcond is negative, max(m,n)*2^-23 is used.
+func MinNormSolve32(a, b blas32.General, rcond float32) MinNormResult32 {
+	m, n := a.Rows, a.Cols
+	if b.Rows != m {
+		panic(badShapeB)
+	}
+	nrhs := b.Cols
+	if rcond < 0 {
+		rcond = float32(max(m, n)) * 2 * slamchE
+	}
+
+	res := MinNormResult32{
+		X:    newGeneral32(n, nrhs),
+		Perm: make([]int, n),
+	}
+	for j := range res.Perm {
+		res.Perm[j] = j
+	}
+	if m == 0 || n == 0 || nrhs == 0 {
+		return res
+	}
+
+	ac := cloneGeneral32(a)
+	ldb := max(1, nrhs)
+	bc := make([]float32, max(m, n)*ldb)
+	for i := 0; i < m; i++ {
+		copy(bc[i*ldb:i*ldb+nrhs], b.Data[i*b.Stride:i*b.Stride+nrhs])
+	}
+	jpvt := make([]lapacke.Int, n)
+	var rank [1]lapacke.Int
+	work := make([]float32, 1)
+	lapacke.Sgelsy(m, n, nrhs, ac.Data, ac.Stride, bc, ldb, jpvt, rcond, rank[:], work, -1)
+	work = make([]float32, int(work[0]))
+	if !lapacke.Sgelsy(m, n, nrhs, ac.Data, ac.Stride, bc, ldb, jpvt, rcond, rank[:], work, len(work)) {
+		panic("lapack: invalid argument to Sgelsy")
+	}
+
+	res.Rank = int(rank[0])
+	for j, p := range jpvt {
+		res.Perm[j] = int(p) - 1
+	}
+	for i := 0; i < n; i++ {
+		copy(res.X.Data[i*res.X.Stride:i*res.X.Stride+nrhs], bc[i*ldb:i*ldb+nrhs])
+	}
+	return res
+}
@@ -0,0 +1,74 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestMinNormSolve(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, rank, nrhs int
+	}{
+		{m: 0, n: 3, rank: 0, nrhs: 2},
+		{m: 1, n: 1, rank: 1, nrhs: 1},
+		{m: 6, n: 6, rank: 6, nrhs: 2},
+		{m: 10, n: 4, rank: 4, nrhs: 3},
+		{m: 4, n: 10, rank: 4, nrhs: 1},
+		{m: 9, n: 7, rank: 3, nrhs: 2},
+		{m: 5, n: 8, rank: 2, nrhs: 4},
+	} {
+		name := fmt.Sprintf("m=%d,n=%d,rank=%d,nrhs=%d", test.m, test.n, test.rank, test.nrhs)
+		a := newGeneral(test.m, test.n)
+		if test.rank > 0 {
+			a = mul(randomGeneral(rnd, test.m, test.rank, test.rank), randomGeneral(rnd, test.rank, test.n, test.n))
+		}
+		b := randomGeneral(rnd, test.m, test.nrhs, test.nrhs+2)
+		aCopy := cloneGeneral(a)
+		bCopy := cloneGeneral(b)
+
+		res := MinNormSolve(a, b, 1e-10)
+		if !floats.Equal(a.Data, aCopy.Data) || !floats.Equal(cloneGeneral(b).Data, bCopy.Data) {
+			t.Errorf("%s: input modified", name)
+		}
+		if res.Rank != test.rank {
+			t.Errorf("%s: unexpected rank: got %d want %d", name, res.Rank, test.rank)
+		}
+		perm := make([]int, len(res.Perm))
+		copy(perm, res.Perm)
+		sort.Ints(perm)
+		for i, p := range perm {
+			if p != i {
+				t.Errorf("%s: Perm is not a permutation: %v", name, res.Perm)
+				break
+			}
+		}
+		if res.X.Rows != test.n || res.X.Cols != test.nrhs {
+			t.Errorf("%s: unexpected solution shape %d×%d", name, res.X.Rows, res.X.Cols)
+			continue
+		}
+		if test.m == 0 {
+			continue
+		}
+
+		// The minimum norm solution is A^+ * B.
+		p, _, err := PInv(a, 1e-10)
+		if err != nil {
+			t.Fatalf("%s: unexpected error from PInv: %v", name, err)
+		}
+		want := mul(p, cloneGeneral(b))
+		if d := maxDiff(res.X, want, false); d > tol {
+			t.Errorf("%s: solution differs from pseudo-inverse solution: %v", name, d)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import "gonum.org/v1/gonum/blas/blas64"
+
+// MixedSolve solves the system of linear equations A * X = B for the n×n
+// matrix A by Dsgesv, which factors A in single precision and refines the
+// solution to double precision accuracy. For well conditioned systems this
+// is up to twice as fast as a double precision LU solve on hardware where single precision
+// arithmetic has twice the throughput. The inputs a and b are not modified.
+//
+// iter is the number of refinement iterations, or negative if Dsgesv fell
+// back to a double precision factorization, with the values described for
+// Dsgesv. If A is exactly singular, MixedSolve returns a SingularError
+// holding the index of the first zero pivot.
+func MixedSolve(a, b blas64.General) (x blas64.General, iter int, err error) {
+	switch {
+	case a.Rows != a.Cols:
+		panic(badShapeA)
+	case b.Rows != a.Rows:
+		panic(badShapeB)
+	}
+	n, nrhs := a.Rows, b.Cols
+	x = newGeneral(n, nrhs)
+	if n == 0 || nrhs == 0 {
+		return x, 0, nil
+	}
+	lu := cloneGeneral(a)
+	ipiv := make([]int, n)
+	work := make([]float64, n*nrhs)
+	swork := make([]float32, n*(n+nrhs))
+	iter, ok := lapackImpl.Dsgesv(n, nrhs, lu.Data, lu.Stride, ipiv, b.Data, b.Stride, x.Data, x.Stride, work, swork)
+	if !ok {
+		return blas64.General{}, iter, SingularError{Index: zeroDiag(lu.Data, lu.Stride, n)}
+	}
+	return x, iter, nil
+}
@@ -0,0 +1,135 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+func TestDsgesv(t *testing.T) {
+	const tol = 1e-14
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ n, nrhs, lda, ldb, ldx int }{
+		{1, 1, 1, 1, 1}, {5, 2, 5, 2, 2}, {40, 3, 43, 5, 4}, {100, 1, 100, 1, 1},
+	} {
+		n, nrhs := test.n, test.nrhs
+		name := fmt.Sprintf("%+v", test)
+		a := randomGeneral(rnd, n, n, test.lda)
+		for i := 0; i < n; i++ {
+			a.Data[i*a.Stride+i] += float64(n)
+		}
+		b := randomGeneral(rnd, n, nrhs, test.ldb)
+		x := randomGeneral(rnd, n, nrhs, test.ldx)
+		lu := a
+		lu.Data = append([]float64(nil), a.Data...)
+		bCopy := cloneGeneral(b)
+
+		ipiv := make([]int, n)
+		work := make([]float64, n*nrhs)
+		swork := make([]float32, n*(n+nrhs))
+		iter, ok := impl.Dsgesv(n, nrhs, lu.Data, lu.Stride, ipiv, b.Data, b.Stride, x.Data, x.Stride, work, swork)
+		if !ok {
+			t.Errorf("%s: unexpected singular matrix", name)
+			continue
+		}
+		// Diagonally dominant systems converge after a few refinements.
+		if iter < 0 && iter != -1 {
+			t.Errorf("%s: unexpected iter %d", name, iter)
+		}
+		if iter >= 0 && maxDiff(lu, a, false) != 0 {
+			t.Errorf("%s: a modified although the refinement converged", name)
+		}
+		if maxDiff(b, bCopy, false) != 0 {
+			t.Errorf("%s: b modified", name)
+		}
+		for _, p := range ipiv {
+			if p < 0 || n <= p {
+				t.Errorf("%s: pivot out of range: %v", name, ipiv)
+				break
+			}
+		}
+		if res := residual(blas.NoTrans, a, x, b); res > tol {
+			t.Errorf("%s: residual too large: %v", name, res)
+		}
+	}
+}
+
+func TestZcgesv(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 6, 35} {
+		for _, nrhs := range []int{1, 3} {
+			a := randomCGeneral(rnd, n, n, n+1)
+			for i := 0; i < n; i++ {
+				a.Data[i*a.Stride+i] += complex(float64(2*n), 0)
+			}
+			b := randomCGeneral(rnd, n, nrhs, nrhs)
+			x := newCGeneral(n, nrhs)
+			lu := cloneCGeneral(a)
+			ipiv := make([]int, n)
+			work := make([]complex128, n*nrhs)
+			swork := make([]complex64, n*(n+nrhs))
+			rwork := make([]float64, n)
+			iter, ok := impl.Zcgesv(n, nrhs, lu.Data, lu.Stride, ipiv, b.Data, b.Stride, x.Data, x.Stride, work, swork, rwork)
+			if !ok {
+				t.Fatalf("n=%d: unexpected singular matrix", n)
+			}
+			if iter < 0 && iter != -1 {
+				t.Errorf("n=%d nrhs=%d: unexpected iter %d", n, nrhs, iter)
+			}
+			if d := cmaxAbsDiff(naiveCMul(a, x), b); d > ztol {
+				t.Errorf("n=%d nrhs=%d: unexpected residual %v", n, nrhs, d)
+			}
+		}
+	}
+}
+
+func TestMixedSolve(t *testing.T) {
+	const tol = 1e-14
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 7, 60} {
+		a := randomGeneral(rnd, n, n, max(1, n))
+		for i := 0; i < n; i++ {
+			a.Data[i*a.Stride+i] += float64(n)
+		}
+		b := randomGeneral(rnd, n, 2, 2)
+		aCopy := cloneGeneral(a)
+		x, _, err := MixedSolve(a, b)
+		if err != nil {
+			t.Errorf("n=%d: unexpected error: %v", n, err)
+			continue
+		}
+		if maxDiff(a, aCopy, false) != 0 {
+			t.Errorf("n=%d: a modified", n)
+		}
+		if x.Rows != n || x.Cols != 2 {
+			t.Errorf("n=%d: unexpected shape of x %d×%d", n, x.Rows, x.Cols)
+			continue
+		}
+		if n > 0 {
+			if res := residual(blas.NoTrans, a, x, b); res > tol {
+				t.Errorf("n=%d: residual too large: %v", n, res)
+			}
+		}
+	}
+
+	// The third column is the sum of the first two.
+	a := blas64.General{Rows: 3, Cols: 3, Stride: 3, Data: []float64{
+		1, 2, 3,
+		4, 5, 9,
+		7, 8, 15,
+	}}
+	b := blas64.General{Rows: 3, Cols: 1, Stride: 1, Data: []float64{1, 2, 3}}
+	if _, _, err := MixedSolve(a, b); err == nil {
+		t.Error("expected error for singular matrix")
+	} else if _, ok := err.(SingularError); !ok {
+		t.Errorf("unexpected error type %T", err)
+	}
+}
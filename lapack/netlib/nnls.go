@@ -0,0 +1,256 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// NNLS solves the nonnegative least squares problem
+//  minimize ||A*x - b||_2 subject to x >= 0
+// for the m×n matrix A using the active set method of Lawson and Hanson.
+// NNLS returns the solution x and the Euclidean norm of the residual b - A*x.
+// The inputs a and b are not modified. b must have length m, otherwise NNLS
+// will panic.
+//
+// The QR factorization of the columns of A in the passive set is updated
+// with a single Householder reflection when a column enters the set, and is
+// refactorized when columns leave it.
+//
+// If the method does not converge within 3*n iterations, the current
+// feasible iterate is returned with ErrIterationLimit.
+func NNLS(a blas64.General, b []float64) (x []float64, rnorm float64, err error) {
+	m, n := a.Rows, a.Cols
+	if len(b) != m {
+		panic(badShapeB)
+	}
+	x = make([]float64, n)
+	if m == 0 || n == 0 {
+		return x, blasImpl.Dnrm2(m, b, 1), nil
+	}
+
+	s := newNNLSState(cloneGeneral(a), b)
+	tol := 10 * dlamchE * float64(max(m, n)) * lapackImpl.Dlange(lapack.MaxColumnSum, m, n, s.a.Data, s.a.Stride, make([]float64, n))
+
+	const (
+		zeroSet = iota
+		passiveSet
+		rejected
+	)
+	state := make([]int8, n)
+	w := make([]float64, n)
+	r := make([]float64, m)
+	z := make([]float64, n)
+	maxIter := 3 * n
+	for iter := 0; ; {
+		// Compute the negative gradient w = A^T * (b - A*x).
+		copy(r, b)
+		blasImpl.Dgemv(blas.NoTrans, m, n, -1, s.a.Data, s.a.Stride, x, 1, 1, r, 1)
+		blasImpl.Dgemv(blas.Trans, m, n, 1, s.a.Data, s.a.Stride, r, 1, 0, w, 1)
+
+		j := -1
+		wmax := tol
+		for i, v := range w {
+			if state[i] == zeroSet && v > wmax {
+				j, wmax = i, v
+			}
+		}
+		if j < 0 {
+			// The Kuhn-Tucker conditions are satisfied.
+			return x, blasImpl.Dnrm2(m, r, 1), nil
+		}
+
+		// Move column j into the passive set unless it is numerically
+		// dependent on the passive columns or would enter with a
+		// nonpositive coefficient.
+		if !s.add(j) {
+			state[j] = rejected
+			continue
+		}
+		s.solve(z)
+		if z[len(s.cols)-1] <= 0 {
+			s.removeLast()
+			state[j] = rejected
+			continue
+		}
+		for i, v := range state {
+			if v == rejected {
+				state[i] = zeroSet
+			}
+		}
+		state[j] = passiveSet
+
+		for {
+			iter++
+			if iter > maxIter {
+				copy(r, b)
+				blasImpl.Dgemv(blas.NoTrans, m, n, -1, s.a.Data, s.a.Stride, x, 1, 1, r, 1)
+				return x, blasImpl.Dnrm2(m, r, 1), ErrIterationLimit
+			}
+			k := len(s.cols)
+			feasible := true
+			for _, v := range z[:k] {
+				if v <= 0 {
+					feasible = false
+					break
+				}
+			}
+			if feasible {
+				for i, c := range s.cols {
+					x[c] = z[i]
+				}
+				break
+			}
+
+			// Step from x towards z as far as possible while staying
+			// feasible and move the variables that reach zero back into
+			// the zero set.
+			alpha := math.Inf(1)
+			jmin := -1
+			for i, c := range s.cols {
+				if z[i] <= 0 {
+					t := x[c] / (x[c] - z[i])
+					if t < alpha {
+						alpha, jmin = t, c
+					}
+				}
+			}
+			cols := s.cols[:0]
+			for i, c := range s.cols {
+				x[c] += alpha * (z[i] - x[c])
+				if c == jmin || x[c] <= 0 {
+					x[c] = 0
+					state[c] = zeroSet
+					continue
+				}
+				cols = append(cols, c)
+			}
+			// Rounding may leave a remaining column numerically
+			// dependent on the others, and such a column is moved
+			// back to the zero set as a column rejected by add.
+			for _, c := range s.refactor(cols) {
+				x[c] = 0
+				state[c] = rejected
+			}
+			s.solve(z)
+		}
+	}
+}
+
+// nnlsState holds the QR factorization of the passive columns of A used
+// by NNLS.
+type nnlsState struct {
+	a blas64.General
+	b []float64
+
+	// cols holds the passive columns in factorization order.
+	cols []int
+	// qr and tau hold the factorization of A[:, cols] in the form returned
+	// by Dgeqrf, using the first len(cols) columns of qr.
+	qr  []float64
+	tau []float64
+	// qtb holds Q^T * b.
+	qtb []float64
+
+	work []float64
+}
+
+func newNNLSState(a blas64.General, b []float64) *nnlsState {
+	s := &nnlsState{
+		a:    a,
+		b:    b,
+		qr:   make([]float64, a.Rows*a.Cols),
+		tau:  make([]float64, a.Cols),
+		qtb:  make([]float64, a.Rows),
+		work: make([]float64, 1),
+	}
+	copy(s.qtb, b)
+	return s
+}
+
+// add appends column j of A to the factorization. It returns false and
+// leaves the factorization unchanged if the column is numerically linearly
+// dependent on the passive columns.
+func (s *nnlsState) add(j int) bool {
+	m, n := s.a.Rows, s.a.Cols
+	k := len(s.cols)
+	if k == m {
+		return false
+	}
+	for i := 0; i < m; i++ {
+		s.qr[i*n+k] = s.a.Data[i*s.a.Stride+j]
+	}
+	cnorm := blasImpl.Dnrm2(m, s.qr[k:], n)
+	if k > 0 {
+		lapackImpl.Dormqr(blas.Left, blas.Trans, m, 1, k, s.qr, n, s.tau[:k], s.qr[k:], n, s.work, len(s.work))
+	}
+	beta, tau := lapackImpl.Dlarfg(m-k, s.qr[k*n+k], s.qr[min((k+1)*n+k, len(s.qr)):], n)
+	if math.Abs(beta) <= 100*dlamchE*cnorm {
+		return false
+	}
+	s.qr[k*n+k] = beta
+	s.tau[k] = tau
+	s.cols = append(s.cols, j)
+	s.reflect(k)
+	return true
+}
+
+// removeLast removes the most recently added column from the factorization.
+func (s *nnlsState) removeLast() {
+	k := len(s.cols) - 1
+	// Householder reflections are involutions, so applying H_k again
+	// restores Q^T * b.
+	s.reflect(k)
+	s.cols = s.cols[:k]
+}
+
+// reflect applies the kth elementary reflector H_k to qtb.
+func (s *nnlsState) reflect(k int) {
+	m, n := s.a.Rows, s.a.Cols
+	tau := s.tau[k]
+	if tau == 0 {
+		return
+	}
+	sum := s.qtb[k]
+	for i := k + 1; i < m; i++ {
+		sum += s.qr[i*n+k] * s.qtb[i]
+	}
+	sum *= tau
+	s.qtb[k] -= sum
+	for i := k + 1; i < m; i++ {
+		s.qtb[i] -= sum * s.qr[i*n+k]
+	}
+}
+
+// refactor recomputes the factorization for the given passive columns and
+// returns the columns that add rejected. A subset of linearly independent
+// columns is independent, but the columns may not remain numerically
+// independent once the factorization is computed in a different order.
+func (s *nnlsState) refactor(cols []int) (rejected []int) {
+	cols = append([]int(nil), cols...)
+	s.cols = s.cols[:0]
+	copy(s.qtb, s.b)
+	for _, j := range cols {
+		if !s.add(j) {
+			rejected = append(rejected, j)
+		}
+	}
+	return rejected
+}
+
+// solve stores the least squares solution for the passive columns into
+// z[:len(s.cols)].
+func (s *nnlsState) solve(z []float64) {
+	k := len(s.cols)
+	if k == 0 {
+		return
+	}
+	copy(z, s.qtb[:k])
+	lapackImpl.Dtrtrs(blas.Upper, blas.NoTrans, blas.NonUnit, k, 1, s.qr, s.a.Cols, z, 1)
+}
@@ -0,0 +1,247 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// NNLS32 is the float32 version of NNLS. The Householder reflections are
+// computed by Slarfg and applied by Sormqr.
+func NNLS32(a blas32.General, b []float32) (x []float32, rnorm float32, err error) {
+	m, n := a.Rows, a.Cols
+	if len(b) != m {
+		panic(badShapeB)
+	}
+	x = make([]float32, n)
+	if m == 0 || n == 0 {
+		return x, blasImpl.Snrm2(m, b, 1), nil
+	}
+
+	s := newNNLSState32(cloneGeneral32(a), b)
+	tol := 10 * slamchE * float32(max(m, n)) * lapacke.Slange(byte(lapack.MaxColumnSum), m, n, s.a.Data, s.a.Stride, make([]float32, n))
+
+	const (
+		zeroSet = iota
+		passiveSet
+		rejected
+	)
+	state := make([]int8, n)
+	w := make([]float32, n)
+	r := make([]float32, m)
+	z := make([]float32, n)
+	maxIter := 3 * n
+	for iter := 0; ; {
+		// Compute the negative gradient w = A^T * (b - A*x).
+		copy(r, b)
+		blasImpl.Sgemv(blas.NoTrans, m, n, -1, s.a.Data, s.a.Stride, x, 1, 1, r, 1)
+		blasImpl.Sgemv(blas.Trans, m, n, 1, s.a.Data, s.a.Stride, r, 1, 0, w, 1)
+
+		j := -1
+		wmax := tol
+		for i, v := range w {
+			if state[i] == zeroSet && v > wmax {
+				j, wmax = i, v
+			}
+		}
+		if j < 0 {
+			// The Kuhn-Tucker conditions are satisfied.
+			return x, blasImpl.Snrm2(m, r, 1), nil
+		}
+
+		// Move column j into the passive set unless it is numerically
+		// dependent on the passive columns or would enter with a
+		// nonpositive coefficient.
+		if !s.add(j) {
+			state[j] = rejected
+			continue
+		}
+		s.solve(z)
+		if z[len(s.cols)-1] <= 0 {
+			s.removeLast()
+			state[j] = rejected
+			continue
+		}
+		for i, v := range state {
+			if v == rejected {
+				state[i] = zeroSet
+			}
+		}
+		state[j] = passiveSet
+
+		for {
+			iter++
+			if iter > maxIter {
+				copy(r, b)
+				blasImpl.Sgemv(blas.NoTrans, m, n, -1, s.a.Data, s.a.Stride, x, 1, 1, r, 1)
+				return x, blasImpl.Snrm2(m, r, 1), ErrIterationLimit
+			}
+			k := len(s.cols)
+			feasible := true
+			for _, v := range z[:k] {
+				if v <= 0 {
+					feasible = false
+					break
+				}
+			}
+			if feasible {
+				for i, c := range s.cols {
+					x[c] = z[i]
+				}
+				break
+			}
+
+			// Step from x towards z as far as possible while staying
+			// feasible and move the variables that reach zero back into
+			// the zero set.
+			alpha := float32(math.Inf(1))
+			jmin := -1
+			for i, c := range s.cols {
+				if z[i] <= 0 {
+					t := x[c] / (x[c] - z[i])
+					if t < alpha {
+						alpha, jmin = t, c
+					}
+				}
+			}
+			cols := s.cols[:0]
+			for i, c := range s.cols {
+				x[c] += alpha * (z[i] - x[c])
+				if c == jmin || x[c] <= 0 {
+					x[c] = 0
+					state[c] = zeroSet
+					continue
+				}
+				cols = append(cols, c)
+			}
+			// Rounding may leave a remaining column numerically
+			// dependent on the others, and such a column is moved
+			// back to the zero set as a column rejected by add.
+			for _, c := range s.refactor(cols) {
+				x[c] = 0
+				state[c] = rejected
+			}
+			s.solve(z)
+		}
+	}
+}
+
+// nnlsState32 is the float32 version of nnlsState.
+type nnlsState32 struct {
+	a blas32.General
+	b []float32
+
+	// cols holds the passive columns in factorization order.
+	cols []int
+	// qr and tau hold the factorization of A[:, cols] in the form returned
+	// by Sgeqrf, using the first len(cols) columns of qr.
+	qr  []float32
+	tau []float32
+	// qtb holds Q^T * b.
+	qtb []float32
+
+	work []float32
+}
+
+func newNNLSState32(a blas32.General, b []float32) *nnlsState32 {
+	s := &nnlsState32{
+		a:    a,
+		b:    b,
+		qr:   make([]float32, a.Rows*a.Cols),
+		tau:  make([]float32, a.Cols),
+		qtb:  make([]float32, a.Rows),
+		work: make([]float32, 1),
+	}
+	copy(s.qtb, b)
+	return s
+}
+
+// add appends column j of A to the factorization. It returns false and
+// leaves the factorization unchanged if the column is numerically linearly
+// dependent on the passive columns.
+func (s *nnlsState32) add(j int) bool {
+	m, n := s.a.Rows, s.a.Cols
+	k := len(s.cols)
+	if k == m {
+		return false
+	}
+	for i := 0; i < m; i++ {
+		s.qr[i*n+k] = s.a.Data[i*s.a.Stride+j]
+	}
+	cnorm := blasImpl.Snrm2(m, s.qr[k:], n)
+	if k > 0 {
+		lapacke.Sormqr('L', 'T', m, 1, k, s.qr, n, s.tau[:k], s.qr[k:], n, s.work, len(s.work))
+	}
+	alpha := []float32{s.qr[k*n+k]}
+	lapacke.Slarfg(m-k, alpha, s.qr[min((k+1)*n+k, len(s.qr)):], n, s.tau[k:k+1])
+	beta := alpha[0]
+	if math.Abs(float64(beta)) <= 100*slamchE*float64(cnorm) {
+		s.tau[k] = 0
+		return false
+	}
+	s.qr[k*n+k] = beta
+	s.cols = append(s.cols, j)
+	s.reflect(k)
+	return true
+}
+
+// removeLast removes the most recently added column from the factorization.
+func (s *nnlsState32) removeLast() {
+	k := len(s.cols) - 1
+	// Householder reflections are involutions, so applying H_k again
+	// restores Q^T * b.
+	s.reflect(k)
+	s.cols = s.cols[:k]
+}
+
+// reflect applies the kth elementary reflector H_k to qtb.
+func (s *nnlsState32) reflect(k int) {
+	m, n := s.a.Rows, s.a.Cols
+	tau := s.tau[k]
+	if tau == 0 {
+		return
+	}
+	sum := s.qtb[k]
+	for i := k + 1; i < m; i++ {
+		sum += s.qr[i*n+k] * s.qtb[i]
+	}
+	sum *= tau
+	s.qtb[k] -= sum
+	for i := k + 1; i < m; i++ {
+		s.qtb[i] -= sum * s.qr[i*n+k]
+	}
+}
+
+// refactor recomputes the factorization for the given passive columns and
+// returns the columns that add rejected. A subset of linearly independent
+// columns is independent, but the columns may not remain numerically
+// independent once the factorization is computed in a different order.
+func (s *nnlsState32) refactor(cols []int) (rejected []int) {
+	cols = append([]int(nil), cols...)
+	s.cols = s.cols[:0]
+	copy(s.qtb, s.b)
+	for _, j := range cols {
+		if !s.add(j) {
+			rejected = append(rejected, j)
+		}
+	}
+	return rejected
+}
+
+// solve stores the least squares solution for the passive columns into
+// z[:len(s.cols)].
+func (s *nnlsState32) solve(z []float32) {
+	k := len(s.cols)
+	if k == 0 {
+		return
+	}
+	copy(z, s.qtb[:k])
+	lapacke.Strtrs('U', 'N', 'N', k, 1, s.qr, s.a.Cols, z, 1)
+}
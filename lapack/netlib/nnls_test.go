@@ -0,0 +1,98 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+)
+
+func TestNNLS(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, lda int
+	}{
+		{m: 1, n: 1, lda: 1},
+		{m: 5, n: 3, lda: 3},
+		{m: 10, n: 4, lda: 7},
+		{m: 20, n: 20, lda: 20},
+		{m: 4, n: 8, lda: 8},
+		{m: 50, n: 30, lda: 30},
+	} {
+		for trial := 0; trial < 10; trial++ {
+			name := fmt.Sprintf("m=%d,n=%d,lda=%d,trial=%d", test.m, test.n, test.lda, trial)
+			a := randomGeneral(rnd, test.m, test.n, test.lda)
+			b := make([]float64, test.m)
+			for i := range b {
+				b[i] = rnd.NormFloat64()
+			}
+			aCopy := cloneGeneral(a)
+			bCopy := make([]float64, len(b))
+			copy(bCopy, b)
+
+			x, rnorm, err := NNLS(a, b)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			if !floats.Equal(cloneGeneral(a).Data, aCopy.Data) || !floats.Equal(b, bCopy) {
+				t.Errorf("%s: inputs modified", name)
+			}
+
+			// Check the Kuhn-Tucker conditions.
+			r := make([]float64, test.m)
+			copy(r, b)
+			blas64.Gemv(blas.NoTrans, -1, a, blas64.Vector{N: test.n, Data: x, Inc: 1}, 1, blas64.Vector{N: test.m, Data: r, Inc: 1})
+			if math.Abs(blas64.Nrm2(blas64.Vector{N: test.m, Data: r, Inc: 1})-rnorm) > tol {
+				t.Errorf("%s: unexpected residual norm", name)
+			}
+			w := make([]float64, test.n)
+			blas64.Gemv(blas.Trans, 1, a, blas64.Vector{N: test.m, Data: r, Inc: 1}, 0, blas64.Vector{N: test.n, Data: w, Inc: 1})
+			for j, v := range x {
+				switch {
+				case v < 0:
+					t.Errorf("%s: x[%d] = %v is negative", name, j, v)
+				case v > 0 && math.Abs(w[j]) > tol:
+					t.Errorf("%s: nonzero gradient %v for passive variable %d", name, w[j], j)
+				case v == 0 && w[j] > tol:
+					t.Errorf("%s: positive gradient %v for zero variable %d", name, w[j], j)
+				}
+			}
+		}
+	}
+}
+
+func TestNNLSFeasible(t *testing.T) {
+	// If b is in the positive span of A, the unconstrained and the
+	// nonnegative solutions coincide.
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	const m, n = 15, 6
+	a := randomGeneral(rnd, m, n, n)
+	want := make([]float64, n)
+	for i := range want {
+		want[i] = 1 + rnd.Float64()
+	}
+	b := make([]float64, m)
+	blas64.Gemv(blas.NoTrans, 1, a, blas64.Vector{N: n, Data: want, Inc: 1}, 0, blas64.Vector{N: m, Data: b, Inc: 1})
+	x, rnorm, err := NNLS(a, b)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !floats.EqualApprox(x, want, tol) {
+		t.Errorf("unexpected solution: got %v want %v", x, want)
+	}
+	if rnorm > tol {
+		t.Errorf("unexpected residual norm: %v", rnorm)
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+const badProbes = "lapack: number of probes out of range"
+
+const (
+	// normEstTol is the relative change between successive estimates at
+	// which NormEst2 stops, which is the default of MATLAB's normest.
+	normEstTol = 1e-6
+
+	// normEstMaxIter is the maximum number of iterations of NormEst2.
+	normEstMaxIter = 100
+)
+
+// NormEst2 estimates the 2-norm, the largest singular value, of the m×n
+// matrix A by the power iteration on A^T * A used by MATLAB's normest. Each
+// iteration costs two matrix-vector products by Dgemv, and the iteration
+// stops when the estimate changes by less than a relative 1e-6. The
+// estimate is a lower bound of the 2-norm.
+//
+// If the estimate has not converged after 100 iterations, NormEst2 returns
+// it with ErrIterationLimit.
+func NormEst2(a blas64.General) (float64, error) {
+	m, n := a.Rows, a.Cols
+	if m == 0 || n == 0 {
+		return 0, nil
+	}
+	// Start from the vector of absolute column sums of A.
+	x := make([]float64, n)
+	for j := range x {
+		x[j] = blasImpl.Dasum(m, a.Data[j:], a.Stride)
+	}
+	e := blasImpl.Dnrm2(n, x, 1)
+	if e == 0 {
+		return 0, nil
+	}
+	blasImpl.Dscal(n, 1/e, x, 1)
+
+	var rnd *rand.Rand
+	ax := make([]float64, m)
+	for it := 0; it < normEstMaxIter; it++ {
+		e0 := e
+		blasImpl.Dgemv(blas.NoTrans, m, n, 1, a.Data, a.Stride, x, 1, 0, ax, 1)
+		nax := blasImpl.Dnrm2(m, ax, 1)
+		if nax == 0 {
+			// x is in the null space of A, so restart from a random
+			// vector.
+			if rnd == nil {
+				rnd = rand.New(rand.NewSource(1))
+			}
+			for i := range ax {
+				ax[i] = rnd.Float64()
+			}
+			nax = blasImpl.Dnrm2(m, ax, 1)
+		}
+		blasImpl.Dgemv(blas.Trans, m, n, 1, a.Data, a.Stride, ax, 1, 0, x, 1)
+		nx := blasImpl.Dnrm2(n, x, 1)
+		e = nx / nax
+		if nx != 0 {
+			blasImpl.Dscal(n, 1/nx, x, 1)
+		}
+		if math.Abs(e-e0) <= normEstTol*e {
+			return e, nil
+		}
+	}
+	return e, ErrIterationLimit
+}
+
+// FrobeniusNormEst estimates the Frobenius norm of the m×n matrix A from k
+// random probes. With the columns of the n×k matrix G drawn from the
+// standard normal distribution, the expected squared Frobenius norm of
+// A * G is k times that of A, so the estimate is
+//
+//	‖A * G‖_F / sqrt(k)
+//
+// computed by a single call to Dgemm. The relative standard deviation of
+// the squared estimate is at most sqrt(2/k).
+//
+// The probes are drawn from rnd. If rnd is nil, a source with a fixed seed
+// is used, so that the estimate is reproducible. FrobeniusNormEst panics if
+// k < 1.
+func FrobeniusNormEst(a blas64.General, k int, rnd *rand.Rand) float64 {
+	if k < 1 {
+		panic(badProbes)
+	}
+	m, n := a.Rows, a.Cols
+	if m == 0 || n == 0 {
+		return 0
+	}
+	g := normalProbes(n, k, rnd)
+	y := newGeneral(m, k)
+	blasImpl.Dgemm(blas.NoTrans, blas.NoTrans, m, k, n, 1, a.Data, a.Stride, g.Data, g.Stride, 0, y.Data, y.Stride)
+	return blasImpl.Dnrm2(m*k, y.Data, 1) / math.Sqrt(float64(k))
+}
+
+// SpectralNormEst estimates the 2-norm of the m×n matrix A by the block
+// power iteration on k random probes. The probes form the columns of an
+// n×k matrix Z with orthonormal columns, which is refined by q iterations
+//
+//	Y = orth(A * Z), Z = orth(A^T * Y)
+//
+// using Dgemm and a QR factorization, and the estimate is the largest
+// singular value of A * Z. The estimate is a lower bound of the 2-norm
+// whose error decreases with the ratio of the (k+1)-th to the largest
+// singular value raised to the power 2q+1, so a few probes and iterations
+// usually suffice for a stopping criterion. k is reduced to min(m, n) if it
+// is larger.
+//
+// The probes are drawn from rnd. If rnd is nil, a source with a fixed seed
+// is used. SpectralNormEst panics if k < 1 or q < 0. If the final
+// eigenvalue problem fails to converge, SpectralNormEst returns
+// ErrIterationLimit.
+func SpectralNormEst(a blas64.General, k, q int, rnd *rand.Rand) (float64, error) {
+	if k < 1 {
+		panic(badProbes)
+	}
+	if q < 0 {
+		panic("lapack: negative iteration count")
+	}
+	m, n := a.Rows, a.Cols
+	k = min(k, min(m, n))
+	if k == 0 {
+		return 0, nil
+	}
+	z := normalProbes(n, k, rnd)
+	orthonormalize(z)
+	y := newGeneral(m, k)
+	blasImpl.Dgemm(blas.NoTrans, blas.NoTrans, m, k, n, 1, a.Data, a.Stride, z.Data, z.Stride, 0, y.Data, y.Stride)
+	for it := 0; it < q; it++ {
+		orthonormalize(y)
+		blasImpl.Dgemm(blas.Trans, blas.NoTrans, n, k, m, 1, a.Data, a.Stride, y.Data, y.Stride, 0, z.Data, z.Stride)
+		orthonormalize(z)
+		blasImpl.Dgemm(blas.NoTrans, blas.NoTrans, m, k, n, 1, a.Data, a.Stride, z.Data, z.Stride, 0, y.Data, y.Stride)
+	}
+
+	// The squared singular values of Y are the eigenvalues of Y^T * Y.
+	c := make([]float64, k*k)
+	blasImpl.Dsyrk(blas.Upper, blas.Trans, k, m, 1, y.Data, y.Stride, 0, c, k)
+	w := make([]float64, k)
+	work := make([]float64, 1)
+	lapacke.Dsyev('N', 'U', k, c, k, w, work, -1)
+	work = make([]float64, int(work[0]))
+	if !lapacke.Dsyev('N', 'U', k, c, k, w, work, len(work)) {
+		return 0, ErrIterationLimit
+	}
+	return math.Sqrt(math.Max(w[k-1], 0)), nil
+}
+
+// normalProbes returns an r×c matrix of standard normal values drawn from
+// rnd, or from a source with a fixed seed if rnd is nil.
+func normalProbes(r, c int, rnd *rand.Rand) blas64.General {
+	if rnd == nil {
+		rnd = rand.New(rand.NewSource(1))
+	}
+	g := newGeneral(r, c)
+	for i := range g.Data {
+		g.Data[i] = rnd.NormFloat64()
+	}
+	return g
+}
+
+// orthonormalize overwrites the m×k matrix a, m >= k, with the orthonormal
+// factor Q of its thin QR factorization.
+func orthonormalize(a blas64.General) {
+	m, k := a.Rows, a.Cols
+	tau := make([]float64, k)
+	work := make([]float64, 1)
+	lapacke.Dgeqrf(m, k, a.Data, a.Stride, tau, work, -1)
+	lwork := int(work[0])
+	lapacke.Dorgqr(m, k, k, a.Data, a.Stride, tau, work, -1)
+	work = make([]float64, max(lwork, int(work[0])))
+	lapacke.Dgeqrf(m, k, a.Data, a.Stride, tau, work, len(work))
+	lapacke.Dorgqr(m, k, k, a.Data, a.Stride, tau, work, len(work))
+}
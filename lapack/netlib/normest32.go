@@ -0,0 +1,136 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// NormEst232 is the float32 version of NormEst2. The products are computed
+// by Sgemv.
+func NormEst232(a blas32.General) (float32, error) {
+	m, n := a.Rows, a.Cols
+	if m == 0 || n == 0 {
+		return 0, nil
+	}
+	x := make([]float32, n)
+	for j := range x {
+		x[j] = blasImpl.Sasum(m, a.Data[j:], a.Stride)
+	}
+	e := blasImpl.Snrm2(n, x, 1)
+	if e == 0 {
+		return 0, nil
+	}
+	blasImpl.Sscal(n, 1/e, x, 1)
+
+	var rnd *rand.Rand
+	ax := make([]float32, m)
+	for it := 0; it < normEstMaxIter; it++ {
+		e0 := e
+		blasImpl.Sgemv(blas.NoTrans, m, n, 1, a.Data, a.Stride, x, 1, 0, ax, 1)
+		nax := blasImpl.Snrm2(m, ax, 1)
+		if nax == 0 {
+			if rnd == nil {
+				rnd = rand.New(rand.NewSource(1))
+			}
+			for i := range ax {
+				ax[i] = float32(rnd.Float64())
+			}
+			nax = blasImpl.Snrm2(m, ax, 1)
+		}
+		blasImpl.Sgemv(blas.Trans, m, n, 1, a.Data, a.Stride, ax, 1, 0, x, 1)
+		nx := blasImpl.Snrm2(n, x, 1)
+		e = nx / nax
+		if nx != 0 {
+			blasImpl.Sscal(n, 1/nx, x, 1)
+		}
+		if math.Abs(float64(e-e0)) <= normEstTol*float64(e) {
+			return e, nil
+		}
+	}
+	return e, ErrIterationLimit
+}
+
+// FrobeniusNormEst32 is the float32 version of FrobeniusNormEst. The product
+// is computed by Sgemm.
+func FrobeniusNormEst32(a blas32.General, k int, rnd *rand.Rand) float32 {
+	if k < 1 {
+		panic(badProbes)
+	}
+	m, n := a.Rows, a.Cols
+	if m == 0 || n == 0 {
+		return 0
+	}
+	g := normalProbes32(n, k, rnd)
+	y := newGeneral32(m, k)
+	blasImpl.Sgemm(blas.NoTrans, blas.NoTrans, m, k, n, 1, a.Data, a.Stride, g.Data, g.Stride, 0, y.Data, y.Stride)
+	return blasImpl.Snrm2(m*k, y.Data, 1) / float32(math.Sqrt(float64(k)))
+}
+
+// SpectralNormEst32 is the float32 version of SpectralNormEst. The products
+// are computed by Sgemm and the final eigenvalue problem is solved by Ssyev.
+func SpectralNormEst32(a blas32.General, k, q int, rnd *rand.Rand) (float32, error) {
+	if k < 1 {
+		panic(badProbes)
+	}
+	if q < 0 {
+		panic("lapack: negative iteration count")
+	}
+	m, n := a.Rows, a.Cols
+	k = min(k, min(m, n))
+	if k == 0 {
+		return 0, nil
+	}
+	z := normalProbes32(n, k, rnd)
+	orthonormalize32(z)
+	y := newGeneral32(m, k)
+	blasImpl.Sgemm(blas.NoTrans, blas.NoTrans, m, k, n, 1, a.Data, a.Stride, z.Data, z.Stride, 0, y.Data, y.Stride)
+	for it := 0; it < q; it++ {
+		orthonormalize32(y)
+		blasImpl.Sgemm(blas.Trans, blas.NoTrans, n, k, m, 1, a.Data, a.Stride, y.Data, y.Stride, 0, z.Data, z.Stride)
+		orthonormalize32(z)
+		blasImpl.Sgemm(blas.NoTrans, blas.NoTrans, m, k, n, 1, a.Data, a.Stride, z.Data, z.Stride, 0, y.Data, y.Stride)
+	}
+
+	c := make([]float32, k*k)
+	blasImpl.Ssyrk(blas.Upper, blas.Trans, k, m, 1, y.Data, y.Stride, 0, c, k)
+	w := make([]float32, k)
+	work := make([]float32, 1)
+	lapacke.Ssyev('N', 'U', k, c, k, w, work, -1)
+	work = make([]float32, int(work[0]))
+	if !lapacke.Ssyev('N', 'U', k, c, k, w, work, len(work)) {
+		return 0, ErrIterationLimit
+	}
+	return float32(math.Sqrt(math.Max(float64(w[k-1]), 0))), nil
+}
+
+func normalProbes32(r, c int, rnd *rand.Rand) blas32.General {
+	if rnd == nil {
+		rnd = rand.New(rand.NewSource(1))
+	}
+	g := newGeneral32(r, c)
+	for i := range g.Data {
+		g.Data[i] = float32(rnd.NormFloat64())
+	}
+	return g
+}
+
+func orthonormalize32(a blas32.General) {
+	m, k := a.Rows, a.Cols
+	tau := make([]float32, k)
+	work := make([]float32, 1)
+	lapacke.Sgeqrf(m, k, a.Data, a.Stride, tau, work, -1)
+	lwork := int(work[0])
+	lapacke.Sorgqr(m, k, k, a.Data, a.Stride, tau, work, -1)
+	work = make([]float32, max(lwork, int(work[0])))
+	lapacke.Sgeqrf(m, k, a.Data, a.Stride, tau, work, len(work))
+	lapacke.Sorgqr(m, k, k, a.Data, a.Stride, tau, work, len(work))
+}
@@ -0,0 +1,139 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// generalWithSingularValues returns a random m×n matrix with the singular
+// values s, len(s) <= min(m, n), and zero for the remaining ones.
+func generalWithSingularValues(t *testing.T, rnd *rand.Rand, m, n int, s []float64) blas64.General {
+	k := len(s)
+	u, err := Orth(randomGeneral(rnd, m, k, k), DefaultRCond)
+	if err != nil || u.Cols != k {
+		t.Fatal("unexpected orthogonal basis")
+	}
+	v, err := Orth(randomGeneral(rnd, n, k, k), DefaultRCond)
+	if err != nil || v.Cols != k {
+		t.Fatal("unexpected orthogonal basis")
+	}
+	for j, sj := range s {
+		blas64.Scal(sj, blas64.Vector{N: m, Data: u.Data[j:], Inc: u.Stride})
+	}
+	a := newGeneral(m, n)
+	blas64.Gemm(blas.NoTrans, blas.Trans, 1, u, v, 0, a)
+	return a
+}
+
+var normEstTests = []struct {
+	m, n int
+	s    []float64
+}{
+	{m: 0, n: 4},
+	{m: 1, n: 1, s: []float64{3}},
+	{m: 20, n: 12, s: []float64{10, 5, 4, 1, 0.5}},
+	{m: 12, n: 30, s: []float64{2, 1.5, 1, 1, 1, 0.1}},
+	{m: 40, n: 40, s: []float64{1e3, 1e2, 10, 1, 1e-1, 1e-2, 1e-3}},
+}
+
+func TestNormEst2(t *testing.T) {
+	const tol = 1e-5
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range normEstTests {
+		name := fmt.Sprintf("m=%d,n=%d", test.m, test.n)
+		var want float64
+		a := newGeneral(test.m, test.n)
+		if len(test.s) > 0 {
+			want = test.s[0]
+			a = generalWithSingularValues(t, rnd, test.m, test.n, test.s)
+		}
+		got, err := NormEst2(a)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+		if math.Abs(got-want) > tol*want || got > want*(1+1e-12) {
+			t.Errorf("%s: unexpected estimate: got %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestSpectralNormEst(t *testing.T) {
+	const tol = 1e-6
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range normEstTests {
+		name := fmt.Sprintf("m=%d,n=%d", test.m, test.n)
+		var want float64
+		a := newGeneral(test.m, test.n)
+		if len(test.s) > 0 {
+			want = test.s[0]
+			a = generalWithSingularValues(t, rnd, test.m, test.n, test.s)
+		}
+		got, err := SpectralNormEst(a, 4, 4, rnd)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+		if math.Abs(got-want) > tol*want || got > want*(1+1e-12) {
+			t.Errorf("%s: unexpected estimate: got %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestFrobeniusNormEst(t *testing.T) {
+	// The relative standard deviation of the squared estimate is at most
+	// sqrt(2/k), which is 0.05 for k = 800. The tolerance is about three
+	// standard deviations of the estimate.
+	const k = 800
+	const tol = 0.08
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range normEstTests {
+		name := fmt.Sprintf("m=%d,n=%d", test.m, test.n)
+		var want float64
+		a := newGeneral(test.m, test.n)
+		if len(test.s) > 0 {
+			a = generalWithSingularValues(t, rnd, test.m, test.n, test.s)
+			for _, s := range test.s {
+				want += s * s
+			}
+			want = math.Sqrt(want)
+		}
+		got := FrobeniusNormEst(a, k, rnd)
+		if math.Abs(got-want) > tol*want {
+			t.Errorf("%s: unexpected estimate: got %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestNormEst32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range normEstTests[1:] {
+		name := fmt.Sprintf("m=%d,n=%d", test.m, test.n)
+		a := generalWithSingularValues(t, rnd, test.m, test.n, test.s)
+		a32 := round32(a)
+		want := test.s[0]
+
+		got, err := NormEst232(a32)
+		if err != nil || math.Abs(float64(got)-want) > tol32*want {
+			t.Errorf("%s: unexpected NormEst232 result: got %v, want %v, err=%v", name, got, want, err)
+		}
+		got, err = SpectralNormEst32(a32, 4, 4, rnd)
+		if err != nil || math.Abs(float64(got)-want) > tol32*want {
+			t.Errorf("%s: unexpected SpectralNormEst32 result: got %v, want %v, err=%v", name, got, want, err)
+		}
+		// The same source gives the same probes in both precisions.
+		wantF := FrobeniusNormEst(a, 4, rand.New(rand.NewSource(2)))
+		gotF := float64(FrobeniusNormEst32(a32, 4, rand.New(rand.NewSource(2))))
+		if math.Abs(gotF-wantF) > tol32*wantF {
+			t.Errorf("%s: unexpected FrobeniusNormEst32 result: got %v, want %v", name, gotF, wantF)
+		}
+	}
+}
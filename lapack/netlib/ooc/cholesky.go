@@ -0,0 +1,76 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ooc
+
+import (
+	"gonum.org/v1/gonum/blas"
+
+	"gonum.org/v1/netlib/lapack/lapacke"
+	lapacknetlib "gonum.org/v1/netlib/lapack/netlib"
+)
+
+// Cholesky computes the Cholesky factorization
+//
+//	A = L * Lᵀ
+//
+// of the n×n symmetric positive definite matrix A in a, overwriting the
+// lower triangle of a with L. The strictly upper triangle of a is not
+// referenced.
+//
+// The factorization proceeds by block rows of opts.BlockSize rows. Each
+// block row of L is computed from the block row of A by Dgemm and Dtrsm
+// updates with the block rows above it, which are read again from a, and
+// its diagonal block is factored by Dsyrk and Dpotrf. Two block rows of up
+// to n elements each are held in memory.
+//
+// If A is not positive definite, Cholesky returns an
+// ErrNotPositiveDefinite of gonum.org/v1/netlib/lapack/netlib and the rows
+// of a from the block row of the failing pivot on are not factored. Errors
+// of the Storage are returned as they are.
+func Cholesky(a Matrix, opts *Options) error {
+	if a.Rows != a.Cols {
+		panic(badShape)
+	}
+	n := a.Rows
+	nb := opts.blockSize()
+	r := make([]float64, min(nb, n)*n)
+	s := make([]float64, min(nb, n)*n)
+	for k0 := 0; k0 < n; k0 += nb {
+		kb := min(nb, n-k0)
+		kend := k0 + kb
+		// The block row of A from its first column to the end of the
+		// diagonal block.
+		ldr := kend
+		if err := a.ReadBlock(k0, 0, kb, kend, r, ldr); err != nil {
+			return err
+		}
+		for j0 := 0; j0 < k0; j0 += nb {
+			jend := j0 + nb
+			lds := jend
+			if err := a.ReadBlock(j0, 0, nb, jend, s, lds); err != nil {
+				return err
+			}
+			// R[:, J] -= R[:, 0:J] * L[J, 0:J]ᵀ
+			if j0 > 0 {
+				blasImpl.Dgemm(blas.NoTrans, blas.Trans, kb, nb, j0, -1, r, ldr, s, lds, 1, r[j0:], ldr)
+			}
+			// R[:, J] *= L[J, J]⁻ᵀ
+			blasImpl.Dtrsm(blas.Right, blas.Lower, blas.Trans, blas.NonUnit, kb, nb, 1, s[j0:], lds, r[j0:], ldr)
+		}
+		if k0 > 0 {
+			blasImpl.Dsyrk(blas.Lower, blas.NoTrans, kb, k0, -1, r, ldr, 1, r[k0:], ldr)
+		}
+		if info := lapacke.DpotrfInfo(byte(blas.Lower), kb, r[k0:], ldr); info != 0 {
+			if info < 0 {
+				panic("ooc: invalid argument to Dpotrf")
+			}
+			return lapacknetlib.ErrNotPositiveDefinite{Index: k0 + info - 1}
+		}
+		if err := a.WriteBlock(k0, 0, kb, kend, r, ldr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
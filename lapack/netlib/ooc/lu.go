@@ -0,0 +1,91 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ooc
+
+import (
+	"gonum.org/v1/gonum/blas"
+
+	lapacknetlib "gonum.org/v1/netlib/lapack/netlib"
+)
+
+// LU computes the LU factorization with partial pivoting
+//
+//	A = P * L * U
+//
+// of the n×n matrix A in a, overwriting a with the unit lower triangular L
+// below the diagonal and the upper triangular U on and above it. ipiv must
+// have length n and holds the zero-indexed row interchanges on return, as
+// returned by Dgetrf: row i of A was interchanged with row ipiv[i].
+//
+// The factorization proceeds by block columns of opts.BlockSize columns.
+// Each block column is read with the interchanges of the previous block
+// columns applied, updated by Dtrsm and Dgemm with the block columns to its
+// left, which are read again from a, and factored by Dgetrf. The
+// interchanges of the block column are then applied to the columns to its
+// left in a. Two block columns of up to n elements each are held in
+// memory.
+//
+// If U is exactly singular, LU completes the factorization and returns a
+// SingularError of gonum.org/v1/netlib/lapack/netlib with the index of the
+// first zero pivot. Errors of the Storage are returned as they are.
+func LU(a Matrix, ipiv []int, opts *Options) error {
+	if a.Rows != a.Cols {
+		panic(badShape)
+	}
+	n := a.Rows
+	if len(ipiv) != n {
+		panic(badLenIpiv)
+	}
+	nb := opts.blockSize()
+	p := make([]float64, n*min(nb, n))
+	q := make([]float64, n*min(nb, n))
+	singular := -1
+	for k0 := 0; k0 < n; k0 += nb {
+		kb := min(nb, n-k0)
+		// The block column of A with the interchanges of the previous
+		// block columns applied.
+		ldp := kb
+		if err := a.ReadBlock(0, k0, n, kb, p, ldp); err != nil {
+			return err
+		}
+		if k0 > 0 {
+			lapackImpl.Dlaswp(kb, p, ldp, 0, k0-1, ipiv[:k0], 1)
+		}
+		for j0 := 0; j0 < k0; j0 += nb {
+			// The block column of L from its diagonal block down.
+			ldq := nb
+			if err := a.ReadBlock(j0, j0, n-j0, nb, q, ldq); err != nil {
+				return err
+			}
+			// P[J, :] = L[J, J]⁻¹ * P[J, :]
+			blasImpl.Dtrsm(blas.Left, blas.Lower, blas.NoTrans, blas.Unit, nb, kb, 1, q, ldq, p[j0*ldp:], ldp)
+			// P[J+1:, :] -= L[J+1:, J] * P[J, :]
+			blasImpl.Dgemm(blas.NoTrans, blas.NoTrans, n-j0-nb, kb, nb, -1, q[nb*ldq:], ldq, p[j0*ldp:], ldp, 1, p[(j0+nb)*ldp:], ldp)
+		}
+		piv := ipiv[k0 : k0+kb]
+		lapackImpl.Dgetrf(n-k0, kb, p[k0*ldp:], ldp, piv)
+		for i := range piv {
+			if singular < 0 && p[(k0+i)*ldp+i] == 0 {
+				singular = k0 + i
+			}
+			piv[i] += k0
+		}
+		if err := a.WriteBlock(0, k0, n, kb, p, ldp); err != nil {
+			return err
+		}
+		for i := k0; i < k0+kb; i++ {
+			if ipiv[i] == i {
+				continue
+			}
+			if err := a.swapRows(i, ipiv[i], k0); err != nil {
+				return err
+			}
+		}
+	}
+	if singular >= 0 {
+		return lapacknetlib.SingularError{Index: singular}
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package ooc provides out-of-core factorizations of dense matrices that
+// are too large to be held in memory.
+//
+// The matrix is kept in a Storage, usually an *os.File, and the drivers
+// stage panels of a few block rows or columns through memory, where they are
+// updated by the BLAS and LAPACK kernels of gonum.org/v1/netlib. The
+// factorizations are left-looking: each panel is read once to be factored
+// and written back, and the panels to its left are read again to apply
+// their updates, so that the memory needed is a small multiple of the
+// panel size regardless of the order of the matrix:
+//
+//	f, err := os.OpenFile("a.bin", os.O_RDWR, 0)
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	a := ooc.Matrix{Storage: f, Rows: n, Cols: n}
+//	err = ooc.Cholesky(a, &ooc.Options{BlockSize: 512})
+//
+// Matrices are stored in row-major order as little-endian IEEE 754 float64
+// values.
+package ooc // import "gonum.org/v1/netlib/lapack/netlib/ooc"
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+
+	blasnetlib "gonum.org/v1/netlib/blas/netlib"
+	lapacknetlib "gonum.org/v1/netlib/lapack/netlib"
+)
+
+var (
+	blasImpl   blasnetlib.Implementation
+	lapackImpl lapacknetlib.Implementation
+)
+
+const (
+	badShape     = "ooc: matrix is not square"
+	badBlockSize = "ooc: block size < 1"
+	badBlock     = "ooc: block out of range"
+	badLenIpiv   = "ooc: bad length of ipiv"
+	badLd        = "ooc: bad leading dimension"
+	shortBuf     = "ooc: insufficient length of buffer"
+)
+
+// defaultBlockSize is the block size used when Options is nil or its
+// BlockSize is zero.
+const defaultBlockSize = 256
+
+// Storage is the backing store of a Matrix. *os.File implements Storage.
+type Storage interface {
+	io.ReaderAt
+	io.WriterAt
+}
+
+// Matrix is a Rows×Cols matrix stored in row-major order in Storage,
+// starting at the byte offset Offset.
+type Matrix struct {
+	Storage    Storage
+	Rows, Cols int
+	Offset     int64
+}
+
+// Options specifies the block size of the out-of-core drivers.
+type Options struct {
+	// BlockSize is the number of rows or columns of the panels staged
+	// through memory. The drivers hold two panels of BlockSize rows or
+	// columns of the full matrix in memory. If BlockSize is zero, 256 is
+	// used.
+	BlockSize int
+}
+
+func (o *Options) blockSize() int {
+	if o == nil || o.BlockSize == 0 {
+		return defaultBlockSize
+	}
+	if o.BlockSize < 1 {
+		panic(badBlockSize)
+	}
+	return o.BlockSize
+}
+
+// ReadBlock reads the r×c block of m with the top left element at row i and
+// column j into dst, which is stored in row-major order with the leading
+// dimension ld.
+func (m Matrix) ReadBlock(i, j, r, c int, dst []float64, ld int) error {
+	m.checkBlock(i, j, r, c, dst, ld)
+	if r == 0 || c == 0 {
+		return nil
+	}
+	buf := make([]byte, 8*c)
+	for k := 0; k < r; k++ {
+		n, err := m.Storage.ReadAt(buf, m.offset(i+k, j))
+		if n < len(buf) {
+			if err == nil || err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return err
+		}
+		row := dst[k*ld : k*ld+c]
+		for l := range row {
+			row[l] = math.Float64frombits(binary.LittleEndian.Uint64(buf[8*l:]))
+		}
+	}
+	return nil
+}
+
+// WriteBlock writes the r×c block of src, which is stored in row-major
+// order with the leading dimension ld, into m with the top left element at
+// row i and column j.
+func (m Matrix) WriteBlock(i, j, r, c int, src []float64, ld int) error {
+	m.checkBlock(i, j, r, c, src, ld)
+	if r == 0 || c == 0 {
+		return nil
+	}
+	buf := make([]byte, 8*c)
+	for k := 0; k < r; k++ {
+		for l, v := range src[k*ld : k*ld+c] {
+			binary.LittleEndian.PutUint64(buf[8*l:], math.Float64bits(v))
+		}
+		_, err := m.Storage.WriteAt(buf, m.offset(i+k, j))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m Matrix) checkBlock(i, j, r, c int, a []float64, ld int) {
+	switch {
+	case i < 0 || j < 0 || r < 0 || c < 0 || m.Rows < i+r || m.Cols < j+c:
+		panic(badBlock)
+	case ld < max(1, c):
+		panic(badLd)
+	case r > 0 && len(a) < (r-1)*ld+c:
+		panic(shortBuf)
+	}
+}
+
+// offset returns the byte offset of the element of m at row i and column j.
+func (m Matrix) offset(i, j int) int64 {
+	return m.Offset + 8*(int64(i)*int64(m.Cols)+int64(j))
+}
+
+// swapRows swaps the first c elements of the rows i and k of m.
+func (m Matrix) swapRows(i, k, c int) error {
+	if c == 0 {
+		return nil
+	}
+	a := make([]float64, 2*c)
+	if err := m.ReadBlock(i, 0, 1, c, a, c); err != nil {
+		return err
+	}
+	if err := m.ReadBlock(k, 0, 1, c, a[c:], c); err != nil {
+		return err
+	}
+	if err := m.WriteBlock(i, 0, 1, c, a[c:], c); err != nil {
+		return err
+	}
+	return m.WriteBlock(k, 0, 1, c, a, c)
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,202 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ooc
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+
+	lapacknetlib "gonum.org/v1/netlib/lapack/netlib"
+)
+
+// tempMatrix returns an r×c matrix stored in a temporary file after a
+// header of 16 bytes, holding the elements of data.
+func tempMatrix(t *testing.T, r, c int, data []float64) (Matrix, func()) {
+	f, err := ioutil.TempFile("", "ooc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := Matrix{Storage: f, Rows: r, Cols: c, Offset: 16}
+	if err := m.WriteBlock(0, 0, r, c, data, max(1, c)); err != nil {
+		t.Fatal(err)
+	}
+	return m, func() {
+		f.Close()
+		os.Remove(f.Name())
+	}
+}
+
+func maxAbsDiff(a, b []float64) float64 {
+	var d float64
+	for i := range a {
+		d = math.Max(d, math.Abs(a[i]-b[i]))
+	}
+	return d
+}
+
+func TestReadWriteBlock(t *testing.T) {
+	data := make([]float64, 5*7)
+	for i := range data {
+		data[i] = float64(i)
+	}
+	m, cleanup := tempMatrix(t, 5, 7, data)
+	defer cleanup()
+
+	got := make([]float64, 2*3)
+	if err := m.ReadBlock(2, 3, 2, 3, got, 3); err != nil {
+		t.Fatal(err)
+	}
+	want := []float64{17, 18, 19, 24, 25, 26}
+	if d := maxAbsDiff(got, want); d != 0 {
+		t.Errorf("unexpected block: got %v, want %v", got, want)
+	}
+
+	if err := m.WriteBlock(4, 5, 1, 2, []float64{-1, -2}, 2); err != nil {
+		t.Fatal(err)
+	}
+	all := make([]float64, 5*7)
+	if err := m.ReadBlock(0, 0, 5, 7, all, 7); err != nil {
+		t.Fatal(err)
+	}
+	data[33], data[34] = -1, -2
+	if d := maxAbsDiff(all, data); d != 0 {
+		t.Errorf("unexpected matrix after WriteBlock: %v", all)
+	}
+
+	short := Matrix{Storage: m.Storage, Rows: 6, Cols: 7, Offset: 16}
+	if err := short.ReadBlock(5, 0, 1, 7, all, 7); err == nil {
+		t.Error("unexpected nil error for a read past the end of the storage")
+	}
+}
+
+func TestCholesky(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 7, 20, 33} {
+		for _, nb := range []int{1, 4, 8, 64} {
+			name := fmt.Sprintf("n=%d,nb=%d", n, nb)
+			// A = X * Xᵀ + n * I
+			x := make([]float64, n*n)
+			for i := range x {
+				x[i] = rnd.NormFloat64()
+			}
+			a := make([]float64, n*n)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					for k := 0; k < n; k++ {
+						a[i*n+j] += x[i*n+k] * x[j*n+k]
+					}
+				}
+				a[i*n+i] += float64(n)
+			}
+
+			m, cleanup := tempMatrix(t, n, n, a)
+			err := Cholesky(m, &Options{BlockSize: nb})
+			if err != nil {
+				cleanup()
+				t.Fatalf("%s: unexpected error: %v", name, err)
+			}
+			got := make([]float64, n*n)
+			err = m.ReadBlock(0, 0, n, n, got, max(1, n))
+			cleanup()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := append([]float64(nil), a...)
+			if n > 0 && !lapackImpl.Dpotrf(blas.Lower, n, want, n) {
+				t.Fatalf("%s: unexpected failure of Dpotrf", name)
+			}
+			for i := 0; i < n; i++ {
+				for j := i + 1; j < n; j++ {
+					if got[i*n+j] != a[i*n+j] {
+						t.Fatalf("%s: upper triangle modified", name)
+					}
+					got[i*n+j], want[i*n+j] = 0, 0
+				}
+			}
+			if d := maxAbsDiff(got, want); d > 1e-12*float64(n) {
+				t.Errorf("%s: unexpected factor: difference %v", name, d)
+			}
+		}
+	}
+}
+
+func TestCholeskyNotPositiveDefinite(t *testing.T) {
+	const n = 6
+	a := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		a[i*n+i] = 1
+	}
+	a[4*n+4] = -1
+	m, cleanup := tempMatrix(t, n, n, a)
+	defer cleanup()
+	err := Cholesky(m, &Options{BlockSize: 2})
+	if e, ok := err.(lapacknetlib.ErrNotPositiveDefinite); !ok || e.Index != 4 {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestLU(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 7, 20, 33} {
+		for _, nb := range []int{1, 4, 8, 64} {
+			name := fmt.Sprintf("n=%d,nb=%d", n, nb)
+			a := make([]float64, n*n)
+			for i := range a {
+				a[i] = rnd.NormFloat64()
+			}
+
+			m, cleanup := tempMatrix(t, n, n, a)
+			ipiv := make([]int, n)
+			err := LU(m, ipiv, &Options{BlockSize: nb})
+			if err != nil {
+				cleanup()
+				t.Fatalf("%s: unexpected error: %v", name, err)
+			}
+			got := make([]float64, n*n)
+			err = m.ReadBlock(0, 0, n, n, got, max(1, n))
+			cleanup()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := append([]float64(nil), a...)
+			wantPiv := make([]int, n)
+			if n > 0 {
+				lapackImpl.Dgetrf(n, n, want, n, wantPiv)
+			}
+			for i := range ipiv {
+				if ipiv[i] != wantPiv[i] {
+					t.Fatalf("%s: unexpected pivots: got %v, want %v", name, ipiv, wantPiv)
+				}
+			}
+			if d := maxAbsDiff(got, want); d > 1e-12*float64(n) {
+				t.Errorf("%s: unexpected factors: difference %v", name, d)
+			}
+		}
+	}
+}
+
+func TestLUSingular(t *testing.T) {
+	const n = 5
+	a := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		a[i*n+i] = float64(i + 1)
+	}
+	a[3*n+3] = 0
+	m, cleanup := tempMatrix(t, n, n, a)
+	defer cleanup()
+	err := LU(m, make([]int, n), &Options{BlockSize: 2})
+	if e, ok := err.(lapacknetlib.SingularError); !ok || e.Index != 3 {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import "gonum.org/v1/netlib/lapack/lapacke"
+
+// Open loads the LAPACKE library at path and returns an Implementation that
+// calls into it. Open is only supported when the lapacke package is built
+// with the dlopen build tag; see lapacke.Open for how the library is bound.
+//
+// The convenience functions of this package also call BLAS routines through
+// gonum.org/v1/netlib/blas/netlib, whose library is loaded separately by
+// its Open function. Libraries such as OpenBLAS and MKL provide both
+// interfaces and can be passed to both.
+func Open(path string) (*Implementation, error) {
+	err := lapacke.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Implementation{}, nil
+}
@@ -0,0 +1,83 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import "gonum.org/v1/gonum/blas/blas64"
+
+// Orth returns a matrix whose columns form an orthonormal basis for the range
+// of the m×n matrix A. The basis is given by the leading left singular
+// vectors of A, computed by the driver chosen by DefaultDrivers, and the
+// number of columns of the result is the effective rank of A.
+//
+// Singular values less than or equal to rcond times the largest singular
+// value are treated as zero. If rcond is negative, max(m,n) times the
+// machine epsilon 2^-52 is used, which is the default of scipy.linalg.orth.
+// The input a is not modified.
+//
+// If the singular value decomposition fails to converge, Orth returns
+// ErrIterationLimit.
+func Orth(a blas64.General, rcond float64) (q blas64.General, err error) {
+	m, n := a.Rows, a.Cols
+	ws := getWorkspace()
+	defer putWorkspace(ws)
+	s, u, _, ok := svd(DefaultDrivers.Select(SVDProblem, m, n), ws.general(a), 'S', &ws.workspace)
+	if !ok {
+		return newGeneral(m, 0), ErrIterationLimit
+	}
+	rank := svdRank(s, m, n, rcond)
+	k := len(s)
+	q = newGeneral(m, rank)
+	for i := 0; i < m && rank > 0; i++ {
+		copy(q.Data[i*q.Stride:i*q.Stride+rank], u[i*k:i*k+rank])
+	}
+	return q, nil
+}
+
+// Null returns a matrix whose columns form an orthonormal basis for the null
+// space of the m×n matrix A. The basis is given by the trailing right
+// singular vectors of A, computed by the driver chosen by DefaultDrivers, and
+// the number of columns of the result is n minus the effective rank of A.
+//
+// The effective rank is determined by rcond as described for Orth, which
+// matches the default of scipy.linalg.null_space. The input a is not
+// modified.
+//
+// If the singular value decomposition fails to converge, Null returns
+// ErrIterationLimit.
+func Null(a blas64.General, rcond float64) (z blas64.General, err error) {
+	m, n := a.Rows, a.Cols
+	ws := getWorkspace()
+	defer putWorkspace(ws)
+	s, _, vt, ok := svd(DefaultDrivers.Select(SVDProblem, m, n), ws.general(a), 'A', &ws.workspace)
+	if !ok {
+		return newGeneral(n, 0), ErrIterationLimit
+	}
+	rank := svdRank(s, m, n, rcond)
+	z = newGeneral(n, n-rank)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n-rank; j++ {
+			z.Data[i*z.Stride+j] = vt[(rank+j)*n+i]
+		}
+	}
+	return z, nil
+}
+
+// svdRank returns the number of singular values in s, sorted in decreasing
+// order, that are greater than rcond times the largest. If rcond is negative,
+// max(m,n)*2^-52 is used.
+func svdRank(s []float64, m, n int, rcond float64) int {
+	if len(s) == 0 {
+		return 0
+	}
+	if rcond < 0 {
+		rcond = float64(max(m, n)) * 2 * dlamchE
+	}
+	cutoff := rcond * s[0]
+	var rank int
+	for rank < len(s) && s[rank] > cutoff {
+		rank++
+	}
+	return rank
+}
@@ -0,0 +1,136 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+)
+
+// orthonormalityError returns max |Q^T*Q - I|.
+func orthonormalityError(q blas64.General) float64 {
+	k := q.Cols
+	qtq := newGeneral(k, k)
+	if q.Rows > 0 {
+		blas64.Gemm(blas.Trans, blas.NoTrans, 1, q, q, 0, qtq)
+	}
+	for i := 0; i < k; i++ {
+		qtq.Data[i*qtq.Stride+i]--
+	}
+	return floats.Norm(qtq.Data, 1e300)
+}
+
+func TestOrthNull(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, rank int
+	}{
+		{m: 0, n: 4, rank: 0},
+		{m: 4, n: 0, rank: 0},
+		{m: 3, n: 3, rank: 0},
+		{m: 1, n: 1, rank: 1},
+		{m: 6, n: 6, rank: 6},
+		{m: 9, n: 4, rank: 4},
+		{m: 4, n: 9, rank: 4},
+		{m: 10, n: 8, rank: 3},
+		{m: 5, n: 11, rank: 2},
+	} {
+		name := fmt.Sprintf("m=%d,n=%d,rank=%d", test.m, test.n, test.rank)
+		a := newGeneral(test.m, test.n)
+		if test.rank > 0 {
+			a = mul(randomGeneral(rnd, test.m, test.rank, test.rank), randomGeneral(rnd, test.rank, test.n, test.n))
+		}
+		aCopy := cloneGeneral(a)
+
+		q, err := Orth(a, -1)
+		if err != nil {
+			t.Errorf("%s: unexpected error from Orth: %v", name, err)
+			continue
+		}
+		if q.Rows != test.m || q.Cols != test.rank {
+			t.Errorf("%s: unexpected Orth shape %d×%d", name, q.Rows, q.Cols)
+			continue
+		}
+		if d := orthonormalityError(q); d > tol {
+			t.Errorf("%s: Orth basis not orthonormal: %v", name, d)
+		}
+		// The columns of A must lie in the span of Q.
+		if test.m > 0 && test.n > 0 {
+			qta := newGeneral(test.rank, test.n)
+			proj := newGeneral(test.m, test.n)
+			if test.rank > 0 {
+				blas64.Gemm(blas.Trans, blas.NoTrans, 1, q, a, 0, qta)
+				blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, q, qta, 0, proj)
+			}
+			if d := maxDiff(proj, a, false); d > tol {
+				t.Errorf("%s: A not in range of Orth basis: %v", name, d)
+			}
+		}
+
+		z, err := Null(a, -1)
+		if err != nil {
+			t.Errorf("%s: unexpected error from Null: %v", name, err)
+			continue
+		}
+		if z.Rows != test.n || z.Cols != test.n-test.rank {
+			t.Errorf("%s: unexpected Null shape %d×%d", name, z.Rows, z.Cols)
+			continue
+		}
+		if d := orthonormalityError(z); d > tol {
+			t.Errorf("%s: Null basis not orthonormal: %v", name, d)
+		}
+		if test.m > 0 && z.Cols > 0 {
+			az := mul(a, z)
+			if d := floats.Norm(az.Data, 1e300); d > tol {
+				t.Errorf("%s: A*Z not zero: %v", name, d)
+			}
+		}
+
+		if !floats.Equal(a.Data, aCopy.Data) {
+			t.Errorf("%s: input modified", name)
+		}
+	}
+}
+
+func TestOrthRCond(t *testing.T) {
+	a := blas64.General{
+		Rows: 3, Cols: 2, Stride: 2,
+		Data: []float64{
+			1, 0,
+			0, 1e-9,
+			0, 0,
+		},
+	}
+	for _, test := range []struct {
+		rcond float64
+		rank  int
+	}{
+		{rcond: -1, rank: 2},
+		{rcond: 1e-6, rank: 1},
+		{rcond: 1, rank: 0},
+	} {
+		q, err := Orth(a, test.rcond)
+		if err != nil {
+			t.Fatalf("rcond=%v: unexpected error: %v", test.rcond, err)
+		}
+		if q.Cols != test.rank {
+			t.Errorf("rcond=%v: unexpected Orth rank: got %d want %d", test.rcond, q.Cols, test.rank)
+		}
+		z, err := Null(a, test.rcond)
+		if err != nil {
+			t.Fatalf("rcond=%v: unexpected error: %v", test.rcond, err)
+		}
+		if z.Cols != 2-test.rank {
+			t.Errorf("rcond=%v: unexpected Null dimension: got %d want %d", test.rcond, z.Cols, 2-test.rank)
+		}
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// cpack returns the triangle of a indicated by uplo packed row by row.
+func cpack(uplo blas.Uplo, a cblas128.General) []complex128 {
+	var ap []complex128
+	for i := 0; i < a.Rows; i++ {
+		if uplo == blas.Upper {
+			ap = append(ap, a.Data[i*a.Stride+i:i*a.Stride+a.Cols]...)
+		} else {
+			ap = append(ap, a.Data[i*a.Stride:i*a.Stride+i+1]...)
+		}
+	}
+	return ap
+}
+
+// randomSymmetric returns a random n×n symmetric indefinite matrix.
+func randomSymmetric(rnd *rand.Rand, n int) blas64.Symmetric {
+	a := newGeneral(n, n)
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			v := rnd.NormFloat64()
+			a.Data[i*a.Stride+j] = v
+			a.Data[j*a.Stride+i] = v
+		}
+	}
+	return blas64.Symmetric{Uplo: blas.Upper, N: n, Stride: a.Stride, Data: a.Data}
+}
+
+func TestDppsv(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 4, 11} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			name := fmt.Sprintf("n=%d,uplo=%c", n, uplo)
+			a := randomSPD(rnd, n, n+2)
+			a.Uplo = uplo
+			b := randomGeneral(rnd, n, 3, 3)
+
+			ap := ToPacked(a).Data
+			x := cloneGeneral(b)
+			if !impl.Dppsv(uplo, n, 3, ap, x.Data, x.Stride) {
+				t.Fatalf("%s: unexpected failure of Dppsv", name)
+			}
+			if d := maxDiff(mul(symGeneral(a), x), b, false); d > 1e-10*float64(max(1, n)) {
+				t.Errorf("%s: unexpected residual of Dppsv %v", name, d)
+			}
+
+			// Dpptrf and Dpptrs give the same factor and solution.
+			f := ToPacked(a).Data
+			if !impl.Dpptrf(uplo, n, f) {
+				t.Fatalf("%s: unexpected failure of Dpptrf", name)
+			}
+			if !floats.EqualApprox(f, ap, 1e-14) {
+				t.Errorf("%s: factors of Dpptrf and Dppsv differ", name)
+			}
+			y := cloneGeneral(b)
+			impl.Dpptrs(uplo, n, 3, f, y.Data, y.Stride)
+			if d := maxDiff(y, x, false); d > 1e-12 {
+				t.Errorf("%s: solutions of Dpptrs and Dppsv differ by %v", name, d)
+			}
+		}
+	}
+
+	ap := []float64{1, 2, 1}
+	if impl.Dpptrf(blas.Upper, 2, ap) {
+		t.Error("unexpected success of Dpptrf for an indefinite matrix")
+	}
+}
+
+func TestDspsv(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 5, 12} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			name := fmt.Sprintf("n=%d,uplo=%c", n, uplo)
+			a := randomSymmetric(rnd, n)
+			a.Uplo = uplo
+			b := randomGeneral(rnd, n, 2, 2)
+
+			ap := ToPacked(a).Data
+			ipiv := make([]int, n)
+			x := cloneGeneral(b)
+			if !impl.Dspsv(uplo, n, 2, ap, ipiv, x.Data, x.Stride) {
+				t.Fatalf("%s: unexpected singular matrix", name)
+			}
+			if d := maxDiff(mul(symGeneral(a), x), b, false); d > 1e-10*float64(max(1, n)) {
+				t.Errorf("%s: unexpected residual of Dspsv %v", name, d)
+			}
+			for k, p := range ipiv {
+				if p < -n || n <= p {
+					t.Errorf("%s: pivot %d out of range: %d", name, k, p)
+				}
+			}
+
+			f := ToPacked(a).Data
+			fpiv := make([]int, n)
+			if !impl.Dsptrf(uplo, n, f, fpiv) {
+				t.Fatalf("%s: unexpected singular matrix", name)
+			}
+			y := cloneGeneral(b)
+			impl.Dsptrs(uplo, n, 2, f, fpiv, y.Data, y.Stride)
+			if d := maxDiff(y, x, false); d > 1e-12*float64(max(1, n)) {
+				t.Errorf("%s: solutions of Dsptrs and Dspsv differ by %v", name, d)
+			}
+		}
+	}
+}
+
+func TestDspev(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 4, 10} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			name := fmt.Sprintf("n=%d,uplo=%c", n, uplo)
+			a := randomSymmetric(rnd, n)
+			a.Uplo = uplo
+
+			w := make([]float64, n)
+			z := newGeneral(n, n)
+			if !impl.Dspev(lapack.EVCompute, uplo, n, ToPacked(a).Data, w, z.Data, z.Stride, make([]float64, 3*n)) {
+				t.Fatalf("%s: Dspev did not converge", name)
+			}
+			if !sort.Float64sAreSorted(w) {
+				t.Errorf("%s: eigenvalues not in ascending order: %v", name, w)
+			}
+			zw := cloneGeneral(z)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					zw.Data[i*zw.Stride+j] *= w[j]
+				}
+			}
+			if d := maxDiff(mul(symGeneral(a), z), zw, false); d > 1e-12*float64(max(1, n)) {
+				t.Errorf("%s: unexpected A * Z - Z * Λ, difference %v", name, d)
+			}
+
+			w2 := make([]float64, n)
+			if !impl.Dspev(lapack.EVNone, uplo, n, ToPacked(a).Data, w2, nil, 1, make([]float64, 3*n)) {
+				t.Fatalf("%s: Dspev did not converge", name)
+			}
+			if !floats.EqualApprox(w2, w, 1e-12*float64(max(1, n))) {
+				t.Errorf("%s: eigenvalues with and without vectors differ", name)
+			}
+		}
+	}
+}
+
+func TestZppsv(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 4, 10} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			a := randomHPD(rnd, n)
+			b := randomCGeneral(rnd, n, 2, 2)
+			x := cloneCGeneral(b)
+			if !impl.Zppsv(uplo, n, 2, cpack(uplo, a), x.Data, x.Stride) {
+				t.Fatalf("n=%d uplo=%c: unexpected failure of Zppsv", n, uplo)
+			}
+			if d := cmaxAbsDiff(naiveCMul(a, x), b); d > ztol {
+				t.Errorf("n=%d uplo=%c: unexpected residual of Zppsv %v", n, uplo, d)
+			}
+
+			f := cpack(uplo, a)
+			if !impl.Zpptrf(uplo, n, f) {
+				t.Fatalf("n=%d uplo=%c: unexpected failure of Zpptrf", n, uplo)
+			}
+			y := cloneCGeneral(b)
+			impl.Zpptrs(uplo, n, 2, f, y.Data, y.Stride)
+			if d := cmaxAbsDiff(naiveCMul(a, y), b); d > ztol {
+				t.Errorf("n=%d uplo=%c: unexpected residual of Zpptrs %v", n, uplo, d)
+			}
+		}
+	}
+}
+
+func TestZhpsv(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 4, 10} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			// A Hermitian indefinite matrix.
+			m := randomCGeneral(rnd, n, n, n)
+			a := naiveCMul(conjTrans(m), m)
+			for i := 0; i < n; i++ {
+				a.Data[i*a.Stride+i] -= complex(float64(n), 0)
+			}
+			b := randomCGeneral(rnd, n, 2, 2)
+			ipiv := make([]int, n)
+			x := cloneCGeneral(b)
+			if !impl.Zhpsv(uplo, n, 2, cpack(uplo, a), ipiv, x.Data, x.Stride) {
+				t.Fatalf("n=%d uplo=%c: unexpected singular matrix", n, uplo)
+			}
+			if d := cmaxAbsDiff(naiveCMul(a, x), b); d > ztol*float64(n) {
+				t.Errorf("n=%d uplo=%c: unexpected residual of Zhpsv %v", n, uplo, d)
+			}
+
+			f := cpack(uplo, a)
+			if !impl.Zhptrf(uplo, n, f, ipiv) {
+				t.Fatalf("n=%d uplo=%c: unexpected singular matrix", n, uplo)
+			}
+			y := cloneCGeneral(b)
+			impl.Zhptrs(uplo, n, 2, f, ipiv, y.Data, y.Stride)
+			if d := cmaxAbsDiff(naiveCMul(a, y), b); d > ztol*float64(n) {
+				t.Errorf("n=%d uplo=%c: unexpected residual of Zhptrs %v", n, uplo, d)
+			}
+		}
+	}
+}
+
+func TestZhpev(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 4, 9} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			m := randomCGeneral(rnd, n, n, n)
+			a := naiveCMul(conjTrans(m), m)
+			w := make([]float64, n)
+			z := newCGeneral(n, n)
+			work := make([]complex128, max(1, 2*n-1))
+			rwork := make([]float64, max(1, 3*n-2))
+			if !impl.Zhpev(lapack.EVCompute, uplo, n, cpack(uplo, a), w, z.Data, z.Stride, work, rwork) {
+				t.Fatalf("n=%d uplo=%c: Zhpev did not converge", n, uplo)
+			}
+			if !sort.Float64sAreSorted(w) {
+				t.Errorf("n=%d uplo=%c: eigenvalues not in ascending order: %v", n, uplo, w)
+			}
+			zw := cloneCGeneral(z)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					zw.Data[i*zw.Stride+j] *= complex(w[j], 0)
+				}
+			}
+			if d := cmaxAbsDiff(naiveCMul(a, z), zw); d > ztol*float64(n) {
+				t.Errorf("n=%d uplo=%c: unexpected A * Z - Z * Λ, difference %v", n, uplo, d)
+			}
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// DefaultRCond is the default relative cutoff for small singular values used
+// by NumPy's pinv.
+const DefaultRCond = 1e-15
+
+// PInv computes the Moore-Penrose pseudo-inverse of the m×n matrix A from
+// its singular value decomposition
+//  A = U * Σ * V^T
+// computed by the driver chosen by DefaultDrivers, which is the divide and
+// conquer driver Dgesdd unless another has been selected. Singular values
+// less than or equal to rcond times the largest singular value are treated
+// as zero, so that the pseudo-inverse is
+//  A^+ = V * Σ^+ * U^T
+// where Σ^+ holds the reciprocals of the retained singular values. This is
+// the thresholding performed by numpy.linalg.pinv, and DefaultRCond is the
+// NumPy default. rcond must not be negative, otherwise PInv will panic.
+//
+// PInv returns the n×m pseudo-inverse and the effective rank of A, which is
+// the number of retained singular values. The input a is not modified.
+//
+// If the singular value decomposition fails to converge, PInv returns
+// ErrIterationLimit.
+func PInv(a blas64.General, rcond float64) (p blas64.General, rank int, err error) {
+	if rcond < 0 {
+		panic("lapack: negative rcond")
+	}
+	ws := getWorkspace()
+	defer putWorkspace(ws)
+	return pinv(ws.general(a), rcond, &ws.workspace)
+}
+
+// pinv computes the pseudo-inverse of A as described for PInv, overwriting
+// a. Scratch memory is taken from ws, which may be nil.
+func pinv(a blas64.General, rcond float64, ws *workspace) (p blas64.General, rank int, err error) {
+	m, n := a.Rows, a.Cols
+	p = newGeneral(n, m)
+	k := min(m, n)
+	if k == 0 {
+		return p, 0, nil
+	}
+
+	s, u, vt, ok := svd(DefaultDrivers.Select(SVDProblem, m, n), a, 'S', ws)
+	if !ok {
+		return p, 0, ErrIterationLimit
+	}
+
+	rank = svdRank(s, m, n, rcond)
+	if rank == 0 {
+		return p, 0, nil
+	}
+	// Scale the retained rows of V^T by the reciprocal singular values and
+	// form A^+ = (Σ^+ * V^T)^T * U^T.
+	for i := 0; i < rank; i++ {
+		blasImpl.Dscal(n, 1/s[i], vt[i*n:], 1)
+	}
+	blasImpl.Dgemm(blas.Trans, blas.Trans, n, m, rank, 1, vt, n, u, k, 0, p.Data, p.Stride)
+	return p, rank, nil
+}
@@ -0,0 +1,103 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+)
+
+// PInv32 is the float32 version of PInv. Since rcond is relative to the
+// largest singular value, it should not be less than about 1e-7, the
+// precision of float32.
+func PInv32(a blas32.General, rcond float32) (p blas32.General, rank int, err error) {
+	if rcond < 0 {
+		panic("lapack: negative rcond")
+	}
+	ws := getWorkspace()
+	defer putWorkspace(ws)
+	return pinv32(ws.general32(a), rcond, &ws.workspace)
+}
+
+// pinv32 is the float32 version of pinv.
+func pinv32(a blas32.General, rcond float32, ws *workspace) (p blas32.General, rank int, err error) {
+	m, n := a.Rows, a.Cols
+	p = newGeneral32(n, m)
+	k := min(m, n)
+	if k == 0 {
+		return p, 0, nil
+	}
+
+	s, u, vt, ok := svd32(DefaultDrivers.Select(SVDProblem, m, n), a, 'S', ws)
+	if !ok {
+		return p, 0, ErrIterationLimit
+	}
+
+	rank = svdRank32(s, m, n, rcond)
+	if rank == 0 {
+		return p, 0, nil
+	}
+	for i := 0; i < rank; i++ {
+		blasImpl.Sscal(n, 1/s[i], vt[i*n:], 1)
+	}
+	blasImpl.Sgemm(blas.Trans, blas.Trans, n, m, rank, 1, vt, n, u, k, 0, p.Data, p.Stride)
+	return p, rank, nil
+}
+
+// Orth32 is the float32 version of Orth. If rcond is negative, max(m,n)
+// times the float32 machine epsilon 2^-23 is used.
+func Orth32(a blas32.General, rcond float32) (q blas32.General, err error) {
+	m, n := a.Rows, a.Cols
+	ws := getWorkspace()
+	defer putWorkspace(ws)
+	s, u, _, ok := svd32(DefaultDrivers.Select(SVDProblem, m, n), ws.general32(a), 'S', &ws.workspace)
+	if !ok {
+		return newGeneral32(m, 0), ErrIterationLimit
+	}
+	rank := svdRank32(s, m, n, rcond)
+	k := len(s)
+	q = newGeneral32(m, rank)
+	for i := 0; i < m && rank > 0; i++ {
+		copy(q.Data[i*q.Stride:i*q.Stride+rank], u[i*k:i*k+rank])
+	}
+	return q, nil
+}
+
+// Null32 is the float32 version of Null. The effective rank is determined by
+// rcond as described for Orth32.
+func Null32(a blas32.General, rcond float32) (z blas32.General, err error) {
+	m, n := a.Rows, a.Cols
+	ws := getWorkspace()
+	defer putWorkspace(ws)
+	s, _, vt, ok := svd32(DefaultDrivers.Select(SVDProblem, m, n), ws.general32(a), 'A', &ws.workspace)
+	if !ok {
+		return newGeneral32(n, 0), ErrIterationLimit
+	}
+	rank := svdRank32(s, m, n, rcond)
+	z = newGeneral32(n, n-rank)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n-rank; j++ {
+			z.Data[i*z.Stride+j] = vt[(rank+j)*n+i]
+		}
+	}
+	return z, nil
+}
+
+// svdRank32 is the float32 version of svdRank. If rcond is negative,
+// max(m,n)*2^-23 is used.
+func svdRank32(s []float32, m, n int, rcond float32) int {
+	if len(s) == 0 {
+		return 0
+	}
+	if rcond < 0 {
+		rcond = float32(max(m, n)) * 2 * slamchE
+	}
+	cutoff := rcond * s[0]
+	var rank int
+	for rank < len(s) && s[rank] > cutoff {
+		rank++
+	}
+	return rank
+}
@@ -0,0 +1,130 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+)
+
+// mul returns a*b.
+func mul(a, b blas64.General) blas64.General {
+	c := newGeneral(a.Rows, b.Cols)
+	blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, a, b, 0, c)
+	return c
+}
+
+// maxDiff returns the largest absolute difference between the elements of a
+// and b, or between a and the transpose of b if trans is true.
+func maxDiff(a, b blas64.General, trans bool) float64 {
+	var d float64
+	for i := 0; i < a.Rows; i++ {
+		for j := 0; j < a.Cols; j++ {
+			v := b.Data[i*b.Stride+j]
+			if trans {
+				v = b.Data[j*b.Stride+i]
+			}
+			d = math.Max(d, math.Abs(a.Data[i*a.Stride+j]-v))
+		}
+	}
+	return d
+}
+
+func TestPInv(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, rank int
+	}{
+		{m: 0, n: 3, rank: 0},
+		{m: 1, n: 1, rank: 1},
+		{m: 5, n: 5, rank: 5},
+		{m: 8, n: 5, rank: 5},
+		{m: 5, n: 8, rank: 5},
+		{m: 10, n: 7, rank: 3},
+		{m: 6, n: 9, rank: 2},
+	} {
+		name := fmt.Sprintf("m=%d,n=%d,rank=%d", test.m, test.n, test.rank)
+		a := newGeneral(test.m, test.n)
+		if test.rank > 0 {
+			a = mul(randomGeneral(rnd, test.m, test.rank, test.rank), randomGeneral(rnd, test.rank, test.n, test.n))
+		}
+		aCopy := cloneGeneral(a)
+
+		p, rank, err := PInv(a, DefaultRCond*10)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if !floats.Equal(a.Data, aCopy.Data) {
+			t.Errorf("%s: input modified", name)
+		}
+		if p.Rows != test.n || p.Cols != test.m {
+			t.Errorf("%s: unexpected shape %d×%d", name, p.Rows, p.Cols)
+			continue
+		}
+		if rank != test.rank {
+			t.Errorf("%s: unexpected rank: got %d want %d", name, rank, test.rank)
+		}
+		if test.m == 0 || test.n == 0 {
+			continue
+		}
+
+		// Check the Penrose conditions.
+		ap := mul(a, p)
+		pa := mul(p, a)
+		if d := maxDiff(mul(ap, a), a, false); d > tol {
+			t.Errorf("%s: A*P*A != A: %v", name, d)
+		}
+		if d := maxDiff(mul(pa, p), p, false); d > tol {
+			t.Errorf("%s: P*A*P != P: %v", name, d)
+		}
+		if d := maxDiff(ap, ap, true); d > tol {
+			t.Errorf("%s: A*P not symmetric: %v", name, d)
+		}
+		if d := maxDiff(pa, pa, true); d > tol {
+			t.Errorf("%s: P*A not symmetric: %v", name, d)
+		}
+	}
+}
+
+func TestPInvCutoff(t *testing.T) {
+	a := blas64.General{
+		Rows: 3, Cols: 3, Stride: 3,
+		Data: []float64{
+			2, 0, 0,
+			0, 1e-10, 0,
+			0, 0, 0,
+		},
+	}
+	for _, test := range []struct {
+		rcond float64
+		rank  int
+		want  []float64
+	}{
+		{rcond: DefaultRCond, rank: 2, want: []float64{0.5, 0, 0, 0, 1e10, 0, 0, 0, 0}},
+		{rcond: 1e-8, rank: 1, want: []float64{0.5, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{rcond: 1, rank: 0, want: make([]float64, 9)},
+	} {
+		p, rank, err := PInv(a, test.rcond)
+		if err != nil {
+			t.Errorf("rcond=%v: unexpected error: %v", test.rcond, err)
+			continue
+		}
+		if rank != test.rank {
+			t.Errorf("rcond=%v: unexpected rank: got %d want %d", test.rcond, rank, test.rank)
+		}
+		if !floats.EqualApprox(p.Data, test.want, 1e-6) {
+			t.Errorf("rcond=%v: unexpected pseudo-inverse: got %v want %v", test.rcond, p.Data, test.want)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// PivotedQR is a QR factorization with column pivoting
+//
+//	A * P = Q * R
+//
+// of an m×n matrix A, as computed by QRPivot. The magnitudes of the diagonal
+// elements of R are non-increasing, so the factorization reveals the
+// numerical rank of A more cheaply than a singular value decomposition.
+type PivotedQR struct {
+	// QR holds R in its upper triangle and the elementary reflectors
+	// representing Q below the diagonal, as returned by Dgeqp3.
+	QR blas64.General
+	// Tau holds the scalar factors of the elementary reflectors.
+	Tau []float64
+	// Perm is the zero-based column permutation P: column j of A*P is
+	// column Perm[j] of A.
+	Perm []int
+}
+
+// QRPivot computes the QR factorization with column pivoting of the m×n
+// matrix A by Dgeqp3. The input a is not modified.
+func QRPivot(a blas64.General) PivotedQR {
+	m, n := a.Rows, a.Cols
+	f := PivotedQR{
+		QR:   cloneGeneral(a),
+		Tau:  make([]float64, min(m, n)),
+		Perm: make([]int, n),
+	}
+	if min(m, n) == 0 {
+		for j := range f.Perm {
+			f.Perm[j] = j
+		}
+		return f
+	}
+	// Mark all columns as free.
+	for j := range f.Perm {
+		f.Perm[j] = -1
+	}
+	work := make([]float64, 1)
+	lapackImpl.Dgeqp3(m, n, f.QR.Data, f.QR.Stride, f.Perm, f.Tau, work, -1)
+	work = make([]float64, int(work[0]))
+	lapackImpl.Dgeqp3(m, n, f.QR.Data, f.QR.Stride, f.Perm, f.Tau, work, len(work))
+	return f
+}
+
+// Rank returns the number of diagonal elements of R whose magnitude is
+// greater than rcond times the largest one. If rcond is negative,
+// max(m,n)*2^-52 is used as for Orth.
+func (f PivotedQR) Rank(rcond float64) int {
+	d := make([]float64, len(f.Tau))
+	for i := range d {
+		d[i] = math.Abs(f.QR.Data[i*f.QR.Stride+i])
+	}
+	return svdRank(d, f.QR.Rows, f.QR.Cols, rcond)
+}
+
+// R returns the min(m,n)×n upper trapezoidal factor R.
+func (f PivotedQR) R() blas64.General {
+	k, n := len(f.Tau), f.QR.Cols
+	r := newGeneral(k, n)
+	for i := 0; i < k; i++ {
+		copy(r.Data[i*r.Stride+i:i*r.Stride+n], f.QR.Data[i*f.QR.Stride+i:])
+	}
+	return r
+}
+
+// ApplyQ returns Q * B if trans is blas.NoTrans and Q^T * B if trans is
+// blas.Trans, where Q is the m×m orthogonal factor and B has m rows. The
+// product is computed by Dormqr without forming Q. The input b is not
+// modified.
+func (f PivotedQR) ApplyQ(trans blas.Transpose, b blas64.General) blas64.General {
+	m, k := f.QR.Rows, len(f.Tau)
+	if trans != blas.NoTrans && trans != blas.Trans {
+		panic(badTrans)
+	}
+	if b.Rows != m {
+		panic(badShapeB)
+	}
+	c := cloneGeneral(b)
+	if m == 0 || c.Cols == 0 || k == 0 {
+		return c
+	}
+	work := make([]float64, 1)
+	lapackImpl.Dormqr(blas.Left, trans, m, c.Cols, k, f.QR.Data, f.QR.Stride, f.Tau, c.Data, c.Stride, work, -1)
+	work = make([]float64, int(work[0]))
+	lapackImpl.Dormqr(blas.Left, trans, m, c.Cols, k, f.QR.Data, f.QR.Stride, f.Tau, c.Data, c.Stride, work, len(work))
+	return c
+}
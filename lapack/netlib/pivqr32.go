@@ -0,0 +1,85 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+)
+
+// PivotedQR32 is the float32 version of PivotedQR.
+type PivotedQR32 struct {
+	QR   blas32.General
+	Tau  []float32
+	Perm []int
+}
+
+// QRPivot32 is the float32 version of QRPivot. The factorization is
+// computed by Sgeqp3.
+func QRPivot32(a blas32.General) PivotedQR32 {
+	m, n := a.Rows, a.Cols
+	f := PivotedQR32{
+		QR:   cloneGeneral32(a),
+		Tau:  make([]float32, min(m, n)),
+		Perm: make([]int, n),
+	}
+	if min(m, n) == 0 {
+		for j := range f.Perm {
+			f.Perm[j] = j
+		}
+		return f
+	}
+	// Mark all columns as free.
+	for j := range f.Perm {
+		f.Perm[j] = -1
+	}
+	work := make([]float32, 1)
+	lapackImpl.Sgeqp3(m, n, f.QR.Data, f.QR.Stride, f.Perm, f.Tau, work, -1)
+	work = make([]float32, int(work[0]))
+	lapackImpl.Sgeqp3(m, n, f.QR.Data, f.QR.Stride, f.Perm, f.Tau, work, len(work))
+	return f
+}
+
+// Rank is the float32 version of PivotedQR.Rank.
+func (f PivotedQR32) Rank(rcond float32) int {
+	d := make([]float32, len(f.Tau))
+	for i := range d {
+		d[i] = float32(math.Abs(float64(f.QR.Data[i*f.QR.Stride+i])))
+	}
+	return svdRank32(d, f.QR.Rows, f.QR.Cols, rcond)
+}
+
+// R is the float32 version of PivotedQR.R.
+func (f PivotedQR32) R() blas32.General {
+	k, n := len(f.Tau), f.QR.Cols
+	r := newGeneral32(k, n)
+	for i := 0; i < k; i++ {
+		copy(r.Data[i*r.Stride+i:i*r.Stride+n], f.QR.Data[i*f.QR.Stride+i:])
+	}
+	return r
+}
+
+// ApplyQ is the float32 version of PivotedQR.ApplyQ. The product is computed
+// by Sormqr.
+func (f PivotedQR32) ApplyQ(trans blas.Transpose, b blas32.General) blas32.General {
+	m, k := f.QR.Rows, len(f.Tau)
+	if trans != blas.NoTrans && trans != blas.Trans {
+		panic(badTrans)
+	}
+	if b.Rows != m {
+		panic(badShapeB)
+	}
+	c := cloneGeneral32(b)
+	if m == 0 || c.Cols == 0 || k == 0 {
+		return c
+	}
+	work := make([]float32, 1)
+	lapackImpl.Sormqr(blas.Left, trans, m, c.Cols, k, f.QR.Data, f.QR.Stride, f.Tau, c.Data, c.Stride, work, -1)
+	work = make([]float32, int(work[0]))
+	lapackImpl.Sormqr(blas.Left, trans, m, c.Cols, k, f.QR.Data, f.QR.Stride, f.Tau, c.Data, c.Stride, work, len(work))
+	return c
+}
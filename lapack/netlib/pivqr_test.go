@@ -0,0 +1,120 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+func TestQRPivot(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, rank int
+	}{
+		{0, 0, 0}, {3, 0, 0}, {0, 4, 0},
+		{1, 1, 1}, {6, 4, 4}, {4, 6, 4},
+		{10, 7, 3}, {7, 10, 5}, {12, 12, 11},
+	} {
+		m, n, rank := test.m, test.n, test.rank
+		name := fmt.Sprintf("m=%d,n=%d,rank=%d", m, n, rank)
+		a := mul(randomGeneral(rnd, m, rank, max(1, rank)), randomGeneral(rnd, rank, n, max(1, n)))
+		orig := cloneGeneral(a)
+		f := QRPivot(a)
+		if maxDiff(a, orig, false) != 0 {
+			t.Errorf("%s: a modified", name)
+		}
+		if got := f.Rank(-1); got != rank {
+			t.Errorf("%s: unexpected rank: got %d want %d", name, got, rank)
+		}
+		seen := make([]bool, n)
+		for _, p := range f.Perm {
+			if p < 0 || n <= p || seen[p] {
+				t.Fatalf("%s: invalid permutation %v", name, f.Perm)
+			}
+			seen[p] = true
+		}
+		k := min(m, n)
+		r := f.R()
+		if r.Rows != k || r.Cols != n {
+			t.Fatalf("%s: unexpected shape of R %d×%d", name, r.Rows, r.Cols)
+		}
+		for i := 1; i < k; i++ {
+			if math.Abs(r.Data[i*r.Stride+i]) > math.Abs(r.Data[(i-1)*r.Stride+i-1]) {
+				t.Errorf("%s: diagonal of R not non-increasing", name)
+				break
+			}
+		}
+
+		// Compare Q*[R; 0] with A*P.
+		rp := newGeneral(m, n)
+		for i := 0; i < k; i++ {
+			copy(rp.Data[i*rp.Stride:i*rp.Stride+n], r.Data[i*r.Stride:])
+		}
+		qr := f.ApplyQ(blas.NoTrans, rp)
+		ap := newGeneral(m, n)
+		for i := 0; i < m; i++ {
+			for j, p := range f.Perm {
+				ap.Data[i*ap.Stride+j] = a.Data[i*a.Stride+p]
+			}
+		}
+		if d := maxDiff(qr, ap, false); d > tol {
+			t.Errorf("%s: |Q*R - A*P| = %v", name, d)
+		}
+		if d := maxDiff(f.ApplyQ(blas.Trans, qr), rp, false); d > tol {
+			t.Errorf("%s: |Q^T*Q*R - R| = %v", name, d)
+		}
+	}
+
+	f := QRPivot(newGeneral(3, 2))
+	for _, test := range []struct {
+		name string
+		fn   func()
+		want string
+	}{
+		{"shape", func() { f.ApplyQ(blas.NoTrans, newGeneral(2, 2)) }, badShapeB},
+		{"trans", func() { f.ApplyQ(blas.ConjTrans, newGeneral(3, 2)) }, badTrans},
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r != test.want {
+					t.Errorf("%s: unexpected panic: got %v want %q", test.name, r, test.want)
+				}
+			}()
+			test.fn()
+		}()
+	}
+}
+
+func TestQRPivotRankTolerance(t *testing.T) {
+	// The columns of A have norms 1, 1e-4 and 1e-10, so the effective rank
+	// depends on rcond.
+	a := blas64.General{Rows: 3, Cols: 3, Stride: 3, Data: []float64{
+		0, 0, 1,
+		0, 1e-4, 0,
+		1e-10, 0, 0,
+	}}
+	f := QRPivot(a)
+	if f.Perm[0] != 2 || f.Perm[1] != 1 || f.Perm[2] != 0 {
+		t.Errorf("unexpected permutation %v", f.Perm)
+	}
+	for _, test := range []struct {
+		rcond float64
+		want  int
+	}{
+		{-1, 3}, {1e-12, 3}, {1e-6, 2}, {1e-2, 1}, {2, 0},
+	} {
+		if got := f.Rank(test.rcond); got != test.want {
+			t.Errorf("rcond=%v: unexpected rank: got %d want %d", test.rcond, got, test.want)
+		}
+	}
+}
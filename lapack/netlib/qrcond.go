@@ -0,0 +1,93 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+const badShapeQR = "lapack: a has fewer rows than columns"
+
+// QRCondition holds the conditioning information of the triangular factor R
+// of a QR factorization
+//  A = Q * R
+// of an m×n matrix A with m >= n.
+type QRCondition struct {
+	// RCond is the estimate of the reciprocal condition number of R in
+	// the 1-norm computed by Dtrcon. Since Q is orthogonal, R has the
+	// 2-norm condition number of A, and the 1-norm estimate is within a
+	// factor of n of it.
+	RCond float64
+
+	// Deficient holds the zero-based indices of the diagonal elements of
+	// R whose magnitude is at most max(m,n)*eps times the largest one. A
+	// non-empty list warns that A is rank deficient to working precision
+	// and that the least squares solution obtained from the factorization
+	// is not reliable. The converse does not hold: QR without pivoting
+	// does not reveal rank, so a small RCond may occur with an empty list.
+	Deficient []int
+}
+
+// QRCond returns the conditioning of the triangular factor R of the QR
+// factorization of the m×n matrix A, m >= n, which it computes with Dgeqrf.
+// It is a cheap reliability check for least squares problems that avoids
+// a singular value decomposition. The input a is not modified.
+//
+// If R is singular to working precision, QRCond returns a Condition error
+// holding 1/RCond. QRCond panics if m < n.
+func QRCond(a blas64.General) (QRCondition, error) {
+	m, n := a.Rows, a.Cols
+	if m < n {
+		panic(badShapeQR)
+	}
+	qr := cloneGeneral(a)
+	if n > 0 {
+		tau := make([]float64, n)
+		work := make([]float64, 1)
+		lapackImpl.Dgeqrf(m, n, qr.Data, qr.Stride, tau, work, -1)
+		work = make([]float64, int(work[0]))
+		lapackImpl.Dgeqrf(m, n, qr.Data, qr.Stride, tau, work, len(work))
+	}
+	return RCondR(qr)
+}
+
+// RCondR returns the conditioning of the upper triangular factor R held in
+// the upper triangle of the m×n matrix qr, m >= n, as computed for a QR
+// factorization by Dgeqrf or Dgeqp3. Elements below the diagonal are not
+// referenced.
+//
+// If R is singular to working precision, RCondR returns a Condition error
+// holding 1/RCond. RCondR panics if m < n.
+func RCondR(qr blas64.General) (QRCondition, error) {
+	m, n := qr.Rows, qr.Cols
+	if m < n {
+		panic(badShapeQR)
+	}
+	if n == 0 {
+		return QRCondition{RCond: 1}, nil
+	}
+
+	var dmax float64
+	for i := 0; i < n; i++ {
+		dmax = math.Max(dmax, math.Abs(qr.Data[i*qr.Stride+i]))
+	}
+	var c QRCondition
+	tol := float64(m) * dlamchE * dmax
+	for i := 0; i < n; i++ {
+		if math.Abs(qr.Data[i*qr.Stride+i]) <= tol {
+			c.Deficient = append(c.Deficient, i)
+		}
+	}
+
+	c.RCond = lapackImpl.Dtrcon(lapack.MaxColumnSum, blas.Upper, blas.NonUnit, n, qr.Data, qr.Stride, make([]float64, 3*n), make([]int, n))
+	if c.RCond < dlamchE {
+		return c, Condition(1 / c.RCond)
+	}
+	return c, nil
+}
@@ -0,0 +1,70 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// QRCondition32 is the float32 version of QRCondition.
+type QRCondition32 struct {
+	RCond     float32
+	Deficient []int
+}
+
+// QRCond32 is the float32 version of QRCond. The factorization is computed
+// by Sgeqrf.
+func QRCond32(a blas32.General) (QRCondition32, error) {
+	m, n := a.Rows, a.Cols
+	if m < n {
+		panic(badShapeQR)
+	}
+	qr := cloneGeneral32(a)
+	if n > 0 {
+		tau := make([]float32, n)
+		work := make([]float32, 1)
+		lapacke.Sgeqrf(m, n, qr.Data, qr.Stride, tau, work, -1)
+		work = make([]float32, int(work[0]))
+		lapacke.Sgeqrf(m, n, qr.Data, qr.Stride, tau, work, len(work))
+	}
+	return RCondR32(qr)
+}
+
+// RCondR32 is the float32 version of RCondR. The condition number is
+// estimated by Strcon.
+func RCondR32(qr blas32.General) (QRCondition32, error) {
+	m, n := qr.Rows, qr.Cols
+	if m < n {
+		panic(badShapeQR)
+	}
+	if n == 0 {
+		return QRCondition32{RCond: 1}, nil
+	}
+
+	var dmax float32
+	for i := 0; i < n; i++ {
+		dmax = float32(math.Max(float64(dmax), math.Abs(float64(qr.Data[i*qr.Stride+i]))))
+	}
+	var c QRCondition32
+	tol := float32(m) * slamchE * dmax
+	for i := 0; i < n; i++ {
+		if float32(math.Abs(float64(qr.Data[i*qr.Stride+i]))) <= tol {
+			c.Deficient = append(c.Deficient, i)
+		}
+	}
+
+	rcond := make([]float32, 1)
+	lapacke.Strcon(byte(lapack.MaxColumnSum), byte(blas.Upper), byte(blas.NonUnit), n, qr.Data, qr.Stride, rcond, make([]float32, 3*n), make([]lapacke.Int, n))
+	c.RCond = rcond[0]
+	if c.RCond < slamchE {
+		return c, Condition(1 / float64(c.RCond))
+	}
+	return c, nil
+}
@@ -0,0 +1,113 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+func TestQRCond(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ m, n int }{
+		{0, 0}, {1, 1}, {3, 3}, {5, 3}, {10, 10}, {20, 7},
+	} {
+		m, n := test.m, test.n
+		name := fmt.Sprintf("m=%d,n=%d", m, n)
+		a := randomGeneral(rnd, m, n, n+3)
+		orig := cloneGeneral(a)
+
+		c, err := QRCond(a)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if maxDiff(a, orig, false) != 0 {
+			t.Errorf("%s: a modified", name)
+		}
+		if len(c.Deficient) != 0 {
+			t.Errorf("%s: unexpected deficient columns: %v", name, c.Deficient)
+		}
+		if n == 0 {
+			if c.RCond != 1 {
+				t.Errorf("%s: unexpected rcond for empty matrix: %v", name, c.RCond)
+			}
+			continue
+		}
+
+		// Compare against the reciprocal condition number of R in the
+		// 1-norm computed from its explicit inverse.
+		qr := cloneGeneral(a)
+		tau := make([]float64, n)
+		work := make([]float64, n)
+		impl.Dgeqr2(m, n, qr.Data, qr.Stride, tau, work)
+		r := newGeneral(n, n)
+		for i := 0; i < n; i++ {
+			copy(r.Data[i*n+i:i*n+n], qr.Data[i*qr.Stride+i:i*qr.Stride+n])
+		}
+		rinv := cloneGeneral(r)
+		impl.Dtrtri(blas.Upper, blas.NonUnit, n, rinv.Data, rinv.Stride)
+		want := 1 / (norm1(r) * norm1(rinv))
+		if c.RCond < want/(1+1e-12) || c.RCond > 10*want {
+			t.Errorf("%s: unexpected rcond estimate: got %v want about %v", name, c.RCond, want)
+		}
+
+		got, err := RCondR(qr)
+		if err != nil || got.RCond != c.RCond {
+			t.Errorf("%s: RCondR mismatch: got %v, %v want %v", name, got.RCond, err, c.RCond)
+		}
+	}
+}
+
+func TestQRCondRankDeficient(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	const m, n = 8, 5
+	a := randomGeneral(rnd, m, n, n)
+	// Make the last column a copy of the second.
+	for i := 0; i < m; i++ {
+		a.Data[i*a.Stride+n-1] = a.Data[i*a.Stride+1]
+	}
+	c, err := QRCond(a)
+	if _, ok := err.(Condition); !ok {
+		t.Errorf("unexpected error: got %v want Condition", err)
+	}
+	if len(c.Deficient) != 1 || c.Deficient[0] != n-1 {
+		t.Errorf("unexpected deficient columns: got %v want [%d]", c.Deficient, n-1)
+	}
+
+	z := newGeneral(m, n)
+	c, err = QRCond(z)
+	if err == nil || !math.IsInf(float64(err.(Condition)), 1) || len(c.Deficient) != n {
+		t.Errorf("unexpected result for zero matrix: %+v, %v", c, err)
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r != badShapeQR {
+				t.Errorf("unexpected panic for wide matrix: %v", r)
+			}
+		}()
+		QRCond(newGeneral(2, 3))
+	}()
+}
+
+// norm1 returns the maximum absolute column sum of a.
+func norm1(a blas64.General) float64 {
+	var v float64
+	for j := 0; j < a.Cols; j++ {
+		var s float64
+		for i := 0; i < a.Rows; i++ {
+			s += math.Abs(a.Data[i*a.Stride+j])
+		}
+		v = math.Max(v, s)
+	}
+	return v
+}
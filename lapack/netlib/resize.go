@@ -0,0 +1,155 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math/bits"
+	"sync"
+
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// The matrix storage used by the LAPACK routines must satisfy
+//
+//	Stride >= max(1, Cols) and len(Data) >= (Rows-1)*Stride + Cols
+//
+// for a non-empty matrix, and violating either condition results in a
+// badLdA or shortA panic. Resize, Reshape and Compact derive a new matrix
+// from an existing one and always return storage that satisfies both
+// conditions, reusing the existing storage when that is possible and
+// taking new storage from an internal pool otherwise. Storage that is no
+// longer needed can be handed back to the pool by Release.
+
+const badReshape = "lapack: reshape changes the number of elements"
+
+// Resize returns an r×c matrix whose leading min(r, a.Rows)×min(c, a.Cols)
+// block holds the corresponding block of a and whose remaining elements are
+// zero.
+//
+// If c <= a.Stride and the capacity of a.Data holds r rows of that stride,
+// the result keeps the stride of a and shares its storage, so shrinking a
+// matrix and growing it again within its capacity does not copy. As with
+// append, growing in place overwrites the elements of a.Data[:cap(a.Data)]
+// that lie outside a, so a view of a larger matrix must not be grown unless
+// those elements are unused. Otherwise the leading block is copied to new
+// storage with a compact stride.
+func Resize(a blas64.General, r, c int) blas64.General {
+	checkGeneral(a)
+	if r < 0 || c < 0 {
+		panic(badReshape)
+	}
+	if r == 0 || c == 0 {
+		return blas64.General{Rows: r, Cols: c, Stride: max(1, c)}
+	}
+	if a.Rows > 0 && c <= a.Stride && (r-1)*a.Stride+c <= cap(a.Data) {
+		b := blas64.General{Rows: r, Cols: c, Stride: a.Stride, Data: a.Data[:(r-1)*a.Stride+c]}
+		// Zero the elements that were not part of a.
+		for i := 0; i < r; i++ {
+			row := b.Data[i*b.Stride : i*b.Stride+c]
+			if i < a.Rows {
+				row = row[min(c, a.Cols):]
+			}
+			for j := range row {
+				row[j] = 0
+			}
+		}
+		return b
+	}
+	b := blas64.General{Rows: r, Cols: c, Stride: c, Data: getFloat64s(r * c)}
+	rows, cols := min(r, a.Rows), min(c, a.Cols)
+	for i := 0; i < rows; i++ {
+		copy(b.Data[i*b.Stride:i*b.Stride+cols], a.Data[i*a.Stride:i*a.Stride+cols])
+	}
+	return b
+}
+
+// Reshape returns an r×c matrix holding the elements of a in row-major
+// order. r*c must equal a.Rows*a.Cols, otherwise Reshape will panic.
+//
+// If a is compact, that is a.Stride == a.Cols, or a has a single row, the
+// result shares the storage of a and no elements are copied. Otherwise the
+// elements are copied to new storage.
+func Reshape(a blas64.General, r, c int) blas64.General {
+	checkGeneral(a)
+	if r < 0 || c < 0 || r*c != a.Rows*a.Cols {
+		panic(badReshape)
+	}
+	if r*c == 0 {
+		return blas64.General{Rows: r, Cols: c, Stride: max(1, c)}
+	}
+	b := Compact(a)
+	b.Rows, b.Cols, b.Stride = r, c, c
+	return b
+}
+
+// Compact returns a matrix with the elements of a and the stride
+// max(1, a.Cols). If a is already compact or has a single row, the result
+// shares the storage of a with Data trimmed to the minimum length. Otherwise
+// the elements are copied to new storage.
+func Compact(a blas64.General) blas64.General {
+	checkGeneral(a)
+	if a.Rows == 0 || a.Cols == 0 {
+		return blas64.General{Rows: a.Rows, Cols: a.Cols, Stride: max(1, a.Cols)}
+	}
+	if a.Stride == a.Cols || a.Rows == 1 {
+		a.Stride = a.Cols
+		a.Data = a.Data[:a.Rows*a.Cols]
+		return a
+	}
+	b := blas64.General{Rows: a.Rows, Cols: a.Cols, Stride: a.Cols, Data: getFloat64s(a.Rows * a.Cols)}
+	for i := 0; i < a.Rows; i++ {
+		copy(b.Data[i*b.Stride:i*b.Stride+a.Cols], a.Data[i*a.Stride:i*a.Stride+a.Cols])
+	}
+	return b
+}
+
+// Release returns the storage of a to the pool used by Resize, Reshape and
+// Compact. Neither a nor any matrix sharing its storage may be used after
+// the call.
+func Release(a blas64.General) {
+	putFloat64s(a.Data)
+}
+
+// checkGeneral panics if a does not satisfy the storage conditions of the
+// LAPACK routines.
+func checkGeneral(a blas64.General) {
+	switch {
+	case a.Rows < 0 || a.Cols < 0:
+		panic(badReshape)
+	case a.Stride < max(1, a.Cols):
+		panic(badLdA)
+	case a.Rows > 0 && a.Cols > 0 && len(a.Data) < (a.Rows-1)*a.Stride+a.Cols:
+		panic(shortA)
+	}
+}
+
+// float64Pools holds released float64 slices by size class. The slices in
+// float64Pools[k] have a capacity of at least 1<<k.
+var float64Pools [64]sync.Pool
+
+// getFloat64s returns a zeroed slice of length n, reusing released storage
+// if possible.
+func getFloat64s(n int) []float64 {
+	k := bits.Len(uint(n - 1))
+	if p, ok := float64Pools[k].Get().(*[]float64); ok {
+		s := (*p)[:n]
+		for i := range s {
+			s[i] = 0
+		}
+		return s
+	}
+	return make([]float64, n, 1<<uint(k))
+}
+
+// putFloat64s releases s for reuse by getFloat64s.
+func putFloat64s(s []float64) {
+	if cap(s) == 0 {
+		return
+	}
+	// Round down, so that every slice in a class is large enough.
+	k := bits.Len(uint(cap(s))) - 1
+	s = s[:cap(s)]
+	float64Pools[k].Put(&s)
+}
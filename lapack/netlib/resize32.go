@@ -0,0 +1,113 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math/bits"
+	"sync"
+
+	"gonum.org/v1/gonum/blas/blas32"
+)
+
+// Resize32 is the float32 version of Resize.
+func Resize32(a blas32.General, r, c int) blas32.General {
+	checkGeneral32(a)
+	if r < 0 || c < 0 {
+		panic(badReshape)
+	}
+	if r == 0 || c == 0 {
+		return blas32.General{Rows: r, Cols: c, Stride: max(1, c)}
+	}
+	if a.Rows > 0 && c <= a.Stride && (r-1)*a.Stride+c <= cap(a.Data) {
+		b := blas32.General{Rows: r, Cols: c, Stride: a.Stride, Data: a.Data[:(r-1)*a.Stride+c]}
+		for i := 0; i < r; i++ {
+			row := b.Data[i*b.Stride : i*b.Stride+c]
+			if i < a.Rows {
+				row = row[min(c, a.Cols):]
+			}
+			for j := range row {
+				row[j] = 0
+			}
+		}
+		return b
+	}
+	b := blas32.General{Rows: r, Cols: c, Stride: c, Data: getFloat32s(r * c)}
+	rows, cols := min(r, a.Rows), min(c, a.Cols)
+	for i := 0; i < rows; i++ {
+		copy(b.Data[i*b.Stride:i*b.Stride+cols], a.Data[i*a.Stride:i*a.Stride+cols])
+	}
+	return b
+}
+
+// Reshape32 is the float32 version of Reshape.
+func Reshape32(a blas32.General, r, c int) blas32.General {
+	checkGeneral32(a)
+	if r < 0 || c < 0 || r*c != a.Rows*a.Cols {
+		panic(badReshape)
+	}
+	if r*c == 0 {
+		return blas32.General{Rows: r, Cols: c, Stride: max(1, c)}
+	}
+	b := Compact32(a)
+	b.Rows, b.Cols, b.Stride = r, c, c
+	return b
+}
+
+// Compact32 is the float32 version of Compact.
+func Compact32(a blas32.General) blas32.General {
+	checkGeneral32(a)
+	if a.Rows == 0 || a.Cols == 0 {
+		return blas32.General{Rows: a.Rows, Cols: a.Cols, Stride: max(1, a.Cols)}
+	}
+	if a.Stride == a.Cols || a.Rows == 1 {
+		a.Stride = a.Cols
+		a.Data = a.Data[:a.Rows*a.Cols]
+		return a
+	}
+	b := blas32.General{Rows: a.Rows, Cols: a.Cols, Stride: a.Cols, Data: getFloat32s(a.Rows * a.Cols)}
+	for i := 0; i < a.Rows; i++ {
+		copy(b.Data[i*b.Stride:i*b.Stride+a.Cols], a.Data[i*a.Stride:i*a.Stride+a.Cols])
+	}
+	return b
+}
+
+// Release32 is the float32 version of Release.
+func Release32(a blas32.General) {
+	putFloat32s(a.Data)
+}
+
+func checkGeneral32(a blas32.General) {
+	switch {
+	case a.Rows < 0 || a.Cols < 0:
+		panic(badReshape)
+	case a.Stride < max(1, a.Cols):
+		panic(badLdA)
+	case a.Rows > 0 && a.Cols > 0 && len(a.Data) < (a.Rows-1)*a.Stride+a.Cols:
+		panic(shortA)
+	}
+}
+
+var float32Pools [64]sync.Pool
+
+func getFloat32s(n int) []float32 {
+	k := bits.Len(uint(n - 1))
+	if p, ok := float32Pools[k].Get().(*[]float32); ok {
+		s := (*p)[:n]
+		for i := range s {
+			s[i] = 0
+		}
+		return s
+	}
+	return make([]float32, n, 1<<uint(k))
+}
+
+func putFloat32s(s []float32) {
+	if cap(s) == 0 {
+		return
+	}
+	k := bits.Len(uint(cap(s))) - 1
+	s = s[:cap(s)]
+	float32Pools[k].Put(&s)
+}
@@ -0,0 +1,158 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// sameStorage reports whether a and b start at the same element.
+func sameStorage(a, b []float64) bool {
+	return len(a) > 0 && len(b) > 0 && &a[0] == &b[0]
+}
+
+func TestResize(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		r, c, stride int
+		nr, nc       int
+		shared       bool
+	}{
+		{r: 4, c: 3, stride: 5, nr: 2, nc: 2, shared: true},
+		{r: 4, c: 3, stride: 5, nr: 3, nc: 5, shared: true},
+		{r: 4, c: 3, stride: 5, nr: 4, nc: 5, shared: false},
+		{r: 4, c: 3, stride: 3, nr: 4, nc: 4, shared: false},
+		{r: 4, c: 3, stride: 3, nr: 6, nc: 3, shared: false},
+		{r: 3, c: 3, stride: 3, nr: 0, nc: 3, shared: false},
+		{r: 0, c: 2, stride: 2, nr: 3, nc: 2, shared: false},
+		{r: 5, c: 4, stride: 4, nr: 5, nc: 4, shared: true},
+	} {
+		name := fmt.Sprintf("%+v", test)
+		a := randomGeneral(rnd, test.r, test.c, test.stride)
+		orig := cloneGeneral(a)
+
+		b := Resize(a, test.nr, test.nc)
+		if b.Rows != test.nr || b.Cols != test.nc {
+			t.Errorf("%s: unexpected shape %d×%d", name, b.Rows, b.Cols)
+			continue
+		}
+		if b.Stride < max(1, b.Cols) || (b.Rows > 0 && b.Cols > 0 && len(b.Data) < (b.Rows-1)*b.Stride+b.Cols) {
+			t.Errorf("%s: invalid storage: stride %d, len %d", name, b.Stride, len(b.Data))
+			continue
+		}
+		if got := sameStorage(a.Data, b.Data); got != test.shared {
+			t.Errorf("%s: unexpected storage sharing: got %t, want %t", name, got, test.shared)
+		}
+		for i := 0; i < b.Rows; i++ {
+			for j := 0; j < b.Cols; j++ {
+				var want float64
+				if i < orig.Rows && j < orig.Cols {
+					want = orig.Data[i*orig.Stride+j]
+				}
+				if v := b.Data[i*b.Stride+j]; v != want {
+					t.Errorf("%s: unexpected element (%d,%d): got %v, want %v", name, i, j, v, want)
+				}
+			}
+		}
+	}
+
+	// Shrinking and growing back within the capacity does not copy, and
+	// the elements that were cut off are zeroed.
+	a := randomGeneral(rnd, 4, 4, 4)
+	b := Resize(Resize(a, 2, 2), 4, 4)
+	if !sameStorage(a.Data, b.Data) {
+		t.Errorf("storage not reused when growing within capacity")
+	}
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			if (i >= 2 || j >= 2) && b.Data[i*b.Stride+j] != 0 {
+				t.Errorf("element (%d,%d) not zeroed", i, j)
+			}
+		}
+	}
+}
+
+func TestReshape(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		r, c, stride int
+		nr, nc       int
+		shared       bool
+	}{
+		{r: 3, c: 4, stride: 4, nr: 6, nc: 2, shared: true},
+		{r: 3, c: 4, stride: 4, nr: 1, nc: 12, shared: true},
+		{r: 1, c: 6, stride: 9, nr: 3, nc: 2, shared: true},
+		{r: 3, c: 4, stride: 7, nr: 2, nc: 6, shared: false},
+		{r: 0, c: 4, stride: 4, nr: 4, nc: 0, shared: false},
+	} {
+		name := fmt.Sprintf("%+v", test)
+		a := randomGeneral(rnd, test.r, test.c, test.stride)
+		orig := cloneGeneral(a)
+
+		b := Reshape(a, test.nr, test.nc)
+		if b.Rows != test.nr || b.Cols != test.nc || b.Stride != max(1, test.nc) || len(b.Data) != test.nr*test.nc {
+			t.Errorf("%s: unexpected result: %d×%d, stride %d, len %d", name, b.Rows, b.Cols, b.Stride, len(b.Data))
+			continue
+		}
+		if got := sameStorage(a.Data, b.Data); got != test.shared {
+			t.Errorf("%s: unexpected storage sharing: got %t, want %t", name, got, test.shared)
+		}
+		// The elements are in the same row-major order.
+		for k, v := range b.Data {
+			if want := orig.Data[k]; v != want {
+				t.Errorf("%s: unexpected element %d: got %v, want %v", name, k, v, want)
+			}
+		}
+	}
+
+	for _, test := range []struct {
+		name string
+		fn   func()
+		want string
+	}{
+		{"count", func() { Reshape(newGeneral(2, 3), 4, 2) }, badReshape},
+		{"stride", func() { Reshape(blas64.General{Rows: 2, Cols: 3, Stride: 2, Data: make([]float64, 6)}, 3, 2) }, badLdA},
+		{"length", func() { Reshape(blas64.General{Rows: 2, Cols: 3, Stride: 4, Data: make([]float64, 6)}, 3, 2) }, shortA},
+		{"negative", func() { Resize(newGeneral(2, 3), -1, 2) }, badReshape},
+	} {
+		func() {
+			defer func() {
+				if r := recover(); r != test.want {
+					t.Errorf("%s: unexpected panic: got %v want %q", test.name, r, test.want)
+				}
+			}()
+			test.fn()
+		}()
+	}
+}
+
+func TestCompactRelease(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	a := randomGeneral(rnd, 5, 3, 8)
+	b := Compact(a)
+	if b.Stride != 3 || len(b.Data) != 15 || sameStorage(a.Data, b.Data) {
+		t.Fatalf("unexpected compact copy: stride %d, len %d", b.Stride, len(b.Data))
+	}
+	if d := maxDiff(a, b, false); d != 0 {
+		t.Errorf("unexpected compact elements: difference %v", d)
+	}
+	if c := Compact(b); !sameStorage(b.Data, c.Data) {
+		t.Errorf("compact matrix copied")
+	}
+
+	// Released storage is zeroed when it is handed out again.
+	Release(b)
+	c := Resize(newGeneral(0, 0), 3, 5)
+	for i, v := range c.Data {
+		if v != 0 {
+			t.Fatalf("element %d of reused storage not zeroed: %v", i, v)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// RFP is an n×n symmetric matrix stored in Rectangular Full Packed format.
+// The format holds the triangle of the matrix indicated by Uplo in
+// n*(n+1)/2 elements, as the standard packed format does, but arranges them
+// as a full rectangular array so that the factorization and solve routines
+// can use the Level 3 BLAS. It halves the memory of the full format without
+// the loss of performance of the standard packed format.
+//
+// TransR specifies whether Data holds the normal (blas.NoTrans) or the
+// transposed (blas.Trans) form of the rectangular array. Both forms hold the
+// same elements and are accepted by all the routines.
+type RFP struct {
+	N      int
+	Uplo   blas.Uplo
+	TransR blas.Transpose
+	Data   []float64
+}
+
+// ToRFP returns the triangle of a indicated by a.Uplo in Rectangular Full
+// Packed format with the form transr, converted by Dtrttf. The input a is
+// not modified.
+func ToRFP(a blas64.Symmetric, transr blas.Transpose) RFP {
+	n := a.N
+	r := RFP{N: n, Uplo: a.Uplo, TransR: transr, Data: make([]float64, n*(n+1)/2)}
+	lapackImpl.Dtrttf(transr, a.Uplo, n, a.Data, max(1, a.Stride), r.Data)
+	return r
+}
+
+// Symmetric returns r in full format, converted by Dtfttr. Only the
+// triangle indicated by r.Uplo is set.
+func (r RFP) Symmetric() blas64.Symmetric {
+	n := r.N
+	a := blas64.Symmetric{N: n, Uplo: r.Uplo, Stride: max(1, n), Data: make([]float64, n*n)}
+	lapackImpl.Dtfttr(r.TransR, r.Uplo, n, r.Data, a.Data, a.Stride)
+	return a
+}
+
+// PackedToRFP returns the matrix a in standard packed format in Rectangular
+// Full Packed format with the form transr, converted by Dtpttf. The input a
+// is not modified.
+func PackedToRFP(a blas64.SymmetricPacked, transr blas.Transpose) RFP {
+	n := a.N
+	r := RFP{N: n, Uplo: a.Uplo, TransR: transr, Data: make([]float64, n*(n+1)/2)}
+	lapackImpl.Dtpttf(transr, a.Uplo, n, a.Data, r.Data)
+	return r
+}
+
+// Packed returns r in standard packed format, converted by Dtfttp.
+func (r RFP) Packed() blas64.SymmetricPacked {
+	n := r.N
+	a := blas64.SymmetricPacked{N: n, Uplo: r.Uplo, Data: make([]float64, n*(n+1)/2)}
+	lapackImpl.Dtfttp(r.TransR, r.Uplo, n, r.Data, a.Data)
+	return a
+}
+
+// ToPacked returns the triangle of a indicated by a.Uplo in standard
+// packed format, converted by Dtrttp. The input a is not modified.
+func ToPacked(a blas64.Symmetric) blas64.SymmetricPacked {
+	n := a.N
+	p := blas64.SymmetricPacked{N: n, Uplo: a.Uplo, Data: make([]float64, n*(n+1)/2)}
+	lapackImpl.Dtrttp(a.Uplo, n, a.Data, max(1, a.Stride), p.Data)
+	return p
+}
+
+// FromPacked returns the matrix a in standard packed format in full format,
+// converted by Dtpttr. Only the triangle indicated by a.Uplo is set.
+func FromPacked(a blas64.SymmetricPacked) blas64.Symmetric {
+	n := a.N
+	s := blas64.Symmetric{N: n, Uplo: a.Uplo, Stride: max(1, n), Data: make([]float64, n*n)}
+	lapackImpl.Dtpttr(a.Uplo, n, a.Data, s.Data, s.Stride)
+	return s
+}
+
+// CholeskyRFP returns the Cholesky factor of the symmetric positive definite
+// matrix A in Rectangular Full Packed format, computed by Dpftrf. The factor
+// U with A = Uᵀ*U or L with A = L*Lᵀ, as indicated by a.Uplo, is returned in
+// the same format as a, which is not modified.
+//
+// If A is not positive definite, CholeskyRFP returns an
+// ErrNotPositiveDefinite.
+func CholeskyRFP(a RFP) (RFP, error) {
+	n := a.N
+	if a.TransR != blas.NoTrans && a.TransR != blas.Trans {
+		panic(badTransR)
+	}
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	if len(a.Data) < n*(n+1)/2 {
+		panic(shortA)
+	}
+	f := RFP{N: n, Uplo: a.Uplo, TransR: a.TransR, Data: make([]float64, n*(n+1)/2)}
+	copy(f.Data, a.Data)
+	if n == 0 {
+		return f, nil
+	}
+	info := lapacke.DpftrfInfo(byte(f.TransR), byte(f.Uplo), n, f.Data)
+	if info > 0 {
+		return RFP{}, ErrNotPositiveDefinite{Index: info - 1}
+	}
+	return f, nil
+}
+
+// CholSolveRFP solves the system of linear equations A * X = B, where A is
+// the symmetric positive definite matrix whose Cholesky factor f in
+// Rectangular Full Packed format was computed by CholeskyRFP, and returns
+// X. The input b is not modified.
+func CholSolveRFP(f RFP, b blas64.General) blas64.General {
+	if b.Rows != f.N {
+		panic(badShapeB)
+	}
+	x := cloneGeneral(b)
+	lapackImpl.Dpftrs(f.TransR, f.Uplo, f.N, x.Cols, f.Data, x.Data, x.Stride)
+	return x
+}
@@ -0,0 +1,101 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// RFP32 is the float32 version of RFP.
+type RFP32 struct {
+	N      int
+	Uplo   blas.Uplo
+	TransR blas.Transpose
+	Data   []float32
+}
+
+// ToRFP32 is the float32 version of ToRFP.
+func ToRFP32(a blas32.Symmetric, transr blas.Transpose) RFP32 {
+	n := a.N
+	r := RFP32{N: n, Uplo: a.Uplo, TransR: transr, Data: make([]float32, n*(n+1)/2)}
+	lapackImpl.Strttf(transr, a.Uplo, n, a.Data, max(1, a.Stride), r.Data)
+	return r
+}
+
+// Symmetric is the float32 version of RFP.Symmetric.
+func (r RFP32) Symmetric() blas32.Symmetric {
+	n := r.N
+	a := blas32.Symmetric{N: n, Uplo: r.Uplo, Stride: max(1, n), Data: make([]float32, n*n)}
+	lapackImpl.Stfttr(r.TransR, r.Uplo, n, r.Data, a.Data, a.Stride)
+	return a
+}
+
+// PackedToRFP32 is the float32 version of PackedToRFP.
+func PackedToRFP32(a blas32.SymmetricPacked, transr blas.Transpose) RFP32 {
+	n := a.N
+	r := RFP32{N: n, Uplo: a.Uplo, TransR: transr, Data: make([]float32, n*(n+1)/2)}
+	lapackImpl.Stpttf(transr, a.Uplo, n, a.Data, r.Data)
+	return r
+}
+
+// Packed is the float32 version of RFP.Packed.
+func (r RFP32) Packed() blas32.SymmetricPacked {
+	n := r.N
+	a := blas32.SymmetricPacked{N: n, Uplo: r.Uplo, Data: make([]float32, n*(n+1)/2)}
+	lapackImpl.Stfttp(r.TransR, r.Uplo, n, r.Data, a.Data)
+	return a
+}
+
+// ToPacked32 is the float32 version of ToPacked.
+func ToPacked32(a blas32.Symmetric) blas32.SymmetricPacked {
+	n := a.N
+	p := blas32.SymmetricPacked{N: n, Uplo: a.Uplo, Data: make([]float32, n*(n+1)/2)}
+	lapackImpl.Strttp(a.Uplo, n, a.Data, max(1, a.Stride), p.Data)
+	return p
+}
+
+// FromPacked32 is the float32 version of FromPacked.
+func FromPacked32(a blas32.SymmetricPacked) blas32.Symmetric {
+	n := a.N
+	s := blas32.Symmetric{N: n, Uplo: a.Uplo, Stride: max(1, n), Data: make([]float32, n*n)}
+	lapackImpl.Stpttr(a.Uplo, n, a.Data, s.Data, s.Stride)
+	return s
+}
+
+// CholeskyRFP32 is the float32 version of CholeskyRFP.
+func CholeskyRFP32(a RFP32) (RFP32, error) {
+	n := a.N
+	if a.TransR != blas.NoTrans && a.TransR != blas.Trans {
+		panic(badTransR)
+	}
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	if len(a.Data) < n*(n+1)/2 {
+		panic(shortA)
+	}
+	f := RFP32{N: n, Uplo: a.Uplo, TransR: a.TransR, Data: make([]float32, n*(n+1)/2)}
+	copy(f.Data, a.Data)
+	if n == 0 {
+		return f, nil
+	}
+	info := lapacke.SpftrfInfo(byte(f.TransR), byte(f.Uplo), n, f.Data)
+	if info > 0 {
+		return RFP32{}, ErrNotPositiveDefinite{Index: info - 1}
+	}
+	return f, nil
+}
+
+// CholSolveRFP32 is the float32 version of CholSolveRFP.
+func CholSolveRFP32(f RFP32, b blas32.General) blas32.General {
+	if b.Rows != f.N {
+		panic(badShapeB)
+	}
+	x := cloneGeneral32(b)
+	lapackImpl.Spftrs(f.TransR, f.Uplo, f.N, x.Cols, f.Data, x.Data, x.Stride)
+	return x
+}
@@ -0,0 +1,136 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+)
+
+// triDiff returns the largest absolute difference between the triangles of
+// a and b indicated by a.Uplo.
+func triDiff(a, b blas64.Symmetric) float64 {
+	var d float64
+	for i := 0; i < a.N; i++ {
+		for j := 0; j < a.N; j++ {
+			if (a.Uplo == blas.Upper && j < i) || (a.Uplo == blas.Lower && j > i) {
+				continue
+			}
+			d = math.Max(d, math.Abs(a.Data[i*a.Stride+j]-b.Data[i*b.Stride+j]))
+		}
+	}
+	return d
+}
+
+func TestRFPConversions(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 5, 6} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, transr := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				name := fmt.Sprintf("n=%d,uplo=%c,transr=%c", n, uplo, transr)
+				g := randomGeneral(rnd, n, n, n+3)
+				a := blas64.Symmetric{Uplo: uplo, N: n, Stride: g.Stride, Data: g.Data}
+				aCopy := append([]float64(nil), a.Data...)
+
+				// The packed triangle is stored row by row.
+				var want []float64
+				for i := 0; i < n; i++ {
+					if uplo == blas.Upper {
+						want = append(want, a.Data[i*a.Stride+i:i*a.Stride+n]...)
+					} else {
+						want = append(want, a.Data[i*a.Stride:i*a.Stride+i+1]...)
+					}
+				}
+				p := ToPacked(a)
+				if p.N != n || p.Uplo != uplo || !floats.Equal(p.Data, want) {
+					t.Errorf("%s: unexpected packed matrix", name)
+				}
+				if d := triDiff(a, FromPacked(p)); d != 0 {
+					t.Errorf("%s: packed round trip differs by %v", name, d)
+				}
+
+				r := ToRFP(a, transr)
+				if len(r.Data) != n*(n+1)/2 {
+					t.Errorf("%s: unexpected length of RFP data %d", name, len(r.Data))
+				}
+				if d := triDiff(a, r.Symmetric()); d != 0 {
+					t.Errorf("%s: RFP round trip differs by %v", name, d)
+				}
+				if !floats.Equal(r.Packed().Data, want) {
+					t.Errorf("%s: unexpected packed matrix from RFP", name)
+				}
+				if !floats.Equal(PackedToRFP(p, transr).Data, r.Data) {
+					t.Errorf("%s: RFP from packed and full formats differ", name)
+				}
+				if !floats.Same(a.Data, aCopy) {
+					t.Errorf("%s: input matrix modified", name)
+				}
+			}
+		}
+	}
+}
+
+func TestCholeskyRFP(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 4, 7, 10} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, transr := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				name := fmt.Sprintf("n=%d,uplo=%c,transr=%c", n, uplo, transr)
+				a := randomSPD(rnd, n, n+2)
+				a.Uplo = uplo
+				r := ToRFP(a, transr)
+				rCopy := append([]float64(nil), r.Data...)
+
+				f, err := CholeskyRFP(r)
+				if err != nil {
+					t.Fatalf("%s: unexpected error: %v", name, err)
+				}
+				if !floats.Same(r.Data, rCopy) {
+					t.Errorf("%s: input matrix modified", name)
+				}
+
+				// The factor agrees with the one of the full format.
+				want, _, err := CholeskySPD(a, nil)
+				if err != nil {
+					t.Fatalf("%s: unexpected error: %v", name, err)
+				}
+				got := f.Symmetric()
+				if d := triDiff(got, blas64.Symmetric{Uplo: uplo, N: n, Stride: want.Stride, Data: want.Data}); d > 1e-12*float64(max(1, n))*maxAbs(a.Data) {
+					t.Errorf("%s: unexpected factor: difference %v", name, d)
+				}
+
+				b := randomGeneral(rnd, n, 3, 3)
+				x := CholSolveRFP(f, b)
+				if d := maxDiff(mul(symGeneral(a), x), b, false); d > 1e-10*float64(max(1, n)) {
+					t.Errorf("%s: unexpected residual %v", name, d)
+				}
+			}
+		}
+	}
+}
+
+func TestCholeskyRFPNotPositiveDefinite(t *testing.T) {
+	a := blas64.Symmetric{
+		Uplo:   blas.Upper,
+		N:      3,
+		Stride: 3,
+		Data: []float64{
+			4, 2, 0,
+			2, 1, 0,
+			0, 0, 1,
+		},
+	}
+	_, err := CholeskyRFP(ToRFP(a, blas.NoTrans))
+	if e, ok := err.(ErrNotPositiveDefinite); !ok || e.Index != 1 {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
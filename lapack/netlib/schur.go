@@ -0,0 +1,186 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"errors"
+
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// SchurCondJob specifies the condition numbers computed by Dtrsen.
+type SchurCondJob byte
+
+const (
+	// CondNone computes no condition numbers.
+	CondNone SchurCondJob = 'N'
+	// CondEigenvalues computes the condition number of the selected cluster
+	// of eigenvalues.
+	CondEigenvalues SchurCondJob = 'E'
+	// CondSubspace computes the condition number of the invariant subspace.
+	CondSubspace SchurCondJob = 'V'
+	// CondBoth computes both condition numbers.
+	CondBoth SchurCondJob = 'B'
+)
+
+const (
+	badSchurCondJob = "lapack: bad SchurCondJob"
+	badLdVS         = "lapack: bad leading dimension of VS"
+	shortVS         = "lapack: insufficient length of vs"
+)
+
+// ErrReorder is returned when the eigenvalues of a Schur form cannot be
+// reordered because some of them are too close to separate, or when the
+// reordered eigenvalues no longer satisfy the selection due to roundoff.
+var ErrReorder = errors.New("lapack: eigenvalues could not be reordered")
+
+// Schur computes the real Schur factorization of the n×n matrix A
+//
+//	A = Z * T * Z^T
+//
+// by the driver Dgees, where Z is orthogonal and T is block upper triangular
+// with 1×1 and 2×2 diagonal blocks in Schur canonical form. The eigenvalues
+// of A are returned in w in the order in which they appear on the diagonal of
+// T, with complex conjugate pairs consecutive and the one with positive
+// imaginary part first. The input a is not modified.
+//
+// If sel is not nil, the eigenvalues for which sel returns true are moved to
+// the top left of T and sdim is their number, so that the leading sdim
+// columns of Z span the invariant subspace of A belonging to them. A complex
+// conjugate pair is selected if sel is true for either of its eigenvalues.
+//
+// If the QR algorithm fails to converge, Schur returns ErrIterationLimit. If
+// the selected eigenvalues cannot be reordered, it returns ErrReorder.
+func Schur(a blas64.General, sel func(complex128) bool) (t, z blas64.General, w []complex128, sdim int, err error) {
+	if a.Rows != a.Cols {
+		panic(badShapeA)
+	}
+	n := a.Rows
+	t = cloneGeneral(a)
+	z = newGeneral(n, n)
+	if n == 0 {
+		return t, z, nil, 0, nil
+	}
+	sort := byte('N')
+	var bwork []lapacke.Int
+	var selri func(wr, wi float64) bool
+	if sel != nil {
+		sort = 'S'
+		bwork = make([]lapacke.Int, n)
+		selri = func(wr, wi float64) bool { return sel(complex(wr, wi)) }
+	}
+	sd := make([]lapacke.Int, 1)
+	wr := make([]float64, n)
+	wi := make([]float64, n)
+	work := make([]float64, 1)
+	lapacke.Dgees('V', sort, selri, n, t.Data, t.Stride, sd, wr, wi, z.Data, z.Stride, work, -1, bwork)
+	work = make([]float64, int(work[0]))
+	info := lapacke.Dgees('V', sort, selri, n, t.Data, t.Stride, sd, wr, wi, z.Data, z.Stride, work, len(work), bwork)
+	w = make([]complex128, n)
+	for i := range w {
+		w[i] = complex(wr[i], wi[i])
+	}
+	return t, z, w, int(sd[0]), schurError(info, n)
+}
+
+// ReorderSchur reorders the real Schur factorization A = Z * T * Z^T
+// computed by Schur so that the eigenvalues selected by sel are at the top
+// left of the returned factor T, using Dtrsen. The eigenvalue with index i
+// on the diagonal of t is selected if sel[i] is true, and a complex
+// conjugate pair is selected if either of its eigenvalues is. The reordered
+// eigenvalues are returned in w and their number in m. The inputs t and z
+// are not modified.
+//
+// If the eigenvalues cannot be reordered, ReorderSchur returns ErrReorder
+// together with the partially reordered factorization.
+func ReorderSchur(t, z blas64.General, sel []bool) (tr, zr blas64.General, w []complex128, m int, err error) {
+	if t.Rows != t.Cols {
+		panic(badShapeA)
+	}
+	n := t.Rows
+	if z.Rows != n || z.Cols != n {
+		panic(badShapeB)
+	}
+	if len(sel) != n {
+		panic(badLenSelected)
+	}
+	tr = cloneGeneral(t)
+	zr = cloneGeneral(z)
+	if n == 0 {
+		return tr, zr, nil, 0, nil
+	}
+	wr := make([]float64, n)
+	wi := make([]float64, n)
+	work := make([]float64, n)
+	iwork := make([]int, 1)
+	m, _, _, ok := lapackImpl.Dtrsen(CondNone, lapack.UpdateSchur, sel, n, tr.Data, tr.Stride, zr.Data, zr.Stride, wr, wi, work, len(work), iwork, len(iwork))
+	w = make([]complex128, n)
+	for i := range w {
+		w[i] = complex(wr[i], wi[i])
+	}
+	if !ok {
+		return tr, zr, w, m, ErrReorder
+	}
+	return tr, zr, w, m, nil
+}
+
+// ComplexSchur computes the complex Schur factorization of the n×n complex
+// matrix A
+//
+//	A = Z * T * Z^H
+//
+// by the driver Zgees, where Z is unitary and T is upper triangular with the
+// eigenvalues w of A on its diagonal. The input a is not modified.
+//
+// The eigenvalues for which sel returns true are moved to the top left of T
+// and sdim is their number. If sel is nil, the eigenvalues are not ordered.
+//
+// If the QR algorithm fails to converge, ComplexSchur returns
+// ErrIterationLimit. If the selected eigenvalues cannot be reordered, it
+// returns ErrReorder.
+func ComplexSchur(a cblas128.General, sel func(complex128) bool) (t, z cblas128.General, w []complex128, sdim int, err error) {
+	if a.Rows != a.Cols {
+		panic(badShapeA)
+	}
+	n := a.Rows
+	t = newCGeneral(n, n)
+	for i := 0; i < n; i++ {
+		copy(t.Data[i*t.Stride:i*t.Stride+n], a.Data[i*a.Stride:])
+	}
+	z = newCGeneral(n, n)
+	if n == 0 {
+		return t, z, nil, 0, nil
+	}
+	sort := byte('N')
+	var bwork []lapacke.Int
+	if sel != nil {
+		sort = 'S'
+		bwork = make([]lapacke.Int, n)
+	}
+	sd := make([]lapacke.Int, 1)
+	w = make([]complex128, n)
+	rwork := make([]float64, n)
+	work := make([]complex128, 1)
+	lapacke.Zgees('V', sort, sel, n, t.Data, t.Stride, sd, w, z.Data, z.Stride, work, -1, rwork, bwork)
+	work = make([]complex128, int(real(work[0])))
+	info := lapacke.Zgees('V', sort, sel, n, t.Data, t.Stride, sd, w, z.Data, z.Stride, work, len(work), rwork, bwork)
+	return t, z, w, int(sd[0]), schurError(info, n)
+}
+
+// schurError returns the error corresponding to the info value returned by
+// the ?gees drivers for an n×n matrix.
+func schurError(info, n int) error {
+	switch {
+	case info == 0:
+		return nil
+	case info <= n:
+		return ErrIterationLimit
+	default:
+		return ErrReorder
+	}
+}
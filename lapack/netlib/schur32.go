@@ -0,0 +1,44 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// Schur32 is the float32 version of Schur. The factorization is computed by
+// Sgees.
+func Schur32(a blas32.General, sel func(complex64) bool) (t, z blas32.General, w []complex64, sdim int, err error) {
+	if a.Rows != a.Cols {
+		panic(badShapeA)
+	}
+	n := a.Rows
+	t = cloneGeneral32(a)
+	z = newGeneral32(n, n)
+	if n == 0 {
+		return t, z, nil, 0, nil
+	}
+	sort := byte('N')
+	var bwork []lapacke.Int
+	var selri func(wr, wi float32) bool
+	if sel != nil {
+		sort = 'S'
+		bwork = make([]lapacke.Int, n)
+		selri = func(wr, wi float32) bool { return sel(complex(wr, wi)) }
+	}
+	sd := make([]lapacke.Int, 1)
+	wr := make([]float32, n)
+	wi := make([]float32, n)
+	work := make([]float32, 1)
+	lapacke.Sgees('V', sort, selri, n, t.Data, t.Stride, sd, wr, wi, z.Data, z.Stride, work, -1, bwork)
+	work = make([]float32, int(work[0]))
+	info := lapacke.Sgees('V', sort, selri, n, t.Data, t.Stride, sd, wr, wi, z.Data, z.Stride, work, len(work), bwork)
+	w = make([]complex64, n)
+	for i := range w {
+		w[i] = complex(wr[i], wi[i])
+	}
+	return t, z, w, int(sd[0]), schurError(info, n)
+}
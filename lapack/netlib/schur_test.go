@@ -0,0 +1,287 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// schurResidual returns the largest absolute differences between the
+// elements of A and Z*T*Z^T and between those of Z^T*Z and I.
+func schurResidual(a, t, z blas64.General) (fact, orth float64) {
+	n := a.Rows
+	zt := newGeneral(n, n)
+	blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, z, t, 0, zt)
+	ztz := newGeneral(n, n)
+	blas64.Gemm(blas.NoTrans, blas.Trans, 1, zt, z, 0, ztz)
+	fact = maxDiff(a, ztz, false)
+	eye := newGeneral(n, n)
+	blas64.Gemm(blas.Trans, blas.NoTrans, 1, z, z, -1, eye)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			v := eye.Data[i*eye.Stride+j]
+			if i == j {
+				v++
+			}
+			orth = math.Max(orth, math.Abs(v))
+		}
+	}
+	return fact, orth
+}
+
+// isSchurForm returns whether t is block upper triangular with 1×1 and 2×2
+// diagonal blocks in Schur canonical form.
+func isSchurForm(t blas64.General) bool {
+	n := t.Rows
+	for i := 0; i < n; i++ {
+		for j := 0; j < i-1; j++ {
+			if t.Data[i*t.Stride+j] != 0 {
+				return false
+			}
+		}
+	}
+	for i := 0; i < n-1; i++ {
+		if t.Data[(i+1)*t.Stride+i] == 0 {
+			continue
+		}
+		// A 2×2 block has equal diagonal elements and off-diagonal elements
+		// of opposite sign, and cannot be followed by another subdiagonal
+		// element.
+		if t.Data[i*t.Stride+i] != t.Data[(i+1)*t.Stride+i+1] ||
+			t.Data[i*t.Stride+i+1]*t.Data[(i+1)*t.Stride+i] >= 0 ||
+			i < n-2 && t.Data[(i+2)*t.Stride+i+1] != 0 {
+			return false
+		}
+		i++
+	}
+	return true
+}
+
+// checkSelected returns whether exactly the leading sdim eigenvalues in w
+// satisfy sel.
+func checkSelected(w []complex128, sdim int, sel func(complex128) bool) bool {
+	for i, v := range w {
+		if sel(v) != (i < sdim) {
+			return false
+		}
+	}
+	return true
+}
+
+func negativeReal(λ complex128) bool { return real(λ) < 0 }
+
+func TestDgees(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 5, 10, 23} {
+		for _, sort := range []bool{false, true} {
+			name := fmt.Sprintf("n=%d,sort=%t", n, sort)
+			a := randomGeneral(rnd, n, n, n+3)
+			tm := cloneGeneral(a)
+			z := newGeneral(n, n)
+			wr := make([]float64, n)
+			wi := make([]float64, n)
+			var sel func(wr, wi float64) bool
+			if sort {
+				sel = func(wr, wi float64) bool { return wr < 0 }
+			}
+			work := make([]float64, 1)
+			impl.Dgees(lapack.SchurOrig, sel, n, tm.Data, max(1, tm.Stride), wr, wi, z.Data, max(1, z.Stride), work, -1)
+			work = make([]float64, int(work[0]))
+			sdim, info := impl.Dgees(lapack.SchurOrig, sel, n, tm.Data, max(1, tm.Stride), wr, wi, z.Data, max(1, z.Stride), work, len(work))
+			if info != 0 {
+				t.Errorf("%s: unexpected info %d", name, info)
+				continue
+			}
+			if !isSchurForm(tm) {
+				t.Errorf("%s: T not in Schur canonical form", name)
+			}
+			if fact, orth := schurResidual(a, tm, z); fact > tol || orth > tol {
+				t.Errorf("%s: unexpected residual: |A-ZTZ^T|=%v, |Z^TZ-I|=%v", name, fact, orth)
+			}
+			w := make([]complex128, n)
+			for i := range w {
+				w[i] = complex(wr[i], wi[i])
+			}
+			if !sort {
+				if sdim != 0 {
+					t.Errorf("%s: unexpected sdim %d", name, sdim)
+				}
+				continue
+			}
+			if !checkSelected(w, sdim, negativeReal) {
+				t.Errorf("%s: selected eigenvalues not leading: sdim=%d, w=%v", name, sdim, w)
+			}
+		}
+	}
+}
+
+func TestDtrsen(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 4, 9, 20} {
+		for _, job := range []SchurCondJob{CondNone, CondEigenvalues, CondSubspace, CondBoth} {
+			name := fmt.Sprintf("n=%d,job=%c", n, job)
+			a := randomGeneral(rnd, n, n, max(1, n))
+			tm, z, w, _, err := Schur(a, nil)
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", name, err)
+			}
+			// Select the eigenvalues with positive real part, which are
+			// interleaved with the others in the unordered form.
+			sel := make([]bool, n)
+			var want int
+			for i, v := range w {
+				sel[i] = real(v) > 0
+				if sel[i] {
+					want++
+				}
+			}
+			wr := make([]float64, n)
+			wi := make([]float64, n)
+			work := make([]float64, 1)
+			iwork := make([]int, 1)
+			impl.Dtrsen(job, lapack.UpdateSchur, sel, n, tm.Data, max(1, tm.Stride), z.Data, max(1, z.Stride), wr, wi, work, -1, iwork, -1)
+			work = make([]float64, int(work[0]))
+			iwork = make([]int, iwork[0])
+			m, s, sep, ok := impl.Dtrsen(job, lapack.UpdateSchur, sel, n, tm.Data, max(1, tm.Stride), z.Data, max(1, z.Stride), wr, wi, work, len(work), iwork, len(iwork))
+			if !ok {
+				t.Errorf("%s: unexpected failure", name)
+				continue
+			}
+			if m != want {
+				t.Errorf("%s: unexpected m: got %d want %d", name, m, want)
+			}
+			if !isSchurForm(tm) {
+				t.Errorf("%s: T not in Schur canonical form", name)
+			}
+			if fact, orth := schurResidual(a, tm, z); fact > tol || orth > tol {
+				t.Errorf("%s: unexpected residual: |A-ZTZ^T|=%v, |Z^TZ-I|=%v", name, fact, orth)
+			}
+			for i := 0; i < n; i++ {
+				if (wr[i] > 0) != (i < m) {
+					t.Errorf("%s: selected eigenvalues not leading: m=%d, wr=%v", name, m, wr)
+					break
+				}
+			}
+			if (job == CondEigenvalues || job == CondBoth) && n > 0 && (s <= 0 || s > 1) {
+				t.Errorf("%s: unexpected s %v", name, s)
+			}
+			if (job == CondSubspace || job == CondBoth) && n > 0 && sep < 0 {
+				t.Errorf("%s: unexpected sep %v", name, sep)
+			}
+		}
+	}
+}
+
+func TestSchur(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 3, 8, 17} {
+		for _, sel := range []func(complex128) bool{nil, negativeReal} {
+			name := fmt.Sprintf("n=%d,sort=%t", n, sel != nil)
+			a := randomGeneral(rnd, n, n, n+2)
+			orig := cloneGeneral(a)
+			tm, z, w, sdim, err := Schur(a, sel)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			if maxDiff(a, orig, false) != 0 {
+				t.Errorf("%s: a modified", name)
+			}
+			if len(w) != n || !isSchurForm(tm) {
+				t.Errorf("%s: unexpected Schur form", name)
+			}
+			if fact, orth := schurResidual(a, tm, z); fact > tol || orth > tol {
+				t.Errorf("%s: unexpected residual: |A-ZTZ^T|=%v, |Z^TZ-I|=%v", name, fact, orth)
+			}
+			if sel != nil && !checkSelected(w, sdim, sel) {
+				t.Errorf("%s: selected eigenvalues not leading: sdim=%d, w=%v", name, sdim, w)
+			}
+		}
+	}
+}
+
+func TestReorderSchur(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	a := randomGeneral(rnd, 12, 12, 12)
+	tm, z, w, _, err := Schur(a, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	sel := make([]bool, len(w))
+	for i, v := range w {
+		sel[i] = cmplx.Abs(v) > 1
+	}
+	tc, zc := cloneGeneral(tm), cloneGeneral(z)
+	tr, zr, wr, m, err := ReorderSchur(tm, z, sel)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if maxDiff(tm, tc, false) != 0 || maxDiff(z, zc, false) != 0 {
+		t.Errorf("inputs modified")
+	}
+	if !isSchurForm(tr) {
+		t.Errorf("T not in Schur canonical form")
+	}
+	if fact, orth := schurResidual(a, tr, zr); fact > tol || orth > tol {
+		t.Errorf("unexpected residual: |A-ZTZ^T|=%v, |Z^TZ-I|=%v", fact, orth)
+	}
+	if !checkSelected(wr, m, func(λ complex128) bool { return cmplx.Abs(λ) > 1 }) {
+		t.Errorf("selected eigenvalues not leading: m=%d, w=%v", m, wr)
+	}
+}
+
+func TestComplexSchur(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	inside := func(λ complex128) bool { return cmplx.Abs(λ) < 2 }
+	for _, n := range []int{0, 1, 4, 13} {
+		for _, sel := range []func(complex128) bool{nil, inside} {
+			name := fmt.Sprintf("n=%d,sort=%t", n, sel != nil)
+			a := randomCGeneral(rnd, n, n, n+1)
+			orig := cloneCGeneral(a)
+			tm, z, w, sdim, err := ComplexSchur(a, sel)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			if n == 0 {
+				continue
+			}
+			if cmaxAbsDiff(a, orig) != 0 {
+				t.Errorf("%s: a modified", name)
+			}
+			for i := 0; i < n; i++ {
+				for j := 0; j < i; j++ {
+					if tm.Data[i*tm.Stride+j] != 0 {
+						t.Fatalf("%s: T not upper triangular", name)
+					}
+				}
+				if tm.Data[i*tm.Stride+i] != w[i] {
+					t.Errorf("%s: eigenvalue %d not on the diagonal of T", name, i)
+				}
+			}
+			ztzh := naiveCMul(naiveCMul(z, tm), conjTrans(z))
+			if d := cmaxAbsDiff(ztzh, a); d > tol {
+				t.Errorf("%s: unexpected residual |A-ZTZ^H|=%v", name, d)
+			}
+			if sel != nil && !checkSelected(w, sdim, sel) {
+				t.Errorf("%s: selected eigenvalues not leading: sdim=%d, w=%v", name, sdim, w)
+			}
+		}
+	}
+}
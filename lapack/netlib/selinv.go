@@ -0,0 +1,123 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"sort"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// selInvBlock is the maximum number of right-hand sides solved together
+// by CholeskyInverseDiag.
+const selInvBlock = 64
+
+// CholeskyInverseDiag returns the diagonal elements of the inverse of the
+// symmetric positive definite matrix A selected by idx, given the Cholesky
+// factor t of A as returned by CholeskySPD. The kth returned element is
+// (A^{-1})_{i,i} for i = idx[k]. If idx is nil, all n diagonal elements are
+// returned.
+//
+// With A = U^T * U the element (A^{-1})_{i,i} is the squared norm of the
+// solution y of U^T * y = e_i, and similarly for A = L * L^T. The first i
+// elements of y are zero, so the selected indices are sorted and solved in
+// batches by single calls to Dtrsm on the trailing triangle of t starting at
+// the smallest index of the batch. The cost for a single index i is
+// O((n-i)^2) instead of the O(n^3) of forming A^{-1}.
+//
+// CholeskyInverseDiag panics if an index is out of range. A singular factor
+// gives infinite or NaN elements.
+func CholeskyInverseDiag(t blas64.Triangular, idx []int) []float64 {
+	if t.Uplo != blas.Upper && t.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	n := t.N
+	order := selInvOrder(n, idx)
+	d := make([]float64, len(order))
+	trans := blas.NoTrans
+	if t.Uplo == blas.Upper {
+		trans = blas.Trans
+	}
+	for len(order) > 0 {
+		k := min(len(order), selInvBlock)
+		batch := order[:k]
+		order = order[k:]
+
+		i0 := batch[0].i
+		m := n - i0
+		y := make([]float64, m*k)
+		for j, s := range batch {
+			y[(s.i-i0)*k+j] = 1
+		}
+		blasImpl.Dtrsm(blas.Left, t.Uplo, trans, t.Diag, m, k, 1, t.Data[i0*t.Stride+i0:], t.Stride, y, k)
+		for j, s := range batch {
+			d[s.k] = selInvNorm(y[(s.i-i0)*k+j:], m-(s.i-i0), k)
+		}
+	}
+	return d
+}
+
+// BandCholeskyInverseDiag returns the diagonal elements of the inverse of
+// the symmetric positive definite band matrix A selected by idx as described
+// for CholeskyInverseDiag, given the band Cholesky factor t of A as computed
+// by Dpbtrf. Each selected index i is solved by Dtbsv on the trailing band
+// triangle of t starting at i, so its cost is O((n-i)*k).
+func BandCholeskyInverseDiag(t blas64.TriangularBand, idx []int) []float64 {
+	if t.Uplo != blas.Upper && t.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	n := t.N
+	order := selInvOrder(n, idx)
+	d := make([]float64, len(order))
+	trans := blas.NoTrans
+	if t.Uplo == blas.Upper {
+		trans = blas.Trans
+	}
+	work := make([]float64, n)
+	for _, s := range order {
+		m := n - s.i
+		y := work[:m]
+		for i := range y {
+			y[i] = 0
+		}
+		y[0] = 1
+		blasImpl.Dtbsv(t.Uplo, trans, t.Diag, m, t.K, t.Data[s.i*t.Stride:], t.Stride, y, 1)
+		d[s.k] = selInvNorm(y, m, 1)
+	}
+	return d
+}
+
+// selInvIndex is a selected row index i and its position k in the result.
+type selInvIndex struct{ i, k int }
+
+// selInvOrder returns the indices in idx, or all n indices if idx is nil,
+// sorted by row index.
+func selInvOrder(n int, idx []int) []selInvIndex {
+	var order []selInvIndex
+	if idx == nil {
+		order = make([]selInvIndex, n)
+		for i := range order {
+			order[i] = selInvIndex{i: i, k: i}
+		}
+		return order
+	}
+	order = make([]selInvIndex, len(idx))
+	for k, i := range idx {
+		if i < 0 || n <= i {
+			panic(badIndex)
+		}
+		order[k] = selInvIndex{i: i, k: k}
+	}
+	sort.Slice(order, func(a, b int) bool { return order[a].i < order[b].i })
+	return order
+}
+
+// selInvNorm returns the squared norm of the n elements of y at increment
+// inc.
+func selInvNorm(y []float64, n, inc int) float64 {
+	v := blasImpl.Dnrm2(n, y, inc)
+	return v * v
+}
@@ -0,0 +1,75 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+)
+
+// CholeskyInverseDiag32 is the float32 version of CholeskyInverseDiag. The
+// triangular solves are computed by Strsm.
+func CholeskyInverseDiag32(t blas32.Triangular, idx []int) []float32 {
+	if t.Uplo != blas.Upper && t.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	n := t.N
+	order := selInvOrder(n, idx)
+	d := make([]float32, len(order))
+	trans := blas.NoTrans
+	if t.Uplo == blas.Upper {
+		trans = blas.Trans
+	}
+	for len(order) > 0 {
+		k := min(len(order), selInvBlock)
+		batch := order[:k]
+		order = order[k:]
+
+		i0 := batch[0].i
+		m := n - i0
+		y := make([]float32, m*k)
+		for j, s := range batch {
+			y[(s.i-i0)*k+j] = 1
+		}
+		blasImpl.Strsm(blas.Left, t.Uplo, trans, t.Diag, m, k, 1, t.Data[i0*t.Stride+i0:], t.Stride, y, k)
+		for j, s := range batch {
+			d[s.k] = selInvNorm32(y[(s.i-i0)*k+j:], m-(s.i-i0), k)
+		}
+	}
+	return d
+}
+
+// BandCholeskyInverseDiag32 is the float32 version of
+// BandCholeskyInverseDiag. The triangular solves are computed by Stbsv.
+func BandCholeskyInverseDiag32(t blas32.TriangularBand, idx []int) []float32 {
+	if t.Uplo != blas.Upper && t.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	n := t.N
+	order := selInvOrder(n, idx)
+	d := make([]float32, len(order))
+	trans := blas.NoTrans
+	if t.Uplo == blas.Upper {
+		trans = blas.Trans
+	}
+	work := make([]float32, n)
+	for _, s := range order {
+		m := n - s.i
+		y := work[:m]
+		for i := range y {
+			y[i] = 0
+		}
+		y[0] = 1
+		blasImpl.Stbsv(t.Uplo, trans, t.Diag, m, t.K, t.Data[s.i*t.Stride:], t.Stride, y, 1)
+		d[s.k] = selInvNorm32(y, m, 1)
+	}
+	return d
+}
+
+// selInvNorm32 is the float32 version of selInvNorm.
+func selInvNorm32(y []float32, n, inc int) float32 {
+	v := blasImpl.Snrm2(n, y, inc)
+	return v * v
+}
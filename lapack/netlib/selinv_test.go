@@ -0,0 +1,179 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// inverseDiag returns the diagonal of the inverse of the symmetric positive
+// definite matrix a computed by Dpotrf and Dpotri.
+func inverseDiag(a blas64.Symmetric) []float64 {
+	n := a.N
+	f := cloneGeneral(blas64.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data})
+	if !impl.Dpotrf(a.Uplo, n, f.Data, f.Stride) || !impl.Dpotri(a.Uplo, n, f.Data, f.Stride) {
+		panic("matrix not positive definite")
+	}
+	d := make([]float64, n)
+	for i := range d {
+		d[i] = f.Data[i*f.Stride+i]
+	}
+	return d
+}
+
+// randomSPDBand returns a random symmetric positive definite n×n band
+// matrix with kd off-diagonals, with both triangles stored.
+func randomSPDBand(rnd *rand.Rand, n, kd int) blas64.Symmetric {
+	a := newGeneral(n, n)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < min(n, i+kd+1); j++ {
+			v := rnd.NormFloat64()
+			a.Data[i*a.Stride+j] = v
+			a.Data[j*a.Stride+i] = v
+		}
+	}
+	for i := 0; i < n; i++ {
+		var sum float64
+		for j := 0; j < n; j++ {
+			sum += math.Abs(a.Data[i*a.Stride+j])
+		}
+		a.Data[i*a.Stride+i] = sum + 1
+	}
+	return blas64.Symmetric{Uplo: blas.Upper, N: n, Stride: a.Stride, Data: a.Data}
+}
+
+// bandFactor returns the band Cholesky factor of the band matrix a with kd
+// off-diagonals computed by Dpbtrf.
+func bandFactor(a blas64.Symmetric, kd int) blas64.TriangularBand {
+	n := a.N
+	t := blas64.TriangularBand{Uplo: a.Uplo, Diag: blas.NonUnit, N: n, K: kd, Stride: kd + 1, Data: make([]float64, n*(kd+1))}
+	for i := 0; i < n; i++ {
+		for j := max(0, i-kd); j < min(n, i+kd+1); j++ {
+			switch {
+			case a.Uplo == blas.Upper && j >= i:
+				t.Data[i*t.Stride+j-i] = a.Data[i*a.Stride+j]
+			case a.Uplo == blas.Lower && j <= i:
+				t.Data[i*t.Stride+kd+j-i] = a.Data[i*a.Stride+j]
+			}
+		}
+	}
+	if !impl.Dpbtrf(t.Uplo, n, kd, t.Data, t.Stride) {
+		panic("matrix not positive definite")
+	}
+	return t
+}
+
+func selectedIndices(n int) [][]int {
+	if n == 0 {
+		return [][]int{nil, {}}
+	}
+	return [][]int{nil, {n - 1}, {n - 1, 0, n / 2, 0, n / 3}}
+}
+
+func TestCholeskyInverseDiag(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 5, 70, 150} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			a := randomSPD(rnd, n, n+2)
+			a.Uplo = uplo
+			want := inverseDiag(a)
+			f, _, err := CholeskySPD(a, nil)
+			if err != nil {
+				t.Fatalf("n=%d,uplo=%c: unexpected error: %v", n, uplo, err)
+			}
+			for _, idx := range selectedIndices(n) {
+				name := fmt.Sprintf("n=%d,uplo=%c,idx=%v", n, uplo, idx)
+				got := CholeskyInverseDiag(f, idx)
+				checkInverseDiag(t, name, got, want, idx, tol)
+			}
+		}
+	}
+}
+
+func TestBandCholeskyInverseDiag(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 5, 40} {
+		for _, kd := range []int{0, 1, 3} {
+			for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+				a := randomSPDBand(rnd, n, kd)
+				a.Uplo = uplo
+				want := inverseDiag(a)
+				f := bandFactor(a, kd)
+				for _, idx := range selectedIndices(n) {
+					name := fmt.Sprintf("n=%d,kd=%d,uplo=%c,idx=%v", n, kd, uplo, idx)
+					got := BandCholeskyInverseDiag(f, idx)
+					checkInverseDiag(t, name, got, want, idx, tol)
+				}
+			}
+		}
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r != badIndex {
+				t.Errorf("unexpected panic for index out of range: %v", r)
+			}
+		}()
+		BandCholeskyInverseDiag(blas64.TriangularBand{Uplo: blas.Upper, Diag: blas.NonUnit, N: 2, Stride: 1, Data: []float64{1, 1}}, []int{2})
+	}()
+}
+
+func checkInverseDiag(t *testing.T, name string, got, want []float64, idx []int, tol float64) {
+	t.Helper()
+	if idx == nil {
+		idx = make([]int, len(want))
+		for i := range idx {
+			idx[i] = i
+		}
+	}
+	if len(got) != len(idx) {
+		t.Errorf("%s: unexpected length: got %d want %d", name, len(got), len(idx))
+		return
+	}
+	for k, i := range idx {
+		if math.Abs(got[k]-want[i]) > tol*math.Max(1, math.Abs(want[i])) {
+			t.Errorf("%s: unexpected element %d: got %v want %v", name, i, got[k], want[i])
+		}
+	}
+}
+
+func TestCholeskyInverseDiag32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 5, 70} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			a := randomSPDBand(rnd, n, 2)
+			a.Uplo = uplo
+			a32 := symmetric32(a)
+			want := inverseDiag(a)
+
+			f, _, err := CholeskySPD32(a32, nil)
+			if err != nil {
+				t.Fatalf("n=%d,uplo=%c: unexpected error: %v", n, uplo, err)
+			}
+			if d := maxDiff32(CholeskyInverseDiag32(f, nil), want); d > tol32 {
+				t.Errorf("n=%d,uplo=%c: unexpected dense result: difference %v", n, uplo, d)
+			}
+
+			b := bandFactor(a, 2)
+			b32 := blas32.TriangularBand{Uplo: b.Uplo, Diag: b.Diag, N: b.N, K: b.K, Stride: b.Stride, Data: make([]float32, len(b.Data))}
+			for i, v := range b.Data {
+				b32.Data[i] = float32(v)
+			}
+			if d := maxDiff32(BandCholeskyInverseDiag32(b32, nil), want); d > tol32 {
+				t.Errorf("n=%d,uplo=%c: unexpected band result: difference %v", n, uplo, d)
+			}
+		}
+	}
+}
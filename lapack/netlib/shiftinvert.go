@@ -0,0 +1,190 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+	"sort"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+const badEigCount = "lapack: number of eigenpairs out of range"
+
+// ShiftInvertEig computes the k eigenvalues of the symmetric n×n matrix A
+// nearest to sigma and the corresponding orthonormal eigenvectors. The
+// eigenvalues are returned in ascending order in w and the eigenvectors as
+// the columns of the n×k matrix v. The input a is not modified.
+//
+// A - sigma*I is factored once by Dsytrf and the Lanczos method with full
+// reorthogonalization is applied to its inverse through Dsytrs. The
+// eigenvalues of A nearest to sigma are the eigenvalues of largest magnitude
+// of the inverse and are found after a number of Lanczos steps that is
+// usually small compared to n, so for large matrices the cost is dominated
+// by the factorization. The returned eigenvalues are the Rayleigh quotients
+// of A at the computed eigenvectors.
+//
+// The Lanczos method finds a single eigenvector of a multiple eigenvalue
+// unless the Krylov subspace becomes invariant and is extended before the
+// requested eigenpairs have converged, so the result may miss copies of a
+// multiple eigenvalue.
+//
+// If A - sigma*I is exactly singular, sigma is an eigenvalue of A and
+// ShiftInvertEig returns a SingularError. ShiftInvertEig panics if k < 0 or
+// k > n.
+func ShiftInvertEig(a blas64.Symmetric, sigma float64, k int) (w []float64, v blas64.General, err error) {
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	n := a.N
+	if k < 0 || n < k {
+		panic(badEigCount)
+	}
+	if k == 0 {
+		return nil, newGeneral(n, 0), nil
+	}
+
+	f := cloneGeneral(blas64.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data})
+	for i := 0; i < n; i++ {
+		f.Data[i*f.Stride+i] -= sigma
+	}
+	ipiv := make([]lapacke.Int, n)
+	work := make([]float64, 1)
+	lapacke.Dsytrf(byte(a.Uplo), n, f.Data, f.Stride, ipiv, work, -1)
+	work = make([]float64, int(work[0]))
+	switch info := lapacke.DsytrfInfo(byte(a.Uplo), n, f.Data, f.Stride, ipiv, work, len(work)); {
+	case info < 0:
+		panic("lapack: invalid argument to Dsytrf")
+	case info > 0:
+		return nil, blas64.General{}, SingularError{Index: info - 1}
+	}
+
+	// The Lanczos vectors are stored as the rows of q.
+	q := make([]float64, 0, n*min(n, 2*k+20))
+	var alpha, beta []float64
+	h := make([]float64, n)
+	r := make([]float64, n)
+	rnd := rand.New(rand.NewSource(1))
+	for i := range r {
+		r[i] = rnd.NormFloat64()
+	}
+	rnorm := blasImpl.Dnrm2(n, r, 1)
+	// scale is the largest norm of the inverse applied to a Lanczos vector,
+	// a lower bound on the norm of the inverse.
+	var scale float64
+	var theta, z []float64
+	for m := 1; m <= n; m++ {
+		if m > 1 {
+			beta = append(beta, rnorm)
+		}
+		if m > 1 && rnorm <= 1e3*dlamchE*scale {
+			// The Krylov subspace is invariant, so continue with a
+			// random vector orthogonal to it.
+			beta[m-2] = 0
+			for i := range r {
+				r[i] = rnd.NormFloat64()
+			}
+			lanczosOrthogonalize(m-1, n, q, r, h)
+			rnorm = blasImpl.Dnrm2(n, r, 1)
+		}
+		blasImpl.Dscal(n, 1/rnorm, r, 1)
+		q = append(q, r...)
+
+		lapacke.Dsytrs(byte(a.Uplo), n, 1, f.Data, f.Stride, ipiv, r, 1)
+		scale = math.Max(scale, blasImpl.Dnrm2(n, r, 1))
+		alpha = append(alpha, lanczosOrthogonalize(m, n, q, r, h))
+		rnorm = blasImpl.Dnrm2(n, r, 1)
+
+		if m < k && m < n {
+			continue
+		}
+		theta = make([]float64, m)
+		copy(theta, alpha)
+		e := make([]float64, m)
+		copy(e, beta)
+		z = make([]float64, m*m)
+		if !lapacke.Dstev('V', m, theta, e, z, m, make([]float64, max(1, 2*m-2))) {
+			return nil, blas64.General{}, ErrIterationLimit
+		}
+		if m == n || lanczosConverged(theta, z, m, k, rnorm) {
+			break
+		}
+	}
+
+	// The Ritz values of largest magnitude are at the ends of theta.
+	m := len(alpha)
+	sel := make([]int, 0, k)
+	for lo, hi := 0, m-1; len(sel) < k; {
+		if math.Abs(theta[lo]) >= math.Abs(theta[hi]) {
+			sel = append(sel, lo)
+			lo++
+		} else {
+			sel = append(sel, hi)
+			hi--
+		}
+	}
+
+	type pair struct {
+		w float64
+		y []float64
+	}
+	pairs := make([]pair, k)
+	ay := make([]float64, n)
+	for i, j := range sel {
+		y := make([]float64, n)
+		blasImpl.Dgemv(blas.Trans, m, n, 1, q, n, z[j:], m, 0, y, 1)
+		blasImpl.Dscal(n, 1/blasImpl.Dnrm2(n, y, 1), y, 1)
+		blasImpl.Dsymv(a.Uplo, n, 1, a.Data, a.Stride, y, 1, 0, ay, 1)
+		pairs[i] = pair{w: blasImpl.Ddot(n, y, 1, ay, 1), y: y}
+	}
+	sort.SliceStable(pairs, func(i, j int) bool { return pairs[i].w < pairs[j].w })
+
+	w = make([]float64, k)
+	v = newGeneral(n, k)
+	for j, p := range pairs {
+		w[j] = p.w
+		blasImpl.Dcopy(n, p.y, 1, v.Data[j:], v.Stride)
+	}
+	return w, v, nil
+}
+
+// lanczosOrthogonalize orthogonalizes r against the m vectors stored as the
+// rows of q with two passes of classical Gram-Schmidt and returns the
+// coefficient of the last vector. h is used as workspace.
+func lanczosOrthogonalize(m, n int, q, r, h []float64) float64 {
+	var c float64
+	for pass := 0; pass < 2; pass++ {
+		blasImpl.Dgemv(blas.NoTrans, m, n, 1, q, n, r, 1, 0, h, 1)
+		blasImpl.Dgemv(blas.Trans, m, n, -1, q, n, h, 1, 1, r, 1)
+		c += h[m-1]
+	}
+	return c
+}
+
+// lanczosConverged returns whether the k Ritz values of largest magnitude of
+// the m×m tridiagonal matrix with eigenvalues theta and eigenvectors z have
+// converged, given the norm rnorm of the Lanczos residual. The residual of
+// each Ritz pair must be small relative to its Ritz value, which bounds the
+// residual of the corresponding eigenpair of A relative to the norm of A.
+func lanczosConverged(theta, z []float64, m, k int, rnorm float64) bool {
+	for lo, hi, i := 0, m-1, 0; i < k; i++ {
+		var j int
+		if math.Abs(theta[lo]) >= math.Abs(theta[hi]) {
+			j = lo
+			lo++
+		} else {
+			j = hi
+			hi--
+		}
+		if rnorm*math.Abs(z[(m-1)*m+j]) > 1e3*dlamchE*math.Abs(theta[j]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,164 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+	"sort"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// ShiftInvertEig32 is the float32 version of ShiftInvertEig. The shifted
+// matrix is factored by Ssytrf and the tridiagonal matrix is diagonalized
+// by Sstev.
+func ShiftInvertEig32(a blas32.Symmetric, sigma float32, k int) (w []float32, v blas32.General, err error) {
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	n := a.N
+	if k < 0 || n < k {
+		panic(badEigCount)
+	}
+	if k == 0 {
+		return nil, newGeneral32(n, 0), nil
+	}
+
+	f := cloneGeneral32(blas32.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data})
+	for i := 0; i < n; i++ {
+		f.Data[i*f.Stride+i] -= sigma
+	}
+	ipiv := make([]lapacke.Int, n)
+	work := make([]float32, 1)
+	lapacke.Ssytrf(byte(a.Uplo), n, f.Data, f.Stride, ipiv, work, -1)
+	work = make([]float32, int(work[0]))
+	switch info := lapacke.SsytrfInfo(byte(a.Uplo), n, f.Data, f.Stride, ipiv, work, len(work)); {
+	case info < 0:
+		panic("lapack: invalid argument to Ssytrf")
+	case info > 0:
+		return nil, blas32.General{}, SingularError{Index: info - 1}
+	}
+
+	// The Lanczos vectors are stored as the rows of q.
+	q := make([]float32, 0, n*min(n, 2*k+20))
+	var alpha, beta []float32
+	h := make([]float32, n)
+	r := make([]float32, n)
+	rnd := rand.New(rand.NewSource(1))
+	for i := range r {
+		r[i] = float32(rnd.NormFloat64())
+	}
+	rnorm := blasImpl.Snrm2(n, r, 1)
+	// scale is the largest norm of the inverse applied to a Lanczos vector,
+	// a lower bound on the norm of the inverse.
+	var scale float32
+	var theta, z []float32
+	for m := 1; m <= n; m++ {
+		if m > 1 {
+			beta = append(beta, rnorm)
+		}
+		if m > 1 && rnorm <= 1e3*slamchE*scale {
+			// The Krylov subspace is invariant, so continue with a
+			// random vector orthogonal to it.
+			beta[m-2] = 0
+			for i := range r {
+				r[i] = float32(rnd.NormFloat64())
+			}
+			lanczosOrthogonalize32(m-1, n, q, r, h)
+			rnorm = blasImpl.Snrm2(n, r, 1)
+		}
+		blasImpl.Sscal(n, 1/rnorm, r, 1)
+		q = append(q, r...)
+
+		lapacke.Ssytrs(byte(a.Uplo), n, 1, f.Data, f.Stride, ipiv, r, 1)
+		scale = float32(math.Max(float64(scale), float64(blasImpl.Snrm2(n, r, 1))))
+		alpha = append(alpha, lanczosOrthogonalize32(m, n, q, r, h))
+		rnorm = blasImpl.Snrm2(n, r, 1)
+
+		if m < k && m < n {
+			continue
+		}
+		theta = make([]float32, m)
+		copy(theta, alpha)
+		e := make([]float32, m)
+		copy(e, beta)
+		z = make([]float32, m*m)
+		if !lapacke.Sstev('V', m, theta, e, z, m, make([]float32, max(1, 2*m-2))) {
+			return nil, blas32.General{}, ErrIterationLimit
+		}
+		if m == n || lanczosConverged32(theta, z, m, k, rnorm) {
+			break
+		}
+	}
+
+	// The Ritz values of largest magnitude are at the ends of theta.
+	m := len(alpha)
+	sel := make([]int, 0, k)
+	for lo, hi := 0, m-1; len(sel) < k; {
+		if math.Abs(float64(theta[lo])) >= math.Abs(float64(theta[hi])) {
+			sel = append(sel, lo)
+			lo++
+		} else {
+			sel = append(sel, hi)
+			hi--
+		}
+	}
+
+	type pair struct {
+		w float32
+		y []float32
+	}
+	pairs := make([]pair, k)
+	ay := make([]float32, n)
+	for i, j := range sel {
+		y := make([]float32, n)
+		blasImpl.Sgemv(blas.Trans, m, n, 1, q, n, z[j:], m, 0, y, 1)
+		blasImpl.Sscal(n, 1/blasImpl.Snrm2(n, y, 1), y, 1)
+		blasImpl.Ssymv(a.Uplo, n, 1, a.Data, a.Stride, y, 1, 0, ay, 1)
+		pairs[i] = pair{w: blasImpl.Sdot(n, y, 1, ay, 1), y: y}
+	}
+	sort.SliceStable(pairs, func(i, j int) bool { return pairs[i].w < pairs[j].w })
+
+	w = make([]float32, k)
+	v = newGeneral32(n, k)
+	for j, p := range pairs {
+		w[j] = p.w
+		blasImpl.Scopy(n, p.y, 1, v.Data[j:], v.Stride)
+	}
+	return w, v, nil
+}
+
+// lanczosOrthogonalize32 is the float32 version of lanczosOrthogonalize.
+func lanczosOrthogonalize32(m, n int, q, r, h []float32) float32 {
+	var c float32
+	for pass := 0; pass < 2; pass++ {
+		blasImpl.Sgemv(blas.NoTrans, m, n, 1, q, n, r, 1, 0, h, 1)
+		blasImpl.Sgemv(blas.Trans, m, n, -1, q, n, h, 1, 1, r, 1)
+		c += h[m-1]
+	}
+	return c
+}
+
+// lanczosConverged32 is the float32 version of lanczosConverged.
+func lanczosConverged32(theta, z []float32, m, k int, rnorm float32) bool {
+	for lo, hi, i := 0, m-1, 0; i < k; i++ {
+		var j int
+		if math.Abs(float64(theta[lo])) >= math.Abs(float64(theta[hi])) {
+			j = lo
+			lo++
+		} else {
+			j = hi
+			hi--
+		}
+		if math.Abs(float64(rnorm*z[(m-1)*m+j])) > 1e3*slamchE*math.Abs(float64(theta[j])) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,200 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// symmetricWithSpectrum returns the symmetric matrix Q * diag(lambda) * Q^T
+// for a random orthogonal Q.
+func symmetricWithSpectrum(rnd *rand.Rand, lambda []float64, uplo blas.Uplo) blas64.Symmetric {
+	n := len(lambda)
+	q := randomGeneral(rnd, n, n, n)
+	// Orthonormalize the columns of q by modified Gram-Schmidt.
+	for j := 0; j < n; j++ {
+		for l := 0; l < j; l++ {
+			var d float64
+			for i := 0; i < n; i++ {
+				d += q.Data[i*n+l] * q.Data[i*n+j]
+			}
+			for i := 0; i < n; i++ {
+				q.Data[i*n+j] -= d * q.Data[i*n+l]
+			}
+		}
+		var s float64
+		for i := 0; i < n; i++ {
+			s += q.Data[i*n+j] * q.Data[i*n+j]
+		}
+		s = math.Sqrt(s)
+		for i := 0; i < n; i++ {
+			q.Data[i*n+j] /= s
+		}
+	}
+	a := blas64.Symmetric{Uplo: uplo, N: n, Stride: n + 1, Data: make([]float64, n*(n+1))}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			var s float64
+			for l := 0; l < n; l++ {
+				s += q.Data[i*n+l] * lambda[l] * q.Data[j*n+l]
+			}
+			a.Data[i*a.Stride+j] = s
+		}
+	}
+	return a
+}
+
+func TestShiftInvertEig(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		n     int
+		sigma float64
+		k     int
+	}{
+		{n: 1, sigma: 0.5, k: 1},
+		{n: 5, sigma: 0, k: 5},
+		{n: 30, sigma: 0.1, k: 1},
+		{n: 30, sigma: 3.4, k: 3},
+		{n: 60, sigma: -100, k: 4},
+		{n: 60, sigma: 7.7, k: 6},
+	} {
+		n, k := test.n, test.k
+		lambda := make([]float64, n)
+		for i := range lambda {
+			lambda[i] = 1.5*float64(i) - float64(n)/2 + 0.25
+		}
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			a := symmetricWithSpectrum(rnd, lambda, uplo)
+			orig := append([]float64(nil), a.Data...)
+
+			w, v, err := ShiftInvertEig(a, test.sigma, k)
+			if err != nil {
+				t.Fatalf("n=%d sigma=%v k=%d: unexpected error: %v", n, test.sigma, k, err)
+			}
+			for i := range orig {
+				if a.Data[i] != orig[i] {
+					t.Fatalf("n=%d sigma=%v k=%d: a was modified", n, test.sigma, k)
+				}
+			}
+
+			want := append([]float64(nil), lambda...)
+			sort.SliceStable(want, func(i, j int) bool {
+				return math.Abs(want[i]-test.sigma) < math.Abs(want[j]-test.sigma)
+			})
+			want = want[:k]
+			sort.Float64s(want)
+			for i := range want {
+				if math.Abs(w[i]-want[i]) > 1e-10*float64(n) {
+					t.Errorf("n=%d sigma=%v k=%d: unexpected eigenvalues: got %v want %v", n, test.sigma, k, w, want)
+					break
+				}
+			}
+
+			full := blas64.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data}
+			if uplo == blas.Upper {
+				full = cloneGeneral(full)
+				for i := 0; i < n; i++ {
+					for j := 0; j < i; j++ {
+						full.Data[i*n+j] = full.Data[j*n+i]
+					}
+				}
+			}
+			for j := 0; j < k; j++ {
+				for l := 0; l <= j; l++ {
+					var d float64
+					for i := 0; i < n; i++ {
+						d += v.Data[i*v.Stride+j] * v.Data[i*v.Stride+l]
+					}
+					if l == j {
+						d--
+					}
+					if math.Abs(d) > 1e-10 {
+						t.Errorf("n=%d sigma=%v k=%d: eigenvectors %d and %d not orthonormal: %v", n, test.sigma, k, l, j, d)
+					}
+				}
+				var res float64
+				for i := 0; i < n; i++ {
+					var s float64
+					for l := 0; l < n; l++ {
+						s += full.Data[i*full.Stride+l] * v.Data[l*v.Stride+j]
+					}
+					res = math.Max(res, math.Abs(s-w[j]*v.Data[i*v.Stride+j]))
+				}
+				if res > 1e-9*float64(n) {
+					t.Errorf("n=%d sigma=%v k=%d: unexpected residual %v for eigenpair %d", n, test.sigma, k, res, j)
+				}
+			}
+		}
+	}
+}
+
+func TestShiftInvertEigSingular(t *testing.T) {
+	a := blas64.Symmetric{Uplo: blas.Upper, N: 3, Stride: 3, Data: []float64{
+		1, 0, 0,
+		0, 2, 0,
+		0, 0, 3,
+	}}
+	_, _, err := ShiftInvertEig(a, 2, 1)
+	if _, ok := err.(SingularError); !ok {
+		t.Errorf("unexpected error for shift at an eigenvalue: got %v want SingularError", err)
+	}
+
+	w, v, err := ShiftInvertEig(a, 2, 0)
+	if err != nil || len(w) != 0 || v.Rows != 3 || v.Cols != 0 {
+		t.Errorf("unexpected result for k=0: %v %+v %v", w, v, err)
+	}
+
+	panicked := func() (p bool) {
+		defer func() { p = recover() != nil }()
+		ShiftInvertEig(a, 0, 4)
+		return false
+	}()
+	if !panicked {
+		t.Error("expected panic for k > n")
+	}
+}
+
+func TestShiftInvertEig32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		n     int
+		sigma float64
+		k     int
+	}{
+		{n: 5, sigma: 0, k: 5},
+		{n: 30, sigma: 3.4, k: 3},
+		{n: 60, sigma: 7.7, k: 6},
+	} {
+		n, k := test.n, test.k
+		lambda := make([]float64, n)
+		for i := range lambda {
+			lambda[i] = 1.5*float64(i) - float64(n)/2 + 0.25
+		}
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			a := symmetricWithSpectrum(rnd, lambda, uplo)
+			want, _, err := ShiftInvertEig(a, test.sigma, k)
+			if err != nil {
+				t.Fatalf("n=%d sigma=%v k=%d: unexpected error: %v", n, test.sigma, k, err)
+			}
+			w, v, err := ShiftInvertEig32(symmetric32(a), float32(test.sigma), k)
+			if err != nil {
+				t.Fatalf("n=%d sigma=%v k=%d: unexpected error: %v", n, test.sigma, k, err)
+			}
+			if d := maxDiff32(w, want); d > tol32*float64(n) {
+				t.Errorf("n=%d sigma=%v k=%d: unexpected eigenvalues: difference %v", n, test.sigma, k, d)
+			}
+			if v.Rows != n || v.Cols != k {
+				t.Errorf("n=%d sigma=%v k=%d: unexpected eigenvector shape %d×%d", n, test.sigma, k, v.Rows, v.Cols)
+			}
+		}
+	}
+}
@@ -0,0 +1,2376 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/gonum/lapack/gonum"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// The float32 methods of Implementation call the single precision LAPACKE
+// drivers directly, with the argument checks of their float64 versions.
+
+// Sgetrf is the float32 version of Dgetrf. ipiv is zero-indexed.
+func (impl Implementation) Sgetrf(m, n int, a []float32, lda int, ipiv []int) (ok bool) {
+	mn := min(m, n)
+	switch {
+	case m < 0:
+		panic(Error{Routine: "Sgetrf", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Sgetrf", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Sgetrf", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if mn == 0 {
+		return true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(Error{Routine: "Sgetrf", Param: "a", Message: shortA})
+	case len(ipiv) != mn:
+		panic(Error{Routine: "Sgetrf", Param: "ipiv", Message: badLenIpiv})
+	}
+
+	ipiv32 := make([]lapacke.Int, mn)
+	ok = lapacke.Sgetrf(m, n, a, lda, ipiv32)
+	for i, v := range ipiv32 {
+		ipiv[i] = int(v) - 1 // Transform to zero-indexed.
+	}
+	return ok
+}
+
+// Sgetrs is the float32 version of Dgetrs. ipiv is zero-indexed.
+func (impl Implementation) Sgetrs(trans blas.Transpose, n, nrhs int, a []float32, lda int, ipiv []int, b []float32, ldb int) {
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(Error{Routine: "Sgetrs", Param: "trans", Message: badTrans})
+	case n < 0:
+		panic(Error{Routine: "Sgetrs", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Sgetrs", Param: "nrhs", Message: nrhsLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Sgetrs", Param: "lda", Message: badLdA})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Sgetrs", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Sgetrs", Param: "a", Message: shortA})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Sgetrs", Param: "b", Message: shortB})
+	case len(ipiv) != n:
+		panic(Error{Routine: "Sgetrs", Param: "ipiv", Message: badLenIpiv})
+	}
+
+	ipiv32 := make([]lapacke.Int, n)
+	for i, v := range ipiv {
+		v++ // Transform to one-indexed.
+		if v != int(lapacke.Int(v)) {
+			panic(Error{Routine: "Sgetrs", Param: "ipiv", Message: "lapack: ipiv element out of range"})
+		}
+		ipiv32[i] = lapacke.Int(v)
+	}
+	lapacke.Sgetrs(byte(trans), n, nrhs, a, lda, ipiv32, b, ldb)
+}
+
+// Sgetri is the float32 version of Dgetri.
+func (impl Implementation) Sgetri(n int, a []float32, lda int, ipiv []int, work []float32, lwork int) (ok bool) {
+	iws := max(1, n)
+	switch {
+	case n < 0:
+		panic(Error{Routine: "Sgetri", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Sgetri", Param: "lda", Message: badLdA})
+	case lwork < iws && lwork != -1:
+		panic(Error{Routine: "Sgetri", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Sgetri", Param: "work", Message: shortWork})
+	}
+
+	if n == 0 {
+		work[0] = 1
+		return true
+	}
+
+	if lwork == -1 {
+		return lapacke.Sgetri(n, a, lda, nil, work, -1)
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Sgetri", Param: "a", Message: shortA})
+	case len(ipiv) != n:
+		panic(Error{Routine: "Sgetri", Param: "ipiv", Message: badLenIpiv})
+	}
+
+	ipiv32 := make([]lapacke.Int, n)
+	for i, v := range ipiv {
+		v++ // Transform to one-indexed.
+		if v != int(lapacke.Int(v)) {
+			panic(Error{Routine: "Sgetri", Param: "ipiv", Message: "lapack: ipiv element out of range"})
+		}
+		ipiv32[i] = lapacke.Int(v)
+	}
+	return lapacke.Sgetri(n, a, lda, ipiv32, work, lwork)
+}
+
+// Spotrf is the float32 version of Dpotrf.
+func (impl Implementation) Spotrf(ul blas.Uplo, n int, a []float32, lda int) (ok bool) {
+	switch {
+	case ul != blas.Upper && ul != blas.Lower:
+		panic(Error{Routine: "Spotrf", Param: "ul", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Spotrf", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Spotrf", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	if len(a) < (n-1)*lda+n {
+		panic(Error{Routine: "Spotrf", Param: "a", Message: shortA})
+	}
+
+	return lapacke.Spotrf(byte(ul), n, a, lda)
+}
+
+// Spotrs is the float32 version of Dpotrs.
+func (impl Implementation) Spotrs(uplo blas.Uplo, n, nrhs int, a []float32, lda int, b []float32, ldb int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Spotrs", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Spotrs", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Spotrs", Param: "nrhs", Message: nrhsLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Spotrs", Param: "lda", Message: badLdA})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Spotrs", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Spotrs", Param: "a", Message: shortA})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Spotrs", Param: "b", Message: shortB})
+	}
+
+	lapacke.Spotrs(byte(uplo), n, nrhs, a, lda, b, ldb)
+}
+
+// Spotri is the float32 version of Dpotri.
+func (impl Implementation) Spotri(uplo blas.Uplo, n int, a []float32, lda int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Spotri", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Spotri", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Spotri", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	if len(a) < (n-1)*lda+n {
+		panic(Error{Routine: "Spotri", Param: "a", Message: shortA})
+	}
+
+	return lapacke.Spotri(byte(uplo), n, a, lda)
+}
+
+// Strtri is the float32 version of Dtrtri.
+func (impl Implementation) Strtri(uplo blas.Uplo, diag blas.Diag, n int, a []float32, lda int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Strtri", Param: "uplo", Message: badUplo})
+	case diag != blas.NonUnit && diag != blas.Unit:
+		panic(Error{Routine: "Strtri", Param: "diag", Message: badDiag})
+	case n < 0:
+		panic(Error{Routine: "Strtri", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Strtri", Param: "lda", Message: badLdA})
+	}
+
+	if n == 0 {
+		return true
+	}
+
+	if len(a) < (n-1)*lda+n {
+		panic(Error{Routine: "Strtri", Param: "a", Message: shortA})
+	}
+
+	return lapacke.Strtri(byte(uplo), byte(diag), n, a, lda)
+}
+
+// Strtrs is the float32 version of Dtrtrs.
+func (impl Implementation) Strtrs(uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, n, nrhs int, a []float32, lda int, b []float32, ldb int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Strtrs", Param: "uplo", Message: badUplo})
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(Error{Routine: "Strtrs", Param: "trans", Message: badTrans})
+	case diag != blas.NonUnit && diag != blas.Unit:
+		panic(Error{Routine: "Strtrs", Param: "diag", Message: badDiag})
+	case n < 0:
+		panic(Error{Routine: "Strtrs", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Strtrs", Param: "nrhs", Message: nrhsLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Strtrs", Param: "lda", Message: badLdA})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Strtrs", Param: "ldb", Message: badLdB})
+	}
+
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Strtrs", Param: "a", Message: shortA})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Strtrs", Param: "b", Message: shortB})
+	}
+
+	return lapacke.Strtrs(byte(uplo), byte(trans), byte(diag), n, nrhs, a, lda, b, ldb)
+}
+
+// Sgeqrf is the float32 version of Dgeqrf.
+func (impl Implementation) Sgeqrf(m, n int, a []float32, lda int, tau, work []float32, lwork int) {
+	switch {
+	case m < 0:
+		panic(Error{Routine: "Sgeqrf", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Sgeqrf", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Sgeqrf", Param: "lda", Message: badLdA})
+	case lwork < max(1, n) && lwork != -1:
+		panic(Error{Routine: "Sgeqrf", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Sgeqrf", Param: "work", Message: shortWork})
+	}
+
+	// Quick return if possible.
+	k := min(m, n)
+	if k == 0 {
+		work[0] = 1
+		return
+	}
+
+	if lwork == -1 {
+		lapacke.Sgeqrf(m, n, a, lda, tau, work, -1)
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(Error{Routine: "Sgeqrf", Param: "a", Message: shortA})
+	case len(tau) < k:
+		panic(Error{Routine: "Sgeqrf", Param: "tau", Message: shortTau})
+	}
+
+	lapacke.Sgeqrf(m, n, a, lda, tau, work, lwork)
+}
+
+// Sgeqp3 is the float32 version of Dgeqp3. jpvt is zero-indexed.
+func (impl Implementation) Sgeqp3(m, n int, a []float32, lda int, jpvt []int, tau, work []float32, lwork int) {
+	minmn := min(m, n)
+	iws := 3*n + 1
+	if minmn == 0 {
+		iws = 1
+	}
+	switch {
+	case m < 0:
+		panic(Error{Routine: "Sgeqp3", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Sgeqp3", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Sgeqp3", Param: "lda", Message: badLdA})
+	case lwork < iws && lwork != -1:
+		panic(Error{Routine: "Sgeqp3", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Sgeqp3", Param: "work", Message: shortWork})
+	}
+
+	// Quick return if possible.
+	if minmn == 0 {
+		work[0] = 1
+		return
+	}
+
+	// Don't update jpvt if querying lwkopt.
+	if lwork == -1 {
+		lapacke.Sgeqp3(m, n, a, lda, nil, nil, work, -1)
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(Error{Routine: "Sgeqp3", Param: "a", Message: shortA})
+	case len(jpvt) != n:
+		panic(Error{Routine: "Sgeqp3", Param: "jpvt", Message: badLenJpvt})
+	case len(tau) < minmn:
+		panic(Error{Routine: "Sgeqp3", Param: "tau", Message: shortTau})
+	}
+
+	jpvt32 := make([]lapacke.Int, n)
+	for i, v := range jpvt {
+		v++
+		if v != int(lapacke.Int(v)) || v < 0 || n < v {
+			panic(Error{Routine: "Sgeqp3", Param: "jpvt", Message: badJpvt})
+		}
+		jpvt32[i] = lapacke.Int(v)
+	}
+	lapacke.Sgeqp3(m, n, a, lda, jpvt32, tau, work, lwork)
+	for i, v := range jpvt32 {
+		jpvt[i] = int(v - 1)
+	}
+}
+
+// Sorgqr is the float32 version of Dorgqr.
+func (impl Implementation) Sorgqr(m, n, k int, a []float32, lda int, tau, work []float32, lwork int) {
+	switch {
+	case m < 0:
+		panic(Error{Routine: "Sorgqr", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Sorgqr", Param: "n", Message: nLT0})
+	case n > m:
+		panic(Error{Routine: "Sorgqr", Param: "n", Message: nGTM})
+	case k < 0:
+		panic(Error{Routine: "Sorgqr", Param: "k", Message: kLT0})
+	case k > n:
+		panic(Error{Routine: "Sorgqr", Param: "k", Message: kGTN})
+	case lda < max(1, n):
+		panic(Error{Routine: "Sorgqr", Param: "lda", Message: badLdA})
+	case lwork < max(1, n) && lwork != -1:
+		panic(Error{Routine: "Sorgqr", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Sorgqr", Param: "work", Message: shortWork})
+	}
+
+	if n == 0 {
+		work[0] = 1
+		return
+	}
+
+	if lwork == -1 {
+		lapacke.Sorgqr(m, n, k, a, lda, tau, work, -1)
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(Error{Routine: "Sorgqr", Param: "a", Message: shortA})
+	case len(tau) < k:
+		panic(Error{Routine: "Sorgqr", Param: "tau", Message: shortTau})
+	}
+
+	lapacke.Sorgqr(m, n, k, a, lda, tau, work, lwork)
+}
+
+// Sormqr is the float32 version of Dormqr.
+func (impl Implementation) Sormqr(side blas.Side, trans blas.Transpose, m, n, k int, a []float32, lda int, tau, c []float32, ldc int, work []float32, lwork int) {
+	left := side == blas.Left
+	nq := n
+	nw := m
+	if left {
+		nq = m
+		nw = n
+	}
+	switch {
+	case !left && side != blas.Right:
+		panic(Error{Routine: "Sormqr", Param: "side", Message: badSide})
+	case trans != blas.NoTrans && trans != blas.Trans:
+		panic(Error{Routine: "Sormqr", Param: "trans", Message: badTrans})
+	case m < 0:
+		panic(Error{Routine: "Sormqr", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Sormqr", Param: "n", Message: nLT0})
+	case k < 0:
+		panic(Error{Routine: "Sormqr", Param: "k", Message: kLT0})
+	case left && k > m:
+		panic(Error{Routine: "Sormqr", Param: "k", Message: kGTM})
+	case !left && k > n:
+		panic(Error{Routine: "Sormqr", Param: "k", Message: kGTN})
+	case lda < max(1, k):
+		panic(Error{Routine: "Sormqr", Param: "lda", Message: badLdA})
+	case ldc < max(1, n):
+		panic(Error{Routine: "Sormqr", Param: "ldc", Message: badLdC})
+	case lwork < max(1, nw) && lwork != -1:
+		panic(Error{Routine: "Sormqr", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Sormqr", Param: "work", Message: shortWork})
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 || k == 0 {
+		work[0] = 1
+		return
+	}
+
+	if lwork == -1 {
+		lapacke.Sormqr(byte(side), byte(trans), m, n, k, a, lda, tau, c, ldc, work, -1)
+		return
+	}
+
+	switch {
+	case len(a) < (nq-1)*lda+k:
+		panic(Error{Routine: "Sormqr", Param: "a", Message: shortA})
+	case len(tau) != k:
+		panic(Error{Routine: "Sormqr", Param: "tau", Message: badLenTau})
+	case len(c) < (m-1)*ldc+n:
+		panic(Error{Routine: "Sormqr", Param: "c", Message: shortC})
+	}
+
+	lapacke.Sormqr(byte(side), byte(trans), m, n, k, a, lda, tau, c, ldc, work, lwork)
+}
+
+// Sgels is the float32 version of Dgels.
+func (impl Implementation) Sgels(trans blas.Transpose, m, n, nrhs int, a []float32, lda int, b []float32, ldb int, work []float32, lwork int) bool {
+	mn := min(m, n)
+	minwrk := mn + max(mn, nrhs)
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(Error{Routine: "Sgels", Param: "trans", Message: badTrans})
+	case m < 0:
+		panic(Error{Routine: "Sgels", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Sgels", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Sgels", Param: "nrhs", Message: nrhsLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Sgels", Param: "lda", Message: badLdA})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Sgels", Param: "ldb", Message: badLdB})
+	case lwork < max(1, minwrk) && lwork != -1:
+		panic(Error{Routine: "Sgels", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Sgels", Param: "work", Message: shortWork})
+	}
+
+	// Quick return if possible.
+	if mn == 0 || nrhs == 0 {
+		for i := 0; i < max(m, n); i++ {
+			for j := 0; j < nrhs; j++ {
+				b[i*ldb+j] = 0
+			}
+		}
+		work[0] = 1
+		return true
+	}
+
+	if lwork == -1 {
+		return lapacke.Sgels(byte(trans), m, n, nrhs, a, lda, b, ldb, work, -1)
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(Error{Routine: "Sgels", Param: "a", Message: shortA})
+	case len(b) < (max(m, n)-1)*ldb+nrhs:
+		panic(Error{Routine: "Sgels", Param: "b", Message: shortB})
+	}
+
+	return lapacke.Sgels(byte(trans), m, n, nrhs, a, lda, b, ldb, work, lwork)
+}
+
+// Sgelsd is the float32 version of Dgelsd.
+func (impl Implementation) Sgelsd(m, n, nrhs int, a []float32, lda int, b []float32, ldb int, s []float32, rcond float32, work []float32, lwork int, iwork []int) (rank int, ok bool) {
+	const smlsiz = 25
+	mn := min(m, n)
+	var nlvl int
+	if mn > 0 {
+		nlvl = max(0, int(math.Log2(float64(mn)/(smlsiz+1)))+1)
+	}
+	minwrk, liwork := 1, 1
+	if mn > 0 {
+		wlalsd := 9*mn + 2*mn*smlsiz + 8*mn*nlvl + mn*nrhs + (smlsiz+1)*(smlsiz+1)
+		minwrk = 3*mn + max(max(m, n), max(nrhs, wlalsd))
+		liwork = max(1, 3*mn*nlvl+11*mn)
+	}
+	switch {
+	case m < 0:
+		panic(Error{Routine: "Sgelsd", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Sgelsd", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Sgelsd", Param: "nrhs", Message: nrhsLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Sgelsd", Param: "lda", Message: badLdA})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Sgelsd", Param: "ldb", Message: badLdB})
+	case lwork < minwrk && lwork != -1:
+		panic(Error{Routine: "Sgelsd", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Sgelsd", Param: "work", Message: shortWork})
+	case len(iwork) < liwork:
+		panic(Error{Routine: "Sgelsd", Param: "iwork", Message: shortIWork})
+	}
+
+	// Quick return if possible.
+	if mn == 0 || nrhs == 0 {
+		if lwork == -1 {
+			work[0] = float32(minwrk)
+			iwork[0] = liwork
+			return 0, true
+		}
+		for i := 0; i < max(m, n); i++ {
+			for j := 0; j < nrhs; j++ {
+				b[i*ldb+j] = 0
+			}
+		}
+		return 0, true
+	}
+
+	_rank := []lapacke.Int{0}
+	if lwork == -1 {
+		_iwork := []lapacke.Int{0}
+		ok = lapacke.Sgelsd(m, n, nrhs, a, lda, b, ldb, s, rcond, _rank, work, -1, _iwork)
+		iwork[0] = int(_iwork[0])
+		return 0, ok
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(Error{Routine: "Sgelsd", Param: "a", Message: shortA})
+	case len(b) < (max(m, n)-1)*ldb+nrhs:
+		panic(Error{Routine: "Sgelsd", Param: "b", Message: shortB})
+	case len(s) < mn:
+		panic(Error{Routine: "Sgelsd", Param: "s", Message: shortS})
+	}
+
+	_iwork := make([]lapacke.Int, liwork)
+	ok = lapacke.Sgelsd(m, n, nrhs, a, lda, b, ldb, s, rcond, _rank, work, lwork, _iwork)
+	return int(_rank[0]), ok
+}
+
+// Sgelsy is the float32 version of Dgelsy.
+func (impl Implementation) Sgelsy(m, n, nrhs int, a []float32, lda int, b []float32, ldb int, jpvt []int, rcond float32, work []float32, lwork int) (rank int) {
+	mn := min(m, n)
+	minwrk := 1
+	if mn > 0 {
+		minwrk = max(mn+3*n+1, 2*mn+nrhs)
+	}
+	switch {
+	case m < 0:
+		panic(Error{Routine: "Sgelsy", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Sgelsy", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Sgelsy", Param: "nrhs", Message: nrhsLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Sgelsy", Param: "lda", Message: badLdA})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Sgelsy", Param: "ldb", Message: badLdB})
+	case lwork < minwrk && lwork != -1:
+		panic(Error{Routine: "Sgelsy", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Sgelsy", Param: "work", Message: shortWork})
+	}
+
+	// Quick return if possible.
+	if mn == 0 || nrhs == 0 {
+		if lwork == -1 {
+			work[0] = float32(minwrk)
+			return 0
+		}
+		for i := 0; i < max(m, n); i++ {
+			for j := 0; j < nrhs; j++ {
+				b[i*ldb+j] = 0
+			}
+		}
+		return 0
+	}
+
+	_rank := []lapacke.Int{0}
+	if lwork == -1 {
+		lapacke.Sgelsy(m, n, nrhs, a, lda, b, ldb, nil, rcond, _rank, work, -1)
+		return 0
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(Error{Routine: "Sgelsy", Param: "a", Message: shortA})
+	case len(b) < (max(m, n)-1)*ldb+nrhs:
+		panic(Error{Routine: "Sgelsy", Param: "b", Message: shortB})
+	case len(jpvt) != n:
+		panic(Error{Routine: "Sgelsy", Param: "jpvt", Message: badLenJpvt})
+	}
+
+	jpvt32 := make([]lapacke.Int, n)
+	for i, v := range jpvt {
+		v++
+		if v != int(lapacke.Int(v)) || v < 0 || n < v {
+			panic(Error{Routine: "Sgelsy", Param: "jpvt", Message: badJpvt})
+		}
+		jpvt32[i] = lapacke.Int(v)
+	}
+	lapacke.Sgelsy(m, n, nrhs, a, lda, b, ldb, jpvt32, rcond, _rank, work, lwork)
+	for i, v := range jpvt32 {
+		jpvt[i] = int(v - 1)
+	}
+	return int(_rank[0])
+}
+
+// Sgetsls is the float32 version of Dgetsls.
+func (impl Implementation) Sgetsls(trans blas.Transpose, m, n, nrhs int, a []float32, lda int, b []float32, ldb int, work []float32, lwork int) bool {
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans:
+		panic(Error{Routine: "Sgetsls", Param: "trans", Message: badTrans})
+	case m < 0:
+		panic(Error{Routine: "Sgetsls", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Sgetsls", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Sgetsls", Param: "nrhs", Message: nrhsLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Sgetsls", Param: "lda", Message: badLdA})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Sgetsls", Param: "ldb", Message: badLdB})
+	case lwork < 1 && lwork != -1:
+		panic(Error{Routine: "Sgetsls", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Sgetsls", Param: "work", Message: shortWork})
+	}
+
+	// Quick return if possible.
+	if min(m, n) == 0 || nrhs == 0 {
+		if lwork == -1 {
+			work[0] = 1
+			return true
+		}
+		for i := 0; i < max(m, n); i++ {
+			for j := 0; j < nrhs; j++ {
+				b[i*ldb+j] = 0
+			}
+		}
+		return true
+	}
+
+	if lwork == -1 {
+		return lapacke.Sgetsls(byte(trans), m, n, nrhs, a, lda, b, ldb, work, -1)
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(Error{Routine: "Sgetsls", Param: "a", Message: shortA})
+	case len(b) < (max(m, n)-1)*ldb+nrhs:
+		panic(Error{Routine: "Sgetsls", Param: "b", Message: shortB})
+	}
+
+	return lapacke.Sgetsls(byte(trans), m, n, nrhs, a, lda, b, ldb, work, lwork)
+}
+
+// Sgesvd is the float32 version of Dgesvd.
+func (impl Implementation) Sgesvd(jobU, jobVT lapack.SVDJob, m, n int, a []float32, lda int, s, u []float32, ldu int, vt []float32, ldvt int, work []float32, lwork int) (ok bool) {
+	wantua := jobU == lapack.SVDAll
+	wantus := jobU == lapack.SVDStore
+	wantuo := jobU == lapack.SVDOverwrite
+	wantun := jobU == lapack.SVDNone
+	if !(wantua || wantus || wantuo || wantun) {
+		panic(Error{Routine: "Sgesvd", Param: "jobU", Message: badSVDJob})
+	}
+
+	wantva := jobVT == lapack.SVDAll
+	wantvs := jobVT == lapack.SVDStore
+	wantvas := wantva || wantvs
+	wantvo := jobVT == lapack.SVDOverwrite
+	wantvn := jobVT == lapack.SVDNone
+	if !(wantva || wantvs || wantvo || wantvn) {
+		panic(Error{Routine: "Sgesvd", Param: "jobVT", Message: badSVDJob})
+	}
+
+	if wantuo && wantvo {
+		panic(Error{Routine: "Sgesvd", Param: "", Message: bothSVDOver})
+	}
+
+	minmn := min(m, n)
+	minwork := 1
+	if minmn > 0 {
+		minwork = max(3*minmn+max(m, n), 5*minmn)
+	}
+	switch {
+	case m < 0:
+		panic(Error{Routine: "Sgesvd", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Sgesvd", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Sgesvd", Param: "lda", Message: badLdA})
+	case ldu < 1, wantua && ldu < m, wantus && ldu < minmn:
+		panic(Error{Routine: "Sgesvd", Param: "ldu", Message: badLdU})
+	case ldvt < 1 || (wantvas && ldvt < n):
+		panic(Error{Routine: "Sgesvd", Param: "ldvt", Message: badLdVT})
+	case lwork < minwork && lwork != -1:
+		panic(Error{Routine: "Sgesvd", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Sgesvd", Param: "work", Message: shortWork})
+	}
+
+	// Quick return if possible.
+	if minmn == 0 {
+		work[0] = 1
+		return true
+	}
+
+	if lwork == -1 {
+		return lapacke.Sgesvd(byte(jobU), byte(jobVT), m, n, a, lda, s, u, ldu, vt, ldvt, work, -1)
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(Error{Routine: "Sgesvd", Param: "a", Message: shortA})
+	case len(s) < minmn:
+		panic(Error{Routine: "Sgesvd", Param: "s", Message: shortS})
+	case (len(u) < (m-1)*ldu+m && wantua) || (len(u) < (m-1)*ldu+minmn && wantus):
+		panic(Error{Routine: "Sgesvd", Param: "u", Message: shortU})
+	case (len(vt) < (n-1)*ldvt+n && wantva) || (len(vt) < (minmn-1)*ldvt+n && wantvs):
+		panic(Error{Routine: "Sgesvd", Param: "vt", Message: shortVT})
+	}
+
+	return lapacke.Sgesvd(byte(jobU), byte(jobVT), m, n, a, lda, s, u, ldu, vt, ldvt, work, lwork)
+}
+
+// Ssyev is the float32 version of Dsyev.
+func (impl Implementation) Ssyev(jobz lapack.EVJob, uplo blas.Uplo, n int, a []float32, lda int, w, work []float32, lwork int) (ok bool) {
+	switch {
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(Error{Routine: "Ssyev", Param: "jobz", Message: badEVJob})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Ssyev", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Ssyev", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Ssyev", Param: "lda", Message: badLdA})
+	case lwork < max(1, 3*n-1) && lwork != -1:
+		panic(Error{Routine: "Ssyev", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Ssyev", Param: "work", Message: shortWork})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	if lwork == -1 {
+		return lapacke.Ssyev(byte(jobz), byte(uplo), n, a, lda, w, work, -1)
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Ssyev", Param: "a", Message: shortA})
+	case len(w) < n:
+		panic(Error{Routine: "Ssyev", Param: "w", Message: shortW})
+	}
+
+	return lapacke.Ssyev(byte(jobz), byte(uplo), n, a, lda, w, work, lwork)
+}
+
+// Sgesv computes the solution of the system of linear equations
+//
+//	A * X = B
+//
+// for the n×n matrix A and the n×nrhs matrix B using the LU factorization
+// with partial pivoting computed by Sgetrf. On return, a contains the
+// factors L and U, ipiv the zero-indexed pivot indices and b the solution X.
+//
+// Sgesv returns whether A is nonsingular. If it is singular, the
+// factorization is completed but X is not computed.
+func (impl Implementation) Sgesv(n, nrhs int, a []float32, lda int, ipiv []int, b []float32, ldb int) (ok bool) {
+	switch {
+	case n < 0:
+		panic(Error{Routine: "Sgesv", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Sgesv", Param: "nrhs", Message: nrhsLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Sgesv", Param: "lda", Message: badLdA})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Sgesv", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Sgesv", Param: "a", Message: shortA})
+	case len(ipiv) != n:
+		panic(Error{Routine: "Sgesv", Param: "ipiv", Message: badLenIpiv})
+	case nrhs > 0 && len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Sgesv", Param: "b", Message: shortB})
+	}
+
+	ipiv32 := make([]lapacke.Int, n)
+	ok = lapacke.Sgesv(n, nrhs, a, lda, ipiv32, b, ldb)
+	for i, v := range ipiv32 {
+		ipiv[i] = int(v) - 1 // Transform to zero-indexed.
+	}
+	return ok
+}
+
+// Sgesdd computes the singular value decomposition of the m×n matrix A
+//
+//	A = U * Σ * V^T
+//
+// using the divide and conquer method. jobz selects the singular vectors
+// that are computed:
+//   - lapack.SVDAll: all m columns of U and all n rows of V^T are returned
+//     in u and vt.
+//   - lapack.SVDStore: the first min(m,n) columns of U and rows of V^T are
+//     returned in u and vt.
+//   - lapack.SVDOverwrite: if m >= n, the first n columns of U overwrite a
+//     and V^T is returned in vt, otherwise U is returned in u and the first
+//     m rows of V^T overwrite a.
+//   - lapack.SVDNone: no singular vectors are computed.
+//
+// The singular values are returned in descending order in s. iwork must
+// have length at least 8*min(m,n). lwork must be -1 or at least the minimum
+// workspace documented for the LAPACK routine, and if lwork == -1 the
+// optimal work length is stored into work[0].
+//
+// Sgesdd returns whether the decomposition successfully completed.
+func (impl Implementation) Sgesdd(jobz lapack.SVDJob, m, n int, a []float32, lda int, s, u []float32, ldu int, vt []float32, ldvt int, work []float32, lwork int, iwork []int) (ok bool) {
+	mn := min(m, n)
+	mx := max(m, n)
+	var minwork, ucol, vtrow int
+	switch jobz {
+	case lapack.SVDAll:
+		minwork = 4*mn*mn + 6*mn + mx
+		ucol, vtrow = m, n
+	case lapack.SVDStore:
+		minwork = 4*mn*mn + 6*mn + mx
+		ucol, vtrow = mn, mn
+	case lapack.SVDOverwrite:
+		minwork = 3*mn + max(mx, 5*mn*mn+4*mn)
+		if m >= n {
+			vtrow = n
+		} else {
+			ucol = m
+		}
+	case lapack.SVDNone:
+		minwork = 3*mn + max(mx, 7*mn)
+	default:
+		panic(Error{Routine: "Sgesdd", Param: "jobz", Message: badSVDJob})
+	}
+	if mn == 0 {
+		minwork = 1
+	}
+	switch {
+	case m < 0:
+		panic(Error{Routine: "Sgesdd", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Sgesdd", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Sgesdd", Param: "lda", Message: badLdA})
+	case ldu < max(1, ucol):
+		panic(Error{Routine: "Sgesdd", Param: "ldu", Message: badLdU})
+	case ldvt < 1 || (vtrow > 0 && ldvt < n):
+		panic(Error{Routine: "Sgesdd", Param: "ldvt", Message: badLdVT})
+	case lwork < minwork && lwork != -1:
+		panic(Error{Routine: "Sgesdd", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Sgesdd", Param: "work", Message: shortWork})
+	}
+
+	// Quick return if possible.
+	if mn == 0 {
+		work[0] = 1
+		return true
+	}
+
+	iwork32 := make([]lapacke.Int, 8*mn)
+	if lwork == -1 {
+		return lapacke.Sgesdd(byte(jobz), m, n, a, lda, s, u, ldu, vt, ldvt, work, -1, iwork32)
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(Error{Routine: "Sgesdd", Param: "a", Message: shortA})
+	case len(s) < mn:
+		panic(Error{Routine: "Sgesdd", Param: "s", Message: shortS})
+	case ucol > 0 && len(u) < (m-1)*ldu+ucol:
+		panic(Error{Routine: "Sgesdd", Param: "u", Message: shortU})
+	case vtrow > 0 && len(vt) < (vtrow-1)*ldvt+n:
+		panic(Error{Routine: "Sgesdd", Param: "vt", Message: shortVT})
+	case len(iwork) < 8*mn:
+		panic(Error{Routine: "Sgesdd", Param: "iwork", Message: shortIWork})
+	}
+
+	return lapacke.Sgesdd(byte(jobz), m, n, a, lda, s, u, ldu, vt, ldvt, work, lwork, iwork32)
+}
+
+// Sgbsv is the float32 version of Dgbsv. ipiv is zero-indexed.
+func (impl Implementation) Sgbsv(n, kl, ku, nrhs int, ab []float32, ldab int, ipiv []int, b []float32, ldb int) (ok bool) {
+	switch {
+	case n < 0:
+		panic(Error{Routine: "Sgbsv", Param: "n", Message: nLT0})
+	case kl < 0:
+		panic(Error{Routine: "Sgbsv", Param: "kl", Message: klLT0})
+	case ku < 0:
+		panic(Error{Routine: "Sgbsv", Param: "ku", Message: kuLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Sgbsv", Param: "nrhs", Message: nrhsLT0})
+	case ldab < 2*kl+ku+1:
+		panic(Error{Routine: "Sgbsv", Param: "ldab", Message: badLdA})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Sgbsv", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+2*kl+ku+1:
+		panic(Error{Routine: "Sgbsv", Param: "ab", Message: shortAB})
+	case len(ipiv) != n:
+		panic(Error{Routine: "Sgbsv", Param: "ipiv", Message: badLenIpiv})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Sgbsv", Param: "b", Message: shortB})
+	}
+
+	// The first kl rows of the LAPACKE band storage are workspace for the
+	// fill-in.
+	_ldab := n
+	_ab := make([]float32, (2*kl+ku+1)*_ldab)
+	bandGenToLapacke32(n, kl, ku, ab, ldab, _ab[kl*_ldab:], _ldab)
+	ipiv32 := make([]lapacke.Int, n)
+	ok = lapacke.Sgbsv(n, kl, ku, nrhs, _ab, _ldab, ipiv32, b, ldb)
+	bandGenToGonum32(n, kl, kl+ku, _ab, _ldab, ab, ldab)
+	for i, v := range ipiv32 {
+		ipiv[i] = int(v) - 1 // Transform to zero-indexed.
+	}
+	return ok
+}
+
+// Sgbtrf is the float32 version of Dgbtrf. ipiv is zero-indexed.
+func (impl Implementation) Sgbtrf(m, n, kl, ku int, ab []float32, ldab int, ipiv []int) (ok bool) {
+	mn := min(m, n)
+	switch {
+	case m < 0:
+		panic(Error{Routine: "Sgbtrf", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Sgbtrf", Param: "n", Message: nLT0})
+	case kl < 0:
+		panic(Error{Routine: "Sgbtrf", Param: "kl", Message: klLT0})
+	case ku < 0:
+		panic(Error{Routine: "Sgbtrf", Param: "ku", Message: kuLT0})
+	case ldab < 2*kl+ku+1:
+		panic(Error{Routine: "Sgbtrf", Param: "ldab", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if mn == 0 {
+		return true
+	}
+
+	// Rows below n+kl have no elements in the band.
+	rows := min(m, n+kl)
+	switch {
+	case len(ab) < (rows-1)*ldab+2*kl+ku+1:
+		panic(Error{Routine: "Sgbtrf", Param: "ab", Message: shortAB})
+	case len(ipiv) != mn:
+		panic(Error{Routine: "Sgbtrf", Param: "ipiv", Message: badLenIpiv})
+	}
+
+	// Convert to the LAPACKE band storage of the m×n matrix with kl+ku
+	// super-diagonals, the first kl of which are workspace on entry.
+	kv := kl + ku
+	_ldab := n
+	_ab := make([]float32, (kl+kv+1)*_ldab)
+	for i := 0; i < rows; i++ {
+		for j := max(0, i-kl); j < min(n, i+ku+1); j++ {
+			_ab[(kv+i-j)*_ldab+j] = ab[i*ldab+kl+j-i]
+		}
+	}
+	ipiv32 := make([]lapacke.Int, mn)
+	ok = lapacke.Sgbtrf(m, n, kl, ku, _ab, _ldab, ipiv32)
+	for i := 0; i < rows; i++ {
+		for j := max(0, i-kl); j < min(n, i+kv+1); j++ {
+			ab[i*ldab+kl+j-i] = _ab[(kv+i-j)*_ldab+j]
+		}
+	}
+	for i, v := range ipiv32 {
+		ipiv[i] = int(v) - 1 // Transform to zero-indexed.
+	}
+	return ok
+}
+
+// Sgbtrs is the float32 version of Dgbtrs. ipiv is zero-indexed.
+func (impl Implementation) Sgbtrs(trans blas.Transpose, n, kl, ku, nrhs int, ab []float32, ldab int, ipiv []int, b []float32, ldb int) {
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(Error{Routine: "Sgbtrs", Param: "trans", Message: badTrans})
+	case n < 0:
+		panic(Error{Routine: "Sgbtrs", Param: "n", Message: nLT0})
+	case kl < 0:
+		panic(Error{Routine: "Sgbtrs", Param: "kl", Message: klLT0})
+	case ku < 0:
+		panic(Error{Routine: "Sgbtrs", Param: "ku", Message: kuLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Sgbtrs", Param: "nrhs", Message: nrhsLT0})
+	case ldab < 2*kl+ku+1:
+		panic(Error{Routine: "Sgbtrs", Param: "ldab", Message: badLdA})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Sgbtrs", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+2*kl+ku+1:
+		panic(Error{Routine: "Sgbtrs", Param: "ab", Message: shortAB})
+	case len(ipiv) != n:
+		panic(Error{Routine: "Sgbtrs", Param: "ipiv", Message: badLenIpiv})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Sgbtrs", Param: "b", Message: shortB})
+	}
+
+	_ldab := n
+	_ab := make([]float32, (2*kl+ku+1)*_ldab)
+	bandGenToLapacke32(n, kl, kl+ku, ab, ldab, _ab, _ldab)
+	ipiv32 := make([]lapacke.Int, n)
+	for i, v := range ipiv {
+		v++ // Transform to one-indexed.
+		if v != int(lapacke.Int(v)) {
+			panic(Error{Routine: "Sgbtrs", Param: "ipiv", Message: "lapack: ipiv element out of range"})
+		}
+		ipiv32[i] = lapacke.Int(v)
+	}
+	lapacke.Sgbtrs(byte(trans), n, kl, ku, nrhs, _ab, _ldab, ipiv32, b, ldb)
+}
+
+// Spbsv is the float32 version of Dpbsv.
+func (impl Implementation) Spbsv(uplo blas.Uplo, n, kd, nrhs int, ab []float32, ldab int, b []float32, ldb int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Spbsv", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Spbsv", Param: "n", Message: nLT0})
+	case kd < 0:
+		panic(Error{Routine: "Spbsv", Param: "kd", Message: kdLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Spbsv", Param: "nrhs", Message: nrhsLT0})
+	case ldab < kd+1:
+		panic(Error{Routine: "Spbsv", Param: "ldab", Message: badLdA})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Spbsv", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(ab) < (n-1)*ldab+kd+1:
+		panic(Error{Routine: "Spbsv", Param: "ab", Message: shortAB})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Spbsv", Param: "b", Message: shortB})
+	}
+
+	ldabConv := n
+	abConv := make([]float32, (kd+1)*ldabConv)
+	bandTriToLapacke32(uplo, n, kd, ab, ldab, abConv, ldabConv)
+	ok = lapacke.Spbsv(byte(uplo), n, kd, nrhs, abConv, ldabConv, b, ldb)
+	bandTriToGonum32(uplo, n, kd, abConv, ldabConv, ab, ldab)
+	return ok
+}
+
+// Spbtrf is the float32 version of Dpbtrf.
+func (impl Implementation) Spbtrf(uplo blas.Uplo, n, kd int, ab []float32, ldab int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Spbtrf", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Spbtrf", Param: "n", Message: nLT0})
+	case kd < 0:
+		panic(Error{Routine: "Spbtrf", Param: "kd", Message: kdLT0})
+	case ldab < kd+1:
+		panic(Error{Routine: "Spbtrf", Param: "ldab", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	if len(ab) < (n-1)*ldab+kd+1 {
+		panic(Error{Routine: "Spbtrf", Param: "ab", Message: shortAB})
+	}
+
+	ldabConv := n
+	abConv := make([]float32, (kd+1)*ldabConv)
+	bandTriToLapacke32(uplo, n, kd, ab, ldab, abConv, ldabConv)
+	info := lapacke.Spbtrf(byte(uplo), n, kd, abConv, ldabConv)
+	bandTriToGonum32(uplo, n, kd, abConv, ldabConv, ab, ldab)
+	return info
+}
+
+// Spbtrs is the float32 version of Dpbtrs.
+func (impl Implementation) Spbtrs(uplo blas.Uplo, n, kd, nrhs int, ab []float32, ldab int, b []float32, ldb int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Spbtrs", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Spbtrs", Param: "n", Message: nLT0})
+	case kd < 0:
+		panic(Error{Routine: "Spbtrs", Param: "kd", Message: kdLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Spbtrs", Param: "nrhs", Message: nrhsLT0})
+	case ldab < kd+1:
+		panic(Error{Routine: "Spbtrs", Param: "ldab", Message: badLdA})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Spbtrs", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	if len(ab) < (n-1)*ldab+kd {
+		panic(Error{Routine: "Spbtrs", Param: "ab", Message: shortAB})
+	}
+	if len(b) < (n-1)*ldb+nrhs {
+		panic(Error{Routine: "Spbtrs", Param: "b", Message: shortB})
+	}
+
+	ldabConv := n
+	abConv := make([]float32, (kd+1)*ldabConv)
+	bandTriToLapacke32(uplo, n, kd, ab, ldab, abConv, ldabConv)
+	lapacke.Spbtrs(byte(uplo), n, kd, nrhs, abConv, ldabConv, b, ldb)
+}
+
+// Sgtsv is the float32 version of Dgtsv.
+func (impl Implementation) Sgtsv(n, nrhs int, dl, d, du, b []float32, ldb int) (ok bool) {
+	switch {
+	case n < 0:
+		panic(Error{Routine: "Sgtsv", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Sgtsv", Param: "nrhs", Message: nrhsLT0})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Sgtsv", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(dl) < n-1:
+		panic(Error{Routine: "Sgtsv", Param: "dl", Message: shortDL})
+	case len(d) < n:
+		panic(Error{Routine: "Sgtsv", Param: "d", Message: shortD})
+	case len(du) < n-1:
+		panic(Error{Routine: "Sgtsv", Param: "du", Message: shortDU})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Sgtsv", Param: "b", Message: shortB})
+	}
+
+	return lapacke.Sgtsv(n, nrhs, dl, d, du, b, ldb)
+}
+
+// Sgttrf is the float32 version of Dgttrf. ipiv is zero-indexed.
+func (impl Implementation) Sgttrf(n int, dl, d, du, du2 []float32, ipiv []int) (ok bool) {
+	if n < 0 {
+		panic(Error{Routine: "Sgttrf", Param: "n", Message: nLT0})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(dl) < n-1:
+		panic(Error{Routine: "Sgttrf", Param: "dl", Message: shortDL})
+	case len(d) < n:
+		panic(Error{Routine: "Sgttrf", Param: "d", Message: shortD})
+	case len(du) < n-1:
+		panic(Error{Routine: "Sgttrf", Param: "du", Message: shortDU})
+	case len(du2) < n-2:
+		panic(Error{Routine: "Sgttrf", Param: "du2", Message: shortDU2})
+	case len(ipiv) != n:
+		panic(Error{Routine: "Sgttrf", Param: "ipiv", Message: badLenIpiv})
+	}
+
+	ipiv32 := make([]lapacke.Int, n)
+	ok = lapacke.Sgttrf(n, dl, d, du, du2, ipiv32)
+	for i, v := range ipiv32 {
+		ipiv[i] = int(v) - 1 // Transform to zero-indexed.
+	}
+	return ok
+}
+
+// Sgttrs is the float32 version of Dgttrs. ipiv is zero-indexed.
+func (impl Implementation) Sgttrs(trans blas.Transpose, n, nrhs int, dl, d, du, du2 []float32, ipiv []int, b []float32, ldb int) {
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(Error{Routine: "Sgttrs", Param: "trans", Message: badTrans})
+	case n < 0:
+		panic(Error{Routine: "Sgttrs", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Sgttrs", Param: "nrhs", Message: nrhsLT0})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Sgttrs", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(dl) < n-1:
+		panic(Error{Routine: "Sgttrs", Param: "dl", Message: shortDL})
+	case len(d) < n:
+		panic(Error{Routine: "Sgttrs", Param: "d", Message: shortD})
+	case len(du) < n-1:
+		panic(Error{Routine: "Sgttrs", Param: "du", Message: shortDU})
+	case len(du2) < n-2:
+		panic(Error{Routine: "Sgttrs", Param: "du2", Message: shortDU2})
+	case len(ipiv) != n:
+		panic(Error{Routine: "Sgttrs", Param: "ipiv", Message: badLenIpiv})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Sgttrs", Param: "b", Message: shortB})
+	}
+
+	ipiv32 := make([]lapacke.Int, n)
+	for i, v := range ipiv {
+		v++ // Transform to one-indexed.
+		if v != int(lapacke.Int(v)) {
+			panic(Error{Routine: "Sgttrs", Param: "ipiv", Message: "lapack: ipiv element out of range"})
+		}
+		ipiv32[i] = lapacke.Int(v)
+	}
+	lapacke.Sgttrs(byte(trans), n, nrhs, dl, d, du, du2, ipiv32, b, ldb)
+}
+
+// Sptsv is the float32 version of Dptsv.
+func (impl Implementation) Sptsv(n, nrhs int, d, e, b []float32, ldb int) (ok bool) {
+	switch {
+	case n < 0:
+		panic(Error{Routine: "Sptsv", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Sptsv", Param: "nrhs", Message: nrhsLT0})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Sptsv", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(Error{Routine: "Sptsv", Param: "d", Message: shortD})
+	case len(e) < n-1:
+		panic(Error{Routine: "Sptsv", Param: "e", Message: shortE})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Sptsv", Param: "b", Message: shortB})
+	}
+
+	return lapacke.Sptsv(n, nrhs, d, e, b, ldb)
+}
+
+// Spttrf is the float32 version of Dpttrf.
+func (impl Implementation) Spttrf(n int, d, e []float32) (ok bool) {
+	if n < 0 {
+		panic(Error{Routine: "Spttrf", Param: "n", Message: nLT0})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(d) < n:
+		panic(Error{Routine: "Spttrf", Param: "d", Message: shortD})
+	case len(e) < n-1:
+		panic(Error{Routine: "Spttrf", Param: "e", Message: shortE})
+	}
+
+	return lapacke.Spttrf(n, d, e)
+}
+
+// Spttrs is the float32 version of Dpttrs.
+func (impl Implementation) Spttrs(n, nrhs int, d, e, b []float32, ldb int) {
+	switch {
+	case n < 0:
+		panic(Error{Routine: "Spttrs", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Spttrs", Param: "nrhs", Message: nrhsLT0})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Spttrs", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(d) < n:
+		panic(Error{Routine: "Spttrs", Param: "d", Message: shortD})
+	case len(e) < n-1:
+		panic(Error{Routine: "Spttrs", Param: "e", Message: shortE})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Spttrs", Param: "b", Message: shortB})
+	}
+
+	lapacke.Spttrs(n, nrhs, d, e, b, ldb)
+}
+
+// Sstemr is the float32 version of Dstemr. isuppz is zero-indexed.
+func (impl Implementation) Sstemr(jobz lapack.EVJob, rng EVRange, n int, d, e []float32, vl, vu float32, il, iu int, w, z []float32, ldz int, isuppz []int, tryrac bool, work []float32, lwork int, iwork []int, liwork int) (m int, rac, ok bool) {
+	minwork, miniwork := max(1, 12*n), max(1, 8*n)
+	if jobz == lapack.EVCompute {
+		minwork, miniwork = max(1, 18*n), max(1, 10*n)
+	}
+	switch {
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(Error{Routine: "Sstemr", Param: "jobz", Message: badEVJob})
+	case rng != EVAll && rng != EVValue && rng != EVIndex:
+		panic(Error{Routine: "Sstemr", Param: "rng", Message: badEVRange})
+	case n < 0:
+		panic(Error{Routine: "Sstemr", Param: "n", Message: nLT0})
+	case rng == EVValue && n > 0 && vu <= vl:
+		panic(Error{Routine: "Sstemr", Param: "vu", Message: badEVInterval})
+	case rng == EVIndex && n > 0 && (il < 0 || n <= il):
+		panic(Error{Routine: "Sstemr", Param: "il", Message: badEVIndex})
+	case rng == EVIndex && n > 0 && (iu < il || n <= iu):
+		panic(Error{Routine: "Sstemr", Param: "iu", Message: badEVIndex})
+	case jobz == lapack.EVCompute && ldz < max(1, n):
+		panic(Error{Routine: "Sstemr", Param: "ldz", Message: badLdZ})
+	case lwork < minwork && lwork != -1:
+		panic(Error{Routine: "Sstemr", Param: "lwork", Message: badLWork})
+	case liwork < miniwork && liwork != -1:
+		panic(Error{Routine: "Sstemr", Param: "liwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Sstemr", Param: "work", Message: shortWork})
+	case len(iwork) < max(1, liwork):
+		panic(Error{Routine: "Sstemr", Param: "iwork", Message: shortIWork})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		if lwork == -1 || liwork == -1 {
+			work[0] = 1
+			iwork[0] = 1
+		}
+		return 0, false, true
+	}
+
+	_m := make([]lapacke.Int, 1)
+	_tryrac := []lapacke.Int{0}
+	if tryrac {
+		_tryrac[0] = 1
+	}
+	if lwork == -1 || liwork == -1 {
+		_iwork := make([]lapacke.Int, 1)
+		ok = lapacke.Sstemr(byte(jobz), byte(rng), n, d, e, vl, vu, il+1, iu+1, _m, w, z, max(1, ldz), n, nil, _tryrac, work, -1, _iwork, -1)
+		iwork[0] = int(_iwork[0])
+		return 0, false, ok
+	}
+
+	switch {
+	case len(d) < n:
+		panic(Error{Routine: "Sstemr", Param: "d", Message: shortD})
+	case len(e) < n:
+		panic(Error{Routine: "Sstemr", Param: "e", Message: shortE})
+	case len(w) < n:
+		panic(Error{Routine: "Sstemr", Param: "w", Message: shortW})
+	case jobz == lapack.EVCompute && len(z) < (n-1)*ldz+n:
+		panic(Error{Routine: "Sstemr", Param: "z", Message: shortZ})
+	case jobz == lapack.EVCompute && len(isuppz) < 2*n:
+		panic(Error{Routine: "Sstemr", Param: "isuppz", Message: shortIsuppz})
+	}
+
+	_isuppz := make([]lapacke.Int, 2*n)
+	_iwork := make([]lapacke.Int, liwork)
+	ok = lapacke.Sstemr(byte(jobz), byte(rng), n, d, e, vl, vu, il+1, iu+1, _m, w, z, max(1, ldz), n, _isuppz, _tryrac, work, lwork, _iwork, liwork)
+	m = int(_m[0])
+	if jobz == lapack.EVCompute {
+		for i, v := range _isuppz[:2*m] {
+			isuppz[i] = int(v - 1)
+		}
+	}
+	return m, _tryrac[0] != 0, ok
+}
+
+// Sgecon is the float32 version of Dgecon.
+func (impl Implementation) Sgecon(norm lapack.MatrixNorm, n int, a []float32, lda int, anorm float32, work []float32, iwork []int) float32 {
+	switch {
+	case norm != lapack.MaxColumnSum && norm != lapack.MaxRowSum:
+		panic(Error{Routine: "Sgecon", Param: "norm", Message: badNorm})
+	case n < 0:
+		panic(Error{Routine: "Sgecon", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Sgecon", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 1
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Sgecon", Param: "a", Message: shortA})
+	case len(work) < 4*n:
+		panic(Error{Routine: "Sgecon", Param: "work", Message: shortWork})
+	case len(iwork) < n:
+		panic(Error{Routine: "Sgecon", Param: "iwork", Message: shortIWork})
+	}
+
+	rcond := []float32{0}
+	_iwork := make([]lapacke.Int, n)
+	lapacke.Sgecon(byte(norm), n, a, lda, anorm, rcond, work, _iwork)
+	return rcond[0]
+}
+
+// Spocon is the float32 version of Dpocon.
+func (impl Implementation) Spocon(uplo blas.Uplo, n int, a []float32, lda int, anorm float32, work []float32, iwork []int) float32 {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Spocon", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Spocon", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Spocon", Param: "lda", Message: badLdA})
+	case anorm < 0:
+		panic(Error{Routine: "Spocon", Param: "anorm", Message: negANorm})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 1
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Spocon", Param: "a", Message: shortA})
+	case len(work) < 3*n:
+		panic(Error{Routine: "Spocon", Param: "work", Message: shortWork})
+	case len(iwork) < n:
+		panic(Error{Routine: "Spocon", Param: "iwork", Message: shortIWork})
+	}
+
+	rcond := []float32{0}
+	_iwork := make([]lapacke.Int, n)
+	lapacke.Spocon(byte(uplo), n, a, lda, anorm, rcond, work, _iwork)
+	return rcond[0]
+}
+
+// Strcon is the float32 version of Dtrcon.
+func (impl Implementation) Strcon(norm lapack.MatrixNorm, uplo blas.Uplo, diag blas.Diag, n int, a []float32, lda int, work []float32, iwork []int) float32 {
+	switch {
+	case norm != lapack.MaxColumnSum && norm != lapack.MaxRowSum:
+		panic(Error{Routine: "Strcon", Param: "norm", Message: badNorm})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Strcon", Param: "uplo", Message: badUplo})
+	case diag != blas.NonUnit && diag != blas.Unit:
+		panic(Error{Routine: "Strcon", Param: "diag", Message: badDiag})
+	case n < 0:
+		panic(Error{Routine: "Strcon", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Strcon", Param: "lda", Message: badLdA})
+	}
+
+	if n == 0 {
+		return 1
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Strcon", Param: "a", Message: shortA})
+	case len(work) < 3*n:
+		panic(Error{Routine: "Strcon", Param: "work", Message: shortWork})
+	case len(iwork) < n:
+		panic(Error{Routine: "Strcon", Param: "iwork", Message: shortIWork})
+	}
+
+	rcond := []float32{0}
+	_iwork := make([]lapacke.Int, n)
+	lapacke.Strcon(byte(norm), byte(uplo), byte(diag), n, a, lda, rcond, work, _iwork)
+	return rcond[0]
+}
+
+// Slaqge is the float32 version of Dlaqge.
+func (impl Implementation) Slaqge(m, n int, a []float32, lda int, r, c []float32, rowcnd, colcnd, amax float32) Equilibration {
+	switch {
+	case m < 0:
+		panic(Error{Routine: "Slaqge", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Slaqge", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Slaqge", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return NoEquilibration
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(Error{Routine: "Slaqge", Param: "a", Message: shortA})
+	case len(r) < m:
+		panic(Error{Routine: "Slaqge", Param: "r", Message: shortR})
+	case len(c) < n:
+		panic(Error{Routine: "Slaqge", Param: "c", Message: shortC})
+	}
+
+	if !lapacke.HasLaq() {
+		return slaqge(m, n, a, lda, r, c, rowcnd, colcnd, amax)
+	}
+	return Equilibration(lapacke.Slaqge(m, n, a, lda, r, c, rowcnd, colcnd, amax))
+}
+
+// Slaqsy is the float32 version of Dlaqsy.
+func (impl Implementation) Slaqsy(uplo blas.Uplo, n int, a []float32, lda int, s []float32, scond, amax float32) (scaled bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Slaqsy", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Slaqsy", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Slaqsy", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return false
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Slaqsy", Param: "a", Message: shortA})
+	case len(s) < n:
+		panic(Error{Routine: "Slaqsy", Param: "s", Message: shortS})
+	}
+
+	if !lapacke.HasLaq() {
+		return slaqsy(uplo, n, a, lda, s, scond, amax)
+	}
+	return lapacke.Slaqsy(byte(uplo), n, a, lda, s, scond, amax) == 'Y'
+}
+
+// Slatrs is the float32 version of Dlatrs. If the library does not provide
+// slatrs, the system is solved in float64 by the implementation in
+// gonum/lapack/gonum.
+func (impl Implementation) Slatrs(uplo blas.Uplo, trans blas.Transpose, diag blas.Diag, normin bool, n int, a []float32, lda int, x []float32, cnorm []float32) (scale float32) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Slatrs", Param: "uplo", Message: badUplo})
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(Error{Routine: "Slatrs", Param: "trans", Message: badTrans})
+	case diag != blas.Unit && diag != blas.NonUnit:
+		panic(Error{Routine: "Slatrs", Param: "diag", Message: badDiag})
+	case n < 0:
+		panic(Error{Routine: "Slatrs", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Slatrs", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 1
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Slatrs", Param: "a", Message: shortA})
+	case len(x) < n:
+		panic(Error{Routine: "Slatrs", Param: "x", Message: shortX})
+	case len(cnorm) < n:
+		panic(Error{Routine: "Slatrs", Param: "cnorm", Message: shortCNorm})
+	}
+
+	if !lapacke.HasLatrs() {
+		a64 := make([]float64, n*n)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				a64[i*n+j] = float64(a[i*lda+j])
+			}
+		}
+		x64 := make([]float64, n)
+		cnorm64 := make([]float64, n)
+		for i := range x64 {
+			x64[i] = float64(x[i])
+			cnorm64[i] = float64(cnorm[i])
+		}
+		s := gonum.Implementation{}.Dlatrs(uplo, trans, diag, normin, n, a64, n, x64, cnorm64)
+		for i := range x64 {
+			x[i] = float32(x64[i])
+			cnorm[i] = float32(cnorm64[i])
+		}
+		return float32(s)
+	}
+	norm := byte('N')
+	if normin {
+		norm = 'Y'
+	}
+	s := []float32{0}
+	lapacke.Slatrs(byte(uplo), byte(trans), byte(diag), norm, n, a, lda, x, s, cnorm)
+	return s[0]
+}
+
+// Strsyl is the float32 version of Dtrsyl.
+func (impl Implementation) Strsyl(trana, tranb blas.Transpose, isgn, m, n int, a []float32, lda int, b []float32, ldb int, c []float32, ldc int) (scale float32, ok bool) {
+	switch {
+	case trana != blas.NoTrans && trana != blas.Trans && trana != blas.ConjTrans:
+		panic(Error{Routine: "Strsyl", Param: "trana", Message: badTrans})
+	case tranb != blas.NoTrans && tranb != blas.Trans && tranb != blas.ConjTrans:
+		panic(Error{Routine: "Strsyl", Param: "tranb", Message: badTrans})
+	case isgn != 1 && isgn != -1:
+		panic(Error{Routine: "Strsyl", Param: "isgn", Message: badIsgn})
+	case m < 0:
+		panic(Error{Routine: "Strsyl", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Strsyl", Param: "n", Message: nLT0})
+	case lda < max(1, m):
+		panic(Error{Routine: "Strsyl", Param: "lda", Message: badLdA})
+	case ldb < max(1, n):
+		panic(Error{Routine: "Strsyl", Param: "ldb", Message: badLdB})
+	case ldc < max(1, n):
+		panic(Error{Routine: "Strsyl", Param: "ldc", Message: badLdC})
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return 1, true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+m:
+		panic(Error{Routine: "Strsyl", Param: "a", Message: shortA})
+	case len(b) < (n-1)*ldb+n:
+		panic(Error{Routine: "Strsyl", Param: "b", Message: shortB})
+	case len(c) < (m-1)*ldc+n:
+		panic(Error{Routine: "Strsyl", Param: "c", Message: shortC})
+	}
+
+	_scale := []float32{0}
+	ok = lapacke.Strsyl(byte(trana), byte(tranb), isgn, m, n, a, lda, b, ldb, c, ldc, _scale)
+	return _scale[0], ok
+}
+
+// Spftrf is the float32 version of Dpftrf.
+func (impl Implementation) Spftrf(transr blas.Transpose, uplo blas.Uplo, n int, a []float32) (ok bool) {
+	switch {
+	case transr != blas.NoTrans && transr != blas.Trans:
+		panic(Error{Routine: "Spftrf", Param: "transr", Message: badTransR})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Spftrf", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Spftrf", Param: "n", Message: nLT0})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	if len(a) < n*(n+1)/2 {
+		panic(Error{Routine: "Spftrf", Param: "a", Message: shortA})
+	}
+
+	return lapacke.Spftrf(byte(transr), byte(uplo), n, a)
+}
+
+// Spftri is the float32 version of Dpftri.
+func (impl Implementation) Spftri(transr blas.Transpose, uplo blas.Uplo, n int, a []float32) (ok bool) {
+	switch {
+	case transr != blas.NoTrans && transr != blas.Trans:
+		panic(Error{Routine: "Spftri", Param: "transr", Message: badTransR})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Spftri", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Spftri", Param: "n", Message: nLT0})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	if len(a) < n*(n+1)/2 {
+		panic(Error{Routine: "Spftri", Param: "a", Message: shortA})
+	}
+
+	return lapacke.Spftri(byte(transr), byte(uplo), n, a)
+}
+
+// Spftrs is the float32 version of Dpftrs.
+func (impl Implementation) Spftrs(transr blas.Transpose, uplo blas.Uplo, n, nrhs int, a, b []float32, ldb int) {
+	switch {
+	case transr != blas.NoTrans && transr != blas.Trans:
+		panic(Error{Routine: "Spftrs", Param: "transr", Message: badTransR})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Spftrs", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Spftrs", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Spftrs", Param: "nrhs", Message: nrhsLT0})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Spftrs", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < n*(n+1)/2:
+		panic(Error{Routine: "Spftrs", Param: "a", Message: shortA})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Spftrs", Param: "b", Message: shortB})
+	}
+
+	lapacke.Spftrs(byte(transr), byte(uplo), n, nrhs, a, b, ldb)
+}
+
+// Stfttp is the float32 version of Dtfttp.
+func (impl Implementation) Stfttp(transr blas.Transpose, uplo blas.Uplo, n int, arf, ap []float32) {
+	switch {
+	case transr != blas.NoTrans && transr != blas.Trans:
+		panic(Error{Routine: "Stfttp", Param: "transr", Message: badTransR})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Stfttp", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Stfttp", Param: "n", Message: nLT0})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	switch {
+	case len(arf) < n*(n+1)/2:
+		panic(Error{Routine: "Stfttp", Param: "arf", Message: shortARF})
+	case len(ap) < n*(n+1)/2:
+		panic(Error{Routine: "Stfttp", Param: "ap", Message: shortAP})
+	}
+
+	lapacke.Stfttp(byte(transr), byte(uplo), n, arf, ap)
+}
+
+// Stfttr is the float32 version of Dtfttr.
+func (impl Implementation) Stfttr(transr blas.Transpose, uplo blas.Uplo, n int, arf, a []float32, lda int) {
+	switch {
+	case transr != blas.NoTrans && transr != blas.Trans:
+		panic(Error{Routine: "Stfttr", Param: "transr", Message: badTransR})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Stfttr", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Stfttr", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Stfttr", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	switch {
+	case len(arf) < n*(n+1)/2:
+		panic(Error{Routine: "Stfttr", Param: "arf", Message: shortARF})
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Stfttr", Param: "a", Message: shortA})
+	}
+
+	lapacke.Stfttr(byte(transr), byte(uplo), n, arf, a, lda)
+}
+
+// Stpttf is the float32 version of Dtpttf.
+func (impl Implementation) Stpttf(transr blas.Transpose, uplo blas.Uplo, n int, ap, arf []float32) {
+	switch {
+	case transr != blas.NoTrans && transr != blas.Trans:
+		panic(Error{Routine: "Stpttf", Param: "transr", Message: badTransR})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Stpttf", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Stpttf", Param: "n", Message: nLT0})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	switch {
+	case len(ap) < n*(n+1)/2:
+		panic(Error{Routine: "Stpttf", Param: "ap", Message: shortAP})
+	case len(arf) < n*(n+1)/2:
+		panic(Error{Routine: "Stpttf", Param: "arf", Message: shortARF})
+	}
+
+	lapacke.Stpttf(byte(transr), byte(uplo), n, ap, arf)
+}
+
+// Stpttr is the float32 version of Dtpttr.
+func (impl Implementation) Stpttr(uplo blas.Uplo, n int, ap, a []float32, lda int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Stpttr", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Stpttr", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Stpttr", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	switch {
+	case len(ap) < n*(n+1)/2:
+		panic(Error{Routine: "Stpttr", Param: "ap", Message: shortAP})
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Stpttr", Param: "a", Message: shortA})
+	}
+
+	lapacke.Stpttr(byte(uplo), n, ap, a, lda)
+}
+
+// Strttf is the float32 version of Dtrttf.
+func (impl Implementation) Strttf(transr blas.Transpose, uplo blas.Uplo, n int, a []float32, lda int, arf []float32) {
+	switch {
+	case transr != blas.NoTrans && transr != blas.Trans:
+		panic(Error{Routine: "Strttf", Param: "transr", Message: badTransR})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Strttf", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Strttf", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Strttf", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Strttf", Param: "a", Message: shortA})
+	case len(arf) < n*(n+1)/2:
+		panic(Error{Routine: "Strttf", Param: "arf", Message: shortARF})
+	}
+
+	lapacke.Strttf(byte(transr), byte(uplo), n, a, lda, arf)
+}
+
+// Strttp is the float32 version of Dtrttp.
+func (impl Implementation) Strttp(uplo blas.Uplo, n int, a []float32, lda int, ap []float32) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Strttp", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Strttp", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Strttp", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Strttp", Param: "a", Message: shortA})
+	case len(ap) < n*(n+1)/2:
+		panic(Error{Routine: "Strttp", Param: "ap", Message: shortAP})
+	}
+
+	lapacke.Strttp(byte(uplo), n, a, lda, ap)
+}
+
+// Sppsv is the float32 version of Dppsv.
+func (impl Implementation) Sppsv(uplo blas.Uplo, n, nrhs int, ap, b []float32, ldb int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Sppsv", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Sppsv", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Sppsv", Param: "nrhs", Message: nrhsLT0})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Sppsv", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(ap) < n*(n+1)/2:
+		panic(Error{Routine: "Sppsv", Param: "ap", Message: shortAP})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Sppsv", Param: "b", Message: shortB})
+	}
+
+	return lapacke.Sppsv(byte(uplo), n, nrhs, ap, b, ldb)
+}
+
+// Spptrf is the float32 version of Dpptrf.
+func (impl Implementation) Spptrf(uplo blas.Uplo, n int, ap []float32) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Spptrf", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Spptrf", Param: "n", Message: nLT0})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	if len(ap) < n*(n+1)/2 {
+		panic(Error{Routine: "Spptrf", Param: "ap", Message: shortAP})
+	}
+
+	return lapacke.Spptrf(byte(uplo), n, ap)
+}
+
+// Spptrs is the float32 version of Dpptrs.
+func (impl Implementation) Spptrs(uplo blas.Uplo, n, nrhs int, ap, b []float32, ldb int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Spptrs", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Spptrs", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Spptrs", Param: "nrhs", Message: nrhsLT0})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Spptrs", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(ap) < n*(n+1)/2:
+		panic(Error{Routine: "Spptrs", Param: "ap", Message: shortAP})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Spptrs", Param: "b", Message: shortB})
+	}
+
+	lapacke.Spptrs(byte(uplo), n, nrhs, ap, b, ldb)
+}
+
+// Sspev is the float32 version of Dspev.
+func (impl Implementation) Sspev(jobz lapack.EVJob, uplo blas.Uplo, n int, ap, w, z []float32, ldz int, work []float32) (ok bool) {
+	switch {
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(Error{Routine: "Sspev", Param: "jobz", Message: badEVJob})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Sspev", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Sspev", Param: "n", Message: nLT0})
+	case ldz < 1 || (jobz == lapack.EVCompute && ldz < n):
+		panic(Error{Routine: "Sspev", Param: "ldz", Message: badLdZ})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(ap) < n*(n+1)/2:
+		panic(Error{Routine: "Sspev", Param: "ap", Message: shortAP})
+	case len(w) < n:
+		panic(Error{Routine: "Sspev", Param: "w", Message: shortW})
+	case jobz == lapack.EVCompute && len(z) < (n-1)*ldz+n:
+		panic(Error{Routine: "Sspev", Param: "z", Message: shortZ})
+	case len(work) < 3*n:
+		panic(Error{Routine: "Sspev", Param: "work", Message: shortWork})
+	}
+
+	return lapacke.Sspev(byte(jobz), byte(uplo), n, ap, w, z, ldz, work)
+}
+
+// Sspsv is the float32 version of Dspsv.
+func (impl Implementation) Sspsv(uplo blas.Uplo, n, nrhs int, ap []float32, ipiv []int, b []float32, ldb int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Sspsv", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Sspsv", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Sspsv", Param: "nrhs", Message: nrhsLT0})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Sspsv", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(ap) < n*(n+1)/2:
+		panic(Error{Routine: "Sspsv", Param: "ap", Message: shortAP})
+	case len(ipiv) != n:
+		panic(Error{Routine: "Sspsv", Param: "ipiv", Message: badLenIpiv})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Sspsv", Param: "b", Message: shortB})
+	}
+
+	ipiv32 := make([]lapacke.Int, n)
+	ok = lapacke.Sspsv(byte(uplo), n, nrhs, ap, ipiv32, b, ldb)
+	symPivotsToGonum(ipiv, ipiv32)
+	return ok
+}
+
+// Ssptrf is the float32 version of Dsptrf.
+func (impl Implementation) Ssptrf(uplo blas.Uplo, n int, ap []float32, ipiv []int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Ssptrf", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Ssptrf", Param: "n", Message: nLT0})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(ap) < n*(n+1)/2:
+		panic(Error{Routine: "Ssptrf", Param: "ap", Message: shortAP})
+	case len(ipiv) != n:
+		panic(Error{Routine: "Ssptrf", Param: "ipiv", Message: badLenIpiv})
+	}
+
+	ipiv32 := make([]lapacke.Int, n)
+	ok = lapacke.Ssptrf(byte(uplo), n, ap, ipiv32)
+	symPivotsToGonum(ipiv, ipiv32)
+	return ok
+}
+
+// Ssptrs is the float32 version of Dsptrs.
+func (impl Implementation) Ssptrs(uplo blas.Uplo, n, nrhs int, ap []float32, ipiv []int, b []float32, ldb int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Ssptrs", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Ssptrs", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Ssptrs", Param: "nrhs", Message: nrhsLT0})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Ssptrs", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(ap) < n*(n+1)/2:
+		panic(Error{Routine: "Ssptrs", Param: "ap", Message: shortAP})
+	case len(ipiv) != n:
+		panic(Error{Routine: "Ssptrs", Param: "ipiv", Message: badLenIpiv})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Ssptrs", Param: "b", Message: shortB})
+	}
+
+	lapacke.Ssptrs(byte(uplo), n, nrhs, ap, symPivotsToLapacke(ipiv), b, ldb)
+}
+
+// Sorcsd2by1 is the float32 version of Dorcsd2by1.
+func (impl Implementation) Sorcsd2by1(jobU1, jobU2, jobV1T CSDJob, m, p, q int, x11 []float32, ldx11 int, x21 []float32, ldx21 int, theta, u1 []float32, ldu1 int, u2 []float32, ldu2 int, v1t []float32, ldv1t int, work []float32, lwork int) (ok bool) {
+	wantu1 := jobU1 == CSDCompute
+	wantu2 := jobU2 == CSDCompute
+	wantv1t := jobV1T == CSDCompute
+	switch {
+	case !wantu1 && jobU1 != CSDNone:
+		panic(Error{Routine: "Sorcsd2by1", Param: "jobU1", Message: badCSDJob})
+	case !wantu2 && jobU2 != CSDNone:
+		panic(Error{Routine: "Sorcsd2by1", Param: "jobU2", Message: badCSDJob})
+	case !wantv1t && jobV1T != CSDNone:
+		panic(Error{Routine: "Sorcsd2by1", Param: "jobV1T", Message: badCSDJob})
+	case m < 0:
+		panic(Error{Routine: "Sorcsd2by1", Param: "m", Message: mLT0})
+	case p < 0 || m < p:
+		panic(Error{Routine: "Sorcsd2by1", Param: "p", Message: badCSDP})
+	case q < 0 || m < q:
+		panic(Error{Routine: "Sorcsd2by1", Param: "q", Message: badCSDQ})
+	case ldx11 < max(1, q):
+		panic(Error{Routine: "Sorcsd2by1", Param: "ldx11", Message: badLdX})
+	case ldx21 < max(1, q):
+		panic(Error{Routine: "Sorcsd2by1", Param: "ldx21", Message: badLdX})
+	case ldu1 < 1, wantu1 && ldu1 < p:
+		panic(Error{Routine: "Sorcsd2by1", Param: "ldu1", Message: badLdU})
+	case ldu2 < 1, wantu2 && ldu2 < m-p:
+		panic(Error{Routine: "Sorcsd2by1", Param: "ldu2", Message: badLdU})
+	case ldv1t < 1, wantv1t && ldv1t < q:
+		panic(Error{Routine: "Sorcsd2by1", Param: "ldv1t", Message: badLdV})
+	case lwork < 1 && lwork != -1:
+		panic(Error{Routine: "Sorcsd2by1", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Sorcsd2by1", Param: "work", Message: shortWork})
+	}
+
+	// Quick return if possible.
+	if m == 0 {
+		work[0] = 1
+		return true
+	}
+
+	if lwork == -1 {
+		return lapacke.Sorcsd2by1(byte(jobU1), byte(jobU2), byte(jobV1T), m, p, q, x11, ldx11, x21, ldx21, theta, u1, ldu1, u2, ldu2, v1t, ldv1t, work, -1, nil)
+	}
+
+	r := csdRank(m, p, q)
+	switch {
+	case len(x11) < (p-1)*ldx11+q:
+		panic(Error{Routine: "Sorcsd2by1", Param: "x11", Message: shortX})
+	case len(x21) < (m-p-1)*ldx21+q:
+		panic(Error{Routine: "Sorcsd2by1", Param: "x21", Message: shortX})
+	case len(theta) != r:
+		panic(Error{Routine: "Sorcsd2by1", Param: "theta", Message: shortTheta})
+	case wantu1 && len(u1) < (p-1)*ldu1+p:
+		panic(Error{Routine: "Sorcsd2by1", Param: "u1", Message: shortU})
+	case wantu2 && len(u2) < (m-p-1)*ldu2+m-p:
+		panic(Error{Routine: "Sorcsd2by1", Param: "u2", Message: shortU})
+	case wantv1t && len(v1t) < (q-1)*ldv1t+q:
+		panic(Error{Routine: "Sorcsd2by1", Param: "v1t", Message: shortV})
+	}
+
+	_iwork := make([]lapacke.Int, max(1, m-r))
+	return lapacke.Sorcsd2by1(byte(jobU1), byte(jobU2), byte(jobV1T), m, p, q, x11, ldx11, x21, ldx21, theta, u1, ldu1, u2, ldu2, v1t, ldv1t, work, lwork, _iwork)
+}
+
+// Slacpy is the float32 version of Dlacpy.
+func (impl Implementation) Slacpy(uplo blas.Uplo, m, n int, a []float32, lda int, b []float32, ldb int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower && uplo != blas.All:
+		panic(Error{Routine: "Slacpy", Param: "uplo", Message: badUplo})
+	case m < 0:
+		panic(Error{Routine: "Slacpy", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Slacpy", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Slacpy", Param: "lda", Message: badLdA})
+	case ldb < max(1, n):
+		panic(Error{Routine: "Slacpy", Param: "ldb", Message: badLdB})
+	}
+
+	if m == 0 || n == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(Error{Routine: "Slacpy", Param: "a", Message: shortA})
+	case len(b) < (m-1)*ldb+n:
+		panic(Error{Routine: "Slacpy", Param: "b", Message: shortB})
+	}
+
+	lapacke.Slacpy(byte(uplo), m, n, a, lda, b, ldb)
+}
+
+// Slange is the float32 version of Dlange.
+func (impl Implementation) Slange(norm lapack.MatrixNorm, m, n int, a []float32, lda int, work []float32) float32 {
+	switch {
+	case norm != lapack.MaxRowSum && norm != lapack.MaxColumnSum && norm != lapack.Frobenius && norm != lapack.MaxAbs:
+		panic(Error{Routine: "Slange", Param: "norm", Message: badNorm})
+	case lda < max(1, n):
+		panic(Error{Routine: "Slange", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return 0
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(Error{Routine: "Slange", Param: "a", Message: shortA})
+	case norm == lapack.MaxColumnSum && len(work) < n:
+		panic(Error{Routine: "Slange", Param: "work", Message: shortWork})
+	}
+
+	return lapacke.Slange(byte(norm), m, n, a, lda, work)
+}
+
+// Slansy is the float32 version of Dlansy.
+func (impl Implementation) Slansy(norm lapack.MatrixNorm, uplo blas.Uplo, n int, a []float32, lda int, work []float32) float32 {
+	switch {
+	case norm != lapack.MaxRowSum && norm != lapack.MaxColumnSum && norm != lapack.Frobenius && norm != lapack.MaxAbs:
+		panic(Error{Routine: "Slansy", Param: "norm", Message: badNorm})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Slansy", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Slansy", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Slansy", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Slansy", Param: "a", Message: shortA})
+	case (norm == lapack.MaxColumnSum || norm == lapack.MaxRowSum) && len(work) < n:
+		panic(Error{Routine: "Slansy", Param: "work", Message: shortWork})
+	}
+
+	return lapacke.Slansy(byte(norm), byte(uplo), n, a, lda, work)
+}
+
+// Slantr is the float32 version of Dlantr.
+func (impl Implementation) Slantr(norm lapack.MatrixNorm, uplo blas.Uplo, diag blas.Diag, m, n int, a []float32, lda int, work []float32) float32 {
+	switch {
+	case norm != lapack.MaxRowSum && norm != lapack.MaxColumnSum && norm != lapack.Frobenius && norm != lapack.MaxAbs:
+		panic(Error{Routine: "Slantr", Param: "norm", Message: badNorm})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Slantr", Param: "uplo", Message: badUplo})
+	case diag != blas.Unit && diag != blas.NonUnit:
+		panic(Error{Routine: "Slantr", Param: "diag", Message: badDiag})
+	case m < 0:
+		panic(Error{Routine: "Slantr", Param: "m", Message: mLT0})
+	case uplo == blas.Upper && m > n:
+		panic(Error{Routine: "Slantr", Param: "m", Message: mGTN})
+	case n < 0:
+		panic(Error{Routine: "Slantr", Param: "n", Message: nLT0})
+	case uplo == blas.Lower && n > m:
+		panic(Error{Routine: "Slantr", Param: "n", Message: nGTM})
+	case lda < max(1, n):
+		panic(Error{Routine: "Slantr", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	minmn := min(m, n)
+	if minmn == 0 {
+		return 0
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(Error{Routine: "Slantr", Param: "a", Message: shortA})
+	case norm == lapack.MaxColumnSum && len(work) < n:
+		panic(Error{Routine: "Slantr", Param: "work", Message: shortWork})
+	}
+
+	if norm == lapack.MaxRowSum && len(work) < m {
+		// Allocate new work to be on the safe side because the expectation of LAPACKE on
+		// row-major input is unclear.
+		work = make([]float32, m)
+	}
+	return lapacke.Slantr(byte(norm), byte(uplo), byte(diag), m, n, a, lda, work)
+}
+
+// Slascl is the float32 version of Dlascl.
+func (impl Implementation) Slascl(kind lapack.MatrixType, kl, ku int, cfrom, cto float32, m, n int, a []float32, lda int) {
+	switch kind {
+	default:
+		panic(Error{Routine: "Slascl", Param: "kind", Message: badMatrixType})
+	case 'H', 'B', 'Q', 'Z': // See dlascl.f.
+	case lapack.General, lapack.UpperTri, lapack.LowerTri:
+		if lda < max(1, n) {
+			panic(Error{Routine: "Slascl", Param: "lda", Message: badLdA})
+		}
+	}
+	switch {
+	case cfrom == 0:
+		panic(Error{Routine: "Slascl", Param: "cfrom", Message: zeroCFrom})
+	case math.IsNaN(float64(cfrom)):
+		panic(Error{Routine: "Slascl", Param: "cfrom", Message: nanCFrom})
+	case math.IsNaN(float64(cto)):
+		panic(Error{Routine: "Slascl", Param: "cto", Message: nanCTo})
+	case m < 0:
+		panic(Error{Routine: "Slascl", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Slascl", Param: "n", Message: nLT0})
+	}
+
+	if n == 0 || m == 0 {
+		return
+	}
+
+	switch kind {
+	case lapack.General, lapack.UpperTri, lapack.LowerTri:
+		if len(a) < (m-1)*lda+n {
+			panic(Error{Routine: "Slascl", Param: "a", Message: shortA})
+		}
+	}
+
+	lapacke.Slascl(byte(kind), kl, ku, cfrom, cto, m, n, a, lda)
+}
+
+// Slaset is the float32 version of Dlaset.
+func (impl Implementation) Slaset(uplo blas.Uplo, m, n int, alpha, beta float32, a []float32, lda int) {
+	switch {
+	case m < 0:
+		panic(Error{Routine: "Slaset", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Slaset", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Slaset", Param: "lda", Message: badLdA})
+	}
+
+	minmn := min(m, n)
+	if minmn == 0 {
+		return
+	}
+
+	if len(a) < (m-1)*lda+n {
+		panic(Error{Routine: "Slaset", Param: "a", Message: shortA})
+	}
+
+	lapacke.Slaset(byte(uplo), m, n, alpha, beta, a, lda)
+}
@@ -0,0 +1,338 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// The float32 methods of Implementation are tested against their float64
+// versions on inputs that are exactly representable in float32.
+
+func TestSgetrs(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 4, 17} {
+		for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+			a := randomGeneral(rnd, n, n, n+2)
+			a32 := round32(a)
+			b := randomGeneral(rnd, n, 3, 3)
+			b32 := round32(b)
+
+			ipiv := make([]int, n)
+			impl.Dgetrf(n, n, a.Data, a.Stride, ipiv)
+			impl.Dgetrs(trans, n, 3, a.Data, a.Stride, ipiv, b.Data, b.Stride)
+			if !impl.Sgetrf(n, n, a32.Data, a32.Stride, ipiv) {
+				t.Fatalf("n=%d: unexpected singular matrix", n)
+			}
+			impl.Sgetrs(trans, n, 3, a32.Data, a32.Stride, ipiv, b32.Data, b32.Stride)
+			if d := maxDiff32(b32.Data, b.Data); d > tol32 {
+				t.Errorf("n=%d,trans=%c: unexpected solution: difference %v", n, trans, d)
+			}
+
+			inv := append([]float64(nil), a.Data...)
+			work := make([]float64, 4*n)
+			impl.Dgetri(n, inv, a.Stride, ipiv, work, len(work))
+			inv32 := append([]float32(nil), a32.Data...)
+			if !impl.Sgetri(n, inv32, a32.Stride, ipiv, make([]float32, 4*n), 4*n) {
+				t.Fatalf("n=%d: unexpected failure of Sgetri", n)
+			}
+			if d := maxDiff32(inv32, inv); d > tol32 {
+				t.Errorf("n=%d: unexpected inverse: difference %v", n, d)
+			}
+		}
+	}
+}
+
+func TestSgesv(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 5, 20} {
+		a := randomGeneral(rnd, n, n, max(1, n))
+		a32 := round32(a)
+		b := randomGeneral(rnd, n, 2, 2)
+		b32 := round32(b)
+		ipiv := make([]int, n)
+		impl.Dgetrf(n, n, a.Data, a.Stride, ipiv)
+		impl.Dgetrs(blas.NoTrans, n, 2, a.Data, a.Stride, ipiv, b.Data, b.Stride)
+		if !impl.Sgesv(n, 2, a32.Data, a32.Stride, ipiv, b32.Data, b32.Stride) {
+			t.Fatalf("n=%d: unexpected singular matrix", n)
+		}
+		if d := maxDiff32(b32.Data, b.Data); d > tol32 {
+			t.Errorf("n=%d: unexpected solution: difference %v", n, d)
+		}
+	}
+}
+
+func TestSpotrs(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 4, 15} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			a := randomSPD(rnd, n, n+1)
+			a.Uplo = uplo
+			a32 := symmetric32(a)
+			b := randomGeneral(rnd, n, 2, 2)
+			b32 := round32(b)
+
+			impl.Dpotrf(uplo, n, a.Data, a.Stride)
+			impl.Dpotrs(uplo, n, 2, a.Data, a.Stride, b.Data, b.Stride)
+			if !impl.Spotrf(uplo, n, a32.Data, a32.Stride) {
+				t.Fatalf("n=%d,uplo=%c: unexpected failure of Spotrf", n, uplo)
+			}
+			impl.Spotrs(uplo, n, 2, a32.Data, a32.Stride, b32.Data, b32.Stride)
+			if d := maxDiff32(b32.Data, b.Data); d > tol32 {
+				t.Errorf("n=%d,uplo=%c: unexpected solution: difference %v", n, uplo, d)
+			}
+
+			impl.Dpotri(uplo, n, a.Data, a.Stride)
+			impl.Spotri(uplo, n, a32.Data, a32.Stride)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					if (uplo == blas.Upper) != (j >= i) {
+						a.Data[i*a.Stride+j] = 0
+						a32.Data[i*a32.Stride+j] = 0
+					}
+				}
+			}
+			if d := maxDiff32(a32.Data, a.Data); d > tol32 {
+				t.Errorf("n=%d,uplo=%c: unexpected inverse: difference %v", n, uplo, d)
+			}
+		}
+	}
+}
+
+func TestStrtrs(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 6} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			a := randomGeneral(rnd, n, n, n)
+			for i := 0; i < n; i++ {
+				a.Data[i*a.Stride+i] += 4
+			}
+			a32 := round32(a)
+			b := randomGeneral(rnd, n, 2, 2)
+			b32 := round32(b)
+			impl.Dtrtrs(uplo, blas.Trans, blas.NonUnit, n, 2, a.Data, a.Stride, b.Data, b.Stride)
+			impl.Strtrs(uplo, blas.Trans, blas.NonUnit, n, 2, a32.Data, a32.Stride, b32.Data, b32.Stride)
+			if d := maxDiff32(b32.Data, b.Data); d > tol32 {
+				t.Errorf("n=%d,uplo=%c: unexpected solution: difference %v", n, uplo, d)
+			}
+		}
+	}
+}
+
+func TestSgeqrf(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, dims := range []struct{ m, n int }{{1, 1}, {7, 4}, {10, 10}} {
+		m, n := dims.m, dims.n
+		a := randomGeneral(rnd, m, n, n)
+		a32 := round32(a)
+		c := randomGeneral(rnd, m, 3, 3)
+		c32 := round32(c)
+
+		tau := make([]float64, n)
+		work := make([]float64, 64*max(m, n))
+		impl.Dgeqrf(m, n, a.Data, a.Stride, tau, work, len(work))
+		impl.Dormqr(blas.Left, blas.Trans, m, 3, n, a.Data, a.Stride, tau, c.Data, c.Stride, work, len(work))
+		impl.Dorgqr(m, n, n, a.Data, a.Stride, tau, work, len(work))
+
+		tau32 := make([]float32, n)
+		work32 := make([]float32, 1)
+		impl.Sgeqrf(m, n, a32.Data, a32.Stride, tau32, work32, -1)
+		work32 = make([]float32, max(3, int(work32[0])))
+		impl.Sgeqrf(m, n, a32.Data, a32.Stride, tau32, work32, len(work32))
+		impl.Sormqr(blas.Left, blas.Trans, m, 3, n, a32.Data, a32.Stride, tau32, c32.Data, c32.Stride, work32, len(work32))
+		impl.Sorgqr(m, n, n, a32.Data, a32.Stride, tau32, work32, len(work32))
+		if d := maxDiff32(c32.Data, c.Data); d > tol32 {
+			t.Errorf("m=%d,n=%d: unexpected Q^T * C: difference %v", m, n, d)
+		}
+		if d := maxDiff32(a32.Data, a.Data); d > tol32 {
+			t.Errorf("m=%d,n=%d: unexpected Q: difference %v", m, n, d)
+		}
+	}
+}
+
+func TestSgels(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, dims := range []struct{ m, n int }{{8, 3}, {3, 8}, {5, 5}} {
+		m, n := dims.m, dims.n
+		a := randomGeneral(rnd, m, n, n)
+		a32 := round32(a)
+		b := randomGeneral(rnd, max(m, n), 2, 2)
+		b32 := round32(b)
+		work := make([]float64, 64*max(m, n))
+		impl.Dgels(blas.NoTrans, m, n, 2, a.Data, a.Stride, b.Data, b.Stride, work, len(work))
+		work32 := make([]float32, 64*max(m, n))
+		if !impl.Sgels(blas.NoTrans, m, n, 2, a32.Data, a32.Stride, b32.Data, b32.Stride, work32, len(work32)) {
+			t.Fatalf("m=%d,n=%d: unexpected rank deficiency", m, n)
+		}
+		if d := maxDiff32(b32.Data[:n*2], b.Data[:n*2]); d > tol32 {
+			t.Errorf("m=%d,n=%d: unexpected solution: difference %v", m, n, d)
+		}
+	}
+}
+
+func TestSgesvdSgesdd(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, dims := range []struct{ m, n int }{{1, 1}, {7, 4}, {4, 7}, {9, 9}} {
+		m, n := dims.m, dims.n
+		mn := min(m, n)
+		a := randomGeneral(rnd, m, n, n)
+		a32 := round32(a)
+
+		s := make([]float64, mn)
+		work := make([]float64, 1)
+		ac := cloneGeneral(a)
+		impl.Dgesvd(lapack.SVDNone, lapack.SVDNone, m, n, ac.Data, ac.Stride, s, nil, 1, nil, 1, work, -1)
+		work = make([]float64, int(work[0]))
+		impl.Dgesvd(lapack.SVDNone, lapack.SVDNone, m, n, ac.Data, ac.Stride, s, nil, 1, nil, 1, work, len(work))
+
+		s32 := make([]float32, mn)
+		work32 := make([]float32, 1)
+		ac32 := append([]float32(nil), a32.Data...)
+		impl.Sgesvd(lapack.SVDNone, lapack.SVDNone, m, n, ac32, a32.Stride, s32, nil, 1, nil, 1, work32, -1)
+		work32 = make([]float32, int(work32[0]))
+		if !impl.Sgesvd(lapack.SVDNone, lapack.SVDNone, m, n, ac32, a32.Stride, s32, nil, 1, nil, 1, work32, len(work32)) {
+			t.Fatalf("m=%d,n=%d: Sgesvd did not converge", m, n)
+		}
+		if d := maxDiff32(s32, s); d > tol32 {
+			t.Errorf("m=%d,n=%d: unexpected Sgesvd singular values: difference %v", m, n, d)
+		}
+
+		u := make([]float32, m*m)
+		vt := make([]float32, n*n)
+		iwork := make([]int, 8*mn)
+		ac32 = append(ac32[:0], a32.Data...)
+		work32 = make([]float32, 1)
+		impl.Sgesdd(lapack.SVDAll, m, n, ac32, a32.Stride, s32, u, m, vt, n, work32, -1, iwork)
+		work32 = make([]float32, int(work32[0]))
+		if !impl.Sgesdd(lapack.SVDAll, m, n, ac32, a32.Stride, s32, u, m, vt, n, work32, len(work32), iwork) {
+			t.Fatalf("m=%d,n=%d: Sgesdd did not converge", m, n)
+		}
+		if d := maxDiff32(s32, s); d > tol32 {
+			t.Errorf("m=%d,n=%d: unexpected Sgesdd singular values: difference %v", m, n, d)
+		}
+		// U * Σ * V^T must reproduce A.
+		usv := make([]float32, m*n)
+		for i := 0; i < m; i++ {
+			for j := 0; j < n; j++ {
+				var v float32
+				for l := 0; l < mn; l++ {
+					v += u[i*m+l] * s32[l] * vt[l*n+j]
+				}
+				usv[i*n+j] = v
+			}
+		}
+		if d := maxDiff32(usv, a.Data); d > tol32 {
+			t.Errorf("m=%d,n=%d: unexpected Sgesdd factors: difference %v", m, n, d)
+		}
+	}
+}
+
+func TestSsyev(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 5, 12} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			a := randomSPD(rnd, n, n+1)
+			a32 := symmetric32(a)
+			w := make([]float64, n)
+			work := make([]float64, 3*n)
+			impl.Dsyev(lapack.EVNone, uplo, n, a.Data, a.Stride, w, work, len(work))
+			w32 := make([]float32, n)
+			if !impl.Ssyev(lapack.EVCompute, uplo, n, a32.Data, a32.Stride, w32, make([]float32, 3*n), 3*n) {
+				t.Fatalf("n=%d,uplo=%c: Ssyev did not converge", n, uplo)
+			}
+			if d := maxDiff32(w32, w); d > tol32 {
+				t.Errorf("n=%d,uplo=%c: unexpected eigenvalues: difference %v", n, uplo, d)
+			}
+		}
+	}
+}
+
+func TestSppsv(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 6, 13} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			s := randomSPD(rnd, n, n+2)
+			s.Uplo = uplo
+			ap := ToPacked(s).Data
+			ap32 := make([]float32, len(ap))
+			for i, v := range ap {
+				ap32[i] = float32(v)
+				ap[i] = float64(ap32[i])
+			}
+			b := randomGeneral(rnd, n, 2, 2)
+			b32 := round32(b)
+			b.Data = general64(b32).Data
+
+			w := make([]float64, n)
+			impl.Dspev(lapack.EVNone, uplo, n, append([]float64(nil), ap...), w, nil, 1, make([]float64, 3*n))
+			w32 := make([]float32, n)
+			if !impl.Sspev(lapack.EVNone, uplo, n, append([]float32(nil), ap32...), w32, nil, 1, make([]float32, 3*n)) {
+				t.Fatalf("n=%d,uplo=%c: Sspev did not converge", n, uplo)
+			}
+			if d := maxDiff32(w32, w); d > tol32*float64(max(1, n)) {
+				t.Errorf("n=%d,uplo=%c: unexpected eigenvalues: difference %v", n, uplo, d)
+			}
+
+			impl.Dppsv(uplo, n, 2, ap, b.Data, b.Stride)
+			if !impl.Sppsv(uplo, n, 2, ap32, b32.Data, b32.Stride) {
+				t.Fatalf("n=%d,uplo=%c: unexpected failure of Sppsv", n, uplo)
+			}
+			if d := maxDiff32(b32.Data, b.Data); d > tol32 {
+				t.Errorf("n=%d,uplo=%c: unexpected solution: difference %v", n, uplo, d)
+			}
+		}
+	}
+}
+
+func TestSlange(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	norms := []lapack.MatrixNorm{lapack.MaxAbs, lapack.MaxColumnSum, lapack.MaxRowSum, lapack.Frobenius}
+	for _, m := range []int{1, 4, 9} {
+		for _, n := range []int{1, 3, 10} {
+			a := randomGeneral(rnd, m, n, n+1)
+			a32 := round32(a)
+			a = general64(a32)
+			for _, norm := range norms {
+				work := make([]float64, max(m, n))
+				work32 := make([]float32, max(m, n))
+				want := impl.Dlange(norm, m, n, a.Data, a.Stride, work)
+				got := impl.Slange(norm, m, n, a32.Data, a32.Stride, work32)
+				if d := maxDiff32([]float32{got}, []float64{want}); d > tol32 {
+					t.Errorf("m=%d,n=%d,norm=%c: unexpected Slange: got %v want %v", m, n, norm, got, want)
+				}
+				if m != n {
+					continue
+				}
+				for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+					want := impl.Dlansy(norm, uplo, n, a.Data, a.Stride, work)
+					got := impl.Slansy(norm, uplo, n, a32.Data, a32.Stride, work32)
+					if d := maxDiff32([]float32{got}, []float64{want}); d > tol32 {
+						t.Errorf("n=%d,norm=%c,uplo=%c: unexpected Slansy: got %v want %v", n, norm, uplo, got, want)
+					}
+				}
+			}
+
+			b32 := round32(newGeneral(m, n))
+			impl.Slacpy(blas.All, m, n, a32.Data, a32.Stride, b32.Data, b32.Stride)
+			impl.Slascl(lapack.General, 0, 0, 2, 1, m, n, b32.Data, b32.Stride)
+			impl.Slaset(blas.All, m, n, 0, 0, a32.Data, a32.Stride)
+			for i := 0; i < m; i++ {
+				for j := 0; j < n; j++ {
+					if a32.Data[i*a32.Stride+j] != 0 {
+						t.Fatalf("m=%d,n=%d: Slaset did not zero the matrix", m, n)
+					}
+					if got, want := b32.Data[i*b32.Stride+j], float32(a.Data[i*a.Stride+j]/2); got != want {
+						t.Fatalf("m=%d,n=%d: unexpected element (%d,%d) after Slacpy and Slascl: got %v want %v", m, n, i, j, got, want)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// Equilibration specifies the scaling that was applied to a matrix before
+// it was factorized.
+type Equilibration byte
+
+const (
+	NoEquilibration   Equilibration = 'N' // A was not scaled.
+	RowEquilibration  Equilibration = 'R' // A was replaced by diag(R)*A.
+	ColEquilibration  Equilibration = 'C' // A was replaced by A*diag(C).
+	BothEquilibration Equilibration = 'B' // A was replaced by diag(R)*A*diag(C).
+)
+
+// LUSolveInfo holds the diagnostic output of SolveExpert.
+type LUSolveInfo struct {
+	// Equilibration is the scaling that was applied to A.
+	Equilibration Equilibration
+
+	// R and C are the row and column scale factors of A. They are only
+	// meaningful for the scalings indicated by Equilibration.
+	R, C []float64
+
+	// RCond is the estimate of the reciprocal condition number of A
+	// after equilibration.
+	RCond float64
+
+	// PivotGrowth is the reciprocal pivot growth factor
+	//  max |A_ij| / max |U_ij|.
+	// A value much less than one indicates that the factorization is
+	// unstable and the solution and RCond may be unreliable.
+	PivotGrowth float64
+
+	// ForwardErr and BackwardErr hold for each column of the solution
+	// the estimated forward error bound and the componentwise relative
+	// backward error after iterative refinement.
+	ForwardErr, BackwardErr []float64
+}
+
+// SolveExpert solves the system of linear equations
+//  A * X = B    if trans == blas.NoTrans,
+//  A^T * X = B  if trans == blas.Trans or blas.ConjTrans,
+// where A is a general n×n matrix, using the LU factorization with partial
+// pivoting computed by the expert driver Dgesvx. If equilibrate is true, A is
+// scaled to improve its condition before it is factorized when this is
+// deemed worthwhile. The solution is improved by iterative refinement.
+//
+// SolveExpert returns the solution X together with the equilibration,
+// condition, pivot growth and error bound information computed by the
+// driver. The inputs a and b are not modified.
+//
+// If A is exactly singular, SolveExpert returns a SingularError and no
+// solution is computed. If A is singular to working precision, the solution
+// is returned with a Condition error holding 1/RCond.
+func SolveExpert(trans blas.Transpose, a, b blas64.General, equilibrate bool) (x blas64.General, info LUSolveInfo, err error) {
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case a.Rows != a.Cols:
+		panic(badShapeA)
+	case b.Rows != a.Rows:
+		panic(badShapeB)
+	}
+	n := a.Rows
+	nrhs := b.Cols
+
+	x = newGeneral(n, nrhs)
+	info = LUSolveInfo{
+		Equilibration: NoEquilibration,
+		R:             make([]float64, n),
+		C:             make([]float64, n),
+		PivotGrowth:   1,
+		ForwardErr:    make([]float64, nrhs),
+		BackwardErr:   make([]float64, nrhs),
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		info.RCond = 1
+		return x, info, nil
+	}
+	if nrhs == 0 {
+		// Dgesvx requires a right-hand side to work on.
+		b = newGeneral(n, 1)
+	}
+
+	fact := byte('N')
+	if equilibrate {
+		fact = 'E'
+	}
+	ac := cloneGeneral(a)
+	bc := cloneGeneral(b)
+	xc := x
+	if nrhs == 0 {
+		xc = newGeneral(n, 1)
+	}
+	af := make([]float64, n*n)
+	ipiv := make([]lapacke.Int, n)
+	equed := []byte{'N'}
+	rcond := make([]float64, 1)
+	ferr := make([]float64, max(1, nrhs))
+	berr := make([]float64, max(1, nrhs))
+	work := make([]float64, 4*n)
+	iwork := make([]lapacke.Int, n)
+	ret := lapacke.DgesvxInfo(fact, byte(trans), n, bc.Cols, ac.Data, ac.Stride, af, n, ipiv, equed, info.R, info.C,
+		bc.Data, bc.Stride, xc.Data, xc.Stride, rcond, ferr, berr, work, iwork)
+
+	info.Equilibration = Equilibration(equed[0])
+	info.RCond = rcond[0]
+	info.PivotGrowth = work[0]
+	copy(info.ForwardErr, ferr)
+	copy(info.BackwardErr, berr)
+
+	switch {
+	case ret < 0:
+		panic("lapack: invalid argument to Dgesvx")
+	case 0 < ret && ret <= n:
+		return x, info, SingularError{Index: ret - 1}
+	case ret == n+1:
+		return x, info, Condition(1 / info.RCond)
+	}
+	return x, info, nil
+}
+
+// CholSolveInfo holds the diagnostic output of SPDSolveExpert.
+type CholSolveInfo struct {
+	// Equilibrated reports whether A was replaced by diag(S)*A*diag(S).
+	Equilibrated bool
+
+	// S holds the scale factors of A. It is only meaningful if
+	// Equilibrated is true.
+	S []float64
+
+	// RCond is the estimate of the reciprocal condition number of A
+	// after equilibration.
+	RCond float64
+
+	// ForwardErr and BackwardErr hold for each column of the solution
+	// the estimated forward error bound and the componentwise relative
+	// backward error after iterative refinement.
+	ForwardErr, BackwardErr []float64
+}
+
+// SPDSolveExpert solves the system of linear equations
+//  A * X = B
+// where A is an n×n symmetric positive definite matrix, using the Cholesky
+// factorization computed by the expert driver Dposvx. If equilibrate is
+// true, A is scaled symmetrically to improve its condition before it is
+// factorized when this is deemed worthwhile. The solution is improved by
+// iterative refinement.
+//
+// SPDSolveExpert returns the solution X together with the equilibration,
+// condition and error bound information computed by the driver. The inputs
+// a and b are not modified.
+//
+// If A is not positive definite, SPDSolveExpert returns an
+// ErrNotPositiveDefinite and no solution is computed. If A is singular to
+// working precision, the solution is returned with a Condition error
+// holding 1/RCond.
+func SPDSolveExpert(a blas64.Symmetric, b blas64.General, equilibrate bool) (x blas64.General, info CholSolveInfo, err error) {
+	switch {
+	case a.Uplo != blas.Upper && a.Uplo != blas.Lower:
+		panic(badUplo)
+	case b.Rows != a.N:
+		panic(badShapeB)
+	}
+	n := a.N
+	nrhs := b.Cols
+
+	x = newGeneral(n, nrhs)
+	info = CholSolveInfo{
+		S:           make([]float64, n),
+		ForwardErr:  make([]float64, nrhs),
+		BackwardErr: make([]float64, nrhs),
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		info.RCond = 1
+		return x, info, nil
+	}
+	if nrhs == 0 {
+		// Dposvx requires a right-hand side to work on.
+		b = newGeneral(n, 1)
+	}
+
+	fact := byte('N')
+	if equilibrate {
+		fact = 'E'
+	}
+	ac := cloneGeneral(blas64.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data})
+	bc := cloneGeneral(b)
+	xc := x
+	if nrhs == 0 {
+		xc = newGeneral(n, 1)
+	}
+	af := make([]float64, n*n)
+	equed := []byte{'N'}
+	rcond := make([]float64, 1)
+	ferr := make([]float64, max(1, nrhs))
+	berr := make([]float64, max(1, nrhs))
+	work := make([]float64, 3*n)
+	iwork := make([]lapacke.Int, n)
+	ret := lapacke.DposvxInfo(fact, byte(a.Uplo), n, bc.Cols, ac.Data, ac.Stride, af, n, equed, info.S,
+		bc.Data, bc.Stride, xc.Data, xc.Stride, rcond, ferr, berr, work, iwork)
+
+	info.Equilibrated = equed[0] == 'Y'
+	info.RCond = rcond[0]
+	copy(info.ForwardErr, ferr)
+	copy(info.BackwardErr, berr)
+
+	switch {
+	case ret < 0:
+		panic("lapack: invalid argument to Dposvx")
+	case 0 < ret && ret <= n:
+		return x, info, ErrNotPositiveDefinite{Index: ret - 1}
+	case ret == n+1:
+		return x, info, Condition(1 / info.RCond)
+	}
+	return x, info, nil
+}
@@ -0,0 +1,165 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// LUSolveInfo32 is the float32 version of LUSolveInfo.
+type LUSolveInfo32 struct {
+	Equilibration           Equilibration
+	R, C                    []float32
+	RCond                   float32
+	PivotGrowth             float32
+	ForwardErr, BackwardErr []float32
+}
+
+// SolveExpert32 is the float32 version of SolveExpert. The system is solved
+// by Sgesvx.
+func SolveExpert32(trans blas.Transpose, a, b blas32.General, equilibrate bool) (x blas32.General, info LUSolveInfo32, err error) {
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case a.Rows != a.Cols:
+		panic(badShapeA)
+	case b.Rows != a.Rows:
+		panic(badShapeB)
+	}
+	n := a.Rows
+	nrhs := b.Cols
+
+	x = newGeneral32(n, nrhs)
+	info = LUSolveInfo32{
+		Equilibration: NoEquilibration,
+		R:             make([]float32, n),
+		C:             make([]float32, n),
+		PivotGrowth:   1,
+		ForwardErr:    make([]float32, nrhs),
+		BackwardErr:   make([]float32, nrhs),
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		info.RCond = 1
+		return x, info, nil
+	}
+	if nrhs == 0 {
+		// Sgesvx requires a right-hand side to work on.
+		b = newGeneral32(n, 1)
+	}
+
+	fact := byte('N')
+	if equilibrate {
+		fact = 'E'
+	}
+	ac := cloneGeneral32(a)
+	bc := cloneGeneral32(b)
+	xc := x
+	if nrhs == 0 {
+		xc = newGeneral32(n, 1)
+	}
+	af := make([]float32, n*n)
+	ipiv := make([]lapacke.Int, n)
+	equed := []byte{'N'}
+	rcond := make([]float32, 1)
+	ferr := make([]float32, max(1, nrhs))
+	berr := make([]float32, max(1, nrhs))
+	work := make([]float32, 4*n)
+	iwork := make([]lapacke.Int, n)
+	ret := lapacke.SgesvxInfo(fact, byte(trans), n, bc.Cols, ac.Data, ac.Stride, af, n, ipiv, equed, info.R, info.C,
+		bc.Data, bc.Stride, xc.Data, xc.Stride, rcond, ferr, berr, work, iwork)
+
+	info.Equilibration = Equilibration(equed[0])
+	info.RCond = rcond[0]
+	info.PivotGrowth = work[0]
+	copy(info.ForwardErr, ferr)
+	copy(info.BackwardErr, berr)
+
+	switch {
+	case ret < 0:
+		panic("lapack: invalid argument to Sgesvx")
+	case 0 < ret && ret <= n:
+		return x, info, SingularError{Index: ret - 1}
+	case ret == n+1:
+		return x, info, Condition(1 / float64(info.RCond))
+	}
+	return x, info, nil
+}
+
+// CholSolveInfo32 is the float32 version of CholSolveInfo.
+type CholSolveInfo32 struct {
+	Equilibrated            bool
+	S                       []float32
+	RCond                   float32
+	ForwardErr, BackwardErr []float32
+}
+
+// SPDSolveExpert32 is the float32 version of SPDSolveExpert. The system is
+// solved by Sposvx.
+func SPDSolveExpert32(a blas32.Symmetric, b blas32.General, equilibrate bool) (x blas32.General, info CholSolveInfo32, err error) {
+	switch {
+	case a.Uplo != blas.Upper && a.Uplo != blas.Lower:
+		panic(badUplo)
+	case b.Rows != a.N:
+		panic(badShapeB)
+	}
+	n := a.N
+	nrhs := b.Cols
+
+	x = newGeneral32(n, nrhs)
+	info = CholSolveInfo32{
+		S:           make([]float32, n),
+		ForwardErr:  make([]float32, nrhs),
+		BackwardErr: make([]float32, nrhs),
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		info.RCond = 1
+		return x, info, nil
+	}
+	if nrhs == 0 {
+		// Sposvx requires a right-hand side to work on.
+		b = newGeneral32(n, 1)
+	}
+
+	fact := byte('N')
+	if equilibrate {
+		fact = 'E'
+	}
+	ac := cloneGeneral32(blas32.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data})
+	bc := cloneGeneral32(b)
+	xc := x
+	if nrhs == 0 {
+		xc = newGeneral32(n, 1)
+	}
+	af := make([]float32, n*n)
+	equed := []byte{'N'}
+	rcond := make([]float32, 1)
+	ferr := make([]float32, max(1, nrhs))
+	berr := make([]float32, max(1, nrhs))
+	work := make([]float32, 3*n)
+	iwork := make([]lapacke.Int, n)
+	ret := lapacke.SposvxInfo(fact, byte(a.Uplo), n, bc.Cols, ac.Data, ac.Stride, af, n, equed, info.S,
+		bc.Data, bc.Stride, xc.Data, xc.Stride, rcond, ferr, berr, work, iwork)
+
+	info.Equilibrated = equed[0] == 'Y'
+	info.RCond = rcond[0]
+	copy(info.ForwardErr, ferr)
+	copy(info.BackwardErr, berr)
+
+	switch {
+	case ret < 0:
+		panic("lapack: invalid argument to Sposvx")
+	case 0 < ret && ret <= n:
+		return x, info, ErrNotPositiveDefinite{Index: ret - 1}
+	case ret == n+1:
+		return x, info, Condition(1 / float64(info.RCond))
+	}
+	return x, info, nil
+}
@@ -0,0 +1,201 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+)
+
+func randomGeneral(rnd *rand.Rand, r, c, stride int) blas64.General {
+	a := blas64.General{
+		Rows:   r,
+		Cols:   c,
+		Stride: stride,
+		Data:   make([]float64, max(0, (r-1)*stride+c)),
+	}
+	for i := range a.Data {
+		a.Data[i] = rnd.NormFloat64()
+	}
+	return a
+}
+
+// residual returns max_j ||op(A)*x_j - b_j||_inf / (||A||_inf * ||x_j||_inf).
+func residual(trans blas.Transpose, a, x, b blas64.General) float64 {
+	r := cloneGeneral(b)
+	blas64.Gemm(trans, blas.NoTrans, 1, a, x, -1, r)
+	anorm := impl.Dlange('I', a.Rows, a.Cols, a.Data, a.Stride, nil)
+	var res float64
+	for j := 0; j < x.Cols; j++ {
+		var rmax, xmax float64
+		for i := 0; i < r.Rows; i++ {
+			rmax = math.Max(rmax, math.Abs(r.Data[i*r.Stride+j]))
+		}
+		for i := 0; i < x.Rows; i++ {
+			xmax = math.Max(xmax, math.Abs(x.Data[i*x.Stride+j]))
+		}
+		res = math.Max(res, rmax/(anorm*xmax))
+	}
+	return res
+}
+
+func TestSolveExpert(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+		for _, equilibrate := range []bool{false, true} {
+			for _, test := range []struct {
+				n, nrhs, lda, ldb int
+				scaled            bool
+			}{
+				{n: 1, nrhs: 1, lda: 1, ldb: 1},
+				{n: 5, nrhs: 2, lda: 5, ldb: 2},
+				{n: 10, nrhs: 3, lda: 12, ldb: 7},
+				{n: 10, nrhs: 3, lda: 10, ldb: 3, scaled: true},
+			} {
+				name := fmt.Sprintf("trans=%c,equilibrate=%t,%+v", trans, equilibrate, test)
+				a := randomGeneral(rnd, test.n, test.n, test.lda)
+				if test.scaled {
+					for i := 0; i < test.n; i++ {
+						blas64.Implementation().Dscal(test.n, math.Pow(10, float64(2*i)), a.Data[i*a.Stride:], 1)
+					}
+				}
+				b := randomGeneral(rnd, test.n, test.nrhs, test.ldb)
+				aCopy := cloneGeneral(a)
+				bCopy := cloneGeneral(b)
+
+				x, info, err := SolveExpert(trans, a, b, equilibrate)
+				if err != nil {
+					t.Errorf("%s: unexpected error: %v", name, err)
+					continue
+				}
+				if !floats.Equal(cloneGeneral(a).Data, aCopy.Data) || !floats.Equal(cloneGeneral(b).Data, bCopy.Data) {
+					t.Errorf("%s: inputs modified", name)
+				}
+				if res := residual(trans, a, x, b); res > tol {
+					t.Errorf("%s: residual too large: %v", name, res)
+				}
+				if info.RCond <= 0 || 1 < info.RCond {
+					t.Errorf("%s: invalid RCond: %v", name, info.RCond)
+				}
+				if !equilibrate && info.Equilibration != NoEquilibration {
+					t.Errorf("%s: unexpected equilibration %c", name, info.Equilibration)
+				}
+				if equilibrate && test.scaled && info.Equilibration == NoEquilibration {
+					t.Errorf("%s: badly scaled matrix not equilibrated", name)
+				}
+				for j := range info.BackwardErr {
+					if info.ForwardErr[j] < 0 || info.BackwardErr[j] > tol {
+						t.Errorf("%s: invalid error bounds for column %d: ferr=%v berr=%v", name, j, info.ForwardErr[j], info.BackwardErr[j])
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSolveExpertSingular(t *testing.T) {
+	a := blas64.General{
+		Rows: 3, Cols: 3, Stride: 3,
+		Data: []float64{
+			1, 2, 3,
+			2, 4, 6,
+			0, 0, 0,
+		},
+	}
+	b := blas64.General{Rows: 3, Cols: 1, Stride: 1, Data: []float64{1, 2, 3}}
+	_, info, err := SolveExpert(blas.NoTrans, a, b, false)
+	if _, ok := err.(SingularError); !ok {
+		t.Fatalf("unexpected error for singular matrix: %v", err)
+	}
+	if info.RCond != 0 {
+		t.Errorf("unexpected RCond for singular matrix: %v", info.RCond)
+	}
+}
+
+func TestSPDSolveExpert(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+		for _, equilibrate := range []bool{false, true} {
+			for _, test := range []struct {
+				n, nrhs int
+				scaled  bool
+			}{
+				{n: 1, nrhs: 1},
+				{n: 5, nrhs: 2},
+				{n: 10, nrhs: 3},
+				{n: 10, nrhs: 3, scaled: true},
+			} {
+				name := fmt.Sprintf("uplo=%c,equilibrate=%t,%+v", uplo, equilibrate, test)
+				n := test.n
+				a := randomSPD(rnd, n, n+2)
+				a.Uplo = uplo
+				if test.scaled {
+					// Replace A by D*A*D with D = diag(10^i).
+					for i := 0; i < n; i++ {
+						for j := 0; j < n; j++ {
+							a.Data[i*a.Stride+j] *= math.Pow(10, float64(i+j))
+						}
+					}
+				}
+				dense := blas64.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data}
+				aCopy := cloneGeneral(dense)
+				b := randomGeneral(rnd, n, test.nrhs, test.nrhs)
+				bCopy := cloneGeneral(b)
+
+				x, info, err := SPDSolveExpert(a, b, equilibrate)
+				if err != nil {
+					t.Errorf("%s: unexpected error: %v", name, err)
+					continue
+				}
+				if !floats.Equal(dense.Data, aCopy.Data) || !floats.Equal(b.Data, bCopy.Data) {
+					t.Errorf("%s: inputs modified", name)
+				}
+				if res := residual(blas.NoTrans, dense, x, b); res > tol {
+					t.Errorf("%s: residual too large: %v", name, res)
+				}
+				if info.RCond <= 0 || 1 < info.RCond {
+					t.Errorf("%s: invalid RCond: %v", name, info.RCond)
+				}
+				if !equilibrate && info.Equilibrated {
+					t.Errorf("%s: unexpected equilibration", name)
+				}
+				if equilibrate && test.scaled && !info.Equilibrated {
+					t.Errorf("%s: badly scaled matrix not equilibrated", name)
+				}
+				for j := range info.BackwardErr {
+					if info.ForwardErr[j] < 0 || info.BackwardErr[j] > tol {
+						t.Errorf("%s: invalid error bounds for column %d: ferr=%v berr=%v", name, j, info.ForwardErr[j], info.BackwardErr[j])
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSPDSolveExpertNotPositiveDefinite(t *testing.T) {
+	// The leading minor of order 2 is 1 - 4 < 0.
+	a := blas64.Symmetric{
+		Uplo: blas.Upper, N: 3, Stride: 3,
+		Data: []float64{
+			1, 2, 0,
+			2, 1, 0,
+			0, 0, 1,
+		},
+	}
+	b := blas64.General{Rows: 3, Cols: 1, Stride: 1, Data: []float64{1, 2, 3}}
+	_, _, err := SPDSolveExpert(a, b, false)
+	if e, ok := err.(ErrNotPositiveDefinite); !ok || e.Index != 1 {
+		t.Errorf("unexpected error for indefinite matrix: %v", err)
+	}
+}
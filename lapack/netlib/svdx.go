@@ -0,0 +1,141 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+const (
+	badSVDRange    = "lapack: bad singular value index range"
+	badSVDInterval = "lapack: bad singular value interval"
+)
+
+// SVDRange computes the singular values of the m×n matrix A with indices
+// il through iu-1 in descending order, so that SVDRange(a, 0, k) returns the
+// k largest singular values, together with the corresponding left and right
+// singular vectors. The returned u is m×k and vt is k×n with k = iu-il, and
+//
+//	A * V = U * diag(s)
+//
+// holds for the selected columns of U and V.
+//
+// The values are computed by the bisection based driver Dgesvdx, which
+// only computes the requested part of the spectrum and so is much cheaper
+// than a full decomposition when k is small relative to min(m, n).
+//
+// SVDRange panics unless 0 <= il <= iu <= min(m, n). The input a is not
+// modified. If the decomposition fails to converge, SVDRange returns
+// ErrIterationLimit.
+func SVDRange(a blas64.General, il, iu int) (s []float64, u, vt blas64.General, err error) {
+	if il < 0 || iu < il || min(a.Rows, a.Cols) < iu {
+		panic(badSVDRange)
+	}
+	if il == iu {
+		return nil, newGeneral(a.Rows, 0), newGeneral(0, a.Cols), nil
+	}
+	return svdx(a, 'I', 0, 0, il+1, iu)
+}
+
+// SVDInterval computes the singular values of the m×n matrix A in the
+// half-open interval (vl, vu] in descending order, together with the
+// corresponding left and right singular vectors, as described for
+// SVDRange. The number of returned values is not known in advance.
+//
+// SVDInterval panics unless 0 <= vl < vu. The input a is not modified. If
+// the decomposition fails to converge, SVDInterval returns
+// ErrIterationLimit.
+func SVDInterval(a blas64.General, vl, vu float64) (s []float64, u, vt blas64.General, err error) {
+	if vl < 0 || vu <= vl {
+		panic(badSVDInterval)
+	}
+	if min(a.Rows, a.Cols) == 0 {
+		return nil, newGeneral(a.Rows, 0), newGeneral(0, a.Cols), nil
+	}
+	return svdx(a, 'V', vl, vu, 0, 0)
+}
+
+// svdx calls Dgesvdx with the given range on a copy of a and trims the
+// results to the number of values found.
+func svdx(a blas64.General, rng byte, vl, vu float64, il, iu int) (s []float64, u, vt blas64.General, err error) {
+	m, n := a.Rows, a.Cols
+	k := min(m, n)
+	if rng == 'I' {
+		k = iu - il + 1
+	}
+	c := cloneGeneral(a)
+	s = make([]float64, min(m, n))
+	u = newGeneral(m, k)
+	vt = newGeneral(k, n)
+	ns := make([]lapacke.Int, 1)
+	iwork := make([]lapacke.Int, 12*min(m, n))
+
+	work := make([]float64, 1)
+	lapacke.Dgesvdx('V', 'V', rng, m, n, c.Data, c.Stride, vl, vu, il, iu, ns, s, u.Data, u.Stride, vt.Data, vt.Stride, work, -1, iwork)
+	work = make([]float64, int(work[0]))
+	ok := lapacke.Dgesvdx('V', 'V', rng, m, n, c.Data, c.Stride, vl, vu, il, iu, ns, s, u.Data, u.Stride, vt.Data, vt.Stride, work, len(work), iwork)
+	if !ok {
+		return nil, u, vt, ErrIterationLimit
+	}
+
+	nf := int(ns[0])
+	s = s[:nf]
+	if nf < k {
+		// Repack the leading nf columns of U.
+		t := newGeneral(m, nf)
+		for i := 0; i < m && nf > 0; i++ {
+			copy(t.Data[i*t.Stride:i*t.Stride+nf], u.Data[i*u.Stride:])
+		}
+		u = t
+		vt.Rows = nf
+		vt.Data = vt.Data[:nf*vt.Stride]
+	}
+	return s, u, vt, nil
+}
+
+// JacobiSVD computes the singular value decomposition
+//
+//	A = U * Σ * V^T
+//
+// of the m×n matrix A with m >= n by the preconditioned one-sided Jacobi
+// driver Dgejsv. The returned s holds the n singular values in descending
+// order, u is m×n and v is n×n.
+//
+// Unlike the bidiagonalization based drivers, which compute the singular
+// values with an error relative to the largest one, Dgejsv computes each
+// singular value to high relative accuracy when A = B * D with D diagonal
+// and B well conditioned. This is the case for graded matrices, where the
+// small singular values lost by Dgesvd and Dgesdd are still resolved.
+//
+// JacobiSVD panics if m < n. The input a is not modified. If the iteration
+// fails to converge, JacobiSVD returns ErrIterationLimit.
+func JacobiSVD(a blas64.General) (s []float64, u, v blas64.General, err error) {
+	m, n := a.Rows, a.Cols
+	if m < n {
+		panic(badShapeQR)
+	}
+	u = newGeneral(m, n)
+	v = newGeneral(n, n)
+	if n == 0 {
+		return nil, u, v, nil
+	}
+	c := cloneGeneral(a)
+	s = make([]float64, n)
+	work := make([]float64, max(7, max(2*m+n, 6*n+2*n*n)))
+	iwork := make([]lapacke.Int, max(3, m+3*n))
+	ok := lapacke.Dgejsv('C', 'U', 'V', 'R', 'N', 'N', m, n, c.Data, c.Stride, s, u.Data, u.Stride, v.Data, v.Stride, work, len(work), iwork)
+	if !ok {
+		return nil, u, v, ErrIterationLimit
+	}
+	// Dgejsv returns the singular values scaled by work[1]/work[0] to avoid
+	// overflow.
+	if scale := work[1] / work[0]; scale != 1 {
+		for i := range s {
+			s[i] *= scale
+		}
+	}
+	return s, u, v, nil
+}
@@ -0,0 +1,97 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// SVDRange32 is the float32 version of SVDRange. The values are computed by
+// Sgesvdx.
+func SVDRange32(a blas32.General, il, iu int) (s []float32, u, vt blas32.General, err error) {
+	if il < 0 || iu < il || min(a.Rows, a.Cols) < iu {
+		panic(badSVDRange)
+	}
+	if il == iu {
+		return nil, newGeneral32(a.Rows, 0), newGeneral32(0, a.Cols), nil
+	}
+	return svdx32(a, 'I', 0, 0, il+1, iu)
+}
+
+// SVDInterval32 is the float32 version of SVDInterval. The values are
+// computed by Sgesvdx.
+func SVDInterval32(a blas32.General, vl, vu float32) (s []float32, u, vt blas32.General, err error) {
+	if vl < 0 || vu <= vl {
+		panic(badSVDInterval)
+	}
+	if min(a.Rows, a.Cols) == 0 {
+		return nil, newGeneral32(a.Rows, 0), newGeneral32(0, a.Cols), nil
+	}
+	return svdx32(a, 'V', vl, vu, 0, 0)
+}
+
+func svdx32(a blas32.General, rng byte, vl, vu float32, il, iu int) (s []float32, u, vt blas32.General, err error) {
+	m, n := a.Rows, a.Cols
+	k := min(m, n)
+	if rng == 'I' {
+		k = iu - il + 1
+	}
+	c := cloneGeneral32(a)
+	s = make([]float32, min(m, n))
+	u = newGeneral32(m, k)
+	vt = newGeneral32(k, n)
+	ns := make([]lapacke.Int, 1)
+	iwork := make([]lapacke.Int, 12*min(m, n))
+
+	work := make([]float32, 1)
+	lapacke.Sgesvdx('V', 'V', rng, m, n, c.Data, c.Stride, vl, vu, il, iu, ns, s, u.Data, u.Stride, vt.Data, vt.Stride, work, -1, iwork)
+	work = make([]float32, int(work[0]))
+	ok := lapacke.Sgesvdx('V', 'V', rng, m, n, c.Data, c.Stride, vl, vu, il, iu, ns, s, u.Data, u.Stride, vt.Data, vt.Stride, work, len(work), iwork)
+	if !ok {
+		return nil, u, vt, ErrIterationLimit
+	}
+
+	nf := int(ns[0])
+	s = s[:nf]
+	if nf < k {
+		t := newGeneral32(m, nf)
+		for i := 0; i < m && nf > 0; i++ {
+			copy(t.Data[i*t.Stride:i*t.Stride+nf], u.Data[i*u.Stride:])
+		}
+		u = t
+		vt.Rows = nf
+		vt.Data = vt.Data[:nf*vt.Stride]
+	}
+	return s, u, vt, nil
+}
+
+// JacobiSVD32 is the float32 version of JacobiSVD. The decomposition is
+// computed by Sgejsv.
+func JacobiSVD32(a blas32.General) (s []float32, u, v blas32.General, err error) {
+	m, n := a.Rows, a.Cols
+	if m < n {
+		panic(badShapeQR)
+	}
+	u = newGeneral32(m, n)
+	v = newGeneral32(n, n)
+	if n == 0 {
+		return nil, u, v, nil
+	}
+	c := cloneGeneral32(a)
+	s = make([]float32, n)
+	work := make([]float32, max(7, max(2*m+n, 6*n+2*n*n)))
+	iwork := make([]lapacke.Int, max(3, m+3*n))
+	ok := lapacke.Sgejsv('C', 'U', 'V', 'R', 'N', 'N', m, n, c.Data, c.Stride, s, u.Data, u.Stride, v.Data, v.Stride, work, len(work), iwork)
+	if !ok {
+		return nil, u, v, ErrIterationLimit
+	}
+	if scale := work[1] / work[0]; scale != 1 {
+		for i := range s {
+			s[i] *= scale
+		}
+	}
+	return s, u, v, nil
+}
@@ -0,0 +1,170 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// singularValues returns the singular values of a in descending order as
+// computed by Dgesvd.
+func singularValues(a blas64.General) []float64 {
+	c := cloneGeneral(a)
+	s := make([]float64, min(a.Rows, a.Cols))
+	work := make([]float64, 1)
+	lapacke.Dgesvd('N', 'N', c.Rows, c.Cols, c.Data, c.Stride, s, nil, 1, nil, 1, work, -1)
+	work = make([]float64, int(work[0]))
+	lapacke.Dgesvd('N', 'N', c.Rows, c.Cols, c.Data, c.Stride, s, nil, 1, nil, 1, work, len(work))
+	return s
+}
+
+// svdResidual returns the largest element of |A*V - U*diag(s)|, where V is
+// v or v^T as given by tV.
+func svdResidual(a blas64.General, s []float64, u blas64.General, tV blas.Transpose, v blas64.General) float64 {
+	av := newGeneral(a.Rows, len(s))
+	if len(s) > 0 {
+		blas64.Gemm(blas.NoTrans, tV, 1, a, v, 0, av)
+	}
+	var r float64
+	for i := 0; i < a.Rows; i++ {
+		for j := range s {
+			r = math.Max(r, math.Abs(av.Data[i*av.Stride+j]-s[j]*u.Data[i*u.Stride+j]))
+		}
+	}
+	return r
+}
+
+func TestSVDRange(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n, il, iu int
+	}{
+		{m: 0, n: 3, il: 0, iu: 0},
+		{m: 5, n: 5, il: 0, iu: 5},
+		{m: 10, n: 6, il: 0, iu: 2},
+		{m: 6, n: 10, il: 2, iu: 5},
+		{m: 20, n: 20, il: 19, iu: 20},
+		{m: 7, n: 4, il: 1, iu: 1},
+	} {
+		name := fmt.Sprintf("m=%d,n=%d,il=%d,iu=%d", test.m, test.n, test.il, test.iu)
+		a := randomGeneral(rnd, test.m, test.n, max(1, test.n))
+		want := singularValues(a)[test.il:test.iu]
+		orig := cloneGeneral(a)
+
+		s, u, vt, err := SVDRange(a, test.il, test.iu)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if maxDiff(a, orig, false) != 0 {
+			t.Errorf("%s: input modified", name)
+		}
+		k := test.iu - test.il
+		if len(s) != k || u.Rows != test.m || u.Cols != k || vt.Rows != k || vt.Cols != test.n {
+			t.Errorf("%s: unexpected result shape: len(s)=%d, u %d×%d, vt %d×%d", name, len(s), u.Rows, u.Cols, vt.Rows, vt.Cols)
+			continue
+		}
+		if k == 0 {
+			continue
+		}
+		for i := range s {
+			if math.Abs(s[i]-want[i]) > tol*want[0] {
+				t.Errorf("%s: s[%d] = %v, want %v", name, i, s[i], want[i])
+			}
+		}
+		if r := svdResidual(a, s, u, blas.Trans, vt); r > tol*math.Max(1, want[0]) {
+			t.Errorf("%s: residual %v too large", name, r)
+		}
+	}
+}
+
+func TestSVDInterval(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n int
+	}{
+		{m: 5, n: 5},
+		{m: 12, n: 7},
+		{m: 7, n: 12},
+	} {
+		name := fmt.Sprintf("m=%d,n=%d", test.m, test.n)
+		a := randomGeneral(rnd, test.m, test.n, test.n)
+		all := singularValues(a)
+		// Select the interval between the second and fourth largest
+		// values, which contains the third and the fourth.
+		vl := (all[3] + all[4]) / 2
+		vu := (all[1] + all[2]) / 2
+		want := all[2:4]
+
+		s, u, vt, err := SVDInterval(a, vl, vu)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if len(s) != len(want) || u.Cols != len(want) || vt.Rows != len(want) {
+			t.Errorf("%s: got %d values, want %d", name, len(s), len(want))
+			continue
+		}
+		for i := range s {
+			if math.Abs(s[i]-want[i]) > tol*all[0] {
+				t.Errorf("%s: s[%d] = %v, want %v", name, i, s[i], want[i])
+			}
+		}
+		if r := svdResidual(a, s, u, blas.Trans, vt); r > tol*all[0] {
+			t.Errorf("%s: residual %v too large", name, r)
+		}
+	}
+}
+
+func TestJacobiSVD(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		m, n int
+	}{
+		{m: 1, n: 1},
+		{m: 6, n: 6},
+		{m: 12, n: 8},
+	} {
+		name := fmt.Sprintf("m=%d,n=%d", test.m, test.n)
+		// A = Q * D with orthonormal columns in Q and a strongly graded
+		// diagonal D has the singular values |D| exactly, and Dgejsv
+		// resolves them all to high relative accuracy.
+		q, err := Orth(randomGeneral(rnd, test.m, test.n, test.n), DefaultRCond)
+		if err != nil || q.Cols != test.n {
+			t.Fatalf("%s: unexpected orthogonal basis", name)
+		}
+		want := make([]float64, test.n)
+		a := cloneGeneral(q)
+		for j := range want {
+			want[j] = math.Pow(10, -2*float64(j))
+			blas64.Scal(want[j], blas64.Vector{N: test.m, Data: a.Data[j:], Inc: a.Stride})
+		}
+
+		s, u, v, err := JacobiSVD(a)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		for i := range want {
+			if math.Abs(s[i]-want[i]) > tol*want[i] {
+				t.Errorf("%s: s[%d] = %v, want %v", name, i, s[i], want[i])
+			}
+		}
+		if r := svdResidual(a, s, u, blas.NoTrans, v); r > tol {
+			t.Errorf("%s: residual %v too large", name, r)
+		}
+	}
+}
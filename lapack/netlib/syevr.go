@@ -0,0 +1,110 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// EVRange specifies the eigenvalues computed by Dsyevr and Zheevr.
+type EVRange byte
+
+const (
+	// EVAll selects all eigenvalues.
+	EVAll EVRange = 'A'
+	// EVValue selects the eigenvalues in a half-open interval (vl, vu].
+	EVValue EVRange = 'V'
+	// EVIndex selects the eigenvalues with indices il through iu.
+	EVIndex EVRange = 'I'
+)
+
+const (
+	badEVRange    = "lapack: bad EVRange"
+	badEVInterval = "lapack: vu <= vl"
+	badEVIndex    = "lapack: eigenvalue index out of range"
+	shortIsuppz   = "lapack: insufficient length of isuppz"
+)
+
+// SymEigRange computes the eigenvalues of the symmetric n×n matrix A with
+// indices il through iu-1 in ascending order, so that SymEigRange(a, 0, k)
+// returns the k smallest eigenvalues, and the corresponding orthonormal
+// eigenvectors, returned as the columns of the n×(iu-il) matrix v.
+//
+// The eigenpairs are computed by the MRRR driver Dsyevr, which only computes
+// the requested part of the spectrum after the reduction to tridiagonal
+// form, unlike Dsyevd, which always computes all of it.
+//
+// SymEigRange panics unless 0 <= il <= iu <= n. The input a is not modified.
+// If the driver fails to converge, SymEigRange returns ErrIterationLimit.
+func SymEigRange(a blas64.Symmetric, il, iu int) (w []float64, v blas64.General, err error) {
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	if il < 0 || iu < il || a.N < iu {
+		panic(badEVIndex)
+	}
+	if il == iu {
+		return nil, newGeneral(a.N, 0), nil
+	}
+	return symEigRange(a, EVIndex, 0, 0, il, iu-1)
+}
+
+// SymEigInterval computes the eigenvalues of the symmetric n×n matrix A in
+// the half-open interval (vl, vu] in ascending order and the corresponding
+// orthonormal eigenvectors by Dsyevr, as described for SymEigRange. The
+// number of returned eigenvalues is not known in advance.
+//
+// SymEigInterval panics if vu <= vl. The input a is not modified. If the
+// driver fails to converge, SymEigInterval returns ErrIterationLimit.
+func SymEigInterval(a blas64.Symmetric, vl, vu float64) (w []float64, v blas64.General, err error) {
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	if vu <= vl {
+		panic(badEVInterval)
+	}
+	if a.N == 0 {
+		return nil, newGeneral(0, 0), nil
+	}
+	return symEigRange(a, EVValue, vl, vu, 0, 0)
+}
+
+// symEigRange calls Dsyevr with the given range on a copy of a and trims
+// the results to the number of eigenvalues found. il and iu are zero-based
+// and inclusive.
+func symEigRange(a blas64.Symmetric, rng EVRange, vl, vu float64, il, iu int) (w []float64, v blas64.General, err error) {
+	n := a.N
+	k := n
+	if rng == EVIndex {
+		k = iu - il + 1
+	}
+	c := cloneGeneral(blas64.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data})
+	w = make([]float64, n)
+	v = newGeneral(n, k)
+	found := make([]lapacke.Int, 1)
+	isuppz := make([]lapacke.Int, 2*k)
+	work := make([]float64, 1)
+	iwork := make([]lapacke.Int, 1)
+	lapacke.Dsyevr('V', byte(rng), byte(a.Uplo), n, c.Data, c.Stride, vl, vu, il+1, iu+1, 0, found, w, v.Data, v.Stride, isuppz, work, -1, iwork, -1)
+	work = make([]float64, int(work[0]))
+	iwork = make([]lapacke.Int, int(iwork[0]))
+	ok := lapacke.Dsyevr('V', byte(rng), byte(a.Uplo), n, c.Data, c.Stride, vl, vu, il+1, iu+1, 0, found, w, v.Data, v.Stride, isuppz, work, len(work), iwork, len(iwork))
+	if !ok {
+		return nil, v, ErrIterationLimit
+	}
+
+	m := int(found[0])
+	w = w[:m]
+	if m < k {
+		t := newGeneral(n, m)
+		for i := 0; i < n && m > 0; i++ {
+			copy(t.Data[i*t.Stride:i*t.Stride+m], v.Data[i*v.Stride:])
+		}
+		v = t
+	}
+	return w, v, nil
+}
@@ -0,0 +1,74 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+// SymEigRange32 is the float32 version of SymEigRange. The eigenpairs are
+// computed by Ssyevr.
+func SymEigRange32(a blas32.Symmetric, il, iu int) (w []float32, v blas32.General, err error) {
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	if il < 0 || iu < il || a.N < iu {
+		panic(badEVIndex)
+	}
+	if il == iu {
+		return nil, newGeneral32(a.N, 0), nil
+	}
+	return symEigRange32(a, EVIndex, 0, 0, il, iu-1)
+}
+
+// SymEigInterval32 is the float32 version of SymEigInterval. The eigenpairs
+// are computed by Ssyevr.
+func SymEigInterval32(a blas32.Symmetric, vl, vu float32) (w []float32, v blas32.General, err error) {
+	if a.Uplo != blas.Upper && a.Uplo != blas.Lower {
+		panic(badUplo)
+	}
+	if vu <= vl {
+		panic(badEVInterval)
+	}
+	if a.N == 0 {
+		return nil, newGeneral32(0, 0), nil
+	}
+	return symEigRange32(a, EVValue, vl, vu, 0, 0)
+}
+
+func symEigRange32(a blas32.Symmetric, rng EVRange, vl, vu float32, il, iu int) (w []float32, v blas32.General, err error) {
+	n := a.N
+	k := n
+	if rng == EVIndex {
+		k = iu - il + 1
+	}
+	c := cloneGeneral32(blas32.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data})
+	w = make([]float32, n)
+	v = newGeneral32(n, k)
+	found := make([]lapacke.Int, 1)
+	isuppz := make([]lapacke.Int, 2*k)
+	work := make([]float32, 1)
+	iwork := make([]lapacke.Int, 1)
+	lapacke.Ssyevr('V', byte(rng), byte(a.Uplo), n, c.Data, c.Stride, vl, vu, il+1, iu+1, 0, found, w, v.Data, v.Stride, isuppz, work, -1, iwork, -1)
+	work = make([]float32, int(work[0]))
+	iwork = make([]lapacke.Int, int(iwork[0]))
+	ok := lapacke.Ssyevr('V', byte(rng), byte(a.Uplo), n, c.Data, c.Stride, vl, vu, il+1, iu+1, 0, found, w, v.Data, v.Stride, isuppz, work, len(work), iwork, len(iwork))
+	if !ok {
+		return nil, v, ErrIterationLimit
+	}
+
+	m := int(found[0])
+	w = w[:m]
+	if m < k {
+		t := newGeneral32(n, m)
+		for i := 0; i < n && m > 0; i++ {
+			copy(t.Data[i*t.Stride:i*t.Stride+m], v.Data[i*v.Stride:])
+		}
+		v = t
+	}
+	return w, v, nil
+}
@@ -0,0 +1,201 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// eigResidual returns the largest element of |A*V - V*diag(w)| for the
+// symmetric matrix A.
+func eigResidual(a blas64.Symmetric, w []float64, v blas64.General) float64 {
+	av := newGeneral(a.N, len(w))
+	if len(w) > 0 {
+		blas64.Symm(blas.Left, 1, a, v, 0, av)
+	}
+	var r float64
+	for i := 0; i < a.N; i++ {
+		for j := range w {
+			r = math.Max(r, math.Abs(av.Data[i*av.Stride+j]-w[j]*v.Data[i*v.Stride+j]))
+		}
+	}
+	return r
+}
+
+// evrSpectrum returns the eigenvalues 1, 2, ..., n.
+func evrSpectrum(n int) []float64 {
+	lambda := make([]float64, n)
+	for i := range lambda {
+		lambda[i] = float64(i + 1)
+	}
+	return lambda
+}
+
+func TestDsyevr(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 6, 15} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, rng := range []EVRange{EVAll, EVValue, EVIndex} {
+				name := fmt.Sprintf("n=%d,uplo=%c,rng=%c", n, uplo, rng)
+				lambda := evrSpectrum(n)
+				a := symmetricWithSpectrum(rnd, lambda, uplo)
+				// Select the upper half of the spectrum.
+				il, iu := n/2, n-1
+				vl, vu := float64(n/2)+0.5, float64(n)+0.5
+				want := lambda[n/2:]
+				ncols := n
+				switch rng {
+				case EVAll:
+					want = lambda
+				case EVIndex:
+					ncols = iu - il + 1
+				}
+
+				c := cloneGeneral(blas64.General{Rows: n, Cols: n, Stride: a.Stride, Data: a.Data})
+				w := make([]float64, n)
+				z := newGeneral(n, ncols)
+				isuppz := make([]int, 2*ncols)
+				work := make([]float64, 1)
+				iwork := make([]int, 1)
+				impl.Dsyevr(lapack.EVCompute, rng, uplo, n, c.Data, c.Stride, vl, vu, il, iu, 0, w, z.Data, z.Stride, isuppz, work, -1, iwork, -1)
+				work = make([]float64, int(work[0]))
+				iwork = make([]int, iwork[0])
+				m, ok := impl.Dsyevr(lapack.EVCompute, rng, uplo, n, c.Data, c.Stride, vl, vu, il, iu, 0, w, z.Data, z.Stride, isuppz, work, len(work), iwork, len(iwork))
+				if !ok {
+					t.Errorf("%s: Dsyevr did not converge", name)
+					continue
+				}
+				if m != len(want) {
+					t.Errorf("%s: unexpected number of eigenvalues: got %d, want %d", name, m, len(want))
+					continue
+				}
+				for i := 0; i < m; i++ {
+					if math.Abs(w[i]-want[i]) > tol*float64(n) {
+						t.Errorf("%s: unexpected eigenvalue %d: got %v, want %v", name, i, w[i], want[i])
+					}
+				}
+				v := blas64.General{Rows: n, Cols: m, Stride: z.Stride, Data: z.Data}
+				if r := eigResidual(a, w[:m], v); r > tol*float64(n*n) {
+					t.Errorf("%s: residual %v too large", name, r)
+				}
+				for i := 0; i < m; i++ {
+					if isuppz[2*i] < 0 || isuppz[2*i+1] < isuppz[2*i] || n <= isuppz[2*i+1] {
+						t.Errorf("%s: bad support of eigenvector %d: [%d,%d]", name, i, isuppz[2*i], isuppz[2*i+1])
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSymEigRange(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		n, il, iu int
+	}{
+		{n: 0, il: 0, iu: 0},
+		{n: 1, il: 0, iu: 1},
+		{n: 8, il: 0, iu: 3},
+		{n: 8, il: 5, iu: 8},
+		{n: 20, il: 7, iu: 7},
+		{n: 20, il: 0, iu: 20},
+	} {
+		name := fmt.Sprintf("n=%d,il=%d,iu=%d", test.n, test.il, test.iu)
+		lambda := evrSpectrum(test.n)
+		a := symmetricWithSpectrum(rnd, lambda, blas.Upper)
+		orig := append([]float64(nil), a.Data...)
+
+		w, v, err := SymEigRange(a, test.il, test.iu)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if !floats.Equal(a.Data, orig) {
+			t.Errorf("%s: input modified", name)
+		}
+		want := lambda[test.il:test.iu]
+		if len(w) != len(want) || v.Rows != test.n || v.Cols != len(want) {
+			t.Errorf("%s: unexpected result shape: len(w)=%d, v %d×%d", name, len(w), v.Rows, v.Cols)
+			continue
+		}
+		for i := range w {
+			if math.Abs(w[i]-want[i]) > tol*float64(test.n) {
+				t.Errorf("%s: w[%d] = %v, want %v", name, i, w[i], want[i])
+			}
+		}
+		if r := eigResidual(a, w, v); r > tol*float64(test.n*test.n) {
+			t.Errorf("%s: residual %v too large", name, r)
+		}
+	}
+}
+
+func TestSymEigInterval(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	const n = 12
+	lambda := evrSpectrum(n)
+	a := symmetricWithSpectrum(rnd, lambda, blas.Lower)
+	for _, test := range []struct {
+		vl, vu float64
+		want   []float64
+	}{
+		{vl: 0, vu: 0.5},
+		{vl: 2.5, vu: 5.5, want: []float64{3, 4, 5}},
+		{vl: -1, vu: 100, want: lambda},
+	} {
+		name := fmt.Sprintf("vl=%v,vu=%v", test.vl, test.vu)
+		w, v, err := SymEigInterval(a, test.vl, test.vu)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if len(w) != len(test.want) || v.Cols != len(test.want) {
+			t.Errorf("%s: got %d eigenvalues, want %d", name, len(w), len(test.want))
+			continue
+		}
+		for i := range w {
+			if math.Abs(w[i]-test.want[i]) > tol*n {
+				t.Errorf("%s: w[%d] = %v, want %v", name, i, w[i], test.want[i])
+			}
+		}
+		if r := eigResidual(a, w, v); r > tol*n*n {
+			t.Errorf("%s: residual %v too large", name, r)
+		}
+	}
+}
+
+func TestSymEigRange32(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	const n = 10
+	lambda := evrSpectrum(n)
+	a := symmetricWithSpectrum(rnd, lambda, blas.Upper)
+	a32 := symmetric32(a)
+
+	w, v, err := SymEigRange32(a32, 2, 6)
+	if err != nil || v.Cols != 4 {
+		t.Fatalf("unexpected SymEigRange32 result: err=%v, %d columns", err, v.Cols)
+	}
+	if d := maxDiff32(w, lambda[2:6]); d > tol32 {
+		t.Errorf("unexpected SymEigRange32 eigenvalues: got %v, want %v", w, lambda[2:6])
+	}
+	w, _, err = SymEigInterval32(a32, 6.5, 9.5)
+	if err != nil {
+		t.Fatalf("unexpected SymEigInterval32 error: %v", err)
+	}
+	if d := maxDiff32(w, lambda[6:9]); d > tol32 {
+		t.Errorf("unexpected SymEigInterval32 eigenvalues: got %v, want %v", w, lambda[6:9])
+	}
+}
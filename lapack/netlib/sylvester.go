@@ -0,0 +1,117 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"errors"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// ErrCloseEigenvalues is returned by Sylvester and Lyapunov when A and -B
+// have common or very close eigenvalues, so that the equation is singular or
+// nearly singular. The solution of a slightly perturbed equation is still
+// returned.
+var ErrCloseEigenvalues = errors.New("lapack: A and -B have close eigenvalues")
+
+// Sylvester solves the Sylvester equation
+//
+//	A * X + X * B = C
+//
+// for the m×n matrix X, where A is m×m, B is n×n and C is m×n, by the
+// Bartels–Stewart method: A and B are reduced to real Schur form by Dgees,
+// the transformed equation is solved by Dtrsyl and the solution is
+// transformed back. The inputs are not modified.
+//
+// If the Schur factorization of A or B fails, Sylvester returns its error.
+// If A and -B have close eigenvalues, it returns ErrCloseEigenvalues
+// together with the solution of a perturbed equation.
+func Sylvester(a, b, c blas64.General) (x blas64.General, err error) {
+	switch {
+	case a.Rows != a.Cols || b.Rows != b.Cols:
+		panic(badShapeA)
+	case c.Rows != a.Rows || c.Cols != b.Rows:
+		panic(badShapeB)
+	}
+	m, n := a.Rows, b.Rows
+	if m == 0 || n == 0 {
+		return newGeneral(m, n), nil
+	}
+	ta, u, _, _, err := Schur(a, nil)
+	if err != nil {
+		return blas64.General{}, err
+	}
+	tb, v, _, _, err := Schur(b, nil)
+	if err != nil {
+		return blas64.General{}, err
+	}
+	// Solve Ta * Y + Y * Tb = Uᵀ * C * V and form X = U * Y * Vᵀ.
+	y := sandwich(blas.Trans, u, c, blas.NoTrans, v)
+	scale, ok := lapackImpl.Dtrsyl(blas.NoTrans, blas.NoTrans, 1, m, n, ta.Data, ta.Stride, tb.Data, tb.Stride, y.Data, y.Stride)
+	x = sandwich(blas.NoTrans, u, y, blas.Trans, v)
+	unscale(x, scale)
+	if !ok {
+		return x, ErrCloseEigenvalues
+	}
+	return x, nil
+}
+
+// Lyapunov solves the continuous Lyapunov equation
+//
+//	A * X + X * Aᵀ = C
+//
+// for the n×n matrix X by the Bartels–Stewart method, reducing A to real
+// Schur form once by Dgees and solving the transformed equation by Dtrsyl.
+// If C is symmetric, so is X up to rounding. The inputs are not modified.
+//
+// If the Schur factorization of A fails, Lyapunov returns its error. If A
+// and -A have close eigenvalues, as when A has an eigenvalue close to the
+// imaginary axis, it returns ErrCloseEigenvalues together with the solution
+// of a perturbed equation.
+func Lyapunov(a, c blas64.General) (x blas64.General, err error) {
+	switch {
+	case a.Rows != a.Cols:
+		panic(badShapeA)
+	case c.Rows != a.Rows || c.Cols != a.Rows:
+		panic(badShapeB)
+	}
+	n := a.Rows
+	if n == 0 {
+		return newGeneral(0, 0), nil
+	}
+	t, u, _, _, err := Schur(a, nil)
+	if err != nil {
+		return blas64.General{}, err
+	}
+	// Solve T * Y + Y * Tᵀ = Uᵀ * C * U and form X = U * Y * Uᵀ.
+	y := sandwich(blas.Trans, u, c, blas.NoTrans, u)
+	scale, ok := lapackImpl.Dtrsyl(blas.NoTrans, blas.Trans, 1, n, n, t.Data, t.Stride, t.Data, t.Stride, y.Data, y.Stride)
+	x = sandwich(blas.NoTrans, u, y, blas.Trans, u)
+	unscale(x, scale)
+	if !ok {
+		return x, ErrCloseEigenvalues
+	}
+	return x, nil
+}
+
+// sandwich returns op(P) * C * op(Q) for square P and Q.
+func sandwich(tP blas.Transpose, p, c blas64.General, tQ blas.Transpose, q blas64.General) blas64.General {
+	t := newGeneral(c.Rows, q.Rows)
+	blasImpl.Dgemm(blas.NoTrans, tQ, c.Rows, q.Rows, c.Cols, 1, c.Data, c.Stride, q.Data, q.Stride, 0, t.Data, t.Stride)
+	r := newGeneral(p.Rows, q.Rows)
+	blasImpl.Dgemm(tP, blas.NoTrans, p.Rows, q.Rows, c.Rows, 1, p.Data, p.Stride, t.Data, t.Stride, 0, r.Data, r.Stride)
+	return r
+}
+
+// unscale divides x by the scale factor returned by Dtrsyl.
+func unscale(x blas64.General, scale float64) {
+	if scale == 1 {
+		return
+	}
+	for i := 0; i < x.Rows; i++ {
+		blasImpl.Dscal(x.Cols, 1/scale, x.Data[i*x.Stride:], 1)
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+)
+
+// Sylvester32 is the float32 version of Sylvester. The Schur forms are
+// computed by Sgees and the transformed equation is solved by Strsyl.
+func Sylvester32(a, b, c blas32.General) (x blas32.General, err error) {
+	switch {
+	case a.Rows != a.Cols || b.Rows != b.Cols:
+		panic(badShapeA)
+	case c.Rows != a.Rows || c.Cols != b.Rows:
+		panic(badShapeB)
+	}
+	m, n := a.Rows, b.Rows
+	if m == 0 || n == 0 {
+		return newGeneral32(m, n), nil
+	}
+	ta, u, _, _, err := Schur32(a, nil)
+	if err != nil {
+		return blas32.General{}, err
+	}
+	tb, v, _, _, err := Schur32(b, nil)
+	if err != nil {
+		return blas32.General{}, err
+	}
+	y := sandwich32(blas.Trans, u, c, blas.NoTrans, v)
+	scale, ok := lapackImpl.Strsyl(blas.NoTrans, blas.NoTrans, 1, m, n, ta.Data, ta.Stride, tb.Data, tb.Stride, y.Data, y.Stride)
+	x = sandwich32(blas.NoTrans, u, y, blas.Trans, v)
+	unscale32(x, scale)
+	if !ok {
+		return x, ErrCloseEigenvalues
+	}
+	return x, nil
+}
+
+// Lyapunov32 is the float32 version of Lyapunov. The Schur form is computed
+// by Sgees and the transformed equation is solved by Strsyl.
+func Lyapunov32(a, c blas32.General) (x blas32.General, err error) {
+	switch {
+	case a.Rows != a.Cols:
+		panic(badShapeA)
+	case c.Rows != a.Rows || c.Cols != a.Rows:
+		panic(badShapeB)
+	}
+	n := a.Rows
+	if n == 0 {
+		return newGeneral32(0, 0), nil
+	}
+	t, u, _, _, err := Schur32(a, nil)
+	if err != nil {
+		return blas32.General{}, err
+	}
+	y := sandwich32(blas.Trans, u, c, blas.NoTrans, u)
+	scale, ok := lapackImpl.Strsyl(blas.NoTrans, blas.Trans, 1, n, n, t.Data, t.Stride, t.Data, t.Stride, y.Data, y.Stride)
+	x = sandwich32(blas.NoTrans, u, y, blas.Trans, u)
+	unscale32(x, scale)
+	if !ok {
+		return x, ErrCloseEigenvalues
+	}
+	return x, nil
+}
+
+// sandwich32 is the float32 version of sandwich.
+func sandwich32(tP blas.Transpose, p, c blas32.General, tQ blas.Transpose, q blas32.General) blas32.General {
+	t := newGeneral32(c.Rows, q.Rows)
+	blasImpl.Sgemm(blas.NoTrans, tQ, c.Rows, q.Rows, c.Cols, 1, c.Data, c.Stride, q.Data, q.Stride, 0, t.Data, t.Stride)
+	r := newGeneral32(p.Rows, q.Rows)
+	blasImpl.Sgemm(tP, blas.NoTrans, p.Rows, q.Rows, c.Rows, 1, p.Data, p.Stride, t.Data, t.Stride, 0, r.Data, r.Stride)
+	return r
+}
+
+// unscale32 is the float32 version of unscale.
+func unscale32(x blas32.General, scale float32) {
+	if scale == 1 {
+		return
+	}
+	for i := 0; i < x.Rows; i++ {
+		blasImpl.Sscal(x.Cols, 1/scale, x.Data[i*x.Stride:], 1)
+	}
+}
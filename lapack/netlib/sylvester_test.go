@@ -0,0 +1,174 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/floats"
+)
+
+// shifted returns a random n×n matrix with shift added to its diagonal.
+func shifted(rnd *rand.Rand, n int, shift float64) blas64.General {
+	a := randomGeneral(rnd, n, n, max(1, n))
+	for i := 0; i < n; i++ {
+		a.Data[i*a.Stride+i] += shift
+	}
+	return a
+}
+
+// add returns a + b.
+func add(a, b blas64.General) blas64.General {
+	c := newGeneral(a.Rows, a.Cols)
+	for i := 0; i < a.Rows; i++ {
+		for j := 0; j < a.Cols; j++ {
+			c.Data[i*c.Stride+j] = a.Data[i*a.Stride+j] + b.Data[i*b.Stride+j]
+		}
+	}
+	return c
+}
+
+func TestSylvester(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{0, 1, 4, 13} {
+		for _, n := range []int{0, 1, 3, 10} {
+			name := fmt.Sprintf("m=%d,n=%d", m, n)
+			// The eigenvalues of A and -B are separated by the shifts.
+			a := shifted(rnd, m, 3*math.Sqrt(float64(m+1)))
+			b := shifted(rnd, n, 3*math.Sqrt(float64(n+1)))
+			want := randomGeneral(rnd, m, n, max(1, n))
+			c := add(mul(a, want), mul(want, b))
+			aCopy := cloneGeneral(a)
+			bCopy := cloneGeneral(b)
+			cCopy := cloneGeneral(c)
+
+			x, err := Sylvester(a, b, c)
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", name, err)
+				continue
+			}
+			if !floats.Equal(a.Data, aCopy.Data) || !floats.Equal(b.Data, bCopy.Data) || !floats.Equal(c.Data, cCopy.Data) {
+				t.Errorf("%s: inputs modified", name)
+			}
+			if x.Rows != m || x.Cols != n {
+				t.Fatalf("%s: unexpected shape %d×%d", name, x.Rows, x.Cols)
+			}
+			if d := maxDiff(x, want, false); d > 1e-12 {
+				t.Errorf("%s: unexpected solution: difference %v", name, d)
+			}
+		}
+	}
+}
+
+func TestSylvesterCloseEigenvalues(t *testing.T) {
+	a := blas64.General{Rows: 2, Cols: 2, Stride: 2, Data: []float64{
+		1, 0,
+		0, 2,
+	}}
+	b := blas64.General{Rows: 2, Cols: 2, Stride: 2, Data: []float64{
+		-1, 0,
+		0, 3,
+	}}
+	c := blas64.General{Rows: 2, Cols: 2, Stride: 2, Data: []float64{
+		1, 1,
+		1, 1,
+	}}
+	x, err := Sylvester(a, b, c)
+	if err != ErrCloseEigenvalues {
+		t.Errorf("unexpected error: got %v want %v", err, ErrCloseEigenvalues)
+	}
+	if x.Rows != 2 || x.Cols != 2 {
+		t.Errorf("unexpected shape %d×%d", x.Rows, x.Cols)
+	}
+}
+
+func TestLyapunov(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 5, 16} {
+		name := fmt.Sprintf("n=%d", n)
+		// A is stable, so that A and -A have no common eigenvalues.
+		a := shifted(rnd, n, -3*math.Sqrt(float64(n+1)))
+		g := randomGeneral(rnd, n, n, max(1, n))
+		want := add(g, transpose(g))
+		c := add(mul(a, want), mul(want, transpose(a)))
+
+		x, err := Lyapunov(a, c)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if d := maxDiff(x, want, false); d > 1e-12 {
+			t.Errorf("%s: unexpected solution: difference %v", name, d)
+		}
+		if d := maxDiff(x, x, true); d > 1e-12 {
+			t.Errorf("%s: solution not symmetric: difference %v", name, d)
+		}
+	}
+}
+
+// transpose returns the transpose of a.
+func transpose(a blas64.General) blas64.General {
+	t := newGeneral(a.Cols, a.Rows)
+	for i := 0; i < a.Rows; i++ {
+		for j := 0; j < a.Cols; j++ {
+			t.Data[j*t.Stride+i] = a.Data[i*a.Stride+j]
+		}
+	}
+	return t
+}
+
+func TestZtrsyl(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	const m, n = 6, 4
+	upper := func(a cblas128.General, shift complex128) {
+		for i := 0; i < a.Rows; i++ {
+			a.Data[i*a.Stride+i] += shift
+			for j := 0; j < i; j++ {
+				a.Data[i*a.Stride+j] = 0
+			}
+		}
+	}
+	for _, trana := range []blas.Transpose{blas.NoTrans, blas.ConjTrans} {
+		for _, tranb := range []blas.Transpose{blas.NoTrans, blas.ConjTrans} {
+			for _, isgn := range []int{1, -1} {
+				name := fmt.Sprintf("trana=%c,tranb=%c,isgn=%d", trana, tranb, isgn)
+				a := randomCGeneral(rnd, m, m, m)
+				upper(a, 8)
+				b := randomCGeneral(rnd, n, n, n)
+				upper(b, complex(8*float64(isgn), 0))
+				want := randomCGeneral(rnd, m, n, n)
+				opA, opB := a, b
+				if trana == blas.ConjTrans {
+					opA = conjTrans(a)
+				}
+				if tranb == blas.ConjTrans {
+					opB = conjTrans(b)
+				}
+				c := naiveCMul(opA, want)
+				xb := naiveCMul(want, opB)
+				for i := range c.Data {
+					c.Data[i] += complex(float64(isgn), 0) * xb.Data[i]
+				}
+				scale, ok := impl.Ztrsyl(trana, tranb, isgn, m, n, a.Data, a.Stride, b.Data, b.Stride, c.Data, c.Stride)
+				if !ok {
+					t.Errorf("%s: unexpected close eigenvalues", name)
+				}
+				for i := range c.Data {
+					c.Data[i] /= complex(scale, 0)
+				}
+				if d := cmaxAbsDiff(c, want); d > ztol {
+					t.Errorf("%s: unexpected solution: difference %v", name, d)
+				}
+			}
+		}
+	}
+}
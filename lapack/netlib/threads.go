@@ -0,0 +1,21 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import blasnetlib "gonum.org/v1/netlib/blas/netlib"
+
+// SetNumThreads sets the number of threads used by the linked library, which
+// provides both the BLAS and the LAPACK routines. It is equivalent to
+// SetNumThreads of gonum.org/v1/netlib/blas/netlib.
+func SetNumThreads(n int) {
+	blasnetlib.SetNumThreads(n)
+}
+
+// NumThreads returns the number of threads used by the linked library, or
+// zero if the library provides no thread control. It is equivalent to
+// NumThreads of gonum.org/v1/netlib/blas/netlib.
+func NumThreads() int {
+	return blasnetlib.NumThreads()
+}
@@ -0,0 +1,120 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/lapack"
+)
+
+const badTridiag = "lapack: bad length of tridiagonal"
+
+// TridiagSolve solves the system of linear equations A * X = B, where A is
+// the n×n tridiagonal matrix with the sub-diagonal dl, the diagonal d and the
+// super-diagonal du, by Gaussian elimination with partial pivoting by Dgtsv.
+// d must have length n and dl and du length n-1, where n is the number of
+// rows of b. The cost is O(n) per column of B.
+//
+// The inputs are not modified. If A is exactly singular, TridiagSolve
+// returns a SingularError and no solution is computed.
+func TridiagSolve(dl, d, du []float64, b blas64.General) (blas64.General, error) {
+	n := b.Rows
+	if len(d) != n || len(dl) != max(0, n-1) || len(du) != max(0, n-1) {
+		panic(badTridiag)
+	}
+	x := cloneGeneral(b)
+	if n == 0 {
+		return x, nil
+	}
+	dlc := append([]float64(nil), dl...)
+	dc := append([]float64(nil), d...)
+	duc := append([]float64(nil), du...)
+	if !lapackImpl.Dgtsv(n, x.Cols, dlc, dc, duc, x.Data, x.Stride) {
+		// Find the first zero on the diagonal of U.
+		i := 0
+		for i < n-1 && dc[i] != 0 {
+			i++
+		}
+		return x, SingularError{Index: i}
+	}
+	return x, nil
+}
+
+// SPDTridiagSolve solves the system of linear equations A * X = B, where A
+// is the n×n symmetric positive definite tridiagonal matrix with the
+// diagonal d and the off-diagonal e, by the L*D*L^T factorization of Dptsv.
+// d must have length n and e length n-1, where n is the number of rows of b.
+//
+// The inputs are not modified. If A is not positive definite,
+// SPDTridiagSolve returns an ErrNotPositiveDefinite and no solution is
+// computed.
+func SPDTridiagSolve(d, e []float64, b blas64.General) (blas64.General, error) {
+	n := b.Rows
+	if len(d) != n || len(e) != max(0, n-1) {
+		panic(badTridiag)
+	}
+	x := cloneGeneral(b)
+	if n == 0 {
+		return x, nil
+	}
+	dc := append([]float64(nil), d...)
+	ec := append([]float64(nil), e...)
+	if !lapackImpl.Dptsv(n, x.Cols, dc, ec, x.Data, x.Stride) {
+		// The factorization stops at the first non-positive element of D.
+		i := 0
+		for i < n-1 && dc[i] > 0 {
+			i++
+		}
+		return x, ErrNotPositiveDefinite{Index: i}
+	}
+	return x, nil
+}
+
+// SymTridiagEig computes the eigenvalues of the n×n symmetric tridiagonal
+// matrix T with the diagonal d and the off-diagonal e with indices il
+// through iu-1 in ascending order, and the corresponding orthonormal
+// eigenvectors, returned as the columns of the n×(iu-il) matrix v. d must
+// have length n and e length n-1.
+//
+// The eigenpairs are computed by the MRRR algorithm of Dstemr in O(n) time
+// per eigenpair, with high relative accuracy where T determines its
+// eigenvalues to that accuracy.
+//
+// SymTridiagEig panics unless 0 <= il <= iu <= n. The inputs are not
+// modified. If the algorithm fails to converge, SymTridiagEig returns
+// ErrIterationLimit.
+func SymTridiagEig(d, e []float64, il, iu int) (w []float64, v blas64.General, err error) {
+	n := len(d)
+	if len(e) != max(0, n-1) {
+		panic(badTridiag)
+	}
+	if il < 0 || iu < il || n < iu {
+		panic(badEVIndex)
+	}
+	if il == iu {
+		return nil, newGeneral(n, 0), nil
+	}
+	k := iu - il
+	dc := append([]float64(nil), d...)
+	ec := make([]float64, n)
+	copy(ec, e)
+	w = make([]float64, n)
+	z := newGeneral(n, n)
+	isuppz := make([]int, 2*n)
+	work := make([]float64, 1)
+	iwork := make([]int, 1)
+	lapackImpl.Dstemr(lapack.EVCompute, EVIndex, n, dc, ec, 0, 0, il, iu-1, w, z.Data, z.Stride, isuppz, true, work, -1, iwork, -1)
+	work = make([]float64, int(work[0]))
+	iwork = make([]int, iwork[0])
+	m, _, ok := lapackImpl.Dstemr(lapack.EVCompute, EVIndex, n, dc, ec, 0, 0, il, iu-1, w, z.Data, z.Stride, isuppz, true, work, len(work), iwork, len(iwork))
+	if !ok || m != k {
+		return nil, newGeneral(n, 0), ErrIterationLimit
+	}
+	v = newGeneral(n, k)
+	for i := 0; i < n; i++ {
+		copy(v.Data[i*v.Stride:i*v.Stride+k], z.Data[i*z.Stride:])
+	}
+	return w[:k], v, nil
+}
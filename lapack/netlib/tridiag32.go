@@ -0,0 +1,93 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"gonum.org/v1/gonum/blas/blas32"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// TridiagSolve32 is the float32 version of TridiagSolve. The system is
+// solved by Sgtsv.
+func TridiagSolve32(dl, d, du []float32, b blas32.General) (blas32.General, error) {
+	n := b.Rows
+	if len(d) != n || len(dl) != max(0, n-1) || len(du) != max(0, n-1) {
+		panic(badTridiag)
+	}
+	x := cloneGeneral32(b)
+	if n == 0 {
+		return x, nil
+	}
+	dlc := append([]float32(nil), dl...)
+	dc := append([]float32(nil), d...)
+	duc := append([]float32(nil), du...)
+	if !lapackImpl.Sgtsv(n, x.Cols, dlc, dc, duc, x.Data, x.Stride) {
+		i := 0
+		for i < n-1 && dc[i] != 0 {
+			i++
+		}
+		return x, SingularError{Index: i}
+	}
+	return x, nil
+}
+
+// SPDTridiagSolve32 is the float32 version of SPDTridiagSolve. The system
+// is solved by Sptsv.
+func SPDTridiagSolve32(d, e []float32, b blas32.General) (blas32.General, error) {
+	n := b.Rows
+	if len(d) != n || len(e) != max(0, n-1) {
+		panic(badTridiag)
+	}
+	x := cloneGeneral32(b)
+	if n == 0 {
+		return x, nil
+	}
+	dc := append([]float32(nil), d...)
+	ec := append([]float32(nil), e...)
+	if !lapackImpl.Sptsv(n, x.Cols, dc, ec, x.Data, x.Stride) {
+		i := 0
+		for i < n-1 && dc[i] > 0 {
+			i++
+		}
+		return x, ErrNotPositiveDefinite{Index: i}
+	}
+	return x, nil
+}
+
+// SymTridiagEig32 is the float32 version of SymTridiagEig. The eigenpairs
+// are computed by Sstemr.
+func SymTridiagEig32(d, e []float32, il, iu int) (w []float32, v blas32.General, err error) {
+	n := len(d)
+	if len(e) != max(0, n-1) {
+		panic(badTridiag)
+	}
+	if il < 0 || iu < il || n < iu {
+		panic(badEVIndex)
+	}
+	if il == iu {
+		return nil, newGeneral32(n, 0), nil
+	}
+	k := iu - il
+	dc := append([]float32(nil), d...)
+	ec := make([]float32, n)
+	copy(ec, e)
+	w = make([]float32, n)
+	z := newGeneral32(n, n)
+	isuppz := make([]int, 2*n)
+	work := make([]float32, 1)
+	iwork := make([]int, 1)
+	lapackImpl.Sstemr(lapack.EVCompute, EVIndex, n, dc, ec, 0, 0, il, iu-1, w, z.Data, z.Stride, isuppz, true, work, -1, iwork, -1)
+	work = make([]float32, int(work[0]))
+	iwork = make([]int, iwork[0])
+	m, _, ok := lapackImpl.Sstemr(lapack.EVCompute, EVIndex, n, dc, ec, 0, 0, il, iu-1, w, z.Data, z.Stride, isuppz, true, work, len(work), iwork, len(iwork))
+	if !ok || m != k {
+		return nil, newGeneral32(n, 0), ErrIterationLimit
+	}
+	v = newGeneral32(n, k)
+	for i := 0; i < n; i++ {
+		copy(v.Data[i*v.Stride:i*v.Stride+k], z.Data[i*z.Stride:])
+	}
+	return w[:k], v, nil
+}
@@ -0,0 +1,295 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// randomTridiag returns the diagonals of a random n×n tridiagonal matrix
+// and its dense representation. If spd is true, the matrix is symmetric
+// and diagonally dominant, and du is nil.
+func randomTridiag(rnd *rand.Rand, n int, spd bool) (dl, d, du []float64, dense blas64.General) {
+	dl = make([]float64, max(0, n-1))
+	d = make([]float64, n)
+	du = make([]float64, max(0, n-1))
+	dense = newGeneral(n, n)
+	for i := range dl {
+		dl[i] = rnd.NormFloat64()
+		du[i] = rnd.NormFloat64()
+		if spd {
+			du[i] = dl[i]
+		}
+		dense.Data[(i+1)*dense.Stride+i] = dl[i]
+		dense.Data[i*dense.Stride+i+1] = du[i]
+	}
+	for i := range d {
+		d[i] = rnd.NormFloat64()
+		if spd {
+			d[i] = 2.5 + math.Abs(d[i])
+		}
+		dense.Data[i*dense.Stride+i] = d[i]
+	}
+	if spd {
+		du = nil
+	}
+	return dl, d, du, dense
+}
+
+func TestDgtsv(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 3, 10, 41} {
+		for _, nrhs := range []int{1, 3} {
+			name := fmt.Sprintf("n=%d,nrhs=%d", n, nrhs)
+			dl, d, du, dense := randomTridiag(rnd, n, false)
+			b := randomGeneral(rnd, n, nrhs, nrhs)
+
+			x := cloneGeneral(b)
+			dlc := append([]float64(nil), dl...)
+			dc := append([]float64(nil), d...)
+			duc := append([]float64(nil), du...)
+			if !impl.Dgtsv(n, nrhs, dlc, dc, duc, x.Data, x.Stride) {
+				t.Errorf("%s: unexpected singular matrix", name)
+				continue
+			}
+			if res := residual(blas.NoTrans, dense, x, b); res > tol {
+				t.Errorf("%s: residual of Dgtsv too large: %v", name, res)
+			}
+
+			// Factor once and solve both systems with Dgttrs.
+			du2 := make([]float64, max(0, n-2))
+			ipiv := make([]int, n)
+			if !impl.Dgttrf(n, dl, d, du, du2, ipiv) {
+				t.Errorf("%s: unexpected singular matrix", name)
+				continue
+			}
+			for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				x := cloneGeneral(b)
+				impl.Dgttrs(trans, n, nrhs, dl, d, du, du2, ipiv, x.Data, x.Stride)
+				if res := residual(trans, dense, x, b); res > tol {
+					t.Errorf("%s,trans=%c: residual of Dgttrs too large: %v", name, trans, res)
+				}
+			}
+		}
+	}
+}
+
+func TestDptsv(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 9, 50} {
+		name := fmt.Sprintf("n=%d", n)
+		e, d, _, dense := randomTridiag(rnd, n, true)
+		b := randomGeneral(rnd, n, 2, 2)
+
+		x := cloneGeneral(b)
+		dc := append([]float64(nil), d...)
+		ec := append([]float64(nil), e...)
+		if !impl.Dptsv(n, 2, dc, ec, x.Data, x.Stride) {
+			t.Errorf("%s: unexpected failure", name)
+			continue
+		}
+		if res := residual(blas.NoTrans, dense, x, b); res > tol {
+			t.Errorf("%s: residual of Dptsv too large: %v", name, res)
+		}
+
+		if !impl.Dpttrf(n, d, e) {
+			t.Errorf("%s: unexpected failure of Dpttrf", name)
+			continue
+		}
+		x = cloneGeneral(b)
+		impl.Dpttrs(n, 2, d, e, x.Data, x.Stride)
+		if res := residual(blas.NoTrans, dense, x, b); res > tol {
+			t.Errorf("%s: residual of Dpttrs too large: %v", name, res)
+		}
+	}
+}
+
+// tridiagEigResidual returns the largest absolute differences between the
+// elements of T*V and V*diag(w) and between those of V^T*V and I.
+func tridiagEigResidual(dense blas64.General, w []float64, v blas64.General) (res, orth float64) {
+	n, k := v.Rows, v.Cols
+	tv := newGeneral(n, k)
+	blas64.Gemm(blas.NoTrans, blas.NoTrans, 1, dense, v, 0, tv)
+	for i := 0; i < n; i++ {
+		for j := 0; j < k; j++ {
+			res = math.Max(res, math.Abs(tv.Data[i*tv.Stride+j]-w[j]*v.Data[i*v.Stride+j]))
+		}
+	}
+	vtv := newGeneral(k, k)
+	blas64.Gemm(blas.Trans, blas.NoTrans, 1, v, v, 0, vtv)
+	for i := 0; i < k; i++ {
+		vtv.Data[i*vtv.Stride+i]--
+	}
+	for _, x := range vtv.Data {
+		orth = math.Max(orth, math.Abs(x))
+	}
+	return res, orth
+}
+
+func TestDstemr(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 7, 30} {
+		for _, rng := range []EVRange{EVAll, EVValue, EVIndex} {
+			name := fmt.Sprintf("n=%d,rng=%c", n, rng)
+			e, d, _, dense := randomTridiag(rnd, n, false)
+			for i := range e {
+				dense.Data[i*dense.Stride+i+1] = e[i]
+			}
+			want := append([]float64(nil), d...)
+			impl.Dsterf(n, want, append([]float64(nil), e...))
+
+			il, iu := 0, n-1
+			var vl, vu float64
+			switch rng {
+			case EVIndex:
+				il, iu = n/3, (2*n)/3
+			case EVValue:
+				vl, vu = -1, 2
+			}
+			var sel []float64
+			for i, v := range want {
+				if rng == EVAll || rng == EVIndex && il <= i && i <= iu || rng == EVValue && vl < v && v <= vu {
+					sel = append(sel, v)
+				}
+			}
+			ncols := n
+			if rng == EVIndex {
+				ncols = iu - il + 1
+			}
+
+			dc := append([]float64(nil), d...)
+			ec := make([]float64, n)
+			copy(ec, e)
+			w := make([]float64, n)
+			z := newGeneral(n, n)
+			isuppz := make([]int, 2*n)
+			work := make([]float64, 1)
+			iwork := make([]int, 1)
+			impl.Dstemr(lapack.EVCompute, rng, n, dc, ec, vl, vu, il, iu, w, z.Data, z.Stride, isuppz, true, work, -1, iwork, -1)
+			work = make([]float64, int(work[0]))
+			iwork = make([]int, iwork[0])
+			m, _, ok := impl.Dstemr(lapack.EVCompute, rng, n, dc, ec, vl, vu, il, iu, w, z.Data, z.Stride, isuppz, true, work, len(work), iwork, len(iwork))
+			if !ok {
+				t.Errorf("%s: unexpected failure", name)
+				continue
+			}
+			if m != len(sel) || m > ncols {
+				t.Errorf("%s: unexpected number of eigenvalues: got %d want %d", name, m, len(sel))
+				continue
+			}
+			if !floats.EqualApprox(w[:m], sel, tol) {
+				t.Errorf("%s: eigenvalue mismatch: got %v want %v", name, w[:m], sel)
+			}
+			v := newGeneral(n, m)
+			for i := 0; i < n && m > 0; i++ {
+				copy(v.Data[i*v.Stride:i*v.Stride+m], z.Data[i*z.Stride:])
+			}
+			if res, orth := tridiagEigResidual(dense, w[:m], v); res > tol || orth > tol {
+				t.Errorf("%s: unexpected residual: |TV-VW|=%v, |V^TV-I|=%v", name, res, orth)
+			}
+			for i := 0; i < m; i++ {
+				if isuppz[2*i] < 0 || isuppz[2*i+1] < isuppz[2*i] || n <= isuppz[2*i+1] {
+					t.Errorf("%s: invalid support of eigenvector %d: %v", name, i, isuppz[2*i:2*i+2])
+				}
+			}
+		}
+	}
+}
+
+func TestTridiagSolve(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 5, 64} {
+		name := fmt.Sprintf("n=%d", n)
+		dl, d, du, dense := randomTridiag(rnd, n, false)
+		b := randomGeneral(rnd, n, 2, 2)
+		dCopy := append([]float64(nil), d...)
+		x, err := TridiagSolve(dl, d, du, b)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if !floats.Equal(d, dCopy) {
+			t.Errorf("%s: d modified", name)
+		}
+		if n > 0 {
+			if res := residual(blas.NoTrans, dense, x, b); res > tol {
+				t.Errorf("%s: residual too large: %v", name, res)
+			}
+		}
+
+		e, d, _, dense := randomTridiag(rnd, n, true)
+		x, err = SPDTridiagSolve(d, e, b)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if n > 0 {
+			if res := residual(blas.NoTrans, dense, x, b); res > tol {
+				t.Errorf("%s: SPD residual too large: %v", name, res)
+			}
+		}
+	}
+
+	// The second row is zero.
+	b := blas64.General{Rows: 3, Cols: 1, Stride: 1, Data: []float64{1, 2, 3}}
+	_, err := TridiagSolve([]float64{0, 1}, []float64{1, 0, 1}, []float64{1, 0}, b)
+	if e, ok := err.(SingularError); !ok || e.Index != 1 {
+		t.Errorf("unexpected error for singular matrix: %v", err)
+	}
+	// The second leading minor is 1 - 4 < 0.
+	_, err = SPDTridiagSolve([]float64{1, 1, 3}, []float64{2, 0}, b)
+	if e, ok := err.(ErrNotPositiveDefinite); !ok || e.Index != 1 {
+		t.Errorf("unexpected error for indefinite matrix: %v", err)
+	}
+}
+
+func TestSymTridiagEig(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct{ n, il, iu int }{
+		{0, 0, 0}, {1, 0, 1}, {6, 0, 6}, {20, 3, 9}, {20, 19, 20}, {8, 4, 4},
+	} {
+		name := fmt.Sprintf("%+v", test)
+		e, d, _, dense := randomTridiag(rnd, test.n, false)
+		for i := range e {
+			dense.Data[i*dense.Stride+i+1] = e[i]
+		}
+		w, v, err := SymTridiagEig(d, e, test.il, test.iu)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		k := test.iu - test.il
+		if len(w) != k || v.Rows != test.n || v.Cols != k {
+			t.Errorf("%s: unexpected result shape", name)
+			continue
+		}
+		if !sort.Float64sAreSorted(w) {
+			t.Errorf("%s: eigenvalues not sorted: %v", name, w)
+		}
+		all := append([]float64(nil), d...)
+		impl.Dsterf(test.n, all, append([]float64(nil), e...))
+		if k > 0 && !floats.EqualApprox(w, all[test.il:test.iu], tol) {
+			t.Errorf("%s: eigenvalue mismatch: got %v want %v", name, w, all[test.il:test.iu])
+		}
+		if res, orth := tridiagEigResidual(dense, w, v); res > tol || orth > tol {
+			t.Errorf("%s: unexpected residual: |TV-VW|=%v, |V^TV-I|=%v", name, res, orth)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+)
+
+// SafeTriangularSolve solves the triangular system
+//
+//	op(T) * x = scale * b
+//
+// where op(T) is T or Tᵀ as specified by trans, and returns x and the scale
+// factor. The system is first solved by Dtrsv with scale 1. If that
+// overflows, it is solved again by Dlatrs, which chooses 0 ≤ scale ≤ 1 so
+// that the components of x stay finite. A scale of 0 means that T is
+// singular, in which case x is a non-trivial solution of op(T) * x = 0. The
+// inputs t and b are not modified.
+//
+// SafeTriangularSolve is intended for the solves of condition estimators
+// and inverse iteration, where T may be close to singular and the
+// magnitude of x matters more than its scale.
+func SafeTriangularSolve(trans blas.Transpose, t blas64.Triangular, b []float64) (x []float64, scale float64) {
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case t.Uplo != blas.Upper && t.Uplo != blas.Lower:
+		panic(badUplo)
+	case t.Diag != blas.NonUnit && t.Diag != blas.Unit:
+		panic(badDiag)
+	case len(b) != t.N:
+		panic(badShapeB)
+	}
+	n := t.N
+	x = make([]float64, n)
+	if n == 0 {
+		return x, 1
+	}
+	copy(x, b)
+	blasImpl.Dtrsv(t.Uplo, trans, t.Diag, n, t.Data, t.Stride, x, 1)
+	if allFinite(x) || !allFinite(b) {
+		return x, 1
+	}
+	copy(x, b)
+	scale = lapackImpl.Dlatrs(t.Uplo, trans, t.Diag, false, n, t.Data, t.Stride, x, make([]float64, n))
+	return x, scale
+}
+
+// allFinite returns whether all elements of x are finite.
+func allFinite(x []float64) bool {
+	for _, v := range x {
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			return false
+		}
+	}
+	return true
+}
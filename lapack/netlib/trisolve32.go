@@ -0,0 +1,50 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas32"
+)
+
+// SafeTriangularSolve32 is the float32 version of SafeTriangularSolve. The
+// system is solved by Strsv and, if that overflows, by Slatrs.
+func SafeTriangularSolve32(trans blas.Transpose, t blas32.Triangular, b []float32) (x []float32, scale float32) {
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(badTrans)
+	case t.Uplo != blas.Upper && t.Uplo != blas.Lower:
+		panic(badUplo)
+	case t.Diag != blas.NonUnit && t.Diag != blas.Unit:
+		panic(badDiag)
+	case len(b) != t.N:
+		panic(badShapeB)
+	}
+	n := t.N
+	x = make([]float32, n)
+	if n == 0 {
+		return x, 1
+	}
+	copy(x, b)
+	blasImpl.Strsv(t.Uplo, trans, t.Diag, n, t.Data, t.Stride, x, 1)
+	if allFinite32(x) || !allFinite32(b) {
+		return x, 1
+	}
+	copy(x, b)
+	scale = lapackImpl.Slatrs(t.Uplo, trans, t.Diag, false, n, t.Data, t.Stride, x, make([]float32, n))
+	return x, scale
+}
+
+// allFinite32 returns whether all elements of x are finite.
+func allFinite32(x []float32) bool {
+	for _, v := range x {
+		if math.IsInf(float64(v), 0) || math.IsNaN(float64(v)) {
+			return false
+		}
+	}
+	return true
+}
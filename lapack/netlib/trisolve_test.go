@@ -0,0 +1,90 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/blas64"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+// triSolveResidual returns the norm of op(T)*x - scale*b relative to the
+// magnitudes of the terms.
+func triSolveResidual(trans blas.Transpose, t blas64.Triangular, x []float64, scale float64, b []float64) float64 {
+	n := t.N
+	tx := append([]float64(nil), x...)
+	blasImpl.Dtrmv(t.Uplo, trans, t.Diag, n, t.Data, t.Stride, tx, 1)
+	var r, s float64
+	for i := range tx {
+		r = math.Max(r, math.Abs(tx[i]-scale*b[i]))
+		s = math.Max(s, math.Abs(x[i]))
+	}
+	anorm := impl.Dlantr(lapack.MaxAbs, t.Uplo, t.Diag, n, n, t.Data, t.Stride, nil)
+	return r / (float64(n) * anorm * s)
+}
+
+func TestSafeTriangularSolve(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 5, 40} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			for _, trans := range []blas.Transpose{blas.NoTrans, blas.Trans} {
+				for _, small := range []float64{1, 1e-30, 0} {
+					name := fmt.Sprintf("n=%d,uplo=%c,trans=%c,diag=%v", n, uplo, trans, small)
+					tri := blas64.Triangular{Uplo: uplo, Diag: blas.NonUnit, N: n, Stride: max(1, n), Data: make([]float64, n*max(1, n))}
+					for i := 0; i < n; i++ {
+						for j := 0; j < n; j++ {
+							if uplo == blas.Upper && i < j || uplo == blas.Lower && j < i {
+								tri.Data[i*tri.Stride+j] = rnd.NormFloat64()
+							}
+						}
+						tri.Data[i*tri.Stride+i] = small * (1 + rnd.Float64())
+					}
+					b := make([]float64, n)
+					for i := range b {
+						b[i] = rnd.NormFloat64()
+					}
+					tcopy := append([]float64(nil), tri.Data...)
+					bcopy := append([]float64(nil), b...)
+
+					x, scale := SafeTriangularSolve(trans, tri, b)
+					if !floats.Same(tri.Data, tcopy) || !floats.Same(b, bcopy) {
+						t.Errorf("%s: input modified", name)
+					}
+					if n == 0 {
+						if scale != 1 || len(x) != 0 {
+							t.Errorf("%s: unexpected result %v, %v", name, x, scale)
+						}
+						continue
+					}
+					if !allFinite(x) {
+						t.Errorf("%s: non-finite solution", name)
+						continue
+					}
+					switch {
+					case small == 1 && scale != 1:
+						t.Errorf("%s: unexpected scale for well-conditioned matrix: %v", name, scale)
+					case small == 0 && scale != 0:
+						t.Errorf("%s: unexpected scale for singular matrix: %v", name, scale)
+					case scale < 0 || scale > 1:
+						t.Errorf("%s: scale out of range: %v", name, scale)
+					}
+					if small == 0 && floats.Norm(x, math.Inf(1)) == 0 {
+						t.Errorf("%s: trivial null vector", name)
+					}
+					if r := triSolveResidual(trans, tri, x, scale, b); r > 1e-13 {
+						t.Errorf("%s: unexpected residual %v", name, r)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,1597 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/lapack"
+	"gonum.org/v1/netlib/lapack/lapacke"
+)
+
+var _ lapack.Complex128 = Implementation{}
+
+// shortRWork is the panic message for a too short real workspace of the
+// complex routines.
+const shortRWork = "lapack: insufficient length of rwork"
+
+// Zgetrf computes the LU decomposition of the m×n complex matrix A as
+// described for Dgetrf. ipiv is zero-indexed and must have length min(m,n).
+//
+// Zgetrf returns whether the matrix A is nonsingular.
+func (impl Implementation) Zgetrf(m, n int, a []complex128, lda int, ipiv []int) (ok bool) {
+	mn := min(m, n)
+	switch {
+	case m < 0:
+		panic(Error{Routine: "Zgetrf", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Zgetrf", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Zgetrf", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if mn == 0 {
+		return true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(Error{Routine: "Zgetrf", Param: "a", Message: shortA})
+	case len(ipiv) != mn:
+		panic(Error{Routine: "Zgetrf", Param: "ipiv", Message: badLenIpiv})
+	}
+
+	ipiv32 := make([]lapacke.Int, mn)
+	ok = lapacke.Zgetrf(m, n, a, lda, ipiv32)
+	for i, v := range ipiv32 {
+		ipiv[i] = int(v) - 1 // Transform to zero-indexed.
+	}
+	return ok
+}
+
+// Zgetrs solves a system of equations using the LU factorization of the
+// n×n complex matrix A computed by Zgetrf. The system solved is
+//
+//	A * X = B    if trans == blas.NoTrans
+//	A^T * X = B  if trans == blas.Trans
+//	A^H * X = B  if trans == blas.ConjTrans
+//
+// On entry b contains the n×nrhs matrix B, on exit it contains the solution
+// X. ipiv is zero-indexed.
+func (impl Implementation) Zgetrs(trans blas.Transpose, n, nrhs int, a []complex128, lda int, ipiv []int, b []complex128, ldb int) {
+	switch {
+	case trans != blas.NoTrans && trans != blas.Trans && trans != blas.ConjTrans:
+		panic(Error{Routine: "Zgetrs", Param: "trans", Message: badTrans})
+	case n < 0:
+		panic(Error{Routine: "Zgetrs", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Zgetrs", Param: "nrhs", Message: nrhsLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Zgetrs", Param: "lda", Message: badLdA})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Zgetrs", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Zgetrs", Param: "a", Message: shortA})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Zgetrs", Param: "b", Message: shortB})
+	case len(ipiv) != n:
+		panic(Error{Routine: "Zgetrs", Param: "ipiv", Message: badLenIpiv})
+	}
+
+	ipiv32 := make([]lapacke.Int, n)
+	for i, v := range ipiv {
+		v++ // Transform to one-indexed.
+		if v != int(lapacke.Int(v)) {
+			panic(Error{Routine: "Zgetrs", Param: "ipiv", Message: "lapack: ipiv element out of range"})
+		}
+		ipiv32[i] = lapacke.Int(v)
+	}
+	lapacke.Zgetrs(byte(trans), n, nrhs, a, lda, ipiv32, b, ldb)
+}
+
+// Zpotrf computes the Cholesky decomposition of the Hermitian positive
+// definite matrix A,
+//
+//	A = U^H * U  if ul == blas.Upper
+//	A = L * L^H  if ul == blas.Lower
+//
+// and stores the factor in place into a. If A is not positive definite,
+// false is returned.
+func (impl Implementation) Zpotrf(ul blas.Uplo, n int, a []complex128, lda int) (ok bool) {
+	switch {
+	case ul != blas.Upper && ul != blas.Lower:
+		panic(Error{Routine: "Zpotrf", Param: "ul", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Zpotrf", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Zpotrf", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	if len(a) < (n-1)*lda+n {
+		panic(Error{Routine: "Zpotrf", Param: "a", Message: shortA})
+	}
+
+	return lapacke.Zpotrf(byte(ul), n, a, lda)
+}
+
+// Zpotrs solves a system of n linear equations A*X = B where A is an n×n
+// Hermitian positive definite matrix represented by its Cholesky
+// factorization as computed by Zpotrf. On entry, B contains the n×nrhs
+// right-hand side matrix, on return it contains the solution matrix X.
+func (impl Implementation) Zpotrs(uplo blas.Uplo, n, nrhs int, a []complex128, lda int, b []complex128, ldb int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Zpotrs", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Zpotrs", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Zpotrs", Param: "nrhs", Message: nrhsLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Zpotrs", Param: "lda", Message: badLdA})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Zpotrs", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Zpotrs", Param: "a", Message: shortA})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Zpotrs", Param: "b", Message: shortB})
+	}
+
+	lapacke.Zpotrs(byte(uplo), n, nrhs, a, lda, b, ldb)
+}
+
+// Zgeqrf computes the QR factorization of the m×n complex matrix A as
+// described for Dgeqrf, with the elementary reflectors
+//
+//	H_i = I - tau * v * v^H.
+//
+// work must have length at least max(1, lwork) and lwork must be -1 or at
+// least n. If lwork == -1, the optimal work length is stored into work[0].
+// tau must have length at least min(m,n).
+func (impl Implementation) Zgeqrf(m, n int, a []complex128, lda int, tau, work []complex128, lwork int) {
+	switch {
+	case m < 0:
+		panic(Error{Routine: "Zgeqrf", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Zgeqrf", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Zgeqrf", Param: "lda", Message: badLdA})
+	case lwork < max(1, n) && lwork != -1:
+		panic(Error{Routine: "Zgeqrf", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Zgeqrf", Param: "work", Message: shortWork})
+	}
+
+	// Quick return if possible.
+	k := min(m, n)
+	if k == 0 {
+		work[0] = 1
+		return
+	}
+
+	if lwork == -1 {
+		lapacke.Zgeqrf(m, n, a, lda, tau, work, -1)
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(Error{Routine: "Zgeqrf", Param: "a", Message: shortA})
+	case len(tau) < k:
+		panic(Error{Routine: "Zgeqrf", Param: "tau", Message: shortTau})
+	}
+
+	lapacke.Zgeqrf(m, n, a, lda, tau, work, lwork)
+}
+
+// Zungqr generates the m×n complex matrix Q with orthonormal columns
+// defined by the first k elementary reflectors computed by Zgeqrf, as
+// described for Dorgqr.
+//
+// If lwork == -1, the optimal work length is stored into work[0].
+func (impl Implementation) Zungqr(m, n, k int, a []complex128, lda int, tau, work []complex128, lwork int) {
+	switch {
+	case m < 0:
+		panic(Error{Routine: "Zungqr", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Zungqr", Param: "n", Message: nLT0})
+	case n > m:
+		panic(Error{Routine: "Zungqr", Param: "n", Message: nGTM})
+	case k < 0:
+		panic(Error{Routine: "Zungqr", Param: "k", Message: kLT0})
+	case k > n:
+		panic(Error{Routine: "Zungqr", Param: "k", Message: kGTN})
+	case lda < max(1, n):
+		panic(Error{Routine: "Zungqr", Param: "lda", Message: badLdA})
+	case lwork < max(1, n) && lwork != -1:
+		panic(Error{Routine: "Zungqr", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Zungqr", Param: "work", Message: shortWork})
+	}
+
+	if n == 0 {
+		work[0] = 1
+		return
+	}
+
+	if lwork == -1 {
+		lapacke.Zungqr(m, n, k, a, lda, tau, work, -1)
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(Error{Routine: "Zungqr", Param: "a", Message: shortA})
+	case len(tau) < k:
+		panic(Error{Routine: "Zungqr", Param: "tau", Message: shortTau})
+	}
+
+	lapacke.Zungqr(m, n, k, a, lda, tau, work, lwork)
+}
+
+// Zunmqr multiplies the m×n complex matrix C by the unitary matrix Q
+// defined by the k elementary reflectors computed by Zgeqrf,
+//
+//	C = Q * C    if side == blas.Left and trans == blas.NoTrans
+//	C = Q^H * C  if side == blas.Left and trans == blas.ConjTrans
+//	C = C * Q    if side == blas.Right and trans == blas.NoTrans
+//	C = C * Q^H  if side == blas.Right and trans == blas.ConjTrans
+//
+// If lwork == -1, the optimal work length is stored into work[0].
+func (impl Implementation) Zunmqr(side blas.Side, trans blas.Transpose, m, n, k int, a []complex128, lda int, tau, c []complex128, ldc int, work []complex128, lwork int) {
+	left := side == blas.Left
+	nq := n
+	nw := m
+	if left {
+		nq = m
+		nw = n
+	}
+	switch {
+	case !left && side != blas.Right:
+		panic(Error{Routine: "Zunmqr", Param: "side", Message: badSide})
+	case trans != blas.NoTrans && trans != blas.ConjTrans:
+		panic(Error{Routine: "Zunmqr", Param: "trans", Message: badTrans})
+	case m < 0:
+		panic(Error{Routine: "Zunmqr", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Zunmqr", Param: "n", Message: nLT0})
+	case k < 0:
+		panic(Error{Routine: "Zunmqr", Param: "k", Message: kLT0})
+	case left && k > m:
+		panic(Error{Routine: "Zunmqr", Param: "k", Message: kGTM})
+	case !left && k > n:
+		panic(Error{Routine: "Zunmqr", Param: "k", Message: kGTN})
+	case lda < max(1, k):
+		panic(Error{Routine: "Zunmqr", Param: "lda", Message: badLdA})
+	case ldc < max(1, n):
+		panic(Error{Routine: "Zunmqr", Param: "ldc", Message: badLdC})
+	case lwork < max(1, nw) && lwork != -1:
+		panic(Error{Routine: "Zunmqr", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Zunmqr", Param: "work", Message: shortWork})
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 || k == 0 {
+		work[0] = 1
+		return
+	}
+
+	if lwork == -1 {
+		lapacke.Zunmqr(byte(side), byte(trans), m, n, k, a, lda, tau, c, ldc, work, -1)
+		return
+	}
+
+	switch {
+	case len(a) < (nq-1)*lda+k:
+		panic(Error{Routine: "Zunmqr", Param: "a", Message: shortA})
+	case len(tau) != k:
+		panic(Error{Routine: "Zunmqr", Param: "tau", Message: badLenTau})
+	case len(c) < (m-1)*ldc+n:
+		panic(Error{Routine: "Zunmqr", Param: "c", Message: shortC})
+	}
+
+	lapacke.Zunmqr(byte(side), byte(trans), m, n, k, a, lda, tau, c, ldc, work, lwork)
+}
+
+// Zgesvd computes the singular value decomposition of the m×n complex
+// matrix A,
+//
+//	A = U * Σ * V^H,
+//
+// as described for Dgesvd. The singular values are real and returned in s.
+//
+// rwork must have length at least 5*min(m,n). lwork must be -1 or at least
+// 2*min(m,n)+max(m,n). If lwork == -1, the optimal work length is stored
+// into work[0].
+//
+// Zgesvd returns whether the decomposition successfully completed.
+func (impl Implementation) Zgesvd(jobU, jobVT lapack.SVDJob, m, n int, a []complex128, lda int, s []float64, u []complex128, ldu int, vt []complex128, ldvt int, work []complex128, lwork int, rwork []float64) (ok bool) {
+	wantua := jobU == lapack.SVDAll
+	wantus := jobU == lapack.SVDStore
+	wantuo := jobU == lapack.SVDOverwrite
+	wantun := jobU == lapack.SVDNone
+	if !(wantua || wantus || wantuo || wantun) {
+		panic(Error{Routine: "Zgesvd", Param: "jobU", Message: badSVDJob})
+	}
+
+	wantva := jobVT == lapack.SVDAll
+	wantvs := jobVT == lapack.SVDStore
+	wantvas := wantva || wantvs
+	wantvo := jobVT == lapack.SVDOverwrite
+	wantvn := jobVT == lapack.SVDNone
+	if !(wantva || wantvs || wantvo || wantvn) {
+		panic(Error{Routine: "Zgesvd", Param: "jobVT", Message: badSVDJob})
+	}
+
+	if wantuo && wantvo {
+		panic(Error{Routine: "Zgesvd", Param: "", Message: bothSVDOver})
+	}
+
+	minmn := min(m, n)
+	minwork := 1
+	if minmn > 0 {
+		minwork = 2*minmn + max(m, n)
+	}
+	switch {
+	case m < 0:
+		panic(Error{Routine: "Zgesvd", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Zgesvd", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Zgesvd", Param: "lda", Message: badLdA})
+	case ldu < 1, wantua && ldu < m, wantus && ldu < minmn:
+		panic(Error{Routine: "Zgesvd", Param: "ldu", Message: badLdU})
+	case ldvt < 1 || (wantvas && ldvt < n):
+		panic(Error{Routine: "Zgesvd", Param: "ldvt", Message: badLdVT})
+	case lwork < minwork && lwork != -1:
+		panic(Error{Routine: "Zgesvd", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Zgesvd", Param: "work", Message: shortWork})
+	}
+
+	// Quick return if possible.
+	if minmn == 0 {
+		work[0] = 1
+		return true
+	}
+
+	if lwork == -1 {
+		return lapacke.Zgesvd(byte(jobU), byte(jobVT), m, n, a, lda, s, u, ldu, vt, ldvt, work, -1, rwork)
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(Error{Routine: "Zgesvd", Param: "a", Message: shortA})
+	case len(s) < minmn:
+		panic(Error{Routine: "Zgesvd", Param: "s", Message: shortS})
+	case (len(u) < (m-1)*ldu+m && wantua) || (len(u) < (m-1)*ldu+minmn && wantus):
+		panic(Error{Routine: "Zgesvd", Param: "u", Message: shortU})
+	case (len(vt) < (n-1)*ldvt+n && wantva) || (len(vt) < (minmn-1)*ldvt+n && wantvs):
+		panic(Error{Routine: "Zgesvd", Param: "vt", Message: shortVT})
+	case len(rwork) < 5*minmn:
+		panic(Error{Routine: "Zgesvd", Param: "rwork", Message: shortRWork})
+	}
+
+	return lapacke.Zgesvd(byte(jobU), byte(jobVT), m, n, a, lda, s, u, ldu, vt, ldvt, work, lwork, rwork)
+}
+
+// Zheev computes all eigenvalues and, optionally, the eigenvectors of the
+// n×n Hermitian matrix A stored in the triangle given by uplo. The real
+// eigenvalues are returned in ascending order in w. If jobz is
+// lapack.EVCompute, a is overwritten by the orthonormal eigenvectors.
+//
+// rwork must have length at least max(1, 3*n-2). lwork must be -1 or at
+// least max(1, 2*n-1). If lwork == -1, the optimal work length is stored
+// into work[0].
+//
+// Zheev returns whether the algorithm converged.
+func (impl Implementation) Zheev(jobz lapack.EVJob, uplo blas.Uplo, n int, a []complex128, lda int, w []float64, work []complex128, lwork int, rwork []float64) (ok bool) {
+	switch {
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(Error{Routine: "Zheev", Param: "jobz", Message: badEVJob})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Zheev", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Zheev", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Zheev", Param: "lda", Message: badLdA})
+	case lwork < max(1, 2*n-1) && lwork != -1:
+		panic(Error{Routine: "Zheev", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Zheev", Param: "work", Message: shortWork})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	if lwork == -1 {
+		return lapacke.Zheev(byte(jobz), byte(uplo), n, a, lda, w, work, -1, rwork)
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Zheev", Param: "a", Message: shortA})
+	case len(w) < n:
+		panic(Error{Routine: "Zheev", Param: "w", Message: shortW})
+	case len(rwork) < max(1, 3*n-2):
+		panic(Error{Routine: "Zheev", Param: "rwork", Message: shortRWork})
+	}
+
+	return lapacke.Zheev(byte(jobz), byte(uplo), n, a, lda, w, work, lwork, rwork)
+}
+
+// Zheevr computes selected eigenvalues and, optionally, the eigenvectors of
+// the n×n Hermitian matrix A stored in the triangle given by uplo by the
+// MRRR algorithm. The eigenvalues computed are specified by rng, vl, vu, il
+// and iu, and the results are stored in w, z and isuppz, as described for
+// Dsyevr. The number of eigenvalues found is returned.
+//
+// lwork must be at least max(1, 2*n), lrwork at least max(1, 24*n) and
+// liwork at least max(1, 10*n), and Zheevr will panic otherwise. If any of
+// them is -1, instead of computing the eigenvalues the optimal lengths of
+// work, rwork and iwork are stored into work[0], rwork[0] and iwork[0].
+//
+// Zheevr returns whether the algorithm converged.
+func (impl Implementation) Zheevr(jobz lapack.EVJob, rng EVRange, uplo blas.Uplo, n int, a []complex128, lda int, vl, vu float64, il, iu int, abstol float64, w []float64, z []complex128, ldz int, isuppz []int, work []complex128, lwork int, rwork []float64, lrwork int, iwork []int, liwork int) (m int, ok bool) {
+	ncols := n
+	switch {
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(Error{Routine: "Zheevr", Param: "jobz", Message: badEVJob})
+	case rng != EVAll && rng != EVValue && rng != EVIndex:
+		panic(Error{Routine: "Zheevr", Param: "rng", Message: badEVRange})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Zheevr", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Zheevr", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Zheevr", Param: "lda", Message: badLdA})
+	case rng == EVValue && n > 0 && vu <= vl:
+		panic(Error{Routine: "Zheevr", Param: "vu", Message: badEVInterval})
+	case rng == EVIndex && n > 0 && (il < 0 || n <= il):
+		panic(Error{Routine: "Zheevr", Param: "il", Message: badEVIndex})
+	case rng == EVIndex && n > 0 && (iu < il || n <= iu):
+		panic(Error{Routine: "Zheevr", Param: "iu", Message: badEVIndex})
+	}
+	if rng == EVIndex {
+		ncols = iu - il + 1
+	}
+	switch {
+	case jobz == lapack.EVCompute && ldz < max(1, ncols):
+		panic(Error{Routine: "Zheevr", Param: "ldz", Message: badLdZ})
+	case lwork < max(1, 2*n) && lwork != -1:
+		panic(Error{Routine: "Zheevr", Param: "lwork", Message: badLWork})
+	case lrwork < max(1, 24*n) && lrwork != -1:
+		panic(Error{Routine: "Zheevr", Param: "lrwork", Message: badLWork})
+	case liwork < max(1, 10*n) && liwork != -1:
+		panic(Error{Routine: "Zheevr", Param: "liwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Zheevr", Param: "work", Message: shortWork})
+	case len(rwork) < max(1, lrwork):
+		panic(Error{Routine: "Zheevr", Param: "rwork", Message: shortRWork})
+	case len(iwork) < max(1, liwork):
+		panic(Error{Routine: "Zheevr", Param: "iwork", Message: shortIWork})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		if lwork == -1 || lrwork == -1 || liwork == -1 {
+			work[0] = 1
+			rwork[0] = 1
+			iwork[0] = 1
+		}
+		return 0, true
+	}
+
+	_m := make([]lapacke.Int, 1)
+	if lwork == -1 || lrwork == -1 || liwork == -1 {
+		_iwork := make([]lapacke.Int, 1)
+		ok = lapacke.Zheevr(byte(jobz), byte(rng), byte(uplo), n, a, lda, vl, vu, il+1, iu+1, abstol, _m, w, z, max(1, ldz), nil, work, -1, rwork, -1, _iwork, -1)
+		iwork[0] = int(_iwork[0])
+		return 0, ok
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Zheevr", Param: "a", Message: shortA})
+	case len(w) < n:
+		panic(Error{Routine: "Zheevr", Param: "w", Message: shortW})
+	case jobz == lapack.EVCompute && len(z) < (n-1)*ldz+ncols:
+		panic(Error{Routine: "Zheevr", Param: "z", Message: shortZ})
+	case jobz == lapack.EVCompute && len(isuppz) < 2*ncols:
+		panic(Error{Routine: "Zheevr", Param: "isuppz", Message: shortIsuppz})
+	}
+
+	_isuppz := make([]lapacke.Int, 2*ncols)
+	_iwork := make([]lapacke.Int, liwork)
+	ok = lapacke.Zheevr(byte(jobz), byte(rng), byte(uplo), n, a, lda, vl, vu, il+1, iu+1, abstol, _m, w, z, max(1, ldz), _isuppz, work, lwork, rwork, lrwork, _iwork, liwork)
+	m = int(_m[0])
+	if jobz == lapack.EVCompute {
+		for i, v := range _isuppz[:2*m] {
+			isuppz[i] = int(v - 1)
+		}
+	}
+	return m, ok
+}
+
+// Zgees computes the eigenvalues, the Schur form T and, optionally, the matrix
+// of Schur vectors Z of an n×n complex matrix A
+//  A = Z*T*Z^H
+// as described for Dgees. T is upper triangular with the eigenvalues in w on
+// its diagonal. If sel is not nil, the eigenvalues for which sel returns true
+// are ordered to the top left of T and sdim is their number.
+//
+// work must have length at least lwork and lwork must be at least max(1,2*n),
+// and rwork must have length at least n, otherwise Zgees will panic. If
+// lwork == -1, instead of performing Zgees, the function only calculates the
+// optimal value of lwork and stores it into work[0].
+//
+// info is as described for Dgees.
+func (impl Implementation) Zgees(jobvs lapack.SchurComp, sel func(w complex128) bool, n int, a []complex128, lda int, w []complex128, vs []complex128, ldvs int, work []complex128, lwork int, rwork []float64) (sdim, info int) {
+	wantvs := jobvs == lapack.SchurOrig
+	switch {
+	case jobvs != lapack.SchurNone && jobvs != lapack.SchurOrig:
+		panic(Error{Routine: "Zgees", Param: "jobvs", Message: badSchurComp})
+	case n < 0:
+		panic(Error{Routine: "Zgees", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Zgees", Param: "lda", Message: badLdA})
+	case ldvs < 1, wantvs && ldvs < n:
+		panic(Error{Routine: "Zgees", Param: "ldvs", Message: badLdVS})
+	case lwork < max(1, 2*n) && lwork != -1:
+		panic(Error{Routine: "Zgees", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Zgees", Param: "work", Message: shortWork})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		work[0] = 1
+		return 0, 0
+	}
+
+	sort := byte('N')
+	if sel != nil {
+		sort = 'S'
+	}
+	_sdim := []lapacke.Int{0}
+
+	// Quick return in case of a workspace query.
+	if lwork == -1 {
+		lapacke.Zgees(byte(jobvs), sort, sel, n, a, lda, _sdim, w, vs, max(n, ldvs), work, -1, rwork, nil)
+		return 0, 0
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Zgees", Param: "a", Message: shortA})
+	case len(w) < n:
+		panic(Error{Routine: "Zgees", Param: "w", Message: shortW})
+	case wantvs && len(vs) < (n-1)*ldvs+n:
+		panic(Error{Routine: "Zgees", Param: "vs", Message: shortVS})
+	case len(rwork) < n:
+		panic(Error{Routine: "Zgees", Param: "rwork", Message: shortRWork})
+	}
+
+	var bwork []lapacke.Int
+	if sel != nil {
+		bwork = make([]lapacke.Int, n)
+	}
+	info = lapacke.Zgees(byte(jobvs), sort, sel, n, a, lda, _sdim, w, vs, max(n, ldvs), work, lwork, rwork, bwork)
+	return int(_sdim[0]), info
+}
+
+// Zggbal balances the pair of n×n complex matrices (A,B) of a generalized
+// eigenvalue problem as described for Dggbal. The scaling factors and
+// permutations are returned in the real slices lscale and rscale, and work
+// is real.
+func (impl Implementation) Zggbal(job lapack.BalanceJob, n int, a []complex128, lda int, b []complex128, ldb int, lscale, rscale, work []float64) (ilo, ihi int) {
+	switch {
+	case job != lapack.BalanceNone && job != lapack.Permute && job != lapack.Scale && job != lapack.PermuteScale:
+		panic(Error{Routine: "Zggbal", Param: "job", Message: badBalanceJob})
+	case n < 0:
+		panic(Error{Routine: "Zggbal", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Zggbal", Param: "lda", Message: badLdA})
+	case ldb < max(1, n):
+		panic(Error{Routine: "Zggbal", Param: "ldb", Message: badLdB})
+	}
+
+	ilo = 0
+	ihi = n - 1
+
+	if n == 0 {
+		return ilo, ihi
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Zggbal", Param: "a", Message: shortA})
+	case len(b) < (n-1)*ldb+n:
+		panic(Error{Routine: "Zggbal", Param: "b", Message: shortB})
+	case len(lscale) != n:
+		panic(Error{Routine: "Zggbal", Param: "lscale", Message: shortScale})
+	case len(rscale) != n:
+		panic(Error{Routine: "Zggbal", Param: "rscale", Message: shortScale})
+	case (job == lapack.Scale || job == lapack.PermuteScale) && len(work) < 6*n:
+		panic(Error{Routine: "Zggbal", Param: "work", Message: shortWork})
+	}
+
+	if len(work) == 0 {
+		work = make([]float64, 1)
+	}
+	ilo32 := []lapacke.Int{0}
+	ihi32 := []lapacke.Int{0}
+	lapacke.Zggbal(byte(job), n, a, lda, b, ldb, ilo32, ihi32, lscale, rscale, work)
+	ilo = int(ilo32[0]) - 1
+	ihi = int(ihi32[0]) - 1
+	for j := 0; j < ilo; j++ {
+		lscale[j]--
+		rscale[j]--
+	}
+	for j := ihi + 1; j < n; j++ {
+		lscale[j]--
+		rscale[j]--
+	}
+	return ilo, ihi
+}
+
+// Zggbak transforms the n×m complex matrix V of eigenvectors of the
+// generalized eigenvalue problem balanced by Zggbal to those of the original
+// problem as described for Dggbak.
+func (impl Implementation) Zggbak(job lapack.BalanceJob, side lapack.EVSide, n, ilo, ihi int, lscale, rscale []float64, m int, v []complex128, ldv int) {
+	switch {
+	case job != lapack.BalanceNone && job != lapack.Permute && job != lapack.Scale && job != lapack.PermuteScale:
+		panic(Error{Routine: "Zggbak", Param: "job", Message: badBalanceJob})
+	case side != lapack.EVLeft && side != lapack.EVRight:
+		panic(Error{Routine: "Zggbak", Param: "side", Message: badEVSide})
+	case n < 0:
+		panic(Error{Routine: "Zggbak", Param: "n", Message: nLT0})
+	case ilo < 0 || max(0, n-1) < ilo:
+		panic(Error{Routine: "Zggbak", Param: "ilo", Message: badIlo})
+	case ihi < min(ilo, n-1) || n <= ihi:
+		panic(Error{Routine: "Zggbak", Param: "ihi", Message: badIhi})
+	case m < 0:
+		panic(Error{Routine: "Zggbak", Param: "m", Message: mLT0})
+	case ldv < max(1, m):
+		panic(Error{Routine: "Zggbak", Param: "ldv", Message: badLdV})
+	}
+
+	// Quick return if possible.
+	if n == 0 || m == 0 {
+		return
+	}
+
+	switch {
+	case len(lscale) < n:
+		panic(Error{Routine: "Zggbak", Param: "lscale", Message: shortScale})
+	case len(rscale) < n:
+		panic(Error{Routine: "Zggbak", Param: "rscale", Message: shortScale})
+	case len(v) < (n-1)*ldv+m:
+		panic(Error{Routine: "Zggbak", Param: "v", Message: shortV})
+	}
+
+	// Quick return if possible.
+	if job == lapack.BalanceNone {
+		return
+	}
+
+	// Convert permutation indices to 1-based.
+	for j := 0; j < ilo; j++ {
+		lscale[j]++
+		rscale[j]++
+	}
+	for j := ihi + 1; j < n; j++ {
+		lscale[j]++
+		rscale[j]++
+	}
+	lapacke.Zggbak(byte(job), byte(side), n, ilo+1, ihi+1, lscale, rscale, m, v, ldv)
+	// Convert permutation indices back to 0-based.
+	for j := 0; j < ilo; j++ {
+		lscale[j]--
+		rscale[j]--
+	}
+	for j := ihi + 1; j < n; j++ {
+		lscale[j]--
+		rscale[j]--
+	}
+}
+
+// Zgetri computes the inverse of the n×n complex matrix A from its LU
+// factorization computed by Zgetrf as described for Dgetri. ipiv is
+// zero-indexed. If lwork == -1, the optimal work length is stored into
+// work[0].
+//
+// Zgetri returns whether the matrix is nonsingular. If it is singular, the
+// inversion is not performed.
+func (impl Implementation) Zgetri(n int, a []complex128, lda int, ipiv []int, work []complex128, lwork int) (ok bool) {
+	iws := max(1, n)
+	switch {
+	case n < 0:
+		panic(Error{Routine: "Zgetri", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Zgetri", Param: "lda", Message: badLdA})
+	case lwork < iws && lwork != -1:
+		panic(Error{Routine: "Zgetri", Param: "lwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Zgetri", Param: "work", Message: shortWork})
+	}
+
+	if n == 0 {
+		work[0] = 1
+		return true
+	}
+
+	if lwork == -1 {
+		return lapacke.Zgetri(n, a, lda, nil, work, -1)
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Zgetri", Param: "a", Message: shortA})
+	case len(ipiv) != n:
+		panic(Error{Routine: "Zgetri", Param: "ipiv", Message: badLenIpiv})
+	}
+
+	ipiv32 := make([]lapacke.Int, n)
+	for i, v := range ipiv {
+		v++ // Transform to one-indexed.
+		if v != int(lapacke.Int(v)) {
+			panic(Error{Routine: "Zgetri", Param: "ipiv", Message: "lapack: ipiv element out of range"})
+		}
+		ipiv32[i] = lapacke.Int(v)
+	}
+	return lapacke.Zgetri(n, a, lda, ipiv32, work, lwork)
+}
+
+// Zpotri computes the inverse of the n×n Hermitian positive definite matrix
+// A from its Cholesky factorization computed by Zpotrf, as described for
+// Dpotri. On return, the triangle of a given by uplo holds that of the
+// inverse of A.
+func (impl Implementation) Zpotri(uplo blas.Uplo, n int, a []complex128, lda int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Zpotri", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Zpotri", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Zpotri", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	if len(a) < (n-1)*lda+n {
+		panic(Error{Routine: "Zpotri", Param: "a", Message: shortA})
+	}
+
+	return lapacke.Zpotri(byte(uplo), n, a, lda)
+}
+
+// Ztrtri computes the inverse of the n×n complex triangular matrix A in
+// place as described for Dtrtri.
+//
+// Ztrtri returns whether the matrix is nonsingular. If it is singular, the
+// inversion is not performed.
+func (impl Implementation) Ztrtri(uplo blas.Uplo, diag blas.Diag, n int, a []complex128, lda int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Ztrtri", Param: "uplo", Message: badUplo})
+	case diag != blas.NonUnit && diag != blas.Unit:
+		panic(Error{Routine: "Ztrtri", Param: "diag", Message: badDiag})
+	case n < 0:
+		panic(Error{Routine: "Ztrtri", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Ztrtri", Param: "lda", Message: badLdA})
+	}
+
+	if n == 0 {
+		return true
+	}
+
+	if len(a) < (n-1)*lda+n {
+		panic(Error{Routine: "Ztrtri", Param: "a", Message: shortA})
+	}
+
+	return lapacke.Ztrtri(byte(uplo), byte(diag), n, a, lda)
+}
+
+// Zcgesv computes the solution of the system of linear equations
+//
+//	A * X = B
+//
+// for the n×n complex matrix A by mixed precision iterative refinement as
+// described for Dsgesv, with the factorization computed in complex64 by
+// Cgetrf. work must have length at least n*nrhs, swork at least n*(n+nrhs)
+// and rwork at least n.
+func (impl Implementation) Zcgesv(n, nrhs int, a []complex128, lda int, ipiv []int, b []complex128, ldb int, x []complex128, ldx int, work []complex128, swork []complex64, rwork []float64) (iter int, ok bool) {
+	switch {
+	case n < 0:
+		panic(Error{Routine: "Zcgesv", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Zcgesv", Param: "nrhs", Message: nrhsLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Zcgesv", Param: "lda", Message: badLdA})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Zcgesv", Param: "ldb", Message: badLdB})
+	case ldx < max(1, nrhs):
+		panic(Error{Routine: "Zcgesv", Param: "ldx", Message: badLdX})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0, true
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Zcgesv", Param: "a", Message: shortA})
+	case len(ipiv) != n:
+		panic(Error{Routine: "Zcgesv", Param: "ipiv", Message: badLenIpiv})
+	case nrhs > 0 && len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Zcgesv", Param: "b", Message: shortB})
+	case nrhs > 0 && len(x) < (n-1)*ldx+nrhs:
+		panic(Error{Routine: "Zcgesv", Param: "x", Message: shortX})
+	case len(work) < n*nrhs:
+		panic(Error{Routine: "Zcgesv", Param: "work", Message: shortWork})
+	case len(swork) < n*(n+nrhs):
+		panic(Error{Routine: "Zcgesv", Param: "swork", Message: shortSWork})
+	case len(rwork) < n:
+		panic(Error{Routine: "Zcgesv", Param: "rwork", Message: shortRWork})
+	}
+
+	ipiv32 := make([]lapacke.Int, n)
+	_iter := make([]lapacke.Int, 1)
+	ok = lapacke.Zcgesv(n, nrhs, a, lda, ipiv32, b, ldb, x, ldx, work, swork, rwork, _iter)
+	for i, v := range ipiv32 {
+		ipiv[i] = int(v) - 1 // Transform to zero-indexed.
+	}
+	return int(_iter[0]), ok
+}
+
+// Zgecon estimates the reciprocal of the condition number of the n×n complex
+// matrix A in the 1-norm or the ∞-norm given its LU decomposition computed
+// by Zgetrf, as described for Dgecon. anorm is the corresponding norm of the
+// original matrix A.
+//
+// work must have length at least 2*n and rwork at least 2*n, and Zgecon
+// will panic otherwise.
+func (impl Implementation) Zgecon(norm lapack.MatrixNorm, n int, a []complex128, lda int, anorm float64, work []complex128, rwork []float64) float64 {
+	switch {
+	case norm != lapack.MaxColumnSum && norm != lapack.MaxRowSum:
+		panic(Error{Routine: "Zgecon", Param: "norm", Message: badNorm})
+	case n < 0:
+		panic(Error{Routine: "Zgecon", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Zgecon", Param: "lda", Message: badLdA})
+	case anorm < 0:
+		panic(Error{Routine: "Zgecon", Param: "anorm", Message: negANorm})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 1
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Zgecon", Param: "a", Message: shortA})
+	case len(work) < 2*n:
+		panic(Error{Routine: "Zgecon", Param: "work", Message: shortWork})
+	case len(rwork) < 2*n:
+		panic(Error{Routine: "Zgecon", Param: "rwork", Message: shortRWork})
+	}
+
+	rcond := []float64{0}
+	lapacke.Zgecon(byte(norm), n, a, lda, anorm, rcond, work, rwork)
+	return rcond[0]
+}
+
+// Zpocon estimates the reciprocal of the condition number in the 1-norm of
+// the n×n Hermitian positive definite matrix A given its Cholesky
+// decomposition computed by Zpotrf. anorm is the 1-norm of the original
+// matrix A.
+//
+// work must have length at least 2*n and rwork at least n, and Zpocon will
+// panic otherwise.
+func (impl Implementation) Zpocon(uplo blas.Uplo, n int, a []complex128, lda int, anorm float64, work []complex128, rwork []float64) float64 {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Zpocon", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Zpocon", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Zpocon", Param: "lda", Message: badLdA})
+	case anorm < 0:
+		panic(Error{Routine: "Zpocon", Param: "anorm", Message: negANorm})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 1
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Zpocon", Param: "a", Message: shortA})
+	case len(work) < 2*n:
+		panic(Error{Routine: "Zpocon", Param: "work", Message: shortWork})
+	case len(rwork) < n:
+		panic(Error{Routine: "Zpocon", Param: "rwork", Message: shortRWork})
+	}
+
+	rcond := []float64{0}
+	lapacke.Zpocon(byte(uplo), n, a, lda, anorm, rcond, work, rwork)
+	return rcond[0]
+}
+
+// Ztrcon estimates the reciprocal of the condition number of the n×n complex
+// triangular matrix A in the 1-norm or the ∞-norm.
+//
+// work must have length at least 2*n and rwork at least n, and Ztrcon will
+// panic otherwise.
+func (impl Implementation) Ztrcon(norm lapack.MatrixNorm, uplo blas.Uplo, diag blas.Diag, n int, a []complex128, lda int, work []complex128, rwork []float64) float64 {
+	switch {
+	case norm != lapack.MaxColumnSum && norm != lapack.MaxRowSum:
+		panic(Error{Routine: "Ztrcon", Param: "norm", Message: badNorm})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Ztrcon", Param: "uplo", Message: badUplo})
+	case diag != blas.NonUnit && diag != blas.Unit:
+		panic(Error{Routine: "Ztrcon", Param: "diag", Message: badDiag})
+	case n < 0:
+		panic(Error{Routine: "Ztrcon", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Ztrcon", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 1
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Ztrcon", Param: "a", Message: shortA})
+	case len(work) < 2*n:
+		panic(Error{Routine: "Ztrcon", Param: "work", Message: shortWork})
+	case len(rwork) < n:
+		panic(Error{Routine: "Ztrcon", Param: "rwork", Message: shortRWork})
+	}
+
+	rcond := []float64{0}
+	lapacke.Ztrcon(byte(norm), byte(uplo), byte(diag), n, a, lda, rcond, work, rwork)
+	return rcond[0]
+}
+
+// Ztrsyl solves the complex Sylvester matrix equation
+//  op(A)*X + isgn*X*op(B) = scale*C
+// where op(A) is A or Aᴴ as specified by trana and op(B) is B or Bᴴ as
+// specified by tranb, A is an m×m and B an n×n upper triangular matrix in
+// Schur form, and C and X are m×n matrices. trana and tranb must be
+// blas.NoTrans or blas.ConjTrans, and isgn must be 1 or -1, otherwise Ztrsyl
+// will panic.
+//
+// On entry c holds C and on return it is overwritten by the solution X. The
+// scale factor 0 < scale ≤ 1 is chosen to avoid overflow in X.
+//
+// ok is false if A and -isgn*B have common or very close eigenvalues, in
+// which case perturbed values were used to solve the equation.
+func (impl Implementation) Ztrsyl(trana, tranb blas.Transpose, isgn, m, n int, a []complex128, lda int, b []complex128, ldb int, c []complex128, ldc int) (scale float64, ok bool) {
+	switch {
+	case trana != blas.NoTrans && trana != blas.ConjTrans:
+		panic(Error{Routine: "Ztrsyl", Param: "trana", Message: badTrans})
+	case tranb != blas.NoTrans && tranb != blas.ConjTrans:
+		panic(Error{Routine: "Ztrsyl", Param: "tranb", Message: badTrans})
+	case isgn != 1 && isgn != -1:
+		panic(Error{Routine: "Ztrsyl", Param: "isgn", Message: badIsgn})
+	case m < 0:
+		panic(Error{Routine: "Ztrsyl", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Ztrsyl", Param: "n", Message: nLT0})
+	case lda < max(1, m):
+		panic(Error{Routine: "Ztrsyl", Param: "lda", Message: badLdA})
+	case ldb < max(1, n):
+		panic(Error{Routine: "Ztrsyl", Param: "ldb", Message: badLdB})
+	case ldc < max(1, n):
+		panic(Error{Routine: "Ztrsyl", Param: "ldc", Message: badLdC})
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return 1, true
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+m:
+		panic(Error{Routine: "Ztrsyl", Param: "a", Message: shortA})
+	case len(b) < (n-1)*ldb+n:
+		panic(Error{Routine: "Ztrsyl", Param: "b", Message: shortB})
+	case len(c) < (m-1)*ldc+n:
+		panic(Error{Routine: "Ztrsyl", Param: "c", Message: shortC})
+	}
+
+	_scale := []float64{0}
+	ok = lapacke.Ztrsyl(byte(trana), byte(tranb), isgn, m, n, a, lda, b, ldb, c, ldc, _scale)
+	return _scale[0], ok
+}
+
+// Zppsv computes the solution of the system of linear equations A * X = B
+// with the n×n Hermitian positive definite matrix A stored in packed format
+// in ap, as described for Dppsv. On return, ap holds the Cholesky factor of
+// A in the same format, as computed by Zpptrf, and b holds the solution X.
+//
+// If A is not positive definite, Zppsv returns false and the solution is
+// not computed.
+func (impl Implementation) Zppsv(uplo blas.Uplo, n, nrhs int, ap, b []complex128, ldb int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Zppsv", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Zppsv", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Zppsv", Param: "nrhs", Message: nrhsLT0})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Zppsv", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(ap) < n*(n+1)/2:
+		panic(Error{Routine: "Zppsv", Param: "ap", Message: shortAP})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Zppsv", Param: "b", Message: shortB})
+	}
+
+	return lapacke.Zppsv(byte(uplo), n, nrhs, ap, b, ldb)
+}
+
+// Zpptrf computes the Cholesky factorization of the n×n Hermitian positive
+// definite matrix A stored in packed format in ap,
+//
+//	A = U^H * U  if uplo == blas.Upper
+//	A = L * L^H  if uplo == blas.Lower
+//
+// and stores the factor in place into ap, which must have length at least
+// n*(n+1)/2. If A is not positive definite, false is returned.
+func (impl Implementation) Zpptrf(uplo blas.Uplo, n int, ap []complex128) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Zpptrf", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Zpptrf", Param: "n", Message: nLT0})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	if len(ap) < n*(n+1)/2 {
+		panic(Error{Routine: "Zpptrf", Param: "ap", Message: shortAP})
+	}
+
+	return lapacke.Zpptrf(byte(uplo), n, ap)
+}
+
+// Zpptrs solves a system of n linear equations A*X = B where A is an n×n
+// Hermitian positive definite matrix represented by its Cholesky
+// factorization in packed format, as computed by Zpptrf. On entry, B
+// contains the n×nrhs right-hand side matrix, on return it contains the
+// solution matrix X.
+func (impl Implementation) Zpptrs(uplo blas.Uplo, n, nrhs int, ap, b []complex128, ldb int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Zpptrs", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Zpptrs", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Zpptrs", Param: "nrhs", Message: nrhsLT0})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Zpptrs", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(ap) < n*(n+1)/2:
+		panic(Error{Routine: "Zpptrs", Param: "ap", Message: shortAP})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Zpptrs", Param: "b", Message: shortB})
+	}
+
+	lapacke.Zpptrs(byte(uplo), n, nrhs, ap, b, ldb)
+}
+
+// Zhpev computes all eigenvalues and, optionally, the eigenvectors of the
+// n×n Hermitian matrix A stored in packed format in ap, as described for
+// Dspev. The real eigenvalues are returned in ascending order in w.
+//
+// work must have length at least max(1, 2*n-1) and rwork at least
+// max(1, 3*n-2).
+//
+// Zhpev returns whether the algorithm converged.
+func (impl Implementation) Zhpev(jobz lapack.EVJob, uplo blas.Uplo, n int, ap []complex128, w []float64, z []complex128, ldz int, work []complex128, rwork []float64) (ok bool) {
+	switch {
+	case jobz != lapack.EVNone && jobz != lapack.EVCompute:
+		panic(Error{Routine: "Zhpev", Param: "jobz", Message: badEVJob})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Zhpev", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Zhpev", Param: "n", Message: nLT0})
+	case ldz < 1 || (jobz == lapack.EVCompute && ldz < n):
+		panic(Error{Routine: "Zhpev", Param: "ldz", Message: badLdZ})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(ap) < n*(n+1)/2:
+		panic(Error{Routine: "Zhpev", Param: "ap", Message: shortAP})
+	case len(w) < n:
+		panic(Error{Routine: "Zhpev", Param: "w", Message: shortW})
+	case jobz == lapack.EVCompute && len(z) < (n-1)*ldz+n:
+		panic(Error{Routine: "Zhpev", Param: "z", Message: shortZ})
+	case len(work) < max(1, 2*n-1):
+		panic(Error{Routine: "Zhpev", Param: "work", Message: shortWork})
+	case len(rwork) < max(1, 3*n-2):
+		panic(Error{Routine: "Zhpev", Param: "rwork", Message: shortRWork})
+	}
+
+	return lapacke.Zhpev(byte(jobz), byte(uplo), n, ap, w, z, ldz, work, rwork)
+}
+
+// Zhpsv computes the solution of the system of linear equations A * X = B
+// with the n×n Hermitian matrix A stored in packed format in ap, using the
+// diagonal pivoting factorization of A computed by Zhptrf. On return, ap
+// and ipiv hold the factorization as described for Zhptrf, and b holds the
+// solution X.
+//
+// If D is exactly singular, Zhpsv returns false and the solution is not
+// computed.
+func (impl Implementation) Zhpsv(uplo blas.Uplo, n, nrhs int, ap []complex128, ipiv []int, b []complex128, ldb int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Zhpsv", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Zhpsv", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Zhpsv", Param: "nrhs", Message: nrhsLT0})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Zhpsv", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(ap) < n*(n+1)/2:
+		panic(Error{Routine: "Zhpsv", Param: "ap", Message: shortAP})
+	case len(ipiv) != n:
+		panic(Error{Routine: "Zhpsv", Param: "ipiv", Message: badLenIpiv})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Zhpsv", Param: "b", Message: shortB})
+	}
+
+	ipiv32 := make([]lapacke.Int, n)
+	ok = lapacke.Zhpsv(byte(uplo), n, nrhs, ap, ipiv32, b, ldb)
+	symPivotsToGonum(ipiv, ipiv32)
+	return ok
+}
+
+// Zhptrf computes the factorization
+//
+//	A = U * D * U^H  if uplo == blas.Upper
+//	A = L * D * L^H  if uplo == blas.Lower
+//
+// of the n×n Hermitian matrix A stored in packed format in ap by the
+// Bunch-Kaufman diagonal pivoting method, where D is Hermitian and block
+// diagonal with 1×1 and 2×2 blocks. On return, ap holds D and the
+// multipliers of U or L in the same format and ipiv holds the interchanges
+// and the block structure of D as described for Dsptrf.
+//
+// Zhptrf returns whether D is non-singular. The factorization is completed
+// in either case, but a singular D cannot be used to solve a system.
+func (impl Implementation) Zhptrf(uplo blas.Uplo, n int, ap []complex128, ipiv []int) (ok bool) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Zhptrf", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Zhptrf", Param: "n", Message: nLT0})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return true
+	}
+
+	switch {
+	case len(ap) < n*(n+1)/2:
+		panic(Error{Routine: "Zhptrf", Param: "ap", Message: shortAP})
+	case len(ipiv) != n:
+		panic(Error{Routine: "Zhptrf", Param: "ipiv", Message: badLenIpiv})
+	}
+
+	ipiv32 := make([]lapacke.Int, n)
+	ok = lapacke.Zhptrf(byte(uplo), n, ap, ipiv32)
+	symPivotsToGonum(ipiv, ipiv32)
+	return ok
+}
+
+// Zhptrs solves a system of linear equations A * X = B with the n×n
+// Hermitian matrix A stored in packed format, using the factorization in ap
+// and ipiv computed by Zhptrf. On entry, b holds the n×nrhs right hand side
+// matrix B, and on return it is overwritten by the solution X.
+func (impl Implementation) Zhptrs(uplo blas.Uplo, n, nrhs int, ap []complex128, ipiv []int, b []complex128, ldb int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Zhptrs", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Zhptrs", Param: "n", Message: nLT0})
+	case nrhs < 0:
+		panic(Error{Routine: "Zhptrs", Param: "nrhs", Message: nrhsLT0})
+	case ldb < max(1, nrhs):
+		panic(Error{Routine: "Zhptrs", Param: "ldb", Message: badLdB})
+	}
+
+	// Quick return if possible.
+	if n == 0 || nrhs == 0 {
+		return
+	}
+
+	switch {
+	case len(ap) < n*(n+1)/2:
+		panic(Error{Routine: "Zhptrs", Param: "ap", Message: shortAP})
+	case len(ipiv) != n:
+		panic(Error{Routine: "Zhptrs", Param: "ipiv", Message: badLenIpiv})
+	case len(b) < (n-1)*ldb+nrhs:
+		panic(Error{Routine: "Zhptrs", Param: "b", Message: shortB})
+	}
+
+	lapacke.Zhptrs(byte(uplo), n, nrhs, ap, symPivotsToLapacke(ipiv), b, ldb)
+}
+
+// Zuncsd computes the CS decomposition of the m×m unitary matrix X,
+// partitioned into the p×q block X11, the p×(m-q) block X12, the (m-p)×q
+// block X21 and the (m-p)×(m-q) block X22,
+//
+//	[ X11 X12 ]   [ U1  0 ] [ D11 D12 ] [ V1  0 ]ᴴ
+//	[ X21 X22 ] = [ 0  U2 ] [ D21 D22 ] [ 0  V2 ] ,
+//
+// where U1, U2, V1 and V2 are unitary matrices of order p, m-p, q and m-q,
+// and the blocks of D are real diagonal matrices padded with zero rows and
+// columns, holding the cosines and sines of r = min(p, m-p, q, m-q) angles
+// in [0, π/2] together with ones and zeros. D11, D21 and D22 are
+// nonnegative and D12 is nonpositive.
+//
+// On entry, x11, x12, x21 and x22 hold the blocks of X. On return their
+// contents are destroyed and theta, which must have length r, holds the
+// angles in increasing order.
+//
+// U1, U2, V1ᴴ and V2ᴴ are computed into u1, u2, v1t and v2t if the
+// corresponding job is CSDCompute, and the slices are not referenced if it
+// is CSDNone.
+//
+// lwork and lrwork must be -1 or at least 1, and work and rwork must have
+// length at least max(1, lwork) and max(1, lrwork). If either of lwork and
+// lrwork is -1, instead of performing the decomposition the optimal lwork
+// and lrwork are stored into work[0] and rwork[0].
+//
+// Zuncsd returns whether the decomposition converged.
+func (impl Implementation) Zuncsd(jobU1, jobU2, jobV1T, jobV2T CSDJob, m, p, q int, x11 []complex128, ldx11 int, x12 []complex128, ldx12 int, x21 []complex128, ldx21 int, x22 []complex128, ldx22 int, theta []float64, u1 []complex128, ldu1 int, u2 []complex128, ldu2 int, v1t []complex128, ldv1t int, v2t []complex128, ldv2t int, work []complex128, lwork int, rwork []float64, lrwork int) (ok bool) {
+	wantu1 := jobU1 == CSDCompute
+	wantu2 := jobU2 == CSDCompute
+	wantv1t := jobV1T == CSDCompute
+	wantv2t := jobV2T == CSDCompute
+	switch {
+	case !wantu1 && jobU1 != CSDNone:
+		panic(Error{Routine: "Zuncsd", Param: "jobU1", Message: badCSDJob})
+	case !wantu2 && jobU2 != CSDNone:
+		panic(Error{Routine: "Zuncsd", Param: "jobU2", Message: badCSDJob})
+	case !wantv1t && jobV1T != CSDNone:
+		panic(Error{Routine: "Zuncsd", Param: "jobV1T", Message: badCSDJob})
+	case !wantv2t && jobV2T != CSDNone:
+		panic(Error{Routine: "Zuncsd", Param: "jobV2T", Message: badCSDJob})
+	case m < 0:
+		panic(Error{Routine: "Zuncsd", Param: "m", Message: mLT0})
+	case p < 0 || m < p:
+		panic(Error{Routine: "Zuncsd", Param: "p", Message: badCSDP})
+	case q < 0 || m < q:
+		panic(Error{Routine: "Zuncsd", Param: "q", Message: badCSDQ})
+	case ldx11 < max(1, q):
+		panic(Error{Routine: "Zuncsd", Param: "ldx11", Message: badLdX})
+	case ldx12 < max(1, m-q):
+		panic(Error{Routine: "Zuncsd", Param: "ldx12", Message: badLdX})
+	case ldx21 < max(1, q):
+		panic(Error{Routine: "Zuncsd", Param: "ldx21", Message: badLdX})
+	case ldx22 < max(1, m-q):
+		panic(Error{Routine: "Zuncsd", Param: "ldx22", Message: badLdX})
+	case ldu1 < 1, wantu1 && ldu1 < p:
+		panic(Error{Routine: "Zuncsd", Param: "ldu1", Message: badLdU})
+	case ldu2 < 1, wantu2 && ldu2 < m-p:
+		panic(Error{Routine: "Zuncsd", Param: "ldu2", Message: badLdU})
+	case ldv1t < 1, wantv1t && ldv1t < q:
+		panic(Error{Routine: "Zuncsd", Param: "ldv1t", Message: badLdV})
+	case ldv2t < 1, wantv2t && ldv2t < m-q:
+		panic(Error{Routine: "Zuncsd", Param: "ldv2t", Message: badLdV})
+	case lwork < 1 && lwork != -1:
+		panic(Error{Routine: "Zuncsd", Param: "lwork", Message: badLWork})
+	case lrwork < 1 && lrwork != -1:
+		panic(Error{Routine: "Zuncsd", Param: "lrwork", Message: badLWork})
+	case len(work) < max(1, lwork):
+		panic(Error{Routine: "Zuncsd", Param: "work", Message: shortWork})
+	case len(rwork) < max(1, lrwork):
+		panic(Error{Routine: "Zuncsd", Param: "rwork", Message: shortRWork})
+	}
+
+	// Quick return if possible.
+	if m == 0 {
+		work[0] = 1
+		rwork[0] = 1
+		return true
+	}
+
+	r := csdRank(m, p, q)
+	if lwork == -1 || lrwork == -1 {
+		_iwork := make([]lapacke.Int, max(1, m-r))
+		return lapacke.Zuncsd(byte(jobU1), byte(jobU2), byte(jobV1T), byte(jobV2T), 'N', 'D', m, p, q, x11, ldx11, x12, ldx12, x21, ldx21, x22, ldx22, theta, u1, ldu1, u2, ldu2, v1t, ldv1t, v2t, ldv2t, work, -1, rwork, -1, _iwork)
+	}
+
+	switch {
+	case len(x11) < (p-1)*ldx11+q:
+		panic(Error{Routine: "Zuncsd", Param: "x11", Message: shortX})
+	case len(x12) < (p-1)*ldx12+m-q:
+		panic(Error{Routine: "Zuncsd", Param: "x12", Message: shortX})
+	case len(x21) < (m-p-1)*ldx21+q:
+		panic(Error{Routine: "Zuncsd", Param: "x21", Message: shortX})
+	case len(x22) < (m-p-1)*ldx22+m-q:
+		panic(Error{Routine: "Zuncsd", Param: "x22", Message: shortX})
+	case len(theta) != r:
+		panic(Error{Routine: "Zuncsd", Param: "theta", Message: shortTheta})
+	case wantu1 && len(u1) < (p-1)*ldu1+p:
+		panic(Error{Routine: "Zuncsd", Param: "u1", Message: shortU})
+	case wantu2 && len(u2) < (m-p-1)*ldu2+m-p:
+		panic(Error{Routine: "Zuncsd", Param: "u2", Message: shortU})
+	case wantv1t && len(v1t) < (q-1)*ldv1t+q:
+		panic(Error{Routine: "Zuncsd", Param: "v1t", Message: shortV})
+	case wantv2t && len(v2t) < (m-q-1)*ldv2t+m-q:
+		panic(Error{Routine: "Zuncsd", Param: "v2t", Message: shortV})
+	}
+
+	_iwork := make([]lapacke.Int, max(1, m-r))
+	return lapacke.Zuncsd(byte(jobU1), byte(jobU2), byte(jobV1T), byte(jobV2T), 'N', 'D', m, p, q, x11, ldx11, x12, ldx12, x21, ldx21, x22, ldx22, theta, u1, ldu1, u2, ldu2, v1t, ldv1t, v2t, ldv2t, work, lwork, rwork, lrwork, _iwork)
+}
+
+// Zlacpy copies the elements of A specified by uplo into B, as described
+// for Dlacpy.
+func (impl Implementation) Zlacpy(uplo blas.Uplo, m, n int, a []complex128, lda int, b []complex128, ldb int) {
+	switch {
+	case uplo != blas.Upper && uplo != blas.Lower && uplo != blas.All:
+		panic(Error{Routine: "Zlacpy", Param: "uplo", Message: badUplo})
+	case m < 0:
+		panic(Error{Routine: "Zlacpy", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Zlacpy", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Zlacpy", Param: "lda", Message: badLdA})
+	case ldb < max(1, n):
+		panic(Error{Routine: "Zlacpy", Param: "ldb", Message: badLdB})
+	}
+
+	if m == 0 || n == 0 {
+		return
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(Error{Routine: "Zlacpy", Param: "a", Message: shortA})
+	case len(b) < (m-1)*ldb+n:
+		panic(Error{Routine: "Zlacpy", Param: "b", Message: shortB})
+	}
+
+	lapacke.Zlacpy(byte(uplo), m, n, a, lda, b, ldb)
+}
+
+// Zlange computes the specified norm of the general m×n complex matrix A,
+// as described for Dlange, with the absolute values of the complex entries.
+// If norm == lapack.MaxColumnSum, work must have length at least n.
+func (impl Implementation) Zlange(norm lapack.MatrixNorm, m, n int, a []complex128, lda int, work []float64) float64 {
+	switch {
+	case norm != lapack.MaxRowSum && norm != lapack.MaxColumnSum && norm != lapack.Frobenius && norm != lapack.MaxAbs:
+		panic(Error{Routine: "Zlange", Param: "norm", Message: badNorm})
+	case lda < max(1, n):
+		panic(Error{Routine: "Zlange", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if m == 0 || n == 0 {
+		return 0
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(Error{Routine: "Zlange", Param: "a", Message: shortA})
+	case norm == lapack.MaxColumnSum && len(work) < n:
+		panic(Error{Routine: "Zlange", Param: "work", Message: shortWork})
+	}
+
+	return lapacke.Zlange(byte(norm), m, n, a, lda, work)
+}
+
+// Zlanhe computes the specified norm of the n×n Hermitian matrix A stored
+// in the triangle given by uplo. If norm == lapack.MaxColumnSum or
+// norm == lapack.MaxRowSum, work must have length at least n, otherwise work
+// is unused.
+func (impl Implementation) Zlanhe(norm lapack.MatrixNorm, uplo blas.Uplo, n int, a []complex128, lda int, work []float64) float64 {
+	switch {
+	case norm != lapack.MaxRowSum && norm != lapack.MaxColumnSum && norm != lapack.Frobenius && norm != lapack.MaxAbs:
+		panic(Error{Routine: "Zlanhe", Param: "norm", Message: badNorm})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Zlanhe", Param: "uplo", Message: badUplo})
+	case n < 0:
+		panic(Error{Routine: "Zlanhe", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Zlanhe", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	if n == 0 {
+		return 0
+	}
+
+	switch {
+	case len(a) < (n-1)*lda+n:
+		panic(Error{Routine: "Zlanhe", Param: "a", Message: shortA})
+	case (norm == lapack.MaxColumnSum || norm == lapack.MaxRowSum) && len(work) < n:
+		panic(Error{Routine: "Zlanhe", Param: "work", Message: shortWork})
+	}
+
+	return lapacke.Zlanhe(byte(norm), byte(uplo), n, a, lda, work)
+}
+
+// Zlantr computes the specified norm of the m×n complex trapezoidal matrix
+// A. If norm == lapack.MaxColumnSum work must have length at least n,
+// otherwise work is unused.
+func (impl Implementation) Zlantr(norm lapack.MatrixNorm, uplo blas.Uplo, diag blas.Diag, m, n int, a []complex128, lda int, work []float64) float64 {
+	switch {
+	case norm != lapack.MaxRowSum && norm != lapack.MaxColumnSum && norm != lapack.Frobenius && norm != lapack.MaxAbs:
+		panic(Error{Routine: "Zlantr", Param: "norm", Message: badNorm})
+	case uplo != blas.Upper && uplo != blas.Lower:
+		panic(Error{Routine: "Zlantr", Param: "uplo", Message: badUplo})
+	case diag != blas.Unit && diag != blas.NonUnit:
+		panic(Error{Routine: "Zlantr", Param: "diag", Message: badDiag})
+	case m < 0:
+		panic(Error{Routine: "Zlantr", Param: "m", Message: mLT0})
+	case uplo == blas.Upper && m > n:
+		panic(Error{Routine: "Zlantr", Param: "m", Message: mGTN})
+	case n < 0:
+		panic(Error{Routine: "Zlantr", Param: "n", Message: nLT0})
+	case uplo == blas.Lower && n > m:
+		panic(Error{Routine: "Zlantr", Param: "n", Message: nGTM})
+	case lda < max(1, n):
+		panic(Error{Routine: "Zlantr", Param: "lda", Message: badLdA})
+	}
+
+	// Quick return if possible.
+	minmn := min(m, n)
+	if minmn == 0 {
+		return 0
+	}
+
+	switch {
+	case len(a) < (m-1)*lda+n:
+		panic(Error{Routine: "Zlantr", Param: "a", Message: shortA})
+	case norm == lapack.MaxColumnSum && len(work) < n:
+		panic(Error{Routine: "Zlantr", Param: "work", Message: shortWork})
+	}
+
+	if norm == lapack.MaxRowSum && len(work) < m {
+		// Allocate new work to be on the safe side because the expectation of LAPACKE on
+		// row-major input is unclear.
+		work = make([]float64, m)
+	}
+	return lapacke.Zlantr(byte(norm), byte(uplo), byte(diag), m, n, a, lda, work)
+}
+
+// Zlascl multiplies the m×n complex matrix A by the real scalar cto/cfrom,
+// as described for Dlascl.
+func (impl Implementation) Zlascl(kind lapack.MatrixType, kl, ku int, cfrom, cto float64, m, n int, a []complex128, lda int) {
+	switch kind {
+	default:
+		panic(Error{Routine: "Zlascl", Param: "kind", Message: badMatrixType})
+	case 'H', 'B', 'Q', 'Z': // See dlascl.f.
+	case lapack.General, lapack.UpperTri, lapack.LowerTri:
+		if lda < max(1, n) {
+			panic(Error{Routine: "Zlascl", Param: "lda", Message: badLdA})
+		}
+	}
+	switch {
+	case cfrom == 0:
+		panic(Error{Routine: "Zlascl", Param: "cfrom", Message: zeroCFrom})
+	case math.IsNaN(cfrom):
+		panic(Error{Routine: "Zlascl", Param: "cfrom", Message: nanCFrom})
+	case math.IsNaN(cto):
+		panic(Error{Routine: "Zlascl", Param: "cto", Message: nanCTo})
+	case m < 0:
+		panic(Error{Routine: "Zlascl", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Zlascl", Param: "n", Message: nLT0})
+	}
+
+	if n == 0 || m == 0 {
+		return
+	}
+
+	switch kind {
+	case lapack.General, lapack.UpperTri, lapack.LowerTri:
+		if len(a) < (m-1)*lda+n {
+			panic(Error{Routine: "Zlascl", Param: "a", Message: shortA})
+		}
+	}
+
+	lapacke.Zlascl(byte(kind), kl, ku, cfrom, cto, m, n, a, lda)
+}
+
+// Zlaset sets the off-diagonal elements of the m×n complex matrix A in the
+// part given by uplo to alpha and the diagonal elements to beta, as
+// described for Dlaset.
+func (impl Implementation) Zlaset(uplo blas.Uplo, m, n int, alpha, beta complex128, a []complex128, lda int) {
+	switch {
+	case m < 0:
+		panic(Error{Routine: "Zlaset", Param: "m", Message: mLT0})
+	case n < 0:
+		panic(Error{Routine: "Zlaset", Param: "n", Message: nLT0})
+	case lda < max(1, n):
+		panic(Error{Routine: "Zlaset", Param: "lda", Message: badLdA})
+	}
+
+	minmn := min(m, n)
+	if minmn == 0 {
+		return
+	}
+
+	if len(a) < (m-1)*lda+n {
+		panic(Error{Routine: "Zlaset", Param: "a", Message: shortA})
+	}
+
+	lapacke.Zlaset(byte(uplo), m, n, alpha, beta, a, lda)
+}
@@ -0,0 +1,328 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+	"math/cmplx"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+	"gonum.org/v1/gonum/blas/cblas128"
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/lapack"
+)
+
+const ztol = 1e-10
+
+// cmaxAbsDiff returns the largest absolute difference between the elements
+// of a and b.
+func cmaxAbsDiff(a, b cblas128.General) float64 {
+	var d float64
+	for i := 0; i < a.Rows; i++ {
+		for j := 0; j < a.Cols; j++ {
+			d = max64(d, cmplx.Abs(a.Data[i*a.Stride+j]-b.Data[i*b.Stride+j]))
+		}
+	}
+	return d
+}
+
+func cloneCGeneral(a cblas128.General) cblas128.General {
+	c := newCGeneral(a.Rows, a.Cols)
+	for i := 0; i < a.Rows; i++ {
+		copy(c.Data[i*c.Stride:i*c.Stride+a.Cols], a.Data[i*a.Stride:])
+	}
+	return c
+}
+
+// conjTrans returns A^H.
+func conjTrans(a cblas128.General) cblas128.General {
+	return CMatrix{General: a, Trans: blas.ConjTrans}.Dense()
+}
+
+// randomHPD returns a random n×n Hermitian positive definite matrix.
+func randomHPD(rnd *rand.Rand, n int) cblas128.General {
+	m := randomCGeneral(rnd, n, n, n)
+	a := naiveCMul(conjTrans(m), m)
+	for i := 0; i < n; i++ {
+		a.Data[i*a.Stride+i] += complex(float64(n), 0)
+	}
+	return a
+}
+
+func TestZgetrs(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 4, 10} {
+		for _, nrhs := range []int{1, 3} {
+			for _, trans := range transposes {
+				a := randomCGeneral(rnd, n, n, n+2)
+				b := randomCGeneral(rnd, n, nrhs, nrhs+1)
+				lu := cloneCGeneral(a)
+				ipiv := make([]int, n)
+				if !impl.Zgetrf(n, n, lu.Data, lu.Stride, ipiv) {
+					t.Fatalf("n=%d: unexpected singular matrix", n)
+				}
+				x := cloneCGeneral(b)
+				impl.Zgetrs(trans, n, nrhs, lu.Data, lu.Stride, ipiv, x.Data, x.Stride)
+				op := CMatrix{General: a, Trans: trans}.Dense()
+				if d := cmaxAbsDiff(naiveCMul(op, x), b); d > ztol {
+					t.Errorf("n=%d nrhs=%d trans=%c: unexpected residual %v", n, nrhs, trans, d)
+				}
+			}
+		}
+	}
+}
+
+func TestZpotrs(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 4, 10} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			a := randomHPD(rnd, n)
+			b := randomCGeneral(rnd, n, 2, 2)
+			f := cloneCGeneral(a)
+			if !impl.Zpotrf(uplo, n, f.Data, f.Stride) {
+				t.Fatalf("n=%d uplo=%c: unexpected failure of Zpotrf", n, uplo)
+			}
+			x := cloneCGeneral(b)
+			impl.Zpotrs(uplo, n, 2, f.Data, f.Stride, x.Data, x.Stride)
+			if d := cmaxAbsDiff(naiveCMul(a, x), b); d > ztol {
+				t.Errorf("n=%d uplo=%c: unexpected residual %v", n, uplo, d)
+			}
+		}
+	}
+
+	a := cblas128.General{Rows: 2, Cols: 2, Stride: 2, Data: []complex128{1, 2, 2, 1}}
+	if impl.Zpotrf(blas.Upper, 2, a.Data, a.Stride) {
+		t.Error("unexpected success of Zpotrf for an indefinite matrix")
+	}
+}
+
+func TestZgeqrf(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, dims := range []struct{ m, n int }{{1, 1}, {5, 3}, {8, 8}} {
+		m, n := dims.m, dims.n
+		a := randomCGeneral(rnd, m, n, n)
+		qr := cloneCGeneral(a)
+		tau := make([]complex128, n)
+		work := make([]complex128, 1)
+		impl.Zgeqrf(m, n, qr.Data, qr.Stride, tau, work, -1)
+		work = make([]complex128, int(real(work[0])))
+		impl.Zgeqrf(m, n, qr.Data, qr.Stride, tau, work, len(work))
+
+		r := newCGeneral(n, n)
+		for i := 0; i < n; i++ {
+			copy(r.Data[i*r.Stride+i:i*r.Stride+n], qr.Data[i*qr.Stride+i:])
+		}
+
+		// C = Q^H * A must equal R in its first n rows.
+		c := cloneCGeneral(a)
+		impl.Zunmqr(blas.Left, blas.ConjTrans, m, n, n, qr.Data, qr.Stride, tau, c.Data, c.Stride, make([]complex128, n), n)
+		top := cblas128.General{Rows: n, Cols: n, Stride: c.Stride, Data: c.Data}
+		if d := cmaxAbsDiff(top, r); d > ztol {
+			t.Errorf("m=%d n=%d: unexpected Q^H * A from Zunmqr, difference %v", m, n, d)
+		}
+
+		q := qr
+		impl.Zungqr(m, n, n, q.Data, q.Stride, tau, make([]complex128, n), n)
+		if d := cmaxAbsDiff(naiveCMul(q, r), a); d > ztol {
+			t.Errorf("m=%d n=%d: unexpected Q * R, difference %v", m, n, d)
+		}
+		eye := newCGeneral(n, n)
+		for i := 0; i < n; i++ {
+			eye.Data[i*eye.Stride+i] = 1
+		}
+		if d := cmaxAbsDiff(naiveCMul(conjTrans(q), q), eye); d > ztol {
+			t.Errorf("m=%d n=%d: Q not orthonormal, difference %v", m, n, d)
+		}
+	}
+}
+
+func TestZgesvd(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, dims := range []struct{ m, n int }{{1, 1}, {5, 3}, {3, 5}, {6, 6}} {
+		m, n := dims.m, dims.n
+		k := min(m, n)
+		a := randomCGeneral(rnd, m, n, n+1)
+		f := cloneCGeneral(a)
+		s := make([]float64, k)
+		u := newCGeneral(m, m)
+		vt := newCGeneral(n, n)
+		rwork := make([]float64, 5*k)
+		work := make([]complex128, 1)
+		impl.Zgesvd(lapack.SVDAll, lapack.SVDAll, m, n, f.Data, f.Stride, s, u.Data, u.Stride, vt.Data, vt.Stride, work, -1, rwork)
+		work = make([]complex128, int(real(work[0])))
+		if !impl.Zgesvd(lapack.SVDAll, lapack.SVDAll, m, n, f.Data, f.Stride, s, u.Data, u.Stride, vt.Data, vt.Stride, work, len(work), rwork) {
+			t.Fatalf("m=%d n=%d: Zgesvd did not converge", m, n)
+		}
+		if !sort.SliceIsSorted(s, func(i, j int) bool { return s[i] > s[j] }) {
+			t.Errorf("m=%d n=%d: singular values not in descending order: %v", m, n, s)
+		}
+		sigma := newCGeneral(m, n)
+		for i, v := range s {
+			sigma.Data[i*sigma.Stride+i] = complex(v, 0)
+		}
+		if d := cmaxAbsDiff(naiveCMul(naiveCMul(u, sigma), vt), a); d > ztol {
+			t.Errorf("m=%d n=%d: unexpected U * Σ * V^H, difference %v", m, n, d)
+		}
+	}
+}
+
+func TestZheev(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 4, 9} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			m := randomCGeneral(rnd, n, n, n)
+			a := naiveCMul(conjTrans(m), m)
+			v := cloneCGeneral(a)
+			w := make([]float64, n)
+			rwork := make([]float64, max(1, 3*n-2))
+			work := make([]complex128, 1)
+			impl.Zheev(lapack.EVCompute, uplo, n, v.Data, v.Stride, w, work, -1, rwork)
+			work = make([]complex128, int(real(work[0])))
+			if !impl.Zheev(lapack.EVCompute, uplo, n, v.Data, v.Stride, w, work, len(work), rwork) {
+				t.Fatalf("n=%d uplo=%c: Zheev did not converge", n, uplo)
+			}
+			if !sort.Float64sAreSorted(w) {
+				t.Errorf("n=%d uplo=%c: eigenvalues not in ascending order: %v", n, uplo, w)
+			}
+			vw := cloneCGeneral(v)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					vw.Data[i*vw.Stride+j] *= complex(w[j], 0)
+				}
+			}
+			if d := cmaxAbsDiff(naiveCMul(a, v), vw); d > ztol*float64(n) {
+				t.Errorf("n=%d uplo=%c: unexpected A * V - V * Λ, difference %v", n, uplo, d)
+			}
+		}
+	}
+}
+
+func TestZheevr(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 5, 10} {
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			m := randomCGeneral(rnd, n, n, n)
+			a := naiveCMul(conjTrans(m), m)
+
+			// The eigenvalues with indices il through iu of the full
+			// spectrum computed by Zheev.
+			all := cloneCGeneral(a)
+			want := make([]float64, n)
+			work := make([]complex128, max(1, 2*n-1))
+			impl.Zheev(lapack.EVNone, uplo, n, all.Data, all.Stride, want, work, len(work), make([]float64, max(1, 3*n-2)))
+			il, iu := n/3, n-1
+
+			k := iu - il + 1
+			c := cloneCGeneral(a)
+			w := make([]float64, n)
+			z := newCGeneral(n, k)
+			isuppz := make([]int, 2*k)
+			rwork := make([]float64, 1)
+			iwork := make([]int, 1)
+			work = make([]complex128, 1)
+			impl.Zheevr(lapack.EVCompute, EVIndex, uplo, n, c.Data, c.Stride, 0, 0, il, iu, 0, w, z.Data, z.Stride, isuppz, work, -1, rwork, -1, iwork, -1)
+			work = make([]complex128, int(real(work[0])))
+			rwork = make([]float64, int(rwork[0]))
+			iwork = make([]int, iwork[0])
+			found, ok := impl.Zheevr(lapack.EVCompute, EVIndex, uplo, n, c.Data, c.Stride, 0, 0, il, iu, 0, w, z.Data, z.Stride, isuppz, work, len(work), rwork, len(rwork), iwork, len(iwork))
+			if !ok {
+				t.Fatalf("n=%d uplo=%c: Zheevr did not converge", n, uplo)
+			}
+			if found != k {
+				t.Fatalf("n=%d uplo=%c: unexpected number of eigenvalues: got %d, want %d", n, uplo, found, k)
+			}
+			for i := 0; i < k; i++ {
+				if d := w[i] - want[il+i]; d > ztol*want[n-1] || -d > ztol*want[n-1] {
+					t.Errorf("n=%d uplo=%c: unexpected eigenvalue %d: got %v, want %v", n, uplo, il+i, w[i], want[il+i])
+				}
+			}
+			zw := cloneCGeneral(z)
+			for i := 0; i < n; i++ {
+				for j := 0; j < k; j++ {
+					zw.Data[i*zw.Stride+j] *= complex(w[j], 0)
+				}
+			}
+			if d := cmaxAbsDiff(naiveCMul(a, z), zw); d > ztol*float64(n)*want[n-1] {
+				t.Errorf("n=%d uplo=%c: unexpected A * Z - Z * Λ, difference %v", n, uplo, d)
+			}
+		}
+	}
+}
+
+func TestZlange(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, m := range []int{1, 4, 9} {
+		for _, n := range []int{1, 3, 10} {
+			a := randomCGeneral(rnd, m, n, n+1)
+			var maxAbs, fro float64
+			colSum := make([]float64, n)
+			rowSum := make([]float64, m)
+			for i := 0; i < m; i++ {
+				for j := 0; j < n; j++ {
+					v := cmplx.Abs(a.Data[i*a.Stride+j])
+					maxAbs = math.Max(maxAbs, v)
+					fro += v * v
+					colSum[j] += v
+					rowSum[i] += v
+				}
+			}
+			for _, test := range []struct {
+				norm lapack.MatrixNorm
+				want float64
+			}{
+				{lapack.MaxAbs, maxAbs},
+				{lapack.MaxColumnSum, floats.Max(colSum)},
+				{lapack.MaxRowSum, floats.Max(rowSum)},
+				{lapack.Frobenius, math.Sqrt(fro)},
+			} {
+				got := impl.Zlange(test.norm, m, n, a.Data, a.Stride, make([]float64, max(m, n)))
+				if math.Abs(got-test.want) > ztol*test.want {
+					t.Errorf("m=%d,n=%d,norm=%c: unexpected Zlange: got %v want %v", m, n, test.norm, got, test.want)
+				}
+			}
+
+			b := newCGeneral(m, n)
+			impl.Zlacpy(blas.All, m, n, a.Data, a.Stride, b.Data, b.Stride)
+			impl.Zlascl(lapack.General, 0, 0, 4, 1, m, n, b.Data, b.Stride)
+			impl.Zlaset(blas.Upper, m, n, 1i, 2, a.Data, a.Stride)
+			for i := 0; i < m; i++ {
+				for j := 0; j < n; j++ {
+					if got, want := b.Data[i*b.Stride+j]*4, a.Data[i*a.Stride+j]; j < i && got != want {
+						t.Fatalf("m=%d,n=%d: unexpected element (%d,%d) after Zlacpy and Zlascl: got %v want %v", m, n, i, j, got, want)
+					}
+					var want complex128
+					switch {
+					case i == j:
+						want = 2
+					case i < j:
+						want = 1i
+					default:
+						continue
+					}
+					if got := a.Data[i*a.Stride+j]; got != want {
+						t.Fatalf("m=%d,n=%d: unexpected element (%d,%d) after Zlaset: got %v want %v", m, n, i, j, got, want)
+					}
+				}
+			}
+		}
+	}
+
+	// The norms of a Hermitian matrix do not depend on the stored triangle.
+	const n = 6
+	h := randomHPD(rnd, n)
+	for _, norm := range []lapack.MatrixNorm{lapack.MaxAbs, lapack.MaxColumnSum, lapack.Frobenius} {
+		want := impl.Zlange(norm, n, n, h.Data, h.Stride, make([]float64, n))
+		for _, uplo := range []blas.Uplo{blas.Upper, blas.Lower} {
+			got := impl.Zlanhe(norm, uplo, n, h.Data, h.Stride, make([]float64, n))
+			if math.Abs(got-want) > ztol*want {
+				t.Errorf("norm=%c,uplo=%c: unexpected Zlanhe: got %v want %v", norm, uplo, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,513 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package testmat provides generators for test matrices with controlled
+// properties, mirroring the DLATMS family of routines in the LAPACK test
+// suite.
+//
+// The generators follow the algorithms of their LAPACK counterparts and
+// produce matrices with the requested singular values or eigenvalues,
+// condition number and bandwidth. Random numbers are drawn from the provided
+// source, so a given seed always produces the same matrix, but the random
+// streams differ from those of the LAPACK test suite.
+//
+// All matrices are stored in row-major order.
+package testmat // import "gonum.org/v1/netlib/lapack/testmat"
+
+import (
+	"math"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/blas"
+
+	"gonum.org/v1/netlib/blas/netlib"
+)
+
+var impl netlib.Implementation
+
+const (
+	badMode  = "testmat: invalid mode"
+	badDist  = "testmat: invalid dist"
+	badSym   = "testmat: invalid sym"
+	badCond  = "testmat: cond < 1"
+	badKL    = "testmat: invalid value of kl"
+	badKU    = "testmat: invalid value of ku"
+	badK     = "testmat: invalid value of k"
+	badLdA   = "testmat: bad leading dimension of A"
+	badLenD  = "testmat: bad length of d"
+	mLT0     = "testmat: m < 0"
+	nLT0     = "testmat: n < 0"
+	shortA   = "testmat: insufficient length of a"
+	shortWrk = "testmat: insufficient work length"
+)
+
+// Dlatm1 computes the entries of dst as specified by mode, cond and rsign.
+//
+// mode describes how dst will be computed:
+//  |mode| == 1: dst[0] = 1 and dst[1:n] = 1/cond
+//  |mode| == 2: dst[:n-1] = 1 and dst[n-1] = 1/cond
+//  |mode| == 3: dst[i] = cond^{-i/(n-1)}, i=0,...,n-1
+//  |mode| == 4: dst[i] = 1 - i*(1-1/cond)/(n-1)
+//  |mode| == 5: dst[i] = random number in the range (1/cond, 1) such that
+//                    their logarithms are uniformly distributed
+//  |mode| == 6: dst[i] = random number from the distribution given by dist
+// If mode is negative, the order of the elements of dst will be reversed.
+// For other values of mode Dlatm1 will panic.
+//
+// If rsign is true and mode is not ±6, each entry of dst will be multiplied by 1
+// or -1 with probability 0.5.
+//
+// dist specifies the type of distribution to be used when mode == ±6:
+//  dist == 1: Uniform[0,1)
+//  dist == 2: Uniform[-1,1)
+//  dist == 3: Normal(0,1)
+// For other values of dist Dlatm1 will panic.
+func Dlatm1(dst []float64, mode int, cond float64, rsign bool, dist int, rnd *rand.Rand) {
+	amode := mode
+	if amode < 0 {
+		amode = -amode
+	}
+	switch {
+	case amode < 1 || 6 < amode:
+		panic(badMode)
+	case cond < 1:
+		panic(badCond)
+	case amode == 6 && (dist < 1 || 3 < dist):
+		panic(badDist)
+	}
+
+	n := len(dst)
+	if n == 0 {
+		return
+	}
+
+	switch amode {
+	case 1:
+		dst[0] = 1
+		for i := 1; i < n; i++ {
+			dst[i] = 1 / cond
+		}
+	case 2:
+		for i := 0; i < n-1; i++ {
+			dst[i] = 1
+		}
+		dst[n-1] = 1 / cond
+	case 3:
+		dst[0] = 1
+		if n > 1 {
+			alpha := math.Pow(cond, -1/float64(n-1))
+			for i := 1; i < n; i++ {
+				dst[i] = math.Pow(alpha, float64(i))
+			}
+		}
+	case 4:
+		dst[0] = 1
+		if n > 1 {
+			condInv := 1 / cond
+			alpha := (1 - condInv) / float64(n-1)
+			for i := 1; i < n; i++ {
+				dst[i] = float64(n-i-1)*alpha + condInv
+			}
+		}
+	case 5:
+		alpha := math.Log(1 / cond)
+		for i := range dst {
+			dst[i] = math.Exp(alpha * rnd.Float64())
+		}
+	case 6:
+		dlarnv(dst, dist, rnd)
+	}
+
+	if rsign && amode != 6 {
+		for i, v := range dst {
+			if rnd.Float64() < 0.5 {
+				dst[i] = -v
+			}
+		}
+	}
+
+	if mode < 0 {
+		for i := 0; i < n/2; i++ {
+			dst[i], dst[n-i-1] = dst[n-i-1], dst[i]
+		}
+	}
+}
+
+// Dlagge generates a real general m×n matrix A, by pre- and post-multiplying
+// a real diagonal matrix D with random orthogonal matrices:
+//  A = U*D*V.
+// The lower and upper bandwidths are then reduced to kl and ku by additional
+// orthogonal transformations, so the singular values of A are the absolute
+// values of the elements of d.
+//
+// kl and ku must satisfy
+//  0 <= kl <= m-1,
+//  0 <= ku <= n-1,
+// d must have length min(m,n), and work must have length at least m+n,
+// otherwise Dlagge will panic.
+func Dlagge(m, n, kl, ku int, d []float64, a []float64, lda int, rnd *rand.Rand, work []float64) {
+	checkMatrix(m, n, a, lda)
+	switch {
+	case kl < 0 || max(0, m-1) < kl:
+		panic(badKL)
+	case ku < 0 || max(0, n-1) < ku:
+		panic(badKU)
+	case len(d) != min(m, n):
+		panic(badLenD)
+	case len(work) < m+n:
+		panic(shortWrk)
+	}
+
+	// Initialize A to diagonal matrix.
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			a[i*lda+j] = 0
+		}
+	}
+	for i := 0; i < min(m, n); i++ {
+		a[i*lda+i] = d[i]
+	}
+
+	// Quick exit if the user wants a diagonal matrix.
+	if kl == 0 && ku == 0 {
+		return
+	}
+
+	// Pre- and post-multiply A by random orthogonal matrices.
+	for i := min(m, n) - 1; i >= 0; i-- {
+		if i < m-1 {
+			tau := randomReflector(work[:m-i], rnd)
+
+			// Multiply A[i:m,i:n] by random reflection from the left.
+			impl.Dgemv(blas.Trans, m-i, n-i,
+				1, a[i*lda+i:], lda, work[:m-i], 1,
+				0, work[m:m+n-i], 1)
+			impl.Dger(m-i, n-i,
+				-tau, work[:m-i], 1, work[m:m+n-i], 1,
+				a[i*lda+i:], lda)
+		}
+		if i < n-1 {
+			tau := randomReflector(work[:n-i], rnd)
+
+			// Multiply A[i:m,i:n] by random reflection from the right.
+			impl.Dgemv(blas.NoTrans, m-i, n-i,
+				1, a[i*lda+i:], lda, work[:n-i], 1,
+				0, work[n:n+m-i], 1)
+			impl.Dger(m-i, n-i,
+				-tau, work[n:n+m-i], 1, work[:n-i], 1,
+				a[i*lda+i:], lda)
+		}
+	}
+
+	// Reduce number of subdiagonals to kl and number of superdiagonals to ku.
+	for i := 0; i < max(m-1-kl, n-1-ku); i++ {
+		if kl <= ku {
+			// Annihilate subdiagonal elements first (necessary if kl == 0).
+			if i < min(m-1-kl, n) {
+				annihilateColumn(m, n, kl, i, a, lda, work)
+			}
+			if i < min(n-1-ku, m) {
+				annihilateRow(m, n, ku, i, a, lda, work)
+			}
+		} else {
+			// Annihilate superdiagonal elements first (necessary if ku == 0).
+			if i < min(n-1-ku, m) {
+				annihilateRow(m, n, ku, i, a, lda, work)
+			}
+			if i < min(m-1-kl, n) {
+				annihilateColumn(m, n, kl, i, a, lda, work)
+			}
+		}
+		if i < n {
+			for j := kl + i + 1; j < m; j++ {
+				a[j*lda+i] = 0
+			}
+		}
+		if i < m {
+			for j := ku + i + 1; j < n; j++ {
+				a[i*lda+j] = 0
+			}
+		}
+	}
+}
+
+// annihilateColumn applies a reflection from the left to annihilate
+// A[kl+i+1:m,i].
+func annihilateColumn(m, n, kl, i int, a []float64, lda int, work []float64) {
+	r := kl + i
+	tau, wa := reflector(m-r, a[r*lda+i:], lda)
+	// Apply reflection to A[kl+i:m,i+1:n] from the left.
+	if n-i-1 > 0 {
+		impl.Dgemv(blas.Trans, m-r, n-i-1,
+			1, a[r*lda+i+1:], lda, a[r*lda+i:], lda,
+			0, work, 1)
+		impl.Dger(m-r, n-i-1,
+			-tau, a[r*lda+i:], lda, work, 1,
+			a[r*lda+i+1:], lda)
+	}
+	a[r*lda+i] = -wa
+}
+
+// annihilateRow applies a reflection from the right to annihilate
+// A[i,ku+i+1:n].
+func annihilateRow(m, n, ku, i int, a []float64, lda int, work []float64) {
+	c := ku + i
+	tau, wa := reflector(n-c, a[i*lda+c:], 1)
+	// Apply reflection to A[i+1:m,ku+i:n] from the right.
+	if m-i-1 > 0 {
+		impl.Dgemv(blas.NoTrans, m-i-1, n-c,
+			1, a[(i+1)*lda+c:], lda, a[i*lda+c:], 1,
+			0, work, 1)
+		impl.Dger(m-i-1, n-c,
+			-tau, work, 1, a[i*lda+c:], 1,
+			a[(i+1)*lda+c:], lda)
+	}
+	a[i*lda+c] = -wa
+}
+
+// Dlagsy generates an n×n symmetric matrix A, by pre- and post- multiplying a
+// real diagonal matrix D with a random orthogonal matrix:
+//  A = U * D * U^T.
+// The number of nonzero subdiagonals and superdiagonals is then reduced to k
+// by additional orthogonal similarity transformations, so the eigenvalues of
+// A are the elements of d.
+//
+// k must satisfy 0 <= k <= n-1, d must have length n, and work must have
+// length at least 2*n, otherwise Dlagsy will panic.
+func Dlagsy(n, k int, d []float64, a []float64, lda int, rnd *rand.Rand, work []float64) {
+	checkMatrix(n, n, a, lda)
+	switch {
+	case k < 0 || max(0, n-1) < k:
+		panic(badK)
+	case len(d) != n:
+		panic(badLenD)
+	case len(work) < 2*n:
+		panic(shortWrk)
+	}
+
+	// Initialize A to diagonal matrix.
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			a[i*lda+j] = 0
+		}
+		a[i*lda+i] = d[i]
+	}
+
+	// Quick exit if the user wants a diagonal matrix.
+	if k == 0 {
+		return
+	}
+
+	// Generate lower triangle of symmetric matrix.
+	for i := n - 2; i >= 0; i-- {
+		tau := randomReflector(work[:n-i], rnd)
+
+		// Apply random reflection to A[i:n,i:n] from the left and the
+		// right.
+		symmetricUpdate(n-i, tau, a[i*lda+i:], lda, work[:n-i], 1, work[n:2*n-i])
+	}
+
+	// Reduce number of subdiagonals to k.
+	for i := 0; i < n-1-k; i++ {
+		r := k + i
+		// Generate reflection to annihilate A[k+i+1:n,i].
+		tau, wa := reflector(n-r, a[r*lda+i:], lda)
+
+		// Apply reflection to A[k+i:n,i+1:k+i] from the left.
+		if k > 1 {
+			impl.Dgemv(blas.Trans, n-r, k-1,
+				1, a[r*lda+i+1:], lda, a[r*lda+i:], lda,
+				0, work, 1)
+			impl.Dger(n-r, k-1,
+				-tau, a[r*lda+i:], lda, work, 1,
+				a[r*lda+i+1:], lda)
+		}
+
+		// Apply reflection to A[k+i:n,k+i:n] from the left and the right.
+		symmetricUpdate(n-r, tau, a[r*lda+r:], lda, a[r*lda+i:], lda, work)
+
+		a[r*lda+i] = -wa
+		for j := r + 1; j < n; j++ {
+			a[j*lda+i] = 0
+		}
+	}
+
+	// Store full symmetric matrix.
+	for i := 1; i < n; i++ {
+		for j := 0; j < i; j++ {
+			a[j*lda+i] = a[i*lda+j]
+		}
+	}
+}
+
+// symmetricUpdate computes
+//  A = H * A * H,
+// where H = I - tau * u * u^T and A is the n×n symmetric matrix stored in the
+// lower triangle of a. work must have length at least n.
+func symmetricUpdate(n int, tau float64, a []float64, lda int, u []float64, incU int, work []float64) {
+	// Compute y := tau * A * u.
+	impl.Dsymv(blas.Lower, n, tau, a, lda, u, incU, 0, work, 1)
+
+	// Compute v := y - 1/2 * tau * ( y, u ) * u.
+	alpha := -0.5 * tau * impl.Ddot(n, work, 1, u, incU)
+	impl.Daxpy(n, alpha, u, incU, work, 1)
+
+	// Apply the transformation as a rank-2 update to A.
+	impl.Dsyr2(blas.Lower, n, -1, u, incU, work, 1, a, lda)
+}
+
+// Dlatms generates an m×n matrix A with specified singular values (if sym is
+// 'N') or eigenvalues (if sym is 'S' or 'P'), lower bandwidth kl and upper
+// bandwidth ku.
+//
+// If mode is not zero, the diagonal d is computed by Dlatm1 with the given
+// mode, cond and dist, and scaled so that its largest absolute value is dmax.
+// For sym equal to 'S' the elements of d are given random signs, otherwise they
+// are nonnegative. If mode is zero, d is used as given and cond and dmax are
+// not referenced.
+//
+// sym specifies the structure of A:
+//  sym == 'N': A is a general matrix with singular values |d[i]|,
+//  sym == 'S': A is symmetric with eigenvalues d[i],
+//  sym == 'P': A is symmetric positive semidefinite with eigenvalues d[i].
+// For symmetric matrices m must equal n and kl must equal ku.
+//
+// d must have length min(m,n). On return d holds the diagonal used to
+// generate A.
+func Dlatms(m, n int, dist int, sym byte, d []float64, mode int, cond, dmax float64, kl, ku int, a []float64, lda int, rnd *rand.Rand) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case sym != 'N' && sym != 'S' && sym != 'P':
+		panic(badSym)
+	case sym != 'N' && m != n:
+		panic(badSym)
+	case sym != 'N' && kl != ku:
+		panic(badKU)
+	case len(d) != min(m, n):
+		panic(badLenD)
+	}
+	checkMatrix(m, n, a, lda)
+
+	if mode != 0 {
+		Dlatm1(d, mode, cond, sym == 'S', dist, rnd)
+		if sym != 'S' {
+			for i, v := range d {
+				d[i] = math.Abs(v)
+			}
+		}
+		var dmaxAbs float64
+		for _, v := range d {
+			dmaxAbs = math.Max(dmaxAbs, math.Abs(v))
+		}
+		if dmaxAbs != 0 {
+			impl.Dscal(len(d), dmax/dmaxAbs, d, 1)
+		}
+	}
+	if sym == 'P' {
+		for _, v := range d {
+			if v < 0 {
+				panic("testmat: negative eigenvalue for positive semidefinite matrix")
+			}
+		}
+	}
+
+	work := make([]float64, m+n)
+	if sym == 'N' {
+		Dlagge(m, n, min(kl, max(0, m-1)), min(ku, max(0, n-1)), d, a, lda, rnd, work)
+		return
+	}
+	Dlagsy(n, min(kl, max(0, n-1)), d, a, lda, rnd, work)
+}
+
+// randomReflector generates a random Householder reflection
+//  H = I - tau * v * v^T
+// with v[0] = 1 and stores v into v.
+func randomReflector(v []float64, rnd *rand.Rand) (tau float64) {
+	for j := range v {
+		v[j] = rnd.NormFloat64()
+	}
+	n := len(v)
+	wn := impl.Dnrm2(n, v, 1)
+	if wn == 0 {
+		return 0
+	}
+	wa := math.Copysign(wn, v[0])
+	wb := v[0] + wa
+	impl.Dscal(n-1, 1/wb, v[1:], 1)
+	v[0] = 1
+	return wb / wa
+}
+
+// reflector overwrites the n-vector x with the Householder vector v
+// annihilating x[1:n] with v[0] = 1, and returns tau and wa such that
+//  (I - tau * v * v^T) * x = -wa * e_0.
+func reflector(n int, x []float64, incX int) (tau, wa float64) {
+	wn := impl.Dnrm2(n, x, incX)
+	wa = math.Copysign(wn, x[0])
+	if wn == 0 {
+		return 0, wa
+	}
+	wb := x[0] + wa
+	if n > 1 {
+		impl.Dscal(n-1, 1/wb, x[incX:], incX)
+	}
+	x[0] = 1
+	return wb / wa, wa
+}
+
+// dlarnv fills dst with random numbers from a uniform or normal distribution
+// specified by dist:
+//  dist=1: uniform(0,1),
+//  dist=2: uniform(-1,1),
+//  dist=3: normal(0,1).
+// For other values of dist dlarnv will panic.
+func dlarnv(dst []float64, dist int, rnd *rand.Rand) {
+	switch dist {
+	default:
+		panic(badDist)
+	case 1:
+		for i := range dst {
+			dst[i] = rnd.Float64()
+		}
+	case 2:
+		for i := range dst {
+			dst[i] = 2*rnd.Float64() - 1
+		}
+	case 3:
+		for i := range dst {
+			dst[i] = rnd.NormFloat64()
+		}
+	}
+}
+
+func checkMatrix(m, n int, a []float64, lda int) {
+	switch {
+	case m < 0:
+		panic(mLT0)
+	case n < 0:
+		panic(nLT0)
+	case lda < max(1, n):
+		panic(badLdA)
+	case m > 0 && n > 0 && len(a) < (m-1)*lda+n:
+		panic(shortA)
+	}
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
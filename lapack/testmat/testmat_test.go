@@ -0,0 +1,172 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package testmat
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+)
+
+func TestDlatm1(t *testing.T) {
+	const tol = 1e-14
+	rnd := rand.New(rand.NewSource(1))
+	const n, cond = 5, 100.0
+	for _, test := range []struct {
+		mode int
+		want []float64
+	}{
+		{mode: 1, want: []float64{1, 0.01, 0.01, 0.01, 0.01}},
+		{mode: 2, want: []float64{1, 1, 1, 1, 0.01}},
+		{mode: 3, want: []float64{1, math.Pow(10, -0.5), 0.1, math.Pow(10, -1.5), 0.01}},
+		{mode: 4, want: []float64{1, 0.7525, 0.505, 0.2575, 0.01}},
+		{mode: -2, want: []float64{0.01, 1, 1, 1, 1}},
+	} {
+		d := make([]float64, n)
+		Dlatm1(d, test.mode, cond, false, 1, rnd)
+		if !floats.EqualApprox(d, test.want, tol) {
+			t.Errorf("mode=%d: unexpected result: got %v want %v", test.mode, d, test.want)
+		}
+	}
+
+	d := make([]float64, 100)
+	Dlatm1(d, 5, cond, false, 1, rnd)
+	for i, v := range d {
+		if v < 1/cond || 1 < v {
+			t.Errorf("mode=5: d[%d]=%v out of range", i, v)
+		}
+	}
+}
+
+func TestDlatmsGeneral(t *testing.T) {
+	const tol = 1e-12
+	for _, test := range []struct {
+		m, n, kl, ku, lda int
+	}{
+		{m: 1, n: 1, kl: 0, ku: 0, lda: 1},
+		{m: 6, n: 6, kl: 0, ku: 0, lda: 6},
+		{m: 6, n: 6, kl: 5, ku: 5, lda: 8},
+		{m: 8, n: 8, kl: 1, ku: 2, lda: 8},
+		{m: 8, n: 8, kl: 0, ku: 1, lda: 8},
+		{m: 8, n: 8, kl: 3, ku: 0, lda: 9},
+		{m: 10, n: 6, kl: 2, ku: 1, lda: 6},
+		{m: 6, n: 10, kl: 1, ku: 3, lda: 12},
+		{m: 20, n: 15, kl: 19, ku: 14, lda: 15},
+	} {
+		for _, mode := range []int{1, 2, 3, 4, 5, -3} {
+			name := fmt.Sprintf("m=%d,n=%d,kl=%d,ku=%d,mode=%d", test.m, test.n, test.kl, test.ku, mode)
+			const cond, dmax = 1e4, 3.0
+			a := make([]float64, (test.m-1)*test.lda+test.n)
+			d := make([]float64, min(test.m, test.n))
+			Dlatms(test.m, test.n, 1, 'N', d, mode, cond, dmax, test.kl, test.ku, a, test.lda, rand.New(rand.NewSource(1)))
+
+			checkBand(t, name, test.m, test.n, test.kl, test.ku, a, test.lda)
+
+			var svd mat.SVD
+			if !svd.Factorize(dense(test.m, test.n, a, test.lda), mat.SVDNone) {
+				t.Fatalf("%s: SVD failed", name)
+			}
+			got := svd.Values(nil)
+			want := make([]float64, len(d))
+			for i, v := range d {
+				want[i] = math.Abs(v)
+			}
+			sort.Sort(sort.Reverse(sort.Float64Slice(want)))
+			if !floats.EqualApprox(got, want, tol) {
+				t.Errorf("%s: unexpected singular values: got %v want %v", name, got, want)
+			}
+			if len(want) > 0 && math.Abs(want[0]-dmax) > tol {
+				t.Errorf("%s: unexpected largest singular value: got %v want %v", name, want[0], dmax)
+			}
+			if len(want) > 1 && mode > 0 && mode != 5 && math.Abs(want[0]/want[len(want)-1]-cond) > tol*cond {
+				t.Errorf("%s: unexpected condition number: got %v want %v", name, want[0]/want[len(want)-1], cond)
+			}
+		}
+	}
+}
+
+func TestDlatmsSymmetric(t *testing.T) {
+	const tol = 1e-12
+	for _, test := range []struct {
+		n, k, lda int
+	}{
+		{n: 1, k: 0, lda: 1},
+		{n: 6, k: 0, lda: 6},
+		{n: 6, k: 1, lda: 6},
+		{n: 10, k: 3, lda: 11},
+		{n: 10, k: 9, lda: 10},
+	} {
+		for _, sym := range []byte{'S', 'P'} {
+			name := fmt.Sprintf("n=%d,k=%d,sym=%c", test.n, test.k, sym)
+			a := make([]float64, (test.n-1)*test.lda+test.n)
+			d := make([]float64, test.n)
+			Dlatms(test.n, test.n, 1, sym, d, 3, 100, 1, test.k, test.k, a, test.lda, rand.New(rand.NewSource(1)))
+
+			checkBand(t, name, test.n, test.n, test.k, test.k, a, test.lda)
+			for i := 0; i < test.n; i++ {
+				for j := 0; j < i; j++ {
+					if a[i*test.lda+j] != a[j*test.lda+i] {
+						t.Fatalf("%s: matrix not symmetric", name)
+					}
+				}
+			}
+
+			var eig mat.EigenSym
+			if !eig.Factorize(mat.NewSymDense(test.n, dense(test.n, test.n, a, test.lda).RawMatrix().Data), false) {
+				t.Fatalf("%s: eigendecomposition failed", name)
+			}
+			got := eig.Values(nil)
+			want := make([]float64, len(d))
+			copy(want, d)
+			sort.Float64s(want)
+			if !floats.EqualApprox(got, want, tol) {
+				t.Errorf("%s: unexpected eigenvalues: got %v want %v", name, got, want)
+			}
+			if sym == 'P' && want[0] < 0 {
+				t.Errorf("%s: negative eigenvalue", name)
+			}
+		}
+	}
+}
+
+func TestDlatmsDeterministic(t *testing.T) {
+	const m, n, lda = 7, 5, 5
+	a1 := make([]float64, m*lda)
+	a2 := make([]float64, m*lda)
+	d := make([]float64, n)
+	Dlatms(m, n, 1, 'N', d, 5, 10, 1, 2, 2, a1, lda, rand.New(rand.NewSource(42)))
+	Dlatms(m, n, 1, 'N', d, 5, 10, 1, 2, 2, a2, lda, rand.New(rand.NewSource(42)))
+	if !floats.Same(a1, a2) {
+		t.Error("matrices generated from the same seed differ")
+	}
+}
+
+func checkBand(t *testing.T, name string, m, n, kl, ku int, a []float64, lda int) {
+	t.Helper()
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			if (j < i-kl || i+ku < j) && a[i*lda+j] != 0 {
+				t.Errorf("%s: nonzero element (%d,%d) outside band", name, i, j)
+				return
+			}
+		}
+	}
+}
+
+func dense(m, n int, a []float64, lda int) *mat.Dense {
+	d := mat.NewDense(m, n, nil)
+	for i := 0; i < m; i++ {
+		for j := 0; j < n; j++ {
+			d.Set(i, j, a[i*lda+j])
+		}
+	}
+	return d
+}
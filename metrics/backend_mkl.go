@@ -0,0 +1,49 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build mkl
+// +build mkl
+
+package metrics
+
+/*
+#cgo CFLAGS: -g -O2
+
+// Declarations from mkl_service.h.
+long long mkl_mem_stat(int *buffers);
+long long mkl_peak_mem_usage(int mode);
+void mkl_free_buffers(void);
+
+enum {
+	MKL_PEAK_MEM_DISABLE = 0,
+	MKL_PEAK_MEM_ENABLE = 1,
+	MKL_PEAK_MEM_RESET = -1,
+	MKL_PEAK_MEM = 2,
+};
+*/
+import "C"
+
+func backendMemory() (bytes int64, buffers int, peak int64, ok bool) {
+	var n C.int
+	bytes = int64(C.mkl_mem_stat(&n))
+	peak = int64(C.mkl_peak_mem_usage(C.MKL_PEAK_MEM))
+	return bytes, int(n), peak, true
+}
+
+func backendTrackPeak(enable bool) bool {
+	mode := C.int(C.MKL_PEAK_MEM_DISABLE)
+	if enable {
+		mode = C.MKL_PEAK_MEM_ENABLE
+	}
+	return C.mkl_peak_mem_usage(mode) != -1
+}
+
+func backendResetPeak() bool {
+	return C.mkl_peak_mem_usage(C.MKL_PEAK_MEM_RESET) != -1
+}
+
+func backendFreeBuffers() bool {
+	C.mkl_free_buffers()
+	return true
+}
@@ -0,0 +1,16 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !mkl
+// +build !mkl
+
+package metrics
+
+func backendMemory() (bytes int64, buffers int, peak int64, ok bool) { return 0, 0, -1, false }
+
+func backendTrackPeak(enable bool) bool { return false }
+
+func backendResetPeak() bool { return false }
+
+func backendFreeBuffers() bool { return false }
@@ -0,0 +1,90 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package metrics reports the memory held by the netlib packages and, where
+// the backend library supports it, by the backend's own memory manager, so
+// that the memory requirements of a process can be measured and bounded.
+//
+// Backend figures are available when the packages are linked against Intel
+// MKL and built with the mkl build tag. OpenBLAS and the reference
+// implementations do not expose their internal buffers, so for them only the
+// memory held by the Go wrappers is reported.
+//
+// The counters of the process-wide admission controller, which bounds the
+// number and rate of calls into the backend, are reported by ReadAdmission.
+package metrics // import "gonum.org/v1/netlib/metrics"
+
+import (
+	"gonum.org/v1/netlib/admission"
+	"gonum.org/v1/netlib/internal/buffer"
+)
+
+// Memory is a snapshot of memory usage.
+type Memory struct {
+	// BufferLiveBytes and BufferPeakBytes are the current and the largest
+	// number of bytes held by scratch buffers of the Go wrappers that are
+	// in use, and BufferFreeBytes is the number of bytes held by scratch
+	// buffers kept for reuse.
+	BufferLiveBytes, BufferPeakBytes, BufferFreeBytes int64
+
+	// Backend reports whether the backend fields below are valid.
+	Backend bool
+	// BackendBytes is the number of bytes currently allocated by the
+	// backend memory manager in BackendBuffers buffers.
+	BackendBytes   int64
+	BackendBuffers int
+	// BackendPeakBytes is the largest number of bytes allocated by the
+	// backend since peak tracking was enabled or last reset, or -1 if
+	// peak tracking is not enabled.
+	BackendPeakBytes int64
+}
+
+// ReadMemory returns the current memory usage.
+func ReadMemory() Memory {
+	s := buffer.Default.Stats()
+	m := Memory{
+		BufferLiveBytes:  s.LiveBytes,
+		BufferPeakBytes:  s.PeakBytes,
+		BufferFreeBytes:  s.FreeBytes,
+		BackendPeakBytes: -1,
+	}
+	m.BackendBytes, m.BackendBuffers, m.BackendPeakBytes, m.Backend = backendMemory()
+	return m
+}
+
+// TrackBackendPeak enables or disables tracking of the peak memory usage of
+// the backend. Tracking may slow down the backend's memory manager. It
+// returns false if the backend does not support peak tracking.
+func TrackBackendPeak(enable bool) bool {
+	return backendTrackPeak(enable)
+}
+
+// ResetBackendPeak resets the peak memory usage of the backend to its
+// current usage. It returns false if the backend does not support peak
+// tracking.
+func ResetBackendPeak() bool {
+	return backendResetPeak()
+}
+
+// FreeBuffers releases the scratch buffers of the Go wrappers that are kept
+// for reuse and asks the backend to release its internal buffers. It is
+// intended to be called between phases of a computation to reduce the
+// memory footprint of a long-running process. Buffers that are in use are
+// not affected. FreeBuffers returns false if the backend does not support
+// releasing its buffers.
+func FreeBuffers() bool {
+	buffer.Default.Reset()
+	return backendFreeBuffers()
+}
+
+// ReadAdmission returns the counters of the process-wide admission
+// controller installed with admission.SetDefault, and whether one is
+// installed.
+func ReadAdmission() (admission.Stats, bool) {
+	c := admission.Default()
+	if c == nil {
+		return admission.Stats{}, false
+	}
+	return c.Stats(), true
+}
@@ -0,0 +1,68 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package metrics
+
+import (
+	"testing"
+
+	"gonum.org/v1/netlib/admission"
+	"gonum.org/v1/netlib/internal/buffer"
+)
+
+func TestReadMemory(t *testing.T) {
+	FreeBuffers()
+	before := ReadMemory()
+
+	s := buffer.NewScope()
+	s.Float64s(1000)
+	during := ReadMemory()
+	if got := during.BufferLiveBytes - before.BufferLiveBytes; got < 8000 {
+		t.Errorf("unexpected increase of live bytes: %d", got)
+	}
+	if during.BufferPeakBytes < during.BufferLiveBytes {
+		t.Errorf("peak below live bytes: %+v", during)
+	}
+
+	s.Release()
+	after := ReadMemory()
+	if after.BufferLiveBytes != before.BufferLiveBytes {
+		t.Errorf("unexpected live bytes after release: got %d want %d", after.BufferLiveBytes, before.BufferLiveBytes)
+	}
+	if after.BufferFreeBytes < 8000 {
+		t.Errorf("released buffer not kept for reuse: %+v", after)
+	}
+
+	FreeBuffers()
+	if m := ReadMemory(); m.BufferFreeBytes != 0 {
+		t.Errorf("unexpected free bytes after FreeBuffers: %d", m.BufferFreeBytes)
+	}
+	if m := ReadMemory(); !m.Backend && m.BackendPeakBytes != -1 {
+		t.Errorf("unexpected backend peak without backend support: %d", m.BackendPeakBytes)
+	}
+}
+
+func TestReadAdmission(t *testing.T) {
+	admission.SetDefault(nil)
+	if _, ok := ReadAdmission(); ok {
+		t.Error("unexpected admission controller")
+	}
+
+	c := admission.New(admission.Config{MaxConcurrent: 1})
+	admission.SetDefault(c)
+	defer admission.SetDefault(nil)
+	err := c.Do(10, func() {
+		s, ok := ReadAdmission()
+		if !ok || s.Running != 1 {
+			t.Errorf("unexpected counters during call: %+v, %t", s, ok)
+		}
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	s, ok := ReadAdmission()
+	if !ok || s.Running != 0 || s.Admitted != 1 || s.FLOPs != 10 {
+		t.Errorf("unexpected counters after call: %+v, %t", s, ok)
+	}
+}
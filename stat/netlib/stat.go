@@ -0,0 +1,254 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package netlib provides statistical routines for large data matrices built
+// on the cgo BLAS bindings in gonum.org/v1/netlib/blas/netlib.
+//
+// Data matrices are stored in row-major order with one observation per row
+// and one variable per column, so an r×c data matrix X with stride ldx holds
+// variable j of observation i at x[i*ldx+j].
+package netlib // import "gonum.org/v1/netlib/stat/netlib"
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+
+	blasnetlib "gonum.org/v1/netlib/blas/netlib"
+)
+
+var impl blasnetlib.Implementation
+
+// Method specifies the algorithm used to compute second moments.
+type Method int
+
+const (
+	// TwoPass centers the data in a copy before forming the cross
+	// products and applies a correction term for the rounding error in the
+	// means. It requires r*c additional storage and is numerically stable.
+	TwoPass Method = iota
+	// OnePass forms the cross products of the uncentered data and
+	// subtracts the outer product of the means. It does not copy the data
+	// but loses accuracy when the means are large relative to the spread
+	// of the data.
+	OnePass
+)
+
+const (
+	rLT0      = "stat: r < 0"
+	cLT0      = "stat: c < 0"
+	rLT2      = "stat: fewer than two observations"
+	badLdX    = "stat: bad leading dimension of X"
+	badLdDst  = "stat: bad leading dimension of dst"
+	badMethod = "stat: bad method"
+	shortX    = "stat: insufficient length of x"
+	shortDst  = "stat: insufficient length of dst"
+	shortMean = "stat: insufficient length of mean"
+	shortStd  = "stat: insufficient length of std"
+)
+
+func checkData(r, c int, x []float64, ldx int) {
+	switch {
+	case r < 0:
+		panic(rLT0)
+	case c < 0:
+		panic(cLT0)
+	case ldx < max(1, c):
+		panic(badLdX)
+	case r > 0 && c > 0 && len(x) < (r-1)*ldx+c:
+		panic(shortX)
+	}
+}
+
+func checkSquare(c int, dst []float64, ldd int) {
+	switch {
+	case ldd < max(1, c):
+		panic(badLdDst)
+	case c > 0 && len(dst) < (c-1)*ldd+c:
+		panic(shortDst)
+	}
+}
+
+// Means stores the column means of the r×c data matrix X into mean.
+// mean must have length at least c, otherwise Means will panic.
+func Means(r, c int, x []float64, ldx int, mean []float64) {
+	checkData(r, c, x, ldx)
+	if len(mean) < c {
+		panic(shortMean)
+	}
+	if c == 0 {
+		return
+	}
+	if r == 0 {
+		for j := range mean[:c] {
+			mean[j] = math.NaN()
+		}
+		return
+	}
+	ones := make([]float64, r)
+	for i := range ones {
+		ones[i] = 1
+	}
+	impl.Dgemv(blas.Trans, r, c, 1, x, ldx, ones, 1, 0, mean, 1)
+	for j := range mean[:c] {
+		mean[j] /= float64(r)
+	}
+}
+
+// Gram computes the c×c Gram matrix of the r×c matrix X,
+//  dst = X^T * X,
+// and stores both triangles into dst.
+func Gram(r, c int, x []float64, ldx int, dst []float64, ldd int) {
+	checkData(r, c, x, ldx)
+	checkSquare(c, dst, ldd)
+	if c == 0 {
+		return
+	}
+	impl.Dsyrk(blas.Upper, blas.Trans, c, r, 1, x, ldx, 0, dst, ldd)
+	symmetrize(c, dst, ldd)
+}
+
+// Covariance computes the c×c sample covariance matrix of the variables in
+// the r×c data matrix X using the given method and stores both triangles
+// into dst. The covariance is normalized by r-1. r must be at least 2,
+// otherwise Covariance will panic.
+func Covariance(method Method, r, c int, x []float64, ldx int, dst []float64, ldd int) {
+	if method != TwoPass && method != OnePass {
+		panic(badMethod)
+	}
+	checkData(r, c, x, ldx)
+	checkSquare(c, dst, ldd)
+	if r < 2 {
+		panic(rLT2)
+	}
+	if c == 0 {
+		return
+	}
+
+	mean := make([]float64, c)
+	Means(r, c, x, ldx, mean)
+	n := float64(r)
+	switch method {
+	case TwoPass:
+		xc := make([]float64, r*c)
+		for i := 0; i < r; i++ {
+			row := x[i*ldx : i*ldx+c]
+			for j, v := range row {
+				xc[i*c+j] = v - mean[j]
+			}
+		}
+		impl.Dsyrk(blas.Upper, blas.Trans, c, r, 1/(n-1), xc, c, 0, dst, ldd)
+
+		// Correct for the rounding error in the means using the sums of
+		// the deviations, which are zero in exact arithmetic.
+		ones := make([]float64, r)
+		for i := range ones {
+			ones[i] = 1
+		}
+		sum := make([]float64, c)
+		impl.Dgemv(blas.Trans, r, c, 1, xc, c, ones, 1, 0, sum, 1)
+		impl.Dsyr(blas.Upper, c, -1/(n*(n-1)), sum, 1, dst, ldd)
+	case OnePass:
+		impl.Dsyrk(blas.Upper, blas.Trans, c, r, 1/(n-1), x, ldx, 0, dst, ldd)
+		impl.Dsyr(blas.Upper, c, -n/(n-1), mean, 1, dst, ldd)
+	}
+	symmetrize(c, dst, ldd)
+}
+
+// Correlation computes the c×c sample correlation matrix of the variables in
+// the r×c data matrix X using the given method and stores both triangles
+// into dst. r must be at least 2, otherwise Correlation will panic.
+// Correlations involving a constant variable are NaN.
+func Correlation(method Method, r, c int, x []float64, ldx int, dst []float64, ldd int) {
+	Covariance(method, r, c, x, ldx, dst, ldd)
+	if c == 0 {
+		return
+	}
+	scale := make([]float64, c)
+	for j := range scale {
+		v := dst[j*ldd+j]
+		if v == 0 {
+			scale[j] = math.NaN()
+		} else {
+			scale[j] = 1 / math.Sqrt(v)
+		}
+	}
+	for i := 0; i < c; i++ {
+		row := dst[i*ldd : i*ldd+c]
+		for j := range row {
+			row[j] *= scale[i] * scale[j]
+		}
+		if !math.IsNaN(scale[i]) {
+			row[i] = 1
+		}
+	}
+}
+
+// Standardize transforms the r×c data matrix X in place to z-scores by
+// subtracting the column means and dividing by the sample standard
+// deviations. On return mean and std hold the means and standard deviations
+// that were used. Columns with zero standard deviation are centered but not
+// scaled.
+//
+// r must be at least 2, and mean and std must have length at least c,
+// otherwise Standardize will panic.
+func Standardize(r, c int, x []float64, ldx int, mean, std []float64) {
+	checkData(r, c, x, ldx)
+	switch {
+	case r < 2:
+		panic(rLT2)
+	case len(mean) < c:
+		panic(shortMean)
+	case len(std) < c:
+		panic(shortStd)
+	}
+	if c == 0 {
+		return
+	}
+
+	Means(r, c, x, ldx, mean)
+	for i := 0; i < r; i++ {
+		row := x[i*ldx : i*ldx+c]
+		for j := range row {
+			row[j] -= mean[j]
+		}
+	}
+	// Correct for the rounding error in the means. This also ensures that
+	// constant columns are centered exactly to zero.
+	corr := make([]float64, c)
+	Means(r, c, x, ldx, corr)
+	for i := 0; i < r; i++ {
+		row := x[i*ldx : i*ldx+c]
+		for j := range row {
+			row[j] -= corr[j]
+		}
+	}
+	for j, v := range corr {
+		mean[j] += v
+	}
+	norm := math.Sqrt(float64(r - 1))
+	for j := 0; j < c; j++ {
+		std[j] = impl.Dnrm2(r, x[j:], ldx) / norm
+		if std[j] != 0 {
+			impl.Dscal(r, 1/std[j], x[j:], ldx)
+		}
+	}
+}
+
+// symmetrize copies the upper triangle of the n×n matrix A into its lower
+// triangle.
+func symmetrize(n int, a []float64, lda int) {
+	for i := 1; i < n; i++ {
+		for j := 0; j < i; j++ {
+			a[i*lda+j] = a[j*lda+i]
+		}
+	}
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
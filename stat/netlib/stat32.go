@@ -0,0 +1,191 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+
+	"gonum.org/v1/gonum/blas"
+)
+
+func checkData32(r, c int, x []float32, ldx int) {
+	switch {
+	case r < 0:
+		panic(rLT0)
+	case c < 0:
+		panic(cLT0)
+	case ldx < max(1, c):
+		panic(badLdX)
+	case r > 0 && c > 0 && len(x) < (r-1)*ldx+c:
+		panic(shortX)
+	}
+}
+
+func checkSquare32(c int, dst []float32, ldd int) {
+	switch {
+	case ldd < max(1, c):
+		panic(badLdDst)
+	case c > 0 && len(dst) < (c-1)*ldd+c:
+		panic(shortDst)
+	}
+}
+
+// Means32 is the float32 version of Means.
+func Means32(r, c int, x []float32, ldx int, mean []float32) {
+	checkData32(r, c, x, ldx)
+	if len(mean) < c {
+		panic(shortMean)
+	}
+	if c == 0 {
+		return
+	}
+	if r == 0 {
+		for j := range mean[:c] {
+			mean[j] = float32(math.NaN())
+		}
+		return
+	}
+	ones := make([]float32, r)
+	for i := range ones {
+		ones[i] = 1
+	}
+	impl.Sgemv(blas.Trans, r, c, 1, x, ldx, ones, 1, 0, mean, 1)
+	for j := range mean[:c] {
+		mean[j] /= float32(r)
+	}
+}
+
+// Gram32 is the float32 version of Gram.
+func Gram32(r, c int, x []float32, ldx int, dst []float32, ldd int) {
+	checkData32(r, c, x, ldx)
+	checkSquare32(c, dst, ldd)
+	if c == 0 {
+		return
+	}
+	impl.Ssyrk(blas.Upper, blas.Trans, c, r, 1, x, ldx, 0, dst, ldd)
+	symmetrize32(c, dst, ldd)
+}
+
+// Covariance32 is the float32 version of Covariance.
+func Covariance32(method Method, r, c int, x []float32, ldx int, dst []float32, ldd int) {
+	if method != TwoPass && method != OnePass {
+		panic(badMethod)
+	}
+	checkData32(r, c, x, ldx)
+	checkSquare32(c, dst, ldd)
+	if r < 2 {
+		panic(rLT2)
+	}
+	if c == 0 {
+		return
+	}
+
+	mean := make([]float32, c)
+	Means32(r, c, x, ldx, mean)
+	n := float32(r)
+	switch method {
+	case TwoPass:
+		xc := make([]float32, r*c)
+		for i := 0; i < r; i++ {
+			row := x[i*ldx : i*ldx+c]
+			for j, v := range row {
+				xc[i*c+j] = v - mean[j]
+			}
+		}
+		impl.Ssyrk(blas.Upper, blas.Trans, c, r, 1/(n-1), xc, c, 0, dst, ldd)
+
+		// Correct for the rounding error in the means using the sums of
+		// the deviations, which are zero in exact arithmetic.
+		ones := make([]float32, r)
+		for i := range ones {
+			ones[i] = 1
+		}
+		sum := make([]float32, c)
+		impl.Sgemv(blas.Trans, r, c, 1, xc, c, ones, 1, 0, sum, 1)
+		impl.Ssyr(blas.Upper, c, -1/(n*(n-1)), sum, 1, dst, ldd)
+	case OnePass:
+		impl.Ssyrk(blas.Upper, blas.Trans, c, r, 1/(n-1), x, ldx, 0, dst, ldd)
+		impl.Ssyr(blas.Upper, c, -n/(n-1), mean, 1, dst, ldd)
+	}
+	symmetrize32(c, dst, ldd)
+}
+
+// Correlation32 is the float32 version of Correlation.
+func Correlation32(method Method, r, c int, x []float32, ldx int, dst []float32, ldd int) {
+	Covariance32(method, r, c, x, ldx, dst, ldd)
+	if c == 0 {
+		return
+	}
+	scale := make([]float32, c)
+	for j := range scale {
+		v := dst[j*ldd+j]
+		if v == 0 {
+			scale[j] = float32(math.NaN())
+		} else {
+			scale[j] = 1 / float32(math.Sqrt(float64(v)))
+		}
+	}
+	for i := 0; i < c; i++ {
+		row := dst[i*ldd : i*ldd+c]
+		for j := range row {
+			row[j] *= scale[i] * scale[j]
+		}
+		if !math.IsNaN(float64(scale[i])) {
+			row[i] = 1
+		}
+	}
+}
+
+// Standardize32 is the float32 version of Standardize.
+func Standardize32(r, c int, x []float32, ldx int, mean, std []float32) {
+	checkData32(r, c, x, ldx)
+	switch {
+	case r < 2:
+		panic(rLT2)
+	case len(mean) < c:
+		panic(shortMean)
+	case len(std) < c:
+		panic(shortStd)
+	}
+	if c == 0 {
+		return
+	}
+
+	Means32(r, c, x, ldx, mean)
+	for i := 0; i < r; i++ {
+		row := x[i*ldx : i*ldx+c]
+		for j := range row {
+			row[j] -= mean[j]
+		}
+	}
+	// Correct for the rounding error in the means. This also ensures that
+	// constant columns are centered exactly to zero.
+	corr := make([]float32, c)
+	Means32(r, c, x, ldx, corr)
+	for i := 0; i < r; i++ {
+		row := x[i*ldx : i*ldx+c]
+		for j := range row {
+			row[j] -= corr[j]
+		}
+	}
+	for j, v := range corr {
+		mean[j] += v
+	}
+	norm := float32(math.Sqrt(float64(r - 1)))
+	for j := 0; j < c; j++ {
+		std[j] = impl.Snrm2(r, x[j:], ldx) / norm
+		if std[j] != 0 {
+			impl.Sscal(r, 1/std[j], x[j:], ldx)
+		}
+	}
+}
+
+func symmetrize32(n int, a []float32, lda int) {
+	for i := 1; i < n; i++ {
+		for j := 0; j < i; j++ {
+			a[i*lda+j] = a[j*lda+i]
+		}
+	}
+}
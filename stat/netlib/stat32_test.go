@@ -0,0 +1,69 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestFloat32(t *testing.T) {
+	const (
+		r, c, ldx = 40, 5, 7
+		tol       = 1e-4
+	)
+	rnd := rand.New(rand.NewSource(1))
+	x := randomData(rnd, r, c, ldx, 3)
+	x32 := make([]float32, len(x))
+	for i, v := range x {
+		// Round the data so that both precisions see the same values.
+		x32[i] = float32(v)
+		x[i] = float64(x32[i])
+	}
+
+	check := func(name string, got []float32, want []float64) {
+		for i, v := range want {
+			if math.IsNaN(v) && math.IsNaN(float64(got[i])) {
+				continue
+			}
+			if math.Abs(float64(got[i])-v) > tol*math.Max(1, math.Abs(v)) {
+				t.Errorf("%s: mismatch at %d: got %v want %v", name, i, got[i], v)
+				return
+			}
+		}
+	}
+
+	mean := make([]float64, c)
+	Means(r, c, x, ldx, mean)
+	mean32 := make([]float32, c)
+	Means32(r, c, x32, ldx, mean32)
+	check("Means32", mean32, mean)
+
+	dst := make([]float64, c*c)
+	dst32 := make([]float32, c*c)
+	Gram(r, c, x, ldx, dst, c)
+	Gram32(r, c, x32, ldx, dst32, c)
+	check("Gram32", dst32, dst)
+
+	for _, method := range []Method{TwoPass, OnePass} {
+		Covariance(method, r, c, x, ldx, dst, c)
+		Covariance32(method, r, c, x32, ldx, dst32, c)
+		check("Covariance32", dst32, dst)
+
+		Correlation(method, r, c, x, ldx, dst, c)
+		Correlation32(method, r, c, x32, ldx, dst32, c)
+		check("Correlation32", dst32, dst)
+	}
+
+	std := make([]float64, c)
+	std32 := make([]float32, c)
+	Standardize(r, c, x, ldx, mean, std)
+	Standardize32(r, c, x32, ldx, mean32, std32)
+	check("Standardize32 mean", mean32, mean)
+	check("Standardize32 std", std32, std)
+	check("Standardize32 data", x32, x)
+}
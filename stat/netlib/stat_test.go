@@ -0,0 +1,145 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+	"gonum.org/v1/gonum/mat"
+	"gonum.org/v1/gonum/stat"
+)
+
+func randomData(rnd *rand.Rand, r, c, ldx int, offset float64) []float64 {
+	x := make([]float64, (r-1)*ldx+c)
+	for i := range x {
+		x[i] = math.NaN()
+	}
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			x[i*ldx+j] = offset + float64(j+1)*rnd.NormFloat64()
+		}
+	}
+	return x
+}
+
+func denseOf(r, c int, x []float64, ldx int) *mat.Dense {
+	d := mat.NewDense(r, c, nil)
+	for i := 0; i < r; i++ {
+		for j := 0; j < c; j++ {
+			d.Set(i, j, x[i*ldx+j])
+		}
+	}
+	return d
+}
+
+func TestCovarianceCorrelation(t *testing.T) {
+	const tol = 1e-10
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		r, c, ldx int
+	}{
+		{r: 2, c: 1, ldx: 1},
+		{r: 10, c: 3, ldx: 3},
+		{r: 25, c: 7, ldx: 11},
+		{r: 100, c: 20, ldx: 20},
+	} {
+		for _, method := range []Method{TwoPass, OnePass} {
+			name := fmt.Sprintf("r=%d,c=%d,ldx=%d,method=%d", test.r, test.c, test.ldx, method)
+			x := randomData(rnd, test.r, test.c, test.ldx, 5)
+			xCopy := make([]float64, len(x))
+			copy(xCopy, x)
+			d := denseOf(test.r, test.c, x, test.ldx)
+
+			var want mat.SymDense
+			stat.CovarianceMatrix(&want, d, nil)
+			ldd := test.c + 2
+			got := make([]float64, (test.c-1)*ldd+test.c)
+			Covariance(method, test.r, test.c, x, test.ldx, got, ldd)
+			if !equalSym(test.c, got, ldd, &want, tol) {
+				t.Errorf("%s: unexpected covariance", name)
+			}
+
+			stat.CorrelationMatrix(&want, d, nil)
+			Correlation(method, test.r, test.c, x, test.ldx, got, ldd)
+			if !equalSym(test.c, got, ldd, &want, tol) {
+				t.Errorf("%s: unexpected correlation", name)
+			}
+
+			if !floats.Same(x, xCopy) {
+				t.Errorf("%s: data modified", name)
+			}
+		}
+	}
+}
+
+func TestGram(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		r, c, ldx int
+	}{
+		{r: 1, c: 1, ldx: 1},
+		{r: 5, c: 3, ldx: 4},
+		{r: 3, c: 6, ldx: 6},
+	} {
+		x := randomData(rnd, test.r, test.c, test.ldx, 0)
+		d := denseOf(test.r, test.c, x, test.ldx)
+		var want mat.Dense
+		want.Mul(d.T(), d)
+		got := make([]float64, test.c*test.c)
+		Gram(test.r, test.c, x, test.ldx, got, test.c)
+		if !floats.EqualApprox(got, want.RawMatrix().Data, tol) {
+			t.Errorf("r=%d,c=%d: unexpected Gram matrix", test.r, test.c)
+		}
+	}
+}
+
+func TestStandardize(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	const r, c, ldx = 30, 4, 6
+	x := randomData(rnd, r, c, ldx, 3)
+	// Make the last column constant.
+	for i := 0; i < r; i++ {
+		x[i*ldx+c-1] = 2
+	}
+	d := denseOf(r, c, x, ldx)
+
+	mean := make([]float64, c)
+	std := make([]float64, c)
+	Standardize(r, c, x, ldx, mean, std)
+	for j := 0; j < c; j++ {
+		col := mat.Col(nil, j, d)
+		m, s := stat.MeanStdDev(col, nil)
+		if math.Abs(mean[j]-m) > tol || math.Abs(std[j]-s) > tol {
+			t.Errorf("column %d: unexpected moments: got (%v, %v) want (%v, %v)", j, mean[j], std[j], m, s)
+		}
+		for i := 0; i < r; i++ {
+			want := col[i] - m
+			if s != 0 {
+				want /= s
+			}
+			if math.Abs(x[i*ldx+j]-want) > tol {
+				t.Errorf("element (%d,%d): got %v want %v", i, j, x[i*ldx+j], want)
+			}
+		}
+	}
+}
+
+func equalSym(n int, a []float64, lda int, b mat.Symmetric, tol float64) bool {
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if !floats.EqualWithinAbsOrRel(a[i*lda+j], b.At(i, j), tol, tol) {
+				return false
+			}
+		}
+	}
+	return true
+}
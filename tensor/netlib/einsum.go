@@ -0,0 +1,468 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package netlib provides tensor contractions built on the cgo BLAS bindings
+// in gonum.org/v1/netlib/blas/netlib.
+//
+// Tensors are dense and stored in row-major order: the element of a tensor
+// with shape [d0, d1, ..., dn] at index (i0, i1, ..., in) is at
+//  ((i0*d1 + i1)*d2 + ...)*dn + in
+// in its data slice.
+//
+// Contractions of two tensors are specified in the subscript notation of
+// numpy.einsum, for example "ij,jk->ik" for a matrix product or
+// "bij,bjk->bik" for a batch of matrix products. A contraction is planned
+// by grouping the indices into batch, free and contracted indices, so that
+// it is computed as a batch of matrix products with Dgemm after at most one
+// permutation of each operand and of the result. Operands whose indices are
+// already in a suitable order are passed to Dgemm without copying,
+// transposed if necessary.
+package netlib // import "gonum.org/v1/netlib/tensor/netlib"
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gonum.org/v1/gonum/blas"
+
+	blasnetlib "gonum.org/v1/netlib/blas/netlib"
+)
+
+var impl blasnetlib.Implementation
+
+const (
+	badShape = "tensor: negative dimension"
+	badData  = "tensor: data length does not match shape"
+	badPerm  = "tensor: bad permutation"
+)
+
+// Tensor is a dense row-major tensor.
+type Tensor struct {
+	Shape []int
+	Data  []float64
+}
+
+// New returns a zeroed tensor with the given shape. A tensor with an empty
+// shape is a scalar.
+func New(shape ...int) Tensor {
+	return Tensor{Shape: append([]int(nil), shape...), Data: make([]float64, size(shape))}
+}
+
+// size returns the number of elements of a tensor with the given shape.
+func size(shape []int) int {
+	n := 1
+	for _, d := range shape {
+		if d < 0 {
+			panic(badShape)
+		}
+		n *= d
+	}
+	return n
+}
+
+func (t Tensor) check() {
+	if len(t.Data) != size(t.Shape) {
+		panic(badData)
+	}
+}
+
+// Transpose returns a copy of t with its axes permuted so that axis i of
+// the result is axis perm[i] of t.
+func Transpose(t Tensor, perm ...int) Tensor {
+	t.check()
+	if len(perm) != len(t.Shape) {
+		panic(badPerm)
+	}
+	seen := make([]bool, len(perm))
+	shape := make([]int, len(perm))
+	for i, p := range perm {
+		if p < 0 || p >= len(perm) || seen[p] {
+			panic(badPerm)
+		}
+		seen[p] = true
+		shape[i] = t.Shape[p]
+	}
+	return Tensor{Shape: shape, Data: permute(t.Data, t.Shape, perm, nil)}
+}
+
+// permute stores the elements of the row-major tensor src with the given
+// shape into dst with its axes permuted by perm, and returns dst. If dst is
+// nil, it is allocated.
+func permute(src []float64, shape, perm []int, dst []float64) []float64 {
+	if dst == nil {
+		dst = make([]float64, len(src))
+	}
+	if len(src) == 0 {
+		return dst
+	}
+	n := len(shape)
+	// stride[i] is the stride in src of axis perm[i] of dst.
+	strides := make([]int, n)
+	s := 1
+	for i := n - 1; i >= 0; i-- {
+		strides[i] = s
+		s *= shape[i]
+	}
+	dims := make([]int, n)
+	stride := make([]int, n)
+	for i, p := range perm {
+		dims[i] = shape[p]
+		stride[i] = strides[p]
+	}
+	idx := make([]int, n)
+	var off int
+	for k := range dst {
+		dst[k] = src[off]
+		// Advance the multi-index of dst, tracking the offset in src.
+		for i := n - 1; i >= 0; i-- {
+			idx[i]++
+			off += stride[i]
+			if idx[i] < dims[i] {
+				break
+			}
+			off -= idx[i] * stride[i]
+			idx[i] = 0
+		}
+	}
+	return dst
+}
+
+// Contraction is a planned contraction of two tensors with fixed shapes. A
+// Contraction may be used concurrently by multiple goroutines.
+type Contraction struct {
+	spec  string
+	shape []int
+
+	// Operand a is summed over the axes in sumA and then permuted by
+	// permA so that its axes are ordered as batch, free and contracted
+	// axes, or batch, contracted and free axes if transA is blas.Trans.
+	// permA is nil if no permutation is needed. Likewise for b.
+	sumA, sumB     sum
+	permA, permB   []int
+	transA, transB blas.Transpose
+
+	batch, m, n, k int
+
+	// permC is the permutation from the batch, free a, free b order of
+	// the product to the requested output order, or nil if they are the
+	// same.
+	permC []int
+}
+
+// sum describes the summation of an operand over the axes that appear in
+// no other operand and not in the output.
+type sum struct {
+	perm    []int // permutation moving the summed axes last, or nil
+	shape   []int // shape of the operand before summation
+	reduced []int // shape of the operand after summation
+	width   int   // product of the summed dimensions
+}
+
+// NewContraction plans the contraction of tensors with shapes a and b
+// specified by spec in the subscript notation of numpy.einsum, for example
+// "ij,jk->ik". Each subscript is a single letter. If the output subscripts
+// and the arrow are omitted, the output subscripts are the letters that
+// appear exactly once, in alphabetical order.
+//
+// Subscripts that appear in both operands and the output are batch indices,
+// those that appear in both operands but not the output are summed by the
+// matrix products, and those that appear in one operand only and not the
+// output are summed out of that operand before the products are formed.
+// Repeated subscripts within an operand, which denote diagonals, are not
+// supported.
+//
+// NewContraction returns an error if spec is malformed or inconsistent with
+// the shapes.
+func NewContraction(spec string, a, b []int) (*Contraction, error) {
+	in, out, err := parse(spec)
+	if err != nil {
+		return nil, err
+	}
+	sa, sb := in[0], in[1]
+	if len(sa) != len(a) || len(sb) != len(b) {
+		return nil, fmt.Errorf("tensor: %q: subscripts do not match the number of dimensions", spec)
+	}
+
+	dim := make(map[byte]int)
+	for _, op := range []struct {
+		sub   string
+		shape []int
+	}{{sa, a}, {sb, b}} {
+		for i := 0; i < len(op.sub); i++ {
+			c := op.sub[i]
+			d := op.shape[i]
+			if d < 0 {
+				return nil, errors.New(badShape)
+			}
+			if prev, ok := dim[c]; ok && prev != d {
+				return nil, fmt.Errorf("tensor: %q: inconsistent dimensions %d and %d for subscript %c", spec, prev, d, c)
+			}
+			dim[c] = d
+		}
+	}
+
+	var batch, freeA, freeB, contract []byte
+	inOut := func(c byte) bool { return strings.IndexByte(out, c) >= 0 }
+	for i := 0; i < len(sa); i++ {
+		c := sa[i]
+		switch inB := strings.IndexByte(sb, c) >= 0; {
+		case inB && inOut(c):
+			batch = append(batch, c)
+		case inB:
+			contract = append(contract, c)
+		case inOut(c):
+			freeA = append(freeA, c)
+		}
+	}
+	for i := 0; i < len(sb); i++ {
+		c := sb[i]
+		if strings.IndexByte(sa, c) < 0 && inOut(c) {
+			freeB = append(freeB, c)
+		}
+	}
+	for i := 0; i < len(out); i++ {
+		if _, ok := dim[out[i]]; !ok {
+			return nil, fmt.Errorf("tensor: %q: output subscript %c does not appear in an operand", spec, out[i])
+		}
+	}
+
+	c := &Contraction{spec: spec}
+	prod := func(sub []byte) int {
+		p := 1
+		for _, s := range sub {
+			p *= dim[s]
+		}
+		return p
+	}
+	c.batch, c.m, c.n, c.k = prod(batch), prod(freeA), prod(freeB), prod(contract)
+
+	sa, c.sumA = planSum(sa, a, out, sb)
+	sb, c.sumB = planSum(sb, b, out, sa)
+	c.permA, c.transA = planOperand(sa, batch, freeA, contract)
+	c.permB, c.transB = planOperand(sb, batch, contract, freeB)
+
+	prodOrder := string(batch) + string(freeA) + string(freeB)
+	c.shape = make([]int, len(out))
+	for i := 0; i < len(out); i++ {
+		c.shape[i] = dim[out[i]]
+	}
+	if prodOrder != out {
+		c.permC = make([]int, len(out))
+		for i := 0; i < len(out); i++ {
+			c.permC[i] = strings.IndexByte(prodOrder, out[i])
+		}
+	}
+	return c, nil
+}
+
+// parse splits spec into the subscripts of the two operands and of the
+// output.
+func parse(spec string) (in [2]string, out string, err error) {
+	s := strings.Replace(spec, " ", "", -1)
+	lhs := s
+	explicit := false
+	if i := strings.Index(s, "->"); i >= 0 {
+		lhs, out = s[:i], s[i+2:]
+		explicit = true
+	}
+	ops := strings.Split(lhs, ",")
+	if len(ops) != 2 {
+		return in, "", fmt.Errorf("tensor: %q: expected two operands", spec)
+	}
+	count := make(map[byte]int)
+	for i, op := range ops {
+		for j := 0; j < len(op); j++ {
+			c := op[j]
+			if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z') {
+				return in, "", fmt.Errorf("tensor: %q: invalid subscript %q", spec, c)
+			}
+			if strings.IndexByte(op[:j], c) >= 0 {
+				return in, "", fmt.Errorf("tensor: %q: repeated subscript %c in operand %d is not supported", spec, c, i)
+			}
+			count[c]++
+		}
+		in[i] = op
+	}
+	if !explicit {
+		var once []byte
+		for c := byte('A'); c <= 'z'; c++ {
+			if count[c] == 1 {
+				once = append(once, c)
+			}
+		}
+		return in, string(once), nil
+	}
+	for j := 0; j < len(out); j++ {
+		if strings.IndexByte(out[:j], out[j]) >= 0 {
+			return in, "", fmt.Errorf("tensor: %q: repeated output subscript %c", spec, out[j])
+		}
+	}
+	return in, out, nil
+}
+
+// planSum returns the subscripts of an operand with subscripts sub and the
+// given shape after summing over the axes that appear neither in the output
+// nor in the other operand, and the plan for that summation.
+func planSum(sub string, shape []int, out, other string) (string, sum) {
+	var keep, drop []int
+	for i := 0; i < len(sub); i++ {
+		c := sub[i]
+		if strings.IndexByte(out, c) < 0 && strings.IndexByte(other, c) < 0 {
+			drop = append(drop, i)
+		} else {
+			keep = append(keep, i)
+		}
+	}
+	s := sum{shape: shape, reduced: shape, width: 1}
+	if len(drop) == 0 {
+		return sub, s
+	}
+	s.reduced = make([]int, len(keep))
+	perm := append(keep, drop...)
+	if !isIdentity(perm) {
+		s.perm = perm
+	}
+	kept := make([]byte, len(keep))
+	for i, k := range keep {
+		kept[i] = sub[k]
+		s.reduced[i] = shape[k]
+	}
+	for _, d := range drop {
+		s.width *= shape[d]
+	}
+	return string(kept), s
+}
+
+// planOperand returns the permutation that orders the axes of an operand
+// with subscripts sub as batch, row and column indices, or nil if none is
+// needed, and whether the operand is then used transposed. If sub is
+// ordered as batch, column and row indices, the operand is used transposed
+// without permutation.
+func planOperand(sub string, batch, rows, cols []byte) ([]int, blas.Transpose) {
+	b := string(batch)
+	switch sub {
+	case b + string(rows) + string(cols):
+		return nil, blas.NoTrans
+	case b + string(cols) + string(rows):
+		return nil, blas.Trans
+	}
+	order := b + string(rows) + string(cols)
+	perm := make([]int, len(order))
+	for i := 0; i < len(order); i++ {
+		perm[i] = strings.IndexByte(sub, order[i])
+	}
+	return perm, blas.NoTrans
+}
+
+func isIdentity(perm []int) bool {
+	for i, p := range perm {
+		if i != p {
+			return false
+		}
+	}
+	return true
+}
+
+// Shape returns the shape of the result of the contraction.
+func (c *Contraction) Shape() []int {
+	return append([]int(nil), c.shape...)
+}
+
+// GemmShape returns the number of matrix products performed by the
+// contraction and the dimensions of each product, whose m×k and k×n
+// operands give an m×n result.
+func (c *Contraction) GemmShape() (batch, m, n, k int) {
+	return c.batch, c.m, c.n, c.k
+}
+
+// String returns the subscript specification of the contraction.
+func (c *Contraction) String() string { return c.spec }
+
+// Do computes the contraction of a and b and stores the result into dst,
+// which must have length equal to the product of the dimensions returned by
+// Shape. a and b must have lengths matching the shapes the contraction was
+// planned for. Otherwise Do will panic.
+func (c *Contraction) Do(dst, a, b []float64) {
+	if len(a) != size(c.sumA.shape) || len(b) != size(c.sumB.shape) || len(dst) != size(c.shape) {
+		panic(badData)
+	}
+	a = c.sumA.apply(a)
+	b = c.sumB.apply(b)
+	if c.permA != nil {
+		a = permute(a, c.sumA.reduced, c.permA, nil)
+	}
+	if c.permB != nil {
+		b = permute(b, c.sumB.reduced, c.permB, nil)
+	}
+
+	prod := dst
+	if c.permC != nil {
+		prod = make([]float64, len(dst))
+	}
+	m, n, k := c.m, c.n, c.k
+	switch {
+	case len(prod) == 0:
+	case k == 0:
+		for i := range prod {
+			prod[i] = 0
+		}
+	default:
+		lda := k
+		if c.transA == blas.Trans {
+			lda = m
+		}
+		ldb := n
+		if c.transB == blas.Trans {
+			ldb = k
+		}
+		for p := 0; p < c.batch; p++ {
+			impl.Dgemm(c.transA, c.transB, m, n, k, 1, a[p*m*k:], lda, b[p*k*n:], ldb, 0, prod[p*m*n:], n)
+		}
+	}
+	if c.permC != nil {
+		// The product is ordered as batch, free a and free b indices.
+		pshape := make([]int, len(c.shape))
+		for i, p := range c.permC {
+			pshape[p] = c.shape[i]
+		}
+		permute(prod, pshape, c.permC, dst)
+	}
+}
+
+// apply returns x summed over the axes described by s.
+func (s sum) apply(x []float64) []float64 {
+	if len(s.reduced) == len(s.shape) {
+		return x
+	}
+	if s.perm != nil {
+		x = permute(x, s.shape, s.perm, nil)
+	}
+	y := make([]float64, size(s.reduced))
+	if s.width == 0 {
+		return y
+	}
+	for i := range y {
+		var v float64
+		for _, e := range x[i*s.width : (i+1)*s.width] {
+			v += e
+		}
+		y[i] = v
+	}
+	return y
+}
+
+// Einsum computes the contraction of a and b specified by spec as described
+// for NewContraction and returns the result.
+func Einsum(spec string, a, b Tensor) (Tensor, error) {
+	a.check()
+	b.check()
+	c, err := NewContraction(spec, a.Shape, b.Shape)
+	if err != nil {
+		return Tensor{}, err
+	}
+	t := New(c.shape...)
+	c.Do(t.Data, a.Data, b.Data)
+	return t, nil
+}
@@ -0,0 +1,162 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import "gonum.org/v1/gonum/blas"
+
+// Tensor32 is a dense row-major tensor of float32 values.
+type Tensor32 struct {
+	Shape []int
+	Data  []float32
+}
+
+// New32 is the float32 version of New.
+func New32(shape ...int) Tensor32 {
+	return Tensor32{Shape: append([]int(nil), shape...), Data: make([]float32, size(shape))}
+}
+
+func (t Tensor32) check() {
+	if len(t.Data) != size(t.Shape) {
+		panic(badData)
+	}
+}
+
+// Transpose32 is the float32 version of Transpose.
+func Transpose32(t Tensor32, perm ...int) Tensor32 {
+	t.check()
+	if len(perm) != len(t.Shape) {
+		panic(badPerm)
+	}
+	seen := make([]bool, len(perm))
+	shape := make([]int, len(perm))
+	for i, p := range perm {
+		if p < 0 || p >= len(perm) || seen[p] {
+			panic(badPerm)
+		}
+		seen[p] = true
+		shape[i] = t.Shape[p]
+	}
+	return Tensor32{Shape: shape, Data: permute32(t.Data, t.Shape, perm, nil)}
+}
+
+// permute32 is the float32 version of permute.
+func permute32(src []float32, shape, perm []int, dst []float32) []float32 {
+	if dst == nil {
+		dst = make([]float32, len(src))
+	}
+	if len(src) == 0 {
+		return dst
+	}
+	n := len(shape)
+	strides := make([]int, n)
+	s := 1
+	for i := n - 1; i >= 0; i-- {
+		strides[i] = s
+		s *= shape[i]
+	}
+	dims := make([]int, n)
+	stride := make([]int, n)
+	for i, p := range perm {
+		dims[i] = shape[p]
+		stride[i] = strides[p]
+	}
+	idx := make([]int, n)
+	var off int
+	for k := range dst {
+		dst[k] = src[off]
+		for i := n - 1; i >= 0; i-- {
+			idx[i]++
+			off += stride[i]
+			if idx[i] < dims[i] {
+				break
+			}
+			off -= idx[i] * stride[i]
+			idx[i] = 0
+		}
+	}
+	return dst
+}
+
+// Do32 is the float32 version of Do. The products are computed with Sgemm.
+func (c *Contraction) Do32(dst, a, b []float32) {
+	if len(a) != size(c.sumA.shape) || len(b) != size(c.sumB.shape) || len(dst) != size(c.shape) {
+		panic(badData)
+	}
+	a = c.sumA.apply32(a)
+	b = c.sumB.apply32(b)
+	if c.permA != nil {
+		a = permute32(a, c.sumA.reduced, c.permA, nil)
+	}
+	if c.permB != nil {
+		b = permute32(b, c.sumB.reduced, c.permB, nil)
+	}
+
+	prod := dst
+	if c.permC != nil {
+		prod = make([]float32, len(dst))
+	}
+	m, n, k := c.m, c.n, c.k
+	switch {
+	case len(prod) == 0:
+	case k == 0:
+		for i := range prod {
+			prod[i] = 0
+		}
+	default:
+		lda := k
+		if c.transA == blas.Trans {
+			lda = m
+		}
+		ldb := n
+		if c.transB == blas.Trans {
+			ldb = k
+		}
+		for p := 0; p < c.batch; p++ {
+			impl.Sgemm(c.transA, c.transB, m, n, k, 1, a[p*m*k:], lda, b[p*k*n:], ldb, 0, prod[p*m*n:], n)
+		}
+	}
+	if c.permC != nil {
+		pshape := make([]int, len(c.shape))
+		for i, p := range c.permC {
+			pshape[p] = c.shape[i]
+		}
+		permute32(prod, pshape, c.permC, dst)
+	}
+}
+
+// apply32 is the float32 version of apply.
+func (s sum) apply32(x []float32) []float32 {
+	if len(s.reduced) == len(s.shape) {
+		return x
+	}
+	if s.perm != nil {
+		x = permute32(x, s.shape, s.perm, nil)
+	}
+	y := make([]float32, size(s.reduced))
+	if s.width == 0 {
+		return y
+	}
+	for i := range y {
+		var v float32
+		for _, e := range x[i*s.width : (i+1)*s.width] {
+			v += e
+		}
+		y[i] = v
+	}
+	return y
+}
+
+// Einsum32 is the float32 version of Einsum.
+func Einsum32(spec string, a, b Tensor32) (Tensor32, error) {
+	a.check()
+	b.check()
+	c, err := NewContraction(spec, a.Shape, b.Shape)
+	if err != nil {
+		return Tensor32{}, err
+	}
+	t := New32(c.shape...)
+	c.Do32(t.Data, a.Data, b.Data)
+	return t, nil
+}
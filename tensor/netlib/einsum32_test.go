@@ -0,0 +1,70 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"math"
+	"testing"
+
+	"golang.org/x/exp/rand"
+)
+
+func TestEinsum32(t *testing.T) {
+	const tol = 1e-5
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		spec string
+		a, b []int
+	}{
+		{spec: "ij,jk->ik", a: []int{3, 4}, b: []int{4, 5}},
+		{spec: "ij,jk->ki", a: []int{3, 4}, b: []int{4, 5}},
+		{spec: "bij,bjk->bik", a: []int{6, 3, 4}, b: []int{6, 4, 2}},
+		{spec: "ibj,bkj->bki", a: []int{3, 6, 4}, b: []int{6, 2, 4}},
+		{spec: "xij,jky->ki", a: []int{2, 3, 4}, b: []int{4, 5, 3}},
+		{spec: "ij,jk->ik", a: []int{3, 0}, b: []int{0, 5}},
+	} {
+		a := randomTensor(rnd, test.a...)
+		b := randomTensor(rnd, test.b...)
+		a32 := Tensor32{Shape: a.Shape, Data: make([]float32, len(a.Data))}
+		for i, v := range a.Data {
+			a32.Data[i] = float32(v)
+			a.Data[i] = float64(a32.Data[i])
+		}
+		b32 := Tensor32{Shape: b.Shape, Data: make([]float32, len(b.Data))}
+		for i, v := range b.Data {
+			b32.Data[i] = float32(v)
+			b.Data[i] = float64(b32.Data[i])
+		}
+
+		want, err := Einsum(test.spec, a, b)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", test.spec, err)
+		}
+		got, err := Einsum32(test.spec, a32, b32)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.spec, err)
+			continue
+		}
+		if !equalInts(got.Shape, want.Shape) {
+			t.Errorf("%s: unexpected shape: got %v want %v", test.spec, got.Shape, want.Shape)
+			continue
+		}
+		for i, v := range want.Data {
+			if math.Abs(float64(got.Data[i])-v) > tol*math.Max(1, math.Abs(v)) {
+				t.Errorf("%s: unexpected result at %d: got %v want %v", test.spec, i, got.Data[i], v)
+				break
+			}
+		}
+	}
+
+	x := New32(2, 3)
+	for i := range x.Data {
+		x.Data[i] = float32(i)
+	}
+	y := Transpose32(x, 1, 0)
+	if !equalInts(y.Shape, []int{3, 2}) || y.Data[1] != 3 || y.Data[2] != 1 {
+		t.Errorf("unexpected transpose: %+v", y)
+	}
+}
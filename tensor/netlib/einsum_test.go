@@ -0,0 +1,192 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/exp/rand"
+
+	"gonum.org/v1/gonum/floats"
+)
+
+func randomTensor(rnd *rand.Rand, shape ...int) Tensor {
+	t := New(shape...)
+	for i := range t.Data {
+		t.Data[i] = rnd.NormFloat64()
+	}
+	return t
+}
+
+// naiveEinsum computes the contraction by looping over all values of all
+// subscripts.
+func naiveEinsum(in [2]string, out string, a, b Tensor) Tensor {
+	dim := make(map[byte]int)
+	var labels []byte
+	for k, t := range []Tensor{a, b} {
+		for i := 0; i < len(in[k]); i++ {
+			if _, ok := dim[in[k][i]]; !ok {
+				labels = append(labels, in[k][i])
+			}
+			dim[in[k][i]] = t.Shape[i]
+		}
+	}
+	shape := make([]int, len(out))
+	for i := 0; i < len(out); i++ {
+		shape[i] = dim[out[i]]
+	}
+	res := New(shape...)
+	offset := func(sub string, shape []int, val map[byte]int) int {
+		var off int
+		for i := 0; i < len(sub); i++ {
+			off = off*shape[i] + val[sub[i]]
+		}
+		return off
+	}
+	val := make(map[byte]int)
+	var loop func(int)
+	loop = func(l int) {
+		if l == len(labels) {
+			res.Data[offset(out, shape, val)] += a.Data[offset(in[0], a.Shape, val)] * b.Data[offset(in[1], b.Shape, val)]
+			return
+		}
+		for v := 0; v < dim[labels[l]]; v++ {
+			val[labels[l]] = v
+			loop(l + 1)
+		}
+	}
+	loop(0)
+	return res
+}
+
+func TestEinsum(t *testing.T) {
+	const tol = 1e-12
+	rnd := rand.New(rand.NewSource(1))
+	for _, test := range []struct {
+		spec string
+		a, b []int
+		want []int
+	}{
+		{spec: "ij,jk->ik", a: []int{3, 4}, b: []int{4, 5}, want: []int{3, 5}},
+		{spec: "ij,jk", a: []int{3, 4}, b: []int{4, 5}, want: []int{3, 5}},
+		{spec: "ji,jk->ik", a: []int{4, 3}, b: []int{4, 5}, want: []int{3, 5}},
+		{spec: "ij,kj->ik", a: []int{3, 4}, b: []int{5, 4}, want: []int{3, 5}},
+		{spec: "ij,jk->ki", a: []int{3, 4}, b: []int{4, 5}, want: []int{5, 3}},
+		{spec: "bij,bjk->bik", a: []int{6, 3, 4}, b: []int{6, 4, 2}, want: []int{6, 3, 2}},
+		{spec: "ibj,bkj->bki", a: []int{3, 6, 4}, b: []int{6, 2, 4}, want: []int{6, 2, 3}},
+		{spec: "i,i->", a: []int{7}, b: []int{7}, want: []int{}},
+		{spec: "i,j->ij", a: []int{3}, b: []int{4}, want: []int{3, 4}},
+		{spec: "ijk,jkl->il", a: []int{2, 3, 4}, b: []int{3, 4, 5}, want: []int{2, 5}},
+		{spec: "ikj,kjl->li", a: []int{2, 3, 4}, b: []int{3, 4, 5}, want: []int{5, 2}},
+		{spec: "ijx,jk->ik", a: []int{3, 4, 2}, b: []int{4, 5}, want: []int{3, 5}},
+		{spec: "xij,jky->ki", a: []int{2, 3, 4}, b: []int{4, 5, 3}, want: []int{5, 3}},
+		{spec: "ij,jk->ik", a: []int{3, 0}, b: []int{0, 5}, want: []int{3, 5}},
+		{spec: "ij,jk->ik", a: []int{0, 2}, b: []int{2, 5}, want: []int{0, 5}},
+		{spec: "abcd,cdbe->aeb", a: []int{2, 3, 2, 3}, b: []int{2, 3, 3, 4}, want: []int{2, 4, 3}},
+	} {
+		a := randomTensor(rnd, test.a...)
+		b := randomTensor(rnd, test.b...)
+		got, err := Einsum(test.spec, a, b)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.spec, err)
+			continue
+		}
+		if !equalInts(got.Shape, test.want) {
+			t.Errorf("%s: unexpected shape: got %v want %v", test.spec, got.Shape, test.want)
+			continue
+		}
+		in, out, _ := parse(test.spec)
+		want := naiveEinsum(in, out, a, b)
+		if !floats.EqualApprox(got.Data, want.Data, tol) {
+			t.Errorf("%s: unexpected result: got %v want %v", test.spec, got.Data, want.Data)
+		}
+	}
+}
+
+func TestContractionPlan(t *testing.T) {
+	for _, test := range []struct {
+		spec           string
+		a, b           []int
+		permA, permB   bool
+		permC          bool
+		batch, m, n, k int
+	}{
+		{spec: "ij,jk->ik", a: []int{3, 4}, b: []int{4, 5}, batch: 1, m: 3, n: 5, k: 4},
+		{spec: "ji,kj->ik", a: []int{4, 3}, b: []int{5, 4}, batch: 1, m: 3, n: 5, k: 4},
+		{spec: "bij,bjk->bik", a: []int{6, 3, 4}, b: []int{6, 4, 2}, batch: 6, m: 3, n: 2, k: 4},
+		{spec: "ibj,bjk->bik", a: []int{3, 6, 4}, b: []int{6, 4, 2}, permA: true, batch: 6, m: 3, n: 2, k: 4},
+		{spec: "ij,jk->ki", a: []int{3, 4}, b: []int{4, 5}, permC: true, batch: 1, m: 3, n: 5, k: 4},
+	} {
+		c, err := NewContraction(test.spec, test.a, test.b)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", test.spec, err)
+			continue
+		}
+		if (c.permA != nil) != test.permA || (c.permB != nil) != test.permB || (c.permC != nil) != test.permC {
+			t.Errorf("%s: unexpected permutations: %v %v %v", test.spec, c.permA, c.permB, c.permC)
+		}
+		batch, m, n, k := c.GemmShape()
+		if batch != test.batch || m != test.m || n != test.n || k != test.k {
+			t.Errorf("%s: unexpected gemm shape: got %d,%d,%d,%d want %d,%d,%d,%d",
+				test.spec, batch, m, n, k, test.batch, test.m, test.n, test.k)
+		}
+	}
+}
+
+func TestContractionErrors(t *testing.T) {
+	for _, test := range []struct {
+		spec string
+		a, b []int
+		want string
+	}{
+		{spec: "ij->i", a: []int{2, 2}, b: []int{2}, want: "two operands"},
+		{spec: "ij,jk,kl->il", a: []int{2, 2}, b: []int{2, 2}, want: "two operands"},
+		{spec: "ii,ij->j", a: []int{2, 2}, b: []int{2, 2}, want: "repeated subscript"},
+		{spec: "ij,jk->iz", a: []int{2, 2}, b: []int{2, 2}, want: "does not appear"},
+		{spec: "ij,jk->ik", a: []int{2, 3}, b: []int{2, 2}, want: "inconsistent dimensions"},
+		{spec: "ij,jk->ik", a: []int{2}, b: []int{2, 2}, want: "number of dimensions"},
+		{spec: "i1,jk->ik", a: []int{2, 2}, b: []int{2, 2}, want: "invalid subscript"},
+		{spec: "ij,jk->ii", a: []int{2, 2}, b: []int{2, 2}, want: "repeated output"},
+	} {
+		_, err := NewContraction(test.spec, test.a, test.b)
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%s: unexpected error: got %v want %q", test.spec, err, test.want)
+		}
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	a := Tensor{Shape: []int{2, 3}, Data: []float64{1, 2, 3, 4, 5, 6}}
+	got := Transpose(a, 1, 0)
+	if !equalInts(got.Shape, []int{3, 2}) || !floats.Equal(got.Data, []float64{1, 4, 2, 5, 3, 6}) {
+		t.Errorf("unexpected transpose: %v", got)
+	}
+
+	rnd := rand.New(rand.NewSource(1))
+	b := randomTensor(rnd, 2, 3, 4)
+	c := Transpose(b, 2, 0, 1)
+	for i := 0; i < 2; i++ {
+		for j := 0; j < 3; j++ {
+			for k := 0; k < 4; k++ {
+				if c.Data[(k*2+i)*3+j] != b.Data[(i*3+j)*4+k] {
+					t.Fatalf("unexpected element at (%d,%d,%d)", i, j, k)
+				}
+			}
+		}
+	}
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,75 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"sync"
+
+	blasnetlib "gonum.org/v1/netlib/blas/netlib"
+)
+
+// single records the regions run by SingleThreaded.
+var single struct {
+	sync.Mutex
+	// depth is the number of regions that have not returned.
+	depth int
+	// saved is the number of threads to restore when the last region
+	// returns, or zero if the library does not report it.
+	saved int
+}
+
+// SingleThreaded calls f with the linked library limited to one thread and
+// restores the previous number of threads when f returns or panics. It is
+// meant for sections in which the caller parallelizes its work at a higher
+// level, for example over goroutines, so that the library does not
+// oversubscribe the processors.
+//
+// Regions may be nested and may run concurrently in several goroutines.
+// The library uses one thread until the last of them returns, and the
+// number of threads from before the first one is restored then. A number
+// of threads set by Configure while a region runs takes effect when the
+// last region returns. SetNumThreads of gonum.org/v1/netlib/blas/netlib
+// changes the number immediately and should not be called inside a region.
+//
+// If the library does not report its number of threads, SingleThreaded
+// only calls f.
+func SingleThreaded(f func()) {
+	enterSingle()
+	defer leaveSingle()
+	f()
+}
+
+func enterSingle() {
+	single.Lock()
+	defer single.Unlock()
+	if single.depth == 0 {
+		single.saved = blasnetlib.NumThreads()
+		if single.saved > 1 {
+			blasnetlib.SetNumThreads(1)
+		}
+	}
+	single.depth++
+}
+
+func leaveSingle() {
+	single.Lock()
+	defer single.Unlock()
+	single.depth--
+	if single.depth == 0 && single.saved > 1 {
+		blasnetlib.SetNumThreads(single.saved)
+	}
+}
+
+// setThreads sets the number of threads used by the library, deferring the
+// change to the end of the running SingleThreaded regions, if any.
+func setThreads(n int) {
+	single.Lock()
+	defer single.Unlock()
+	if single.depth > 0 {
+		single.saved = n
+		return
+	}
+	blasnetlib.SetNumThreads(n)
+}
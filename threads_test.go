@@ -0,0 +1,57 @@
+// Copyright ©2026 The Gonum Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package netlib
+
+import (
+	"sync"
+	"testing"
+
+	blasnetlib "gonum.org/v1/netlib/blas/netlib"
+)
+
+func TestSingleThreaded(t *testing.T) {
+	if blasnetlib.NumThreads() == 0 {
+		t.Skip("library does not report its number of threads")
+	}
+	defer blasnetlib.SetNumThreads(blasnetlib.NumThreads())
+
+	blasnetlib.SetNumThreads(4)
+	SingleThreaded(func() {
+		if n := blasnetlib.NumThreads(); n != 1 {
+			t.Errorf("unexpected number of threads in region: got %d want 1", n)
+		}
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				SingleThreaded(func() {})
+			}()
+		}
+		wg.Wait()
+		if n := blasnetlib.NumThreads(); n != 1 {
+			t.Errorf("unexpected number of threads after nested regions: got %d want 1", n)
+		}
+		Configure(WithThreads(3))
+		if n := blasnetlib.NumThreads(); n != 1 {
+			t.Errorf("Configure changed the number of threads in region: got %d", n)
+		}
+	})
+	if n := blasnetlib.NumThreads(); n != 3 {
+		t.Errorf("unexpected number of threads after region: got %d want 3", n)
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("expected panic")
+			}
+		}()
+		SingleThreaded(func() { panic("test") })
+	}()
+	if n := blasnetlib.NumThreads(); n != 3 {
+		t.Errorf("number of threads not restored after panic: got %d want 3", n)
+	}
+}